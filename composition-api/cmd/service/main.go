@@ -31,12 +31,14 @@ import (
 
 	"composition-api/internal/adapters"
 	"composition-api/internal/config"
+	deidentifyDomain "composition-api/internal/domain/deidentify"
 	api "composition-api/internal/generated/http/api"
 	"composition-api/internal/observability"
 	"composition-api/internal/repository"
 	"composition-api/internal/server"
 	"composition-api/internal/server/security"
 	"composition-api/internal/services"
+	"composition-api/internal/services/deidentify"
 )
 
 //go:embed server.yml
@@ -61,6 +63,27 @@ func splitCommaTrim(s string) []string {
 	return out
 }
 
+// runPeriodic выполняет job раз в interval, ошибки только логируются; interval 0 отключает job
+func runPeriodic(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				slog.Error(name, "err", err)
+			}
+		}
+	}
+}
+
 func main() {
 	os.Exit(run())
 }
@@ -89,6 +112,7 @@ func run() (exitCode int) {
 	uziConn, err := grpc.NewClient(
 		cfg.Adapters.UziUrl,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(security.ActorClientCall),
 	)
 	if err != nil {
 		slog.Error("init uziConn", slog.Any("err", err))
@@ -176,8 +200,39 @@ func run() (exitCode int) {
 
 	producer := producers.New(dbusClient)
 
+	deidentifyProfile, err := deidentifyDomain.ProfileName("").Parse(cfg.Deidentify.Profile)
+	if err != nil {
+		slog.Error("parse deidentify profile", slog.Any("err", err))
+		return failExitCode
+	}
+
 	// services
-	services := services.New(adapters, producer, dao)
+	services := services.New(adapters, producer, dao, deidentify.Config{
+		Profile: deidentifyDomain.NewProfile(deidentifyProfile),
+		Key:     cfg.Deidentify.Key,
+	})
+
+	go runPeriodic(context.Background(), "upload processing", cfg.Upload.ProcessInterval, func(ctx context.Context) error {
+		processed, err := services.UploadService.RunProcessing(ctx)
+		if err != nil {
+			return err
+		}
+		if processed > 0 {
+			slog.Info("upload processing", "processed", processed)
+		}
+		return nil
+	})
+
+	go runPeriodic(context.Background(), "upload expiration", cfg.Upload.ExpireInterval, func(ctx context.Context) error {
+		expired, err := services.UploadService.RunExpiration(ctx)
+		if err != nil {
+			return err
+		}
+		if expired > 0 {
+			slog.Info("upload expiration", "expired", expired)
+		}
+		return nil
+	})
 
 	// server
 	handlers := server.New(services)
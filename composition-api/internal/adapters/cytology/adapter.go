@@ -37,8 +37,13 @@ type Adapter interface {
 	CreateSegmentation(ctx context.Context, in CreateSegmentationIn) (int, error)
 	GetSegmentationById(ctx context.Context, id int) (domain.Segmentation, error)
 	GetSegmentsByGroupId(ctx context.Context, id int) ([]domain.Segmentation, error)
+	GetSegmentsInViewport(ctx context.Context, in GetSegmentsInViewportIn) (domain.SegmentationPage, error)
 	UpdateSegmentation(ctx context.Context, in UpdateSegmentationIn) (domain.Segmentation, error)
 	DeleteSegmentation(ctx context.Context, id int) error
+
+	// QUPATH
+	ExportQuPathGeoJson(ctx context.Context, cytologyID uuid.UUID) ([]byte, error)
+	ImportQuPathGeoJson(ctx context.Context, cytologyID uuid.UUID, geojson []byte) (domain.QuPathImport, error)
 }
 
 type adapter struct {
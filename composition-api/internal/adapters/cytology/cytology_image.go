@@ -34,8 +34,11 @@ func (a *adapter) CreateCytologyImage(ctx context.Context, in CreateCytologyImag
 		DiagnosticNumber: int32(in.DiagnosticNumber),
 	}
 
-	// Файл больше не передается через gRPC, он загружается напрямую в S3 в сервисе
-	// Оставляем File пустым, чтобы не читать файл в память
+	// Файл не передается через gRPC, он загружается напрямую в S3 в сервисе
+	if in.ImagePath != nil {
+		req.ImagePath = in.ImagePath
+		req.ContentType = &in.ContentType
+	}
 
 	if in.DiagnosticMarking != nil {
 		dm := diagnosticMarkingMap[*in.DiagnosticMarking]
@@ -77,7 +80,7 @@ func (a *adapter) CreateCytologyImage(ctx context.Context, in CreateCytologyImag
 	}
 
 	slog.Info("CreateCytologyImage: sending gRPC request",
-		"file_size", len(req.File),
+		"has_image", req.ImagePath != nil,
 	)
 
 	startTime := time.Now()
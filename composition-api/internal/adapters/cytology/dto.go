@@ -2,7 +2,6 @@ package cytology
 
 import (
 	"github.com/google/uuid"
-	ht "github.com/ogen-go/ogen/http"
 
 	domain "composition-api/internal/domain/cytology"
 )
@@ -20,8 +19,9 @@ type CreateCytologyImageIn struct {
 	Details           *string
 	PrevID            *uuid.UUID
 	ParentPrevID      *uuid.UUID
-	File              *ht.MultipartFile
 	ContentType       string
+	// путь к уже загруженному в S3 файлу
+	ImagePath *string
 }
 
 type UpdateCytologyImageIn struct {
@@ -39,10 +39,10 @@ type UpdateCytologyImageIn struct {
 
 type CreateOriginalImageIn struct {
 	CytologyID  uuid.UUID
-	File        ht.MultipartFile // Используется только если ImagePath не указан
 	ContentType string
 	DelayTime   *float64
-	ImagePath   *string // Путь к файлу в S3 (если файл уже загружен)
+	ImagePath   string // Путь к уже загруженному в S3 файлу
+	Sha256      *string
 }
 
 type UpdateOriginalImageIn struct {
@@ -67,10 +67,31 @@ type UpdateSegmentationGroupIn struct {
 
 type CreateSegmentationIn struct {
 	SegmentationGroupID int
-	Points              []domain.SegmentationPoint
+	// не передан - определяется по точкам
+	GeometryType *domain.GeometryType
+	Points       []domain.SegmentationPoint
+	Color        *domain.Color
+	IsLocked     bool
+	Confidence   *float64
+	Properties   *string
 }
 
+type GetSegmentsInViewportIn struct {
+	CytologyID uuid.UUID
+	BBox       domain.BBox
+	Filter     domain.SegmentationViewportFilter
+	Downsample float64
+	Cursor     *string
+	Limit      int
+}
+
+// UpdateSegmentationIn точки заменяются целиком, метаданные - только переданные
 type UpdateSegmentationIn struct {
-	Id     int
-	Points []domain.SegmentationPoint
+	Id           int
+	Points       []domain.SegmentationPoint
+	GeometryType *domain.GeometryType
+	Color        *domain.Color
+	IsLocked     *bool
+	Confidence   *float64
+	Properties   *string
 }
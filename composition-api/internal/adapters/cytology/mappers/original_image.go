@@ -27,6 +27,7 @@ func (m OriginalImage) Domain(pb *pb.OriginalImage) domain.OriginalImage {
 		CreateDate: createDate,
 		DelayTime:  delayTime,
 		ViewedFlag: pb.ViewedFlag,
+		Sha256:     pb.Sha256,
 	}
 }
 
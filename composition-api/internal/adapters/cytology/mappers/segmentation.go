@@ -34,6 +34,13 @@ var groupTypeMap = map[pb.GroupType]domain.GroupType{
 	pb.GroupType_GROUP_TYPE_ME: domain.GroupTypeME,
 }
 
+var geometryTypeMap = map[pb.GeometryType]domain.GeometryType{
+	pb.GeometryType_GEOMETRY_TYPE_POINT:         domain.GeometryTypePoint,
+	pb.GeometryType_GEOMETRY_TYPE_LINE_STRING:   domain.GeometryTypeLineString,
+	pb.GeometryType_GEOMETRY_TYPE_POLYGON:       domain.GeometryTypePolygon,
+	pb.GeometryType_GEOMETRY_TYPE_MULTI_POLYGON: domain.GeometryTypeMultiPolygon,
+}
+
 type SegmentationGroup struct{}
 
 func (m SegmentationGroup) Domain(pb *pb.SegmentationGroup) domain.SegmentationGroup {
@@ -69,6 +76,8 @@ func (m Segmentation) Domain(pb *pb.Segmentation) domain.Segmentation {
 		points = append(points, domain.SegmentationPoint{
 			Id:             int(p.Id),
 			SegmentationID: int(p.SegmentationId),
+			Polygon:        int(p.Polygon),
+			Ring:           int(p.Ring),
 			X:              int(p.X),
 			Y:              int(p.Y),
 			UID:            p.Uid,
@@ -76,10 +85,20 @@ func (m Segmentation) Domain(pb *pb.Segmentation) domain.Segmentation {
 		})
 	}
 
+	var color *domain.Color
+	if pb.Color != nil {
+		color = &domain.Color{R: int(pb.Color.R), G: int(pb.Color.G), B: int(pb.Color.B)}
+	}
+
 	return domain.Segmentation{
 		Id:                  int(pb.Id),
 		SegmentationGroupID: int(pb.SegmentationGroupId),
+		GeometryType:        geometryTypeMap[pb.GeometryType],
 		Points:              points,
+		Color:               color,
+		IsLocked:            pb.IsLocked,
+		Confidence:          pb.Confidence,
+		Properties:          pb.Properties,
 		CreateAt:            createAt,
 	}
 }
@@ -2,7 +2,6 @@ package cytology
 
 import (
 	"context"
-	"io"
 
 	"composition-api/internal/adapters/cytology/mappers"
 	adapter_errors "composition-api/internal/adapters/errors"
@@ -17,21 +16,9 @@ func (a *adapter) CreateOriginalImage(ctx context.Context, in CreateOriginalImag
 		CytologyId:  in.CytologyID.String(),
 		ContentType: in.ContentType,
 		DelayTime:   in.DelayTime,
-	}
-
-	// Если передан путь к файлу, используем его (файл уже загружен в S3)
-	if in.ImagePath != nil && *in.ImagePath != "" {
-		// Файл уже загружен в S3 в composition-api
-		// Передаем путь вместо файла, чтобы не отправлять файл по сети
-		req.ImagePath = in.ImagePath
-		req.File = nil // Не передаем файл, так как он уже в S3
-	} else {
-		// Если путь не передан, читаем файл и передаем его (старый способ)
-		fileData, err := io.ReadAll(in.File.File)
-		if err != nil {
-			return uuid.Nil, adapter_errors.HandleGRPCError(err)
-		}
-		req.File = fileData
+		// файл уже загружен в S3, по сети передается только путь
+		ImagePath: &in.ImagePath,
+		Sha256:    in.Sha256,
 	}
 
 	res, err := a.client.CreateOriginalImage(ctx, req)
@@ -0,0 +1,41 @@
+package cytology
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"composition-api/internal/adapters/cytology/mappers"
+	adapter_errors "composition-api/internal/adapters/errors"
+	domain "composition-api/internal/domain/cytology"
+	pb "composition-api/internal/generated/grpc/clients/cytology"
+)
+
+func (a *adapter) ExportQuPathGeoJson(ctx context.Context, cytologyID uuid.UUID) ([]byte, error) {
+	res, err := a.client.ExportQuPathGeoJson(ctx, &pb.ExportQuPathGeoJsonIn{CytologyId: cytologyID.String()})
+	if err != nil {
+		return nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return []byte(res.Geojson), nil
+}
+
+func (a *adapter) ImportQuPathGeoJson(ctx context.Context, cytologyID uuid.UUID, geojson []byte) (domain.QuPathImport, error) {
+	res, err := a.client.ImportQuPathGeoJson(ctx, &pb.ImportQuPathGeoJsonIn{
+		CytologyId: cytologyID.String(),
+		Geojson:    string(geojson),
+	})
+	if err != nil {
+		return domain.QuPathImport{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return domain.QuPathImport{
+		CytologyImage:  mappers.CytologyImage{}.Domain(res.CytologyImage),
+		Added:          int(res.Added),
+		Removed:        int(res.Removed),
+		Changed:        int(res.Changed),
+		Unchanged:      int(res.Unchanged),
+		Skipped:        int(res.Skipped),
+		UnknownClasses: res.UnknownClasses,
+	}, nil
+}
@@ -36,6 +36,33 @@ var groupTypeMap = map[domain.GroupType]pb.GroupType{
 	domain.GroupTypeME: pb.GroupType_GROUP_TYPE_ME,
 }
 
+var geometryTypeMap = map[domain.GeometryType]pb.GeometryType{
+	domain.GeometryTypePoint:        pb.GeometryType_GEOMETRY_TYPE_POINT,
+	domain.GeometryTypeLineString:   pb.GeometryType_GEOMETRY_TYPE_LINE_STRING,
+	domain.GeometryTypePolygon:      pb.GeometryType_GEOMETRY_TYPE_POLYGON,
+	domain.GeometryTypeMultiPolygon: pb.GeometryType_GEOMETRY_TYPE_MULTI_POLYGON,
+}
+
+func pointsToProto(points []domain.SegmentationPoint) []*pb.SegmentationPointCreate {
+	res := make([]*pb.SegmentationPointCreate, 0, len(points))
+	for _, p := range points {
+		res = append(res, &pb.SegmentationPointCreate{
+			X:       int32(p.X),
+			Y:       int32(p.Y),
+			Polygon: int32(p.Polygon),
+			Ring:    int32(p.Ring),
+		})
+	}
+	return res
+}
+
+func colorToProto(c *domain.Color) *pb.Color {
+	if c == nil {
+		return nil
+	}
+	return &pb.Color{R: int32(c.R), G: int32(c.G), B: int32(c.B)}
+}
+
 func (a *adapter) CreateSegmentationGroup(ctx context.Context, in CreateSegmentationGroupIn) (int, error) {
 	req := &pb.CreateSegmentationGroupIn{
 		CytologyId: in.CytologyID.String(),
@@ -105,17 +132,16 @@ func (a *adapter) DeleteSegmentationGroup(ctx context.Context, id int) error {
 }
 
 func (a *adapter) CreateSegmentation(ctx context.Context, in CreateSegmentationIn) (int, error) {
-	points := make([]*pb.SegmentationPointCreate, 0, len(in.Points))
-	for _, p := range in.Points {
-		points = append(points, &pb.SegmentationPointCreate{
-			X: int32(p.X),
-			Y: int32(p.Y),
-		})
-	}
-
 	req := &pb.CreateSegmentationIn{
 		SegmentationGroupId: int32(in.SegmentationGroupID),
-		Points:              points,
+		Points:              pointsToProto(in.Points),
+		Color:               colorToProto(in.Color),
+		IsLocked:            in.IsLocked,
+		Confidence:          in.Confidence,
+		Properties:          in.Properties,
+	}
+	if in.GeometryType != nil {
+		req.GeometryType = geometryTypeMap[*in.GeometryType]
 	}
 
 	res, err := a.client.CreateSegmentation(ctx, req)
@@ -144,18 +170,52 @@ func (a *adapter) GetSegmentsByGroupId(ctx context.Context, id int) ([]domain.Se
 	return mappers.Segmentation{}.SliceDomain(res.Segmentations), nil
 }
 
-func (a *adapter) UpdateSegmentation(ctx context.Context, in UpdateSegmentationIn) (domain.Segmentation, error) {
-	points := make([]*pb.SegmentationPointCreate, 0, len(in.Points))
-	for _, p := range in.Points {
-		points = append(points, &pb.SegmentationPointCreate{
-			X: int32(p.X),
-			Y: int32(p.Y),
-		})
+func (a *adapter) GetSegmentsInViewport(ctx context.Context, in GetSegmentsInViewportIn) (domain.SegmentationPage, error) {
+	req := &pb.GetSegmentsInViewportIn{
+		CytologyId: in.CytologyID.String(),
+		Bbox: &pb.BBox{
+			MinX: int32(in.BBox.MinX),
+			MinY: int32(in.BBox.MinY),
+			MaxX: int32(in.BBox.MaxX),
+			MaxY: int32(in.BBox.MaxY),
+		},
+		IsAi:       in.Filter.IsAI,
+		Downsample: in.Downsample,
+		Cursor:     in.Cursor,
+		Limit:      int32(in.Limit),
+	}
+	if in.Filter.SegType != nil {
+		st := segTypeMap[*in.Filter.SegType]
+		req.SegType = &st
+	}
+	if in.Filter.GroupType != nil {
+		gt := groupTypeMap[*in.Filter.GroupType]
+		req.GroupType = &gt
 	}
 
+	res, err := a.client.GetSegmentsInViewport(ctx, req)
+	if err != nil {
+		return domain.SegmentationPage{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return domain.SegmentationPage{
+		Segmentations: mappers.Segmentation{}.SliceDomain(res.Segmentations),
+		NextCursor:    res.NextCursor,
+	}, nil
+}
+
+func (a *adapter) UpdateSegmentation(ctx context.Context, in UpdateSegmentationIn) (domain.Segmentation, error) {
 	req := &pb.UpdateSegmentationIn{
-		Id:     int32(in.Id),
-		Points: points,
+		Id:         int32(in.Id),
+		Points:     pointsToProto(in.Points),
+		Color:      colorToProto(in.Color),
+		IsLocked:   in.IsLocked,
+		Confidence: in.Confidence,
+		Properties: in.Properties,
+	}
+	if in.GeometryType != nil {
+		gt := geometryTypeMap[*in.GeometryType]
+		req.GeometryType = &gt
 	}
 
 	res, err := a.client.UpdateSegmentation(ctx, req)
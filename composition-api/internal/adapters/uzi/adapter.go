@@ -11,32 +11,63 @@ import (
 
 type Adapter interface {
 	// DEVICE
-	CreateDevice(ctx context.Context, name string) (int, error)
+	CreateDevice(ctx context.Context, in CreateDeviceIn) (int, error)
 	GetDeviceList(ctx context.Context) ([]domain.Device, error)
+	GetDeviceById(ctx context.Context, id int) (domain.Device, error)
+	UpdateDevice(ctx context.Context, in UpdateDeviceIn) (domain.Device, error)
+	DeleteDevice(ctx context.Context, id int) error
 	// UZI
-	CreateUzi(ctx context.Context, in CreateUziIn) (uuid.UUID, error)
+	// CreateUzi возвращает id узи и узи пациента с тем же sha256
+	CreateUzi(ctx context.Context, in CreateUziIn) (uuid.UUID, []uuid.UUID, error)
 	GetUziById(ctx context.Context, id uuid.UUID) (domain.Uzi, error)
 	GetUzisByExternalId(ctx context.Context, id uuid.UUID) ([]domain.Uzi, error)
 	GetUzisByAuthor(ctx context.Context, id uuid.UUID) ([]domain.Uzi, error)
+	SearchUzis(ctx context.Context, in SearchUzisIn) (domain.UziPage, error)
 	GetEchographicByUziId(ctx context.Context, id uuid.UUID) (domain.Echographic, error)
 	UpdateUzi(ctx context.Context, in UpdateUziIn) (domain.Uzi, error)
 	UpdateEchographic(ctx context.Context, in domain.Echographic) (domain.Echographic, error)
 	DeleteUzi(ctx context.Context, id uuid.UUID) error
+	// ANALYTICS
+	GetUziAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.Analytics, error)
 	// IMAGE
 	GetImagesByUziId(ctx context.Context, id uuid.UUID) ([]domain.Image, error)
 	// NODE
 	GetNodesByUziId(ctx context.Context, id uuid.UUID) ([]domain.Node, error)
 	UpdateNode(ctx context.Context, in UpdateNodeIn) (domain.Node, error)
+	// пакетная валидация узлов нейросети, результат по каждому узлу
+	ReviewNodes(ctx context.Context, ids []uuid.UUID, validation domain.NodeValidation) ([]domain.NodeReviewItem, error)
+	CheckUzi(ctx context.Context, uziID uuid.UUID, unvalidated *domain.NodeValidation) (domain.Uzi, []domain.NodeReviewItem, error)
+	AcceptAiNodes(ctx context.Context, uziID uuid.UUID, threshold float64) ([]domain.NodeReviewItem, error)
+	// TIRADS
+	SetNodeDescriptors(ctx context.Context, in domain.NodeDescriptors) (domain.NodeTirads, error)
+	GetNodeTirads(ctx context.Context, nodeID uuid.UUID) (domain.NodeTirads, error)
+	// LINEAGE
+	LinkNodes(ctx context.Context, nodeID, targetNodeID uuid.UUID) (uuid.UUID, error)
+	UnlinkNode(ctx context.Context, nodeID uuid.UUID) error
+	SuggestNodeLinks(ctx context.Context, nodeID uuid.UUID) ([]domain.NodeLinkSuggestion, error)
+	GetGrowthReport(ctx context.Context, externalID uuid.UUID) ([]domain.NodeGrowth, error)
+	// REPORT
+	GenerateReport(ctx context.Context, uziID uuid.UUID) (domain.Report, error)
+	GetReports(ctx context.Context, uziID uuid.UUID) ([]domain.Report, error)
+	GetReport(ctx context.Context, uziID uuid.UUID, version *int) (domain.Report, error)
 	// SEGMENT
 	CreateSegment(ctx context.Context, in CreateSegmentIn) (uuid.UUID, error)
 	GetSegmentsByNodeId(ctx context.Context, id uuid.UUID) ([]domain.Segment, error)
 	UpdateSegment(ctx context.Context, in UpdateSegmentIn) (domain.Segment, error)
+	// PROPAGATION
+	// ProposeSegments черновики контуров узла на соседних кадрах, depth nil - по умолчанию
+	ProposeSegments(ctx context.Context, segmentID uuid.UUID, depth *int) ([]domain.SegmentDraft, error)
+	GetSegmentDraftsByNodeId(ctx context.Context, nodeID uuid.UUID) ([]domain.SegmentDraft, error)
+	AcceptSegmentDraft(ctx context.Context, id uuid.UUID) (domain.Segment, error)
+	DiscardSegmentDraft(ctx context.Context, id uuid.UUID) error
 	// доменные области слишком сильно пересекаются, вынесено в одну надобласть
 	// NODE-SEGMENT
 	CreateNodeWithSegments(ctx context.Context, in CreateNodeWithSegmentsIn) (uuid.UUID, []uuid.UUID, error)
 	GetNodesWithSegmentsByImageId(ctx context.Context, id uuid.UUID) ([]domain.Node, []domain.Segment, error)
 	DeleteNode(ctx context.Context, id uuid.UUID) error
 	DeleteSegment(ctx context.Context, id uuid.UUID) error
+	MergeNodes(ctx context.Context, ids []uuid.UUID) (domain.Node, error)
+	SplitNode(ctx context.Context, id uuid.UUID, segmentIDs []uuid.UUID) (domain.Node, domain.Node, error)
 }
 
 type adapter struct {
@@ -0,0 +1,31 @@
+package uzi
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+
+	adapter_errors "composition-api/internal/adapters/errors"
+	"composition-api/internal/adapters/uzi/mappers"
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+)
+
+func (a *adapter) GetUziAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.Analytics, error) {
+	req := &pb.GetUziAnalyticsIn{
+		Author:     uuidToPB(filter.Author),
+		CreateFrom: timeToPB(filter.CreateFrom),
+		CreateTo:   timeToPB(filter.CreateTo),
+		Period:     mappers.AnalyticsPeriodMap[filter.Period],
+	}
+	if filter.DeviceID != nil {
+		req.DeviceId = pointer.To(int64(*filter.DeviceID))
+	}
+
+	res, err := a.client.GetUziAnalytics(ctx, req)
+	if err != nil {
+		return domain.Analytics{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Analytics{}.Domain(res), nil
+}
@@ -11,10 +11,23 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-func (a *adapter) CreateDevice(ctx context.Context, name string) (int, error) {
-	res, err := a.client.CreateDevice(ctx, &pb.CreateDeviceIn{Name: name})
+var probeTypeMap = map[domain.ProbeType]pb.ProbeType{
+	domain.ProbeTypeLinear: pb.ProbeType_PROBE_TYPE_LINEAR,
+	domain.ProbeTypeConvex: pb.ProbeType_PROBE_TYPE_CONVEX,
+	domain.ProbeTypeSector: pb.ProbeType_PROBE_TYPE_SECTOR,
+}
+
+func (a *adapter) CreateDevice(ctx context.Context, in CreateDeviceIn) (int, error) {
+	res, err := a.client.CreateDevice(ctx, &pb.CreateDeviceIn{
+		Name:         in.Name,
+		Manufacturer: in.Manufacturer,
+		Model:        in.Model,
+		SerialNumber: in.SerialNumber,
+		ProbeType:    mappers.PointerFromMap(probeTypeMap, in.ProbeType),
+		PixelSpacing: pixelSpacingToPB(in.PixelSpacing),
+	})
 	if err != nil {
-		return 0, err
+		return 0, adapter_errors.HandleGRPCError(err)
 	}
 
 	return int(res.Id), nil
@@ -28,3 +41,38 @@ func (a *adapter) GetDeviceList(ctx context.Context) ([]domain.Device, error) {
 
 	return mappers.Device{}.SliceDomain(res.Devices), nil
 }
+
+func (a *adapter) GetDeviceById(ctx context.Context, id int) (domain.Device, error) {
+	res, err := a.client.GetDeviceById(ctx, &pb.GetDeviceByIdIn{Id: int64(id)})
+	if err != nil {
+		return domain.Device{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Device{}.Domain(res.Device), nil
+}
+
+func (a *adapter) UpdateDevice(ctx context.Context, in UpdateDeviceIn) (domain.Device, error) {
+	res, err := a.client.UpdateDevice(ctx, &pb.UpdateDeviceIn{
+		Id:           int64(in.Id),
+		Name:         in.Name,
+		Manufacturer: in.Manufacturer,
+		Model:        in.Model,
+		SerialNumber: in.SerialNumber,
+		ProbeType:    mappers.PointerFromMap(probeTypeMap, in.ProbeType),
+		PixelSpacing: pixelSpacingToPB(in.PixelSpacing),
+	})
+	if err != nil {
+		return domain.Device{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Device{}.Domain(res.Device), nil
+}
+
+func (a *adapter) DeleteDevice(ctx context.Context, id int) error {
+	_, err := a.client.DeleteDevice(ctx, &pb.DeleteDeviceIn{Id: int64(id)})
+	if err != nil {
+		return adapter_errors.HandleGRPCError(err)
+	}
+
+	return nil
+}
@@ -8,18 +8,48 @@ import (
 	domain "composition-api/internal/domain/uzi"
 )
 
+type CreateDeviceIn struct {
+	Name         string
+	Manufacturer *string
+	Model        *string
+	SerialNumber *string
+	ProbeType    *domain.ProbeType
+	PixelSpacing *domain.PixelSpacing
+}
+
+type UpdateDeviceIn struct {
+	Id           int
+	Name         *string
+	Manufacturer *string
+	Model        *string
+	SerialNumber *string
+	ProbeType    *domain.ProbeType
+	PixelSpacing *domain.PixelSpacing
+}
+
 type CreateUziIn struct {
 	Projection  domain.UziProjection
 	ExternalID  uuid.UUID
 	Author      uuid.UUID
 	DeviceID    int
 	Description *string
+
+	PixelSpacing *domain.PixelSpacing
+	Sha256       *string
 }
 
 type UpdateUziIn struct {
-	Id         uuid.UUID
-	Projection *domain.UziProjection
-	Checked    *bool
+	Id           uuid.UUID
+	Projection   *domain.UziProjection
+	Checked      *bool
+	PixelSpacing *domain.PixelSpacing
+}
+
+type SearchUzisIn struct {
+	Filter domain.UziFilter
+	Order  domain.SortOrder
+	Cursor *string
+	Limit  int
 }
 
 type UpdateNodeIn struct {
@@ -28,6 +58,7 @@ type UpdateNodeIn struct {
 	Tirads_23  *float64
 	Tirads_4   *float64
 	Tirads_5   *float64
+	Lobe       *domain.NodeLobe
 }
 
 type CreateSegmentIn struct {
@@ -0,0 +1,47 @@
+package uzi
+
+import (
+	"context"
+
+	adapter_errors "composition-api/internal/adapters/errors"
+	"composition-api/internal/adapters/uzi/mappers"
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+
+	"github.com/google/uuid"
+)
+
+func (a *adapter) LinkNodes(ctx context.Context, nodeID, targetNodeID uuid.UUID) (uuid.UUID, error) {
+	res, err := a.client.LinkNodes(ctx, &pb.LinkNodesIn{
+		NodeId:       nodeID.String(),
+		TargetNodeId: targetNodeID.String(),
+	})
+	if err != nil {
+		return uuid.Nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return uuid.MustParse(res.LineageId), nil
+}
+
+func (a *adapter) UnlinkNode(ctx context.Context, nodeID uuid.UUID) error {
+	_, err := a.client.UnlinkNode(ctx, &pb.UnlinkNodeIn{NodeId: nodeID.String()})
+	return adapter_errors.HandleGRPCError(err)
+}
+
+func (a *adapter) SuggestNodeLinks(ctx context.Context, nodeID uuid.UUID) ([]domain.NodeLinkSuggestion, error) {
+	res, err := a.client.SuggestNodeLinks(ctx, &pb.SuggestNodeLinksIn{NodeId: nodeID.String()})
+	if err != nil {
+		return nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.NodeLinkSuggestion{}.SliceDomain(res.Suggestions), nil
+}
+
+func (a *adapter) GetGrowthReport(ctx context.Context, externalID uuid.UUID) ([]domain.NodeGrowth, error) {
+	res, err := a.client.GetGrowthReport(ctx, &pb.GetGrowthReportIn{ExternalId: externalID.String()})
+	if err != nil {
+		return nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.NodeGrowth{}.SliceDomain(res.Lineages), nil
+}
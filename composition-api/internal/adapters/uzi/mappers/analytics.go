@@ -0,0 +1,105 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+)
+
+var AnalyticsPeriodMap = map[domain.AnalyticsPeriod]pb.AnalyticsPeriod{
+	domain.AnalyticsPeriodMonth: pb.AnalyticsPeriod_ANALYTICS_PERIOD_MONTH,
+	domain.AnalyticsPeriodWeek:  pb.AnalyticsPeriod_ANALYTICS_PERIOD_WEEK,
+	domain.AnalyticsPeriodDay:   pb.AnalyticsPeriod_ANALYTICS_PERIOD_DAY,
+}
+
+type TiradsDistribution struct{}
+
+func (m TiradsDistribution) Domain(pb *pb.TiradsDistribution) domain.TiradsDistribution {
+	return domain.TiradsDistribution{
+		Tirads23: int(pb.GetTirads_23()),
+		Tirads4:  int(pb.GetTirads_4()),
+		Tirads5:  int(pb.GetTirads_5()),
+	}
+}
+
+type NodeMetrics struct{}
+
+func (m NodeMetrics) Domain(pb *pb.NodeMetrics) domain.NodeMetrics {
+	return domain.NodeMetrics{
+		Uzis:             int(pb.GetUzis()),
+		AiNodes:          int(pb.GetAiNodes()),
+		Valid:            int(pb.GetValid()),
+		Invalid:          int(pb.GetInvalid()),
+		Unvalidated:      int(pb.GetUnvalidated()),
+		ManualNodes:      int(pb.GetManualNodes()),
+		Precision:        pb.Precision,
+		UnvalidatedShare: pb.UnvalidatedShare,
+		AiTirads:         TiradsDistribution{}.Domain(pb.GetAiTirads()),
+		ManualTirads:     TiradsDistribution{}.Domain(pb.GetManualTirads()),
+	}
+}
+
+type DeviceNodeMetrics struct{}
+
+func (m DeviceNodeMetrics) Domain(pb *pb.DeviceNodeMetrics) domain.DeviceNodeMetrics {
+	return domain.DeviceNodeMetrics{
+		DeviceID: int(pb.DeviceId),
+		Metrics:  NodeMetrics{}.Domain(pb.Metrics),
+	}
+}
+
+type AuthorNodeMetrics struct{}
+
+func (m AuthorNodeMetrics) Domain(pb *pb.AuthorNodeMetrics) domain.AuthorNodeMetrics {
+	return domain.AuthorNodeMetrics{
+		Author:  uuid.MustParse(pb.Author),
+		Metrics: NodeMetrics{}.Domain(pb.Metrics),
+	}
+}
+
+type PeriodNodeMetrics struct{}
+
+func (m PeriodNodeMetrics) Domain(pb *pb.PeriodNodeMetrics) domain.PeriodNodeMetrics {
+	start, _ := time.Parse(time.RFC3339, pb.Start)
+
+	return domain.PeriodNodeMetrics{
+		Start:   start,
+		Metrics: NodeMetrics{}.Domain(pb.Metrics),
+	}
+}
+
+type ReaderAgreement struct{}
+
+func (m ReaderAgreement) Domain(pb *pb.ReaderAgreement) domain.ReaderAgreement {
+	return domain.ReaderAgreement{
+		ReaderA:   uuid.MustParse(pb.ReaderA),
+		ReaderB:   uuid.MustParse(pb.ReaderB),
+		Uzis:      int(pb.Uzis),
+		Matched:   int(pb.Matched),
+		Unmatched: int(pb.Unmatched),
+		Kappa:     pb.Kappa,
+		MeanIoU:   pb.MeanIou,
+	}
+}
+
+type Analytics struct{}
+
+func (m Analytics) Domain(pb *pb.GetUziAnalyticsOut) domain.Analytics {
+	return domain.Analytics{
+		Total:     NodeMetrics{}.Domain(pb.Total),
+		ByDevice:  slice(pb.ByDevice, DeviceNodeMetrics{}),
+		ByAuthor:  slice(pb.ByAuthor, AuthorNodeMetrics{}),
+		ByPeriod:  slice(pb.ByPeriod, PeriodNodeMetrics{}),
+		Agreement: slice(pb.Agreement, ReaderAgreement{}),
+		Kappa:     pb.Kappa,
+		MeanIoU:   pb.MeanIou,
+		AiMerged:  int(pb.AiMerged),
+		AiSplit:   int(pb.AiSplit),
+
+		BulkValid:   int(pb.BulkValid),
+		BulkInvalid: int(pb.BulkInvalid),
+	}
+}
@@ -5,12 +5,23 @@ import (
 	pb "composition-api/internal/generated/grpc/clients/uzi"
 )
 
+var probeTypeMap = map[pb.ProbeType]domain.ProbeType{
+	pb.ProbeType_PROBE_TYPE_LINEAR: domain.ProbeTypeLinear,
+	pb.ProbeType_PROBE_TYPE_CONVEX: domain.ProbeTypeConvex,
+	pb.ProbeType_PROBE_TYPE_SECTOR: domain.ProbeTypeSector,
+}
+
 type Device struct{}
 
 func (m Device) Domain(pb *pb.Device) domain.Device {
 	return domain.Device{
-		Id:   int(pb.Id),
-		Name: pb.Name,
+		Id:           int(pb.Id),
+		Name:         pb.Name,
+		Manufacturer: pb.Manufacturer,
+		Model:        pb.Model,
+		SerialNumber: pb.SerialNumber,
+		ProbeType:    PointerFromMap(probeTypeMap, pb.ProbeType),
+		PixelSpacing: PixelSpacing{}.Domain(pb.PixelSpacing),
 	}
 }
 
@@ -1,36 +1,77 @@
 package mappers
 
 import (
+	"github.com/AlekSi/pointer"
 	"github.com/google/uuid"
 
 	domain "composition-api/internal/domain/uzi"
 	pb "composition-api/internal/generated/grpc/clients/uzi"
 )
 
+var echographicStructMap = map[pb.EchographicStruct]domain.EchographicStruct{
+	pb.EchographicStruct_ECHOGRAPHIC_STRUCT_HOMOGENEOUS:   domain.EchographicStructHomogeneous,
+	pb.EchographicStruct_ECHOGRAPHIC_STRUCT_HETEROGENEOUS: domain.EchographicStructHeterogeneous,
+}
+
+var echographicEchogenicityMap = map[pb.EchographicEchogenicity]domain.EchographicEchogenicity{
+	pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_NORMAL:    domain.EchographicEchogenicityNormal,
+	pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_INCREASED: domain.EchographicEchogenicityIncreased,
+	pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_DECREASED: domain.EchographicEchogenicityDecreased,
+	pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_MIXED:     domain.EchographicEchogenicityMixed,
+}
+
+var echographicVascularizationMap = map[pb.EchographicVascularization]domain.EchographicVascularization{
+	pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_NORMAL:    domain.EchographicVascularizationNormal,
+	pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_INCREASED: domain.EchographicVascularizationIncreased,
+	pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_DECREASED: domain.EchographicVascularizationDecreased,
+}
+
+var patientSexMap = map[pb.PatientSex]domain.PatientSex{
+	pb.PatientSex_PATIENT_SEX_MALE:   domain.PatientSexMale,
+	pb.PatientSex_PATIENT_SEX_FEMALE: domain.PatientSexFemale,
+}
+
 type Echographic struct{}
 
 func (m Echographic) Domain(pb *pb.Echographic) domain.Echographic {
-	return domain.Echographic{
-		Id:              uuid.MustParse(pb.Id),
-		Contors:         pb.Contors,
-		LeftLobeLength:  pb.LeftLobeLength,
-		LeftLobeWidth:   pb.LeftLobeWidth,
-		LeftLobeThick:   pb.LeftLobeThick,
-		LeftLobeVolum:   pb.LeftLobeVolum,
-		RightLobeLength: pb.RightLobeLength,
-		RightLobeWidth:  pb.RightLobeWidth,
-		RightLobeThick:  pb.RightLobeThick,
-		RightLobeVolum:  pb.RightLobeVolum,
-		GlandVolum:      pb.GlandVolum,
-		Isthmus:         pb.Isthmus,
-		Struct:          pb.Struct,
-		Echogenicity:    pb.Echogenicity,
-		RegionalLymph:   pb.RegionalLymph,
-		Vascularization: pb.Vascularization,
-		Location:        pb.Location,
-		Additional:      pb.Additional,
-		Conclusion:      pb.Conclusion,
+	echographic := domain.Echographic{
+		Id:                  uuid.MustParse(pb.Id),
+		Contors:             pb.Contors,
+		LeftLobeLength:      pb.LeftLobeLength,
+		LeftLobeWidth:       pb.LeftLobeWidth,
+		LeftLobeThick:       pb.LeftLobeThick,
+		LeftLobeVolum:       pb.LeftLobeVolum,
+		RightLobeLength:     pb.RightLobeLength,
+		RightLobeWidth:      pb.RightLobeWidth,
+		RightLobeThick:      pb.RightLobeThick,
+		RightLobeVolum:      pb.RightLobeVolum,
+		GlandVolum:          pb.GlandVolum,
+		Isthmus:             pb.Isthmus,
+		Struct:              PointerFromMap(echographicStructMap, pb.Struct),
+		Echogenicity:        PointerFromMap(echographicEchogenicityMap, pb.Echogenicity),
+		RegionalLymph:       pb.RegionalLymph,
+		Vascularization:     PointerFromMap(echographicVascularizationMap, pb.Vascularization),
+		Location:            pb.Location,
+		Additional:          pb.Additional,
+		Conclusion:          pb.Conclusion,
+		PatientSex:          PointerFromMap(patientSexMap, pb.PatientSex),
+		GeneratedConclusion: pb.GeneratedConclusion,
+	}
+
+	if pb.PatientAge != nil {
+		echographic.PatientAge = pointer.To(int(*pb.PatientAge))
 	}
+
+	for _, flag := range pb.Flags {
+		echographic.Flags = append(echographic.Flags, domain.EchographicFlag{
+			Field: flag.Field,
+			Value: flag.Value,
+			Min:   flag.Min,
+			Max:   flag.Max,
+		})
+	}
+
+	return echographic
 }
 
 func (m Echographic) SliceDomain(pbs []*pb.Echographic) []domain.Echographic {
@@ -7,13 +7,32 @@ import (
 	pb "composition-api/internal/generated/grpc/clients/uzi"
 )
 
+var imageSizeMap = map[pb.ImageSize]domain.ImageSize{
+	pb.ImageSize_IMAGE_SIZE_ORIGINAL:  domain.ImageSizeOriginal,
+	pb.ImageSize_IMAGE_SIZE_PREVIEW:   domain.ImageSizePreview,
+	pb.ImageSize_IMAGE_SIZE_THUMBNAIL: domain.ImageSizeThumbnail,
+}
+
 type Image struct{}
 
 func (m Image) Domain(pb *pb.Image) domain.Image {
+	variants := make([]domain.ImageVariant, 0, len(pb.Variants))
+	for _, v := range pb.Variants {
+		variants = append(variants, domain.ImageVariant{
+			Size:        imageSizeMap[v.Size],
+			ContentType: v.ContentType,
+			Width:       int(v.Width),
+			Height:      int(v.Height),
+		})
+	}
+
 	return domain.Image{
-		Id:    uuid.MustParse(pb.Id),
-		UziID: uuid.MustParse(pb.UziId),
-		Page:  int(pb.Page),
+		Id:       uuid.MustParse(pb.Id),
+		UziID:    uuid.MustParse(pb.UziId),
+		Page:     int(pb.Page),
+		Width:    int(pb.Width),
+		Height:   int(pb.Height),
+		Variants: variants,
 	}
 }
 
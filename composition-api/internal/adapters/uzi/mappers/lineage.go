@@ -0,0 +1,51 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+)
+
+type NodeLinkSuggestion struct{}
+
+func (m NodeLinkSuggestion) Domain(pb *pb.NodeLinkSuggestion) domain.NodeLinkSuggestion {
+	return domain.NodeLinkSuggestion{
+		Node:  Node{}.Domain(pb.Node),
+		Score: pb.Score,
+	}
+}
+
+func (m NodeLinkSuggestion) SliceDomain(pbs []*pb.NodeLinkSuggestion) []domain.NodeLinkSuggestion {
+	return slice(pbs, m)
+}
+
+type NodeGrowthPoint struct{}
+
+func (m NodeGrowthPoint) Domain(pb *pb.NodeGrowthPoint) domain.NodeGrowthPoint {
+	createAt, _ := time.Parse(time.RFC3339, pb.CreateAt)
+
+	return domain.NodeGrowthPoint{
+		Node:     Node{}.Domain(pb.Node),
+		UziID:    uuid.MustParse(pb.UziId),
+		CreateAt: createAt,
+		Volume:   pb.Volume,
+	}
+}
+
+type NodeGrowth struct{}
+
+func (m NodeGrowth) Domain(pb *pb.NodeGrowth) domain.NodeGrowth {
+	return domain.NodeGrowth{
+		LineageID:    uuid.MustParse(pb.LineageId),
+		Points:       slice(pb.Points, NodeGrowthPoint{}),
+		VolumeChange: pb.VolumeChange,
+		DoublingTime: pb.DoublingTime,
+	}
+}
+
+func (m NodeGrowth) SliceDomain(pbs []*pb.NodeGrowth) []domain.NodeGrowth {
+	return slice(pbs, m)
+}
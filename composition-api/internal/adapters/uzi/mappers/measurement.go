@@ -0,0 +1,60 @@
+package mappers
+
+import (
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+)
+
+var measureUnitMap = map[pb.MeasureUnit]domain.MeasureUnit{
+	pb.MeasureUnit_MEASURE_UNIT_PX: domain.MeasureUnitPx,
+	pb.MeasureUnit_MEASURE_UNIT_MM: domain.MeasureUnitMm,
+}
+
+type PixelSpacing struct{}
+
+func (m PixelSpacing) Domain(pb *pb.PixelSpacing) *domain.PixelSpacing {
+	if pb == nil {
+		return nil
+	}
+	return &domain.PixelSpacing{
+		X: pb.X,
+		Y: pb.Y,
+	}
+}
+
+type SegmentMeasurement struct{}
+
+func (m SegmentMeasurement) Domain(pb *pb.SegmentMeasurement) *domain.SegmentMeasurement {
+	if pb == nil {
+		return nil
+	}
+	return &domain.SegmentMeasurement{
+		BBox: domain.BoundingBox{
+			X:      int(pb.Bbox.GetX()),
+			Y:      int(pb.Bbox.GetY()),
+			Width:  int(pb.Bbox.GetWidth()),
+			Height: int(pb.Bbox.GetHeight()),
+		},
+		Area:      pb.Area,
+		Perimeter: pb.Perimeter,
+		MajorAxis: pb.MajorAxis,
+		MinorAxis: pb.MinorAxis,
+		Unit:      measureUnitMap[pb.Unit],
+	}
+}
+
+type NodeMeasurement struct{}
+
+func (m NodeMeasurement) Domain(pb *pb.NodeMeasurement) *domain.NodeMeasurement {
+	if pb == nil {
+		return nil
+	}
+	return &domain.NodeMeasurement{
+		Area:      pb.Area,
+		Perimeter: pb.Perimeter,
+		MajorAxis: pb.MajorAxis,
+		MinorAxis: pb.MinorAxis,
+		Volume:    pb.Volume,
+		Unit:      measureUnitMap[pb.Unit],
+	}
+}
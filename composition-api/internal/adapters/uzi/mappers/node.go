@@ -14,6 +14,12 @@ var nodeValidationMap = map[pb.NodeValidation]domain.NodeValidation{
 	pb.NodeValidation_NODE_VALIDATION_INVALID: domain.NodeValidationInvalid,
 }
 
+var nodeLobeMap = map[pb.NodeLobe]domain.NodeLobe{
+	pb.NodeLobe_NODE_LOBE_RIGHT:   domain.NodeLobeRight,
+	pb.NodeLobe_NODE_LOBE_LEFT:    domain.NodeLobeLeft,
+	pb.NodeLobe_NODE_LOBE_ISTHMUS: domain.NodeLobeIsthmus,
+}
+
 func nodeValidation(pb *pb.NodeValidation) *domain.NodeValidation {
 	if pb == nil {
 		return nil
@@ -33,6 +39,8 @@ func (m Node) Domain(pb *pb.Node) domain.Node {
 		Tirads4:     pb.Tirads_4,
 		Tirads5:     pb.Tirads_5,
 		Description: pb.Description,
+		Lobe:        PointerFromMap(nodeLobeMap, pb.Lobe),
+		Measurement: NodeMeasurement{}.Domain(pb.Measurement),
 	}
 }
 
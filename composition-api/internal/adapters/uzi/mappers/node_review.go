@@ -0,0 +1,35 @@
+package mappers
+
+import (
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+)
+
+var nodeReviewStatusMap = map[pb.NodeReviewStatus]domain.NodeReviewStatus{
+	pb.NodeReviewStatus_NODE_REVIEW_STATUS_APPLIED:           domain.NodeReviewStatusApplied,
+	pb.NodeReviewStatus_NODE_REVIEW_STATUS_UNCHANGED:         domain.NodeReviewStatusUnchanged,
+	pb.NodeReviewStatus_NODE_REVIEW_STATUS_NOT_FOUND:         domain.NodeReviewStatusNotFound,
+	pb.NodeReviewStatus_NODE_REVIEW_STATUS_MANUAL_NODE:       domain.NodeReviewStatusManualNode,
+	pb.NodeReviewStatus_NODE_REVIEW_STATUS_ALREADY_VALIDATED: domain.NodeReviewStatusAlreadyValidated,
+	pb.NodeReviewStatus_NODE_REVIEW_STATUS_BELOW_THRESHOLD:   domain.NodeReviewStatusBelowThreshold,
+}
+
+type NodeReviewItem struct{}
+
+func (m NodeReviewItem) Domain(pb *pb.NodeReviewItem) domain.NodeReviewItem {
+	item := domain.NodeReviewItem{
+		NodeID: uuid.MustParse(pb.NodeId),
+		Status: nodeReviewStatusMap[pb.Status],
+	}
+	if pb.Node != nil {
+		node := Node{}.Domain(pb.Node)
+		item.Node = &node
+	}
+	return item
+}
+
+func (m NodeReviewItem) SliceDomain(pbs []*pb.NodeReviewItem) []domain.NodeReviewItem {
+	return slice(pbs, m)
+}
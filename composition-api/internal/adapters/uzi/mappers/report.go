@@ -0,0 +1,29 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+)
+
+type Report struct{}
+
+func (m Report) Domain(pb *pb.Report) domain.Report {
+	createAt, _ := time.Parse(time.RFC3339, pb.CreateAt)
+
+	return domain.Report{
+		Id:       uuid.MustParse(pb.Id),
+		UziID:    uuid.MustParse(pb.UziId),
+		Version:  int(pb.Version),
+		HtmlPath: pb.HtmlPath,
+		PdfPath:  pb.PdfPath,
+		CreateAt: createAt,
+	}
+}
+
+func (m Report) SliceDomain(pbs []*pb.Report) []domain.Report {
+	return slice(pbs, m)
+}
@@ -19,6 +19,8 @@ func (m Segment) Domain(pb *pb.Segment) domain.Segment {
 		Tirads23: pb.Tirads_23,
 		Tirads4:  pb.Tirads_4,
 		Tirads5:  pb.Tirads_5,
+
+		Measurement: SegmentMeasurement{}.Domain(pb.Measurement),
 	}
 }
 
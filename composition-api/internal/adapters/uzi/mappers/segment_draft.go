@@ -0,0 +1,33 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+)
+
+type SegmentDraft struct{}
+
+func (m SegmentDraft) Domain(pb *pb.SegmentDraft) domain.SegmentDraft {
+	createAt, _ := time.Parse(time.RFC3339, pb.CreateAt)
+
+	return domain.SegmentDraft{
+		Id:              uuid.MustParse(pb.Id),
+		NodeID:          uuid.MustParse(pb.NodeId),
+		ImageID:         uuid.MustParse(pb.ImageId),
+		SourceSegmentID: uuid.MustParse(pb.SourceSegmentId),
+		Contor:          pb.Contor,
+		Tirads23:        pb.Tirads_23,
+		Tirads4:         pb.Tirads_4,
+		Tirads5:         pb.Tirads_5,
+		Score:           pb.Score,
+		CreateAt:        createAt,
+	}
+}
+
+func (m SegmentDraft) SliceDomain(pbs []*pb.SegmentDraft) []domain.SegmentDraft {
+	return slice(pbs, m)
+}
@@ -0,0 +1,102 @@
+package mappers
+
+import (
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+)
+
+var tiradsCompositionMap = map[pb.TiradsComposition]domain.TiradsComposition{
+	pb.TiradsComposition_TIRADS_COMPOSITION_CYSTIC:     domain.TiradsCompositionCystic,
+	pb.TiradsComposition_TIRADS_COMPOSITION_SPONGIFORM: domain.TiradsCompositionSpongiform,
+	pb.TiradsComposition_TIRADS_COMPOSITION_MIXED:      domain.TiradsCompositionMixed,
+	pb.TiradsComposition_TIRADS_COMPOSITION_SOLID:      domain.TiradsCompositionSolid,
+}
+
+var tiradsEchogenicityMap = map[pb.TiradsEchogenicity]domain.TiradsEchogenicity{
+	pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_ANECHOIC:  domain.TiradsEchogenicityAnechoic,
+	pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPER_ISO: domain.TiradsEchogenicityHyperIso,
+	pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPO:      domain.TiradsEchogenicityHypo,
+	pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_VERY_HYPO: domain.TiradsEchogenicityVeryHypo,
+}
+
+var tiradsShapeMap = map[pb.TiradsShape]domain.TiradsShape{
+	pb.TiradsShape_TIRADS_SHAPE_WIDER_THAN_TALL:  domain.TiradsShapeWiderThanTall,
+	pb.TiradsShape_TIRADS_SHAPE_TALLER_THAN_WIDE: domain.TiradsShapeTallerThanWide,
+}
+
+var tiradsMarginMap = map[pb.TiradsMargin]domain.TiradsMargin{
+	pb.TiradsMargin_TIRADS_MARGIN_SMOOTH:         domain.TiradsMarginSmooth,
+	pb.TiradsMargin_TIRADS_MARGIN_ILL_DEFINED:    domain.TiradsMarginIllDefined,
+	pb.TiradsMargin_TIRADS_MARGIN_LOBULATED:      domain.TiradsMarginLobulated,
+	pb.TiradsMargin_TIRADS_MARGIN_EXTRATHYROIDAL: domain.TiradsMarginExtrathyroidal,
+}
+
+var tiradsEchogenicFociMap = map[pb.TiradsEchogenicFoci]domain.TiradsEchogenicFoci{
+	pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_NONE:                domain.TiradsEchogenicFociNone,
+	pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_MACROCALCIFICATIONS: domain.TiradsEchogenicFociMacrocalcifications,
+	pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PERIPHERAL:          domain.TiradsEchogenicFociPeripheral,
+	pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PUNCTATE:            domain.TiradsEchogenicFociPunctate,
+}
+
+var tiradsCategoryMap = map[pb.TiradsCategory]domain.TiradsCategory{
+	pb.TiradsCategory_TIRADS_CATEGORY_TR1: domain.TiradsCategoryTR1,
+	pb.TiradsCategory_TIRADS_CATEGORY_TR2: domain.TiradsCategoryTR2,
+	pb.TiradsCategory_TIRADS_CATEGORY_TR3: domain.TiradsCategoryTR3,
+	pb.TiradsCategory_TIRADS_CATEGORY_TR4: domain.TiradsCategoryTR4,
+	pb.TiradsCategory_TIRADS_CATEGORY_TR5: domain.TiradsCategoryTR5,
+}
+
+var tiradsRecommendationMap = map[pb.TiradsRecommendation]domain.TiradsRecommendation{
+	pb.TiradsRecommendation_TIRADS_RECOMMENDATION_NONE:      domain.TiradsRecommendationNone,
+	pb.TiradsRecommendation_TIRADS_RECOMMENDATION_FOLLOW_UP: domain.TiradsRecommendationFollowUp,
+	pb.TiradsRecommendation_TIRADS_RECOMMENDATION_FNA:       domain.TiradsRecommendationFNA,
+	pb.TiradsRecommendation_TIRADS_RECOMMENDATION_UNKNOWN:   domain.TiradsRecommendationUnknown,
+}
+
+type NodeDescriptors struct{}
+
+func (m NodeDescriptors) Domain(pb *pb.NodeDescriptors) *domain.NodeDescriptors {
+	if pb == nil {
+		return nil
+	}
+
+	foci := make([]domain.TiradsEchogenicFoci, 0, len(pb.EchogenicFoci))
+	for _, v := range pb.EchogenicFoci {
+		foci = append(foci, tiradsEchogenicFociMap[v])
+	}
+
+	return &domain.NodeDescriptors{
+		NodeID:        uuid.MustParse(pb.NodeId),
+		Composition:   tiradsCompositionMap[pb.Composition],
+		Echogenicity:  tiradsEchogenicityMap[pb.Echogenicity],
+		Shape:         tiradsShapeMap[pb.Shape],
+		Margin:        tiradsMarginMap[pb.Margin],
+		EchogenicFoci: foci,
+	}
+}
+
+type TiradsScore struct{}
+
+func (m TiradsScore) Domain(pb *pb.TiradsScore) *domain.TiradsScore {
+	if pb == nil {
+		return nil
+	}
+	return &domain.TiradsScore{
+		Points:         int(pb.Points),
+		Category:       tiradsCategoryMap[pb.Category],
+		Recommendation: tiradsRecommendationMap[pb.Recommendation],
+		MaxDiameter:    pb.MaxDiameter,
+	}
+}
+
+type NodeTirads struct{}
+
+func (m NodeTirads) Domain(pb *pb.NodeTirads) domain.NodeTirads {
+	return domain.NodeTirads{
+		Node:        Node{}.Domain(pb.Node),
+		Descriptors: NodeDescriptors{}.Domain(pb.Descriptors),
+		Score:       TiradsScore{}.Domain(pb.Score),
+	}
+}
@@ -35,6 +35,9 @@ func (m Uzi) Domain(pb *pb.Uzi) domain.Uzi {
 		Status:      uziStatusMap[pb.Status],
 		Description: pb.Description,
 		CreateAt:    createAt,
+
+		PixelSpacing: PixelSpacing{}.Domain(pb.PixelSpacing),
+		Sha256:       pb.Sha256,
 	}
 }
 
@@ -16,6 +16,12 @@ var nodeValidationMap = map[domain.NodeValidation]pb.NodeValidation{
 	domain.NodeValidationInvalid: pb.NodeValidation_NODE_VALIDATION_INVALID,
 }
 
+var nodeLobeMap = map[domain.NodeLobe]pb.NodeLobe{
+	domain.NodeLobeRight:   pb.NodeLobe_NODE_LOBE_RIGHT,
+	domain.NodeLobeLeft:    pb.NodeLobe_NODE_LOBE_LEFT,
+	domain.NodeLobeIsthmus: pb.NodeLobe_NODE_LOBE_ISTHMUS,
+}
+
 func (a *adapter) GetNodesByUziId(ctx context.Context, id uuid.UUID) ([]domain.Node, error) {
 	res, err := a.client.GetNodesByUziId(ctx, &pb.GetNodesByUziIdIn{UziId: id.String()})
 	if err != nil {
@@ -32,6 +38,7 @@ func (a *adapter) UpdateNode(ctx context.Context, in UpdateNodeIn) (domain.Node,
 		Tirads_23:  in.Tirads_23,
 		Tirads_4:   in.Tirads_4,
 		Tirads_5:   in.Tirads_5,
+		Lobe:       mappers.PointerFromMap(nodeLobeMap, in.Lobe),
 	})
 	if err != nil {
 		return domain.Node{}, adapter_errors.HandleGRPCError(err)
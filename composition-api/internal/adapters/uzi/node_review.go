@@ -0,0 +1,53 @@
+package uzi
+
+import (
+	"context"
+
+	adapter_errors "composition-api/internal/adapters/errors"
+	"composition-api/internal/adapters/uzi/mappers"
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+
+	"github.com/google/uuid"
+)
+
+func (a *adapter) ReviewNodes(ctx context.Context, ids []uuid.UUID, validation domain.NodeValidation) ([]domain.NodeReviewItem, error) {
+	req := &pb.ReviewNodesIn{
+		NodeIds:    make([]string, 0, len(ids)),
+		Validation: nodeValidationMap[validation],
+	}
+	for _, id := range ids {
+		req.NodeIds = append(req.NodeIds, id.String())
+	}
+
+	res, err := a.client.ReviewNodes(ctx, req)
+	if err != nil {
+		return nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.NodeReviewItem{}.SliceDomain(res.Items), nil
+}
+
+func (a *adapter) CheckUzi(ctx context.Context, uziID uuid.UUID, unvalidated *domain.NodeValidation) (domain.Uzi, []domain.NodeReviewItem, error) {
+	res, err := a.client.CheckUzi(ctx, &pb.CheckUziIn{
+		UziId:       uziID.String(),
+		Unvalidated: mappers.PointerFromMap(nodeValidationMap, unvalidated),
+	})
+	if err != nil {
+		return domain.Uzi{}, nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Uzi{}.Domain(res.Uzi), mappers.NodeReviewItem{}.SliceDomain(res.Items), nil
+}
+
+func (a *adapter) AcceptAiNodes(ctx context.Context, uziID uuid.UUID, threshold float64) ([]domain.NodeReviewItem, error) {
+	res, err := a.client.AcceptAiNodes(ctx, &pb.AcceptAiNodesIn{
+		UziId:     uziID.String(),
+		Threshold: threshold,
+	})
+	if err != nil {
+		return nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.NodeReviewItem{}.SliceDomain(res.Items), nil
+}
@@ -57,3 +57,34 @@ func (a *adapter) GetNodesWithSegmentsByImageId(ctx context.Context, id uuid.UUI
 
 	return nodes, segments, nil
 }
+
+func (a *adapter) MergeNodes(ctx context.Context, ids []uuid.UUID) (domain.Node, error) {
+	req := &pb.MergeNodesIn{NodeIds: make([]string, 0, len(ids))}
+	for _, id := range ids {
+		req.NodeIds = append(req.NodeIds, id.String())
+	}
+
+	res, err := a.client.MergeNodes(ctx, req)
+	if err != nil {
+		return domain.Node{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Node{}.Domain(res.Node), nil
+}
+
+func (a *adapter) SplitNode(ctx context.Context, id uuid.UUID, segmentIDs []uuid.UUID) (domain.Node, domain.Node, error) {
+	req := &pb.SplitNodeIn{
+		NodeId:     id.String(),
+		SegmentIds: make([]string, 0, len(segmentIDs)),
+	}
+	for _, segmentID := range segmentIDs {
+		req.SegmentIds = append(req.SegmentIds, segmentID.String())
+	}
+
+	res, err := a.client.SplitNode(ctx, req)
+	if err != nil {
+		return domain.Node{}, domain.Node{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Node{}.Domain(res.Node), mappers.Node{}.Domain(res.NewNode), nil
+}
@@ -0,0 +1,45 @@
+package uzi
+
+import (
+	"context"
+
+	adapter_errors "composition-api/internal/adapters/errors"
+	"composition-api/internal/adapters/uzi/mappers"
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+)
+
+func (a *adapter) GenerateReport(ctx context.Context, uziID uuid.UUID) (domain.Report, error) {
+	res, err := a.client.GenerateReport(ctx, &pb.GenerateReportIn{UziId: uziID.String()})
+	if err != nil {
+		return domain.Report{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Report{}.Domain(res.Report), nil
+}
+
+func (a *adapter) GetReports(ctx context.Context, uziID uuid.UUID) ([]domain.Report, error) {
+	res, err := a.client.GetReports(ctx, &pb.GetReportsIn{UziId: uziID.String()})
+	if err != nil {
+		return nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Report{}.SliceDomain(res.Reports), nil
+}
+
+func (a *adapter) GetReport(ctx context.Context, uziID uuid.UUID, version *int) (domain.Report, error) {
+	req := &pb.GetReportIn{UziId: uziID.String()}
+	if version != nil {
+		req.Version = pointer.To(int64(*version))
+	}
+
+	res, err := a.client.GetReport(ctx, req)
+	if err != nil {
+		return domain.Report{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Report{}.Domain(res.Report), nil
+}
@@ -0,0 +1,50 @@
+package uzi
+
+import (
+	"context"
+
+	adapter_errors "composition-api/internal/adapters/errors"
+	"composition-api/internal/adapters/uzi/mappers"
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+
+	"github.com/google/uuid"
+)
+
+func (a *adapter) ProposeSegments(ctx context.Context, segmentID uuid.UUID, depth *int) ([]domain.SegmentDraft, error) {
+	req := &pb.ProposeSegmentsIn{SegmentId: segmentID.String()}
+	if depth != nil {
+		v := int64(*depth)
+		req.Depth = &v
+	}
+
+	res, err := a.client.ProposeSegments(ctx, req)
+	if err != nil {
+		return nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.SegmentDraft{}.SliceDomain(res.Drafts), nil
+}
+
+func (a *adapter) GetSegmentDraftsByNodeId(ctx context.Context, nodeID uuid.UUID) ([]domain.SegmentDraft, error) {
+	res, err := a.client.GetSegmentDraftsByNodeId(ctx, &pb.GetSegmentDraftsByNodeIdIn{NodeId: nodeID.String()})
+	if err != nil {
+		return nil, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.SegmentDraft{}.SliceDomain(res.Drafts), nil
+}
+
+func (a *adapter) AcceptSegmentDraft(ctx context.Context, id uuid.UUID) (domain.Segment, error) {
+	res, err := a.client.AcceptSegmentDraft(ctx, &pb.AcceptSegmentDraftIn{Id: id.String()})
+	if err != nil {
+		return domain.Segment{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.Segment{}.Domain(res.Segment), nil
+}
+
+func (a *adapter) DiscardSegmentDraft(ctx context.Context, id uuid.UUID) error {
+	_, err := a.client.DiscardSegmentDraft(ctx, &pb.DiscardSegmentDraftIn{Id: id.String()})
+	return adapter_errors.HandleGRPCError(err)
+}
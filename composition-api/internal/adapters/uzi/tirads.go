@@ -0,0 +1,77 @@
+package uzi
+
+import (
+	"context"
+
+	adapter_errors "composition-api/internal/adapters/errors"
+	"composition-api/internal/adapters/uzi/mappers"
+	domain "composition-api/internal/domain/uzi"
+	pb "composition-api/internal/generated/grpc/clients/uzi"
+
+	"github.com/google/uuid"
+)
+
+var tiradsCompositionMap = map[domain.TiradsComposition]pb.TiradsComposition{
+	domain.TiradsCompositionCystic:     pb.TiradsComposition_TIRADS_COMPOSITION_CYSTIC,
+	domain.TiradsCompositionSpongiform: pb.TiradsComposition_TIRADS_COMPOSITION_SPONGIFORM,
+	domain.TiradsCompositionMixed:      pb.TiradsComposition_TIRADS_COMPOSITION_MIXED,
+	domain.TiradsCompositionSolid:      pb.TiradsComposition_TIRADS_COMPOSITION_SOLID,
+}
+
+var tiradsEchogenicityMap = map[domain.TiradsEchogenicity]pb.TiradsEchogenicity{
+	domain.TiradsEchogenicityAnechoic: pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_ANECHOIC,
+	domain.TiradsEchogenicityHyperIso: pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPER_ISO,
+	domain.TiradsEchogenicityHypo:     pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPO,
+	domain.TiradsEchogenicityVeryHypo: pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_VERY_HYPO,
+}
+
+var tiradsShapeMap = map[domain.TiradsShape]pb.TiradsShape{
+	domain.TiradsShapeWiderThanTall:  pb.TiradsShape_TIRADS_SHAPE_WIDER_THAN_TALL,
+	domain.TiradsShapeTallerThanWide: pb.TiradsShape_TIRADS_SHAPE_TALLER_THAN_WIDE,
+}
+
+var tiradsMarginMap = map[domain.TiradsMargin]pb.TiradsMargin{
+	domain.TiradsMarginSmooth:         pb.TiradsMargin_TIRADS_MARGIN_SMOOTH,
+	domain.TiradsMarginIllDefined:     pb.TiradsMargin_TIRADS_MARGIN_ILL_DEFINED,
+	domain.TiradsMarginLobulated:      pb.TiradsMargin_TIRADS_MARGIN_LOBULATED,
+	domain.TiradsMarginExtrathyroidal: pb.TiradsMargin_TIRADS_MARGIN_EXTRATHYROIDAL,
+}
+
+var tiradsEchogenicFociMap = map[domain.TiradsEchogenicFoci]pb.TiradsEchogenicFoci{
+	domain.TiradsEchogenicFociNone:                pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_NONE,
+	domain.TiradsEchogenicFociMacrocalcifications: pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_MACROCALCIFICATIONS,
+	domain.TiradsEchogenicFociPeripheral:          pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PERIPHERAL,
+	domain.TiradsEchogenicFociPunctate:            pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PUNCTATE,
+}
+
+func (a *adapter) SetNodeDescriptors(ctx context.Context, in domain.NodeDescriptors) (domain.NodeTirads, error) {
+	foci := make([]pb.TiradsEchogenicFoci, 0, len(in.EchogenicFoci))
+	for _, v := range in.EchogenicFoci {
+		foci = append(foci, tiradsEchogenicFociMap[v])
+	}
+
+	res, err := a.client.SetNodeDescriptors(ctx, &pb.SetNodeDescriptorsIn{
+		Descriptors: &pb.NodeDescriptors{
+			NodeId:        in.NodeID.String(),
+			Composition:   tiradsCompositionMap[in.Composition],
+			Echogenicity:  tiradsEchogenicityMap[in.Echogenicity],
+			Shape:         tiradsShapeMap[in.Shape],
+			Margin:        tiradsMarginMap[in.Margin],
+			EchogenicFoci: foci,
+		},
+	})
+	if err != nil {
+		return domain.NodeTirads{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.NodeTirads{}.Domain(res.Tirads), nil
+}
+
+func (a *adapter) GetNodeTirads(ctx context.Context, nodeID uuid.UUID) (domain.NodeTirads, error) {
+	res, err := a.client.GetNodeTirads(ctx, &pb.GetNodeTiradsIn{NodeId: nodeID.String()})
+	if err != nil {
+		return domain.NodeTirads{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return mappers.NodeTirads{}.Domain(res.Tirads), nil
+}
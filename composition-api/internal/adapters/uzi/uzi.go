@@ -2,12 +2,14 @@ package uzi
 
 import (
 	"context"
+	"time"
 
 	adapter_errors "composition-api/internal/adapters/errors"
 	"composition-api/internal/adapters/uzi/mappers"
 	domain "composition-api/internal/domain/uzi"
 	pb "composition-api/internal/generated/grpc/clients/uzi"
 
+	"github.com/AlekSi/pointer"
 	"github.com/google/uuid"
 )
 
@@ -16,19 +18,34 @@ var uziProjectionMap = map[domain.UziProjection]pb.UziProjection{
 	domain.UziProjectionLong:  pb.UziProjection_UZI_PROJECTION_LONG,
 }
 
-func (a *adapter) CreateUzi(ctx context.Context, in CreateUziIn) (uuid.UUID, error) {
+func pixelSpacingToPB(spacing *domain.PixelSpacing) *pb.PixelSpacing {
+	if spacing == nil {
+		return nil
+	}
+	return &pb.PixelSpacing{X: spacing.X, Y: spacing.Y}
+}
+
+func (a *adapter) CreateUzi(ctx context.Context, in CreateUziIn) (uuid.UUID, []uuid.UUID, error) {
 	res, err := a.client.CreateUzi(ctx, &pb.CreateUziIn{
 		Projection:  uziProjectionMap[in.Projection],
 		ExternalId:  in.ExternalID.String(),
 		Author:      in.Author.String(),
 		DeviceId:    int64(in.DeviceID),
 		Description: in.Description,
+
+		PixelSpacing: pixelSpacingToPB(in.PixelSpacing),
+		Sha256:       in.Sha256,
 	})
 	if err != nil {
-		return uuid.Nil, err
+		return uuid.Nil, nil, adapter_errors.HandleGRPCError(err)
 	}
 
-	return uuid.MustParse(res.Id), nil
+	duplicates := make([]uuid.UUID, 0, len(res.DuplicateOf))
+	for _, id := range res.DuplicateOf {
+		duplicates = append(duplicates, uuid.MustParse(id))
+	}
+
+	return uuid.MustParse(res.Id), duplicates, nil
 }
 
 func (a *adapter) GetUziById(ctx context.Context, id uuid.UUID) (domain.Uzi, error) {
@@ -69,9 +86,10 @@ func (a *adapter) GetEchographicByUziId(ctx context.Context, id uuid.UUID) (doma
 
 func (a *adapter) UpdateUzi(ctx context.Context, in UpdateUziIn) (domain.Uzi, error) {
 	res, err := a.client.UpdateUzi(ctx, &pb.UpdateUziIn{
-		Id:         in.Id.String(),
-		Projection: mappers.PointerFromMap(uziProjectionMap, in.Projection),
-		Checked:    in.Checked,
+		Id:           in.Id.String(),
+		Projection:   mappers.PointerFromMap(uziProjectionMap, in.Projection),
+		Checked:      in.Checked,
+		PixelSpacing: pixelSpacingToPB(in.PixelSpacing),
 	})
 	if err != nil {
 		return domain.Uzi{}, adapter_errors.HandleGRPCError(err)
@@ -80,7 +98,35 @@ func (a *adapter) UpdateUzi(ctx context.Context, in UpdateUziIn) (domain.Uzi, er
 	return mappers.Uzi{}.Domain(res.Uzi), nil
 }
 
+var echographicStructMap = map[domain.EchographicStruct]pb.EchographicStruct{
+	domain.EchographicStructHomogeneous:   pb.EchographicStruct_ECHOGRAPHIC_STRUCT_HOMOGENEOUS,
+	domain.EchographicStructHeterogeneous: pb.EchographicStruct_ECHOGRAPHIC_STRUCT_HETEROGENEOUS,
+}
+
+var echographicEchogenicityMap = map[domain.EchographicEchogenicity]pb.EchographicEchogenicity{
+	domain.EchographicEchogenicityNormal:    pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_NORMAL,
+	domain.EchographicEchogenicityIncreased: pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_INCREASED,
+	domain.EchographicEchogenicityDecreased: pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_DECREASED,
+	domain.EchographicEchogenicityMixed:     pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_MIXED,
+}
+
+var echographicVascularizationMap = map[domain.EchographicVascularization]pb.EchographicVascularization{
+	domain.EchographicVascularizationNormal:    pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_NORMAL,
+	domain.EchographicVascularizationIncreased: pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_INCREASED,
+	domain.EchographicVascularizationDecreased: pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_DECREASED,
+}
+
+var patientSexMap = map[domain.PatientSex]pb.PatientSex{
+	domain.PatientSexMale:   pb.PatientSex_PATIENT_SEX_MALE,
+	domain.PatientSexFemale: pb.PatientSex_PATIENT_SEX_FEMALE,
+}
+
 func (a *adapter) UpdateEchographic(ctx context.Context, in domain.Echographic) (domain.Echographic, error) {
+	var patientAge *int64
+	if in.PatientAge != nil {
+		patientAge = pointer.To(int64(*in.PatientAge))
+	}
+
 	res, err := a.client.UpdateEchographic(ctx, &pb.UpdateEchographicIn{
 		Echographic: &pb.Echographic{
 			Id:              in.Id.String(),
@@ -95,13 +141,15 @@ func (a *adapter) UpdateEchographic(ctx context.Context, in domain.Echographic)
 			RightLobeVolum:  in.RightLobeVolum,
 			GlandVolum:      in.GlandVolum,
 			Isthmus:         in.Isthmus,
-			Struct:          in.Struct,
-			Echogenicity:    in.Echogenicity,
+			Struct:          mappers.PointerFromMap(echographicStructMap, in.Struct),
+			Echogenicity:    mappers.PointerFromMap(echographicEchogenicityMap, in.Echogenicity),
 			RegionalLymph:   in.RegionalLymph,
-			Vascularization: in.Vascularization,
+			Vascularization: mappers.PointerFromMap(echographicVascularizationMap, in.Vascularization),
 			Location:        in.Location,
 			Additional:      in.Additional,
 			Conclusion:      in.Conclusion,
+			PatientSex:      mappers.PointerFromMap(patientSexMap, in.PatientSex),
+			PatientAge:      patientAge,
 		},
 	})
 	if err != nil {
@@ -115,3 +163,58 @@ func (a *adapter) DeleteUzi(ctx context.Context, id uuid.UUID) error {
 	_, err := a.client.DeleteUzi(ctx, &pb.DeleteUziIn{Id: id.String()})
 	return err
 }
+
+var uziStatusMap = map[domain.UziStatus]pb.UziStatus{
+	domain.UziStatusNew:       pb.UziStatus_UZI_STATUS_NEW,
+	domain.UziStatusPending:   pb.UziStatus_UZI_STATUS_PENDING,
+	domain.UziStatusCompleted: pb.UziStatus_UZI_STATUS_COMPLETED,
+}
+
+var sortOrderMap = map[domain.SortOrder]pb.SortOrder{
+	domain.SortOrderDesc: pb.SortOrder_SORT_ORDER_DESC,
+	domain.SortOrderAsc:  pb.SortOrder_SORT_ORDER_ASC,
+}
+
+func uuidToPB(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	return pointer.To(id.String())
+}
+
+func timeToPB(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return pointer.To(t.Format(time.RFC3339))
+}
+
+func (a *adapter) SearchUzis(ctx context.Context, in SearchUzisIn) (domain.UziPage, error) {
+	req := &pb.SearchUzisIn{
+		Author:        uuidToPB(in.Filter.Author),
+		ExternalId:    uuidToPB(in.Filter.ExternalID),
+		Status:        mappers.PointerFromMap(uziStatusMap, in.Filter.Status),
+		Projection:    mappers.PointerFromMap(uziProjectionMap, in.Filter.Projection),
+		Checked:       in.Filter.Checked,
+		CreateFrom:    timeToPB(in.Filter.CreateFrom),
+		CreateTo:      timeToPB(in.Filter.CreateTo),
+		AiTirads_4Min: in.Filter.AiTirads4Min,
+		AiTirads_5Min: in.Filter.AiTirads5Min,
+		Order:         sortOrderMap[in.Order],
+		Cursor:        in.Cursor,
+		Limit:         int64(in.Limit),
+	}
+	if in.Filter.DeviceID != nil {
+		req.DeviceId = pointer.To(int64(*in.Filter.DeviceID))
+	}
+
+	res, err := a.client.SearchUzis(ctx, req)
+	if err != nil {
+		return domain.UziPage{}, adapter_errors.HandleGRPCError(err)
+	}
+
+	return domain.UziPage{
+		Uzis:       mappers.Uzi{}.SliceDomain(res.Uzis),
+		NextCursor: res.NextCursor,
+	}, nil
+}
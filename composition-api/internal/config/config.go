@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"time"
 )
 
 type Config struct {
@@ -13,6 +14,9 @@ type Config struct {
 	S3       S3
 	Dbus     Dbus
 	JWT      JWT
+
+	Deidentify Deidentify
+	Upload     Upload
 }
 
 type App struct {
@@ -41,6 +45,21 @@ type Dbus struct {
 	Addrs []string `env:"DBUS_ADDRS" env-required:"true"`
 }
 
+// Deidentify очистка метаданных загружаемых файлов перед сохранением в S3
+type Deidentify struct {
+	// off, basic или strict
+	Profile string `env:"DEIDENTIFY_PROFILE" env-default:"basic"`
+	// ключ HMAC для псевдонимов
+	Key string `env:"DEIDENTIFY_KEY" env-default:""`
+}
+
+type Upload struct {
+	// период повторной обработки собранных файлов, первая попытка - сразу после завершения загрузки
+	ProcessInterval time.Duration `env:"UPLOAD_PROCESS_INTERVAL" env-default:"1m"`
+	// период удаления брошенных загрузок с истекшим сроком
+	ExpireInterval time.Duration `env:"UPLOAD_EXPIRE_INTERVAL" env-default:"1h"`
+}
+
 type JWT struct {
 	RsaPublicKey string `env:"JWT_KEY_PUBLIC" env-required:"true"`
 }
@@ -13,4 +13,5 @@ type OriginalImage struct {
 	CreateDate time.Time
 	DelayTime  *float64
 	ViewedFlag bool
+	Sha256     *string
 }
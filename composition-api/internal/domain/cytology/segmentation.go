@@ -35,20 +35,80 @@ const (
 	GroupTypeME GroupType = "ME"
 )
 
+// GeometryType тип геометрии сегментации в терминах GeoJSON
+type GeometryType string
+
+const (
+	GeometryTypePoint        GeometryType = "Point"
+	GeometryTypeLineString   GeometryType = "LineString"
+	GeometryTypePolygon      GeometryType = "Polygon"
+	GeometryTypeMultiPolygon GeometryType = "MultiPolygon"
+)
+
+// Color цвет аннотации в RGB
+type Color struct {
+	R int
+	G int
+	B int
+}
+
 type SegmentationPoint struct {
 	Id             int
 	SegmentationID int
-	X              int
-	Y              int
-	UID            int64
-	CreateAt       time.Time
+	// номер полигона в MultiPolygon
+	Polygon int
+	// номер кольца в полигоне, 0 - внешний контур, остальные - дыры
+	Ring     int
+	X        int
+	Y        int
+	UID      int64
+	CreateAt time.Time
 }
 
 type Segmentation struct {
 	Id                  int
 	SegmentationGroupID int
+	GeometryType        GeometryType
 	Points              []SegmentationPoint
-	CreateAt            time.Time
+	Color               *Color
+	IsLocked            bool
+	Confidence          *float64
+	// JSON объект
+	Properties *string
+	CreateAt   time.Time
+}
+
+// BBox прямоугольник в пикселях нулевого уровня изображения, границы включительно
+type BBox struct {
+	MinX int
+	MinY int
+	MaxX int
+	MaxY int
+}
+
+// SegmentationViewportFilter фильтры по группе сегментации, незаданные поля не ограничивают выборку
+type SegmentationViewportFilter struct {
+	SegType   *SegType
+	GroupType *GroupType
+	IsAI      *bool
+}
+
+type SegmentationPage struct {
+	Segmentations []Segmentation
+	// курсор следующей страницы, nil если страница последняя
+	NextCursor *string
+}
+
+// QuPathImport итог импорта GeoJSON из QuPath новой версией исследования
+type QuPathImport struct {
+	CytologyImage CytologyImage
+	Added         int
+	Removed       int
+	Changed       int
+	Unchanged     int
+	// features без класса, геометрии или с неизвестным классом
+	Skipped        int
+	UnknownClasses []string
 }
 
 type SegmentationGroup struct {
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Field группа метаданных, с которой профиль поступает одинаково
+type Field string
+
+const (
+	FieldDescription  Field = "description"
+	FieldDocumentName Field = "document_name"
+	FieldPageName     Field = "page_name"
+	FieldArtist       Field = "artist"
+	FieldHostComputer Field = "host_computer"
+	FieldCopyright    Field = "copyright"
+	FieldDateTime     Field = "date_time"
+	// текстовые комментарии: jpeg COM, png tEXt/zTXt/iTXt, exif UserComment
+	FieldComment Field = "comment"
+	// идентифицирующие поля exif: владелец и серийный номер камеры, id снимка
+	FieldExif Field = "exif"
+	FieldGps  Field = "gps"
+	FieldXmp  Field = "xmp"
+	FieldIptc Field = "iptc"
+	// теги пациента и учреждения dicom
+	FieldPatientName Field = "patient_name"
+	FieldBirthDate   Field = "birth_date"
+	FieldInstitution Field = "institution"
+)
+
+type Action string
+
+const (
+	// значение затирается нулями, в dicom пробелами, в jpeg и png сегмент удаляется целиком
+	ActionStrip Action = "strip"
+	// значение заменяется HMAC от него той же длины, одинаковые значения дают одинаковый псевдоним;
+	// для бинарных сегментов равносильно strip
+	ActionPseudonymize Action = "pseudonymize"
+)
+
+type ProfileName string
+
+const (
+	// метаданные не меняются
+	ProfileOff ProfileName = "off"
+	// удаляет персональные данные, описание изображения сохраняется: в нем масштаб сканеров
+	ProfileBasic ProfileName = "basic"
+	// basic и описание изображения
+	ProfileStrict ProfileName = "strict"
+)
+
+func (ProfileName) Parse(name string) (ProfileName, error) {
+	switch p := ProfileName(name); p {
+	case ProfileOff, ProfileBasic, ProfileStrict:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown deidentification profile: %q", name)
+	}
+}
+
+func (p ProfileName) String() string {
+	return string(p)
+}
+
+// Profile что делать с каждой группой метаданных, группы без действия не меняются
+type Profile struct {
+	Name   ProfileName
+	Fields map[Field]Action
+}
+
+func NewProfile(name ProfileName) Profile {
+	fields := map[Field]Action{}
+	if name == ProfileBasic || name == ProfileStrict {
+		for _, field := range []Field{
+			FieldArtist, FieldHostComputer, FieldCopyright, FieldDateTime,
+			FieldComment, FieldExif, FieldGps, FieldXmp, FieldIptc,
+			FieldBirthDate, FieldInstitution,
+		} {
+			fields[field] = ActionStrip
+		}
+		// имена документа и страницы часто содержат номер исследования, псевдоним сохраняет связь между файлами
+		fields[FieldDocumentName] = ActionPseudonymize
+		fields[FieldPageName] = ActionPseudonymize
+		// по псевдониму пациента снимки одного человека остаются связаны
+		fields[FieldPatientName] = ActionPseudonymize
+	}
+	if name == ProfileStrict {
+		fields[FieldDescription] = ActionStrip
+	}
+
+	return Profile{Name: name, Fields: fields}
+}
+
+// Removed одно измененное поле, значение в отчет не попадает
+type Removed struct {
+	Field  Field
+	Tag    string
+	Action Action
+}
+
+// Report что было удалено из файла перед сохранением
+type Report struct {
+	Profile ProfileName
+	// dicom, tiff, jpeg, png, пустой для форматов, которые не разбираются
+	Format   string
+	Removed  []Removed
+	CreateAt time.Time
+}
+
+// Changed файл был изменен
+func (r Report) Changed() bool {
+	return len(r.Removed) > 0
+}
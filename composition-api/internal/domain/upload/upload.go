@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	deidentifyDomain "composition-api/internal/domain/deidentify"
+)
+
+type UploadKind string
+
+const (
+	// исходный файл узи
+	UploadKindUzi UploadKind = "uzi"
+	// оригинальное изображение цитологии
+	UploadKindCytology UploadKind = "cytology"
+)
+
+func (k UploadKind) String() string {
+	return string(k)
+}
+
+type UploadStatus string
+
+const (
+	// части загружаются
+	UploadStatusPending UploadStatus = "pending"
+	// части собраны в файл, файл хешируется и очищается от метаданных в фоне
+	UploadStatusProcessing UploadStatus = "processing"
+	// файл очищен, контрольная сумма сверена
+	UploadStatusCompleted UploadStatus = "completed"
+	// сессия занята созданием узи или цитологии, файл переносится,
+	// повторно использовать нельзя
+	UploadStatusClaimed UploadStatus = "claimed"
+	// загрузка отменена клиентом или обработка файла не прошла
+	UploadStatusAborted UploadStatus = "aborted"
+)
+
+func (s UploadStatus) String() string {
+	return string(s)
+}
+
+// Upload сессия загрузки файла частями через S3 multipart
+type Upload struct {
+	Id          uuid.UUID
+	Owner       uuid.UUID
+	Kind        UploadKind
+	FileName    string
+	ContentType string
+	Size        int64
+	PartSize    int64
+	// sha256 всего файла в hex, сверяется при обработке собранного файла,
+	// после обработки - sha256 файла с очищенными метаданными
+	Sha256    *string
+	Status    UploadStatus
+	CreateAt  time.Time
+	ExpiresAt time.Time
+	// id multipart загрузки в S3
+	S3UploadID string
+	// до этого времени файл обрабатывает одна из реплик
+	ProcessingUntil *time.Time
+	// причина отмены, если не прошла обработка файла
+	FailReason *string
+	// версия сохраненной сессии, смена статуса записывается только поверх прочитанной версии
+	Version string
+	// отчет об очистке метаданных собранного файла, сохраняется рядом с файлом при переносе
+	Deidentification *deidentifyDomain.Report
+}
+
+// UploadPart загруженная часть файла
+type UploadPart struct {
+	Number int
+	Size   int64
+	ETag   string
+}
+
+// UploadPartURL presigned URL для PUT части напрямую в S3
+type UploadPartURL struct {
+	Number    int
+	URL       string
+	ExpiresAt time.Time
+}
+
+// UploadProgress сессия с уже загруженными частями
+type UploadProgress struct {
+	Upload Upload
+	Parts  []UploadPart
+	// сумма размеров загруженных частей
+	UploadedBytes int64
+}
+
+// PartsCount число частей, на которые делится файл
+func (u Upload) PartsCount() int {
+	if u.PartSize <= 0 {
+		return 0
+	}
+	return int((u.Size + u.PartSize - 1) / u.PartSize)
+}
+
+// PartLength размер части с номером number, последняя часть может быть меньше
+func (u Upload) PartLength(number int) int64 {
+	if number < 1 || number > u.PartsCount() {
+		return 0
+	}
+	if number < u.PartsCount() {
+		return u.PartSize
+	}
+	return u.Size - u.PartSize*int64(number-1)
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadParts(t *testing.T) {
+	u := Upload{Size: 25, PartSize: 10}
+	require.Equal(t, 3, u.PartsCount())
+	require.Equal(t, int64(10), u.PartLength(1))
+	require.Equal(t, int64(5), u.PartLength(3))
+	require.Equal(t, int64(0), u.PartLength(4))
+
+	require.Equal(t, 2, Upload{Size: 20, PartSize: 10}.PartsCount())
+	require.Equal(t, 0, Upload{Size: 20}.PartsCount())
+}
+
+func TestUploadStrings(t *testing.T) {
+	require.Equal(t, "cytology", UploadKindCytology.String())
+	require.Equal(t, "claimed", UploadStatusClaimed.String())
+}
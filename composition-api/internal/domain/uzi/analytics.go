@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnalyticsPeriod шаг группировки аналитики по дате создания узи
+type AnalyticsPeriod string
+
+const (
+	AnalyticsPeriodDay   AnalyticsPeriod = "day"
+	AnalyticsPeriodWeek  AnalyticsPeriod = "week"
+	AnalyticsPeriodMonth AnalyticsPeriod = "month"
+)
+
+func (p AnalyticsPeriod) String() string {
+	return string(p)
+}
+
+func (p AnalyticsPeriod) Parse(period string) (AnalyticsPeriod, error) {
+	switch period {
+	case "day":
+		return AnalyticsPeriodDay, nil
+	case "week":
+		return AnalyticsPeriodWeek, nil
+	case "month":
+		return AnalyticsPeriodMonth, nil
+	default:
+		return "", fmt.Errorf("invalid analytics period: %s", period)
+	}
+}
+
+// AnalyticsFilter выборка узи для аналитики, незаданные поля не ограничивают выборку
+type AnalyticsFilter struct {
+	DeviceID *int
+	Author   *uuid.UUID
+	// границы даты создания узи, включительно
+	CreateFrom *time.Time
+	CreateTo   *time.Time
+	Period     AnalyticsPeriod
+}
+
+// TiradsDistribution число узлов по классу с наибольшей вероятностью
+type TiradsDistribution struct {
+	Tirads23 int
+	Tirads4  int
+	Tirads5  int
+}
+
+// NodeMetrics качество нейросети относительно валидации врачами
+type NodeMetrics struct {
+	Uzis        int
+	AiNodes     int
+	Valid       int
+	Invalid     int
+	Unvalidated int
+	ManualNodes int
+	// valid / (valid + invalid), nil если провалидированных узлов нет
+	Precision *float64
+	// unvalidated / ai_nodes, nil если нейросетевых узлов нет
+	UnvalidatedShare *float64
+
+	AiTirads     TiradsDistribution
+	ManualTirads TiradsDistribution
+}
+
+type DeviceNodeMetrics struct {
+	DeviceID int
+	Metrics  NodeMetrics
+}
+
+// AuthorNodeMetrics метрики узи автора исследования
+type AuthorNodeMetrics struct {
+	Author  uuid.UUID
+	Metrics NodeMetrics
+}
+
+type PeriodNodeMetrics struct {
+	Start   time.Time
+	Metrics NodeMetrics
+}
+
+// ReaderAgreement согласие двух врачей, размечавших одни и те же узи вручную
+type ReaderAgreement struct {
+	ReaderA uuid.UUID
+	ReaderB uuid.UUID
+	Uzis    int
+	// пары узлов, сопоставленных по IoU контуров
+	Matched int
+	// узлы, отмеченные только одним из врачей
+	Unmatched int
+	// каппа Коэна по классам TI-RADS, несопоставленный узел - отдельный класс "нет узла"
+	Kappa *float64
+	// средний IoU контуров сопоставленных узлов
+	MeanIoU *float64
+}
+
+type Analytics struct {
+	Total    NodeMetrics
+	ByDevice []DeviceNodeMetrics
+	ByAuthor []AuthorNodeMetrics
+	ByPeriod []PeriodNodeMetrics
+
+	// пары врачей, по всем пересекающимся узи
+	Agreement []ReaderAgreement
+	// каппа и IoU по всем парам вместе
+	Kappa   *float64
+	MeanIoU *float64
+
+	// узлы нейросети, которые врачи слили с другими или разделили
+	AiMerged int
+	AiSplit  int
+	// узлы нейросети, провалидированные пакетными операциями, по решению
+	BulkValid   int
+	BulkInvalid int
+}
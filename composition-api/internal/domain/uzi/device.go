@@ -1,6 +1,42 @@
 package domain
 
+import "fmt"
+
 type Device struct {
-	Id   int
-	Name string
+	Id           int
+	Name         string
+	Manufacturer *string
+	Model        *string
+	SerialNumber *string
+	ProbeType    *ProbeType
+	// калибровка аппарата по умолчанию, используется если у узи не задан свой шаг пикселя
+	PixelSpacing *PixelSpacing
+}
+
+type ProbeType string
+
+const (
+	// линейный датчик
+	ProbeTypeLinear ProbeType = "linear"
+	// конвексный датчик
+	ProbeTypeConvex ProbeType = "convex"
+	// секторный (фазированный) датчик
+	ProbeTypeSector ProbeType = "sector"
+)
+
+func (p ProbeType) String() string {
+	return string(p)
+}
+
+func (p ProbeType) Parse(probeType string) (ProbeType, error) {
+	switch probeType {
+	case "linear":
+		return ProbeTypeLinear, nil
+	case "convex":
+		return ProbeTypeConvex, nil
+	case "sector":
+		return ProbeTypeSector, nil
+	default:
+		return "", fmt.Errorf("invalid probe type: %s", probeType)
+	}
 }
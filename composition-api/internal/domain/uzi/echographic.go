@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 )
 
+// Размеры долей и перешейка в см, объемы в мл
 type Echographic struct {
 	Id              uuid.UUID
 	Contors         *string
@@ -17,11 +18,57 @@ type Echographic struct {
 	RightLobeVolum  *float64
 	GlandVolum      *float64
 	Isthmus         *float64
-	Struct          *string
-	Echogenicity    *string
+	Struct          *EchographicStruct
+	Echogenicity    *EchographicEchogenicity
 	RegionalLymph   *string
-	Vascularization *string
+	Vascularization *EchographicVascularization
 	Location        *string
 	Additional      *string
 	Conclusion      *string
+	PatientSex      *PatientSex
+	// полных лет на дату узи
+	PatientAge *int
+
+	// вычисляет uzi, только чтение
+	Flags               []EchographicFlag
+	GeneratedConclusion string
+}
+
+type EchographicStruct string
+
+const (
+	EchographicStructHomogeneous   EchographicStruct = "homogeneous"
+	EchographicStructHeterogeneous EchographicStruct = "heterogeneous"
+)
+
+type EchographicEchogenicity string
+
+const (
+	EchographicEchogenicityNormal    EchographicEchogenicity = "normal"
+	EchographicEchogenicityIncreased EchographicEchogenicity = "increased"
+	EchographicEchogenicityDecreased EchographicEchogenicity = "decreased"
+	EchographicEchogenicityMixed     EchographicEchogenicity = "mixed"
+)
+
+type EchographicVascularization string
+
+const (
+	EchographicVascularizationNormal    EchographicVascularization = "normal"
+	EchographicVascularizationIncreased EchographicVascularization = "increased"
+	EchographicVascularizationDecreased EchographicVascularization = "decreased"
+)
+
+type PatientSex string
+
+const (
+	PatientSexMale   PatientSex = "male"
+	PatientSexFemale PatientSex = "female"
+)
+
+// EchographicFlag значение вне нормы, Min и Max - границы нормы
+type EchographicFlag struct {
+	Field string
+	Value float64
+	Min   *float64
+	Max   *float64
 }
@@ -8,4 +8,24 @@ type Image struct {
 	Id    uuid.UUID
 	UziID uuid.UUID
 	Page  int
+	// размер исходного кадра, 0 у кадров, разбитых до появления превью
+	Width    int
+	Height   int
+	Variants []ImageVariant
+}
+
+type ImageSize string
+
+const (
+	ImageSizeOriginal  ImageSize = "original"
+	ImageSizePreview   ImageSize = "preview"
+	ImageSizeThumbnail ImageSize = "thumbnail"
+)
+
+// ImageVariant файл кадра определенного размера
+type ImageVariant struct {
+	Size        ImageSize
+	ContentType string
+	Width       int
+	Height      int
 }
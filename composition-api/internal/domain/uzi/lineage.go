@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NodeLineage связь узла с цепочкой наблюдений одного и того же образования
+// в разных узи пациента
+type NodeLineage struct {
+	NodeID    uuid.UUID
+	LineageID uuid.UUID
+}
+
+// NodeLinkSuggestion кандидат на связывание с узлом, чем выше Score тем вероятнее совпадение
+type NodeLinkSuggestion struct {
+	Node  Node
+	Score float64
+}
+
+// NodeGrowthPoint состояние отслеживаемого узла в одном из узи
+type NodeGrowthPoint struct {
+	Node     Node
+	UziID    uuid.UUID
+	CreateAt time.Time
+	// объем в мм³, nil если узел не измерен в мм
+	Volume *float64
+}
+
+// NodeGrowth динамика отслеживаемого узла, точки упорядочены по дате узи
+type NodeGrowth struct {
+	LineageID uuid.UUID
+	Points    []NodeGrowthPoint
+	// изменение объема между первым и последним измерением в процентах
+	VolumeChange *float64
+	// время удвоения объема в днях, nil если объем не растет
+	DoublingTime *float64
+}
@@ -11,4 +11,6 @@ type Node struct {
 	Tirads4     float64
 	Tirads5     float64
 	Description *string
+	Lobe        *NodeLobe
+	Measurement *NodeMeasurement
 }
@@ -0,0 +1,31 @@
+package domain
+
+import "fmt"
+
+type NodeLobe string
+
+const (
+	// правая доля щитовидной железы
+	NodeLobeRight NodeLobe = "right"
+	// левая доля щитовидной железы
+	NodeLobeLeft NodeLobe = "left"
+	// перешеек
+	NodeLobeIsthmus NodeLobe = "isthmus"
+)
+
+func (l NodeLobe) String() string {
+	return string(l)
+}
+
+func (l NodeLobe) Parse(lobe string) (NodeLobe, error) {
+	switch lobe {
+	case "right":
+		return NodeLobeRight, nil
+	case "left":
+		return NodeLobeLeft, nil
+	case "isthmus":
+		return NodeLobeIsthmus, nil
+	default:
+		return "", fmt.Errorf("invalid lobe: %s", lobe)
+	}
+}
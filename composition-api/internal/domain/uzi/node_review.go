@@ -0,0 +1,22 @@
+package domain
+
+import "github.com/google/uuid"
+
+// NodeReviewStatus результат пакетной валидации для одного узла
+type NodeReviewStatus string
+
+const (
+	NodeReviewStatusApplied          NodeReviewStatus = "applied"
+	NodeReviewStatusUnchanged        NodeReviewStatus = "unchanged"
+	NodeReviewStatusNotFound         NodeReviewStatus = "not_found"
+	NodeReviewStatusManualNode       NodeReviewStatus = "manual_node"
+	NodeReviewStatusAlreadyValidated NodeReviewStatus = "already_validated"
+	NodeReviewStatusBelowThreshold   NodeReviewStatus = "below_threshold"
+)
+
+// NodeReviewItem узел пакета, Node nil если узел не найден
+type NodeReviewItem struct {
+	NodeID uuid.UUID
+	Status NodeReviewStatus
+	Node   *Node
+}
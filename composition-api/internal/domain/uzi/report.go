@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Report сформированное заключение по узи, каждая генерация создает новую версию
+type Report struct {
+	Id       uuid.UUID
+	UziID    uuid.UUID
+	Version  int
+	HtmlPath string
+	PdfPath  string
+	CreateAt time.Time
+}
+
+type ReportFormat string
+
+const (
+	ReportFormatPdf  ReportFormat = "pdf"
+	ReportFormatHtml ReportFormat = "html"
+)
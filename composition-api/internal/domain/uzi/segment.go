@@ -2,6 +2,7 @@ package domain
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,4 +16,20 @@ type Segment struct {
 	Tirads23 float64
 	Tirads4  float64
 	Tirads5  float64
+
+	Measurement *SegmentMeasurement
+}
+
+// SegmentDraft контур узла, предложенный на соседнем кадре, становится сегментом после подтверждения
+type SegmentDraft struct {
+	Id              uuid.UUID
+	NodeID          uuid.UUID
+	ImageID         uuid.UUID
+	SourceSegmentID uuid.UUID
+	Contor          json.RawMessage
+	Tirads23        float64
+	Tirads4         float64
+	Tirads5         float64
+	Score           float64
+	CreateAt        time.Time
 }
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Дескрипторы ACR TI-RADS
+
+type TiradsComposition string
+
+const (
+	// кистозный или почти полностью кистозный
+	TiradsCompositionCystic TiradsComposition = "cystic"
+	// губчатый
+	TiradsCompositionSpongiform TiradsComposition = "spongiform"
+	// смешанный кистозно-солидный
+	TiradsCompositionMixed TiradsComposition = "mixed"
+	// солидный или почти полностью солидный
+	TiradsCompositionSolid TiradsComposition = "solid"
+)
+
+func (c TiradsComposition) String() string {
+	return string(c)
+}
+
+func (c TiradsComposition) Parse(composition string) (TiradsComposition, error) {
+	switch composition {
+	case "cystic":
+		return TiradsCompositionCystic, nil
+	case "spongiform":
+		return TiradsCompositionSpongiform, nil
+	case "mixed":
+		return TiradsCompositionMixed, nil
+	case "solid":
+		return TiradsCompositionSolid, nil
+	default:
+		return "", fmt.Errorf("invalid composition: %s", composition)
+	}
+}
+
+type TiradsEchogenicity string
+
+const (
+	// анэхогенный
+	TiradsEchogenicityAnechoic TiradsEchogenicity = "anechoic"
+	// гиперэхогенный или изоэхогенный
+	TiradsEchogenicityHyperIso TiradsEchogenicity = "hyper_iso"
+	// гипоэхогенный
+	TiradsEchogenicityHypo TiradsEchogenicity = "hypo"
+	// выраженно гипоэхогенный
+	TiradsEchogenicityVeryHypo TiradsEchogenicity = "very_hypo"
+)
+
+func (e TiradsEchogenicity) String() string {
+	return string(e)
+}
+
+func (e TiradsEchogenicity) Parse(echogenicity string) (TiradsEchogenicity, error) {
+	switch echogenicity {
+	case "anechoic":
+		return TiradsEchogenicityAnechoic, nil
+	case "hyper_iso":
+		return TiradsEchogenicityHyperIso, nil
+	case "hypo":
+		return TiradsEchogenicityHypo, nil
+	case "very_hypo":
+		return TiradsEchogenicityVeryHypo, nil
+	default:
+		return "", fmt.Errorf("invalid echogenicity: %s", echogenicity)
+	}
+}
+
+type TiradsShape string
+
+const (
+	// ширина больше высоты
+	TiradsShapeWiderThanTall TiradsShape = "wider_than_tall"
+	// высота больше ширины
+	TiradsShapeTallerThanWide TiradsShape = "taller_than_wide"
+)
+
+func (s TiradsShape) String() string {
+	return string(s)
+}
+
+func (s TiradsShape) Parse(shape string) (TiradsShape, error) {
+	switch shape {
+	case "wider_than_tall":
+		return TiradsShapeWiderThanTall, nil
+	case "taller_than_wide":
+		return TiradsShapeTallerThanWide, nil
+	default:
+		return "", fmt.Errorf("invalid shape: %s", shape)
+	}
+}
+
+type TiradsMargin string
+
+const (
+	// ровный
+	TiradsMarginSmooth TiradsMargin = "smooth"
+	// нечеткий
+	TiradsMarginIllDefined TiradsMargin = "ill_defined"
+	// дольчатый или неровный
+	TiradsMarginLobulated TiradsMargin = "lobulated"
+	// экстратиреоидное распространение
+	TiradsMarginExtrathyroidal TiradsMargin = "extrathyroidal"
+)
+
+func (m TiradsMargin) String() string {
+	return string(m)
+}
+
+func (m TiradsMargin) Parse(margin string) (TiradsMargin, error) {
+	switch margin {
+	case "smooth":
+		return TiradsMarginSmooth, nil
+	case "ill_defined":
+		return TiradsMarginIllDefined, nil
+	case "lobulated":
+		return TiradsMarginLobulated, nil
+	case "extrathyroidal":
+		return TiradsMarginExtrathyroidal, nil
+	default:
+		return "", fmt.Errorf("invalid margin: %s", margin)
+	}
+}
+
+type TiradsEchogenicFoci string
+
+const (
+	// нет или крупные артефакты "хвост кометы"
+	TiradsEchogenicFociNone TiradsEchogenicFoci = "none"
+	// макрокальцинаты
+	TiradsEchogenicFociMacrocalcifications TiradsEchogenicFoci = "macrocalcifications"
+	// периферические (ободковые) кальцинаты
+	TiradsEchogenicFociPeripheral TiradsEchogenicFoci = "peripheral"
+	// точечные эхогенные включения
+	TiradsEchogenicFociPunctate TiradsEchogenicFoci = "punctate"
+)
+
+func (f TiradsEchogenicFoci) String() string {
+	return string(f)
+}
+
+func (f TiradsEchogenicFoci) Parse(foci string) (TiradsEchogenicFoci, error) {
+	switch foci {
+	case "none":
+		return TiradsEchogenicFociNone, nil
+	case "macrocalcifications":
+		return TiradsEchogenicFociMacrocalcifications, nil
+	case "peripheral":
+		return TiradsEchogenicFociPeripheral, nil
+	case "punctate":
+		return TiradsEchogenicFociPunctate, nil
+	default:
+		return "", fmt.Errorf("invalid echogenic foci: %s", foci)
+	}
+}
+
+type NodeDescriptors struct {
+	NodeID        uuid.UUID
+	Composition   TiradsComposition
+	Echogenicity  TiradsEchogenicity
+	Shape         TiradsShape
+	Margin        TiradsMargin
+	EchogenicFoci []TiradsEchogenicFoci
+}
+
+// Результат оценки ACR TI-RADS
+
+type TiradsCategory string
+
+const (
+	// доброкачественный
+	TiradsCategoryTR1 TiradsCategory = "tr1"
+	// не подозрительный
+	TiradsCategoryTR2 TiradsCategory = "tr2"
+	// слабо подозрительный
+	TiradsCategoryTR3 TiradsCategory = "tr3"
+	// умеренно подозрительный
+	TiradsCategoryTR4 TiradsCategory = "tr4"
+	// высоко подозрительный
+	TiradsCategoryTR5 TiradsCategory = "tr5"
+)
+
+func (c TiradsCategory) String() string {
+	return string(c)
+}
+
+type TiradsRecommendation string
+
+const (
+	// наблюдение не требуется
+	TiradsRecommendationNone TiradsRecommendation = "none"
+	// динамическое наблюдение
+	TiradsRecommendationFollowUp TiradsRecommendation = "follow_up"
+	// тонкоигольная аспирационная биопсия
+	TiradsRecommendationFNA TiradsRecommendation = "fna"
+	// размер узла в мм неизвестен
+	TiradsRecommendationUnknown TiradsRecommendation = "unknown"
+)
+
+func (r TiradsRecommendation) String() string {
+	return string(r)
+}
+
+type TiradsScore struct {
+	Points         int
+	Category       TiradsCategory
+	Recommendation TiradsRecommendation
+	// максимальный размер узла в мм, по которому дана рекомендация
+	MaxDiameter *float64
+}
+
+// Оценка узла врачом вместе с вероятностями нейросети
+type NodeTirads struct {
+	Node        Node
+	Descriptors *NodeDescriptors
+	Score       *TiradsScore
+}
@@ -16,4 +16,7 @@ type Uzi struct {
 	Status      UziStatus
 	Description *string
 	CreateAt    time.Time
+
+	PixelSpacing *PixelSpacing
+	Sha256       *string
 }
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SortOrder string
+
+const (
+	// сначала новые
+	SortOrderDesc SortOrder = "desc"
+	// сначала старые
+	SortOrderAsc SortOrder = "asc"
+)
+
+func (o SortOrder) String() string {
+	return string(o)
+}
+
+func (o SortOrder) Parse(order string) (SortOrder, error) {
+	switch order {
+	case "desc":
+		return SortOrderDesc, nil
+	case "asc":
+		return SortOrderAsc, nil
+	default:
+		return "", fmt.Errorf("invalid sort order: %s", order)
+	}
+}
+
+// UziFilter фильтры поиска узи, незаданные поля не ограничивают выборку
+type UziFilter struct {
+	Author     *uuid.UUID
+	ExternalID *uuid.UUID
+	Status     *UziStatus
+	Projection *UziProjection
+	DeviceID   *int
+	Checked    *bool
+	// границы даты создания, включительно
+	CreateFrom *time.Time
+	CreateTo   *time.Time
+	// в узи есть нейросетевой узел с вероятностями классов не ниже порогов
+	AiTirads4Min *float64
+	AiTirads5Min *float64
+}
+
+type UziPage struct {
+	Uzis []Uzi
+	// курсор следующей страницы, nil если страница последняя
+	NextCursor *string
+}
@@ -58,6 +58,21 @@ type Invoker interface {
 	//
 	// GET /cytology/patient/{patient_id}/shots
 	CytologyPatientShotsRead(ctx context.Context, params CytologyPatientShotsReadParams) (CytologyPatientShotsReadRes, error)
+	// CytologyQuPathExport invokes CytologyQuPathExport operation.
+	//
+	// Все группы сегментаций исследования одним FeatureCollection,
+	// класс и его цвет в properties.classification.
+	//
+	// GET /cytology/{id}/qupath
+	CytologyQuPathExport(ctx context.Context, params CytologyQuPathExportParams) (CytologyQuPathExportRes, error)
+	// CytologyQuPathImport invokes CytologyQuPathImport operation.
+	//
+	// Создает новую версию исследования копированием,
+	// сегментации новой версии берутся из GeoJSON. Аннотации
+	// сравниваются с экспортом по id объекта QuPath.
+	//
+	// POST /cytology/{id}/qupath
+	CytologyQuPathImport(ctx context.Context, request CytologyQuPathImportReq, params CytologyQuPathImportParams) (CytologyQuPathImportRes, error)
 	// CytologyRead invokes CytologyRead operation.
 	//
 	// Информация об одной группе снимков.
@@ -100,6 +115,17 @@ type Invoker interface {
 	//
 	// GET /cytology/{id}/segments
 	CytologySegmentsList(ctx context.Context, params CytologySegmentsListParams) (CytologySegmentsListRes, error)
+	// CytologySegmentsViewport invokes CytologySegmentsViewport operation.
+	//
+	// Сегментации исследования, ограничивающий
+	// прямоугольник которых пересекается с областью в
+	// пикселях нулевого уровня. Сегментации отсортированы
+	// по id, для следующей страницы передайте next_cursor из
+	// предыдущего ответа. При downsample больше 1 контуры
+	// упрощаются с допуском downsample пикселей.
+	//
+	// GET /cytology/{id}/segments/viewport
+	CytologySegmentsViewport(ctx context.Context, params CytologySegmentsViewportParams) (CytologySegmentsViewportRes, error)
 	// CytologyUpdatePartialUpdate invokes CytologyUpdatePartialUpdate operation.
 	//
 	// Обновление всей страницы с информацией о приеме.
@@ -125,6 +151,12 @@ type Invoker interface {
 	//
 	// GET /download/{uzi_id}/{image_id}
 	DownloadUziIDImageIDGet(ctx context.Context, params DownloadUziIDImageIDGetParams) (DownloadUziIDImageIDGetRes, error)
+	// DownloadUziUziIDReportGet invokes GET /download/uzi/{uzi_id}/report operation.
+	//
+	// Скачать заключение по узи.
+	//
+	// GET /download/uzi/{uzi_id}/report
+	DownloadUziUziIDReportGet(ctx context.Context, params DownloadUziUziIDReportGetParams) (DownloadUziUziIDReportGetRes, error)
 	// LoginPost invokes POST /login operation.
 	//
 	// Авторизация.
@@ -256,6 +288,59 @@ type Invoker interface {
 	//
 	// GET /tiler/dzi/{file_path}
 	TilerDziFilePathGet(ctx context.Context, params TilerDziFilePathGetParams) (TilerDziFilePathGetRes, error)
+	// UploadsIDCompletePost invokes POST /uploads/{id}/complete operation.
+	//
+	// Собирает файл из частей и ставит его в обработку. sha256
+	// сверяется и метаданные очищаются в фоне, пока
+	// загрузка в статусе processing, ее нельзя передать в узи или
+	// цитологию.
+	//
+	// POST /uploads/{id}/complete
+	UploadsIDCompletePost(ctx context.Context, params UploadsIDCompletePostParams) (UploadsIDCompletePostRes, error)
+	// UploadsIDDelete invokes DELETE /uploads/{id} operation.
+	//
+	// Отменить загрузку.
+	//
+	// DELETE /uploads/{id}
+	UploadsIDDelete(ctx context.Context, params UploadsIDDeleteParams) (UploadsIDDeleteRes, error)
+	// UploadsIDGet invokes GET /uploads/{id} operation.
+	//
+	// Получить состояние загрузки.
+	//
+	// GET /uploads/{id}
+	UploadsIDGet(ctx context.Context, params UploadsIDGetParams) (UploadsIDGetRes, error)
+	// UploadsIDPartsPost invokes POST /uploads/{id}/parts operation.
+	//
+	// Получить url для загрузки частей.
+	//
+	// POST /uploads/{id}/parts
+	UploadsIDPartsPost(ctx context.Context, request *UploadsIDPartsPostReq, params UploadsIDPartsPostParams) (UploadsIDPartsPostRes, error)
+	// UploadsPost invokes POST /uploads operation.
+	//
+	// Файл загружается напрямую в S3 частями по presigned url,
+	// после завершения id загрузки передается в POST /uzi или
+	// /cytology/create.
+	//
+	// POST /uploads
+	UploadsPost(ctx context.Context, request *UploadsPostReq) (UploadsPostRes, error)
+	// UziDeviceIDDelete invokes DELETE /uzi/device/{id} operation.
+	//
+	// Аппарат, на который ссылаются узи, удалить нельзя.
+	//
+	// DELETE /uzi/device/{id}
+	UziDeviceIDDelete(ctx context.Context, params UziDeviceIDDeleteParams) (UziDeviceIDDeleteRes, error)
+	// UziDeviceIDGet invokes GET /uzi/device/{id} operation.
+	//
+	// Получить uzi аппарат.
+	//
+	// GET /uzi/device/{id}
+	UziDeviceIDGet(ctx context.Context, params UziDeviceIDGetParams) (UziDeviceIDGetRes, error)
+	// UziDeviceIDPatch invokes PATCH /uzi/device/{id} operation.
+	//
+	// Обновить uzi аппарат.
+	//
+	// PATCH /uzi/device/{id}
+	UziDeviceIDPatch(ctx context.Context, request *UziDeviceIDPatchReq, params UziDeviceIDPatchParams) (UziDeviceIDPatchRes, error)
 	// UziDevicePost invokes POST /uzi/device operation.
 	//
 	// Добавить uzi аппарат.
@@ -268,6 +353,14 @@ type Invoker interface {
 	//
 	// GET /uzi/devices
 	UziDevicesGet(ctx context.Context) (UziDevicesGetRes, error)
+	// UziIDCheckPost invokes POST /uzi/{id}/check operation.
+	//
+	// Узи получает checked, при заданном unvalidated узлам нейросети
+	// без решения врача проставляется эта валидация.
+	// Выполняется в одной транзакции.
+	//
+	// POST /uzi/{id}/check
+	UziIDCheckPost(ctx context.Context, request OptUziIDCheckPostReq, params UziIDCheckPostParams) (UziIDCheckPostRes, error)
 	// UziIDDelete invokes DELETE /uzi/{id} operation.
 	//
 	// Удалить узи.
@@ -298,6 +391,15 @@ type Invoker interface {
 	//
 	// GET /uzi/{id}/images
 	UziIDImagesGet(ctx context.Context, params UziIDImagesGetParams) (UziIDImagesGetRes, error)
+	// UziIDNodesAcceptPost invokes POST /uzi/{id}/nodes/accept operation.
+	//
+	// Узлы нейросети без решения врача, у которых
+	// вероятность наиболее вероятного класса TI-RADS не ниже
+	// threshold,
+	// становятся valid. Выполняется в одной транзакции.
+	//
+	// POST /uzi/{id}/nodes/accept
+	UziIDNodesAcceptPost(ctx context.Context, request *UziIDNodesAcceptPostReq, params UziIDNodesAcceptPostParams) (UziIDNodesAcceptPostRes, error)
 	// UziIDNodesGet invokes GET /uzi/{id}/nodes operation.
 	//
 	// Получить все узлы узи.
@@ -316,6 +418,19 @@ type Invoker interface {
 	//
 	// PATCH /uzi/{id}
 	UziIDPatch(ctx context.Context, request *UziIDPatchReq, params UziIDPatchParams) (UziIDPatchRes, error)
+	// UziIDReportsGet invokes GET /uzi/{id}/reports operation.
+	//
+	// Получить версии заключения по узи.
+	//
+	// GET /uzi/{id}/reports
+	UziIDReportsGet(ctx context.Context, params UziIDReportsGetParams) (UziIDReportsGetRes, error)
+	// UziIDReportsPost invokes POST /uzi/{id}/reports operation.
+	//
+	// Заключение собирается из эхографических признаков,
+	// узлов и ключевых кадров с контурами.
+	//
+	// POST /uzi/{id}/reports
+	UziIDReportsPost(ctx context.Context, params UziIDReportsPostParams) (UziIDReportsPostRes, error)
 	// UziImageIDNodesSegmentsGet invokes GET /uzi/image/{id}/nodes-segments operation.
 	//
 	// Получит узлы и сегменты на указанном изображении.
@@ -328,24 +443,99 @@ type Invoker interface {
 	//
 	// DELETE /uzi/nodes/{id}
 	UziNodesIDDelete(ctx context.Context, params UziNodesIDDeleteParams) (UziNodesIDDeleteRes, error)
+	// UziNodesIDLinkDelete invokes DELETE /uzi/nodes/{id}/link operation.
+	//
+	// Отвязать узел от цепочки наблюдений.
+	//
+	// DELETE /uzi/nodes/{id}/link
+	UziNodesIDLinkDelete(ctx context.Context, params UziNodesIDLinkDeleteParams) (UziNodesIDLinkDeleteRes, error)
+	// UziNodesIDLinkPost invokes POST /uzi/nodes/{id}/link operation.
+	//
+	// Узел добавляется в цепочку наблюдений целевого узла.
+	//
+	// POST /uzi/nodes/{id}/link
+	UziNodesIDLinkPost(ctx context.Context, request *UziNodesIDLinkPostReq, params UziNodesIDLinkPostParams) (UziNodesIDLinkPostRes, error)
+	// UziNodesIDLinkSuggestionsGet invokes GET /uzi/nodes/{id}/link/suggestions operation.
+	//
+	// Предложить узлы из других узи пациента для связывания.
+	//
+	// GET /uzi/nodes/{id}/link/suggestions
+	UziNodesIDLinkSuggestionsGet(ctx context.Context, params UziNodesIDLinkSuggestionsGetParams) (UziNodesIDLinkSuggestionsGetRes, error)
 	// UziNodesIDPatch invokes PATCH /uzi/nodes/{id} operation.
 	//
 	// Обновить узел.
 	//
 	// PATCH /uzi/nodes/{id}
 	UziNodesIDPatch(ctx context.Context, request *UziNodesIDPatchReq, params UziNodesIDPatchParams) (UziNodesIDPatchRes, error)
+	// UziNodesIDSegmentDraftsGet invokes GET /uzi/nodes/{id}/segment-drafts operation.
+	//
+	// Получить черновики сегментов узла.
+	//
+	// GET /uzi/nodes/{id}/segment-drafts
+	UziNodesIDSegmentDraftsGet(ctx context.Context, params UziNodesIDSegmentDraftsGetParams) (UziNodesIDSegmentDraftsGetRes, error)
 	// UziNodesIDSegmentsGet invokes GET /uzi/nodes/{id}/segments operation.
 	//
 	// Получить сегменты узла.
 	//
 	// GET /uzi/nodes/{id}/segments
 	UziNodesIDSegmentsGet(ctx context.Context, params UziNodesIDSegmentsGetParams) (UziNodesIDSegmentsGetRes, error)
+	// UziNodesIDSplitPost invokes POST /uzi/nodes/{id}/split operation.
+	//
+	// Вероятности TI-RADS обоих узлов пересчитываются по их
+	// сегментам, исходный узел сохраняется для аналитики.
+	// В узле должен остаться хотя бы один сегмент.
+	//
+	// POST /uzi/nodes/{id}/split
+	UziNodesIDSplitPost(ctx context.Context, request *UziNodesIDSplitPostReq, params UziNodesIDSplitPostParams) (UziNodesIDSplitPostRes, error)
+	// UziNodesIDTiradsGet invokes GET /uzi/nodes/{id}/tirads operation.
+	//
+	// Получить оценку узла по ACR TI-RADS.
+	//
+	// GET /uzi/nodes/{id}/tirads
+	UziNodesIDTiradsGet(ctx context.Context, params UziNodesIDTiradsGetParams) (UziNodesIDTiradsGetRes, error)
+	// UziNodesIDTiradsPut invokes PUT /uzi/nodes/{id}/tirads operation.
+	//
+	// Задать дескрипторы узла по ACR TI-RADS.
+	//
+	// PUT /uzi/nodes/{id}/tirads
+	UziNodesIDTiradsPut(ctx context.Context, request *NodeDescriptors, params UziNodesIDTiradsPutParams) (UziNodesIDTiradsPutRes, error)
+	// UziNodesMergePost invokes POST /uzi/nodes/merge operation.
+	//
+	// Сегменты всех узлов переносятся в первый из node_ids,
+	// остальные узлы удаляются.
+	// Вероятности TI-RADS узла пересчитываются по его
+	// сегментам, исходные узлы сохраняются для аналитики.
+	//
+	// POST /uzi/nodes/merge
+	UziNodesMergePost(ctx context.Context, request *UziNodesMergePostReq) (UziNodesMergePostRes, error)
+	// UziNodesReviewPost invokes POST /uzi/nodes/review operation.
+	//
+	// Валидация проставляется всем узлам в одной
+	// транзакции, узлы, которые нельзя изменить,
+	// возвращаются со своим статусом.
+	// Пакетные операции учитываются в аналитике.
+	//
+	// POST /uzi/nodes/review
+	UziNodesReviewPost(ctx context.Context, request *UziNodesReviewPostReq) (UziNodesReviewPostRes, error)
 	// UziPost invokes POST /uzi operation.
 	//
 	// Загрузить узи на обработку.
 	//
 	// POST /uzi
 	UziPost(ctx context.Context, request *UziPostReq) (UziPostRes, error)
+	// UziSegmentDraftsIDAcceptPost invokes POST /uzi/segment-drafts/{id}/accept operation.
+	//
+	// Из черновика создается ручной сегмент узла, черновик
+	// удаляется.
+	//
+	// POST /uzi/segment-drafts/{id}/accept
+	UziSegmentDraftsIDAcceptPost(ctx context.Context, params UziSegmentDraftsIDAcceptPostParams) (UziSegmentDraftsIDAcceptPostRes, error)
+	// UziSegmentDraftsIDDelete invokes DELETE /uzi/segment-drafts/{id} operation.
+	//
+	// Отклонить черновик.
+	//
+	// DELETE /uzi/segment-drafts/{id}
+	UziSegmentDraftsIDDelete(ctx context.Context, params UziSegmentDraftsIDDeleteParams) (UziSegmentDraftsIDDeleteRes, error)
 	// UziSegmentIDDelete invokes DELETE /uzi/segment/{id} operation.
 	//
 	// Если у узла не останется сегментов, он будет **удален**.
@@ -358,12 +548,33 @@ type Invoker interface {
 	//
 	// PATCH /uzi/segment/{id}
 	UziSegmentIDPatch(ctx context.Context, request *UziSegmentIDPatchReq, params UziSegmentIDPatchParams) (UziSegmentIDPatchRes, error)
+	// UziSegmentIDPropagatePost invokes POST /uzi/segment/{id}/propagate operation.
+	//
+	// Контур сегмента переносится на соседние кадры узи,
+	// результат сохраняется черновиками.
+	// В каждую сторону распространение останавливается на
+	// кадре, где у узла уже есть сегмент, или при потере
+	// контура.
+	// Прежние черновики узла на тех же кадрах заменяются.
+	//
+	// POST /uzi/segment/{id}/propagate
+	UziSegmentIDPropagatePost(ctx context.Context, params UziSegmentIDPropagatePostParams) (UziSegmentIDPropagatePostRes, error)
 	// UziSegmentPost invokes POST /uzi/segment operation.
 	//
 	// Добавить новый сегмент.
 	//
 	// POST /uzi/segment
 	UziSegmentPost(ctx context.Context, request *UziSegmentPostReq) (UziSegmentPostRes, error)
+	// UzisAnalyticsGet invokes GET /uzis/analytics operation.
+	//
+	// Точность нейросети относительно валидации, доля
+	// непровалидированных узлов и распределение классов
+	// TI-RADS по всей выборке, аппаратам, авторам и периодам.
+	// Для узи, размеченных несколькими врачами, согласие
+	// врачей: каппа Коэна и IoU контуров.
+	//
+	// GET /uzis/analytics
+	UzisAnalyticsGet(ctx context.Context, params UzisAnalyticsGetParams) (UzisAnalyticsGetRes, error)
 	// UzisAuthorIDGet invokes GET /uzis/author/{id} operation.
 	//
 	// Получить узи по id автора.
@@ -376,6 +587,20 @@ type Invoker interface {
 	//
 	// GET /uzis/external/{id}
 	UzisExternalIDGet(ctx context.Context, params UzisExternalIDGetParams) (UzisExternalIDGetRes, error)
+	// UzisExternalIDGrowthGet invokes GET /uzis/external/{id}/growth operation.
+	//
+	// Получить динамику отслеживаемых узлов пациента.
+	//
+	// GET /uzis/external/{id}/growth
+	UzisExternalIDGrowthGet(ctx context.Context, params UzisExternalIDGrowthGetParams) (UzisExternalIDGrowthGetRes, error)
+	// UzisSearchGet invokes GET /uzis/search operation.
+	//
+	// Все фильтры необязательны. Узи отсортированы по дате
+	// создания, для следующей страницы передайте next_cursor из
+	// предыдущего ответа.
+	//
+	// GET /uzis/search
+	UzisSearchGet(ctx context.Context, params UzisSearchGetParams) (UzisSearchGetRes, error)
 	// YookassaWebhooksPost invokes POST /yookassa/webhooks operation.
 	//
 	// Обработка уведомлений от Юкассы.
@@ -948,21 +1173,22 @@ func (c *Client) sendCytologyPatientShotsRead(ctx context.Context, params Cytolo
 	return result, nil
 }
 
-// CytologyRead invokes CytologyRead operation.
+// CytologyQuPathExport invokes CytologyQuPathExport operation.
 //
-// Информация об одной группе снимков.
+// Все группы сегментаций исследования одним FeatureCollection,
+// класс и его цвет в properties.classification.
 //
-// GET /cytology/{id}
-func (c *Client) CytologyRead(ctx context.Context, params CytologyReadParams) (CytologyReadRes, error) {
-	res, err := c.sendCytologyRead(ctx, params)
+// GET /cytology/{id}/qupath
+func (c *Client) CytologyQuPathExport(ctx context.Context, params CytologyQuPathExportParams) (CytologyQuPathExportRes, error) {
+	res, err := c.sendCytologyQuPathExport(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCytologyRead(ctx context.Context, params CytologyReadParams) (res CytologyReadRes, err error) {
+func (c *Client) sendCytologyQuPathExport(ctx context.Context, params CytologyQuPathExportParams) (res CytologyQuPathExportRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologyRead"),
+		otelogen.OperationID("CytologyQuPathExport"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/cytology/{id}"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/qupath"),
 	}
 
 	// Run stopwatch.
@@ -977,7 +1203,7 @@ func (c *Client) sendCytologyRead(ctx context.Context, params CytologyReadParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CytologyReadOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologyQuPathExportOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -994,7 +1220,7 @@ func (c *Client) sendCytologyRead(ctx context.Context, params CytologyReadParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/cytology/"
 	{
 		// Encode "id" parameter.
@@ -1004,7 +1230,7 @@ func (c *Client) sendCytologyRead(ctx context.Context, params CytologyReadParams
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ID))
+			return e.EncodeValue(conv.UUIDToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1014,6 +1240,7 @@ func (c *Client) sendCytologyRead(ctx context.Context, params CytologyReadParams
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/qupath"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1027,7 +1254,7 @@ func (c *Client) sendCytologyRead(ctx context.Context, params CytologyReadParams
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CytologyReadOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CytologyQuPathExportOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1063,7 +1290,7 @@ func (c *Client) sendCytologyRead(ctx context.Context, params CytologyReadParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCytologyReadResponse(resp)
+	result, err := decodeCytologyQuPathExportResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1071,30 +1298,23 @@ func (c *Client) sendCytologyRead(ctx context.Context, params CytologyReadParams
 	return result, nil
 }
 
-// CytologySegmentGroupCreateCreate invokes CytologySegmentGroupCreateCreate operation.
+// CytologyQuPathImport invokes CytologyQuPathImport operation.
 //
-// Создать группу сегментаций.
+// Создает новую версию исследования копированием,
+// сегментации новой версии берутся из GeoJSON. Аннотации
+// сравниваются с экспортом по id объекта QuPath.
 //
-// POST /cytology/segment/group/create/{cytology_img_id}
-func (c *Client) CytologySegmentGroupCreateCreate(ctx context.Context, request *CytologySegmentGroupCreateCreateReq, params CytologySegmentGroupCreateCreateParams) (CytologySegmentGroupCreateCreateRes, error) {
-	res, err := c.sendCytologySegmentGroupCreateCreate(ctx, request, params)
+// POST /cytology/{id}/qupath
+func (c *Client) CytologyQuPathImport(ctx context.Context, request CytologyQuPathImportReq, params CytologyQuPathImportParams) (CytologyQuPathImportRes, error) {
+	res, err := c.sendCytologyQuPathImport(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCytologySegmentGroupCreateCreate(ctx context.Context, request *CytologySegmentGroupCreateCreateReq, params CytologySegmentGroupCreateCreateParams) (res CytologySegmentGroupCreateCreateRes, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendCytologyQuPathImport(ctx context.Context, request CytologyQuPathImportReq, params CytologyQuPathImportParams) (res CytologyQuPathImportRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentGroupCreateCreate"),
+		otelogen.OperationID("CytologyQuPathImport"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/cytology/segment/group/create/{cytology_img_id}"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/qupath"),
 	}
 
 	// Run stopwatch.
@@ -1109,7 +1329,7 @@ func (c *Client) sendCytologySegmentGroupCreateCreate(ctx context.Context, reque
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentGroupCreateCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologyQuPathImportOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1126,17 +1346,17 @@ func (c *Client) sendCytologySegmentGroupCreateCreate(ctx context.Context, reque
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/cytology/segment/group/create/"
+	var pathParts [3]string
+	pathParts[0] = "/cytology/"
 	{
-		// Encode "cytology_img_id" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "cytology_img_id",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.CytologyImgID))
+			return e.EncodeValue(conv.UUIDToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1146,6 +1366,7 @@ func (c *Client) sendCytologySegmentGroupCreateCreate(ctx context.Context, reque
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/qupath"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1153,7 +1374,7 @@ func (c *Client) sendCytologySegmentGroupCreateCreate(ctx context.Context, reque
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCytologySegmentGroupCreateCreateRequest(request, r); err != nil {
+	if err := encodeCytologyQuPathImportRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1162,7 +1383,7 @@ func (c *Client) sendCytologySegmentGroupCreateCreate(ctx context.Context, reque
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CytologySegmentGroupCreateCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CytologyQuPathImportOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1198,7 +1419,7 @@ func (c *Client) sendCytologySegmentGroupCreateCreate(ctx context.Context, reque
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCytologySegmentGroupCreateCreateResponse(resp)
+	result, err := decodeCytologyQuPathImportResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1206,21 +1427,21 @@ func (c *Client) sendCytologySegmentGroupCreateCreate(ctx context.Context, reque
 	return result, nil
 }
 
-// CytologySegmentUpdateDelete invokes CytologySegmentUpdateDelete operation.
+// CytologyRead invokes CytologyRead operation.
 //
-// Удалить сегментацию.
+// Информация об одной группе снимков.
 //
-// DELETE /cytology/segment/update/{id}
-func (c *Client) CytologySegmentUpdateDelete(ctx context.Context, params CytologySegmentUpdateDeleteParams) (CytologySegmentUpdateDeleteRes, error) {
-	res, err := c.sendCytologySegmentUpdateDelete(ctx, params)
+// GET /cytology/{id}
+func (c *Client) CytologyRead(ctx context.Context, params CytologyReadParams) (CytologyReadRes, error) {
+	res, err := c.sendCytologyRead(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCytologySegmentUpdateDelete(ctx context.Context, params CytologySegmentUpdateDeleteParams) (res CytologySegmentUpdateDeleteRes, err error) {
+func (c *Client) sendCytologyRead(ctx context.Context, params CytologyReadParams) (res CytologyReadRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentUpdateDelete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
+		otelogen.OperationID("CytologyRead"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/cytology/{id}"),
 	}
 
 	// Run stopwatch.
@@ -1235,7 +1456,7 @@ func (c *Client) sendCytologySegmentUpdateDelete(ctx context.Context, params Cyt
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentUpdateDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologyReadOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1253,7 +1474,7 @@ func (c *Client) sendCytologySegmentUpdateDelete(ctx context.Context, params Cyt
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/cytology/segment/update/"
+	pathParts[0] = "/cytology/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1262,7 +1483,7 @@ func (c *Client) sendCytologySegmentUpdateDelete(ctx context.Context, params Cyt
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
+			return e.EncodeValue(conv.StringToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1275,7 +1496,7 @@ func (c *Client) sendCytologySegmentUpdateDelete(ctx context.Context, params Cyt
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1285,7 +1506,7 @@ func (c *Client) sendCytologySegmentUpdateDelete(ctx context.Context, params Cyt
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CytologySegmentUpdateDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CytologyReadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1321,7 +1542,7 @@ func (c *Client) sendCytologySegmentUpdateDelete(ctx context.Context, params Cyt
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCytologySegmentUpdateDeleteResponse(resp)
+	result, err := decodeCytologyReadResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1329,17 +1550,17 @@ func (c *Client) sendCytologySegmentUpdateDelete(ctx context.Context, params Cyt
 	return result, nil
 }
 
-// CytologySegmentUpdatePartialUpdate invokes CytologySegmentUpdatePartialUpdate operation.
+// CytologySegmentGroupCreateCreate invokes CytologySegmentGroupCreateCreate operation.
 //
-// Обновить сегментацию.
+// Создать группу сегментаций.
 //
-// PATCH /cytology/segment/update/{id}
-func (c *Client) CytologySegmentUpdatePartialUpdate(ctx context.Context, request *CytologySegmentUpdatePartialUpdateReq, params CytologySegmentUpdatePartialUpdateParams) (CytologySegmentUpdatePartialUpdateRes, error) {
-	res, err := c.sendCytologySegmentUpdatePartialUpdate(ctx, request, params)
+// POST /cytology/segment/group/create/{cytology_img_id}
+func (c *Client) CytologySegmentGroupCreateCreate(ctx context.Context, request *CytologySegmentGroupCreateCreateReq, params CytologySegmentGroupCreateCreateParams) (CytologySegmentGroupCreateCreateRes, error) {
+	res, err := c.sendCytologySegmentGroupCreateCreate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCytologySegmentUpdatePartialUpdate(ctx context.Context, request *CytologySegmentUpdatePartialUpdateReq, params CytologySegmentUpdatePartialUpdateParams) (res CytologySegmentUpdatePartialUpdateRes, err error) {
+func (c *Client) sendCytologySegmentGroupCreateCreate(ctx context.Context, request *CytologySegmentGroupCreateCreateReq, params CytologySegmentGroupCreateCreateParams) (res CytologySegmentGroupCreateCreateRes, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -1350,9 +1571,9 @@ func (c *Client) sendCytologySegmentUpdatePartialUpdate(ctx context.Context, req
 		return res, errors.Wrap(err, "validate")
 	}
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentUpdatePartialUpdate"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
+		otelogen.OperationID("CytologySegmentGroupCreateCreate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/cytology/segment/group/create/{cytology_img_id}"),
 	}
 
 	// Run stopwatch.
@@ -1367,7 +1588,7 @@ func (c *Client) sendCytologySegmentUpdatePartialUpdate(ctx context.Context, req
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentUpdatePartialUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentGroupCreateCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1385,16 +1606,16 @@ func (c *Client) sendCytologySegmentUpdatePartialUpdate(ctx context.Context, req
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/cytology/segment/update/"
+	pathParts[0] = "/cytology/segment/group/create/"
 	{
-		// Encode "id" parameter.
+		// Encode "cytology_img_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
+			Param:   "cytology_img_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
+			return e.EncodeValue(conv.UUIDToString(params.CytologyImgID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1407,11 +1628,11 @@ func (c *Client) sendCytologySegmentUpdatePartialUpdate(ctx context.Context, req
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCytologySegmentUpdatePartialUpdateRequest(request, r); err != nil {
+	if err := encodeCytologySegmentGroupCreateCreateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1420,7 +1641,7 @@ func (c *Client) sendCytologySegmentUpdatePartialUpdate(ctx context.Context, req
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CytologySegmentUpdatePartialUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CytologySegmentGroupCreateCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1456,7 +1677,7 @@ func (c *Client) sendCytologySegmentUpdatePartialUpdate(ctx context.Context, req
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCytologySegmentUpdatePartialUpdateResponse(resp)
+	result, err := decodeCytologySegmentGroupCreateCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1464,20 +1685,20 @@ func (c *Client) sendCytologySegmentUpdatePartialUpdate(ctx context.Context, req
 	return result, nil
 }
 
-// CytologySegmentUpdateRead invokes CytologySegmentUpdateRead operation.
+// CytologySegmentUpdateDelete invokes CytologySegmentUpdateDelete operation.
 //
-// Получить сегментацию.
+// Удалить сегментацию.
 //
-// GET /cytology/segment/update/{id}
-func (c *Client) CytologySegmentUpdateRead(ctx context.Context, params CytologySegmentUpdateReadParams) (CytologySegmentUpdateReadRes, error) {
-	res, err := c.sendCytologySegmentUpdateRead(ctx, params)
+// DELETE /cytology/segment/update/{id}
+func (c *Client) CytologySegmentUpdateDelete(ctx context.Context, params CytologySegmentUpdateDeleteParams) (CytologySegmentUpdateDeleteRes, error) {
+	res, err := c.sendCytologySegmentUpdateDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCytologySegmentUpdateRead(ctx context.Context, params CytologySegmentUpdateReadParams) (res CytologySegmentUpdateReadRes, err error) {
+func (c *Client) sendCytologySegmentUpdateDelete(ctx context.Context, params CytologySegmentUpdateDeleteParams) (res CytologySegmentUpdateDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentUpdateRead"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("CytologySegmentUpdateDelete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
 		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
 	}
 
@@ -1493,7 +1714,7 @@ func (c *Client) sendCytologySegmentUpdateRead(ctx context.Context, params Cytol
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentUpdateReadOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentUpdateDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1533,7 +1754,7 @@ func (c *Client) sendCytologySegmentUpdateRead(ctx context.Context, params Cytol
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1543,7 +1764,7 @@ func (c *Client) sendCytologySegmentUpdateRead(ctx context.Context, params Cytol
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CytologySegmentUpdateReadOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CytologySegmentUpdateDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1579,7 +1800,7 @@ func (c *Client) sendCytologySegmentUpdateRead(ctx context.Context, params Cytol
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCytologySegmentUpdateReadResponse(resp)
+	result, err := decodeCytologySegmentUpdateDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1587,17 +1808,17 @@ func (c *Client) sendCytologySegmentUpdateRead(ctx context.Context, params Cytol
 	return result, nil
 }
 
-// CytologySegmentUpdateUpdate invokes CytologySegmentUpdateUpdate operation.
+// CytologySegmentUpdatePartialUpdate invokes CytologySegmentUpdatePartialUpdate operation.
 //
 // Обновить сегментацию.
 //
-// PUT /cytology/segment/update/{id}
-func (c *Client) CytologySegmentUpdateUpdate(ctx context.Context, request *CytologySegmentUpdateUpdateReq, params CytologySegmentUpdateUpdateParams) (CytologySegmentUpdateUpdateRes, error) {
-	res, err := c.sendCytologySegmentUpdateUpdate(ctx, request, params)
+// PATCH /cytology/segment/update/{id}
+func (c *Client) CytologySegmentUpdatePartialUpdate(ctx context.Context, request *CytologySegmentUpdatePartialUpdateReq, params CytologySegmentUpdatePartialUpdateParams) (CytologySegmentUpdatePartialUpdateRes, error) {
+	res, err := c.sendCytologySegmentUpdatePartialUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCytologySegmentUpdateUpdate(ctx context.Context, request *CytologySegmentUpdateUpdateReq, params CytologySegmentUpdateUpdateParams) (res CytologySegmentUpdateUpdateRes, err error) {
+func (c *Client) sendCytologySegmentUpdatePartialUpdate(ctx context.Context, request *CytologySegmentUpdatePartialUpdateReq, params CytologySegmentUpdatePartialUpdateParams) (res CytologySegmentUpdatePartialUpdateRes, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -1608,8 +1829,8 @@ func (c *Client) sendCytologySegmentUpdateUpdate(ctx context.Context, request *C
 		return res, errors.Wrap(err, "validate")
 	}
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentUpdateUpdate"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
+		otelogen.OperationID("CytologySegmentUpdatePartialUpdate"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
 		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
 	}
 
@@ -1625,7 +1846,7 @@ func (c *Client) sendCytologySegmentUpdateUpdate(ctx context.Context, request *C
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentUpdateUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentUpdatePartialUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1665,11 +1886,11 @@ func (c *Client) sendCytologySegmentUpdateUpdate(ctx context.Context, request *C
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCytologySegmentUpdateUpdateRequest(request, r); err != nil {
+	if err := encodeCytologySegmentUpdatePartialUpdateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1678,7 +1899,7 @@ func (c *Client) sendCytologySegmentUpdateUpdate(ctx context.Context, request *C
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CytologySegmentUpdateUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CytologySegmentUpdatePartialUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1714,7 +1935,7 @@ func (c *Client) sendCytologySegmentUpdateUpdate(ctx context.Context, request *C
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCytologySegmentUpdateUpdateResponse(resp)
+	result, err := decodeCytologySegmentUpdatePartialUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1722,21 +1943,21 @@ func (c *Client) sendCytologySegmentUpdateUpdate(ctx context.Context, request *C
 	return result, nil
 }
 
-// CytologySegmentsList invokes CytologySegmentsList operation.
+// CytologySegmentUpdateRead invokes CytologySegmentUpdateRead operation.
 //
-// Информация об одной группе снимков.
+// Получить сегментацию.
 //
-// GET /cytology/{id}/segments
-func (c *Client) CytologySegmentsList(ctx context.Context, params CytologySegmentsListParams) (CytologySegmentsListRes, error) {
-	res, err := c.sendCytologySegmentsList(ctx, params)
+// GET /cytology/segment/update/{id}
+func (c *Client) CytologySegmentUpdateRead(ctx context.Context, params CytologySegmentUpdateReadParams) (CytologySegmentUpdateReadRes, error) {
+	res, err := c.sendCytologySegmentUpdateRead(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySegmentsListParams) (res CytologySegmentsListRes, err error) {
+func (c *Client) sendCytologySegmentUpdateRead(ctx context.Context, params CytologySegmentUpdateReadParams) (res CytologySegmentUpdateReadRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentsList"),
+		otelogen.OperationID("CytologySegmentUpdateRead"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/cytology/{id}/segments"),
+		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
 	}
 
 	// Run stopwatch.
@@ -1751,7 +1972,7 @@ func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentsListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentUpdateReadOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1768,8 +1989,8 @@ func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/cytology/"
+	var pathParts [2]string
+	pathParts[0] = "/cytology/segment/update/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1778,7 +1999,7 @@ func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySe
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ID))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1788,13 +2009,271 @@ func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySe
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/segments"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
 	{
-		// Encode "is_ai" parameter.
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CytologySegmentUpdateReadOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCytologySegmentUpdateReadResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CytologySegmentUpdateUpdate invokes CytologySegmentUpdateUpdate operation.
+//
+// Обновить сегментацию.
+//
+// PUT /cytology/segment/update/{id}
+func (c *Client) CytologySegmentUpdateUpdate(ctx context.Context, request *CytologySegmentUpdateUpdateReq, params CytologySegmentUpdateUpdateParams) (CytologySegmentUpdateUpdateRes, error) {
+	res, err := c.sendCytologySegmentUpdateUpdate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendCytologySegmentUpdateUpdate(ctx context.Context, request *CytologySegmentUpdateUpdateReq, params CytologySegmentUpdateUpdateParams) (res CytologySegmentUpdateUpdateRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("CytologySegmentUpdateUpdate"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentUpdateUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/cytology/segment/update/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCytologySegmentUpdateUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CytologySegmentUpdateUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCytologySegmentUpdateUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CytologySegmentsList invokes CytologySegmentsList operation.
+//
+// Информация об одной группе снимков.
+//
+// GET /cytology/{id}/segments
+func (c *Client) CytologySegmentsList(ctx context.Context, params CytologySegmentsListParams) (CytologySegmentsListRes, error) {
+	res, err := c.sendCytologySegmentsList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySegmentsListParams) (res CytologySegmentsListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("CytologySegmentsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/segments"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/cytology/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/segments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "is_ai" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
 			Name:    "is_ai",
 			Style:   uri.QueryStyleForm,
@@ -1878,10 +2357,3542 @@ func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySe
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	u.RawQuery = q.Values().Encode()
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CytologySegmentsListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCytologySegmentsListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CytologySegmentsViewport invokes CytologySegmentsViewport operation.
+//
+// Сегментации исследования, ограничивающий
+// прямоугольник которых пересекается с областью в
+// пикселях нулевого уровня. Сегментации отсортированы
+// по id, для следующей страницы передайте next_cursor из
+// предыдущего ответа. При downsample больше 1 контуры
+// упрощаются с допуском downsample пикселей.
+//
+// GET /cytology/{id}/segments/viewport
+func (c *Client) CytologySegmentsViewport(ctx context.Context, params CytologySegmentsViewportParams) (CytologySegmentsViewportRes, error) {
+	res, err := c.sendCytologySegmentsViewport(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendCytologySegmentsViewport(ctx context.Context, params CytologySegmentsViewportParams) (res CytologySegmentsViewportRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("CytologySegmentsViewport"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/segments/viewport"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologySegmentsViewportOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/cytology/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/segments/viewport"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "min_x" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "min_x",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.MinX))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "min_y" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "min_y",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.MinY))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "max_x" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "max_x",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.MaxX))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "max_y" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "max_y",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.MaxY))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "seg_type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "seg_type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SegType.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "group_type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "group_type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.GroupType.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "is_ai" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "is_ai",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IsAi.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "downsample" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "downsample",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Downsample.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CytologySegmentsViewportOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCytologySegmentsViewportResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CytologyUpdatePartialUpdate invokes CytologyUpdatePartialUpdate operation.
+//
+// Обновление всей страницы с информацией о приеме.
+//
+// PATCH /cytology/{id}/update
+func (c *Client) CytologyUpdatePartialUpdate(ctx context.Context, request *CytologyUpdatePartialUpdateReq, params CytologyUpdatePartialUpdateParams) (CytologyUpdatePartialUpdateRes, error) {
+	res, err := c.sendCytologyUpdatePartialUpdate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendCytologyUpdatePartialUpdate(ctx context.Context, request *CytologyUpdatePartialUpdateReq, params CytologyUpdatePartialUpdateParams) (res CytologyUpdatePartialUpdateRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("CytologyUpdatePartialUpdate"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/update"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologyUpdatePartialUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/cytology/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/update"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCytologyUpdatePartialUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CytologyUpdatePartialUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCytologyUpdatePartialUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CytologyUpdateUpdate invokes CytologyUpdateUpdate operation.
+//
+// Обновление всей страницы с информацией о приеме.
+//
+// PUT /cytology/{id}/update
+func (c *Client) CytologyUpdateUpdate(ctx context.Context, request *CytologyUpdateUpdateReq, params CytologyUpdateUpdateParams) (CytologyUpdateUpdateRes, error) {
+	res, err := c.sendCytologyUpdateUpdate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendCytologyUpdateUpdate(ctx context.Context, request *CytologyUpdateUpdateReq, params CytologyUpdateUpdateParams) (res CytologyUpdateUpdateRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("CytologyUpdateUpdate"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/update"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CytologyUpdateUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/cytology/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/update"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCytologyUpdateUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CytologyUpdateUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCytologyUpdateUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DownloadCytologyCytologyIDOriginalImageIDGet invokes GET /download/cytology/{cytology_id}/{original_image_id} operation.
+//
+// Получить оригинальное изображение цитологического
+// исследования.
+//
+// GET /download/cytology/{cytology_id}/{original_image_id}
+func (c *Client) DownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Context, params DownloadCytologyCytologyIDOriginalImageIDGetParams) (DownloadCytologyCytologyIDOriginalImageIDGetRes, error) {
+	res, err := c.sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Context, params DownloadCytologyCytologyIDOriginalImageIDGetParams) (res DownloadCytologyCytologyIDOriginalImageIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/download/cytology/{cytology_id}/{original_image_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DownloadCytologyCytologyIDOriginalImageIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/download/cytology/"
+	{
+		// Encode "cytology_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "cytology_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CytologyID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "original_image_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "original_image_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.OriginalImageID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DownloadCytologyCytologyIDOriginalImageIDGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDownloadCytologyCytologyIDOriginalImageIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DownloadUziIDImageIDGet invokes GET /download/{uzi_id}/{image_id} operation.
+//
+// Получить кадр узи.
+//
+// GET /download/{uzi_id}/{image_id}
+func (c *Client) DownloadUziIDImageIDGet(ctx context.Context, params DownloadUziIDImageIDGetParams) (DownloadUziIDImageIDGetRes, error) {
+	res, err := c.sendDownloadUziIDImageIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDownloadUziIDImageIDGet(ctx context.Context, params DownloadUziIDImageIDGetParams) (res DownloadUziIDImageIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/download/{uzi_id}/{image_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DownloadUziIDImageIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/download/"
+	{
+		// Encode "uzi_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uzi_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UziID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "image_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "image_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ImageID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Size.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DownloadUziIDImageIDGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDownloadUziIDImageIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DownloadUziUziIDReportGet invokes GET /download/uzi/{uzi_id}/report operation.
+//
+// Скачать заключение по узи.
+//
+// GET /download/uzi/{uzi_id}/report
+func (c *Client) DownloadUziUziIDReportGet(ctx context.Context, params DownloadUziUziIDReportGetParams) (DownloadUziUziIDReportGetRes, error) {
+	res, err := c.sendDownloadUziUziIDReportGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDownloadUziUziIDReportGet(ctx context.Context, params DownloadUziUziIDReportGetParams) (res DownloadUziUziIDReportGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/download/uzi/{uzi_id}/report"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DownloadUziUziIDReportGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/download/uzi/"
+	{
+		// Encode "uzi_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uzi_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UziID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/report"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "version" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "version",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Version.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "format" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Format.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DownloadUziUziIDReportGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDownloadUziUziIDReportGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LoginPost invokes POST /login operation.
+//
+// Авторизация.
+//
+// POST /login
+func (c *Client) LoginPost(ctx context.Context, request *LoginPostReq) (LoginPostRes, error) {
+	res, err := c.sendLoginPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendLoginPost(ctx context.Context, request *LoginPostReq) (res LoginPostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/login"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, LoginPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLoginPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLoginPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MedCardDoctorIDPatientIDGet invokes GET /med/card/{doctor_id}/{patient_id} operation.
+//
+// Получить карту пациента.
+//
+// GET /med/card/{doctor_id}/{patient_id}
+func (c *Client) MedCardDoctorIDPatientIDGet(ctx context.Context, params MedCardDoctorIDPatientIDGetParams) (MedCardDoctorIDPatientIDGetRes, error) {
+	res, err := c.sendMedCardDoctorIDPatientIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendMedCardDoctorIDPatientIDGet(ctx context.Context, params MedCardDoctorIDPatientIDGetParams) (res MedCardDoctorIDPatientIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/med/card/{doctor_id}/{patient_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MedCardDoctorIDPatientIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/med/card/"
+	{
+		// Encode "doctor_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "doctor_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.DoctorID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "patient_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "patient_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PatientID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MedCardDoctorIDPatientIDGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMedCardDoctorIDPatientIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MedCardDoctorIDPatientIDPatch invokes PATCH /med/card/{doctor_id}/{patient_id} operation.
+//
+// Обновить карту пациента.
+//
+// PATCH /med/card/{doctor_id}/{patient_id}
+func (c *Client) MedCardDoctorIDPatientIDPatch(ctx context.Context, request *MedCardDoctorIDPatientIDPatchReq, params MedCardDoctorIDPatientIDPatchParams) (MedCardDoctorIDPatientIDPatchRes, error) {
+	res, err := c.sendMedCardDoctorIDPatientIDPatch(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendMedCardDoctorIDPatientIDPatch(ctx context.Context, request *MedCardDoctorIDPatientIDPatchReq, params MedCardDoctorIDPatientIDPatchParams) (res MedCardDoctorIDPatientIDPatchRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/med/card/{doctor_id}/{patient_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MedCardDoctorIDPatientIDPatchOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/med/card/"
+	{
+		// Encode "doctor_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "doctor_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.DoctorID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "patient_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "patient_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PatientID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeMedCardDoctorIDPatientIDPatchRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MedCardDoctorIDPatientIDPatchOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMedCardDoctorIDPatientIDPatchResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MedCardPost invokes POST /med/card operation.
+//
+// Создать карту пациента.
+//
+// POST /med/card
+func (c *Client) MedCardPost(ctx context.Context, request *Card) (MedCardPostRes, error) {
+	res, err := c.sendMedCardPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendMedCardPost(ctx context.Context, request *Card) (res MedCardPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/med/card"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MedCardPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/med/card"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeMedCardPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MedCardPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMedCardPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MedDoctorIDGet invokes GET /med/doctor/{id} operation.
+//
+// Получить врача.
+//
+// GET /med/doctor/{id}
+func (c *Client) MedDoctorIDGet(ctx context.Context, params MedDoctorIDGetParams) (MedDoctorIDGetRes, error) {
+	res, err := c.sendMedDoctorIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendMedDoctorIDGet(ctx context.Context, params MedDoctorIDGetParams) (res MedDoctorIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/med/doctor/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MedDoctorIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/med/doctor/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MedDoctorIDGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMedDoctorIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MedDoctorIDPatientsGet invokes GET /med/doctor/{id}/patients operation.
+//
+// Получить пациентов врача.
+//
+// GET /med/doctor/{id}/patients
+func (c *Client) MedDoctorIDPatientsGet(ctx context.Context, params MedDoctorIDPatientsGetParams) (MedDoctorIDPatientsGetRes, error) {
+	res, err := c.sendMedDoctorIDPatientsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendMedDoctorIDPatientsGet(ctx context.Context, params MedDoctorIDPatientsGetParams) (res MedDoctorIDPatientsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/med/doctor/{id}/patients"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MedDoctorIDPatientsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/med/doctor/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/patients"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MedDoctorIDPatientsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMedDoctorIDPatientsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MedPatientIDGet invokes GET /med/patient/{id} operation.
+//
+// Получить пациента.
+//
+// GET /med/patient/{id}
+func (c *Client) MedPatientIDGet(ctx context.Context, params MedPatientIDGetParams) (MedPatientIDGetRes, error) {
+	res, err := c.sendMedPatientIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendMedPatientIDGet(ctx context.Context, params MedPatientIDGetParams) (res MedPatientIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/med/patient/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MedPatientIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/med/patient/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MedPatientIDGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMedPatientIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MedPatientIDPatch invokes PATCH /med/patient/{id} operation.
+//
+// Обновить пациента.
+//
+// PATCH /med/patient/{id}
+func (c *Client) MedPatientIDPatch(ctx context.Context, request *MedPatientIDPatchReq, params MedPatientIDPatchParams) (MedPatientIDPatchRes, error) {
+	res, err := c.sendMedPatientIDPatch(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendMedPatientIDPatch(ctx context.Context, request *MedPatientIDPatchReq, params MedPatientIDPatchParams) (res MedPatientIDPatchRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/med/patient/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MedPatientIDPatchOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/med/patient/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeMedPatientIDPatchRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MedPatientIDPatchOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMedPatientIDPatchResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MedPatientPost invokes POST /med/patient operation.
+//
+// Зарегистрировать пациента.
+//
+// POST /med/patient
+func (c *Client) MedPatientPost(ctx context.Context, request *MedPatientPostReq) (MedPatientPostRes, error) {
+	res, err := c.sendMedPatientPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendMedPatientPost(ctx context.Context, request *MedPatientPostReq) (res MedPatientPostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/med/patient"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MedPatientPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/med/patient"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeMedPatientPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MedPatientPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMedPatientPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PaymentProvidersGet invokes GET /payment_providers operation.
+//
+// Получить список всех платежных провайдеров.
+//
+// GET /payment_providers
+func (c *Client) PaymentProvidersGet(ctx context.Context) (PaymentProvidersGetRes, error) {
+	res, err := c.sendPaymentProvidersGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendPaymentProvidersGet(ctx context.Context) (res PaymentProvidersGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/payment_providers"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PaymentProvidersGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/payment_providers"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PaymentProvidersGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePaymentProvidersGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RefreshPost invokes POST /refresh operation.
+//
+// Обновить access token.
+//
+// POST /refresh
+func (c *Client) RefreshPost(ctx context.Context, request *RefreshPostReq) (RefreshPostRes, error) {
+	res, err := c.sendRefreshPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRefreshPost(ctx context.Context, request *RefreshPostReq) (res RefreshPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/refresh"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RefreshPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/refresh"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRefreshPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRefreshPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RegDoctorPost invokes POST /reg/doctor operation.
+//
+// Зарегистрировать врача (как пользователя).
+//
+// POST /reg/doctor
+func (c *Client) RegDoctorPost(ctx context.Context, request *RegDoctorPostReq) (RegDoctorPostRes, error) {
+	res, err := c.sendRegDoctorPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRegDoctorPost(ctx context.Context, request *RegDoctorPostReq) (res RegDoctorPostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/reg/doctor"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RegDoctorPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/reg/doctor"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRegDoctorPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRegDoctorPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RegPatientPost invokes POST /reg/patient operation.
+//
+// Зарегистрировать пациента (как пользователя).
+// **Сделано под мобилки api**. Врачам регистрировать
+// пациентов через /med/patient.
+//
+// POST /reg/patient
+func (c *Client) RegPatientPost(ctx context.Context, request *RegPatientPostReq) (RegPatientPostRes, error) {
+	res, err := c.sendRegPatientPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRegPatientPost(ctx context.Context, request *RegPatientPostReq) (res RegPatientPostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/reg/patient"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RegPatientPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/reg/patient"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRegPatientPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRegPatientPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SubscriptionsCheckActiveGet invokes GET /subscriptions/check-active operation.
+//
+// Проверить наличие активной подписки у текущего
+// пользователя.
+//
+// GET /subscriptions/check-active
+func (c *Client) SubscriptionsCheckActiveGet(ctx context.Context) (SubscriptionsCheckActiveGetRes, error) {
+	res, err := c.sendSubscriptionsCheckActiveGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendSubscriptionsCheckActiveGet(ctx context.Context) (res SubscriptionsCheckActiveGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/subscriptions/check-active"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SubscriptionsCheckActiveGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/subscriptions/check-active"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SubscriptionsCheckActiveGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSubscriptionsCheckActiveGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SubscriptionsGetActiveGet invokes GET /subscriptions/get-active operation.
+//
+// Получить информацию об активной подписке текущего
+// пользователя.
+//
+// GET /subscriptions/get-active
+func (c *Client) SubscriptionsGetActiveGet(ctx context.Context) (SubscriptionsGetActiveGetRes, error) {
+	res, err := c.sendSubscriptionsGetActiveGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendSubscriptionsGetActiveGet(ctx context.Context) (res SubscriptionsGetActiveGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/subscriptions/get-active"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SubscriptionsGetActiveGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/subscriptions/get-active"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SubscriptionsGetActiveGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSubscriptionsGetActiveGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SubscriptionsPurchasePost invokes POST /subscriptions/purchase operation.
+//
+// Купить подписку.
+//
+// POST /subscriptions/purchase
+func (c *Client) SubscriptionsPurchasePost(ctx context.Context, request *PurchaseSubscriptionRequest) (SubscriptionsPurchasePostRes, error) {
+	res, err := c.sendSubscriptionsPurchasePost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSubscriptionsPurchasePost(ctx context.Context, request *PurchaseSubscriptionRequest) (res SubscriptionsPurchasePostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/subscriptions/purchase"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SubscriptionsPurchasePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/subscriptions/purchase"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSubscriptionsPurchasePostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SubscriptionsPurchasePostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSubscriptionsPurchasePostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// TariffPlansGet invokes GET /tariff_plans operation.
+//
+// Получить список всех тарифных планов.
+//
+// GET /tariff_plans
+func (c *Client) TariffPlansGet(ctx context.Context) (TariffPlansGetRes, error) {
+	res, err := c.sendTariffPlansGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendTariffPlansGet(ctx context.Context) (res TariffPlansGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tariff_plans"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, TariffPlansGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/tariff_plans"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, TariffPlansGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeTariffPlansGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// TariffPlansIDGet invokes GET /tariff_plans/{id} operation.
+//
+// Получить тарифный план по ID.
+//
+// GET /tariff_plans/{id}
+func (c *Client) TariffPlansIDGet(ctx context.Context, params TariffPlansIDGetParams) (TariffPlansIDGetRes, error) {
+	res, err := c.sendTariffPlansIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendTariffPlansIDGet(ctx context.Context, params TariffPlansIDGetParams) (res TariffPlansIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tariff_plans/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, TariffPlansIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/tariff_plans/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, TariffPlansIDGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeTariffPlansIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// TilerDziFilePathFilesLevelColRowFormatGet invokes TilerDziFilePathFilesLevelColRowFormatGet operation.
+//
+// Возвращает конкретный тайл (плитку) изображения для
+// указанного уровня масштабирования,
+// колонки и строки. Запрос проксируется напрямую на
+// tiler_service.
+// Формат пути: `/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}`.
+//
+// GET /tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}
+func (c *Client) TilerDziFilePathFilesLevelColRowFormatGet(ctx context.Context, params TilerDziFilePathFilesLevelColRowFormatGetParams) (TilerDziFilePathFilesLevelColRowFormatGetRes, error) {
+	res, err := c.sendTilerDziFilePathFilesLevelColRowFormatGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendTilerDziFilePathFilesLevelColRowFormatGet(ctx context.Context, params TilerDziFilePathFilesLevelColRowFormatGetParams) (res TilerDziFilePathFilesLevelColRowFormatGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("TilerDziFilePathFilesLevelColRowFormatGet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, TilerDziFilePathFilesLevelColRowFormatGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [10]string
+	pathParts[0] = "/tiler/dzi/"
+	{
+		// Encode "file_path" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "file_path",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.FilePath))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/files/"
+	{
+		// Encode "level" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "level",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Level))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/"
+	{
+		// Encode "col" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "col",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Col))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	pathParts[6] = "_"
+	{
+		// Encode "row" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "row",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Row))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[7] = encoded
+	}
+	pathParts[8] = "."
+	{
+		// Encode "format" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "format",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(string(params.Format)))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[9] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeTilerDziFilePathFilesLevelColRowFormatGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// TilerDziFilePathGet invokes TilerDziFilePathGet operation.
+//
+// Возвращает XML метаданные в формате Deep Zoom Image (DZI) для
+// указанного изображения.
+// Путь к файлу должен быть URL-encoded. Запрос проксируется
+// напрямую на tiler_service.
+//
+// GET /tiler/dzi/{file_path}
+func (c *Client) TilerDziFilePathGet(ctx context.Context, params TilerDziFilePathGetParams) (TilerDziFilePathGetRes, error) {
+	res, err := c.sendTilerDziFilePathGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendTilerDziFilePathGet(ctx context.Context, params TilerDziFilePathGetParams) (res TilerDziFilePathGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("TilerDziFilePathGet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tiler/dzi/{file_path}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, TilerDziFilePathGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/tiler/dzi/"
+	{
+		// Encode "file_path" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "file_path",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.FilePath))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeTilerDziFilePathGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UploadsIDCompletePost invokes POST /uploads/{id}/complete operation.
+//
+// Собирает файл из частей и ставит его в обработку. sha256
+// сверяется и метаданные очищаются в фоне, пока
+// загрузка в статусе processing, ее нельзя передать в узи или
+// цитологию.
+//
+// POST /uploads/{id}/complete
+func (c *Client) UploadsIDCompletePost(ctx context.Context, params UploadsIDCompletePostParams) (UploadsIDCompletePostRes, error) {
+	res, err := c.sendUploadsIDCompletePost(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendUploadsIDCompletePost(ctx context.Context, params UploadsIDCompletePostParams) (res UploadsIDCompletePostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uploads/{id}/complete"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UploadsIDCompletePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/uploads/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/complete"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UploadsIDCompletePostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUploadsIDCompletePostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UploadsIDDelete invokes DELETE /uploads/{id} operation.
+//
+// Отменить загрузку.
+//
+// DELETE /uploads/{id}
+func (c *Client) UploadsIDDelete(ctx context.Context, params UploadsIDDeleteParams) (UploadsIDDeleteRes, error) {
+	res, err := c.sendUploadsIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendUploadsIDDelete(ctx context.Context, params UploadsIDDeleteParams) (res UploadsIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uploads/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UploadsIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/uploads/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1891,7 +5902,7 @@ func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CytologySegmentsListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UploadsIDDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1927,7 +5938,7 @@ func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCytologySegmentsListResponse(resp)
+	result, err := decodeUploadsIDDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1935,30 +5946,20 @@ func (c *Client) sendCytologySegmentsList(ctx context.Context, params CytologySe
 	return result, nil
 }
 
-// CytologyUpdatePartialUpdate invokes CytologyUpdatePartialUpdate operation.
+// UploadsIDGet invokes GET /uploads/{id} operation.
 //
-// Обновление всей страницы с информацией о приеме.
+// Получить состояние загрузки.
 //
-// PATCH /cytology/{id}/update
-func (c *Client) CytologyUpdatePartialUpdate(ctx context.Context, request *CytologyUpdatePartialUpdateReq, params CytologyUpdatePartialUpdateParams) (CytologyUpdatePartialUpdateRes, error) {
-	res, err := c.sendCytologyUpdatePartialUpdate(ctx, request, params)
+// GET /uploads/{id}
+func (c *Client) UploadsIDGet(ctx context.Context, params UploadsIDGetParams) (UploadsIDGetRes, error) {
+	res, err := c.sendUploadsIDGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCytologyUpdatePartialUpdate(ctx context.Context, request *CytologyUpdatePartialUpdateReq, params CytologyUpdatePartialUpdateParams) (res CytologyUpdatePartialUpdateRes, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendUploadsIDGet(ctx context.Context, params UploadsIDGetParams) (res UploadsIDGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologyUpdatePartialUpdate"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/cytology/{id}/update"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uploads/{id}"),
 	}
 
 	// Run stopwatch.
@@ -1973,7 +5974,7 @@ func (c *Client) sendCytologyUpdatePartialUpdate(ctx context.Context, request *C
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CytologyUpdatePartialUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UploadsIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1990,8 +5991,8 @@ func (c *Client) sendCytologyUpdatePartialUpdate(ctx context.Context, request *C
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/cytology/"
+	var pathParts [2]string
+	pathParts[0] = "/uploads/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -2000,7 +6001,7 @@ func (c *Client) sendCytologyUpdatePartialUpdate(ctx context.Context, request *C
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ID))
+			return e.EncodeValue(conv.UUIDToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2010,24 +6011,20 @@ func (c *Client) sendCytologyUpdatePartialUpdate(ctx context.Context, request *C
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/update"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCytologyUpdatePartialUpdateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CytologyUpdatePartialUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UploadsIDGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2063,7 +6060,7 @@ func (c *Client) sendCytologyUpdatePartialUpdate(ctx context.Context, request *C
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCytologyUpdatePartialUpdateResponse(resp)
+	result, err := decodeUploadsIDGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2071,30 +6068,20 @@ func (c *Client) sendCytologyUpdatePartialUpdate(ctx context.Context, request *C
 	return result, nil
 }
 
-// CytologyUpdateUpdate invokes CytologyUpdateUpdate operation.
+// UploadsIDPartsPost invokes POST /uploads/{id}/parts operation.
 //
-// Обновление всей страницы с информацией о приеме.
+// Получить url для загрузки частей.
 //
-// PUT /cytology/{id}/update
-func (c *Client) CytologyUpdateUpdate(ctx context.Context, request *CytologyUpdateUpdateReq, params CytologyUpdateUpdateParams) (CytologyUpdateUpdateRes, error) {
-	res, err := c.sendCytologyUpdateUpdate(ctx, request, params)
+// POST /uploads/{id}/parts
+func (c *Client) UploadsIDPartsPost(ctx context.Context, request *UploadsIDPartsPostReq, params UploadsIDPartsPostParams) (UploadsIDPartsPostRes, error) {
+	res, err := c.sendUploadsIDPartsPost(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCytologyUpdateUpdate(ctx context.Context, request *CytologyUpdateUpdateReq, params CytologyUpdateUpdateParams) (res CytologyUpdateUpdateRes, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendUploadsIDPartsPost(ctx context.Context, request *UploadsIDPartsPostReq, params UploadsIDPartsPostParams) (res UploadsIDPartsPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologyUpdateUpdate"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/cytology/{id}/update"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uploads/{id}/parts"),
 	}
 
 	// Run stopwatch.
@@ -2109,7 +6096,7 @@ func (c *Client) sendCytologyUpdateUpdate(ctx context.Context, request *Cytology
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CytologyUpdateUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UploadsIDPartsPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2127,7 +6114,7 @@ func (c *Client) sendCytologyUpdateUpdate(ctx context.Context, request *Cytology
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/cytology/"
+	pathParts[0] = "/uploads/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -2136,7 +6123,7 @@ func (c *Client) sendCytologyUpdateUpdate(ctx context.Context, request *Cytology
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ID))
+			return e.EncodeValue(conv.UUIDToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2146,15 +6133,15 @@ func (c *Client) sendCytologyUpdateUpdate(ctx context.Context, request *Cytology
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/update"
+	pathParts[2] = "/parts"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCytologyUpdateUpdateRequest(request, r); err != nil {
+	if err := encodeUploadsIDPartsPostRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -2163,7 +6150,7 @@ func (c *Client) sendCytologyUpdateUpdate(ctx context.Context, request *Cytology
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CytologyUpdateUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UploadsIDPartsPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2199,7 +6186,7 @@ func (c *Client) sendCytologyUpdateUpdate(ctx context.Context, request *Cytology
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCytologyUpdateUpdateResponse(resp)
+	result, err := decodeUploadsIDPartsPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2207,21 +6194,31 @@ func (c *Client) sendCytologyUpdateUpdate(ctx context.Context, request *Cytology
 	return result, nil
 }
 
-// DownloadCytologyCytologyIDOriginalImageIDGet invokes GET /download/cytology/{cytology_id}/{original_image_id} operation.
+// UploadsPost invokes POST /uploads operation.
 //
-// Получить оригинальное изображение цитологического
-// исследования.
+// Файл загружается напрямую в S3 частями по presigned url,
+// после завершения id загрузки передается в POST /uzi или
+// /cytology/create.
 //
-// GET /download/cytology/{cytology_id}/{original_image_id}
-func (c *Client) DownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Context, params DownloadCytologyCytologyIDOriginalImageIDGetParams) (DownloadCytologyCytologyIDOriginalImageIDGetRes, error) {
-	res, err := c.sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx, params)
+// POST /uploads
+func (c *Client) UploadsPost(ctx context.Context, request *UploadsPostReq) (UploadsPostRes, error) {
+	res, err := c.sendUploadsPost(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Context, params DownloadCytologyCytologyIDOriginalImageIDGetParams) (res DownloadCytologyCytologyIDOriginalImageIDGetRes, err error) {
+func (c *Client) sendUploadsPost(ctx context.Context, request *UploadsPostReq) (res UploadsPostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/download/cytology/{cytology_id}/{original_image_id}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uploads"),
 	}
 
 	// Run stopwatch.
@@ -2236,7 +6233,7 @@ func (c *Client) sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Co
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DownloadCytologyCytologyIDOriginalImageIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UploadsPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2253,36 +6250,124 @@ func (c *Client) sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Co
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/download/cytology/"
+	var pathParts [1]string
+	pathParts[0] = "/uploads"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUploadsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
 	{
-		// Encode "cytology_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "cytology_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.CytologyID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UploadsPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/"
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUploadsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UziDeviceIDDelete invokes DELETE /uzi/device/{id} operation.
+//
+// Аппарат, на который ссылаются узи, удалить нельзя.
+//
+// DELETE /uzi/device/{id}
+func (c *Client) UziDeviceIDDelete(ctx context.Context, params UziDeviceIDDeleteParams) (UziDeviceIDDeleteRes, error) {
+	res, err := c.sendUziDeviceIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendUziDeviceIDDelete(ctx context.Context, params UziDeviceIDDeleteParams) (res UziDeviceIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/device/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UziDeviceIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/uzi/device/"
 	{
-		// Encode "original_image_id" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "original_image_id",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.OriginalImageID))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2290,12 +6375,12 @@ func (c *Client) sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Co
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[3] = encoded
+		pathParts[1] = encoded
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2305,7 +6390,7 @@ func (c *Client) sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Co
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DownloadCytologyCytologyIDOriginalImageIDGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziDeviceIDDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2341,7 +6426,7 @@ func (c *Client) sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Co
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDownloadCytologyCytologyIDOriginalImageIDGetResponse(resp)
+	result, err := decodeUziDeviceIDDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2349,20 +6434,20 @@ func (c *Client) sendDownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Co
 	return result, nil
 }
 
-// DownloadUziIDImageIDGet invokes GET /download/{uzi_id}/{image_id} operation.
+// UziDeviceIDGet invokes GET /uzi/device/{id} operation.
 //
-// Получить кадр узи.
+// Получить uzi аппарат.
 //
-// GET /download/{uzi_id}/{image_id}
-func (c *Client) DownloadUziIDImageIDGet(ctx context.Context, params DownloadUziIDImageIDGetParams) (DownloadUziIDImageIDGetRes, error) {
-	res, err := c.sendDownloadUziIDImageIDGet(ctx, params)
+// GET /uzi/device/{id}
+func (c *Client) UziDeviceIDGet(ctx context.Context, params UziDeviceIDGetParams) (UziDeviceIDGetRes, error) {
+	res, err := c.sendUziDeviceIDGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDownloadUziIDImageIDGet(ctx context.Context, params DownloadUziIDImageIDGetParams) (res DownloadUziIDImageIDGetRes, err error) {
+func (c *Client) sendUziDeviceIDGet(ctx context.Context, params UziDeviceIDGetParams) (res UziDeviceIDGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/download/{uzi_id}/{image_id}"),
+		semconv.HTTPRouteKey.String("/uzi/device/{id}"),
 	}
 
 	// Run stopwatch.
@@ -2377,7 +6462,7 @@ func (c *Client) sendDownloadUziIDImageIDGet(ctx context.Context, params Downloa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DownloadUziIDImageIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziDeviceIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2394,36 +6479,17 @@ func (c *Client) sendDownloadUziIDImageIDGet(ctx context.Context, params Downloa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/download/"
-	{
-		// Encode "uzi_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uzi_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UziID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/"
+	var pathParts [2]string
+	pathParts[0] = "/uzi/device/"
 	{
-		// Encode "image_id" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "image_id",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.ImageID))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2431,7 +6497,7 @@ func (c *Client) sendDownloadUziIDImageIDGet(ctx context.Context, params Downloa
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[3] = encoded
+		pathParts[1] = encoded
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
@@ -2446,7 +6512,7 @@ func (c *Client) sendDownloadUziIDImageIDGet(ctx context.Context, params Downloa
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DownloadUziIDImageIDGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziDeviceIDGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2482,7 +6548,7 @@ func (c *Client) sendDownloadUziIDImageIDGet(ctx context.Context, params Downloa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDownloadUziIDImageIDGetResponse(resp)
+	result, err := decodeUziDeviceIDGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2490,17 +6556,17 @@ func (c *Client) sendDownloadUziIDImageIDGet(ctx context.Context, params Downloa
 	return result, nil
 }
 
-// LoginPost invokes POST /login operation.
+// UziDeviceIDPatch invokes PATCH /uzi/device/{id} operation.
 //
-// Авторизация.
+// Обновить uzi аппарат.
 //
-// POST /login
-func (c *Client) LoginPost(ctx context.Context, request *LoginPostReq) (LoginPostRes, error) {
-	res, err := c.sendLoginPost(ctx, request)
+// PATCH /uzi/device/{id}
+func (c *Client) UziDeviceIDPatch(ctx context.Context, request *UziDeviceIDPatchReq, params UziDeviceIDPatchParams) (UziDeviceIDPatchRes, error) {
+	res, err := c.sendUziDeviceIDPatch(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendLoginPost(ctx context.Context, request *LoginPostReq) (res LoginPostRes, err error) {
+func (c *Client) sendUziDeviceIDPatch(ctx context.Context, request *UziDeviceIDPatchReq, params UziDeviceIDPatchParams) (res UziDeviceIDPatchRes, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -2511,8 +6577,8 @@ func (c *Client) sendLoginPost(ctx context.Context, request *LoginPostReq) (res
 		return res, errors.Wrap(err, "validate")
 	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/login"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/uzi/device/{id}"),
 	}
 
 	// Run stopwatch.
@@ -2527,7 +6593,7 @@ func (c *Client) sendLoginPost(ctx context.Context, request *LoginPostReq) (res
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LoginPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziDeviceIDPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2544,19 +6610,70 @@ func (c *Client) sendLoginPost(ctx context.Context, request *LoginPostReq) (res
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/login"
+	var pathParts [2]string
+	pathParts[0] = "/uzi/device/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeLoginPostRequest(request, r); err != nil {
+	if err := encodeUziDeviceIDPatchRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UziDeviceIDPatchOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -2565,7 +6682,7 @@ func (c *Client) sendLoginPost(ctx context.Context, request *LoginPostReq) (res
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeLoginPostResponse(resp)
+	result, err := decodeUziDeviceIDPatchResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2573,20 +6690,29 @@ func (c *Client) sendLoginPost(ctx context.Context, request *LoginPostReq) (res
 	return result, nil
 }
 
-// MedCardDoctorIDPatientIDGet invokes GET /med/card/{doctor_id}/{patient_id} operation.
+// UziDevicePost invokes POST /uzi/device operation.
 //
-// Получить карту пациента.
+// Добавить uzi аппарат.
 //
-// GET /med/card/{doctor_id}/{patient_id}
-func (c *Client) MedCardDoctorIDPatientIDGet(ctx context.Context, params MedCardDoctorIDPatientIDGetParams) (MedCardDoctorIDPatientIDGetRes, error) {
-	res, err := c.sendMedCardDoctorIDPatientIDGet(ctx, params)
+// POST /uzi/device
+func (c *Client) UziDevicePost(ctx context.Context, request *UziDevicePostReq) (UziDevicePostRes, error) {
+	res, err := c.sendUziDevicePost(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendMedCardDoctorIDPatientIDGet(ctx context.Context, params MedCardDoctorIDPatientIDGetParams) (res MedCardDoctorIDPatientIDGetRes, err error) {
+func (c *Client) sendUziDevicePost(ctx context.Context, request *UziDevicePostReq) (res UziDevicePostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/med/card/{doctor_id}/{patient_id}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/device"),
 	}
 
 	// Run stopwatch.
@@ -2601,7 +6727,7 @@ func (c *Client) sendMedCardDoctorIDPatientIDGet(ctx context.Context, params Med
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MedCardDoctorIDPatientIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziDevicePostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2618,59 +6744,25 @@ func (c *Client) sendMedCardDoctorIDPatientIDGet(ctx context.Context, params Med
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/med/card/"
-	{
-		// Encode "doctor_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "doctor_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.DoctorID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/"
-	{
-		// Encode "patient_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "patient_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PatientID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/uzi/device"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUziDevicePostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MedCardDoctorIDPatientIDGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziDevicePostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2706,7 +6798,7 @@ func (c *Client) sendMedCardDoctorIDPatientIDGet(ctx context.Context, params Med
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMedCardDoctorIDPatientIDGetResponse(resp)
+	result, err := decodeUziDevicePostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2714,20 +6806,20 @@ func (c *Client) sendMedCardDoctorIDPatientIDGet(ctx context.Context, params Med
 	return result, nil
 }
 
-// MedCardDoctorIDPatientIDPatch invokes PATCH /med/card/{doctor_id}/{patient_id} operation.
+// UziDevicesGet invokes GET /uzi/devices operation.
 //
-// Обновить карту пациента.
+// Получит список uzi апппапапратов.
 //
-// PATCH /med/card/{doctor_id}/{patient_id}
-func (c *Client) MedCardDoctorIDPatientIDPatch(ctx context.Context, request *MedCardDoctorIDPatientIDPatchReq, params MedCardDoctorIDPatientIDPatchParams) (MedCardDoctorIDPatientIDPatchRes, error) {
-	res, err := c.sendMedCardDoctorIDPatientIDPatch(ctx, request, params)
+// GET /uzi/devices
+func (c *Client) UziDevicesGet(ctx context.Context) (UziDevicesGetRes, error) {
+	res, err := c.sendUziDevicesGet(ctx)
 	return res, err
 }
 
-func (c *Client) sendMedCardDoctorIDPatientIDPatch(ctx context.Context, request *MedCardDoctorIDPatientIDPatchReq, params MedCardDoctorIDPatientIDPatchParams) (res MedCardDoctorIDPatientIDPatchRes, err error) {
+func (c *Client) sendUziDevicesGet(ctx context.Context) (res UziDevicesGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/med/card/{doctor_id}/{patient_id}"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/devices"),
 	}
 
 	// Run stopwatch.
@@ -2742,7 +6834,7 @@ func (c *Client) sendMedCardDoctorIDPatientIDPatch(ctx context.Context, request
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MedCardDoctorIDPatientIDPatchOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziDevicesGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2759,62 +6851,22 @@ func (c *Client) sendMedCardDoctorIDPatientIDPatch(ctx context.Context, request
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/med/card/"
-	{
-		// Encode "doctor_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "doctor_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.DoctorID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/"
-	{
-		// Encode "patient_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "patient_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PatientID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/uzi/devices"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMedCardDoctorIDPatientIDPatchRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MedCardDoctorIDPatientIDPatchOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziDevicesGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2850,7 +6902,7 @@ func (c *Client) sendMedCardDoctorIDPatientIDPatch(ctx context.Context, request
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMedCardDoctorIDPatientIDPatchResponse(resp)
+	result, err := decodeUziDevicesGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2858,20 +6910,38 @@ func (c *Client) sendMedCardDoctorIDPatientIDPatch(ctx context.Context, request
 	return result, nil
 }
 
-// MedCardPost invokes POST /med/card operation.
+// UziIDCheckPost invokes POST /uzi/{id}/check operation.
 //
-// Создать карту пациента.
+// Узи получает checked, при заданном unvalidated узлам нейросети
+// без решения врача проставляется эта валидация.
+// Выполняется в одной транзакции.
 //
-// POST /med/card
-func (c *Client) MedCardPost(ctx context.Context, request *Card) (MedCardPostRes, error) {
-	res, err := c.sendMedCardPost(ctx, request)
+// POST /uzi/{id}/check
+func (c *Client) UziIDCheckPost(ctx context.Context, request OptUziIDCheckPostReq, params UziIDCheckPostParams) (UziIDCheckPostRes, error) {
+	res, err := c.sendUziIDCheckPost(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendMedCardPost(ctx context.Context, request *Card) (res MedCardPostRes, err error) {
+func (c *Client) sendUziIDCheckPost(ctx context.Context, request OptUziIDCheckPostReq, params UziIDCheckPostParams) (res UziIDCheckPostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if value, ok := request.Get(); ok {
+			if err := func() error {
+				if err := value.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/med/card"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/check"),
 	}
 
 	// Run stopwatch.
@@ -2886,7 +6956,7 @@ func (c *Client) sendMedCardPost(ctx context.Context, request *Card) (res MedCar
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MedCardPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDCheckPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2903,8 +6973,27 @@ func (c *Client) sendMedCardPost(ctx context.Context, request *Card) (res MedCar
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/med/card"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/check"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2912,7 +7001,7 @@ func (c *Client) sendMedCardPost(ctx context.Context, request *Card) (res MedCar
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMedCardPostRequest(request, r); err != nil {
+	if err := encodeUziIDCheckPostRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -2921,7 +7010,7 @@ func (c *Client) sendMedCardPost(ctx context.Context, request *Card) (res MedCar
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MedCardPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziIDCheckPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2957,7 +7046,7 @@ func (c *Client) sendMedCardPost(ctx context.Context, request *Card) (res MedCar
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMedCardPostResponse(resp)
+	result, err := decodeUziIDCheckPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2965,20 +7054,20 @@ func (c *Client) sendMedCardPost(ctx context.Context, request *Card) (res MedCar
 	return result, nil
 }
 
-// MedDoctorIDGet invokes GET /med/doctor/{id} operation.
+// UziIDDelete invokes DELETE /uzi/{id} operation.
 //
-// Получить врача.
+// Удалить узи.
 //
-// GET /med/doctor/{id}
-func (c *Client) MedDoctorIDGet(ctx context.Context, params MedDoctorIDGetParams) (MedDoctorIDGetRes, error) {
-	res, err := c.sendMedDoctorIDGet(ctx, params)
+// DELETE /uzi/{id}
+func (c *Client) UziIDDelete(ctx context.Context, params UziIDDeleteParams) (UziIDDeleteRes, error) {
+	res, err := c.sendUziIDDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendMedDoctorIDGet(ctx context.Context, params MedDoctorIDGetParams) (res MedDoctorIDGetRes, err error) {
+func (c *Client) sendUziIDDelete(ctx context.Context, params UziIDDeleteParams) (res UziIDDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/med/doctor/{id}"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/{id}"),
 	}
 
 	// Run stopwatch.
@@ -2993,7 +7082,7 @@ func (c *Client) sendMedDoctorIDGet(ctx context.Context, params MedDoctorIDGetPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MedDoctorIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3011,7 +7100,7 @@ func (c *Client) sendMedDoctorIDGet(ctx context.Context, params MedDoctorIDGetPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/med/doctor/"
+	pathParts[0] = "/uzi/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3033,7 +7122,7 @@ func (c *Client) sendMedDoctorIDGet(ctx context.Context, params MedDoctorIDGetPa
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3043,7 +7132,7 @@ func (c *Client) sendMedDoctorIDGet(ctx context.Context, params MedDoctorIDGetPa
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MedDoctorIDGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziIDDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3079,7 +7168,7 @@ func (c *Client) sendMedDoctorIDGet(ctx context.Context, params MedDoctorIDGetPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMedDoctorIDGetResponse(resp)
+	result, err := decodeUziIDDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3087,20 +7176,20 @@ func (c *Client) sendMedDoctorIDGet(ctx context.Context, params MedDoctorIDGetPa
 	return result, nil
 }
 
-// MedDoctorIDPatientsGet invokes GET /med/doctor/{id}/patients operation.
+// UziIDEchographicsGet invokes GET /uzi/{id}/echographics operation.
 //
-// Получить пациентов врача.
+// Получить эхографику uzi.
 //
-// GET /med/doctor/{id}/patients
-func (c *Client) MedDoctorIDPatientsGet(ctx context.Context, params MedDoctorIDPatientsGetParams) (MedDoctorIDPatientsGetRes, error) {
-	res, err := c.sendMedDoctorIDPatientsGet(ctx, params)
+// GET /uzi/{id}/echographics
+func (c *Client) UziIDEchographicsGet(ctx context.Context, params UziIDEchographicsGetParams) (UziIDEchographicsGetRes, error) {
+	res, err := c.sendUziIDEchographicsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendMedDoctorIDPatientsGet(ctx context.Context, params MedDoctorIDPatientsGetParams) (res MedDoctorIDPatientsGetRes, err error) {
+func (c *Client) sendUziIDEchographicsGet(ctx context.Context, params UziIDEchographicsGetParams) (res UziIDEchographicsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/med/doctor/{id}/patients"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/echographics"),
 	}
 
 	// Run stopwatch.
@@ -3115,7 +7204,7 @@ func (c *Client) sendMedDoctorIDPatientsGet(ctx context.Context, params MedDocto
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MedDoctorIDPatientsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDEchographicsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3133,7 +7222,7 @@ func (c *Client) sendMedDoctorIDPatientsGet(ctx context.Context, params MedDocto
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/med/doctor/"
+	pathParts[0] = "/uzi/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3152,30 +7241,9 @@ func (c *Client) sendMedDoctorIDPatientsGet(ctx context.Context, params MedDocto
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/patients"
+	pathParts[2] = "/echographics"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "status" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Status.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -3187,7 +7255,7 @@ func (c *Client) sendMedDoctorIDPatientsGet(ctx context.Context, params MedDocto
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MedDoctorIDPatientsGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziIDEchographicsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3223,7 +7291,7 @@ func (c *Client) sendMedDoctorIDPatientsGet(ctx context.Context, params MedDocto
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMedDoctorIDPatientsGetResponse(resp)
+	result, err := decodeUziIDEchographicsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3231,20 +7299,29 @@ func (c *Client) sendMedDoctorIDPatientsGet(ctx context.Context, params MedDocto
 	return result, nil
 }
 
-// MedPatientIDGet invokes GET /med/patient/{id} operation.
+// UziIDEchographicsPatch invokes PATCH /uzi/{id}/echographics operation.
 //
-// Получить пациента.
+// Обновить эхографику.
 //
-// GET /med/patient/{id}
-func (c *Client) MedPatientIDGet(ctx context.Context, params MedPatientIDGetParams) (MedPatientIDGetRes, error) {
-	res, err := c.sendMedPatientIDGet(ctx, params)
+// PATCH /uzi/{id}/echographics
+func (c *Client) UziIDEchographicsPatch(ctx context.Context, request *Echographics, params UziIDEchographicsPatchParams) (UziIDEchographicsPatchRes, error) {
+	res, err := c.sendUziIDEchographicsPatch(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendMedPatientIDGet(ctx context.Context, params MedPatientIDGetParams) (res MedPatientIDGetRes, err error) {
+func (c *Client) sendUziIDEchographicsPatch(ctx context.Context, request *Echographics, params UziIDEchographicsPatchParams) (res UziIDEchographicsPatchRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/med/patient/{id}"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/echographics"),
 	}
 
 	// Run stopwatch.
@@ -3259,7 +7336,7 @@ func (c *Client) sendMedPatientIDGet(ctx context.Context, params MedPatientIDGet
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MedPatientIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDEchographicsPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3276,8 +7353,8 @@ func (c *Client) sendMedPatientIDGet(ctx context.Context, params MedPatientIDGet
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/med/patient/"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3296,20 +7373,24 @@ func (c *Client) sendMedPatientIDGet(ctx context.Context, params MedPatientIDGet
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/echographics"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUziIDEchographicsPatchRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MedPatientIDGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziIDEchographicsPatchOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3345,7 +7426,7 @@ func (c *Client) sendMedPatientIDGet(ctx context.Context, params MedPatientIDGet
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMedPatientIDGetResponse(resp)
+	result, err := decodeUziIDEchographicsPatchResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3353,20 +7434,20 @@ func (c *Client) sendMedPatientIDGet(ctx context.Context, params MedPatientIDGet
 	return result, nil
 }
 
-// MedPatientIDPatch invokes PATCH /med/patient/{id} operation.
+// UziIDGet invokes GET /uzi/{id} operation.
 //
-// Обновить пациента.
+// Получить узи.
 //
-// PATCH /med/patient/{id}
-func (c *Client) MedPatientIDPatch(ctx context.Context, request *MedPatientIDPatchReq, params MedPatientIDPatchParams) (MedPatientIDPatchRes, error) {
-	res, err := c.sendMedPatientIDPatch(ctx, request, params)
+// GET /uzi/{id}
+func (c *Client) UziIDGet(ctx context.Context, params UziIDGetParams) (UziIDGetRes, error) {
+	res, err := c.sendUziIDGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendMedPatientIDPatch(ctx context.Context, request *MedPatientIDPatchReq, params MedPatientIDPatchParams) (res MedPatientIDPatchRes, err error) {
+func (c *Client) sendUziIDGet(ctx context.Context, params UziIDGetParams) (res UziIDGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/med/patient/{id}"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/{id}"),
 	}
 
 	// Run stopwatch.
@@ -3381,7 +7462,7 @@ func (c *Client) sendMedPatientIDPatch(ctx context.Context, request *MedPatientI
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MedPatientIDPatchOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3399,7 +7480,7 @@ func (c *Client) sendMedPatientIDPatch(ctx context.Context, request *MedPatientI
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/med/patient/"
+	pathParts[0] = "/uzi/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3421,20 +7502,17 @@ func (c *Client) sendMedPatientIDPatch(ctx context.Context, request *MedPatientI
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMedPatientIDPatchRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MedPatientIDPatchOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziIDGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3470,7 +7548,7 @@ func (c *Client) sendMedPatientIDPatch(ctx context.Context, request *MedPatientI
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMedPatientIDPatchResponse(resp)
+	result, err := decodeUziIDGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3478,29 +7556,20 @@ func (c *Client) sendMedPatientIDPatch(ctx context.Context, request *MedPatientI
 	return result, nil
 }
 
-// MedPatientPost invokes POST /med/patient operation.
+// UziIDImagesGet invokes GET /uzi/{id}/images operation.
 //
-// Зарегистрировать пациента.
+// Получает списк изображений uzi.
 //
-// POST /med/patient
-func (c *Client) MedPatientPost(ctx context.Context, request *MedPatientPostReq) (MedPatientPostRes, error) {
-	res, err := c.sendMedPatientPost(ctx, request)
+// GET /uzi/{id}/images
+func (c *Client) UziIDImagesGet(ctx context.Context, params UziIDImagesGetParams) (UziIDImagesGetRes, error) {
+	res, err := c.sendUziIDImagesGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendMedPatientPost(ctx context.Context, request *MedPatientPostReq) (res MedPatientPostRes, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendUziIDImagesGet(ctx context.Context, params UziIDImagesGetParams) (res UziIDImagesGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/med/patient"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/images"),
 	}
 
 	// Run stopwatch.
@@ -3515,7 +7584,7 @@ func (c *Client) sendMedPatientPost(ctx context.Context, request *MedPatientPost
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MedPatientPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDImagesGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3532,25 +7601,41 @@ func (c *Client) sendMedPatientPost(ctx context.Context, request *MedPatientPost
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/med/patient"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/images"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMedPatientPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MedPatientPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziIDImagesGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3586,7 +7671,7 @@ func (c *Client) sendMedPatientPost(ctx context.Context, request *MedPatientPost
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMedPatientPostResponse(resp)
+	result, err := decodeUziIDImagesGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3594,20 +7679,32 @@ func (c *Client) sendMedPatientPost(ctx context.Context, request *MedPatientPost
 	return result, nil
 }
 
-// PaymentProvidersGet invokes GET /payment_providers operation.
+// UziIDNodesAcceptPost invokes POST /uzi/{id}/nodes/accept operation.
 //
-// Получить список всех платежных провайдеров.
+// Узлы нейросети без решения врача, у которых
+// вероятность наиболее вероятного класса TI-RADS не ниже
+// threshold,
+// становятся valid. Выполняется в одной транзакции.
 //
-// GET /payment_providers
-func (c *Client) PaymentProvidersGet(ctx context.Context) (PaymentProvidersGetRes, error) {
-	res, err := c.sendPaymentProvidersGet(ctx)
+// POST /uzi/{id}/nodes/accept
+func (c *Client) UziIDNodesAcceptPost(ctx context.Context, request *UziIDNodesAcceptPostReq, params UziIDNodesAcceptPostParams) (UziIDNodesAcceptPostRes, error) {
+	res, err := c.sendUziIDNodesAcceptPost(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPaymentProvidersGet(ctx context.Context) (res PaymentProvidersGetRes, err error) {
+func (c *Client) sendUziIDNodesAcceptPost(ctx context.Context, request *UziIDNodesAcceptPostReq, params UziIDNodesAcceptPostParams) (res UziIDNodesAcceptPostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/payment_providers"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/nodes/accept"),
 	}
 
 	// Run stopwatch.
@@ -3622,7 +7719,7 @@ func (c *Client) sendPaymentProvidersGet(ctx context.Context) (res PaymentProvid
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PaymentProvidersGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDNodesAcceptPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3639,22 +7736,44 @@ func (c *Client) sendPaymentProvidersGet(ctx context.Context) (res PaymentProvid
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/payment_providers"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/nodes/accept"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUziIDNodesAcceptPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PaymentProvidersGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziIDNodesAcceptPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3690,7 +7809,7 @@ func (c *Client) sendPaymentProvidersGet(ctx context.Context) (res PaymentProvid
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePaymentProvidersGetResponse(resp)
+	result, err := decodeUziIDNodesAcceptPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3698,20 +7817,20 @@ func (c *Client) sendPaymentProvidersGet(ctx context.Context) (res PaymentProvid
 	return result, nil
 }
 
-// RefreshPost invokes POST /refresh operation.
+// UziIDNodesGet invokes GET /uzi/{id}/nodes operation.
 //
-// Обновить access token.
+// Получить все узлы узи.
 //
-// POST /refresh
-func (c *Client) RefreshPost(ctx context.Context, request *RefreshPostReq) (RefreshPostRes, error) {
-	res, err := c.sendRefreshPost(ctx, request)
+// GET /uzi/{id}/nodes
+func (c *Client) UziIDNodesGet(ctx context.Context, params UziIDNodesGetParams) (UziIDNodesGetRes, error) {
+	res, err := c.sendUziIDNodesGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendRefreshPost(ctx context.Context, request *RefreshPostReq) (res RefreshPostRes, err error) {
+func (c *Client) sendUziIDNodesGet(ctx context.Context, params UziIDNodesGetParams) (res UziIDNodesGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/refresh"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/nodes"),
 	}
 
 	// Run stopwatch.
@@ -3726,7 +7845,7 @@ func (c *Client) sendRefreshPost(ctx context.Context, request *RefreshPostReq) (
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RefreshPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDNodesGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3743,17 +7862,66 @@ func (c *Client) sendRefreshPost(ctx context.Context, request *RefreshPostReq) (
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/refresh"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/nodes"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRefreshPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UziIDNodesGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
 	}
 
 	stage = "SendRequest"
@@ -3764,7 +7932,7 @@ func (c *Client) sendRefreshPost(ctx context.Context, request *RefreshPostReq) (
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRefreshPostResponse(resp)
+	result, err := decodeUziIDNodesGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3772,17 +7940,17 @@ func (c *Client) sendRefreshPost(ctx context.Context, request *RefreshPostReq) (
 	return result, nil
 }
 
-// RegDoctorPost invokes POST /reg/doctor operation.
+// UziIDNodesSegmentsPost invokes POST /uzi/{id}/nodes-segments operation.
 //
-// Зарегистрировать врача (как пользователя).
+// Добавить узел с сегментами.
 //
-// POST /reg/doctor
-func (c *Client) RegDoctorPost(ctx context.Context, request *RegDoctorPostReq) (RegDoctorPostRes, error) {
-	res, err := c.sendRegDoctorPost(ctx, request)
+// POST /uzi/{id}/nodes-segments
+func (c *Client) UziIDNodesSegmentsPost(ctx context.Context, request *UziIDNodesSegmentsPostReq, params UziIDNodesSegmentsPostParams) (UziIDNodesSegmentsPostRes, error) {
+	res, err := c.sendUziIDNodesSegmentsPost(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRegDoctorPost(ctx context.Context, request *RegDoctorPostReq) (res RegDoctorPostRes, err error) {
+func (c *Client) sendUziIDNodesSegmentsPost(ctx context.Context, request *UziIDNodesSegmentsPostReq, params UziIDNodesSegmentsPostParams) (res UziIDNodesSegmentsPostRes, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -3794,7 +7962,7 @@ func (c *Client) sendRegDoctorPost(ctx context.Context, request *RegDoctorPostRe
 	}
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/reg/doctor"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/nodes-segments"),
 	}
 
 	// Run stopwatch.
@@ -3809,7 +7977,7 @@ func (c *Client) sendRegDoctorPost(ctx context.Context, request *RegDoctorPostRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RegDoctorPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDNodesSegmentsPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3826,8 +7994,27 @@ func (c *Client) sendRegDoctorPost(ctx context.Context, request *RegDoctorPostRe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/reg/doctor"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/nodes-segments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3835,10 +8022,43 @@ func (c *Client) sendRegDoctorPost(ctx context.Context, request *RegDoctorPostRe
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRegDoctorPostRequest(request, r); err != nil {
+	if err := encodeUziIDNodesSegmentsPostRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UziIDNodesSegmentsPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -3847,7 +8067,7 @@ func (c *Client) sendRegDoctorPost(ctx context.Context, request *RegDoctorPostRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRegDoctorPostResponse(resp)
+	result, err := decodeUziIDNodesSegmentsPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3855,19 +8075,17 @@ func (c *Client) sendRegDoctorPost(ctx context.Context, request *RegDoctorPostRe
 	return result, nil
 }
 
-// RegPatientPost invokes POST /reg/patient operation.
+// UziIDPatch invokes PATCH /uzi/{id} operation.
 //
-// Зарегистрировать пациента (как пользователя).
-// **Сделано под мобилки api**. Врачам регистрировать
-// пациентов через /med/patient.
+// Обновить узи.
 //
-// POST /reg/patient
-func (c *Client) RegPatientPost(ctx context.Context, request *RegPatientPostReq) (RegPatientPostRes, error) {
-	res, err := c.sendRegPatientPost(ctx, request)
+// PATCH /uzi/{id}
+func (c *Client) UziIDPatch(ctx context.Context, request *UziIDPatchReq, params UziIDPatchParams) (UziIDPatchRes, error) {
+	res, err := c.sendUziIDPatch(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRegPatientPost(ctx context.Context, request *RegPatientPostReq) (res RegPatientPostRes, err error) {
+func (c *Client) sendUziIDPatch(ctx context.Context, request *UziIDPatchReq, params UziIDPatchParams) (res UziIDPatchRes, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -3878,8 +8096,8 @@ func (c *Client) sendRegPatientPost(ctx context.Context, request *RegPatientPost
 		return res, errors.Wrap(err, "validate")
 	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/reg/patient"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/uzi/{id}"),
 	}
 
 	// Run stopwatch.
@@ -3894,7 +8112,7 @@ func (c *Client) sendRegPatientPost(ctx context.Context, request *RegPatientPost
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RegPatientPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3911,19 +8129,70 @@ func (c *Client) sendRegPatientPost(ctx context.Context, request *RegPatientPost
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/reg/patient"
+	var pathParts [2]string
+	pathParts[0] = "/uzi/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRegPatientPostRequest(request, r); err != nil {
+	if err := encodeUziIDPatchRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UziIDPatchOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -3932,7 +8201,7 @@ func (c *Client) sendRegPatientPost(ctx context.Context, request *RegPatientPost
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRegPatientPostResponse(resp)
+	result, err := decodeUziIDPatchResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3940,21 +8209,20 @@ func (c *Client) sendRegPatientPost(ctx context.Context, request *RegPatientPost
 	return result, nil
 }
 
-// SubscriptionsCheckActiveGet invokes GET /subscriptions/check-active operation.
+// UziIDReportsGet invokes GET /uzi/{id}/reports operation.
 //
-// Проверить наличие активной подписки у текущего
-// пользователя.
+// Получить версии заключения по узи.
 //
-// GET /subscriptions/check-active
-func (c *Client) SubscriptionsCheckActiveGet(ctx context.Context) (SubscriptionsCheckActiveGetRes, error) {
-	res, err := c.sendSubscriptionsCheckActiveGet(ctx)
+// GET /uzi/{id}/reports
+func (c *Client) UziIDReportsGet(ctx context.Context, params UziIDReportsGetParams) (UziIDReportsGetRes, error) {
+	res, err := c.sendUziIDReportsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendSubscriptionsCheckActiveGet(ctx context.Context) (res SubscriptionsCheckActiveGetRes, err error) {
+func (c *Client) sendUziIDReportsGet(ctx context.Context, params UziIDReportsGetParams) (res UziIDReportsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/subscriptions/check-active"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/reports"),
 	}
 
 	// Run stopwatch.
@@ -3969,7 +8237,7 @@ func (c *Client) sendSubscriptionsCheckActiveGet(ctx context.Context) (res Subsc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SubscriptionsCheckActiveGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDReportsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3986,8 +8254,27 @@ func (c *Client) sendSubscriptionsCheckActiveGet(ctx context.Context) (res Subsc
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/subscriptions/check-active"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/reports"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -4001,7 +8288,7 @@ func (c *Client) sendSubscriptionsCheckActiveGet(ctx context.Context) (res Subsc
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SubscriptionsCheckActiveGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziIDReportsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4037,7 +8324,7 @@ func (c *Client) sendSubscriptionsCheckActiveGet(ctx context.Context) (res Subsc
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSubscriptionsCheckActiveGetResponse(resp)
+	result, err := decodeUziIDReportsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4045,21 +8332,21 @@ func (c *Client) sendSubscriptionsCheckActiveGet(ctx context.Context) (res Subsc
 	return result, nil
 }
 
-// SubscriptionsGetActiveGet invokes GET /subscriptions/get-active operation.
+// UziIDReportsPost invokes POST /uzi/{id}/reports operation.
 //
-// Получить информацию об активной подписке текущего
-// пользователя.
+// Заключение собирается из эхографических признаков,
+// узлов и ключевых кадров с контурами.
 //
-// GET /subscriptions/get-active
-func (c *Client) SubscriptionsGetActiveGet(ctx context.Context) (SubscriptionsGetActiveGetRes, error) {
-	res, err := c.sendSubscriptionsGetActiveGet(ctx)
+// POST /uzi/{id}/reports
+func (c *Client) UziIDReportsPost(ctx context.Context, params UziIDReportsPostParams) (UziIDReportsPostRes, error) {
+	res, err := c.sendUziIDReportsPost(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendSubscriptionsGetActiveGet(ctx context.Context) (res SubscriptionsGetActiveGetRes, err error) {
+func (c *Client) sendUziIDReportsPost(ctx context.Context, params UziIDReportsPostParams) (res UziIDReportsPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/subscriptions/get-active"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/reports"),
 	}
 
 	// Run stopwatch.
@@ -4074,7 +8361,7 @@ func (c *Client) sendSubscriptionsGetActiveGet(ctx context.Context) (res Subscri
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SubscriptionsGetActiveGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziIDReportsPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4091,12 +8378,31 @@ func (c *Client) sendSubscriptionsGetActiveGet(ctx context.Context) (res Subscri
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/subscriptions/get-active"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/reports"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -4106,7 +8412,7 @@ func (c *Client) sendSubscriptionsGetActiveGet(ctx context.Context) (res Subscri
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SubscriptionsGetActiveGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziIDReportsPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4142,7 +8448,7 @@ func (c *Client) sendSubscriptionsGetActiveGet(ctx context.Context) (res Subscri
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSubscriptionsGetActiveGetResponse(resp)
+	result, err := decodeUziIDReportsPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4150,20 +8456,20 @@ func (c *Client) sendSubscriptionsGetActiveGet(ctx context.Context) (res Subscri
 	return result, nil
 }
 
-// SubscriptionsPurchasePost invokes POST /subscriptions/purchase operation.
+// UziImageIDNodesSegmentsGet invokes GET /uzi/image/{id}/nodes-segments operation.
 //
-// Купить подписку.
+// Получит узлы и сегменты на указанном изображении.
 //
-// POST /subscriptions/purchase
-func (c *Client) SubscriptionsPurchasePost(ctx context.Context, request *PurchaseSubscriptionRequest) (SubscriptionsPurchasePostRes, error) {
-	res, err := c.sendSubscriptionsPurchasePost(ctx, request)
+// GET /uzi/image/{id}/nodes-segments
+func (c *Client) UziImageIDNodesSegmentsGet(ctx context.Context, params UziImageIDNodesSegmentsGetParams) (UziImageIDNodesSegmentsGetRes, error) {
+	res, err := c.sendUziImageIDNodesSegmentsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendSubscriptionsPurchasePost(ctx context.Context, request *PurchaseSubscriptionRequest) (res SubscriptionsPurchasePostRes, err error) {
+func (c *Client) sendUziImageIDNodesSegmentsGet(ctx context.Context, params UziImageIDNodesSegmentsGetParams) (res UziImageIDNodesSegmentsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/subscriptions/purchase"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/image/{id}/nodes-segments"),
 	}
 
 	// Run stopwatch.
@@ -4178,7 +8484,7 @@ func (c *Client) sendSubscriptionsPurchasePost(ctx context.Context, request *Pur
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SubscriptionsPurchasePostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziImageIDNodesSegmentsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4195,25 +8501,41 @@ func (c *Client) sendSubscriptionsPurchasePost(ctx context.Context, request *Pur
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/subscriptions/purchase"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/image/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/nodes-segments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSubscriptionsPurchasePostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SubscriptionsPurchasePostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziImageIDNodesSegmentsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4249,7 +8571,7 @@ func (c *Client) sendSubscriptionsPurchasePost(ctx context.Context, request *Pur
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSubscriptionsPurchasePostResponse(resp)
+	result, err := decodeUziImageIDNodesSegmentsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4257,20 +8579,20 @@ func (c *Client) sendSubscriptionsPurchasePost(ctx context.Context, request *Pur
 	return result, nil
 }
 
-// TariffPlansGet invokes GET /tariff_plans operation.
+// UziNodesIDDelete invokes DELETE /uzi/nodes/{id} operation.
 //
-// Получить список всех тарифных планов.
+// Сегменты узла будут также удалены.
 //
-// GET /tariff_plans
-func (c *Client) TariffPlansGet(ctx context.Context) (TariffPlansGetRes, error) {
-	res, err := c.sendTariffPlansGet(ctx)
+// DELETE /uzi/nodes/{id}
+func (c *Client) UziNodesIDDelete(ctx context.Context, params UziNodesIDDeleteParams) (UziNodesIDDeleteRes, error) {
+	res, err := c.sendUziNodesIDDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendTariffPlansGet(ctx context.Context) (res TariffPlansGetRes, err error) {
+func (c *Client) sendUziNodesIDDelete(ctx context.Context, params UziNodesIDDeleteParams) (res UziNodesIDDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tariff_plans"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}"),
 	}
 
 	// Run stopwatch.
@@ -4285,7 +8607,7 @@ func (c *Client) sendTariffPlansGet(ctx context.Context) (res TariffPlansGetRes,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TariffPlansGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4302,12 +8624,30 @@ func (c *Client) sendTariffPlansGet(ctx context.Context) (res TariffPlansGetRes,
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/tariff_plans"
+	var pathParts [2]string
+	pathParts[0] = "/uzi/nodes/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -4317,7 +8657,7 @@ func (c *Client) sendTariffPlansGet(ctx context.Context) (res TariffPlansGetRes,
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, TariffPlansGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesIDDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4353,7 +8693,7 @@ func (c *Client) sendTariffPlansGet(ctx context.Context) (res TariffPlansGetRes,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeTariffPlansGetResponse(resp)
+	result, err := decodeUziNodesIDDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4361,20 +8701,20 @@ func (c *Client) sendTariffPlansGet(ctx context.Context) (res TariffPlansGetRes,
 	return result, nil
 }
 
-// TariffPlansIDGet invokes GET /tariff_plans/{id} operation.
+// UziNodesIDLinkDelete invokes DELETE /uzi/nodes/{id}/link operation.
 //
-// Получить тарифный план по ID.
+// Отвязать узел от цепочки наблюдений.
 //
-// GET /tariff_plans/{id}
-func (c *Client) TariffPlansIDGet(ctx context.Context, params TariffPlansIDGetParams) (TariffPlansIDGetRes, error) {
-	res, err := c.sendTariffPlansIDGet(ctx, params)
+// DELETE /uzi/nodes/{id}/link
+func (c *Client) UziNodesIDLinkDelete(ctx context.Context, params UziNodesIDLinkDeleteParams) (UziNodesIDLinkDeleteRes, error) {
+	res, err := c.sendUziNodesIDLinkDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendTariffPlansIDGet(ctx context.Context, params TariffPlansIDGetParams) (res TariffPlansIDGetRes, err error) {
+func (c *Client) sendUziNodesIDLinkDelete(ctx context.Context, params UziNodesIDLinkDeleteParams) (res UziNodesIDLinkDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tariff_plans/{id}"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/link"),
 	}
 
 	// Run stopwatch.
@@ -4389,7 +8729,7 @@ func (c *Client) sendTariffPlansIDGet(ctx context.Context, params TariffPlansIDG
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TariffPlansIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDLinkDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4406,8 +8746,8 @@ func (c *Client) sendTariffPlansIDGet(ctx context.Context, params TariffPlansIDG
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/tariff_plans/"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/nodes/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -4426,10 +8766,11 @@ func (c *Client) sendTariffPlansIDGet(ctx context.Context, params TariffPlansIDG
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/link"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -4439,7 +8780,7 @@ func (c *Client) sendTariffPlansIDGet(ctx context.Context, params TariffPlansIDG
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, TariffPlansIDGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesIDLinkDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4475,7 +8816,7 @@ func (c *Client) sendTariffPlansIDGet(ctx context.Context, params TariffPlansIDG
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeTariffPlansIDGetResponse(resp)
+	result, err := decodeUziNodesIDLinkDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4483,25 +8824,20 @@ func (c *Client) sendTariffPlansIDGet(ctx context.Context, params TariffPlansIDG
 	return result, nil
 }
 
-// TilerDziFilePathFilesLevelColRowFormatGet invokes TilerDziFilePathFilesLevelColRowFormatGet operation.
+// UziNodesIDLinkPost invokes POST /uzi/nodes/{id}/link operation.
 //
-// Возвращает конкретный тайл (плитку) изображения для
-// указанного уровня масштабирования,
-// колонки и строки. Запрос проксируется напрямую на
-// tiler_service.
-// Формат пути: `/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}`.
+// Узел добавляется в цепочку наблюдений целевого узла.
 //
-// GET /tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}
-func (c *Client) TilerDziFilePathFilesLevelColRowFormatGet(ctx context.Context, params TilerDziFilePathFilesLevelColRowFormatGetParams) (TilerDziFilePathFilesLevelColRowFormatGetRes, error) {
-	res, err := c.sendTilerDziFilePathFilesLevelColRowFormatGet(ctx, params)
+// POST /uzi/nodes/{id}/link
+func (c *Client) UziNodesIDLinkPost(ctx context.Context, request *UziNodesIDLinkPostReq, params UziNodesIDLinkPostParams) (UziNodesIDLinkPostRes, error) {
+	res, err := c.sendUziNodesIDLinkPost(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendTilerDziFilePathFilesLevelColRowFormatGet(ctx context.Context, params TilerDziFilePathFilesLevelColRowFormatGetParams) (res TilerDziFilePathFilesLevelColRowFormatGetRes, err error) {
+func (c *Client) sendUziNodesIDLinkPost(ctx context.Context, request *UziNodesIDLinkPostReq, params UziNodesIDLinkPostParams) (res UziNodesIDLinkPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("TilerDziFilePathFilesLevelColRowFormatGet"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/link"),
 	}
 
 	// Run stopwatch.
@@ -4516,110 +8852,34 @@ func (c *Client) sendTilerDziFilePathFilesLevelColRowFormatGet(ctx context.Conte
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TilerDziFilePathFilesLevelColRowFormatGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDLinkPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [10]string
-	pathParts[0] = "/tiler/dzi/"
-	{
-		// Encode "file_path" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "file_path",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.FilePath))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/files/"
-	{
-		// Encode "level" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "level",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.Level))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/"
-	{
-		// Encode "col" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "col",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.Col))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
-	pathParts[6] = "_"
-	{
-		// Encode "row" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "row",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.Row))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
 		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		pathParts[7] = encoded
-	}
-	pathParts[8] = "."
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/uzi/nodes/"
 	{
-		// Encode "format" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "format",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(string(params.Format)))
+			return e.EncodeValue(conv.UUIDToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -4627,15 +8887,52 @@ func (c *Client) sendTilerDziFilePathFilesLevelColRowFormatGet(ctx context.Conte
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[9] = encoded
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/link"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUziNodesIDLinkPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UziNodesIDLinkPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -4645,7 +8942,7 @@ func (c *Client) sendTilerDziFilePathFilesLevelColRowFormatGet(ctx context.Conte
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeTilerDziFilePathFilesLevelColRowFormatGetResponse(resp)
+	result, err := decodeUziNodesIDLinkPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4653,24 +8950,20 @@ func (c *Client) sendTilerDziFilePathFilesLevelColRowFormatGet(ctx context.Conte
 	return result, nil
 }
 
-// TilerDziFilePathGet invokes TilerDziFilePathGet operation.
+// UziNodesIDLinkSuggestionsGet invokes GET /uzi/nodes/{id}/link/suggestions operation.
 //
-// Возвращает XML метаданные в формате Deep Zoom Image (DZI) для
-// указанного изображения.
-// Путь к файлу должен быть URL-encoded. Запрос проксируется
-// напрямую на tiler_service.
+// Предложить узлы из других узи пациента для связывания.
 //
-// GET /tiler/dzi/{file_path}
-func (c *Client) TilerDziFilePathGet(ctx context.Context, params TilerDziFilePathGetParams) (TilerDziFilePathGetRes, error) {
-	res, err := c.sendTilerDziFilePathGet(ctx, params)
+// GET /uzi/nodes/{id}/link/suggestions
+func (c *Client) UziNodesIDLinkSuggestionsGet(ctx context.Context, params UziNodesIDLinkSuggestionsGetParams) (UziNodesIDLinkSuggestionsGetRes, error) {
+	res, err := c.sendUziNodesIDLinkSuggestionsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendTilerDziFilePathGet(ctx context.Context, params TilerDziFilePathGetParams) (res TilerDziFilePathGetRes, err error) {
+func (c *Client) sendUziNodesIDLinkSuggestionsGet(ctx context.Context, params UziNodesIDLinkSuggestionsGetParams) (res UziNodesIDLinkSuggestionsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("TilerDziFilePathGet"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tiler/dzi/{file_path}"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/link/suggestions"),
 	}
 
 	// Run stopwatch.
@@ -4685,7 +8978,7 @@ func (c *Client) sendTilerDziFilePathGet(ctx context.Context, params TilerDziFil
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TilerDziFilePathGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDLinkSuggestionsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4702,17 +8995,17 @@ func (c *Client) sendTilerDziFilePathGet(ctx context.Context, params TilerDziFil
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/tiler/dzi/"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/nodes/"
 	{
-		// Encode "file_path" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "file_path",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.FilePath))
+			return e.EncodeValue(conv.UUIDToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -4722,6 +9015,7 @@ func (c *Client) sendTilerDziFilePathGet(ctx context.Context, params TilerDziFil
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/link/suggestions"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -4730,6 +9024,39 @@ func (c *Client) sendTilerDziFilePathGet(ctx context.Context, params TilerDziFil
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UziNodesIDLinkSuggestionsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -4738,7 +9065,7 @@ func (c *Client) sendTilerDziFilePathGet(ctx context.Context, params TilerDziFil
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeTilerDziFilePathGetResponse(resp)
+	result, err := decodeUziNodesIDLinkSuggestionsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4746,17 +9073,17 @@ func (c *Client) sendTilerDziFilePathGet(ctx context.Context, params TilerDziFil
 	return result, nil
 }
 
-// UziDevicePost invokes POST /uzi/device operation.
+// UziNodesIDPatch invokes PATCH /uzi/nodes/{id} operation.
 //
-// Добавить uzi аппарат.
+// Обновить узел.
 //
-// POST /uzi/device
-func (c *Client) UziDevicePost(ctx context.Context, request *UziDevicePostReq) (UziDevicePostRes, error) {
-	res, err := c.sendUziDevicePost(ctx, request)
+// PATCH /uzi/nodes/{id}
+func (c *Client) UziNodesIDPatch(ctx context.Context, request *UziNodesIDPatchReq, params UziNodesIDPatchParams) (UziNodesIDPatchRes, error) {
+	res, err := c.sendUziNodesIDPatch(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUziDevicePost(ctx context.Context, request *UziDevicePostReq) (res UziDevicePostRes, err error) {
+func (c *Client) sendUziNodesIDPatch(ctx context.Context, request *UziNodesIDPatchReq, params UziNodesIDPatchParams) (res UziNodesIDPatchRes, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -4767,8 +9094,8 @@ func (c *Client) sendUziDevicePost(ctx context.Context, request *UziDevicePostRe
 		return res, errors.Wrap(err, "validate")
 	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/uzi/device"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}"),
 	}
 
 	// Run stopwatch.
@@ -4783,7 +9110,7 @@ func (c *Client) sendUziDevicePost(ctx context.Context, request *UziDevicePostRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziDevicePostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4800,16 +9127,34 @@ func (c *Client) sendUziDevicePost(ctx context.Context, request *UziDevicePostRe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/uzi/device"
+	var pathParts [2]string
+	pathParts[0] = "/uzi/nodes/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUziDevicePostRequest(request, r); err != nil {
+	if err := encodeUziNodesIDPatchRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4818,7 +9163,7 @@ func (c *Client) sendUziDevicePost(ctx context.Context, request *UziDevicePostRe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziDevicePostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesIDPatchOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4854,7 +9199,7 @@ func (c *Client) sendUziDevicePost(ctx context.Context, request *UziDevicePostRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziDevicePostResponse(resp)
+	result, err := decodeUziNodesIDPatchResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4862,20 +9207,20 @@ func (c *Client) sendUziDevicePost(ctx context.Context, request *UziDevicePostRe
 	return result, nil
 }
 
-// UziDevicesGet invokes GET /uzi/devices operation.
+// UziNodesIDSegmentDraftsGet invokes GET /uzi/nodes/{id}/segment-drafts operation.
 //
-// Получит список uzi апппапапратов.
+// Получить черновики сегментов узла.
 //
-// GET /uzi/devices
-func (c *Client) UziDevicesGet(ctx context.Context) (UziDevicesGetRes, error) {
-	res, err := c.sendUziDevicesGet(ctx)
+// GET /uzi/nodes/{id}/segment-drafts
+func (c *Client) UziNodesIDSegmentDraftsGet(ctx context.Context, params UziNodesIDSegmentDraftsGetParams) (UziNodesIDSegmentDraftsGetRes, error) {
+	res, err := c.sendUziNodesIDSegmentDraftsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziDevicesGet(ctx context.Context) (res UziDevicesGetRes, err error) {
+func (c *Client) sendUziNodesIDSegmentDraftsGet(ctx context.Context, params UziNodesIDSegmentDraftsGetParams) (res UziNodesIDSegmentDraftsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/devices"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/segment-drafts"),
 	}
 
 	// Run stopwatch.
@@ -4890,7 +9235,7 @@ func (c *Client) sendUziDevicesGet(ctx context.Context) (res UziDevicesGetRes, e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziDevicesGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDSegmentDraftsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4907,8 +9252,27 @@ func (c *Client) sendUziDevicesGet(ctx context.Context) (res UziDevicesGetRes, e
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/uzi/devices"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/nodes/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/segment-drafts"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -4922,7 +9286,7 @@ func (c *Client) sendUziDevicesGet(ctx context.Context) (res UziDevicesGetRes, e
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziDevicesGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesIDSegmentDraftsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4958,7 +9322,7 @@ func (c *Client) sendUziDevicesGet(ctx context.Context) (res UziDevicesGetRes, e
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziDevicesGetResponse(resp)
+	result, err := decodeUziNodesIDSegmentDraftsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4966,20 +9330,20 @@ func (c *Client) sendUziDevicesGet(ctx context.Context) (res UziDevicesGetRes, e
 	return result, nil
 }
 
-// UziIDDelete invokes DELETE /uzi/{id} operation.
+// UziNodesIDSegmentsGet invokes GET /uzi/nodes/{id}/segments operation.
 //
-// Удалить узи.
+// Получить сегменты узла.
 //
-// DELETE /uzi/{id}
-func (c *Client) UziIDDelete(ctx context.Context, params UziIDDeleteParams) (UziIDDeleteRes, error) {
-	res, err := c.sendUziIDDelete(ctx, params)
+// GET /uzi/nodes/{id}/segments
+func (c *Client) UziNodesIDSegmentsGet(ctx context.Context, params UziNodesIDSegmentsGetParams) (UziNodesIDSegmentsGetRes, error) {
+	res, err := c.sendUziNodesIDSegmentsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziIDDelete(ctx context.Context, params UziIDDeleteParams) (res UziIDDeleteRes, err error) {
+func (c *Client) sendUziNodesIDSegmentsGet(ctx context.Context, params UziNodesIDSegmentsGetParams) (res UziNodesIDSegmentsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/uzi/{id}"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/segments"),
 	}
 
 	// Run stopwatch.
@@ -4994,7 +9358,7 @@ func (c *Client) sendUziIDDelete(ctx context.Context, params UziIDDeleteParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziIDDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDSegmentsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5011,8 +9375,8 @@ func (c *Client) sendUziIDDelete(ctx context.Context, params UziIDDeleteParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/uzi/"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/nodes/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5031,10 +9395,11 @@ func (c *Client) sendUziIDDelete(ctx context.Context, params UziIDDeleteParams)
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/segments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -5044,7 +9409,7 @@ func (c *Client) sendUziIDDelete(ctx context.Context, params UziIDDeleteParams)
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziIDDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesIDSegmentsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5080,7 +9445,7 @@ func (c *Client) sendUziIDDelete(ctx context.Context, params UziIDDeleteParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziIDDeleteResponse(resp)
+	result, err := decodeUziNodesIDSegmentsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5088,20 +9453,31 @@ func (c *Client) sendUziIDDelete(ctx context.Context, params UziIDDeleteParams)
 	return result, nil
 }
 
-// UziIDEchographicsGet invokes GET /uzi/{id}/echographics operation.
+// UziNodesIDSplitPost invokes POST /uzi/nodes/{id}/split operation.
 //
-// Получить эхографику uzi.
+// Вероятности TI-RADS обоих узлов пересчитываются по их
+// сегментам, исходный узел сохраняется для аналитики.
+// В узле должен остаться хотя бы один сегмент.
 //
-// GET /uzi/{id}/echographics
-func (c *Client) UziIDEchographicsGet(ctx context.Context, params UziIDEchographicsGetParams) (UziIDEchographicsGetRes, error) {
-	res, err := c.sendUziIDEchographicsGet(ctx, params)
+// POST /uzi/nodes/{id}/split
+func (c *Client) UziNodesIDSplitPost(ctx context.Context, request *UziNodesIDSplitPostReq, params UziNodesIDSplitPostParams) (UziNodesIDSplitPostRes, error) {
+	res, err := c.sendUziNodesIDSplitPost(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUziIDEchographicsGet(ctx context.Context, params UziIDEchographicsGetParams) (res UziIDEchographicsGetRes, err error) {
+func (c *Client) sendUziNodesIDSplitPost(ctx context.Context, request *UziNodesIDSplitPostReq, params UziNodesIDSplitPostParams) (res UziNodesIDSplitPostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/echographics"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/split"),
 	}
 
 	// Run stopwatch.
@@ -5116,7 +9492,7 @@ func (c *Client) sendUziIDEchographicsGet(ctx context.Context, params UziIDEchog
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziIDEchographicsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDSplitPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5134,7 +9510,7 @@ func (c *Client) sendUziIDEchographicsGet(ctx context.Context, params UziIDEchog
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/uzi/"
+	pathParts[0] = "/uzi/nodes/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5153,21 +9529,24 @@ func (c *Client) sendUziIDEchographicsGet(ctx context.Context, params UziIDEchog
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/echographics"
+	pathParts[2] = "/split"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUziNodesIDSplitPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziIDEchographicsGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesIDSplitPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5203,7 +9582,7 @@ func (c *Client) sendUziIDEchographicsGet(ctx context.Context, params UziIDEchog
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziIDEchographicsGetResponse(resp)
+	result, err := decodeUziNodesIDSplitPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5211,29 +9590,20 @@ func (c *Client) sendUziIDEchographicsGet(ctx context.Context, params UziIDEchog
 	return result, nil
 }
 
-// UziIDEchographicsPatch invokes PATCH /uzi/{id}/echographics operation.
+// UziNodesIDTiradsGet invokes GET /uzi/nodes/{id}/tirads operation.
 //
-// Обновить эхографику.
+// Получить оценку узла по ACR TI-RADS.
 //
-// PATCH /uzi/{id}/echographics
-func (c *Client) UziIDEchographicsPatch(ctx context.Context, request *Echographics, params UziIDEchographicsPatchParams) (UziIDEchographicsPatchRes, error) {
-	res, err := c.sendUziIDEchographicsPatch(ctx, request, params)
+// GET /uzi/nodes/{id}/tirads
+func (c *Client) UziNodesIDTiradsGet(ctx context.Context, params UziNodesIDTiradsGetParams) (UziNodesIDTiradsGetRes, error) {
+	res, err := c.sendUziNodesIDTiradsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziIDEchographicsPatch(ctx context.Context, request *Echographics, params UziIDEchographicsPatchParams) (res UziIDEchographicsPatchRes, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendUziNodesIDTiradsGet(ctx context.Context, params UziNodesIDTiradsGetParams) (res UziNodesIDTiradsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/echographics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/tirads"),
 	}
 
 	// Run stopwatch.
@@ -5248,7 +9618,7 @@ func (c *Client) sendUziIDEchographicsPatch(ctx context.Context, request *Echogr
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziIDEchographicsPatchOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDTiradsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5266,7 +9636,7 @@ func (c *Client) sendUziIDEchographicsPatch(ctx context.Context, request *Echogr
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/uzi/"
+	pathParts[0] = "/uzi/nodes/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5285,24 +9655,21 @@ func (c *Client) sendUziIDEchographicsPatch(ctx context.Context, request *Echogr
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/echographics"
+	pathParts[2] = "/tirads"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUziIDEchographicsPatchRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziIDEchographicsPatchOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesIDTiradsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5338,7 +9705,7 @@ func (c *Client) sendUziIDEchographicsPatch(ctx context.Context, request *Echogr
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziIDEchographicsPatchResponse(resp)
+	result, err := decodeUziNodesIDTiradsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5346,20 +9713,29 @@ func (c *Client) sendUziIDEchographicsPatch(ctx context.Context, request *Echogr
 	return result, nil
 }
 
-// UziIDGet invokes GET /uzi/{id} operation.
+// UziNodesIDTiradsPut invokes PUT /uzi/nodes/{id}/tirads operation.
 //
-// Получить узи.
+// Задать дескрипторы узла по ACR TI-RADS.
 //
-// GET /uzi/{id}
-func (c *Client) UziIDGet(ctx context.Context, params UziIDGetParams) (UziIDGetRes, error) {
-	res, err := c.sendUziIDGet(ctx, params)
+// PUT /uzi/nodes/{id}/tirads
+func (c *Client) UziNodesIDTiradsPut(ctx context.Context, request *NodeDescriptors, params UziNodesIDTiradsPutParams) (UziNodesIDTiradsPutRes, error) {
+	res, err := c.sendUziNodesIDTiradsPut(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUziIDGet(ctx context.Context, params UziIDGetParams) (res UziIDGetRes, err error) {
+func (c *Client) sendUziNodesIDTiradsPut(ctx context.Context, request *NodeDescriptors, params UziNodesIDTiradsPutParams) (res UziNodesIDTiradsPutRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/{id}"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/tirads"),
 	}
 
 	// Run stopwatch.
@@ -5374,7 +9750,7 @@ func (c *Client) sendUziIDGet(ctx context.Context, params UziIDGetParams) (res U
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDTiradsPutOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5391,8 +9767,8 @@ func (c *Client) sendUziIDGet(ctx context.Context, params UziIDGetParams) (res U
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/uzi/"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/nodes/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5411,20 +9787,24 @@ func (c *Client) sendUziIDGet(ctx context.Context, params UziIDGetParams) (res U
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/tirads"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUziNodesIDTiradsPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziIDGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesIDTiradsPutOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5460,7 +9840,7 @@ func (c *Client) sendUziIDGet(ctx context.Context, params UziIDGetParams) (res U
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziIDGetResponse(resp)
+	result, err := decodeUziNodesIDTiradsPutResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5468,20 +9848,32 @@ func (c *Client) sendUziIDGet(ctx context.Context, params UziIDGetParams) (res U
 	return result, nil
 }
 
-// UziIDImagesGet invokes GET /uzi/{id}/images operation.
+// UziNodesMergePost invokes POST /uzi/nodes/merge operation.
 //
-// Получает списк изображений uzi.
+// Сегменты всех узлов переносятся в первый из node_ids,
+// остальные узлы удаляются.
+// Вероятности TI-RADS узла пересчитываются по его
+// сегментам, исходные узлы сохраняются для аналитики.
 //
-// GET /uzi/{id}/images
-func (c *Client) UziIDImagesGet(ctx context.Context, params UziIDImagesGetParams) (UziIDImagesGetRes, error) {
-	res, err := c.sendUziIDImagesGet(ctx, params)
+// POST /uzi/nodes/merge
+func (c *Client) UziNodesMergePost(ctx context.Context, request *UziNodesMergePostReq) (UziNodesMergePostRes, error) {
+	res, err := c.sendUziNodesMergePost(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUziIDImagesGet(ctx context.Context, params UziIDImagesGetParams) (res UziIDImagesGetRes, err error) {
+func (c *Client) sendUziNodesMergePost(ctx context.Context, request *UziNodesMergePostReq) (res UziNodesMergePostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/images"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/merge"),
 	}
 
 	// Run stopwatch.
@@ -5496,7 +9888,7 @@ func (c *Client) sendUziIDImagesGet(ctx context.Context, params UziIDImagesGetPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziIDImagesGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesMergePostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5513,41 +9905,25 @@ func (c *Client) sendUziIDImagesGet(ctx context.Context, params UziIDImagesGetPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/uzi/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/images"
+	var pathParts [1]string
+	pathParts[0] = "/uzi/nodes/merge"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUziNodesMergePostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziIDImagesGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesMergePostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5583,7 +9959,7 @@ func (c *Client) sendUziIDImagesGet(ctx context.Context, params UziIDImagesGetPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziIDImagesGetResponse(resp)
+	result, err := decodeUziNodesMergePostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5591,20 +9967,32 @@ func (c *Client) sendUziIDImagesGet(ctx context.Context, params UziIDImagesGetPa
 	return result, nil
 }
 
-// UziIDNodesGet invokes GET /uzi/{id}/nodes operation.
+// UziNodesReviewPost invokes POST /uzi/nodes/review operation.
 //
-// Получить все узлы узи.
+// Валидация проставляется всем узлам в одной
+// транзакции, узлы, которые нельзя изменить,
+// возвращаются со своим статусом.
+// Пакетные операции учитываются в аналитике.
 //
-// GET /uzi/{id}/nodes
-func (c *Client) UziIDNodesGet(ctx context.Context, params UziIDNodesGetParams) (UziIDNodesGetRes, error) {
-	res, err := c.sendUziIDNodesGet(ctx, params)
+// POST /uzi/nodes/review
+func (c *Client) UziNodesReviewPost(ctx context.Context, request *UziNodesReviewPostReq) (UziNodesReviewPostRes, error) {
+	res, err := c.sendUziNodesReviewPost(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUziIDNodesGet(ctx context.Context, params UziIDNodesGetParams) (res UziIDNodesGetRes, err error) {
+func (c *Client) sendUziNodesReviewPost(ctx context.Context, request *UziNodesReviewPostReq) (res UziNodesReviewPostRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/nodes"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/review"),
 	}
 
 	// Run stopwatch.
@@ -5619,7 +10007,7 @@ func (c *Client) sendUziIDNodesGet(ctx context.Context, params UziIDNodesGetPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziIDNodesGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesReviewPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5636,41 +10024,25 @@ func (c *Client) sendUziIDNodesGet(ctx context.Context, params UziIDNodesGetPara
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/uzi/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/nodes"
+	var pathParts [1]string
+	pathParts[0] = "/uzi/nodes/review"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUziNodesReviewPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziIDNodesGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziNodesReviewPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5706,7 +10078,7 @@ func (c *Client) sendUziIDNodesGet(ctx context.Context, params UziIDNodesGetPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziIDNodesGetResponse(resp)
+	result, err := decodeUziNodesReviewPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5714,17 +10086,17 @@ func (c *Client) sendUziIDNodesGet(ctx context.Context, params UziIDNodesGetPara
 	return result, nil
 }
 
-// UziIDNodesSegmentsPost invokes POST /uzi/{id}/nodes-segments operation.
+// UziPost invokes POST /uzi operation.
 //
-// Добавить узел с сегментами.
+// Загрузить узи на обработку.
 //
-// POST /uzi/{id}/nodes-segments
-func (c *Client) UziIDNodesSegmentsPost(ctx context.Context, request *UziIDNodesSegmentsPostReq, params UziIDNodesSegmentsPostParams) (UziIDNodesSegmentsPostRes, error) {
-	res, err := c.sendUziIDNodesSegmentsPost(ctx, request, params)
+// POST /uzi
+func (c *Client) UziPost(ctx context.Context, request *UziPostReq) (UziPostRes, error) {
+	res, err := c.sendUziPost(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUziIDNodesSegmentsPost(ctx context.Context, request *UziIDNodesSegmentsPostReq, params UziIDNodesSegmentsPostParams) (res UziIDNodesSegmentsPostRes, err error) {
+func (c *Client) sendUziPost(ctx context.Context, request *UziPostReq) (res UziPostRes, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -5736,7 +10108,7 @@ func (c *Client) sendUziIDNodesSegmentsPost(ctx context.Context, request *UziIDN
 	}
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/nodes-segments"),
+		semconv.HTTPRouteKey.String("/uzi"),
 	}
 
 	// Run stopwatch.
@@ -5751,7 +10123,7 @@ func (c *Client) sendUziIDNodesSegmentsPost(ctx context.Context, request *UziIDN
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziIDNodesSegmentsPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5768,27 +10140,8 @@ func (c *Client) sendUziIDNodesSegmentsPost(ctx context.Context, request *UziIDN
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/uzi/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/nodes-segments"
+	var pathParts [1]string
+	pathParts[0] = "/uzi"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -5796,7 +10149,7 @@ func (c *Client) sendUziIDNodesSegmentsPost(ctx context.Context, request *UziIDN
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUziIDNodesSegmentsPostRequest(request, r); err != nil {
+	if err := encodeUziPostRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -5805,7 +10158,7 @@ func (c *Client) sendUziIDNodesSegmentsPost(ctx context.Context, request *UziIDN
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziIDNodesSegmentsPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5841,7 +10194,7 @@ func (c *Client) sendUziIDNodesSegmentsPost(ctx context.Context, request *UziIDN
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziIDNodesSegmentsPostResponse(resp)
+	result, err := decodeUziPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5849,29 +10202,21 @@ func (c *Client) sendUziIDNodesSegmentsPost(ctx context.Context, request *UziIDN
 	return result, nil
 }
 
-// UziIDPatch invokes PATCH /uzi/{id} operation.
+// UziSegmentDraftsIDAcceptPost invokes POST /uzi/segment-drafts/{id}/accept operation.
 //
-// Обновить узи.
+// Из черновика создается ручной сегмент узла, черновик
+// удаляется.
 //
-// PATCH /uzi/{id}
-func (c *Client) UziIDPatch(ctx context.Context, request *UziIDPatchReq, params UziIDPatchParams) (UziIDPatchRes, error) {
-	res, err := c.sendUziIDPatch(ctx, request, params)
+// POST /uzi/segment-drafts/{id}/accept
+func (c *Client) UziSegmentDraftsIDAcceptPost(ctx context.Context, params UziSegmentDraftsIDAcceptPostParams) (UziSegmentDraftsIDAcceptPostRes, error) {
+	res, err := c.sendUziSegmentDraftsIDAcceptPost(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziIDPatch(ctx context.Context, request *UziIDPatchReq, params UziIDPatchParams) (res UziIDPatchRes, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendUziSegmentDraftsIDAcceptPost(ctx context.Context, params UziSegmentDraftsIDAcceptPostParams) (res UziSegmentDraftsIDAcceptPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/uzi/{id}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/segment-drafts/{id}/accept"),
 	}
 
 	// Run stopwatch.
@@ -5886,7 +10231,7 @@ func (c *Client) sendUziIDPatch(ctx context.Context, request *UziIDPatchReq, par
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziIDPatchOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziSegmentDraftsIDAcceptPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5903,8 +10248,8 @@ func (c *Client) sendUziIDPatch(ctx context.Context, request *UziIDPatchReq, par
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/uzi/"
+	var pathParts [3]string
+	pathParts[0] = "/uzi/segment-drafts/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5923,23 +10268,21 @@ func (c *Client) sendUziIDPatch(ctx context.Context, request *UziIDPatchReq, par
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/accept"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUziIDPatchRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziIDPatchOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziSegmentDraftsIDAcceptPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5975,7 +10318,7 @@ func (c *Client) sendUziIDPatch(ctx context.Context, request *UziIDPatchReq, par
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziIDPatchResponse(resp)
+	result, err := decodeUziSegmentDraftsIDAcceptPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5983,20 +10326,20 @@ func (c *Client) sendUziIDPatch(ctx context.Context, request *UziIDPatchReq, par
 	return result, nil
 }
 
-// UziImageIDNodesSegmentsGet invokes GET /uzi/image/{id}/nodes-segments operation.
+// UziSegmentDraftsIDDelete invokes DELETE /uzi/segment-drafts/{id} operation.
 //
-// Получит узлы и сегменты на указанном изображении.
+// Отклонить черновик.
 //
-// GET /uzi/image/{id}/nodes-segments
-func (c *Client) UziImageIDNodesSegmentsGet(ctx context.Context, params UziImageIDNodesSegmentsGetParams) (UziImageIDNodesSegmentsGetRes, error) {
-	res, err := c.sendUziImageIDNodesSegmentsGet(ctx, params)
+// DELETE /uzi/segment-drafts/{id}
+func (c *Client) UziSegmentDraftsIDDelete(ctx context.Context, params UziSegmentDraftsIDDeleteParams) (UziSegmentDraftsIDDeleteRes, error) {
+	res, err := c.sendUziSegmentDraftsIDDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziImageIDNodesSegmentsGet(ctx context.Context, params UziImageIDNodesSegmentsGetParams) (res UziImageIDNodesSegmentsGetRes, err error) {
+func (c *Client) sendUziSegmentDraftsIDDelete(ctx context.Context, params UziSegmentDraftsIDDeleteParams) (res UziSegmentDraftsIDDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/image/{id}/nodes-segments"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/segment-drafts/{id}"),
 	}
 
 	// Run stopwatch.
@@ -6011,7 +10354,7 @@ func (c *Client) sendUziImageIDNodesSegmentsGet(ctx context.Context, params UziI
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziImageIDNodesSegmentsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziSegmentDraftsIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6028,8 +10371,8 @@ func (c *Client) sendUziImageIDNodesSegmentsGet(ctx context.Context, params UziI
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/uzi/image/"
+	var pathParts [2]string
+	pathParts[0] = "/uzi/segment-drafts/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -6048,11 +10391,10 @@ func (c *Client) sendUziImageIDNodesSegmentsGet(ctx context.Context, params UziI
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/nodes-segments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -6062,7 +10404,7 @@ func (c *Client) sendUziImageIDNodesSegmentsGet(ctx context.Context, params UziI
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziImageIDNodesSegmentsGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziSegmentDraftsIDDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6098,7 +10440,7 @@ func (c *Client) sendUziImageIDNodesSegmentsGet(ctx context.Context, params UziI
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziImageIDNodesSegmentsGetResponse(resp)
+	result, err := decodeUziSegmentDraftsIDDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6106,20 +10448,20 @@ func (c *Client) sendUziImageIDNodesSegmentsGet(ctx context.Context, params UziI
 	return result, nil
 }
 
-// UziNodesIDDelete invokes DELETE /uzi/nodes/{id} operation.
+// UziSegmentIDDelete invokes DELETE /uzi/segment/{id} operation.
 //
-// Сегменты узла будут также удалены.
+// Если у узла не останется сегментов, он будет **удален**.
 //
-// DELETE /uzi/nodes/{id}
-func (c *Client) UziNodesIDDelete(ctx context.Context, params UziNodesIDDeleteParams) (UziNodesIDDeleteRes, error) {
-	res, err := c.sendUziNodesIDDelete(ctx, params)
+// DELETE /uzi/segment/{id}
+func (c *Client) UziSegmentIDDelete(ctx context.Context, params UziSegmentIDDeleteParams) (UziSegmentIDDeleteRes, error) {
+	res, err := c.sendUziSegmentIDDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziNodesIDDelete(ctx context.Context, params UziNodesIDDeleteParams) (res UziNodesIDDeleteRes, err error) {
+func (c *Client) sendUziSegmentIDDelete(ctx context.Context, params UziSegmentIDDeleteParams) (res UziSegmentIDDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/uzi/nodes/{id}"),
+		semconv.HTTPRouteKey.String("/uzi/segment/{id}"),
 	}
 
 	// Run stopwatch.
@@ -6134,7 +10476,7 @@ func (c *Client) sendUziNodesIDDelete(ctx context.Context, params UziNodesIDDele
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziSegmentIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6152,7 +10494,7 @@ func (c *Client) sendUziNodesIDDelete(ctx context.Context, params UziNodesIDDele
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/uzi/nodes/"
+	pathParts[0] = "/uzi/segment/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -6184,7 +10526,7 @@ func (c *Client) sendUziNodesIDDelete(ctx context.Context, params UziNodesIDDele
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziNodesIDDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziSegmentIDDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6220,7 +10562,7 @@ func (c *Client) sendUziNodesIDDelete(ctx context.Context, params UziNodesIDDele
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziNodesIDDeleteResponse(resp)
+	result, err := decodeUziSegmentIDDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6228,17 +10570,17 @@ func (c *Client) sendUziNodesIDDelete(ctx context.Context, params UziNodesIDDele
 	return result, nil
 }
 
-// UziNodesIDPatch invokes PATCH /uzi/nodes/{id} operation.
+// UziSegmentIDPatch invokes PATCH /uzi/segment/{id} operation.
 //
-// Обновить узел.
+// Обновить сегмент.
 //
-// PATCH /uzi/nodes/{id}
-func (c *Client) UziNodesIDPatch(ctx context.Context, request *UziNodesIDPatchReq, params UziNodesIDPatchParams) (UziNodesIDPatchRes, error) {
-	res, err := c.sendUziNodesIDPatch(ctx, request, params)
+// PATCH /uzi/segment/{id}
+func (c *Client) UziSegmentIDPatch(ctx context.Context, request *UziSegmentIDPatchReq, params UziSegmentIDPatchParams) (UziSegmentIDPatchRes, error) {
+	res, err := c.sendUziSegmentIDPatch(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUziNodesIDPatch(ctx context.Context, request *UziNodesIDPatchReq, params UziNodesIDPatchParams) (res UziNodesIDPatchRes, err error) {
+func (c *Client) sendUziSegmentIDPatch(ctx context.Context, request *UziSegmentIDPatchReq, params UziSegmentIDPatchParams) (res UziSegmentIDPatchRes, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -6250,7 +10592,7 @@ func (c *Client) sendUziNodesIDPatch(ctx context.Context, request *UziNodesIDPat
 	}
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/uzi/nodes/{id}"),
+		semconv.HTTPRouteKey.String("/uzi/segment/{id}"),
 	}
 
 	// Run stopwatch.
@@ -6265,7 +10607,7 @@ func (c *Client) sendUziNodesIDPatch(ctx context.Context, request *UziNodesIDPat
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDPatchOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziSegmentIDPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6283,7 +10625,7 @@ func (c *Client) sendUziNodesIDPatch(ctx context.Context, request *UziNodesIDPat
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/uzi/nodes/"
+	pathParts[0] = "/uzi/segment/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -6309,7 +10651,7 @@ func (c *Client) sendUziNodesIDPatch(ctx context.Context, request *UziNodesIDPat
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUziNodesIDPatchRequest(request, r); err != nil {
+	if err := encodeUziSegmentIDPatchRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -6318,7 +10660,7 @@ func (c *Client) sendUziNodesIDPatch(ctx context.Context, request *UziNodesIDPat
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziNodesIDPatchOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziSegmentIDPatchOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6354,7 +10696,7 @@ func (c *Client) sendUziNodesIDPatch(ctx context.Context, request *UziNodesIDPat
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziNodesIDPatchResponse(resp)
+	result, err := decodeUziSegmentIDPatchResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6362,20 +10704,25 @@ func (c *Client) sendUziNodesIDPatch(ctx context.Context, request *UziNodesIDPat
 	return result, nil
 }
 
-// UziNodesIDSegmentsGet invokes GET /uzi/nodes/{id}/segments operation.
+// UziSegmentIDPropagatePost invokes POST /uzi/segment/{id}/propagate operation.
 //
-// Получить сегменты узла.
+// Контур сегмента переносится на соседние кадры узи,
+// результат сохраняется черновиками.
+// В каждую сторону распространение останавливается на
+// кадре, где у узла уже есть сегмент, или при потере
+// контура.
+// Прежние черновики узла на тех же кадрах заменяются.
 //
-// GET /uzi/nodes/{id}/segments
-func (c *Client) UziNodesIDSegmentsGet(ctx context.Context, params UziNodesIDSegmentsGetParams) (UziNodesIDSegmentsGetRes, error) {
-	res, err := c.sendUziNodesIDSegmentsGet(ctx, params)
+// POST /uzi/segment/{id}/propagate
+func (c *Client) UziSegmentIDPropagatePost(ctx context.Context, params UziSegmentIDPropagatePostParams) (UziSegmentIDPropagatePostRes, error) {
+	res, err := c.sendUziSegmentIDPropagatePost(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziNodesIDSegmentsGet(ctx context.Context, params UziNodesIDSegmentsGetParams) (res UziNodesIDSegmentsGetRes, err error) {
+func (c *Client) sendUziSegmentIDPropagatePost(ctx context.Context, params UziSegmentIDPropagatePostParams) (res UziSegmentIDPropagatePostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/segments"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/segment/{id}/propagate"),
 	}
 
 	// Run stopwatch.
@@ -6390,7 +10737,7 @@ func (c *Client) sendUziNodesIDSegmentsGet(ctx context.Context, params UziNodesI
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziNodesIDSegmentsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziSegmentIDPropagatePostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6408,7 +10755,7 @@ func (c *Client) sendUziNodesIDSegmentsGet(ctx context.Context, params UziNodesI
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/uzi/nodes/"
+	pathParts[0] = "/uzi/segment/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -6427,11 +10774,32 @@ func (c *Client) sendUziNodesIDSegmentsGet(ctx context.Context, params UziNodesI
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/segments"
+	pathParts[2] = "/propagate"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "depth" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "depth",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Depth.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -6441,7 +10809,7 @@ func (c *Client) sendUziNodesIDSegmentsGet(ctx context.Context, params UziNodesI
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziNodesIDSegmentsGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziSegmentIDPropagatePostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6477,7 +10845,7 @@ func (c *Client) sendUziNodesIDSegmentsGet(ctx context.Context, params UziNodesI
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziNodesIDSegmentsGetResponse(resp)
+	result, err := decodeUziSegmentIDPropagatePostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6485,17 +10853,17 @@ func (c *Client) sendUziNodesIDSegmentsGet(ctx context.Context, params UziNodesI
 	return result, nil
 }
 
-// UziPost invokes POST /uzi operation.
+// UziSegmentPost invokes POST /uzi/segment operation.
 //
-// Загрузить узи на обработку.
+// Добавить новый сегмент.
 //
-// POST /uzi
-func (c *Client) UziPost(ctx context.Context, request *UziPostReq) (UziPostRes, error) {
-	res, err := c.sendUziPost(ctx, request)
+// POST /uzi/segment
+func (c *Client) UziSegmentPost(ctx context.Context, request *UziSegmentPostReq) (UziSegmentPostRes, error) {
+	res, err := c.sendUziSegmentPost(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUziPost(ctx context.Context, request *UziPostReq) (res UziPostRes, err error) {
+func (c *Client) sendUziSegmentPost(ctx context.Context, request *UziSegmentPostReq) (res UziSegmentPostRes, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -6507,7 +10875,7 @@ func (c *Client) sendUziPost(ctx context.Context, request *UziPostReq) (res UziP
 	}
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/uzi"),
+		semconv.HTTPRouteKey.String("/uzi/segment"),
 	}
 
 	// Run stopwatch.
@@ -6522,7 +10890,7 @@ func (c *Client) sendUziPost(ctx context.Context, request *UziPostReq) (res UziP
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UziSegmentPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6540,7 +10908,7 @@ func (c *Client) sendUziPost(ctx context.Context, request *UziPostReq) (res UziP
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/uzi"
+	pathParts[0] = "/uzi/segment"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6548,7 +10916,7 @@ func (c *Client) sendUziPost(ctx context.Context, request *UziPostReq) (res UziP
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUziPostRequest(request, r); err != nil {
+	if err := encodeUziSegmentPostRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -6557,7 +10925,7 @@ func (c *Client) sendUziPost(ctx context.Context, request *UziPostReq) (res UziP
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UziSegmentPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6593,7 +10961,7 @@ func (c *Client) sendUziPost(ctx context.Context, request *UziPostReq) (res UziP
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziPostResponse(resp)
+	result, err := decodeUziSegmentPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6601,20 +10969,24 @@ func (c *Client) sendUziPost(ctx context.Context, request *UziPostReq) (res UziP
 	return result, nil
 }
 
-// UziSegmentIDDelete invokes DELETE /uzi/segment/{id} operation.
+// UzisAnalyticsGet invokes GET /uzis/analytics operation.
 //
-// Если у узла не останется сегментов, он будет **удален**.
+// Точность нейросети относительно валидации, доля
+// непровалидированных узлов и распределение классов
+// TI-RADS по всей выборке, аппаратам, авторам и периодам.
+// Для узи, размеченных несколькими врачами, согласие
+// врачей: каппа Коэна и IoU контуров.
 //
-// DELETE /uzi/segment/{id}
-func (c *Client) UziSegmentIDDelete(ctx context.Context, params UziSegmentIDDeleteParams) (UziSegmentIDDeleteRes, error) {
-	res, err := c.sendUziSegmentIDDelete(ctx, params)
+// GET /uzis/analytics
+func (c *Client) UzisAnalyticsGet(ctx context.Context, params UzisAnalyticsGetParams) (UzisAnalyticsGetRes, error) {
+	res, err := c.sendUzisAnalyticsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziSegmentIDDelete(ctx context.Context, params UziSegmentIDDeleteParams) (res UziSegmentIDDeleteRes, err error) {
+func (c *Client) sendUzisAnalyticsGet(ctx context.Context, params UzisAnalyticsGetParams) (res UzisAnalyticsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/uzi/segment/{id}"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzis/analytics"),
 	}
 
 	// Run stopwatch.
@@ -6629,7 +11001,7 @@ func (c *Client) sendUziSegmentIDDelete(ctx context.Context, params UziSegmentID
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziSegmentIDDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UzisAnalyticsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6646,30 +11018,101 @@ func (c *Client) sendUziSegmentIDDelete(ctx context.Context, params UziSegmentID
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/uzi/segment/"
+	var pathParts [1]string
+	pathParts[0] = "/uzis/analytics"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "device_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "device_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DeviceID.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "author_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "author_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AuthorID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "create_from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "create_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CreateFrom.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "create_to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "create_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CreateTo.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "period" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "period",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Period.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -6679,7 +11122,7 @@ func (c *Client) sendUziSegmentIDDelete(ctx context.Context, params UziSegmentID
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziSegmentIDDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UzisAnalyticsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6715,7 +11158,7 @@ func (c *Client) sendUziSegmentIDDelete(ctx context.Context, params UziSegmentID
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziSegmentIDDeleteResponse(resp)
+	result, err := decodeUzisAnalyticsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6723,29 +11166,20 @@ func (c *Client) sendUziSegmentIDDelete(ctx context.Context, params UziSegmentID
 	return result, nil
 }
 
-// UziSegmentIDPatch invokes PATCH /uzi/segment/{id} operation.
+// UzisAuthorIDGet invokes GET /uzis/author/{id} operation.
 //
-// Обновить сегмент.
+// Получить узи по id автора.
 //
-// PATCH /uzi/segment/{id}
-func (c *Client) UziSegmentIDPatch(ctx context.Context, request *UziSegmentIDPatchReq, params UziSegmentIDPatchParams) (UziSegmentIDPatchRes, error) {
-	res, err := c.sendUziSegmentIDPatch(ctx, request, params)
+// GET /uzis/author/{id}
+func (c *Client) UzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGetParams) (UzisAuthorIDGetRes, error) {
+	res, err := c.sendUzisAuthorIDGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziSegmentIDPatch(ctx context.Context, request *UziSegmentIDPatchReq, params UziSegmentIDPatchParams) (res UziSegmentIDPatchRes, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendUzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGetParams) (res UzisAuthorIDGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/uzi/segment/{id}"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzis/author/{id}"),
 	}
 
 	// Run stopwatch.
@@ -6760,7 +11194,7 @@ func (c *Client) sendUziSegmentIDPatch(ctx context.Context, request *UziSegmentI
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziSegmentIDPatchOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UzisAuthorIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6778,7 +11212,7 @@ func (c *Client) sendUziSegmentIDPatch(ctx context.Context, request *UziSegmentI
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/uzi/segment/"
+	pathParts[0] = "/uzis/author/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -6800,20 +11234,17 @@ func (c *Client) sendUziSegmentIDPatch(ctx context.Context, request *UziSegmentI
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUziSegmentIDPatchRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziSegmentIDPatchOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UzisAuthorIDGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6849,7 +11280,7 @@ func (c *Client) sendUziSegmentIDPatch(ctx context.Context, request *UziSegmentI
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziSegmentIDPatchResponse(resp)
+	result, err := decodeUzisAuthorIDGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6857,29 +11288,20 @@ func (c *Client) sendUziSegmentIDPatch(ctx context.Context, request *UziSegmentI
 	return result, nil
 }
 
-// UziSegmentPost invokes POST /uzi/segment operation.
+// UzisExternalIDGet invokes GET /uzis/external/{id} operation.
 //
-// Добавить новый сегмент.
+// Получить узи по внешнему id.
 //
-// POST /uzi/segment
-func (c *Client) UziSegmentPost(ctx context.Context, request *UziSegmentPostReq) (UziSegmentPostRes, error) {
-	res, err := c.sendUziSegmentPost(ctx, request)
+// GET /uzis/external/{id}
+func (c *Client) UzisExternalIDGet(ctx context.Context, params UzisExternalIDGetParams) (UzisExternalIDGetRes, error) {
+	res, err := c.sendUzisExternalIDGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUziSegmentPost(ctx context.Context, request *UziSegmentPostReq) (res UziSegmentPostRes, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendUzisExternalIDGet(ctx context.Context, params UzisExternalIDGetParams) (res UzisExternalIDGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/uzi/segment"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzis/external/{id}"),
 	}
 
 	// Run stopwatch.
@@ -6894,7 +11316,7 @@ func (c *Client) sendUziSegmentPost(ctx context.Context, request *UziSegmentPost
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UziSegmentPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UzisExternalIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6911,25 +11333,40 @@ func (c *Client) sendUziSegmentPost(ctx context.Context, request *UziSegmentPost
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/uzi/segment"
+	var pathParts [2]string
+	pathParts[0] = "/uzis/external/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUziSegmentPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UziSegmentPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UzisExternalIDGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6965,7 +11402,7 @@ func (c *Client) sendUziSegmentPost(ctx context.Context, request *UziSegmentPost
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUziSegmentPostResponse(resp)
+	result, err := decodeUzisExternalIDGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6973,20 +11410,20 @@ func (c *Client) sendUziSegmentPost(ctx context.Context, request *UziSegmentPost
 	return result, nil
 }
 
-// UzisAuthorIDGet invokes GET /uzis/author/{id} operation.
+// UzisExternalIDGrowthGet invokes GET /uzis/external/{id}/growth operation.
 //
-// Получить узи по id автора.
+// Получить динамику отслеживаемых узлов пациента.
 //
-// GET /uzis/author/{id}
-func (c *Client) UzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGetParams) (UzisAuthorIDGetRes, error) {
-	res, err := c.sendUzisAuthorIDGet(ctx, params)
+// GET /uzis/external/{id}/growth
+func (c *Client) UzisExternalIDGrowthGet(ctx context.Context, params UzisExternalIDGrowthGetParams) (UzisExternalIDGrowthGetRes, error) {
+	res, err := c.sendUzisExternalIDGrowthGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGetParams) (res UzisAuthorIDGetRes, err error) {
+func (c *Client) sendUzisExternalIDGrowthGet(ctx context.Context, params UzisExternalIDGrowthGetParams) (res UzisExternalIDGrowthGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzis/author/{id}"),
+		semconv.HTTPRouteKey.String("/uzis/external/{id}/growth"),
 	}
 
 	// Run stopwatch.
@@ -7001,7 +11438,7 @@ func (c *Client) sendUzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGet
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UzisAuthorIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UzisExternalIDGrowthGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7018,8 +11455,8 @@ func (c *Client) sendUzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGet
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/uzis/author/"
+	var pathParts [3]string
+	pathParts[0] = "/uzis/external/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -7038,6 +11475,7 @@ func (c *Client) sendUzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGet
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/growth"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -7051,7 +11489,7 @@ func (c *Client) sendUzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGet
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UzisAuthorIDGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UzisExternalIDGrowthGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7087,7 +11525,7 @@ func (c *Client) sendUzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGet
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUzisAuthorIDGetResponse(resp)
+	result, err := decodeUzisExternalIDGrowthGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7095,20 +11533,22 @@ func (c *Client) sendUzisAuthorIDGet(ctx context.Context, params UzisAuthorIDGet
 	return result, nil
 }
 
-// UzisExternalIDGet invokes GET /uzis/external/{id} operation.
+// UzisSearchGet invokes GET /uzis/search operation.
 //
-// Получить узи по внешнему id.
+// Все фильтры необязательны. Узи отсортированы по дате
+// создания, для следующей страницы передайте next_cursor из
+// предыдущего ответа.
 //
-// GET /uzis/external/{id}
-func (c *Client) UzisExternalIDGet(ctx context.Context, params UzisExternalIDGetParams) (UzisExternalIDGetRes, error) {
-	res, err := c.sendUzisExternalIDGet(ctx, params)
+// GET /uzis/search
+func (c *Client) UzisSearchGet(ctx context.Context, params UzisSearchGetParams) (UzisSearchGetRes, error) {
+	res, err := c.sendUzisSearchGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUzisExternalIDGet(ctx context.Context, params UzisExternalIDGetParams) (res UzisExternalIDGetRes, err error) {
+func (c *Client) sendUzisSearchGet(ctx context.Context, params UzisSearchGetParams) (res UzisSearchGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzis/external/{id}"),
+		semconv.HTTPRouteKey.String("/uzis/search"),
 	}
 
 	// Run stopwatch.
@@ -7123,7 +11563,7 @@ func (c *Client) sendUzisExternalIDGet(ctx context.Context, params UzisExternalI
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UzisExternalIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UzisSearchGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7140,27 +11580,234 @@ func (c *Client) sendUzisExternalIDGet(ctx context.Context, params UzisExternalI
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/uzis/external/"
+	var pathParts [1]string
+	pathParts[0] = "/uzis/search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "author_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "author_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AuthorID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "external_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "external_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ExternalID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "projection" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projection",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Projection.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "device_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "device_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DeviceID.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "checked" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "checked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Checked.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "create_from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "create_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CreateFrom.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "create_to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "create_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CreateTo.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "ai_tirads_4_min" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "ai_tirads_4_min",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AiTirads4Min.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "ai_tirads_5_min" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "ai_tirads_5_min",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AiTirads5Min.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -7173,7 +11820,7 @@ func (c *Client) sendUzisExternalIDGet(ctx context.Context, params UzisExternalI
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UzisExternalIDGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UzisSearchGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7209,7 +11856,7 @@ func (c *Client) sendUzisExternalIDGet(ctx context.Context, params UzisExternalI
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUzisExternalIDGetResponse(resp)
+	result, err := decodeUzisSearchGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3,6 +3,7 @@
 package api
 
 import (
+	"fmt"
 	"net/url"
 	"time"
 
@@ -10,6 +11,44 @@ import (
 	"github.com/google/uuid"
 )
 
+// SetFake set fake values.
+func (s *AuthorNodeMetrics) SetFake() {
+	{
+		{
+			s.AuthorID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Metrics.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BoundingBox) SetFake() {
+	{
+		{
+			s.X = int(0)
+		}
+	}
+	{
+		{
+			s.Y = int(0)
+		}
+	}
+	{
+		{
+			s.Width = int(0)
+		}
+	}
+	{
+		{
+			s.Height = int(0)
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *Card) SetFake() {
 	{
@@ -31,34 +70,63 @@ func (s *Card) SetFake() {
 
 // SetFake set fake values.
 func (s *Contor) SetFake() {
-	var unwrapped []ContorItem
 	{
-		unwrapped = nil
-		for i := 0; i < 0; i++ {
-			var elem ContorItem
-			{
-				elem.SetFake()
-			}
-			unwrapped = append(unwrapped, elem)
+		{
+			s.Type.SetFake()
 		}
 	}
-	*s = Contor(unwrapped)
-}
-
-// SetFake set fake values.
-func (s *ContorItem) SetFake() {
 	{
 		{
-			s.X = int(0)
+			s.Version.SetFake()
 		}
 	}
 	{
 		{
-			s.Y = int(0)
+			s.Coordinates = nil
+			for i := 0; i < 1; i++ {
+				var elem [][]float64
+				{
+					elem = nil
+					for i := 0; i < 4; i++ {
+						var elemElem []float64
+						{
+							elemElem = nil
+							for i := 0; i < 2; i++ {
+								var elemElemElem float64
+								{
+									elemElemElem = float64(0)
+								}
+								elemElem = append(elemElem, elemElemElem)
+							}
+						}
+						elem = append(elem, elemElem)
+					}
+				}
+				s.Coordinates = append(s.Coordinates, elem)
+			}
 		}
 	}
 }
 
+// SetFake set fake values.
+func (s *ContorType) SetFake() {
+	*s = ContorTypePolygon
+}
+
+// SetFake set fake values.
+func (s *ContorVersion) SetFake() {
+	*s = ContorVersion1
+}
+
+// SetFake set fake values.
+func (s *CytologyColor) SetFake() {
+	var unwrapped string
+	{
+		unwrapped = "string"
+	}
+	*s = CytologyColor(unwrapped)
+}
+
 // SetFake set fake values.
 func (s *CytologyCopyCreateCreated) SetFake() {
 	{
@@ -175,6 +243,11 @@ func (s *CytologyCreateCreateCreatedMaterialType) SetFake() {
 	*s = CytologyCreateCreateCreatedMaterialTypeGS
 }
 
+// SetFake set fake values.
+func (s *CytologyGeometryType) SetFake() {
+	*s = CytologyGeometryTypePoint
+}
+
 // SetFake set fake values.
 func (s *CytologyHistoryReadOK) SetFake() {
 	{
@@ -399,6 +472,52 @@ func (s *CytologyPatientShotsReadOK) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *CytologyQupathImport) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Added = int(0)
+		}
+	}
+	{
+		{
+			s.Removed = int(0)
+		}
+	}
+	{
+		{
+			s.Changed = int(0)
+		}
+	}
+	{
+		{
+			s.Unchanged = int(0)
+		}
+	}
+	{
+		{
+			s.Skipped = int(0)
+		}
+	}
+	{
+		{
+			s.UnknownClasses = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.UnknownClasses = append(s.UnknownClasses, elem)
+			}
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *CytologyReadOK) SetFake() {
 	{
@@ -596,6 +715,16 @@ func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) SetFake() {
 			s.Y = int(0)
 		}
 	}
+	{
+		{
+			s.Polygon.SetFake()
+		}
+	}
+	{
+		{
+			s.Ring.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -626,6 +755,31 @@ func (s *CytologySegmentGroupCreateCreateReqData) SetFake() {
 			}
 		}
 	}
+	{
+		{
+			s.GeometryType.SetFake()
+		}
+	}
+	{
+		{
+			s.Color.SetFake()
+		}
+	}
+	{
+		{
+			s.IsLocked.SetFake()
+		}
+	}
+	{
+		{
+			s.Confidence.SetFake()
+		}
+	}
+	{
+		{
+			s.Properties.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -640,6 +794,16 @@ func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) SetFake() {
 			s.Y = int(0)
 		}
 	}
+	{
+		{
+			s.Polygon.SetFake()
+		}
+	}
+	{
+		{
+			s.Ring.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -647,6 +811,17 @@ func (s *CytologySegmentGroupCreateCreateReqSegType) SetFake() {
 	*s = CytologySegmentGroupCreateCreateReqSegTypeNIL
 }
 
+// SetFake set fake values.
+func (s *CytologySegmentProperties) SetFake() {
+	var (
+		elem jx.Raw
+		m    map[string]jx.Raw = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
 // SetFake set fake values.
 func (s *CytologySegmentUpdatePartialUpdateOK) SetFake() {
 	{
@@ -666,6 +841,31 @@ func (s *CytologySegmentUpdatePartialUpdateOK) SetFake() {
 			s.SegmentGroup.SetFake()
 		}
 	}
+	{
+		{
+			s.GeometryType.SetFake()
+		}
+	}
+	{
+		{
+			s.Color.SetFake()
+		}
+	}
+	{
+		{
+			s.IsLocked.SetFake()
+		}
+	}
+	{
+		{
+			s.Confidence.SetFake()
+		}
+	}
+	{
+		{
+			s.Properties.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -680,6 +880,16 @@ func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) SetFake() {
 			s.Y = int(0)
 		}
 	}
+	{
+		{
+			s.Polygon.SetFake()
+		}
+	}
+	{
+		{
+			s.Ring.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -701,6 +911,31 @@ func (s *CytologySegmentUpdatePartialUpdateReq) SetFake() {
 			s.SegmentGroup.SetFake()
 		}
 	}
+	{
+		{
+			s.GeometryType.SetFake()
+		}
+	}
+	{
+		{
+			s.Color.SetFake()
+		}
+	}
+	{
+		{
+			s.IsLocked.SetFake()
+		}
+	}
+	{
+		{
+			s.Confidence.SetFake()
+		}
+	}
+	{
+		{
+			s.Properties.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -715,6 +950,16 @@ func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) SetFake() {
 			s.Y = int(0)
 		}
 	}
+	{
+		{
+			s.Polygon.SetFake()
+		}
+	}
+	{
+		{
+			s.Ring.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -736,6 +981,31 @@ func (s *CytologySegmentUpdateReadOK) SetFake() {
 			s.SegmentGroup.SetFake()
 		}
 	}
+	{
+		{
+			s.GeometryType.SetFake()
+		}
+	}
+	{
+		{
+			s.Color.SetFake()
+		}
+	}
+	{
+		{
+			s.IsLocked.SetFake()
+		}
+	}
+	{
+		{
+			s.Confidence.SetFake()
+		}
+	}
+	{
+		{
+			s.Properties.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -750,6 +1020,16 @@ func (s *CytologySegmentUpdateReadOKPointsItem) SetFake() {
 			s.Y = int(0)
 		}
 	}
+	{
+		{
+			s.Polygon.SetFake()
+		}
+	}
+	{
+		{
+			s.Ring.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -771,6 +1051,31 @@ func (s *CytologySegmentUpdateUpdateOK) SetFake() {
 			s.SegmentGroup.SetFake()
 		}
 	}
+	{
+		{
+			s.GeometryType.SetFake()
+		}
+	}
+	{
+		{
+			s.Color.SetFake()
+		}
+	}
+	{
+		{
+			s.IsLocked.SetFake()
+		}
+	}
+	{
+		{
+			s.Confidence.SetFake()
+		}
+	}
+	{
+		{
+			s.Properties.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -785,6 +1090,16 @@ func (s *CytologySegmentUpdateUpdateOKPointsItem) SetFake() {
 			s.Y = int(0)
 		}
 	}
+	{
+		{
+			s.Polygon.SetFake()
+		}
+	}
+	{
+		{
+			s.Ring.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -806,13 +1121,38 @@ func (s *CytologySegmentUpdateUpdateReq) SetFake() {
 			s.SegmentGroup.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *CytologySegmentUpdateUpdateReqPointsItem) SetFake() {
 	{
 		{
-			s.X = int(0)
+			s.GeometryType.SetFake()
+		}
+	}
+	{
+		{
+			s.Color.SetFake()
+		}
+	}
+	{
+		{
+			s.IsLocked.SetFake()
+		}
+	}
+	{
+		{
+			s.Confidence.SetFake()
+		}
+	}
+	{
+		{
+			s.Properties.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *CytologySegmentUpdateUpdateReqPointsItem) SetFake() {
+	{
+		{
+			s.X = int(0)
 		}
 	}
 	{
@@ -820,6 +1160,37 @@ func (s *CytologySegmentUpdateUpdateReqPointsItem) SetFake() {
 			s.Y = int(0)
 		}
 	}
+	{
+		{
+			s.Polygon.SetFake()
+		}
+	}
+	{
+		{
+			s.Ring.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *CytologySegmentationPage) SetFake() {
+	{
+		{
+			s.Segmentations = nil
+			for i := 0; i < 0; i++ {
+				var elem Segmentation
+				{
+					elem.SetFake()
+				}
+				s.Segmentations = append(s.Segmentations, elem)
+			}
+		}
+	}
+	{
+		{
+			s.NextCursor.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -918,6 +1289,31 @@ func (s *CytologySegmentsListOKResultsItemDataItem) SetFake() {
 			s.Details.SetFake()
 		}
 	}
+	{
+		{
+			s.GeometryType.SetFake()
+		}
+	}
+	{
+		{
+			s.Color.SetFake()
+		}
+	}
+	{
+		{
+			s.IsLocked.SetFake()
+		}
+	}
+	{
+		{
+			s.Confidence.SetFake()
+		}
+	}
+	{
+		{
+			s.Properties.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -942,6 +1338,16 @@ func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) SetFake() {
 			s.Y = int(0)
 		}
 	}
+	{
+		{
+			s.Polygon.SetFake()
+		}
+	}
+	{
+		{
+			s.Ring.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -1509,6 +1915,45 @@ func (s *Device) SetFake() {
 			s.Name = "string"
 		}
 	}
+	{
+		{
+			s.Manufacturer.SetFake()
+		}
+	}
+	{
+		{
+			s.Model.SetFake()
+		}
+	}
+	{
+		{
+			s.SerialNumber.SetFake()
+		}
+	}
+	{
+		{
+			s.ProbeType.SetFake()
+		}
+	}
+	{
+		{
+			s.PixelSpacing.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DeviceNodeMetrics) SetFake() {
+	{
+		{
+			s.DeviceID = int(0)
+		}
+	}
+	{
+		{
+			s.Metrics.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -1540,6 +1985,35 @@ func (s *Doctor) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *EchographicFlag) SetFake() {
+	{
+		{
+			s.Field.SetFake()
+		}
+	}
+	{
+		{
+			s.Value = float64(0)
+		}
+	}
+	{
+		{
+			s.Min.SetFake()
+		}
+	}
+	{
+		{
+			s.Max.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *EchographicFlagField) SetFake() {
+	*s = EchographicFlagFieldGlandVolum
+}
+
 // SetFake set fake values.
 func (s *Echographics) SetFake() {
 	{
@@ -1637,6 +2111,53 @@ func (s *Echographics) SetFake() {
 			s.Conclusion.SetFake()
 		}
 	}
+	{
+		{
+			s.PatientSex.SetFake()
+		}
+	}
+	{
+		{
+			s.PatientAge.SetFake()
+		}
+	}
+	{
+		{
+			s.Flags = nil
+			for i := 0; i < 0; i++ {
+				var elem EchographicFlag
+				{
+					elem.SetFake()
+				}
+				s.Flags = append(s.Flags, elem)
+			}
+		}
+	}
+	{
+		{
+			s.GeneratedConclusion.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *EchographicsEchogenicity) SetFake() {
+	*s = EchographicsEchogenicityNormal
+}
+
+// SetFake set fake values.
+func (s *EchographicsPatientSex) SetFake() {
+	*s = EchographicsPatientSexMale
+}
+
+// SetFake set fake values.
+func (s *EchographicsStruct) SetFake() {
+	*s = EchographicsStructHomogeneous
+}
+
+// SetFake set fake values.
+func (s *EchographicsVascularization) SetFake() {
+	*s = EchographicsVascularizationNormal
 }
 
 // SetFake set fake values.
@@ -1665,6 +2186,57 @@ func (s *Image) SetFake() {
 			s.Page = int(0)
 		}
 	}
+	{
+		{
+			s.Width = int(0)
+		}
+	}
+	{
+		{
+			s.Height = int(0)
+		}
+	}
+	{
+		{
+			s.Variants = nil
+			for i := 0; i < 0; i++ {
+				var elem ImageVariant
+				{
+					elem.SetFake()
+				}
+				s.Variants = append(s.Variants, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ImageSize) SetFake() {
+	*s = ImageSizeOriginal
+}
+
+// SetFake set fake values.
+func (s *ImageVariant) SetFake() {
+	{
+		{
+			s.Size.SetFake()
+		}
+	}
+	{
+		{
+			s.ContentType = "string"
+		}
+	}
+	{
+		{
+			s.Width = int(0)
+		}
+	}
+	{
+		{
+			s.Height = int(0)
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -1695,6 +2267,11 @@ func (s *LoginPostReq) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *MeasureUnit) SetFake() {
+	*s = MeasureUnitPx
+}
+
 // SetFake set fake values.
 func (s *MedCardDoctorIDPatientIDPatchReq) SetFake() {
 	{
@@ -1810,115 +2387,301 @@ func (s *Node) SetFake() {
 			s.Description.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *NodeValidation) SetFake() {
-	*s = NodeValidationInvalid
-}
-
-// SetFake set fake values.
-func (s *OptBool) SetFake() {
-	var elem bool
 	{
-		elem = true
+		{
+			s.Measurement.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptCytologyCreateCreateCreatedDiagnosticMarking) SetFake() {
-	var elem CytologyCreateCreateCreatedDiagnosticMarking
 	{
-		elem.SetFake()
+		{
+			s.Lobe.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptCytologyCreateCreateCreatedMaterialType) SetFake() {
-	var elem CytologyCreateCreateCreatedMaterialType
+func (s *NodeDescriptors) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.Composition.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) SetFake() {
-	var elem CytologyHistoryReadOKResultsItemDiagnosticMarking
 	{
-		elem.SetFake()
+		{
+			s.Echogenicity.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptCytologyHistoryReadOKResultsItemMaterialType) SetFake() {
-	var elem CytologyHistoryReadOKResultsItemMaterialType
 	{
-		elem.SetFake()
+		{
+			s.Shape.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
+	{
+		{
+			s.Margin.SetFake()
+		}
+	}
+	{
+		{
+			s.EchogenicFoci = nil
+			for i := 0; i < 0; i++ {
+				var elem NodeDescriptorsEchogenicFociItem
+				{
+					elem.SetFake()
+				}
+				s.EchogenicFoci = append(s.EchogenicFoci, elem)
+			}
+		}
+	}
+}
+
 // SetFake set fake values.
-func (s *OptCytologyPatientShotDiagnosticMarking) SetFake() {
-	var elem CytologyPatientShotDiagnosticMarking
+func (s *NodeDescriptorsComposition) SetFake() {
+	*s = NodeDescriptorsCompositionCystic
+}
+
+// SetFake set fake values.
+func (s *NodeDescriptorsEchogenicFociItem) SetFake() {
+	*s = NodeDescriptorsEchogenicFociItemNone
+}
+
+// SetFake set fake values.
+func (s *NodeDescriptorsEchogenicity) SetFake() {
+	*s = NodeDescriptorsEchogenicityAnechoic
+}
+
+// SetFake set fake values.
+func (s *NodeDescriptorsMargin) SetFake() {
+	*s = NodeDescriptorsMarginSmooth
+}
+
+// SetFake set fake values.
+func (s *NodeDescriptorsShape) SetFake() {
+	*s = NodeDescriptorsShapeWiderThanTall
+}
+
+// SetFake set fake values.
+func (s *NodeGrowth) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.LineageID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Points = nil
+			for i := 0; i < 0; i++ {
+				var elem NodeGrowthPoint
+				{
+					elem.SetFake()
+				}
+				s.Points = append(s.Points, elem)
+			}
+		}
+	}
+	{
+		{
+			s.VolumeChange.SetFake()
+		}
+	}
+	{
+		{
+			s.DoublingTime.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptCytologyPatientShotMaterialType) SetFake() {
-	var elem CytologyPatientShotMaterialType
+func (s *NodeGrowthPoint) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.Node.SetFake()
+		}
+	}
+	{
+		{
+			s.UziID = uuid.New()
+		}
+	}
+	{
+		{
+			s.CreateAt = time.Now()
+		}
+	}
+	{
+		{
+			s.Volume.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptCytologyReadOKInfoImageGroupDiagnosticMarking) SetFake() {
-	var elem CytologyReadOKInfoImageGroupDiagnosticMarking
+func (s *NodeLinkSuggestion) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.Node.SetFake()
+		}
+	}
+	{
+		{
+			s.Score = float64(0)
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptCytologyReadOKInfoImageGroupMaterialType) SetFake() {
-	var elem CytologyReadOKInfoImageGroupMaterialType
+func (s *NodeLobe) SetFake() {
+	*s = NodeLobeRight
+}
+
+// SetFake set fake values.
+func (s *NodeMeasurement) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.Area = float64(0)
+		}
+	}
+	{
+		{
+			s.Perimeter = float64(0)
+		}
+	}
+	{
+		{
+			s.MajorAxis = float64(0)
+		}
+	}
+	{
+		{
+			s.MinorAxis = float64(0)
+		}
+	}
+	{
+		{
+			s.Volume = float64(0)
+		}
+	}
+	{
+		{
+			s.Unit.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptCytologySegmentGroupCreateCreateCreatedData) SetFake() {
-	var elem CytologySegmentGroupCreateCreateCreatedData
+func (s *NodeMetrics) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.Uzis = int(0)
+		}
+	}
+	{
+		{
+			s.AiNodes = int(0)
+		}
+	}
+	{
+		{
+			s.Valid = int(0)
+		}
+	}
+	{
+		{
+			s.Invalid = int(0)
+		}
+	}
+	{
+		{
+			s.Unvalidated = int(0)
+		}
+	}
+	{
+		{
+			s.ManualNodes = int(0)
+		}
+	}
+	{
+		{
+			s.Precision.SetFake()
+		}
+	}
+	{
+		{
+			s.UnvalidatedShare.SetFake()
+		}
+	}
+	{
+		{
+			s.AiTirads.SetFake()
+		}
+	}
+	{
+		{
+			s.ManualTirads.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptCytologySegmentsListOKResultsItemGroupType) SetFake() {
-	var elem CytologySegmentsListOKResultsItemGroupType
+func (s *NodeReviewItem) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.NodeID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.Node.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeReviewItemStatus) SetFake() {
+	*s = NodeReviewItemStatusApplied
+}
+
+// SetFake set fake values.
+func (s *NodeTirads) SetFake() {
+	{
+		{
+			s.Node.SetFake()
+		}
+	}
+	{
+		{
+			s.Descriptors.SetFake()
+		}
+	}
+	{
+		{
+			s.Score.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeValidation) SetFake() {
+	*s = NodeValidationInvalid
+}
+
+// SetFake set fake values.
+func (s *OptBool) SetFake() {
+	var elem bool
+	{
+		elem = true
 	}
 	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptCytologySegmentsListOKResultsItemSegType) SetFake() {
-	var elem CytologySegmentsListOKResultsItemSegType
+func (s *OptContor) SetFake() {
+	var elem Contor
 	{
 		elem.SetFake()
 	}
@@ -1926,8 +2689,8 @@ func (s *OptCytologySegmentsListOKResultsItemSegType) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) SetFake() {
-	var elem CytologyUpdatePartialUpdateReqDiagnosticMarking
+func (s *OptCytologyColor) SetFake() {
+	var elem CytologyColor
 	{
 		elem.SetFake()
 	}
@@ -1935,8 +2698,8 @@ func (s *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *OptCytologyUpdatePartialUpdateReqMaterialType) SetFake() {
-	var elem CytologyUpdatePartialUpdateReqMaterialType
+func (s *OptCytologyCreateCreateCreatedDiagnosticMarking) SetFake() {
+	var elem CytologyCreateCreateCreatedDiagnosticMarking
 	{
 		elem.SetFake()
 	}
@@ -1944,8 +2707,8 @@ func (s *OptCytologyUpdatePartialUpdateReqMaterialType) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *OptCytologyUpdateUpdateReqDetails) SetFake() {
-	var elem CytologyUpdateUpdateReqDetails
+func (s *OptCytologyCreateCreateCreatedMaterialType) SetFake() {
+	var elem CytologyCreateCreateCreatedMaterialType
 	{
 		elem.SetFake()
 	}
@@ -1953,8 +2716,8 @@ func (s *OptCytologyUpdateUpdateReqDetails) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) SetFake() {
-	var elem CytologyUpdateUpdateReqDetailsDiagnosticMarking
+func (s *OptCytologyGeometryType) SetFake() {
+	var elem CytologyGeometryType
 	{
 		elem.SetFake()
 	}
@@ -1962,8 +2725,8 @@ func (s *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *OptCytologyUpdateUpdateReqDetailsMaterialType) SetFake() {
-	var elem CytologyUpdateUpdateReqDetailsMaterialType
+func (s *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) SetFake() {
+	var elem CytologyHistoryReadOKResultsItemDiagnosticMarking
 	{
 		elem.SetFake()
 	}
@@ -1971,8 +2734,8 @@ func (s *OptCytologyUpdateUpdateReqDetailsMaterialType) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *OptCytologyUpdateUpdateReqDiagnosticMarking) SetFake() {
-	var elem CytologyUpdateUpdateReqDiagnosticMarking
+func (s *OptCytologyHistoryReadOKResultsItemMaterialType) SetFake() {
+	var elem CytologyHistoryReadOKResultsItemMaterialType
 	{
 		elem.SetFake()
 	}
@@ -1980,8 +2743,8 @@ func (s *OptCytologyUpdateUpdateReqDiagnosticMarking) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *OptCytologyUpdateUpdateReqMaterialType) SetFake() {
-	var elem CytologyUpdateUpdateReqMaterialType
+func (s *OptCytologyPatientShotDiagnosticMarking) SetFake() {
+	var elem CytologyPatientShotDiagnosticMarking
 	{
 		elem.SetFake()
 	}
@@ -1989,37 +2752,208 @@ func (s *OptCytologyUpdateUpdateReqMaterialType) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *OptDate) SetFake() {
-	var elem time.Time
+func (s *OptCytologyPatientShotMaterialType) SetFake() {
+	var elem CytologyPatientShotMaterialType
 	{
-		elem = time.Now()
+		elem.SetFake()
 	}
 	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptDateTime) SetFake() {
-	var elem time.Time
+func (s *OptCytologyReadOKInfoImageGroupDiagnosticMarking) SetFake() {
+	var elem CytologyReadOKInfoImageGroupDiagnosticMarking
 	{
-		elem = time.Now()
+		elem.SetFake()
 	}
 	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptFloat64) SetFake() {
-	var elem float64
+func (s *OptCytologyReadOKInfoImageGroupMaterialType) SetFake() {
+	var elem CytologyReadOKInfoImageGroupMaterialType
 	{
-		elem = float64(0)
+		elem.SetFake()
 	}
 	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptInt) SetFake() {
-	var elem int
+func (s *OptCytologySegmentGroupCreateCreateCreatedData) SetFake() {
+	var elem CytologySegmentGroupCreateCreateCreatedData
 	{
-		elem = int(0)
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologySegmentProperties) SetFake() {
+	var elem CytologySegmentProperties
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologySegmentsListOKResultsItemGroupType) SetFake() {
+	var elem CytologySegmentsListOKResultsItemGroupType
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologySegmentsListOKResultsItemSegType) SetFake() {
+	var elem CytologySegmentsListOKResultsItemSegType
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) SetFake() {
+	var elem CytologyUpdatePartialUpdateReqDiagnosticMarking
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologyUpdatePartialUpdateReqMaterialType) SetFake() {
+	var elem CytologyUpdatePartialUpdateReqMaterialType
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologyUpdateUpdateReqDetails) SetFake() {
+	var elem CytologyUpdateUpdateReqDetails
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) SetFake() {
+	var elem CytologyUpdateUpdateReqDetailsDiagnosticMarking
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologyUpdateUpdateReqDetailsMaterialType) SetFake() {
+	var elem CytologyUpdateUpdateReqDetailsMaterialType
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologyUpdateUpdateReqDiagnosticMarking) SetFake() {
+	var elem CytologyUpdateUpdateReqDiagnosticMarking
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCytologyUpdateUpdateReqMaterialType) SetFake() {
+	var elem CytologyUpdateUpdateReqMaterialType
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDate) SetFake() {
+	var elem time.Time
+	{
+		elem = time.Now()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDateTime) SetFake() {
+	var elem time.Time
+	{
+		elem = time.Now()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptEchographicsEchogenicity) SetFake() {
+	var elem EchographicsEchogenicity
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptEchographicsPatientSex) SetFake() {
+	var elem EchographicsPatientSex
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptEchographicsStruct) SetFake() {
+	var elem EchographicsStruct
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptEchographicsVascularization) SetFake() {
+	var elem EchographicsVascularization
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptFloat64) SetFake() {
+	var elem float64
+	{
+		elem = float64(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptInt) SetFake() {
+	var elem int
+	{
+		elem = int(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptInt64) SetFake() {
+	var elem int64
+	{
+		elem = int64(0)
 	}
 	s.SetTo(elem)
 }
@@ -2042,80 +2976,865 @@ func (s *OptNilUziNodesIDPatchReqValidation) SetFake() {
 	s.Set = true
 }
 
+// SetFake set fake values.
+func (s *OptNode) SetFake() {
+	var elem Node
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptNodeDescriptors) SetFake() {
+	var elem NodeDescriptors
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptNodeLobe) SetFake() {
+	var elem NodeLobe
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptNodeMeasurement) SetFake() {
+	var elem NodeMeasurement
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptPixelSpacing) SetFake() {
+	var elem PixelSpacing
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptProbeType) SetFake() {
+	var elem ProbeType
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptSegmentMeasurement) SetFake() {
+	var elem SegmentMeasurement
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
 // SetFake set fake values.
 func (s *OptString) SetFake() {
 	var elem string
 	{
-		elem = "string"
+		elem = "string"
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptTiradsScore) SetFake() {
+	var elem TiradsScore
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptURI) SetFake() {
+	var elem url.URL
+	{
+		elem = url.URL{Scheme: "https", Host: "github.com", Path: "/ogen-go/ogen"}
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptUUID) SetFake() {
+	var elem uuid.UUID
+	{
+		elem = uuid.New()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptUziIDCheckPostReq) SetFake() {
+	var elem UziIDCheckPostReq
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptUziIDCheckPostReqUnvalidated) SetFake() {
+	var elem UziIDCheckPostReqUnvalidated
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptUziIDPatchReqProjection) SetFake() {
+	var elem UziIDPatchReqProjection
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptYookassaWebhookRequestObject) SetFake() {
+	var elem YookassaWebhookRequestObject
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptYookassaWebhookRequestObjectAmount) SetFake() {
+	var elem YookassaWebhookRequestObjectAmount
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptYookassaWebhookRequestObjectPaymentMethod) SetFake() {
+	var elem YookassaWebhookRequestObjectPaymentMethod
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptYookassaWebhookRequestObjectPaymentMethodCard) SetFake() {
+	var elem YookassaWebhookRequestObjectPaymentMethodCard
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *Patient) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Fullname = "string"
+		}
+	}
+	{
+		{
+			s.Email = "string"
+		}
+	}
+	{
+		{
+			s.Policy = "string"
+		}
+	}
+	{
+		{
+			s.Active = true
+		}
+	}
+	{
+		{
+			s.Malignancy = true
+		}
+	}
+	{
+		{
+			s.BirthDate = time.Now()
+		}
+	}
+	{
+		{
+			s.LastUziDate.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PatientCard) SetFake() {
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.AcceptanceDatetime.SetFake()
+		}
+	}
+	{
+		{
+			s.Diagnosis.SetFake()
+		}
+	}
+	{
+		{
+			s.Patient.SetFake()
+		}
+	}
+	{
+		{
+			s.MedWorker.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PaymentProvider) SetFake() {
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.IsActive.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PaymentProvidersGetOKApplicationJSON) SetFake() {
+	var unwrapped []PaymentProvider
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem PaymentProvider
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = PaymentProvidersGetOKApplicationJSON(unwrapped)
+}
+
+// SetFake set fake values.
+func (s *PeriodNodeMetrics) SetFake() {
+	{
+		{
+			s.Start = time.Now()
+		}
+	}
+	{
+		{
+			s.Metrics.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PixelSpacing) SetFake() {
+	{
+		{
+			s.X = float64(0)
+		}
+	}
+	{
+		{
+			s.Y = float64(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ProbeType) SetFake() {
+	*s = ProbeTypeLinear
+}
+
+// SetFake set fake values.
+func (s *PurchaseSubscriptionRequest) SetFake() {
+	{
+		{
+			s.TariffPlanID = uuid.New()
+		}
+	}
+	{
+		{
+			s.PaymentProviderID = uuid.New()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PurchaseSubscriptionResponse) SetFake() {
+	{
+		{
+			s.SubscriptionID = uuid.New()
+		}
+	}
+	{
+		{
+			s.ConfirmationURL = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ReaderAgreement) SetFake() {
+	{
+		{
+			s.ReaderA = uuid.New()
+		}
+	}
+	{
+		{
+			s.ReaderB = uuid.New()
+		}
+	}
+	{
+		{
+			s.Uzis = int(0)
+		}
+	}
+	{
+		{
+			s.Matched = int(0)
+		}
+	}
+	{
+		{
+			s.Unmatched = int(0)
+		}
+	}
+	{
+		{
+			s.Kappa.SetFake()
+		}
+	}
+	{
+		{
+			s.MeanIou.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RefreshPostOK) SetFake() {
+	{
+		{
+			s.AccessToken = "string"
+		}
+	}
+	{
+		{
+			s.RefreshToken = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RefreshPostReq) SetFake() {
+	{
+		{
+			s.RefreshToken = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RegDoctorPostReq) SetFake() {
+	{
+		{
+			s.Email = "string"
+		}
+	}
+	{
+		{
+			s.Password = "string"
+		}
+	}
+	{
+		{
+			s.Fullname = "string"
+		}
+	}
+	{
+		{
+			s.Org = "string"
+		}
+	}
+	{
+		{
+			s.Job = "string"
+		}
+	}
+	{
+		{
+			s.Description.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RegPatientPostReq) SetFake() {
+	{
+		{
+			s.Fullname = "string"
+		}
+	}
+	{
+		{
+			s.Policy = "string"
+		}
+	}
+	{
+		{
+			s.BirthDate = time.Now()
+		}
+	}
+	{
+		{
+			s.Email = "string"
+		}
+	}
+	{
+		{
+			s.Password = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Report) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.UziID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Version = int(0)
+		}
+	}
+	{
+		{
+			s.CreateAt = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Segment) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.ImageID = uuid.New()
+		}
+	}
+	{
+		{
+			s.NodeID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Contor.SetFake()
+		}
+	}
+	{
+		{
+			s.Ai = true
+		}
+	}
+	{
+		{
+			s.Tirads23 = float64(0)
+		}
+	}
+	{
+		{
+			s.Tirads4 = float64(0)
+		}
+	}
+	{
+		{
+			s.Tirads5 = float64(0)
+		}
+	}
+	{
+		{
+			s.Measurement.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *SegmentDraft) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.NodeID = uuid.New()
+		}
+	}
+	{
+		{
+			s.ImageID = uuid.New()
+		}
+	}
+	{
+		{
+			s.SourceSegmentID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Contor.SetFake()
+		}
+	}
+	{
+		{
+			s.Tirads23 = float64(0)
+		}
+	}
+	{
+		{
+			s.Tirads4 = float64(0)
+		}
+	}
+	{
+		{
+			s.Tirads5 = float64(0)
+		}
+	}
+	{
+		{
+			s.Score = float64(0)
+		}
+	}
+	{
+		{
+			s.CreateAt = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *SegmentMeasurement) SetFake() {
+	{
+		{
+			s.Bbox.SetFake()
+		}
+	}
+	{
+		{
+			s.Area = float64(0)
+		}
+	}
+	{
+		{
+			s.Perimeter = float64(0)
+		}
+	}
+	{
+		{
+			s.MajorAxis = float64(0)
+		}
+	}
+	{
+		{
+			s.MinorAxis = float64(0)
+		}
+	}
+	{
+		{
+			s.Unit.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Segmentation) SetFake() {
+	{
+		{
+			s.ID = int(0)
+		}
+	}
+	{
+		{
+			s.SegmentationGroupID = int(0)
+		}
+	}
+	{
+		{
+			s.Points = nil
+			for i := 0; i < 0; i++ {
+				var elem SegmentationPoint
+				{
+					elem.SetFake()
+				}
+				s.Points = append(s.Points, elem)
+			}
+		}
+	}
+	{
+		{
+			s.CreateAt = time.Now()
+		}
+	}
+	{
+		{
+			s.GeometryType.SetFake()
+		}
+	}
+	{
+		{
+			s.Color.SetFake()
+		}
+	}
+	{
+		{
+			s.IsLocked.SetFake()
+		}
+	}
+	{
+		{
+			s.Confidence.SetFake()
+		}
+	}
+	{
+		{
+			s.Properties.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *SegmentationPoint) SetFake() {
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.UID.SetFake()
+		}
+	}
+	{
+		{
+			s.X = int(0)
+		}
+	}
+	{
+		{
+			s.Y = int(0)
+		}
+	}
+	{
+		{
+			s.Polygon.SetFake()
+		}
+	}
+	{
+		{
+			s.Ring.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *SimpleUuid) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Subscription) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.TariffPlanID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.StartDate = time.Now()
+		}
+	}
+	{
+		{
+			s.EndDate = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *SubscriptionStatus) SetFake() {
+	*s = SubscriptionStatusPendingPayment
+}
+
+// SetFake set fake values.
+func (s *SubscriptionsCheckActiveGetOK) SetFake() {
+	{
+		{
+			s.HasActiveSubscription.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TariffPlan) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Description = "string"
+		}
+	}
+	{
+		{
+			s.Price = "string"
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptURI) SetFake() {
-	var elem url.URL
 	{
-		elem = url.URL{Scheme: "https", Host: "github.com", Path: "/ogen-go/ogen"}
+		{
+			s.Duration = int(0)
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptUUID) SetFake() {
-	var elem uuid.UUID
+func (s *TariffPlansGetOKApplicationJSON) SetFake() {
+	var unwrapped []TariffPlan
 	{
-		elem = uuid.New()
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem TariffPlan
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
 	}
-	s.SetTo(elem)
+	*s = TariffPlansGetOKApplicationJSON(unwrapped)
 }
 
 // SetFake set fake values.
-func (s *OptUziIDPatchReqProjection) SetFake() {
-	var elem UziIDPatchReqProjection
+func (s *TiradsDistribution) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.Tirads23 = int(0)
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptYookassaWebhookRequestObject) SetFake() {
-	var elem YookassaWebhookRequestObject
 	{
-		elem.SetFake()
+		{
+			s.Tirads4 = int(0)
+		}
+	}
+	{
+		{
+			s.Tirads5 = int(0)
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptYookassaWebhookRequestObjectAmount) SetFake() {
-	var elem YookassaWebhookRequestObjectAmount
+func (s *TiradsScore) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.Points = int(0)
+		}
+	}
+	{
+		{
+			s.Category.SetFake()
+		}
+	}
+	{
+		{
+			s.Recommendation.SetFake()
+		}
+	}
+	{
+		{
+			s.MaxDiameter.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptYookassaWebhookRequestObjectPaymentMethod) SetFake() {
-	var elem YookassaWebhookRequestObjectPaymentMethod
-	{
-		elem.SetFake()
-	}
-	s.SetTo(elem)
+func (s *TiradsScoreCategory) SetFake() {
+	*s = TiradsScoreCategoryTr1
 }
 
 // SetFake set fake values.
-func (s *OptYookassaWebhookRequestObjectPaymentMethodCard) SetFake() {
-	var elem YookassaWebhookRequestObjectPaymentMethodCard
-	{
-		elem.SetFake()
-	}
-	s.SetTo(elem)
+func (s *TiradsScoreRecommendation) SetFake() {
+	*s = TiradsScoreRecommendationNone
 }
 
 // SetFake set fake values.
-func (s *Patient) SetFake() {
+func (s *Upload) SetFake() {
 	{
 		{
 			s.ID = uuid.New()
@@ -2123,452 +3842,530 @@ func (s *Patient) SetFake() {
 	}
 	{
 		{
-			s.Fullname = "string"
-		}
-	}
-	{
-		{
-			s.Email = "string"
+			s.Kind.SetFake()
 		}
 	}
 	{
 		{
-			s.Policy = "string"
+			s.FileName = "string"
 		}
 	}
 	{
 		{
-			s.Active = true
+			s.ContentType = "string"
 		}
 	}
 	{
 		{
-			s.Malignancy = true
+			s.Size = int64(0)
 		}
 	}
 	{
 		{
-			s.BirthDate = time.Now()
+			s.PartSize = int64(0)
 		}
 	}
 	{
 		{
-			s.LastUziDate.SetFake()
+			s.PartsCount = int(0)
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *PatientCard) SetFake() {
 	{
 		{
-			s.ID.SetFake()
+			s.SHA256.SetFake()
 		}
 	}
 	{
 		{
-			s.AcceptanceDatetime.SetFake()
+			s.Status.SetFake()
 		}
 	}
 	{
 		{
-			s.Diagnosis.SetFake()
+			s.FailReason.SetFake()
 		}
 	}
 	{
 		{
-			s.Patient.SetFake()
+			s.CreateAt = time.Now()
 		}
 	}
 	{
 		{
-			s.MedWorker.SetFake()
+			s.ExpiresAt = time.Now()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *PaymentProvider) SetFake() {
+func (s *UploadKind) SetFake() {
+	*s = UploadKindUzi
+}
+
+// SetFake set fake values.
+func (s *UploadPart) SetFake() {
 	{
 		{
-			s.ID.SetFake()
+			s.Number = int(0)
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.Size = int64(0)
 		}
 	}
 	{
 		{
-			s.IsActive.SetFake()
+			s.Etag = "string"
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *PaymentProvidersGetOKApplicationJSON) SetFake() {
-	var unwrapped []PaymentProvider
+func (s *UploadPartURL) SetFake() {
 	{
-		unwrapped = nil
-		for i := 0; i < 0; i++ {
-			var elem PaymentProvider
-			{
-				elem.SetFake()
-			}
-			unwrapped = append(unwrapped, elem)
+		{
+			s.Number = int(0)
 		}
 	}
-	*s = PaymentProvidersGetOKApplicationJSON(unwrapped)
-}
-
-// SetFake set fake values.
-func (s *PurchaseSubscriptionRequest) SetFake() {
 	{
 		{
-			s.TariffPlanID = uuid.New()
+			s.URL = "string"
 		}
 	}
 	{
 		{
-			s.PaymentProviderID = uuid.New()
+			s.ExpiresAt = time.Now()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *PurchaseSubscriptionResponse) SetFake() {
+func (s *UploadProgress) SetFake() {
 	{
 		{
-			s.SubscriptionID = uuid.New()
+			s.Upload.SetFake()
 		}
 	}
 	{
 		{
-			s.ConfirmationURL = "string"
+			s.UploadedBytes = int64(0)
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RefreshPostOK) SetFake() {
 	{
 		{
-			s.AccessToken = "string"
+			s.Parts = nil
+			for i := 0; i < 0; i++ {
+				var elem UploadPart
+				{
+					elem.SetFake()
+				}
+				s.Parts = append(s.Parts, elem)
+			}
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *UploadStatus) SetFake() {
+	*s = UploadStatusPending
+}
+
+// SetFake set fake values.
+func (s *UploadsIDPartsPostOKApplicationJSON) SetFake() {
+	var unwrapped []UploadPartURL
 	{
-		{
-			s.RefreshToken = "string"
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem UploadPartURL
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
 		}
 	}
+	*s = UploadsIDPartsPostOKApplicationJSON(unwrapped)
 }
 
 // SetFake set fake values.
-func (s *RefreshPostReq) SetFake() {
+func (s *UploadsIDPartsPostReq) SetFake() {
 	{
 		{
-			s.RefreshToken = "string"
+			s.Numbers = nil
+			for i := 0; i < 0; i++ {
+				var elem int
+				{
+					elem = int(0)
+				}
+				s.Numbers = append(s.Numbers, elem)
+			}
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RegDoctorPostReq) SetFake() {
+func (s *UploadsPostReq) SetFake() {
 	{
 		{
-			s.Email = "string"
+			s.Kind.SetFake()
 		}
 	}
 	{
 		{
-			s.Password = "string"
+			s.FileName = "string"
 		}
 	}
 	{
 		{
-			s.Fullname = "string"
+			s.ContentType = "string"
 		}
 	}
 	{
 		{
-			s.Org = "string"
+			s.Size = int64(0)
 		}
 	}
 	{
 		{
-			s.Job = "string"
+			s.PartSize.SetFake()
 		}
 	}
 	{
 		{
-			s.Description.SetFake()
+			s.SHA256.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RegPatientPostReq) SetFake() {
+func (s *Uzi) SetFake() {
 	{
 		{
-			s.Fullname = "string"
+			s.ID = uuid.New()
 		}
 	}
 	{
 		{
-			s.Policy = "string"
+			s.Projection.SetFake()
 		}
 	}
 	{
 		{
-			s.BirthDate = time.Now()
+			s.Checked = true
 		}
 	}
 	{
 		{
-			s.Email = "string"
+			s.ExternalID = uuid.New()
 		}
 	}
 	{
 		{
-			s.Password = "string"
+			s.AuthorID = uuid.New()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *Segment) SetFake() {
 	{
 		{
-			s.ID = uuid.New()
+			s.DeviceID = int(0)
 		}
 	}
 	{
 		{
-			s.ImageID = uuid.New()
+			s.Status.SetFake()
 		}
 	}
 	{
 		{
-			s.NodeID = uuid.New()
+			s.CreateAt = time.Now()
 		}
 	}
 	{
 		{
-			s.Contor.SetFake()
+			s.PixelSpacing.SetFake()
 		}
 	}
 	{
 		{
-			s.Ai = true
+			s.SHA256.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *UziAnalytics) SetFake() {
 	{
 		{
-			s.Tirads23 = float64(0)
+			s.Total.SetFake()
 		}
 	}
 	{
 		{
-			s.Tirads4 = float64(0)
+			s.ByDevice = nil
+			for i := 0; i < 0; i++ {
+				var elem DeviceNodeMetrics
+				{
+					elem.SetFake()
+				}
+				s.ByDevice = append(s.ByDevice, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Tirads5 = float64(0)
+			s.ByAuthor = nil
+			for i := 0; i < 0; i++ {
+				var elem AuthorNodeMetrics
+				{
+					elem.SetFake()
+				}
+				s.ByAuthor = append(s.ByAuthor, elem)
+			}
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *SimpleUuid) SetFake() {
 	{
 		{
-			s.ID = uuid.New()
+			s.ByPeriod = nil
+			for i := 0; i < 0; i++ {
+				var elem PeriodNodeMetrics
+				{
+					elem.SetFake()
+				}
+				s.ByPeriod = append(s.ByPeriod, elem)
+			}
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *Subscription) SetFake() {
 	{
 		{
-			s.ID = uuid.New()
+			s.Agreement = nil
+			for i := 0; i < 0; i++ {
+				var elem ReaderAgreement
+				{
+					elem.SetFake()
+				}
+				s.Agreement = append(s.Agreement, elem)
+			}
 		}
 	}
 	{
 		{
-			s.TariffPlanID = uuid.New()
+			s.Kappa.SetFake()
 		}
 	}
 	{
 		{
-			s.Status.SetFake()
+			s.MeanIou.SetFake()
 		}
 	}
 	{
 		{
-			s.StartDate = time.Now()
+			s.AiMerged = int(0)
 		}
 	}
 	{
 		{
-			s.EndDate = time.Now()
+			s.AiSplit = int(0)
+		}
+	}
+	{
+		{
+			s.BulkValid = int(0)
+		}
+	}
+	{
+		{
+			s.BulkInvalid = int(0)
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *SubscriptionStatus) SetFake() {
-	*s = SubscriptionStatusPendingPayment
+func (s *UziCreated) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.DuplicateOf = nil
+			for i := 0; i < 0; i++ {
+				var elem uuid.UUID
+				{
+					elem = uuid.New()
+				}
+				s.DuplicateOf = append(s.DuplicateOf, elem)
+			}
+		}
+	}
 }
 
 // SetFake set fake values.
-func (s *SubscriptionsCheckActiveGetOK) SetFake() {
+func (s *UziDeviceIDPatchReq) SetFake() {
 	{
 		{
-			s.HasActiveSubscription.SetFake()
+			s.Name.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *TariffPlan) SetFake() {
 	{
 		{
-			s.ID = uuid.New()
+			s.Manufacturer.SetFake()
 		}
 	}
 	{
 		{
-			s.Name = "string"
+			s.Model.SetFake()
 		}
 	}
 	{
 		{
-			s.Description = "string"
+			s.SerialNumber.SetFake()
 		}
 	}
 	{
 		{
-			s.Price = "string"
+			s.ProbeType.SetFake()
 		}
 	}
 	{
 		{
-			s.Duration = int(0)
+			s.PixelSpacing.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *TariffPlansGetOKApplicationJSON) SetFake() {
-	var unwrapped []TariffPlan
+func (s *UziDevicePostOK) SetFake() {
 	{
-		unwrapped = nil
-		for i := 0; i < 0; i++ {
-			var elem TariffPlan
-			{
-				elem.SetFake()
-			}
-			unwrapped = append(unwrapped, elem)
+		{
+			s.ID = int(0)
 		}
 	}
-	*s = TariffPlansGetOKApplicationJSON(unwrapped)
 }
 
 // SetFake set fake values.
-func (s *Uzi) SetFake() {
+func (s *UziDevicePostReq) SetFake() {
 	{
 		{
-			s.ID = uuid.New()
+			s.Name = "string"
 		}
 	}
 	{
 		{
-			s.Projection.SetFake()
+			s.Manufacturer.SetFake()
 		}
 	}
 	{
 		{
-			s.Checked = true
+			s.Model.SetFake()
 		}
 	}
 	{
 		{
-			s.ExternalID = uuid.New()
+			s.SerialNumber.SetFake()
 		}
 	}
 	{
 		{
-			s.AuthorID = uuid.New()
+			s.ProbeType.SetFake()
 		}
 	}
 	{
 		{
-			s.DeviceID = int(0)
+			s.PixelSpacing.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *UziDevicesGetOKApplicationJSON) SetFake() {
+	var unwrapped []Device
 	{
-		{
-			s.Status.SetFake()
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem Device
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
 		}
 	}
+	*s = UziDevicesGetOKApplicationJSON(unwrapped)
+}
+
+// SetFake set fake values.
+func (s *UziIDCheckPostOK) SetFake() {
 	{
 		{
-			s.CreateAt = time.Now()
+			s.Uzi.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *UziDevicePostOK) SetFake() {
 	{
 		{
-			s.ID = int(0)
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem NodeReviewItem
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *UziDevicePostReq) SetFake() {
+func (s *UziIDCheckPostReq) SetFake() {
 	{
 		{
-			s.Name = "string"
+			s.Unvalidated.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *UziDevicesGetOKApplicationJSON) SetFake() {
-	var unwrapped []Device
+func (s *UziIDCheckPostReqUnvalidated) SetFake() {
+	*s = UziIDCheckPostReqUnvalidatedValid
+}
+
+// SetFake set fake values.
+func (s *UziIDImagesGetOKApplicationJSON) SetFake() {
+	var unwrapped []Image
 	{
 		unwrapped = nil
 		for i := 0; i < 0; i++ {
-			var elem Device
+			var elem Image
 			{
 				elem.SetFake()
 			}
 			unwrapped = append(unwrapped, elem)
 		}
 	}
-	*s = UziDevicesGetOKApplicationJSON(unwrapped)
+	*s = UziIDImagesGetOKApplicationJSON(unwrapped)
 }
 
 // SetFake set fake values.
-func (s *UziIDImagesGetOKApplicationJSON) SetFake() {
-	var unwrapped []Image
+func (s *UziIDNodesAcceptPostOKApplicationJSON) SetFake() {
+	var unwrapped []NodeReviewItem
 	{
 		unwrapped = nil
 		for i := 0; i < 0; i++ {
-			var elem Image
+			var elem NodeReviewItem
 			{
 				elem.SetFake()
 			}
 			unwrapped = append(unwrapped, elem)
 		}
 	}
-	*s = UziIDImagesGetOKApplicationJSON(unwrapped)
+	*s = UziIDNodesAcceptPostOKApplicationJSON(unwrapped)
+}
+
+// SetFake set fake values.
+func (s *UziIDNodesAcceptPostReq) SetFake() {
+	{
+		{
+			s.Threshold = float64(0)
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -2694,6 +4491,11 @@ func (s *UziIDPatchReq) SetFake() {
 			s.Checked.SetFake()
 		}
 	}
+	{
+		{
+			s.PixelSpacing.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -2701,6 +4503,22 @@ func (s *UziIDPatchReqProjection) SetFake() {
 	*s = UziIDPatchReqProjectionCross
 }
 
+// SetFake set fake values.
+func (s *UziIDReportsGetOKApplicationJSON) SetFake() {
+	var unwrapped []Report
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem Report
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = UziIDReportsGetOKApplicationJSON(unwrapped)
+}
+
 // SetFake set fake values.
 func (s *UziImageIDNodesSegmentsGetOK) SetFake() {
 	{
@@ -2729,6 +4547,40 @@ func (s *UziImageIDNodesSegmentsGetOK) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *UziNodesIDLinkPostOK) SetFake() {
+	{
+		{
+			s.LineageID = uuid.New()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *UziNodesIDLinkPostReq) SetFake() {
+	{
+		{
+			s.TargetNodeID = uuid.New()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *UziNodesIDLinkSuggestionsGetOKApplicationJSON) SetFake() {
+	var unwrapped []NodeLinkSuggestion
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem NodeLinkSuggestion
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = UziNodesIDLinkSuggestionsGetOKApplicationJSON(unwrapped)
+}
+
 // SetFake set fake values.
 func (s *UziNodesIDPatchReq) SetFake() {
 	{
@@ -2751,6 +4603,11 @@ func (s *UziNodesIDPatchReq) SetFake() {
 			s.Tirads5.SetFake()
 		}
 	}
+	{
+		{
+			s.Lobe.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -2758,6 +4615,22 @@ func (s *UziNodesIDPatchReqValidation) SetFake() {
 	*s = UziNodesIDPatchReqValidationInvalid
 }
 
+// SetFake set fake values.
+func (s *UziNodesIDSegmentDraftsGetOKApplicationJSON) SetFake() {
+	var unwrapped []SegmentDraft
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem SegmentDraft
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = UziNodesIDSegmentDraftsGetOKApplicationJSON(unwrapped)
+}
+
 // SetFake set fake values.
 func (s *UziNodesIDSegmentsGetOKApplicationJSON) SetFake() {
 	var unwrapped []Segment
@@ -2774,6 +4647,115 @@ func (s *UziNodesIDSegmentsGetOKApplicationJSON) SetFake() {
 	*s = UziNodesIDSegmentsGetOKApplicationJSON(unwrapped)
 }
 
+// SetFake set fake values.
+func (s *UziNodesIDSplitPostOK) SetFake() {
+	{
+		{
+			s.Node.SetFake()
+		}
+	}
+	{
+		{
+			s.NewNode.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *UziNodesIDSplitPostReq) SetFake() {
+	{
+		{
+			s.SegmentIds = nil
+			for i := 0; i < 1; i++ {
+				var elem uuid.UUID
+				{
+					elem = uuid.New()
+				}
+				s.SegmentIds = append(s.SegmentIds, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *UziNodesMergePostReq) SetFake() {
+	{
+		{
+			s.NodeIds = nil
+			for i := 0; i < 2; i++ {
+				var elem uuid.UUID
+				{
+					elem = uuid.New()
+				}
+				s.NodeIds = append(s.NodeIds, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *UziNodesReviewPostOKApplicationJSON) SetFake() {
+	var unwrapped []NodeReviewItem
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem NodeReviewItem
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = UziNodesReviewPostOKApplicationJSON(unwrapped)
+}
+
+// SetFake set fake values.
+func (s *UziNodesReviewPostReq) SetFake() {
+	{
+		{
+			s.NodeIds = nil
+			for i := 0; i < 1; i++ {
+				var elem uuid.UUID
+				{
+					elem = uuid.New()
+				}
+				s.NodeIds = append(s.NodeIds, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Validation.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *UziNodesReviewPostReqValidation) SetFake() {
+	*s = UziNodesReviewPostReqValidationValid
+}
+
+// SetFake set fake values.
+func (s *UziPage) SetFake() {
+	{
+		{
+			s.Uzis = nil
+			for i := 0; i < 0; i++ {
+				var elem Uzi
+				{
+					elem.SetFake()
+				}
+				s.Uzis = append(s.Uzis, elem)
+			}
+		}
+	}
+	{
+		{
+			s.NextCursor.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *UziProjection) SetFake() {
 	*s = UziProjectionCross
@@ -2803,6 +4785,22 @@ func (s *UziSegmentIDPatchReq) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *UziSegmentIDPropagatePostOKApplicationJSON) SetFake() {
+	var unwrapped []SegmentDraft
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem SegmentDraft
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = UziSegmentIDPropagatePostOKApplicationJSON(unwrapped)
+}
+
 // SetFake set fake values.
 func (s *UziSegmentPostReq) SetFake() {
 	{
@@ -2874,6 +4872,22 @@ func (s *UzisExternalIDGetOKApplicationJSON) SetFake() {
 	*s = UzisExternalIDGetOKApplicationJSON(unwrapped)
 }
 
+// SetFake set fake values.
+func (s *UzisExternalIDGrowthGetOKApplicationJSON) SetFake() {
+	var unwrapped []NodeGrowth
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem NodeGrowth
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = UzisExternalIDGrowthGetOKApplicationJSON(unwrapped)
+}
+
 // SetFake set fake values.
 func (s *YookassaWebhookRequest) SetFake() {
 	{
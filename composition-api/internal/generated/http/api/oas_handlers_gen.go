@@ -823,22 +823,23 @@ func (s *Server) handleCytologyPatientShotsReadRequest(args [1]string, argsEscap
 	}
 }
 
-// handleCytologyReadRequest handles CytologyRead operation.
+// handleCytologyQuPathExportRequest handles CytologyQuPathExport operation.
 //
-// Информация об одной группе снимков.
+// Все группы сегментаций исследования одним FeatureCollection,
+// класс и его цвет в properties.classification.
 //
-// GET /cytology/{id}
-func (s *Server) handleCytologyReadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /cytology/{id}/qupath
+func (s *Server) handleCytologyQuPathExportRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologyRead"),
+		otelogen.OperationID("CytologyQuPathExport"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/cytology/{id}"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/qupath"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologyReadOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologyQuPathExportOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -893,15 +894,15 @@ func (s *Server) handleCytologyReadRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CytologyReadOperation,
-			ID:   "CytologyRead",
+			Name: CytologyQuPathExportOperation,
+			ID:   "CytologyQuPathExport",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CytologyReadOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologyQuPathExportOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -943,7 +944,7 @@ func (s *Server) handleCytologyReadRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeCytologyReadParams(args, argsEscaped, r)
+	params, err := decodeCytologyQuPathExportParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -954,13 +955,13 @@ func (s *Server) handleCytologyReadRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	var response CytologyReadRes
+	var response CytologyQuPathExportRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CytologyReadOperation,
-			OperationSummary: "Информация об одной группе снимков",
-			OperationID:      "CytologyRead",
+			OperationName:    CytologyQuPathExportOperation,
+			OperationSummary: "экспорт сегментаций в GeoJSON для QuPath",
+			OperationID:      "CytologyQuPathExport",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -973,8 +974,8 @@ func (s *Server) handleCytologyReadRequest(args [1]string, argsEscaped bool, w h
 
 		type (
 			Request  = struct{}
-			Params   = CytologyReadParams
-			Response = CytologyReadRes
+			Params   = CytologyQuPathExportParams
+			Response = CytologyQuPathExportRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -983,14 +984,14 @@ func (s *Server) handleCytologyReadRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackCytologyReadParams,
+			unpackCytologyQuPathExportParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CytologyRead(ctx, params)
+				response, err = s.h.CytologyQuPathExport(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CytologyRead(ctx, params)
+		response, err = s.h.CytologyQuPathExport(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1009,7 +1010,7 @@ func (s *Server) handleCytologyReadRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeCytologyReadResponse(response, w, span); err != nil {
+	if err := encodeCytologyQuPathExportResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1018,22 +1019,24 @@ func (s *Server) handleCytologyReadRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleCytologySegmentGroupCreateCreateRequest handles CytologySegmentGroupCreateCreate operation.
+// handleCytologyQuPathImportRequest handles CytologyQuPathImport operation.
 //
-// Создать группу сегментаций.
+// Создает новую версию исследования копированием,
+// сегментации новой версии берутся из GeoJSON. Аннотации
+// сравниваются с экспортом по id объекта QuPath.
 //
-// POST /cytology/segment/group/create/{cytology_img_id}
-func (s *Server) handleCytologySegmentGroupCreateCreateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /cytology/{id}/qupath
+func (s *Server) handleCytologyQuPathImportRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentGroupCreateCreate"),
+		otelogen.OperationID("CytologyQuPathImport"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/cytology/segment/group/create/{cytology_img_id}"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/qupath"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentGroupCreateCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologyQuPathImportOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1088,15 +1091,15 @@ func (s *Server) handleCytologySegmentGroupCreateCreateRequest(args [1]string, a
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CytologySegmentGroupCreateCreateOperation,
-			ID:   "CytologySegmentGroupCreateCreate",
+			Name: CytologyQuPathImportOperation,
+			ID:   "CytologyQuPathImport",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentGroupCreateCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologyQuPathImportOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1138,7 +1141,7 @@ func (s *Server) handleCytologySegmentGroupCreateCreateRequest(args [1]string, a
 			return
 		}
 	}
-	params, err := decodeCytologySegmentGroupCreateCreateParams(args, argsEscaped, r)
+	params, err := decodeCytologyQuPathImportParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1148,7 +1151,7 @@ func (s *Server) handleCytologySegmentGroupCreateCreateRequest(args [1]string, a
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeCytologySegmentGroupCreateCreateRequest(r)
+	request, close, err := s.decodeCytologyQuPathImportRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1164,27 +1167,27 @@ func (s *Server) handleCytologySegmentGroupCreateCreateRequest(args [1]string, a
 		}
 	}()
 
-	var response CytologySegmentGroupCreateCreateRes
+	var response CytologyQuPathImportRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CytologySegmentGroupCreateCreateOperation,
-			OperationSummary: "создать группу сегментаций",
-			OperationID:      "CytologySegmentGroupCreateCreate",
+			OperationName:    CytologyQuPathImportOperation,
+			OperationSummary: "импорт отредактированного в QuPath GeoJSON новой версией исследования",
+			OperationID:      "CytologyQuPathImport",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "cytology_img_id",
+					Name: "id",
 					In:   "path",
-				}: params.CytologyImgID,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *CytologySegmentGroupCreateCreateReq
-			Params   = CytologySegmentGroupCreateCreateParams
-			Response = CytologySegmentGroupCreateCreateRes
+			Request  = CytologyQuPathImportReq
+			Params   = CytologyQuPathImportParams
+			Response = CytologyQuPathImportRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1193,14 +1196,14 @@ func (s *Server) handleCytologySegmentGroupCreateCreateRequest(args [1]string, a
 		](
 			m,
 			mreq,
-			unpackCytologySegmentGroupCreateCreateParams,
+			unpackCytologyQuPathImportParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CytologySegmentGroupCreateCreate(ctx, request, params)
+				response, err = s.h.CytologyQuPathImport(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CytologySegmentGroupCreateCreate(ctx, request, params)
+		response, err = s.h.CytologyQuPathImport(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1219,7 +1222,7 @@ func (s *Server) handleCytologySegmentGroupCreateCreateRequest(args [1]string, a
 		return
 	}
 
-	if err := encodeCytologySegmentGroupCreateCreateResponse(response, w, span); err != nil {
+	if err := encodeCytologyQuPathImportResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1228,22 +1231,22 @@ func (s *Server) handleCytologySegmentGroupCreateCreateRequest(args [1]string, a
 	}
 }
 
-// handleCytologySegmentUpdateDeleteRequest handles CytologySegmentUpdateDelete operation.
+// handleCytologyReadRequest handles CytologyRead operation.
 //
-// Удалить сегментацию.
+// Информация об одной группе снимков.
 //
-// DELETE /cytology/segment/update/{id}
-func (s *Server) handleCytologySegmentUpdateDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /cytology/{id}
+func (s *Server) handleCytologyReadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentUpdateDelete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
+		otelogen.OperationID("CytologyRead"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/cytology/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentUpdateDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologyReadOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1298,15 +1301,15 @@ func (s *Server) handleCytologySegmentUpdateDeleteRequest(args [1]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CytologySegmentUpdateDeleteOperation,
-			ID:   "CytologySegmentUpdateDelete",
+			Name: CytologyReadOperation,
+			ID:   "CytologyRead",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentUpdateDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologyReadOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1348,7 +1351,7 @@ func (s *Server) handleCytologySegmentUpdateDeleteRequest(args [1]string, argsEs
 			return
 		}
 	}
-	params, err := decodeCytologySegmentUpdateDeleteParams(args, argsEscaped, r)
+	params, err := decodeCytologyReadParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1359,13 +1362,13 @@ func (s *Server) handleCytologySegmentUpdateDeleteRequest(args [1]string, argsEs
 		return
 	}
 
-	var response CytologySegmentUpdateDeleteRes
+	var response CytologyReadRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CytologySegmentUpdateDeleteOperation,
-			OperationSummary: "удалить сегментацию",
-			OperationID:      "CytologySegmentUpdateDelete",
+			OperationName:    CytologyReadOperation,
+			OperationSummary: "Информация об одной группе снимков",
+			OperationID:      "CytologyRead",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -1378,8 +1381,8 @@ func (s *Server) handleCytologySegmentUpdateDeleteRequest(args [1]string, argsEs
 
 		type (
 			Request  = struct{}
-			Params   = CytologySegmentUpdateDeleteParams
-			Response = CytologySegmentUpdateDeleteRes
+			Params   = CytologyReadParams
+			Response = CytologyReadRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1388,14 +1391,14 @@ func (s *Server) handleCytologySegmentUpdateDeleteRequest(args [1]string, argsEs
 		](
 			m,
 			mreq,
-			unpackCytologySegmentUpdateDeleteParams,
+			unpackCytologyReadParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CytologySegmentUpdateDelete(ctx, params)
+				response, err = s.h.CytologyRead(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CytologySegmentUpdateDelete(ctx, params)
+		response, err = s.h.CytologyRead(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1414,7 +1417,7 @@ func (s *Server) handleCytologySegmentUpdateDeleteRequest(args [1]string, argsEs
 		return
 	}
 
-	if err := encodeCytologySegmentUpdateDeleteResponse(response, w, span); err != nil {
+	if err := encodeCytologyReadResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1423,22 +1426,22 @@ func (s *Server) handleCytologySegmentUpdateDeleteRequest(args [1]string, argsEs
 	}
 }
 
-// handleCytologySegmentUpdatePartialUpdateRequest handles CytologySegmentUpdatePartialUpdate operation.
+// handleCytologySegmentGroupCreateCreateRequest handles CytologySegmentGroupCreateCreate operation.
 //
-// Обновить сегментацию.
+// Создать группу сегментаций.
 //
-// PATCH /cytology/segment/update/{id}
-func (s *Server) handleCytologySegmentUpdatePartialUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /cytology/segment/group/create/{cytology_img_id}
+func (s *Server) handleCytologySegmentGroupCreateCreateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentUpdatePartialUpdate"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
+		otelogen.OperationID("CytologySegmentGroupCreateCreate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/cytology/segment/group/create/{cytology_img_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentUpdatePartialUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentGroupCreateCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1493,15 +1496,15 @@ func (s *Server) handleCytologySegmentUpdatePartialUpdateRequest(args [1]string,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CytologySegmentUpdatePartialUpdateOperation,
-			ID:   "CytologySegmentUpdatePartialUpdate",
+			Name: CytologySegmentGroupCreateCreateOperation,
+			ID:   "CytologySegmentGroupCreateCreate",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentUpdatePartialUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentGroupCreateCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1543,7 +1546,7 @@ func (s *Server) handleCytologySegmentUpdatePartialUpdateRequest(args [1]string,
 			return
 		}
 	}
-	params, err := decodeCytologySegmentUpdatePartialUpdateParams(args, argsEscaped, r)
+	params, err := decodeCytologySegmentGroupCreateCreateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1553,7 +1556,7 @@ func (s *Server) handleCytologySegmentUpdatePartialUpdateRequest(args [1]string,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeCytologySegmentUpdatePartialUpdateRequest(r)
+	request, close, err := s.decodeCytologySegmentGroupCreateCreateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1569,27 +1572,27 @@ func (s *Server) handleCytologySegmentUpdatePartialUpdateRequest(args [1]string,
 		}
 	}()
 
-	var response CytologySegmentUpdatePartialUpdateRes
+	var response CytologySegmentGroupCreateCreateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CytologySegmentUpdatePartialUpdateOperation,
-			OperationSummary: "обновить сегментацию",
-			OperationID:      "CytologySegmentUpdatePartialUpdate",
+			OperationName:    CytologySegmentGroupCreateCreateOperation,
+			OperationSummary: "создать группу сегментаций",
+			OperationID:      "CytologySegmentGroupCreateCreate",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
+					Name: "cytology_img_id",
 					In:   "path",
-				}: params.ID,
+				}: params.CytologyImgID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *CytologySegmentUpdatePartialUpdateReq
-			Params   = CytologySegmentUpdatePartialUpdateParams
-			Response = CytologySegmentUpdatePartialUpdateRes
+			Request  = *CytologySegmentGroupCreateCreateReq
+			Params   = CytologySegmentGroupCreateCreateParams
+			Response = CytologySegmentGroupCreateCreateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1598,14 +1601,14 @@ func (s *Server) handleCytologySegmentUpdatePartialUpdateRequest(args [1]string,
 		](
 			m,
 			mreq,
-			unpackCytologySegmentUpdatePartialUpdateParams,
+			unpackCytologySegmentGroupCreateCreateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CytologySegmentUpdatePartialUpdate(ctx, request, params)
+				response, err = s.h.CytologySegmentGroupCreateCreate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CytologySegmentUpdatePartialUpdate(ctx, request, params)
+		response, err = s.h.CytologySegmentGroupCreateCreate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1624,7 +1627,7 @@ func (s *Server) handleCytologySegmentUpdatePartialUpdateRequest(args [1]string,
 		return
 	}
 
-	if err := encodeCytologySegmentUpdatePartialUpdateResponse(response, w, span); err != nil {
+	if err := encodeCytologySegmentGroupCreateCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1633,22 +1636,22 @@ func (s *Server) handleCytologySegmentUpdatePartialUpdateRequest(args [1]string,
 	}
 }
 
-// handleCytologySegmentUpdateReadRequest handles CytologySegmentUpdateRead operation.
+// handleCytologySegmentUpdateDeleteRequest handles CytologySegmentUpdateDelete operation.
 //
-// Получить сегментацию.
+// Удалить сегментацию.
 //
-// GET /cytology/segment/update/{id}
-func (s *Server) handleCytologySegmentUpdateReadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /cytology/segment/update/{id}
+func (s *Server) handleCytologySegmentUpdateDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentUpdateRead"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("CytologySegmentUpdateDelete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
 		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentUpdateReadOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentUpdateDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1703,15 +1706,15 @@ func (s *Server) handleCytologySegmentUpdateReadRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CytologySegmentUpdateReadOperation,
-			ID:   "CytologySegmentUpdateRead",
+			Name: CytologySegmentUpdateDeleteOperation,
+			ID:   "CytologySegmentUpdateDelete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentUpdateReadOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentUpdateDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1753,7 +1756,7 @@ func (s *Server) handleCytologySegmentUpdateReadRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeCytologySegmentUpdateReadParams(args, argsEscaped, r)
+	params, err := decodeCytologySegmentUpdateDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1764,13 +1767,13 @@ func (s *Server) handleCytologySegmentUpdateReadRequest(args [1]string, argsEsca
 		return
 	}
 
-	var response CytologySegmentUpdateReadRes
+	var response CytologySegmentUpdateDeleteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CytologySegmentUpdateReadOperation,
-			OperationSummary: "получить сегментацию",
-			OperationID:      "CytologySegmentUpdateRead",
+			OperationName:    CytologySegmentUpdateDeleteOperation,
+			OperationSummary: "удалить сегментацию",
+			OperationID:      "CytologySegmentUpdateDelete",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -1783,8 +1786,8 @@ func (s *Server) handleCytologySegmentUpdateReadRequest(args [1]string, argsEsca
 
 		type (
 			Request  = struct{}
-			Params   = CytologySegmentUpdateReadParams
-			Response = CytologySegmentUpdateReadRes
+			Params   = CytologySegmentUpdateDeleteParams
+			Response = CytologySegmentUpdateDeleteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1793,14 +1796,14 @@ func (s *Server) handleCytologySegmentUpdateReadRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackCytologySegmentUpdateReadParams,
+			unpackCytologySegmentUpdateDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CytologySegmentUpdateRead(ctx, params)
+				response, err = s.h.CytologySegmentUpdateDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CytologySegmentUpdateRead(ctx, params)
+		response, err = s.h.CytologySegmentUpdateDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1819,7 +1822,7 @@ func (s *Server) handleCytologySegmentUpdateReadRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeCytologySegmentUpdateReadResponse(response, w, span); err != nil {
+	if err := encodeCytologySegmentUpdateDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1828,22 +1831,22 @@ func (s *Server) handleCytologySegmentUpdateReadRequest(args [1]string, argsEsca
 	}
 }
 
-// handleCytologySegmentUpdateUpdateRequest handles CytologySegmentUpdateUpdate operation.
+// handleCytologySegmentUpdatePartialUpdateRequest handles CytologySegmentUpdatePartialUpdate operation.
 //
 // Обновить сегментацию.
 //
-// PUT /cytology/segment/update/{id}
-func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /cytology/segment/update/{id}
+func (s *Server) handleCytologySegmentUpdatePartialUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentUpdateUpdate"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
+		otelogen.OperationID("CytologySegmentUpdatePartialUpdate"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
 		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentUpdateUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentUpdatePartialUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1898,15 +1901,15 @@ func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CytologySegmentUpdateUpdateOperation,
-			ID:   "CytologySegmentUpdateUpdate",
+			Name: CytologySegmentUpdatePartialUpdateOperation,
+			ID:   "CytologySegmentUpdatePartialUpdate",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentUpdateUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentUpdatePartialUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1948,7 +1951,7 @@ func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEs
 			return
 		}
 	}
-	params, err := decodeCytologySegmentUpdateUpdateParams(args, argsEscaped, r)
+	params, err := decodeCytologySegmentUpdatePartialUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1958,7 +1961,7 @@ func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEs
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeCytologySegmentUpdateUpdateRequest(r)
+	request, close, err := s.decodeCytologySegmentUpdatePartialUpdateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1974,13 +1977,13 @@ func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEs
 		}
 	}()
 
-	var response CytologySegmentUpdateUpdateRes
+	var response CytologySegmentUpdatePartialUpdateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CytologySegmentUpdateUpdateOperation,
+			OperationName:    CytologySegmentUpdatePartialUpdateOperation,
 			OperationSummary: "обновить сегментацию",
-			OperationID:      "CytologySegmentUpdateUpdate",
+			OperationID:      "CytologySegmentUpdatePartialUpdate",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
@@ -1992,9 +1995,9 @@ func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEs
 		}
 
 		type (
-			Request  = *CytologySegmentUpdateUpdateReq
-			Params   = CytologySegmentUpdateUpdateParams
-			Response = CytologySegmentUpdateUpdateRes
+			Request  = *CytologySegmentUpdatePartialUpdateReq
+			Params   = CytologySegmentUpdatePartialUpdateParams
+			Response = CytologySegmentUpdatePartialUpdateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2003,14 +2006,14 @@ func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEs
 		](
 			m,
 			mreq,
-			unpackCytologySegmentUpdateUpdateParams,
+			unpackCytologySegmentUpdatePartialUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CytologySegmentUpdateUpdate(ctx, request, params)
+				response, err = s.h.CytologySegmentUpdatePartialUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CytologySegmentUpdateUpdate(ctx, request, params)
+		response, err = s.h.CytologySegmentUpdatePartialUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2029,7 +2032,7 @@ func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEs
 		return
 	}
 
-	if err := encodeCytologySegmentUpdateUpdateResponse(response, w, span); err != nil {
+	if err := encodeCytologySegmentUpdatePartialUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2038,22 +2041,22 @@ func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEs
 	}
 }
 
-// handleCytologySegmentsListRequest handles CytologySegmentsList operation.
+// handleCytologySegmentUpdateReadRequest handles CytologySegmentUpdateRead operation.
 //
-// Информация об одной группе снимков.
+// Получить сегментацию.
 //
-// GET /cytology/{id}/segments
-func (s *Server) handleCytologySegmentsListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /cytology/segment/update/{id}
+func (s *Server) handleCytologySegmentUpdateReadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologySegmentsList"),
+		otelogen.OperationID("CytologySegmentUpdateRead"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/cytology/{id}/segments"),
+		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentsListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentUpdateReadOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2108,15 +2111,15 @@ func (s *Server) handleCytologySegmentsListRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CytologySegmentsListOperation,
-			ID:   "CytologySegmentsList",
+			Name: CytologySegmentUpdateReadOperation,
+			ID:   "CytologySegmentUpdateRead",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentsListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentUpdateReadOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2158,7 +2161,7 @@ func (s *Server) handleCytologySegmentsListRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeCytologySegmentsListParams(args, argsEscaped, r)
+	params, err := decodeCytologySegmentUpdateReadParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2169,47 +2172,27 @@ func (s *Server) handleCytologySegmentsListRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	var response CytologySegmentsListRes
+	var response CytologySegmentUpdateReadRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CytologySegmentsListOperation,
-			OperationSummary: "Информация об одной группе снимков",
-			OperationID:      "CytologySegmentsList",
-			Body:             nil,
+			OperationName:    CytologySegmentUpdateReadOperation,
+			OperationSummary: "получить сегментацию",
+			OperationID:      "CytologySegmentUpdateRead",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
 					In:   "path",
 				}: params.ID,
-				{
-					Name: "is_ai",
-					In:   "query",
-				}: params.IsAi,
-				{
-					Name: "group_type",
-					In:   "query",
-				}: params.GroupType,
-				{
-					Name: "seg_type",
-					In:   "query",
-				}: params.SegType,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = CytologySegmentsListParams
-			Response = CytologySegmentsListRes
+			Params   = CytologySegmentUpdateReadParams
+			Response = CytologySegmentUpdateReadRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2218,14 +2201,14 @@ func (s *Server) handleCytologySegmentsListRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackCytologySegmentsListParams,
+			unpackCytologySegmentUpdateReadParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CytologySegmentsList(ctx, params)
+				response, err = s.h.CytologySegmentUpdateRead(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CytologySegmentsList(ctx, params)
+		response, err = s.h.CytologySegmentUpdateRead(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2244,7 +2227,7 @@ func (s *Server) handleCytologySegmentsListRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeCytologySegmentsListResponse(response, w, span); err != nil {
+	if err := encodeCytologySegmentUpdateReadResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2253,22 +2236,22 @@ func (s *Server) handleCytologySegmentsListRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleCytologyUpdatePartialUpdateRequest handles CytologyUpdatePartialUpdate operation.
+// handleCytologySegmentUpdateUpdateRequest handles CytologySegmentUpdateUpdate operation.
 //
-// Обновление всей страницы с информацией о приеме.
+// Обновить сегментацию.
 //
-// PATCH /cytology/{id}/update
-func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /cytology/segment/update/{id}
+func (s *Server) handleCytologySegmentUpdateUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologyUpdatePartialUpdate"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/cytology/{id}/update"),
+		otelogen.OperationID("CytologySegmentUpdateUpdate"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/cytology/segment/update/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologyUpdatePartialUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentUpdateUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2323,15 +2306,15 @@ func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CytologyUpdatePartialUpdateOperation,
-			ID:   "CytologyUpdatePartialUpdate",
+			Name: CytologySegmentUpdateUpdateOperation,
+			ID:   "CytologySegmentUpdateUpdate",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CytologyUpdatePartialUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentUpdateUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2373,7 +2356,7 @@ func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEs
 			return
 		}
 	}
-	params, err := decodeCytologyUpdatePartialUpdateParams(args, argsEscaped, r)
+	params, err := decodeCytologySegmentUpdateUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2383,7 +2366,7 @@ func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEs
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeCytologyUpdatePartialUpdateRequest(r)
+	request, close, err := s.decodeCytologySegmentUpdateUpdateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2399,13 +2382,13 @@ func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEs
 		}
 	}()
 
-	var response CytologyUpdatePartialUpdateRes
+	var response CytologySegmentUpdateUpdateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CytologyUpdatePartialUpdateOperation,
-			OperationSummary: "Обновление всей страницы с информацией о приеме",
-			OperationID:      "CytologyUpdatePartialUpdate",
+			OperationName:    CytologySegmentUpdateUpdateOperation,
+			OperationSummary: "обновить сегментацию",
+			OperationID:      "CytologySegmentUpdateUpdate",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
@@ -2417,9 +2400,9 @@ func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEs
 		}
 
 		type (
-			Request  = *CytologyUpdatePartialUpdateReq
-			Params   = CytologyUpdatePartialUpdateParams
-			Response = CytologyUpdatePartialUpdateRes
+			Request  = *CytologySegmentUpdateUpdateReq
+			Params   = CytologySegmentUpdateUpdateParams
+			Response = CytologySegmentUpdateUpdateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2428,14 +2411,14 @@ func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEs
 		](
 			m,
 			mreq,
-			unpackCytologyUpdatePartialUpdateParams,
+			unpackCytologySegmentUpdateUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CytologyUpdatePartialUpdate(ctx, request, params)
+				response, err = s.h.CytologySegmentUpdateUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CytologyUpdatePartialUpdate(ctx, request, params)
+		response, err = s.h.CytologySegmentUpdateUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2454,7 +2437,7 @@ func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEs
 		return
 	}
 
-	if err := encodeCytologyUpdatePartialUpdateResponse(response, w, span); err != nil {
+	if err := encodeCytologySegmentUpdateUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2463,22 +2446,22 @@ func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEs
 	}
 }
 
-// handleCytologyUpdateUpdateRequest handles CytologyUpdateUpdate operation.
+// handleCytologySegmentsListRequest handles CytologySegmentsList operation.
 //
-// Обновление всей страницы с информацией о приеме.
+// Информация об одной группе снимков.
 //
-// PUT /cytology/{id}/update
-func (s *Server) handleCytologyUpdateUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /cytology/{id}/segments
+func (s *Server) handleCytologySegmentsListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CytologyUpdateUpdate"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/cytology/{id}/update"),
+		otelogen.OperationID("CytologySegmentsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/segments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologyUpdateUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentsListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2533,15 +2516,15 @@ func (s *Server) handleCytologyUpdateUpdateRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CytologyUpdateUpdateOperation,
-			ID:   "CytologyUpdateUpdate",
+			Name: CytologySegmentsListOperation,
+			ID:   "CytologySegmentsList",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CytologyUpdateUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentsListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2583,7 +2566,7 @@ func (s *Server) handleCytologyUpdateUpdateRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeCytologyUpdateUpdateParams(args, argsEscaped, r)
+	params, err := decodeCytologySegmentsListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2593,43 +2576,48 @@ func (s *Server) handleCytologyUpdateUpdateRequest(args [1]string, argsEscaped b
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeCytologyUpdateUpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response CytologyUpdateUpdateRes
+	var response CytologySegmentsListRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CytologyUpdateUpdateOperation,
-			OperationSummary: "Обновление всей страницы с информацией о приеме",
-			OperationID:      "CytologyUpdateUpdate",
-			Body:             request,
+			OperationName:    CytologySegmentsListOperation,
+			OperationSummary: "Информация об одной группе снимков",
+			OperationID:      "CytologySegmentsList",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
 					In:   "path",
 				}: params.ID,
+				{
+					Name: "is_ai",
+					In:   "query",
+				}: params.IsAi,
+				{
+					Name: "group_type",
+					In:   "query",
+				}: params.GroupType,
+				{
+					Name: "seg_type",
+					In:   "query",
+				}: params.SegType,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *CytologyUpdateUpdateReq
-			Params   = CytologyUpdateUpdateParams
-			Response = CytologyUpdateUpdateRes
+			Request  = struct{}
+			Params   = CytologySegmentsListParams
+			Response = CytologySegmentsListRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2638,14 +2626,14 @@ func (s *Server) handleCytologyUpdateUpdateRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackCytologyUpdateUpdateParams,
+			unpackCytologySegmentsListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CytologyUpdateUpdate(ctx, request, params)
+				response, err = s.h.CytologySegmentsList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CytologyUpdateUpdate(ctx, request, params)
+		response, err = s.h.CytologySegmentsList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2664,7 +2652,7 @@ func (s *Server) handleCytologyUpdateUpdateRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeCytologyUpdateUpdateResponse(response, w, span); err != nil {
+	if err := encodeCytologySegmentsListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2673,22 +2661,27 @@ func (s *Server) handleCytologyUpdateUpdateRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleDownloadCytologyCytologyIDOriginalImageIDGetRequest handles GET /download/cytology/{cytology_id}/{original_image_id} operation.
+// handleCytologySegmentsViewportRequest handles CytologySegmentsViewport operation.
 //
-// Получить оригинальное изображение цитологического
-// исследования.
+// Сегментации исследования, ограничивающий
+// прямоугольник которых пересекается с областью в
+// пикселях нулевого уровня. Сегментации отсортированы
+// по id, для следующей страницы передайте next_cursor из
+// предыдущего ответа. При downsample больше 1 контуры
+// упрощаются с допуском downsample пикселей.
 //
-// GET /download/cytology/{cytology_id}/{original_image_id}
-func (s *Server) handleDownloadCytologyCytologyIDOriginalImageIDGetRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /cytology/{id}/segments/viewport
+func (s *Server) handleCytologySegmentsViewportRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("CytologySegmentsViewport"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/download/cytology/{cytology_id}/{original_image_id}"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/segments/viewport"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadCytologyCytologyIDOriginalImageIDGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologySegmentsViewportOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2743,15 +2736,15 @@ func (s *Server) handleDownloadCytologyCytologyIDOriginalImageIDGetRequest(args
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DownloadCytologyCytologyIDOriginalImageIDGetOperation,
-			ID:   "",
+			Name: CytologySegmentsViewportOperation,
+			ID:   "CytologySegmentsViewport",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DownloadCytologyCytologyIDOriginalImageIDGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologySegmentsViewportOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2793,7 +2786,7 @@ func (s *Server) handleDownloadCytologyCytologyIDOriginalImageIDGetRequest(args
 			return
 		}
 	}
-	params, err := decodeDownloadCytologyCytologyIDOriginalImageIDGetParams(args, argsEscaped, r)
+	params, err := decodeCytologySegmentsViewportParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2804,31 +2797,67 @@ func (s *Server) handleDownloadCytologyCytologyIDOriginalImageIDGetRequest(args
 		return
 	}
 
-	var response DownloadCytologyCytologyIDOriginalImageIDGetRes
+	var response CytologySegmentsViewportRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DownloadCytologyCytologyIDOriginalImageIDGetOperation,
-			OperationSummary: "получить оригинальное изображение цитологического исследования",
-			OperationID:      "",
+			OperationName:    CytologySegmentsViewportOperation,
+			OperationSummary: "сегментации в области просмотра",
+			OperationID:      "CytologySegmentsViewport",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "cytology_id",
+					Name: "id",
 					In:   "path",
-				}: params.CytologyID,
+				}: params.ID,
 				{
-					Name: "original_image_id",
-					In:   "path",
-				}: params.OriginalImageID,
+					Name: "min_x",
+					In:   "query",
+				}: params.MinX,
+				{
+					Name: "min_y",
+					In:   "query",
+				}: params.MinY,
+				{
+					Name: "max_x",
+					In:   "query",
+				}: params.MaxX,
+				{
+					Name: "max_y",
+					In:   "query",
+				}: params.MaxY,
+				{
+					Name: "seg_type",
+					In:   "query",
+				}: params.SegType,
+				{
+					Name: "group_type",
+					In:   "query",
+				}: params.GroupType,
+				{
+					Name: "is_ai",
+					In:   "query",
+				}: params.IsAi,
+				{
+					Name: "downsample",
+					In:   "query",
+				}: params.Downsample,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DownloadCytologyCytologyIDOriginalImageIDGetParams
-			Response = DownloadCytologyCytologyIDOriginalImageIDGetRes
+			Params   = CytologySegmentsViewportParams
+			Response = CytologySegmentsViewportRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2837,14 +2866,14 @@ func (s *Server) handleDownloadCytologyCytologyIDOriginalImageIDGetRequest(args
 		](
 			m,
 			mreq,
-			unpackDownloadCytologyCytologyIDOriginalImageIDGetParams,
+			unpackCytologySegmentsViewportParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DownloadCytologyCytologyIDOriginalImageIDGet(ctx, params)
+				response, err = s.h.CytologySegmentsViewport(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DownloadCytologyCytologyIDOriginalImageIDGet(ctx, params)
+		response, err = s.h.CytologySegmentsViewport(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2863,7 +2892,7 @@ func (s *Server) handleDownloadCytologyCytologyIDOriginalImageIDGetRequest(args
 		return
 	}
 
-	if err := encodeDownloadCytologyCytologyIDOriginalImageIDGetResponse(response, w, span); err != nil {
+	if err := encodeCytologySegmentsViewportResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2872,21 +2901,22 @@ func (s *Server) handleDownloadCytologyCytologyIDOriginalImageIDGetRequest(args
 	}
 }
 
-// handleDownloadUziIDImageIDGetRequest handles GET /download/{uzi_id}/{image_id} operation.
+// handleCytologyUpdatePartialUpdateRequest handles CytologyUpdatePartialUpdate operation.
 //
-// Получить кадр узи.
+// Обновление всей страницы с информацией о приеме.
 //
-// GET /download/{uzi_id}/{image_id}
-func (s *Server) handleDownloadUziIDImageIDGetRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /cytology/{id}/update
+func (s *Server) handleCytologyUpdatePartialUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/download/{uzi_id}/{image_id}"),
+		otelogen.OperationID("CytologyUpdatePartialUpdate"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/update"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadUziIDImageIDGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologyUpdatePartialUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2941,15 +2971,15 @@ func (s *Server) handleDownloadUziIDImageIDGetRequest(args [2]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DownloadUziIDImageIDGetOperation,
-			ID:   "",
+			Name: CytologyUpdatePartialUpdateOperation,
+			ID:   "CytologyUpdatePartialUpdate",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DownloadUziIDImageIDGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologyUpdatePartialUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2991,7 +3021,7 @@ func (s *Server) handleDownloadUziIDImageIDGetRequest(args [2]string, argsEscape
 			return
 		}
 	}
-	params, err := decodeDownloadUziIDImageIDGetParams(args, argsEscaped, r)
+	params, err := decodeCytologyUpdatePartialUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3001,32 +3031,43 @@ func (s *Server) handleDownloadUziIDImageIDGetRequest(args [2]string, argsEscape
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-
-	var response DownloadUziIDImageIDGetRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    DownloadUziIDImageIDGetOperation,
-			OperationSummary: "получить кадр узи",
-			OperationID:      "",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "uzi_id",
-					In:   "path",
-				}: params.UziID,
+	request, close, err := s.decodeCytologyUpdatePartialUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CytologyUpdatePartialUpdateRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CytologyUpdatePartialUpdateOperation,
+			OperationSummary: "Обновление всей страницы с информацией о приеме",
+			OperationID:      "CytologyUpdatePartialUpdate",
+			Body:             request,
+			Params: middleware.Parameters{
 				{
-					Name: "image_id",
+					Name: "id",
 					In:   "path",
-				}: params.ImageID,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DownloadUziIDImageIDGetParams
-			Response = DownloadUziIDImageIDGetRes
+			Request  = *CytologyUpdatePartialUpdateReq
+			Params   = CytologyUpdatePartialUpdateParams
+			Response = CytologyUpdatePartialUpdateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3035,14 +3076,14 @@ func (s *Server) handleDownloadUziIDImageIDGetRequest(args [2]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackDownloadUziIDImageIDGetParams,
+			unpackCytologyUpdatePartialUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DownloadUziIDImageIDGet(ctx, params)
+				response, err = s.h.CytologyUpdatePartialUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DownloadUziIDImageIDGet(ctx, params)
+		response, err = s.h.CytologyUpdatePartialUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3061,7 +3102,7 @@ func (s *Server) handleDownloadUziIDImageIDGetRequest(args [2]string, argsEscape
 		return
 	}
 
-	if err := encodeDownloadUziIDImageIDGetResponse(response, w, span); err != nil {
+	if err := encodeCytologyUpdatePartialUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3070,21 +3111,22 @@ func (s *Server) handleDownloadUziIDImageIDGetRequest(args [2]string, argsEscape
 	}
 }
 
-// handleLoginPostRequest handles POST /login operation.
+// handleCytologyUpdateUpdateRequest handles CytologyUpdateUpdate operation.
 //
-// Авторизация.
+// Обновление всей страницы с информацией о приеме.
 //
-// POST /login
-func (s *Server) handleLoginPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /cytology/{id}/update
+func (s *Server) handleCytologyUpdateUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/login"),
+		otelogen.OperationID("CytologyUpdateUpdate"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/cytology/{id}/update"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginPostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CytologyUpdateUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3139,11 +3181,67 @@ func (s *Server) handleLoginPostRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: LoginPostOperation,
-			ID:   "",
+			Name: CytologyUpdateUpdateOperation,
+			ID:   "CytologyUpdateUpdate",
 		}
 	)
-	request, close, err := s.decodeLoginPostRequest(r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CytologyUpdateUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeCytologyUpdateUpdateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeCytologyUpdateUpdateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -3159,22 +3257,27 @@ func (s *Server) handleLoginPostRequest(args [0]string, argsEscaped bool, w http
 		}
 	}()
 
-	var response LoginPostRes
+	var response CytologyUpdateUpdateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LoginPostOperation,
-			OperationSummary: "авторизация",
-			OperationID:      "",
+			OperationName:    CytologyUpdateUpdateOperation,
+			OperationSummary: "Обновление всей страницы с информацией о приеме",
+			OperationID:      "CytologyUpdateUpdate",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *LoginPostReq
-			Params   = struct{}
-			Response = LoginPostRes
+			Request  = *CytologyUpdateUpdateReq
+			Params   = CytologyUpdateUpdateParams
+			Response = CytologyUpdateUpdateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3183,14 +3286,14 @@ func (s *Server) handleLoginPostRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackCytologyUpdateUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.LoginPost(ctx, request)
+				response, err = s.h.CytologyUpdateUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.LoginPost(ctx, request)
+		response, err = s.h.CytologyUpdateUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3209,7 +3312,7 @@ func (s *Server) handleLoginPostRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeLoginPostResponse(response, w, span); err != nil {
+	if err := encodeCytologyUpdateUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3218,21 +3321,22 @@ func (s *Server) handleLoginPostRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleMedCardDoctorIDPatientIDGetRequest handles GET /med/card/{doctor_id}/{patient_id} operation.
+// handleDownloadCytologyCytologyIDOriginalImageIDGetRequest handles GET /download/cytology/{cytology_id}/{original_image_id} operation.
 //
-// Получить карту пациента.
+// Получить оригинальное изображение цитологического
+// исследования.
 //
-// GET /med/card/{doctor_id}/{patient_id}
-func (s *Server) handleMedCardDoctorIDPatientIDGetRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /download/cytology/{cytology_id}/{original_image_id}
+func (s *Server) handleDownloadCytologyCytologyIDOriginalImageIDGetRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/med/card/{doctor_id}/{patient_id}"),
+		semconv.HTTPRouteKey.String("/download/cytology/{cytology_id}/{original_image_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MedCardDoctorIDPatientIDGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadCytologyCytologyIDOriginalImageIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3287,7 +3391,7 @@ func (s *Server) handleMedCardDoctorIDPatientIDGetRequest(args [2]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MedCardDoctorIDPatientIDGetOperation,
+			Name: DownloadCytologyCytologyIDOriginalImageIDGetOperation,
 			ID:   "",
 		}
 	)
@@ -3295,7 +3399,7 @@ func (s *Server) handleMedCardDoctorIDPatientIDGetRequest(args [2]string, argsEs
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MedCardDoctorIDPatientIDGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DownloadCytologyCytologyIDOriginalImageIDGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3337,7 +3441,7 @@ func (s *Server) handleMedCardDoctorIDPatientIDGetRequest(args [2]string, argsEs
 			return
 		}
 	}
-	params, err := decodeMedCardDoctorIDPatientIDGetParams(args, argsEscaped, r)
+	params, err := decodeDownloadCytologyCytologyIDOriginalImageIDGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3348,31 +3452,31 @@ func (s *Server) handleMedCardDoctorIDPatientIDGetRequest(args [2]string, argsEs
 		return
 	}
 
-	var response MedCardDoctorIDPatientIDGetRes
+	var response DownloadCytologyCytologyIDOriginalImageIDGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MedCardDoctorIDPatientIDGetOperation,
-			OperationSummary: "получить карту пациента",
+			OperationName:    DownloadCytologyCytologyIDOriginalImageIDGetOperation,
+			OperationSummary: "получить оригинальное изображение цитологического исследования",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "doctor_id",
+					Name: "cytology_id",
 					In:   "path",
-				}: params.DoctorID,
+				}: params.CytologyID,
 				{
-					Name: "patient_id",
+					Name: "original_image_id",
 					In:   "path",
-				}: params.PatientID,
+				}: params.OriginalImageID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = MedCardDoctorIDPatientIDGetParams
-			Response = MedCardDoctorIDPatientIDGetRes
+			Params   = DownloadCytologyCytologyIDOriginalImageIDGetParams
+			Response = DownloadCytologyCytologyIDOriginalImageIDGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3381,14 +3485,14 @@ func (s *Server) handleMedCardDoctorIDPatientIDGetRequest(args [2]string, argsEs
 		](
 			m,
 			mreq,
-			unpackMedCardDoctorIDPatientIDGetParams,
+			unpackDownloadCytologyCytologyIDOriginalImageIDGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MedCardDoctorIDPatientIDGet(ctx, params)
+				response, err = s.h.DownloadCytologyCytologyIDOriginalImageIDGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MedCardDoctorIDPatientIDGet(ctx, params)
+		response, err = s.h.DownloadCytologyCytologyIDOriginalImageIDGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3407,7 +3511,7 @@ func (s *Server) handleMedCardDoctorIDPatientIDGetRequest(args [2]string, argsEs
 		return
 	}
 
-	if err := encodeMedCardDoctorIDPatientIDGetResponse(response, w, span); err != nil {
+	if err := encodeDownloadCytologyCytologyIDOriginalImageIDGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3416,21 +3520,21 @@ func (s *Server) handleMedCardDoctorIDPatientIDGetRequest(args [2]string, argsEs
 	}
 }
 
-// handleMedCardDoctorIDPatientIDPatchRequest handles PATCH /med/card/{doctor_id}/{patient_id} operation.
+// handleDownloadUziIDImageIDGetRequest handles GET /download/{uzi_id}/{image_id} operation.
 //
-// Обновить карту пациента.
+// Получить кадр узи.
 //
-// PATCH /med/card/{doctor_id}/{patient_id}
-func (s *Server) handleMedCardDoctorIDPatientIDPatchRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /download/{uzi_id}/{image_id}
+func (s *Server) handleDownloadUziIDImageIDGetRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/med/card/{doctor_id}/{patient_id}"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/download/{uzi_id}/{image_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MedCardDoctorIDPatientIDPatchOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadUziIDImageIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3485,7 +3589,7 @@ func (s *Server) handleMedCardDoctorIDPatientIDPatchRequest(args [2]string, args
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MedCardDoctorIDPatientIDPatchOperation,
+			Name: DownloadUziIDImageIDGetOperation,
 			ID:   "",
 		}
 	)
@@ -3493,7 +3597,7 @@ func (s *Server) handleMedCardDoctorIDPatientIDPatchRequest(args [2]string, args
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MedCardDoctorIDPatientIDPatchOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DownloadUziIDImageIDGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3535,7 +3639,7 @@ func (s *Server) handleMedCardDoctorIDPatientIDPatchRequest(args [2]string, args
 			return
 		}
 	}
-	params, err := decodeMedCardDoctorIDPatientIDPatchParams(args, argsEscaped, r)
+	params, err := decodeDownloadUziIDImageIDGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3545,48 +3649,37 @@ func (s *Server) handleMedCardDoctorIDPatientIDPatchRequest(args [2]string, args
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeMedCardDoctorIDPatientIDPatchRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response MedCardDoctorIDPatientIDPatchRes
+	var response DownloadUziIDImageIDGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MedCardDoctorIDPatientIDPatchOperation,
-			OperationSummary: "обновить карту пациента",
+			OperationName:    DownloadUziIDImageIDGetOperation,
+			OperationSummary: "получить кадр узи",
 			OperationID:      "",
-			Body:             request,
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "doctor_id",
+					Name: "uzi_id",
 					In:   "path",
-				}: params.DoctorID,
+				}: params.UziID,
 				{
-					Name: "patient_id",
+					Name: "image_id",
 					In:   "path",
-				}: params.PatientID,
+				}: params.ImageID,
+				{
+					Name: "size",
+					In:   "query",
+				}: params.Size,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *MedCardDoctorIDPatientIDPatchReq
-			Params   = MedCardDoctorIDPatientIDPatchParams
-			Response = MedCardDoctorIDPatientIDPatchRes
-		)
+			Request  = struct{}
+			Params   = DownloadUziIDImageIDGetParams
+			Response = DownloadUziIDImageIDGetRes
+		)
 		response, err = middleware.HookMiddleware[
 			Request,
 			Params,
@@ -3594,14 +3687,14 @@ func (s *Server) handleMedCardDoctorIDPatientIDPatchRequest(args [2]string, args
 		](
 			m,
 			mreq,
-			unpackMedCardDoctorIDPatientIDPatchParams,
+			unpackDownloadUziIDImageIDGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MedCardDoctorIDPatientIDPatch(ctx, request, params)
+				response, err = s.h.DownloadUziIDImageIDGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MedCardDoctorIDPatientIDPatch(ctx, request, params)
+		response, err = s.h.DownloadUziIDImageIDGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3620,7 +3713,7 @@ func (s *Server) handleMedCardDoctorIDPatientIDPatchRequest(args [2]string, args
 		return
 	}
 
-	if err := encodeMedCardDoctorIDPatientIDPatchResponse(response, w, span); err != nil {
+	if err := encodeDownloadUziIDImageIDGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3629,21 +3722,21 @@ func (s *Server) handleMedCardDoctorIDPatientIDPatchRequest(args [2]string, args
 	}
 }
 
-// handleMedCardPostRequest handles POST /med/card operation.
+// handleDownloadUziUziIDReportGetRequest handles GET /download/uzi/{uzi_id}/report operation.
 //
-// Создать карту пациента.
+// Скачать заключение по узи.
 //
-// POST /med/card
-func (s *Server) handleMedCardPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /download/uzi/{uzi_id}/report
+func (s *Server) handleDownloadUziUziIDReportGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/med/card"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/download/uzi/{uzi_id}/report"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MedCardPostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadUziUziIDReportGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3698,7 +3791,7 @@ func (s *Server) handleMedCardPostRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MedCardPostOperation,
+			Name: DownloadUziUziIDReportGetOperation,
 			ID:   "",
 		}
 	)
@@ -3706,7 +3799,7 @@ func (s *Server) handleMedCardPostRequest(args [0]string, argsEscaped bool, w ht
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MedCardPostOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DownloadUziUziIDReportGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3748,38 +3841,46 @@ func (s *Server) handleMedCardPostRequest(args [0]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	request, close, err := s.decodeMedCardPostRequest(r)
+	params, err := decodeDownloadUziUziIDReportGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response MedCardPostRes
+	var response DownloadUziUziIDReportGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MedCardPostOperation,
-			OperationSummary: "создать карту пациента",
+			OperationName:    DownloadUziUziIDReportGetOperation,
+			OperationSummary: "скачать заключение по узи",
 			OperationID:      "",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uzi_id",
+					In:   "path",
+				}: params.UziID,
+				{
+					Name: "version",
+					In:   "query",
+				}: params.Version,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *Card
-			Params   = struct{}
-			Response = MedCardPostRes
+			Request  = struct{}
+			Params   = DownloadUziUziIDReportGetParams
+			Response = DownloadUziUziIDReportGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3788,14 +3889,14 @@ func (s *Server) handleMedCardPostRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			nil,
+			unpackDownloadUziUziIDReportGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MedCardPost(ctx, request)
+				response, err = s.h.DownloadUziUziIDReportGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MedCardPost(ctx, request)
+		response, err = s.h.DownloadUziUziIDReportGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3814,7 +3915,7 @@ func (s *Server) handleMedCardPostRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeMedCardPostResponse(response, w, span); err != nil {
+	if err := encodeDownloadUziUziIDReportGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3823,21 +3924,21 @@ func (s *Server) handleMedCardPostRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleMedDoctorIDGetRequest handles GET /med/doctor/{id} operation.
+// handleLoginPostRequest handles POST /login operation.
 //
-// Получить врача.
+// Авторизация.
 //
-// GET /med/doctor/{id}
-func (s *Server) handleMedDoctorIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /login
+func (s *Server) handleLoginPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/med/doctor/{id}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/login"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MedDoctorIDGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3892,88 +3993,42 @@ func (s *Server) handleMedDoctorIDGetRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MedDoctorIDGetOperation,
+			Name: LoginPostOperation,
 			ID:   "",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MedDoctorIDGetOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-					defer recordError("Security:BearerAuth", err)
-				}
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-				defer recordError("Security", err)
-			}
-			return
-		}
-	}
-	params, err := decodeMedDoctorIDGetParams(args, argsEscaped, r)
+	request, close, err := s.decodeLoginPostRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response MedDoctorIDGetRes
+	var response LoginPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MedDoctorIDGetOperation,
-			OperationSummary: "получить врача",
+			OperationName:    LoginPostOperation,
+			OperationSummary: "авторизация",
 			OperationID:      "",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = MedDoctorIDGetParams
-			Response = MedDoctorIDGetRes
+			Request  = *LoginPostReq
+			Params   = struct{}
+			Response = LoginPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3982,14 +4037,14 @@ func (s *Server) handleMedDoctorIDGetRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackMedDoctorIDGetParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MedDoctorIDGet(ctx, params)
+				response, err = s.h.LoginPost(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MedDoctorIDGet(ctx, params)
+		response, err = s.h.LoginPost(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4008,7 +4063,7 @@ func (s *Server) handleMedDoctorIDGetRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeMedDoctorIDGetResponse(response, w, span); err != nil {
+	if err := encodeLoginPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4017,21 +4072,21 @@ func (s *Server) handleMedDoctorIDGetRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleMedDoctorIDPatientsGetRequest handles GET /med/doctor/{id}/patients operation.
+// handleMedCardDoctorIDPatientIDGetRequest handles GET /med/card/{doctor_id}/{patient_id} operation.
 //
-// Получить пациентов врача.
+// Получить карту пациента.
 //
-// GET /med/doctor/{id}/patients
-func (s *Server) handleMedDoctorIDPatientsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /med/card/{doctor_id}/{patient_id}
+func (s *Server) handleMedCardDoctorIDPatientIDGetRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/med/doctor/{id}/patients"),
+		semconv.HTTPRouteKey.String("/med/card/{doctor_id}/{patient_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MedDoctorIDPatientsGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MedCardDoctorIDPatientIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4086,7 +4141,7 @@ func (s *Server) handleMedDoctorIDPatientsGetRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MedDoctorIDPatientsGetOperation,
+			Name: MedCardDoctorIDPatientIDGetOperation,
 			ID:   "",
 		}
 	)
@@ -4094,7 +4149,7 @@ func (s *Server) handleMedDoctorIDPatientsGetRequest(args [1]string, argsEscaped
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MedDoctorIDPatientsGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, MedCardDoctorIDPatientIDGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4136,7 +4191,7 @@ func (s *Server) handleMedDoctorIDPatientsGetRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeMedDoctorIDPatientsGetParams(args, argsEscaped, r)
+	params, err := decodeMedCardDoctorIDPatientIDGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4147,31 +4202,31 @@ func (s *Server) handleMedDoctorIDPatientsGetRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response MedDoctorIDPatientsGetRes
+	var response MedCardDoctorIDPatientIDGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MedDoctorIDPatientsGetOperation,
-			OperationSummary: "получить пациентов врача",
+			OperationName:    MedCardDoctorIDPatientIDGetOperation,
+			OperationSummary: "получить карту пациента",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
+					Name: "doctor_id",
 					In:   "path",
-				}: params.ID,
+				}: params.DoctorID,
 				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
+					Name: "patient_id",
+					In:   "path",
+				}: params.PatientID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = MedDoctorIDPatientsGetParams
-			Response = MedDoctorIDPatientsGetRes
+			Params   = MedCardDoctorIDPatientIDGetParams
+			Response = MedCardDoctorIDPatientIDGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4180,14 +4235,14 @@ func (s *Server) handleMedDoctorIDPatientsGetRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackMedDoctorIDPatientsGetParams,
+			unpackMedCardDoctorIDPatientIDGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MedDoctorIDPatientsGet(ctx, params)
+				response, err = s.h.MedCardDoctorIDPatientIDGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MedDoctorIDPatientsGet(ctx, params)
+		response, err = s.h.MedCardDoctorIDPatientIDGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4206,7 +4261,7 @@ func (s *Server) handleMedDoctorIDPatientsGetRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeMedDoctorIDPatientsGetResponse(response, w, span); err != nil {
+	if err := encodeMedCardDoctorIDPatientIDGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4215,21 +4270,21 @@ func (s *Server) handleMedDoctorIDPatientsGetRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleMedPatientIDGetRequest handles GET /med/patient/{id} operation.
+// handleMedCardDoctorIDPatientIDPatchRequest handles PATCH /med/card/{doctor_id}/{patient_id} operation.
 //
-// Получить пациента.
+// Обновить карту пациента.
 //
-// GET /med/patient/{id}
-func (s *Server) handleMedPatientIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /med/card/{doctor_id}/{patient_id}
+func (s *Server) handleMedCardDoctorIDPatientIDPatchRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/med/patient/{id}"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/med/card/{doctor_id}/{patient_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MedPatientIDGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MedCardDoctorIDPatientIDPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4284,7 +4339,7 @@ func (s *Server) handleMedPatientIDGetRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MedPatientIDGetOperation,
+			Name: MedCardDoctorIDPatientIDPatchOperation,
 			ID:   "",
 		}
 	)
@@ -4292,7 +4347,7 @@ func (s *Server) handleMedPatientIDGetRequest(args [1]string, argsEscaped bool,
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MedPatientIDGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, MedCardDoctorIDPatientIDPatchOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4334,7 +4389,7 @@ func (s *Server) handleMedPatientIDGetRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeMedPatientIDGetParams(args, argsEscaped, r)
+	params, err := decodeMedCardDoctorIDPatientIDPatchParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4344,28 +4399,47 @@ func (s *Server) handleMedPatientIDGetRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeMedCardDoctorIDPatientIDPatchRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response MedPatientIDGetRes
+	var response MedCardDoctorIDPatientIDPatchRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MedPatientIDGetOperation,
-			OperationSummary: "получить пациента",
+			OperationName:    MedCardDoctorIDPatientIDPatchOperation,
+			OperationSummary: "обновить карту пациента",
 			OperationID:      "",
-			Body:             nil,
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
+					Name: "doctor_id",
 					In:   "path",
-				}: params.ID,
+				}: params.DoctorID,
+				{
+					Name: "patient_id",
+					In:   "path",
+				}: params.PatientID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = MedPatientIDGetParams
-			Response = MedPatientIDGetRes
+			Request  = *MedCardDoctorIDPatientIDPatchReq
+			Params   = MedCardDoctorIDPatientIDPatchParams
+			Response = MedCardDoctorIDPatientIDPatchRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4374,14 +4448,14 @@ func (s *Server) handleMedPatientIDGetRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackMedPatientIDGetParams,
+			unpackMedCardDoctorIDPatientIDPatchParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MedPatientIDGet(ctx, params)
+				response, err = s.h.MedCardDoctorIDPatientIDPatch(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MedPatientIDGet(ctx, params)
+		response, err = s.h.MedCardDoctorIDPatientIDPatch(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4400,7 +4474,7 @@ func (s *Server) handleMedPatientIDGetRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeMedPatientIDGetResponse(response, w, span); err != nil {
+	if err := encodeMedCardDoctorIDPatientIDPatchResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4409,21 +4483,21 @@ func (s *Server) handleMedPatientIDGetRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleMedPatientIDPatchRequest handles PATCH /med/patient/{id} operation.
+// handleMedCardPostRequest handles POST /med/card operation.
 //
-// Обновить пациента.
+// Создать карту пациента.
 //
-// PATCH /med/patient/{id}
-func (s *Server) handleMedPatientIDPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /med/card
+func (s *Server) handleMedCardPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/med/patient/{id}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/med/card"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MedPatientIDPatchOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MedCardPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4478,7 +4552,7 @@ func (s *Server) handleMedPatientIDPatchRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MedPatientIDPatchOperation,
+			Name: MedCardPostOperation,
 			ID:   "",
 		}
 	)
@@ -4486,7 +4560,7 @@ func (s *Server) handleMedPatientIDPatchRequest(args [1]string, argsEscaped bool
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MedPatientIDPatchOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, MedCardPostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4528,17 +4602,7 @@ func (s *Server) handleMedPatientIDPatchRequest(args [1]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeMedPatientIDPatchParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeMedPatientIDPatchRequest(r)
+	request, close, err := s.decodeMedCardPostRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -4554,27 +4618,22 @@ func (s *Server) handleMedPatientIDPatchRequest(args [1]string, argsEscaped bool
 		}
 	}()
 
-	var response MedPatientIDPatchRes
+	var response MedCardPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MedPatientIDPatchOperation,
-			OperationSummary: "обновить пациента",
+			OperationName:    MedCardPostOperation,
+			OperationSummary: "создать карту пациента",
 			OperationID:      "",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *MedPatientIDPatchReq
-			Params   = MedPatientIDPatchParams
-			Response = MedPatientIDPatchRes
+			Request  = *Card
+			Params   = struct{}
+			Response = MedCardPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4583,14 +4642,14 @@ func (s *Server) handleMedPatientIDPatchRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackMedPatientIDPatchParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MedPatientIDPatch(ctx, request, params)
+				response, err = s.h.MedCardPost(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MedPatientIDPatch(ctx, request, params)
+		response, err = s.h.MedCardPost(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4609,7 +4668,7 @@ func (s *Server) handleMedPatientIDPatchRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeMedPatientIDPatchResponse(response, w, span); err != nil {
+	if err := encodeMedCardPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4618,21 +4677,21 @@ func (s *Server) handleMedPatientIDPatchRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleMedPatientPostRequest handles POST /med/patient operation.
+// handleMedDoctorIDGetRequest handles GET /med/doctor/{id} operation.
 //
-// Зарегистрировать пациента.
+// Получить врача.
 //
-// POST /med/patient
-func (s *Server) handleMedPatientPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /med/doctor/{id}
+func (s *Server) handleMedDoctorIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/med/patient"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/med/doctor/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MedPatientPostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MedDoctorIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4687,7 +4746,7 @@ func (s *Server) handleMedPatientPostRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MedPatientPostOperation,
+			Name: MedDoctorIDGetOperation,
 			ID:   "",
 		}
 	)
@@ -4695,7 +4754,7 @@ func (s *Server) handleMedPatientPostRequest(args [0]string, argsEscaped bool, w
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MedPatientPostOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, MedDoctorIDGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4737,38 +4796,38 @@ func (s *Server) handleMedPatientPostRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeMedPatientPostRequest(r)
+	params, err := decodeMedDoctorIDGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response MedPatientPostRes
+	var response MedDoctorIDGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MedPatientPostOperation,
-			OperationSummary: "зарегистрировать пациента",
+			OperationName:    MedDoctorIDGetOperation,
+			OperationSummary: "получить врача",
 			OperationID:      "",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *MedPatientPostReq
-			Params   = struct{}
-			Response = MedPatientPostRes
+			Request  = struct{}
+			Params   = MedDoctorIDGetParams
+			Response = MedDoctorIDGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4777,14 +4836,14 @@ func (s *Server) handleMedPatientPostRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackMedDoctorIDGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MedPatientPost(ctx, request)
+				response, err = s.h.MedDoctorIDGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MedPatientPost(ctx, request)
+		response, err = s.h.MedDoctorIDGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4803,7 +4862,7 @@ func (s *Server) handleMedPatientPostRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeMedPatientPostResponse(response, w, span); err != nil {
+	if err := encodeMedDoctorIDGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4812,21 +4871,21 @@ func (s *Server) handleMedPatientPostRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handlePaymentProvidersGetRequest handles GET /payment_providers operation.
+// handleMedDoctorIDPatientsGetRequest handles GET /med/doctor/{id}/patients operation.
 //
-// Получить список всех платежных провайдеров.
+// Получить пациентов врача.
 //
-// GET /payment_providers
-func (s *Server) handlePaymentProvidersGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /med/doctor/{id}/patients
+func (s *Server) handleMedDoctorIDPatientsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/payment_providers"),
+		semconv.HTTPRouteKey.String("/med/doctor/{id}/patients"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PaymentProvidersGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MedDoctorIDPatientsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4881,7 +4940,7 @@ func (s *Server) handlePaymentProvidersGetRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PaymentProvidersGetOperation,
+			Name: MedDoctorIDPatientsGetOperation,
 			ID:   "",
 		}
 	)
@@ -4889,7 +4948,7 @@ func (s *Server) handlePaymentProvidersGetRequest(args [0]string, argsEscaped bo
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PaymentProvidersGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, MedDoctorIDPatientsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4931,23 +4990,42 @@ func (s *Server) handlePaymentProvidersGetRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
+	params, err := decodeMedDoctorIDPatientsGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response PaymentProvidersGetRes
+	var response MedDoctorIDPatientsGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PaymentProvidersGetOperation,
-			OperationSummary: "Получить список всех платежных провайдеров",
+			OperationName:    MedDoctorIDPatientsGetOperation,
+			OperationSummary: "получить пациентов врача",
 			OperationID:      "",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = PaymentProvidersGetRes
+			Params   = MedDoctorIDPatientsGetParams
+			Response = MedDoctorIDPatientsGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4956,14 +5034,14 @@ func (s *Server) handlePaymentProvidersGetRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			nil,
+			unpackMedDoctorIDPatientsGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PaymentProvidersGet(ctx)
+				response, err = s.h.MedDoctorIDPatientsGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PaymentProvidersGet(ctx)
+		response, err = s.h.MedDoctorIDPatientsGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4982,7 +5060,7 @@ func (s *Server) handlePaymentProvidersGetRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodePaymentProvidersGetResponse(response, w, span); err != nil {
+	if err := encodeMedDoctorIDPatientsGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4991,21 +5069,21 @@ func (s *Server) handlePaymentProvidersGetRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleRefreshPostRequest handles POST /refresh operation.
+// handleMedPatientIDGetRequest handles GET /med/patient/{id} operation.
 //
-// Обновить access token.
+// Получить пациента.
 //
-// POST /refresh
-func (s *Server) handleRefreshPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /med/patient/{id}
+func (s *Server) handleMedPatientIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/refresh"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/med/patient/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RefreshPostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MedPatientIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5060,42 +5138,5926 @@ func (s *Server) handleRefreshPostRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RefreshPostOperation,
+			Name: MedPatientIDGetOperation,
 			ID:   "",
 		}
 	)
-	request, close, err := s.decodeRefreshPostRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MedPatientIDGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
 		}
-	}()
 
-	var response RefreshPostRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    RefreshPostOperation,
-			OperationSummary: "обновить access token",
-			OperationID:      "",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
-		}
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeMedPatientIDGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response MedPatientIDGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MedPatientIDGetOperation,
+			OperationSummary: "получить пациента",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = MedPatientIDGetParams
+			Response = MedPatientIDGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackMedPatientIDGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MedPatientIDGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MedPatientIDGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMedPatientIDGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMedPatientIDPatchRequest handles PATCH /med/patient/{id} operation.
+//
+// Обновить пациента.
+//
+// PATCH /med/patient/{id}
+func (s *Server) handleMedPatientIDPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/med/patient/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MedPatientIDPatchOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MedPatientIDPatchOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MedPatientIDPatchOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeMedPatientIDPatchParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeMedPatientIDPatchRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response MedPatientIDPatchRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MedPatientIDPatchOperation,
+			OperationSummary: "обновить пациента",
+			OperationID:      "",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *MedPatientIDPatchReq
+			Params   = MedPatientIDPatchParams
+			Response = MedPatientIDPatchRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackMedPatientIDPatchParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MedPatientIDPatch(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MedPatientIDPatch(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMedPatientIDPatchResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMedPatientPostRequest handles POST /med/patient operation.
+//
+// Зарегистрировать пациента.
+//
+// POST /med/patient
+func (s *Server) handleMedPatientPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/med/patient"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MedPatientPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MedPatientPostOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MedPatientPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeMedPatientPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response MedPatientPostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MedPatientPostOperation,
+			OperationSummary: "зарегистрировать пациента",
+			OperationID:      "",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *MedPatientPostReq
+			Params   = struct{}
+			Response = MedPatientPostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MedPatientPost(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MedPatientPost(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMedPatientPostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePaymentProvidersGetRequest handles GET /payment_providers operation.
+//
+// Получить список всех платежных провайдеров.
+//
+// GET /payment_providers
+func (s *Server) handlePaymentProvidersGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/payment_providers"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PaymentProvidersGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PaymentProvidersGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PaymentProvidersGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response PaymentProvidersGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PaymentProvidersGetOperation,
+			OperationSummary: "Получить список всех платежных провайдеров",
+			OperationID:      "",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = PaymentProvidersGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PaymentProvidersGet(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PaymentProvidersGet(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePaymentProvidersGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRefreshPostRequest handles POST /refresh operation.
+//
+// Обновить access token.
+//
+// POST /refresh
+func (s *Server) handleRefreshPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/refresh"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RefreshPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RefreshPostOperation,
+			ID:   "",
+		}
+	)
+	request, close, err := s.decodeRefreshPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response RefreshPostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RefreshPostOperation,
+			OperationSummary: "обновить access token",
+			OperationID:      "",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *RefreshPostReq
+			Params   = struct{}
+			Response = RefreshPostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RefreshPost(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RefreshPost(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRefreshPostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRegDoctorPostRequest handles POST /reg/doctor operation.
+//
+// Зарегистрировать врача (как пользователя).
+//
+// POST /reg/doctor
+func (s *Server) handleRegDoctorPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/reg/doctor"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RegDoctorPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RegDoctorPostOperation,
+			ID:   "",
+		}
+	)
+	request, close, err := s.decodeRegDoctorPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response RegDoctorPostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RegDoctorPostOperation,
+			OperationSummary: "зарегистрировать врача (как пользователя)",
+			OperationID:      "",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *RegDoctorPostReq
+			Params   = struct{}
+			Response = RegDoctorPostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RegDoctorPost(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RegDoctorPost(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRegDoctorPostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRegPatientPostRequest handles POST /reg/patient operation.
+//
+// Зарегистрировать пациента (как пользователя).
+// **Сделано под мобилки api**. Врачам регистрировать
+// пациентов через /med/patient.
+//
+// POST /reg/patient
+func (s *Server) handleRegPatientPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/reg/patient"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RegPatientPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RegPatientPostOperation,
+			ID:   "",
+		}
+	)
+	request, close, err := s.decodeRegPatientPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response RegPatientPostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RegPatientPostOperation,
+			OperationSummary: "зарегистрировать пациента (как пользователя). **Сделано под мобилки api**. Врачам регистрировать пациентов через /med/patient",
+			OperationID:      "",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *RegPatientPostReq
+			Params   = struct{}
+			Response = RegPatientPostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RegPatientPost(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RegPatientPost(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRegPatientPostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSubscriptionsCheckActiveGetRequest handles GET /subscriptions/check-active operation.
+//
+// Проверить наличие активной подписки у текущего
+// пользователя.
+//
+// GET /subscriptions/check-active
+func (s *Server) handleSubscriptionsCheckActiveGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/subscriptions/check-active"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SubscriptionsCheckActiveGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SubscriptionsCheckActiveGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SubscriptionsCheckActiveGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response SubscriptionsCheckActiveGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SubscriptionsCheckActiveGetOperation,
+			OperationSummary: "Проверить наличие активной подписки у текущего пользователя",
+			OperationID:      "",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = SubscriptionsCheckActiveGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SubscriptionsCheckActiveGet(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SubscriptionsCheckActiveGet(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSubscriptionsCheckActiveGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSubscriptionsGetActiveGetRequest handles GET /subscriptions/get-active operation.
+//
+// Получить информацию об активной подписке текущего
+// пользователя.
+//
+// GET /subscriptions/get-active
+func (s *Server) handleSubscriptionsGetActiveGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/subscriptions/get-active"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SubscriptionsGetActiveGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SubscriptionsGetActiveGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SubscriptionsGetActiveGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response SubscriptionsGetActiveGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SubscriptionsGetActiveGetOperation,
+			OperationSummary: "Получить информацию об активной подписке текущего пользователя",
+			OperationID:      "",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = SubscriptionsGetActiveGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SubscriptionsGetActiveGet(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SubscriptionsGetActiveGet(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSubscriptionsGetActiveGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSubscriptionsPurchasePostRequest handles POST /subscriptions/purchase operation.
+//
+// Купить подписку.
+//
+// POST /subscriptions/purchase
+func (s *Server) handleSubscriptionsPurchasePostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/subscriptions/purchase"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SubscriptionsPurchasePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SubscriptionsPurchasePostOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SubscriptionsPurchasePostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeSubscriptionsPurchasePostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response SubscriptionsPurchasePostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SubscriptionsPurchasePostOperation,
+			OperationSummary: "Купить подписку",
+			OperationID:      "",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *PurchaseSubscriptionRequest
+			Params   = struct{}
+			Response = SubscriptionsPurchasePostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SubscriptionsPurchasePost(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SubscriptionsPurchasePost(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSubscriptionsPurchasePostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleTariffPlansGetRequest handles GET /tariff_plans operation.
+//
+// Получить список всех тарифных планов.
+//
+// GET /tariff_plans
+func (s *Server) handleTariffPlansGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tariff_plans"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TariffPlansGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: TariffPlansGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, TariffPlansGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response TariffPlansGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    TariffPlansGetOperation,
+			OperationSummary: "Получить список всех тарифных планов",
+			OperationID:      "",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = TariffPlansGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.TariffPlansGet(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.TariffPlansGet(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeTariffPlansGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleTariffPlansIDGetRequest handles GET /tariff_plans/{id} operation.
+//
+// Получить тарифный план по ID.
+//
+// GET /tariff_plans/{id}
+func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tariff_plans/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TariffPlansIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: TariffPlansIDGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, TariffPlansIDGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeTariffPlansIDGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response TariffPlansIDGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    TariffPlansIDGetOperation,
+			OperationSummary: "Получить тарифный план по ID",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = TariffPlansIDGetParams
+			Response = TariffPlansIDGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackTariffPlansIDGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.TariffPlansIDGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.TariffPlansIDGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeTariffPlansIDGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleTilerDziFilePathFilesLevelColRowFormatGetRequest handles TilerDziFilePathFilesLevelColRowFormatGet operation.
+//
+// Возвращает конкретный тайл (плитку) изображения для
+// указанного уровня масштабирования,
+// колонки и строки. Запрос проксируется напрямую на
+// tiler_service.
+// Формат пути: `/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}`.
+//
+// GET /tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}
+func (s *Server) handleTilerDziFilePathFilesLevelColRowFormatGetRequest(args [5]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("TilerDziFilePathFilesLevelColRowFormatGet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TilerDziFilePathFilesLevelColRowFormatGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: TilerDziFilePathFilesLevelColRowFormatGetOperation,
+			ID:   "TilerDziFilePathFilesLevelColRowFormatGet",
+		}
+	)
+	params, err := decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response TilerDziFilePathFilesLevelColRowFormatGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    TilerDziFilePathFilesLevelColRowFormatGetOperation,
+			OperationSummary: "Получить тайл изображения",
+			OperationID:      "TilerDziFilePathFilesLevelColRowFormatGet",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "file_path",
+					In:   "path",
+				}: params.FilePath,
+				{
+					Name: "level",
+					In:   "path",
+				}: params.Level,
+				{
+					Name: "col",
+					In:   "path",
+				}: params.Col,
+				{
+					Name: "row",
+					In:   "path",
+				}: params.Row,
+				{
+					Name: "format",
+					In:   "path",
+				}: params.Format,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = TilerDziFilePathFilesLevelColRowFormatGetParams
+			Response = TilerDziFilePathFilesLevelColRowFormatGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackTilerDziFilePathFilesLevelColRowFormatGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.TilerDziFilePathFilesLevelColRowFormatGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.TilerDziFilePathFilesLevelColRowFormatGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeTilerDziFilePathFilesLevelColRowFormatGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleTilerDziFilePathGetRequest handles TilerDziFilePathGet operation.
+//
+// Возвращает XML метаданные в формате Deep Zoom Image (DZI) для
+// указанного изображения.
+// Путь к файлу должен быть URL-encoded. Запрос проксируется
+// напрямую на tiler_service.
+//
+// GET /tiler/dzi/{file_path}
+func (s *Server) handleTilerDziFilePathGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("TilerDziFilePathGet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tiler/dzi/{file_path}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TilerDziFilePathGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: TilerDziFilePathGetOperation,
+			ID:   "TilerDziFilePathGet",
+		}
+	)
+	params, err := decodeTilerDziFilePathGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response TilerDziFilePathGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    TilerDziFilePathGetOperation,
+			OperationSummary: "Получить DZI XML метаданные для изображения",
+			OperationID:      "TilerDziFilePathGet",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "file_path",
+					In:   "path",
+				}: params.FilePath,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = TilerDziFilePathGetParams
+			Response = TilerDziFilePathGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackTilerDziFilePathGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.TilerDziFilePathGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.TilerDziFilePathGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeTilerDziFilePathGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUploadsIDCompletePostRequest handles POST /uploads/{id}/complete operation.
+//
+// Собирает файл из частей и ставит его в обработку. sha256
+// сверяется и метаданные очищаются в фоне, пока
+// загрузка в статусе processing, ее нельзя передать в узи или
+// цитологию.
+//
+// POST /uploads/{id}/complete
+func (s *Server) handleUploadsIDCompletePostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uploads/{id}/complete"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadsIDCompletePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UploadsIDCompletePostOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UploadsIDCompletePostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUploadsIDCompletePostParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UploadsIDCompletePostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UploadsIDCompletePostOperation,
+			OperationSummary: "завершить загрузку",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = UploadsIDCompletePostParams
+			Response = UploadsIDCompletePostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUploadsIDCompletePostParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UploadsIDCompletePost(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UploadsIDCompletePost(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUploadsIDCompletePostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUploadsIDDeleteRequest handles DELETE /uploads/{id} operation.
+//
+// Отменить загрузку.
+//
+// DELETE /uploads/{id}
+func (s *Server) handleUploadsIDDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uploads/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadsIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UploadsIDDeleteOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UploadsIDDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUploadsIDDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UploadsIDDeleteRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UploadsIDDeleteOperation,
+			OperationSummary: "отменить загрузку",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = UploadsIDDeleteParams
+			Response = UploadsIDDeleteRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUploadsIDDeleteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UploadsIDDelete(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UploadsIDDelete(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUploadsIDDeleteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUploadsIDGetRequest handles GET /uploads/{id} operation.
+//
+// Получить состояние загрузки.
+//
+// GET /uploads/{id}
+func (s *Server) handleUploadsIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uploads/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadsIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UploadsIDGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UploadsIDGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUploadsIDGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UploadsIDGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UploadsIDGetOperation,
+			OperationSummary: "получить состояние загрузки",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = UploadsIDGetParams
+			Response = UploadsIDGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUploadsIDGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UploadsIDGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UploadsIDGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUploadsIDGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUploadsIDPartsPostRequest handles POST /uploads/{id}/parts operation.
+//
+// Получить url для загрузки частей.
+//
+// POST /uploads/{id}/parts
+func (s *Server) handleUploadsIDPartsPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uploads/{id}/parts"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadsIDPartsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UploadsIDPartsPostOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UploadsIDPartsPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUploadsIDPartsPostParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUploadsIDPartsPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UploadsIDPartsPostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UploadsIDPartsPostOperation,
+			OperationSummary: "получить url для загрузки частей",
+			OperationID:      "",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UploadsIDPartsPostReq
+			Params   = UploadsIDPartsPostParams
+			Response = UploadsIDPartsPostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUploadsIDPartsPostParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UploadsIDPartsPost(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UploadsIDPartsPost(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUploadsIDPartsPostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUploadsPostRequest handles POST /uploads operation.
+//
+// Файл загружается напрямую в S3 частями по presigned url,
+// после завершения id загрузки передается в POST /uzi или
+// /cytology/create.
+//
+// POST /uploads
+func (s *Server) handleUploadsPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uploads"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UploadsPostOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UploadsPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeUploadsPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UploadsPostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UploadsPostOperation,
+			OperationSummary: "начать загрузку файла частями",
+			OperationID:      "",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *UploadsPostReq
+			Params   = struct{}
+			Response = UploadsPostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UploadsPost(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UploadsPost(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUploadsPostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziDeviceIDDeleteRequest handles DELETE /uzi/device/{id} operation.
+//
+// Аппарат, на который ссылаются узи, удалить нельзя.
+//
+// DELETE /uzi/device/{id}
+func (s *Server) handleUziDeviceIDDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/device/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziDeviceIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziDeviceIDDeleteOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziDeviceIDDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziDeviceIDDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UziDeviceIDDeleteRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziDeviceIDDeleteOperation,
+			OperationSummary: "удалить uzi аппарат",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = UziDeviceIDDeleteParams
+			Response = UziDeviceIDDeleteRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziDeviceIDDeleteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziDeviceIDDelete(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziDeviceIDDelete(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziDeviceIDDeleteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziDeviceIDGetRequest handles GET /uzi/device/{id} operation.
+//
+// Получить uzi аппарат.
+//
+// GET /uzi/device/{id}
+func (s *Server) handleUziDeviceIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/device/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziDeviceIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziDeviceIDGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziDeviceIDGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziDeviceIDGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UziDeviceIDGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziDeviceIDGetOperation,
+			OperationSummary: "получить uzi аппарат",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = UziDeviceIDGetParams
+			Response = UziDeviceIDGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziDeviceIDGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziDeviceIDGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziDeviceIDGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziDeviceIDGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziDeviceIDPatchRequest handles PATCH /uzi/device/{id} operation.
+//
+// Обновить uzi аппарат.
+//
+// PATCH /uzi/device/{id}
+func (s *Server) handleUziDeviceIDPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/uzi/device/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziDeviceIDPatchOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziDeviceIDPatchOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziDeviceIDPatchOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziDeviceIDPatchParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUziDeviceIDPatchRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UziDeviceIDPatchRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziDeviceIDPatchOperation,
+			OperationSummary: "обновить uzi аппарат",
+			OperationID:      "",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UziDeviceIDPatchReq
+			Params   = UziDeviceIDPatchParams
+			Response = UziDeviceIDPatchRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziDeviceIDPatchParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziDeviceIDPatch(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziDeviceIDPatch(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziDeviceIDPatchResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziDevicePostRequest handles POST /uzi/device operation.
+//
+// Добавить uzi аппарат.
+//
+// POST /uzi/device
+func (s *Server) handleUziDevicePostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/device"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziDevicePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziDevicePostOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziDevicePostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeUziDevicePostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UziDevicePostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziDevicePostOperation,
+			OperationSummary: "добавить uzi аппарат",
+			OperationID:      "",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *UziDevicePostReq
+			Params   = struct{}
+			Response = UziDevicePostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziDevicePost(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziDevicePost(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziDevicePostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziDevicesGetRequest handles GET /uzi/devices operation.
+//
+// Получит список uzi апппапапратов.
+//
+// GET /uzi/devices
+func (s *Server) handleUziDevicesGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/devices"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziDevicesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziDevicesGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziDevicesGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response UziDevicesGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziDevicesGetOperation,
+			OperationSummary: "получит список uzi апппапапратов",
+			OperationID:      "",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = UziDevicesGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziDevicesGet(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziDevicesGet(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziDevicesGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziIDCheckPostRequest handles POST /uzi/{id}/check operation.
+//
+// Узи получает checked, при заданном unvalidated узлам нейросети
+// без решения врача проставляется эта валидация.
+// Выполняется в одной транзакции.
+//
+// POST /uzi/{id}/check
+func (s *Server) handleUziIDCheckPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/check"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDCheckPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziIDCheckPostOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDCheckPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDCheckPostParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUziIDCheckPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UziIDCheckPostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziIDCheckPostOperation,
+			OperationSummary: "отметить узи проверенным",
+			OperationID:      "",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = OptUziIDCheckPostReq
+			Params   = UziIDCheckPostParams
+			Response = UziIDCheckPostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziIDCheckPostParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziIDCheckPost(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziIDCheckPost(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziIDCheckPostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziIDDeleteRequest handles DELETE /uzi/{id} operation.
+//
+// Удалить узи.
+//
+// DELETE /uzi/{id}
+func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziIDDeleteOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UziIDDeleteRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziIDDeleteOperation,
+			OperationSummary: "удалить узи",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = UziIDDeleteParams
+			Response = UziIDDeleteRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziIDDeleteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziIDDelete(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziIDDelete(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziIDDeleteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziIDEchographicsGetRequest handles GET /uzi/{id}/echographics operation.
+//
+// Получить эхографику uzi.
+//
+// GET /uzi/{id}/echographics
+func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/echographics"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDEchographicsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziIDEchographicsGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDEchographicsGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDEchographicsGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UziIDEchographicsGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziIDEchographicsGetOperation,
+			OperationSummary: "получить эхографику uzi",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = UziIDEchographicsGetParams
+			Response = UziIDEchographicsGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziIDEchographicsGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziIDEchographicsGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziIDEchographicsGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziIDEchographicsGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziIDEchographicsPatchRequest handles PATCH /uzi/{id}/echographics operation.
+//
+// Обновить эхографику.
+//
+// PATCH /uzi/{id}/echographics
+func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/echographics"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDEchographicsPatchOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziIDEchographicsPatchOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDEchographicsPatchOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDEchographicsPatchParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUziIDEchographicsPatchRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UziIDEchographicsPatchRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziIDEchographicsPatchOperation,
+			OperationSummary: "обновить эхографику",
+			OperationID:      "",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *Echographics
+			Params   = UziIDEchographicsPatchParams
+			Response = UziIDEchographicsPatchRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziIDEchographicsPatchParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziIDEchographicsPatch(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziIDEchographicsPatch(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziIDEchographicsPatchResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziIDGetRequest handles GET /uzi/{id} operation.
+//
+// Получить узи.
+//
+// GET /uzi/{id}
+func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziIDGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UziIDGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziIDGetOperation,
+			OperationSummary: "получить узи",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = UziIDGetParams
+			Response = UziIDGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziIDGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziIDGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziIDGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziIDGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziIDImagesGetRequest handles GET /uzi/{id}/images operation.
+//
+// Получает списк изображений uzi.
+//
+// GET /uzi/{id}/images
+func (s *Server) handleUziIDImagesGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/images"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDImagesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziIDImagesGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDImagesGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDImagesGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UziIDImagesGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziIDImagesGetOperation,
+			OperationSummary: "получает списк изображений uzi",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = UziIDImagesGetParams
+			Response = UziIDImagesGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziIDImagesGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziIDImagesGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziIDImagesGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziIDImagesGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziIDNodesAcceptPostRequest handles POST /uzi/{id}/nodes/accept operation.
+//
+// Узлы нейросети без решения врача, у которых
+// вероятность наиболее вероятного класса TI-RADS не ниже
+// threshold,
+// становятся valid. Выполняется в одной транзакции.
+//
+// POST /uzi/{id}/nodes/accept
+func (s *Server) handleUziIDNodesAcceptPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/nodes/accept"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDNodesAcceptPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziIDNodesAcceptPostOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDNodesAcceptPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDNodesAcceptPostParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUziIDNodesAcceptPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UziIDNodesAcceptPostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziIDNodesAcceptPostOperation,
+			OperationSummary: "принять узлы нейросети выше порога уверенности",
+			OperationID:      "",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UziIDNodesAcceptPostReq
+			Params   = UziIDNodesAcceptPostParams
+			Response = UziIDNodesAcceptPostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUziIDNodesAcceptPostParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UziIDNodesAcceptPost(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UziIDNodesAcceptPost(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUziIDNodesAcceptPostResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUziIDNodesGetRequest handles GET /uzi/{id}/nodes operation.
+//
+// Получить все узлы узи.
+//
+// GET /uzi/{id}/nodes
+func (s *Server) handleUziIDNodesGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/nodes"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDNodesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UziIDNodesGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDNodesGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDNodesGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response UziIDNodesGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UziIDNodesGetOperation,
+			OperationSummary: "получить все узлы узи",
+			OperationID:      "",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
 
 		type (
-			Request  = *RefreshPostReq
-			Params   = struct{}
-			Response = RefreshPostRes
+			Request  = struct{}
+			Params   = UziIDNodesGetParams
+			Response = UziIDNodesGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5104,14 +11066,14 @@ func (s *Server) handleRefreshPostRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			nil,
+			unpackUziIDNodesGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RefreshPost(ctx, request)
+				response, err = s.h.UziIDNodesGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RefreshPost(ctx, request)
+		response, err = s.h.UziIDNodesGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5130,7 +11092,7 @@ func (s *Server) handleRefreshPostRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeRefreshPostResponse(response, w, span); err != nil {
+	if err := encodeUziIDNodesGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5139,21 +11101,21 @@ func (s *Server) handleRefreshPostRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleRegDoctorPostRequest handles POST /reg/doctor operation.
+// handleUziIDNodesSegmentsPostRequest handles POST /uzi/{id}/nodes-segments operation.
 //
-// Зарегистрировать врача (как пользователя).
+// Добавить узел с сегментами.
 //
-// POST /reg/doctor
-func (s *Server) handleRegDoctorPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi/{id}/nodes-segments
+func (s *Server) handleUziIDNodesSegmentsPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/reg/doctor"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/nodes-segments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RegDoctorPostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDNodesSegmentsPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5208,11 +11170,67 @@ func (s *Server) handleRegDoctorPostRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RegDoctorPostOperation,
+			Name: UziIDNodesSegmentsPostOperation,
 			ID:   "",
 		}
 	)
-	request, close, err := s.decodeRegDoctorPostRequest(r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDNodesSegmentsPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDNodesSegmentsPostParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUziIDNodesSegmentsPostRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -5228,22 +11246,27 @@ func (s *Server) handleRegDoctorPostRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response RegDoctorPostRes
+	var response UziIDNodesSegmentsPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RegDoctorPostOperation,
-			OperationSummary: "зарегистрировать врача (как пользователя)",
+			OperationName:    UziIDNodesSegmentsPostOperation,
+			OperationSummary: "добавить узел с сегментами",
 			OperationID:      "",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *RegDoctorPostReq
-			Params   = struct{}
-			Response = RegDoctorPostRes
+			Request  = *UziIDNodesSegmentsPostReq
+			Params   = UziIDNodesSegmentsPostParams
+			Response = UziIDNodesSegmentsPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5252,14 +11275,14 @@ func (s *Server) handleRegDoctorPostRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackUziIDNodesSegmentsPostParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RegDoctorPost(ctx, request)
+				response, err = s.h.UziIDNodesSegmentsPost(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RegDoctorPost(ctx, request)
+		response, err = s.h.UziIDNodesSegmentsPost(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5278,7 +11301,7 @@ func (s *Server) handleRegDoctorPostRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeRegDoctorPostResponse(response, w, span); err != nil {
+	if err := encodeUziIDNodesSegmentsPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5287,23 +11310,21 @@ func (s *Server) handleRegDoctorPostRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleRegPatientPostRequest handles POST /reg/patient operation.
+// handleUziIDPatchRequest handles PATCH /uzi/{id} operation.
 //
-// Зарегистрировать пациента (как пользователя).
-// **Сделано под мобилки api**. Врачам регистрировать
-// пациентов через /med/patient.
+// Обновить узи.
 //
-// POST /reg/patient
-func (s *Server) handleRegPatientPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /uzi/{id}
+func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/reg/patient"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/uzi/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RegPatientPostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5358,11 +11379,67 @@ func (s *Server) handleRegPatientPostRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RegPatientPostOperation,
+			Name: UziIDPatchOperation,
 			ID:   "",
 		}
 	)
-	request, close, err := s.decodeRegPatientPostRequest(r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDPatchOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziIDPatchParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUziIDPatchRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -5378,22 +11455,27 @@ func (s *Server) handleRegPatientPostRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response RegPatientPostRes
+	var response UziIDPatchRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RegPatientPostOperation,
-			OperationSummary: "зарегистрировать пациента (как пользователя). **Сделано под мобилки api**. Врачам регистрировать пациентов через /med/patient",
+			OperationName:    UziIDPatchOperation,
+			OperationSummary: "обновить узи",
 			OperationID:      "",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *RegPatientPostReq
-			Params   = struct{}
-			Response = RegPatientPostRes
+			Request  = *UziIDPatchReq
+			Params   = UziIDPatchParams
+			Response = UziIDPatchRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5402,14 +11484,14 @@ func (s *Server) handleRegPatientPostRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackUziIDPatchParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RegPatientPost(ctx, request)
+				response, err = s.h.UziIDPatch(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RegPatientPost(ctx, request)
+		response, err = s.h.UziIDPatch(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5428,7 +11510,7 @@ func (s *Server) handleRegPatientPostRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeRegPatientPostResponse(response, w, span); err != nil {
+	if err := encodeUziIDPatchResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5437,22 +11519,21 @@ func (s *Server) handleRegPatientPostRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleSubscriptionsCheckActiveGetRequest handles GET /subscriptions/check-active operation.
+// handleUziIDReportsGetRequest handles GET /uzi/{id}/reports operation.
 //
-// Проверить наличие активной подписки у текущего
-// пользователя.
+// Получить версии заключения по узи.
 //
-// GET /subscriptions/check-active
-func (s *Server) handleSubscriptionsCheckActiveGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzi/{id}/reports
+func (s *Server) handleUziIDReportsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/subscriptions/check-active"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/reports"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SubscriptionsCheckActiveGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDReportsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5507,7 +11588,7 @@ func (s *Server) handleSubscriptionsCheckActiveGetRequest(args [0]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SubscriptionsCheckActiveGetOperation,
+			Name: UziIDReportsGetOperation,
 			ID:   "",
 		}
 	)
@@ -5515,7 +11596,7 @@ func (s *Server) handleSubscriptionsCheckActiveGetRequest(args [0]string, argsEs
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SubscriptionsCheckActiveGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDReportsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5557,23 +11638,38 @@ func (s *Server) handleSubscriptionsCheckActiveGetRequest(args [0]string, argsEs
 			return
 		}
 	}
+	params, err := decodeUziIDReportsGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response SubscriptionsCheckActiveGetRes
+	var response UziIDReportsGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SubscriptionsCheckActiveGetOperation,
-			OperationSummary: "Проверить наличие активной подписки у текущего пользователя",
+			OperationName:    UziIDReportsGetOperation,
+			OperationSummary: "получить версии заключения по узи",
 			OperationID:      "",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = SubscriptionsCheckActiveGetRes
+			Params   = UziIDReportsGetParams
+			Response = UziIDReportsGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5582,14 +11678,14 @@ func (s *Server) handleSubscriptionsCheckActiveGetRequest(args [0]string, argsEs
 		](
 			m,
 			mreq,
-			nil,
+			unpackUziIDReportsGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SubscriptionsCheckActiveGet(ctx)
+				response, err = s.h.UziIDReportsGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SubscriptionsCheckActiveGet(ctx)
+		response, err = s.h.UziIDReportsGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5608,7 +11704,7 @@ func (s *Server) handleSubscriptionsCheckActiveGetRequest(args [0]string, argsEs
 		return
 	}
 
-	if err := encodeSubscriptionsCheckActiveGetResponse(response, w, span); err != nil {
+	if err := encodeUziIDReportsGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5617,22 +11713,22 @@ func (s *Server) handleSubscriptionsCheckActiveGetRequest(args [0]string, argsEs
 	}
 }
 
-// handleSubscriptionsGetActiveGetRequest handles GET /subscriptions/get-active operation.
+// handleUziIDReportsPostRequest handles POST /uzi/{id}/reports operation.
 //
-// Получить информацию об активной подписке текущего
-// пользователя.
+// Заключение собирается из эхографических признаков,
+// узлов и ключевых кадров с контурами.
 //
-// GET /subscriptions/get-active
-func (s *Server) handleSubscriptionsGetActiveGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi/{id}/reports
+func (s *Server) handleUziIDReportsPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/subscriptions/get-active"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/{id}/reports"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SubscriptionsGetActiveGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDReportsPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5687,7 +11783,7 @@ func (s *Server) handleSubscriptionsGetActiveGetRequest(args [0]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SubscriptionsGetActiveGetOperation,
+			Name: UziIDReportsPostOperation,
 			ID:   "",
 		}
 	)
@@ -5695,7 +11791,7 @@ func (s *Server) handleSubscriptionsGetActiveGetRequest(args [0]string, argsEsca
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SubscriptionsGetActiveGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziIDReportsPostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5737,23 +11833,38 @@ func (s *Server) handleSubscriptionsGetActiveGetRequest(args [0]string, argsEsca
 			return
 		}
 	}
+	params, err := decodeUziIDReportsPostParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response SubscriptionsGetActiveGetRes
+	var response UziIDReportsPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SubscriptionsGetActiveGetOperation,
-			OperationSummary: "Получить информацию об активной подписке текущего пользователя",
+			OperationName:    UziIDReportsPostOperation,
+			OperationSummary: "сформировать новую версию заключения по узи",
 			OperationID:      "",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = SubscriptionsGetActiveGetRes
+			Params   = UziIDReportsPostParams
+			Response = UziIDReportsPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5762,14 +11873,14 @@ func (s *Server) handleSubscriptionsGetActiveGetRequest(args [0]string, argsEsca
 		](
 			m,
 			mreq,
-			nil,
+			unpackUziIDReportsPostParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SubscriptionsGetActiveGet(ctx)
+				response, err = s.h.UziIDReportsPost(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SubscriptionsGetActiveGet(ctx)
+		response, err = s.h.UziIDReportsPost(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5788,7 +11899,7 @@ func (s *Server) handleSubscriptionsGetActiveGetRequest(args [0]string, argsEsca
 		return
 	}
 
-	if err := encodeSubscriptionsGetActiveGetResponse(response, w, span); err != nil {
+	if err := encodeUziIDReportsPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5797,21 +11908,21 @@ func (s *Server) handleSubscriptionsGetActiveGetRequest(args [0]string, argsEsca
 	}
 }
 
-// handleSubscriptionsPurchasePostRequest handles POST /subscriptions/purchase operation.
+// handleUziImageIDNodesSegmentsGetRequest handles GET /uzi/image/{id}/nodes-segments operation.
 //
-// Купить подписку.
+// Получит узлы и сегменты на указанном изображении.
 //
-// POST /subscriptions/purchase
-func (s *Server) handleSubscriptionsPurchasePostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzi/image/{id}/nodes-segments
+func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/subscriptions/purchase"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/image/{id}/nodes-segments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SubscriptionsPurchasePostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziImageIDNodesSegmentsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5866,7 +11977,7 @@ func (s *Server) handleSubscriptionsPurchasePostRequest(args [0]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SubscriptionsPurchasePostOperation,
+			Name: UziImageIDNodesSegmentsGetOperation,
 			ID:   "",
 		}
 	)
@@ -5874,7 +11985,7 @@ func (s *Server) handleSubscriptionsPurchasePostRequest(args [0]string, argsEsca
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SubscriptionsPurchasePostOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziImageIDNodesSegmentsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5916,38 +12027,38 @@ func (s *Server) handleSubscriptionsPurchasePostRequest(args [0]string, argsEsca
 			return
 		}
 	}
-	request, close, err := s.decodeSubscriptionsPurchasePostRequest(r)
+	params, err := decodeUziImageIDNodesSegmentsGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response SubscriptionsPurchasePostRes
+	var response UziImageIDNodesSegmentsGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SubscriptionsPurchasePostOperation,
-			OperationSummary: "Купить подписку",
+			OperationName:    UziImageIDNodesSegmentsGetOperation,
+			OperationSummary: "получит узлы и сегменты на указанном изображении",
 			OperationID:      "",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *PurchaseSubscriptionRequest
-			Params   = struct{}
-			Response = SubscriptionsPurchasePostRes
+			Request  = struct{}
+			Params   = UziImageIDNodesSegmentsGetParams
+			Response = UziImageIDNodesSegmentsGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5956,14 +12067,14 @@ func (s *Server) handleSubscriptionsPurchasePostRequest(args [0]string, argsEsca
 		](
 			m,
 			mreq,
-			nil,
+			unpackUziImageIDNodesSegmentsGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SubscriptionsPurchasePost(ctx, request)
+				response, err = s.h.UziImageIDNodesSegmentsGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SubscriptionsPurchasePost(ctx, request)
+		response, err = s.h.UziImageIDNodesSegmentsGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5982,7 +12093,7 @@ func (s *Server) handleSubscriptionsPurchasePostRequest(args [0]string, argsEsca
 		return
 	}
 
-	if err := encodeSubscriptionsPurchasePostResponse(response, w, span); err != nil {
+	if err := encodeUziImageIDNodesSegmentsGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5991,21 +12102,21 @@ func (s *Server) handleSubscriptionsPurchasePostRequest(args [0]string, argsEsca
 	}
 }
 
-// handleTariffPlansGetRequest handles GET /tariff_plans operation.
+// handleUziNodesIDDeleteRequest handles DELETE /uzi/nodes/{id} operation.
 //
-// Получить список всех тарифных планов.
+// Сегменты узла будут также удалены.
 //
-// GET /tariff_plans
-func (s *Server) handleTariffPlansGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /uzi/nodes/{id}
+func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tariff_plans"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TariffPlansGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6060,7 +12171,7 @@ func (s *Server) handleTariffPlansGetRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TariffPlansGetOperation,
+			Name: UziNodesIDDeleteOperation,
 			ID:   "",
 		}
 	)
@@ -6068,7 +12179,7 @@ func (s *Server) handleTariffPlansGetRequest(args [0]string, argsEscaped bool, w
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, TariffPlansGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6110,23 +12221,38 @@ func (s *Server) handleTariffPlansGetRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeUziNodesIDDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response TariffPlansGetRes
+	var response UziNodesIDDeleteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TariffPlansGetOperation,
-			OperationSummary: "Получить список всех тарифных планов",
+			OperationName:    UziNodesIDDeleteOperation,
+			OperationSummary: "удалить узел",
 			OperationID:      "",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = TariffPlansGetRes
+			Params   = UziNodesIDDeleteParams
+			Response = UziNodesIDDeleteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6135,14 +12261,14 @@ func (s *Server) handleTariffPlansGetRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackUziNodesIDDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TariffPlansGet(ctx)
+				response, err = s.h.UziNodesIDDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TariffPlansGet(ctx)
+		response, err = s.h.UziNodesIDDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6161,7 +12287,7 @@ func (s *Server) handleTariffPlansGetRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeTariffPlansGetResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6170,21 +12296,21 @@ func (s *Server) handleTariffPlansGetRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleTariffPlansIDGetRequest handles GET /tariff_plans/{id} operation.
+// handleUziNodesIDLinkDeleteRequest handles DELETE /uzi/nodes/{id}/link operation.
 //
-// Получить тарифный план по ID.
+// Отвязать узел от цепочки наблюдений.
 //
-// GET /tariff_plans/{id}
-func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /uzi/nodes/{id}/link
+func (s *Server) handleUziNodesIDLinkDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tariff_plans/{id}"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/link"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TariffPlansIDGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDLinkDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6239,7 +12365,7 @@ func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TariffPlansIDGetOperation,
+			Name: UziNodesIDLinkDeleteOperation,
 			ID:   "",
 		}
 	)
@@ -6247,7 +12373,7 @@ func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool,
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, TariffPlansIDGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDLinkDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6289,7 +12415,7 @@ func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeTariffPlansIDGetParams(args, argsEscaped, r)
+	params, err := decodeUziNodesIDLinkDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6300,12 +12426,12 @@ func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response TariffPlansIDGetRes
+	var response UziNodesIDLinkDeleteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TariffPlansIDGetOperation,
-			OperationSummary: "Получить тарифный план по ID",
+			OperationName:    UziNodesIDLinkDeleteOperation,
+			OperationSummary: "отвязать узел от цепочки наблюдений",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
@@ -6319,8 +12445,8 @@ func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool,
 
 		type (
 			Request  = struct{}
-			Params   = TariffPlansIDGetParams
-			Response = TariffPlansIDGetRes
+			Params   = UziNodesIDLinkDeleteParams
+			Response = UziNodesIDLinkDeleteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6329,14 +12455,14 @@ func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackTariffPlansIDGetParams,
+			unpackUziNodesIDLinkDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TariffPlansIDGet(ctx, params)
+				response, err = s.h.UziNodesIDLinkDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TariffPlansIDGet(ctx, params)
+		response, err = s.h.UziNodesIDLinkDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6355,7 +12481,7 @@ func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeTariffPlansIDGetResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDLinkDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6364,26 +12490,21 @@ func (s *Server) handleTariffPlansIDGetRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleTilerDziFilePathFilesLevelColRowFormatGetRequest handles TilerDziFilePathFilesLevelColRowFormatGet operation.
+// handleUziNodesIDLinkPostRequest handles POST /uzi/nodes/{id}/link operation.
 //
-// Возвращает конкретный тайл (плитку) изображения для
-// указанного уровня масштабирования,
-// колонки и строки. Запрос проксируется напрямую на
-// tiler_service.
-// Формат пути: `/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}`.
+// Узел добавляется в цепочку наблюдений целевого узла.
 //
-// GET /tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}
-func (s *Server) handleTilerDziFilePathFilesLevelColRowFormatGetRequest(args [5]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi/nodes/{id}/link
+func (s *Server) handleUziNodesIDLinkPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("TilerDziFilePathFilesLevelColRowFormatGet"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/link"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TilerDziFilePathFilesLevelColRowFormatGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDLinkPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6438,11 +12559,57 @@ func (s *Server) handleTilerDziFilePathFilesLevelColRowFormatGetRequest(args [5]
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TilerDziFilePathFilesLevelColRowFormatGetOperation,
-			ID:   "TilerDziFilePathFilesLevelColRowFormatGet",
+			Name: UziNodesIDLinkPostOperation,
+			ID:   "",
 		}
 	)
-	params, err := decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDLinkPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziNodesIDLinkPostParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6452,44 +12619,43 @@ func (s *Server) handleTilerDziFilePathFilesLevelColRowFormatGetRequest(args [5]
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeUziNodesIDLinkPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response TilerDziFilePathFilesLevelColRowFormatGetRes
+	var response UziNodesIDLinkPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TilerDziFilePathFilesLevelColRowFormatGetOperation,
-			OperationSummary: "Получить тайл изображения",
-			OperationID:      "TilerDziFilePathFilesLevelColRowFormatGet",
-			Body:             nil,
+			OperationName:    UziNodesIDLinkPostOperation,
+			OperationSummary: "связать узел с узлом из другого узи пациента",
+			OperationID:      "",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "file_path",
-					In:   "path",
-				}: params.FilePath,
-				{
-					Name: "level",
-					In:   "path",
-				}: params.Level,
-				{
-					Name: "col",
-					In:   "path",
-				}: params.Col,
-				{
-					Name: "row",
-					In:   "path",
-				}: params.Row,
-				{
-					Name: "format",
+					Name: "id",
 					In:   "path",
-				}: params.Format,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = TilerDziFilePathFilesLevelColRowFormatGetParams
-			Response = TilerDziFilePathFilesLevelColRowFormatGetRes
+			Request  = *UziNodesIDLinkPostReq
+			Params   = UziNodesIDLinkPostParams
+			Response = UziNodesIDLinkPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6498,14 +12664,14 @@ func (s *Server) handleTilerDziFilePathFilesLevelColRowFormatGetRequest(args [5]
 		](
 			m,
 			mreq,
-			unpackTilerDziFilePathFilesLevelColRowFormatGetParams,
+			unpackUziNodesIDLinkPostParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TilerDziFilePathFilesLevelColRowFormatGet(ctx, params)
+				response, err = s.h.UziNodesIDLinkPost(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TilerDziFilePathFilesLevelColRowFormatGet(ctx, params)
+		response, err = s.h.UziNodesIDLinkPost(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6524,7 +12690,7 @@ func (s *Server) handleTilerDziFilePathFilesLevelColRowFormatGetRequest(args [5]
 		return
 	}
 
-	if err := encodeTilerDziFilePathFilesLevelColRowFormatGetResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDLinkPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6533,25 +12699,21 @@ func (s *Server) handleTilerDziFilePathFilesLevelColRowFormatGetRequest(args [5]
 	}
 }
 
-// handleTilerDziFilePathGetRequest handles TilerDziFilePathGet operation.
+// handleUziNodesIDLinkSuggestionsGetRequest handles GET /uzi/nodes/{id}/link/suggestions operation.
 //
-// Возвращает XML метаданные в формате Deep Zoom Image (DZI) для
-// указанного изображения.
-// Путь к файлу должен быть URL-encoded. Запрос проксируется
-// напрямую на tiler_service.
+// Предложить узлы из других узи пациента для связывания.
 //
-// GET /tiler/dzi/{file_path}
-func (s *Server) handleTilerDziFilePathGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzi/nodes/{id}/link/suggestions
+func (s *Server) handleUziNodesIDLinkSuggestionsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("TilerDziFilePathGet"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tiler/dzi/{file_path}"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/link/suggestions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TilerDziFilePathGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDLinkSuggestionsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6606,11 +12768,57 @@ func (s *Server) handleTilerDziFilePathGetRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TilerDziFilePathGetOperation,
-			ID:   "TilerDziFilePathGet",
+			Name: UziNodesIDLinkSuggestionsGetOperation,
+			ID:   "",
 		}
 	)
-	params, err := decodeTilerDziFilePathGetParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDLinkSuggestionsGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUziNodesIDLinkSuggestionsGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6621,27 +12829,27 @@ func (s *Server) handleTilerDziFilePathGetRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	var response TilerDziFilePathGetRes
+	var response UziNodesIDLinkSuggestionsGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TilerDziFilePathGetOperation,
-			OperationSummary: "Получить DZI XML метаданные для изображения",
-			OperationID:      "TilerDziFilePathGet",
+			OperationName:    UziNodesIDLinkSuggestionsGetOperation,
+			OperationSummary: "предложить узлы из других узи пациента для связывания",
+			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "file_path",
+					Name: "id",
 					In:   "path",
-				}: params.FilePath,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = TilerDziFilePathGetParams
-			Response = TilerDziFilePathGetRes
+			Params   = UziNodesIDLinkSuggestionsGetParams
+			Response = UziNodesIDLinkSuggestionsGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6650,14 +12858,14 @@ func (s *Server) handleTilerDziFilePathGetRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackTilerDziFilePathGetParams,
+			unpackUziNodesIDLinkSuggestionsGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TilerDziFilePathGet(ctx, params)
+				response, err = s.h.UziNodesIDLinkSuggestionsGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TilerDziFilePathGet(ctx, params)
+		response, err = s.h.UziNodesIDLinkSuggestionsGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6676,7 +12884,7 @@ func (s *Server) handleTilerDziFilePathGetRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeTilerDziFilePathGetResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDLinkSuggestionsGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6685,21 +12893,21 @@ func (s *Server) handleTilerDziFilePathGetRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleUziDevicePostRequest handles POST /uzi/device operation.
+// handleUziNodesIDPatchRequest handles PATCH /uzi/nodes/{id} operation.
 //
-// Добавить uzi аппарат.
+// Обновить узел.
 //
-// POST /uzi/device
-func (s *Server) handleUziDevicePostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /uzi/nodes/{id}
+func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/uzi/device"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziDevicePostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6754,7 +12962,7 @@ func (s *Server) handleUziDevicePostRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziDevicePostOperation,
+			Name: UziNodesIDPatchOperation,
 			ID:   "",
 		}
 	)
@@ -6762,7 +12970,7 @@ func (s *Server) handleUziDevicePostRequest(args [0]string, argsEscaped bool, w
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziDevicePostOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDPatchOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6804,7 +13012,17 @@ func (s *Server) handleUziDevicePostRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeUziDevicePostRequest(r)
+	params, err := decodeUziNodesIDPatchParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUziNodesIDPatchRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -6820,22 +13038,27 @@ func (s *Server) handleUziDevicePostRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response UziDevicePostRes
+	var response UziNodesIDPatchRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziDevicePostOperation,
-			OperationSummary: "добавить uzi аппарат",
+			OperationName:    UziNodesIDPatchOperation,
+			OperationSummary: "обновить узел",
 			OperationID:      "",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *UziDevicePostReq
-			Params   = struct{}
-			Response = UziDevicePostRes
+			Request  = *UziNodesIDPatchReq
+			Params   = UziNodesIDPatchParams
+			Response = UziNodesIDPatchRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6844,14 +13067,14 @@ func (s *Server) handleUziDevicePostRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackUziNodesIDPatchParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziDevicePost(ctx, request)
+				response, err = s.h.UziNodesIDPatch(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziDevicePost(ctx, request)
+		response, err = s.h.UziNodesIDPatch(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6870,7 +13093,7 @@ func (s *Server) handleUziDevicePostRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUziDevicePostResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDPatchResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6879,21 +13102,21 @@ func (s *Server) handleUziDevicePostRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleUziDevicesGetRequest handles GET /uzi/devices operation.
+// handleUziNodesIDSegmentDraftsGetRequest handles GET /uzi/nodes/{id}/segment-drafts operation.
 //
-// Получит список uzi апппапапратов.
+// Получить черновики сегментов узла.
 //
-// GET /uzi/devices
-func (s *Server) handleUziDevicesGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzi/nodes/{id}/segment-drafts
+func (s *Server) handleUziNodesIDSegmentDraftsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/devices"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/segment-drafts"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziDevicesGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDSegmentDraftsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6948,7 +13171,7 @@ func (s *Server) handleUziDevicesGetRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziDevicesGetOperation,
+			Name: UziNodesIDSegmentDraftsGetOperation,
 			ID:   "",
 		}
 	)
@@ -6956,7 +13179,7 @@ func (s *Server) handleUziDevicesGetRequest(args [0]string, argsEscaped bool, w
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziDevicesGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDSegmentDraftsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6998,23 +13221,38 @@ func (s *Server) handleUziDevicesGetRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeUziNodesIDSegmentDraftsGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response UziDevicesGetRes
+	var response UziNodesIDSegmentDraftsGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziDevicesGetOperation,
-			OperationSummary: "получит список uzi апппапапратов",
+			OperationName:    UziNodesIDSegmentDraftsGetOperation,
+			OperationSummary: "получить черновики сегментов узла",
 			OperationID:      "",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = UziDevicesGetRes
+			Params   = UziNodesIDSegmentDraftsGetParams
+			Response = UziNodesIDSegmentDraftsGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7023,14 +13261,14 @@ func (s *Server) handleUziDevicesGetRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackUziNodesIDSegmentDraftsGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziDevicesGet(ctx)
+				response, err = s.h.UziNodesIDSegmentDraftsGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziDevicesGet(ctx)
+		response, err = s.h.UziNodesIDSegmentDraftsGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7049,7 +13287,7 @@ func (s *Server) handleUziDevicesGetRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUziDevicesGetResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDSegmentDraftsGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7058,21 +13296,21 @@ func (s *Server) handleUziDevicesGetRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleUziIDDeleteRequest handles DELETE /uzi/{id} operation.
+// handleUziNodesIDSegmentsGetRequest handles GET /uzi/nodes/{id}/segments operation.
 //
-// Удалить узи.
+// Получить сегменты узла.
 //
-// DELETE /uzi/{id}
-func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzi/nodes/{id}/segments
+func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/uzi/{id}"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/segments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDSegmentsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7127,7 +13365,7 @@ func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziIDDeleteOperation,
+			Name: UziNodesIDSegmentsGetOperation,
 			ID:   "",
 		}
 	)
@@ -7135,7 +13373,7 @@ func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w ht
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziIDDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDSegmentsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7177,7 +13415,7 @@ func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeUziIDDeleteParams(args, argsEscaped, r)
+	params, err := decodeUziNodesIDSegmentsGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7188,12 +13426,12 @@ func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	var response UziIDDeleteRes
+	var response UziNodesIDSegmentsGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziIDDeleteOperation,
-			OperationSummary: "удалить узи",
+			OperationName:    UziNodesIDSegmentsGetOperation,
+			OperationSummary: "получить сегменты узла",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
@@ -7207,8 +13445,8 @@ func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w ht
 
 		type (
 			Request  = struct{}
-			Params   = UziIDDeleteParams
-			Response = UziIDDeleteRes
+			Params   = UziNodesIDSegmentsGetParams
+			Response = UziNodesIDSegmentsGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7217,14 +13455,14 @@ func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackUziIDDeleteParams,
+			unpackUziNodesIDSegmentsGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziIDDelete(ctx, params)
+				response, err = s.h.UziNodesIDSegmentsGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziIDDelete(ctx, params)
+		response, err = s.h.UziNodesIDSegmentsGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7243,7 +13481,7 @@ func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeUziIDDeleteResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDSegmentsGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7252,21 +13490,23 @@ func (s *Server) handleUziIDDeleteRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleUziIDEchographicsGetRequest handles GET /uzi/{id}/echographics operation.
+// handleUziNodesIDSplitPostRequest handles POST /uzi/nodes/{id}/split operation.
 //
-// Получить эхографику uzi.
+// Вероятности TI-RADS обоих узлов пересчитываются по их
+// сегментам, исходный узел сохраняется для аналитики.
+// В узле должен остаться хотя бы один сегмент.
 //
-// GET /uzi/{id}/echographics
-func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi/nodes/{id}/split
+func (s *Server) handleUziNodesIDSplitPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/echographics"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/split"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDEchographicsGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDSplitPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7321,7 +13561,7 @@ func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziIDEchographicsGetOperation,
+			Name: UziNodesIDSplitPostOperation,
 			ID:   "",
 		}
 	)
@@ -7329,7 +13569,7 @@ func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped b
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziIDEchographicsGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDSplitPostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7371,7 +13611,7 @@ func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeUziIDEchographicsGetParams(args, argsEscaped, r)
+	params, err := decodeUziNodesIDSplitPostParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7381,15 +13621,30 @@ func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped b
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeUziNodesIDSplitPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response UziIDEchographicsGetRes
+	var response UziNodesIDSplitPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziIDEchographicsGetOperation,
-			OperationSummary: "получить эхографику uzi",
+			OperationName:    UziNodesIDSplitPostOperation,
+			OperationSummary: "выделить сегменты узла в новый узел",
 			OperationID:      "",
-			Body:             nil,
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
@@ -7400,9 +13655,9 @@ func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped b
 		}
 
 		type (
-			Request  = struct{}
-			Params   = UziIDEchographicsGetParams
-			Response = UziIDEchographicsGetRes
+			Request  = *UziNodesIDSplitPostReq
+			Params   = UziNodesIDSplitPostParams
+			Response = UziNodesIDSplitPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7411,14 +13666,14 @@ func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackUziIDEchographicsGetParams,
+			unpackUziNodesIDSplitPostParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziIDEchographicsGet(ctx, params)
+				response, err = s.h.UziNodesIDSplitPost(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziIDEchographicsGet(ctx, params)
+		response, err = s.h.UziNodesIDSplitPost(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7437,7 +13692,7 @@ func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeUziIDEchographicsGetResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDSplitPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7446,21 +13701,21 @@ func (s *Server) handleUziIDEchographicsGetRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleUziIDEchographicsPatchRequest handles PATCH /uzi/{id}/echographics operation.
+// handleUziNodesIDTiradsGetRequest handles GET /uzi/nodes/{id}/tirads operation.
 //
-// Обновить эхографику.
+// Получить оценку узла по ACR TI-RADS.
 //
-// PATCH /uzi/{id}/echographics
-func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzi/nodes/{id}/tirads
+func (s *Server) handleUziNodesIDTiradsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/echographics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/tirads"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDEchographicsPatchOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDTiradsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7515,7 +13770,7 @@ func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziIDEchographicsPatchOperation,
+			Name: UziNodesIDTiradsGetOperation,
 			ID:   "",
 		}
 	)
@@ -7523,7 +13778,7 @@ func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziIDEchographicsPatchOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDTiradsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7565,7 +13820,7 @@ func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeUziIDEchographicsPatchParams(args, argsEscaped, r)
+	params, err := decodeUziNodesIDTiradsGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7575,30 +13830,15 @@ func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUziIDEchographicsPatchRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response UziIDEchographicsPatchRes
+	var response UziNodesIDTiradsGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziIDEchographicsPatchOperation,
-			OperationSummary: "обновить эхографику",
+			OperationName:    UziNodesIDTiradsGetOperation,
+			OperationSummary: "получить оценку узла по ACR TI-RADS",
 			OperationID:      "",
-			Body:             request,
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
@@ -7609,9 +13849,9 @@ func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped
 		}
 
 		type (
-			Request  = *Echographics
-			Params   = UziIDEchographicsPatchParams
-			Response = UziIDEchographicsPatchRes
+			Request  = struct{}
+			Params   = UziNodesIDTiradsGetParams
+			Response = UziNodesIDTiradsGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7620,14 +13860,14 @@ func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackUziIDEchographicsPatchParams,
+			unpackUziNodesIDTiradsGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziIDEchographicsPatch(ctx, request, params)
+				response, err = s.h.UziNodesIDTiradsGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziIDEchographicsPatch(ctx, request, params)
+		response, err = s.h.UziNodesIDTiradsGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7646,7 +13886,7 @@ func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeUziIDEchographicsPatchResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDTiradsGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7655,21 +13895,21 @@ func (s *Server) handleUziIDEchographicsPatchRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleUziIDGetRequest handles GET /uzi/{id} operation.
+// handleUziNodesIDTiradsPutRequest handles PUT /uzi/nodes/{id}/tirads operation.
 //
-// Получить узи.
+// Задать дескрипторы узла по ACR TI-RADS.
 //
-// GET /uzi/{id}
-func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /uzi/nodes/{id}/tirads
+func (s *Server) handleUziNodesIDTiradsPutRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/{id}"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/tirads"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDTiradsPutOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7724,7 +13964,7 @@ func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziIDGetOperation,
+			Name: UziNodesIDTiradsPutOperation,
 			ID:   "",
 		}
 	)
@@ -7732,7 +13972,7 @@ func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziIDGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDTiradsPutOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7774,7 +14014,7 @@ func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeUziIDGetParams(args, argsEscaped, r)
+	params, err := decodeUziNodesIDTiradsPutParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7784,15 +14024,30 @@ func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeUziNodesIDTiradsPutRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response UziIDGetRes
+	var response UziNodesIDTiradsPutRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziIDGetOperation,
-			OperationSummary: "получить узи",
+			OperationName:    UziNodesIDTiradsPutOperation,
+			OperationSummary: "задать дескрипторы узла по ACR TI-RADS",
 			OperationID:      "",
-			Body:             nil,
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
@@ -7803,9 +14058,9 @@ func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.
 		}
 
 		type (
-			Request  = struct{}
-			Params   = UziIDGetParams
-			Response = UziIDGetRes
+			Request  = *NodeDescriptors
+			Params   = UziNodesIDTiradsPutParams
+			Response = UziNodesIDTiradsPutRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7814,14 +14069,14 @@ func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackUziIDGetParams,
+			unpackUziNodesIDTiradsPutParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziIDGet(ctx, params)
+				response, err = s.h.UziNodesIDTiradsPut(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziIDGet(ctx, params)
+		response, err = s.h.UziNodesIDTiradsPut(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7840,7 +14095,7 @@ func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeUziIDGetResponse(response, w, span); err != nil {
+	if err := encodeUziNodesIDTiradsPutResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7849,21 +14104,24 @@ func (s *Server) handleUziIDGetRequest(args [1]string, argsEscaped bool, w http.
 	}
 }
 
-// handleUziIDImagesGetRequest handles GET /uzi/{id}/images operation.
+// handleUziNodesMergePostRequest handles POST /uzi/nodes/merge operation.
 //
-// Получает списк изображений uzi.
+// Сегменты всех узлов переносятся в первый из node_ids,
+// остальные узлы удаляются.
+// Вероятности TI-RADS узла пересчитываются по его
+// сегментам, исходные узлы сохраняются для аналитики.
 //
-// GET /uzi/{id}/images
-func (s *Server) handleUziIDImagesGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi/nodes/merge
+func (s *Server) handleUziNodesMergePostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/images"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/merge"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDImagesGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesMergePostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7918,7 +14176,7 @@ func (s *Server) handleUziIDImagesGetRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziIDImagesGetOperation,
+			Name: UziNodesMergePostOperation,
 			ID:   "",
 		}
 	)
@@ -7926,7 +14184,7 @@ func (s *Server) handleUziIDImagesGetRequest(args [1]string, argsEscaped bool, w
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziIDImagesGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesMergePostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7968,38 +14226,38 @@ func (s *Server) handleUziIDImagesGetRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeUziIDImagesGetParams(args, argsEscaped, r)
+	request, close, err := s.decodeUziNodesMergePostRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response UziIDImagesGetRes
+	var response UziNodesMergePostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziIDImagesGetOperation,
-			OperationSummary: "получает списк изображений uzi",
+			OperationName:    UziNodesMergePostOperation,
+			OperationSummary: "слить узлы одного узи",
 			OperationID:      "",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = UziIDImagesGetParams
-			Response = UziIDImagesGetRes
+			Request  = *UziNodesMergePostReq
+			Params   = struct{}
+			Response = UziNodesMergePostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8008,14 +14266,14 @@ func (s *Server) handleUziIDImagesGetRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackUziIDImagesGetParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziIDImagesGet(ctx, params)
+				response, err = s.h.UziNodesMergePost(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziIDImagesGet(ctx, params)
+		response, err = s.h.UziNodesMergePost(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8034,7 +14292,7 @@ func (s *Server) handleUziIDImagesGetRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUziIDImagesGetResponse(response, w, span); err != nil {
+	if err := encodeUziNodesMergePostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8043,21 +14301,24 @@ func (s *Server) handleUziIDImagesGetRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleUziIDNodesGetRequest handles GET /uzi/{id}/nodes operation.
+// handleUziNodesReviewPostRequest handles POST /uzi/nodes/review operation.
 //
-// Получить все узлы узи.
+// Валидация проставляется всем узлам в одной
+// транзакции, узлы, которые нельзя изменить,
+// возвращаются со своим статусом.
+// Пакетные операции учитываются в аналитике.
 //
-// GET /uzi/{id}/nodes
-func (s *Server) handleUziIDNodesGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi/nodes/review
+func (s *Server) handleUziNodesReviewPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/nodes"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/nodes/review"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDNodesGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesReviewPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8112,7 +14373,7 @@ func (s *Server) handleUziIDNodesGetRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziIDNodesGetOperation,
+			Name: UziNodesReviewPostOperation,
 			ID:   "",
 		}
 	)
@@ -8120,7 +14381,7 @@ func (s *Server) handleUziIDNodesGetRequest(args [1]string, argsEscaped bool, w
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziIDNodesGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesReviewPostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8162,38 +14423,38 @@ func (s *Server) handleUziIDNodesGetRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeUziIDNodesGetParams(args, argsEscaped, r)
+	request, close, err := s.decodeUziNodesReviewPostRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response UziIDNodesGetRes
+	var response UziNodesReviewPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziIDNodesGetOperation,
-			OperationSummary: "получить все узлы узи",
+			OperationName:    UziNodesReviewPostOperation,
+			OperationSummary: "провалидировать узлы нейросети пакетом",
 			OperationID:      "",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = UziIDNodesGetParams
-			Response = UziIDNodesGetRes
+			Request  = *UziNodesReviewPostReq
+			Params   = struct{}
+			Response = UziNodesReviewPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8202,14 +14463,14 @@ func (s *Server) handleUziIDNodesGetRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackUziIDNodesGetParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziIDNodesGet(ctx, params)
+				response, err = s.h.UziNodesReviewPost(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziIDNodesGet(ctx, params)
+		response, err = s.h.UziNodesReviewPost(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8228,7 +14489,7 @@ func (s *Server) handleUziIDNodesGetRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUziIDNodesGetResponse(response, w, span); err != nil {
+	if err := encodeUziNodesReviewPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8237,21 +14498,21 @@ func (s *Server) handleUziIDNodesGetRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleUziIDNodesSegmentsPostRequest handles POST /uzi/{id}/nodes-segments operation.
+// handleUziPostRequest handles POST /uzi operation.
 //
-// Добавить узел с сегментами.
+// Загрузить узи на обработку.
 //
-// POST /uzi/{id}/nodes-segments
-func (s *Server) handleUziIDNodesSegmentsPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi
+func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/uzi/{id}/nodes-segments"),
+		semconv.HTTPRouteKey.String("/uzi"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDNodesSegmentsPostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8306,7 +14567,7 @@ func (s *Server) handleUziIDNodesSegmentsPostRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziIDNodesSegmentsPostOperation,
+			Name: UziPostOperation,
 			ID:   "",
 		}
 	)
@@ -8314,7 +14575,7 @@ func (s *Server) handleUziIDNodesSegmentsPostRequest(args [1]string, argsEscaped
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziIDNodesSegmentsPostOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziPostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8356,17 +14617,7 @@ func (s *Server) handleUziIDNodesSegmentsPostRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeUziIDNodesSegmentsPostParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeUziIDNodesSegmentsPostRequest(r)
+	request, close, err := s.decodeUziPostRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -8382,27 +14633,22 @@ func (s *Server) handleUziIDNodesSegmentsPostRequest(args [1]string, argsEscaped
 		}
 	}()
 
-	var response UziIDNodesSegmentsPostRes
+	var response UziPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziIDNodesSegmentsPostOperation,
-			OperationSummary: "добавить узел с сегментами",
+			OperationName:    UziPostOperation,
+			OperationSummary: "загрузить узи на обработку",
 			OperationID:      "",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *UziIDNodesSegmentsPostReq
-			Params   = UziIDNodesSegmentsPostParams
-			Response = UziIDNodesSegmentsPostRes
+			Request  = *UziPostReq
+			Params   = struct{}
+			Response = UziPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8411,14 +14657,14 @@ func (s *Server) handleUziIDNodesSegmentsPostRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackUziIDNodesSegmentsPostParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziIDNodesSegmentsPost(ctx, request, params)
+				response, err = s.h.UziPost(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziIDNodesSegmentsPost(ctx, request, params)
+		response, err = s.h.UziPost(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8437,7 +14683,7 @@ func (s *Server) handleUziIDNodesSegmentsPostRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeUziIDNodesSegmentsPostResponse(response, w, span); err != nil {
+	if err := encodeUziPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8446,21 +14692,22 @@ func (s *Server) handleUziIDNodesSegmentsPostRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleUziIDPatchRequest handles PATCH /uzi/{id} operation.
+// handleUziSegmentDraftsIDAcceptPostRequest handles POST /uzi/segment-drafts/{id}/accept operation.
 //
-// Обновить узи.
+// Из черновика создается ручной сегмент узла, черновик
+// удаляется.
 //
-// PATCH /uzi/{id}
-func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi/segment-drafts/{id}/accept
+func (s *Server) handleUziSegmentDraftsIDAcceptPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/uzi/{id}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/segment-drafts/{id}/accept"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziIDPatchOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziSegmentDraftsIDAcceptPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8515,7 +14762,7 @@ func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziIDPatchOperation,
+			Name: UziSegmentDraftsIDAcceptPostOperation,
 			ID:   "",
 		}
 	)
@@ -8523,7 +14770,7 @@ func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w htt
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziIDPatchOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziSegmentDraftsIDAcceptPostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8565,7 +14812,7 @@ func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeUziIDPatchParams(args, argsEscaped, r)
+	params, err := decodeUziSegmentDraftsIDAcceptPostParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8575,30 +14822,15 @@ func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w htt
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUziIDPatchRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response UziIDPatchRes
+	var response UziSegmentDraftsIDAcceptPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziIDPatchOperation,
-			OperationSummary: "обновить узи",
+			OperationName:    UziSegmentDraftsIDAcceptPostOperation,
+			OperationSummary: "подтвердить черновик",
 			OperationID:      "",
-			Body:             request,
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
@@ -8609,9 +14841,9 @@ func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w htt
 		}
 
 		type (
-			Request  = *UziIDPatchReq
-			Params   = UziIDPatchParams
-			Response = UziIDPatchRes
+			Request  = struct{}
+			Params   = UziSegmentDraftsIDAcceptPostParams
+			Response = UziSegmentDraftsIDAcceptPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8620,14 +14852,14 @@ func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackUziIDPatchParams,
+			unpackUziSegmentDraftsIDAcceptPostParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziIDPatch(ctx, request, params)
+				response, err = s.h.UziSegmentDraftsIDAcceptPost(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziIDPatch(ctx, request, params)
+		response, err = s.h.UziSegmentDraftsIDAcceptPost(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8646,7 +14878,7 @@ func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeUziIDPatchResponse(response, w, span); err != nil {
+	if err := encodeUziSegmentDraftsIDAcceptPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8655,21 +14887,21 @@ func (s *Server) handleUziIDPatchRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleUziImageIDNodesSegmentsGetRequest handles GET /uzi/image/{id}/nodes-segments operation.
+// handleUziSegmentDraftsIDDeleteRequest handles DELETE /uzi/segment-drafts/{id} operation.
 //
-// Получит узлы и сегменты на указанном изображении.
+// Отклонить черновик.
 //
-// GET /uzi/image/{id}/nodes-segments
-func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /uzi/segment-drafts/{id}
+func (s *Server) handleUziSegmentDraftsIDDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/image/{id}/nodes-segments"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/uzi/segment-drafts/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziImageIDNodesSegmentsGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziSegmentDraftsIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8724,7 +14956,7 @@ func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziImageIDNodesSegmentsGetOperation,
+			Name: UziSegmentDraftsIDDeleteOperation,
 			ID:   "",
 		}
 	)
@@ -8732,7 +14964,7 @@ func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEsc
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziImageIDNodesSegmentsGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziSegmentDraftsIDDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8774,7 +15006,7 @@ func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEsc
 			return
 		}
 	}
-	params, err := decodeUziImageIDNodesSegmentsGetParams(args, argsEscaped, r)
+	params, err := decodeUziSegmentDraftsIDDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8785,12 +15017,12 @@ func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEsc
 		return
 	}
 
-	var response UziImageIDNodesSegmentsGetRes
+	var response UziSegmentDraftsIDDeleteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziImageIDNodesSegmentsGetOperation,
-			OperationSummary: "получит узлы и сегменты на указанном изображении",
+			OperationName:    UziSegmentDraftsIDDeleteOperation,
+			OperationSummary: "отклонить черновик",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
@@ -8804,8 +15036,8 @@ func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEsc
 
 		type (
 			Request  = struct{}
-			Params   = UziImageIDNodesSegmentsGetParams
-			Response = UziImageIDNodesSegmentsGetRes
+			Params   = UziSegmentDraftsIDDeleteParams
+			Response = UziSegmentDraftsIDDeleteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8814,14 +15046,14 @@ func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackUziImageIDNodesSegmentsGetParams,
+			unpackUziSegmentDraftsIDDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziImageIDNodesSegmentsGet(ctx, params)
+				response, err = s.h.UziSegmentDraftsIDDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziImageIDNodesSegmentsGet(ctx, params)
+		response, err = s.h.UziSegmentDraftsIDDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8840,7 +15072,7 @@ func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEsc
 		return
 	}
 
-	if err := encodeUziImageIDNodesSegmentsGetResponse(response, w, span); err != nil {
+	if err := encodeUziSegmentDraftsIDDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8849,21 +15081,21 @@ func (s *Server) handleUziImageIDNodesSegmentsGetRequest(args [1]string, argsEsc
 	}
 }
 
-// handleUziNodesIDDeleteRequest handles DELETE /uzi/nodes/{id} operation.
+// handleUziSegmentIDDeleteRequest handles DELETE /uzi/segment/{id} operation.
 //
-// Сегменты узла будут также удалены.
+// Если у узла не останется сегментов, он будет **удален**.
 //
-// DELETE /uzi/nodes/{id}
-func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /uzi/segment/{id}
+func (s *Server) handleUziSegmentIDDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/uzi/nodes/{id}"),
+		semconv.HTTPRouteKey.String("/uzi/segment/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziSegmentIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8918,7 +15150,7 @@ func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziNodesIDDeleteOperation,
+			Name: UziSegmentIDDeleteOperation,
 			ID:   "",
 		}
 	)
@@ -8926,7 +15158,7 @@ func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool,
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziSegmentIDDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8968,7 +15200,7 @@ func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeUziNodesIDDeleteParams(args, argsEscaped, r)
+	params, err := decodeUziSegmentIDDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8979,12 +15211,12 @@ func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response UziNodesIDDeleteRes
+	var response UziSegmentIDDeleteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziNodesIDDeleteOperation,
-			OperationSummary: "удалить узел",
+			OperationName:    UziSegmentIDDeleteOperation,
+			OperationSummary: "удалить сегмент",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
@@ -8998,8 +15230,8 @@ func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool,
 
 		type (
 			Request  = struct{}
-			Params   = UziNodesIDDeleteParams
-			Response = UziNodesIDDeleteRes
+			Params   = UziSegmentIDDeleteParams
+			Response = UziSegmentIDDeleteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9008,14 +15240,14 @@ func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackUziNodesIDDeleteParams,
+			unpackUziSegmentIDDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziNodesIDDelete(ctx, params)
+				response, err = s.h.UziSegmentIDDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziNodesIDDelete(ctx, params)
+		response, err = s.h.UziSegmentIDDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9034,7 +15266,7 @@ func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeUziNodesIDDeleteResponse(response, w, span); err != nil {
+	if err := encodeUziSegmentIDDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9043,21 +15275,21 @@ func (s *Server) handleUziNodesIDDeleteRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleUziNodesIDPatchRequest handles PATCH /uzi/nodes/{id} operation.
+// handleUziSegmentIDPatchRequest handles PATCH /uzi/segment/{id} operation.
 //
-// Обновить узел.
+// Обновить сегмент.
 //
-// PATCH /uzi/nodes/{id}
-func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /uzi/segment/{id}
+func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/uzi/nodes/{id}"),
+		semconv.HTTPRouteKey.String("/uzi/segment/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDPatchOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziSegmentIDPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9112,7 +15344,7 @@ func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziNodesIDPatchOperation,
+			Name: UziSegmentIDPatchOperation,
 			ID:   "",
 		}
 	)
@@ -9120,7 +15352,7 @@ func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool,
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDPatchOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziSegmentIDPatchOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9162,7 +15394,7 @@ func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeUziNodesIDPatchParams(args, argsEscaped, r)
+	params, err := decodeUziSegmentIDPatchParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9172,7 +15404,7 @@ func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUziNodesIDPatchRequest(r)
+	request, close, err := s.decodeUziSegmentIDPatchRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -9188,12 +15420,12 @@ func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool,
 		}
 	}()
 
-	var response UziNodesIDPatchRes
+	var response UziSegmentIDPatchRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziNodesIDPatchOperation,
-			OperationSummary: "обновить узел",
+			OperationName:    UziSegmentIDPatchOperation,
+			OperationSummary: "обновить сегмент",
 			OperationID:      "",
 			Body:             request,
 			Params: middleware.Parameters{
@@ -9206,9 +15438,9 @@ func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = *UziNodesIDPatchReq
-			Params   = UziNodesIDPatchParams
-			Response = UziNodesIDPatchRes
+			Request  = *UziSegmentIDPatchReq
+			Params   = UziSegmentIDPatchParams
+			Response = UziSegmentIDPatchRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9217,14 +15449,14 @@ func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackUziNodesIDPatchParams,
+			unpackUziSegmentIDPatchParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziNodesIDPatch(ctx, request, params)
+				response, err = s.h.UziSegmentIDPatch(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziNodesIDPatch(ctx, request, params)
+		response, err = s.h.UziSegmentIDPatch(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9243,7 +15475,7 @@ func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeUziNodesIDPatchResponse(response, w, span); err != nil {
+	if err := encodeUziSegmentIDPatchResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9252,21 +15484,26 @@ func (s *Server) handleUziNodesIDPatchRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleUziNodesIDSegmentsGetRequest handles GET /uzi/nodes/{id}/segments operation.
+// handleUziSegmentIDPropagatePostRequest handles POST /uzi/segment/{id}/propagate operation.
 //
-// Получить сегменты узла.
+// Контур сегмента переносится на соседние кадры узи,
+// результат сохраняется черновиками.
+// В каждую сторону распространение останавливается на
+// кадре, где у узла уже есть сегмент, или при потере
+// контура.
+// Прежние черновики узла на тех же кадрах заменяются.
 //
-// GET /uzi/nodes/{id}/segments
-func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi/segment/{id}/propagate
+func (s *Server) handleUziSegmentIDPropagatePostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzi/nodes/{id}/segments"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/uzi/segment/{id}/propagate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziNodesIDSegmentsGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziSegmentIDPropagatePostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9321,7 +15558,7 @@ func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziNodesIDSegmentsGetOperation,
+			Name: UziSegmentIDPropagatePostOperation,
 			ID:   "",
 		}
 	)
@@ -9329,7 +15566,7 @@ func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziNodesIDSegmentsGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziSegmentIDPropagatePostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9371,7 +15608,7 @@ func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeUziNodesIDSegmentsGetParams(args, argsEscaped, r)
+	params, err := decodeUziSegmentIDPropagatePostParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9382,12 +15619,12 @@ func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response UziNodesIDSegmentsGetRes
+	var response UziSegmentIDPropagatePostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziNodesIDSegmentsGetOperation,
-			OperationSummary: "получить сегменты узла",
+			OperationName:    UziSegmentIDPropagatePostOperation,
+			OperationSummary: "предложить контуры узла на соседних кадрах",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
@@ -9395,14 +15632,18 @@ func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped
 					Name: "id",
 					In:   "path",
 				}: params.ID,
+				{
+					Name: "depth",
+					In:   "query",
+				}: params.Depth,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = UziNodesIDSegmentsGetParams
-			Response = UziNodesIDSegmentsGetRes
+			Request  = struct{}
+			Params   = UziSegmentIDPropagatePostParams
+			Response = UziSegmentIDPropagatePostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9411,14 +15652,14 @@ func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackUziNodesIDSegmentsGetParams,
+			unpackUziSegmentIDPropagatePostParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziNodesIDSegmentsGet(ctx, params)
+				response, err = s.h.UziSegmentIDPropagatePost(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziNodesIDSegmentsGet(ctx, params)
+		response, err = s.h.UziSegmentIDPropagatePost(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9437,7 +15678,7 @@ func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeUziNodesIDSegmentsGetResponse(response, w, span); err != nil {
+	if err := encodeUziSegmentIDPropagatePostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9446,21 +15687,21 @@ func (s *Server) handleUziNodesIDSegmentsGetRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleUziPostRequest handles POST /uzi operation.
+// handleUziSegmentPostRequest handles POST /uzi/segment operation.
 //
-// Загрузить узи на обработку.
+// Добавить новый сегмент.
 //
-// POST /uzi
-func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /uzi/segment
+func (s *Server) handleUziSegmentPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/uzi"),
+		semconv.HTTPRouteKey.String("/uzi/segment"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziPostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UziSegmentPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9515,7 +15756,7 @@ func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziPostOperation,
+			Name: UziSegmentPostOperation,
 			ID:   "",
 		}
 	)
@@ -9523,7 +15764,7 @@ func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.R
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziPostOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UziSegmentPostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9565,7 +15806,7 @@ func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	request, close, err := s.decodeUziPostRequest(r)
+	request, close, err := s.decodeUziSegmentPostRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -9581,12 +15822,12 @@ func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.R
 		}
 	}()
 
-	var response UziPostRes
+	var response UziSegmentPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziPostOperation,
-			OperationSummary: "загрузить узи на обработку",
+			OperationName:    UziSegmentPostOperation,
+			OperationSummary: "добавить новый сегмент",
 			OperationID:      "",
 			Body:             request,
 			Params:           middleware.Parameters{},
@@ -9594,9 +15835,9 @@ func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.R
 		}
 
 		type (
-			Request  = *UziPostReq
+			Request  = *UziSegmentPostReq
 			Params   = struct{}
-			Response = UziPostRes
+			Response = UziSegmentPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9607,12 +15848,12 @@ func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.R
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziPost(ctx, request)
+				response, err = s.h.UziSegmentPost(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziPost(ctx, request)
+		response, err = s.h.UziSegmentPost(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9631,7 +15872,7 @@ func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeUziPostResponse(response, w, span); err != nil {
+	if err := encodeUziSegmentPostResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9640,21 +15881,25 @@ func (s *Server) handleUziPostRequest(args [0]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleUziSegmentIDDeleteRequest handles DELETE /uzi/segment/{id} operation.
+// handleUzisAnalyticsGetRequest handles GET /uzis/analytics operation.
 //
-// Если у узла не останется сегментов, он будет **удален**.
+// Точность нейросети относительно валидации, доля
+// непровалидированных узлов и распределение классов
+// TI-RADS по всей выборке, аппаратам, авторам и периодам.
+// Для узи, размеченных несколькими врачами, согласие
+// врачей: каппа Коэна и IoU контуров.
 //
-// DELETE /uzi/segment/{id}
-func (s *Server) handleUziSegmentIDDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzis/analytics
+func (s *Server) handleUzisAnalyticsGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/uzi/segment/{id}"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzis/analytics"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziSegmentIDDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UzisAnalyticsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9709,7 +15954,7 @@ func (s *Server) handleUziSegmentIDDeleteRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziSegmentIDDeleteOperation,
+			Name: UzisAnalyticsGetOperation,
 			ID:   "",
 		}
 	)
@@ -9717,7 +15962,7 @@ func (s *Server) handleUziSegmentIDDeleteRequest(args [1]string, argsEscaped boo
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziSegmentIDDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UzisAnalyticsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9759,7 +16004,7 @@ func (s *Server) handleUziSegmentIDDeleteRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeUziSegmentIDDeleteParams(args, argsEscaped, r)
+	params, err := decodeUzisAnalyticsGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9770,27 +16015,43 @@ func (s *Server) handleUziSegmentIDDeleteRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response UziSegmentIDDeleteRes
+	var response UzisAnalyticsGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziSegmentIDDeleteOperation,
-			OperationSummary: "удалить сегмент",
+			OperationName:    UzisAnalyticsGetOperation,
+			OperationSummary: "аналитика разметки узи",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
+					Name: "device_id",
+					In:   "query",
+				}: params.DeviceID,
+				{
+					Name: "author_id",
+					In:   "query",
+				}: params.AuthorID,
+				{
+					Name: "create_from",
+					In:   "query",
+				}: params.CreateFrom,
+				{
+					Name: "create_to",
+					In:   "query",
+				}: params.CreateTo,
+				{
+					Name: "period",
+					In:   "query",
+				}: params.Period,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = UziSegmentIDDeleteParams
-			Response = UziSegmentIDDeleteRes
+			Params   = UzisAnalyticsGetParams
+			Response = UzisAnalyticsGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9799,14 +16060,14 @@ func (s *Server) handleUziSegmentIDDeleteRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackUziSegmentIDDeleteParams,
+			unpackUzisAnalyticsGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziSegmentIDDelete(ctx, params)
+				response, err = s.h.UzisAnalyticsGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziSegmentIDDelete(ctx, params)
+		response, err = s.h.UzisAnalyticsGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9825,7 +16086,7 @@ func (s *Server) handleUziSegmentIDDeleteRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeUziSegmentIDDeleteResponse(response, w, span); err != nil {
+	if err := encodeUzisAnalyticsGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9834,21 +16095,21 @@ func (s *Server) handleUziSegmentIDDeleteRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleUziSegmentIDPatchRequest handles PATCH /uzi/segment/{id} operation.
+// handleUzisAuthorIDGetRequest handles GET /uzis/author/{id} operation.
 //
-// Обновить сегмент.
+// Получить узи по id автора.
 //
-// PATCH /uzi/segment/{id}
-func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzis/author/{id}
+func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/uzi/segment/{id}"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzis/author/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziSegmentIDPatchOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UzisAuthorIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9903,7 +16164,7 @@ func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziSegmentIDPatchOperation,
+			Name: UzisAuthorIDGetOperation,
 			ID:   "",
 		}
 	)
@@ -9911,7 +16172,7 @@ func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziSegmentIDPatchOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UzisAuthorIDGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9953,7 +16214,7 @@ func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeUziSegmentIDPatchParams(args, argsEscaped, r)
+	params, err := decodeUzisAuthorIDGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9963,30 +16224,15 @@ func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUziSegmentIDPatchRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response UziSegmentIDPatchRes
+	var response UzisAuthorIDGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziSegmentIDPatchOperation,
-			OperationSummary: "обновить сегмент",
+			OperationName:    UzisAuthorIDGetOperation,
+			OperationSummary: "получить узи по id автора",
 			OperationID:      "",
-			Body:             request,
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
@@ -9997,9 +16243,9 @@ func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool
 		}
 
 		type (
-			Request  = *UziSegmentIDPatchReq
-			Params   = UziSegmentIDPatchParams
-			Response = UziSegmentIDPatchRes
+			Request  = struct{}
+			Params   = UzisAuthorIDGetParams
+			Response = UzisAuthorIDGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10008,14 +16254,14 @@ func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackUziSegmentIDPatchParams,
+			unpackUzisAuthorIDGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziSegmentIDPatch(ctx, request, params)
+				response, err = s.h.UzisAuthorIDGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziSegmentIDPatch(ctx, request, params)
+		response, err = s.h.UzisAuthorIDGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10034,7 +16280,7 @@ func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeUziSegmentIDPatchResponse(response, w, span); err != nil {
+	if err := encodeUzisAuthorIDGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10043,21 +16289,21 @@ func (s *Server) handleUziSegmentIDPatchRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleUziSegmentPostRequest handles POST /uzi/segment operation.
+// handleUzisExternalIDGetRequest handles GET /uzis/external/{id} operation.
 //
-// Добавить новый сегмент.
+// Получить узи по внешнему id.
 //
-// POST /uzi/segment
-func (s *Server) handleUziSegmentPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzis/external/{id}
+func (s *Server) handleUzisExternalIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/uzi/segment"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/uzis/external/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UziSegmentPostOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UzisExternalIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10112,7 +16358,7 @@ func (s *Server) handleUziSegmentPostRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UziSegmentPostOperation,
+			Name: UzisExternalIDGetOperation,
 			ID:   "",
 		}
 	)
@@ -10120,7 +16366,7 @@ func (s *Server) handleUziSegmentPostRequest(args [0]string, argsEscaped bool, w
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UziSegmentPostOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UzisExternalIDGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10162,38 +16408,38 @@ func (s *Server) handleUziSegmentPostRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeUziSegmentPostRequest(r)
+	params, err := decodeUzisExternalIDGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response UziSegmentPostRes
+	var response UzisExternalIDGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UziSegmentPostOperation,
-			OperationSummary: "добавить новый сегмент",
+			OperationName:    UzisExternalIDGetOperation,
+			OperationSummary: "получить узи по внешнему id",
 			OperationID:      "",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *UziSegmentPostReq
-			Params   = struct{}
-			Response = UziSegmentPostRes
+			Request  = struct{}
+			Params   = UzisExternalIDGetParams
+			Response = UzisExternalIDGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10202,14 +16448,14 @@ func (s *Server) handleUziSegmentPostRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackUzisExternalIDGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UziSegmentPost(ctx, request)
+				response, err = s.h.UzisExternalIDGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UziSegmentPost(ctx, request)
+		response, err = s.h.UzisExternalIDGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10228,7 +16474,7 @@ func (s *Server) handleUziSegmentPostRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUziSegmentPostResponse(response, w, span); err != nil {
+	if err := encodeUzisExternalIDGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10237,21 +16483,21 @@ func (s *Server) handleUziSegmentPostRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleUzisAuthorIDGetRequest handles GET /uzis/author/{id} operation.
+// handleUzisExternalIDGrowthGetRequest handles GET /uzis/external/{id}/growth operation.
 //
-// Получить узи по id автора.
+// Получить динамику отслеживаемых узлов пациента.
 //
-// GET /uzis/author/{id}
-func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzis/external/{id}/growth
+func (s *Server) handleUzisExternalIDGrowthGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzis/author/{id}"),
+		semconv.HTTPRouteKey.String("/uzis/external/{id}/growth"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UzisAuthorIDGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UzisExternalIDGrowthGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10306,7 +16552,7 @@ func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UzisAuthorIDGetOperation,
+			Name: UzisExternalIDGrowthGetOperation,
 			ID:   "",
 		}
 	)
@@ -10314,7 +16560,7 @@ func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool,
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UzisAuthorIDGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UzisExternalIDGrowthGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10356,7 +16602,7 @@ func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeUzisAuthorIDGetParams(args, argsEscaped, r)
+	params, err := decodeUzisExternalIDGrowthGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10367,12 +16613,12 @@ func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response UzisAuthorIDGetRes
+	var response UzisExternalIDGrowthGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UzisAuthorIDGetOperation,
-			OperationSummary: "получить узи по id автора",
+			OperationName:    UzisExternalIDGrowthGetOperation,
+			OperationSummary: "получить динамику отслеживаемых узлов пациента",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
@@ -10386,8 +16632,8 @@ func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool,
 
 		type (
 			Request  = struct{}
-			Params   = UzisAuthorIDGetParams
-			Response = UzisAuthorIDGetRes
+			Params   = UzisExternalIDGrowthGetParams
+			Response = UzisExternalIDGrowthGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10396,14 +16642,14 @@ func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackUzisAuthorIDGetParams,
+			unpackUzisExternalIDGrowthGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UzisAuthorIDGet(ctx, params)
+				response, err = s.h.UzisExternalIDGrowthGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UzisAuthorIDGet(ctx, params)
+		response, err = s.h.UzisExternalIDGrowthGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10422,7 +16668,7 @@ func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeUzisAuthorIDGetResponse(response, w, span); err != nil {
+	if err := encodeUzisExternalIDGrowthGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10431,21 +16677,23 @@ func (s *Server) handleUzisAuthorIDGetRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleUzisExternalIDGetRequest handles GET /uzis/external/{id} operation.
+// handleUzisSearchGetRequest handles GET /uzis/search operation.
 //
-// Получить узи по внешнему id.
+// Все фильтры необязательны. Узи отсортированы по дате
+// создания, для следующей страницы передайте next_cursor из
+// предыдущего ответа.
 //
-// GET /uzis/external/{id}
-func (s *Server) handleUzisExternalIDGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /uzis/search
+func (s *Server) handleUzisSearchGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/uzis/external/{id}"),
+		semconv.HTTPRouteKey.String("/uzis/search"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UzisExternalIDGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UzisSearchGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10500,7 +16748,7 @@ func (s *Server) handleUzisExternalIDGetRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UzisExternalIDGetOperation,
+			Name: UzisSearchGetOperation,
 			ID:   "",
 		}
 	)
@@ -10508,7 +16756,7 @@ func (s *Server) handleUzisExternalIDGetRequest(args [1]string, argsEscaped bool
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UzisExternalIDGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UzisSearchGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10550,7 +16798,7 @@ func (s *Server) handleUzisExternalIDGetRequest(args [1]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeUzisExternalIDGetParams(args, argsEscaped, r)
+	params, err := decodeUzisSearchGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10561,27 +16809,75 @@ func (s *Server) handleUzisExternalIDGetRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	var response UzisExternalIDGetRes
+	var response UzisSearchGetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UzisExternalIDGetOperation,
-			OperationSummary: "получить узи по внешнему id",
+			OperationName:    UzisSearchGetOperation,
+			OperationSummary: "поиск узи с фильтрами и постраничной выдачей",
 			OperationID:      "",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
+					Name: "author_id",
+					In:   "query",
+				}: params.AuthorID,
+				{
+					Name: "external_id",
+					In:   "query",
+				}: params.ExternalID,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "projection",
+					In:   "query",
+				}: params.Projection,
+				{
+					Name: "device_id",
+					In:   "query",
+				}: params.DeviceID,
+				{
+					Name: "checked",
+					In:   "query",
+				}: params.Checked,
+				{
+					Name: "create_from",
+					In:   "query",
+				}: params.CreateFrom,
+				{
+					Name: "create_to",
+					In:   "query",
+				}: params.CreateTo,
+				{
+					Name: "ai_tirads_4_min",
+					In:   "query",
+				}: params.AiTirads4Min,
+				{
+					Name: "ai_tirads_5_min",
+					In:   "query",
+				}: params.AiTirads5Min,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = UzisExternalIDGetParams
-			Response = UzisExternalIDGetRes
+			Params   = UzisSearchGetParams
+			Response = UzisSearchGetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10590,14 +16886,14 @@ func (s *Server) handleUzisExternalIDGetRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackUzisExternalIDGetParams,
+			unpackUzisSearchGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UzisExternalIDGet(ctx, params)
+				response, err = s.h.UzisSearchGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UzisExternalIDGet(ctx, params)
+		response, err = s.h.UzisSearchGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10616,7 +16912,7 @@ func (s *Server) handleUzisExternalIDGetRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeUzisExternalIDGetResponse(response, w, span); err != nil {
+	if err := encodeUzisSearchGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17,6 +17,14 @@ type CytologyPatientShotsReadRes interface {
 	cytologyPatientShotsReadRes()
 }
 
+type CytologyQuPathExportRes interface {
+	cytologyQuPathExportRes()
+}
+
+type CytologyQuPathImportRes interface {
+	cytologyQuPathImportRes()
+}
+
 type CytologyReadRes interface {
 	cytologyReadRes()
 }
@@ -45,6 +53,10 @@ type CytologySegmentsListRes interface {
 	cytologySegmentsListRes()
 }
 
+type CytologySegmentsViewportRes interface {
+	cytologySegmentsViewportRes()
+}
+
 type CytologyUpdatePartialUpdateRes interface {
 	cytologyUpdatePartialUpdateRes()
 }
@@ -61,6 +73,10 @@ type DownloadUziIDImageIDGetRes interface {
 	downloadUziIDImageIDGetRes()
 }
 
+type DownloadUziUziIDReportGetRes interface {
+	downloadUziUziIDReportGetRes()
+}
+
 type LoginPostRes interface {
 	loginPostRes()
 }
@@ -141,6 +157,38 @@ type TilerDziFilePathGetRes interface {
 	tilerDziFilePathGetRes()
 }
 
+type UploadsIDCompletePostRes interface {
+	uploadsIDCompletePostRes()
+}
+
+type UploadsIDDeleteRes interface {
+	uploadsIDDeleteRes()
+}
+
+type UploadsIDGetRes interface {
+	uploadsIDGetRes()
+}
+
+type UploadsIDPartsPostRes interface {
+	uploadsIDPartsPostRes()
+}
+
+type UploadsPostRes interface {
+	uploadsPostRes()
+}
+
+type UziDeviceIDDeleteRes interface {
+	uziDeviceIDDeleteRes()
+}
+
+type UziDeviceIDGetRes interface {
+	uziDeviceIDGetRes()
+}
+
+type UziDeviceIDPatchRes interface {
+	uziDeviceIDPatchRes()
+}
+
 type UziDevicePostRes interface {
 	uziDevicePostRes()
 }
@@ -149,6 +197,10 @@ type UziDevicesGetRes interface {
 	uziDevicesGetRes()
 }
 
+type UziIDCheckPostRes interface {
+	uziIDCheckPostRes()
+}
+
 type UziIDDeleteRes interface {
 	uziIDDeleteRes()
 }
@@ -169,6 +221,10 @@ type UziIDImagesGetRes interface {
 	uziIDImagesGetRes()
 }
 
+type UziIDNodesAcceptPostRes interface {
+	uziIDNodesAcceptPostRes()
+}
+
 type UziIDNodesGetRes interface {
 	uziIDNodesGetRes()
 }
@@ -181,6 +237,14 @@ type UziIDPatchRes interface {
 	uziIDPatchRes()
 }
 
+type UziIDReportsGetRes interface {
+	uziIDReportsGetRes()
+}
+
+type UziIDReportsPostRes interface {
+	uziIDReportsPostRes()
+}
+
 type UziImageIDNodesSegmentsGetRes interface {
 	uziImageIDNodesSegmentsGetRes()
 }
@@ -189,18 +253,62 @@ type UziNodesIDDeleteRes interface {
 	uziNodesIDDeleteRes()
 }
 
+type UziNodesIDLinkDeleteRes interface {
+	uziNodesIDLinkDeleteRes()
+}
+
+type UziNodesIDLinkPostRes interface {
+	uziNodesIDLinkPostRes()
+}
+
+type UziNodesIDLinkSuggestionsGetRes interface {
+	uziNodesIDLinkSuggestionsGetRes()
+}
+
 type UziNodesIDPatchRes interface {
 	uziNodesIDPatchRes()
 }
 
+type UziNodesIDSegmentDraftsGetRes interface {
+	uziNodesIDSegmentDraftsGetRes()
+}
+
 type UziNodesIDSegmentsGetRes interface {
 	uziNodesIDSegmentsGetRes()
 }
 
+type UziNodesIDSplitPostRes interface {
+	uziNodesIDSplitPostRes()
+}
+
+type UziNodesIDTiradsGetRes interface {
+	uziNodesIDTiradsGetRes()
+}
+
+type UziNodesIDTiradsPutRes interface {
+	uziNodesIDTiradsPutRes()
+}
+
+type UziNodesMergePostRes interface {
+	uziNodesMergePostRes()
+}
+
+type UziNodesReviewPostRes interface {
+	uziNodesReviewPostRes()
+}
+
 type UziPostRes interface {
 	uziPostRes()
 }
 
+type UziSegmentDraftsIDAcceptPostRes interface {
+	uziSegmentDraftsIDAcceptPostRes()
+}
+
+type UziSegmentDraftsIDDeleteRes interface {
+	uziSegmentDraftsIDDeleteRes()
+}
+
 type UziSegmentIDDeleteRes interface {
 	uziSegmentIDDeleteRes()
 }
@@ -209,10 +317,18 @@ type UziSegmentIDPatchRes interface {
 	uziSegmentIDPatchRes()
 }
 
+type UziSegmentIDPropagatePostRes interface {
+	uziSegmentIDPropagatePostRes()
+}
+
 type UziSegmentPostRes interface {
 	uziSegmentPostRes()
 }
 
+type UzisAnalyticsGetRes interface {
+	uzisAnalyticsGetRes()
+}
+
 type UzisAuthorIDGetRes interface {
 	uzisAuthorIDGetRes()
 }
@@ -221,6 +337,14 @@ type UzisExternalIDGetRes interface {
 	uzisExternalIDGetRes()
 }
 
+type UzisExternalIDGrowthGetRes interface {
+	uzisExternalIDGrowthGetRes()
+}
+
+type UzisSearchGetRes interface {
+	uzisSearchGetRes()
+}
+
 type YookassaWebhooksPostRes interface {
 	yookassaWebhooksPostRes()
 }
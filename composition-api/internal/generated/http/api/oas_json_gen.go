@@ -16,85 +16,66 @@ import (
 )
 
 // Encode implements json.Marshaler.
-func (s *Card) Encode(e *jx.Encoder) {
+func (s *AuthorNodeMetrics) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Card) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("patient_id")
-		json.EncodeUUID(e, s.PatientID)
-	}
+func (s *AuthorNodeMetrics) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("doctor_id")
-		json.EncodeUUID(e, s.DoctorID)
+		e.FieldStart("author_id")
+		json.EncodeUUID(e, s.AuthorID)
 	}
 	{
-		if s.Diagnosis.Set {
-			e.FieldStart("diagnosis")
-			s.Diagnosis.Encode(e)
-		}
+		e.FieldStart("metrics")
+		s.Metrics.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfCard = [3]string{
-	0: "patient_id",
-	1: "doctor_id",
-	2: "diagnosis",
+var jsonFieldsNameOfAuthorNodeMetrics = [2]string{
+	0: "author_id",
+	1: "metrics",
 }
 
-// Decode decodes Card from json.
-func (s *Card) Decode(d *jx.Decoder) error {
+// Decode decodes AuthorNodeMetrics from json.
+func (s *AuthorNodeMetrics) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Card to nil")
+		return errors.New("invalid: unable to decode AuthorNodeMetrics to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "patient_id":
+		case "author_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := json.DecodeUUID(d)
-				s.PatientID = v
+				s.AuthorID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient_id\"")
+				return errors.Wrap(err, "decode field \"author_id\"")
 			}
-		case "doctor_id":
+		case "metrics":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.DoctorID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"doctor_id\"")
-			}
-		case "diagnosis":
-			if err := func() error {
-				s.Diagnosis.Reset()
-				if err := s.Diagnosis.Decode(d); err != nil {
+				if err := s.Metrics.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnosis\"")
+				return errors.Wrap(err, "decode field \"metrics\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Card")
+		return errors.Wrap(err, "decode AuthorNodeMetrics")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -111,8 +92,8 @@ func (s *Card) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCard) {
-					name = jsonFieldsNameOfCard[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAuthorNodeMetrics) {
+					name = jsonFieldsNameOfAuthorNodeMetrics[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -133,82 +114,27 @@ func (s *Card) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Card) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Card) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes Contor as json.
-func (s Contor) Encode(e *jx.Encoder) {
-	unwrapped := []ContorItem(s)
-	if unwrapped == nil {
-		e.ArrEmpty()
-		return
-	}
-	if unwrapped != nil {
-		e.ArrStart()
-		for _, elem := range unwrapped {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
-}
-
-// Decode decodes Contor from json.
-func (s *Contor) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Contor to nil")
-	}
-	var unwrapped []ContorItem
-	if err := func() error {
-		unwrapped = make([]ContorItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem ContorItem
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = Contor(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s Contor) MarshalJSON() ([]byte, error) {
+func (s *AuthorNodeMetrics) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Contor) UnmarshalJSON(data []byte) error {
+func (s *AuthorNodeMetrics) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ContorItem) Encode(e *jx.Encoder) {
+func (s *BoundingBox) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ContorItem) encodeFields(e *jx.Encoder) {
+func (s *BoundingBox) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("x")
 		e.Int(s.X)
@@ -217,17 +143,27 @@ func (s *ContorItem) encodeFields(e *jx.Encoder) {
 		e.FieldStart("y")
 		e.Int(s.Y)
 	}
+	{
+		e.FieldStart("width")
+		e.Int(s.Width)
+	}
+	{
+		e.FieldStart("height")
+		e.Int(s.Height)
+	}
 }
 
-var jsonFieldsNameOfContorItem = [2]string{
+var jsonFieldsNameOfBoundingBox = [4]string{
 	0: "x",
 	1: "y",
+	2: "width",
+	3: "height",
 }
 
-// Decode decodes ContorItem from json.
-func (s *ContorItem) Decode(d *jx.Decoder) error {
+// Decode decodes BoundingBox from json.
+func (s *BoundingBox) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ContorItem to nil")
+		return errors.New("invalid: unable to decode BoundingBox to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -257,17 +193,41 @@ func (s *ContorItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"y\"")
 			}
+		case "width":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Width = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"width\"")
+			}
+		case "height":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Height = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"height\"")
+			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ContorItem")
+		return errors.Wrap(err, "decode BoundingBox")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -279,8 +239,8 @@ func (s *ContorItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfContorItem) {
-					name = jsonFieldsNameOfContorItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfBoundingBox) {
+					name = jsonFieldsNameOfBoundingBox[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -301,166 +261,265 @@ func (s *ContorItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ContorItem) MarshalJSON() ([]byte, error) {
+func (s *BoundingBox) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContorItem) UnmarshalJSON(data []byte) error {
+func (s *BoundingBox) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyCopyCreateCreated) Encode(e *jx.Encoder) {
+func (s *Card) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyCopyCreateCreated) encodeFields(e *jx.Encoder) {
+func (s *Card) encodeFields(e *jx.Encoder) {
 	{
-		if s.Pk.Set {
-			e.FieldStart("pk")
-			s.Pk.Encode(e)
-		}
+		e.FieldStart("patient_id")
+		json.EncodeUUID(e, s.PatientID)
 	}
 	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
+		e.FieldStart("doctor_id")
+		json.EncodeUUID(e, s.DoctorID)
+	}
+	{
+		if s.Diagnosis.Set {
+			e.FieldStart("diagnosis")
+			s.Diagnosis.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCytologyCopyCreateCreated = [2]string{
-	0: "pk",
-	1: "id",
+var jsonFieldsNameOfCard = [3]string{
+	0: "patient_id",
+	1: "doctor_id",
+	2: "diagnosis",
 }
 
-// Decode decodes CytologyCopyCreateCreated from json.
-func (s *CytologyCopyCreateCreated) Decode(d *jx.Decoder) error {
+// Decode decodes Card from json.
+func (s *Card) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyCopyCreateCreated to nil")
+		return errors.New("invalid: unable to decode Card to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "pk":
+		case "patient_id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Pk.Reset()
-				if err := s.Pk.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.PatientID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pk\"")
+				return errors.Wrap(err, "decode field \"patient_id\"")
 			}
-		case "id":
+		case "doctor_id":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.DoctorID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"doctor_id\"")
+			}
+		case "diagnosis":
+			if err := func() error {
+				s.Diagnosis.Reset()
+				if err := s.Diagnosis.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnosis\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyCopyCreateCreated")
+		return errors.Wrap(err, "decode Card")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCard) {
+					name = jsonFieldsNameOfCard[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyCopyCreateCreated) MarshalJSON() ([]byte, error) {
+func (s *Card) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyCopyCreateCreated) UnmarshalJSON(data []byte) error {
+func (s *Card) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyCopyCreateReq) Encode(e *jx.Encoder) {
+func (s *Contor) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyCopyCreateReq) encodeFields(e *jx.Encoder) {
+func (s *Contor) encodeFields(e *jx.Encoder) {
 	{
-		if s.Pk.Set {
-			e.FieldStart("pk")
-			s.Pk.Encode(e)
-		}
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
 	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
+		e.FieldStart("version")
+		s.Version.Encode(e)
+	}
+	{
+		e.FieldStart("coordinates")
+		e.ArrStart()
+		for _, elem := range s.Coordinates {
+			e.ArrStart()
+			for _, elem := range elem {
+				e.ArrStart()
+				for _, elem := range elem {
+					e.Float64(elem)
+				}
+				e.ArrEnd()
+			}
+			e.ArrEnd()
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfCytologyCopyCreateReq = [2]string{
-	0: "pk",
-	1: "id",
+var jsonFieldsNameOfContor = [3]string{
+	0: "type",
+	1: "version",
+	2: "coordinates",
 }
 
-// Decode decodes CytologyCopyCreateReq from json.
-func (s *CytologyCopyCreateReq) Decode(d *jx.Decoder) error {
+// Decode decodes Contor from json.
+func (s *Contor) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyCopyCreateReq to nil")
+		return errors.New("invalid: unable to decode Contor to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "pk":
+		case "type":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Pk.Reset()
-				if err := s.Pk.Decode(d); err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pk\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "id":
+		case "version":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
+				if err := s.Version.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		case "coordinates":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Coordinates = make([][][]float64, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem [][]float64
+					elem = make([][]float64, 0)
+					if err := d.Arr(func(d *jx.Decoder) error {
+						var elemElem []float64
+						elemElem = make([]float64, 0)
+						if err := d.Arr(func(d *jx.Decoder) error {
+							var elemElemElem float64
+							v, err := d.Float64()
+							elemElemElem = float64(v)
+							if err != nil {
+								return err
+							}
+							elemElem = append(elemElem, elemElemElem)
+							return nil
+						}); err != nil {
+							return err
+						}
+						elem = append(elem, elemElem)
+						return nil
+					}); err != nil {
+						return err
+					}
+					s.Coordinates = append(s.Coordinates, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"coordinates\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyCopyCreateReq")
+		return errors.Wrap(err, "decode Contor")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000010,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -472,8 +531,8 @@ func (s *CytologyCopyCreateReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyCopyCreateReq) {
-					name = jsonFieldsNameOfCytologyCopyCreateReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfContor) {
+					name = jsonFieldsNameOfContor[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -494,293 +553,276 @@ func (s *CytologyCopyCreateReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyCopyCreateReq) MarshalJSON() ([]byte, error) {
+func (s *Contor) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyCopyCreateReq) UnmarshalJSON(data []byte) error {
+func (s *Contor) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *CytologyCreateCreateCreated) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes ContorType as json.
+func (s ContorType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// encodeFields encodes fields.
-func (s *CytologyCreateCreateCreated) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Image.Set {
-			e.FieldStart("image")
-			s.Image.Encode(e)
-		}
-	}
-	{
-		if s.IsLast.Set {
-			e.FieldStart("is_last")
-			s.IsLast.Encode(e)
-		}
-	}
-	{
-		if s.DiagnosDate.Set {
-			e.FieldStart("diagnos_date")
-			s.DiagnosDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.Details != nil {
-			e.FieldStart("details")
-			s.Details.Encode(e)
-		}
-	}
-	{
-		if s.DiagnosticMarking.Set {
-			e.FieldStart("diagnostic_marking")
-			s.DiagnosticMarking.Encode(e)
-		}
+// Decode decodes ContorType from json.
+func (s *ContorType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContorType to nil")
 	}
-	{
-		e.FieldStart("diagnostic_number")
-		e.Int(s.DiagnosticNumber)
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	{
-		if s.MaterialType.Set {
-			e.FieldStart("material_type")
-			s.MaterialType.Encode(e)
-		}
+	// Try to use constant string.
+	switch ContorType(v) {
+	case ContorTypePolygon:
+		*s = ContorTypePolygon
+	default:
+		*s = ContorType(v)
 	}
-	{
-		if s.Calcitonin.Set {
-			e.FieldStart("calcitonin")
-			s.Calcitonin.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ContorType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContorType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ContorVersion as json.
+func (s ContorVersion) Encode(e *jx.Encoder) {
+	e.Int(int(s))
+}
+
+// Decode decodes ContorVersion from json.
+func (s *ContorVersion) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContorVersion to nil")
 	}
-	{
-		if s.CalcitoninInFlush.Set {
-			e.FieldStart("calcitonin_in_flush")
-			s.CalcitoninInFlush.Encode(e)
-		}
+	v, err := d.Int()
+	if err != nil {
+		return err
 	}
-	{
-		if s.Thyroglobulin.Set {
-			e.FieldStart("thyroglobulin")
-			s.Thyroglobulin.Encode(e)
-		}
+	*s = ContorVersion(v)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ContorVersion) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContorVersion) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyColor as json.
+func (s CytologyColor) Encode(e *jx.Encoder) {
+	unwrapped := string(s)
+
+	e.Str(unwrapped)
+}
+
+// Decode decodes CytologyColor from json.
+func (s *CytologyColor) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyColor to nil")
 	}
-	{
-		if s.Prev.Set {
-			e.FieldStart("prev")
-			s.Prev.Encode(e)
+	var unwrapped string
+	if err := func() error {
+		v, err := d.Str()
+		unwrapped = string(v)
+		if err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = CytologyColor(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CytologyColor) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyColor) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CytologyCopyCreateCreated) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologyCopyCreateCreated) encodeFields(e *jx.Encoder) {
 	{
-		if s.ParentPrev.Set {
-			e.FieldStart("parent_prev")
-			s.ParentPrev.Encode(e)
+		if s.Pk.Set {
+			e.FieldStart("pk")
+			s.Pk.Encode(e)
 		}
 	}
 	{
-		if s.PatientCard.Set {
-			e.FieldStart("patient_card")
-			s.PatientCard.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCytologyCreateCreateCreated = [14]string{
-	0:  "id",
-	1:  "image",
-	2:  "is_last",
-	3:  "diagnos_date",
-	4:  "details",
-	5:  "diagnostic_marking",
-	6:  "diagnostic_number",
-	7:  "material_type",
-	8:  "calcitonin",
-	9:  "calcitonin_in_flush",
-	10: "thyroglobulin",
-	11: "prev",
-	12: "parent_prev",
-	13: "patient_card",
+var jsonFieldsNameOfCytologyCopyCreateCreated = [2]string{
+	0: "pk",
+	1: "id",
 }
 
-// Decode decodes CytologyCreateCreateCreated from json.
-func (s *CytologyCreateCreateCreated) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyCopyCreateCreated from json.
+func (s *CytologyCopyCreateCreated) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyCreateCreateCreated to nil")
+		return errors.New("invalid: unable to decode CytologyCopyCreateCreated to nil")
 	}
-	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "image":
-			if err := func() error {
-				s.Image.Reset()
-				if err := s.Image.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"image\"")
-			}
-		case "is_last":
-			if err := func() error {
-				s.IsLast.Reset()
-				if err := s.IsLast.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_last\"")
-			}
-		case "diagnos_date":
+		case "pk":
 			if err := func() error {
-				s.DiagnosDate.Reset()
-				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.Pk.Reset()
+				if err := s.Pk.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnos_date\"")
+				return errors.Wrap(err, "decode field \"pk\"")
 			}
-		case "details":
+		case "id":
 			if err := func() error {
-				s.Details = nil
-				var elem CytologyCreateCreateCreatedDetails
-				if err := elem.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
-				s.Details = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"details\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "diagnostic_marking":
-			if err := func() error {
-				s.DiagnosticMarking.Reset()
-				if err := s.DiagnosticMarking.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
-			}
-		case "diagnostic_number":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Int()
-				s.DiagnosticNumber = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_number\"")
-			}
-		case "material_type":
-			if err := func() error {
-				s.MaterialType.Reset()
-				if err := s.MaterialType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"material_type\"")
-			}
-		case "calcitonin":
-			if err := func() error {
-				s.Calcitonin.Reset()
-				if err := s.Calcitonin.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin\"")
-			}
-		case "calcitonin_in_flush":
-			if err := func() error {
-				s.CalcitoninInFlush.Reset()
-				if err := s.CalcitoninInFlush.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
-			}
-		case "thyroglobulin":
-			if err := func() error {
-				s.Thyroglobulin.Reset()
-				if err := s.Thyroglobulin.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"thyroglobulin\"")
-			}
-		case "prev":
-			if err := func() error {
-				s.Prev.Reset()
-				if err := s.Prev.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"prev\"")
-			}
-		case "parent_prev":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologyCopyCreateCreated")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CytologyCopyCreateCreated) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyCopyCreateCreated) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CytologyCopyCreateReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologyCopyCreateReq) encodeFields(e *jx.Encoder) {
+	{
+		if s.Pk.Set {
+			e.FieldStart("pk")
+			s.Pk.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+}
+
+var jsonFieldsNameOfCytologyCopyCreateReq = [2]string{
+	0: "pk",
+	1: "id",
+}
+
+// Decode decodes CytologyCopyCreateReq from json.
+func (s *CytologyCopyCreateReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyCopyCreateReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "pk":
 			if err := func() error {
-				s.ParentPrev.Reset()
-				if err := s.ParentPrev.Decode(d); err != nil {
+				s.Pk.Reset()
+				if err := s.Pk.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"parent_prev\"")
+				return errors.Wrap(err, "decode field \"pk\"")
 			}
-		case "patient_card":
+		case "id":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.PatientCard.Reset()
-				if err := s.PatientCard.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient_card\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyCreateCreateCreated")
+		return errors.Wrap(err, "decode CytologyCopyCreateReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b01000000,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000010,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -792,8 +834,8 @@ func (s *CytologyCreateCreateCreated) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyCreateCreateCreated) {
-					name = jsonFieldsNameOfCytologyCreateCreateCreated[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyCopyCreateReq) {
+					name = jsonFieldsNameOfCytologyCopyCreateReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -814,337 +856,56 @@ func (s *CytologyCreateCreateCreated) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyCreateCreateCreated) MarshalJSON() ([]byte, error) {
+func (s *CytologyCopyCreateReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyCreateCreateCreated) UnmarshalJSON(data []byte) error {
+func (s *CytologyCopyCreateReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyCreateCreateCreatedDetails) Encode(e *jx.Encoder) {
+func (s *CytologyCreateCreateCreated) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyCreateCreateCreatedDetails) encodeFields(e *jx.Encoder) {
-}
-
-var jsonFieldsNameOfCytologyCreateCreateCreatedDetails = [0]string{}
-
-// Decode decodes CytologyCreateCreateCreatedDetails from json.
-func (s *CytologyCreateCreateCreatedDetails) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyCreateCreateCreatedDetails to nil")
+func (s *CytologyCreateCreateCreated) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
+	{
+		if s.Image.Set {
+			e.FieldStart("image")
+			s.Image.Encode(e)
 		}
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologyCreateCreateCreatedDetails")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyCreateCreateCreatedDetails) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyCreateCreateCreatedDetails) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CytologyCreateCreateCreatedDiagnosticMarking as json.
-func (s CytologyCreateCreateCreatedDiagnosticMarking) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes CytologyCreateCreateCreatedDiagnosticMarking from json.
-func (s *CytologyCreateCreateCreatedDiagnosticMarking) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyCreateCreateCreatedDiagnosticMarking to nil")
+	{
+		if s.IsLast.Set {
+			e.FieldStart("is_last")
+			s.IsLast.Encode(e)
+		}
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		if s.DiagnosDate.Set {
+			e.FieldStart("diagnos_date")
+			s.DiagnosDate.Encode(e, json.EncodeDateTime)
+		}
 	}
-	// Try to use constant string.
-	switch CytologyCreateCreateCreatedDiagnosticMarking(v) {
-	case CytologyCreateCreateCreatedDiagnosticMarking11:
-		*s = CytologyCreateCreateCreatedDiagnosticMarking11
-	case CytologyCreateCreateCreatedDiagnosticMarking23:
-		*s = CytologyCreateCreateCreatedDiagnosticMarking23
-	default:
-		*s = CytologyCreateCreateCreatedDiagnosticMarking(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CytologyCreateCreateCreatedDiagnosticMarking) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyCreateCreateCreatedDiagnosticMarking) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CytologyCreateCreateCreatedMaterialType as json.
-func (s CytologyCreateCreateCreatedMaterialType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes CytologyCreateCreateCreatedMaterialType from json.
-func (s *CytologyCreateCreateCreatedMaterialType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyCreateCreateCreatedMaterialType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch CytologyCreateCreateCreatedMaterialType(v) {
-	case CytologyCreateCreateCreatedMaterialTypeGS:
-		*s = CytologyCreateCreateCreatedMaterialTypeGS
-	case CytologyCreateCreateCreatedMaterialTypeBP:
-		*s = CytologyCreateCreateCreatedMaterialTypeBP
-	case CytologyCreateCreateCreatedMaterialTypeTP:
-		*s = CytologyCreateCreateCreatedMaterialTypeTP
-	case CytologyCreateCreateCreatedMaterialTypePTP:
-		*s = CytologyCreateCreateCreatedMaterialTypePTP
-	case CytologyCreateCreateCreatedMaterialTypeLNP:
-		*s = CytologyCreateCreateCreatedMaterialTypeLNP
-	default:
-		*s = CytologyCreateCreateCreatedMaterialType(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CytologyCreateCreateCreatedMaterialType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyCreateCreateCreatedMaterialType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CytologyHistoryReadOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CytologyHistoryReadOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("count")
-		e.Int(s.Count)
-	}
-	{
-		if s.Next.Set {
-			e.FieldStart("next")
-			s.Next.Encode(e)
-		}
-	}
-	{
-		if s.Previous.Set {
-			e.FieldStart("previous")
-			s.Previous.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("results")
-		e.ArrStart()
-		for _, elem := range s.Results {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
-}
-
-var jsonFieldsNameOfCytologyHistoryReadOK = [4]string{
-	0: "count",
-	1: "next",
-	2: "previous",
-	3: "results",
-}
-
-// Decode decodes CytologyHistoryReadOK from json.
-func (s *CytologyHistoryReadOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyHistoryReadOK to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "count":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.Count = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"count\"")
-			}
-		case "next":
-			if err := func() error {
-				s.Next.Reset()
-				if err := s.Next.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"next\"")
-			}
-		case "previous":
-			if err := func() error {
-				s.Previous.Reset()
-				if err := s.Previous.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"previous\"")
-			}
-		case "results":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				s.Results = make([]CytologyHistoryReadOKResultsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologyHistoryReadOKResultsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Results = append(s.Results, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"results\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologyHistoryReadOK")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyHistoryReadOK) {
-					name = jsonFieldsNameOfCytologyHistoryReadOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyHistoryReadOK) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyHistoryReadOK) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CytologyHistoryReadOKResultsItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CytologyHistoryReadOKResultsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.IsLast.Set {
-			e.FieldStart("is_last")
-			s.IsLast.Encode(e)
-		}
-	}
-	{
-		if s.DiagnosDate.Set {
-			e.FieldStart("diagnos_date")
-			s.DiagnosDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.Details != nil {
-			e.FieldStart("details")
-			s.Details.Encode(e)
-		}
+	{
+		if s.Details != nil {
+			e.FieldStart("details")
+			s.Details.Encode(e)
+		}
 	}
 	{
 		if s.DiagnosticMarking.Set {
@@ -1198,35 +959,29 @@ func (s *CytologyHistoryReadOKResultsItem) encodeFields(e *jx.Encoder) {
 			s.PatientCard.Encode(e)
 		}
 	}
-	{
-		if s.OriginalImage.Set {
-			e.FieldStart("original_image")
-			s.OriginalImage.Encode(e)
-		}
-	}
 }
 
-var jsonFieldsNameOfCytologyHistoryReadOKResultsItem = [14]string{
+var jsonFieldsNameOfCytologyCreateCreateCreated = [14]string{
 	0:  "id",
-	1:  "is_last",
-	2:  "diagnos_date",
-	3:  "details",
-	4:  "diagnostic_marking",
-	5:  "diagnostic_number",
-	6:  "material_type",
-	7:  "calcitonin",
-	8:  "calcitonin_in_flush",
-	9:  "thyroglobulin",
-	10: "prev",
-	11: "parent_prev",
-	12: "patient_card",
-	13: "original_image",
+	1:  "image",
+	2:  "is_last",
+	3:  "diagnos_date",
+	4:  "details",
+	5:  "diagnostic_marking",
+	6:  "diagnostic_number",
+	7:  "material_type",
+	8:  "calcitonin",
+	9:  "calcitonin_in_flush",
+	10: "thyroglobulin",
+	11: "prev",
+	12: "parent_prev",
+	13: "patient_card",
 }
 
-// Decode decodes CytologyHistoryReadOKResultsItem from json.
-func (s *CytologyHistoryReadOKResultsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyCreateCreateCreated from json.
+func (s *CytologyCreateCreateCreated) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyHistoryReadOKResultsItem to nil")
+		return errors.New("invalid: unable to decode CytologyCreateCreateCreated to nil")
 	}
 	var requiredBitSet [2]uint8
 
@@ -1242,6 +997,16 @@ func (s *CytologyHistoryReadOKResultsItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
+		case "image":
+			if err := func() error {
+				s.Image.Reset()
+				if err := s.Image.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"image\"")
+			}
 		case "is_last":
 			if err := func() error {
 				s.IsLast.Reset()
@@ -1265,7 +1030,7 @@ func (s *CytologyHistoryReadOKResultsItem) Decode(d *jx.Decoder) error {
 		case "details":
 			if err := func() error {
 				s.Details = nil
-				var elem CytologyHistoryReadOKResultsItemDetails
+				var elem CytologyCreateCreateCreatedDetails
 				if err := elem.Decode(d); err != nil {
 					return err
 				}
@@ -1285,7 +1050,7 @@ func (s *CytologyHistoryReadOKResultsItem) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
 			}
 		case "diagnostic_number":
-			requiredBitSet[0] |= 1 << 5
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
 				v, err := d.Int()
 				s.DiagnosticNumber = int(v)
@@ -1366,27 +1131,17 @@ func (s *CytologyHistoryReadOKResultsItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"patient_card\"")
 			}
-		case "original_image":
-			if err := func() error {
-				s.OriginalImage.Reset()
-				if err := s.OriginalImage.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"original_image\"")
-			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyHistoryReadOKResultsItem")
+		return errors.Wrap(err, "decode CytologyCreateCreateCreated")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b00100000,
+		0b01000000,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -1399,8 +1154,8 @@ func (s *CytologyHistoryReadOKResultsItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyHistoryReadOKResultsItem) {
-					name = jsonFieldsNameOfCytologyHistoryReadOKResultsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyCreateCreateCreated) {
+					name = jsonFieldsNameOfCytologyCreateCreateCreated[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1421,35 +1176,35 @@ func (s *CytologyHistoryReadOKResultsItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyHistoryReadOKResultsItem) MarshalJSON() ([]byte, error) {
+func (s *CytologyCreateCreateCreated) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyHistoryReadOKResultsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologyCreateCreateCreated) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyHistoryReadOKResultsItemDetails) Encode(e *jx.Encoder) {
+func (s *CytologyCreateCreateCreatedDetails) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyHistoryReadOKResultsItemDetails) encodeFields(e *jx.Encoder) {
+func (s *CytologyCreateCreateCreatedDetails) encodeFields(e *jx.Encoder) {
 }
 
-var jsonFieldsNameOfCytologyHistoryReadOKResultsItemDetails = [0]string{}
+var jsonFieldsNameOfCytologyCreateCreateCreatedDetails = [0]string{}
 
-// Decode decodes CytologyHistoryReadOKResultsItemDetails from json.
-func (s *CytologyHistoryReadOKResultsItemDetails) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyCreateCreateCreatedDetails from json.
+func (s *CytologyCreateCreateCreatedDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyHistoryReadOKResultsItemDetails to nil")
+		return errors.New("invalid: unable to decode CytologyCreateCreateCreatedDetails to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -1458,380 +1213,267 @@ func (s *CytologyHistoryReadOKResultsItemDetails) Decode(d *jx.Decoder) error {
 			return d.Skip()
 		}
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyHistoryReadOKResultsItemDetails")
+		return errors.Wrap(err, "decode CytologyCreateCreateCreatedDetails")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyHistoryReadOKResultsItemDetails) MarshalJSON() ([]byte, error) {
+func (s *CytologyCreateCreateCreatedDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyHistoryReadOKResultsItemDetails) UnmarshalJSON(data []byte) error {
+func (s *CytologyCreateCreateCreatedDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyHistoryReadOKResultsItemDiagnosticMarking as json.
-func (s CytologyHistoryReadOKResultsItemDiagnosticMarking) Encode(e *jx.Encoder) {
+// Encode encodes CytologyCreateCreateCreatedDiagnosticMarking as json.
+func (s CytologyCreateCreateCreatedDiagnosticMarking) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes CytologyHistoryReadOKResultsItemDiagnosticMarking from json.
-func (s *CytologyHistoryReadOKResultsItemDiagnosticMarking) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyCreateCreateCreatedDiagnosticMarking from json.
+func (s *CytologyCreateCreateCreatedDiagnosticMarking) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyHistoryReadOKResultsItemDiagnosticMarking to nil")
+		return errors.New("invalid: unable to decode CytologyCreateCreateCreatedDiagnosticMarking to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch CytologyHistoryReadOKResultsItemDiagnosticMarking(v) {
-	case CytologyHistoryReadOKResultsItemDiagnosticMarking11:
-		*s = CytologyHistoryReadOKResultsItemDiagnosticMarking11
-	case CytologyHistoryReadOKResultsItemDiagnosticMarking23:
-		*s = CytologyHistoryReadOKResultsItemDiagnosticMarking23
+	switch CytologyCreateCreateCreatedDiagnosticMarking(v) {
+	case CytologyCreateCreateCreatedDiagnosticMarking11:
+		*s = CytologyCreateCreateCreatedDiagnosticMarking11
+	case CytologyCreateCreateCreatedDiagnosticMarking23:
+		*s = CytologyCreateCreateCreatedDiagnosticMarking23
 	default:
-		*s = CytologyHistoryReadOKResultsItemDiagnosticMarking(v)
+		*s = CytologyCreateCreateCreatedDiagnosticMarking(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologyHistoryReadOKResultsItemDiagnosticMarking) MarshalJSON() ([]byte, error) {
+func (s CytologyCreateCreateCreatedDiagnosticMarking) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyHistoryReadOKResultsItemDiagnosticMarking) UnmarshalJSON(data []byte) error {
+func (s *CytologyCreateCreateCreatedDiagnosticMarking) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyHistoryReadOKResultsItemMaterialType as json.
-func (s CytologyHistoryReadOKResultsItemMaterialType) Encode(e *jx.Encoder) {
+// Encode encodes CytologyCreateCreateCreatedMaterialType as json.
+func (s CytologyCreateCreateCreatedMaterialType) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes CytologyHistoryReadOKResultsItemMaterialType from json.
-func (s *CytologyHistoryReadOKResultsItemMaterialType) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyCreateCreateCreatedMaterialType from json.
+func (s *CytologyCreateCreateCreatedMaterialType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyHistoryReadOKResultsItemMaterialType to nil")
+		return errors.New("invalid: unable to decode CytologyCreateCreateCreatedMaterialType to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch CytologyHistoryReadOKResultsItemMaterialType(v) {
-	case CytologyHistoryReadOKResultsItemMaterialTypeGS:
-		*s = CytologyHistoryReadOKResultsItemMaterialTypeGS
-	case CytologyHistoryReadOKResultsItemMaterialTypeBP:
-		*s = CytologyHistoryReadOKResultsItemMaterialTypeBP
-	case CytologyHistoryReadOKResultsItemMaterialTypeTP:
-		*s = CytologyHistoryReadOKResultsItemMaterialTypeTP
-	case CytologyHistoryReadOKResultsItemMaterialTypePTP:
-		*s = CytologyHistoryReadOKResultsItemMaterialTypePTP
-	case CytologyHistoryReadOKResultsItemMaterialTypeLNP:
-		*s = CytologyHistoryReadOKResultsItemMaterialTypeLNP
+	switch CytologyCreateCreateCreatedMaterialType(v) {
+	case CytologyCreateCreateCreatedMaterialTypeGS:
+		*s = CytologyCreateCreateCreatedMaterialTypeGS
+	case CytologyCreateCreateCreatedMaterialTypeBP:
+		*s = CytologyCreateCreateCreatedMaterialTypeBP
+	case CytologyCreateCreateCreatedMaterialTypeTP:
+		*s = CytologyCreateCreateCreatedMaterialTypeTP
+	case CytologyCreateCreateCreatedMaterialTypePTP:
+		*s = CytologyCreateCreateCreatedMaterialTypePTP
+	case CytologyCreateCreateCreatedMaterialTypeLNP:
+		*s = CytologyCreateCreateCreatedMaterialTypeLNP
 	default:
-		*s = CytologyHistoryReadOKResultsItemMaterialType(v)
+		*s = CytologyCreateCreateCreatedMaterialType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologyHistoryReadOKResultsItemMaterialType) MarshalJSON() ([]byte, error) {
+func (s CytologyCreateCreateCreatedMaterialType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyHistoryReadOKResultsItemMaterialType) UnmarshalJSON(data []byte) error {
+func (s *CytologyCreateCreateCreatedMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyGeometryType as json.
+func (s CytologyGeometryType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CytologyGeometryType from json.
+func (s *CytologyGeometryType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyGeometryType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CytologyGeometryType(v) {
+	case CytologyGeometryTypePoint:
+		*s = CytologyGeometryTypePoint
+	case CytologyGeometryTypeLineString:
+		*s = CytologyGeometryTypeLineString
+	case CytologyGeometryTypePolygon:
+		*s = CytologyGeometryTypePolygon
+	case CytologyGeometryTypeMultiPolygon:
+		*s = CytologyGeometryTypeMultiPolygon
+	default:
+		*s = CytologyGeometryType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CytologyGeometryType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyGeometryType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyPatientShot) Encode(e *jx.Encoder) {
+func (s *CytologyHistoryReadOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyPatientShot) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
-	{
-		e.FieldStart("patient_card")
-		s.PatientCard.Encode(e)
-	}
-	{
-		e.FieldStart("is_last")
-		e.Bool(s.IsLast)
-	}
-	{
-		e.FieldStart("diagnos_date")
-		json.EncodeDateTime(e, s.DiagnosDate)
-	}
-	{
-		e.FieldStart("details")
-		s.Details.Encode(e)
-	}
-	{
-		if s.DiagnosticMarking.Set {
-			e.FieldStart("diagnostic_marking")
-			s.DiagnosticMarking.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("diagnostic_number")
-		e.Int(s.DiagnosticNumber)
-	}
-	{
-		if s.MaterialType.Set {
-			e.FieldStart("material_type")
-			s.MaterialType.Encode(e)
-		}
-	}
-	{
-		if s.Calcitonin.Set {
-			e.FieldStart("calcitonin")
-			s.Calcitonin.Encode(e)
-		}
-	}
-	{
-		if s.CalcitoninInFlush.Set {
-			e.FieldStart("calcitonin_in_flush")
-			s.CalcitoninInFlush.Encode(e)
-		}
-	}
+func (s *CytologyHistoryReadOK) encodeFields(e *jx.Encoder) {
 	{
-		if s.Thyroglobulin.Set {
-			e.FieldStart("thyroglobulin")
-			s.Thyroglobulin.Encode(e)
-		}
+		e.FieldStart("count")
+		e.Int(s.Count)
 	}
 	{
-		if s.Prev.Set {
-			e.FieldStart("prev")
-			s.Prev.Encode(e)
+		if s.Next.Set {
+			e.FieldStart("next")
+			s.Next.Encode(e)
 		}
 	}
 	{
-		if s.ParentPrev.Set {
-			e.FieldStart("parent_prev")
-			s.ParentPrev.Encode(e)
+		if s.Previous.Set {
+			e.FieldStart("previous")
+			s.Previous.Encode(e)
 		}
 	}
 	{
-		if s.OriginalImage.Set {
-			e.FieldStart("original_image")
-			s.OriginalImage.Encode(e)
+		e.FieldStart("results")
+		e.ArrStart()
+		for _, elem := range s.Results {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfCytologyPatientShot = [14]string{
-	0:  "id",
-	1:  "patient_card",
-	2:  "is_last",
-	3:  "diagnos_date",
-	4:  "details",
-	5:  "diagnostic_marking",
-	6:  "diagnostic_number",
-	7:  "material_type",
-	8:  "calcitonin",
-	9:  "calcitonin_in_flush",
-	10: "thyroglobulin",
-	11: "prev",
-	12: "parent_prev",
-	13: "original_image",
+var jsonFieldsNameOfCytologyHistoryReadOK = [4]string{
+	0: "count",
+	1: "next",
+	2: "previous",
+	3: "results",
 }
 
-// Decode decodes CytologyPatientShot from json.
-func (s *CytologyPatientShot) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyHistoryReadOK from json.
+func (s *CytologyHistoryReadOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyPatientShot to nil")
+		return errors.New("invalid: unable to decode CytologyHistoryReadOK to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "count":
 			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "patient_card":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				if err := s.PatientCard.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient_card\"")
-			}
-		case "is_last":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Bool()
-				s.IsLast = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_last\"")
-			}
-		case "diagnos_date":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.DiagnosDate = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnos_date\"")
-			}
-		case "details":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				if err := s.Details.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"details\"")
-			}
-		case "diagnostic_marking":
-			if err := func() error {
-				s.DiagnosticMarking.Reset()
-				if err := s.DiagnosticMarking.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
-			}
-		case "diagnostic_number":
-			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
 				v, err := d.Int()
-				s.DiagnosticNumber = int(v)
+				s.Count = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_number\"")
-			}
-		case "material_type":
-			if err := func() error {
-				s.MaterialType.Reset()
-				if err := s.MaterialType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"material_type\"")
-			}
-		case "calcitonin":
-			if err := func() error {
-				s.Calcitonin.Reset()
-				if err := s.Calcitonin.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin\"")
-			}
-		case "calcitonin_in_flush":
-			if err := func() error {
-				s.CalcitoninInFlush.Reset()
-				if err := s.CalcitoninInFlush.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
-			}
-		case "thyroglobulin":
-			if err := func() error {
-				s.Thyroglobulin.Reset()
-				if err := s.Thyroglobulin.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"thyroglobulin\"")
+				return errors.Wrap(err, "decode field \"count\"")
 			}
-		case "prev":
+		case "next":
 			if err := func() error {
-				s.Prev.Reset()
-				if err := s.Prev.Decode(d); err != nil {
+				s.Next.Reset()
+				if err := s.Next.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"prev\"")
+				return errors.Wrap(err, "decode field \"next\"")
 			}
-		case "parent_prev":
+		case "previous":
 			if err := func() error {
-				s.ParentPrev.Reset()
-				if err := s.ParentPrev.Decode(d); err != nil {
+				s.Previous.Reset()
+				if err := s.Previous.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"parent_prev\"")
+				return errors.Wrap(err, "decode field \"previous\"")
 			}
-		case "original_image":
+		case "results":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.OriginalImage.Reset()
-				if err := s.OriginalImage.Decode(d); err != nil {
+				s.Results = make([]CytologyHistoryReadOKResultsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CytologyHistoryReadOKResultsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"original_image\"")
+				return errors.Wrap(err, "decode field \"results\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyPatientShot")
+		return errors.Wrap(err, "decode CytologyHistoryReadOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b01011111,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00001001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1843,8 +1485,8 @@ func (s *CytologyPatientShot) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyPatientShot) {
-					name = jsonFieldsNameOfCytologyPatientShot[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyHistoryReadOK) {
+					name = jsonFieldsNameOfCytologyHistoryReadOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1865,289 +1507,293 @@ func (s *CytologyPatientShot) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyPatientShot) MarshalJSON() ([]byte, error) {
+func (s *CytologyHistoryReadOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyPatientShot) UnmarshalJSON(data []byte) error {
+func (s *CytologyHistoryReadOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyPatientShotDiagnosticMarking as json.
-func (s CytologyPatientShotDiagnosticMarking) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *CytologyHistoryReadOKResultsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CytologyPatientShotDiagnosticMarking from json.
-func (s *CytologyPatientShotDiagnosticMarking) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyPatientShotDiagnosticMarking to nil")
+// encodeFields encodes fields.
+func (s *CytologyHistoryReadOKResultsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		if s.IsLast.Set {
+			e.FieldStart("is_last")
+			s.IsLast.Encode(e)
+		}
 	}
-	// Try to use constant string.
-	switch CytologyPatientShotDiagnosticMarking(v) {
-	case CytologyPatientShotDiagnosticMarking11:
-		*s = CytologyPatientShotDiagnosticMarking11
-	case CytologyPatientShotDiagnosticMarking23:
-		*s = CytologyPatientShotDiagnosticMarking23
-	default:
-		*s = CytologyPatientShotDiagnosticMarking(v)
+	{
+		if s.DiagnosDate.Set {
+			e.FieldStart("diagnos_date")
+			s.DiagnosDate.Encode(e, json.EncodeDateTime)
+		}
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CytologyPatientShotDiagnosticMarking) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyPatientShotDiagnosticMarking) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CytologyPatientShotMaterialType as json.
-func (s CytologyPatientShotMaterialType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes CytologyPatientShotMaterialType from json.
-func (s *CytologyPatientShotMaterialType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyPatientShotMaterialType to nil")
+	{
+		if s.Details != nil {
+			e.FieldStart("details")
+			s.Details.Encode(e)
+		}
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		if s.DiagnosticMarking.Set {
+			e.FieldStart("diagnostic_marking")
+			s.DiagnosticMarking.Encode(e)
+		}
 	}
-	// Try to use constant string.
-	switch CytologyPatientShotMaterialType(v) {
-	case CytologyPatientShotMaterialTypeGS:
-		*s = CytologyPatientShotMaterialTypeGS
-	case CytologyPatientShotMaterialTypeBP:
-		*s = CytologyPatientShotMaterialTypeBP
-	case CytologyPatientShotMaterialTypeTP:
-		*s = CytologyPatientShotMaterialTypeTP
-	case CytologyPatientShotMaterialTypePTP:
-		*s = CytologyPatientShotMaterialTypePTP
-	case CytologyPatientShotMaterialTypeLNP:
-		*s = CytologyPatientShotMaterialTypeLNP
-	default:
-		*s = CytologyPatientShotMaterialType(v)
+	{
+		e.FieldStart("diagnostic_number")
+		e.Int(s.DiagnosticNumber)
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CytologyPatientShotMaterialType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyPatientShotMaterialType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CytologyPatientShotsReadOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CytologyPatientShotsReadOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("patient")
-		s.Patient.Encode(e)
+		if s.MaterialType.Set {
+			e.FieldStart("material_type")
+			s.MaterialType.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("shots")
-		e.ArrStart()
-		for _, elem := range s.Shots {
-			elem.Encode(e)
+		if s.Calcitonin.Set {
+			e.FieldStart("calcitonin")
+			s.Calcitonin.Encode(e)
+		}
+	}
+	{
+		if s.CalcitoninInFlush.Set {
+			e.FieldStart("calcitonin_in_flush")
+			s.CalcitoninInFlush.Encode(e)
+		}
+	}
+	{
+		if s.Thyroglobulin.Set {
+			e.FieldStart("thyroglobulin")
+			s.Thyroglobulin.Encode(e)
+		}
+	}
+	{
+		if s.Prev.Set {
+			e.FieldStart("prev")
+			s.Prev.Encode(e)
+		}
+	}
+	{
+		if s.ParentPrev.Set {
+			e.FieldStart("parent_prev")
+			s.ParentPrev.Encode(e)
+		}
+	}
+	{
+		if s.PatientCard.Set {
+			e.FieldStart("patient_card")
+			s.PatientCard.Encode(e)
+		}
+	}
+	{
+		if s.OriginalImage.Set {
+			e.FieldStart("original_image")
+			s.OriginalImage.Encode(e)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfCytologyPatientShotsReadOK = [2]string{
-	0: "patient",
-	1: "shots",
+var jsonFieldsNameOfCytologyHistoryReadOKResultsItem = [14]string{
+	0:  "id",
+	1:  "is_last",
+	2:  "diagnos_date",
+	3:  "details",
+	4:  "diagnostic_marking",
+	5:  "diagnostic_number",
+	6:  "material_type",
+	7:  "calcitonin",
+	8:  "calcitonin_in_flush",
+	9:  "thyroglobulin",
+	10: "prev",
+	11: "parent_prev",
+	12: "patient_card",
+	13: "original_image",
 }
 
-// Decode decodes CytologyPatientShotsReadOK from json.
-func (s *CytologyPatientShotsReadOK) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyHistoryReadOKResultsItem from json.
+func (s *CytologyHistoryReadOKResultsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyPatientShotsReadOK to nil")
+		return errors.New("invalid: unable to decode CytologyHistoryReadOKResultsItem to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "patient":
-			requiredBitSet[0] |= 1 << 0
+		case "id":
 			if err := func() error {
-				if err := s.Patient.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "shots":
-			requiredBitSet[0] |= 1 << 1
+		case "is_last":
 			if err := func() error {
-				s.Shots = make([]CytologyPatientShot, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologyPatientShot
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Shots = append(s.Shots, elem)
-					return nil
-				}); err != nil {
+				s.IsLast.Reset()
+				if err := s.IsLast.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"shots\"")
+				return errors.Wrap(err, "decode field \"is_last\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologyPatientShotsReadOK")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyPatientShotsReadOK) {
-					name = jsonFieldsNameOfCytologyPatientShotsReadOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "diagnos_date":
+			if err := func() error {
+				s.DiagnosDate.Reset()
+				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnos_date\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyPatientShotsReadOK) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyPatientShotsReadOK) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CytologyReadOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CytologyReadOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("original_image")
-		s.OriginalImage.Encode(e)
-	}
-	{
-		e.FieldStart("info")
-		s.Info.Encode(e)
-	}
-}
-
-var jsonFieldsNameOfCytologyReadOK = [2]string{
-	0: "original_image",
-	1: "info",
-}
-
-// Decode decodes CytologyReadOK from json.
-func (s *CytologyReadOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyReadOK to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "original_image":
-			requiredBitSet[0] |= 1 << 0
+		case "details":
 			if err := func() error {
-				if err := s.OriginalImage.Decode(d); err != nil {
+				s.Details = nil
+				var elem CytologyHistoryReadOKResultsItemDetails
+				if err := elem.Decode(d); err != nil {
 					return err
 				}
+				s.Details = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"original_image\"")
+				return errors.Wrap(err, "decode field \"details\"")
 			}
-		case "info":
-			requiredBitSet[0] |= 1 << 1
+		case "diagnostic_marking":
 			if err := func() error {
-				if err := s.Info.Decode(d); err != nil {
+				s.DiagnosticMarking.Reset()
+				if err := s.DiagnosticMarking.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"info\"")
+				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
+		case "diagnostic_number":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.DiagnosticNumber = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnostic_number\"")
+			}
+		case "material_type":
+			if err := func() error {
+				s.MaterialType.Reset()
+				if err := s.MaterialType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"material_type\"")
+			}
+		case "calcitonin":
+			if err := func() error {
+				s.Calcitonin.Reset()
+				if err := s.Calcitonin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"calcitonin\"")
+			}
+		case "calcitonin_in_flush":
+			if err := func() error {
+				s.CalcitoninInFlush.Reset()
+				if err := s.CalcitoninInFlush.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
+			}
+		case "thyroglobulin":
+			if err := func() error {
+				s.Thyroglobulin.Reset()
+				if err := s.Thyroglobulin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"thyroglobulin\"")
+			}
+		case "prev":
+			if err := func() error {
+				s.Prev.Reset()
+				if err := s.Prev.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"prev\"")
+			}
+		case "parent_prev":
+			if err := func() error {
+				s.ParentPrev.Reset()
+				if err := s.ParentPrev.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parent_prev\"")
+			}
+		case "patient_card":
+			if err := func() error {
+				s.PatientCard.Reset()
+				if err := s.PatientCard.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patient_card\"")
+			}
+		case "original_image":
+			if err := func() error {
+				s.OriginalImage.Reset()
+				if err := s.OriginalImage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"original_image\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyReadOK")
+		return errors.Wrap(err, "decode CytologyHistoryReadOKResultsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b00100000,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2159,8 +1805,8 @@ func (s *CytologyReadOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyReadOK) {
-					name = jsonFieldsNameOfCytologyReadOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyHistoryReadOKResultsItem) {
+					name = jsonFieldsNameOfCytologyHistoryReadOKResultsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2181,176 +1827,178 @@ func (s *CytologyReadOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyReadOK) MarshalJSON() ([]byte, error) {
+func (s *CytologyHistoryReadOKResultsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyReadOK) UnmarshalJSON(data []byte) error {
+func (s *CytologyHistoryReadOKResultsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyReadOKInfo) Encode(e *jx.Encoder) {
+func (s *CytologyHistoryReadOKResultsItemDetails) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyReadOKInfo) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("patient")
-		s.Patient.Encode(e)
-	}
-	{
-		e.FieldStart("patient_card")
-		s.PatientCard.Encode(e)
-	}
-	{
-		e.FieldStart("image_group")
-		s.ImageGroup.Encode(e)
-	}
+func (s *CytologyHistoryReadOKResultsItemDetails) encodeFields(e *jx.Encoder) {
 }
 
-var jsonFieldsNameOfCytologyReadOKInfo = [3]string{
-	0: "patient",
-	1: "patient_card",
-	2: "image_group",
-}
+var jsonFieldsNameOfCytologyHistoryReadOKResultsItemDetails = [0]string{}
 
-// Decode decodes CytologyReadOKInfo from json.
-func (s *CytologyReadOKInfo) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyHistoryReadOKResultsItemDetails from json.
+func (s *CytologyHistoryReadOKResultsItemDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyReadOKInfo to nil")
+		return errors.New("invalid: unable to decode CytologyHistoryReadOKResultsItemDetails to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "patient":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				if err := s.Patient.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient\"")
-			}
-		case "patient_card":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				if err := s.PatientCard.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient_card\"")
-			}
-		case "image_group":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				if err := s.ImageGroup.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"image_group\"")
-			}
 		default:
 			return d.Skip()
 		}
-		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyReadOKInfo")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyReadOKInfo) {
-					name = jsonFieldsNameOfCytologyReadOKInfo[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode CytologyHistoryReadOKResultsItemDetails")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyReadOKInfo) MarshalJSON() ([]byte, error) {
+func (s *CytologyHistoryReadOKResultsItemDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyReadOKInfo) UnmarshalJSON(data []byte) error {
+func (s *CytologyHistoryReadOKResultsItemDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *CytologyReadOKInfoImageGroup) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes CytologyHistoryReadOKResultsItemDiagnosticMarking as json.
+func (s CytologyHistoryReadOKResultsItemDiagnosticMarking) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// encodeFields encodes fields.
-func (s *CytologyReadOKInfoImageGroup) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.IsLast.Set {
-			e.FieldStart("is_last")
-			s.IsLast.Encode(e)
-		}
+// Decode decodes CytologyHistoryReadOKResultsItemDiagnosticMarking from json.
+func (s *CytologyHistoryReadOKResultsItemDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyHistoryReadOKResultsItemDiagnosticMarking to nil")
 	}
-	{
-		if s.DiagnosDate.Set {
-			e.FieldStart("diagnos_date")
-			s.DiagnosDate.Encode(e, json.EncodeDateTime)
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	{
-		if s.Details != nil {
-			e.FieldStart("details")
-			s.Details.Encode(e)
-		}
+	// Try to use constant string.
+	switch CytologyHistoryReadOKResultsItemDiagnosticMarking(v) {
+	case CytologyHistoryReadOKResultsItemDiagnosticMarking11:
+		*s = CytologyHistoryReadOKResultsItemDiagnosticMarking11
+	case CytologyHistoryReadOKResultsItemDiagnosticMarking23:
+		*s = CytologyHistoryReadOKResultsItemDiagnosticMarking23
+	default:
+		*s = CytologyHistoryReadOKResultsItemDiagnosticMarking(v)
 	}
-	{
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CytologyHistoryReadOKResultsItemDiagnosticMarking) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyHistoryReadOKResultsItemDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyHistoryReadOKResultsItemMaterialType as json.
+func (s CytologyHistoryReadOKResultsItemMaterialType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CytologyHistoryReadOKResultsItemMaterialType from json.
+func (s *CytologyHistoryReadOKResultsItemMaterialType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyHistoryReadOKResultsItemMaterialType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CytologyHistoryReadOKResultsItemMaterialType(v) {
+	case CytologyHistoryReadOKResultsItemMaterialTypeGS:
+		*s = CytologyHistoryReadOKResultsItemMaterialTypeGS
+	case CytologyHistoryReadOKResultsItemMaterialTypeBP:
+		*s = CytologyHistoryReadOKResultsItemMaterialTypeBP
+	case CytologyHistoryReadOKResultsItemMaterialTypeTP:
+		*s = CytologyHistoryReadOKResultsItemMaterialTypeTP
+	case CytologyHistoryReadOKResultsItemMaterialTypePTP:
+		*s = CytologyHistoryReadOKResultsItemMaterialTypePTP
+	case CytologyHistoryReadOKResultsItemMaterialTypeLNP:
+		*s = CytologyHistoryReadOKResultsItemMaterialTypeLNP
+	default:
+		*s = CytologyHistoryReadOKResultsItemMaterialType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CytologyHistoryReadOKResultsItemMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyHistoryReadOKResultsItemMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CytologyPatientShot) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologyPatientShot) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("patient_card")
+		s.PatientCard.Encode(e)
+	}
+	{
+		e.FieldStart("is_last")
+		e.Bool(s.IsLast)
+	}
+	{
+		e.FieldStart("diagnos_date")
+		json.EncodeDateTime(e, s.DiagnosDate)
+	}
+	{
+		e.FieldStart("details")
+		s.Details.Encode(e)
+	}
+	{
 		if s.DiagnosticMarking.Set {
 			e.FieldStart("diagnostic_marking")
 			s.DiagnosticMarking.Encode(e)
@@ -2404,45 +2052,60 @@ func (s *CytologyReadOKInfoImageGroup) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfCytologyReadOKInfoImageGroup = [13]string{
+var jsonFieldsNameOfCytologyPatientShot = [14]string{
 	0:  "id",
-	1:  "is_last",
-	2:  "diagnos_date",
-	3:  "details",
-	4:  "diagnostic_marking",
-	5:  "diagnostic_number",
-	6:  "material_type",
-	7:  "calcitonin",
-	8:  "calcitonin_in_flush",
-	9:  "thyroglobulin",
-	10: "prev",
-	11: "parent_prev",
-	12: "original_image",
+	1:  "patient_card",
+	2:  "is_last",
+	3:  "diagnos_date",
+	4:  "details",
+	5:  "diagnostic_marking",
+	6:  "diagnostic_number",
+	7:  "material_type",
+	8:  "calcitonin",
+	9:  "calcitonin_in_flush",
+	10: "thyroglobulin",
+	11: "prev",
+	12: "parent_prev",
+	13: "original_image",
 }
 
-// Decode decodes CytologyReadOKInfoImageGroup from json.
-func (s *CytologyReadOKInfoImageGroup) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyPatientShot from json.
+func (s *CytologyPatientShot) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyReadOKInfoImageGroup to nil")
+		return errors.New("invalid: unable to decode CytologyPatientShot to nil")
 	}
 	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
 		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
+		case "patient_card":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.PatientCard.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patient_card\"")
+			}
 		case "is_last":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.IsLast.Reset()
-				if err := s.IsLast.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.IsLast = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
@@ -2450,9 +2113,11 @@ func (s *CytologyReadOKInfoImageGroup) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"is_last\"")
 			}
 		case "diagnos_date":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.DiagnosDate.Reset()
-				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.DiagnosDate = v
+				if err != nil {
 					return err
 				}
 				return nil
@@ -2460,13 +2125,11 @@ func (s *CytologyReadOKInfoImageGroup) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"diagnos_date\"")
 			}
 		case "details":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.Details = nil
-				var elem CytologyReadOKInfoImageGroupDetails
-				if err := elem.Decode(d); err != nil {
+				if err := s.Details.Decode(d); err != nil {
 					return err
 				}
-				s.Details = &elem
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"details\"")
@@ -2482,7 +2145,7 @@ func (s *CytologyReadOKInfoImageGroup) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
 			}
 		case "diagnostic_number":
-			requiredBitSet[0] |= 1 << 5
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
 				v, err := d.Int()
 				s.DiagnosticNumber = int(v)
@@ -2568,12 +2231,12 @@ func (s *CytologyReadOKInfoImageGroup) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyReadOKInfoImageGroup")
+		return errors.Wrap(err, "decode CytologyPatientShot")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b00100000,
+		0b01011111,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -2586,8 +2249,8 @@ func (s *CytologyReadOKInfoImageGroup) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyReadOKInfoImageGroup) {
-					name = jsonFieldsNameOfCytologyReadOKInfoImageGroup[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyPatientShot) {
+					name = jsonFieldsNameOfCytologyPatientShot[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2608,454 +2271,499 @@ func (s *CytologyReadOKInfoImageGroup) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyReadOKInfoImageGroup) MarshalJSON() ([]byte, error) {
+func (s *CytologyPatientShot) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyReadOKInfoImageGroup) UnmarshalJSON(data []byte) error {
+func (s *CytologyPatientShot) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *CytologyReadOKInfoImageGroupDetails) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CytologyReadOKInfoImageGroupDetails) encodeFields(e *jx.Encoder) {
+// Encode encodes CytologyPatientShotDiagnosticMarking as json.
+func (s CytologyPatientShotDiagnosticMarking) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-var jsonFieldsNameOfCytologyReadOKInfoImageGroupDetails = [0]string{}
-
-// Decode decodes CytologyReadOKInfoImageGroupDetails from json.
-func (s *CytologyReadOKInfoImageGroupDetails) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyPatientShotDiagnosticMarking from json.
+func (s *CytologyPatientShotDiagnosticMarking) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyReadOKInfoImageGroupDetails to nil")
+		return errors.New("invalid: unable to decode CytologyPatientShotDiagnosticMarking to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
-		}
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologyReadOKInfoImageGroupDetails")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CytologyPatientShotDiagnosticMarking(v) {
+	case CytologyPatientShotDiagnosticMarking11:
+		*s = CytologyPatientShotDiagnosticMarking11
+	case CytologyPatientShotDiagnosticMarking23:
+		*s = CytologyPatientShotDiagnosticMarking23
+	default:
+		*s = CytologyPatientShotDiagnosticMarking(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyReadOKInfoImageGroupDetails) MarshalJSON() ([]byte, error) {
+func (s CytologyPatientShotDiagnosticMarking) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyReadOKInfoImageGroupDetails) UnmarshalJSON(data []byte) error {
+func (s *CytologyPatientShotDiagnosticMarking) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyReadOKInfoImageGroupDiagnosticMarking as json.
-func (s CytologyReadOKInfoImageGroupDiagnosticMarking) Encode(e *jx.Encoder) {
+// Encode encodes CytologyPatientShotMaterialType as json.
+func (s CytologyPatientShotMaterialType) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes CytologyReadOKInfoImageGroupDiagnosticMarking from json.
-func (s *CytologyReadOKInfoImageGroupDiagnosticMarking) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyPatientShotMaterialType from json.
+func (s *CytologyPatientShotMaterialType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyReadOKInfoImageGroupDiagnosticMarking to nil")
+		return errors.New("invalid: unable to decode CytologyPatientShotMaterialType to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch CytologyReadOKInfoImageGroupDiagnosticMarking(v) {
-	case CytologyReadOKInfoImageGroupDiagnosticMarking11:
-		*s = CytologyReadOKInfoImageGroupDiagnosticMarking11
-	case CytologyReadOKInfoImageGroupDiagnosticMarking23:
-		*s = CytologyReadOKInfoImageGroupDiagnosticMarking23
+	switch CytologyPatientShotMaterialType(v) {
+	case CytologyPatientShotMaterialTypeGS:
+		*s = CytologyPatientShotMaterialTypeGS
+	case CytologyPatientShotMaterialTypeBP:
+		*s = CytologyPatientShotMaterialTypeBP
+	case CytologyPatientShotMaterialTypeTP:
+		*s = CytologyPatientShotMaterialTypeTP
+	case CytologyPatientShotMaterialTypePTP:
+		*s = CytologyPatientShotMaterialTypePTP
+	case CytologyPatientShotMaterialTypeLNP:
+		*s = CytologyPatientShotMaterialTypeLNP
 	default:
-		*s = CytologyReadOKInfoImageGroupDiagnosticMarking(v)
+		*s = CytologyPatientShotMaterialType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologyReadOKInfoImageGroupDiagnosticMarking) MarshalJSON() ([]byte, error) {
+func (s CytologyPatientShotMaterialType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyReadOKInfoImageGroupDiagnosticMarking) UnmarshalJSON(data []byte) error {
+func (s *CytologyPatientShotMaterialType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyReadOKInfoImageGroupMaterialType as json.
-func (s CytologyReadOKInfoImageGroupMaterialType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes CytologyReadOKInfoImageGroupMaterialType from json.
-func (s *CytologyReadOKInfoImageGroupMaterialType) Decode(d *jx.Decoder) error {
+// Encode implements json.Marshaler.
+func (s *CytologyPatientShotsReadOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologyPatientShotsReadOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("patient")
+		s.Patient.Encode(e)
+	}
+	{
+		e.FieldStart("shots")
+		e.ArrStart()
+		for _, elem := range s.Shots {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfCytologyPatientShotsReadOK = [2]string{
+	0: "patient",
+	1: "shots",
+}
+
+// Decode decodes CytologyPatientShotsReadOK from json.
+func (s *CytologyPatientShotsReadOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyReadOKInfoImageGroupMaterialType to nil")
+		return errors.New("invalid: unable to decode CytologyPatientShotsReadOK to nil")
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "patient":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Patient.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patient\"")
+			}
+		case "shots":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Shots = make([]CytologyPatientShot, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CytologyPatientShot
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Shots = append(s.Shots, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"shots\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologyPatientShotsReadOK")
 	}
-	// Try to use constant string.
-	switch CytologyReadOKInfoImageGroupMaterialType(v) {
-	case CytologyReadOKInfoImageGroupMaterialTypeGS:
-		*s = CytologyReadOKInfoImageGroupMaterialTypeGS
-	case CytologyReadOKInfoImageGroupMaterialTypeBP:
-		*s = CytologyReadOKInfoImageGroupMaterialTypeBP
-	case CytologyReadOKInfoImageGroupMaterialTypeTP:
-		*s = CytologyReadOKInfoImageGroupMaterialTypeTP
-	case CytologyReadOKInfoImageGroupMaterialTypePTP:
-		*s = CytologyReadOKInfoImageGroupMaterialTypePTP
-	case CytologyReadOKInfoImageGroupMaterialTypeLNP:
-		*s = CytologyReadOKInfoImageGroupMaterialTypeLNP
-	default:
-		*s = CytologyReadOKInfoImageGroupMaterialType(v)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologyPatientShotsReadOK) {
+					name = jsonFieldsNameOfCytologyPatientShotsReadOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologyReadOKInfoImageGroupMaterialType) MarshalJSON() ([]byte, error) {
+func (s *CytologyPatientShotsReadOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyReadOKInfoImageGroupMaterialType) UnmarshalJSON(data []byte) error {
+func (s *CytologyPatientShotsReadOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyReadOKOriginalImage) Encode(e *jx.Encoder) {
+func (s *CytologyQupathImport) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyReadOKOriginalImage) encodeFields(e *jx.Encoder) {
+func (s *CytologyQupathImport) encodeFields(e *jx.Encoder) {
 	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
 	{
-		if s.CreateDate.Set {
-			e.FieldStart("create_date")
-			s.CreateDate.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("added")
+		e.Int(s.Added)
 	}
 	{
-		if s.DelayTime.Set {
-			e.FieldStart("delay_time")
-			s.DelayTime.Encode(e)
-		}
+		e.FieldStart("removed")
+		e.Int(s.Removed)
 	}
 	{
-		if s.ViewedFlag.Set {
-			e.FieldStart("viewed_flag")
-			s.ViewedFlag.Encode(e)
-		}
+		e.FieldStart("changed")
+		e.Int(s.Changed)
 	}
 	{
-		if s.Image.Set {
-			e.FieldStart("image")
-			s.Image.Encode(e)
-		}
+		e.FieldStart("unchanged")
+		e.Int(s.Unchanged)
 	}
 	{
-		if s.FilePath.Set {
-			e.FieldStart("file_path")
-			s.FilePath.Encode(e)
+		e.FieldStart("skipped")
+		e.Int(s.Skipped)
+	}
+	{
+		e.FieldStart("unknown_classes")
+		e.ArrStart()
+		for _, elem := range s.UnknownClasses {
+			e.Str(elem)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfCytologyReadOKOriginalImage = [6]string{
+var jsonFieldsNameOfCytologyQupathImport = [7]string{
 	0: "id",
-	1: "create_date",
-	2: "delay_time",
-	3: "viewed_flag",
-	4: "image",
-	5: "file_path",
+	1: "added",
+	2: "removed",
+	3: "changed",
+	4: "unchanged",
+	5: "skipped",
+	6: "unknown_classes",
 }
 
-// Decode decodes CytologyReadOKOriginalImage from json.
-func (s *CytologyReadOKOriginalImage) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyQupathImport from json.
+func (s *CytologyQupathImport) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyReadOKOriginalImage to nil")
+		return errors.New("invalid: unable to decode CytologyQupathImport to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
 		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "create_date":
+		case "added":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.CreateDate.Reset()
-				if err := s.CreateDate.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Int()
+				s.Added = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"create_date\"")
+				return errors.Wrap(err, "decode field \"added\"")
 			}
-		case "delay_time":
+		case "removed":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.DelayTime.Reset()
-				if err := s.DelayTime.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Removed = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"delay_time\"")
+				return errors.Wrap(err, "decode field \"removed\"")
 			}
-		case "viewed_flag":
+		case "changed":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.ViewedFlag.Reset()
-				if err := s.ViewedFlag.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Changed = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"viewed_flag\"")
+				return errors.Wrap(err, "decode field \"changed\"")
 			}
-		case "image":
+		case "unchanged":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.Image.Reset()
-				if err := s.Image.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Unchanged = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"image\"")
+				return errors.Wrap(err, "decode field \"unchanged\"")
 			}
-		case "file_path":
+		case "skipped":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.FilePath.Reset()
-				if err := s.FilePath.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Skipped = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"file_path\"")
+				return errors.Wrap(err, "decode field \"skipped\"")
+			}
+		case "unknown_classes":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				s.UnknownClasses = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.UnknownClasses = append(s.UnknownClasses, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unknown_classes\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyReadOKOriginalImage")
+		return errors.Wrap(err, "decode CytologyQupathImport")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologyQupathImport) {
+					name = jsonFieldsNameOfCytologyQupathImport[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyReadOKOriginalImage) MarshalJSON() ([]byte, error) {
+func (s *CytologyQupathImport) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyReadOKOriginalImage) UnmarshalJSON(data []byte) error {
+func (s *CytologyQupathImport) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentGroupCreateCreateCreated) Encode(e *jx.Encoder) {
+func (s *CytologyReadOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentGroupCreateCreateCreated) encodeFields(e *jx.Encoder) {
+func (s *CytologyReadOK) encodeFields(e *jx.Encoder) {
 	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Data.Set {
-			e.FieldStart("data")
-			s.Data.Encode(e)
-		}
+		e.FieldStart("original_image")
+		s.OriginalImage.Encode(e)
 	}
 	{
-		if s.SegType.Set {
-			e.FieldStart("seg_type")
-			s.SegType.Encode(e)
-		}
+		e.FieldStart("info")
+		s.Info.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfCytologySegmentGroupCreateCreateCreated = [3]string{
-	0: "id",
-	1: "data",
-	2: "seg_type",
+var jsonFieldsNameOfCytologyReadOK = [2]string{
+	0: "original_image",
+	1: "info",
 }
 
-// Decode decodes CytologySegmentGroupCreateCreateCreated from json.
-func (s *CytologySegmentGroupCreateCreateCreated) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyReadOK from json.
+func (s *CytologyReadOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateCreated to nil")
+		return errors.New("invalid: unable to decode CytologyReadOK to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "data":
-			if err := func() error {
-				s.Data.Reset()
-				if err := s.Data.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
-			}
-		case "seg_type":
+		case "original_image":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.SegType.Reset()
-				if err := s.SegType.Decode(d); err != nil {
+				if err := s.OriginalImage.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"seg_type\"")
+				return errors.Wrap(err, "decode field \"original_image\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateCreated")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentGroupCreateCreateCreated) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentGroupCreateCreateCreated) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CytologySegmentGroupCreateCreateCreatedData) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CytologySegmentGroupCreateCreateCreatedData) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("points")
-		e.ArrStart()
-		for _, elem := range s.Points {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
-}
-
-var jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedData = [1]string{
-	0: "points",
-}
-
-// Decode decodes CytologySegmentGroupCreateCreateCreatedData from json.
-func (s *CytologySegmentGroupCreateCreateCreatedData) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateCreatedData to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "points":
-			requiredBitSet[0] |= 1 << 0
+		case "info":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Points = make([]CytologySegmentGroupCreateCreateCreatedDataPointsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentGroupCreateCreateCreatedDataPointsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Points = append(s.Points, elem)
-					return nil
-				}); err != nil {
+				if err := s.Info.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"points\"")
+				return errors.Wrap(err, "decode field \"info\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateCreatedData")
+		return errors.Wrap(err, "decode CytologyReadOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3067,8 +2775,8 @@ func (s *CytologySegmentGroupCreateCreateCreatedData) Decode(d *jx.Decoder) erro
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedData) {
-					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedData[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyReadOK) {
+					name = jsonFieldsNameOfCytologyReadOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3089,86 +2797,97 @@ func (s *CytologySegmentGroupCreateCreateCreatedData) Decode(d *jx.Decoder) erro
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentGroupCreateCreateCreatedData) MarshalJSON() ([]byte, error) {
+func (s *CytologyReadOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentGroupCreateCreateCreatedData) UnmarshalJSON(data []byte) error {
+func (s *CytologyReadOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) Encode(e *jx.Encoder) {
+func (s *CytologyReadOKInfo) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) encodeFields(e *jx.Encoder) {
+func (s *CytologyReadOKInfo) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("x")
-		e.Int(s.X)
+		e.FieldStart("patient")
+		s.Patient.Encode(e)
 	}
 	{
-		e.FieldStart("y")
-		e.Int(s.Y)
+		e.FieldStart("patient_card")
+		s.PatientCard.Encode(e)
+	}
+	{
+		e.FieldStart("image_group")
+		s.ImageGroup.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedDataPointsItem = [2]string{
-	0: "x",
-	1: "y",
+var jsonFieldsNameOfCytologyReadOKInfo = [3]string{
+	0: "patient",
+	1: "patient_card",
+	2: "image_group",
 }
 
-// Decode decodes CytologySegmentGroupCreateCreateCreatedDataPointsItem from json.
-func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyReadOKInfo from json.
+func (s *CytologyReadOKInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateCreatedDataPointsItem to nil")
+		return errors.New("invalid: unable to decode CytologyReadOKInfo to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "x":
+		case "patient":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.X = int(v)
-				if err != nil {
+				if err := s.Patient.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"x\"")
+				return errors.Wrap(err, "decode field \"patient\"")
 			}
-		case "y":
+		case "patient_card":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.Y = int(v)
-				if err != nil {
+				if err := s.PatientCard.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"y\"")
+				return errors.Wrap(err, "decode field \"patient_card\"")
+			}
+		case "image_group":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.ImageGroup.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"image_group\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateCreatedDataPointsItem")
+		return errors.Wrap(err, "decode CytologyReadOKInfo")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3180,8 +2899,8 @@ func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) Decode(d *jx.Dec
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedDataPointsItem) {
-					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedDataPointsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyReadOKInfo) {
+					name = jsonFieldsNameOfCytologyReadOKInfo[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3202,82 +2921,276 @@ func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) Decode(d *jx.Dec
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) MarshalJSON() ([]byte, error) {
+func (s *CytologyReadOKInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologyReadOKInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentGroupCreateCreateReq) Encode(e *jx.Encoder) {
+func (s *CytologyReadOKInfoImageGroup) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentGroupCreateCreateReq) encodeFields(e *jx.Encoder) {
+func (s *CytologyReadOKInfoImageGroup) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("data")
-		s.Data.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("seg_type")
-		s.SegType.Encode(e)
+		if s.IsLast.Set {
+			e.FieldStart("is_last")
+			s.IsLast.Encode(e)
+		}
 	}
-}
-
-var jsonFieldsNameOfCytologySegmentGroupCreateCreateReq = [2]string{
-	0: "data",
-	1: "seg_type",
-}
-
-// Decode decodes CytologySegmentGroupCreateCreateReq from json.
-func (s *CytologySegmentGroupCreateCreateReq) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateReq to nil")
+	{
+		if s.DiagnosDate.Set {
+			e.FieldStart("diagnos_date")
+			s.DiagnosDate.Encode(e, json.EncodeDateTime)
+		}
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "data":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				if err := s.Data.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
-			}
-		case "seg_type":
-			requiredBitSet[0] |= 1 << 1
+	{
+		if s.Details != nil {
+			e.FieldStart("details")
+			s.Details.Encode(e)
+		}
+	}
+	{
+		if s.DiagnosticMarking.Set {
+			e.FieldStart("diagnostic_marking")
+			s.DiagnosticMarking.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("diagnostic_number")
+		e.Int(s.DiagnosticNumber)
+	}
+	{
+		if s.MaterialType.Set {
+			e.FieldStart("material_type")
+			s.MaterialType.Encode(e)
+		}
+	}
+	{
+		if s.Calcitonin.Set {
+			e.FieldStart("calcitonin")
+			s.Calcitonin.Encode(e)
+		}
+	}
+	{
+		if s.CalcitoninInFlush.Set {
+			e.FieldStart("calcitonin_in_flush")
+			s.CalcitoninInFlush.Encode(e)
+		}
+	}
+	{
+		if s.Thyroglobulin.Set {
+			e.FieldStart("thyroglobulin")
+			s.Thyroglobulin.Encode(e)
+		}
+	}
+	{
+		if s.Prev.Set {
+			e.FieldStart("prev")
+			s.Prev.Encode(e)
+		}
+	}
+	{
+		if s.ParentPrev.Set {
+			e.FieldStart("parent_prev")
+			s.ParentPrev.Encode(e)
+		}
+	}
+	{
+		if s.OriginalImage.Set {
+			e.FieldStart("original_image")
+			s.OriginalImage.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCytologyReadOKInfoImageGroup = [13]string{
+	0:  "id",
+	1:  "is_last",
+	2:  "diagnos_date",
+	3:  "details",
+	4:  "diagnostic_marking",
+	5:  "diagnostic_number",
+	6:  "material_type",
+	7:  "calcitonin",
+	8:  "calcitonin_in_flush",
+	9:  "thyroglobulin",
+	10: "prev",
+	11: "parent_prev",
+	12: "original_image",
+}
+
+// Decode decodes CytologyReadOKInfoImageGroup from json.
+func (s *CytologyReadOKInfoImageGroup) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyReadOKInfoImageGroup to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
 			if err := func() error {
-				if err := s.SegType.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"seg_type\"")
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "is_last":
+			if err := func() error {
+				s.IsLast.Reset()
+				if err := s.IsLast.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_last\"")
+			}
+		case "diagnos_date":
+			if err := func() error {
+				s.DiagnosDate.Reset()
+				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnos_date\"")
+			}
+		case "details":
+			if err := func() error {
+				s.Details = nil
+				var elem CytologyReadOKInfoImageGroupDetails
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.Details = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"details\"")
+			}
+		case "diagnostic_marking":
+			if err := func() error {
+				s.DiagnosticMarking.Reset()
+				if err := s.DiagnosticMarking.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
+			}
+		case "diagnostic_number":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.DiagnosticNumber = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnostic_number\"")
+			}
+		case "material_type":
+			if err := func() error {
+				s.MaterialType.Reset()
+				if err := s.MaterialType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"material_type\"")
+			}
+		case "calcitonin":
+			if err := func() error {
+				s.Calcitonin.Reset()
+				if err := s.Calcitonin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"calcitonin\"")
+			}
+		case "calcitonin_in_flush":
+			if err := func() error {
+				s.CalcitoninInFlush.Reset()
+				if err := s.CalcitoninInFlush.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
+			}
+		case "thyroglobulin":
+			if err := func() error {
+				s.Thyroglobulin.Reset()
+				if err := s.Thyroglobulin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"thyroglobulin\"")
+			}
+		case "prev":
+			if err := func() error {
+				s.Prev.Reset()
+				if err := s.Prev.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"prev\"")
+			}
+		case "parent_prev":
+			if err := func() error {
+				s.ParentPrev.Reset()
+				if err := s.ParentPrev.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parent_prev\"")
+			}
+		case "original_image":
+			if err := func() error {
+				s.OriginalImage.Reset()
+				if err := s.OriginalImage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"original_image\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateReq")
+		return errors.Wrap(err, "decode CytologyReadOKInfoImageGroup")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b00100000,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3289,8 +3202,8 @@ func (s *CytologySegmentGroupCreateCreateReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateReq) {
-					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyReadOKInfoImageGroup) {
+					name = jsonFieldsNameOfCytologyReadOKInfoImageGroup[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3311,550 +3224,402 @@ func (s *CytologySegmentGroupCreateCreateReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentGroupCreateCreateReq) MarshalJSON() ([]byte, error) {
+func (s *CytologyReadOKInfoImageGroup) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentGroupCreateCreateReq) UnmarshalJSON(data []byte) error {
+func (s *CytologyReadOKInfoImageGroup) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentGroupCreateCreateReqData) Encode(e *jx.Encoder) {
+func (s *CytologyReadOKInfoImageGroupDetails) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentGroupCreateCreateReqData) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("points")
-		e.ArrStart()
-		for _, elem := range s.Points {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
+func (s *CytologyReadOKInfoImageGroupDetails) encodeFields(e *jx.Encoder) {
 }
 
-var jsonFieldsNameOfCytologySegmentGroupCreateCreateReqData = [1]string{
-	0: "points",
-}
+var jsonFieldsNameOfCytologyReadOKInfoImageGroupDetails = [0]string{}
 
-// Decode decodes CytologySegmentGroupCreateCreateReqData from json.
-func (s *CytologySegmentGroupCreateCreateReqData) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyReadOKInfoImageGroupDetails from json.
+func (s *CytologyReadOKInfoImageGroupDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateReqData to nil")
+		return errors.New("invalid: unable to decode CytologyReadOKInfoImageGroupDetails to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "points":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Points = make([]CytologySegmentGroupCreateCreateReqDataPointsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentGroupCreateCreateReqDataPointsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Points = append(s.Points, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"points\"")
-			}
 		default:
 			return d.Skip()
 		}
-		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateReqData")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateReqData) {
-					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateReqData[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode CytologyReadOKInfoImageGroupDetails")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentGroupCreateCreateReqData) MarshalJSON() ([]byte, error) {
+func (s *CytologyReadOKInfoImageGroupDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentGroupCreateCreateReqData) UnmarshalJSON(data []byte) error {
+func (s *CytologyReadOKInfoImageGroupDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("x")
-		e.Int(s.X)
-	}
-	{
-		e.FieldStart("y")
-		e.Int(s.Y)
-	}
-}
-
-var jsonFieldsNameOfCytologySegmentGroupCreateCreateReqDataPointsItem = [2]string{
-	0: "x",
-	1: "y",
+// Encode encodes CytologyReadOKInfoImageGroupDiagnosticMarking as json.
+func (s CytologyReadOKInfoImageGroupDiagnosticMarking) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes CytologySegmentGroupCreateCreateReqDataPointsItem from json.
-func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyReadOKInfoImageGroupDiagnosticMarking from json.
+func (s *CytologyReadOKInfoImageGroupDiagnosticMarking) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateReqDataPointsItem to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "x":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.X = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"x\"")
-			}
-		case "y":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.Y = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"y\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateReqDataPointsItem")
+		return errors.New("invalid: unable to decode CytologyReadOKInfoImageGroupDiagnosticMarking to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateReqDataPointsItem) {
-					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateReqDataPointsItem[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	// Try to use constant string.
+	switch CytologyReadOKInfoImageGroupDiagnosticMarking(v) {
+	case CytologyReadOKInfoImageGroupDiagnosticMarking11:
+		*s = CytologyReadOKInfoImageGroupDiagnosticMarking11
+	case CytologyReadOKInfoImageGroupDiagnosticMarking23:
+		*s = CytologyReadOKInfoImageGroupDiagnosticMarking23
+	default:
+		*s = CytologyReadOKInfoImageGroupDiagnosticMarking(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) MarshalJSON() ([]byte, error) {
+func (s CytologyReadOKInfoImageGroupDiagnosticMarking) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologyReadOKInfoImageGroupDiagnosticMarking) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologySegmentGroupCreateCreateReqSegType as json.
-func (s CytologySegmentGroupCreateCreateReqSegType) Encode(e *jx.Encoder) {
+// Encode encodes CytologyReadOKInfoImageGroupMaterialType as json.
+func (s CytologyReadOKInfoImageGroupMaterialType) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes CytologySegmentGroupCreateCreateReqSegType from json.
-func (s *CytologySegmentGroupCreateCreateReqSegType) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyReadOKInfoImageGroupMaterialType from json.
+func (s *CytologyReadOKInfoImageGroupMaterialType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateReqSegType to nil")
+		return errors.New("invalid: unable to decode CytologyReadOKInfoImageGroupMaterialType to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch CytologySegmentGroupCreateCreateReqSegType(v) {
-	case CytologySegmentGroupCreateCreateReqSegTypeNIL:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeNIL
-	case CytologySegmentGroupCreateCreateReqSegTypeNIR:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeNIR
-	case CytologySegmentGroupCreateCreateReqSegTypeNIM:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeNIM
-	case CytologySegmentGroupCreateCreateReqSegTypeCNO:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeCNO
-	case CytologySegmentGroupCreateCreateReqSegTypeCGE:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeCGE
-	case CytologySegmentGroupCreateCreateReqSegTypeC2N:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeC2N
-	case CytologySegmentGroupCreateCreateReqSegTypeCPS:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeCPS
-	case CytologySegmentGroupCreateCreateReqSegTypeCFC:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeCFC
-	case CytologySegmentGroupCreateCreateReqSegTypeCLY:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeCLY
-	case CytologySegmentGroupCreateCreateReqSegTypeSOS:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeSOS
-	case CytologySegmentGroupCreateCreateReqSegTypeSDS:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeSDS
-	case CytologySegmentGroupCreateCreateReqSegTypeSMS:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeSMS
-	case CytologySegmentGroupCreateCreateReqSegTypeSTS:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeSTS
-	case CytologySegmentGroupCreateCreateReqSegTypeSPS:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeSPS
-	case CytologySegmentGroupCreateCreateReqSegTypeSNM:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeSNM
-	case CytologySegmentGroupCreateCreateReqSegTypeSTM:
-		*s = CytologySegmentGroupCreateCreateReqSegTypeSTM
+	switch CytologyReadOKInfoImageGroupMaterialType(v) {
+	case CytologyReadOKInfoImageGroupMaterialTypeGS:
+		*s = CytologyReadOKInfoImageGroupMaterialTypeGS
+	case CytologyReadOKInfoImageGroupMaterialTypeBP:
+		*s = CytologyReadOKInfoImageGroupMaterialTypeBP
+	case CytologyReadOKInfoImageGroupMaterialTypeTP:
+		*s = CytologyReadOKInfoImageGroupMaterialTypeTP
+	case CytologyReadOKInfoImageGroupMaterialTypePTP:
+		*s = CytologyReadOKInfoImageGroupMaterialTypePTP
+	case CytologyReadOKInfoImageGroupMaterialTypeLNP:
+		*s = CytologyReadOKInfoImageGroupMaterialTypeLNP
 	default:
-		*s = CytologySegmentGroupCreateCreateReqSegType(v)
+		*s = CytologyReadOKInfoImageGroupMaterialType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologySegmentGroupCreateCreateReqSegType) MarshalJSON() ([]byte, error) {
+func (s CytologyReadOKInfoImageGroupMaterialType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentGroupCreateCreateReqSegType) UnmarshalJSON(data []byte) error {
+func (s *CytologyReadOKInfoImageGroupMaterialType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdatePartialUpdateOK) Encode(e *jx.Encoder) {
+func (s *CytologyReadOKOriginalImage) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentUpdatePartialUpdateOK) encodeFields(e *jx.Encoder) {
+func (s *CytologyReadOKOriginalImage) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("points")
-		e.ArrStart()
-		for _, elem := range s.Points {
-			elem.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
-		e.ArrEnd()
 	}
 	{
-		if s.SegmentGroup.Set {
-			e.FieldStart("segment_group")
-			s.SegmentGroup.Encode(e)
+		if s.CreateDate.Set {
+			e.FieldStart("create_date")
+			s.CreateDate.Encode(e, json.EncodeDateTime)
 		}
 	}
-}
-
-var jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOK = [2]string{
-	0: "points",
-	1: "segment_group",
-}
-
-// Decode decodes CytologySegmentUpdatePartialUpdateOK from json.
-func (s *CytologySegmentUpdatePartialUpdateOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdatePartialUpdateOK to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+	{
+		if s.DelayTime.Set {
+			e.FieldStart("delay_time")
+			s.DelayTime.Encode(e)
+		}
+	}
+	{
+		if s.ViewedFlag.Set {
+			e.FieldStart("viewed_flag")
+			s.ViewedFlag.Encode(e)
+		}
+	}
+	{
+		if s.Image.Set {
+			e.FieldStart("image")
+			s.Image.Encode(e)
+		}
+	}
+	{
+		if s.FilePath.Set {
+			e.FieldStart("file_path")
+			s.FilePath.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCytologyReadOKOriginalImage = [6]string{
+	0: "id",
+	1: "create_date",
+	2: "delay_time",
+	3: "viewed_flag",
+	4: "image",
+	5: "file_path",
+}
+
+// Decode decodes CytologyReadOKOriginalImage from json.
+func (s *CytologyReadOKOriginalImage) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyReadOKOriginalImage to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "points":
-			requiredBitSet[0] |= 1 << 0
+		case "id":
 			if err := func() error {
-				s.Points = make([]CytologySegmentUpdatePartialUpdateOKPointsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentUpdatePartialUpdateOKPointsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Points = append(s.Points, elem)
-					return nil
-				}); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"points\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "segment_group":
+		case "create_date":
 			if err := func() error {
-				s.SegmentGroup.Reset()
-				if err := s.SegmentGroup.Decode(d); err != nil {
+				s.CreateDate.Reset()
+				if err := s.CreateDate.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"segment_group\"")
+				return errors.Wrap(err, "decode field \"create_date\"")
+			}
+		case "delay_time":
+			if err := func() error {
+				s.DelayTime.Reset()
+				if err := s.DelayTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"delay_time\"")
+			}
+		case "viewed_flag":
+			if err := func() error {
+				s.ViewedFlag.Reset()
+				if err := s.ViewedFlag.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"viewed_flag\"")
+			}
+		case "image":
+			if err := func() error {
+				s.Image.Reset()
+				if err := s.Image.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"image\"")
+			}
+		case "file_path":
+			if err := func() error {
+				s.FilePath.Reset()
+				if err := s.FilePath.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"file_path\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdatePartialUpdateOK")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOK) {
-					name = jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode CytologyReadOKOriginalImage")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdatePartialUpdateOK) MarshalJSON() ([]byte, error) {
+func (s *CytologyReadOKOriginalImage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdatePartialUpdateOK) UnmarshalJSON(data []byte) error {
+func (s *CytologyReadOKOriginalImage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) Encode(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateCreated) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateCreated) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("x")
-		e.Int(s.X)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("y")
-		e.Int(s.Y)
+		if s.Data.Set {
+			e.FieldStart("data")
+			s.Data.Encode(e)
+		}
+	}
+	{
+		if s.SegType.Set {
+			e.FieldStart("seg_type")
+			s.SegType.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOKPointsItem = [2]string{
-	0: "x",
-	1: "y",
+var jsonFieldsNameOfCytologySegmentGroupCreateCreateCreated = [3]string{
+	0: "id",
+	1: "data",
+	2: "seg_type",
 }
 
-// Decode decodes CytologySegmentUpdatePartialUpdateOKPointsItem from json.
-func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentGroupCreateCreateCreated from json.
+func (s *CytologySegmentGroupCreateCreateCreated) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdatePartialUpdateOKPointsItem to nil")
+		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateCreated to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "x":
-			requiredBitSet[0] |= 1 << 0
+		case "id":
 			if err := func() error {
-				v, err := d.Int()
-				s.X = int(v)
-				if err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"x\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "y":
-			requiredBitSet[0] |= 1 << 1
+		case "data":
 			if err := func() error {
-				v, err := d.Int()
-				s.Y = int(v)
-				if err != nil {
+				s.Data.Reset()
+				if err := s.Data.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"y\"")
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		case "seg_type":
+			if err := func() error {
+				s.SegType.Reset()
+				if err := s.SegType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"seg_type\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdatePartialUpdateOKPointsItem")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOKPointsItem) {
-					name = jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOKPointsItem[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateCreated")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentGroupCreateCreateCreated) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentGroupCreateCreateCreated) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdatePartialUpdateReq) Encode(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateCreatedData) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentUpdatePartialUpdateReq) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateCreatedData) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("points")
 		e.ArrStart()
@@ -3863,23 +3628,16 @@ func (s *CytologySegmentUpdatePartialUpdateReq) encodeFields(e *jx.Encoder) {
 		}
 		e.ArrEnd()
 	}
-	{
-		if s.SegmentGroup.Set {
-			e.FieldStart("segment_group")
-			s.SegmentGroup.Encode(e)
-		}
-	}
 }
 
-var jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReq = [2]string{
+var jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedData = [1]string{
 	0: "points",
-	1: "segment_group",
 }
 
-// Decode decodes CytologySegmentUpdatePartialUpdateReq from json.
-func (s *CytologySegmentUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentGroupCreateCreateCreatedData from json.
+func (s *CytologySegmentGroupCreateCreateCreatedData) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdatePartialUpdateReq to nil")
+		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateCreatedData to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -3888,9 +3646,9 @@ func (s *CytologySegmentUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
 		case "points":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Points = make([]CytologySegmentUpdatePartialUpdateReqPointsItem, 0)
+				s.Points = make([]CytologySegmentGroupCreateCreateCreatedDataPointsItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentUpdatePartialUpdateReqPointsItem
+					var elem CytologySegmentGroupCreateCreateCreatedDataPointsItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3903,22 +3661,12 @@ func (s *CytologySegmentUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"points\"")
 			}
-		case "segment_group":
-			if err := func() error {
-				s.SegmentGroup.Reset()
-				if err := s.SegmentGroup.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"segment_group\"")
-			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdatePartialUpdateReq")
+		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateCreatedData")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3935,8 +3683,8 @@ func (s *CytologySegmentUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReq) {
-					name = jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedData) {
+					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedData[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3957,27 +3705,27 @@ func (s *CytologySegmentUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdatePartialUpdateReq) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentGroupCreateCreateCreatedData) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdatePartialUpdateReq) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentGroupCreateCreateCreatedData) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) Encode(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("x")
 		e.Int(s.X)
@@ -3986,17 +3734,31 @@ func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) encodeFields(e *jx.Enc
 		e.FieldStart("y")
 		e.Int(s.Y)
 	}
+	{
+		if s.Polygon.Set {
+			e.FieldStart("polygon")
+			s.Polygon.Encode(e)
+		}
+	}
+	{
+		if s.Ring.Set {
+			e.FieldStart("ring")
+			s.Ring.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReqPointsItem = [2]string{
+var jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedDataPointsItem = [4]string{
 	0: "x",
 	1: "y",
+	2: "polygon",
+	3: "ring",
 }
 
-// Decode decodes CytologySegmentUpdatePartialUpdateReqPointsItem from json.
-func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentGroupCreateCreateCreatedDataPointsItem from json.
+func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdatePartialUpdateReqPointsItem to nil")
+		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateCreatedDataPointsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -4026,12 +3788,32 @@ func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) Decode(d *jx.Decoder)
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"y\"")
 			}
+		case "polygon":
+			if err := func() error {
+				s.Polygon.Reset()
+				if err := s.Polygon.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"polygon\"")
+			}
+		case "ring":
+			if err := func() error {
+				s.Ring.Reset()
+				if err := s.Ring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ring\"")
+			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdatePartialUpdateReqPointsItem")
+		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateCreatedDataPointsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -4048,8 +3830,8 @@ func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) Decode(d *jx.Decoder)
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReqPointsItem) {
-					name = jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReqPointsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedDataPointsItem) {
+					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateCreatedDataPointsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4070,96 +3852,82 @@ func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) Decode(d *jx.Decoder)
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdateReadOK) Encode(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentUpdateReadOK) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("points")
-		e.ArrStart()
-		for _, elem := range s.Points {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("data")
+		s.Data.Encode(e)
 	}
 	{
-		if s.SegmentGroup.Set {
-			e.FieldStart("segment_group")
-			s.SegmentGroup.Encode(e)
-		}
+		e.FieldStart("seg_type")
+		s.SegType.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfCytologySegmentUpdateReadOK = [2]string{
-	0: "points",
-	1: "segment_group",
+var jsonFieldsNameOfCytologySegmentGroupCreateCreateReq = [2]string{
+	0: "data",
+	1: "seg_type",
 }
 
-// Decode decodes CytologySegmentUpdateReadOK from json.
-func (s *CytologySegmentUpdateReadOK) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentGroupCreateCreateReq from json.
+func (s *CytologySegmentGroupCreateCreateReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdateReadOK to nil")
+		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "points":
+		case "data":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Points = make([]CytologySegmentUpdateReadOKPointsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentUpdateReadOKPointsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Points = append(s.Points, elem)
-					return nil
-				}); err != nil {
+				if err := s.Data.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"points\"")
+				return errors.Wrap(err, "decode field \"data\"")
 			}
-		case "segment_group":
+		case "seg_type":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.SegmentGroup.Reset()
-				if err := s.SegmentGroup.Decode(d); err != nil {
+				if err := s.SegType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"segment_group\"")
+				return errors.Wrap(err, "decode field \"seg_type\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdateReadOK")
+		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4171,8 +3939,8 @@ func (s *CytologySegmentUpdateReadOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateReadOK) {
-					name = jsonFieldsNameOfCytologySegmentUpdateReadOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateReq) {
+					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4193,86 +3961,164 @@ func (s *CytologySegmentUpdateReadOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdateReadOK) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentGroupCreateCreateReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdateReadOK) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentGroupCreateCreateReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdateReadOKPointsItem) Encode(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateReqData) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentUpdateReadOKPointsItem) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateReqData) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("x")
-		e.Int(s.X)
+		e.FieldStart("points")
+		e.ArrStart()
+		for _, elem := range s.Points {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("y")
-		e.Int(s.Y)
+		if s.GeometryType.Set {
+			e.FieldStart("geometry_type")
+			s.GeometryType.Encode(e)
+		}
+	}
+	{
+		if s.Color.Set {
+			e.FieldStart("color")
+			s.Color.Encode(e)
+		}
+	}
+	{
+		if s.IsLocked.Set {
+			e.FieldStart("is_locked")
+			s.IsLocked.Encode(e)
+		}
+	}
+	{
+		if s.Confidence.Set {
+			e.FieldStart("confidence")
+			s.Confidence.Encode(e)
+		}
+	}
+	{
+		if s.Properties.Set {
+			e.FieldStart("properties")
+			s.Properties.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfCytologySegmentUpdateReadOKPointsItem = [2]string{
-	0: "x",
-	1: "y",
+var jsonFieldsNameOfCytologySegmentGroupCreateCreateReqData = [6]string{
+	0: "points",
+	1: "geometry_type",
+	2: "color",
+	3: "is_locked",
+	4: "confidence",
+	5: "properties",
 }
 
-// Decode decodes CytologySegmentUpdateReadOKPointsItem from json.
-func (s *CytologySegmentUpdateReadOKPointsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentGroupCreateCreateReqData from json.
+func (s *CytologySegmentGroupCreateCreateReqData) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdateReadOKPointsItem to nil")
+		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateReqData to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "x":
+		case "points":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.X = int(v)
-				if err != nil {
+				s.Points = make([]CytologySegmentGroupCreateCreateReqDataPointsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CytologySegmentGroupCreateCreateReqDataPointsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Points = append(s.Points, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"x\"")
+				return errors.Wrap(err, "decode field \"points\"")
 			}
-		case "y":
-			requiredBitSet[0] |= 1 << 1
+		case "geometry_type":
 			if err := func() error {
-				v, err := d.Int()
-				s.Y = int(v)
-				if err != nil {
+				s.GeometryType.Reset()
+				if err := s.GeometryType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"y\"")
+				return errors.Wrap(err, "decode field \"geometry_type\"")
+			}
+		case "color":
+			if err := func() error {
+				s.Color.Reset()
+				if err := s.Color.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"color\"")
+			}
+		case "is_locked":
+			if err := func() error {
+				s.IsLocked.Reset()
+				if err := s.IsLocked.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_locked\"")
+			}
+		case "confidence":
+			if err := func() error {
+				s.Confidence.Reset()
+				if err := s.Confidence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"confidence\"")
+			}
+		case "properties":
+			if err := func() error {
+				s.Properties.Reset()
+				if err := s.Properties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"properties\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdateReadOKPointsItem")
+		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateReqData")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4284,8 +4130,8 @@ func (s *CytologySegmentUpdateReadOKPointsItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateReadOKPointsItem) {
-					name = jsonFieldsNameOfCytologySegmentUpdateReadOKPointsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateReqData) {
+					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateReqData[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4306,96 +4152,120 @@ func (s *CytologySegmentUpdateReadOKPointsItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdateReadOKPointsItem) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentGroupCreateCreateReqData) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdateReadOKPointsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentGroupCreateCreateReqData) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdateUpdateOK) Encode(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentUpdateUpdateOK) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("points")
-		e.ArrStart()
-		for _, elem := range s.Points {
-			elem.Encode(e)
+		e.FieldStart("x")
+		e.Int(s.X)
+	}
+	{
+		e.FieldStart("y")
+		e.Int(s.Y)
+	}
+	{
+		if s.Polygon.Set {
+			e.FieldStart("polygon")
+			s.Polygon.Encode(e)
 		}
-		e.ArrEnd()
 	}
 	{
-		if s.SegmentGroup.Set {
-			e.FieldStart("segment_group")
-			s.SegmentGroup.Encode(e)
+		if s.Ring.Set {
+			e.FieldStart("ring")
+			s.Ring.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCytologySegmentUpdateUpdateOK = [2]string{
-	0: "points",
-	1: "segment_group",
+var jsonFieldsNameOfCytologySegmentGroupCreateCreateReqDataPointsItem = [4]string{
+	0: "x",
+	1: "y",
+	2: "polygon",
+	3: "ring",
 }
 
-// Decode decodes CytologySegmentUpdateUpdateOK from json.
-func (s *CytologySegmentUpdateUpdateOK) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentGroupCreateCreateReqDataPointsItem from json.
+func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdateUpdateOK to nil")
+		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateReqDataPointsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "points":
+		case "x":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Points = make([]CytologySegmentUpdateUpdateOKPointsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentUpdateUpdateOKPointsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Points = append(s.Points, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Int()
+				s.X = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"points\"")
+				return errors.Wrap(err, "decode field \"x\"")
 			}
-		case "segment_group":
+		case "y":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.SegmentGroup.Reset()
-				if err := s.SegmentGroup.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Y = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"segment_group\"")
+				return errors.Wrap(err, "decode field \"y\"")
+			}
+		case "polygon":
+			if err := func() error {
+				s.Polygon.Reset()
+				if err := s.Polygon.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"polygon\"")
+			}
+		case "ring":
+			if err := func() error {
+				s.Ring.Reset()
+				if err := s.Ring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ring\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdateUpdateOK")
+		return errors.Wrap(err, "decode CytologySegmentGroupCreateCreateReqDataPointsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4407,8 +4277,8 @@ func (s *CytologySegmentUpdateUpdateOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateUpdateOK) {
-					name = jsonFieldsNameOfCytologySegmentUpdateUpdateOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentGroupCreateCreateReqDataPointsItem) {
+					name = jsonFieldsNameOfCytologySegmentGroupCreateCreateReqDataPointsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4429,140 +4299,153 @@ func (s *CytologySegmentUpdateUpdateOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdateUpdateOK) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdateUpdateOK) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologySegmentGroupCreateCreateReqSegType as json.
+func (s CytologySegmentGroupCreateCreateReqSegType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CytologySegmentGroupCreateCreateReqSegType from json.
+func (s *CytologySegmentGroupCreateCreateReqSegType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologySegmentGroupCreateCreateReqSegType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CytologySegmentGroupCreateCreateReqSegType(v) {
+	case CytologySegmentGroupCreateCreateReqSegTypeNIL:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeNIL
+	case CytologySegmentGroupCreateCreateReqSegTypeNIR:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeNIR
+	case CytologySegmentGroupCreateCreateReqSegTypeNIM:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeNIM
+	case CytologySegmentGroupCreateCreateReqSegTypeCNO:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeCNO
+	case CytologySegmentGroupCreateCreateReqSegTypeCGE:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeCGE
+	case CytologySegmentGroupCreateCreateReqSegTypeC2N:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeC2N
+	case CytologySegmentGroupCreateCreateReqSegTypeCPS:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeCPS
+	case CytologySegmentGroupCreateCreateReqSegTypeCFC:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeCFC
+	case CytologySegmentGroupCreateCreateReqSegTypeCLY:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeCLY
+	case CytologySegmentGroupCreateCreateReqSegTypeSOS:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeSOS
+	case CytologySegmentGroupCreateCreateReqSegTypeSDS:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeSDS
+	case CytologySegmentGroupCreateCreateReqSegTypeSMS:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeSMS
+	case CytologySegmentGroupCreateCreateReqSegTypeSTS:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeSTS
+	case CytologySegmentGroupCreateCreateReqSegTypeSPS:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeSPS
+	case CytologySegmentGroupCreateCreateReqSegTypeSNM:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeSNM
+	case CytologySegmentGroupCreateCreateReqSegTypeSTM:
+		*s = CytologySegmentGroupCreateCreateReqSegTypeSTM
+	default:
+		*s = CytologySegmentGroupCreateCreateReqSegType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CytologySegmentGroupCreateCreateReqSegType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologySegmentGroupCreateCreateReqSegType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdateUpdateOKPointsItem) Encode(e *jx.Encoder) {
+func (s CytologySegmentProperties) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *CytologySegmentUpdateUpdateOKPointsItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("x")
-		e.Int(s.X)
-	}
-	{
-		e.FieldStart("y")
-		e.Int(s.Y)
-	}
-}
+// encodeFields implements json.Marshaler.
+func (s CytologySegmentProperties) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
 
-var jsonFieldsNameOfCytologySegmentUpdateUpdateOKPointsItem = [2]string{
-	0: "x",
-	1: "y",
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
 }
 
-// Decode decodes CytologySegmentUpdateUpdateOKPointsItem from json.
-func (s *CytologySegmentUpdateUpdateOKPointsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentProperties from json.
+func (s *CytologySegmentProperties) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdateUpdateOKPointsItem to nil")
+		return errors.New("invalid: unable to decode CytologySegmentProperties to nil")
 	}
-	var requiredBitSet [1]uint8
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "x":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.X = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"x\"")
-			}
-		case "y":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.Y = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"y\"")
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdateUpdateOKPointsItem")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateUpdateOKPointsItem) {
-					name = jsonFieldsNameOfCytologySegmentUpdateUpdateOKPointsItem[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode CytologySegmentProperties")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdateUpdateOKPointsItem) MarshalJSON() ([]byte, error) {
+func (s CytologySegmentProperties) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdateUpdateOKPointsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentProperties) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdateUpdateReq) Encode(e *jx.Encoder) {
+func (s *CytologySegmentUpdatePartialUpdateOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentUpdateUpdateReq) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentUpdatePartialUpdateOK) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("points")
 		e.ArrStart()
@@ -4577,17 +4460,52 @@ func (s *CytologySegmentUpdateUpdateReq) encodeFields(e *jx.Encoder) {
 			s.SegmentGroup.Encode(e)
 		}
 	}
+	{
+		if s.GeometryType.Set {
+			e.FieldStart("geometry_type")
+			s.GeometryType.Encode(e)
+		}
+	}
+	{
+		if s.Color.Set {
+			e.FieldStart("color")
+			s.Color.Encode(e)
+		}
+	}
+	{
+		if s.IsLocked.Set {
+			e.FieldStart("is_locked")
+			s.IsLocked.Encode(e)
+		}
+	}
+	{
+		if s.Confidence.Set {
+			e.FieldStart("confidence")
+			s.Confidence.Encode(e)
+		}
+	}
+	{
+		if s.Properties.Set {
+			e.FieldStart("properties")
+			s.Properties.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCytologySegmentUpdateUpdateReq = [2]string{
+var jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOK = [7]string{
 	0: "points",
 	1: "segment_group",
+	2: "geometry_type",
+	3: "color",
+	4: "is_locked",
+	5: "confidence",
+	6: "properties",
 }
 
-// Decode decodes CytologySegmentUpdateUpdateReq from json.
-func (s *CytologySegmentUpdateUpdateReq) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdatePartialUpdateOK from json.
+func (s *CytologySegmentUpdatePartialUpdateOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdateUpdateReq to nil")
+		return errors.New("invalid: unable to decode CytologySegmentUpdatePartialUpdateOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -4596,9 +4514,9 @@ func (s *CytologySegmentUpdateUpdateReq) Decode(d *jx.Decoder) error {
 		case "points":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Points = make([]CytologySegmentUpdateUpdateReqPointsItem, 0)
+				s.Points = make([]CytologySegmentUpdatePartialUpdateOKPointsItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentUpdateUpdateReqPointsItem
+					var elem CytologySegmentUpdatePartialUpdateOKPointsItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -4621,30 +4539,80 @@ func (s *CytologySegmentUpdateUpdateReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"segment_group\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdateUpdateReq")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateUpdateReq) {
-					name = jsonFieldsNameOfCytologySegmentUpdateUpdateReq[fieldIdx]
+		case "geometry_type":
+			if err := func() error {
+				s.GeometryType.Reset()
+				if err := s.GeometryType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"geometry_type\"")
+			}
+		case "color":
+			if err := func() error {
+				s.Color.Reset()
+				if err := s.Color.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"color\"")
+			}
+		case "is_locked":
+			if err := func() error {
+				s.IsLocked.Reset()
+				if err := s.IsLocked.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_locked\"")
+			}
+		case "confidence":
+			if err := func() error {
+				s.Confidence.Reset()
+				if err := s.Confidence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"confidence\"")
+			}
+		case "properties":
+			if err := func() error {
+				s.Properties.Reset()
+				if err := s.Properties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"properties\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologySegmentUpdatePartialUpdateOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOK) {
+					name = jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4665,27 +4633,27 @@ func (s *CytologySegmentUpdateUpdateReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdateUpdateReq) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdatePartialUpdateOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdateUpdateReq) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdatePartialUpdateOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentUpdateUpdateReqPointsItem) Encode(e *jx.Encoder) {
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentUpdateUpdateReqPointsItem) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("x")
 		e.Int(s.X)
@@ -4694,17 +4662,31 @@ func (s *CytologySegmentUpdateUpdateReqPointsItem) encodeFields(e *jx.Encoder) {
 		e.FieldStart("y")
 		e.Int(s.Y)
 	}
+	{
+		if s.Polygon.Set {
+			e.FieldStart("polygon")
+			s.Polygon.Encode(e)
+		}
+	}
+	{
+		if s.Ring.Set {
+			e.FieldStart("ring")
+			s.Ring.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCytologySegmentUpdateUpdateReqPointsItem = [2]string{
+var jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOKPointsItem = [4]string{
 	0: "x",
 	1: "y",
+	2: "polygon",
+	3: "ring",
 }
 
-// Decode decodes CytologySegmentUpdateUpdateReqPointsItem from json.
-func (s *CytologySegmentUpdateUpdateReqPointsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdatePartialUpdateOKPointsItem from json.
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentUpdateUpdateReqPointsItem to nil")
+		return errors.New("invalid: unable to decode CytologySegmentUpdatePartialUpdateOKPointsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -4734,12 +4716,32 @@ func (s *CytologySegmentUpdateUpdateReqPointsItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"y\"")
 			}
+		case "polygon":
+			if err := func() error {
+				s.Polygon.Reset()
+				if err := s.Polygon.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"polygon\"")
+			}
+		case "ring":
+			if err := func() error {
+				s.Ring.Reset()
+				if err := s.Ring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ring\"")
+			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentUpdateUpdateReqPointsItem")
+		return errors.Wrap(err, "decode CytologySegmentUpdatePartialUpdateOKPointsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -4756,8 +4758,8 @@ func (s *CytologySegmentUpdateUpdateReqPointsItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateUpdateReqPointsItem) {
-					name = jsonFieldsNameOfCytologySegmentUpdateUpdateReqPointsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOKPointsItem) {
+					name = jsonFieldsNameOfCytologySegmentUpdatePartialUpdateOKPointsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4778,130 +4780,181 @@ func (s *CytologySegmentUpdateUpdateReqPointsItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentUpdateUpdateReqPointsItem) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentUpdateUpdateReqPointsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentsListOK) Encode(e *jx.Encoder) {
+func (s *CytologySegmentUpdatePartialUpdateReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentsListOK) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentUpdatePartialUpdateReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("count")
-		e.Int(s.Count)
+		e.FieldStart("points")
+		e.ArrStart()
+		for _, elem := range s.Points {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 	{
-		if s.Next.Set {
-			e.FieldStart("next")
-			s.Next.Encode(e)
+		if s.SegmentGroup.Set {
+			e.FieldStart("segment_group")
+			s.SegmentGroup.Encode(e)
 		}
 	}
 	{
-		if s.Previous.Set {
-			e.FieldStart("previous")
-			s.Previous.Encode(e)
+		if s.GeometryType.Set {
+			e.FieldStart("geometry_type")
+			s.GeometryType.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("results")
-		e.ArrStart()
-		for _, elem := range s.Results {
-			elem.Encode(e)
+		if s.Color.Set {
+			e.FieldStart("color")
+			s.Color.Encode(e)
+		}
+	}
+	{
+		if s.IsLocked.Set {
+			e.FieldStart("is_locked")
+			s.IsLocked.Encode(e)
+		}
+	}
+	{
+		if s.Confidence.Set {
+			e.FieldStart("confidence")
+			s.Confidence.Encode(e)
+		}
+	}
+	{
+		if s.Properties.Set {
+			e.FieldStart("properties")
+			s.Properties.Encode(e)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfCytologySegmentsListOK = [4]string{
-	0: "count",
-	1: "next",
-	2: "previous",
-	3: "results",
+var jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReq = [7]string{
+	0: "points",
+	1: "segment_group",
+	2: "geometry_type",
+	3: "color",
+	4: "is_locked",
+	5: "confidence",
+	6: "properties",
 }
 
-// Decode decodes CytologySegmentsListOK from json.
-func (s *CytologySegmentsListOK) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdatePartialUpdateReq from json.
+func (s *CytologySegmentUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentsListOK to nil")
+		return errors.New("invalid: unable to decode CytologySegmentUpdatePartialUpdateReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "count":
+		case "points":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.Count = int(v)
-				if err != nil {
+				s.Points = make([]CytologySegmentUpdatePartialUpdateReqPointsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CytologySegmentUpdatePartialUpdateReqPointsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Points = append(s.Points, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"count\"")
+				return errors.Wrap(err, "decode field \"points\"")
 			}
-		case "next":
+		case "segment_group":
 			if err := func() error {
-				s.Next.Reset()
-				if err := s.Next.Decode(d); err != nil {
+				s.SegmentGroup.Reset()
+				if err := s.SegmentGroup.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"next\"")
+				return errors.Wrap(err, "decode field \"segment_group\"")
 			}
-		case "previous":
+		case "geometry_type":
 			if err := func() error {
-				s.Previous.Reset()
-				if err := s.Previous.Decode(d); err != nil {
+				s.GeometryType.Reset()
+				if err := s.GeometryType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"previous\"")
+				return errors.Wrap(err, "decode field \"geometry_type\"")
 			}
-		case "results":
-			requiredBitSet[0] |= 1 << 3
+		case "color":
 			if err := func() error {
-				s.Results = make([]CytologySegmentsListOKResultsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentsListOKResultsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Results = append(s.Results, elem)
-					return nil
-				}); err != nil {
+				s.Color.Reset()
+				if err := s.Color.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"results\"")
+				return errors.Wrap(err, "decode field \"color\"")
+			}
+		case "is_locked":
+			if err := func() error {
+				s.IsLocked.Reset()
+				if err := s.IsLocked.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_locked\"")
+			}
+		case "confidence":
+			if err := func() error {
+				s.Confidence.Reset()
+				if err := s.Confidence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"confidence\"")
+			}
+		case "properties":
+			if err := func() error {
+				s.Properties.Reset()
+				if err := s.Properties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"properties\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentsListOK")
+		return errors.Wrap(err, "decode CytologySegmentUpdatePartialUpdateReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001001,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4913,8 +4966,8 @@ func (s *CytologySegmentsListOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentsListOK) {
-					name = jsonFieldsNameOfCytologySegmentsListOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReq) {
+					name = jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4935,166 +4988,120 @@ func (s *CytologySegmentsListOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentsListOK) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdatePartialUpdateReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentsListOK) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdatePartialUpdateReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentsListOKResultsItem) Encode(e *jx.Encoder) {
+func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentsListOKResultsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("data")
-		e.ArrStart()
-		for _, elem := range s.Data {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
+func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.GroupType.Set {
-			e.FieldStart("group_type")
-			s.GroupType.Encode(e)
-		}
+		e.FieldStart("x")
+		e.Int(s.X)
 	}
 	{
-		if s.SegType.Set {
-			e.FieldStart("seg_type")
-			s.SegType.Encode(e)
-		}
+		e.FieldStart("y")
+		e.Int(s.Y)
 	}
 	{
-		if s.Details != nil {
-			e.FieldStart("details")
-			s.Details.Encode(e)
+		if s.Polygon.Set {
+			e.FieldStart("polygon")
+			s.Polygon.Encode(e)
 		}
 	}
 	{
-		if s.IsAi.Set {
-			e.FieldStart("is_ai")
-			s.IsAi.Encode(e)
+		if s.Ring.Set {
+			e.FieldStart("ring")
+			s.Ring.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCytologySegmentsListOKResultsItem = [6]string{
-	0: "id",
-	1: "data",
-	2: "group_type",
-	3: "seg_type",
-	4: "details",
-	5: "is_ai",
+var jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReqPointsItem = [4]string{
+	0: "x",
+	1: "y",
+	2: "polygon",
+	3: "ring",
 }
 
-// Decode decodes CytologySegmentsListOKResultsItem from json.
-func (s *CytologySegmentsListOKResultsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdatePartialUpdateReqPointsItem from json.
+func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItem to nil")
+		return errors.New("invalid: unable to decode CytologySegmentUpdatePartialUpdateReqPointsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "x":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				v, err := d.Int()
+				s.X = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"x\"")
 			}
-		case "data":
+		case "y":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Data = make([]CytologySegmentsListOKResultsItemDataItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentsListOKResultsItemDataItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Data = append(s.Data, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
-			}
-		case "group_type":
-			if err := func() error {
-				s.GroupType.Reset()
-				if err := s.GroupType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"group_type\"")
-			}
-		case "seg_type":
-			if err := func() error {
-				s.SegType.Reset()
-				if err := s.SegType.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Y = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"seg_type\"")
+				return errors.Wrap(err, "decode field \"y\"")
 			}
-		case "details":
+		case "polygon":
 			if err := func() error {
-				s.Details = nil
-				var elem CytologySegmentsListOKResultsItemDetails
-				if err := elem.Decode(d); err != nil {
+				s.Polygon.Reset()
+				if err := s.Polygon.Decode(d); err != nil {
 					return err
 				}
-				s.Details = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"details\"")
+				return errors.Wrap(err, "decode field \"polygon\"")
 			}
-		case "is_ai":
+		case "ring":
 			if err := func() error {
-				s.IsAi.Reset()
-				if err := s.IsAi.Decode(d); err != nil {
+				s.Ring.Reset()
+				if err := s.Ring.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_ai\"")
+				return errors.Wrap(err, "decode field \"ring\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentsListOKResultsItem")
+		return errors.Wrap(err, "decode CytologySegmentUpdatePartialUpdateReqPointsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000010,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5106,8 +5113,8 @@ func (s *CytologySegmentsListOKResultsItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentsListOKResultsItem) {
-					name = jsonFieldsNameOfCytologySegmentsListOKResultsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReqPointsItem) {
+					name = jsonFieldsNameOfCytologySegmentUpdatePartialUpdateReqPointsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5128,33 +5135,27 @@ func (s *CytologySegmentsListOKResultsItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentsListOKResultsItem) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentsListOKResultsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentsListOKResultsItemDataItem) Encode(e *jx.Encoder) {
+func (s *CytologySegmentUpdateReadOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentsListOKResultsItemDataItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
+func (s *CytologySegmentUpdateReadOK) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("points")
 		e.ArrStart()
@@ -5164,44 +5165,68 @@ func (s *CytologySegmentsListOKResultsItemDataItem) encodeFields(e *jx.Encoder)
 		e.ArrEnd()
 	}
 	{
-		if s.Details.Set {
-			e.FieldStart("details")
-			s.Details.Encode(e)
+		if s.SegmentGroup.Set {
+			e.FieldStart("segment_group")
+			s.SegmentGroup.Encode(e)
+		}
+	}
+	{
+		if s.GeometryType.Set {
+			e.FieldStart("geometry_type")
+			s.GeometryType.Encode(e)
+		}
+	}
+	{
+		if s.Color.Set {
+			e.FieldStart("color")
+			s.Color.Encode(e)
+		}
+	}
+	{
+		if s.IsLocked.Set {
+			e.FieldStart("is_locked")
+			s.IsLocked.Encode(e)
+		}
+	}
+	{
+		if s.Confidence.Set {
+			e.FieldStart("confidence")
+			s.Confidence.Encode(e)
+		}
+	}
+	{
+		if s.Properties.Set {
+			e.FieldStart("properties")
+			s.Properties.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItem = [3]string{
-	0: "id",
-	1: "points",
-	2: "details",
+var jsonFieldsNameOfCytologySegmentUpdateReadOK = [7]string{
+	0: "points",
+	1: "segment_group",
+	2: "geometry_type",
+	3: "color",
+	4: "is_locked",
+	5: "confidence",
+	6: "properties",
 }
 
-// Decode decodes CytologySegmentsListOKResultsItemDataItem from json.
-func (s *CytologySegmentsListOKResultsItemDataItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdateReadOK from json.
+func (s *CytologySegmentUpdateReadOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemDataItem to nil")
+		return errors.New("invalid: unable to decode CytologySegmentUpdateReadOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
 		case "points":
-			requiredBitSet[0] |= 1 << 1
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Points = make([]CytologySegmentsListOKResultsItemDataItemPointsItem, 0)
+				s.Points = make([]CytologySegmentUpdateReadOKPointsItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CytologySegmentsListOKResultsItemDataItemPointsItem
+					var elem CytologySegmentUpdateReadOKPointsItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -5214,27 +5239,77 @@ func (s *CytologySegmentsListOKResultsItemDataItem) Decode(d *jx.Decoder) error
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"points\"")
 			}
-		case "details":
+		case "segment_group":
 			if err := func() error {
-				s.Details.Reset()
-				if err := s.Details.Decode(d); err != nil {
+				s.SegmentGroup.Reset()
+				if err := s.SegmentGroup.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"details\"")
+				return errors.Wrap(err, "decode field \"segment_group\"")
+			}
+		case "geometry_type":
+			if err := func() error {
+				s.GeometryType.Reset()
+				if err := s.GeometryType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"geometry_type\"")
+			}
+		case "color":
+			if err := func() error {
+				s.Color.Reset()
+				if err := s.Color.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"color\"")
+			}
+		case "is_locked":
+			if err := func() error {
+				s.IsLocked.Reset()
+				if err := s.IsLocked.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_locked\"")
+			}
+		case "confidence":
+			if err := func() error {
+				s.Confidence.Reset()
+				if err := s.Confidence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"confidence\"")
+			}
+		case "properties":
+			if err := func() error {
+				s.Properties.Reset()
+				if err := s.Properties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"properties\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentsListOKResultsItemDataItem")
+		return errors.Wrap(err, "decode CytologySegmentUpdateReadOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000010,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5246,8 +5321,8 @@ func (s *CytologySegmentsListOKResultsItemDataItem) Decode(d *jx.Decoder) error
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItem) {
-					name = jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateReadOK) {
+					name = jsonFieldsNameOfCytologySegmentUpdateReadOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5268,37 +5343,27 @@ func (s *CytologySegmentsListOKResultsItemDataItem) Decode(d *jx.Decoder) error
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentsListOKResultsItemDataItem) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdateReadOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentsListOKResultsItemDataItem) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdateReadOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) Encode(e *jx.Encoder) {
+func (s *CytologySegmentUpdateReadOKPointsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("uid")
-		e.Int(s.UID)
-	}
+func (s *CytologySegmentUpdateReadOKPointsItem) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("x")
 		e.Int(s.X)
@@ -5307,81 +5372,91 @@ func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) encodeFields(e *jx
 		e.FieldStart("y")
 		e.Int(s.Y)
 	}
+	{
+		if s.Polygon.Set {
+			e.FieldStart("polygon")
+			s.Polygon.Encode(e)
+		}
+	}
+	{
+		if s.Ring.Set {
+			e.FieldStart("ring")
+			s.Ring.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItemPointsItem = [4]string{
-	0: "id",
-	1: "uid",
-	2: "x",
-	3: "y",
+var jsonFieldsNameOfCytologySegmentUpdateReadOKPointsItem = [4]string{
+	0: "x",
+	1: "y",
+	2: "polygon",
+	3: "ring",
 }
 
-// Decode decodes CytologySegmentsListOKResultsItemDataItemPointsItem from json.
-func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdateReadOKPointsItem from json.
+func (s *CytologySegmentUpdateReadOKPointsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemDataItemPointsItem to nil")
+		return errors.New("invalid: unable to decode CytologySegmentUpdateReadOKPointsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "x":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				v, err := d.Int()
+				s.X = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"x\"")
 			}
-		case "uid":
+		case "y":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Int()
-				s.UID = int(v)
+				s.Y = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uid\"")
+				return errors.Wrap(err, "decode field \"y\"")
 			}
-		case "x":
-			requiredBitSet[0] |= 1 << 2
+		case "polygon":
 			if err := func() error {
-				v, err := d.Int()
-				s.X = int(v)
-				if err != nil {
+				s.Polygon.Reset()
+				if err := s.Polygon.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"x\"")
+				return errors.Wrap(err, "decode field \"polygon\"")
 			}
-		case "y":
-			requiredBitSet[0] |= 1 << 3
+		case "ring":
 			if err := func() error {
-				v, err := d.Int()
-				s.Y = int(v)
-				if err != nil {
+				s.Ring.Reset()
+				if err := s.Ring.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"y\"")
+				return errors.Wrap(err, "decode field \"ring\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentsListOKResultsItemDataItemPointsItem")
+		return errors.Wrap(err, "decode CytologySegmentUpdateReadOKPointsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001110,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5393,8 +5468,8 @@ func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) Decode(d *jx.Decod
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItemPointsItem) {
-					name = jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItemPointsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateReadOKPointsItem) {
+					name = jsonFieldsNameOfCytologySegmentUpdateReadOKPointsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5415,450 +5490,536 @@ func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) Decode(d *jx.Decod
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdateReadOKPointsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdateReadOKPointsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologySegmentsListOKResultsItemDetails) Encode(e *jx.Encoder) {
+func (s *CytologySegmentUpdateUpdateOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologySegmentsListOKResultsItemDetails) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentUpdateUpdateOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("points")
+		e.ArrStart()
+		for _, elem := range s.Points {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.SegmentGroup.Set {
+			e.FieldStart("segment_group")
+			s.SegmentGroup.Encode(e)
+		}
+	}
+	{
+		if s.GeometryType.Set {
+			e.FieldStart("geometry_type")
+			s.GeometryType.Encode(e)
+		}
+	}
+	{
+		if s.Color.Set {
+			e.FieldStart("color")
+			s.Color.Encode(e)
+		}
+	}
+	{
+		if s.IsLocked.Set {
+			e.FieldStart("is_locked")
+			s.IsLocked.Encode(e)
+		}
+	}
+	{
+		if s.Confidence.Set {
+			e.FieldStart("confidence")
+			s.Confidence.Encode(e)
+		}
+	}
+	{
+		if s.Properties.Set {
+			e.FieldStart("properties")
+			s.Properties.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCytologySegmentsListOKResultsItemDetails = [0]string{}
+var jsonFieldsNameOfCytologySegmentUpdateUpdateOK = [7]string{
+	0: "points",
+	1: "segment_group",
+	2: "geometry_type",
+	3: "color",
+	4: "is_locked",
+	5: "confidence",
+	6: "properties",
+}
 
-// Decode decodes CytologySegmentsListOKResultsItemDetails from json.
-func (s *CytologySegmentsListOKResultsItemDetails) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdateUpdateOK from json.
+func (s *CytologySegmentUpdateUpdateOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemDetails to nil")
+		return errors.New("invalid: unable to decode CytologySegmentUpdateUpdateOK to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "points":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Points = make([]CytologySegmentUpdateUpdateOKPointsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CytologySegmentUpdateUpdateOKPointsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Points = append(s.Points, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"points\"")
+			}
+		case "segment_group":
+			if err := func() error {
+				s.SegmentGroup.Reset()
+				if err := s.SegmentGroup.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"segment_group\"")
+			}
+		case "geometry_type":
+			if err := func() error {
+				s.GeometryType.Reset()
+				if err := s.GeometryType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"geometry_type\"")
+			}
+		case "color":
+			if err := func() error {
+				s.Color.Reset()
+				if err := s.Color.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"color\"")
+			}
+		case "is_locked":
+			if err := func() error {
+				s.IsLocked.Reset()
+				if err := s.IsLocked.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_locked\"")
+			}
+		case "confidence":
+			if err := func() error {
+				s.Confidence.Reset()
+				if err := s.Confidence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"confidence\"")
+			}
+		case "properties":
+			if err := func() error {
+				s.Properties.Reset()
+				if err := s.Properties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"properties\"")
+			}
 		default:
 			return d.Skip()
 		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologySegmentsListOKResultsItemDetails")
+		return errors.Wrap(err, "decode CytologySegmentUpdateUpdateOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateUpdateOK) {
+					name = jsonFieldsNameOfCytologySegmentUpdateUpdateOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologySegmentsListOKResultsItemDetails) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdateUpdateOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentsListOKResultsItemDetails) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdateUpdateOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologySegmentsListOKResultsItemGroupType as json.
-func (s CytologySegmentsListOKResultsItemGroupType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *CytologySegmentUpdateUpdateOKPointsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CytologySegmentsListOKResultsItemGroupType from json.
-func (s *CytologySegmentsListOKResultsItemGroupType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemGroupType to nil")
+// encodeFields encodes fields.
+func (s *CytologySegmentUpdateUpdateOKPointsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("x")
+		e.Int(s.X)
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		e.FieldStart("y")
+		e.Int(s.Y)
 	}
-	// Try to use constant string.
-	switch CytologySegmentsListOKResultsItemGroupType(v) {
-	case CytologySegmentsListOKResultsItemGroupTypeCE:
-		*s = CytologySegmentsListOKResultsItemGroupTypeCE
-	case CytologySegmentsListOKResultsItemGroupTypeCL:
-		*s = CytologySegmentsListOKResultsItemGroupTypeCL
-	case CytologySegmentsListOKResultsItemGroupTypeME:
-		*s = CytologySegmentsListOKResultsItemGroupTypeME
-	default:
-		*s = CytologySegmentsListOKResultsItemGroupType(v)
+	{
+		if s.Polygon.Set {
+			e.FieldStart("polygon")
+			s.Polygon.Encode(e)
+		}
+	}
+	{
+		if s.Ring.Set {
+			e.FieldStart("ring")
+			s.Ring.Encode(e)
+		}
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CytologySegmentsListOKResultsItemGroupType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentsListOKResultsItemGroupType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes CytologySegmentsListOKResultsItemSegType as json.
-func (s CytologySegmentsListOKResultsItemSegType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+var jsonFieldsNameOfCytologySegmentUpdateUpdateOKPointsItem = [4]string{
+	0: "x",
+	1: "y",
+	2: "polygon",
+	3: "ring",
 }
 
-// Decode decodes CytologySegmentsListOKResultsItemSegType from json.
-func (s *CytologySegmentsListOKResultsItemSegType) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdateUpdateOKPointsItem from json.
+func (s *CytologySegmentUpdateUpdateOKPointsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemSegType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch CytologySegmentsListOKResultsItemSegType(v) {
-	case CytologySegmentsListOKResultsItemSegTypeNIL:
-		*s = CytologySegmentsListOKResultsItemSegTypeNIL
-	case CytologySegmentsListOKResultsItemSegTypeNIR:
-		*s = CytologySegmentsListOKResultsItemSegTypeNIR
-	case CytologySegmentsListOKResultsItemSegTypeNIM:
-		*s = CytologySegmentsListOKResultsItemSegTypeNIM
-	case CytologySegmentsListOKResultsItemSegTypeCNO:
-		*s = CytologySegmentsListOKResultsItemSegTypeCNO
-	case CytologySegmentsListOKResultsItemSegTypeCGE:
-		*s = CytologySegmentsListOKResultsItemSegTypeCGE
-	case CytologySegmentsListOKResultsItemSegTypeC2N:
-		*s = CytologySegmentsListOKResultsItemSegTypeC2N
-	case CytologySegmentsListOKResultsItemSegTypeCPS:
-		*s = CytologySegmentsListOKResultsItemSegTypeCPS
-	case CytologySegmentsListOKResultsItemSegTypeCFC:
-		*s = CytologySegmentsListOKResultsItemSegTypeCFC
-	case CytologySegmentsListOKResultsItemSegTypeCLY:
-		*s = CytologySegmentsListOKResultsItemSegTypeCLY
-	case CytologySegmentsListOKResultsItemSegTypeSOS:
-		*s = CytologySegmentsListOKResultsItemSegTypeSOS
-	case CytologySegmentsListOKResultsItemSegTypeSDS:
-		*s = CytologySegmentsListOKResultsItemSegTypeSDS
-	case CytologySegmentsListOKResultsItemSegTypeSMS:
-		*s = CytologySegmentsListOKResultsItemSegTypeSMS
-	case CytologySegmentsListOKResultsItemSegTypeSTS:
-		*s = CytologySegmentsListOKResultsItemSegTypeSTS
-	case CytologySegmentsListOKResultsItemSegTypeSPS:
-		*s = CytologySegmentsListOKResultsItemSegTypeSPS
-	case CytologySegmentsListOKResultsItemSegTypeSNM:
-		*s = CytologySegmentsListOKResultsItemSegTypeSNM
-	case CytologySegmentsListOKResultsItemSegTypeSTM:
-		*s = CytologySegmentsListOKResultsItemSegTypeSTM
-	default:
-		*s = CytologySegmentsListOKResultsItemSegType(v)
+		return errors.New("invalid: unable to decode CytologySegmentUpdateUpdateOKPointsItem to nil")
 	}
+	var requiredBitSet [1]uint8
 
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CytologySegmentsListOKResultsItemSegType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologySegmentsListOKResultsItemSegType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CytologyShotDetails) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CytologyShotDetails) encodeFields(e *jx.Encoder) {
-	{
-		if s.AiInfo != nil {
-			e.FieldStart("ai_info")
-			e.ArrStart()
-			for _, elem := range s.AiInfo {
-				if len(elem) != 0 {
-					e.Raw(elem)
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "x":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.X = int(v)
+				if err != nil {
+					return err
 				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"x\"")
 			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Probs != nil {
-			e.FieldStart("probs")
-			e.ArrStart()
-			for _, elem := range s.Probs {
-				e.Float64(elem)
+		case "y":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Y = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"y\"")
 			}
-			e.ArrEnd()
-		}
-	}
-}
-
-var jsonFieldsNameOfCytologyShotDetails = [2]string{
-	0: "ai_info",
-	1: "probs",
-}
-
-// Decode decodes CytologyShotDetails from json.
-func (s *CytologyShotDetails) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyShotDetails to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "ai_info":
+		case "polygon":
 			if err := func() error {
-				s.AiInfo = make([]jx.Raw, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem jx.Raw
-					v, err := d.RawAppend(nil)
-					elem = jx.Raw(v)
-					if err != nil {
-						return err
-					}
-					s.AiInfo = append(s.AiInfo, elem)
-					return nil
-				}); err != nil {
+				s.Polygon.Reset()
+				if err := s.Polygon.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ai_info\"")
+				return errors.Wrap(err, "decode field \"polygon\"")
 			}
-		case "probs":
+		case "ring":
 			if err := func() error {
-				s.Probs = make([]float64, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem float64
-					v, err := d.Float64()
-					elem = float64(v)
-					if err != nil {
-						return err
-					}
-					s.Probs = append(s.Probs, elem)
-					return nil
-				}); err != nil {
+				s.Ring.Reset()
+				if err := s.Ring.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"probs\"")
+				return errors.Wrap(err, "decode field \"ring\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyShotDetails")
+		return errors.Wrap(err, "decode CytologySegmentUpdateUpdateOKPointsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateUpdateOKPointsItem) {
+					name = jsonFieldsNameOfCytologySegmentUpdateUpdateOKPointsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyShotDetails) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdateUpdateOKPointsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyShotDetails) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdateUpdateOKPointsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyShotPatient) Encode(e *jx.Encoder) {
+func (s *CytologySegmentUpdateUpdateReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyShotPatient) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
+func (s *CytologySegmentUpdateUpdateReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("first_name")
-		e.Str(s.FirstName)
+		e.FieldStart("points")
+		e.ArrStart()
+		for _, elem := range s.Points {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("last_name")
-		e.Str(s.LastName)
+		if s.SegmentGroup.Set {
+			e.FieldStart("segment_group")
+			s.SegmentGroup.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("fathers_name")
-		e.Str(s.FathersName)
+		if s.GeometryType.Set {
+			e.FieldStart("geometry_type")
+			s.GeometryType.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("birth_date")
-		json.EncodeDate(e, s.BirthDate)
+		if s.Color.Set {
+			e.FieldStart("color")
+			s.Color.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("personal_policy")
-		e.Str(s.PersonalPolicy)
+		if s.IsLocked.Set {
+			e.FieldStart("is_locked")
+			s.IsLocked.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		if s.Confidence.Set {
+			e.FieldStart("confidence")
+			s.Confidence.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("is_active")
-		e.Bool(s.IsActive)
+		if s.Properties.Set {
+			e.FieldStart("properties")
+			s.Properties.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfCytologyShotPatient = [8]string{
-	0: "id",
-	1: "first_name",
-	2: "last_name",
-	3: "fathers_name",
-	4: "birth_date",
-	5: "personal_policy",
-	6: "email",
-	7: "is_active",
+var jsonFieldsNameOfCytologySegmentUpdateUpdateReq = [7]string{
+	0: "points",
+	1: "segment_group",
+	2: "geometry_type",
+	3: "color",
+	4: "is_locked",
+	5: "confidence",
+	6: "properties",
 }
 
-// Decode decodes CytologyShotPatient from json.
-func (s *CytologyShotPatient) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdateUpdateReq from json.
+func (s *CytologySegmentUpdateUpdateReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyShotPatient to nil")
+		return errors.New("invalid: unable to decode CytologySegmentUpdateUpdateReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "points":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
+				s.Points = make([]CytologySegmentUpdateUpdateReqPointsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CytologySegmentUpdateUpdateReqPointsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Points = append(s.Points, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"points\"")
 			}
-		case "first_name":
-			requiredBitSet[0] |= 1 << 1
+		case "segment_group":
 			if err := func() error {
-				v, err := d.Str()
-				s.FirstName = string(v)
-				if err != nil {
+				s.SegmentGroup.Reset()
+				if err := s.SegmentGroup.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"first_name\"")
+				return errors.Wrap(err, "decode field \"segment_group\"")
 			}
-		case "last_name":
-			requiredBitSet[0] |= 1 << 2
+		case "geometry_type":
 			if err := func() error {
-				v, err := d.Str()
-				s.LastName = string(v)
-				if err != nil {
+				s.GeometryType.Reset()
+				if err := s.GeometryType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_name\"")
+				return errors.Wrap(err, "decode field \"geometry_type\"")
 			}
-		case "fathers_name":
-			requiredBitSet[0] |= 1 << 3
+		case "color":
 			if err := func() error {
-				v, err := d.Str()
-				s.FathersName = string(v)
-				if err != nil {
+				s.Color.Reset()
+				if err := s.Color.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fathers_name\"")
+				return errors.Wrap(err, "decode field \"color\"")
 			}
-		case "birth_date":
-			requiredBitSet[0] |= 1 << 4
+		case "is_locked":
 			if err := func() error {
-				v, err := json.DecodeDate(d)
-				s.BirthDate = v
-				if err != nil {
+				s.IsLocked.Reset()
+				if err := s.IsLocked.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"birth_date\"")
+				return errors.Wrap(err, "decode field \"is_locked\"")
 			}
-		case "personal_policy":
-			requiredBitSet[0] |= 1 << 5
+		case "confidence":
 			if err := func() error {
-				v, err := d.Str()
-				s.PersonalPolicy = string(v)
-				if err != nil {
+				s.Confidence.Reset()
+				if err := s.Confidence.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"personal_policy\"")
+				return errors.Wrap(err, "decode field \"confidence\"")
 			}
-		case "email":
-			requiredBitSet[0] |= 1 << 6
+		case "properties":
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
+				s.Properties.Reset()
+				if err := s.Properties.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
-			}
-		case "is_active":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				v, err := d.Bool()
-				s.IsActive = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_active\"")
+				return errors.Wrap(err, "decode field \"properties\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyShotPatient")
+		return errors.Wrap(err, "decode CytologySegmentUpdateUpdateReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b11111111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5870,8 +6031,8 @@ func (s *CytologyShotPatient) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyShotPatient) {
-					name = jsonFieldsNameOfCytologyShotPatient[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateUpdateReq) {
+					name = jsonFieldsNameOfCytologySegmentUpdateUpdateReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5892,371 +6053,243 @@ func (s *CytologyShotPatient) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyShotPatient) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdateUpdateReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyShotPatient) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdateUpdateReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyShotPatientCard) Encode(e *jx.Encoder) {
+func (s *CytologySegmentUpdateUpdateReqPointsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyShotPatientCard) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentUpdateUpdateReqPointsItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.AcceptanceDatetime.Set {
-			e.FieldStart("acceptance_datetime")
-			s.AcceptanceDatetime.Encode(e, json.EncodeDateTime)
+		e.FieldStart("x")
+		e.Int(s.X)
+	}
+	{
+		e.FieldStart("y")
+		e.Int(s.Y)
+	}
+	{
+		if s.Polygon.Set {
+			e.FieldStart("polygon")
+			s.Polygon.Encode(e)
 		}
 	}
 	{
-		if s.Diagnosis.Set {
-			e.FieldStart("diagnosis")
-			s.Diagnosis.Encode(e)
+		if s.Ring.Set {
+			e.FieldStart("ring")
+			s.Ring.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCytologyShotPatientCard = [2]string{
-	0: "acceptance_datetime",
-	1: "diagnosis",
+var jsonFieldsNameOfCytologySegmentUpdateUpdateReqPointsItem = [4]string{
+	0: "x",
+	1: "y",
+	2: "polygon",
+	3: "ring",
 }
 
-// Decode decodes CytologyShotPatientCard from json.
-func (s *CytologyShotPatientCard) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentUpdateUpdateReqPointsItem from json.
+func (s *CytologySegmentUpdateUpdateReqPointsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyShotPatientCard to nil")
+		return errors.New("invalid: unable to decode CytologySegmentUpdateUpdateReqPointsItem to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "acceptance_datetime":
+		case "x":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.AcceptanceDatetime.Reset()
-				if err := s.AcceptanceDatetime.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Int()
+				s.X = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"acceptance_datetime\"")
+				return errors.Wrap(err, "decode field \"x\"")
 			}
-		case "diagnosis":
+		case "y":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Diagnosis.Reset()
-				if err := s.Diagnosis.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Y = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnosis\"")
+				return errors.Wrap(err, "decode field \"y\"")
+			}
+		case "polygon":
+			if err := func() error {
+				s.Polygon.Reset()
+				if err := s.Polygon.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"polygon\"")
+			}
+		case "ring":
+			if err := func() error {
+				s.Ring.Reset()
+				if err := s.Ring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ring\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyShotPatientCard")
+		return errors.Wrap(err, "decode CytologySegmentUpdateUpdateReqPointsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentUpdateUpdateReqPointsItem) {
+					name = jsonFieldsNameOfCytologySegmentUpdateUpdateReqPointsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyShotPatientCard) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentUpdateUpdateReqPointsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyShotPatientCard) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentUpdateUpdateReqPointsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdatePartialUpdateOK) Encode(e *jx.Encoder) {
+func (s *CytologySegmentationPage) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdatePartialUpdateOK) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("patient_card")
-		s.PatientCard.Encode(e)
-	}
-	{
-		if s.Details != nil {
-			e.FieldStart("details")
-			s.Details.Encode(e)
-		}
-	}
-	{
-		if s.IsLast.Set {
-			e.FieldStart("is_last")
-			s.IsLast.Encode(e)
-		}
-	}
-	{
-		if s.DiagnosDate.Set {
-			e.FieldStart("diagnos_date")
-			s.DiagnosDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.DiagnosticMarking.Set {
-			e.FieldStart("diagnostic_marking")
-			s.DiagnosticMarking.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("diagnostic_number")
-		e.Int(s.DiagnosticNumber)
-	}
-	{
-		if s.MaterialType.Set {
-			e.FieldStart("material_type")
-			s.MaterialType.Encode(e)
-		}
-	}
-	{
-		if s.Calcitonin.Set {
-			e.FieldStart("calcitonin")
-			s.Calcitonin.Encode(e)
-		}
-	}
-	{
-		if s.CalcitoninInFlush.Set {
-			e.FieldStart("calcitonin_in_flush")
-			s.CalcitoninInFlush.Encode(e)
-		}
-	}
-	{
-		if s.Thyroglobulin.Set {
-			e.FieldStart("thyroglobulin")
-			s.Thyroglobulin.Encode(e)
-		}
-	}
-	{
-		if s.Prev.Set {
-			e.FieldStart("prev")
-			s.Prev.Encode(e)
-		}
-	}
+func (s *CytologySegmentationPage) encodeFields(e *jx.Encoder) {
 	{
-		if s.ParentPrev.Set {
-			e.FieldStart("parent_prev")
-			s.ParentPrev.Encode(e)
+		e.FieldStart("segmentations")
+		e.ArrStart()
+		for _, elem := range s.Segmentations {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 	{
-		if s.OriginalImage.Set {
-			e.FieldStart("original_image")
-			s.OriginalImage.Encode(e)
+		if s.NextCursor.Set {
+			e.FieldStart("next_cursor")
+			s.NextCursor.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCytologyUpdatePartialUpdateOK = [14]string{
-	0:  "id",
-	1:  "patient_card",
-	2:  "details",
-	3:  "is_last",
-	4:  "diagnos_date",
-	5:  "diagnostic_marking",
-	6:  "diagnostic_number",
-	7:  "material_type",
-	8:  "calcitonin",
-	9:  "calcitonin_in_flush",
-	10: "thyroglobulin",
-	11: "prev",
-	12: "parent_prev",
-	13: "original_image",
+var jsonFieldsNameOfCytologySegmentationPage = [2]string{
+	0: "segmentations",
+	1: "next_cursor",
 }
 
-// Decode decodes CytologyUpdatePartialUpdateOK from json.
-func (s *CytologyUpdatePartialUpdateOK) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentationPage from json.
+func (s *CytologySegmentationPage) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateOK to nil")
+		return errors.New("invalid: unable to decode CytologySegmentationPage to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "patient_card":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				if err := s.PatientCard.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient_card\"")
-			}
-		case "details":
+		case "segmentations":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Details = nil
-				var elem CytologyUpdatePartialUpdateOKDetails
-				if err := elem.Decode(d); err != nil {
+				s.Segmentations = make([]Segmentation, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Segmentation
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Segmentations = append(s.Segmentations, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
-				s.Details = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"details\"")
+				return errors.Wrap(err, "decode field \"segmentations\"")
 			}
-		case "is_last":
+		case "next_cursor":
 			if err := func() error {
-				s.IsLast.Reset()
-				if err := s.IsLast.Decode(d); err != nil {
+				s.NextCursor.Reset()
+				if err := s.NextCursor.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_last\"")
-			}
-		case "diagnos_date":
-			if err := func() error {
-				s.DiagnosDate.Reset()
-				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnos_date\"")
-			}
-		case "diagnostic_marking":
-			if err := func() error {
-				s.DiagnosticMarking.Reset()
-				if err := s.DiagnosticMarking.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
-			}
-		case "diagnostic_number":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Int()
-				s.DiagnosticNumber = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_number\"")
-			}
-		case "material_type":
-			if err := func() error {
-				s.MaterialType.Reset()
-				if err := s.MaterialType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"material_type\"")
-			}
-		case "calcitonin":
-			if err := func() error {
-				s.Calcitonin.Reset()
-				if err := s.Calcitonin.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin\"")
-			}
-		case "calcitonin_in_flush":
-			if err := func() error {
-				s.CalcitoninInFlush.Reset()
-				if err := s.CalcitoninInFlush.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
-			}
-		case "thyroglobulin":
-			if err := func() error {
-				s.Thyroglobulin.Reset()
-				if err := s.Thyroglobulin.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"thyroglobulin\"")
-			}
-		case "prev":
-			if err := func() error {
-				s.Prev.Reset()
-				if err := s.Prev.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"prev\"")
-			}
-		case "parent_prev":
-			if err := func() error {
-				s.ParentPrev.Reset()
-				if err := s.ParentPrev.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"parent_prev\"")
-			}
-		case "original_image":
-			if err := func() error {
-				s.OriginalImage.Reset()
-				if err := s.OriginalImage.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"original_image\"")
+				return errors.Wrap(err, "decode field \"next_cursor\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateOK")
+		return errors.Wrap(err, "decode CytologySegmentationPage")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b01000010,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6268,8 +6301,8 @@ func (s *CytologyUpdatePartialUpdateOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyUpdatePartialUpdateOK) {
-					name = jsonFieldsNameOfCytologyUpdatePartialUpdateOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentationPage) {
+					name = jsonFieldsNameOfCytologySegmentationPage[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6290,115 +6323,184 @@ func (s *CytologyUpdatePartialUpdateOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdatePartialUpdateOK) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentationPage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdatePartialUpdateOK) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentationPage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdatePartialUpdateOKDetails) Encode(e *jx.Encoder) {
+func (s *CytologySegmentsListOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdatePartialUpdateOKDetails) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentsListOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("count")
+		e.Int(s.Count)
+	}
+	{
+		if s.Next.Set {
+			e.FieldStart("next")
+			s.Next.Encode(e)
+		}
+	}
+	{
+		if s.Previous.Set {
+			e.FieldStart("previous")
+			s.Previous.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("results")
+		e.ArrStart()
+		for _, elem := range s.Results {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
 }
 
-var jsonFieldsNameOfCytologyUpdatePartialUpdateOKDetails = [0]string{}
+var jsonFieldsNameOfCytologySegmentsListOK = [4]string{
+	0: "count",
+	1: "next",
+	2: "previous",
+	3: "results",
+}
 
-// Decode decodes CytologyUpdatePartialUpdateOKDetails from json.
-func (s *CytologyUpdatePartialUpdateOKDetails) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentsListOK from json.
+func (s *CytologySegmentsListOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateOKDetails to nil")
+		return errors.New("invalid: unable to decode CytologySegmentsListOK to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "count":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Count = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"count\"")
+			}
+		case "next":
+			if err := func() error {
+				s.Next.Reset()
+				if err := s.Next.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"next\"")
+			}
+		case "previous":
+			if err := func() error {
+				s.Previous.Reset()
+				if err := s.Previous.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"previous\"")
+			}
+		case "results":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Results = make([]CytologySegmentsListOKResultsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CytologySegmentsListOKResultsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results\"")
+			}
 		default:
 			return d.Skip()
 		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateOKDetails")
+		return errors.Wrap(err, "decode CytologySegmentsListOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentsListOK) {
+					name = jsonFieldsNameOfCytologySegmentsListOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdatePartialUpdateOKDetails) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentsListOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdatePartialUpdateOKDetails) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentsListOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdatePartialUpdateOKPatientCard) Encode(e *jx.Encoder) {
+func (s *CytologySegmentsListOKResultsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdatePartialUpdateOKPatientCard) encodeFields(e *jx.Encoder) {
-}
-
-var jsonFieldsNameOfCytologyUpdatePartialUpdateOKPatientCard = [0]string{}
-
-// Decode decodes CytologyUpdatePartialUpdateOKPatientCard from json.
-func (s *CytologyUpdatePartialUpdateOKPatientCard) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateOKPatientCard to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
-		}
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateOKPatientCard")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdatePartialUpdateOKPatientCard) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdatePartialUpdateOKPatientCard) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CytologyUpdatePartialUpdateReq) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CytologyUpdatePartialUpdateReq) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentsListOKResultsItem) encodeFields(e *jx.Encoder) {
 	{
 		if s.ID.Set {
 			e.FieldStart("id")
@@ -6406,104 +6508,54 @@ func (s *CytologyUpdatePartialUpdateReq) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		e.FieldStart("patient_card")
-		s.PatientCard.Encode(e)
-	}
-	{
-		if s.Details != nil {
-			e.FieldStart("details")
-			s.Details.Encode(e)
-		}
-	}
-	{
-		if s.IsLast.Set {
-			e.FieldStart("is_last")
-			s.IsLast.Encode(e)
-		}
-	}
-	{
-		if s.DiagnosDate.Set {
-			e.FieldStart("diagnos_date")
-			s.DiagnosDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.DiagnosticMarking.Set {
-			e.FieldStart("diagnostic_marking")
-			s.DiagnosticMarking.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("diagnostic_number")
-		e.Int(s.DiagnosticNumber)
-	}
-	{
-		if s.MaterialType.Set {
-			e.FieldStart("material_type")
-			s.MaterialType.Encode(e)
-		}
-	}
-	{
-		if s.Calcitonin.Set {
-			e.FieldStart("calcitonin")
-			s.Calcitonin.Encode(e)
-		}
-	}
-	{
-		if s.CalcitoninInFlush.Set {
-			e.FieldStart("calcitonin_in_flush")
-			s.CalcitoninInFlush.Encode(e)
+		e.FieldStart("data")
+		e.ArrStart()
+		for _, elem := range s.Data {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 	{
-		if s.Thyroglobulin.Set {
-			e.FieldStart("thyroglobulin")
-			s.Thyroglobulin.Encode(e)
+		if s.GroupType.Set {
+			e.FieldStart("group_type")
+			s.GroupType.Encode(e)
 		}
 	}
 	{
-		if s.Prev.Set {
-			e.FieldStart("prev")
-			s.Prev.Encode(e)
+		if s.SegType.Set {
+			e.FieldStart("seg_type")
+			s.SegType.Encode(e)
 		}
 	}
 	{
-		if s.ParentPrev.Set {
-			e.FieldStart("parent_prev")
-			s.ParentPrev.Encode(e)
+		if s.Details != nil {
+			e.FieldStart("details")
+			s.Details.Encode(e)
 		}
 	}
 	{
-		if s.OriginalImage.Set {
-			e.FieldStart("original_image")
-			s.OriginalImage.Encode(e)
+		if s.IsAi.Set {
+			e.FieldStart("is_ai")
+			s.IsAi.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCytologyUpdatePartialUpdateReq = [14]string{
-	0:  "id",
-	1:  "patient_card",
-	2:  "details",
-	3:  "is_last",
-	4:  "diagnos_date",
-	5:  "diagnostic_marking",
-	6:  "diagnostic_number",
-	7:  "material_type",
-	8:  "calcitonin",
-	9:  "calcitonin_in_flush",
-	10: "thyroglobulin",
-	11: "prev",
-	12: "parent_prev",
-	13: "original_image",
+var jsonFieldsNameOfCytologySegmentsListOKResultsItem = [6]string{
+	0: "id",
+	1: "data",
+	2: "group_type",
+	3: "seg_type",
+	4: "details",
+	5: "is_ai",
 }
 
-// Decode decodes CytologyUpdatePartialUpdateReq from json.
-func (s *CytologyUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentsListOKResultsItem from json.
+func (s *CytologySegmentsListOKResultsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReq to nil")
+		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItem to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -6517,152 +6569,302 @@ func (s *CytologyUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "patient_card":
+		case "data":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.PatientCard.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient_card\"")
-			}
-		case "details":
-			if err := func() error {
-				s.Details = nil
-				var elem CytologyUpdatePartialUpdateReqDetails
-				if err := elem.Decode(d); err != nil {
-					return err
-				}
-				s.Details = &elem
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"details\"")
-			}
-		case "is_last":
-			if err := func() error {
-				s.IsLast.Reset()
-				if err := s.IsLast.Decode(d); err != nil {
+				s.Data = make([]CytologySegmentsListOKResultsItemDataItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CytologySegmentsListOKResultsItemDataItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Data = append(s.Data, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_last\"")
+				return errors.Wrap(err, "decode field \"data\"")
 			}
-		case "diagnos_date":
+		case "group_type":
 			if err := func() error {
-				s.DiagnosDate.Reset()
-				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.GroupType.Reset()
+				if err := s.GroupType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnos_date\"")
+				return errors.Wrap(err, "decode field \"group_type\"")
 			}
-		case "diagnostic_marking":
+		case "seg_type":
 			if err := func() error {
-				s.DiagnosticMarking.Reset()
-				if err := s.DiagnosticMarking.Decode(d); err != nil {
+				s.SegType.Reset()
+				if err := s.SegType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
+				return errors.Wrap(err, "decode field \"seg_type\"")
 			}
-		case "diagnostic_number":
-			requiredBitSet[0] |= 1 << 6
+		case "details":
 			if err := func() error {
-				v, err := d.Int()
-				s.DiagnosticNumber = int(v)
-				if err != nil {
+				s.Details = nil
+				var elem CytologySegmentsListOKResultsItemDetails
+				if err := elem.Decode(d); err != nil {
 					return err
 				}
+				s.Details = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_number\"")
+				return errors.Wrap(err, "decode field \"details\"")
 			}
-		case "material_type":
+		case "is_ai":
 			if err := func() error {
-				s.MaterialType.Reset()
-				if err := s.MaterialType.Decode(d); err != nil {
+				s.IsAi.Reset()
+				if err := s.IsAi.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"material_type\"")
+				return errors.Wrap(err, "decode field \"is_ai\"")
 			}
-		case "calcitonin":
-			if err := func() error {
-				s.Calcitonin.Reset()
-				if err := s.Calcitonin.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologySegmentsListOKResultsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000010,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentsListOKResultsItem) {
+					name = jsonFieldsNameOfCytologySegmentsListOKResultsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "calcitonin_in_flush":
-			if err := func() error {
-				s.CalcitoninInFlush.Reset()
-				if err := s.CalcitoninInFlush.Decode(d); err != nil {
-					return err
-				}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CytologySegmentsListOKResultsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologySegmentsListOKResultsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CytologySegmentsListOKResultsItemDataItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologySegmentsListOKResultsItemDataItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("points")
+		e.ArrStart()
+		for _, elem := range s.Points {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.Details.Set {
+			e.FieldStart("details")
+			s.Details.Encode(e)
+		}
+	}
+	{
+		if s.GeometryType.Set {
+			e.FieldStart("geometry_type")
+			s.GeometryType.Encode(e)
+		}
+	}
+	{
+		if s.Color.Set {
+			e.FieldStart("color")
+			s.Color.Encode(e)
+		}
+	}
+	{
+		if s.IsLocked.Set {
+			e.FieldStart("is_locked")
+			s.IsLocked.Encode(e)
+		}
+	}
+	{
+		if s.Confidence.Set {
+			e.FieldStart("confidence")
+			s.Confidence.Encode(e)
+		}
+	}
+	{
+		if s.Properties.Set {
+			e.FieldStart("properties")
+			s.Properties.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItem = [8]string{
+	0: "id",
+	1: "points",
+	2: "details",
+	3: "geometry_type",
+	4: "color",
+	5: "is_locked",
+	6: "confidence",
+	7: "properties",
+}
+
+// Decode decodes CytologySegmentsListOKResultsItemDataItem from json.
+func (s *CytologySegmentsListOKResultsItemDataItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemDataItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "thyroglobulin":
+		case "points":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Thyroglobulin.Reset()
-				if err := s.Thyroglobulin.Decode(d); err != nil {
+				s.Points = make([]CytologySegmentsListOKResultsItemDataItemPointsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CytologySegmentsListOKResultsItemDataItemPointsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Points = append(s.Points, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"thyroglobulin\"")
+				return errors.Wrap(err, "decode field \"points\"")
 			}
-		case "prev":
+		case "details":
 			if err := func() error {
-				s.Prev.Reset()
-				if err := s.Prev.Decode(d); err != nil {
+				s.Details.Reset()
+				if err := s.Details.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"prev\"")
+				return errors.Wrap(err, "decode field \"details\"")
 			}
-		case "parent_prev":
+		case "geometry_type":
 			if err := func() error {
-				s.ParentPrev.Reset()
-				if err := s.ParentPrev.Decode(d); err != nil {
+				s.GeometryType.Reset()
+				if err := s.GeometryType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"parent_prev\"")
+				return errors.Wrap(err, "decode field \"geometry_type\"")
 			}
-		case "original_image":
+		case "color":
 			if err := func() error {
-				s.OriginalImage.Reset()
-				if err := s.OriginalImage.Decode(d); err != nil {
+				s.Color.Reset()
+				if err := s.Color.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"original_image\"")
+				return errors.Wrap(err, "decode field \"color\"")
+			}
+		case "is_locked":
+			if err := func() error {
+				s.IsLocked.Reset()
+				if err := s.IsLocked.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_locked\"")
+			}
+		case "confidence":
+			if err := func() error {
+				s.Confidence.Reset()
+				if err := s.Confidence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"confidence\"")
+			}
+		case "properties":
+			if err := func() error {
+				s.Properties.Reset()
+				if err := s.Properties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"properties\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateReq")
+		return errors.Wrap(err, "decode CytologySegmentsListOKResultsItemDataItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b01000010,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000010,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6674,8 +6876,8 @@ func (s *CytologyUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyUpdatePartialUpdateReq) {
-					name = jsonFieldsNameOfCytologyUpdatePartialUpdateReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItem) {
+					name = jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6696,540 +6898,653 @@ func (s *CytologyUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdatePartialUpdateReq) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentsListOKResultsItemDataItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdatePartialUpdateReq) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentsListOKResultsItemDataItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdatePartialUpdateReqDetails) Encode(e *jx.Encoder) {
+func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdatePartialUpdateReqDetails) encodeFields(e *jx.Encoder) {
+func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("uid")
+		e.Int(s.UID)
+	}
+	{
+		e.FieldStart("x")
+		e.Int(s.X)
+	}
+	{
+		e.FieldStart("y")
+		e.Int(s.Y)
+	}
+	{
+		if s.Polygon.Set {
+			e.FieldStart("polygon")
+			s.Polygon.Encode(e)
+		}
+	}
+	{
+		if s.Ring.Set {
+			e.FieldStart("ring")
+			s.Ring.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCytologyUpdatePartialUpdateReqDetails = [0]string{}
+var jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItemPointsItem = [6]string{
+	0: "id",
+	1: "uid",
+	2: "x",
+	3: "y",
+	4: "polygon",
+	5: "ring",
+}
 
-// Decode decodes CytologyUpdatePartialUpdateReqDetails from json.
-func (s *CytologyUpdatePartialUpdateReqDetails) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentsListOKResultsItemDataItemPointsItem from json.
+func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReqDetails to nil")
+		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemDataItemPointsItem to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "uid":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.UID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uid\"")
+			}
+		case "x":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.X = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"x\"")
+			}
+		case "y":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Y = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"y\"")
+			}
+		case "polygon":
+			if err := func() error {
+				s.Polygon.Reset()
+				if err := s.Polygon.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"polygon\"")
+			}
+		case "ring":
+			if err := func() error {
+				s.Ring.Reset()
+				if err := s.Ring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ring\"")
+			}
 		default:
 			return d.Skip()
 		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateReqDetails")
+		return errors.Wrap(err, "decode CytologySegmentsListOKResultsItemDataItemPointsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001110,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItemPointsItem) {
+					name = jsonFieldsNameOfCytologySegmentsListOKResultsItemDataItemPointsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdatePartialUpdateReqDetails) MarshalJSON() ([]byte, error) {
+func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdatePartialUpdateReqDetails) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyUpdatePartialUpdateReqDiagnosticMarking as json.
-func (s CytologyUpdatePartialUpdateReqDiagnosticMarking) Encode(e *jx.Encoder) {
+// Encode implements json.Marshaler.
+func (s *CytologySegmentsListOKResultsItemDetails) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologySegmentsListOKResultsItemDetails) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfCytologySegmentsListOKResultsItemDetails = [0]string{}
+
+// Decode decodes CytologySegmentsListOKResultsItemDetails from json.
+func (s *CytologySegmentsListOKResultsItemDetails) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemDetails to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
+		}
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologySegmentsListOKResultsItemDetails")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CytologySegmentsListOKResultsItemDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologySegmentsListOKResultsItemDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologySegmentsListOKResultsItemGroupType as json.
+func (s CytologySegmentsListOKResultsItemGroupType) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes CytologyUpdatePartialUpdateReqDiagnosticMarking from json.
-func (s *CytologyUpdatePartialUpdateReqDiagnosticMarking) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentsListOKResultsItemGroupType from json.
+func (s *CytologySegmentsListOKResultsItemGroupType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReqDiagnosticMarking to nil")
+		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemGroupType to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch CytologyUpdatePartialUpdateReqDiagnosticMarking(v) {
-	case CytologyUpdatePartialUpdateReqDiagnosticMarking11:
-		*s = CytologyUpdatePartialUpdateReqDiagnosticMarking11
-	case CytologyUpdatePartialUpdateReqDiagnosticMarking23:
-		*s = CytologyUpdatePartialUpdateReqDiagnosticMarking23
+	switch CytologySegmentsListOKResultsItemGroupType(v) {
+	case CytologySegmentsListOKResultsItemGroupTypeCE:
+		*s = CytologySegmentsListOKResultsItemGroupTypeCE
+	case CytologySegmentsListOKResultsItemGroupTypeCL:
+		*s = CytologySegmentsListOKResultsItemGroupTypeCL
+	case CytologySegmentsListOKResultsItemGroupTypeME:
+		*s = CytologySegmentsListOKResultsItemGroupTypeME
 	default:
-		*s = CytologyUpdatePartialUpdateReqDiagnosticMarking(v)
+		*s = CytologySegmentsListOKResultsItemGroupType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologyUpdatePartialUpdateReqDiagnosticMarking) MarshalJSON() ([]byte, error) {
+func (s CytologySegmentsListOKResultsItemGroupType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdatePartialUpdateReqDiagnosticMarking) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentsListOKResultsItemGroupType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyUpdatePartialUpdateReqMaterialType as json.
-func (s CytologyUpdatePartialUpdateReqMaterialType) Encode(e *jx.Encoder) {
+// Encode encodes CytologySegmentsListOKResultsItemSegType as json.
+func (s CytologySegmentsListOKResultsItemSegType) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes CytologyUpdatePartialUpdateReqMaterialType from json.
-func (s *CytologyUpdatePartialUpdateReqMaterialType) Decode(d *jx.Decoder) error {
+// Decode decodes CytologySegmentsListOKResultsItemSegType from json.
+func (s *CytologySegmentsListOKResultsItemSegType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReqMaterialType to nil")
+		return errors.New("invalid: unable to decode CytologySegmentsListOKResultsItemSegType to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch CytologyUpdatePartialUpdateReqMaterialType(v) {
-	case CytologyUpdatePartialUpdateReqMaterialTypeGS:
-		*s = CytologyUpdatePartialUpdateReqMaterialTypeGS
-	case CytologyUpdatePartialUpdateReqMaterialTypeBP:
-		*s = CytologyUpdatePartialUpdateReqMaterialTypeBP
-	case CytologyUpdatePartialUpdateReqMaterialTypeTP:
-		*s = CytologyUpdatePartialUpdateReqMaterialTypeTP
-	case CytologyUpdatePartialUpdateReqMaterialTypePTP:
-		*s = CytologyUpdatePartialUpdateReqMaterialTypePTP
-	case CytologyUpdatePartialUpdateReqMaterialTypeLNP:
-		*s = CytologyUpdatePartialUpdateReqMaterialTypeLNP
+	switch CytologySegmentsListOKResultsItemSegType(v) {
+	case CytologySegmentsListOKResultsItemSegTypeNIL:
+		*s = CytologySegmentsListOKResultsItemSegTypeNIL
+	case CytologySegmentsListOKResultsItemSegTypeNIR:
+		*s = CytologySegmentsListOKResultsItemSegTypeNIR
+	case CytologySegmentsListOKResultsItemSegTypeNIM:
+		*s = CytologySegmentsListOKResultsItemSegTypeNIM
+	case CytologySegmentsListOKResultsItemSegTypeCNO:
+		*s = CytologySegmentsListOKResultsItemSegTypeCNO
+	case CytologySegmentsListOKResultsItemSegTypeCGE:
+		*s = CytologySegmentsListOKResultsItemSegTypeCGE
+	case CytologySegmentsListOKResultsItemSegTypeC2N:
+		*s = CytologySegmentsListOKResultsItemSegTypeC2N
+	case CytologySegmentsListOKResultsItemSegTypeCPS:
+		*s = CytologySegmentsListOKResultsItemSegTypeCPS
+	case CytologySegmentsListOKResultsItemSegTypeCFC:
+		*s = CytologySegmentsListOKResultsItemSegTypeCFC
+	case CytologySegmentsListOKResultsItemSegTypeCLY:
+		*s = CytologySegmentsListOKResultsItemSegTypeCLY
+	case CytologySegmentsListOKResultsItemSegTypeSOS:
+		*s = CytologySegmentsListOKResultsItemSegTypeSOS
+	case CytologySegmentsListOKResultsItemSegTypeSDS:
+		*s = CytologySegmentsListOKResultsItemSegTypeSDS
+	case CytologySegmentsListOKResultsItemSegTypeSMS:
+		*s = CytologySegmentsListOKResultsItemSegTypeSMS
+	case CytologySegmentsListOKResultsItemSegTypeSTS:
+		*s = CytologySegmentsListOKResultsItemSegTypeSTS
+	case CytologySegmentsListOKResultsItemSegTypeSPS:
+		*s = CytologySegmentsListOKResultsItemSegTypeSPS
+	case CytologySegmentsListOKResultsItemSegTypeSNM:
+		*s = CytologySegmentsListOKResultsItemSegTypeSNM
+	case CytologySegmentsListOKResultsItemSegTypeSTM:
+		*s = CytologySegmentsListOKResultsItemSegTypeSTM
 	default:
-		*s = CytologyUpdatePartialUpdateReqMaterialType(v)
+		*s = CytologySegmentsListOKResultsItemSegType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologyUpdatePartialUpdateReqMaterialType) MarshalJSON() ([]byte, error) {
+func (s CytologySegmentsListOKResultsItemSegType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdatePartialUpdateReqMaterialType) UnmarshalJSON(data []byte) error {
+func (s *CytologySegmentsListOKResultsItemSegType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdatePartialUpdateReqPatientCard) Encode(e *jx.Encoder) {
+func (s *CytologyShotDetails) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdatePartialUpdateReqPatientCard) encodeFields(e *jx.Encoder) {
-	{
-		if s.Patient.Set {
-			e.FieldStart("patient")
-			s.Patient.Encode(e)
-		}
-	}
+func (s *CytologyShotDetails) encodeFields(e *jx.Encoder) {
 	{
-		if s.AcceptanceDatetime.Set {
-			e.FieldStart("acceptance_datetime")
-			s.AcceptanceDatetime.Encode(e, json.EncodeDateTime)
+		if s.AiInfo != nil {
+			e.FieldStart("ai_info")
+			e.ArrStart()
+			for _, elem := range s.AiInfo {
+				if len(elem) != 0 {
+					e.Raw(elem)
+				}
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.Diagnosis.Set {
-			e.FieldStart("diagnosis")
-			s.Diagnosis.Encode(e)
+		if s.Probs != nil {
+			e.FieldStart("probs")
+			e.ArrStart()
+			for _, elem := range s.Probs {
+				e.Float64(elem)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfCytologyUpdatePartialUpdateReqPatientCard = [3]string{
-	0: "patient",
-	1: "acceptance_datetime",
-	2: "diagnosis",
+var jsonFieldsNameOfCytologyShotDetails = [2]string{
+	0: "ai_info",
+	1: "probs",
 }
 
-// Decode decodes CytologyUpdatePartialUpdateReqPatientCard from json.
-func (s *CytologyUpdatePartialUpdateReqPatientCard) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyShotDetails from json.
+func (s *CytologyShotDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReqPatientCard to nil")
+		return errors.New("invalid: unable to decode CytologyShotDetails to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "patient":
+		case "ai_info":
 			if err := func() error {
-				s.Patient.Reset()
-				if err := s.Patient.Decode(d); err != nil {
+				s.AiInfo = make([]jx.Raw, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem jx.Raw
+					v, err := d.RawAppend(nil)
+					elem = jx.Raw(v)
+					if err != nil {
+						return err
+					}
+					s.AiInfo = append(s.AiInfo, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient\"")
+				return errors.Wrap(err, "decode field \"ai_info\"")
 			}
-		case "acceptance_datetime":
+		case "probs":
 			if err := func() error {
-				s.AcceptanceDatetime.Reset()
-				if err := s.AcceptanceDatetime.Decode(d, json.DecodeDateTime); err != nil {
+				s.Probs = make([]float64, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem float64
+					v, err := d.Float64()
+					elem = float64(v)
+					if err != nil {
+						return err
+					}
+					s.Probs = append(s.Probs, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"acceptance_datetime\"")
-			}
-		case "diagnosis":
-			if err := func() error {
-				s.Diagnosis.Reset()
-				if err := s.Diagnosis.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnosis\"")
+				return errors.Wrap(err, "decode field \"probs\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateReqPatientCard")
+		return errors.Wrap(err, "decode CytologyShotDetails")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdatePartialUpdateReqPatientCard) MarshalJSON() ([]byte, error) {
+func (s *CytologyShotDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdatePartialUpdateReqPatientCard) UnmarshalJSON(data []byte) error {
+func (s *CytologyShotDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdateUpdateOK) Encode(e *jx.Encoder) {
+func (s *CytologyShotPatient) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdateUpdateOK) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("patient_card")
-		s.PatientCard.Encode(e)
-	}
-	{
-		if s.Details != nil {
-			e.FieldStart("details")
-			s.Details.Encode(e)
-		}
-	}
-	{
-		if s.IsLast.Set {
-			e.FieldStart("is_last")
-			s.IsLast.Encode(e)
-		}
-	}
-	{
-		if s.DiagnosDate.Set {
-			e.FieldStart("diagnos_date")
-			s.DiagnosDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.DiagnosticMarking.Set {
-			e.FieldStart("diagnostic_marking")
-			s.DiagnosticMarking.Encode(e)
-		}
-	}
+func (s *CytologyShotPatient) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("diagnostic_number")
-		e.Int(s.DiagnosticNumber)
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
 	{
-		if s.MaterialType.Set {
-			e.FieldStart("material_type")
-			s.MaterialType.Encode(e)
-		}
+		e.FieldStart("first_name")
+		e.Str(s.FirstName)
 	}
 	{
-		if s.Calcitonin.Set {
-			e.FieldStart("calcitonin")
-			s.Calcitonin.Encode(e)
-		}
+		e.FieldStart("last_name")
+		e.Str(s.LastName)
 	}
 	{
-		if s.CalcitoninInFlush.Set {
-			e.FieldStart("calcitonin_in_flush")
-			s.CalcitoninInFlush.Encode(e)
-		}
+		e.FieldStart("fathers_name")
+		e.Str(s.FathersName)
 	}
 	{
-		if s.Thyroglobulin.Set {
-			e.FieldStart("thyroglobulin")
-			s.Thyroglobulin.Encode(e)
-		}
+		e.FieldStart("birth_date")
+		json.EncodeDate(e, s.BirthDate)
 	}
 	{
-		if s.Prev.Set {
-			e.FieldStart("prev")
-			s.Prev.Encode(e)
-		}
+		e.FieldStart("personal_policy")
+		e.Str(s.PersonalPolicy)
 	}
 	{
-		if s.ParentPrev.Set {
-			e.FieldStart("parent_prev")
-			s.ParentPrev.Encode(e)
-		}
+		e.FieldStart("email")
+		e.Str(s.Email)
 	}
 	{
-		if s.OriginalImage.Set {
-			e.FieldStart("original_image")
-			s.OriginalImage.Encode(e)
-		}
+		e.FieldStart("is_active")
+		e.Bool(s.IsActive)
 	}
 }
 
-var jsonFieldsNameOfCytologyUpdateUpdateOK = [14]string{
-	0:  "id",
-	1:  "patient_card",
-	2:  "details",
-	3:  "is_last",
-	4:  "diagnos_date",
-	5:  "diagnostic_marking",
-	6:  "diagnostic_number",
-	7:  "material_type",
-	8:  "calcitonin",
-	9:  "calcitonin_in_flush",
-	10: "thyroglobulin",
-	11: "prev",
-	12: "parent_prev",
-	13: "original_image",
+var jsonFieldsNameOfCytologyShotPatient = [8]string{
+	0: "id",
+	1: "first_name",
+	2: "last_name",
+	3: "fathers_name",
+	4: "birth_date",
+	5: "personal_policy",
+	6: "email",
+	7: "is_active",
 }
 
-// Decode decodes CytologyUpdateUpdateOK from json.
-func (s *CytologyUpdateUpdateOK) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyShotPatient from json.
+func (s *CytologyShotPatient) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateOK to nil")
+		return errors.New("invalid: unable to decode CytologyShotPatient to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
 		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "patient_card":
+		case "first_name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.PatientCard.Decode(d); err != nil {
+				v, err := d.Str()
+				s.FirstName = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient_card\"")
+				return errors.Wrap(err, "decode field \"first_name\"")
 			}
-		case "details":
+		case "last_name":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Details = nil
-				var elem CytologyUpdateUpdateOKDetails
-				if err := elem.Decode(d); err != nil {
+				v, err := d.Str()
+				s.LastName = string(v)
+				if err != nil {
 					return err
 				}
-				s.Details = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"details\"")
+				return errors.Wrap(err, "decode field \"last_name\"")
 			}
-		case "is_last":
+		case "fathers_name":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.IsLast.Reset()
-				if err := s.IsLast.Decode(d); err != nil {
+				v, err := d.Str()
+				s.FathersName = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_last\"")
+				return errors.Wrap(err, "decode field \"fathers_name\"")
 			}
-		case "diagnos_date":
+		case "birth_date":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.DiagnosDate.Reset()
-				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := json.DecodeDate(d)
+				s.BirthDate = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnos_date\"")
+				return errors.Wrap(err, "decode field \"birth_date\"")
 			}
-		case "diagnostic_marking":
+		case "personal_policy":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.DiagnosticMarking.Reset()
-				if err := s.DiagnosticMarking.Decode(d); err != nil {
+				v, err := d.Str()
+				s.PersonalPolicy = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
+				return errors.Wrap(err, "decode field \"personal_policy\"")
 			}
-		case "diagnostic_number":
+		case "email":
 			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				v, err := d.Int()
-				s.DiagnosticNumber = int(v)
+				v, err := d.Str()
+				s.Email = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnostic_number\"")
-			}
-		case "material_type":
-			if err := func() error {
-				s.MaterialType.Reset()
-				if err := s.MaterialType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"material_type\"")
+				return errors.Wrap(err, "decode field \"email\"")
 			}
-		case "calcitonin":
+		case "is_active":
+			requiredBitSet[0] |= 1 << 7
 			if err := func() error {
-				s.Calcitonin.Reset()
-				if err := s.Calcitonin.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.IsActive = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin\"")
+				return errors.Wrap(err, "decode field \"is_active\"")
 			}
-		case "calcitonin_in_flush":
-			if err := func() error {
-				s.CalcitoninInFlush.Reset()
-				if err := s.CalcitoninInFlush.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologyShotPatient")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b11111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCytologyShotPatient) {
+					name = jsonFieldsNameOfCytologyShotPatient[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
-			}
-		case "thyroglobulin":
-			if err := func() error {
-				s.Thyroglobulin.Reset()
-				if err := s.Thyroglobulin.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"thyroglobulin\"")
-			}
-		case "prev":
-			if err := func() error {
-				s.Prev.Reset()
-				if err := s.Prev.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"prev\"")
-			}
-		case "parent_prev":
-			if err := func() error {
-				s.ParentPrev.Reset()
-				if err := s.ParentPrev.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"parent_prev\"")
-			}
-		case "original_image":
-			if err := func() error {
-				s.OriginalImage.Reset()
-				if err := s.OriginalImage.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"original_image\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdateUpdateOK")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b01000010,
-		0b00000000,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyUpdateUpdateOK) {
-					name = jsonFieldsNameOfCytologyUpdateUpdateOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
 		}
 	}
@@ -7241,115 +7556,107 @@ func (s *CytologyUpdateUpdateOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdateUpdateOK) MarshalJSON() ([]byte, error) {
+func (s *CytologyShotPatient) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateOK) UnmarshalJSON(data []byte) error {
+func (s *CytologyShotPatient) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdateUpdateOKDetails) Encode(e *jx.Encoder) {
+func (s *CytologyShotPatientCard) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdateUpdateOKDetails) encodeFields(e *jx.Encoder) {
-}
-
-var jsonFieldsNameOfCytologyUpdateUpdateOKDetails = [0]string{}
-
-// Decode decodes CytologyUpdateUpdateOKDetails from json.
-func (s *CytologyUpdateUpdateOKDetails) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateOKDetails to nil")
+func (s *CytologyShotPatientCard) encodeFields(e *jx.Encoder) {
+	{
+		if s.AcceptanceDatetime.Set {
+			e.FieldStart("acceptance_datetime")
+			s.AcceptanceDatetime.Encode(e, json.EncodeDateTime)
+		}
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
+	{
+		if s.Diagnosis.Set {
+			e.FieldStart("diagnosis")
+			s.Diagnosis.Encode(e)
 		}
-	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdateUpdateOKDetails")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdateUpdateOKDetails) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateOKDetails) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CytologyUpdateUpdateOKPatientCard) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *CytologyUpdateUpdateOKPatientCard) encodeFields(e *jx.Encoder) {
+var jsonFieldsNameOfCytologyShotPatientCard = [2]string{
+	0: "acceptance_datetime",
+	1: "diagnosis",
 }
 
-var jsonFieldsNameOfCytologyUpdateUpdateOKPatientCard = [0]string{}
-
-// Decode decodes CytologyUpdateUpdateOKPatientCard from json.
-func (s *CytologyUpdateUpdateOKPatientCard) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyShotPatientCard from json.
+func (s *CytologyShotPatientCard) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateOKPatientCard to nil")
+		return errors.New("invalid: unable to decode CytologyShotPatientCard to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "acceptance_datetime":
+			if err := func() error {
+				s.AcceptanceDatetime.Reset()
+				if err := s.AcceptanceDatetime.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"acceptance_datetime\"")
+			}
+		case "diagnosis":
+			if err := func() error {
+				s.Diagnosis.Reset()
+				if err := s.Diagnosis.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnosis\"")
+			}
 		default:
 			return d.Skip()
 		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdateUpdateOKPatientCard")
+		return errors.Wrap(err, "decode CytologyShotPatientCard")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdateUpdateOKPatientCard) MarshalJSON() ([]byte, error) {
+func (s *CytologyShotPatientCard) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateOKPatientCard) UnmarshalJSON(data []byte) error {
+func (s *CytologyShotPatientCard) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdateUpdateReq) Encode(e *jx.Encoder) {
+func (s *CytologyUpdatePartialUpdateOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdateUpdateReq) encodeFields(e *jx.Encoder) {
+func (s *CytologyUpdatePartialUpdateOK) encodeFields(e *jx.Encoder) {
 	{
 		if s.ID.Set {
 			e.FieldStart("id")
@@ -7361,7 +7668,7 @@ func (s *CytologyUpdateUpdateReq) encodeFields(e *jx.Encoder) {
 		s.PatientCard.Encode(e)
 	}
 	{
-		if s.Details.Set {
+		if s.Details != nil {
 			e.FieldStart("details")
 			s.Details.Encode(e)
 		}
@@ -7432,7 +7739,7 @@ func (s *CytologyUpdateUpdateReq) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfCytologyUpdateUpdateReq = [14]string{
+var jsonFieldsNameOfCytologyUpdatePartialUpdateOK = [14]string{
 	0:  "id",
 	1:  "patient_card",
 	2:  "details",
@@ -7449,10 +7756,10 @@ var jsonFieldsNameOfCytologyUpdateUpdateReq = [14]string{
 	13: "original_image",
 }
 
-// Decode decodes CytologyUpdateUpdateReq from json.
-func (s *CytologyUpdateUpdateReq) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyUpdatePartialUpdateOK from json.
+func (s *CytologyUpdatePartialUpdateOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateReq to nil")
+		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateOK to nil")
 	}
 	var requiredBitSet [2]uint8
 
@@ -7480,10 +7787,12 @@ func (s *CytologyUpdateUpdateReq) Decode(d *jx.Decoder) error {
 			}
 		case "details":
 			if err := func() error {
-				s.Details.Reset()
-				if err := s.Details.Decode(d); err != nil {
+				s.Details = nil
+				var elem CytologyUpdatePartialUpdateOKDetails
+				if err := elem.Decode(d); err != nil {
 					return err
 				}
+				s.Details = &elem
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"details\"")
@@ -7605,7 +7914,7 @@ func (s *CytologyUpdateUpdateReq) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdateUpdateReq")
+		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -7623,8 +7932,8 @@ func (s *CytologyUpdateUpdateReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyUpdateUpdateReq) {
-					name = jsonFieldsNameOfCytologyUpdateUpdateReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyUpdatePartialUpdateOK) {
+					name = jsonFieldsNameOfCytologyUpdatePartialUpdateOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7645,76 +7954,174 @@ func (s *CytologyUpdateUpdateReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdateUpdateReq) MarshalJSON() ([]byte, error) {
+func (s *CytologyUpdatePartialUpdateOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateReq) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdatePartialUpdateOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdateUpdateReqDetails) Encode(e *jx.Encoder) {
+func (s *CytologyUpdatePartialUpdateOKDetails) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdateUpdateReqDetails) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.IsLast.Set {
-			e.FieldStart("is_last")
-			s.IsLast.Encode(e)
-		}
-	}
-	{
-		if s.DiagnosDate.Set {
-			e.FieldStart("diagnos_date")
-			s.DiagnosDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.DiagnosticMarking.Set {
-			e.FieldStart("diagnostic_marking")
-			s.DiagnosticMarking.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("diagnostic_number")
-		e.Int(s.DiagnosticNumber)
-	}
-	{
-		if s.MaterialType.Set {
-			e.FieldStart("material_type")
-			s.MaterialType.Encode(e)
-		}
-	}
-	{
-		if s.Calcitonin.Set {
-			e.FieldStart("calcitonin")
-			s.Calcitonin.Encode(e)
-		}
+func (s *CytologyUpdatePartialUpdateOKDetails) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfCytologyUpdatePartialUpdateOKDetails = [0]string{}
+
+// Decode decodes CytologyUpdatePartialUpdateOKDetails from json.
+func (s *CytologyUpdatePartialUpdateOKDetails) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateOKDetails to nil")
 	}
-	{
-		if s.CalcitoninInFlush.Set {
-			e.FieldStart("calcitonin_in_flush")
-			s.CalcitoninInFlush.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateOKDetails")
 	}
-	{
-		if s.Thyroglobulin.Set {
-			e.FieldStart("thyroglobulin")
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CytologyUpdatePartialUpdateOKDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyUpdatePartialUpdateOKDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CytologyUpdatePartialUpdateOKPatientCard) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologyUpdatePartialUpdateOKPatientCard) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfCytologyUpdatePartialUpdateOKPatientCard = [0]string{}
+
+// Decode decodes CytologyUpdatePartialUpdateOKPatientCard from json.
+func (s *CytologyUpdatePartialUpdateOKPatientCard) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateOKPatientCard to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
+		}
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateOKPatientCard")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CytologyUpdatePartialUpdateOKPatientCard) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyUpdatePartialUpdateOKPatientCard) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CytologyUpdatePartialUpdateReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologyUpdatePartialUpdateReq) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("patient_card")
+		s.PatientCard.Encode(e)
+	}
+	{
+		if s.Details != nil {
+			e.FieldStart("details")
+			s.Details.Encode(e)
+		}
+	}
+	{
+		if s.IsLast.Set {
+			e.FieldStart("is_last")
+			s.IsLast.Encode(e)
+		}
+	}
+	{
+		if s.DiagnosDate.Set {
+			e.FieldStart("diagnos_date")
+			s.DiagnosDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.DiagnosticMarking.Set {
+			e.FieldStart("diagnostic_marking")
+			s.DiagnosticMarking.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("diagnostic_number")
+		e.Int(s.DiagnosticNumber)
+	}
+	{
+		if s.MaterialType.Set {
+			e.FieldStart("material_type")
+			s.MaterialType.Encode(e)
+		}
+	}
+	{
+		if s.Calcitonin.Set {
+			e.FieldStart("calcitonin")
+			s.Calcitonin.Encode(e)
+		}
+	}
+	{
+		if s.CalcitoninInFlush.Set {
+			e.FieldStart("calcitonin_in_flush")
+			s.CalcitoninInFlush.Encode(e)
+		}
+	}
+	{
+		if s.Thyroglobulin.Set {
+			e.FieldStart("thyroglobulin")
 			s.Thyroglobulin.Encode(e)
 		}
 	}
@@ -7730,12 +8137,6 @@ func (s *CytologyUpdateUpdateReqDetails) encodeFields(e *jx.Encoder) {
 			s.ParentPrev.Encode(e)
 		}
 	}
-	{
-		if s.PatientCard.Set {
-			e.FieldStart("patient_card")
-			s.PatientCard.Encode(e)
-		}
-	}
 	{
 		if s.OriginalImage.Set {
 			e.FieldStart("original_image")
@@ -7744,26 +8145,27 @@ func (s *CytologyUpdateUpdateReqDetails) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfCytologyUpdateUpdateReqDetails = [13]string{
+var jsonFieldsNameOfCytologyUpdatePartialUpdateReq = [14]string{
 	0:  "id",
-	1:  "is_last",
-	2:  "diagnos_date",
-	3:  "diagnostic_marking",
-	4:  "diagnostic_number",
-	5:  "material_type",
-	6:  "calcitonin",
-	7:  "calcitonin_in_flush",
-	8:  "thyroglobulin",
-	9:  "prev",
-	10: "parent_prev",
-	11: "patient_card",
-	12: "original_image",
+	1:  "patient_card",
+	2:  "details",
+	3:  "is_last",
+	4:  "diagnos_date",
+	5:  "diagnostic_marking",
+	6:  "diagnostic_number",
+	7:  "material_type",
+	8:  "calcitonin",
+	9:  "calcitonin_in_flush",
+	10: "thyroglobulin",
+	11: "prev",
+	12: "parent_prev",
+	13: "original_image",
 }
 
-// Decode decodes CytologyUpdateUpdateReqDetails from json.
-func (s *CytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyUpdatePartialUpdateReq from json.
+func (s *CytologyUpdatePartialUpdateReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqDetails to nil")
+		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReq to nil")
 	}
 	var requiredBitSet [2]uint8
 
@@ -7779,6 +8181,28 @@ func (s *CytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
+		case "patient_card":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.PatientCard.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patient_card\"")
+			}
+		case "details":
+			if err := func() error {
+				s.Details = nil
+				var elem CytologyUpdatePartialUpdateReqDetails
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.Details = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"details\"")
+			}
 		case "is_last":
 			if err := func() error {
 				s.IsLast.Reset()
@@ -7810,7 +8234,7 @@ func (s *CytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
 			}
 		case "diagnostic_number":
-			requiredBitSet[0] |= 1 << 4
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
 				v, err := d.Int()
 				s.DiagnosticNumber = int(v)
@@ -7881,16 +8305,6 @@ func (s *CytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"parent_prev\"")
 			}
-		case "patient_card":
-			if err := func() error {
-				s.PatientCard.Reset()
-				if err := s.PatientCard.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient_card\"")
-			}
 		case "original_image":
 			if err := func() error {
 				s.OriginalImage.Reset()
@@ -7906,12 +8320,12 @@ func (s *CytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdateUpdateReqDetails")
+		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b00010000,
+		0b01000010,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -7924,8 +8338,8 @@ func (s *CytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCytologyUpdateUpdateReqDetails) {
-					name = jsonFieldsNameOfCytologyUpdateUpdateReqDetails[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyUpdatePartialUpdateReq) {
+					name = jsonFieldsNameOfCytologyUpdatePartialUpdateReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7946,199 +8360,157 @@ func (s *CytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdateUpdateReqDetails) MarshalJSON() ([]byte, error) {
+func (s *CytologyUpdatePartialUpdateReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateReqDetails) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdatePartialUpdateReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyUpdateUpdateReqDetailsDiagnosticMarking as json.
-func (s CytologyUpdateUpdateReqDetailsDiagnosticMarking) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *CytologyUpdatePartialUpdateReqDetails) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CytologyUpdateUpdateReqDetailsDiagnosticMarking from json.
-func (s *CytologyUpdateUpdateReqDetailsDiagnosticMarking) Decode(d *jx.Decoder) error {
+// encodeFields encodes fields.
+func (s *CytologyUpdatePartialUpdateReqDetails) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfCytologyUpdatePartialUpdateReqDetails = [0]string{}
+
+// Decode decodes CytologyUpdatePartialUpdateReqDetails from json.
+func (s *CytologyUpdatePartialUpdateReqDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqDetailsDiagnosticMarking to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReqDetails to nil")
 	}
-	// Try to use constant string.
-	switch CytologyUpdateUpdateReqDetailsDiagnosticMarking(v) {
-	case CytologyUpdateUpdateReqDetailsDiagnosticMarking11:
-		*s = CytologyUpdateUpdateReqDetailsDiagnosticMarking11
-	case CytologyUpdateUpdateReqDetailsDiagnosticMarking23:
-		*s = CytologyUpdateUpdateReqDetailsDiagnosticMarking23
-	default:
-		*s = CytologyUpdateUpdateReqDetailsDiagnosticMarking(v)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
+		}
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateReqDetails")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologyUpdateUpdateReqDetailsDiagnosticMarking) MarshalJSON() ([]byte, error) {
+func (s *CytologyUpdatePartialUpdateReqDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateReqDetailsDiagnosticMarking) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CytologyUpdateUpdateReqDetailsMaterialType as json.
-func (s CytologyUpdateUpdateReqDetailsMaterialType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes CytologyUpdateUpdateReqDetailsMaterialType from json.
-func (s *CytologyUpdateUpdateReqDetailsMaterialType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqDetailsMaterialType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch CytologyUpdateUpdateReqDetailsMaterialType(v) {
-	case CytologyUpdateUpdateReqDetailsMaterialTypeGS:
-		*s = CytologyUpdateUpdateReqDetailsMaterialTypeGS
-	case CytologyUpdateUpdateReqDetailsMaterialTypeBP:
-		*s = CytologyUpdateUpdateReqDetailsMaterialTypeBP
-	case CytologyUpdateUpdateReqDetailsMaterialTypeTP:
-		*s = CytologyUpdateUpdateReqDetailsMaterialTypeTP
-	case CytologyUpdateUpdateReqDetailsMaterialTypePTP:
-		*s = CytologyUpdateUpdateReqDetailsMaterialTypePTP
-	case CytologyUpdateUpdateReqDetailsMaterialTypeLNP:
-		*s = CytologyUpdateUpdateReqDetailsMaterialTypeLNP
-	default:
-		*s = CytologyUpdateUpdateReqDetailsMaterialType(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CytologyUpdateUpdateReqDetailsMaterialType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateReqDetailsMaterialType) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdatePartialUpdateReqDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyUpdateUpdateReqDiagnosticMarking as json.
-func (s CytologyUpdateUpdateReqDiagnosticMarking) Encode(e *jx.Encoder) {
+// Encode encodes CytologyUpdatePartialUpdateReqDiagnosticMarking as json.
+func (s CytologyUpdatePartialUpdateReqDiagnosticMarking) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes CytologyUpdateUpdateReqDiagnosticMarking from json.
-func (s *CytologyUpdateUpdateReqDiagnosticMarking) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyUpdatePartialUpdateReqDiagnosticMarking from json.
+func (s *CytologyUpdatePartialUpdateReqDiagnosticMarking) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqDiagnosticMarking to nil")
+		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReqDiagnosticMarking to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch CytologyUpdateUpdateReqDiagnosticMarking(v) {
-	case CytologyUpdateUpdateReqDiagnosticMarking11:
-		*s = CytologyUpdateUpdateReqDiagnosticMarking11
-	case CytologyUpdateUpdateReqDiagnosticMarking23:
-		*s = CytologyUpdateUpdateReqDiagnosticMarking23
+	switch CytologyUpdatePartialUpdateReqDiagnosticMarking(v) {
+	case CytologyUpdatePartialUpdateReqDiagnosticMarking11:
+		*s = CytologyUpdatePartialUpdateReqDiagnosticMarking11
+	case CytologyUpdatePartialUpdateReqDiagnosticMarking23:
+		*s = CytologyUpdatePartialUpdateReqDiagnosticMarking23
 	default:
-		*s = CytologyUpdateUpdateReqDiagnosticMarking(v)
+		*s = CytologyUpdatePartialUpdateReqDiagnosticMarking(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologyUpdateUpdateReqDiagnosticMarking) MarshalJSON() ([]byte, error) {
+func (s CytologyUpdatePartialUpdateReqDiagnosticMarking) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateReqDiagnosticMarking) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdatePartialUpdateReqDiagnosticMarking) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyUpdateUpdateReqMaterialType as json.
-func (s CytologyUpdateUpdateReqMaterialType) Encode(e *jx.Encoder) {
+// Encode encodes CytologyUpdatePartialUpdateReqMaterialType as json.
+func (s CytologyUpdatePartialUpdateReqMaterialType) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes CytologyUpdateUpdateReqMaterialType from json.
-func (s *CytologyUpdateUpdateReqMaterialType) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyUpdatePartialUpdateReqMaterialType from json.
+func (s *CytologyUpdatePartialUpdateReqMaterialType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqMaterialType to nil")
+		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReqMaterialType to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch CytologyUpdateUpdateReqMaterialType(v) {
-	case CytologyUpdateUpdateReqMaterialTypeGS:
-		*s = CytologyUpdateUpdateReqMaterialTypeGS
-	case CytologyUpdateUpdateReqMaterialTypeBP:
-		*s = CytologyUpdateUpdateReqMaterialTypeBP
-	case CytologyUpdateUpdateReqMaterialTypeTP:
-		*s = CytologyUpdateUpdateReqMaterialTypeTP
-	case CytologyUpdateUpdateReqMaterialTypePTP:
-		*s = CytologyUpdateUpdateReqMaterialTypePTP
-	case CytologyUpdateUpdateReqMaterialTypeLNP:
-		*s = CytologyUpdateUpdateReqMaterialTypeLNP
+	switch CytologyUpdatePartialUpdateReqMaterialType(v) {
+	case CytologyUpdatePartialUpdateReqMaterialTypeGS:
+		*s = CytologyUpdatePartialUpdateReqMaterialTypeGS
+	case CytologyUpdatePartialUpdateReqMaterialTypeBP:
+		*s = CytologyUpdatePartialUpdateReqMaterialTypeBP
+	case CytologyUpdatePartialUpdateReqMaterialTypeTP:
+		*s = CytologyUpdatePartialUpdateReqMaterialTypeTP
+	case CytologyUpdatePartialUpdateReqMaterialTypePTP:
+		*s = CytologyUpdatePartialUpdateReqMaterialTypePTP
+	case CytologyUpdatePartialUpdateReqMaterialTypeLNP:
+		*s = CytologyUpdatePartialUpdateReqMaterialTypeLNP
 	default:
-		*s = CytologyUpdateUpdateReqMaterialType(v)
+		*s = CytologyUpdatePartialUpdateReqMaterialType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CytologyUpdateUpdateReqMaterialType) MarshalJSON() ([]byte, error) {
+func (s CytologyUpdatePartialUpdateReqMaterialType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateReqMaterialType) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdatePartialUpdateReqMaterialType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CytologyUpdateUpdateReqPatientCard) Encode(e *jx.Encoder) {
+func (s *CytologyUpdatePartialUpdateReqPatientCard) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CytologyUpdateUpdateReqPatientCard) encodeFields(e *jx.Encoder) {
+func (s *CytologyUpdatePartialUpdateReqPatientCard) encodeFields(e *jx.Encoder) {
 	{
 		if s.Patient.Set {
 			e.FieldStart("patient")
@@ -8159,16 +8531,16 @@ func (s *CytologyUpdateUpdateReqPatientCard) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfCytologyUpdateUpdateReqPatientCard = [3]string{
+var jsonFieldsNameOfCytologyUpdatePartialUpdateReqPatientCard = [3]string{
 	0: "patient",
 	1: "acceptance_datetime",
 	2: "diagnosis",
 }
 
-// Decode decodes CytologyUpdateUpdateReqPatientCard from json.
-func (s *CytologyUpdateUpdateReqPatientCard) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyUpdatePartialUpdateReqPatientCard from json.
+func (s *CytologyUpdatePartialUpdateReqPatientCard) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqPatientCard to nil")
+		return errors.New("invalid: unable to decode CytologyUpdatePartialUpdateReqPatientCard to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -8208,257 +8580,298 @@ func (s *CytologyUpdateUpdateReqPatientCard) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CytologyUpdateUpdateReqPatientCard")
+		return errors.Wrap(err, "decode CytologyUpdatePartialUpdateReqPatientCard")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CytologyUpdateUpdateReqPatientCard) MarshalJSON() ([]byte, error) {
+func (s *CytologyUpdatePartialUpdateReqPatientCard) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CytologyUpdateUpdateReqPatientCard) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdatePartialUpdateReqPatientCard) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Device) Encode(e *jx.Encoder) {
+func (s *CytologyUpdateUpdateOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Device) encodeFields(e *jx.Encoder) {
+func (s *CytologyUpdateUpdateOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int(s.ID)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("patient_card")
+		s.PatientCard.Encode(e)
+	}
+	{
+		if s.Details != nil {
+			e.FieldStart("details")
+			s.Details.Encode(e)
+		}
+	}
+	{
+		if s.IsLast.Set {
+			e.FieldStart("is_last")
+			s.IsLast.Encode(e)
+		}
+	}
+	{
+		if s.DiagnosDate.Set {
+			e.FieldStart("diagnos_date")
+			s.DiagnosDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.DiagnosticMarking.Set {
+			e.FieldStart("diagnostic_marking")
+			s.DiagnosticMarking.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("diagnostic_number")
+		e.Int(s.DiagnosticNumber)
+	}
+	{
+		if s.MaterialType.Set {
+			e.FieldStart("material_type")
+			s.MaterialType.Encode(e)
+		}
+	}
+	{
+		if s.Calcitonin.Set {
+			e.FieldStart("calcitonin")
+			s.Calcitonin.Encode(e)
+		}
+	}
+	{
+		if s.CalcitoninInFlush.Set {
+			e.FieldStart("calcitonin_in_flush")
+			s.CalcitoninInFlush.Encode(e)
+		}
+	}
+	{
+		if s.Thyroglobulin.Set {
+			e.FieldStart("thyroglobulin")
+			s.Thyroglobulin.Encode(e)
+		}
+	}
+	{
+		if s.Prev.Set {
+			e.FieldStart("prev")
+			s.Prev.Encode(e)
+		}
+	}
+	{
+		if s.ParentPrev.Set {
+			e.FieldStart("parent_prev")
+			s.ParentPrev.Encode(e)
+		}
+	}
+	{
+		if s.OriginalImage.Set {
+			e.FieldStart("original_image")
+			s.OriginalImage.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfDevice = [2]string{
-	0: "id",
-	1: "name",
+var jsonFieldsNameOfCytologyUpdateUpdateOK = [14]string{
+	0:  "id",
+	1:  "patient_card",
+	2:  "details",
+	3:  "is_last",
+	4:  "diagnos_date",
+	5:  "diagnostic_marking",
+	6:  "diagnostic_number",
+	7:  "material_type",
+	8:  "calcitonin",
+	9:  "calcitonin_in_flush",
+	10: "thyroglobulin",
+	11: "prev",
+	12: "parent_prev",
+	13: "original_image",
 }
 
-// Decode decodes Device from json.
-func (s *Device) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyUpdateUpdateOK from json.
+func (s *CytologyUpdateUpdateOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Device to nil")
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateOK to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
 		case "id":
-			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "name":
+		case "patient_card":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				if err := s.PatientCard.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"patient_card\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Device")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfDevice) {
-					name = jsonFieldsNameOfDevice[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "details":
+			if err := func() error {
+				s.Details = nil
+				var elem CytologyUpdateUpdateOKDetails
+				if err := elem.Decode(d); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				s.Details = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"details\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *Device) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Device) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *Doctor) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *Doctor) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
-	{
-		e.FieldStart("fullname")
-		e.Str(s.Fullname)
-	}
-	{
-		e.FieldStart("org")
-		e.Str(s.Org)
-	}
-	{
-		e.FieldStart("job")
-		e.Str(s.Job)
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfDoctor = [5]string{
-	0: "id",
-	1: "fullname",
-	2: "org",
-	3: "job",
-	4: "description",
-}
-
-// Decode decodes Doctor from json.
-func (s *Doctor) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Doctor to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
+		case "is_last":
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
+				s.IsLast.Reset()
+				if err := s.IsLast.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"is_last\"")
 			}
-		case "fullname":
-			requiredBitSet[0] |= 1 << 1
+		case "diagnos_date":
 			if err := func() error {
-				v, err := d.Str()
-				s.Fullname = string(v)
-				if err != nil {
+				s.DiagnosDate.Reset()
+				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fullname\"")
+				return errors.Wrap(err, "decode field \"diagnos_date\"")
 			}
-		case "org":
-			requiredBitSet[0] |= 1 << 2
+		case "diagnostic_marking":
 			if err := func() error {
-				v, err := d.Str()
-				s.Org = string(v)
-				if err != nil {
+				s.DiagnosticMarking.Reset()
+				if err := s.DiagnosticMarking.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"org\"")
+				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
 			}
-		case "job":
-			requiredBitSet[0] |= 1 << 3
+		case "diagnostic_number":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				v, err := d.Str()
-				s.Job = string(v)
+				v, err := d.Int()
+				s.DiagnosticNumber = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"job\"")
+				return errors.Wrap(err, "decode field \"diagnostic_number\"")
 			}
-		case "description":
+		case "material_type":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.MaterialType.Reset()
+				if err := s.MaterialType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"material_type\"")
+			}
+		case "calcitonin":
+			if err := func() error {
+				s.Calcitonin.Reset()
+				if err := s.Calcitonin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"calcitonin\"")
+			}
+		case "calcitonin_in_flush":
+			if err := func() error {
+				s.CalcitoninInFlush.Reset()
+				if err := s.CalcitoninInFlush.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
+			}
+		case "thyroglobulin":
+			if err := func() error {
+				s.Thyroglobulin.Reset()
+				if err := s.Thyroglobulin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"thyroglobulin\"")
+			}
+		case "prev":
+			if err := func() error {
+				s.Prev.Reset()
+				if err := s.Prev.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"prev\"")
+			}
+		case "parent_prev":
+			if err := func() error {
+				s.ParentPrev.Reset()
+				if err := s.ParentPrev.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parent_prev\"")
+			}
+		case "original_image":
+			if err := func() error {
+				s.OriginalImage.Reset()
+				if err := s.OriginalImage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"original_image\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Doctor")
+		return errors.Wrap(err, "decode CytologyUpdateUpdateOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001111,
+	for i, mask := range [2]uint8{
+		0b01000010,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -8470,8 +8883,8 @@ func (s *Doctor) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDoctor) {
-					name = jsonFieldsNameOfDoctor[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyUpdateUpdateOK) {
+					name = jsonFieldsNameOfCytologyUpdateUpdateOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -8492,376 +8905,376 @@ func (s *Doctor) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Doctor) MarshalJSON() ([]byte, error) {
+func (s *CytologyUpdateUpdateOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Doctor) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdateUpdateOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Echographics) Encode(e *jx.Encoder) {
+func (s *CytologyUpdateUpdateOKDetails) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Echographics) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
-	{
-		if s.Contors.Set {
-			e.FieldStart("contors")
-			s.Contors.Encode(e)
-		}
+func (s *CytologyUpdateUpdateOKDetails) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfCytologyUpdateUpdateOKDetails = [0]string{}
+
+// Decode decodes CytologyUpdateUpdateOKDetails from json.
+func (s *CytologyUpdateUpdateOKDetails) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateOKDetails to nil")
 	}
-	{
-		if s.LeftLobeLength.Set {
-			e.FieldStart("left_lobe_length")
-			s.LeftLobeLength.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologyUpdateUpdateOKDetails")
 	}
-	{
-		if s.LeftLobeWidth.Set {
-			e.FieldStart("left_lobe_width")
-			s.LeftLobeWidth.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CytologyUpdateUpdateOKDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyUpdateUpdateOKDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CytologyUpdateUpdateOKPatientCard) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologyUpdateUpdateOKPatientCard) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfCytologyUpdateUpdateOKPatientCard = [0]string{}
+
+// Decode decodes CytologyUpdateUpdateOKPatientCard from json.
+func (s *CytologyUpdateUpdateOKPatientCard) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateOKPatientCard to nil")
 	}
-	{
-		if s.LeftLobeThick.Set {
-			e.FieldStart("left_lobe_thick")
-			s.LeftLobeThick.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode CytologyUpdateUpdateOKPatientCard")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CytologyUpdateUpdateOKPatientCard) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyUpdateUpdateOKPatientCard) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CytologyUpdateUpdateReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CytologyUpdateUpdateReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.LeftLobeVolum.Set {
-			e.FieldStart("left_lobe_volum")
-			s.LeftLobeVolum.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.RightLobeLength.Set {
-			e.FieldStart("right_lobe_length")
-			s.RightLobeLength.Encode(e)
-		}
+		e.FieldStart("patient_card")
+		s.PatientCard.Encode(e)
 	}
 	{
-		if s.RightLobeWidth.Set {
-			e.FieldStart("right_lobe_width")
-			s.RightLobeWidth.Encode(e)
+		if s.Details.Set {
+			e.FieldStart("details")
+			s.Details.Encode(e)
 		}
 	}
 	{
-		if s.RightLobeThick.Set {
-			e.FieldStart("right_lobe_thick")
-			s.RightLobeThick.Encode(e)
+		if s.IsLast.Set {
+			e.FieldStart("is_last")
+			s.IsLast.Encode(e)
 		}
 	}
 	{
-		if s.RightLobeVolum.Set {
-			e.FieldStart("right_lobe_volum")
-			s.RightLobeVolum.Encode(e)
+		if s.DiagnosDate.Set {
+			e.FieldStart("diagnos_date")
+			s.DiagnosDate.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.GlandVolum.Set {
-			e.FieldStart("gland_volum")
-			s.GlandVolum.Encode(e)
+		if s.DiagnosticMarking.Set {
+			e.FieldStart("diagnostic_marking")
+			s.DiagnosticMarking.Encode(e)
 		}
 	}
 	{
-		if s.Isthmus.Set {
-			e.FieldStart("isthmus")
-			s.Isthmus.Encode(e)
-		}
+		e.FieldStart("diagnostic_number")
+		e.Int(s.DiagnosticNumber)
 	}
 	{
-		if s.Struct.Set {
-			e.FieldStart("struct")
-			s.Struct.Encode(e)
+		if s.MaterialType.Set {
+			e.FieldStart("material_type")
+			s.MaterialType.Encode(e)
 		}
 	}
 	{
-		if s.Echogenicity.Set {
-			e.FieldStart("echogenicity")
-			s.Echogenicity.Encode(e)
+		if s.Calcitonin.Set {
+			e.FieldStart("calcitonin")
+			s.Calcitonin.Encode(e)
 		}
 	}
 	{
-		if s.RegionalLymph.Set {
-			e.FieldStart("regional_lymph")
-			s.RegionalLymph.Encode(e)
+		if s.CalcitoninInFlush.Set {
+			e.FieldStart("calcitonin_in_flush")
+			s.CalcitoninInFlush.Encode(e)
 		}
 	}
 	{
-		if s.Vascularization.Set {
-			e.FieldStart("vascularization")
-			s.Vascularization.Encode(e)
+		if s.Thyroglobulin.Set {
+			e.FieldStart("thyroglobulin")
+			s.Thyroglobulin.Encode(e)
 		}
 	}
 	{
-		if s.Location.Set {
-			e.FieldStart("location")
-			s.Location.Encode(e)
+		if s.Prev.Set {
+			e.FieldStart("prev")
+			s.Prev.Encode(e)
 		}
 	}
 	{
-		if s.Additional.Set {
-			e.FieldStart("additional")
-			s.Additional.Encode(e)
+		if s.ParentPrev.Set {
+			e.FieldStart("parent_prev")
+			s.ParentPrev.Encode(e)
 		}
 	}
 	{
-		if s.Conclusion.Set {
-			e.FieldStart("conclusion")
-			s.Conclusion.Encode(e)
+		if s.OriginalImage.Set {
+			e.FieldStart("original_image")
+			s.OriginalImage.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfEchographics = [19]string{
+var jsonFieldsNameOfCytologyUpdateUpdateReq = [14]string{
 	0:  "id",
-	1:  "contors",
-	2:  "left_lobe_length",
-	3:  "left_lobe_width",
-	4:  "left_lobe_thick",
-	5:  "left_lobe_volum",
-	6:  "right_lobe_length",
-	7:  "right_lobe_width",
-	8:  "right_lobe_thick",
-	9:  "right_lobe_volum",
-	10: "gland_volum",
-	11: "isthmus",
-	12: "struct",
-	13: "echogenicity",
-	14: "regional_lymph",
-	15: "vascularization",
-	16: "location",
-	17: "additional",
-	18: "conclusion",
+	1:  "patient_card",
+	2:  "details",
+	3:  "is_last",
+	4:  "diagnos_date",
+	5:  "diagnostic_marking",
+	6:  "diagnostic_number",
+	7:  "material_type",
+	8:  "calcitonin",
+	9:  "calcitonin_in_flush",
+	10: "thyroglobulin",
+	11: "prev",
+	12: "parent_prev",
+	13: "original_image",
 }
 
-// Decode decodes Echographics from json.
-func (s *Echographics) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyUpdateUpdateReq from json.
+func (s *CytologyUpdateUpdateReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Echographics to nil")
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateReq to nil")
 	}
-	var requiredBitSet [3]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
 		case "id":
-			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "contors":
+		case "patient_card":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Contors.Reset()
-				if err := s.Contors.Decode(d); err != nil {
+				if err := s.PatientCard.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"contors\"")
+				return errors.Wrap(err, "decode field \"patient_card\"")
 			}
-		case "left_lobe_length":
+		case "details":
 			if err := func() error {
-				s.LeftLobeLength.Reset()
-				if err := s.LeftLobeLength.Decode(d); err != nil {
+				s.Details.Reset()
+				if err := s.Details.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"left_lobe_length\"")
+				return errors.Wrap(err, "decode field \"details\"")
 			}
-		case "left_lobe_width":
+		case "is_last":
 			if err := func() error {
-				s.LeftLobeWidth.Reset()
-				if err := s.LeftLobeWidth.Decode(d); err != nil {
+				s.IsLast.Reset()
+				if err := s.IsLast.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"left_lobe_width\"")
+				return errors.Wrap(err, "decode field \"is_last\"")
 			}
-		case "left_lobe_thick":
+		case "diagnos_date":
 			if err := func() error {
-				s.LeftLobeThick.Reset()
-				if err := s.LeftLobeThick.Decode(d); err != nil {
+				s.DiagnosDate.Reset()
+				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"left_lobe_thick\"")
+				return errors.Wrap(err, "decode field \"diagnos_date\"")
 			}
-		case "left_lobe_volum":
+		case "diagnostic_marking":
 			if err := func() error {
-				s.LeftLobeVolum.Reset()
-				if err := s.LeftLobeVolum.Decode(d); err != nil {
+				s.DiagnosticMarking.Reset()
+				if err := s.DiagnosticMarking.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"left_lobe_volum\"")
+				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
 			}
-		case "right_lobe_length":
-			if err := func() error {
-				s.RightLobeLength.Reset()
-				if err := s.RightLobeLength.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"right_lobe_length\"")
-			}
-		case "right_lobe_width":
-			if err := func() error {
-				s.RightLobeWidth.Reset()
-				if err := s.RightLobeWidth.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"right_lobe_width\"")
-			}
-		case "right_lobe_thick":
-			if err := func() error {
-				s.RightLobeThick.Reset()
-				if err := s.RightLobeThick.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"right_lobe_thick\"")
-			}
-		case "right_lobe_volum":
-			if err := func() error {
-				s.RightLobeVolum.Reset()
-				if err := s.RightLobeVolum.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"right_lobe_volum\"")
-			}
-		case "gland_volum":
-			if err := func() error {
-				s.GlandVolum.Reset()
-				if err := s.GlandVolum.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"gland_volum\"")
-			}
-		case "isthmus":
+		case "diagnostic_number":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				s.Isthmus.Reset()
-				if err := s.Isthmus.Decode(d); err != nil {
+				v, err := d.Int()
+				s.DiagnosticNumber = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isthmus\"")
+				return errors.Wrap(err, "decode field \"diagnostic_number\"")
 			}
-		case "struct":
+		case "material_type":
 			if err := func() error {
-				s.Struct.Reset()
-				if err := s.Struct.Decode(d); err != nil {
+				s.MaterialType.Reset()
+				if err := s.MaterialType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"struct\"")
+				return errors.Wrap(err, "decode field \"material_type\"")
 			}
-		case "echogenicity":
+		case "calcitonin":
 			if err := func() error {
-				s.Echogenicity.Reset()
-				if err := s.Echogenicity.Decode(d); err != nil {
+				s.Calcitonin.Reset()
+				if err := s.Calcitonin.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"echogenicity\"")
+				return errors.Wrap(err, "decode field \"calcitonin\"")
 			}
-		case "regional_lymph":
+		case "calcitonin_in_flush":
 			if err := func() error {
-				s.RegionalLymph.Reset()
-				if err := s.RegionalLymph.Decode(d); err != nil {
+				s.CalcitoninInFlush.Reset()
+				if err := s.CalcitoninInFlush.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"regional_lymph\"")
+				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
 			}
-		case "vascularization":
+		case "thyroglobulin":
 			if err := func() error {
-				s.Vascularization.Reset()
-				if err := s.Vascularization.Decode(d); err != nil {
+				s.Thyroglobulin.Reset()
+				if err := s.Thyroglobulin.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vascularization\"")
+				return errors.Wrap(err, "decode field \"thyroglobulin\"")
 			}
-		case "location":
+		case "prev":
 			if err := func() error {
-				s.Location.Reset()
-				if err := s.Location.Decode(d); err != nil {
+				s.Prev.Reset()
+				if err := s.Prev.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"location\"")
+				return errors.Wrap(err, "decode field \"prev\"")
 			}
-		case "additional":
+		case "parent_prev":
 			if err := func() error {
-				s.Additional.Reset()
-				if err := s.Additional.Decode(d); err != nil {
+				s.ParentPrev.Reset()
+				if err := s.ParentPrev.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"additional\"")
+				return errors.Wrap(err, "decode field \"parent_prev\"")
 			}
-		case "conclusion":
+		case "original_image":
 			if err := func() error {
-				s.Conclusion.Reset()
-				if err := s.Conclusion.Decode(d); err != nil {
+				s.OriginalImage.Reset()
+				if err := s.OriginalImage.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"conclusion\"")
+				return errors.Wrap(err, "decode field \"original_image\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Echographics")
+		return errors.Wrap(err, "decode CytologyUpdateUpdateReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [3]uint8{
-		0b00000001,
-		0b00000000,
+	for i, mask := range [2]uint8{
+		0b01000010,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -8874,8 +9287,8 @@ func (s *Echographics) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfEchographics) {
-					name = jsonFieldsNameOfEchographics[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyUpdateUpdateReq) {
+					name = jsonFieldsNameOfCytologyUpdateUpdateReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -8896,199 +9309,274 @@ func (s *Echographics) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Echographics) MarshalJSON() ([]byte, error) {
+func (s *CytologyUpdateUpdateReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Echographics) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdateUpdateReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Error) Encode(e *jx.Encoder) {
+func (s *CytologyUpdateUpdateReqDetails) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Error) encodeFields(e *jx.Encoder) {
+func (s *CytologyUpdateUpdateReqDetails) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.IsLast.Set {
+			e.FieldStart("is_last")
+			s.IsLast.Encode(e)
+		}
+	}
+	{
+		if s.DiagnosDate.Set {
+			e.FieldStart("diagnos_date")
+			s.DiagnosDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.DiagnosticMarking.Set {
+			e.FieldStart("diagnostic_marking")
+			s.DiagnosticMarking.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("diagnostic_number")
+		e.Int(s.DiagnosticNumber)
+	}
+	{
+		if s.MaterialType.Set {
+			e.FieldStart("material_type")
+			s.MaterialType.Encode(e)
+		}
+	}
+	{
+		if s.Calcitonin.Set {
+			e.FieldStart("calcitonin")
+			s.Calcitonin.Encode(e)
+		}
+	}
+	{
+		if s.CalcitoninInFlush.Set {
+			e.FieldStart("calcitonin_in_flush")
+			s.CalcitoninInFlush.Encode(e)
+		}
+	}
+	{
+		if s.Thyroglobulin.Set {
+			e.FieldStart("thyroglobulin")
+			s.Thyroglobulin.Encode(e)
+		}
+	}
+	{
+		if s.Prev.Set {
+			e.FieldStart("prev")
+			s.Prev.Encode(e)
+		}
+	}
+	{
+		if s.ParentPrev.Set {
+			e.FieldStart("parent_prev")
+			s.ParentPrev.Encode(e)
+		}
+	}
+	{
+		if s.PatientCard.Set {
+			e.FieldStart("patient_card")
+			s.PatientCard.Encode(e)
+		}
+	}
+	{
+		if s.OriginalImage.Set {
+			e.FieldStart("original_image")
+			s.OriginalImage.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfError = [1]string{
-	0: "message",
+var jsonFieldsNameOfCytologyUpdateUpdateReqDetails = [13]string{
+	0:  "id",
+	1:  "is_last",
+	2:  "diagnos_date",
+	3:  "diagnostic_marking",
+	4:  "diagnostic_number",
+	5:  "material_type",
+	6:  "calcitonin",
+	7:  "calcitonin_in_flush",
+	8:  "thyroglobulin",
+	9:  "prev",
+	10: "parent_prev",
+	11: "patient_card",
+	12: "original_image",
 }
 
-// Decode decodes Error from json.
-func (s *Error) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyUpdateUpdateReqDetails from json.
+func (s *CytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Error to nil")
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqDetails to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "message":
-			requiredBitSet[0] |= 1 << 0
+		case "id":
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
-				if err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Error")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfError) {
-					name = jsonFieldsNameOfError[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "is_last":
+			if err := func() error {
+				s.IsLast.Reset()
+				if err := s.IsLast.Decode(d); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_last\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *Error) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Error) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *Image) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *Image) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
-	{
-		e.FieldStart("uzi_id")
-		json.EncodeUUID(e, s.UziID)
-	}
-	{
-		e.FieldStart("page")
-		e.Int(s.Page)
-	}
-}
-
-var jsonFieldsNameOfImage = [3]string{
-	0: "id",
-	1: "uzi_id",
-	2: "page",
-}
-
-// Decode decodes Image from json.
-func (s *Image) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Image to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
+		case "diagnos_date":
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
+				s.DiagnosDate.Reset()
+				if err := s.DiagnosDate.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"diagnos_date\"")
 			}
-		case "uzi_id":
-			requiredBitSet[0] |= 1 << 1
+		case "diagnostic_marking":
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.UziID = v
-				if err != nil {
+				s.DiagnosticMarking.Reset()
+				if err := s.DiagnosticMarking.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uzi_id\"")
+				return errors.Wrap(err, "decode field \"diagnostic_marking\"")
 			}
-		case "page":
-			requiredBitSet[0] |= 1 << 2
+		case "diagnostic_number":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
 				v, err := d.Int()
-				s.Page = int(v)
+				s.DiagnosticNumber = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"page\"")
+				return errors.Wrap(err, "decode field \"diagnostic_number\"")
+			}
+		case "material_type":
+			if err := func() error {
+				s.MaterialType.Reset()
+				if err := s.MaterialType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"material_type\"")
+			}
+		case "calcitonin":
+			if err := func() error {
+				s.Calcitonin.Reset()
+				if err := s.Calcitonin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"calcitonin\"")
+			}
+		case "calcitonin_in_flush":
+			if err := func() error {
+				s.CalcitoninInFlush.Reset()
+				if err := s.CalcitoninInFlush.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"calcitonin_in_flush\"")
+			}
+		case "thyroglobulin":
+			if err := func() error {
+				s.Thyroglobulin.Reset()
+				if err := s.Thyroglobulin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"thyroglobulin\"")
+			}
+		case "prev":
+			if err := func() error {
+				s.Prev.Reset()
+				if err := s.Prev.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"prev\"")
+			}
+		case "parent_prev":
+			if err := func() error {
+				s.ParentPrev.Reset()
+				if err := s.ParentPrev.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parent_prev\"")
+			}
+		case "patient_card":
+			if err := func() error {
+				s.PatientCard.Reset()
+				if err := s.PatientCard.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patient_card\"")
+			}
+		case "original_image":
+			if err := func() error {
+				s.OriginalImage.Reset()
+				if err := s.OriginalImage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"original_image\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Image")
+		return errors.Wrap(err, "decode CytologyUpdateUpdateReqDetails")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
+	for i, mask := range [2]uint8{
+		0b00010000,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9100,8 +9588,8 @@ func (s *Image) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfImage) {
-					name = jsonFieldsNameOfImage[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCytologyUpdateUpdateReqDetails) {
+					name = jsonFieldsNameOfCytologyUpdateUpdateReqDetails[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9122,295 +9610,440 @@ func (s *Image) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Image) MarshalJSON() ([]byte, error) {
+func (s *CytologyUpdateUpdateReqDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Image) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdateUpdateReqDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *LoginPostOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes CytologyUpdateUpdateReqDetailsDiagnosticMarking as json.
+func (s CytologyUpdateUpdateReqDetailsDiagnosticMarking) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// encodeFields encodes fields.
-func (s *LoginPostOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("access_token")
-		e.Str(s.AccessToken)
+// Decode decodes CytologyUpdateUpdateReqDetailsDiagnosticMarking from json.
+func (s *CytologyUpdateUpdateReqDetailsDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqDetailsDiagnosticMarking to nil")
 	}
-	{
-		e.FieldStart("refresh_token")
-		e.Str(s.RefreshToken)
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CytologyUpdateUpdateReqDetailsDiagnosticMarking(v) {
+	case CytologyUpdateUpdateReqDetailsDiagnosticMarking11:
+		*s = CytologyUpdateUpdateReqDetailsDiagnosticMarking11
+	case CytologyUpdateUpdateReqDetailsDiagnosticMarking23:
+		*s = CytologyUpdateUpdateReqDetailsDiagnosticMarking23
+	default:
+		*s = CytologyUpdateUpdateReqDetailsDiagnosticMarking(v)
 	}
+
+	return nil
 }
 
-var jsonFieldsNameOfLoginPostOK = [2]string{
-	0: "access_token",
-	1: "refresh_token",
+// MarshalJSON implements stdjson.Marshaler.
+func (s CytologyUpdateUpdateReqDetailsDiagnosticMarking) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes LoginPostOK from json.
-func (s *LoginPostOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode LoginPostOK to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyUpdateUpdateReqDetailsDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdateUpdateReqDetailsMaterialType as json.
+func (s CytologyUpdateUpdateReqDetailsMaterialType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CytologyUpdateUpdateReqDetailsMaterialType from json.
+func (s *CytologyUpdateUpdateReqDetailsMaterialType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqDetailsMaterialType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CytologyUpdateUpdateReqDetailsMaterialType(v) {
+	case CytologyUpdateUpdateReqDetailsMaterialTypeGS:
+		*s = CytologyUpdateUpdateReqDetailsMaterialTypeGS
+	case CytologyUpdateUpdateReqDetailsMaterialTypeBP:
+		*s = CytologyUpdateUpdateReqDetailsMaterialTypeBP
+	case CytologyUpdateUpdateReqDetailsMaterialTypeTP:
+		*s = CytologyUpdateUpdateReqDetailsMaterialTypeTP
+	case CytologyUpdateUpdateReqDetailsMaterialTypePTP:
+		*s = CytologyUpdateUpdateReqDetailsMaterialTypePTP
+	case CytologyUpdateUpdateReqDetailsMaterialTypeLNP:
+		*s = CytologyUpdateUpdateReqDetailsMaterialTypeLNP
+	default:
+		*s = CytologyUpdateUpdateReqDetailsMaterialType(v)
 	}
-	var requiredBitSet [1]uint8
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "access_token":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.AccessToken = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"access_token\"")
-			}
-		case "refresh_token":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.RefreshToken = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refresh_token\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode LoginPostOK")
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CytologyUpdateUpdateReqDetailsMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyUpdateUpdateReqDetailsMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdateUpdateReqDiagnosticMarking as json.
+func (s CytologyUpdateUpdateReqDiagnosticMarking) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CytologyUpdateUpdateReqDiagnosticMarking from json.
+func (s *CytologyUpdateUpdateReqDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqDiagnosticMarking to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfLoginPostOK) {
-					name = jsonFieldsNameOfLoginPostOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	// Try to use constant string.
+	switch CytologyUpdateUpdateReqDiagnosticMarking(v) {
+	case CytologyUpdateUpdateReqDiagnosticMarking11:
+		*s = CytologyUpdateUpdateReqDiagnosticMarking11
+	case CytologyUpdateUpdateReqDiagnosticMarking23:
+		*s = CytologyUpdateUpdateReqDiagnosticMarking23
+	default:
+		*s = CytologyUpdateUpdateReqDiagnosticMarking(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LoginPostOK) MarshalJSON() ([]byte, error) {
+func (s CytologyUpdateUpdateReqDiagnosticMarking) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginPostOK) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdateUpdateReqDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdateUpdateReqMaterialType as json.
+func (s CytologyUpdateUpdateReqMaterialType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CytologyUpdateUpdateReqMaterialType from json.
+func (s *CytologyUpdateUpdateReqMaterialType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqMaterialType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CytologyUpdateUpdateReqMaterialType(v) {
+	case CytologyUpdateUpdateReqMaterialTypeGS:
+		*s = CytologyUpdateUpdateReqMaterialTypeGS
+	case CytologyUpdateUpdateReqMaterialTypeBP:
+		*s = CytologyUpdateUpdateReqMaterialTypeBP
+	case CytologyUpdateUpdateReqMaterialTypeTP:
+		*s = CytologyUpdateUpdateReqMaterialTypeTP
+	case CytologyUpdateUpdateReqMaterialTypePTP:
+		*s = CytologyUpdateUpdateReqMaterialTypePTP
+	case CytologyUpdateUpdateReqMaterialTypeLNP:
+		*s = CytologyUpdateUpdateReqMaterialTypeLNP
+	default:
+		*s = CytologyUpdateUpdateReqMaterialType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CytologyUpdateUpdateReqMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CytologyUpdateUpdateReqMaterialType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *LoginPostReq) Encode(e *jx.Encoder) {
+func (s *CytologyUpdateUpdateReqPatientCard) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *LoginPostReq) encodeFields(e *jx.Encoder) {
+func (s *CytologyUpdateUpdateReqPatientCard) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		if s.Patient.Set {
+			e.FieldStart("patient")
+			s.Patient.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		if s.AcceptanceDatetime.Set {
+			e.FieldStart("acceptance_datetime")
+			s.AcceptanceDatetime.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Diagnosis.Set {
+			e.FieldStart("diagnosis")
+			s.Diagnosis.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfLoginPostReq = [2]string{
-	0: "email",
-	1: "password",
+var jsonFieldsNameOfCytologyUpdateUpdateReqPatientCard = [3]string{
+	0: "patient",
+	1: "acceptance_datetime",
+	2: "diagnosis",
 }
 
-// Decode decodes LoginPostReq from json.
-func (s *LoginPostReq) Decode(d *jx.Decoder) error {
+// Decode decodes CytologyUpdateUpdateReqPatientCard from json.
+func (s *CytologyUpdateUpdateReqPatientCard) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LoginPostReq to nil")
+		return errors.New("invalid: unable to decode CytologyUpdateUpdateReqPatientCard to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "email":
-			requiredBitSet[0] |= 1 << 0
+		case "patient":
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
+				s.Patient.Reset()
+				if err := s.Patient.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"patient\"")
 			}
-		case "password":
-			requiredBitSet[0] |= 1 << 1
+		case "acceptance_datetime":
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
+				s.AcceptanceDatetime.Reset()
+				if err := s.AcceptanceDatetime.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"acceptance_datetime\"")
+			}
+		case "diagnosis":
+			if err := func() error {
+				s.Diagnosis.Reset()
+				if err := s.Diagnosis.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnosis\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode LoginPostReq")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfLoginPostReq) {
-					name = jsonFieldsNameOfLoginPostReq[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode CytologyUpdateUpdateReqPatientCard")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LoginPostReq) MarshalJSON() ([]byte, error) {
+func (s *CytologyUpdateUpdateReqPatientCard) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginPostReq) UnmarshalJSON(data []byte) error {
+func (s *CytologyUpdateUpdateReqPatientCard) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MedCardDoctorIDPatientIDPatchReq) Encode(e *jx.Encoder) {
+func (s *Device) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MedCardDoctorIDPatientIDPatchReq) encodeFields(e *jx.Encoder) {
+func (s *Device) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("diagnosis")
-		e.Str(s.Diagnosis)
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Manufacturer.Set {
+			e.FieldStart("manufacturer")
+			s.Manufacturer.Encode(e)
+		}
+	}
+	{
+		if s.Model.Set {
+			e.FieldStart("model")
+			s.Model.Encode(e)
+		}
+	}
+	{
+		if s.SerialNumber.Set {
+			e.FieldStart("serial_number")
+			s.SerialNumber.Encode(e)
+		}
+	}
+	{
+		if s.ProbeType.Set {
+			e.FieldStart("probe_type")
+			s.ProbeType.Encode(e)
+		}
+	}
+	{
+		if s.PixelSpacing.Set {
+			e.FieldStart("pixel_spacing")
+			s.PixelSpacing.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfMedCardDoctorIDPatientIDPatchReq = [1]string{
-	0: "diagnosis",
+var jsonFieldsNameOfDevice = [7]string{
+	0: "id",
+	1: "name",
+	2: "manufacturer",
+	3: "model",
+	4: "serial_number",
+	5: "probe_type",
+	6: "pixel_spacing",
 }
 
-// Decode decodes MedCardDoctorIDPatientIDPatchReq from json.
-func (s *MedCardDoctorIDPatientIDPatchReq) Decode(d *jx.Decoder) error {
+// Decode decodes Device from json.
+func (s *Device) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MedCardDoctorIDPatientIDPatchReq to nil")
+		return errors.New("invalid: unable to decode Device to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "diagnosis":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Diagnosis = string(v)
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnosis\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "manufacturer":
+			if err := func() error {
+				s.Manufacturer.Reset()
+				if err := s.Manufacturer.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"manufacturer\"")
+			}
+		case "model":
+			if err := func() error {
+				s.Model.Reset()
+				if err := s.Model.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"model\"")
+			}
+		case "serial_number":
+			if err := func() error {
+				s.SerialNumber.Reset()
+				if err := s.SerialNumber.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"serial_number\"")
+			}
+		case "probe_type":
+			if err := func() error {
+				s.ProbeType.Reset()
+				if err := s.ProbeType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"probe_type\"")
+			}
+		case "pixel_spacing":
+			if err := func() error {
+				s.PixelSpacing.Reset()
+				if err := s.PixelSpacing.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pixel_spacing\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MedCardDoctorIDPatientIDPatchReq")
+		return errors.Wrap(err, "decode Device")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9422,8 +10055,8 @@ func (s *MedCardDoctorIDPatientIDPatchReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfMedCardDoctorIDPatientIDPatchReq) {
-					name = jsonFieldsNameOfMedCardDoctorIDPatientIDPatchReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfDevice) {
+					name = jsonFieldsNameOfDevice[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9444,284 +10077,248 @@ func (s *MedCardDoctorIDPatientIDPatchReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MedCardDoctorIDPatientIDPatchReq) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MedCardDoctorIDPatientIDPatchReq) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes MedDoctorIDPatientsGetOKApplicationJSON as json.
-func (s MedDoctorIDPatientsGetOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Patient(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes MedDoctorIDPatientsGetOKApplicationJSON from json.
-func (s *MedDoctorIDPatientsGetOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MedDoctorIDPatientsGetOKApplicationJSON to nil")
-	}
-	var unwrapped []Patient
-	if err := func() error {
-		unwrapped = make([]Patient, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Patient
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = MedDoctorIDPatientsGetOKApplicationJSON(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s MedDoctorIDPatientsGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *Device) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MedDoctorIDPatientsGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *Device) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MedPatientIDPatchReq) Encode(e *jx.Encoder) {
+func (s *DeviceNodeMetrics) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MedPatientIDPatchReq) encodeFields(e *jx.Encoder) {
+func (s *DeviceNodeMetrics) encodeFields(e *jx.Encoder) {
 	{
-		if s.Active.Set {
-			e.FieldStart("active")
-			s.Active.Encode(e)
-		}
+		e.FieldStart("device_id")
+		e.Int(s.DeviceID)
 	}
 	{
-		if s.Malignancy.Set {
-			e.FieldStart("malignancy")
-			s.Malignancy.Encode(e)
-		}
+		e.FieldStart("metrics")
+		s.Metrics.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfMedPatientIDPatchReq = [2]string{
-	0: "active",
-	1: "malignancy",
+var jsonFieldsNameOfDeviceNodeMetrics = [2]string{
+	0: "device_id",
+	1: "metrics",
 }
 
-// Decode decodes MedPatientIDPatchReq from json.
-func (s *MedPatientIDPatchReq) Decode(d *jx.Decoder) error {
+// Decode decodes DeviceNodeMetrics from json.
+func (s *DeviceNodeMetrics) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MedPatientIDPatchReq to nil")
+		return errors.New("invalid: unable to decode DeviceNodeMetrics to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "active":
+		case "device_id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Active.Reset()
-				if err := s.Active.Decode(d); err != nil {
+				v, err := d.Int()
+				s.DeviceID = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"active\"")
+				return errors.Wrap(err, "decode field \"device_id\"")
 			}
-		case "malignancy":
+		case "metrics":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Malignancy.Reset()
-				if err := s.Malignancy.Decode(d); err != nil {
+				if err := s.Metrics.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"malignancy\"")
+				return errors.Wrap(err, "decode field \"metrics\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MedPatientIDPatchReq")
+		return errors.Wrap(err, "decode DeviceNodeMetrics")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDeviceNodeMetrics) {
+					name = jsonFieldsNameOfDeviceNodeMetrics[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MedPatientIDPatchReq) MarshalJSON() ([]byte, error) {
+func (s *DeviceNodeMetrics) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MedPatientIDPatchReq) UnmarshalJSON(data []byte) error {
+func (s *DeviceNodeMetrics) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MedPatientPostReq) Encode(e *jx.Encoder) {
+func (s *Doctor) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MedPatientPostReq) encodeFields(e *jx.Encoder) {
+func (s *Doctor) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
 	{
 		e.FieldStart("fullname")
 		e.Str(s.Fullname)
 	}
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
-	}
-	{
-		e.FieldStart("policy")
-		e.Str(s.Policy)
-	}
-	{
-		e.FieldStart("active")
-		e.Bool(s.Active)
+		e.FieldStart("org")
+		e.Str(s.Org)
 	}
 	{
-		e.FieldStart("malignancy")
-		e.Bool(s.Malignancy)
+		e.FieldStart("job")
+		e.Str(s.Job)
 	}
 	{
-		e.FieldStart("birth_date")
-		json.EncodeDate(e, s.BirthDate)
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfMedPatientPostReq = [6]string{
-	0: "fullname",
-	1: "email",
-	2: "policy",
-	3: "active",
-	4: "malignancy",
-	5: "birth_date",
+var jsonFieldsNameOfDoctor = [5]string{
+	0: "id",
+	1: "fullname",
+	2: "org",
+	3: "job",
+	4: "description",
 }
 
-// Decode decodes MedPatientPostReq from json.
-func (s *MedPatientPostReq) Decode(d *jx.Decoder) error {
+// Decode decodes Doctor from json.
+func (s *Doctor) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MedPatientPostReq to nil")
+		return errors.New("invalid: unable to decode Doctor to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "fullname":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Fullname = string(v)
+				v, err := json.DecodeUUID(d)
+				s.ID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fullname\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "email":
+		case "fullname":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Email = string(v)
+				s.Fullname = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"fullname\"")
 			}
-		case "policy":
+		case "org":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.Policy = string(v)
+				s.Org = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"policy\"")
+				return errors.Wrap(err, "decode field \"org\"")
 			}
-		case "active":
+		case "job":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Bool()
-				s.Active = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"active\"")
-			}
-		case "malignancy":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Bool()
-				s.Malignancy = bool(v)
+				v, err := d.Str()
+				s.Job = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"malignancy\"")
+				return errors.Wrap(err, "decode field \"job\"")
 			}
-		case "birth_date":
-			requiredBitSet[0] |= 1 << 5
+		case "description":
 			if err := func() error {
-				v, err := json.DecodeDate(d)
-				s.BirthDate = v
-				if err != nil {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"birth_date\"")
+				return errors.Wrap(err, "decode field \"description\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MedPatientPostReq")
+		return errors.Wrap(err, "decode Doctor")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00111111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9733,8 +10330,8 @@ func (s *MedPatientPostReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfMedPatientPostReq) {
-					name = jsonFieldsNameOfMedPatientPostReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfDoctor) {
+					name = jsonFieldsNameOfDoctor[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9755,188 +10352,118 @@ func (s *MedPatientPostReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MedPatientPostReq) MarshalJSON() ([]byte, error) {
+func (s *Doctor) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MedPatientPostReq) UnmarshalJSON(data []byte) error {
+func (s *Doctor) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Node) Encode(e *jx.Encoder) {
+func (s *EchographicFlag) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Node) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
+func (s *EchographicFlag) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("ai")
-		e.Bool(s.Ai)
+		e.FieldStart("field")
+		s.Field.Encode(e)
 	}
 	{
-		e.FieldStart("uzi_id")
-		json.EncodeUUID(e, s.UziID)
+		e.FieldStart("value")
+		e.Float64(s.Value)
 	}
 	{
-		if s.Validation.Set {
-			e.FieldStart("validation")
-			s.Validation.Encode(e)
+		if s.Min.Set {
+			e.FieldStart("min")
+			s.Min.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("tirads_23")
-		e.Float64(s.Tirads23)
-	}
-	{
-		e.FieldStart("tirads_4")
-		e.Float64(s.Tirads4)
-	}
-	{
-		e.FieldStart("tirads_5")
-		e.Float64(s.Tirads5)
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
+		if s.Max.Set {
+			e.FieldStart("max")
+			s.Max.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfNode = [8]string{
-	0: "id",
-	1: "ai",
-	2: "uzi_id",
-	3: "validation",
-	4: "tirads_23",
-	5: "tirads_4",
-	6: "tirads_5",
-	7: "description",
+var jsonFieldsNameOfEchographicFlag = [4]string{
+	0: "field",
+	1: "value",
+	2: "min",
+	3: "max",
 }
 
-// Decode decodes Node from json.
-func (s *Node) Decode(d *jx.Decoder) error {
+// Decode decodes EchographicFlag from json.
+func (s *EchographicFlag) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Node to nil")
+		return errors.New("invalid: unable to decode EchographicFlag to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "field":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
+				if err := s.Field.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"field\"")
 			}
-		case "ai":
+		case "value":
 			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Bool()
-				s.Ai = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ai\"")
-			}
-		case "uzi_id":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.UziID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uzi_id\"")
-			}
-		case "validation":
-			if err := func() error {
-				s.Validation.Reset()
-				if err := s.Validation.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"validation\"")
-			}
-		case "tirads_23":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads23 = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_23\"")
-			}
-		case "tirads_4":
-			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
 				v, err := d.Float64()
-				s.Tirads4 = float64(v)
+				s.Value = float64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_4\"")
+				return errors.Wrap(err, "decode field \"value\"")
 			}
-		case "tirads_5":
-			requiredBitSet[0] |= 1 << 6
+		case "min":
 			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads5 = float64(v)
-				if err != nil {
+				s.Min.Reset()
+				if err := s.Min.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_5\"")
+				return errors.Wrap(err, "decode field \"min\"")
 			}
-		case "description":
+		case "max":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.Max.Reset()
+				if err := s.Max.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"max\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Node")
+		return errors.Wrap(err, "decode EchographicFlag")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01110111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9948,8 +10475,8 @@ func (s *Node) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfNode) {
-					name = jsonFieldsNameOfNode[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfEchographicFlag) {
+					name = jsonFieldsNameOfEchographicFlag[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9970,1416 +10497,9967 @@ func (s *Node) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Node) MarshalJSON() ([]byte, error) {
+func (s *EchographicFlag) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Node) UnmarshalJSON(data []byte) error {
+func (s *EchographicFlag) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NodeValidation as json.
-func (s NodeValidation) Encode(e *jx.Encoder) {
+// Encode encodes EchographicFlagField as json.
+func (s EchographicFlagField) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes NodeValidation from json.
-func (s *NodeValidation) Decode(d *jx.Decoder) error {
+// Decode decodes EchographicFlagField from json.
+func (s *EchographicFlagField) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeValidation to nil")
+		return errors.New("invalid: unable to decode EchographicFlagField to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch NodeValidation(v) {
-	case NodeValidationInvalid:
-		*s = NodeValidationInvalid
-	case NodeValidationValid:
-		*s = NodeValidationValid
+	switch EchographicFlagField(v) {
+	case EchographicFlagFieldGlandVolum:
+		*s = EchographicFlagFieldGlandVolum
+	case EchographicFlagFieldIsthmus:
+		*s = EchographicFlagFieldIsthmus
 	default:
-		*s = NodeValidation(v)
+		*s = EchographicFlagField(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s NodeValidation) MarshalJSON() ([]byte, error) {
+func (s EchographicFlagField) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeValidation) UnmarshalJSON(data []byte) error {
+func (s *EchographicFlagField) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes bool as json.
-func (o OptBool) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Bool(bool(o.Value))
+// Encode implements json.Marshaler.
+func (s *Echographics) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes bool from json.
-func (o *OptBool) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBool to nil")
+// encodeFields encodes fields.
+func (s *Echographics) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
-	o.Set = true
-	v, err := d.Bool()
-	if err != nil {
-		return err
+	{
+		if s.Contors.Set {
+			e.FieldStart("contors")
+			s.Contors.Encode(e)
+		}
 	}
-	o.Value = bool(v)
+	{
+		if s.LeftLobeLength.Set {
+			e.FieldStart("left_lobe_length")
+			s.LeftLobeLength.Encode(e)
+		}
+	}
+	{
+		if s.LeftLobeWidth.Set {
+			e.FieldStart("left_lobe_width")
+			s.LeftLobeWidth.Encode(e)
+		}
+	}
+	{
+		if s.LeftLobeThick.Set {
+			e.FieldStart("left_lobe_thick")
+			s.LeftLobeThick.Encode(e)
+		}
+	}
+	{
+		if s.LeftLobeVolum.Set {
+			e.FieldStart("left_lobe_volum")
+			s.LeftLobeVolum.Encode(e)
+		}
+	}
+	{
+		if s.RightLobeLength.Set {
+			e.FieldStart("right_lobe_length")
+			s.RightLobeLength.Encode(e)
+		}
+	}
+	{
+		if s.RightLobeWidth.Set {
+			e.FieldStart("right_lobe_width")
+			s.RightLobeWidth.Encode(e)
+		}
+	}
+	{
+		if s.RightLobeThick.Set {
+			e.FieldStart("right_lobe_thick")
+			s.RightLobeThick.Encode(e)
+		}
+	}
+	{
+		if s.RightLobeVolum.Set {
+			e.FieldStart("right_lobe_volum")
+			s.RightLobeVolum.Encode(e)
+		}
+	}
+	{
+		if s.GlandVolum.Set {
+			e.FieldStart("gland_volum")
+			s.GlandVolum.Encode(e)
+		}
+	}
+	{
+		if s.Isthmus.Set {
+			e.FieldStart("isthmus")
+			s.Isthmus.Encode(e)
+		}
+	}
+	{
+		if s.Struct.Set {
+			e.FieldStart("struct")
+			s.Struct.Encode(e)
+		}
+	}
+	{
+		if s.Echogenicity.Set {
+			e.FieldStart("echogenicity")
+			s.Echogenicity.Encode(e)
+		}
+	}
+	{
+		if s.RegionalLymph.Set {
+			e.FieldStart("regional_lymph")
+			s.RegionalLymph.Encode(e)
+		}
+	}
+	{
+		if s.Vascularization.Set {
+			e.FieldStart("vascularization")
+			s.Vascularization.Encode(e)
+		}
+	}
+	{
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
+		}
+	}
+	{
+		if s.Additional.Set {
+			e.FieldStart("additional")
+			s.Additional.Encode(e)
+		}
+	}
+	{
+		if s.Conclusion.Set {
+			e.FieldStart("conclusion")
+			s.Conclusion.Encode(e)
+		}
+	}
+	{
+		if s.PatientSex.Set {
+			e.FieldStart("patient_sex")
+			s.PatientSex.Encode(e)
+		}
+	}
+	{
+		if s.PatientAge.Set {
+			e.FieldStart("patient_age")
+			s.PatientAge.Encode(e)
+		}
+	}
+	{
+		if s.Flags != nil {
+			e.FieldStart("flags")
+			e.ArrStart()
+			for _, elem := range s.Flags {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.GeneratedConclusion.Set {
+			e.FieldStart("generated_conclusion")
+			s.GeneratedConclusion.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfEchographics = [23]string{
+	0:  "id",
+	1:  "contors",
+	2:  "left_lobe_length",
+	3:  "left_lobe_width",
+	4:  "left_lobe_thick",
+	5:  "left_lobe_volum",
+	6:  "right_lobe_length",
+	7:  "right_lobe_width",
+	8:  "right_lobe_thick",
+	9:  "right_lobe_volum",
+	10: "gland_volum",
+	11: "isthmus",
+	12: "struct",
+	13: "echogenicity",
+	14: "regional_lymph",
+	15: "vascularization",
+	16: "location",
+	17: "additional",
+	18: "conclusion",
+	19: "patient_sex",
+	20: "patient_age",
+	21: "flags",
+	22: "generated_conclusion",
+}
+
+// Decode decodes Echographics from json.
+func (s *Echographics) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Echographics to nil")
+	}
+	var requiredBitSet [3]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "contors":
+			if err := func() error {
+				s.Contors.Reset()
+				if err := s.Contors.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"contors\"")
+			}
+		case "left_lobe_length":
+			if err := func() error {
+				s.LeftLobeLength.Reset()
+				if err := s.LeftLobeLength.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"left_lobe_length\"")
+			}
+		case "left_lobe_width":
+			if err := func() error {
+				s.LeftLobeWidth.Reset()
+				if err := s.LeftLobeWidth.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"left_lobe_width\"")
+			}
+		case "left_lobe_thick":
+			if err := func() error {
+				s.LeftLobeThick.Reset()
+				if err := s.LeftLobeThick.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"left_lobe_thick\"")
+			}
+		case "left_lobe_volum":
+			if err := func() error {
+				s.LeftLobeVolum.Reset()
+				if err := s.LeftLobeVolum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"left_lobe_volum\"")
+			}
+		case "right_lobe_length":
+			if err := func() error {
+				s.RightLobeLength.Reset()
+				if err := s.RightLobeLength.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"right_lobe_length\"")
+			}
+		case "right_lobe_width":
+			if err := func() error {
+				s.RightLobeWidth.Reset()
+				if err := s.RightLobeWidth.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"right_lobe_width\"")
+			}
+		case "right_lobe_thick":
+			if err := func() error {
+				s.RightLobeThick.Reset()
+				if err := s.RightLobeThick.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"right_lobe_thick\"")
+			}
+		case "right_lobe_volum":
+			if err := func() error {
+				s.RightLobeVolum.Reset()
+				if err := s.RightLobeVolum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"right_lobe_volum\"")
+			}
+		case "gland_volum":
+			if err := func() error {
+				s.GlandVolum.Reset()
+				if err := s.GlandVolum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gland_volum\"")
+			}
+		case "isthmus":
+			if err := func() error {
+				s.Isthmus.Reset()
+				if err := s.Isthmus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"isthmus\"")
+			}
+		case "struct":
+			if err := func() error {
+				s.Struct.Reset()
+				if err := s.Struct.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"struct\"")
+			}
+		case "echogenicity":
+			if err := func() error {
+				s.Echogenicity.Reset()
+				if err := s.Echogenicity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"echogenicity\"")
+			}
+		case "regional_lymph":
+			if err := func() error {
+				s.RegionalLymph.Reset()
+				if err := s.RegionalLymph.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"regional_lymph\"")
+			}
+		case "vascularization":
+			if err := func() error {
+				s.Vascularization.Reset()
+				if err := s.Vascularization.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vascularization\"")
+			}
+		case "location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		case "additional":
+			if err := func() error {
+				s.Additional.Reset()
+				if err := s.Additional.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"additional\"")
+			}
+		case "conclusion":
+			if err := func() error {
+				s.Conclusion.Reset()
+				if err := s.Conclusion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"conclusion\"")
+			}
+		case "patient_sex":
+			if err := func() error {
+				s.PatientSex.Reset()
+				if err := s.PatientSex.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patient_sex\"")
+			}
+		case "patient_age":
+			if err := func() error {
+				s.PatientAge.Reset()
+				if err := s.PatientAge.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patient_age\"")
+			}
+		case "flags":
+			if err := func() error {
+				s.Flags = make([]EchographicFlag, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EchographicFlag
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Flags = append(s.Flags, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"flags\"")
+			}
+		case "generated_conclusion":
+			if err := func() error {
+				s.GeneratedConclusion.Reset()
+				if err := s.GeneratedConclusion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"generated_conclusion\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Echographics")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [3]uint8{
+		0b00000001,
+		0b00000000,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEchographics) {
+					name = jsonFieldsNameOfEchographics[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Echographics) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Echographics) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EchographicsEchogenicity as json.
+func (s EchographicsEchogenicity) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EchographicsEchogenicity from json.
+func (s *EchographicsEchogenicity) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EchographicsEchogenicity to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EchographicsEchogenicity(v) {
+	case EchographicsEchogenicityNormal:
+		*s = EchographicsEchogenicityNormal
+	case EchographicsEchogenicityIncreased:
+		*s = EchographicsEchogenicityIncreased
+	case EchographicsEchogenicityDecreased:
+		*s = EchographicsEchogenicityDecreased
+	case EchographicsEchogenicityMixed:
+		*s = EchographicsEchogenicityMixed
+	default:
+		*s = EchographicsEchogenicity(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EchographicsEchogenicity) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EchographicsEchogenicity) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EchographicsPatientSex as json.
+func (s EchographicsPatientSex) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EchographicsPatientSex from json.
+func (s *EchographicsPatientSex) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EchographicsPatientSex to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EchographicsPatientSex(v) {
+	case EchographicsPatientSexMale:
+		*s = EchographicsPatientSexMale
+	case EchographicsPatientSexFemale:
+		*s = EchographicsPatientSexFemale
+	default:
+		*s = EchographicsPatientSex(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EchographicsPatientSex) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EchographicsPatientSex) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EchographicsStruct as json.
+func (s EchographicsStruct) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EchographicsStruct from json.
+func (s *EchographicsStruct) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EchographicsStruct to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EchographicsStruct(v) {
+	case EchographicsStructHomogeneous:
+		*s = EchographicsStructHomogeneous
+	case EchographicsStructHeterogeneous:
+		*s = EchographicsStructHeterogeneous
+	default:
+		*s = EchographicsStruct(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EchographicsStruct) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EchographicsStruct) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EchographicsVascularization as json.
+func (s EchographicsVascularization) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EchographicsVascularization from json.
+func (s *EchographicsVascularization) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EchographicsVascularization to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EchographicsVascularization(v) {
+	case EchographicsVascularizationNormal:
+		*s = EchographicsVascularizationNormal
+	case EchographicsVascularizationIncreased:
+		*s = EchographicsVascularizationIncreased
+	case EchographicsVascularizationDecreased:
+		*s = EchographicsVascularizationDecreased
+	default:
+		*s = EchographicsVascularization(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EchographicsVascularization) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EchographicsVascularization) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Error) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Error) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+}
+
+var jsonFieldsNameOfError = [1]string{
+	0: "message",
+}
+
+// Decode decodes Error from json.
+func (s *Error) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Error to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Error")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfError) {
+					name = jsonFieldsNameOfError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Error) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Error) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Image) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Image) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("uzi_id")
+		json.EncodeUUID(e, s.UziID)
+	}
+	{
+		e.FieldStart("page")
+		e.Int(s.Page)
+	}
+	{
+		e.FieldStart("width")
+		e.Int(s.Width)
+	}
+	{
+		e.FieldStart("height")
+		e.Int(s.Height)
+	}
+	{
+		e.FieldStart("variants")
+		e.ArrStart()
+		for _, elem := range s.Variants {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfImage = [6]string{
+	0: "id",
+	1: "uzi_id",
+	2: "page",
+	3: "width",
+	4: "height",
+	5: "variants",
+}
+
+// Decode decodes Image from json.
+func (s *Image) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Image to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "uzi_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.UziID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uzi_id\"")
+			}
+		case "page":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Page = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"page\"")
+			}
+		case "width":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Width = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"width\"")
+			}
+		case "height":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.Height = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"height\"")
+			}
+		case "variants":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.Variants = make([]ImageVariant, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ImageVariant
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Variants = append(s.Variants, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"variants\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Image")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfImage) {
+					name = jsonFieldsNameOfImage[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Image) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Image) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ImageSize as json.
+func (s ImageSize) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ImageSize from json.
+func (s *ImageSize) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImageSize to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ImageSize(v) {
+	case ImageSizeOriginal:
+		*s = ImageSizeOriginal
+	case ImageSizePreview:
+		*s = ImageSizePreview
+	case ImageSizeThumbnail:
+		*s = ImageSizeThumbnail
+	default:
+		*s = ImageSize(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ImageSize) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ImageSize) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ImageVariant) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ImageVariant) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("size")
+		s.Size.Encode(e)
+	}
+	{
+		e.FieldStart("content_type")
+		e.Str(s.ContentType)
+	}
+	{
+		e.FieldStart("width")
+		e.Int(s.Width)
+	}
+	{
+		e.FieldStart("height")
+		e.Int(s.Height)
+	}
+}
+
+var jsonFieldsNameOfImageVariant = [4]string{
+	0: "size",
+	1: "content_type",
+	2: "width",
+	3: "height",
+}
+
+// Decode decodes ImageVariant from json.
+func (s *ImageVariant) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImageVariant to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "size":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Size.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"size\"")
+			}
+		case "content_type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.ContentType = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"content_type\"")
+			}
+		case "width":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Width = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"width\"")
+			}
+		case "height":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Height = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"height\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ImageVariant")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfImageVariant) {
+					name = jsonFieldsNameOfImageVariant[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ImageVariant) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ImageVariant) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginPostOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginPostOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("access_token")
+		e.Str(s.AccessToken)
+	}
+	{
+		e.FieldStart("refresh_token")
+		e.Str(s.RefreshToken)
+	}
+}
+
+var jsonFieldsNameOfLoginPostOK = [2]string{
+	0: "access_token",
+	1: "refresh_token",
+}
+
+// Decode decodes LoginPostOK from json.
+func (s *LoginPostOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginPostOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "access_token":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.AccessToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"access_token\"")
+			}
+		case "refresh_token":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refresh_token\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginPostOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginPostOK) {
+					name = jsonFieldsNameOfLoginPostOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginPostOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginPostOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginPostReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginPostReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+}
+
+var jsonFieldsNameOfLoginPostReq = [2]string{
+	0: "email",
+	1: "password",
+}
+
+// Decode decodes LoginPostReq from json.
+func (s *LoginPostReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginPostReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "email":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "password":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginPostReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginPostReq) {
+					name = jsonFieldsNameOfLoginPostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginPostReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginPostReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MeasureUnit as json.
+func (s MeasureUnit) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes MeasureUnit from json.
+func (s *MeasureUnit) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MeasureUnit to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch MeasureUnit(v) {
+	case MeasureUnitPx:
+		*s = MeasureUnitPx
+	case MeasureUnitMm:
+		*s = MeasureUnitMm
+	default:
+		*s = MeasureUnit(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s MeasureUnit) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MeasureUnit) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MedCardDoctorIDPatientIDPatchReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MedCardDoctorIDPatientIDPatchReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("diagnosis")
+		e.Str(s.Diagnosis)
+	}
+}
+
+var jsonFieldsNameOfMedCardDoctorIDPatientIDPatchReq = [1]string{
+	0: "diagnosis",
+}
+
+// Decode decodes MedCardDoctorIDPatientIDPatchReq from json.
+func (s *MedCardDoctorIDPatientIDPatchReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MedCardDoctorIDPatientIDPatchReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "diagnosis":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Diagnosis = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnosis\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MedCardDoctorIDPatientIDPatchReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMedCardDoctorIDPatientIDPatchReq) {
+					name = jsonFieldsNameOfMedCardDoctorIDPatientIDPatchReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MedCardDoctorIDPatientIDPatchReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MedCardDoctorIDPatientIDPatchReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MedDoctorIDPatientsGetOKApplicationJSON as json.
+func (s MedDoctorIDPatientsGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Patient(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes MedDoctorIDPatientsGetOKApplicationJSON from json.
+func (s *MedDoctorIDPatientsGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MedDoctorIDPatientsGetOKApplicationJSON to nil")
+	}
+	var unwrapped []Patient
+	if err := func() error {
+		unwrapped = make([]Patient, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Patient
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = MedDoctorIDPatientsGetOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s MedDoctorIDPatientsGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MedDoctorIDPatientsGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MedPatientIDPatchReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MedPatientIDPatchReq) encodeFields(e *jx.Encoder) {
+	{
+		if s.Active.Set {
+			e.FieldStart("active")
+			s.Active.Encode(e)
+		}
+	}
+	{
+		if s.Malignancy.Set {
+			e.FieldStart("malignancy")
+			s.Malignancy.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfMedPatientIDPatchReq = [2]string{
+	0: "active",
+	1: "malignancy",
+}
+
+// Decode decodes MedPatientIDPatchReq from json.
+func (s *MedPatientIDPatchReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MedPatientIDPatchReq to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "active":
+			if err := func() error {
+				s.Active.Reset()
+				if err := s.Active.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"active\"")
+			}
+		case "malignancy":
+			if err := func() error {
+				s.Malignancy.Reset()
+				if err := s.Malignancy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"malignancy\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MedPatientIDPatchReq")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MedPatientIDPatchReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MedPatientIDPatchReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MedPatientPostReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MedPatientPostReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("fullname")
+		e.Str(s.Fullname)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("policy")
+		e.Str(s.Policy)
+	}
+	{
+		e.FieldStart("active")
+		e.Bool(s.Active)
+	}
+	{
+		e.FieldStart("malignancy")
+		e.Bool(s.Malignancy)
+	}
+	{
+		e.FieldStart("birth_date")
+		json.EncodeDate(e, s.BirthDate)
+	}
+}
+
+var jsonFieldsNameOfMedPatientPostReq = [6]string{
+	0: "fullname",
+	1: "email",
+	2: "policy",
+	3: "active",
+	4: "malignancy",
+	5: "birth_date",
+}
+
+// Decode decodes MedPatientPostReq from json.
+func (s *MedPatientPostReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MedPatientPostReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "fullname":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Fullname = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fullname\"")
+			}
+		case "email":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "policy":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Policy = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"policy\"")
+			}
+		case "active":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.Active = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"active\"")
+			}
+		case "malignancy":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.Malignancy = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"malignancy\"")
+			}
+		case "birth_date":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := json.DecodeDate(d)
+				s.BirthDate = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"birth_date\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MedPatientPostReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMedPatientPostReq) {
+					name = jsonFieldsNameOfMedPatientPostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MedPatientPostReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MedPatientPostReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Node) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Node) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("ai")
+		e.Bool(s.Ai)
+	}
+	{
+		e.FieldStart("uzi_id")
+		json.EncodeUUID(e, s.UziID)
+	}
+	{
+		if s.Validation.Set {
+			e.FieldStart("validation")
+			s.Validation.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("tirads_23")
+		e.Float64(s.Tirads23)
+	}
+	{
+		e.FieldStart("tirads_4")
+		e.Float64(s.Tirads4)
+	}
+	{
+		e.FieldStart("tirads_5")
+		e.Float64(s.Tirads5)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.Measurement.Set {
+			e.FieldStart("measurement")
+			s.Measurement.Encode(e)
+		}
+	}
+	{
+		if s.Lobe.Set {
+			e.FieldStart("lobe")
+			s.Lobe.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNode = [10]string{
+	0: "id",
+	1: "ai",
+	2: "uzi_id",
+	3: "validation",
+	4: "tirads_23",
+	5: "tirads_4",
+	6: "tirads_5",
+	7: "description",
+	8: "measurement",
+	9: "lobe",
+}
+
+// Decode decodes Node from json.
+func (s *Node) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Node to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "ai":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.Ai = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ai\"")
+			}
+		case "uzi_id":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.UziID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uzi_id\"")
+			}
+		case "validation":
+			if err := func() error {
+				s.Validation.Reset()
+				if err := s.Validation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"validation\"")
+			}
+		case "tirads_23":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads23 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_23\"")
+			}
+		case "tirads_4":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads4 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_4\"")
+			}
+		case "tirads_5":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads5 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_5\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "measurement":
+			if err := func() error {
+				s.Measurement.Reset()
+				if err := s.Measurement.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"measurement\"")
+			}
+		case "lobe":
+			if err := func() error {
+				s.Lobe.Reset()
+				if err := s.Lobe.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lobe\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Node")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b01110111,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNode) {
+					name = jsonFieldsNameOfNode[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Node) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Node) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeDescriptors) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeDescriptors) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("composition")
+		s.Composition.Encode(e)
+	}
+	{
+		e.FieldStart("echogenicity")
+		s.Echogenicity.Encode(e)
+	}
+	{
+		e.FieldStart("shape")
+		s.Shape.Encode(e)
+	}
+	{
+		e.FieldStart("margin")
+		s.Margin.Encode(e)
+	}
+	{
+		e.FieldStart("echogenic_foci")
+		e.ArrStart()
+		for _, elem := range s.EchogenicFoci {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfNodeDescriptors = [5]string{
+	0: "composition",
+	1: "echogenicity",
+	2: "shape",
+	3: "margin",
+	4: "echogenic_foci",
+}
+
+// Decode decodes NodeDescriptors from json.
+func (s *NodeDescriptors) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeDescriptors to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "composition":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Composition.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"composition\"")
+			}
+		case "echogenicity":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Echogenicity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"echogenicity\"")
+			}
+		case "shape":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Shape.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"shape\"")
+			}
+		case "margin":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Margin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"margin\"")
+			}
+		case "echogenic_foci":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.EchogenicFoci = make([]NodeDescriptorsEchogenicFociItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NodeDescriptorsEchogenicFociItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.EchogenicFoci = append(s.EchogenicFoci, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"echogenic_foci\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeDescriptors")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNodeDescriptors) {
+					name = jsonFieldsNameOfNodeDescriptors[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeDescriptors) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeDescriptors) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeDescriptorsComposition as json.
+func (s NodeDescriptorsComposition) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NodeDescriptorsComposition from json.
+func (s *NodeDescriptorsComposition) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeDescriptorsComposition to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NodeDescriptorsComposition(v) {
+	case NodeDescriptorsCompositionCystic:
+		*s = NodeDescriptorsCompositionCystic
+	case NodeDescriptorsCompositionSpongiform:
+		*s = NodeDescriptorsCompositionSpongiform
+	case NodeDescriptorsCompositionMixed:
+		*s = NodeDescriptorsCompositionMixed
+	case NodeDescriptorsCompositionSolid:
+		*s = NodeDescriptorsCompositionSolid
+	default:
+		*s = NodeDescriptorsComposition(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NodeDescriptorsComposition) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeDescriptorsComposition) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeDescriptorsEchogenicFociItem as json.
+func (s NodeDescriptorsEchogenicFociItem) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NodeDescriptorsEchogenicFociItem from json.
+func (s *NodeDescriptorsEchogenicFociItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeDescriptorsEchogenicFociItem to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NodeDescriptorsEchogenicFociItem(v) {
+	case NodeDescriptorsEchogenicFociItemNone:
+		*s = NodeDescriptorsEchogenicFociItemNone
+	case NodeDescriptorsEchogenicFociItemMacrocalcifications:
+		*s = NodeDescriptorsEchogenicFociItemMacrocalcifications
+	case NodeDescriptorsEchogenicFociItemPeripheral:
+		*s = NodeDescriptorsEchogenicFociItemPeripheral
+	case NodeDescriptorsEchogenicFociItemPunctate:
+		*s = NodeDescriptorsEchogenicFociItemPunctate
+	default:
+		*s = NodeDescriptorsEchogenicFociItem(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NodeDescriptorsEchogenicFociItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeDescriptorsEchogenicFociItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeDescriptorsEchogenicity as json.
+func (s NodeDescriptorsEchogenicity) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NodeDescriptorsEchogenicity from json.
+func (s *NodeDescriptorsEchogenicity) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeDescriptorsEchogenicity to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NodeDescriptorsEchogenicity(v) {
+	case NodeDescriptorsEchogenicityAnechoic:
+		*s = NodeDescriptorsEchogenicityAnechoic
+	case NodeDescriptorsEchogenicityHyperIso:
+		*s = NodeDescriptorsEchogenicityHyperIso
+	case NodeDescriptorsEchogenicityHypo:
+		*s = NodeDescriptorsEchogenicityHypo
+	case NodeDescriptorsEchogenicityVeryHypo:
+		*s = NodeDescriptorsEchogenicityVeryHypo
+	default:
+		*s = NodeDescriptorsEchogenicity(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NodeDescriptorsEchogenicity) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeDescriptorsEchogenicity) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeDescriptorsMargin as json.
+func (s NodeDescriptorsMargin) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NodeDescriptorsMargin from json.
+func (s *NodeDescriptorsMargin) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeDescriptorsMargin to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NodeDescriptorsMargin(v) {
+	case NodeDescriptorsMarginSmooth:
+		*s = NodeDescriptorsMarginSmooth
+	case NodeDescriptorsMarginIllDefined:
+		*s = NodeDescriptorsMarginIllDefined
+	case NodeDescriptorsMarginLobulated:
+		*s = NodeDescriptorsMarginLobulated
+	case NodeDescriptorsMarginExtrathyroidal:
+		*s = NodeDescriptorsMarginExtrathyroidal
+	default:
+		*s = NodeDescriptorsMargin(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NodeDescriptorsMargin) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeDescriptorsMargin) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeDescriptorsShape as json.
+func (s NodeDescriptorsShape) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NodeDescriptorsShape from json.
+func (s *NodeDescriptorsShape) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeDescriptorsShape to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NodeDescriptorsShape(v) {
+	case NodeDescriptorsShapeWiderThanTall:
+		*s = NodeDescriptorsShapeWiderThanTall
+	case NodeDescriptorsShapeTallerThanWide:
+		*s = NodeDescriptorsShapeTallerThanWide
+	default:
+		*s = NodeDescriptorsShape(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NodeDescriptorsShape) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeDescriptorsShape) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeGrowth) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeGrowth) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("lineage_id")
+		json.EncodeUUID(e, s.LineageID)
+	}
+	{
+		e.FieldStart("points")
+		e.ArrStart()
+		for _, elem := range s.Points {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.VolumeChange.Set {
+			e.FieldStart("volume_change")
+			s.VolumeChange.Encode(e)
+		}
+	}
+	{
+		if s.DoublingTime.Set {
+			e.FieldStart("doubling_time")
+			s.DoublingTime.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNodeGrowth = [4]string{
+	0: "lineage_id",
+	1: "points",
+	2: "volume_change",
+	3: "doubling_time",
+}
+
+// Decode decodes NodeGrowth from json.
+func (s *NodeGrowth) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeGrowth to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "lineage_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.LineageID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lineage_id\"")
+			}
+		case "points":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Points = make([]NodeGrowthPoint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NodeGrowthPoint
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Points = append(s.Points, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"points\"")
+			}
+		case "volume_change":
+			if err := func() error {
+				s.VolumeChange.Reset()
+				if err := s.VolumeChange.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"volume_change\"")
+			}
+		case "doubling_time":
+			if err := func() error {
+				s.DoublingTime.Reset()
+				if err := s.DoublingTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"doubling_time\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeGrowth")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNodeGrowth) {
+					name = jsonFieldsNameOfNodeGrowth[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeGrowth) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeGrowth) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeGrowthPoint) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeGrowthPoint) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("node")
+		s.Node.Encode(e)
+	}
+	{
+		e.FieldStart("uzi_id")
+		json.EncodeUUID(e, s.UziID)
+	}
+	{
+		e.FieldStart("create_at")
+		json.EncodeDateTime(e, s.CreateAt)
+	}
+	{
+		if s.Volume.Set {
+			e.FieldStart("volume")
+			s.Volume.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNodeGrowthPoint = [4]string{
+	0: "node",
+	1: "uzi_id",
+	2: "create_at",
+	3: "volume",
+}
+
+// Decode decodes NodeGrowthPoint from json.
+func (s *NodeGrowthPoint) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeGrowthPoint to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "node":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Node.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"node\"")
+			}
+		case "uzi_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.UziID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uzi_id\"")
+			}
+		case "create_at":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreateAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"create_at\"")
+			}
+		case "volume":
+			if err := func() error {
+				s.Volume.Reset()
+				if err := s.Volume.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"volume\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeGrowthPoint")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNodeGrowthPoint) {
+					name = jsonFieldsNameOfNodeGrowthPoint[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeGrowthPoint) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeGrowthPoint) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeLinkSuggestion) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeLinkSuggestion) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("node")
+		s.Node.Encode(e)
+	}
+	{
+		e.FieldStart("score")
+		e.Float64(s.Score)
+	}
+}
+
+var jsonFieldsNameOfNodeLinkSuggestion = [2]string{
+	0: "node",
+	1: "score",
+}
+
+// Decode decodes NodeLinkSuggestion from json.
+func (s *NodeLinkSuggestion) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeLinkSuggestion to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "node":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Node.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"node\"")
+			}
+		case "score":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Float64()
+				s.Score = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeLinkSuggestion")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNodeLinkSuggestion) {
+					name = jsonFieldsNameOfNodeLinkSuggestion[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeLinkSuggestion) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeLinkSuggestion) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeLobe as json.
+func (s NodeLobe) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NodeLobe from json.
+func (s *NodeLobe) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeLobe to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NodeLobe(v) {
+	case NodeLobeRight:
+		*s = NodeLobeRight
+	case NodeLobeLeft:
+		*s = NodeLobeLeft
+	case NodeLobeIsthmus:
+		*s = NodeLobeIsthmus
+	default:
+		*s = NodeLobe(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NodeLobe) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeLobe) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeMeasurement) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeMeasurement) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("area")
+		e.Float64(s.Area)
+	}
+	{
+		e.FieldStart("perimeter")
+		e.Float64(s.Perimeter)
+	}
+	{
+		e.FieldStart("major_axis")
+		e.Float64(s.MajorAxis)
+	}
+	{
+		e.FieldStart("minor_axis")
+		e.Float64(s.MinorAxis)
+	}
+	{
+		e.FieldStart("volume")
+		e.Float64(s.Volume)
+	}
+	{
+		e.FieldStart("unit")
+		s.Unit.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfNodeMeasurement = [6]string{
+	0: "area",
+	1: "perimeter",
+	2: "major_axis",
+	3: "minor_axis",
+	4: "volume",
+	5: "unit",
+}
+
+// Decode decodes NodeMeasurement from json.
+func (s *NodeMeasurement) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeMeasurement to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "area":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Float64()
+				s.Area = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"area\"")
+			}
+		case "perimeter":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Float64()
+				s.Perimeter = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"perimeter\"")
+			}
+		case "major_axis":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.MajorAxis = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"major_axis\"")
+			}
+		case "minor_axis":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.MinorAxis = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"minor_axis\"")
+			}
+		case "volume":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.Volume = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"volume\"")
+			}
+		case "unit":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.Unit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unit\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeMeasurement")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNodeMeasurement) {
+					name = jsonFieldsNameOfNodeMeasurement[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeMeasurement) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeMeasurement) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeMetrics) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeMetrics) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("uzis")
+		e.Int(s.Uzis)
+	}
+	{
+		e.FieldStart("ai_nodes")
+		e.Int(s.AiNodes)
+	}
+	{
+		e.FieldStart("valid")
+		e.Int(s.Valid)
+	}
+	{
+		e.FieldStart("invalid")
+		e.Int(s.Invalid)
+	}
+	{
+		e.FieldStart("unvalidated")
+		e.Int(s.Unvalidated)
+	}
+	{
+		e.FieldStart("manual_nodes")
+		e.Int(s.ManualNodes)
+	}
+	{
+		if s.Precision.Set {
+			e.FieldStart("precision")
+			s.Precision.Encode(e)
+		}
+	}
+	{
+		if s.UnvalidatedShare.Set {
+			e.FieldStart("unvalidated_share")
+			s.UnvalidatedShare.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("ai_tirads")
+		s.AiTirads.Encode(e)
+	}
+	{
+		e.FieldStart("manual_tirads")
+		s.ManualTirads.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfNodeMetrics = [10]string{
+	0: "uzis",
+	1: "ai_nodes",
+	2: "valid",
+	3: "invalid",
+	4: "unvalidated",
+	5: "manual_nodes",
+	6: "precision",
+	7: "unvalidated_share",
+	8: "ai_tirads",
+	9: "manual_tirads",
+}
+
+// Decode decodes NodeMetrics from json.
+func (s *NodeMetrics) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeMetrics to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uzis":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Uzis = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uzis\"")
+			}
+		case "ai_nodes":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.AiNodes = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ai_nodes\"")
+			}
+		case "valid":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Valid = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"valid\"")
+			}
+		case "invalid":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Invalid = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"invalid\"")
+			}
+		case "unvalidated":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.Unvalidated = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unvalidated\"")
+			}
+		case "manual_nodes":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.ManualNodes = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"manual_nodes\"")
+			}
+		case "precision":
+			if err := func() error {
+				s.Precision.Reset()
+				if err := s.Precision.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"precision\"")
+			}
+		case "unvalidated_share":
+			if err := func() error {
+				s.UnvalidatedShare.Reset()
+				if err := s.UnvalidatedShare.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unvalidated_share\"")
+			}
+		case "ai_tirads":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				if err := s.AiTirads.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ai_tirads\"")
+			}
+		case "manual_tirads":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				if err := s.ManualTirads.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"manual_tirads\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeMetrics")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00111111,
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNodeMetrics) {
+					name = jsonFieldsNameOfNodeMetrics[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeMetrics) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeMetrics) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeReviewItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeReviewItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("node_id")
+		json.EncodeUUID(e, s.NodeID)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.Node.Set {
+			e.FieldStart("node")
+			s.Node.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNodeReviewItem = [3]string{
+	0: "node_id",
+	1: "status",
+	2: "node",
+}
+
+// Decode decodes NodeReviewItem from json.
+func (s *NodeReviewItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeReviewItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "node_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.NodeID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"node_id\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "node":
+			if err := func() error {
+				s.Node.Reset()
+				if err := s.Node.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"node\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeReviewItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNodeReviewItem) {
+					name = jsonFieldsNameOfNodeReviewItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeReviewItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeReviewItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeReviewItemStatus as json.
+func (s NodeReviewItemStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NodeReviewItemStatus from json.
+func (s *NodeReviewItemStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeReviewItemStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NodeReviewItemStatus(v) {
+	case NodeReviewItemStatusApplied:
+		*s = NodeReviewItemStatusApplied
+	case NodeReviewItemStatusUnchanged:
+		*s = NodeReviewItemStatusUnchanged
+	case NodeReviewItemStatusNotFound:
+		*s = NodeReviewItemStatusNotFound
+	case NodeReviewItemStatusManualNode:
+		*s = NodeReviewItemStatusManualNode
+	case NodeReviewItemStatusAlreadyValidated:
+		*s = NodeReviewItemStatusAlreadyValidated
+	case NodeReviewItemStatusBelowThreshold:
+		*s = NodeReviewItemStatusBelowThreshold
+	default:
+		*s = NodeReviewItemStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NodeReviewItemStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeReviewItemStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeTirads) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeTirads) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("node")
+		s.Node.Encode(e)
+	}
+	{
+		if s.Descriptors.Set {
+			e.FieldStart("descriptors")
+			s.Descriptors.Encode(e)
+		}
+	}
+	{
+		if s.Score.Set {
+			e.FieldStart("score")
+			s.Score.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNodeTirads = [3]string{
+	0: "node",
+	1: "descriptors",
+	2: "score",
+}
+
+// Decode decodes NodeTirads from json.
+func (s *NodeTirads) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeTirads to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "node":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Node.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"node\"")
+			}
+		case "descriptors":
+			if err := func() error {
+				s.Descriptors.Reset()
+				if err := s.Descriptors.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"descriptors\"")
+			}
+		case "score":
+			if err := func() error {
+				s.Score.Reset()
+				if err := s.Score.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeTirads")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNodeTirads) {
+					name = jsonFieldsNameOfNodeTirads[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeTirads) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeTirads) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeValidation as json.
+func (s NodeValidation) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NodeValidation from json.
+func (s *NodeValidation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeValidation to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NodeValidation(v) {
+	case NodeValidationInvalid:
+		*s = NodeValidationInvalid
+	case NodeValidationValid:
+		*s = NodeValidationValid
+	default:
+		*s = NodeValidation(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NodeValidation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeValidation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Contor as json.
+func (o OptContor) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes Contor from json.
+func (o *OptContor) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptContor to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptContor) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptContor) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyColor as json.
+func (o OptCytologyColor) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CytologyColor from json.
+func (o *OptCytologyColor) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyColor to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyColor) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyColor) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyCreateCreateCreatedDiagnosticMarking as json.
+func (o OptCytologyCreateCreateCreatedDiagnosticMarking) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyCreateCreateCreatedDiagnosticMarking from json.
+func (o *OptCytologyCreateCreateCreatedDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyCreateCreateCreatedDiagnosticMarking to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyCreateCreateCreatedDiagnosticMarking) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyCreateCreateCreatedDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyCreateCreateCreatedMaterialType as json.
+func (o OptCytologyCreateCreateCreatedMaterialType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyCreateCreateCreatedMaterialType from json.
+func (o *OptCytologyCreateCreateCreatedMaterialType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyCreateCreateCreatedMaterialType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyCreateCreateCreatedMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyCreateCreateCreatedMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyGeometryType as json.
+func (o OptCytologyGeometryType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyGeometryType from json.
+func (o *OptCytologyGeometryType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyGeometryType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyGeometryType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyGeometryType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyHistoryReadOKResultsItemDiagnosticMarking as json.
+func (o OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyHistoryReadOKResultsItemDiagnosticMarking from json.
+func (o *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyHistoryReadOKResultsItemDiagnosticMarking to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyHistoryReadOKResultsItemDiagnosticMarking) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyHistoryReadOKResultsItemMaterialType as json.
+func (o OptCytologyHistoryReadOKResultsItemMaterialType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyHistoryReadOKResultsItemMaterialType from json.
+func (o *OptCytologyHistoryReadOKResultsItemMaterialType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyHistoryReadOKResultsItemMaterialType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyHistoryReadOKResultsItemMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyHistoryReadOKResultsItemMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyPatientShotDiagnosticMarking as json.
+func (o OptCytologyPatientShotDiagnosticMarking) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyPatientShotDiagnosticMarking from json.
+func (o *OptCytologyPatientShotDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyPatientShotDiagnosticMarking to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyPatientShotDiagnosticMarking) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyPatientShotDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyPatientShotMaterialType as json.
+func (o OptCytologyPatientShotMaterialType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyPatientShotMaterialType from json.
+func (o *OptCytologyPatientShotMaterialType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyPatientShotMaterialType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyPatientShotMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyPatientShotMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyReadOKInfoImageGroupDiagnosticMarking as json.
+func (o OptCytologyReadOKInfoImageGroupDiagnosticMarking) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyReadOKInfoImageGroupDiagnosticMarking from json.
+func (o *OptCytologyReadOKInfoImageGroupDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyReadOKInfoImageGroupDiagnosticMarking to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyReadOKInfoImageGroupDiagnosticMarking) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyReadOKInfoImageGroupDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyReadOKInfoImageGroupMaterialType as json.
+func (o OptCytologyReadOKInfoImageGroupMaterialType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyReadOKInfoImageGroupMaterialType from json.
+func (o *OptCytologyReadOKInfoImageGroupMaterialType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyReadOKInfoImageGroupMaterialType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyReadOKInfoImageGroupMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyReadOKInfoImageGroupMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologySegmentGroupCreateCreateCreatedData as json.
+func (o OptCytologySegmentGroupCreateCreateCreatedData) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CytologySegmentGroupCreateCreateCreatedData from json.
+func (o *OptCytologySegmentGroupCreateCreateCreatedData) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologySegmentGroupCreateCreateCreatedData to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologySegmentGroupCreateCreateCreatedData) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologySegmentGroupCreateCreateCreatedData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologySegmentProperties as json.
+func (o OptCytologySegmentProperties) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CytologySegmentProperties from json.
+func (o *OptCytologySegmentProperties) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologySegmentProperties to nil")
+	}
+	o.Set = true
+	o.Value = make(CytologySegmentProperties)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologySegmentProperties) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologySegmentProperties) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologySegmentsListOKResultsItemGroupType as json.
+func (o OptCytologySegmentsListOKResultsItemGroupType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologySegmentsListOKResultsItemGroupType from json.
+func (o *OptCytologySegmentsListOKResultsItemGroupType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologySegmentsListOKResultsItemGroupType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologySegmentsListOKResultsItemGroupType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologySegmentsListOKResultsItemGroupType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologySegmentsListOKResultsItemSegType as json.
+func (o OptCytologySegmentsListOKResultsItemSegType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologySegmentsListOKResultsItemSegType from json.
+func (o *OptCytologySegmentsListOKResultsItemSegType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologySegmentsListOKResultsItemSegType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologySegmentsListOKResultsItemSegType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologySegmentsListOKResultsItemSegType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdatePartialUpdateReqDiagnosticMarking as json.
+func (o OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyUpdatePartialUpdateReqDiagnosticMarking from json.
+func (o *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyUpdatePartialUpdateReqDiagnosticMarking to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyUpdatePartialUpdateReqDiagnosticMarking) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdatePartialUpdateReqMaterialType as json.
+func (o OptCytologyUpdatePartialUpdateReqMaterialType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyUpdatePartialUpdateReqMaterialType from json.
+func (o *OptCytologyUpdatePartialUpdateReqMaterialType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyUpdatePartialUpdateReqMaterialType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyUpdatePartialUpdateReqMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyUpdatePartialUpdateReqMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdateUpdateReqDetails as json.
+func (o OptCytologyUpdateUpdateReqDetails) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CytologyUpdateUpdateReqDetails from json.
+func (o *OptCytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqDetails to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyUpdateUpdateReqDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyUpdateUpdateReqDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdateUpdateReqDetailsDiagnosticMarking as json.
+func (o OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyUpdateUpdateReqDetailsDiagnosticMarking from json.
+func (o *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqDetailsDiagnosticMarking to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdateUpdateReqDetailsMaterialType as json.
+func (o OptCytologyUpdateUpdateReqDetailsMaterialType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyUpdateUpdateReqDetailsMaterialType from json.
+func (o *OptCytologyUpdateUpdateReqDetailsMaterialType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqDetailsMaterialType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyUpdateUpdateReqDetailsMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyUpdateUpdateReqDetailsMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdateUpdateReqDiagnosticMarking as json.
+func (o OptCytologyUpdateUpdateReqDiagnosticMarking) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyUpdateUpdateReqDiagnosticMarking from json.
+func (o *OptCytologyUpdateUpdateReqDiagnosticMarking) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqDiagnosticMarking to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyUpdateUpdateReqDiagnosticMarking) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyUpdateUpdateReqDiagnosticMarking) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CytologyUpdateUpdateReqMaterialType as json.
+func (o OptCytologyUpdateUpdateReqMaterialType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CytologyUpdateUpdateReqMaterialType from json.
+func (o *OptCytologyUpdateUpdateReqMaterialType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqMaterialType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCytologyUpdateUpdateReqMaterialType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCytologyUpdateUpdateReqMaterialType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptDate) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptDate) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDate to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDate)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDate)
+}
+
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes EchographicsEchogenicity as json.
+func (o OptEchographicsEchogenicity) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EchographicsEchogenicity from json.
+func (o *OptEchographicsEchogenicity) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEchographicsEchogenicity to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEchographicsEchogenicity) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEchographicsEchogenicity) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EchographicsPatientSex as json.
+func (o OptEchographicsPatientSex) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EchographicsPatientSex from json.
+func (o *OptEchographicsPatientSex) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEchographicsPatientSex to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEchographicsPatientSex) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEchographicsPatientSex) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EchographicsStruct as json.
+func (o OptEchographicsStruct) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EchographicsStruct from json.
+func (o *OptEchographicsStruct) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEchographicsStruct to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEchographicsStruct) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEchographicsStruct) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EchographicsVascularization as json.
+func (o OptEchographicsVascularization) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EchographicsVascularization from json.
+func (o *OptEchographicsVascularization) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEchographicsVascularization to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEchographicsVascularization) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEchographicsVascularization) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes float64 as json.
+func (o OptFloat64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Float64(float64(o.Value))
+}
+
+// Decode decodes float64 from json.
+func (o *OptFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFloat64 to nil")
+	}
+	o.Set = true
+	v, err := d.Float64()
+	if err != nil {
+		return err
+	}
+	o.Value = float64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptFloat64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptFloat64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int64 as json.
+func (o OptInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int64(int64(o.Value))
+}
+
+// Decode decodes int64 from json.
+func (o *OptInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt64 to nil")
+	}
+	o.Set = true
+	v, err := d.Int64()
+	if err != nil {
+		return err
+	}
+	o.Value = int64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeValidation as json.
+func (o OptNilNodeValidation) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes NodeValidation from json.
+func (o *OptNilNodeValidation) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilNodeValidation to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v NodeValidation
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilNodeValidation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilNodeValidation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes uuid.UUID as json.
+func (o OptNilUUID) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	json.EncodeUUID(e, o.Value)
+}
+
+// Decode decodes uuid.UUID from json.
+func (o *OptNilUUID) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilUUID to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v uuid.UUID
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := json.DecodeUUID(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilUUID) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilUUID) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziNodesIDPatchReqValidation as json.
+func (o OptNilUziNodesIDPatchReqValidation) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes UziNodesIDPatchReqValidation from json.
+func (o *OptNilUziNodesIDPatchReqValidation) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilUziNodesIDPatchReqValidation to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v UziNodesIDPatchReqValidation
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilUziNodesIDPatchReqValidation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilUziNodesIDPatchReqValidation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Node as json.
+func (o OptNode) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes Node from json.
+func (o *OptNode) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNode to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeDescriptors as json.
+func (o OptNodeDescriptors) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes NodeDescriptors from json.
+func (o *OptNodeDescriptors) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNodeDescriptors to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNodeDescriptors) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNodeDescriptors) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeLobe as json.
+func (o OptNodeLobe) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes NodeLobe from json.
+func (o *OptNodeLobe) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNodeLobe to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNodeLobe) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNodeLobe) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeMeasurement as json.
+func (o OptNodeMeasurement) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes NodeMeasurement from json.
+func (o *OptNodeMeasurement) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNodeMeasurement to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNodeMeasurement) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNodeMeasurement) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PixelSpacing as json.
+func (o OptPixelSpacing) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes PixelSpacing from json.
+func (o *OptPixelSpacing) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPixelSpacing to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptPixelSpacing) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptPixelSpacing) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProbeType as json.
+func (o OptProbeType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes ProbeType from json.
+func (o *OptProbeType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptProbeType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptProbeType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptProbeType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SegmentMeasurement as json.
+func (o OptSegmentMeasurement) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes SegmentMeasurement from json.
+func (o *OptSegmentMeasurement) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptSegmentMeasurement to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptSegmentMeasurement) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptSegmentMeasurement) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
+	}
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TiradsScore as json.
+func (o OptTiradsScore) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes TiradsScore from json.
+func (o *OptTiradsScore) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptTiradsScore to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptTiradsScore) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptTiradsScore) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes url.URL as json.
+func (o OptURI) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	json.EncodeURI(e, o.Value)
+}
+
+// Decode decodes url.URL from json.
+func (o *OptURI) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptURI to nil")
+	}
+	o.Set = true
+	v, err := json.DecodeURI(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptURI) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptURI) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes uuid.UUID as json.
+func (o OptUUID) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	json.EncodeUUID(e, o.Value)
+}
+
+// Decode decodes uuid.UUID from json.
+func (o *OptUUID) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUUID to nil")
+	}
+	o.Set = true
+	v, err := json.DecodeUUID(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUUID) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUUID) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziIDCheckPostReq as json.
+func (o OptUziIDCheckPostReq) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes UziIDCheckPostReq from json.
+func (o *OptUziIDCheckPostReq) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUziIDCheckPostReq to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUziIDCheckPostReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUziIDCheckPostReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziIDCheckPostReqUnvalidated as json.
+func (o OptUziIDCheckPostReqUnvalidated) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes UziIDCheckPostReqUnvalidated from json.
+func (o *OptUziIDCheckPostReqUnvalidated) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUziIDCheckPostReqUnvalidated to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUziIDCheckPostReqUnvalidated) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUziIDCheckPostReqUnvalidated) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziIDPatchReqProjection as json.
+func (o OptUziIDPatchReqProjection) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes UziIDPatchReqProjection from json.
+func (o *OptUziIDPatchReqProjection) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUziIDPatchReqProjection to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUziIDPatchReqProjection) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUziIDPatchReqProjection) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes YookassaWebhookRequestObject as json.
+func (o OptYookassaWebhookRequestObject) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes YookassaWebhookRequestObject from json.
+func (o *OptYookassaWebhookRequestObject) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptYookassaWebhookRequestObject to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptYookassaWebhookRequestObject) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptYookassaWebhookRequestObject) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes YookassaWebhookRequestObjectAmount as json.
+func (o OptYookassaWebhookRequestObjectAmount) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes YookassaWebhookRequestObjectAmount from json.
+func (o *OptYookassaWebhookRequestObjectAmount) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptYookassaWebhookRequestObjectAmount to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptYookassaWebhookRequestObjectAmount) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptYookassaWebhookRequestObjectAmount) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes YookassaWebhookRequestObjectPaymentMethod as json.
+func (o OptYookassaWebhookRequestObjectPaymentMethod) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes YookassaWebhookRequestObjectPaymentMethod from json.
+func (o *OptYookassaWebhookRequestObjectPaymentMethod) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptYookassaWebhookRequestObjectPaymentMethod to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptYookassaWebhookRequestObjectPaymentMethod) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptYookassaWebhookRequestObjectPaymentMethod) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes YookassaWebhookRequestObjectPaymentMethodCard as json.
+func (o OptYookassaWebhookRequestObjectPaymentMethodCard) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes YookassaWebhookRequestObjectPaymentMethodCard from json.
+func (o *OptYookassaWebhookRequestObjectPaymentMethodCard) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptYookassaWebhookRequestObjectPaymentMethodCard to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptYookassaWebhookRequestObjectPaymentMethodCard) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptYookassaWebhookRequestObjectPaymentMethodCard) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Patient) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Patient) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("fullname")
+		e.Str(s.Fullname)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("policy")
+		e.Str(s.Policy)
+	}
+	{
+		e.FieldStart("active")
+		e.Bool(s.Active)
+	}
+	{
+		e.FieldStart("malignancy")
+		e.Bool(s.Malignancy)
+	}
+	{
+		e.FieldStart("birth_date")
+		json.EncodeDate(e, s.BirthDate)
+	}
+	{
+		if s.LastUziDate.Set {
+			e.FieldStart("last_uzi_date")
+			s.LastUziDate.Encode(e, json.EncodeDate)
+		}
+	}
+}
+
+var jsonFieldsNameOfPatient = [8]string{
+	0: "id",
+	1: "fullname",
+	2: "email",
+	3: "policy",
+	4: "active",
+	5: "malignancy",
+	6: "birth_date",
+	7: "last_uzi_date",
+}
+
+// Decode decodes Patient from json.
+func (s *Patient) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Patient to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "fullname":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Fullname = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fullname\"")
+			}
+		case "email":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "policy":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Policy = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"policy\"")
+			}
+		case "active":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.Active = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"active\"")
+			}
+		case "malignancy":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.Malignancy = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"malignancy\"")
+			}
+		case "birth_date":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDate(d)
+				s.BirthDate = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"birth_date\"")
+			}
+		case "last_uzi_date":
+			if err := func() error {
+				s.LastUziDate.Reset()
+				if err := s.LastUziDate.Decode(d, json.DecodeDate); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_uzi_date\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Patient")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPatient) {
+					name = jsonFieldsNameOfPatient[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Patient) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Patient) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PatientCard) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PatientCard) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.AcceptanceDatetime.Set {
+			e.FieldStart("acceptance_datetime")
+			s.AcceptanceDatetime.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Diagnosis.Set {
+			e.FieldStart("diagnosis")
+			s.Diagnosis.Encode(e)
+		}
+	}
+	{
+		if s.Patient.Set {
+			e.FieldStart("patient")
+			s.Patient.Encode(e)
+		}
+	}
+	{
+		if s.MedWorker.Set {
+			e.FieldStart("med_worker")
+			s.MedWorker.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPatientCard = [5]string{
+	0: "id",
+	1: "acceptance_datetime",
+	2: "diagnosis",
+	3: "patient",
+	4: "med_worker",
+}
+
+// Decode decodes PatientCard from json.
+func (s *PatientCard) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatientCard to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "acceptance_datetime":
+			if err := func() error {
+				s.AcceptanceDatetime.Reset()
+				if err := s.AcceptanceDatetime.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"acceptance_datetime\"")
+			}
+		case "diagnosis":
+			if err := func() error {
+				s.Diagnosis.Reset()
+				if err := s.Diagnosis.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diagnosis\"")
+			}
+		case "patient":
+			if err := func() error {
+				s.Patient.Reset()
+				if err := s.Patient.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patient\"")
+			}
+		case "med_worker":
+			if err := func() error {
+				s.MedWorker.Reset()
+				if err := s.MedWorker.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"med_worker\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatientCard")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PatientCard) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PatientCard) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PaymentProvider) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PaymentProvider) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.IsActive.Set {
+			e.FieldStart("is_active")
+			s.IsActive.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPaymentProvider = [3]string{
+	0: "id",
+	1: "name",
+	2: "is_active",
+}
+
+// Decode decodes PaymentProvider from json.
+func (s *PaymentProvider) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PaymentProvider to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "is_active":
+			if err := func() error {
+				s.IsActive.Reset()
+				if err := s.IsActive.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_active\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PaymentProvider")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PaymentProvider) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PaymentProvider) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PaymentProvidersGetOKApplicationJSON as json.
+func (s PaymentProvidersGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []PaymentProvider(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes PaymentProvidersGetOKApplicationJSON from json.
+func (s *PaymentProvidersGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PaymentProvidersGetOKApplicationJSON to nil")
+	}
+	var unwrapped []PaymentProvider
+	if err := func() error {
+		unwrapped = make([]PaymentProvider, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem PaymentProvider
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = PaymentProvidersGetOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s PaymentProvidersGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PaymentProvidersGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PeriodNodeMetrics) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PeriodNodeMetrics) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("start")
+		json.EncodeDateTime(e, s.Start)
+	}
+	{
+		e.FieldStart("metrics")
+		s.Metrics.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfPeriodNodeMetrics = [2]string{
+	0: "start",
+	1: "metrics",
+}
+
+// Decode decodes PeriodNodeMetrics from json.
+func (s *PeriodNodeMetrics) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PeriodNodeMetrics to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "start":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.Start = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start\"")
+			}
+		case "metrics":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Metrics.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"metrics\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PeriodNodeMetrics")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPeriodNodeMetrics) {
+					name = jsonFieldsNameOfPeriodNodeMetrics[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PeriodNodeMetrics) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PeriodNodeMetrics) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PixelSpacing) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PixelSpacing) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("x")
+		e.Float64(s.X)
+	}
+	{
+		e.FieldStart("y")
+		e.Float64(s.Y)
+	}
+}
+
+var jsonFieldsNameOfPixelSpacing = [2]string{
+	0: "x",
+	1: "y",
+}
+
+// Decode decodes PixelSpacing from json.
+func (s *PixelSpacing) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PixelSpacing to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "x":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Float64()
+				s.X = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"x\"")
+			}
+		case "y":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Float64()
+				s.Y = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"y\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PixelSpacing")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPixelSpacing) {
+					name = jsonFieldsNameOfPixelSpacing[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PixelSpacing) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PixelSpacing) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProbeType as json.
+func (s ProbeType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ProbeType from json.
+func (s *ProbeType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProbeType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ProbeType(v) {
+	case ProbeTypeLinear:
+		*s = ProbeTypeLinear
+	case ProbeTypeConvex:
+		*s = ProbeTypeConvex
+	case ProbeTypeSector:
+		*s = ProbeTypeSector
+	default:
+		*s = ProbeType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ProbeType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProbeType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PurchaseSubscriptionRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PurchaseSubscriptionRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("tariff_plan_id")
+		json.EncodeUUID(e, s.TariffPlanID)
+	}
+	{
+		e.FieldStart("payment_provider_id")
+		json.EncodeUUID(e, s.PaymentProviderID)
+	}
+}
+
+var jsonFieldsNameOfPurchaseSubscriptionRequest = [2]string{
+	0: "tariff_plan_id",
+	1: "payment_provider_id",
+}
+
+// Decode decodes PurchaseSubscriptionRequest from json.
+func (s *PurchaseSubscriptionRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PurchaseSubscriptionRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "tariff_plan_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.TariffPlanID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tariff_plan_id\"")
+			}
+		case "payment_provider_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.PaymentProviderID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"payment_provider_id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PurchaseSubscriptionRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPurchaseSubscriptionRequest) {
+					name = jsonFieldsNameOfPurchaseSubscriptionRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PurchaseSubscriptionRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PurchaseSubscriptionRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PurchaseSubscriptionResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PurchaseSubscriptionResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("subscription_id")
+		json.EncodeUUID(e, s.SubscriptionID)
+	}
+	{
+		e.FieldStart("confirmation_url")
+		e.Str(s.ConfirmationURL)
+	}
+}
+
+var jsonFieldsNameOfPurchaseSubscriptionResponse = [2]string{
+	0: "subscription_id",
+	1: "confirmation_url",
+}
+
+// Decode decodes PurchaseSubscriptionResponse from json.
+func (s *PurchaseSubscriptionResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PurchaseSubscriptionResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "subscription_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.SubscriptionID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"subscription_id\"")
+			}
+		case "confirmation_url":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.ConfirmationURL = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"confirmation_url\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PurchaseSubscriptionResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPurchaseSubscriptionResponse) {
+					name = jsonFieldsNameOfPurchaseSubscriptionResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PurchaseSubscriptionResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PurchaseSubscriptionResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ReaderAgreement) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReaderAgreement) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("reader_a")
+		json.EncodeUUID(e, s.ReaderA)
+	}
+	{
+		e.FieldStart("reader_b")
+		json.EncodeUUID(e, s.ReaderB)
+	}
+	{
+		e.FieldStart("uzis")
+		e.Int(s.Uzis)
+	}
+	{
+		e.FieldStart("matched")
+		e.Int(s.Matched)
+	}
+	{
+		e.FieldStart("unmatched")
+		e.Int(s.Unmatched)
+	}
+	{
+		if s.Kappa.Set {
+			e.FieldStart("kappa")
+			s.Kappa.Encode(e)
+		}
+	}
+	{
+		if s.MeanIou.Set {
+			e.FieldStart("mean_iou")
+			s.MeanIou.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfReaderAgreement = [7]string{
+	0: "reader_a",
+	1: "reader_b",
+	2: "uzis",
+	3: "matched",
+	4: "unmatched",
+	5: "kappa",
+	6: "mean_iou",
+}
+
+// Decode decodes ReaderAgreement from json.
+func (s *ReaderAgreement) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReaderAgreement to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "reader_a":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ReaderA = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reader_a\"")
+			}
+		case "reader_b":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ReaderB = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reader_b\"")
+			}
+		case "uzis":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Uzis = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uzis\"")
+			}
+		case "matched":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Matched = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"matched\"")
+			}
+		case "unmatched":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.Unmatched = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unmatched\"")
+			}
+		case "kappa":
+			if err := func() error {
+				s.Kappa.Reset()
+				if err := s.Kappa.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kappa\"")
+			}
+		case "mean_iou":
+			if err := func() error {
+				s.MeanIou.Reset()
+				if err := s.MeanIou.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mean_iou\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReaderAgreement")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfReaderAgreement) {
+					name = jsonFieldsNameOfReaderAgreement[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ReaderAgreement) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ReaderAgreement) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RefreshPostOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RefreshPostOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("access_token")
+		e.Str(s.AccessToken)
+	}
+	{
+		e.FieldStart("refresh_token")
+		e.Str(s.RefreshToken)
+	}
+}
+
+var jsonFieldsNameOfRefreshPostOK = [2]string{
+	0: "access_token",
+	1: "refresh_token",
+}
+
+// Decode decodes RefreshPostOK from json.
+func (s *RefreshPostOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RefreshPostOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "access_token":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.AccessToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"access_token\"")
+			}
+		case "refresh_token":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refresh_token\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RefreshPostOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRefreshPostOK) {
+					name = jsonFieldsNameOfRefreshPostOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RefreshPostOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RefreshPostOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RefreshPostReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RefreshPostReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("refresh_token")
+		e.Str(s.RefreshToken)
+	}
+}
+
+var jsonFieldsNameOfRefreshPostReq = [1]string{
+	0: "refresh_token",
+}
+
+// Decode decodes RefreshPostReq from json.
+func (s *RefreshPostReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RefreshPostReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "refresh_token":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refresh_token\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RefreshPostReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRefreshPostReq) {
+					name = jsonFieldsNameOfRefreshPostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RefreshPostReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RefreshPostReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RegDoctorPostReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RegDoctorPostReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+	{
+		e.FieldStart("fullname")
+		e.Str(s.Fullname)
+	}
+	{
+		e.FieldStart("org")
+		e.Str(s.Org)
+	}
+	{
+		e.FieldStart("job")
+		e.Str(s.Job)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRegDoctorPostReq = [6]string{
+	0: "email",
+	1: "password",
+	2: "fullname",
+	3: "org",
+	4: "job",
+	5: "description",
+}
+
+// Decode decodes RegDoctorPostReq from json.
+func (s *RegDoctorPostReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RegDoctorPostReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "email":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "password":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		case "fullname":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Fullname = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fullname\"")
+			}
+		case "org":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Org = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"org\"")
+			}
+		case "job":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Job = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"job\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RegDoctorPostReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRegDoctorPostReq) {
+					name = jsonFieldsNameOfRegDoctorPostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RegDoctorPostReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RegDoctorPostReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RegPatientPostReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RegPatientPostReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("fullname")
+		e.Str(s.Fullname)
+	}
+	{
+		e.FieldStart("policy")
+		e.Str(s.Policy)
+	}
+	{
+		e.FieldStart("birth_date")
+		json.EncodeDate(e, s.BirthDate)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+}
+
+var jsonFieldsNameOfRegPatientPostReq = [5]string{
+	0: "fullname",
+	1: "policy",
+	2: "birth_date",
+	3: "email",
+	4: "password",
+}
+
+// Decode decodes RegPatientPostReq from json.
+func (s *RegPatientPostReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RegPatientPostReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "fullname":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Fullname = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fullname\"")
+			}
+		case "policy":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Policy = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"policy\"")
+			}
+		case "birth_date":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDate(d)
+				s.BirthDate = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"birth_date\"")
+			}
+		case "email":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "password":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RegPatientPostReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRegPatientPostReq) {
+					name = jsonFieldsNameOfRegPatientPostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RegPatientPostReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RegPatientPostReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Report) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Report) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("uzi_id")
+		json.EncodeUUID(e, s.UziID)
+	}
+	{
+		e.FieldStart("version")
+		e.Int(s.Version)
+	}
+	{
+		e.FieldStart("create_at")
+		json.EncodeDateTime(e, s.CreateAt)
+	}
+}
+
+var jsonFieldsNameOfReport = [4]string{
+	0: "id",
+	1: "uzi_id",
+	2: "version",
+	3: "create_at",
+}
+
+// Decode decodes Report from json.
+func (s *Report) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Report to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "uzi_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.UziID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uzi_id\"")
+			}
+		case "version":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Version = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		case "create_at":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreateAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"create_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Report")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfReport) {
+					name = jsonFieldsNameOfReport[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptBool) MarshalJSON() ([]byte, error) {
+func (s *Report) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBool) UnmarshalJSON(data []byte) error {
+func (s *Report) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyCreateCreateCreatedDiagnosticMarking as json.
-func (o OptCytologyCreateCreateCreatedDiagnosticMarking) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *Segment) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Segment) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("image_id")
+		json.EncodeUUID(e, s.ImageID)
+	}
+	{
+		e.FieldStart("node_id")
+		json.EncodeUUID(e, s.NodeID)
+	}
+	{
+		e.FieldStart("contor")
+		s.Contor.Encode(e)
+	}
+	{
+		e.FieldStart("ai")
+		e.Bool(s.Ai)
+	}
+	{
+		e.FieldStart("tirads_23")
+		e.Float64(s.Tirads23)
+	}
+	{
+		e.FieldStart("tirads_4")
+		e.Float64(s.Tirads4)
+	}
+	{
+		e.FieldStart("tirads_5")
+		e.Float64(s.Tirads5)
+	}
+	{
+		if s.Measurement.Set {
+			e.FieldStart("measurement")
+			s.Measurement.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfSegment = [9]string{
+	0: "id",
+	1: "image_id",
+	2: "node_id",
+	3: "contor",
+	4: "ai",
+	5: "tirads_23",
+	6: "tirads_4",
+	7: "tirads_5",
+	8: "measurement",
+}
+
+// Decode decodes Segment from json.
+func (s *Segment) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Segment to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "image_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ImageID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"image_id\"")
+			}
+		case "node_id":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.NodeID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"node_id\"")
+			}
+		case "contor":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Contor.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"contor\"")
+			}
+		case "ai":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.Ai = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ai\"")
+			}
+		case "tirads_23":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads23 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_23\"")
+			}
+		case "tirads_4":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads4 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_4\"")
+			}
+		case "tirads_5":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads5 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_5\"")
+			}
+		case "measurement":
+			if err := func() error {
+				s.Measurement.Reset()
+				if err := s.Measurement.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"measurement\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Segment")
 	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes CytologyCreateCreateCreatedDiagnosticMarking from json.
-func (o *OptCytologyCreateCreateCreatedDiagnosticMarking) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyCreateCreateCreatedDiagnosticMarking to nil")
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSegment) {
+					name = jsonFieldsNameOfSegment[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyCreateCreateCreatedDiagnosticMarking) MarshalJSON() ([]byte, error) {
+func (s *Segment) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyCreateCreateCreatedDiagnosticMarking) UnmarshalJSON(data []byte) error {
+func (s *Segment) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyCreateCreateCreatedMaterialType as json.
-func (o OptCytologyCreateCreateCreatedMaterialType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *SegmentDraft) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CytologyCreateCreateCreatedMaterialType from json.
-func (o *OptCytologyCreateCreateCreatedMaterialType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyCreateCreateCreatedMaterialType to nil")
+// encodeFields encodes fields.
+func (s *SegmentDraft) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("node_id")
+		json.EncodeUUID(e, s.NodeID)
+	}
+	{
+		e.FieldStart("image_id")
+		json.EncodeUUID(e, s.ImageID)
+	}
+	{
+		e.FieldStart("source_segment_id")
+		json.EncodeUUID(e, s.SourceSegmentID)
+	}
+	{
+		e.FieldStart("contor")
+		s.Contor.Encode(e)
+	}
+	{
+		e.FieldStart("tirads_23")
+		e.Float64(s.Tirads23)
+	}
+	{
+		e.FieldStart("tirads_4")
+		e.Float64(s.Tirads4)
+	}
+	{
+		e.FieldStart("tirads_5")
+		e.Float64(s.Tirads5)
+	}
+	{
+		e.FieldStart("score")
+		e.Float64(s.Score)
+	}
+	{
+		e.FieldStart("create_at")
+		json.EncodeDateTime(e, s.CreateAt)
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyCreateCreateCreatedMaterialType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyCreateCreateCreatedMaterialType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfSegmentDraft = [10]string{
+	0: "id",
+	1: "node_id",
+	2: "image_id",
+	3: "source_segment_id",
+	4: "contor",
+	5: "tirads_23",
+	6: "tirads_4",
+	7: "tirads_5",
+	8: "score",
+	9: "create_at",
 }
 
-// Encode encodes CytologyHistoryReadOKResultsItemDiagnosticMarking as json.
-func (o OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes SegmentDraft from json.
+func (s *SegmentDraft) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SegmentDraft to nil")
 	}
-	e.Str(string(o.Value))
-}
+	var requiredBitSet [2]uint8
 
-// Decode decodes CytologyHistoryReadOKResultsItemDiagnosticMarking from json.
-func (o *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyHistoryReadOKResultsItemDiagnosticMarking to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "node_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.NodeID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"node_id\"")
+			}
+		case "image_id":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ImageID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"image_id\"")
+			}
+		case "source_segment_id":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.SourceSegmentID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source_segment_id\"")
+			}
+		case "contor":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Contor.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"contor\"")
+			}
+		case "tirads_23":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads23 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_23\"")
+			}
+		case "tirads_4":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads4 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_4\"")
+			}
+		case "tirads_5":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads5 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_5\"")
+			}
+		case "score":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Float64()
+				s.Score = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "create_at":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreateAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"create_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SegmentDraft")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSegmentDraft) {
+					name = jsonFieldsNameOfSegmentDraft[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyHistoryReadOKResultsItemDiagnosticMarking) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CytologyHistoryReadOKResultsItemMaterialType as json.
-func (o OptCytologyHistoryReadOKResultsItemMaterialType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	e.Str(string(o.Value))
-}
 
-// Decode decodes CytologyHistoryReadOKResultsItemMaterialType from json.
-func (o *OptCytologyHistoryReadOKResultsItemMaterialType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyHistoryReadOKResultsItemMaterialType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyHistoryReadOKResultsItemMaterialType) MarshalJSON() ([]byte, error) {
+func (s *SegmentDraft) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyHistoryReadOKResultsItemMaterialType) UnmarshalJSON(data []byte) error {
+func (s *SegmentDraft) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyPatientShotDiagnosticMarking as json.
-func (o OptCytologyPatientShotDiagnosticMarking) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes CytologyPatientShotDiagnosticMarking from json.
-func (o *OptCytologyPatientShotDiagnosticMarking) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyPatientShotDiagnosticMarking to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyPatientShotDiagnosticMarking) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyPatientShotDiagnosticMarking) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+// Encode implements json.Marshaler.
+func (s *SegmentMeasurement) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Encode encodes CytologyPatientShotMaterialType as json.
-func (o OptCytologyPatientShotMaterialType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// encodeFields encodes fields.
+func (s *SegmentMeasurement) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("bbox")
+		s.Bbox.Encode(e)
 	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes CytologyPatientShotMaterialType from json.
-func (o *OptCytologyPatientShotMaterialType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyPatientShotMaterialType to nil")
+	{
+		e.FieldStart("area")
+		e.Float64(s.Area)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("perimeter")
+		e.Float64(s.Perimeter)
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyPatientShotMaterialType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyPatientShotMaterialType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CytologyReadOKInfoImageGroupDiagnosticMarking as json.
-func (o OptCytologyReadOKInfoImageGroupDiagnosticMarking) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		e.FieldStart("major_axis")
+		e.Float64(s.MajorAxis)
 	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes CytologyReadOKInfoImageGroupDiagnosticMarking from json.
-func (o *OptCytologyReadOKInfoImageGroupDiagnosticMarking) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyReadOKInfoImageGroupDiagnosticMarking to nil")
+	{
+		e.FieldStart("minor_axis")
+		e.Float64(s.MinorAxis)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("unit")
+		s.Unit.Encode(e)
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyReadOKInfoImageGroupDiagnosticMarking) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyReadOKInfoImageGroupDiagnosticMarking) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfSegmentMeasurement = [6]string{
+	0: "bbox",
+	1: "area",
+	2: "perimeter",
+	3: "major_axis",
+	4: "minor_axis",
+	5: "unit",
 }
 
-// Encode encodes CytologyReadOKInfoImageGroupMaterialType as json.
-func (o OptCytologyReadOKInfoImageGroupMaterialType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes SegmentMeasurement from json.
+func (s *SegmentMeasurement) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SegmentMeasurement to nil")
 	}
-	e.Str(string(o.Value))
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes CytologyReadOKInfoImageGroupMaterialType from json.
-func (o *OptCytologyReadOKInfoImageGroupMaterialType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyReadOKInfoImageGroupMaterialType to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "bbox":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Bbox.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bbox\"")
+			}
+		case "area":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Float64()
+				s.Area = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"area\"")
+			}
+		case "perimeter":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.Perimeter = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"perimeter\"")
+			}
+		case "major_axis":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.MajorAxis = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"major_axis\"")
+			}
+		case "minor_axis":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.MinorAxis = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"minor_axis\"")
+			}
+		case "unit":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.Unit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unit\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SegmentMeasurement")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSegmentMeasurement) {
+					name = jsonFieldsNameOfSegmentMeasurement[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyReadOKInfoImageGroupMaterialType) MarshalJSON() ([]byte, error) {
+func (s *SegmentMeasurement) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyReadOKInfoImageGroupMaterialType) UnmarshalJSON(data []byte) error {
+func (s *SegmentMeasurement) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologySegmentGroupCreateCreateCreatedData as json.
-func (o OptCytologySegmentGroupCreateCreateCreatedData) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *Segmentation) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CytologySegmentGroupCreateCreateCreatedData from json.
-func (o *OptCytologySegmentGroupCreateCreateCreatedData) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologySegmentGroupCreateCreateCreatedData to nil")
+// encodeFields encodes fields.
+func (s *Segmentation) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("segmentation_group_id")
+		e.Int(s.SegmentationGroupID)
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologySegmentGroupCreateCreateCreatedData) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologySegmentGroupCreateCreateCreatedData) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CytologySegmentsListOKResultsItemGroupType as json.
-func (o OptCytologySegmentsListOKResultsItemGroupType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		e.FieldStart("points")
+		e.ArrStart()
+		for _, elem := range s.Points {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes CytologySegmentsListOKResultsItemGroupType from json.
-func (o *OptCytologySegmentsListOKResultsItemGroupType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologySegmentsListOKResultsItemGroupType to nil")
+	{
+		e.FieldStart("create_at")
+		json.EncodeDateTime(e, s.CreateAt)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.GeometryType.Set {
+			e.FieldStart("geometry_type")
+			s.GeometryType.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologySegmentsListOKResultsItemGroupType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologySegmentsListOKResultsItemGroupType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CytologySegmentsListOKResultsItemSegType as json.
-func (o OptCytologySegmentsListOKResultsItemSegType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		if s.Color.Set {
+			e.FieldStart("color")
+			s.Color.Encode(e)
+		}
 	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes CytologySegmentsListOKResultsItemSegType from json.
-func (o *OptCytologySegmentsListOKResultsItemSegType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologySegmentsListOKResultsItemSegType to nil")
+	{
+		if s.IsLocked.Set {
+			e.FieldStart("is_locked")
+			s.IsLocked.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Confidence.Set {
+			e.FieldStart("confidence")
+			s.Confidence.Encode(e)
+		}
+	}
+	{
+		if s.Properties.Set {
+			e.FieldStart("properties")
+			s.Properties.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologySegmentsListOKResultsItemSegType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologySegmentsListOKResultsItemSegType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes CytologyUpdatePartialUpdateReqDiagnosticMarking as json.
-func (o OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+var jsonFieldsNameOfSegmentation = [9]string{
+	0: "id",
+	1: "segmentation_group_id",
+	2: "points",
+	3: "create_at",
+	4: "geometry_type",
+	5: "color",
+	6: "is_locked",
+	7: "confidence",
+	8: "properties",
+}
+
+// Decode decodes Segmentation from json.
+func (s *Segmentation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Segmentation to nil")
 	}
-	e.Str(string(o.Value))
-}
+	var requiredBitSet [2]uint8
 
-// Decode decodes CytologyUpdatePartialUpdateReqDiagnosticMarking from json.
-func (o *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyUpdatePartialUpdateReqDiagnosticMarking to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "segmentation_group_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.SegmentationGroupID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"segmentation_group_id\"")
+			}
+		case "points":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Points = make([]SegmentationPoint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem SegmentationPoint
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Points = append(s.Points, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"points\"")
+			}
+		case "create_at":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreateAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"create_at\"")
+			}
+		case "geometry_type":
+			if err := func() error {
+				s.GeometryType.Reset()
+				if err := s.GeometryType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"geometry_type\"")
+			}
+		case "color":
+			if err := func() error {
+				s.Color.Reset()
+				if err := s.Color.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"color\"")
+			}
+		case "is_locked":
+			if err := func() error {
+				s.IsLocked.Reset()
+				if err := s.IsLocked.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_locked\"")
+			}
+		case "confidence":
+			if err := func() error {
+				s.Confidence.Reset()
+				if err := s.Confidence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"confidence\"")
+			}
+		case "properties":
+			if err := func() error {
+				s.Properties.Reset()
+				if err := s.Properties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"properties\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Segmentation")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00001111,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSegmentation) {
+					name = jsonFieldsNameOfSegmentation[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyUpdatePartialUpdateReqDiagnosticMarking) MarshalJSON() ([]byte, error) {
+func (s *Segmentation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) UnmarshalJSON(data []byte) error {
+func (s *Segmentation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyUpdatePartialUpdateReqMaterialType as json.
-func (o OptCytologyUpdatePartialUpdateReqMaterialType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *SegmentationPoint) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CytologyUpdatePartialUpdateReqMaterialType from json.
-func (o *OptCytologyUpdatePartialUpdateReqMaterialType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyUpdatePartialUpdateReqMaterialType to nil")
+// encodeFields encodes fields.
+func (s *SegmentationPoint) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("x")
+		e.Int(s.X)
+	}
+	{
+		e.FieldStart("y")
+		e.Int(s.Y)
+	}
+	{
+		if s.Polygon.Set {
+			e.FieldStart("polygon")
+			s.Polygon.Encode(e)
+		}
+	}
+	{
+		if s.Ring.Set {
+			e.FieldStart("ring")
+			s.Ring.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyUpdatePartialUpdateReqMaterialType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyUpdatePartialUpdateReqMaterialType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfSegmentationPoint = [6]string{
+	0: "id",
+	1: "uid",
+	2: "x",
+	3: "y",
+	4: "polygon",
+	5: "ring",
 }
 
-// Encode encodes CytologyUpdateUpdateReqDetails as json.
-func (o OptCytologyUpdateUpdateReqDetails) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes SegmentationPoint from json.
+func (s *SegmentationPoint) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SegmentationPoint to nil")
 	}
-	o.Value.Encode(e)
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes CytologyUpdateUpdateReqDetails from json.
-func (o *OptCytologyUpdateUpdateReqDetails) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqDetails to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "uid":
+			if err := func() error {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uid\"")
+			}
+		case "x":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.X = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"x\"")
+			}
+		case "y":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Y = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"y\"")
+			}
+		case "polygon":
+			if err := func() error {
+				s.Polygon.Reset()
+				if err := s.Polygon.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"polygon\"")
+			}
+		case "ring":
+			if err := func() error {
+				s.Ring.Reset()
+				if err := s.Ring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ring\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SegmentationPoint")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001100,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSegmentationPoint) {
+					name = jsonFieldsNameOfSegmentationPoint[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyUpdateUpdateReqDetails) MarshalJSON() ([]byte, error) {
+func (s *SegmentationPoint) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyUpdateUpdateReqDetails) UnmarshalJSON(data []byte) error {
+func (s *SegmentationPoint) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyUpdateUpdateReqDetailsDiagnosticMarking as json.
-func (o OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *SimpleUuid) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CytologyUpdateUpdateReqDetailsDiagnosticMarking from json.
-func (o *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqDetailsDiagnosticMarking to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+// encodeFields encodes fields.
+func (s *SimpleUuid) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfSimpleUuid = [1]string{
+	0: "id",
 }
 
-// Encode encodes CytologyUpdateUpdateReqDetailsMaterialType as json.
-func (o OptCytologyUpdateUpdateReqDetailsMaterialType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes SimpleUuid from json.
+func (s *SimpleUuid) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SimpleUuid to nil")
 	}
-	e.Str(string(o.Value))
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes CytologyUpdateUpdateReqDetailsMaterialType from json.
-func (o *OptCytologyUpdateUpdateReqDetailsMaterialType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqDetailsMaterialType to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SimpleUuid")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSimpleUuid) {
+					name = jsonFieldsNameOfSimpleUuid[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyUpdateUpdateReqDetailsMaterialType) MarshalJSON() ([]byte, error) {
+func (s *SimpleUuid) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyUpdateUpdateReqDetailsMaterialType) UnmarshalJSON(data []byte) error {
+func (s *SimpleUuid) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CytologyUpdateUpdateReqDiagnosticMarking as json.
-func (o OptCytologyUpdateUpdateReqDiagnosticMarking) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *Subscription) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CytologyUpdateUpdateReqDiagnosticMarking from json.
-func (o *OptCytologyUpdateUpdateReqDiagnosticMarking) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqDiagnosticMarking to nil")
+// encodeFields encodes fields.
+func (s *Subscription) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("tariff_plan_id")
+		json.EncodeUUID(e, s.TariffPlanID)
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyUpdateUpdateReqDiagnosticMarking) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyUpdateUpdateReqDiagnosticMarking) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CytologyUpdateUpdateReqMaterialType as json.
-func (o OptCytologyUpdateUpdateReqMaterialType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
 	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes CytologyUpdateUpdateReqMaterialType from json.
-func (o *OptCytologyUpdateUpdateReqMaterialType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCytologyUpdateUpdateReqMaterialType to nil")
+	{
+		e.FieldStart("start_date")
+		json.EncodeDateTime(e, s.StartDate)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("end_date")
+		json.EncodeDateTime(e, s.EndDate)
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCytologyUpdateUpdateReqMaterialType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCytologyUpdateUpdateReqMaterialType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfSubscription = [5]string{
+	0: "id",
+	1: "tariff_plan_id",
+	2: "status",
+	3: "start_date",
+	4: "end_date",
 }
 
-// Encode encodes time.Time as json.
-func (o OptDate) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
+// Decode decodes Subscription from json.
+func (s *Subscription) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Subscription to nil")
 	}
-	format(e, o.Value)
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes time.Time from json.
-func (o *OptDate) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDate to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "tariff_plan_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.TariffPlanID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tariff_plan_id\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "start_date":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartDate = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start_date\"")
+			}
+		case "end_date":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndDate = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"end_date\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Subscription")
 	}
-	o.Set = true
-	v, err := format(d)
-	if err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSubscription) {
+					name = jsonFieldsNameOfSubscription[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Value = v
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDate) MarshalJSON() ([]byte, error) {
+func (s *Subscription) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDate)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDate) UnmarshalJSON(data []byte) error {
+func (s *Subscription) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDate)
-}
-
-// Encode encodes time.Time as json.
-func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
-	}
-	format(e, o.Value)
+	return s.Decode(d)
 }
 
-// Decode decodes time.Time from json.
-func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDateTime to nil")
+// Encode encodes SubscriptionStatus as json.
+func (s SubscriptionStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes SubscriptionStatus from json.
+func (s *SubscriptionStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SubscriptionStatus to nil")
 	}
-	o.Set = true
-	v, err := format(d)
+	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
-	o.Value = v
+	// Try to use constant string.
+	switch SubscriptionStatus(v) {
+	case SubscriptionStatusPendingPayment:
+		*s = SubscriptionStatusPendingPayment
+	case SubscriptionStatusActive:
+		*s = SubscriptionStatusActive
+	case SubscriptionStatusCancelled:
+		*s = SubscriptionStatusCancelled
+	default:
+		*s = SubscriptionStatus(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDateTime) MarshalJSON() ([]byte, error) {
+func (s SubscriptionStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+func (s *SubscriptionStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+	return s.Decode(d)
 }
 
-// Encode encodes float64 as json.
-func (o OptFloat64) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *SubscriptionsCheckActiveGetOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SubscriptionsCheckActiveGetOK) encodeFields(e *jx.Encoder) {
+	{
+		if s.HasActiveSubscription.Set {
+			e.FieldStart("has_active_subscription")
+			s.HasActiveSubscription.Encode(e)
+		}
 	}
-	e.Float64(float64(o.Value))
 }
 
-// Decode decodes float64 from json.
-func (o *OptFloat64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptFloat64 to nil")
+var jsonFieldsNameOfSubscriptionsCheckActiveGetOK = [1]string{
+	0: "has_active_subscription",
+}
+
+// Decode decodes SubscriptionsCheckActiveGetOK from json.
+func (s *SubscriptionsCheckActiveGetOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SubscriptionsCheckActiveGetOK to nil")
 	}
-	o.Set = true
-	v, err := d.Float64()
-	if err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "has_active_subscription":
+			if err := func() error {
+				s.HasActiveSubscription.Reset()
+				if err := s.HasActiveSubscription.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"has_active_subscription\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SubscriptionsCheckActiveGetOK")
 	}
-	o.Value = float64(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptFloat64) MarshalJSON() ([]byte, error) {
+func (s *SubscriptionsCheckActiveGetOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptFloat64) UnmarshalJSON(data []byte) error {
+func (s *SubscriptionsCheckActiveGetOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes int as json.
-func (o OptInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *TariffPlan) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TariffPlan) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("description")
+		e.Str(s.Description)
+	}
+	{
+		e.FieldStart("price")
+		e.Str(s.Price)
+	}
+	{
+		e.FieldStart("duration")
+		e.Int(s.Duration)
 	}
-	e.Int(int(o.Value))
 }
 
-// Decode decodes int from json.
-func (o *OptInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt to nil")
+var jsonFieldsNameOfTariffPlan = [5]string{
+	0: "id",
+	1: "name",
+	2: "description",
+	3: "price",
+	4: "duration",
+}
+
+// Decode decodes TariffPlan from json.
+func (s *TariffPlan) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TariffPlan to nil")
 	}
-	o.Set = true
-	v, err := d.Int()
-	if err != nil {
-		return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Description = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "price":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Price = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"price\"")
+			}
+		case "duration":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.Duration = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TariffPlan")
 	}
-	o.Value = int(v)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTariffPlan) {
+					name = jsonFieldsNameOfTariffPlan[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptInt) MarshalJSON() ([]byte, error) {
+func (s *TariffPlan) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt) UnmarshalJSON(data []byte) error {
+func (s *TariffPlan) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NodeValidation as json.
-func (o OptNilNodeValidation) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
+// Encode encodes TariffPlansGetOKApplicationJSON as json.
+func (s TariffPlansGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []TariffPlan(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
-	e.Str(string(o.Value))
+	e.ArrEnd()
 }
 
-// Decode decodes NodeValidation from json.
-func (o *OptNilNodeValidation) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilNodeValidation to nil")
+// Decode decodes TariffPlansGetOKApplicationJSON from json.
+func (s *TariffPlansGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TariffPlansGetOKApplicationJSON to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
+	var unwrapped []TariffPlan
+	if err := func() error {
+		unwrapped = make([]TariffPlan, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem TariffPlan
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
 			return err
 		}
-
-		var v NodeValidation
-		o.Value = v
-		o.Set = true
-		o.Null = true
 		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	o.Set = true
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
+	*s = TariffPlansGetOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilNodeValidation) MarshalJSON() ([]byte, error) {
+func (s TariffPlansGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilNodeValidation) UnmarshalJSON(data []byte) error {
+func (s *TariffPlansGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes uuid.UUID as json.
-func (o OptNilUUID) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *TiradsDistribution) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TiradsDistribution) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("tirads_23")
+		e.Int(s.Tirads23)
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		e.FieldStart("tirads_4")
+		e.Int(s.Tirads4)
+	}
+	{
+		e.FieldStart("tirads_5")
+		e.Int(s.Tirads5)
 	}
-	json.EncodeUUID(e, o.Value)
 }
 
-// Decode decodes uuid.UUID from json.
-func (o *OptNilUUID) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilUUID to nil")
+var jsonFieldsNameOfTiradsDistribution = [3]string{
+	0: "tirads_23",
+	1: "tirads_4",
+	2: "tirads_5",
+}
+
+// Decode decodes TiradsDistribution from json.
+func (s *TiradsDistribution) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TiradsDistribution to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
+	var requiredBitSet [1]uint8
 
-		var v uuid.UUID
-		o.Value = v
-		o.Set = true
-		o.Null = true
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "tirads_23":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Tirads23 = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_23\"")
+			}
+		case "tirads_4":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Tirads4 = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_4\"")
+			}
+		case "tirads_5":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Tirads5 = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_5\"")
+			}
+		default:
+			return d.Skip()
+		}
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TiradsDistribution")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := json.DecodeUUID(d)
-	if err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTiradsDistribution) {
+					name = jsonFieldsNameOfTiradsDistribution[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Value = v
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilUUID) MarshalJSON() ([]byte, error) {
+func (s *TiradsDistribution) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilUUID) UnmarshalJSON(data []byte) error {
+func (s *TiradsDistribution) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UziNodesIDPatchReqValidation as json.
-func (o OptNilUziNodesIDPatchReqValidation) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *TiradsScore) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TiradsScore) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("points")
+		e.Int(s.Points)
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		e.FieldStart("category")
+		s.Category.Encode(e)
+	}
+	{
+		e.FieldStart("recommendation")
+		s.Recommendation.Encode(e)
+	}
+	{
+		if s.MaxDiameter.Set {
+			e.FieldStart("max_diameter")
+			s.MaxDiameter.Encode(e)
+		}
 	}
-	e.Str(string(o.Value))
 }
 
-// Decode decodes UziNodesIDPatchReqValidation from json.
-func (o *OptNilUziNodesIDPatchReqValidation) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilUziNodesIDPatchReqValidation to nil")
+var jsonFieldsNameOfTiradsScore = [4]string{
+	0: "points",
+	1: "category",
+	2: "recommendation",
+	3: "max_diameter",
+}
+
+// Decode decodes TiradsScore from json.
+func (s *TiradsScore) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TiradsScore to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "points":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Points = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"points\"")
+			}
+		case "category":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Category.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"category\"")
+			}
+		case "recommendation":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Recommendation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recommendation\"")
+			}
+		case "max_diameter":
+			if err := func() error {
+				s.MaxDiameter.Reset()
+				if err := s.MaxDiameter.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_diameter\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TiradsScore")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTiradsScore) {
+					name = jsonFieldsNameOfTiradsScore[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
 		}
-
-		var v UziNodesIDPatchReqValidation
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilUziNodesIDPatchReqValidation) MarshalJSON() ([]byte, error) {
+func (s *TiradsScore) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilUziNodesIDPatchReqValidation) UnmarshalJSON(data []byte) error {
+func (s *TiradsScore) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode encodes TiradsScoreCategory as json.
+func (s TiradsScoreCategory) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
+// Decode decodes TiradsScoreCategory from json.
+func (s *TiradsScoreCategory) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TiradsScoreCategory to nil")
 	}
-	o.Set = true
-	v, err := d.Str()
+	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
-	o.Value = string(v)
+	// Try to use constant string.
+	switch TiradsScoreCategory(v) {
+	case TiradsScoreCategoryTr1:
+		*s = TiradsScoreCategoryTr1
+	case TiradsScoreCategoryTr2:
+		*s = TiradsScoreCategoryTr2
+	case TiradsScoreCategoryTr3:
+		*s = TiradsScoreCategoryTr3
+	case TiradsScoreCategoryTr4:
+		*s = TiradsScoreCategoryTr4
+	case TiradsScoreCategoryTr5:
+		*s = TiradsScoreCategoryTr5
+	default:
+		*s = TiradsScoreCategory(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
+func (s TiradsScoreCategory) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
+func (s *TiradsScoreCategory) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes url.URL as json.
-func (o OptURI) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	json.EncodeURI(e, o.Value)
+// Encode encodes TiradsScoreRecommendation as json.
+func (s TiradsScoreRecommendation) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes url.URL from json.
-func (o *OptURI) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptURI to nil")
+// Decode decodes TiradsScoreRecommendation from json.
+func (s *TiradsScoreRecommendation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TiradsScoreRecommendation to nil")
 	}
-	o.Set = true
-	v, err := json.DecodeURI(d)
+	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
-	o.Value = v
+	// Try to use constant string.
+	switch TiradsScoreRecommendation(v) {
+	case TiradsScoreRecommendationNone:
+		*s = TiradsScoreRecommendationNone
+	case TiradsScoreRecommendationFollowUp:
+		*s = TiradsScoreRecommendationFollowUp
+	case TiradsScoreRecommendationFna:
+		*s = TiradsScoreRecommendationFna
+	case TiradsScoreRecommendationUnknown:
+		*s = TiradsScoreRecommendationUnknown
+	default:
+		*s = TiradsScoreRecommendation(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptURI) MarshalJSON() ([]byte, error) {
+func (s TiradsScoreRecommendation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptURI) UnmarshalJSON(data []byte) error {
+func (s *TiradsScoreRecommendation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes uuid.UUID as json.
-func (o OptUUID) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *Upload) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Upload) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("kind")
+		s.Kind.Encode(e)
+	}
+	{
+		e.FieldStart("file_name")
+		e.Str(s.FileName)
+	}
+	{
+		e.FieldStart("content_type")
+		e.Str(s.ContentType)
+	}
+	{
+		e.FieldStart("size")
+		e.Int64(s.Size)
+	}
+	{
+		e.FieldStart("part_size")
+		e.Int64(s.PartSize)
+	}
+	{
+		e.FieldStart("parts_count")
+		e.Int(s.PartsCount)
+	}
+	{
+		if s.SHA256.Set {
+			e.FieldStart("sha256")
+			s.SHA256.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.FailReason.Set {
+			e.FieldStart("fail_reason")
+			s.FailReason.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("create_at")
+		json.EncodeDateTime(e, s.CreateAt)
+	}
+	{
+		e.FieldStart("expires_at")
+		json.EncodeDateTime(e, s.ExpiresAt)
 	}
-	json.EncodeUUID(e, o.Value)
 }
 
-// Decode decodes uuid.UUID from json.
-func (o *OptUUID) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUUID to nil")
+var jsonFieldsNameOfUpload = [12]string{
+	0:  "id",
+	1:  "kind",
+	2:  "file_name",
+	3:  "content_type",
+	4:  "size",
+	5:  "part_size",
+	6:  "parts_count",
+	7:  "sha256",
+	8:  "status",
+	9:  "fail_reason",
+	10: "create_at",
+	11: "expires_at",
+}
+
+// Decode decodes Upload from json.
+func (s *Upload) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Upload to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "kind":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Kind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
+			}
+		case "file_name":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.FileName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"file_name\"")
+			}
+		case "content_type":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.ContentType = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"content_type\"")
+			}
+		case "size":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.Size = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"size\"")
+			}
+		case "part_size":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int64()
+				s.PartSize = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"part_size\"")
+			}
+		case "parts_count":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int()
+				s.PartsCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parts_count\"")
+			}
+		case "sha256":
+			if err := func() error {
+				s.SHA256.Reset()
+				if err := s.SHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sha256\"")
+			}
+		case "status":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "fail_reason":
+			if err := func() error {
+				s.FailReason.Reset()
+				if err := s.FailReason.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fail_reason\"")
+			}
+		case "create_at":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreateAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"create_at\"")
+			}
+		case "expires_at":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.ExpiresAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expires_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Upload")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b01111111,
+		0b00001101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUpload) {
+					name = jsonFieldsNameOfUpload[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	v, err := json.DecodeUUID(d)
-	if err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	o.Value = v
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptUUID) MarshalJSON() ([]byte, error) {
+func (s *Upload) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUUID) UnmarshalJSON(data []byte) error {
+func (s *Upload) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UziIDPatchReqProjection as json.
-func (o OptUziIDPatchReqProjection) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode encodes UploadKind as json.
+func (s UploadKind) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes UziIDPatchReqProjection from json.
-func (o *OptUziIDPatchReqProjection) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUziIDPatchReqProjection to nil")
+// Decode decodes UploadKind from json.
+func (s *UploadKind) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadKind to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptUziIDPatchReqProjection) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUziIDPatchReqProjection) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes YookassaWebhookRequestObject as json.
-func (o OptYookassaWebhookRequestObject) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	// Try to use constant string.
+	switch UploadKind(v) {
+	case UploadKindUzi:
+		*s = UploadKindUzi
+	case UploadKindCytology:
+		*s = UploadKindCytology
+	default:
+		*s = UploadKind(v)
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes YookassaWebhookRequestObject from json.
-func (o *OptYookassaWebhookRequestObject) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptYookassaWebhookRequestObject to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptYookassaWebhookRequestObject) MarshalJSON() ([]byte, error) {
+func (s UploadKind) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptYookassaWebhookRequestObject) UnmarshalJSON(data []byte) error {
+func (s *UploadKind) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes YookassaWebhookRequestObjectAmount as json.
-func (o OptYookassaWebhookRequestObjectAmount) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *UploadPart) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes YookassaWebhookRequestObjectAmount from json.
-func (o *OptYookassaWebhookRequestObjectAmount) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptYookassaWebhookRequestObjectAmount to nil")
+// encodeFields encodes fields.
+func (s *UploadPart) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("number")
+		e.Int(s.Number)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("size")
+		e.Int64(s.Size)
+	}
+	{
+		e.FieldStart("etag")
+		e.Str(s.Etag)
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptYookassaWebhookRequestObjectAmount) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptYookassaWebhookRequestObjectAmount) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfUploadPart = [3]string{
+	0: "number",
+	1: "size",
+	2: "etag",
 }
 
-// Encode encodes YookassaWebhookRequestObjectPaymentMethod as json.
-func (o OptYookassaWebhookRequestObjectPaymentMethod) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes UploadPart from json.
+func (s *UploadPart) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadPart to nil")
 	}
-	o.Value.Encode(e)
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes YookassaWebhookRequestObjectPaymentMethod from json.
-func (o *OptYookassaWebhookRequestObjectPaymentMethod) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptYookassaWebhookRequestObjectPaymentMethod to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "number":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Number = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"number\"")
+			}
+		case "size":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.Size = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"size\"")
+			}
+		case "etag":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Etag = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"etag\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UploadPart")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUploadPart) {
+					name = jsonFieldsNameOfUploadPart[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptYookassaWebhookRequestObjectPaymentMethod) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptYookassaWebhookRequestObjectPaymentMethod) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes YookassaWebhookRequestObjectPaymentMethodCard as json.
-func (o OptYookassaWebhookRequestObjectPaymentMethodCard) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes YookassaWebhookRequestObjectPaymentMethodCard from json.
-func (o *OptYookassaWebhookRequestObjectPaymentMethodCard) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptYookassaWebhookRequestObjectPaymentMethodCard to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptYookassaWebhookRequestObjectPaymentMethodCard) MarshalJSON() ([]byte, error) {
+func (s *UploadPart) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptYookassaWebhookRequestObjectPaymentMethodCard) UnmarshalJSON(data []byte) error {
+func (s *UploadPart) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Patient) Encode(e *jx.Encoder) {
+func (s *UploadPartURL) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Patient) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
-	{
-		e.FieldStart("fullname")
-		e.Str(s.Fullname)
-	}
-	{
-		e.FieldStart("email")
-		e.Str(s.Email)
-	}
+func (s *UploadPartURL) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("policy")
-		e.Str(s.Policy)
-	}
-	{
-		e.FieldStart("active")
-		e.Bool(s.Active)
-	}
-	{
-		e.FieldStart("malignancy")
-		e.Bool(s.Malignancy)
+		e.FieldStart("number")
+		e.Int(s.Number)
 	}
 	{
-		e.FieldStart("birth_date")
-		json.EncodeDate(e, s.BirthDate)
+		e.FieldStart("url")
+		e.Str(s.URL)
 	}
 	{
-		if s.LastUziDate.Set {
-			e.FieldStart("last_uzi_date")
-			s.LastUziDate.Encode(e, json.EncodeDate)
-		}
+		e.FieldStart("expires_at")
+		json.EncodeDateTime(e, s.ExpiresAt)
 	}
 }
 
-var jsonFieldsNameOfPatient = [8]string{
-	0: "id",
-	1: "fullname",
-	2: "email",
-	3: "policy",
-	4: "active",
-	5: "malignancy",
-	6: "birth_date",
-	7: "last_uzi_date",
+var jsonFieldsNameOfUploadPartURL = [3]string{
+	0: "number",
+	1: "url",
+	2: "expires_at",
 }
 
-// Decode decodes Patient from json.
-func (s *Patient) Decode(d *jx.Decoder) error {
+// Decode decodes UploadPartURL from json.
+func (s *UploadPartURL) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Patient to nil")
+		return errors.New("invalid: unable to decode UploadPartURL to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "number":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
+				v, err := d.Int()
+				s.Number = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"number\"")
 			}
-		case "fullname":
+		case "url":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Fullname = string(v)
+				s.URL = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fullname\"")
+				return errors.Wrap(err, "decode field \"url\"")
 			}
-		case "email":
+		case "expires_at":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
-			}
-		case "policy":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.Policy = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"policy\"")
-			}
-		case "active":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Bool()
-				s.Active = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"active\"")
-			}
-		case "malignancy":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Bool()
-				s.Malignancy = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"malignancy\"")
-			}
-		case "birth_date":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := json.DecodeDate(d)
-				s.BirthDate = v
+				v, err := json.DecodeDateTime(d)
+				s.ExpiresAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"birth_date\"")
-			}
-		case "last_uzi_date":
-			if err := func() error {
-				s.LastUziDate.Reset()
-				if err := s.LastUziDate.Decode(d, json.DecodeDate); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_uzi_date\"")
+				return errors.Wrap(err, "decode field \"expires_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Patient")
+		return errors.Wrap(err, "decode UploadPartURL")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01111111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -11391,8 +20469,8 @@ func (s *Patient) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPatient) {
-					name = jsonFieldsNameOfPatient[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUploadPartURL) {
+					name = jsonFieldsNameOfUploadPartURL[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -11413,364 +20491,708 @@ func (s *Patient) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Patient) MarshalJSON() ([]byte, error) {
+func (s *UploadPartURL) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Patient) UnmarshalJSON(data []byte) error {
+func (s *UploadPartURL) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PatientCard) Encode(e *jx.Encoder) {
+func (s *UploadProgress) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PatientCard) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.AcceptanceDatetime.Set {
-			e.FieldStart("acceptance_datetime")
-			s.AcceptanceDatetime.Encode(e, json.EncodeDateTime)
-		}
-	}
+func (s *UploadProgress) encodeFields(e *jx.Encoder) {
 	{
-		if s.Diagnosis.Set {
-			e.FieldStart("diagnosis")
-			s.Diagnosis.Encode(e)
-		}
+		e.FieldStart("upload")
+		s.Upload.Encode(e)
 	}
 	{
-		if s.Patient.Set {
-			e.FieldStart("patient")
-			s.Patient.Encode(e)
-		}
+		e.FieldStart("uploaded_bytes")
+		e.Int64(s.UploadedBytes)
 	}
 	{
-		if s.MedWorker.Set {
-			e.FieldStart("med_worker")
-			s.MedWorker.Encode(e)
+		e.FieldStart("parts")
+		e.ArrStart()
+		for _, elem := range s.Parts {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfPatientCard = [5]string{
-	0: "id",
-	1: "acceptance_datetime",
-	2: "diagnosis",
-	3: "patient",
-	4: "med_worker",
+var jsonFieldsNameOfUploadProgress = [3]string{
+	0: "upload",
+	1: "uploaded_bytes",
+	2: "parts",
 }
 
-// Decode decodes PatientCard from json.
-func (s *PatientCard) Decode(d *jx.Decoder) error {
+// Decode decodes UploadProgress from json.
+func (s *UploadProgress) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PatientCard to nil")
+		return errors.New("invalid: unable to decode UploadProgress to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "upload":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				if err := s.Upload.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"upload\"")
 			}
-		case "acceptance_datetime":
+		case "uploaded_bytes":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.AcceptanceDatetime.Reset()
-				if err := s.AcceptanceDatetime.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Int64()
+				s.UploadedBytes = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"acceptance_datetime\"")
+				return errors.Wrap(err, "decode field \"uploaded_bytes\"")
 			}
-		case "diagnosis":
+		case "parts":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Diagnosis.Reset()
-				if err := s.Diagnosis.Decode(d); err != nil {
+				s.Parts = make([]UploadPart, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem UploadPart
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Parts = append(s.Parts, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diagnosis\"")
+				return errors.Wrap(err, "decode field \"parts\"")
 			}
-		case "patient":
-			if err := func() error {
-				s.Patient.Reset()
-				if err := s.Patient.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UploadProgress")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUploadProgress) {
+					name = jsonFieldsNameOfUploadProgress[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"patient\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UploadProgress) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadProgress) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UploadStatus as json.
+func (s UploadStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes UploadStatus from json.
+func (s *UploadStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch UploadStatus(v) {
+	case UploadStatusPending:
+		*s = UploadStatusPending
+	case UploadStatusProcessing:
+		*s = UploadStatusProcessing
+	case UploadStatusCompleted:
+		*s = UploadStatusCompleted
+	case UploadStatusClaimed:
+		*s = UploadStatusClaimed
+	case UploadStatusAborted:
+		*s = UploadStatusAborted
+	default:
+		*s = UploadStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UploadStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UploadsIDPartsPostOKApplicationJSON as json.
+func (s UploadsIDPartsPostOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []UploadPartURL(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes UploadsIDPartsPostOKApplicationJSON from json.
+func (s *UploadsIDPartsPostOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadsIDPartsPostOKApplicationJSON to nil")
+	}
+	var unwrapped []UploadPartURL
+	if err := func() error {
+		unwrapped = make([]UploadPartURL, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem UploadPartURL
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UploadsIDPartsPostOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UploadsIDPartsPostOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadsIDPartsPostOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UploadsIDPartsPostReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UploadsIDPartsPostReq) encodeFields(e *jx.Encoder) {
+	{
+		if s.Numbers != nil {
+			e.FieldStart("numbers")
+			e.ArrStart()
+			for _, elem := range s.Numbers {
+				e.Int(elem)
 			}
-		case "med_worker":
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfUploadsIDPartsPostReq = [1]string{
+	0: "numbers",
+}
+
+// Decode decodes UploadsIDPartsPostReq from json.
+func (s *UploadsIDPartsPostReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadsIDPartsPostReq to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "numbers":
 			if err := func() error {
-				s.MedWorker.Reset()
-				if err := s.MedWorker.Decode(d); err != nil {
+				s.Numbers = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.Numbers = append(s.Numbers, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"med_worker\"")
+				return errors.Wrap(err, "decode field \"numbers\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PatientCard")
+		return errors.Wrap(err, "decode UploadsIDPartsPostReq")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PatientCard) MarshalJSON() ([]byte, error) {
+func (s *UploadsIDPartsPostReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PatientCard) UnmarshalJSON(data []byte) error {
+func (s *UploadsIDPartsPostReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PaymentProvider) Encode(e *jx.Encoder) {
+func (s *UploadsPostReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PaymentProvider) encodeFields(e *jx.Encoder) {
+func (s *UploadsPostReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
+		e.FieldStart("kind")
+		s.Kind.Encode(e)
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+		e.FieldStart("file_name")
+		e.Str(s.FileName)
+	}
+	{
+		e.FieldStart("content_type")
+		e.Str(s.ContentType)
+	}
+	{
+		e.FieldStart("size")
+		e.Int64(s.Size)
+	}
+	{
+		if s.PartSize.Set {
+			e.FieldStart("part_size")
+			s.PartSize.Encode(e)
 		}
 	}
 	{
-		if s.IsActive.Set {
-			e.FieldStart("is_active")
-			s.IsActive.Encode(e)
+		if s.SHA256.Set {
+			e.FieldStart("sha256")
+			s.SHA256.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfPaymentProvider = [3]string{
-	0: "id",
-	1: "name",
-	2: "is_active",
+var jsonFieldsNameOfUploadsPostReq = [6]string{
+	0: "kind",
+	1: "file_name",
+	2: "content_type",
+	3: "size",
+	4: "part_size",
+	5: "sha256",
 }
 
-// Decode decodes PaymentProvider from json.
-func (s *PaymentProvider) Decode(d *jx.Decoder) error {
+// Decode decodes UploadsPostReq from json.
+func (s *UploadsPostReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PaymentProvider to nil")
+		return errors.New("invalid: unable to decode UploadsPostReq to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "kind":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				if err := s.Kind.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"kind\"")
 			}
-		case "name":
+		case "file_name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				v, err := d.Str()
+				s.FileName = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"file_name\"")
 			}
-		case "is_active":
+		case "content_type":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.IsActive.Reset()
-				if err := s.IsActive.Decode(d); err != nil {
+				v, err := d.Str()
+				s.ContentType = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_active\"")
+				return errors.Wrap(err, "decode field \"content_type\"")
+			}
+		case "size":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.Size = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"size\"")
+			}
+		case "part_size":
+			if err := func() error {
+				s.PartSize.Reset()
+				if err := s.PartSize.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"part_size\"")
+			}
+		case "sha256":
+			if err := func() error {
+				s.SHA256.Reset()
+				if err := s.SHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sha256\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaymentProvider")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *PaymentProvider) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaymentProvider) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes PaymentProvidersGetOKApplicationJSON as json.
-func (s PaymentProvidersGetOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []PaymentProvider(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+		return errors.Wrap(err, "decode UploadsPostReq")
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes PaymentProvidersGetOKApplicationJSON from json.
-func (s *PaymentProvidersGetOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode PaymentProvidersGetOKApplicationJSON to nil")
-	}
-	var unwrapped []PaymentProvider
-	if err := func() error {
-		unwrapped = make([]PaymentProvider, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem PaymentProvider
-			if err := elem.Decode(d); err != nil {
-				return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUploadsPostReq) {
+					name = jsonFieldsNameOfUploadsPostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = PaymentProvidersGetOKApplicationJSON(unwrapped)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s PaymentProvidersGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *UploadsPostReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaymentProvidersGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *UploadsPostReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PurchaseSubscriptionRequest) Encode(e *jx.Encoder) {
+func (s *Uzi) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PurchaseSubscriptionRequest) encodeFields(e *jx.Encoder) {
+func (s *Uzi) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("tariff_plan_id")
-		json.EncodeUUID(e, s.TariffPlanID)
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
 	{
-		e.FieldStart("payment_provider_id")
-		json.EncodeUUID(e, s.PaymentProviderID)
+		e.FieldStart("projection")
+		s.Projection.Encode(e)
+	}
+	{
+		e.FieldStart("checked")
+		e.Bool(s.Checked)
+	}
+	{
+		e.FieldStart("external_id")
+		json.EncodeUUID(e, s.ExternalID)
+	}
+	{
+		e.FieldStart("author_id")
+		json.EncodeUUID(e, s.AuthorID)
+	}
+	{
+		e.FieldStart("device_id")
+		e.Int(s.DeviceID)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		e.FieldStart("create_at")
+		json.EncodeDateTime(e, s.CreateAt)
+	}
+	{
+		if s.PixelSpacing.Set {
+			e.FieldStart("pixel_spacing")
+			s.PixelSpacing.Encode(e)
+		}
+	}
+	{
+		if s.SHA256.Set {
+			e.FieldStart("sha256")
+			s.SHA256.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfPurchaseSubscriptionRequest = [2]string{
-	0: "tariff_plan_id",
-	1: "payment_provider_id",
+var jsonFieldsNameOfUzi = [10]string{
+	0: "id",
+	1: "projection",
+	2: "checked",
+	3: "external_id",
+	4: "author_id",
+	5: "device_id",
+	6: "status",
+	7: "create_at",
+	8: "pixel_spacing",
+	9: "sha256",
 }
 
-// Decode decodes PurchaseSubscriptionRequest from json.
-func (s *PurchaseSubscriptionRequest) Decode(d *jx.Decoder) error {
+// Decode decodes Uzi from json.
+func (s *Uzi) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PurchaseSubscriptionRequest to nil")
+		return errors.New("invalid: unable to decode Uzi to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "tariff_plan_id":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := json.DecodeUUID(d)
-				s.TariffPlanID = v
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "projection":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Projection.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"projection\"")
+			}
+		case "checked":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.Checked = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"checked\"")
+			}
+		case "external_id":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ExternalID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tariff_plan_id\"")
+				return errors.Wrap(err, "decode field \"external_id\"")
 			}
-		case "payment_provider_id":
-			requiredBitSet[0] |= 1 << 1
+		case "author_id":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
 				v, err := json.DecodeUUID(d)
-				s.PaymentProviderID = v
+				s.AuthorID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"payment_provider_id\"")
+				return errors.Wrap(err, "decode field \"author_id\"")
+			}
+		case "device_id":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.DeviceID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"device_id\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "create_at":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreateAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"create_at\"")
+			}
+		case "pixel_spacing":
+			if err := func() error {
+				s.PixelSpacing.Reset()
+				if err := s.PixelSpacing.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pixel_spacing\"")
+			}
+		case "sha256":
+			if err := func() error {
+				s.SHA256.Reset()
+				if err := s.SHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sha256\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PurchaseSubscriptionRequest")
+		return errors.Wrap(err, "decode Uzi")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -11782,8 +21204,8 @@ func (s *PurchaseSubscriptionRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPurchaseSubscriptionRequest) {
-					name = jsonFieldsNameOfPurchaseSubscriptionRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUzi) {
+					name = jsonFieldsNameOfUzi[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -11804,199 +21226,278 @@ func (s *PurchaseSubscriptionRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PurchaseSubscriptionRequest) MarshalJSON() ([]byte, error) {
+func (s *Uzi) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PurchaseSubscriptionRequest) UnmarshalJSON(data []byte) error {
+func (s *Uzi) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PurchaseSubscriptionResponse) Encode(e *jx.Encoder) {
+func (s *UziAnalytics) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PurchaseSubscriptionResponse) encodeFields(e *jx.Encoder) {
+func (s *UziAnalytics) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("subscription_id")
-		json.EncodeUUID(e, s.SubscriptionID)
+		e.FieldStart("total")
+		s.Total.Encode(e)
 	}
 	{
-		e.FieldStart("confirmation_url")
-		e.Str(s.ConfirmationURL)
+		e.FieldStart("by_device")
+		e.ArrStart()
+		for _, elem := range s.ByDevice {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("by_author")
+		e.ArrStart()
+		for _, elem := range s.ByAuthor {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("by_period")
+		e.ArrStart()
+		for _, elem := range s.ByPeriod {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("agreement")
+		e.ArrStart()
+		for _, elem := range s.Agreement {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.Kappa.Set {
+			e.FieldStart("kappa")
+			s.Kappa.Encode(e)
+		}
+	}
+	{
+		if s.MeanIou.Set {
+			e.FieldStart("mean_iou")
+			s.MeanIou.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("ai_merged")
+		e.Int(s.AiMerged)
+	}
+	{
+		e.FieldStart("ai_split")
+		e.Int(s.AiSplit)
+	}
+	{
+		e.FieldStart("bulk_valid")
+		e.Int(s.BulkValid)
+	}
+	{
+		e.FieldStart("bulk_invalid")
+		e.Int(s.BulkInvalid)
 	}
 }
 
-var jsonFieldsNameOfPurchaseSubscriptionResponse = [2]string{
-	0: "subscription_id",
-	1: "confirmation_url",
+var jsonFieldsNameOfUziAnalytics = [11]string{
+	0:  "total",
+	1:  "by_device",
+	2:  "by_author",
+	3:  "by_period",
+	4:  "agreement",
+	5:  "kappa",
+	6:  "mean_iou",
+	7:  "ai_merged",
+	8:  "ai_split",
+	9:  "bulk_valid",
+	10: "bulk_invalid",
 }
 
-// Decode decodes PurchaseSubscriptionResponse from json.
-func (s *PurchaseSubscriptionResponse) Decode(d *jx.Decoder) error {
+// Decode decodes UziAnalytics from json.
+func (s *UziAnalytics) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PurchaseSubscriptionResponse to nil")
+		return errors.New("invalid: unable to decode UziAnalytics to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "subscription_id":
+		case "total":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.SubscriptionID = v
-				if err != nil {
+				if err := s.Total.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"subscription_id\"")
+				return errors.Wrap(err, "decode field \"total\"")
 			}
-		case "confirmation_url":
+		case "by_device":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.ConfirmationURL = string(v)
-				if err != nil {
+				s.ByDevice = make([]DeviceNodeMetrics, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem DeviceNodeMetrics
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ByDevice = append(s.ByDevice, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"confirmation_url\"")
+				return errors.Wrap(err, "decode field \"by_device\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode PurchaseSubscriptionResponse")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfPurchaseSubscriptionResponse) {
-					name = jsonFieldsNameOfPurchaseSubscriptionResponse[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "by_author":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.ByAuthor = make([]AuthorNodeMetrics, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AuthorNodeMetrics
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ByAuthor = append(s.ByAuthor, elem)
+					return nil
+				}); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"by_author\"")
+			}
+		case "by_period":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.ByPeriod = make([]PeriodNodeMetrics, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PeriodNodeMetrics
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ByPeriod = append(s.ByPeriod, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"by_period\"")
+			}
+		case "agreement":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Agreement = make([]ReaderAgreement, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ReaderAgreement
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Agreement = append(s.Agreement, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"agreement\"")
+			}
+		case "kappa":
+			if err := func() error {
+				s.Kappa.Reset()
+				if err := s.Kappa.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kappa\"")
+			}
+		case "mean_iou":
+			if err := func() error {
+				s.MeanIou.Reset()
+				if err := s.MeanIou.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mean_iou\"")
+			}
+		case "ai_merged":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int()
+				s.AiMerged = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ai_merged\"")
+			}
+		case "ai_split":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.AiSplit = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ai_split\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *PurchaseSubscriptionResponse) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PurchaseSubscriptionResponse) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *RefreshPostOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *RefreshPostOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("access_token")
-		e.Str(s.AccessToken)
-	}
-	{
-		e.FieldStart("refresh_token")
-		e.Str(s.RefreshToken)
-	}
-}
-
-var jsonFieldsNameOfRefreshPostOK = [2]string{
-	0: "access_token",
-	1: "refresh_token",
-}
-
-// Decode decodes RefreshPostOK from json.
-func (s *RefreshPostOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RefreshPostOK to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "access_token":
-			requiredBitSet[0] |= 1 << 0
+		case "bulk_valid":
+			requiredBitSet[1] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.AccessToken = string(v)
+				v, err := d.Int()
+				s.BulkValid = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"access_token\"")
+				return errors.Wrap(err, "decode field \"bulk_valid\"")
 			}
-		case "refresh_token":
-			requiredBitSet[0] |= 1 << 1
+		case "bulk_invalid":
+			requiredBitSet[1] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.RefreshToken = string(v)
+				v, err := d.Int()
+				s.BulkInvalid = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refresh_token\"")
+				return errors.Wrap(err, "decode field \"bulk_invalid\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RefreshPostOK")
+		return errors.Wrap(err, "decode UziAnalytics")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b10011111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -12008,8 +21509,8 @@ func (s *RefreshPostOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRefreshPostOK) {
-					name = jsonFieldsNameOfRefreshPostOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziAnalytics) {
+					name = jsonFieldsNameOfUziAnalytics[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12030,69 +21531,98 @@ func (s *RefreshPostOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RefreshPostOK) MarshalJSON() ([]byte, error) {
+func (s *UziAnalytics) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RefreshPostOK) UnmarshalJSON(data []byte) error {
+func (s *UziAnalytics) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RefreshPostReq) Encode(e *jx.Encoder) {
+func (s *UziCreated) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RefreshPostReq) encodeFields(e *jx.Encoder) {
+func (s *UziCreated) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("refresh_token")
-		e.Str(s.RefreshToken)
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("duplicate_of")
+		e.ArrStart()
+		for _, elem := range s.DuplicateOf {
+			json.EncodeUUID(e, elem)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfRefreshPostReq = [1]string{
-	0: "refresh_token",
+var jsonFieldsNameOfUziCreated = [2]string{
+	0: "id",
+	1: "duplicate_of",
 }
 
-// Decode decodes RefreshPostReq from json.
-func (s *RefreshPostReq) Decode(d *jx.Decoder) error {
+// Decode decodes UziCreated from json.
+func (s *UziCreated) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RefreshPostReq to nil")
+		return errors.New("invalid: unable to decode UziCreated to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "refresh_token":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.RefreshToken = string(v)
+				v, err := json.DecodeUUID(d)
+				s.ID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refresh_token\"")
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "duplicate_of":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.DuplicateOf = make([]uuid.UUID, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uuid.UUID
+					v, err := json.DecodeUUID(d)
+					elem = v
+					if err != nil {
+						return err
+					}
+					s.DuplicateOf = append(s.DuplicateOf, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duplicate_of\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RefreshPostReq")
+		return errors.Wrap(err, "decode UziCreated")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -12104,8 +21634,8 @@ func (s *RefreshPostReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRefreshPostReq) {
-					name = jsonFieldsNameOfRefreshPostReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziCreated) {
+					name = jsonFieldsNameOfUziCreated[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12126,154 +21656,217 @@ func (s *RefreshPostReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RefreshPostReq) MarshalJSON() ([]byte, error) {
+func (s *UziCreated) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RefreshPostReq) UnmarshalJSON(data []byte) error {
+func (s *UziCreated) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RegDoctorPostReq) Encode(e *jx.Encoder) {
+func (s *UziDeviceIDPatchReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RegDoctorPostReq) encodeFields(e *jx.Encoder) {
+func (s *UziDeviceIDPatchReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		if s.Manufacturer.Set {
+			e.FieldStart("manufacturer")
+			s.Manufacturer.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("fullname")
-		e.Str(s.Fullname)
+		if s.Model.Set {
+			e.FieldStart("model")
+			s.Model.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("org")
-		e.Str(s.Org)
+		if s.SerialNumber.Set {
+			e.FieldStart("serial_number")
+			s.SerialNumber.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("job")
-		e.Str(s.Job)
+		if s.ProbeType.Set {
+			e.FieldStart("probe_type")
+			s.ProbeType.Encode(e)
+		}
 	}
 	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
+		if s.PixelSpacing.Set {
+			e.FieldStart("pixel_spacing")
+			s.PixelSpacing.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRegDoctorPostReq = [6]string{
-	0: "email",
-	1: "password",
-	2: "fullname",
-	3: "org",
-	4: "job",
-	5: "description",
+var jsonFieldsNameOfUziDeviceIDPatchReq = [6]string{
+	0: "name",
+	1: "manufacturer",
+	2: "model",
+	3: "serial_number",
+	4: "probe_type",
+	5: "pixel_spacing",
 }
 
-// Decode decodes RegDoctorPostReq from json.
-func (s *RegDoctorPostReq) Decode(d *jx.Decoder) error {
+// Decode decodes UziDeviceIDPatchReq from json.
+func (s *UziDeviceIDPatchReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RegDoctorPostReq to nil")
+		return errors.New("invalid: unable to decode UziDeviceIDPatchReq to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "email":
-			requiredBitSet[0] |= 1 << 0
+		case "name":
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "password":
-			requiredBitSet[0] |= 1 << 1
+		case "manufacturer":
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
+				s.Manufacturer.Reset()
+				if err := s.Manufacturer.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"manufacturer\"")
 			}
-		case "fullname":
-			requiredBitSet[0] |= 1 << 2
+		case "model":
 			if err := func() error {
-				v, err := d.Str()
-				s.Fullname = string(v)
-				if err != nil {
+				s.Model.Reset()
+				if err := s.Model.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fullname\"")
+				return errors.Wrap(err, "decode field \"model\"")
 			}
-		case "org":
-			requiredBitSet[0] |= 1 << 3
+		case "serial_number":
 			if err := func() error {
-				v, err := d.Str()
-				s.Org = string(v)
-				if err != nil {
+				s.SerialNumber.Reset()
+				if err := s.SerialNumber.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"org\"")
+				return errors.Wrap(err, "decode field \"serial_number\"")
 			}
-		case "job":
-			requiredBitSet[0] |= 1 << 4
+		case "probe_type":
 			if err := func() error {
-				v, err := d.Str()
-				s.Job = string(v)
-				if err != nil {
+				s.ProbeType.Reset()
+				if err := s.ProbeType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"job\"")
+				return errors.Wrap(err, "decode field \"probe_type\"")
 			}
-		case "description":
+		case "pixel_spacing":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.PixelSpacing.Reset()
+				if err := s.PixelSpacing.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pixel_spacing\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UziDeviceIDPatchReq")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UziDeviceIDPatchReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziDeviceIDPatchReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UziDevicePostOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UziDevicePostOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+}
+
+var jsonFieldsNameOfUziDevicePostOK = [1]string{
+	0: "id",
+}
+
+// Decode decodes UziDevicePostOK from json.
+func (s *UziDevicePostOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziDevicePostOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RegDoctorPostReq")
+		return errors.Wrap(err, "decode UziDevicePostOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -12285,8 +21878,8 @@ func (s *RegDoctorPostReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRegDoctorPostReq) {
-					name = jsonFieldsNameOfRegDoctorPostReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziDevicePostOK) {
+					name = jsonFieldsNameOfUziDevicePostOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12307,137 +21900,154 @@ func (s *RegDoctorPostReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RegDoctorPostReq) MarshalJSON() ([]byte, error) {
+func (s *UziDevicePostOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RegDoctorPostReq) UnmarshalJSON(data []byte) error {
+func (s *UziDevicePostOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RegPatientPostReq) Encode(e *jx.Encoder) {
+func (s *UziDevicePostReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RegPatientPostReq) encodeFields(e *jx.Encoder) {
+func (s *UziDevicePostReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("fullname")
-		e.Str(s.Fullname)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("policy")
-		e.Str(s.Policy)
+		if s.Manufacturer.Set {
+			e.FieldStart("manufacturer")
+			s.Manufacturer.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("birth_date")
-		json.EncodeDate(e, s.BirthDate)
+		if s.Model.Set {
+			e.FieldStart("model")
+			s.Model.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		if s.SerialNumber.Set {
+			e.FieldStart("serial_number")
+			s.SerialNumber.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		if s.ProbeType.Set {
+			e.FieldStart("probe_type")
+			s.ProbeType.Encode(e)
+		}
+	}
+	{
+		if s.PixelSpacing.Set {
+			e.FieldStart("pixel_spacing")
+			s.PixelSpacing.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfRegPatientPostReq = [5]string{
-	0: "fullname",
-	1: "policy",
-	2: "birth_date",
-	3: "email",
-	4: "password",
+var jsonFieldsNameOfUziDevicePostReq = [6]string{
+	0: "name",
+	1: "manufacturer",
+	2: "model",
+	3: "serial_number",
+	4: "probe_type",
+	5: "pixel_spacing",
 }
 
-// Decode decodes RegPatientPostReq from json.
-func (s *RegPatientPostReq) Decode(d *jx.Decoder) error {
+// Decode decodes UziDevicePostReq from json.
+func (s *UziDevicePostReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RegPatientPostReq to nil")
+		return errors.New("invalid: unable to decode UziDevicePostReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "fullname":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Fullname = string(v)
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fullname\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "policy":
-			requiredBitSet[0] |= 1 << 1
+		case "manufacturer":
 			if err := func() error {
-				v, err := d.Str()
-				s.Policy = string(v)
-				if err != nil {
+				s.Manufacturer.Reset()
+				if err := s.Manufacturer.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"policy\"")
+				return errors.Wrap(err, "decode field \"manufacturer\"")
 			}
-		case "birth_date":
-			requiredBitSet[0] |= 1 << 2
+		case "model":
 			if err := func() error {
-				v, err := json.DecodeDate(d)
-				s.BirthDate = v
-				if err != nil {
+				s.Model.Reset()
+				if err := s.Model.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"birth_date\"")
+				return errors.Wrap(err, "decode field \"model\"")
 			}
-		case "email":
-			requiredBitSet[0] |= 1 << 3
+		case "serial_number":
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
+				s.SerialNumber.Reset()
+				if err := s.SerialNumber.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"serial_number\"")
 			}
-		case "password":
-			requiredBitSet[0] |= 1 << 4
+		case "probe_type":
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
+				s.ProbeType.Reset()
+				if err := s.ProbeType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"probe_type\"")
+			}
+		case "pixel_spacing":
+			if err := func() error {
+				s.PixelSpacing.Reset()
+				if err := s.PixelSpacing.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pixel_spacing\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RegPatientPostReq")
+		return errors.Wrap(err, "decode UziDevicePostReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -12449,8 +22059,8 @@ func (s *RegPatientPostReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRegPatientPostReq) {
-					name = jsonFieldsNameOfRegPatientPostReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziDevicePostReq) {
+					name = jsonFieldsNameOfUziDevicePostReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12471,188 +22081,144 @@ func (s *RegPatientPostReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RegPatientPostReq) MarshalJSON() ([]byte, error) {
+func (s *UziDevicePostReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RegPatientPostReq) UnmarshalJSON(data []byte) error {
+func (s *UziDevicePostReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *Segment) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
+// Encode encodes UziDevicesGetOKApplicationJSON as json.
+func (s UziDevicesGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Device(s)
 
-// encodeFields encodes fields.
-func (s *Segment) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
-	{
-		e.FieldStart("image_id")
-		json.EncodeUUID(e, s.ImageID)
-	}
-	{
-		e.FieldStart("node_id")
-		json.EncodeUUID(e, s.NodeID)
-	}
-	{
-		if s.Contor != nil {
-			e.FieldStart("contor")
-			s.Contor.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("ai")
-		e.Bool(s.Ai)
-	}
-	{
-		e.FieldStart("tirads_23")
-		e.Float64(s.Tirads23)
-	}
-	{
-		e.FieldStart("tirads_4")
-		e.Float64(s.Tirads4)
-	}
-	{
-		e.FieldStart("tirads_5")
-		e.Float64(s.Tirads5)
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
+	e.ArrEnd()
 }
 
-var jsonFieldsNameOfSegment = [8]string{
-	0: "id",
-	1: "image_id",
-	2: "node_id",
-	3: "contor",
-	4: "ai",
-	5: "tirads_23",
-	6: "tirads_4",
-	7: "tirads_5",
-}
-
-// Decode decodes Segment from json.
-func (s *Segment) Decode(d *jx.Decoder) error {
+// Decode decodes UziDevicesGetOKApplicationJSON from json.
+func (s *UziDevicesGetOKApplicationJSON) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Segment to nil")
+		return errors.New("invalid: unable to decode UziDevicesGetOKApplicationJSON to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "image_id":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ImageID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"image_id\"")
-			}
-		case "node_id":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.NodeID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"node_id\"")
-			}
-		case "contor":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				if err := s.Contor.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"contor\"")
-			}
-		case "ai":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Bool()
-				s.Ai = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ai\"")
-			}
-		case "tirads_23":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads23 = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_23\"")
+	var unwrapped []Device
+	if err := func() error {
+		unwrapped = make([]Device, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Device
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		case "tirads_4":
-			requiredBitSet[0] |= 1 << 6
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UziDevicesGetOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UziDevicesGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziDevicesGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UziIDCheckPostOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UziIDCheckPostOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("uzi")
+		s.Uzi.Encode(e)
+	}
+	{
+		e.FieldStart("items")
+		e.ArrStart()
+		for _, elem := range s.Items {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfUziIDCheckPostOK = [2]string{
+	0: "uzi",
+	1: "items",
+}
+
+// Decode decodes UziIDCheckPostOK from json.
+func (s *UziIDCheckPostOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziIDCheckPostOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uzi":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads4 = float64(v)
-				if err != nil {
+				if err := s.Uzi.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_4\"")
+				return errors.Wrap(err, "decode field \"uzi\"")
 			}
-		case "tirads_5":
-			requiredBitSet[0] |= 1 << 7
+		case "items":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads5 = float64(v)
-				if err != nil {
+				s.Items = make([]NodeReviewItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NodeReviewItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Items = append(s.Items, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_5\"")
+				return errors.Wrap(err, "decode field \"items\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Segment")
+		return errors.Wrap(err, "decode UziIDCheckPostOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b11111111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -12664,8 +22230,8 @@ func (s *Segment) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSegment) {
-					name = jsonFieldsNameOfSegment[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziIDCheckPostOK) {
+					name = jsonFieldsNameOfUziIDCheckPostOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12686,231 +22252,272 @@ func (s *Segment) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Segment) MarshalJSON() ([]byte, error) {
+func (s *UziIDCheckPostOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Segment) UnmarshalJSON(data []byte) error {
+func (s *UziIDCheckPostOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SimpleUuid) Encode(e *jx.Encoder) {
+func (s *UziIDCheckPostReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SimpleUuid) encodeFields(e *jx.Encoder) {
+func (s *UziIDCheckPostReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
+		if s.Unvalidated.Set {
+			e.FieldStart("unvalidated")
+			s.Unvalidated.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfSimpleUuid = [1]string{
-	0: "id",
+var jsonFieldsNameOfUziIDCheckPostReq = [1]string{
+	0: "unvalidated",
 }
 
-// Decode decodes SimpleUuid from json.
-func (s *SimpleUuid) Decode(d *jx.Decoder) error {
+// Decode decodes UziIDCheckPostReq from json.
+func (s *UziIDCheckPostReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SimpleUuid to nil")
+		return errors.New("invalid: unable to decode UziIDCheckPostReq to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
+		case "unvalidated":
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
+				s.Unvalidated.Reset()
+				if err := s.Unvalidated.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"unvalidated\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SimpleUuid")
+		return errors.Wrap(err, "decode UziIDCheckPostReq")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfSimpleUuid) {
-					name = jsonFieldsNameOfSimpleUuid[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UziIDCheckPostReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziIDCheckPostReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziIDCheckPostReqUnvalidated as json.
+func (s UziIDCheckPostReqUnvalidated) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes UziIDCheckPostReqUnvalidated from json.
+func (s *UziIDCheckPostReqUnvalidated) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziIDCheckPostReqUnvalidated to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch UziIDCheckPostReqUnvalidated(v) {
+	case UziIDCheckPostReqUnvalidatedValid:
+		*s = UziIDCheckPostReqUnvalidatedValid
+	case UziIDCheckPostReqUnvalidatedInvalid:
+		*s = UziIDCheckPostReqUnvalidatedInvalid
+	default:
+		*s = UziIDCheckPostReqUnvalidated(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SimpleUuid) MarshalJSON() ([]byte, error) {
+func (s UziIDCheckPostReqUnvalidated) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SimpleUuid) UnmarshalJSON(data []byte) error {
+func (s *UziIDCheckPostReqUnvalidated) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *Subscription) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes UziIDImagesGetOKApplicationJSON as json.
+func (s UziIDImagesGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Image(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
 }
 
-// encodeFields encodes fields.
-func (s *Subscription) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
+// Decode decodes UziIDImagesGetOKApplicationJSON from json.
+func (s *UziIDImagesGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziIDImagesGetOKApplicationJSON to nil")
 	}
-	{
-		e.FieldStart("tariff_plan_id")
-		json.EncodeUUID(e, s.TariffPlanID)
+	var unwrapped []Image
+	if err := func() error {
+		unwrapped = make([]Image, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Image
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
+	*s = UziIDImagesGetOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UziIDImagesGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziIDImagesGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziIDNodesAcceptPostOKApplicationJSON as json.
+func (s UziIDNodesAcceptPostOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []NodeReviewItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
-	{
-		e.FieldStart("start_date")
-		json.EncodeDateTime(e, s.StartDate)
+	e.ArrEnd()
+}
+
+// Decode decodes UziIDNodesAcceptPostOKApplicationJSON from json.
+func (s *UziIDNodesAcceptPostOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziIDNodesAcceptPostOKApplicationJSON to nil")
 	}
-	{
-		e.FieldStart("end_date")
-		json.EncodeDateTime(e, s.EndDate)
+	var unwrapped []NodeReviewItem
+	if err := func() error {
+		unwrapped = make([]NodeReviewItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem NodeReviewItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = UziIDNodesAcceptPostOKApplicationJSON(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfSubscription = [5]string{
-	0: "id",
-	1: "tariff_plan_id",
-	2: "status",
-	3: "start_date",
-	4: "end_date",
+// MarshalJSON implements stdjson.Marshaler.
+func (s UziIDNodesAcceptPostOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziIDNodesAcceptPostOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UziIDNodesAcceptPostReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UziIDNodesAcceptPostReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("threshold")
+		e.Float64(s.Threshold)
+	}
 }
 
-// Decode decodes Subscription from json.
-func (s *Subscription) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfUziIDNodesAcceptPostReq = [1]string{
+	0: "threshold",
+}
+
+// Decode decodes UziIDNodesAcceptPostReq from json.
+func (s *UziIDNodesAcceptPostReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Subscription to nil")
+		return errors.New("invalid: unable to decode UziIDNodesAcceptPostReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "threshold":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "tariff_plan_id":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.TariffPlanID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tariff_plan_id\"")
-			}
-		case "status":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
-			}
-		case "start_date":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.StartDate = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"start_date\"")
-			}
-		case "end_date":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.EndDate = v
+				v, err := d.Float64()
+				s.Threshold = float64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"end_date\"")
+				return errors.Wrap(err, "decode field \"threshold\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Subscription")
+		return errors.Wrap(err, "decode UziIDNodesAcceptPostReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -12922,8 +22529,8 @@ func (s *Subscription) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSubscription) {
-					name = jsonFieldsNameOfSubscription[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziIDNodesAcceptPostReq) {
+					name = jsonFieldsNameOfUziIDNodesAcceptPostReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12944,242 +22551,148 @@ func (s *Subscription) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Subscription) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Subscription) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes SubscriptionStatus as json.
-func (s SubscriptionStatus) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes SubscriptionStatus from json.
-func (s *SubscriptionStatus) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode SubscriptionStatus to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch SubscriptionStatus(v) {
-	case SubscriptionStatusPendingPayment:
-		*s = SubscriptionStatusPendingPayment
-	case SubscriptionStatusActive:
-		*s = SubscriptionStatusActive
-	case SubscriptionStatusCancelled:
-		*s = SubscriptionStatusCancelled
-	default:
-		*s = SubscriptionStatus(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s SubscriptionStatus) MarshalJSON() ([]byte, error) {
+func (s *UziIDNodesAcceptPostReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SubscriptionStatus) UnmarshalJSON(data []byte) error {
+func (s *UziIDNodesAcceptPostReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *SubscriptionsCheckActiveGetOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
+// Encode encodes UziIDNodesGetOKApplicationJSON as json.
+func (s UziIDNodesGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Node(s)
 
-// encodeFields encodes fields.
-func (s *SubscriptionsCheckActiveGetOK) encodeFields(e *jx.Encoder) {
-	{
-		if s.HasActiveSubscription.Set {
-			e.FieldStart("has_active_subscription")
-			s.HasActiveSubscription.Encode(e)
-		}
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
+	e.ArrEnd()
 }
 
-var jsonFieldsNameOfSubscriptionsCheckActiveGetOK = [1]string{
-	0: "has_active_subscription",
-}
-
-// Decode decodes SubscriptionsCheckActiveGetOK from json.
-func (s *SubscriptionsCheckActiveGetOK) Decode(d *jx.Decoder) error {
+// Decode decodes UziIDNodesGetOKApplicationJSON from json.
+func (s *UziIDNodesGetOKApplicationJSON) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SubscriptionsCheckActiveGetOK to nil")
+		return errors.New("invalid: unable to decode UziIDNodesGetOKApplicationJSON to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "has_active_subscription":
-			if err := func() error {
-				s.HasActiveSubscription.Reset()
-				if err := s.HasActiveSubscription.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"has_active_subscription\"")
+	var unwrapped []Node
+	if err := func() error {
+		unwrapped = make([]Node, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Node
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode SubscriptionsCheckActiveGetOK")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = UziIDNodesGetOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SubscriptionsCheckActiveGetOK) MarshalJSON() ([]byte, error) {
+func (s UziIDNodesGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SubscriptionsCheckActiveGetOK) UnmarshalJSON(data []byte) error {
+func (s *UziIDNodesGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TariffPlan) Encode(e *jx.Encoder) {
+func (s *UziIDNodesSegmentsPostOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TariffPlan) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		e.FieldStart("description")
-		e.Str(s.Description)
-	}
+func (s *UziIDNodesSegmentsPostOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("price")
-		e.Str(s.Price)
+		e.FieldStart("node_id")
+		json.EncodeUUID(e, s.NodeID)
 	}
 	{
-		e.FieldStart("duration")
-		e.Int(s.Duration)
+		e.FieldStart("segment_ids")
+		e.ArrStart()
+		for _, elem := range s.SegmentIds {
+			json.EncodeUUID(e, elem)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTariffPlan = [5]string{
-	0: "id",
-	1: "name",
-	2: "description",
-	3: "price",
-	4: "duration",
+var jsonFieldsNameOfUziIDNodesSegmentsPostOK = [2]string{
+	0: "node_id",
+	1: "segment_ids",
 }
 
-// Decode decodes TariffPlan from json.
-func (s *TariffPlan) Decode(d *jx.Decoder) error {
+// Decode decodes UziIDNodesSegmentsPostOK from json.
+func (s *UziIDNodesSegmentsPostOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TariffPlan to nil")
+		return errors.New("invalid: unable to decode UziIDNodesSegmentsPostOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "node_id":
 			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "description":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Description = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
-			}
-		case "price":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.Price = string(v)
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.NodeID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"price\"")
+				return errors.Wrap(err, "decode field \"node_id\"")
 			}
-		case "duration":
-			requiredBitSet[0] |= 1 << 4
+		case "segment_ids":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.Duration = int(v)
-				if err != nil {
+				s.SegmentIds = make([]uuid.UUID, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uuid.UUID
+					v, err := json.DecodeUUID(d)
+					elem = v
+					if err != nil {
+						return err
+					}
+					s.SegmentIds = append(s.SegmentIds, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"segment_ids\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TariffPlan")
+		return errors.Wrap(err, "decode UziIDNodesSegmentsPostOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -13191,8 +22704,8 @@ func (s *TariffPlan) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTariffPlan) {
-					name = jsonFieldsNameOfTariffPlan[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziIDNodesSegmentsPostOK) {
+					name = jsonFieldsNameOfUziIDNodesSegmentsPostOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -13213,234 +22726,241 @@ func (s *TariffPlan) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TariffPlan) MarshalJSON() ([]byte, error) {
+func (s *UziIDNodesSegmentsPostOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TariffPlan) UnmarshalJSON(data []byte) error {
+func (s *UziIDNodesSegmentsPostOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TariffPlansGetOKApplicationJSON as json.
-func (s TariffPlansGetOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []TariffPlan(s)
+// Encode implements json.Marshaler.
+func (s *UziIDNodesSegmentsPostReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *UziIDNodesSegmentsPostReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("node")
+		s.Node.Encode(e)
+	}
+	{
+		e.FieldStart("segments")
+		e.ArrStart()
+		for _, elem := range s.Segments {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
-	e.ArrEnd()
 }
 
-// Decode decodes TariffPlansGetOKApplicationJSON from json.
-func (s *TariffPlansGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfUziIDNodesSegmentsPostReq = [2]string{
+	0: "node",
+	1: "segments",
+}
+
+// Decode decodes UziIDNodesSegmentsPostReq from json.
+func (s *UziIDNodesSegmentsPostReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TariffPlansGetOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode UziIDNodesSegmentsPostReq to nil")
 	}
-	var unwrapped []TariffPlan
-	if err := func() error {
-		unwrapped = make([]TariffPlan, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem TariffPlan
-			if err := elem.Decode(d); err != nil {
-				return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "node":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Node.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"node\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		case "segments":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Segments = make([]UziIDNodesSegmentsPostReqSegmentsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem UziIDNodesSegmentsPostReqSegmentsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Segments = append(s.Segments, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"segments\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode UziIDNodesSegmentsPostReq")
 	}
-	*s = TariffPlansGetOKApplicationJSON(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUziIDNodesSegmentsPostReq) {
+					name = jsonFieldsNameOfUziIDNodesSegmentsPostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s TariffPlansGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *UziIDNodesSegmentsPostReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TariffPlansGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *UziIDNodesSegmentsPostReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Uzi) Encode(e *jx.Encoder) {
+func (s *UziIDNodesSegmentsPostReqNode) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Uzi) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
-	{
-		e.FieldStart("projection")
-		s.Projection.Encode(e)
-	}
-	{
-		e.FieldStart("checked")
-		e.Bool(s.Checked)
-	}
-	{
-		e.FieldStart("external_id")
-		json.EncodeUUID(e, s.ExternalID)
-	}
+func (s *UziIDNodesSegmentsPostReqNode) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("author_id")
-		json.EncodeUUID(e, s.AuthorID)
+		e.FieldStart("tirads_23")
+		e.Float64(s.Tirads23)
 	}
 	{
-		e.FieldStart("device_id")
-		e.Int(s.DeviceID)
+		e.FieldStart("tirads_4")
+		e.Float64(s.Tirads4)
 	}
 	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
+		e.FieldStart("tirads_5")
+		e.Float64(s.Tirads5)
 	}
 	{
-		e.FieldStart("create_at")
-		json.EncodeDateTime(e, s.CreateAt)
-	}
-}
-
-var jsonFieldsNameOfUzi = [8]string{
-	0: "id",
-	1: "projection",
-	2: "checked",
-	3: "external_id",
-	4: "author_id",
-	5: "device_id",
-	6: "status",
-	7: "create_at",
-}
-
-// Decode decodes Uzi from json.
-func (s *Uzi) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Uzi to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "projection":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				if err := s.Projection.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"projection\"")
-			}
-		case "checked":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Bool()
-				s.Checked = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"checked\"")
-			}
-		case "external_id":
-			requiredBitSet[0] |= 1 << 3
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfUziIDNodesSegmentsPostReqNode = [4]string{
+	0: "tirads_23",
+	1: "tirads_4",
+	2: "tirads_5",
+	3: "description",
+}
+
+// Decode decodes UziIDNodesSegmentsPostReqNode from json.
+func (s *UziIDNodesSegmentsPostReqNode) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziIDNodesSegmentsPostReqNode to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "tirads_23":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ExternalID = v
+				v, err := d.Float64()
+				s.Tirads23 = float64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"external_id\"")
+				return errors.Wrap(err, "decode field \"tirads_23\"")
 			}
-		case "author_id":
-			requiredBitSet[0] |= 1 << 4
+		case "tirads_4":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.AuthorID = v
+				v, err := d.Float64()
+				s.Tirads4 = float64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"author_id\"")
+				return errors.Wrap(err, "decode field \"tirads_4\"")
 			}
-		case "device_id":
-			requiredBitSet[0] |= 1 << 5
+		case "tirads_5":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Int()
-				s.DeviceID = int(v)
+				v, err := d.Float64()
+				s.Tirads5 = float64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"device_id\"")
-			}
-		case "status":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"tirads_5\"")
 			}
-		case "create_at":
-			requiredBitSet[0] |= 1 << 7
+		case "description":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreateAt = v
-				if err != nil {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"create_at\"")
+				return errors.Wrap(err, "decode field \"description\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Uzi")
+		return errors.Wrap(err, "decode UziIDNodesSegmentsPostReqNode")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b11111111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -13452,8 +22972,8 @@ func (s *Uzi) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUzi) {
-					name = jsonFieldsNameOfUzi[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziIDNodesSegmentsPostReqNode) {
+					name = jsonFieldsNameOfUziIDNodesSegmentsPostReqNode[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -13474,69 +22994,135 @@ func (s *Uzi) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Uzi) MarshalJSON() ([]byte, error) {
+func (s *UziIDNodesSegmentsPostReqNode) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Uzi) UnmarshalJSON(data []byte) error {
+func (s *UziIDNodesSegmentsPostReqNode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UziDevicePostOK) Encode(e *jx.Encoder) {
+func (s *UziIDNodesSegmentsPostReqSegmentsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UziDevicePostOK) encodeFields(e *jx.Encoder) {
+func (s *UziIDNodesSegmentsPostReqSegmentsItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int(s.ID)
+		e.FieldStart("image_id")
+		json.EncodeUUID(e, s.ImageID)
+	}
+	{
+		e.FieldStart("contor")
+		s.Contor.Encode(e)
+	}
+	{
+		e.FieldStart("tirads_23")
+		e.Float64(s.Tirads23)
+	}
+	{
+		e.FieldStart("tirads_4")
+		e.Float64(s.Tirads4)
+	}
+	{
+		e.FieldStart("tirads_5")
+		e.Float64(s.Tirads5)
 	}
 }
 
-var jsonFieldsNameOfUziDevicePostOK = [1]string{
-	0: "id",
+var jsonFieldsNameOfUziIDNodesSegmentsPostReqSegmentsItem = [5]string{
+	0: "image_id",
+	1: "contor",
+	2: "tirads_23",
+	3: "tirads_4",
+	4: "tirads_5",
 }
 
-// Decode decodes UziDevicePostOK from json.
-func (s *UziDevicePostOK) Decode(d *jx.Decoder) error {
+// Decode decodes UziIDNodesSegmentsPostReqSegmentsItem from json.
+func (s *UziIDNodesSegmentsPostReqSegmentsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziDevicePostOK to nil")
+		return errors.New("invalid: unable to decode UziIDNodesSegmentsPostReqSegmentsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "image_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
+				v, err := json.DecodeUUID(d)
+				s.ImageID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"image_id\"")
+			}
+		case "contor":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Contor.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"contor\"")
+			}
+		case "tirads_23":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads23 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_23\"")
+			}
+		case "tirads_4":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads4 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_4\"")
+			}
+		case "tirads_5":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.Tirads5 = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tirads_5\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UziDevicePostOK")
+		return errors.Wrap(err, "decode UziIDNodesSegmentsPostReqSegmentsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -13548,8 +23134,8 @@ func (s *UziDevicePostOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUziDevicePostOK) {
-					name = jsonFieldsNameOfUziDevicePostOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziIDNodesSegmentsPostReqSegmentsItem) {
+					name = jsonFieldsNameOfUziIDNodesSegmentsPostReqSegmentsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -13570,167 +23156,158 @@ func (s *UziDevicePostOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UziDevicePostOK) MarshalJSON() ([]byte, error) {
+func (s *UziIDNodesSegmentsPostReqSegmentsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziDevicePostOK) UnmarshalJSON(data []byte) error {
+func (s *UziIDNodesSegmentsPostReqSegmentsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UziDevicePostReq) Encode(e *jx.Encoder) {
+func (s *UziIDPatchReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UziDevicePostReq) encodeFields(e *jx.Encoder) {
+func (s *UziIDPatchReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		if s.Projection.Set {
+			e.FieldStart("projection")
+			s.Projection.Encode(e)
+		}
+	}
+	{
+		if s.Checked.Set {
+			e.FieldStart("checked")
+			s.Checked.Encode(e)
+		}
+	}
+	{
+		if s.PixelSpacing.Set {
+			e.FieldStart("pixel_spacing")
+			s.PixelSpacing.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfUziDevicePostReq = [1]string{
-	0: "name",
+var jsonFieldsNameOfUziIDPatchReq = [3]string{
+	0: "projection",
+	1: "checked",
+	2: "pixel_spacing",
 }
 
-// Decode decodes UziDevicePostReq from json.
-func (s *UziDevicePostReq) Decode(d *jx.Decoder) error {
+// Decode decodes UziIDPatchReq from json.
+func (s *UziIDPatchReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziDevicePostReq to nil")
+		return errors.New("invalid: unable to decode UziIDPatchReq to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
-			requiredBitSet[0] |= 1 << 0
+		case "projection":
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				s.Projection.Reset()
+				if err := s.Projection.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"projection\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode UziDevicePostReq")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfUziDevicePostReq) {
-					name = jsonFieldsNameOfUziDevicePostReq[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "checked":
+			if err := func() error {
+				s.Checked.Reset()
+				if err := s.Checked.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"checked\"")
+			}
+		case "pixel_spacing":
+			if err := func() error {
+				s.PixelSpacing.Reset()
+				if err := s.PixelSpacing.Decode(d); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pixel_spacing\"")
 			}
+		default:
+			return d.Skip()
 		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UziIDPatchReq")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UziDevicePostReq) MarshalJSON() ([]byte, error) {
+func (s *UziIDPatchReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziDevicePostReq) UnmarshalJSON(data []byte) error {
+func (s *UziIDPatchReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UziDevicesGetOKApplicationJSON as json.
-func (s UziDevicesGetOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Device(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+// Encode encodes UziIDPatchReqProjection as json.
+func (s UziIDPatchReqProjection) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes UziDevicesGetOKApplicationJSON from json.
-func (s *UziDevicesGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes UziIDPatchReqProjection from json.
+func (s *UziIDPatchReqProjection) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziDevicesGetOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode UziIDPatchReqProjection to nil")
 	}
-	var unwrapped []Device
-	if err := func() error {
-		unwrapped = make([]Device, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Device
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = UziDevicesGetOKApplicationJSON(unwrapped)
+	// Try to use constant string.
+	switch UziIDPatchReqProjection(v) {
+	case UziIDPatchReqProjectionCross:
+		*s = UziIDPatchReqProjectionCross
+	case UziIDPatchReqProjectionLong:
+		*s = UziIDPatchReqProjectionLong
+	default:
+		*s = UziIDPatchReqProjection(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s UziDevicesGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s UziIDPatchReqProjection) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziDevicesGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *UziIDPatchReqProjection) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UziIDImagesGetOKApplicationJSON as json.
-func (s UziIDImagesGetOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Image(s)
+// Encode encodes UziIDReportsGetOKApplicationJSON as json.
+func (s UziIDReportsGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Report(s)
 
 	e.ArrStart()
 	for _, elem := range unwrapped {
@@ -13739,16 +23316,16 @@ func (s UziIDImagesGetOKApplicationJSON) Encode(e *jx.Encoder) {
 	e.ArrEnd()
 }
 
-// Decode decodes UziIDImagesGetOKApplicationJSON from json.
-func (s *UziIDImagesGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes UziIDReportsGetOKApplicationJSON from json.
+func (s *UziIDReportsGetOKApplicationJSON) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziIDImagesGetOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode UziIDReportsGetOKApplicationJSON to nil")
 	}
-	var unwrapped []Image
+	var unwrapped []Report
 	if err := func() error {
-		unwrapped = make([]Image, 0)
+		unwrapped = make([]Report, 0)
 		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Image
+			var elem Report
 			if err := elem.Decode(d); err != nil {
 				return err
 			}
@@ -13761,153 +23338,176 @@ func (s *UziIDImagesGetOKApplicationJSON) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = UziIDImagesGetOKApplicationJSON(unwrapped)
+	*s = UziIDReportsGetOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s UziIDImagesGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s UziIDReportsGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziIDImagesGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *UziIDReportsGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UziIDNodesGetOKApplicationJSON as json.
-func (s UziIDNodesGetOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Node(s)
+// Encode implements json.Marshaler.
+func (s *UziImageIDNodesSegmentsGetOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *UziImageIDNodesSegmentsGetOK) encodeFields(e *jx.Encoder) {
+	{
+		if s.Nodes != nil {
+			e.FieldStart("nodes")
+			e.ArrStart()
+			for _, elem := range s.Nodes {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Segments != nil {
+			e.FieldStart("segments")
+			e.ArrStart()
+			for _, elem := range s.Segments {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	e.ArrEnd()
 }
 
-// Decode decodes UziIDNodesGetOKApplicationJSON from json.
-func (s *UziIDNodesGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfUziImageIDNodesSegmentsGetOK = [2]string{
+	0: "nodes",
+	1: "segments",
+}
+
+// Decode decodes UziImageIDNodesSegmentsGetOK from json.
+func (s *UziImageIDNodesSegmentsGetOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziIDNodesGetOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode UziImageIDNodesSegmentsGetOK to nil")
 	}
-	var unwrapped []Node
-	if err := func() error {
-		unwrapped = make([]Node, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Node
-			if err := elem.Decode(d); err != nil {
-				return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "nodes":
+			if err := func() error {
+				s.Nodes = make([]Node, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Node
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Nodes = append(s.Nodes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"nodes\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		case "segments":
+			if err := func() error {
+				s.Segments = make([]Segment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Segment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Segments = append(s.Segments, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"segments\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode UziImageIDNodesSegmentsGetOK")
 	}
-	*s = UziIDNodesGetOKApplicationJSON(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s UziIDNodesGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *UziImageIDNodesSegmentsGetOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziIDNodesGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *UziImageIDNodesSegmentsGetOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UziIDNodesSegmentsPostOK) Encode(e *jx.Encoder) {
+func (s *UziNodesIDLinkPostOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UziIDNodesSegmentsPostOK) encodeFields(e *jx.Encoder) {
+func (s *UziNodesIDLinkPostOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("node_id")
-		json.EncodeUUID(e, s.NodeID)
-	}
-	{
-		e.FieldStart("segment_ids")
-		e.ArrStart()
-		for _, elem := range s.SegmentIds {
-			json.EncodeUUID(e, elem)
-		}
-		e.ArrEnd()
+		e.FieldStart("lineage_id")
+		json.EncodeUUID(e, s.LineageID)
 	}
 }
 
-var jsonFieldsNameOfUziIDNodesSegmentsPostOK = [2]string{
-	0: "node_id",
-	1: "segment_ids",
+var jsonFieldsNameOfUziNodesIDLinkPostOK = [1]string{
+	0: "lineage_id",
 }
 
-// Decode decodes UziIDNodesSegmentsPostOK from json.
-func (s *UziIDNodesSegmentsPostOK) Decode(d *jx.Decoder) error {
+// Decode decodes UziNodesIDLinkPostOK from json.
+func (s *UziNodesIDLinkPostOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziIDNodesSegmentsPostOK to nil")
+		return errors.New("invalid: unable to decode UziNodesIDLinkPostOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "node_id":
+		case "lineage_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := json.DecodeUUID(d)
-				s.NodeID = v
+				s.LineageID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"node_id\"")
-			}
-		case "segment_ids":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				s.SegmentIds = make([]uuid.UUID, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem uuid.UUID
-					v, err := json.DecodeUUID(d)
-					elem = v
-					if err != nil {
-						return err
-					}
-					s.SegmentIds = append(s.SegmentIds, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"segment_ids\"")
+				return errors.Wrap(err, "decode field \"lineage_id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UziIDNodesSegmentsPostOK")
+		return errors.Wrap(err, "decode UziNodesIDLinkPostOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -13919,8 +23519,8 @@ func (s *UziIDNodesSegmentsPostOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUziIDNodesSegmentsPostOK) {
-					name = jsonFieldsNameOfUziIDNodesSegmentsPostOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziNodesIDLinkPostOK) {
+					name = jsonFieldsNameOfUziNodesIDLinkPostOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -13941,94 +23541,69 @@ func (s *UziIDNodesSegmentsPostOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UziIDNodesSegmentsPostOK) MarshalJSON() ([]byte, error) {
+func (s *UziNodesIDLinkPostOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziIDNodesSegmentsPostOK) UnmarshalJSON(data []byte) error {
+func (s *UziNodesIDLinkPostOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UziIDNodesSegmentsPostReq) Encode(e *jx.Encoder) {
+func (s *UziNodesIDLinkPostReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UziIDNodesSegmentsPostReq) encodeFields(e *jx.Encoder) {
+func (s *UziNodesIDLinkPostReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("node")
-		s.Node.Encode(e)
-	}
-	{
-		e.FieldStart("segments")
-		e.ArrStart()
-		for _, elem := range s.Segments {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("target_node_id")
+		json.EncodeUUID(e, s.TargetNodeID)
 	}
 }
 
-var jsonFieldsNameOfUziIDNodesSegmentsPostReq = [2]string{
-	0: "node",
-	1: "segments",
+var jsonFieldsNameOfUziNodesIDLinkPostReq = [1]string{
+	0: "target_node_id",
 }
 
-// Decode decodes UziIDNodesSegmentsPostReq from json.
-func (s *UziIDNodesSegmentsPostReq) Decode(d *jx.Decoder) error {
+// Decode decodes UziNodesIDLinkPostReq from json.
+func (s *UziNodesIDLinkPostReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziIDNodesSegmentsPostReq to nil")
+		return errors.New("invalid: unable to decode UziNodesIDLinkPostReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "node":
+		case "target_node_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Node.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"node\"")
-			}
-		case "segments":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				s.Segments = make([]UziIDNodesSegmentsPostReqSegmentsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem UziIDNodesSegmentsPostReqSegmentsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Segments = append(s.Segments, elem)
-					return nil
-				}); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.TargetNodeID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"segments\"")
+				return errors.Wrap(err, "decode field \"target_node_id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UziIDNodesSegmentsPostReq")
+		return errors.Wrap(err, "decode UziNodesIDLinkPostReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -14040,8 +23615,8 @@ func (s *UziIDNodesSegmentsPostReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUziIDNodesSegmentsPostReq) {
-					name = jsonFieldsNameOfUziIDNodesSegmentsPostReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziNodesIDLinkPostReq) {
+					name = jsonFieldsNameOfUziNodesIDLinkPostReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -14062,69 +23637,139 @@ func (s *UziIDNodesSegmentsPostReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UziIDNodesSegmentsPostReq) MarshalJSON() ([]byte, error) {
+func (s *UziNodesIDLinkPostReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziIDNodesSegmentsPostReq) UnmarshalJSON(data []byte) error {
+func (s *UziNodesIDLinkPostReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziNodesIDLinkSuggestionsGetOKApplicationJSON as json.
+func (s UziNodesIDLinkSuggestionsGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []NodeLinkSuggestion(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes UziNodesIDLinkSuggestionsGetOKApplicationJSON from json.
+func (s *UziNodesIDLinkSuggestionsGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziNodesIDLinkSuggestionsGetOKApplicationJSON to nil")
+	}
+	var unwrapped []NodeLinkSuggestion
+	if err := func() error {
+		unwrapped = make([]NodeLinkSuggestion, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem NodeLinkSuggestion
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UziNodesIDLinkSuggestionsGetOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UziNodesIDLinkSuggestionsGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziNodesIDLinkSuggestionsGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UziIDNodesSegmentsPostReqNode) Encode(e *jx.Encoder) {
+func (s *UziNodesIDPatchReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UziIDNodesSegmentsPostReqNode) encodeFields(e *jx.Encoder) {
+func (s *UziNodesIDPatchReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("tirads_23")
-		e.Float64(s.Tirads23)
+		if s.Validation.Set {
+			e.FieldStart("validation")
+			s.Validation.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("tirads_4")
-		e.Float64(s.Tirads4)
+		if s.Tirads23.Set {
+			e.FieldStart("tirads_23")
+			s.Tirads23.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("tirads_5")
-		e.Float64(s.Tirads5)
+		if s.Tirads4.Set {
+			e.FieldStart("tirads_4")
+			s.Tirads4.Encode(e)
+		}
 	}
 	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
+		if s.Tirads5.Set {
+			e.FieldStart("tirads_5")
+			s.Tirads5.Encode(e)
+		}
+	}
+	{
+		if s.Lobe.Set {
+			e.FieldStart("lobe")
+			s.Lobe.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfUziIDNodesSegmentsPostReqNode = [4]string{
-	0: "tirads_23",
-	1: "tirads_4",
-	2: "tirads_5",
-	3: "description",
+var jsonFieldsNameOfUziNodesIDPatchReq = [5]string{
+	0: "validation",
+	1: "tirads_23",
+	2: "tirads_4",
+	3: "tirads_5",
+	4: "lobe",
 }
 
-// Decode decodes UziIDNodesSegmentsPostReqNode from json.
-func (s *UziIDNodesSegmentsPostReqNode) Decode(d *jx.Decoder) error {
+// Decode decodes UziNodesIDPatchReq from json.
+func (s *UziNodesIDPatchReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziIDNodesSegmentsPostReqNode to nil")
+		return errors.New("invalid: unable to decode UziNodesIDPatchReq to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "validation":
+			if err := func() error {
+				s.Validation.Reset()
+				if err := s.Validation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"validation\"")
+			}
 		case "tirads_23":
-			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads23 = float64(v)
-				if err != nil {
+				s.Tirads23.Reset()
+				if err := s.Tirads23.Decode(d); err != nil {
 					return err
 				}
 				return nil
@@ -14132,11 +23777,9 @@ func (s *UziIDNodesSegmentsPostReqNode) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"tirads_23\"")
 			}
 		case "tirads_4":
-			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads4 = float64(v)
-				if err != nil {
+				s.Tirads4.Reset()
+				if err := s.Tirads4.Decode(d); err != nil {
 					return err
 				}
 				return nil
@@ -14144,202 +23787,253 @@ func (s *UziIDNodesSegmentsPostReqNode) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"tirads_4\"")
 			}
 		case "tirads_5":
-			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads5 = float64(v)
-				if err != nil {
+				s.Tirads5.Reset()
+				if err := s.Tirads5.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"tirads_5\"")
 			}
-		case "description":
+		case "lobe":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.Lobe.Reset()
+				if err := s.Lobe.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"lobe\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UziIDNodesSegmentsPostReqNode")
+		return errors.Wrap(err, "decode UziNodesIDPatchReq")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UziNodesIDPatchReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziNodesIDPatchReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziNodesIDPatchReqValidation as json.
+func (s UziNodesIDPatchReqValidation) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes UziNodesIDPatchReqValidation from json.
+func (s *UziNodesIDPatchReqValidation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziNodesIDPatchReqValidation to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch UziNodesIDPatchReqValidation(v) {
+	case UziNodesIDPatchReqValidationInvalid:
+		*s = UziNodesIDPatchReqValidationInvalid
+	case UziNodesIDPatchReqValidationValid:
+		*s = UziNodesIDPatchReqValidationValid
+	default:
+		*s = UziNodesIDPatchReqValidation(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UziNodesIDPatchReqValidation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziNodesIDPatchReqValidation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziNodesIDSegmentDraftsGetOKApplicationJSON as json.
+func (s UziNodesIDSegmentDraftsGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []SegmentDraft(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes UziNodesIDSegmentDraftsGetOKApplicationJSON from json.
+func (s *UziNodesIDSegmentDraftsGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziNodesIDSegmentDraftsGetOKApplicationJSON to nil")
+	}
+	var unwrapped []SegmentDraft
+	if err := func() error {
+		unwrapped = make([]SegmentDraft, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem SegmentDraft
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UziNodesIDSegmentDraftsGetOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UziNodesIDSegmentDraftsGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziNodesIDSegmentDraftsGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziNodesIDSegmentsGetOKApplicationJSON as json.
+func (s UziNodesIDSegmentsGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Segment(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes UziNodesIDSegmentsGetOKApplicationJSON from json.
+func (s *UziNodesIDSegmentsGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziNodesIDSegmentsGetOKApplicationJSON to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfUziIDNodesSegmentsPostReqNode) {
-					name = jsonFieldsNameOfUziIDNodesSegmentsPostReqNode[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+	var unwrapped []Segment
+	if err := func() error {
+		unwrapped = make([]Segment, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Segment
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
+	*s = UziNodesIDSegmentsGetOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UziIDNodesSegmentsPostReqNode) MarshalJSON() ([]byte, error) {
+func (s UziNodesIDSegmentsGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziIDNodesSegmentsPostReqNode) UnmarshalJSON(data []byte) error {
+func (s *UziNodesIDSegmentsGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UziIDNodesSegmentsPostReqSegmentsItem) Encode(e *jx.Encoder) {
+func (s *UziNodesIDSplitPostOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UziIDNodesSegmentsPostReqSegmentsItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("image_id")
-		json.EncodeUUID(e, s.ImageID)
-	}
-	{
-		if s.Contor != nil {
-			e.FieldStart("contor")
-			s.Contor.Encode(e)
-		}
-	}
+func (s *UziNodesIDSplitPostOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("tirads_23")
-		e.Float64(s.Tirads23)
-	}
-	{
-		e.FieldStart("tirads_4")
-		e.Float64(s.Tirads4)
+		e.FieldStart("node")
+		s.Node.Encode(e)
 	}
 	{
-		e.FieldStart("tirads_5")
-		e.Float64(s.Tirads5)
+		e.FieldStart("new_node")
+		s.NewNode.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfUziIDNodesSegmentsPostReqSegmentsItem = [5]string{
-	0: "image_id",
-	1: "contor",
-	2: "tirads_23",
-	3: "tirads_4",
-	4: "tirads_5",
+var jsonFieldsNameOfUziNodesIDSplitPostOK = [2]string{
+	0: "node",
+	1: "new_node",
 }
 
-// Decode decodes UziIDNodesSegmentsPostReqSegmentsItem from json.
-func (s *UziIDNodesSegmentsPostReqSegmentsItem) Decode(d *jx.Decoder) error {
+// Decode decodes UziNodesIDSplitPostOK from json.
+func (s *UziNodesIDSplitPostOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziIDNodesSegmentsPostReqSegmentsItem to nil")
+		return errors.New("invalid: unable to decode UziNodesIDSplitPostOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "image_id":
+		case "node":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ImageID = v
-				if err != nil {
+				if err := s.Node.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"image_id\"")
+				return errors.Wrap(err, "decode field \"node\"")
 			}
-		case "contor":
+		case "new_node":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Contor.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"contor\"")
-			}
-		case "tirads_23":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads23 = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_23\"")
-			}
-		case "tirads_4":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads4 = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_4\"")
-			}
-		case "tirads_5":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Float64()
-				s.Tirads5 = float64(v)
-				if err != nil {
+				if err := s.NewNode.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_5\"")
+				return errors.Wrap(err, "decode field \"new_node\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UziIDNodesSegmentsPostReqSegmentsItem")
+		return errors.Wrap(err, "decode UziNodesIDSplitPostOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -14351,8 +24045,8 @@ func (s *UziIDNodesSegmentsPostReqSegmentsItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUziIDNodesSegmentsPostReqSegmentsItem) {
-					name = jsonFieldsNameOfUziIDNodesSegmentsPostReqSegmentsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUziNodesIDSplitPostOK) {
+					name = jsonFieldsNameOfUziNodesIDSplitPostOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -14366,447 +24060,573 @@ func (s *UziIDNodesSegmentsPostReqSegmentsItem) Decode(d *jx.Decoder) error {
 		}
 	}
 	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *UziIDNodesSegmentsPostReqSegmentsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziIDNodesSegmentsPostReqSegmentsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *UziIDPatchReq) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *UziIDPatchReq) encodeFields(e *jx.Encoder) {
-	{
-		if s.Projection.Set {
-			e.FieldStart("projection")
-			s.Projection.Encode(e)
-		}
-	}
-	{
-		if s.Checked.Set {
-			e.FieldStart("checked")
-			s.Checked.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfUziIDPatchReq = [2]string{
-	0: "projection",
-	1: "checked",
-}
-
-// Decode decodes UziIDPatchReq from json.
-func (s *UziIDPatchReq) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode UziIDPatchReq to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "projection":
-			if err := func() error {
-				s.Projection.Reset()
-				if err := s.Projection.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"projection\"")
-			}
-		case "checked":
-			if err := func() error {
-				s.Checked.Reset()
-				if err := s.Checked.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"checked\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode UziIDPatchReq")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *UziIDPatchReq) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziIDPatchReq) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes UziIDPatchReqProjection as json.
-func (s UziIDPatchReqProjection) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes UziIDPatchReqProjection from json.
-func (s *UziIDPatchReqProjection) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode UziIDPatchReqProjection to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch UziIDPatchReqProjection(v) {
-	case UziIDPatchReqProjectionCross:
-		*s = UziIDPatchReqProjectionCross
-	case UziIDPatchReqProjectionLong:
-		*s = UziIDPatchReqProjectionLong
-	default:
-		*s = UziIDPatchReqProjection(v)
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s UziIDPatchReqProjection) MarshalJSON() ([]byte, error) {
+func (s *UziNodesIDSplitPostOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziIDPatchReqProjection) UnmarshalJSON(data []byte) error {
+func (s *UziNodesIDSplitPostOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UziImageIDNodesSegmentsGetOK) Encode(e *jx.Encoder) {
+func (s *UziNodesIDSplitPostReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UziImageIDNodesSegmentsGetOK) encodeFields(e *jx.Encoder) {
-	{
-		if s.Nodes != nil {
-			e.FieldStart("nodes")
-			e.ArrStart()
-			for _, elem := range s.Nodes {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
+func (s *UziNodesIDSplitPostReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.Segments != nil {
-			e.FieldStart("segments")
-			e.ArrStart()
-			for _, elem := range s.Segments {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		e.FieldStart("segment_ids")
+		e.ArrStart()
+		for _, elem := range s.SegmentIds {
+			json.EncodeUUID(e, elem)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfUziImageIDNodesSegmentsGetOK = [2]string{
-	0: "nodes",
-	1: "segments",
+var jsonFieldsNameOfUziNodesIDSplitPostReq = [1]string{
+	0: "segment_ids",
 }
 
-// Decode decodes UziImageIDNodesSegmentsGetOK from json.
-func (s *UziImageIDNodesSegmentsGetOK) Decode(d *jx.Decoder) error {
+// Decode decodes UziNodesIDSplitPostReq from json.
+func (s *UziNodesIDSplitPostReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziImageIDNodesSegmentsGetOK to nil")
+		return errors.New("invalid: unable to decode UziNodesIDSplitPostReq to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "nodes":
-			if err := func() error {
-				s.Nodes = make([]Node, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Node
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Nodes = append(s.Nodes, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"nodes\"")
-			}
-		case "segments":
+		case "segment_ids":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Segments = make([]Segment, 0)
+				s.SegmentIds = make([]uuid.UUID, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Segment
-					if err := elem.Decode(d); err != nil {
+					var elem uuid.UUID
+					v, err := json.DecodeUUID(d)
+					elem = v
+					if err != nil {
 						return err
 					}
-					s.Segments = append(s.Segments, elem)
+					s.SegmentIds = append(s.SegmentIds, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"segments\"")
+				return errors.Wrap(err, "decode field \"segment_ids\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UziImageIDNodesSegmentsGetOK")
+		return errors.Wrap(err, "decode UziNodesIDSplitPostReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUziNodesIDSplitPostReq) {
+					name = jsonFieldsNameOfUziNodesIDSplitPostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UziImageIDNodesSegmentsGetOK) MarshalJSON() ([]byte, error) {
+func (s *UziNodesIDSplitPostReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziImageIDNodesSegmentsGetOK) UnmarshalJSON(data []byte) error {
+func (s *UziNodesIDSplitPostReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UziNodesIDPatchReq) Encode(e *jx.Encoder) {
+func (s *UziNodesMergePostReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UziNodesIDPatchReq) encodeFields(e *jx.Encoder) {
-	{
-		if s.Validation.Set {
-			e.FieldStart("validation")
-			s.Validation.Encode(e)
-		}
-	}
-	{
-		if s.Tirads23.Set {
-			e.FieldStart("tirads_23")
-			s.Tirads23.Encode(e)
-		}
-	}
-	{
-		if s.Tirads4.Set {
-			e.FieldStart("tirads_4")
-			s.Tirads4.Encode(e)
-		}
-	}
+func (s *UziNodesMergePostReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.Tirads5.Set {
-			e.FieldStart("tirads_5")
-			s.Tirads5.Encode(e)
+		e.FieldStart("node_ids")
+		e.ArrStart()
+		for _, elem := range s.NodeIds {
+			json.EncodeUUID(e, elem)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfUziNodesIDPatchReq = [4]string{
-	0: "validation",
-	1: "tirads_23",
-	2: "tirads_4",
-	3: "tirads_5",
+var jsonFieldsNameOfUziNodesMergePostReq = [1]string{
+	0: "node_ids",
 }
 
-// Decode decodes UziNodesIDPatchReq from json.
-func (s *UziNodesIDPatchReq) Decode(d *jx.Decoder) error {
+// Decode decodes UziNodesMergePostReq from json.
+func (s *UziNodesMergePostReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziNodesIDPatchReq to nil")
+		return errors.New("invalid: unable to decode UziNodesMergePostReq to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "validation":
+		case "node_ids":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Validation.Reset()
-				if err := s.Validation.Decode(d); err != nil {
+				s.NodeIds = make([]uuid.UUID, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uuid.UUID
+					v, err := json.DecodeUUID(d)
+					elem = v
+					if err != nil {
+						return err
+					}
+					s.NodeIds = append(s.NodeIds, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"validation\"")
+				return errors.Wrap(err, "decode field \"node_ids\"")
 			}
-		case "tirads_23":
-			if err := func() error {
-				s.Tirads23.Reset()
-				if err := s.Tirads23.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UziNodesMergePostReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUziNodesMergePostReq) {
+					name = jsonFieldsNameOfUziNodesMergePostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_23\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "tirads_4":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UziNodesMergePostReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziNodesMergePostReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UziNodesReviewPostOKApplicationJSON as json.
+func (s UziNodesReviewPostOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []NodeReviewItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes UziNodesReviewPostOKApplicationJSON from json.
+func (s *UziNodesReviewPostOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziNodesReviewPostOKApplicationJSON to nil")
+	}
+	var unwrapped []NodeReviewItem
+	if err := func() error {
+		unwrapped = make([]NodeReviewItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem NodeReviewItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UziNodesReviewPostOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UziNodesReviewPostOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziNodesReviewPostOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UziNodesReviewPostReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UziNodesReviewPostReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("node_ids")
+		e.ArrStart()
+		for _, elem := range s.NodeIds {
+			json.EncodeUUID(e, elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("validation")
+		s.Validation.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfUziNodesReviewPostReq = [2]string{
+	0: "node_ids",
+	1: "validation",
+}
+
+// Decode decodes UziNodesReviewPostReq from json.
+func (s *UziNodesReviewPostReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziNodesReviewPostReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "node_ids":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Tirads4.Reset()
-				if err := s.Tirads4.Decode(d); err != nil {
+				s.NodeIds = make([]uuid.UUID, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uuid.UUID
+					v, err := json.DecodeUUID(d)
+					elem = v
+					if err != nil {
+						return err
+					}
+					s.NodeIds = append(s.NodeIds, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_4\"")
+				return errors.Wrap(err, "decode field \"node_ids\"")
 			}
-		case "tirads_5":
+		case "validation":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Tirads5.Reset()
-				if err := s.Tirads5.Decode(d); err != nil {
+				if err := s.Validation.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tirads_5\"")
+				return errors.Wrap(err, "decode field \"validation\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UziNodesIDPatchReq")
+		return errors.Wrap(err, "decode UziNodesReviewPostReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUziNodesReviewPostReq) {
+					name = jsonFieldsNameOfUziNodesReviewPostReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UziNodesIDPatchReq) MarshalJSON() ([]byte, error) {
+func (s *UziNodesReviewPostReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziNodesIDPatchReq) UnmarshalJSON(data []byte) error {
+func (s *UziNodesReviewPostReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UziNodesIDPatchReqValidation as json.
-func (s UziNodesIDPatchReqValidation) Encode(e *jx.Encoder) {
+// Encode encodes UziNodesReviewPostReqValidation as json.
+func (s UziNodesReviewPostReqValidation) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes UziNodesIDPatchReqValidation from json.
-func (s *UziNodesIDPatchReqValidation) Decode(d *jx.Decoder) error {
+// Decode decodes UziNodesReviewPostReqValidation from json.
+func (s *UziNodesReviewPostReqValidation) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziNodesIDPatchReqValidation to nil")
+		return errors.New("invalid: unable to decode UziNodesReviewPostReqValidation to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch UziNodesIDPatchReqValidation(v) {
-	case UziNodesIDPatchReqValidationInvalid:
-		*s = UziNodesIDPatchReqValidationInvalid
-	case UziNodesIDPatchReqValidationValid:
-		*s = UziNodesIDPatchReqValidationValid
+	switch UziNodesReviewPostReqValidation(v) {
+	case UziNodesReviewPostReqValidationValid:
+		*s = UziNodesReviewPostReqValidationValid
+	case UziNodesReviewPostReqValidationInvalid:
+		*s = UziNodesReviewPostReqValidationInvalid
 	default:
-		*s = UziNodesIDPatchReqValidation(v)
+		*s = UziNodesReviewPostReqValidation(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s UziNodesIDPatchReqValidation) MarshalJSON() ([]byte, error) {
+func (s UziNodesReviewPostReqValidation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziNodesIDPatchReqValidation) UnmarshalJSON(data []byte) error {
+func (s *UziNodesReviewPostReqValidation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UziNodesIDSegmentsGetOKApplicationJSON as json.
-func (s UziNodesIDSegmentsGetOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Segment(s)
+// Encode implements json.Marshaler.
+func (s *UziPage) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *UziPage) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("uzis")
+		e.ArrStart()
+		for _, elem := range s.Uzis {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.NextCursor.Set {
+			e.FieldStart("next_cursor")
+			s.NextCursor.Encode(e)
+		}
 	}
-	e.ArrEnd()
 }
 
-// Decode decodes UziNodesIDSegmentsGetOKApplicationJSON from json.
-func (s *UziNodesIDSegmentsGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfUziPage = [2]string{
+	0: "uzis",
+	1: "next_cursor",
+}
+
+// Decode decodes UziPage from json.
+func (s *UziPage) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UziNodesIDSegmentsGetOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode UziPage to nil")
 	}
-	var unwrapped []Segment
-	if err := func() error {
-		unwrapped = make([]Segment, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Segment
-			if err := elem.Decode(d); err != nil {
-				return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uzis":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Uzis = make([]Uzi, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Uzi
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Uzis = append(s.Uzis, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uzis\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		case "next_cursor":
+			if err := func() error {
+				s.NextCursor.Reset()
+				if err := s.NextCursor.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"next_cursor\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode UziPage")
 	}
-	*s = UziNodesIDSegmentsGetOKApplicationJSON(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUziPage) {
+					name = jsonFieldsNameOfUziPage[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s UziNodesIDSegmentsGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *UziPage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UziNodesIDSegmentsGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *UziPage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -14861,7 +24681,7 @@ func (s *UziSegmentIDPatchReq) Encode(e *jx.Encoder) {
 // encodeFields encodes fields.
 func (s *UziSegmentIDPatchReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.Contor != nil {
+		if s.Contor.Set {
 			e.FieldStart("contor")
 			s.Contor.Encode(e)
 		}
@@ -14903,6 +24723,7 @@ func (s *UziSegmentIDPatchReq) Decode(d *jx.Decoder) error {
 		switch string(k) {
 		case "contor":
 			if err := func() error {
+				s.Contor.Reset()
 				if err := s.Contor.Decode(d); err != nil {
 					return err
 				}
@@ -14964,6 +24785,56 @@ func (s *UziSegmentIDPatchReq) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes UziSegmentIDPropagatePostOKApplicationJSON as json.
+func (s UziSegmentIDPropagatePostOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []SegmentDraft(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes UziSegmentIDPropagatePostOKApplicationJSON from json.
+func (s *UziSegmentIDPropagatePostOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UziSegmentIDPropagatePostOKApplicationJSON to nil")
+	}
+	var unwrapped []SegmentDraft
+	if err := func() error {
+		unwrapped = make([]SegmentDraft, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem SegmentDraft
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UziSegmentIDPropagatePostOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UziSegmentIDPropagatePostOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UziSegmentIDPropagatePostOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *UziSegmentPostReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -14982,10 +24853,8 @@ func (s *UziSegmentPostReq) encodeFields(e *jx.Encoder) {
 		json.EncodeUUID(e, s.NodeID)
 	}
 	{
-		if s.Contor != nil {
-			e.FieldStart("contor")
-			s.Contor.Encode(e)
-		}
+		e.FieldStart("contor")
+		s.Contor.Encode(e)
 	}
 	{
 		e.FieldStart("tirads_23")
@@ -15287,6 +25156,56 @@ func (s *UzisExternalIDGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes UzisExternalIDGrowthGetOKApplicationJSON as json.
+func (s UzisExternalIDGrowthGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []NodeGrowth(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes UzisExternalIDGrowthGetOKApplicationJSON from json.
+func (s *UzisExternalIDGrowthGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UzisExternalIDGrowthGetOKApplicationJSON to nil")
+	}
+	var unwrapped []NodeGrowth
+	if err := func() error {
+		unwrapped = make([]NodeGrowth, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem NodeGrowth
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UzisExternalIDGrowthGetOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UzisExternalIDGrowthGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UzisExternalIDGrowthGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *YookassaWebhookRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -10,6 +10,8 @@ const (
 	CytologyCreateCreateOperation                         OperationName = "CytologyCreateCreate"
 	CytologyHistoryReadOperation                          OperationName = "CytologyHistoryRead"
 	CytologyPatientShotsReadOperation                     OperationName = "CytologyPatientShotsRead"
+	CytologyQuPathExportOperation                         OperationName = "CytologyQuPathExport"
+	CytologyQuPathImportOperation                         OperationName = "CytologyQuPathImport"
 	CytologyReadOperation                                 OperationName = "CytologyRead"
 	CytologySegmentGroupCreateCreateOperation             OperationName = "CytologySegmentGroupCreateCreate"
 	CytologySegmentUpdateDeleteOperation                  OperationName = "CytologySegmentUpdateDelete"
@@ -17,10 +19,12 @@ const (
 	CytologySegmentUpdateReadOperation                    OperationName = "CytologySegmentUpdateRead"
 	CytologySegmentUpdateUpdateOperation                  OperationName = "CytologySegmentUpdateUpdate"
 	CytologySegmentsListOperation                         OperationName = "CytologySegmentsList"
+	CytologySegmentsViewportOperation                     OperationName = "CytologySegmentsViewport"
 	CytologyUpdatePartialUpdateOperation                  OperationName = "CytologyUpdatePartialUpdate"
 	CytologyUpdateUpdateOperation                         OperationName = "CytologyUpdateUpdate"
 	DownloadCytologyCytologyIDOriginalImageIDGetOperation OperationName = "DownloadCytologyCytologyIDOriginalImageIDGet"
 	DownloadUziIDImageIDGetOperation                      OperationName = "DownloadUziIDImageIDGet"
+	DownloadUziUziIDReportGetOperation                    OperationName = "DownloadUziUziIDReportGet"
 	LoginPostOperation                                    OperationName = "LoginPost"
 	MedCardDoctorIDPatientIDGetOperation                  OperationName = "MedCardDoctorIDPatientIDGet"
 	MedCardDoctorIDPatientIDPatchOperation                OperationName = "MedCardDoctorIDPatientIDPatch"
@@ -41,25 +45,52 @@ const (
 	TariffPlansIDGetOperation                             OperationName = "TariffPlansIDGet"
 	TilerDziFilePathFilesLevelColRowFormatGetOperation    OperationName = "TilerDziFilePathFilesLevelColRowFormatGet"
 	TilerDziFilePathGetOperation                          OperationName = "TilerDziFilePathGet"
+	UploadsIDCompletePostOperation                        OperationName = "UploadsIDCompletePost"
+	UploadsIDDeleteOperation                              OperationName = "UploadsIDDelete"
+	UploadsIDGetOperation                                 OperationName = "UploadsIDGet"
+	UploadsIDPartsPostOperation                           OperationName = "UploadsIDPartsPost"
+	UploadsPostOperation                                  OperationName = "UploadsPost"
+	UziDeviceIDDeleteOperation                            OperationName = "UziDeviceIDDelete"
+	UziDeviceIDGetOperation                               OperationName = "UziDeviceIDGet"
+	UziDeviceIDPatchOperation                             OperationName = "UziDeviceIDPatch"
 	UziDevicePostOperation                                OperationName = "UziDevicePost"
 	UziDevicesGetOperation                                OperationName = "UziDevicesGet"
+	UziIDCheckPostOperation                               OperationName = "UziIDCheckPost"
 	UziIDDeleteOperation                                  OperationName = "UziIDDelete"
 	UziIDEchographicsGetOperation                         OperationName = "UziIDEchographicsGet"
 	UziIDEchographicsPatchOperation                       OperationName = "UziIDEchographicsPatch"
 	UziIDGetOperation                                     OperationName = "UziIDGet"
 	UziIDImagesGetOperation                               OperationName = "UziIDImagesGet"
+	UziIDNodesAcceptPostOperation                         OperationName = "UziIDNodesAcceptPost"
 	UziIDNodesGetOperation                                OperationName = "UziIDNodesGet"
 	UziIDNodesSegmentsPostOperation                       OperationName = "UziIDNodesSegmentsPost"
 	UziIDPatchOperation                                   OperationName = "UziIDPatch"
+	UziIDReportsGetOperation                              OperationName = "UziIDReportsGet"
+	UziIDReportsPostOperation                             OperationName = "UziIDReportsPost"
 	UziImageIDNodesSegmentsGetOperation                   OperationName = "UziImageIDNodesSegmentsGet"
 	UziNodesIDDeleteOperation                             OperationName = "UziNodesIDDelete"
+	UziNodesIDLinkDeleteOperation                         OperationName = "UziNodesIDLinkDelete"
+	UziNodesIDLinkPostOperation                           OperationName = "UziNodesIDLinkPost"
+	UziNodesIDLinkSuggestionsGetOperation                 OperationName = "UziNodesIDLinkSuggestionsGet"
 	UziNodesIDPatchOperation                              OperationName = "UziNodesIDPatch"
+	UziNodesIDSegmentDraftsGetOperation                   OperationName = "UziNodesIDSegmentDraftsGet"
 	UziNodesIDSegmentsGetOperation                        OperationName = "UziNodesIDSegmentsGet"
+	UziNodesIDSplitPostOperation                          OperationName = "UziNodesIDSplitPost"
+	UziNodesIDTiradsGetOperation                          OperationName = "UziNodesIDTiradsGet"
+	UziNodesIDTiradsPutOperation                          OperationName = "UziNodesIDTiradsPut"
+	UziNodesMergePostOperation                            OperationName = "UziNodesMergePost"
+	UziNodesReviewPostOperation                           OperationName = "UziNodesReviewPost"
 	UziPostOperation                                      OperationName = "UziPost"
+	UziSegmentDraftsIDAcceptPostOperation                 OperationName = "UziSegmentDraftsIDAcceptPost"
+	UziSegmentDraftsIDDeleteOperation                     OperationName = "UziSegmentDraftsIDDelete"
 	UziSegmentIDDeleteOperation                           OperationName = "UziSegmentIDDelete"
 	UziSegmentIDPatchOperation                            OperationName = "UziSegmentIDPatch"
+	UziSegmentIDPropagatePostOperation                    OperationName = "UziSegmentIDPropagatePost"
 	UziSegmentPostOperation                               OperationName = "UziSegmentPost"
+	UzisAnalyticsGetOperation                             OperationName = "UzisAnalyticsGet"
 	UzisAuthorIDGetOperation                              OperationName = "UzisAuthorIDGet"
 	UzisExternalIDGetOperation                            OperationName = "UzisExternalIDGet"
+	UzisExternalIDGrowthGetOperation                      OperationName = "UzisExternalIDGrowthGet"
+	UzisSearchGetOperation                                OperationName = "UzisSearchGet"
 	YookassaWebhooksPostOperation                         OperationName = "YookassaWebhooksPost"
 )
@@ -5,6 +5,7 @@ package api
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -311,6 +312,138 @@ func decodeCytologyPatientShotsReadParams(args [1]string, argsEscaped bool, r *h
 	return params, nil
 }
 
+// CytologyQuPathExportParams is parameters of CytologyQuPathExport operation.
+type CytologyQuPathExportParams struct {
+	// Id цитологического исследования.
+	ID uuid.UUID
+}
+
+func unpackCytologyQuPathExportParams(packed middleware.Parameters) (params CytologyQuPathExportParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeCytologyQuPathExportParams(args [1]string, argsEscaped bool, r *http.Request) (params CytologyQuPathExportParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CytologyQuPathImportParams is parameters of CytologyQuPathImport operation.
+type CytologyQuPathImportParams struct {
+	// Id версии исследования, из которой сделан экспорт.
+	ID uuid.UUID
+}
+
+func unpackCytologyQuPathImportParams(packed middleware.Parameters) (params CytologyQuPathImportParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeCytologyQuPathImportParams(args [1]string, argsEscaped bool, r *http.Request) (params CytologyQuPathImportParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // CytologyReadParams is parameters of CytologyRead operation.
 type CytologyReadParams struct {
 	// Id цитологического исследования.
@@ -1064,24 +1197,118 @@ func decodeCytologySegmentsListParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// CytologyUpdatePartialUpdateParams is parameters of CytologyUpdatePartialUpdate operation.
-type CytologyUpdatePartialUpdateParams struct {
+// CytologySegmentsViewportParams is parameters of CytologySegmentsViewport operation.
+type CytologySegmentsViewportParams struct {
 	// Id цитологического исследования.
-	ID string
+	ID        uuid.UUID
+	MinX      int
+	MinY      int
+	MaxX      int
+	MaxY      int
+	SegType   OptCytologySegmentsViewportSegType
+	GroupType OptCytologySegmentsViewportGroupType
+	IsAi      OptBool
+	// Пикселей нулевого уровня в пикселе экрана.
+	Downsample OptFloat64
+	Cursor     OptString
+	Limit      OptInt
 }
 
-func unpackCytologyUpdatePartialUpdateParams(packed middleware.Parameters) (params CytologyUpdatePartialUpdateParams) {
+func unpackCytologySegmentsViewportParams(packed middleware.Parameters) (params CytologySegmentsViewportParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "min_x",
+			In:   "query",
+		}
+		params.MinX = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "min_y",
+			In:   "query",
+		}
+		params.MinY = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "max_x",
+			In:   "query",
+		}
+		params.MaxX = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "max_y",
+			In:   "query",
+		}
+		params.MaxY = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "seg_type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SegType = v.(OptCytologySegmentsViewportSegType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "group_type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.GroupType = v.(OptCytologySegmentsViewportGroupType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "is_ai",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IsAi = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "downsample",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Downsample = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
 	}
 	return params
 }
 
-func decodeCytologyUpdatePartialUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params CytologyUpdatePartialUpdateParams, _ error) {
+func decodeCytologySegmentsViewportParams(args [1]string, argsEscaped bool, r *http.Request) (params CytologySegmentsViewportParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1106,7 +1333,7 @@ func decodeCytologyUpdatePartialUpdateParams(args [1]string, argsEscaped bool, r
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -1127,652 +1354,500 @@ func decodeCytologyUpdatePartialUpdateParams(args [1]string, argsEscaped bool, r
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// CytologyUpdateUpdateParams is parameters of CytologyUpdateUpdate operation.
-type CytologyUpdateUpdateParams struct {
-	// Id цитологического исследования.
-	ID string
-}
-
-func unpackCytologyUpdateUpdateParams(packed middleware.Parameters) (params CytologyUpdateUpdateParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeCytologyUpdateUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params CytologyUpdateUpdateParams, _ error) {
-	// Decode path: id.
+	// Decode query: min_x.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "min_x",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.MinX = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "min_x",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DownloadCytologyCytologyIDOriginalImageIDGetParams is parameters of GET /download/cytology/{cytology_id}/{original_image_id} operation.
-type DownloadCytologyCytologyIDOriginalImageIDGetParams struct {
-	// Id цитологического исследования.
-	CytologyID uuid.UUID
-	// Id оригинального изображения.
-	OriginalImageID uuid.UUID
-}
-
-func unpackDownloadCytologyCytologyIDOriginalImageIDGetParams(packed middleware.Parameters) (params DownloadCytologyCytologyIDOriginalImageIDGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "cytology_id",
-			In:   "path",
-		}
-		params.CytologyID = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "original_image_id",
-			In:   "path",
-		}
-		params.OriginalImageID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeDownloadCytologyCytologyIDOriginalImageIDGetParams(args [2]string, argsEscaped bool, r *http.Request) (params DownloadCytologyCytologyIDOriginalImageIDGetParams, _ error) {
-	// Decode path: cytology_id.
+	// Decode query: min_y.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "min_y",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "cytology_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.CytologyID = c
+				params.MinY = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "cytology_id",
-			In:   "path",
+			Name: "min_y",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: original_image_id.
+	// Decode query: max_x.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "max_x",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "original_image_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.OriginalImageID = c
+				params.MaxX = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "original_image_id",
-			In:   "path",
+			Name: "max_x",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DownloadUziIDImageIDGetParams is parameters of GET /download/{uzi_id}/{image_id} operation.
-type DownloadUziIDImageIDGetParams struct {
-	// Id узи.
-	UziID uuid.UUID
-	// Id кадра.
-	ImageID uuid.UUID
-}
-
-func unpackDownloadUziIDImageIDGetParams(packed middleware.Parameters) (params DownloadUziIDImageIDGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uzi_id",
-			In:   "path",
-		}
-		params.UziID = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "image_id",
-			In:   "path",
-		}
-		params.ImageID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeDownloadUziIDImageIDGetParams(args [2]string, argsEscaped bool, r *http.Request) (params DownloadUziIDImageIDGetParams, _ error) {
-	// Decode path: uzi_id.
+	// Decode query: max_y.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "max_y",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uzi_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.UziID = c
+				params.MaxY = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uzi_id",
-			In:   "path",
+			Name: "max_y",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: image_id.
+	// Decode query: seg_type.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "seg_type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "image_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSegTypeVal CytologySegmentsViewportSegType
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSegTypeVal = CytologySegmentsViewportSegType(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ImageID = c
+				params.SegType.SetTo(paramsDotSegTypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SegType.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "image_id",
-			In:   "path",
+			Name: "seg_type",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: group_type.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "group_type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// MedCardDoctorIDPatientIDGetParams is parameters of GET /med/card/{doctor_id}/{patient_id} operation.
-type MedCardDoctorIDPatientIDGetParams struct {
-	// Id врача.
-	DoctorID uuid.UUID
-	// Id пациента.
-	PatientID uuid.UUID
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotGroupTypeVal CytologySegmentsViewportGroupType
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackMedCardDoctorIDPatientIDGetParams(packed middleware.Parameters) (params MedCardDoctorIDPatientIDGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "doctor_id",
-			In:   "path",
-		}
-		params.DoctorID = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "patient_id",
-			In:   "path",
-		}
-		params.PatientID = packed[key].(uuid.UUID)
-	}
-	return params
-}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-func decodeMedCardDoctorIDPatientIDGetParams(args [2]string, argsEscaped bool, r *http.Request) (params MedCardDoctorIDPatientIDGetParams, _ error) {
-	// Decode path: doctor_id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "doctor_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
+					paramsDotGroupTypeVal = CytologySegmentsViewportGroupType(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
+				params.GroupType.SetTo(paramsDotGroupTypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.GroupType.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
 				}
-
-				params.DoctorID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "doctor_id",
-			In:   "path",
+			Name: "group_type",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: patient_id.
+	// Decode query: is_ai.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "is_ai",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "patient_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIsAiVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIsAiVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.PatientID = c
+				params.IsAi.SetTo(paramsDotIsAiVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "patient_id",
-			In:   "path",
+			Name: "is_ai",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// MedCardDoctorIDPatientIDPatchParams is parameters of PATCH /med/card/{doctor_id}/{patient_id} operation.
-type MedCardDoctorIDPatientIDPatchParams struct {
-	// Id врача.
-	DoctorID uuid.UUID
-	// Id пациента.
-	PatientID uuid.UUID
-}
-
-func unpackMedCardDoctorIDPatientIDPatchParams(packed middleware.Parameters) (params MedCardDoctorIDPatientIDPatchParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "doctor_id",
-			In:   "path",
-		}
-		params.DoctorID = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "patient_id",
-			In:   "path",
-		}
-		params.PatientID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeMedCardDoctorIDPatientIDPatchParams(args [2]string, argsEscaped bool, r *http.Request) (params MedCardDoctorIDPatientIDPatchParams, _ error) {
-	// Decode path: doctor_id.
+	// Decode query: downsample.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "downsample",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "doctor_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDownsampleVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDownsampleVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.DoctorID = c
+				params.Downsample.SetTo(paramsDotDownsampleVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Downsample.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    nil,
+						}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "doctor_id",
-			In:   "path",
+			Name: "downsample",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: patient_id.
+	// Decode query: cursor.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "patient_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.PatientID = c
+				params.Cursor.SetTo(paramsDotCursorVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "patient_id",
-			In:   "path",
+			Name: "cursor",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// MedDoctorIDGetParams is parameters of GET /med/doctor/{id} operation.
-type MedDoctorIDGetParams struct {
-	// Id врача.
-	ID uuid.UUID
-}
-
-func unpackMedDoctorIDGetParams(packed middleware.Parameters) (params MedDoctorIDGetParams) {
+	// Set default value for query: limit.
 	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
+		val := int(500)
+		params.Limit.SetTo(val)
 	}
-	return params
-}
-
-func decodeMedDoctorIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params MedDoctorIDGetParams, _ error) {
-	// Decode path: id.
+	// Decode query: limit.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ID = c
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// MedDoctorIDPatientsGetParams is parameters of GET /med/doctor/{id}/patients operation.
-type MedDoctorIDPatientsGetParams struct {
-	// Id врача.
-	ID uuid.UUID
-	// Фильтр по статусу пациента (true - активные, false -
-	// неактивные).
-	Status OptBool
+// CytologyUpdatePartialUpdateParams is parameters of CytologyUpdatePartialUpdate operation.
+type CytologyUpdatePartialUpdateParams struct {
+	// Id цитологического исследования.
+	ID string
 }
 
-func unpackMedDoctorIDPatientsGetParams(packed middleware.Parameters) (params MedDoctorIDPatientsGetParams) {
+func unpackCytologyUpdatePartialUpdateParams(packed middleware.Parameters) (params CytologyUpdatePartialUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "status",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Status = v.(OptBool)
-		}
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeMedDoctorIDPatientsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params MedDoctorIDPatientsGetParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeCytologyUpdatePartialUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params CytologyUpdatePartialUpdateParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1797,7 +1872,7 @@ func decodeMedDoctorIDPatientsGetParams(args [1]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -1818,68 +1893,27 @@ func decodeMedDoctorIDPatientsGetParams(args [1]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
-	// Decode query: status.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStatusVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Status.SetTo(paramsDotStatusVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
-			In:   "query",
-			Err:  err,
-		}
-	}
 	return params, nil
 }
 
-// MedPatientIDGetParams is parameters of GET /med/patient/{id} operation.
-type MedPatientIDGetParams struct {
-	// Id пациента.
-	ID uuid.UUID
+// CytologyUpdateUpdateParams is parameters of CytologyUpdateUpdate operation.
+type CytologyUpdateUpdateParams struct {
+	// Id цитологического исследования.
+	ID string
 }
 
-func unpackMedPatientIDGetParams(packed middleware.Parameters) (params MedPatientIDGetParams) {
+func unpackCytologyUpdateUpdateParams(packed middleware.Parameters) (params CytologyUpdateUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeMedPatientIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params MedPatientIDGetParams, _ error) {
+func decodeCytologyUpdateUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params CytologyUpdateUpdateParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1904,7 +1938,7 @@ func decodeMedPatientIDGetParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -1928,25 +1962,34 @@ func decodeMedPatientIDGetParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// MedPatientIDPatchParams is parameters of PATCH /med/patient/{id} operation.
-type MedPatientIDPatchParams struct {
-	// Id пациента.
-	ID uuid.UUID
+// DownloadCytologyCytologyIDOriginalImageIDGetParams is parameters of GET /download/cytology/{cytology_id}/{original_image_id} operation.
+type DownloadCytologyCytologyIDOriginalImageIDGetParams struct {
+	// Id цитологического исследования.
+	CytologyID uuid.UUID
+	// Id оригинального изображения.
+	OriginalImageID uuid.UUID
 }
 
-func unpackMedPatientIDPatchParams(packed middleware.Parameters) (params MedPatientIDPatchParams) {
+func unpackDownloadCytologyCytologyIDOriginalImageIDGetParams(packed middleware.Parameters) (params DownloadCytologyCytologyIDOriginalImageIDGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "cytology_id",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.CytologyID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_image_id",
+			In:   "path",
+		}
+		params.OriginalImageID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeMedPatientIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params MedPatientIDPatchParams, _ error) {
-	// Decode path: id.
+func decodeDownloadCytologyCytologyIDOriginalImageIDGetParams(args [2]string, argsEscaped bool, r *http.Request) (params DownloadCytologyCytologyIDOriginalImageIDGetParams, _ error) {
+	// Decode path: cytology_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1958,7 +2001,7 @@ func decodeMedPatientIDPatchParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "cytology_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1975,7 +2018,7 @@ func decodeMedPatientIDPatchParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.ID = c
+				params.CytologyID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1986,37 +2029,16 @@ func decodeMedPatientIDPatchParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "cytology_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// TariffPlansIDGetParams is parameters of GET /tariff_plans/{id} operation.
-type TariffPlansIDGetParams struct {
-	// Идентификатор тарифного плана.
-	ID uuid.UUID
-}
-
-func unpackTariffPlansIDGetParams(packed middleware.Parameters) (params TariffPlansIDGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeTariffPlansIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params TariffPlansIDGetParams, _ error) {
-	// Decode path: id.
+	// Decode path: original_image_id.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2024,7 +2046,7 @@ func decodeTariffPlansIDGetParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "original_image_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2041,7 +2063,7 @@ func decodeTariffPlansIDGetParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.ID = c
+				params.OriginalImageID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2052,7 +2074,7 @@ func decodeTariffPlansIDGetParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "original_image_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -2060,67 +2082,47 @@ func decodeTariffPlansIDGetParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// TilerDziFilePathFilesLevelColRowFormatGetParams is parameters of TilerDziFilePathFilesLevelColRowFormatGet operation.
-type TilerDziFilePathFilesLevelColRowFormatGetParams struct {
-	// Путь к файлу изображения в S3 хранилище.
-	// Формат пути из cytology: `{cytology_id}/{image_id}/{image_id}`
-	// Пример:
-	// `123e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174001/123e4567-e89b-12d3-a456-426614174001`
-	// Путь должен быть URL-encoded.
-	FilePath string
-	// Уровень масштабирования (zoom level).
-	// 0 - оригинальное разрешение изображения, каждый
-	// следующий уровень уменьшает разрешение в 2 раза.
-	Level int
-	// Номер колонки тайла (начинается с 0).
-	Col int
-	// Номер строки тайла (начинается с 0).
-	Row int
-	// Формат изображения тайла.
-	Format TilerDziFilePathFilesLevelColRowFormatGetFormat
-}
-
-func unpackTilerDziFilePathFilesLevelColRowFormatGetParams(packed middleware.Parameters) (params TilerDziFilePathFilesLevelColRowFormatGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "file_path",
-			In:   "path",
-		}
-		params.FilePath = packed[key].(string)
-	}
+// DownloadUziIDImageIDGetParams is parameters of GET /download/{uzi_id}/{image_id} operation.
+type DownloadUziIDImageIDGetParams struct {
+	// Id узи.
+	UziID uuid.UUID
+	// Id кадра.
+	ImageID uuid.UUID
+	// Размер кадра, если превью еще не созданы - отдается
+	// исходный кадр.
+	Size OptImageSize
+}
+
+func unpackDownloadUziIDImageIDGetParams(packed middleware.Parameters) (params DownloadUziIDImageIDGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "level",
+			Name: "uzi_id",
 			In:   "path",
 		}
-		params.Level = packed[key].(int)
+		params.UziID = packed[key].(uuid.UUID)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "col",
+			Name: "image_id",
 			In:   "path",
 		}
-		params.Col = packed[key].(int)
+		params.ImageID = packed[key].(uuid.UUID)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "row",
-			In:   "path",
+			Name: "size",
+			In:   "query",
 		}
-		params.Row = packed[key].(int)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "format",
-			In:   "path",
+		if v, ok := packed[key]; ok {
+			params.Size = v.(OptImageSize)
 		}
-		params.Format = packed[key].(TilerDziFilePathFilesLevelColRowFormatGetFormat)
 	}
 	return params
 }
 
-func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsEscaped bool, r *http.Request) (params TilerDziFilePathFilesLevelColRowFormatGetParams, _ error) {
-	// Decode path: file_path.
+func decodeDownloadUziIDImageIDGetParams(args [2]string, argsEscaped bool, r *http.Request) (params DownloadUziIDImageIDGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: uzi_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2132,7 +2134,7 @@ func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsE
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "file_path",
+				Param:   "uzi_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2144,12 +2146,12 @@ func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsE
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.FilePath = c
+				params.UziID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2160,12 +2162,12 @@ func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsE
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "file_path",
+			Name: "uzi_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: level.
+	// Decode path: image_id.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -2177,7 +2179,7 @@ func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsE
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "level",
+				Param:   "image_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2189,29 +2191,12 @@ func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsE
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Level = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           0,
-					MaxSet:        false,
-					Max:           0,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.Level)); err != nil {
-					return errors.Wrap(err, "int")
-				}
+				params.ImageID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2222,78 +2207,115 @@ func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsE
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "level",
+			Name: "image_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: col.
+	// Decode query: size.
 	if err := func() error {
-		param := args[2]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "col",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSizeVal ImageSize
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSizeVal = ImageSize(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Col = c
+				params.Size.SetTo(paramsDotSizeVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           0,
-					MaxSet:        false,
-					Max:           0,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.Col)); err != nil {
-					return errors.Wrap(err, "int")
+				if value, ok := params.Size.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "col",
-			In:   "path",
+			Name: "size",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: row.
+	return params, nil
+}
+
+// DownloadUziUziIDReportGetParams is parameters of GET /download/uzi/{uzi_id}/report operation.
+type DownloadUziUziIDReportGetParams struct {
+	// Id узи.
+	UziID uuid.UUID
+	// Версия заключения, по умолчанию последняя.
+	Version OptInt
+	Format  OptDownloadUziUziIDReportGetFormat
+}
+
+func unpackDownloadUziUziIDReportGetParams(packed middleware.Parameters) (params DownloadUziUziIDReportGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uzi_id",
+			In:   "path",
+		}
+		params.UziID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "version",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Version = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptDownloadUziUziIDReportGetFormat)
+		}
+	}
+	return params
+}
+
+func decodeDownloadUziUziIDReportGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DownloadUziUziIDReportGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: uzi_id.
 	if err := func() error {
-		param := args[3]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[3])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2301,7 +2323,7 @@ func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsE
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "row",
+				Param:   "uzi_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2313,29 +2335,12 @@ func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsE
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Row = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           0,
-					MaxSet:        false,
-					Max:           0,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.Row)); err != nil {
-					return errors.Wrap(err, "int")
-				}
+				params.UziID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2346,90 +2351,168 @@ func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsE
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "row",
+			Name: "uzi_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: format.
+	// Decode query: version.
 	if err := func() error {
-		param := args[4]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[4])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "version",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "format",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotVersionVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
 
-				params.Format = TilerDziFilePathFilesLevelColRowFormatGetFormat(c)
-				return nil
-			}(); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := params.Format.Validate(); err != nil {
+					paramsDotVersionVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.Version.SetTo(paramsDotVersionVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Version.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "version",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: format.
+	{
+		val := DownloadUziUziIDReportGetFormat("pdf")
+		params.Format.SetTo(val)
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal DownloadUziUziIDReportGetFormat
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = DownloadUziUziIDReportGetFormat(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
 			Name: "format",
-			In:   "path",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// TilerDziFilePathGetParams is parameters of TilerDziFilePathGet operation.
-type TilerDziFilePathGetParams struct {
-	// Путь к файлу изображения в S3 хранилище.
-	// Формат пути из cytology: `{cytology_id}/{image_id}/{image_id}`
-	// Пример:
-	// `123e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174001/123e4567-e89b-12d3-a456-426614174001`
-	// Путь должен быть URL-encoded.
-	FilePath string
+// MedCardDoctorIDPatientIDGetParams is parameters of GET /med/card/{doctor_id}/{patient_id} operation.
+type MedCardDoctorIDPatientIDGetParams struct {
+	// Id врача.
+	DoctorID uuid.UUID
+	// Id пациента.
+	PatientID uuid.UUID
 }
 
-func unpackTilerDziFilePathGetParams(packed middleware.Parameters) (params TilerDziFilePathGetParams) {
+func unpackMedCardDoctorIDPatientIDGetParams(packed middleware.Parameters) (params MedCardDoctorIDPatientIDGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "file_path",
+			Name: "doctor_id",
 			In:   "path",
 		}
-		params.FilePath = packed[key].(string)
+		params.DoctorID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "patient_id",
+			In:   "path",
+		}
+		params.PatientID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeTilerDziFilePathGetParams(args [1]string, argsEscaped bool, r *http.Request) (params TilerDziFilePathGetParams, _ error) {
-	// Decode path: file_path.
+func decodeMedCardDoctorIDPatientIDGetParams(args [2]string, argsEscaped bool, r *http.Request) (params MedCardDoctorIDPatientIDGetParams, _ error) {
+	// Decode path: doctor_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2441,7 +2524,7 @@ func decodeTilerDziFilePathGetParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "file_path",
+				Param:   "doctor_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2453,12 +2536,12 @@ func decodeTilerDziFilePathGetParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.FilePath = c
+				params.DoctorID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2469,37 +2552,16 @@ func decodeTilerDziFilePathGetParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "file_path",
+			Name: "doctor_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// UziIDDeleteParams is parameters of DELETE /uzi/{id} operation.
-type UziIDDeleteParams struct {
-	// Id узи.
-	ID uuid.UUID
-}
-
-func unpackUziIDDeleteParams(packed middleware.Parameters) (params UziIDDeleteParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeUziIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDDeleteParams, _ error) {
-	// Decode path: id.
+	// Decode path: patient_id.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2507,7 +2569,7 @@ func decodeUziIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "patient_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2524,7 +2586,7 @@ func decodeUziIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.ID = c
+				params.PatientID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2535,7 +2597,7 @@ func decodeUziIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "patient_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -2543,25 +2605,34 @@ func decodeUziIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// UziIDEchographicsGetParams is parameters of GET /uzi/{id}/echographics operation.
-type UziIDEchographicsGetParams struct {
-	// Id узи.
-	ID uuid.UUID
+// MedCardDoctorIDPatientIDPatchParams is parameters of PATCH /med/card/{doctor_id}/{patient_id} operation.
+type MedCardDoctorIDPatientIDPatchParams struct {
+	// Id врача.
+	DoctorID uuid.UUID
+	// Id пациента.
+	PatientID uuid.UUID
 }
 
-func unpackUziIDEchographicsGetParams(packed middleware.Parameters) (params UziIDEchographicsGetParams) {
+func unpackMedCardDoctorIDPatientIDPatchParams(packed middleware.Parameters) (params MedCardDoctorIDPatientIDPatchParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "doctor_id",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.DoctorID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "patient_id",
+			In:   "path",
+		}
+		params.PatientID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeUziIDEchographicsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDEchographicsGetParams, _ error) {
-	// Decode path: id.
+func decodeMedCardDoctorIDPatientIDPatchParams(args [2]string, argsEscaped bool, r *http.Request) (params MedCardDoctorIDPatientIDPatchParams, _ error) {
+	// Decode path: doctor_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2573,7 +2644,7 @@ func decodeUziIDEchographicsGetParams(args [1]string, argsEscaped bool, r *http.
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "doctor_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2590,7 +2661,7 @@ func decodeUziIDEchographicsGetParams(args [1]string, argsEscaped bool, r *http.
 					return err
 				}
 
-				params.ID = c
+				params.DoctorID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2601,37 +2672,16 @@ func decodeUziIDEchographicsGetParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "doctor_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// UziIDEchographicsPatchParams is parameters of PATCH /uzi/{id}/echographics operation.
-type UziIDEchographicsPatchParams struct {
-	// Id узи.
-	ID uuid.UUID
-}
-
-func unpackUziIDEchographicsPatchParams(packed middleware.Parameters) (params UziIDEchographicsPatchParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeUziIDEchographicsPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDEchographicsPatchParams, _ error) {
-	// Decode path: id.
+	// Decode path: patient_id.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2639,7 +2689,7 @@ func decodeUziIDEchographicsPatchParams(args [1]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "patient_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2656,7 +2706,7 @@ func decodeUziIDEchographicsPatchParams(args [1]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.ID = c
+				params.PatientID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2667,7 +2717,7 @@ func decodeUziIDEchographicsPatchParams(args [1]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "patient_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -2675,13 +2725,13 @@ func decodeUziIDEchographicsPatchParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// UziIDGetParams is parameters of GET /uzi/{id} operation.
-type UziIDGetParams struct {
-	// Id узи.
+// MedDoctorIDGetParams is parameters of GET /med/doctor/{id} operation.
+type MedDoctorIDGetParams struct {
+	// Id врача.
 	ID uuid.UUID
 }
 
-func unpackUziIDGetParams(packed middleware.Parameters) (params UziIDGetParams) {
+func unpackMedDoctorIDGetParams(packed middleware.Parameters) (params MedDoctorIDGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2692,7 +2742,7 @@ func unpackUziIDGetParams(packed middleware.Parameters) (params UziIDGetParams)
 	return params
 }
 
-func decodeUziIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDGetParams, _ error) {
+func decodeMedDoctorIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params MedDoctorIDGetParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2741,13 +2791,16 @@ func decodeUziIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// UziIDImagesGetParams is parameters of GET /uzi/{id}/images operation.
-type UziIDImagesGetParams struct {
-	// Id узи.
+// MedDoctorIDPatientsGetParams is parameters of GET /med/doctor/{id}/patients operation.
+type MedDoctorIDPatientsGetParams struct {
+	// Id врача.
 	ID uuid.UUID
+	// Фильтр по статусу пациента (true - активные, false -
+	// неактивные).
+	Status OptBool
 }
 
-func unpackUziIDImagesGetParams(packed middleware.Parameters) (params UziIDImagesGetParams) {
+func unpackMedDoctorIDPatientsGetParams(packed middleware.Parameters) (params MedDoctorIDPatientsGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2755,10 +2808,20 @@ func unpackUziIDImagesGetParams(packed middleware.Parameters) (params UziIDImage
 		}
 		params.ID = packed[key].(uuid.UUID)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptBool)
+		}
+	}
 	return params
 }
 
-func decodeUziIDImagesGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDImagesGetParams, _ error) {
+func decodeMedDoctorIDPatientsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params MedDoctorIDPatientsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2804,82 +2867,57 @@ func decodeUziIDImagesGetParams(args [1]string, argsEscaped bool, r *http.Reques
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// UziIDNodesGetParams is parameters of GET /uzi/{id}/nodes operation.
-type UziIDNodesGetParams struct {
-	// Uzi_id.
-	ID uuid.UUID
-}
-
-func unpackUziIDNodesGetParams(packed middleware.Parameters) (params UziIDNodesGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeUziIDNodesGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDNodesGetParams, _ error) {
-	// Decode path: id.
+	// Decode query: status.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ID = c
+				params.Status.SetTo(paramsDotStatusVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "status",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// UziIDNodesSegmentsPostParams is parameters of POST /uzi/{id}/nodes-segments operation.
-type UziIDNodesSegmentsPostParams struct {
-	// Id узи.
+// MedPatientIDGetParams is parameters of GET /med/patient/{id} operation.
+type MedPatientIDGetParams struct {
+	// Id пациента.
 	ID uuid.UUID
 }
 
-func unpackUziIDNodesSegmentsPostParams(packed middleware.Parameters) (params UziIDNodesSegmentsPostParams) {
+func unpackMedPatientIDGetParams(packed middleware.Parameters) (params MedPatientIDGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2890,7 +2928,7 @@ func unpackUziIDNodesSegmentsPostParams(packed middleware.Parameters) (params Uz
 	return params
 }
 
-func decodeUziIDNodesSegmentsPostParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDNodesSegmentsPostParams, _ error) {
+func decodeMedPatientIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params MedPatientIDGetParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2939,13 +2977,13 @@ func decodeUziIDNodesSegmentsPostParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// UziIDPatchParams is parameters of PATCH /uzi/{id} operation.
-type UziIDPatchParams struct {
-	// Id узи.
+// MedPatientIDPatchParams is parameters of PATCH /med/patient/{id} operation.
+type MedPatientIDPatchParams struct {
+	// Id пациента.
 	ID uuid.UUID
 }
 
-func unpackUziIDPatchParams(packed middleware.Parameters) (params UziIDPatchParams) {
+func unpackMedPatientIDPatchParams(packed middleware.Parameters) (params MedPatientIDPatchParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2956,7 +2994,7 @@ func unpackUziIDPatchParams(packed middleware.Parameters) (params UziIDPatchPara
 	return params
 }
 
-func decodeUziIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDPatchParams, _ error) {
+func decodeMedPatientIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params MedPatientIDPatchParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3005,13 +3043,13 @@ func decodeUziIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// UziImageIDNodesSegmentsGetParams is parameters of GET /uzi/image/{id}/nodes-segments operation.
-type UziImageIDNodesSegmentsGetParams struct {
-	// Id изображения.
+// TariffPlansIDGetParams is parameters of GET /tariff_plans/{id} operation.
+type TariffPlansIDGetParams struct {
+	// Идентификатор тарифного плана.
 	ID uuid.UUID
 }
 
-func unpackUziImageIDNodesSegmentsGetParams(packed middleware.Parameters) (params UziImageIDNodesSegmentsGetParams) {
+func unpackTariffPlansIDGetParams(packed middleware.Parameters) (params TariffPlansIDGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -3022,7 +3060,7 @@ func unpackUziImageIDNodesSegmentsGetParams(packed middleware.Parameters) (param
 	return params
 }
 
-func decodeUziImageIDNodesSegmentsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziImageIDNodesSegmentsGetParams, _ error) {
+func decodeTariffPlansIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params TariffPlansIDGetParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3071,24 +3109,67 @@ func decodeUziImageIDNodesSegmentsGetParams(args [1]string, argsEscaped bool, r
 	return params, nil
 }
 
-// UziNodesIDDeleteParams is parameters of DELETE /uzi/nodes/{id} operation.
-type UziNodesIDDeleteParams struct {
-	ID uuid.UUID
+// TilerDziFilePathFilesLevelColRowFormatGetParams is parameters of TilerDziFilePathFilesLevelColRowFormatGet operation.
+type TilerDziFilePathFilesLevelColRowFormatGetParams struct {
+	// Путь к файлу изображения в S3 хранилище.
+	// Формат пути из cytology: `{cytology_id}/{image_id}/{image_id}`
+	// Пример:
+	// `123e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174001/123e4567-e89b-12d3-a456-426614174001`
+	// Путь должен быть URL-encoded.
+	FilePath string
+	// Уровень масштабирования (zoom level).
+	// 0 - оригинальное разрешение изображения, каждый
+	// следующий уровень уменьшает разрешение в 2 раза.
+	Level int
+	// Номер колонки тайла (начинается с 0).
+	Col int
+	// Номер строки тайла (начинается с 0).
+	Row int
+	// Формат изображения тайла.
+	Format TilerDziFilePathFilesLevelColRowFormatGetFormat
 }
 
-func unpackUziNodesIDDeleteParams(packed middleware.Parameters) (params UziNodesIDDeleteParams) {
+func unpackTilerDziFilePathFilesLevelColRowFormatGetParams(packed middleware.Parameters) (params TilerDziFilePathFilesLevelColRowFormatGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "file_path",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.FilePath = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "level",
+			In:   "path",
+		}
+		params.Level = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "col",
+			In:   "path",
+		}
+		params.Col = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "row",
+			In:   "path",
+		}
+		params.Row = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "path",
+		}
+		params.Format = packed[key].(TilerDziFilePathFilesLevelColRowFormatGetFormat)
 	}
 	return params
 }
 
-func decodeUziNodesIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDDeleteParams, _ error) {
-	// Decode path: id.
+func decodeTilerDziFilePathFilesLevelColRowFormatGetParams(args [5]string, argsEscaped bool, r *http.Request) (params TilerDziFilePathFilesLevelColRowFormatGetParams, _ error) {
+	// Decode path: file_path.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3100,7 +3181,7 @@ func decodeUziNodesIDDeleteParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "file_path",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3112,12 +3193,12 @@ func decodeUziNodesIDDeleteParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.FilePath = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3128,37 +3209,16 @@ func decodeUziNodesIDDeleteParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "file_path",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// UziNodesIDPatchParams is parameters of PATCH /uzi/nodes/{id} operation.
-type UziNodesIDPatchParams struct {
-	// Id узла.
-	ID uuid.UUID
-}
-
-func unpackUziNodesIDPatchParams(packed middleware.Parameters) (params UziNodesIDPatchParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeUziNodesIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDPatchParams, _ error) {
-	// Decode path: id.
+	// Decode path: level.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -3166,7 +3226,7 @@ func decodeUziNodesIDPatchParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "level",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3178,12 +3238,29 @@ func decodeUziNodesIDPatchParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.Level = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           0,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Level)); err != nil {
+					return errors.Wrap(err, "int")
+				}
 				return nil
 			}(); err != nil {
 				return err
@@ -3194,37 +3271,16 @@ func decodeUziNodesIDPatchParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "level",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// UziNodesIDSegmentsGetParams is parameters of GET /uzi/nodes/{id}/segments operation.
-type UziNodesIDSegmentsGetParams struct {
-	// Id узла.
-	ID uuid.UUID
-}
-
-func unpackUziNodesIDSegmentsGetParams(packed middleware.Parameters) (params UziNodesIDSegmentsGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeUziNodesIDSegmentsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDSegmentsGetParams, _ error) {
-	// Decode path: id.
+	// Decode path: col.
 	if err := func() error {
-		param := args[0]
+		param := args[2]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[2])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -3232,7 +3288,7 @@ func decodeUziNodesIDSegmentsGetParams(args [1]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "col",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3244,23 +3300,155 @@ func decodeUziNodesIDSegmentsGetParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.Col = c
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           0,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Col)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "col",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: row.
+	if err := func() error {
+		param := args[3]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[3])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "row",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Row = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           0,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Row)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "row",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: format.
+	if err := func() error {
+		param := args[4]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[4])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "format",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Format = TilerDziFilePathFilesLevelColRowFormatGetFormat(c)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Format.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
 			In:   "path",
 			Err:  err,
 		}
@@ -3268,12 +3456,83 @@ func decodeUziNodesIDSegmentsGetParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// UziSegmentIDDeleteParams is parameters of DELETE /uzi/segment/{id} operation.
-type UziSegmentIDDeleteParams struct {
+// TilerDziFilePathGetParams is parameters of TilerDziFilePathGet operation.
+type TilerDziFilePathGetParams struct {
+	// Путь к файлу изображения в S3 хранилище.
+	// Формат пути из cytology: `{cytology_id}/{image_id}/{image_id}`
+	// Пример:
+	// `123e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174001/123e4567-e89b-12d3-a456-426614174001`
+	// Путь должен быть URL-encoded.
+	FilePath string
+}
+
+func unpackTilerDziFilePathGetParams(packed middleware.Parameters) (params TilerDziFilePathGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "file_path",
+			In:   "path",
+		}
+		params.FilePath = packed[key].(string)
+	}
+	return params
+}
+
+func decodeTilerDziFilePathGetParams(args [1]string, argsEscaped bool, r *http.Request) (params TilerDziFilePathGetParams, _ error) {
+	// Decode path: file_path.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "file_path",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.FilePath = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "file_path",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UploadsIDCompletePostParams is parameters of POST /uploads/{id}/complete operation.
+type UploadsIDCompletePostParams struct {
+	// Id загрузки.
 	ID uuid.UUID
 }
 
-func unpackUziSegmentIDDeleteParams(packed middleware.Parameters) (params UziSegmentIDDeleteParams) {
+func unpackUploadsIDCompletePostParams(packed middleware.Parameters) (params UploadsIDCompletePostParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -3284,7 +3543,7 @@ func unpackUziSegmentIDDeleteParams(packed middleware.Parameters) (params UziSeg
 	return params
 }
 
-func decodeUziSegmentIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UziSegmentIDDeleteParams, _ error) {
+func decodeUploadsIDCompletePostParams(args [1]string, argsEscaped bool, r *http.Request) (params UploadsIDCompletePostParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3333,13 +3592,13 @@ func decodeUziSegmentIDDeleteParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// UziSegmentIDPatchParams is parameters of PATCH /uzi/segment/{id} operation.
-type UziSegmentIDPatchParams struct {
-	// Id сегмента.
+// UploadsIDDeleteParams is parameters of DELETE /uploads/{id} operation.
+type UploadsIDDeleteParams struct {
+	// Id загрузки.
 	ID uuid.UUID
 }
 
-func unpackUziSegmentIDPatchParams(packed middleware.Parameters) (params UziSegmentIDPatchParams) {
+func unpackUploadsIDDeleteParams(packed middleware.Parameters) (params UploadsIDDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -3350,7 +3609,7 @@ func unpackUziSegmentIDPatchParams(packed middleware.Parameters) (params UziSegm
 	return params
 }
 
-func decodeUziSegmentIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params UziSegmentIDPatchParams, _ error) {
+func decodeUploadsIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UploadsIDDeleteParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3399,13 +3658,13 @@ func decodeUziSegmentIDPatchParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// UzisAuthorIDGetParams is parameters of GET /uzis/author/{id} operation.
-type UzisAuthorIDGetParams struct {
-	// Id аккаунта, загрузившего узи.
+// UploadsIDGetParams is parameters of GET /uploads/{id} operation.
+type UploadsIDGetParams struct {
+	// Id загрузки.
 	ID uuid.UUID
 }
 
-func unpackUzisAuthorIDGetParams(packed middleware.Parameters) (params UzisAuthorIDGetParams) {
+func unpackUploadsIDGetParams(packed middleware.Parameters) (params UploadsIDGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -3416,7 +3675,7 @@ func unpackUzisAuthorIDGetParams(packed middleware.Parameters) (params UzisAutho
 	return params
 }
 
-func decodeUzisAuthorIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UzisAuthorIDGetParams, _ error) {
+func decodeUploadsIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UploadsIDGetParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3465,13 +3724,13 @@ func decodeUzisAuthorIDGetParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// UzisExternalIDGetParams is parameters of GET /uzis/external/{id} operation.
-type UzisExternalIDGetParams struct {
-	// Внешний id пациента/организации etc.
+// UploadsIDPartsPostParams is parameters of POST /uploads/{id}/parts operation.
+type UploadsIDPartsPostParams struct {
+	// Id загрузки.
 	ID uuid.UUID
 }
 
-func unpackUzisExternalIDGetParams(packed middleware.Parameters) (params UzisExternalIDGetParams) {
+func unpackUploadsIDPartsPostParams(packed middleware.Parameters) (params UploadsIDPartsPostParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -3482,7 +3741,7 @@ func unpackUzisExternalIDGetParams(packed middleware.Parameters) (params UzisExt
 	return params
 }
 
-func decodeUzisExternalIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UzisExternalIDGetParams, _ error) {
+func decodeUploadsIDPartsPostParams(args [1]string, argsEscaped bool, r *http.Request) (params UploadsIDPartsPostParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3530,3 +3789,3427 @@ func decodeUzisExternalIDGetParams(args [1]string, argsEscaped bool, r *http.Req
 	}
 	return params, nil
 }
+
+// UziDeviceIDDeleteParams is parameters of DELETE /uzi/device/{id} operation.
+type UziDeviceIDDeleteParams struct {
+	// Id uzi аппарата.
+	ID int
+}
+
+func unpackUziDeviceIDDeleteParams(packed middleware.Parameters) (params UziDeviceIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeUziDeviceIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UziDeviceIDDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziDeviceIDGetParams is parameters of GET /uzi/device/{id} operation.
+type UziDeviceIDGetParams struct {
+	// Id uzi аппарата.
+	ID int
+}
+
+func unpackUziDeviceIDGetParams(packed middleware.Parameters) (params UziDeviceIDGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeUziDeviceIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziDeviceIDGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziDeviceIDPatchParams is parameters of PATCH /uzi/device/{id} operation.
+type UziDeviceIDPatchParams struct {
+	// Id uzi аппарата.
+	ID int
+}
+
+func unpackUziDeviceIDPatchParams(packed middleware.Parameters) (params UziDeviceIDPatchParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeUziDeviceIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params UziDeviceIDPatchParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDCheckPostParams is parameters of POST /uzi/{id}/check operation.
+type UziIDCheckPostParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDCheckPostParams(packed middleware.Parameters) (params UziIDCheckPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDCheckPostParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDCheckPostParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDDeleteParams is parameters of DELETE /uzi/{id} operation.
+type UziIDDeleteParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDDeleteParams(packed middleware.Parameters) (params UziIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDEchographicsGetParams is parameters of GET /uzi/{id}/echographics operation.
+type UziIDEchographicsGetParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDEchographicsGetParams(packed middleware.Parameters) (params UziIDEchographicsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDEchographicsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDEchographicsGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDEchographicsPatchParams is parameters of PATCH /uzi/{id}/echographics operation.
+type UziIDEchographicsPatchParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDEchographicsPatchParams(packed middleware.Parameters) (params UziIDEchographicsPatchParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDEchographicsPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDEchographicsPatchParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDGetParams is parameters of GET /uzi/{id} operation.
+type UziIDGetParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDGetParams(packed middleware.Parameters) (params UziIDGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDImagesGetParams is parameters of GET /uzi/{id}/images operation.
+type UziIDImagesGetParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDImagesGetParams(packed middleware.Parameters) (params UziIDImagesGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDImagesGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDImagesGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDNodesAcceptPostParams is parameters of POST /uzi/{id}/nodes/accept operation.
+type UziIDNodesAcceptPostParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDNodesAcceptPostParams(packed middleware.Parameters) (params UziIDNodesAcceptPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDNodesAcceptPostParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDNodesAcceptPostParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDNodesGetParams is parameters of GET /uzi/{id}/nodes operation.
+type UziIDNodesGetParams struct {
+	// Uzi_id.
+	ID uuid.UUID
+}
+
+func unpackUziIDNodesGetParams(packed middleware.Parameters) (params UziIDNodesGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDNodesGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDNodesGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDNodesSegmentsPostParams is parameters of POST /uzi/{id}/nodes-segments operation.
+type UziIDNodesSegmentsPostParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDNodesSegmentsPostParams(packed middleware.Parameters) (params UziIDNodesSegmentsPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDNodesSegmentsPostParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDNodesSegmentsPostParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDPatchParams is parameters of PATCH /uzi/{id} operation.
+type UziIDPatchParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDPatchParams(packed middleware.Parameters) (params UziIDPatchParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDPatchParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDReportsGetParams is parameters of GET /uzi/{id}/reports operation.
+type UziIDReportsGetParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDReportsGetParams(packed middleware.Parameters) (params UziIDReportsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDReportsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDReportsGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziIDReportsPostParams is parameters of POST /uzi/{id}/reports operation.
+type UziIDReportsPostParams struct {
+	// Id узи.
+	ID uuid.UUID
+}
+
+func unpackUziIDReportsPostParams(packed middleware.Parameters) (params UziIDReportsPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziIDReportsPostParams(args [1]string, argsEscaped bool, r *http.Request) (params UziIDReportsPostParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziImageIDNodesSegmentsGetParams is parameters of GET /uzi/image/{id}/nodes-segments operation.
+type UziImageIDNodesSegmentsGetParams struct {
+	// Id изображения.
+	ID uuid.UUID
+}
+
+func unpackUziImageIDNodesSegmentsGetParams(packed middleware.Parameters) (params UziImageIDNodesSegmentsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziImageIDNodesSegmentsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziImageIDNodesSegmentsGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDDeleteParams is parameters of DELETE /uzi/nodes/{id} operation.
+type UziNodesIDDeleteParams struct {
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDDeleteParams(packed middleware.Parameters) (params UziNodesIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDLinkDeleteParams is parameters of DELETE /uzi/nodes/{id}/link operation.
+type UziNodesIDLinkDeleteParams struct {
+	// Id узла.
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDLinkDeleteParams(packed middleware.Parameters) (params UziNodesIDLinkDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDLinkDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDLinkDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDLinkPostParams is parameters of POST /uzi/nodes/{id}/link operation.
+type UziNodesIDLinkPostParams struct {
+	// Id узла.
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDLinkPostParams(packed middleware.Parameters) (params UziNodesIDLinkPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDLinkPostParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDLinkPostParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDLinkSuggestionsGetParams is parameters of GET /uzi/nodes/{id}/link/suggestions operation.
+type UziNodesIDLinkSuggestionsGetParams struct {
+	// Id узла.
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDLinkSuggestionsGetParams(packed middleware.Parameters) (params UziNodesIDLinkSuggestionsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDLinkSuggestionsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDLinkSuggestionsGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDPatchParams is parameters of PATCH /uzi/nodes/{id} operation.
+type UziNodesIDPatchParams struct {
+	// Id узла.
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDPatchParams(packed middleware.Parameters) (params UziNodesIDPatchParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDPatchParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDSegmentDraftsGetParams is parameters of GET /uzi/nodes/{id}/segment-drafts operation.
+type UziNodesIDSegmentDraftsGetParams struct {
+	// Id узла.
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDSegmentDraftsGetParams(packed middleware.Parameters) (params UziNodesIDSegmentDraftsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDSegmentDraftsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDSegmentDraftsGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDSegmentsGetParams is parameters of GET /uzi/nodes/{id}/segments operation.
+type UziNodesIDSegmentsGetParams struct {
+	// Id узла.
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDSegmentsGetParams(packed middleware.Parameters) (params UziNodesIDSegmentsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDSegmentsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDSegmentsGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDSplitPostParams is parameters of POST /uzi/nodes/{id}/split operation.
+type UziNodesIDSplitPostParams struct {
+	// Id узла.
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDSplitPostParams(packed middleware.Parameters) (params UziNodesIDSplitPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDSplitPostParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDSplitPostParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDTiradsGetParams is parameters of GET /uzi/nodes/{id}/tirads operation.
+type UziNodesIDTiradsGetParams struct {
+	// Id узла.
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDTiradsGetParams(packed middleware.Parameters) (params UziNodesIDTiradsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDTiradsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDTiradsGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziNodesIDTiradsPutParams is parameters of PUT /uzi/nodes/{id}/tirads operation.
+type UziNodesIDTiradsPutParams struct {
+	// Id узла.
+	ID uuid.UUID
+}
+
+func unpackUziNodesIDTiradsPutParams(packed middleware.Parameters) (params UziNodesIDTiradsPutParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziNodesIDTiradsPutParams(args [1]string, argsEscaped bool, r *http.Request) (params UziNodesIDTiradsPutParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziSegmentDraftsIDAcceptPostParams is parameters of POST /uzi/segment-drafts/{id}/accept operation.
+type UziSegmentDraftsIDAcceptPostParams struct {
+	// Id черновика.
+	ID uuid.UUID
+}
+
+func unpackUziSegmentDraftsIDAcceptPostParams(packed middleware.Parameters) (params UziSegmentDraftsIDAcceptPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziSegmentDraftsIDAcceptPostParams(args [1]string, argsEscaped bool, r *http.Request) (params UziSegmentDraftsIDAcceptPostParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziSegmentDraftsIDDeleteParams is parameters of DELETE /uzi/segment-drafts/{id} operation.
+type UziSegmentDraftsIDDeleteParams struct {
+	// Id черновика.
+	ID uuid.UUID
+}
+
+func unpackUziSegmentDraftsIDDeleteParams(packed middleware.Parameters) (params UziSegmentDraftsIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziSegmentDraftsIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UziSegmentDraftsIDDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziSegmentIDDeleteParams is parameters of DELETE /uzi/segment/{id} operation.
+type UziSegmentIDDeleteParams struct {
+	ID uuid.UUID
+}
+
+func unpackUziSegmentIDDeleteParams(packed middleware.Parameters) (params UziSegmentIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziSegmentIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params UziSegmentIDDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziSegmentIDPatchParams is parameters of PATCH /uzi/segment/{id} operation.
+type UziSegmentIDPatchParams struct {
+	// Id сегмента.
+	ID uuid.UUID
+}
+
+func unpackUziSegmentIDPatchParams(packed middleware.Parameters) (params UziSegmentIDPatchParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUziSegmentIDPatchParams(args [1]string, argsEscaped bool, r *http.Request) (params UziSegmentIDPatchParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UziSegmentIDPropagatePostParams is parameters of POST /uzi/segment/{id}/propagate operation.
+type UziSegmentIDPropagatePostParams struct {
+	// Id сегмента.
+	ID uuid.UUID
+	// Сколько кадров в каждую сторону.
+	Depth OptInt
+}
+
+func unpackUziSegmentIDPropagatePostParams(packed middleware.Parameters) (params UziSegmentIDPropagatePostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "depth",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Depth = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeUziSegmentIDPropagatePostParams(args [1]string, argsEscaped bool, r *http.Request) (params UziSegmentIDPropagatePostParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: depth.
+	{
+		val := int(1)
+		params.Depth.SetTo(val)
+	}
+	// Decode query: depth.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "depth",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDepthVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDepthVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Depth.SetTo(paramsDotDepthVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Depth.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           10,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "depth",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UzisAnalyticsGetParams is parameters of GET /uzis/analytics operation.
+type UzisAnalyticsGetParams struct {
+	DeviceID OptInt
+	AuthorID OptUUID
+	// Дата создания узи от, включительно.
+	CreateFrom OptDateTime
+	// Дата создания узи до, включительно.
+	CreateTo OptDateTime
+	// Шаг группировки by_period.
+	Period OptUzisAnalyticsGetPeriod
+}
+
+func unpackUzisAnalyticsGetParams(packed middleware.Parameters) (params UzisAnalyticsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "device_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DeviceID = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "author_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AuthorID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "create_from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreateFrom = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "create_to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreateTo = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "period",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Period = v.(OptUzisAnalyticsGetPeriod)
+		}
+	}
+	return params
+}
+
+func decodeUzisAnalyticsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params UzisAnalyticsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: device_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceIDVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDeviceIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.DeviceID.SetTo(paramsDotDeviceIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: author_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "author_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAuthorIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAuthorIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AuthorID.SetTo(paramsDotAuthorIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "author_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: create_from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "create_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreateFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreateFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreateFrom.SetTo(paramsDotCreateFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "create_from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: create_to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "create_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreateToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreateToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreateTo.SetTo(paramsDotCreateToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "create_to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: period.
+	{
+		val := UzisAnalyticsGetPeriod("month")
+		params.Period.SetTo(val)
+	}
+	// Decode query: period.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "period",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPeriodVal UzisAnalyticsGetPeriod
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPeriodVal = UzisAnalyticsGetPeriod(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Period.SetTo(paramsDotPeriodVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Period.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "period",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UzisAuthorIDGetParams is parameters of GET /uzis/author/{id} operation.
+type UzisAuthorIDGetParams struct {
+	// Id аккаунта, загрузившего узи.
+	ID uuid.UUID
+}
+
+func unpackUzisAuthorIDGetParams(packed middleware.Parameters) (params UzisAuthorIDGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUzisAuthorIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UzisAuthorIDGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UzisExternalIDGetParams is parameters of GET /uzis/external/{id} operation.
+type UzisExternalIDGetParams struct {
+	// Внешний id пациента/организации etc.
+	ID uuid.UUID
+}
+
+func unpackUzisExternalIDGetParams(packed middleware.Parameters) (params UzisExternalIDGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUzisExternalIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UzisExternalIDGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UzisExternalIDGrowthGetParams is parameters of GET /uzis/external/{id}/growth operation.
+type UzisExternalIDGrowthGetParams struct {
+	// Внешний id пациента/организации etc.
+	ID uuid.UUID
+}
+
+func unpackUzisExternalIDGrowthGetParams(packed middleware.Parameters) (params UzisExternalIDGrowthGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUzisExternalIDGrowthGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UzisExternalIDGrowthGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UzisSearchGetParams is parameters of GET /uzis/search operation.
+type UzisSearchGetParams struct {
+	AuthorID   OptUUID
+	ExternalID OptUUID
+	Status     OptUzisSearchGetStatus
+	Projection OptUzisSearchGetProjection
+	DeviceID   OptInt
+	Checked    OptBool
+	// Дата создания от, включительно.
+	CreateFrom OptDateTime
+	// Дата создания до, включительно.
+	CreateTo OptDateTime
+	// Есть нейросетевой узел с вероятностью tirads_4 не ниже
+	// порога.
+	AiTirads4Min OptFloat64
+	// Есть нейросетевой узел с вероятностью tirads_5 не ниже
+	// порога.
+	AiTirads5Min OptFloat64
+	Order        OptUzisSearchGetOrder
+	Cursor       OptString
+	Limit        OptInt
+}
+
+func unpackUzisSearchGetParams(packed middleware.Parameters) (params UzisSearchGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "author_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AuthorID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "external_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExternalID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptUzisSearchGetStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "projection",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Projection = v.(OptUzisSearchGetProjection)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "device_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DeviceID = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "checked",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Checked = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "create_from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreateFrom = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "create_to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreateTo = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "ai_tirads_4_min",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AiTirads4Min = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "ai_tirads_5_min",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AiTirads5Min = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Order = v.(OptUzisSearchGetOrder)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeUzisSearchGetParams(args [0]string, argsEscaped bool, r *http.Request) (params UzisSearchGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: author_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "author_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAuthorIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAuthorIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AuthorID.SetTo(paramsDotAuthorIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "author_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: external_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "external_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotExternalIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotExternalIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ExternalID.SetTo(paramsDotExternalIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "external_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal UzisSearchGetStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = UzisSearchGetStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: projection.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "projection",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotProjectionVal UzisSearchGetProjection
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotProjectionVal = UzisSearchGetProjection(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Projection.SetTo(paramsDotProjectionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Projection.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projection",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: device_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceIDVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDeviceIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.DeviceID.SetTo(paramsDotDeviceIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: checked.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "checked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCheckedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCheckedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Checked.SetTo(paramsDotCheckedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "checked",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: create_from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "create_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreateFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreateFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreateFrom.SetTo(paramsDotCreateFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "create_from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: create_to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "create_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreateToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreateToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreateTo.SetTo(paramsDotCreateToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "create_to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: ai_tirads_4_min.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "ai_tirads_4_min",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAiTirads4MinVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAiTirads4MinVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AiTirads4Min.SetTo(paramsDotAiTirads4MinVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.AiTirads4Min.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           1,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    nil,
+						}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "ai_tirads_4_min",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: ai_tirads_5_min.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "ai_tirads_5_min",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAiTirads5MinVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAiTirads5MinVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AiTirads5Min.SetTo(paramsDotAiTirads5MinVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.AiTirads5Min.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           1,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    nil,
+						}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "ai_tirads_5_min",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: order.
+	{
+		val := UzisSearchGetOrder("desc")
+		params.Order.SetTo(val)
+	}
+	// Decode query: order.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderVal UzisSearchGetOrder
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderVal = UzisSearchGetOrder(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Order.SetTo(paramsDotOrderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Order.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
@@ -38,6 +38,22 @@ func encodeCytologyCreateCreateRequest(
 	request := req
 
 	q := uri.NewFormEncoder(map[string]string{})
+	{
+		// Encode "upload_id" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "upload_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := request.UploadID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
 	{
 		// Encode "diagnostic_number" form field.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -242,6 +258,16 @@ func encodeCytologyCreateCreateRequest(
 	return nil
 }
 
+func encodeCytologyQuPathImportRequest(
+	req CytologyQuPathImportReq,
+	r *http.Request,
+) error {
+	const contentType = "application/geo+json"
+	body := req
+	ht.SetBody(r, body, contentType)
+	return nil
+}
+
 func encodeCytologySegmentGroupCreateCreateRequest(
 	req *CytologySegmentGroupCreateCreateReq,
 	r *http.Request,
@@ -438,6 +464,48 @@ func encodeSubscriptionsPurchasePostRequest(
 	return nil
 }
 
+func encodeUploadsIDPartsPostRequest(
+	req *UploadsIDPartsPostReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUploadsPostRequest(
+	req *UploadsPostReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUziDeviceIDPatchRequest(
+	req *UziDeviceIDPatchReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUziDevicePostRequest(
 	req *UziDevicePostReq,
 	r *http.Request,
@@ -452,6 +520,26 @@ func encodeUziDevicePostRequest(
 	return nil
 }
 
+func encodeUziIDCheckPostRequest(
+	req OptUziIDCheckPostReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUziIDEchographicsPatchRequest(
 	req *Echographics,
 	r *http.Request,
@@ -466,6 +554,20 @@ func encodeUziIDEchographicsPatchRequest(
 	return nil
 }
 
+func encodeUziIDNodesAcceptPostRequest(
+	req *UziIDNodesAcceptPostReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUziIDNodesSegmentsPostRequest(
 	req *UziIDNodesSegmentsPostReq,
 	r *http.Request,
@@ -494,6 +596,20 @@ func encodeUziIDPatchRequest(
 	return nil
 }
 
+func encodeUziNodesIDLinkPostRequest(
+	req *UziNodesIDLinkPostReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUziNodesIDPatchRequest(
 	req *UziNodesIDPatchReq,
 	r *http.Request,
@@ -508,6 +624,62 @@ func encodeUziNodesIDPatchRequest(
 	return nil
 }
 
+func encodeUziNodesIDSplitPostRequest(
+	req *UziNodesIDSplitPostReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUziNodesIDTiradsPutRequest(
+	req *NodeDescriptors,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUziNodesMergePostRequest(
+	req *UziNodesMergePostReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUziNodesReviewPostRequest(
+	req *UziNodesReviewPostReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUziPostRequest(
 	req *UziPostReq,
 	r *http.Request,
@@ -516,6 +688,22 @@ func encodeUziPostRequest(
 	request := req
 
 	q := uri.NewFormEncoder(map[string]string{})
+	{
+		// Encode "upload_id" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "upload_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := request.UploadID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
 	{
 		// Encode "projection" form field.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -571,9 +759,43 @@ func encodeUziPostRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "pixel_spacing_x" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "pixel_spacing_x",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := request.PixelSpacingX.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "pixel_spacing_y" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "pixel_spacing_y",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := request.PixelSpacingY.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
 	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
-		if err := request.File.WriteMultipart("file", w); err != nil {
-			return errors.Wrap(err, "write \"file\"")
+		if val, ok := request.File.Get(); ok {
+			if err := val.WriteMultipart("file", w); err != nil {
+				return errors.Wrap(err, "write \"file\"")
+			}
 		}
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
@@ -330,6 +330,32 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
+					case 'q': // Prefix: "qupath"
+
+						if l := len("qupath"); len(elem) >= l && elem[0:l] == "qupath" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleCytologyQuPathExportRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleCytologyQuPathImportRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+
 					case 's': // Prefix: "segments"
 
 						if l := len("segments"); len(elem) >= l && elem[0:l] == "segments" {
@@ -339,7 +365,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
 							case "GET":
 								s.handleCytologySegmentsListRequest([1]string{
@@ -351,6 +376,30 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/viewport"
+
+							if l := len("/viewport"); len(elem) >= l && elem[0:l] == "/viewport" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleCytologySegmentsViewportRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
 
 					case 'u': // Prefix: "update"
 
@@ -449,6 +498,52 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
+					elem = origElem
+				case 'u': // Prefix: "uzi/"
+					origElem := elem
+					if l := len("uzi/"); len(elem) >= l && elem[0:l] == "uzi/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "uzi_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/report"
+
+						if l := len("/report"); len(elem) >= l && elem[0:l] == "/report" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleDownloadUziUziIDReportGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
 					elem = origElem
 				}
 				// Param: "uzi_id"
@@ -1107,453 +1202,1053 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
-			case 'u': // Prefix: "uzi"
+			case 'u': // Prefix: "u"
 
-				if l := len("uzi"); len(elem) >= l && elem[0:l] == "uzi" {
+				if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch r.Method {
-					case "POST":
-						s.handleUziPostRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "POST")
-					}
-
-					return
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'p': // Prefix: "ploads"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("ploads"); len(elem) >= l && elem[0:l] == "ploads" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						break
+						switch r.Method {
+						case "POST":
+							s.handleUploadsPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
 					}
 					switch elem[0] {
-					case 'd': // Prefix: "device"
-						origElem := elem
-						if l := len("device"); len(elem) >= l && elem[0:l] == "device" {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
 							switch r.Method {
-							case "POST":
-								s.handleUziDevicePostRequest([0]string{}, elemIsEscaped, w, r)
+							case "DELETE":
+								s.handleUploadsIDDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleUploadsIDGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "POST")
+								s.notAllowed(w, r, "DELETE,GET")
 							}
 
 							return
 						}
 						switch elem[0] {
-						case 's': // Prefix: "s"
+						case '/': // Prefix: "/"
 
-							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleUziDevicesGetRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
+								break
 							}
+							switch elem[0] {
+							case 'c': // Prefix: "complete"
 
-						}
+								if l := len("complete"); len(elem) >= l && elem[0:l] == "complete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						elem = origElem
-					case 'i': // Prefix: "image/"
-						origElem := elem
-						if l := len("image/"); len(elem) >= l && elem[0:l] == "image/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUploadsIDCompletePostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
 
-						// Param: "id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+									return
+								}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/nodes-segments"
+							case 'p': // Prefix: "parts"
 
-							if l := len("/nodes-segments"); len(elem) >= l && elem[0:l] == "/nodes-segments" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								if l := len("parts"); len(elem) >= l && elem[0:l] == "parts" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleUziImageIDNodesSegmentsGetRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUploadsIDPartsPostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
 								}
 
-								return
 							}
 
 						}
 
-						elem = origElem
-					case 'n': // Prefix: "nodes/"
-						origElem := elem
-						if l := len("nodes/"); len(elem) >= l && elem[0:l] == "nodes/" {
+					}
+
+				case 'z': // Prefix: "zi"
+
+					if l := len("zi"); len(elem) >= l && elem[0:l] == "zi" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "POST":
+							s.handleUziPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
-							switch r.Method {
-							case "DELETE":
-								s.handleUziNodesIDDeleteRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "PATCH":
-								s.handleUziNodesIDPatchRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "DELETE,PATCH")
-							}
-
-							return
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/segments"
-
-							if l := len("/segments"); len(elem) >= l && elem[0:l] == "/segments" {
+						case 'd': // Prefix: "device"
+							origElem := elem
+							if l := len("device"); len(elem) >= l && elem[0:l] == "device" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch r.Method {
-								case "GET":
-									s.handleUziNodesIDSegmentsGetRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleUziDevicePostRequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "POST")
 								}
 
 								return
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-						}
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						elem = origElem
-					case 's': // Prefix: "segment"
-						origElem := elem
-						if l := len("segment"); len(elem) >= l && elem[0:l] == "segment" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
 
-						if len(elem) == 0 {
-							switch r.Method {
-							case "POST":
-								s.handleUziSegmentPostRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
-							}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleUziDeviceIDDeleteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleUziDeviceIDGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PATCH":
+										s.handleUziDeviceIDPatchRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET,PATCH")
+									}
 
-							return
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+									return
+								}
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							case 's': // Prefix: "s"
+
+								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleUziDevicesGetRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+							elem = origElem
+						case 'i': // Prefix: "image/"
+							origElem := elem
+							if l := len("image/"); len(elem) >= l && elem[0:l] == "image/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							// Param: "id"
-							// Leaf parameter, slashes are prohibited
+							// Match until "/"
 							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
+							if idx < 0 {
+								idx = len(elem)
 							}
-							args[0] = elem
-							elem = ""
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "DELETE":
-									s.handleUziSegmentIDDeleteRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "PATCH":
-									s.handleUziSegmentIDPatchRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "DELETE,PATCH")
-								}
-
-								return
+								break
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/nodes-segments"
 
-						}
-
-						elem = origElem
-					}
-					// Param: "id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
-					if len(elem) == 0 {
-						switch r.Method {
-						case "DELETE":
-							s.handleUziIDDeleteRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "GET":
-							s.handleUziIDGetRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PATCH":
-							s.handleUziIDPatchRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "DELETE,GET,PATCH")
-						}
+								if l := len("/nodes-segments"); len(elem) >= l && elem[0:l] == "/nodes-segments" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleUziImageIDNodesSegmentsGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									return
+								}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'e': // Prefix: "echographics"
+							}
 
-							if l := len("echographics"); len(elem) >= l && elem[0:l] == "echographics" {
+							elem = origElem
+						case 'n': // Prefix: "nodes/"
+							origElem := elem
+							if l := len("nodes/"); len(elem) >= l && elem[0:l] == "nodes/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleUziIDEchographicsGetRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "PATCH":
-									s.handleUziIDEchographicsPatchRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET,PATCH")
-								}
-
-								return
+								break
 							}
+							switch elem[0] {
+							case 'm': // Prefix: "merge"
+								origElem := elem
+								if l := len("merge"); len(elem) >= l && elem[0:l] == "merge" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						case 'i': // Prefix: "images"
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUziNodesMergePostRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
 
-							if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+									return
+								}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleUziIDImagesGetRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+								elem = origElem
+							case 'r': // Prefix: "review"
+								origElem := elem
+								if l := len("review"); len(elem) >= l && elem[0:l] == "review" {
+									elem = elem[l:]
+								} else {
+									break
 								}
 
-								return
-							}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUziNodesReviewPostRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
 
-						case 'n': // Prefix: "nodes"
+									return
+								}
 
-							if l := len("nodes"); len(elem) >= l && elem[0:l] == "nodes" {
-								elem = elem[l:]
-							} else {
-								break
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
 							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
 								switch r.Method {
-								case "GET":
-									s.handleUziIDNodesGetRequest([1]string{
+								case "DELETE":
+									s.handleUziNodesIDDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleUziNodesIDPatchRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "DELETE,PATCH")
 								}
 
 								return
 							}
 							switch elem[0] {
-							case '-': // Prefix: "-segments"
+							case '/': // Prefix: "/"
 
-								if l := len("-segments"); len(elem) >= l && elem[0:l] == "-segments" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleUziIDNodesSegmentsPostRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "link"
+
+									if l := len("link"); len(elem) >= l && elem[0:l] == "link" {
+										elem = elem[l:]
+									} else {
+										break
 									}
 
-									return
-								}
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleUziNodesIDLinkDeleteRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleUziNodesIDLinkPostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,POST")
+										}
 
-							}
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/suggestions"
 
-						}
+										if l := len("/suggestions"); len(elem) >= l && elem[0:l] == "/suggestions" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleUziNodesIDLinkSuggestionsGetRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-				case 's': // Prefix: "s/"
+											return
+										}
 
-					if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'a': // Prefix: "author/"
+								case 's': // Prefix: "s"
 
-						if l := len("author/"); len(elem) >= l && elem[0:l] == "author/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						// Param: "id"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'e': // Prefix: "egment"
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleUzisAuthorIDGetRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
+										if l := len("egment"); len(elem) >= l && elem[0:l] == "egment" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							return
-						}
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '-': // Prefix: "-drafts"
+
+											if l := len("-drafts"); len(elem) >= l && elem[0:l] == "-drafts" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleUziNodesIDSegmentDraftsGetRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+										case 's': // Prefix: "s"
+
+											if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleUziNodesIDSegmentsGetRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
 
-					case 'e': // Prefix: "external/"
+										}
 
-						if l := len("external/"); len(elem) >= l && elem[0:l] == "external/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									case 'p': // Prefix: "plit"
+
+										if l := len("plit"); len(elem) >= l && elem[0:l] == "plit" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleUziNodesIDSplitPostRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								case 't': // Prefix: "tirads"
+
+									if l := len("tirads"); len(elem) >= l && elem[0:l] == "tirads" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleUziNodesIDTiradsGetRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleUziNodesIDTiradsPutRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,PUT")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						case 's': // Prefix: "segment"
+							origElem := elem
+							if l := len("segment"); len(elem) >= l && elem[0:l] == "segment" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "POST":
+									s.handleUziSegmentPostRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '-': // Prefix: "-drafts/"
+
+								if l := len("-drafts/"); len(elem) >= l && elem[0:l] == "-drafts/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "DELETE":
+										s.handleUziSegmentDraftsIDDeleteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/accept"
+
+									if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleUziSegmentDraftsIDAcceptPostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "DELETE":
+										s.handleUziSegmentIDDeleteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PATCH":
+										s.handleUziSegmentIDPatchRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,PATCH")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/propagate"
+
+									if l := len("/propagate"); len(elem) >= l && elem[0:l] == "/propagate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleUziSegmentIDPropagatePostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						}
 						// Param: "id"
-						// Leaf parameter, slashes are prohibited
+						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleUziIDDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleUziIDGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUziIDPatchRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PATCH")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "check"
+
+								if l := len("check"); len(elem) >= l && elem[0:l] == "check" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUziIDCheckPostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'e': // Prefix: "echographics"
+
+								if l := len("echographics"); len(elem) >= l && elem[0:l] == "echographics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleUziIDEchographicsGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PATCH":
+										s.handleUziIDEchographicsPatchRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,PATCH")
+									}
+
+									return
+								}
+
+							case 'i': // Prefix: "images"
+
+								if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleUziIDImagesGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'n': // Prefix: "nodes"
+
+								if l := len("nodes"); len(elem) >= l && elem[0:l] == "nodes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleUziIDNodesGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '-': // Prefix: "-segments"
+
+									if l := len("-segments"); len(elem) >= l && elem[0:l] == "-segments" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleUziIDNodesSegmentsPostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case '/': // Prefix: "/accept"
+
+									if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleUziIDNodesAcceptPostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							case 'r': // Prefix: "reports"
+
+								if l := len("reports"); len(elem) >= l && elem[0:l] == "reports" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleUziIDReportsGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleUziIDReportsPostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 's': // Prefix: "s/"
+
+						if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
+							elem = elem[l:]
+						} else {
 							break
 						}
-						args[0] = elem
-						elem = ""
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleUzisExternalIDGetRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "a"
+
+							if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'n': // Prefix: "nalytics"
+
+								if l := len("nalytics"); len(elem) >= l && elem[0:l] == "nalytics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleUzisAnalyticsGetRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'u': // Prefix: "uthor/"
+
+								if l := len("uthor/"); len(elem) >= l && elem[0:l] == "uthor/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleUzisAuthorIDGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						case 'e': // Prefix: "external/"
+
+							if l := len("external/"); len(elem) >= l && elem[0:l] == "external/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleUzisExternalIDGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/growth"
+
+								if l := len("/growth"); len(elem) >= l && elem[0:l] == "/growth" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleUzisExternalIDGrowthGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						case 's': // Prefix: "search"
+
+							if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleUzisSearchGetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
 							}
 
-							return
 						}
 
 					}
@@ -1937,7 +2632,278 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					elem = origElem
 				}
-				// Param: "id"
+				// Param: "id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = CytologyReadOperation
+						r.summary = "Информация об одной группе снимков"
+						r.operationID = "CytologyRead"
+						r.pathPattern = "/cytology/{id}"
+						r.args = args
+						r.count = 1
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'q': // Prefix: "qupath"
+
+						if l := len("qupath"); len(elem) >= l && elem[0:l] == "qupath" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = CytologyQuPathExportOperation
+								r.summary = "экспорт сегментаций в GeoJSON для QuPath"
+								r.operationID = "CytologyQuPathExport"
+								r.pathPattern = "/cytology/{id}/qupath"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "POST":
+								r.name = CytologyQuPathImportOperation
+								r.summary = "импорт отредактированного в QuPath GeoJSON новой версией исследования"
+								r.operationID = "CytologyQuPathImport"
+								r.pathPattern = "/cytology/{id}/qupath"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 's': // Prefix: "segments"
+
+						if l := len("segments"); len(elem) >= l && elem[0:l] == "segments" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = CytologySegmentsListOperation
+								r.summary = "Информация об одной группе снимков"
+								r.operationID = "CytologySegmentsList"
+								r.pathPattern = "/cytology/{id}/segments"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/viewport"
+
+							if l := len("/viewport"); len(elem) >= l && elem[0:l] == "/viewport" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = CytologySegmentsViewportOperation
+									r.summary = "сегментации в области просмотра"
+									r.operationID = "CytologySegmentsViewport"
+									r.pathPattern = "/cytology/{id}/segments/viewport"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'u': // Prefix: "update"
+
+						if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "PATCH":
+								r.name = CytologyUpdatePartialUpdateOperation
+								r.summary = "Обновление всей страницы с информацией о приеме"
+								r.operationID = "CytologyUpdatePartialUpdate"
+								r.pathPattern = "/cytology/{id}/update"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = CytologyUpdateUpdateOperation
+								r.summary = "Обновление всей страницы с информацией о приеме"
+								r.operationID = "CytologyUpdateUpdate"
+								r.pathPattern = "/cytology/{id}/update"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'd': // Prefix: "download/"
+
+				if l := len("download/"); len(elem) >= l && elem[0:l] == "download/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "cytology/"
+					origElem := elem
+					if l := len("cytology/"); len(elem) >= l && elem[0:l] == "cytology/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "cytology_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "original_image_id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[1] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = DownloadCytologyCytologyIDOriginalImageIDGetOperation
+								r.summary = "получить оригинальное изображение цитологического исследования"
+								r.operationID = ""
+								r.pathPattern = "/download/cytology/{cytology_id}/{original_image_id}"
+								r.args = args
+								r.count = 2
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+					elem = origElem
+				case 'u': // Prefix: "uzi/"
+					origElem := elem
+					if l := len("uzi/"); len(elem) >= l && elem[0:l] == "uzi/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "uzi_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/report"
+
+						if l := len("/report"); len(elem) >= l && elem[0:l] == "/report" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = DownloadUziUziIDReportGetOperation
+								r.summary = "скачать заключение по узи"
+								r.operationID = ""
+								r.pathPattern = "/download/uzi/{uzi_id}/report"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+					elem = origElem
+				}
+				// Param: "uzi_id"
 				// Match until "/"
 				idx := strings.IndexByte(elem, '/')
 				if idx < 0 {
@@ -1947,59 +2913,204 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				elem = elem[idx:]
 
 				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "image_id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[1] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = DownloadUziIDImageIDGetOperation
+							r.summary = "получить кадр узи"
+							r.operationID = ""
+							r.pathPattern = "/download/{uzi_id}/{image_id}"
+							r.args = args
+							r.count = 2
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'l': // Prefix: "login"
+
+				if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
 					switch method {
-					case "GET":
-						r.name = CytologyReadOperation
-						r.summary = "Информация об одной группе снимков"
-						r.operationID = "CytologyRead"
-						r.pathPattern = "/cytology/{id}"
+					case "POST":
+						r.name = LoginPostOperation
+						r.summary = "авторизация"
+						r.operationID = ""
+						r.pathPattern = "/login"
 						r.args = args
-						r.count = 1
+						r.count = 0
 						return r, true
 					default:
 						return
 					}
 				}
+
+			case 'm': // Prefix: "med/"
+
+				if l := len("med/"); len(elem) >= l && elem[0:l] == "med/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'c': // Prefix: "card"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("card"); len(elem) >= l && elem[0:l] == "card" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						break
+						switch method {
+						case "POST":
+							r.name = MedCardPostOperation
+							r.summary = "создать карту пациента"
+							r.operationID = ""
+							r.pathPattern = "/med/card"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
 					}
 					switch elem[0] {
-					case 's': // Prefix: "segments"
+					case '/': // Prefix: "/"
 
-						if l := len("segments"); len(elem) >= l && elem[0:l] == "segments" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "doctor_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = CytologySegmentsListOperation
-								r.summary = "Информация об одной группе снимков"
-								r.operationID = "CytologySegmentsList"
-								r.pathPattern = "/cytology/{id}/segments"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "patient_id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[1] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = MedCardDoctorIDPatientIDGetOperation
+									r.summary = "получить карту пациента"
+									r.operationID = ""
+									r.pathPattern = "/med/card/{doctor_id}/{patient_id}"
+									r.args = args
+									r.count = 2
+									return r, true
+								case "PATCH":
+									r.name = MedCardDoctorIDPatientIDPatchOperation
+									r.summary = "обновить карту пациента"
+									r.operationID = ""
+									r.pathPattern = "/med/card/{doctor_id}/{patient_id}"
+									r.args = args
+									r.count = 2
+									return r, true
+								default:
+									return
+								}
 							}
+
 						}
 
-					case 'u': // Prefix: "update"
+					}
 
-						if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+				case 'd': // Prefix: "doctor/"
+
+					if l := len("doctor/"); len(elem) >= l && elem[0:l] == "doctor/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = MedDoctorIDGetOperation
+							r.summary = "получить врача"
+							r.operationID = ""
+							r.pathPattern = "/med/doctor/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/patients"
+
+						if l := len("/patients"); len(elem) >= l && elem[0:l] == "/patients" {
 							elem = elem[l:]
 						} else {
 							break
@@ -2008,19 +3119,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "PATCH":
-								r.name = CytologyUpdatePartialUpdateOperation
-								r.summary = "Обновление всей страницы с информацией о приеме"
-								r.operationID = "CytologyUpdatePartialUpdate"
-								r.pathPattern = "/cytology/{id}/update"
-								r.args = args
-								r.count = 1
-								return r, true
-							case "PUT":
-								r.name = CytologyUpdateUpdateOperation
-								r.summary = "Обновление всей страницы с информацией о приеме"
-								r.operationID = "CytologyUpdateUpdate"
-								r.pathPattern = "/cytology/{id}/update"
+							case "GET":
+								r.name = MedDoctorIDPatientsGetOperation
+								r.summary = "получить пациентов врача"
+								r.operationID = ""
+								r.pathPattern = "/med/doctor/{id}/patients"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -2031,39 +3134,27 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
-				}
-
-			case 'd': // Prefix: "download/"
-
-				if l := len("download/"); len(elem) >= l && elem[0:l] == "download/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'c': // Prefix: "cytology/"
-					origElem := elem
-					if l := len("cytology/"); len(elem) >= l && elem[0:l] == "cytology/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+				case 'p': // Prefix: "patient"
 
-					// Param: "cytology_id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
+					if l := len("patient"); len(elem) >= l && elem[0:l] == "patient" {
+						elem = elem[l:]
+					} else {
+						break
 					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						break
+						switch method {
+						case "POST":
+							r.name = MedPatientPostOperation
+							r.summary = "зарегистрировать пациента"
+							r.operationID = ""
+							r.pathPattern = "/med/patient"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
 					}
 					switch elem[0] {
 					case '/': // Prefix: "/"
@@ -2074,25 +3165,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 
-						// Param: "original_image_id"
+						// Param: "id"
 						// Leaf parameter, slashes are prohibited
 						idx := strings.IndexByte(elem, '/')
 						if idx >= 0 {
 							break
 						}
-						args[1] = elem
+						args[0] = elem
 						elem = ""
 
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
 							case "GET":
-								r.name = DownloadCytologyCytologyIDOriginalImageIDGetOperation
-								r.summary = "получить оригинальное изображение цитологического исследования"
+								r.name = MedPatientIDGetOperation
+								r.summary = "получить пациента"
 								r.operationID = ""
-								r.pathPattern = "/download/cytology/{cytology_id}/{original_image_id}"
+								r.pathPattern = "/med/patient/{id}"
 								r.args = args
-								r.count = 2
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = MedPatientIDPatchOperation
+								r.summary = "обновить пациента"
+								r.operationID = ""
+								r.pathPattern = "/med/patient/{id}"
+								r.args = args
+								r.count = 1
 								return r, true
 							default:
 								return
@@ -2101,59 +3200,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
-					elem = origElem
-				}
-				// Param: "uzi_id"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
-
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "image_id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[1] = elem
-					elem = ""
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = DownloadUziIDImageIDGetOperation
-							r.summary = "получить кадр узи"
-							r.operationID = ""
-							r.pathPattern = "/download/{uzi_id}/{image_id}"
-							r.args = args
-							r.count = 2
-							return r, true
-						default:
-							return
-						}
-					}
-
 				}
 
-			case 'l': // Prefix: "login"
+			case 'p': // Prefix: "payment_providers"
 
-				if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+				if l := len("payment_providers"); len(elem) >= l && elem[0:l] == "payment_providers" {
 					elem = elem[l:]
 				} else {
 					break
@@ -2162,11 +3213,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					// Leaf node.
 					switch method {
-					case "POST":
-						r.name = LoginPostOperation
-						r.summary = "авторизация"
+					case "GET":
+						r.name = PaymentProvidersGetOperation
+						r.summary = "Получить список всех платежных провайдеров"
 						r.operationID = ""
-						r.pathPattern = "/login"
+						r.pathPattern = "/payment_providers"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -2175,9 +3226,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 				}
 
-			case 'm': // Prefix: "med/"
+			case 'r': // Prefix: "re"
 
-				if l := len("med/"); len(elem) >= l && elem[0:l] == "med/" {
+				if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
 					elem = elem[l:]
 				} else {
 					break
@@ -2187,21 +3238,22 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'c': // Prefix: "card"
+				case 'f': // Prefix: "fresh"
 
-					if l := len("card"); len(elem) >= l && elem[0:l] == "card" {
+					if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
+						// Leaf node.
 						switch method {
 						case "POST":
-							r.name = MedCardPostOperation
-							r.summary = "создать карту пациента"
+							r.name = RefreshPostOperation
+							r.summary = "обновить access token"
 							r.operationID = ""
-							r.pathPattern = "/med/card"
+							r.pathPattern = "/refresh"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -2209,134 +3261,172 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+
+				case 'g': // Prefix: "g/"
+
+					if l := len("g/"); len(elem) >= l && elem[0:l] == "g/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'd': // Prefix: "doctor"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("doctor"); len(elem) >= l && elem[0:l] == "doctor" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "doctor_id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = RegDoctorPostOperation
+								r.summary = "зарегистрировать врача (как пользователя)"
+								r.operationID = ""
+								r.pathPattern = "/reg/doctor"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
 
-						if len(elem) == 0 {
+					case 'p': // Prefix: "patient"
+
+						if l := len("patient"); len(elem) >= l && elem[0:l] == "patient" {
+							elem = elem[l:]
+						} else {
 							break
 						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = RegPatientPostOperation
+								r.summary = "зарегистрировать пациента (как пользователя). **Сделано под мобилки api**. Врачам регистрировать пациентов через /med/patient"
+								r.operationID = ""
+								r.pathPattern = "/reg/patient"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
 							}
+						}
 
-							// Param: "patient_id"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
-							}
-							args[1] = elem
-							elem = ""
+					}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = MedCardDoctorIDPatientIDGetOperation
-									r.summary = "получить карту пациента"
-									r.operationID = ""
-									r.pathPattern = "/med/card/{doctor_id}/{patient_id}"
-									r.args = args
-									r.count = 2
-									return r, true
-								case "PATCH":
-									r.name = MedCardDoctorIDPatientIDPatchOperation
-									r.summary = "обновить карту пациента"
-									r.operationID = ""
-									r.pathPattern = "/med/card/{doctor_id}/{patient_id}"
-									r.args = args
-									r.count = 2
-									return r, true
-								default:
-									return
-								}
-							}
+				}
+
+			case 's': // Prefix: "subscriptions/"
+
+				if l := len("subscriptions/"); len(elem) >= l && elem[0:l] == "subscriptions/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "check-active"
 
+					if l := len("check-active"); len(elem) >= l && elem[0:l] == "check-active" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = SubscriptionsCheckActiveGetOperation
+							r.summary = "Проверить наличие активной подписки у текущего пользователя"
+							r.operationID = ""
+							r.pathPattern = "/subscriptions/check-active"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'g': // Prefix: "get-active"
+
+					if l := len("get-active"); len(elem) >= l && elem[0:l] == "get-active" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = SubscriptionsGetActiveGetOperation
+							r.summary = "Получить информацию об активной подписке текущего пользователя"
+							r.operationID = ""
+							r.pathPattern = "/subscriptions/get-active"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
 						}
-
 					}
 
-				case 'd': // Prefix: "doctor/"
+				case 'p': // Prefix: "purchase"
 
-					if l := len("doctor/"); len(elem) >= l && elem[0:l] == "doctor/" {
+					if l := len("purchase"); len(elem) >= l && elem[0:l] == "purchase" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
 					if len(elem) == 0 {
+						// Leaf node.
 						switch method {
-						case "GET":
-							r.name = MedDoctorIDGetOperation
-							r.summary = "получить врача"
+						case "POST":
+							r.name = SubscriptionsPurchasePostOperation
+							r.summary = "Купить подписку"
 							r.operationID = ""
-							r.pathPattern = "/med/doctor/{id}"
+							r.pathPattern = "/subscriptions/purchase"
 							r.args = args
-							r.count = 1
+							r.count = 0
 							return r, true
 						default:
 							return
 						}
 					}
-					switch elem[0] {
-					case '/': // Prefix: "/patients"
 
-						if l := len("/patients"); len(elem) >= l && elem[0:l] == "/patients" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+				}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = MedDoctorIDPatientsGetOperation
-								r.summary = "получить пациентов врача"
-								r.operationID = ""
-								r.pathPattern = "/med/doctor/{id}/patients"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
+			case 't': // Prefix: "t"
 
-					}
+				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-				case 'p': // Prefix: "patient"
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "ariff_plans"
 
-					if l := len("patient"); len(elem) >= l && elem[0:l] == "patient" {
+					if l := len("ariff_plans"); len(elem) >= l && elem[0:l] == "ariff_plans" {
 						elem = elem[l:]
 					} else {
 						break
@@ -2344,11 +3434,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					if len(elem) == 0 {
 						switch method {
-						case "POST":
-							r.name = MedPatientPostOperation
-							r.summary = "зарегистрировать пациента"
+						case "GET":
+							r.name = TariffPlansGetOperation
+							r.summary = "Получить список всех тарифных планов"
 							r.operationID = ""
-							r.pathPattern = "/med/patient"
+							r.pathPattern = "/tariff_plans"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -2378,18 +3468,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							// Leaf node.
 							switch method {
 							case "GET":
-								r.name = MedPatientIDGetOperation
-								r.summary = "получить пациента"
-								r.operationID = ""
-								r.pathPattern = "/med/patient/{id}"
-								r.args = args
-								r.count = 1
-								return r, true
-							case "PATCH":
-								r.name = MedPatientIDPatchOperation
-								r.summary = "обновить пациента"
+								r.name = TariffPlansIDGetOperation
+								r.summary = "Получить тарифный план по ID"
 								r.operationID = ""
-								r.pathPattern = "/med/patient/{id}"
+								r.pathPattern = "/tariff_plans/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -2400,135 +3482,147 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
-				}
-
-			case 'p': // Prefix: "payment_providers"
-
-				if l := len("payment_providers"); len(elem) >= l && elem[0:l] == "payment_providers" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = PaymentProvidersGetOperation
-						r.summary = "Получить список всех платежных провайдеров"
-						r.operationID = ""
-						r.pathPattern = "/payment_providers"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-
-			case 'r': // Prefix: "re"
-
-				if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'f': // Prefix: "fresh"
+				case 'i': // Prefix: "iler/dzi/"
 
-					if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+					if l := len("iler/dzi/"); len(elem) >= l && elem[0:l] == "iler/dzi/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
+					// Param: "file_path"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
-						case "POST":
-							r.name = RefreshPostOperation
-							r.summary = "обновить access token"
-							r.operationID = ""
-							r.pathPattern = "/refresh"
+						case "GET":
+							r.name = TilerDziFilePathGetOperation
+							r.summary = "Получить DZI XML метаданные для изображения"
+							r.operationID = "TilerDziFilePathGet"
+							r.pathPattern = "/tiler/dzi/{file_path}"
 							r.args = args
-							r.count = 0
+							r.count = 1
 							return r, true
 						default:
 							return
 						}
 					}
-
-				case 'g': // Prefix: "g/"
-
-					if l := len("g/"); len(elem) >= l && elem[0:l] == "g/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
 					switch elem[0] {
-					case 'd': // Prefix: "doctor"
+					case '/': // Prefix: "/files/"
 
-						if l := len("doctor"); len(elem) >= l && elem[0:l] == "doctor" {
+						if l := len("/files/"); len(elem) >= l && elem[0:l] == "/files/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "level"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[1] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = RegDoctorPostOperation
-								r.summary = "зарегистрировать врача (как пользователя)"
-								r.operationID = ""
-								r.pathPattern = "/reg/doctor"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
+							break
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "col"
+							// Match until "_"
+							idx := strings.IndexByte(elem, '_')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[2] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '_': // Prefix: "_"
+
+								if l := len("_"); len(elem) >= l && elem[0:l] == "_" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "row"
+								// Match until "."
+								idx := strings.IndexByte(elem, '.')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[3] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '.': // Prefix: "."
+
+									if l := len("."); len(elem) >= l && elem[0:l] == "." {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "format"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[4] = elem
+									elem = ""
 
-					case 'p': // Prefix: "patient"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = TilerDziFilePathFilesLevelColRowFormatGetOperation
+											r.summary = "Получить тайл изображения"
+											r.operationID = "TilerDziFilePathFilesLevelColRowFormatGet"
+											r.pathPattern = "/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}"
+											r.args = args
+											r.count = 5
+											return r, true
+										default:
+											return
+										}
+									}
 
-						if l := len("patient"); len(elem) >= l && elem[0:l] == "patient" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = RegPatientPostOperation
-								r.summary = "зарегистрировать пациента (как пользователя). **Сделано под мобилки api**. Врачам регистрировать пациентов через /med/patient"
-								r.operationID = ""
-								r.pathPattern = "/reg/patient"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
 							}
+
 						}
 
 					}
 
 				}
 
-			case 's': // Prefix: "subscriptions/"
+			case 'u': // Prefix: "u"
 
-				if l := len("subscriptions/"); len(elem) >= l && elem[0:l] == "subscriptions/" {
+				if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
 					elem = elem[l:]
 				} else {
 					break
@@ -2538,22 +3632,21 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'c': // Prefix: "check-active"
+				case 'p': // Prefix: "ploads"
 
-					if l := len("check-active"); len(elem) >= l && elem[0:l] == "check-active" {
+					if l := len("ploads"); len(elem) >= l && elem[0:l] == "ploads" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
-						case "GET":
-							r.name = SubscriptionsCheckActiveGetOperation
-							r.summary = "Проверить наличие активной подписки у текущего пользователя"
+						case "POST":
+							r.name = UploadsPostOperation
+							r.summary = "начать загрузку файла частями"
 							r.operationID = ""
-							r.pathPattern = "/subscriptions/check-active"
+							r.pathPattern = "/uploads"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -2561,72 +3654,116 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-				case 'g': // Prefix: "get-active"
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					if l := len("get-active"); len(elem) >= l && elem[0:l] == "get-active" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = SubscriptionsGetActiveGetOperation
-							r.summary = "Получить информацию об активной подписке текущего пользователя"
-							r.operationID = ""
-							r.pathPattern = "/subscriptions/get-active"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = UploadsIDDeleteOperation
+								r.summary = "отменить загрузку"
+								r.operationID = ""
+								r.pathPattern = "/uploads/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = UploadsIDGetOperation
+								r.summary = "получить состояние загрузки"
+								r.operationID = ""
+								r.pathPattern = "/uploads/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
 						}
-					}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-				case 'p': // Prefix: "purchase"
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-					if l := len("purchase"); len(elem) >= l && elem[0:l] == "purchase" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "complete"
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = SubscriptionsPurchasePostOperation
-							r.summary = "Купить подписку"
-							r.operationID = ""
-							r.pathPattern = "/subscriptions/purchase"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
+								if l := len("complete"); len(elem) >= l && elem[0:l] == "complete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-				}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UploadsIDCompletePostOperation
+										r.summary = "завершить загрузку"
+										r.operationID = ""
+										r.pathPattern = "/uploads/{id}/complete"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
 
-			case 't': // Prefix: "t"
+							case 'p': // Prefix: "parts"
 
-				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+								if l := len("parts"); len(elem) >= l && elem[0:l] == "parts" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'a': // Prefix: "ariff_plans"
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UploadsIDPartsPostOperation
+										r.summary = "получить url для загрузки частей"
+										r.operationID = ""
+										r.pathPattern = "/uploads/{id}/parts"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
 
-					if l := len("ariff_plans"); len(elem) >= l && elem[0:l] == "ariff_plans" {
+							}
+
+						}
+
+					}
+
+				case 'z': // Prefix: "zi"
+
+					if l := len("zi"); len(elem) >= l && elem[0:l] == "zi" {
 						elem = elem[l:]
 					} else {
 						break
@@ -2634,11 +3771,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					if len(elem) == 0 {
 						switch method {
-						case "GET":
-							r.name = TariffPlansGetOperation
-							r.summary = "Получить список всех тарифных планов"
+						case "POST":
+							r.name = UziPostOperation
+							r.summary = "загрузить узи на обработку"
 							r.operationID = ""
-							r.pathPattern = "/tariff_plans"
+							r.pathPattern = "/uzi"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -2655,245 +3792,473 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 
-						// Param: "id"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
+						if len(elem) == 0 {
 							break
 						}
-						args[0] = elem
-						elem = ""
+						switch elem[0] {
+						case 'd': // Prefix: "device"
+							origElem := elem
+							if l := len("device"); len(elem) >= l && elem[0:l] == "device" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = TariffPlansIDGetOperation
-								r.summary = "Получить тарифный план по ID"
-								r.operationID = ""
-								r.pathPattern = "/tariff_plans/{id}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									r.name = UziDevicePostOperation
+									r.summary = "добавить uzi аппарат"
+									r.operationID = ""
+									r.pathPattern = "/uzi/device"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
-						}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = UziDeviceIDDeleteOperation
+										r.summary = "удалить uzi аппарат"
+										r.operationID = ""
+										r.pathPattern = "/uzi/device/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "GET":
+										r.name = UziDeviceIDGetOperation
+										r.summary = "получить uzi аппарат"
+										r.operationID = ""
+										r.pathPattern = "/uzi/device/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PATCH":
+										r.name = UziDeviceIDPatchOperation
+										r.summary = "обновить uzi аппарат"
+										r.operationID = ""
+										r.pathPattern = "/uzi/device/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 's': // Prefix: "s"
+
+								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = UziDevicesGetOperation
+										r.summary = "получит список uzi апппапапратов"
+										r.operationID = ""
+										r.pathPattern = "/uzi/devices"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
 
-				case 'i': // Prefix: "iler/dzi/"
+							}
 
-					if l := len("iler/dzi/"); len(elem) >= l && elem[0:l] == "iler/dzi/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							elem = origElem
+						case 'i': // Prefix: "image/"
+							origElem := elem
+							if l := len("image/"); len(elem) >= l && elem[0:l] == "image/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-					// Param: "file_path"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = TilerDziFilePathGetOperation
-							r.summary = "Получить DZI XML метаданные для изображения"
-							r.operationID = "TilerDziFilePathGet"
-							r.pathPattern = "/tiler/dzi/{file_path}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/files/"
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/nodes-segments"
 
-						if l := len("/files/"); len(elem) >= l && elem[0:l] == "/files/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								if l := len("/nodes-segments"); len(elem) >= l && elem[0:l] == "/nodes-segments" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						// Param: "level"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[1] = elem[:idx]
-						elem = elem[idx:]
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = UziImageIDNodesSegmentsGetOperation
+										r.summary = "получит узлы и сегменты на указанном изображении"
+										r.operationID = ""
+										r.pathPattern = "/uzi/image/{id}/nodes-segments"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+							}
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = origElem
+						case 'n': // Prefix: "nodes/"
+							origElem := elem
+							if l := len("nodes/"); len(elem) >= l && elem[0:l] == "nodes/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "col"
-							// Match until "_"
-							idx := strings.IndexByte(elem, '_')
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'm': // Prefix: "merge"
+								origElem := elem
+								if l := len("merge"); len(elem) >= l && elem[0:l] == "merge" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UziNodesMergePostOperation
+										r.summary = "слить узлы одного узи"
+										r.operationID = ""
+										r.pathPattern = "/uzi/nodes/merge"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "review"
+								origElem := elem
+								if l := len("review"); len(elem) >= l && elem[0:l] == "review" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UziNodesReviewPostOperation
+										r.summary = "провалидировать узлы нейросети пакетом"
+										r.operationID = ""
+										r.pathPattern = "/uzi/nodes/review"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
 							if idx < 0 {
 								idx = len(elem)
 							}
-							args[2] = elem[:idx]
+							args[0] = elem[:idx]
 							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								break
+								switch method {
+								case "DELETE":
+									r.name = UziNodesIDDeleteOperation
+									r.summary = "удалить узел"
+									r.operationID = ""
+									r.pathPattern = "/uzi/nodes/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PATCH":
+									r.name = UziNodesIDPatchOperation
+									r.summary = "обновить узел"
+									r.operationID = ""
+									r.pathPattern = "/uzi/nodes/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
 							}
 							switch elem[0] {
-							case '_': // Prefix: "_"
+							case '/': // Prefix: "/"
 
-								if l := len("_"); len(elem) >= l && elem[0:l] == "_" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "row"
-								// Match until "."
-								idx := strings.IndexByte(elem, '.')
-								if idx < 0 {
-									idx = len(elem)
-								}
-								args[3] = elem[:idx]
-								elem = elem[idx:]
-
 								if len(elem) == 0 {
 									break
 								}
 								switch elem[0] {
-								case '.': // Prefix: "."
+								case 'l': // Prefix: "link"
 
-									if l := len("."); len(elem) >= l && elem[0:l] == "." {
+									if l := len("link"); len(elem) >= l && elem[0:l] == "link" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "format"
-									// Leaf parameter, slashes are prohibited
-									idx := strings.IndexByte(elem, '/')
-									if idx >= 0 {
-										break
-									}
-									args[4] = elem
-									elem = ""
-
 									if len(elem) == 0 {
-										// Leaf node.
 										switch method {
-										case "GET":
-											r.name = TilerDziFilePathFilesLevelColRowFormatGetOperation
-											r.summary = "Получить тайл изображения"
-											r.operationID = "TilerDziFilePathFilesLevelColRowFormatGet"
-											r.pathPattern = "/tiler/dzi/{file_path}/files/{level}/{col}_{row}.{format}"
+										case "DELETE":
+											r.name = UziNodesIDLinkDeleteOperation
+											r.summary = "отвязать узел от цепочки наблюдений"
+											r.operationID = ""
+											r.pathPattern = "/uzi/nodes/{id}/link"
 											r.args = args
-											r.count = 5
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = UziNodesIDLinkPostOperation
+											r.summary = "связать узел с узлом из другого узи пациента"
+											r.operationID = ""
+											r.pathPattern = "/uzi/nodes/{id}/link"
+											r.args = args
+											r.count = 1
 											return r, true
 										default:
 											return
 										}
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/suggestions"
 
-								}
+										if l := len("/suggestions"); len(elem) >= l && elem[0:l] == "/suggestions" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = UziNodesIDLinkSuggestionsGetOperation
+												r.summary = "предложить узлы из других узи пациента для связывания"
+												r.operationID = ""
+												r.pathPattern = "/uzi/nodes/{id}/link/suggestions"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
 
-						}
+									}
 
-					}
+								case 's': // Prefix: "s"
 
-				}
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-			case 'u': // Prefix: "uzi"
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'e': // Prefix: "egment"
 
-				if l := len("uzi"); len(elem) >= l && elem[0:l] == "uzi" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+										if l := len("egment"); len(elem) >= l && elem[0:l] == "egment" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-				if len(elem) == 0 {
-					switch method {
-					case "POST":
-						r.name = UziPostOperation
-						r.summary = "загрузить узи на обработку"
-						r.operationID = ""
-						r.pathPattern = "/uzi"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '-': // Prefix: "-drafts"
+
+											if l := len("-drafts"); len(elem) >= l && elem[0:l] == "-drafts" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = UziNodesIDSegmentDraftsGetOperation
+													r.summary = "получить черновики сегментов узла"
+													r.operationID = ""
+													r.pathPattern = "/uzi/nodes/{id}/segment-drafts"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										case 's': // Prefix: "s"
+
+											if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = UziNodesIDSegmentsGetOperation
+													r.summary = "получить сегменты узла"
+													r.operationID = ""
+													r.pathPattern = "/uzi/nodes/{id}/segments"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'd': // Prefix: "device"
-						origElem := elem
-						if l := len("device"); len(elem) >= l && elem[0:l] == "device" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									case 'p': // Prefix: "plit"
+
+										if l := len("plit"); len(elem) >= l && elem[0:l] == "plit" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = UziNodesIDSplitPostOperation
+												r.summary = "выделить сегменты узла в новый узел"
+												r.operationID = ""
+												r.pathPattern = "/uzi/nodes/{id}/split"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 't': // Prefix: "tirads"
+
+									if l := len("tirads"); len(elem) >= l && elem[0:l] == "tirads" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = UziNodesIDTiradsGetOperation
+											r.summary = "получить оценку узла по ACR TI-RADS"
+											r.operationID = ""
+											r.pathPattern = "/uzi/nodes/{id}/tirads"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PUT":
+											r.name = UziNodesIDTiradsPutOperation
+											r.summary = "задать дескрипторы узла по ACR TI-RADS"
+											r.operationID = ""
+											r.pathPattern = "/uzi/nodes/{id}/tirads"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
 
-						if len(elem) == 0 {
-							switch method {
-							case "POST":
-								r.name = UziDevicePostOperation
-								r.summary = "добавить uzi аппарат"
-								r.operationID = ""
-								r.pathPattern = "/uzi/device"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
 							}
-						}
-						switch elem[0] {
-						case 's': // Prefix: "s"
 
-							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = origElem
+						case 's': // Prefix: "segment"
+							origElem := elem
+							if l := len("segment"); len(elem) >= l && elem[0:l] == "segment" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch method {
-								case "GET":
-									r.name = UziDevicesGetOperation
-									r.summary = "получит список uzi апппапапратов"
+								case "POST":
+									r.name = UziSegmentPostOperation
+									r.summary = "добавить новый сегмент"
 									r.operationID = ""
-									r.pathPattern = "/uzi/devices"
+									r.pathPattern = "/uzi/segment"
 									r.args = args
 									r.count = 0
 									return r, true
@@ -2901,66 +4266,135 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									return
 								}
 							}
+							switch elem[0] {
+							case '-': // Prefix: "-drafts/"
 
-						}
+								if l := len("-drafts/"); len(elem) >= l && elem[0:l] == "-drafts/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						elem = origElem
-					case 'i': // Prefix: "image/"
-						origElem := elem
-						if l := len("image/"); len(elem) >= l && elem[0:l] == "image/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
-						// Param: "id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										r.name = UziSegmentDraftsIDDeleteOperation
+										r.summary = "отклонить черновик"
+										r.operationID = ""
+										r.pathPattern = "/uzi/segment-drafts/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/accept"
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/nodes-segments"
+									if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-							if l := len("/nodes-segments"); len(elem) >= l && elem[0:l] == "/nodes-segments" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = UziSegmentDraftsIDAcceptPostOperation
+											r.summary = "подтвердить черновик"
+											r.operationID = ""
+											r.pathPattern = "/uzi/segment-drafts/{id}/accept"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = UziImageIDNodesSegmentsGetOperation
-									r.summary = "получит узлы и сегменты на указанном изображении"
-									r.operationID = ""
-									r.pathPattern = "/uzi/image/{id}/nodes-segments"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
 								}
-							}
 
-						}
+							case '/': // Prefix: "/"
 
-						elem = origElem
-					case 'n': // Prefix: "nodes/"
-						origElem := elem
-						if l := len("nodes/"); len(elem) >= l && elem[0:l] == "nodes/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										r.name = UziSegmentIDDeleteOperation
+										r.summary = "удалить сегмент"
+										r.operationID = ""
+										r.pathPattern = "/uzi/segment/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PATCH":
+										r.name = UziSegmentIDPatchOperation
+										r.summary = "обновить сегмент"
+										r.operationID = ""
+										r.pathPattern = "/uzi/segment/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/propagate"
+
+									if l := len("/propagate"); len(elem) >= l && elem[0:l] == "/propagate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = UziSegmentIDPropagatePostOperation
+											r.summary = "предложить контуры узла на соседних кадрах"
+											r.operationID = ""
+											r.pathPattern = "/uzi/segment/{id}/propagate"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
 
+							elem = origElem
+						}
 						// Param: "id"
 						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
@@ -2973,18 +4407,26 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "DELETE":
-								r.name = UziNodesIDDeleteOperation
-								r.summary = "удалить узел"
+								r.name = UziIDDeleteOperation
+								r.summary = "удалить узи"
+								r.operationID = ""
+								r.pathPattern = "/uzi/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = UziIDGetOperation
+								r.summary = "получить узи"
 								r.operationID = ""
-								r.pathPattern = "/uzi/nodes/{id}"
+								r.pathPattern = "/uzi/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
 							case "PATCH":
-								r.name = UziNodesIDPatchOperation
-								r.summary = "обновить узел"
+								r.name = UziIDPatchOperation
+								r.summary = "обновить узи"
 								r.operationID = ""
-								r.pathPattern = "/uzi/nodes/{id}"
+								r.pathPattern = "/uzi/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -2993,224 +4435,314 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/segments"
+						case '/': // Prefix: "/"
 
-							if l := len("/segments"); len(elem) >= l && elem[0:l] == "/segments" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = UziNodesIDSegmentsGetOperation
-									r.summary = "получить сегменты узла"
-									r.operationID = ""
-									r.pathPattern = "/uzi/nodes/{id}/segments"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "check"
+
+								if l := len("check"); len(elem) >= l && elem[0:l] == "check" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UziIDCheckPostOperation
+										r.summary = "отметить узи проверенным"
+										r.operationID = ""
+										r.pathPattern = "/uzi/{id}/check"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'e': // Prefix: "echographics"
+
+								if l := len("echographics"); len(elem) >= l && elem[0:l] == "echographics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = UziIDEchographicsGetOperation
+										r.summary = "получить эхографику uzi"
+										r.operationID = ""
+										r.pathPattern = "/uzi/{id}/echographics"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PATCH":
+										r.name = UziIDEchographicsPatchOperation
+										r.summary = "обновить эхографику"
+										r.operationID = ""
+										r.pathPattern = "/uzi/{id}/echographics"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'i': // Prefix: "images"
+
+								if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = UziIDImagesGetOperation
+										r.summary = "получает списк изображений uzi"
+										r.operationID = ""
+										r.pathPattern = "/uzi/{id}/images"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'n': // Prefix: "nodes"
+
+								if l := len("nodes"); len(elem) >= l && elem[0:l] == "nodes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = UziIDNodesGetOperation
+										r.summary = "получить все узлы узи"
+										r.operationID = ""
+										r.pathPattern = "/uzi/{id}/nodes"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '-': // Prefix: "-segments"
+
+									if l := len("-segments"); len(elem) >= l && elem[0:l] == "-segments" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = UziIDNodesSegmentsPostOperation
+											r.summary = "добавить узел с сегментами"
+											r.operationID = ""
+											r.pathPattern = "/uzi/{id}/nodes-segments"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case '/': // Prefix: "/accept"
+
+									if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = UziIDNodesAcceptPostOperation
+											r.summary = "принять узлы нейросети выше порога уверенности"
+											r.operationID = ""
+											r.pathPattern = "/uzi/{id}/nodes/accept"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'r': // Prefix: "reports"
+
+								if l := len("reports"); len(elem) >= l && elem[0:l] == "reports" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = UziIDReportsGetOperation
+										r.summary = "получить версии заключения по узи"
+										r.operationID = ""
+										r.pathPattern = "/uzi/{id}/reports"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = UziIDReportsPostOperation
+										r.summary = "сформировать новую версию заключения по узи"
+										r.operationID = ""
+										r.pathPattern = "/uzi/{id}/reports"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
 								}
+
 							}
 
 						}
 
-						elem = origElem
-					case 's': // Prefix: "segment"
-						origElem := elem
-						if l := len("segment"); len(elem) >= l && elem[0:l] == "segment" {
+					case 's': // Prefix: "s/"
+
+						if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
-							case "POST":
-								r.name = UziSegmentPostOperation
-								r.summary = "добавить новый сегмент"
-								r.operationID = ""
-								r.pathPattern = "/uzi/segment"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'a': // Prefix: "a"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "id"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
+							if len(elem) == 0 {
 								break
 							}
-							args[0] = elem
-							elem = ""
+							switch elem[0] {
+							case 'n': // Prefix: "nalytics"
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "DELETE":
-									r.name = UziSegmentIDDeleteOperation
-									r.summary = "удалить сегмент"
-									r.operationID = ""
-									r.pathPattern = "/uzi/segment/{id}"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "PATCH":
-									r.name = UziSegmentIDPatchOperation
-									r.summary = "обновить сегмент"
-									r.operationID = ""
-									r.pathPattern = "/uzi/segment/{id}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+								if l := len("nalytics"); len(elem) >= l && elem[0:l] == "nalytics" {
+									elem = elem[l:]
+								} else {
+									break
 								}
-							}
-
-						}
-
-						elem = origElem
-					}
-					// Param: "id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
 
-					if len(elem) == 0 {
-						switch method {
-						case "DELETE":
-							r.name = UziIDDeleteOperation
-							r.summary = "удалить узи"
-							r.operationID = ""
-							r.pathPattern = "/uzi/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "GET":
-							r.name = UziIDGetOperation
-							r.summary = "получить узи"
-							r.operationID = ""
-							r.pathPattern = "/uzi/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PATCH":
-							r.name = UziIDPatchOperation
-							r.summary = "обновить узи"
-							r.operationID = ""
-							r.pathPattern = "/uzi/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = UzisAnalyticsGetOperation
+										r.summary = "аналитика разметки узи"
+										r.operationID = ""
+										r.pathPattern = "/uzis/analytics"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+							case 'u': // Prefix: "uthor/"
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'e': // Prefix: "echographics"
+								if l := len("uthor/"); len(elem) >= l && elem[0:l] == "uthor/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							if l := len("echographics"); len(elem) >= l && elem[0:l] == "echographics" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = UziIDEchographicsGetOperation
-									r.summary = "получить эхографику uzi"
-									r.operationID = ""
-									r.pathPattern = "/uzi/{id}/echographics"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "PATCH":
-									r.name = UziIDEchographicsPatchOperation
-									r.summary = "обновить эхографику"
-									r.operationID = ""
-									r.pathPattern = "/uzi/{id}/echographics"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = UzisAuthorIDGetOperation
+										r.summary = "получить узи по id автора"
+										r.operationID = ""
+										r.pathPattern = "/uzis/author/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
 								}
+
 							}
 
-						case 'i': // Prefix: "images"
+						case 'e': // Prefix: "external/"
 
-							if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+							if l := len("external/"); len(elem) >= l && elem[0:l] == "external/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = UziIDImagesGetOperation
-									r.summary = "получает списк изображений uzi"
-									r.operationID = ""
-									r.pathPattern = "/uzi/{id}/images"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
-							}
-
-						case 'n': // Prefix: "nodes"
-
-							if l := len("nodes"); len(elem) >= l && elem[0:l] == "nodes" {
-								elem = elem[l:]
-							} else {
-								break
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
 							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									r.name = UziIDNodesGetOperation
-									r.summary = "получить все узлы узи"
+									r.name = UzisExternalIDGetOperation
+									r.summary = "получить узи по внешнему id"
 									r.operationID = ""
-									r.pathPattern = "/uzi/{id}/nodes"
+									r.pathPattern = "/uzis/external/{id}"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -3219,9 +4751,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 							switch elem[0] {
-							case '-': // Prefix: "-segments"
+							case '/': // Prefix: "/growth"
 
-								if l := len("-segments"); len(elem) >= l && elem[0:l] == "-segments" {
+								if l := len("/growth"); len(elem) >= l && elem[0:l] == "/growth" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3230,11 +4762,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "POST":
-										r.name = UziIDNodesSegmentsPostOperation
-										r.summary = "добавить узел с сегментами"
+									case "GET":
+										r.name = UzisExternalIDGrowthGetOperation
+										r.summary = "получить динамику отслеживаемых узлов пациента"
 										r.operationID = ""
-										r.pathPattern = "/uzi/{id}/nodes-segments"
+										r.pathPattern = "/uzis/external/{id}/growth"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -3245,86 +4777,30 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							}
 
-						}
-
-					}
-
-				case 's': // Prefix: "s/"
-
-					if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'a': // Prefix: "author/"
-
-						if l := len("author/"); len(elem) >= l && elem[0:l] == "author/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "id"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
+						case 's': // Prefix: "search"
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = UzisAuthorIDGetOperation
-								r.summary = "получить узи по id автора"
-								r.operationID = ""
-								r.pathPattern = "/uzis/author/{id}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
+							if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+								elem = elem[l:]
+							} else {
+								break
 							}
-						}
-
-					case 'e': // Prefix: "external/"
-
-						if l := len("external/"); len(elem) >= l && elem[0:l] == "external/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "id"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = UzisExternalIDGetOperation
-								r.summary = "получить узи по внешнему id"
-								r.operationID = ""
-								r.pathPattern = "/uzis/external/{id}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = UzisSearchGetOperation
+									r.summary = "поиск узи с фильтрами и постраничной выдачей"
+									r.operationID = ""
+									r.pathPattern = "/uzis/search"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
+
 						}
 
 					}
@@ -19,6 +19,33 @@ func (s *ErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// Метрики узи автора.
+// Ref: #/components/schemas/author_node_metrics
+type AuthorNodeMetrics struct {
+	AuthorID uuid.UUID   `json:"author_id"`
+	Metrics  NodeMetrics `json:"metrics"`
+}
+
+// GetAuthorID returns the value of AuthorID.
+func (s *AuthorNodeMetrics) GetAuthorID() uuid.UUID {
+	return s.AuthorID
+}
+
+// GetMetrics returns the value of Metrics.
+func (s *AuthorNodeMetrics) GetMetrics() NodeMetrics {
+	return s.Metrics
+}
+
+// SetAuthorID sets the value of AuthorID.
+func (s *AuthorNodeMetrics) SetAuthorID(val uuid.UUID) {
+	s.AuthorID = val
+}
+
+// SetMetrics sets the value of Metrics.
+func (s *AuthorNodeMetrics) SetMetrics(val NodeMetrics) {
+	s.Metrics = val
+}
+
 type BearerAuth struct {
 	Token string
 }
@@ -33,6 +60,55 @@ func (s *BearerAuth) SetToken(val string) {
 	s.Token = val
 }
 
+// Ограничивающий прямоугольник в пикселях изображения.
+// Ref: #/components/schemas/bounding_box
+type BoundingBox struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// GetX returns the value of X.
+func (s *BoundingBox) GetX() int {
+	return s.X
+}
+
+// GetY returns the value of Y.
+func (s *BoundingBox) GetY() int {
+	return s.Y
+}
+
+// GetWidth returns the value of Width.
+func (s *BoundingBox) GetWidth() int {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *BoundingBox) GetHeight() int {
+	return s.Height
+}
+
+// SetX sets the value of X.
+func (s *BoundingBox) SetX(val int) {
+	s.X = val
+}
+
+// SetY sets the value of Y.
+func (s *BoundingBox) SetY(val int) {
+	s.Y = val
+}
+
+// SetWidth sets the value of Width.
+func (s *BoundingBox) SetWidth(val int) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *BoundingBox) SetHeight(val int) {
+	s.Height = val
+}
+
 // Карта пациента.
 // Ref: #/components/schemas/card
 type Card struct {
@@ -77,35 +153,104 @@ func (s *Card) SetDiagnosis(val OptString) {
 func (*Card) medCardDoctorIDPatientIDGetRes()   {}
 func (*Card) medCardDoctorIDPatientIDPatchRes() {}
 
-type Contor []ContorItem
+// Контур сегмента, схема версии 1: GeoJSON Polygon в пикселях
+// исходного кадра (x вправо, y вниз).
+// Одно замкнутое кольцо без дыр, точки против часовой
+// стрелки в осях x, y.
+// При записи контур исправляется: кольцо замыкается,
+// повторы точек убираются, порядок точек
+// разворачивается,
+// контур обрезается по границам кадра.
+// Самопересекающийся или вырожденный контур
+// отклоняется.
+// Ref: #/components/schemas/contor
+type Contor struct {
+	Type ContorType `json:"type"`
+	// Версия схемы контура.
+	Version     ContorVersion `json:"version"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
 
-type ContorItem struct {
-	// X координата точки.
-	X int `json:"x"`
-	// Y координата точки.
-	Y int `json:"y"`
+// GetType returns the value of Type.
+func (s *Contor) GetType() ContorType {
+	return s.Type
 }
 
-// GetX returns the value of X.
-func (s *ContorItem) GetX() int {
-	return s.X
+// GetVersion returns the value of Version.
+func (s *Contor) GetVersion() ContorVersion {
+	return s.Version
 }
 
-// GetY returns the value of Y.
-func (s *ContorItem) GetY() int {
-	return s.Y
+// GetCoordinates returns the value of Coordinates.
+func (s *Contor) GetCoordinates() [][][]float64 {
+	return s.Coordinates
 }
 
-// SetX sets the value of X.
-func (s *ContorItem) SetX(val int) {
-	s.X = val
+// SetType sets the value of Type.
+func (s *Contor) SetType(val ContorType) {
+	s.Type = val
 }
 
-// SetY sets the value of Y.
-func (s *ContorItem) SetY(val int) {
-	s.Y = val
+// SetVersion sets the value of Version.
+func (s *Contor) SetVersion(val ContorVersion) {
+	s.Version = val
+}
+
+// SetCoordinates sets the value of Coordinates.
+func (s *Contor) SetCoordinates(val [][][]float64) {
+	s.Coordinates = val
+}
+
+type ContorType string
+
+const (
+	ContorTypePolygon ContorType = "Polygon"
+)
+
+// AllValues returns all ContorType values.
+func (ContorType) AllValues() []ContorType {
+	return []ContorType{
+		ContorTypePolygon,
+	}
 }
 
+// MarshalText implements encoding.TextMarshaler.
+func (s ContorType) MarshalText() ([]byte, error) {
+	switch s {
+	case ContorTypePolygon:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ContorType) UnmarshalText(data []byte) error {
+	switch ContorType(data) {
+	case ContorTypePolygon:
+		*s = ContorTypePolygon
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Версия схемы контура.
+type ContorVersion int
+
+const (
+	ContorVersion1 ContorVersion = 1
+)
+
+// AllValues returns all ContorVersion values.
+func (ContorVersion) AllValues() []ContorVersion {
+	return []ContorVersion{
+		ContorVersion1,
+	}
+}
+
+type CytologyColor string
+
 type CytologyCopyCreateBadRequest ErrorStatusCode
 
 func (*CytologyCopyCreateBadRequest) cytologyCopyCreateRes() {}
@@ -183,6 +328,10 @@ type CytologyCreateCreateBadRequest ErrorStatusCode
 
 func (*CytologyCreateCreateBadRequest) cytologyCreateCreateRes() {}
 
+type CytologyCreateCreateConflict ErrorStatusCode
+
+func (*CytologyCreateCreateConflict) cytologyCreateCreateRes() {}
+
 type CytologyCreateCreateCreated struct {
 	ID                OptUUID                                         `json:"id"`
 	Image             OptURI                                          `json:"image"`
@@ -452,8 +601,11 @@ type CytologyCreateCreateInternalServerError ErrorStatusCode
 func (*CytologyCreateCreateInternalServerError) cytologyCreateCreateRes() {}
 
 type CytologyCreateCreateReq struct {
-	// Файл изображения цитологии.
+	// Файл изображения цитологии. не указывается вместе с
+	// upload_id.
 	Image OptMultipartFile `json:"image"`
+	// Id завершенной загрузки частями вместо image, см. /uploads.
+	UploadID OptUUID `json:"upload_id"`
 	// Номер диагностики.
 	DiagnosticNumber int `json:"diagnostic_number"`
 	// Маркировка диагностики.
@@ -477,6 +629,11 @@ func (s *CytologyCreateCreateReq) GetImage() OptMultipartFile {
 	return s.Image
 }
 
+// GetUploadID returns the value of UploadID.
+func (s *CytologyCreateCreateReq) GetUploadID() OptUUID {
+	return s.UploadID
+}
+
 // GetDiagnosticNumber returns the value of DiagnosticNumber.
 func (s *CytologyCreateCreateReq) GetDiagnosticNumber() int {
 	return s.DiagnosticNumber
@@ -542,6 +699,11 @@ func (s *CytologyCreateCreateReq) SetImage(val OptMultipartFile) {
 	s.Image = val
 }
 
+// SetUploadID sets the value of UploadID.
+func (s *CytologyCreateCreateReq) SetUploadID(val OptUUID) {
+	s.UploadID = val
+}
+
 // SetDiagnosticNumber sets the value of DiagnosticNumber.
 func (s *CytologyCreateCreateReq) SetDiagnosticNumber(val int) {
 	s.DiagnosticNumber = val
@@ -710,6 +872,66 @@ type CytologyCreateCreateUnprocessableEntity ErrorStatusCode
 
 func (*CytologyCreateCreateUnprocessableEntity) cytologyCreateCreateRes() {}
 
+// Тип геометрии сегментации в терминах GeoJSON.
+// Точки всех колец идут по порядку, polygon - номер полигона
+// в MultiPolygon, ring - номер кольца в полигоне (0 - внешний
+// контур, остальные - дыры).
+// Ref: #/components/schemas/cytology_geometry_type
+type CytologyGeometryType string
+
+const (
+	CytologyGeometryTypePoint        CytologyGeometryType = "Point"
+	CytologyGeometryTypeLineString   CytologyGeometryType = "LineString"
+	CytologyGeometryTypePolygon      CytologyGeometryType = "Polygon"
+	CytologyGeometryTypeMultiPolygon CytologyGeometryType = "MultiPolygon"
+)
+
+// AllValues returns all CytologyGeometryType values.
+func (CytologyGeometryType) AllValues() []CytologyGeometryType {
+	return []CytologyGeometryType{
+		CytologyGeometryTypePoint,
+		CytologyGeometryTypeLineString,
+		CytologyGeometryTypePolygon,
+		CytologyGeometryTypeMultiPolygon,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CytologyGeometryType) MarshalText() ([]byte, error) {
+	switch s {
+	case CytologyGeometryTypePoint:
+		return []byte(s), nil
+	case CytologyGeometryTypeLineString:
+		return []byte(s), nil
+	case CytologyGeometryTypePolygon:
+		return []byte(s), nil
+	case CytologyGeometryTypeMultiPolygon:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CytologyGeometryType) UnmarshalText(data []byte) error {
+	switch CytologyGeometryType(data) {
+	case CytologyGeometryTypePoint:
+		*s = CytologyGeometryTypePoint
+		return nil
+	case CytologyGeometryTypeLineString:
+		*s = CytologyGeometryTypeLineString
+		return nil
+	case CytologyGeometryTypePolygon:
+		*s = CytologyGeometryTypePolygon
+		return nil
+	case CytologyGeometryTypeMultiPolygon:
+		*s = CytologyGeometryTypeMultiPolygon
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type CytologyHistoryReadInternalServerError ErrorStatusCode
 
 func (*CytologyHistoryReadInternalServerError) cytologyHistoryReadRes() {}
@@ -1329,6 +1551,146 @@ func (s *CytologyPatientShotsReadOK) SetShots(val []CytologyPatientShot) {
 
 func (*CytologyPatientShotsReadOK) cytologyPatientShotsReadRes() {}
 
+type CytologyQuPathExportInternalServerError ErrorStatusCode
+
+func (*CytologyQuPathExportInternalServerError) cytologyQuPathExportRes() {}
+
+type CytologyQuPathExportNotFound ErrorStatusCode
+
+func (*CytologyQuPathExportNotFound) cytologyQuPathExportRes() {}
+
+type CytologyQuPathExportOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CytologyQuPathExportOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*CytologyQuPathExportOK) cytologyQuPathExportRes() {}
+
+type CytologyQuPathImportBadRequest ErrorStatusCode
+
+func (*CytologyQuPathImportBadRequest) cytologyQuPathImportRes() {}
+
+type CytologyQuPathImportInternalServerError ErrorStatusCode
+
+func (*CytologyQuPathImportInternalServerError) cytologyQuPathImportRes() {}
+
+type CytologyQuPathImportNotFound ErrorStatusCode
+
+func (*CytologyQuPathImportNotFound) cytologyQuPathImportRes() {}
+
+type CytologyQuPathImportReq struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CytologyQuPathImportReq) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type CytologyQuPathImportUnprocessableEntity ErrorStatusCode
+
+func (*CytologyQuPathImportUnprocessableEntity) cytologyQuPathImportRes() {}
+
+// Итог импорта GeoJSON из QuPath.
+// Ref: #/components/schemas/cytology_qupath_import
+type CytologyQupathImport struct {
+	// Id новой версии исследования.
+	ID        uuid.UUID `json:"id"`
+	Added     int       `json:"added"`
+	Removed   int       `json:"removed"`
+	Changed   int       `json:"changed"`
+	Unchanged int       `json:"unchanged"`
+	// Features без класса, геометрии или с неизвестным классом.
+	Skipped        int      `json:"skipped"`
+	UnknownClasses []string `json:"unknown_classes"`
+}
+
+// GetID returns the value of ID.
+func (s *CytologyQupathImport) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetAdded returns the value of Added.
+func (s *CytologyQupathImport) GetAdded() int {
+	return s.Added
+}
+
+// GetRemoved returns the value of Removed.
+func (s *CytologyQupathImport) GetRemoved() int {
+	return s.Removed
+}
+
+// GetChanged returns the value of Changed.
+func (s *CytologyQupathImport) GetChanged() int {
+	return s.Changed
+}
+
+// GetUnchanged returns the value of Unchanged.
+func (s *CytologyQupathImport) GetUnchanged() int {
+	return s.Unchanged
+}
+
+// GetSkipped returns the value of Skipped.
+func (s *CytologyQupathImport) GetSkipped() int {
+	return s.Skipped
+}
+
+// GetUnknownClasses returns the value of UnknownClasses.
+func (s *CytologyQupathImport) GetUnknownClasses() []string {
+	return s.UnknownClasses
+}
+
+// SetID sets the value of ID.
+func (s *CytologyQupathImport) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetAdded sets the value of Added.
+func (s *CytologyQupathImport) SetAdded(val int) {
+	s.Added = val
+}
+
+// SetRemoved sets the value of Removed.
+func (s *CytologyQupathImport) SetRemoved(val int) {
+	s.Removed = val
+}
+
+// SetChanged sets the value of Changed.
+func (s *CytologyQupathImport) SetChanged(val int) {
+	s.Changed = val
+}
+
+// SetUnchanged sets the value of Unchanged.
+func (s *CytologyQupathImport) SetUnchanged(val int) {
+	s.Unchanged = val
+}
+
+// SetSkipped sets the value of Skipped.
+func (s *CytologyQupathImport) SetSkipped(val int) {
+	s.Skipped = val
+}
+
+// SetUnknownClasses sets the value of UnknownClasses.
+func (s *CytologyQupathImport) SetUnknownClasses(val []string) {
+	s.UnknownClasses = val
+}
+
+func (*CytologyQupathImport) cytologyQuPathImportRes() {}
+
 type CytologyReadInternalServerError ErrorStatusCode
 
 func (*CytologyReadInternalServerError) cytologyReadRes() {}
@@ -1778,8 +2140,10 @@ func (s *CytologySegmentGroupCreateCreateCreatedData) SetPoints(val []CytologySe
 }
 
 type CytologySegmentGroupCreateCreateCreatedDataPointsItem struct {
-	X int `json:"x"`
-	Y int `json:"y"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Polygon OptInt `json:"polygon"`
+	Ring    OptInt `json:"ring"`
 }
 
 // GetX returns the value of X.
@@ -1792,6 +2156,16 @@ func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) GetY() int {
 	return s.Y
 }
 
+// GetPolygon returns the value of Polygon.
+func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) GetPolygon() OptInt {
+	return s.Polygon
+}
+
+// GetRing returns the value of Ring.
+func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) GetRing() OptInt {
+	return s.Ring
+}
+
 // SetX sets the value of X.
 func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) SetX(val int) {
 	s.X = val
@@ -1802,6 +2176,16 @@ func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) SetY(val int) {
 	s.Y = val
 }
 
+// SetPolygon sets the value of Polygon.
+func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) SetPolygon(val OptInt) {
+	s.Polygon = val
+}
+
+// SetRing sets the value of Ring.
+func (s *CytologySegmentGroupCreateCreateCreatedDataPointsItem) SetRing(val OptInt) {
+	s.Ring = val
+}
+
 type CytologySegmentGroupCreateCreateInternalServerError ErrorStatusCode
 
 func (*CytologySegmentGroupCreateCreateInternalServerError) cytologySegmentGroupCreateCreateRes() {}
@@ -1836,7 +2220,13 @@ func (s *CytologySegmentGroupCreateCreateReq) SetSegType(val CytologySegmentGrou
 }
 
 type CytologySegmentGroupCreateCreateReqData struct {
-	Points []CytologySegmentGroupCreateCreateReqDataPointsItem `json:"points"`
+	Points       []CytologySegmentGroupCreateCreateReqDataPointsItem `json:"points"`
+	GeometryType OptCytologyGeometryType                             `json:"geometry_type"`
+	Color        OptCytologyColor                                    `json:"color"`
+	IsLocked     OptBool                                             `json:"is_locked"`
+	// Уверенность модели.
+	Confidence OptFloat64                   `json:"confidence"`
+	Properties OptCytologySegmentProperties `json:"properties"`
 }
 
 // GetPoints returns the value of Points.
@@ -1844,14 +2234,66 @@ func (s *CytologySegmentGroupCreateCreateReqData) GetPoints() []CytologySegmentG
 	return s.Points
 }
 
+// GetGeometryType returns the value of GeometryType.
+func (s *CytologySegmentGroupCreateCreateReqData) GetGeometryType() OptCytologyGeometryType {
+	return s.GeometryType
+}
+
+// GetColor returns the value of Color.
+func (s *CytologySegmentGroupCreateCreateReqData) GetColor() OptCytologyColor {
+	return s.Color
+}
+
+// GetIsLocked returns the value of IsLocked.
+func (s *CytologySegmentGroupCreateCreateReqData) GetIsLocked() OptBool {
+	return s.IsLocked
+}
+
+// GetConfidence returns the value of Confidence.
+func (s *CytologySegmentGroupCreateCreateReqData) GetConfidence() OptFloat64 {
+	return s.Confidence
+}
+
+// GetProperties returns the value of Properties.
+func (s *CytologySegmentGroupCreateCreateReqData) GetProperties() OptCytologySegmentProperties {
+	return s.Properties
+}
+
 // SetPoints sets the value of Points.
 func (s *CytologySegmentGroupCreateCreateReqData) SetPoints(val []CytologySegmentGroupCreateCreateReqDataPointsItem) {
 	s.Points = val
 }
 
+// SetGeometryType sets the value of GeometryType.
+func (s *CytologySegmentGroupCreateCreateReqData) SetGeometryType(val OptCytologyGeometryType) {
+	s.GeometryType = val
+}
+
+// SetColor sets the value of Color.
+func (s *CytologySegmentGroupCreateCreateReqData) SetColor(val OptCytologyColor) {
+	s.Color = val
+}
+
+// SetIsLocked sets the value of IsLocked.
+func (s *CytologySegmentGroupCreateCreateReqData) SetIsLocked(val OptBool) {
+	s.IsLocked = val
+}
+
+// SetConfidence sets the value of Confidence.
+func (s *CytologySegmentGroupCreateCreateReqData) SetConfidence(val OptFloat64) {
+	s.Confidence = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *CytologySegmentGroupCreateCreateReqData) SetProperties(val OptCytologySegmentProperties) {
+	s.Properties = val
+}
+
 type CytologySegmentGroupCreateCreateReqDataPointsItem struct {
-	X int `json:"x"`
-	Y int `json:"y"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Polygon OptInt `json:"polygon"`
+	Ring    OptInt `json:"ring"`
 }
 
 // GetX returns the value of X.
@@ -1864,6 +2306,16 @@ func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) GetY() int {
 	return s.Y
 }
 
+// GetPolygon returns the value of Polygon.
+func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) GetPolygon() OptInt {
+	return s.Polygon
+}
+
+// GetRing returns the value of Ring.
+func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) GetRing() OptInt {
+	return s.Ring
+}
+
 // SetX sets the value of X.
 func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) SetX(val int) {
 	s.X = val
@@ -1874,6 +2326,16 @@ func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) SetY(val int) {
 	s.Y = val
 }
 
+// SetPolygon sets the value of Polygon.
+func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) SetPolygon(val OptInt) {
+	s.Polygon = val
+}
+
+// SetRing sets the value of Ring.
+func (s *CytologySegmentGroupCreateCreateReqDataPointsItem) SetRing(val OptInt) {
+	s.Ring = val
+}
+
 type CytologySegmentGroupCreateCreateReqSegType string
 
 const (
@@ -2017,6 +2479,19 @@ type CytologySegmentGroupCreateCreateUnprocessableEntity ErrorStatusCode
 
 func (*CytologySegmentGroupCreateCreateUnprocessableEntity) cytologySegmentGroupCreateCreateRes() {}
 
+// Произвольные properties аннотации.
+// Ref: #/components/schemas/cytology_segment_properties
+type CytologySegmentProperties map[string]jx.Raw
+
+func (s *CytologySegmentProperties) init() CytologySegmentProperties {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
 type CytologySegmentUpdateDeleteInternalServerError ErrorStatusCode
 
 func (*CytologySegmentUpdateDeleteInternalServerError) cytologySegmentUpdateDeleteRes() {}
@@ -2046,6 +2521,12 @@ func (*CytologySegmentUpdatePartialUpdateNotFound) cytologySegmentUpdatePartialU
 type CytologySegmentUpdatePartialUpdateOK struct {
 	Points       []CytologySegmentUpdatePartialUpdateOKPointsItem `json:"points"`
 	SegmentGroup OptInt                                           `json:"segment_group"`
+	GeometryType OptCytologyGeometryType                          `json:"geometry_type"`
+	Color        OptCytologyColor                                 `json:"color"`
+	IsLocked     OptBool                                          `json:"is_locked"`
+	// Уверенность модели.
+	Confidence OptFloat64                   `json:"confidence"`
+	Properties OptCytologySegmentProperties `json:"properties"`
 }
 
 // GetPoints returns the value of Points.
@@ -2058,6 +2539,31 @@ func (s *CytologySegmentUpdatePartialUpdateOK) GetSegmentGroup() OptInt {
 	return s.SegmentGroup
 }
 
+// GetGeometryType returns the value of GeometryType.
+func (s *CytologySegmentUpdatePartialUpdateOK) GetGeometryType() OptCytologyGeometryType {
+	return s.GeometryType
+}
+
+// GetColor returns the value of Color.
+func (s *CytologySegmentUpdatePartialUpdateOK) GetColor() OptCytologyColor {
+	return s.Color
+}
+
+// GetIsLocked returns the value of IsLocked.
+func (s *CytologySegmentUpdatePartialUpdateOK) GetIsLocked() OptBool {
+	return s.IsLocked
+}
+
+// GetConfidence returns the value of Confidence.
+func (s *CytologySegmentUpdatePartialUpdateOK) GetConfidence() OptFloat64 {
+	return s.Confidence
+}
+
+// GetProperties returns the value of Properties.
+func (s *CytologySegmentUpdatePartialUpdateOK) GetProperties() OptCytologySegmentProperties {
+	return s.Properties
+}
+
 // SetPoints sets the value of Points.
 func (s *CytologySegmentUpdatePartialUpdateOK) SetPoints(val []CytologySegmentUpdatePartialUpdateOKPointsItem) {
 	s.Points = val
@@ -2068,23 +2574,60 @@ func (s *CytologySegmentUpdatePartialUpdateOK) SetSegmentGroup(val OptInt) {
 	s.SegmentGroup = val
 }
 
-func (*CytologySegmentUpdatePartialUpdateOK) cytologySegmentUpdatePartialUpdateRes() {}
+// SetGeometryType sets the value of GeometryType.
+func (s *CytologySegmentUpdatePartialUpdateOK) SetGeometryType(val OptCytologyGeometryType) {
+	s.GeometryType = val
+}
 
-type CytologySegmentUpdatePartialUpdateOKPointsItem struct {
-	X int `json:"x"`
-	Y int `json:"y"`
+// SetColor sets the value of Color.
+func (s *CytologySegmentUpdatePartialUpdateOK) SetColor(val OptCytologyColor) {
+	s.Color = val
 }
 
-// GetX returns the value of X.
-func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) GetX() int {
-	return s.X
+// SetIsLocked sets the value of IsLocked.
+func (s *CytologySegmentUpdatePartialUpdateOK) SetIsLocked(val OptBool) {
+	s.IsLocked = val
 }
 
-// GetY returns the value of Y.
+// SetConfidence sets the value of Confidence.
+func (s *CytologySegmentUpdatePartialUpdateOK) SetConfidence(val OptFloat64) {
+	s.Confidence = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *CytologySegmentUpdatePartialUpdateOK) SetProperties(val OptCytologySegmentProperties) {
+	s.Properties = val
+}
+
+func (*CytologySegmentUpdatePartialUpdateOK) cytologySegmentUpdatePartialUpdateRes() {}
+
+type CytologySegmentUpdatePartialUpdateOKPointsItem struct {
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Polygon OptInt `json:"polygon"`
+	Ring    OptInt `json:"ring"`
+}
+
+// GetX returns the value of X.
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) GetX() int {
+	return s.X
+}
+
+// GetY returns the value of Y.
 func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) GetY() int {
 	return s.Y
 }
 
+// GetPolygon returns the value of Polygon.
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) GetPolygon() OptInt {
+	return s.Polygon
+}
+
+// GetRing returns the value of Ring.
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) GetRing() OptInt {
+	return s.Ring
+}
+
 // SetX sets the value of X.
 func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) SetX(val int) {
 	s.X = val
@@ -2095,9 +2638,25 @@ func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) SetY(val int) {
 	s.Y = val
 }
 
+// SetPolygon sets the value of Polygon.
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) SetPolygon(val OptInt) {
+	s.Polygon = val
+}
+
+// SetRing sets the value of Ring.
+func (s *CytologySegmentUpdatePartialUpdateOKPointsItem) SetRing(val OptInt) {
+	s.Ring = val
+}
+
 type CytologySegmentUpdatePartialUpdateReq struct {
 	Points       []CytologySegmentUpdatePartialUpdateReqPointsItem `json:"points"`
 	SegmentGroup OptInt                                            `json:"segment_group"`
+	GeometryType OptCytologyGeometryType                           `json:"geometry_type"`
+	Color        OptCytologyColor                                  `json:"color"`
+	IsLocked     OptBool                                           `json:"is_locked"`
+	// Уверенность модели.
+	Confidence OptFloat64                   `json:"confidence"`
+	Properties OptCytologySegmentProperties `json:"properties"`
 }
 
 // GetPoints returns the value of Points.
@@ -2110,6 +2669,31 @@ func (s *CytologySegmentUpdatePartialUpdateReq) GetSegmentGroup() OptInt {
 	return s.SegmentGroup
 }
 
+// GetGeometryType returns the value of GeometryType.
+func (s *CytologySegmentUpdatePartialUpdateReq) GetGeometryType() OptCytologyGeometryType {
+	return s.GeometryType
+}
+
+// GetColor returns the value of Color.
+func (s *CytologySegmentUpdatePartialUpdateReq) GetColor() OptCytologyColor {
+	return s.Color
+}
+
+// GetIsLocked returns the value of IsLocked.
+func (s *CytologySegmentUpdatePartialUpdateReq) GetIsLocked() OptBool {
+	return s.IsLocked
+}
+
+// GetConfidence returns the value of Confidence.
+func (s *CytologySegmentUpdatePartialUpdateReq) GetConfidence() OptFloat64 {
+	return s.Confidence
+}
+
+// GetProperties returns the value of Properties.
+func (s *CytologySegmentUpdatePartialUpdateReq) GetProperties() OptCytologySegmentProperties {
+	return s.Properties
+}
+
 // SetPoints sets the value of Points.
 func (s *CytologySegmentUpdatePartialUpdateReq) SetPoints(val []CytologySegmentUpdatePartialUpdateReqPointsItem) {
 	s.Points = val
@@ -2120,9 +2704,36 @@ func (s *CytologySegmentUpdatePartialUpdateReq) SetSegmentGroup(val OptInt) {
 	s.SegmentGroup = val
 }
 
+// SetGeometryType sets the value of GeometryType.
+func (s *CytologySegmentUpdatePartialUpdateReq) SetGeometryType(val OptCytologyGeometryType) {
+	s.GeometryType = val
+}
+
+// SetColor sets the value of Color.
+func (s *CytologySegmentUpdatePartialUpdateReq) SetColor(val OptCytologyColor) {
+	s.Color = val
+}
+
+// SetIsLocked sets the value of IsLocked.
+func (s *CytologySegmentUpdatePartialUpdateReq) SetIsLocked(val OptBool) {
+	s.IsLocked = val
+}
+
+// SetConfidence sets the value of Confidence.
+func (s *CytologySegmentUpdatePartialUpdateReq) SetConfidence(val OptFloat64) {
+	s.Confidence = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *CytologySegmentUpdatePartialUpdateReq) SetProperties(val OptCytologySegmentProperties) {
+	s.Properties = val
+}
+
 type CytologySegmentUpdatePartialUpdateReqPointsItem struct {
-	X int `json:"x"`
-	Y int `json:"y"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Polygon OptInt `json:"polygon"`
+	Ring    OptInt `json:"ring"`
 }
 
 // GetX returns the value of X.
@@ -2135,6 +2746,16 @@ func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) GetY() int {
 	return s.Y
 }
 
+// GetPolygon returns the value of Polygon.
+func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) GetPolygon() OptInt {
+	return s.Polygon
+}
+
+// GetRing returns the value of Ring.
+func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) GetRing() OptInt {
+	return s.Ring
+}
+
 // SetX sets the value of X.
 func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) SetX(val int) {
 	s.X = val
@@ -2145,6 +2766,16 @@ func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) SetY(val int) {
 	s.Y = val
 }
 
+// SetPolygon sets the value of Polygon.
+func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) SetPolygon(val OptInt) {
+	s.Polygon = val
+}
+
+// SetRing sets the value of Ring.
+func (s *CytologySegmentUpdatePartialUpdateReqPointsItem) SetRing(val OptInt) {
+	s.Ring = val
+}
+
 type CytologySegmentUpdateReadInternalServerError ErrorStatusCode
 
 func (*CytologySegmentUpdateReadInternalServerError) cytologySegmentUpdateReadRes() {}
@@ -2156,6 +2787,12 @@ func (*CytologySegmentUpdateReadNotFound) cytologySegmentUpdateReadRes() {}
 type CytologySegmentUpdateReadOK struct {
 	Points       []CytologySegmentUpdateReadOKPointsItem `json:"points"`
 	SegmentGroup OptInt                                  `json:"segment_group"`
+	GeometryType OptCytologyGeometryType                 `json:"geometry_type"`
+	Color        OptCytologyColor                        `json:"color"`
+	IsLocked     OptBool                                 `json:"is_locked"`
+	// Уверенность модели.
+	Confidence OptFloat64                   `json:"confidence"`
+	Properties OptCytologySegmentProperties `json:"properties"`
 }
 
 // GetPoints returns the value of Points.
@@ -2168,6 +2805,31 @@ func (s *CytologySegmentUpdateReadOK) GetSegmentGroup() OptInt {
 	return s.SegmentGroup
 }
 
+// GetGeometryType returns the value of GeometryType.
+func (s *CytologySegmentUpdateReadOK) GetGeometryType() OptCytologyGeometryType {
+	return s.GeometryType
+}
+
+// GetColor returns the value of Color.
+func (s *CytologySegmentUpdateReadOK) GetColor() OptCytologyColor {
+	return s.Color
+}
+
+// GetIsLocked returns the value of IsLocked.
+func (s *CytologySegmentUpdateReadOK) GetIsLocked() OptBool {
+	return s.IsLocked
+}
+
+// GetConfidence returns the value of Confidence.
+func (s *CytologySegmentUpdateReadOK) GetConfidence() OptFloat64 {
+	return s.Confidence
+}
+
+// GetProperties returns the value of Properties.
+func (s *CytologySegmentUpdateReadOK) GetProperties() OptCytologySegmentProperties {
+	return s.Properties
+}
+
 // SetPoints sets the value of Points.
 func (s *CytologySegmentUpdateReadOK) SetPoints(val []CytologySegmentUpdateReadOKPointsItem) {
 	s.Points = val
@@ -2178,11 +2840,38 @@ func (s *CytologySegmentUpdateReadOK) SetSegmentGroup(val OptInt) {
 	s.SegmentGroup = val
 }
 
+// SetGeometryType sets the value of GeometryType.
+func (s *CytologySegmentUpdateReadOK) SetGeometryType(val OptCytologyGeometryType) {
+	s.GeometryType = val
+}
+
+// SetColor sets the value of Color.
+func (s *CytologySegmentUpdateReadOK) SetColor(val OptCytologyColor) {
+	s.Color = val
+}
+
+// SetIsLocked sets the value of IsLocked.
+func (s *CytologySegmentUpdateReadOK) SetIsLocked(val OptBool) {
+	s.IsLocked = val
+}
+
+// SetConfidence sets the value of Confidence.
+func (s *CytologySegmentUpdateReadOK) SetConfidence(val OptFloat64) {
+	s.Confidence = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *CytologySegmentUpdateReadOK) SetProperties(val OptCytologySegmentProperties) {
+	s.Properties = val
+}
+
 func (*CytologySegmentUpdateReadOK) cytologySegmentUpdateReadRes() {}
 
 type CytologySegmentUpdateReadOKPointsItem struct {
-	X int `json:"x"`
-	Y int `json:"y"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Polygon OptInt `json:"polygon"`
+	Ring    OptInt `json:"ring"`
 }
 
 // GetX returns the value of X.
@@ -2195,6 +2884,16 @@ func (s *CytologySegmentUpdateReadOKPointsItem) GetY() int {
 	return s.Y
 }
 
+// GetPolygon returns the value of Polygon.
+func (s *CytologySegmentUpdateReadOKPointsItem) GetPolygon() OptInt {
+	return s.Polygon
+}
+
+// GetRing returns the value of Ring.
+func (s *CytologySegmentUpdateReadOKPointsItem) GetRing() OptInt {
+	return s.Ring
+}
+
 // SetX sets the value of X.
 func (s *CytologySegmentUpdateReadOKPointsItem) SetX(val int) {
 	s.X = val
@@ -2205,6 +2904,16 @@ func (s *CytologySegmentUpdateReadOKPointsItem) SetY(val int) {
 	s.Y = val
 }
 
+// SetPolygon sets the value of Polygon.
+func (s *CytologySegmentUpdateReadOKPointsItem) SetPolygon(val OptInt) {
+	s.Polygon = val
+}
+
+// SetRing sets the value of Ring.
+func (s *CytologySegmentUpdateReadOKPointsItem) SetRing(val OptInt) {
+	s.Ring = val
+}
+
 type CytologySegmentUpdateUpdateBadRequest ErrorStatusCode
 
 func (*CytologySegmentUpdateUpdateBadRequest) cytologySegmentUpdateUpdateRes() {}
@@ -2220,6 +2929,12 @@ func (*CytologySegmentUpdateUpdateNotFound) cytologySegmentUpdateUpdateRes() {}
 type CytologySegmentUpdateUpdateOK struct {
 	Points       []CytologySegmentUpdateUpdateOKPointsItem `json:"points"`
 	SegmentGroup OptInt                                    `json:"segment_group"`
+	GeometryType OptCytologyGeometryType                   `json:"geometry_type"`
+	Color        OptCytologyColor                          `json:"color"`
+	IsLocked     OptBool                                   `json:"is_locked"`
+	// Уверенность модели.
+	Confidence OptFloat64                   `json:"confidence"`
+	Properties OptCytologySegmentProperties `json:"properties"`
 }
 
 // GetPoints returns the value of Points.
@@ -2232,6 +2947,31 @@ func (s *CytologySegmentUpdateUpdateOK) GetSegmentGroup() OptInt {
 	return s.SegmentGroup
 }
 
+// GetGeometryType returns the value of GeometryType.
+func (s *CytologySegmentUpdateUpdateOK) GetGeometryType() OptCytologyGeometryType {
+	return s.GeometryType
+}
+
+// GetColor returns the value of Color.
+func (s *CytologySegmentUpdateUpdateOK) GetColor() OptCytologyColor {
+	return s.Color
+}
+
+// GetIsLocked returns the value of IsLocked.
+func (s *CytologySegmentUpdateUpdateOK) GetIsLocked() OptBool {
+	return s.IsLocked
+}
+
+// GetConfidence returns the value of Confidence.
+func (s *CytologySegmentUpdateUpdateOK) GetConfidence() OptFloat64 {
+	return s.Confidence
+}
+
+// GetProperties returns the value of Properties.
+func (s *CytologySegmentUpdateUpdateOK) GetProperties() OptCytologySegmentProperties {
+	return s.Properties
+}
+
 // SetPoints sets the value of Points.
 func (s *CytologySegmentUpdateUpdateOK) SetPoints(val []CytologySegmentUpdateUpdateOKPointsItem) {
 	s.Points = val
@@ -2242,11 +2982,38 @@ func (s *CytologySegmentUpdateUpdateOK) SetSegmentGroup(val OptInt) {
 	s.SegmentGroup = val
 }
 
+// SetGeometryType sets the value of GeometryType.
+func (s *CytologySegmentUpdateUpdateOK) SetGeometryType(val OptCytologyGeometryType) {
+	s.GeometryType = val
+}
+
+// SetColor sets the value of Color.
+func (s *CytologySegmentUpdateUpdateOK) SetColor(val OptCytologyColor) {
+	s.Color = val
+}
+
+// SetIsLocked sets the value of IsLocked.
+func (s *CytologySegmentUpdateUpdateOK) SetIsLocked(val OptBool) {
+	s.IsLocked = val
+}
+
+// SetConfidence sets the value of Confidence.
+func (s *CytologySegmentUpdateUpdateOK) SetConfidence(val OptFloat64) {
+	s.Confidence = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *CytologySegmentUpdateUpdateOK) SetProperties(val OptCytologySegmentProperties) {
+	s.Properties = val
+}
+
 func (*CytologySegmentUpdateUpdateOK) cytologySegmentUpdateUpdateRes() {}
 
 type CytologySegmentUpdateUpdateOKPointsItem struct {
-	X int `json:"x"`
-	Y int `json:"y"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Polygon OptInt `json:"polygon"`
+	Ring    OptInt `json:"ring"`
 }
 
 // GetX returns the value of X.
@@ -2259,6 +3026,16 @@ func (s *CytologySegmentUpdateUpdateOKPointsItem) GetY() int {
 	return s.Y
 }
 
+// GetPolygon returns the value of Polygon.
+func (s *CytologySegmentUpdateUpdateOKPointsItem) GetPolygon() OptInt {
+	return s.Polygon
+}
+
+// GetRing returns the value of Ring.
+func (s *CytologySegmentUpdateUpdateOKPointsItem) GetRing() OptInt {
+	return s.Ring
+}
+
 // SetX sets the value of X.
 func (s *CytologySegmentUpdateUpdateOKPointsItem) SetX(val int) {
 	s.X = val
@@ -2269,9 +3046,25 @@ func (s *CytologySegmentUpdateUpdateOKPointsItem) SetY(val int) {
 	s.Y = val
 }
 
+// SetPolygon sets the value of Polygon.
+func (s *CytologySegmentUpdateUpdateOKPointsItem) SetPolygon(val OptInt) {
+	s.Polygon = val
+}
+
+// SetRing sets the value of Ring.
+func (s *CytologySegmentUpdateUpdateOKPointsItem) SetRing(val OptInt) {
+	s.Ring = val
+}
+
 type CytologySegmentUpdateUpdateReq struct {
 	Points       []CytologySegmentUpdateUpdateReqPointsItem `json:"points"`
 	SegmentGroup OptInt                                     `json:"segment_group"`
+	GeometryType OptCytologyGeometryType                    `json:"geometry_type"`
+	Color        OptCytologyColor                           `json:"color"`
+	IsLocked     OptBool                                    `json:"is_locked"`
+	// Уверенность модели.
+	Confidence OptFloat64                   `json:"confidence"`
+	Properties OptCytologySegmentProperties `json:"properties"`
 }
 
 // GetPoints returns the value of Points.
@@ -2284,6 +3077,31 @@ func (s *CytologySegmentUpdateUpdateReq) GetSegmentGroup() OptInt {
 	return s.SegmentGroup
 }
 
+// GetGeometryType returns the value of GeometryType.
+func (s *CytologySegmentUpdateUpdateReq) GetGeometryType() OptCytologyGeometryType {
+	return s.GeometryType
+}
+
+// GetColor returns the value of Color.
+func (s *CytologySegmentUpdateUpdateReq) GetColor() OptCytologyColor {
+	return s.Color
+}
+
+// GetIsLocked returns the value of IsLocked.
+func (s *CytologySegmentUpdateUpdateReq) GetIsLocked() OptBool {
+	return s.IsLocked
+}
+
+// GetConfidence returns the value of Confidence.
+func (s *CytologySegmentUpdateUpdateReq) GetConfidence() OptFloat64 {
+	return s.Confidence
+}
+
+// GetProperties returns the value of Properties.
+func (s *CytologySegmentUpdateUpdateReq) GetProperties() OptCytologySegmentProperties {
+	return s.Properties
+}
+
 // SetPoints sets the value of Points.
 func (s *CytologySegmentUpdateUpdateReq) SetPoints(val []CytologySegmentUpdateUpdateReqPointsItem) {
 	s.Points = val
@@ -2294,9 +3112,36 @@ func (s *CytologySegmentUpdateUpdateReq) SetSegmentGroup(val OptInt) {
 	s.SegmentGroup = val
 }
 
+// SetGeometryType sets the value of GeometryType.
+func (s *CytologySegmentUpdateUpdateReq) SetGeometryType(val OptCytologyGeometryType) {
+	s.GeometryType = val
+}
+
+// SetColor sets the value of Color.
+func (s *CytologySegmentUpdateUpdateReq) SetColor(val OptCytologyColor) {
+	s.Color = val
+}
+
+// SetIsLocked sets the value of IsLocked.
+func (s *CytologySegmentUpdateUpdateReq) SetIsLocked(val OptBool) {
+	s.IsLocked = val
+}
+
+// SetConfidence sets the value of Confidence.
+func (s *CytologySegmentUpdateUpdateReq) SetConfidence(val OptFloat64) {
+	s.Confidence = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *CytologySegmentUpdateUpdateReq) SetProperties(val OptCytologySegmentProperties) {
+	s.Properties = val
+}
+
 type CytologySegmentUpdateUpdateReqPointsItem struct {
-	X int `json:"x"`
-	Y int `json:"y"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Polygon OptInt `json:"polygon"`
+	Ring    OptInt `json:"ring"`
 }
 
 // GetX returns the value of X.
@@ -2309,6 +3154,16 @@ func (s *CytologySegmentUpdateUpdateReqPointsItem) GetY() int {
 	return s.Y
 }
 
+// GetPolygon returns the value of Polygon.
+func (s *CytologySegmentUpdateUpdateReqPointsItem) GetPolygon() OptInt {
+	return s.Polygon
+}
+
+// GetRing returns the value of Ring.
+func (s *CytologySegmentUpdateUpdateReqPointsItem) GetRing() OptInt {
+	return s.Ring
+}
+
 // SetX sets the value of X.
 func (s *CytologySegmentUpdateUpdateReqPointsItem) SetX(val int) {
 	s.X = val
@@ -2319,6 +3174,47 @@ func (s *CytologySegmentUpdateUpdateReqPointsItem) SetY(val int) {
 	s.Y = val
 }
 
+// SetPolygon sets the value of Polygon.
+func (s *CytologySegmentUpdateUpdateReqPointsItem) SetPolygon(val OptInt) {
+	s.Polygon = val
+}
+
+// SetRing sets the value of Ring.
+func (s *CytologySegmentUpdateUpdateReqPointsItem) SetRing(val OptInt) {
+	s.Ring = val
+}
+
+// Страница сегментаций в области просмотра.
+// Ref: #/components/schemas/cytology_segmentation_page
+type CytologySegmentationPage struct {
+	Segmentations []Segmentation `json:"segmentations"`
+	// Курсор следующей страницы, отсутствует если страница
+	// последняя.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetSegmentations returns the value of Segmentations.
+func (s *CytologySegmentationPage) GetSegmentations() []Segmentation {
+	return s.Segmentations
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *CytologySegmentationPage) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetSegmentations sets the value of Segmentations.
+func (s *CytologySegmentationPage) SetSegmentations(val []Segmentation) {
+	s.Segmentations = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *CytologySegmentationPage) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*CytologySegmentationPage) cytologySegmentsViewportRes() {}
+
 type CytologySegmentsListGroupType string
 
 const (
@@ -2494,9 +3390,15 @@ func (s *CytologySegmentsListOKResultsItem) SetIsAi(val OptBool) {
 }
 
 type CytologySegmentsListOKResultsItemDataItem struct {
-	ID      OptInt                                                `json:"id"`
-	Points  []CytologySegmentsListOKResultsItemDataItemPointsItem `json:"points"`
-	Details OptString                                             `json:"details"`
+	ID           OptInt                                                `json:"id"`
+	Points       []CytologySegmentsListOKResultsItemDataItemPointsItem `json:"points"`
+	Details      OptString                                             `json:"details"`
+	GeometryType OptCytologyGeometryType                               `json:"geometry_type"`
+	Color        OptCytologyColor                                      `json:"color"`
+	IsLocked     OptBool                                               `json:"is_locked"`
+	// Уверенность модели.
+	Confidence OptFloat64                   `json:"confidence"`
+	Properties OptCytologySegmentProperties `json:"properties"`
 }
 
 // GetID returns the value of ID.
@@ -2514,6 +3416,31 @@ func (s *CytologySegmentsListOKResultsItemDataItem) GetDetails() OptString {
 	return s.Details
 }
 
+// GetGeometryType returns the value of GeometryType.
+func (s *CytologySegmentsListOKResultsItemDataItem) GetGeometryType() OptCytologyGeometryType {
+	return s.GeometryType
+}
+
+// GetColor returns the value of Color.
+func (s *CytologySegmentsListOKResultsItemDataItem) GetColor() OptCytologyColor {
+	return s.Color
+}
+
+// GetIsLocked returns the value of IsLocked.
+func (s *CytologySegmentsListOKResultsItemDataItem) GetIsLocked() OptBool {
+	return s.IsLocked
+}
+
+// GetConfidence returns the value of Confidence.
+func (s *CytologySegmentsListOKResultsItemDataItem) GetConfidence() OptFloat64 {
+	return s.Confidence
+}
+
+// GetProperties returns the value of Properties.
+func (s *CytologySegmentsListOKResultsItemDataItem) GetProperties() OptCytologySegmentProperties {
+	return s.Properties
+}
+
 // SetID sets the value of ID.
 func (s *CytologySegmentsListOKResultsItemDataItem) SetID(val OptInt) {
 	s.ID = val
@@ -2529,11 +3456,38 @@ func (s *CytologySegmentsListOKResultsItemDataItem) SetDetails(val OptString) {
 	s.Details = val
 }
 
+// SetGeometryType sets the value of GeometryType.
+func (s *CytologySegmentsListOKResultsItemDataItem) SetGeometryType(val OptCytologyGeometryType) {
+	s.GeometryType = val
+}
+
+// SetColor sets the value of Color.
+func (s *CytologySegmentsListOKResultsItemDataItem) SetColor(val OptCytologyColor) {
+	s.Color = val
+}
+
+// SetIsLocked sets the value of IsLocked.
+func (s *CytologySegmentsListOKResultsItemDataItem) SetIsLocked(val OptBool) {
+	s.IsLocked = val
+}
+
+// SetConfidence sets the value of Confidence.
+func (s *CytologySegmentsListOKResultsItemDataItem) SetConfidence(val OptFloat64) {
+	s.Confidence = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *CytologySegmentsListOKResultsItemDataItem) SetProperties(val OptCytologySegmentProperties) {
+	s.Properties = val
+}
+
 type CytologySegmentsListOKResultsItemDataItemPointsItem struct {
-	ID  OptInt `json:"id"`
-	UID int    `json:"uid"`
-	X   int    `json:"x"`
-	Y   int    `json:"y"`
+	ID      OptInt `json:"id"`
+	UID     int    `json:"uid"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Polygon OptInt `json:"polygon"`
+	Ring    OptInt `json:"ring"`
 }
 
 // GetID returns the value of ID.
@@ -2556,6 +3510,16 @@ func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) GetY() int {
 	return s.Y
 }
 
+// GetPolygon returns the value of Polygon.
+func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) GetPolygon() OptInt {
+	return s.Polygon
+}
+
+// GetRing returns the value of Ring.
+func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) GetRing() OptInt {
+	return s.Ring
+}
+
 // SetID sets the value of ID.
 func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) SetID(val OptInt) {
 	s.ID = val
@@ -2576,6 +3540,16 @@ func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) SetY(val int) {
 	s.Y = val
 }
 
+// SetPolygon sets the value of Polygon.
+func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) SetPolygon(val OptInt) {
+	s.Polygon = val
+}
+
+// SetRing sets the value of Ring.
+func (s *CytologySegmentsListOKResultsItemDataItemPointsItem) SetRing(val OptInt) {
+	s.Ring = val
+}
+
 type CytologySegmentsListOKResultsItemDetails struct{}
 
 type CytologySegmentsListOKResultsItemGroupType string
@@ -2904,72 +3878,267 @@ func (s *CytologySegmentsListSegType) UnmarshalText(data []byte) error {
 	}
 }
 
-// Ref: #/components/schemas/cytologyShotDetails
-type CytologyShotDetails struct {
-	AiInfo []jx.Raw  `json:"ai_info"`
-	Probs  []float64 `json:"probs"`
-}
+type CytologySegmentsViewportBadRequest ErrorStatusCode
 
-// GetAiInfo returns the value of AiInfo.
-func (s *CytologyShotDetails) GetAiInfo() []jx.Raw {
-	return s.AiInfo
-}
+func (*CytologySegmentsViewportBadRequest) cytologySegmentsViewportRes() {}
 
-// GetProbs returns the value of Probs.
-func (s *CytologyShotDetails) GetProbs() []float64 {
-	return s.Probs
-}
+type CytologySegmentsViewportGroupType string
 
-// SetAiInfo sets the value of AiInfo.
-func (s *CytologyShotDetails) SetAiInfo(val []jx.Raw) {
-	s.AiInfo = val
-}
+const (
+	CytologySegmentsViewportGroupTypeCE CytologySegmentsViewportGroupType = "CE"
+	CytologySegmentsViewportGroupTypeCL CytologySegmentsViewportGroupType = "CL"
+	CytologySegmentsViewportGroupTypeME CytologySegmentsViewportGroupType = "ME"
+)
 
-// SetProbs sets the value of Probs.
-func (s *CytologyShotDetails) SetProbs(val []float64) {
-	s.Probs = val
+// AllValues returns all CytologySegmentsViewportGroupType values.
+func (CytologySegmentsViewportGroupType) AllValues() []CytologySegmentsViewportGroupType {
+	return []CytologySegmentsViewportGroupType{
+		CytologySegmentsViewportGroupTypeCE,
+		CytologySegmentsViewportGroupTypeCL,
+		CytologySegmentsViewportGroupTypeME,
+	}
 }
 
-// Пациент в ответе списка снимков цитологии.
-// Ref: #/components/schemas/cytologyShotPatient
-type CytologyShotPatient struct {
-	ID             uuid.UUID `json:"id"`
-	FirstName      string    `json:"first_name"`
-	LastName       string    `json:"last_name"`
-	FathersName    string    `json:"fathers_name"`
-	BirthDate      time.Time `json:"birth_date"`
-	PersonalPolicy string    `json:"personal_policy"`
-	Email          string    `json:"email"`
-	IsActive       bool      `json:"is_active"`
+// MarshalText implements encoding.TextMarshaler.
+func (s CytologySegmentsViewportGroupType) MarshalText() ([]byte, error) {
+	switch s {
+	case CytologySegmentsViewportGroupTypeCE:
+		return []byte(s), nil
+	case CytologySegmentsViewportGroupTypeCL:
+		return []byte(s), nil
+	case CytologySegmentsViewportGroupTypeME:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetID returns the value of ID.
-func (s *CytologyShotPatient) GetID() uuid.UUID {
-	return s.ID
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CytologySegmentsViewportGroupType) UnmarshalText(data []byte) error {
+	switch CytologySegmentsViewportGroupType(data) {
+	case CytologySegmentsViewportGroupTypeCE:
+		*s = CytologySegmentsViewportGroupTypeCE
+		return nil
+	case CytologySegmentsViewportGroupTypeCL:
+		*s = CytologySegmentsViewportGroupTypeCL
+		return nil
+	case CytologySegmentsViewportGroupTypeME:
+		*s = CytologySegmentsViewportGroupTypeME
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetFirstName returns the value of FirstName.
-func (s *CytologyShotPatient) GetFirstName() string {
-	return s.FirstName
-}
+type CytologySegmentsViewportInternalServerError ErrorStatusCode
 
-// GetLastName returns the value of LastName.
-func (s *CytologyShotPatient) GetLastName() string {
-	return s.LastName
-}
+func (*CytologySegmentsViewportInternalServerError) cytologySegmentsViewportRes() {}
 
-// GetFathersName returns the value of FathersName.
-func (s *CytologyShotPatient) GetFathersName() string {
-	return s.FathersName
-}
+type CytologySegmentsViewportSegType string
 
-// GetBirthDate returns the value of BirthDate.
-func (s *CytologyShotPatient) GetBirthDate() time.Time {
-	return s.BirthDate
+const (
+	CytologySegmentsViewportSegTypeNIL CytologySegmentsViewportSegType = "NIL"
+	CytologySegmentsViewportSegTypeNIR CytologySegmentsViewportSegType = "NIR"
+	CytologySegmentsViewportSegTypeNIM CytologySegmentsViewportSegType = "NIM"
+	CytologySegmentsViewportSegTypeCNO CytologySegmentsViewportSegType = "CNO"
+	CytologySegmentsViewportSegTypeCGE CytologySegmentsViewportSegType = "CGE"
+	CytologySegmentsViewportSegTypeC2N CytologySegmentsViewportSegType = "C2N"
+	CytologySegmentsViewportSegTypeCPS CytologySegmentsViewportSegType = "CPS"
+	CytologySegmentsViewportSegTypeCFC CytologySegmentsViewportSegType = "CFC"
+	CytologySegmentsViewportSegTypeCLY CytologySegmentsViewportSegType = "CLY"
+	CytologySegmentsViewportSegTypeSOS CytologySegmentsViewportSegType = "SOS"
+	CytologySegmentsViewportSegTypeSDS CytologySegmentsViewportSegType = "SDS"
+	CytologySegmentsViewportSegTypeSMS CytologySegmentsViewportSegType = "SMS"
+	CytologySegmentsViewportSegTypeSTS CytologySegmentsViewportSegType = "STS"
+	CytologySegmentsViewportSegTypeSPS CytologySegmentsViewportSegType = "SPS"
+	CytologySegmentsViewportSegTypeSNM CytologySegmentsViewportSegType = "SNM"
+	CytologySegmentsViewportSegTypeSTM CytologySegmentsViewportSegType = "STM"
+)
+
+// AllValues returns all CytologySegmentsViewportSegType values.
+func (CytologySegmentsViewportSegType) AllValues() []CytologySegmentsViewportSegType {
+	return []CytologySegmentsViewportSegType{
+		CytologySegmentsViewportSegTypeNIL,
+		CytologySegmentsViewportSegTypeNIR,
+		CytologySegmentsViewportSegTypeNIM,
+		CytologySegmentsViewportSegTypeCNO,
+		CytologySegmentsViewportSegTypeCGE,
+		CytologySegmentsViewportSegTypeC2N,
+		CytologySegmentsViewportSegTypeCPS,
+		CytologySegmentsViewportSegTypeCFC,
+		CytologySegmentsViewportSegTypeCLY,
+		CytologySegmentsViewportSegTypeSOS,
+		CytologySegmentsViewportSegTypeSDS,
+		CytologySegmentsViewportSegTypeSMS,
+		CytologySegmentsViewportSegTypeSTS,
+		CytologySegmentsViewportSegTypeSPS,
+		CytologySegmentsViewportSegTypeSNM,
+		CytologySegmentsViewportSegTypeSTM,
+	}
 }
 
-// GetPersonalPolicy returns the value of PersonalPolicy.
-func (s *CytologyShotPatient) GetPersonalPolicy() string {
+// MarshalText implements encoding.TextMarshaler.
+func (s CytologySegmentsViewportSegType) MarshalText() ([]byte, error) {
+	switch s {
+	case CytologySegmentsViewportSegTypeNIL:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeNIR:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeNIM:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeCNO:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeCGE:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeC2N:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeCPS:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeCFC:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeCLY:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeSOS:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeSDS:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeSMS:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeSTS:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeSPS:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeSNM:
+		return []byte(s), nil
+	case CytologySegmentsViewportSegTypeSTM:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CytologySegmentsViewportSegType) UnmarshalText(data []byte) error {
+	switch CytologySegmentsViewportSegType(data) {
+	case CytologySegmentsViewportSegTypeNIL:
+		*s = CytologySegmentsViewportSegTypeNIL
+		return nil
+	case CytologySegmentsViewportSegTypeNIR:
+		*s = CytologySegmentsViewportSegTypeNIR
+		return nil
+	case CytologySegmentsViewportSegTypeNIM:
+		*s = CytologySegmentsViewportSegTypeNIM
+		return nil
+	case CytologySegmentsViewportSegTypeCNO:
+		*s = CytologySegmentsViewportSegTypeCNO
+		return nil
+	case CytologySegmentsViewportSegTypeCGE:
+		*s = CytologySegmentsViewportSegTypeCGE
+		return nil
+	case CytologySegmentsViewportSegTypeC2N:
+		*s = CytologySegmentsViewportSegTypeC2N
+		return nil
+	case CytologySegmentsViewportSegTypeCPS:
+		*s = CytologySegmentsViewportSegTypeCPS
+		return nil
+	case CytologySegmentsViewportSegTypeCFC:
+		*s = CytologySegmentsViewportSegTypeCFC
+		return nil
+	case CytologySegmentsViewportSegTypeCLY:
+		*s = CytologySegmentsViewportSegTypeCLY
+		return nil
+	case CytologySegmentsViewportSegTypeSOS:
+		*s = CytologySegmentsViewportSegTypeSOS
+		return nil
+	case CytologySegmentsViewportSegTypeSDS:
+		*s = CytologySegmentsViewportSegTypeSDS
+		return nil
+	case CytologySegmentsViewportSegTypeSMS:
+		*s = CytologySegmentsViewportSegTypeSMS
+		return nil
+	case CytologySegmentsViewportSegTypeSTS:
+		*s = CytologySegmentsViewportSegTypeSTS
+		return nil
+	case CytologySegmentsViewportSegTypeSPS:
+		*s = CytologySegmentsViewportSegTypeSPS
+		return nil
+	case CytologySegmentsViewportSegTypeSNM:
+		*s = CytologySegmentsViewportSegTypeSNM
+		return nil
+	case CytologySegmentsViewportSegTypeSTM:
+		*s = CytologySegmentsViewportSegTypeSTM
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/cytologyShotDetails
+type CytologyShotDetails struct {
+	AiInfo []jx.Raw  `json:"ai_info"`
+	Probs  []float64 `json:"probs"`
+}
+
+// GetAiInfo returns the value of AiInfo.
+func (s *CytologyShotDetails) GetAiInfo() []jx.Raw {
+	return s.AiInfo
+}
+
+// GetProbs returns the value of Probs.
+func (s *CytologyShotDetails) GetProbs() []float64 {
+	return s.Probs
+}
+
+// SetAiInfo sets the value of AiInfo.
+func (s *CytologyShotDetails) SetAiInfo(val []jx.Raw) {
+	s.AiInfo = val
+}
+
+// SetProbs sets the value of Probs.
+func (s *CytologyShotDetails) SetProbs(val []float64) {
+	s.Probs = val
+}
+
+// Пациент в ответе списка снимков цитологии.
+// Ref: #/components/schemas/cytologyShotPatient
+type CytologyShotPatient struct {
+	ID             uuid.UUID `json:"id"`
+	FirstName      string    `json:"first_name"`
+	LastName       string    `json:"last_name"`
+	FathersName    string    `json:"fathers_name"`
+	BirthDate      time.Time `json:"birth_date"`
+	PersonalPolicy string    `json:"personal_policy"`
+	Email          string    `json:"email"`
+	IsActive       bool      `json:"is_active"`
+}
+
+// GetID returns the value of ID.
+func (s *CytologyShotPatient) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetFirstName returns the value of FirstName.
+func (s *CytologyShotPatient) GetFirstName() string {
+	return s.FirstName
+}
+
+// GetLastName returns the value of LastName.
+func (s *CytologyShotPatient) GetLastName() string {
+	return s.LastName
+}
+
+// GetFathersName returns the value of FathersName.
+func (s *CytologyShotPatient) GetFathersName() string {
+	return s.FathersName
+}
+
+// GetBirthDate returns the value of BirthDate.
+func (s *CytologyShotPatient) GetBirthDate() time.Time {
+	return s.BirthDate
+}
+
+// GetPersonalPolicy returns the value of PersonalPolicy.
+func (s *CytologyShotPatient) GetPersonalPolicy() string {
 	return s.PersonalPolicy
 }
 
@@ -4257,6 +5426,14 @@ type Device struct {
 	ID int `json:"id"`
 	// Название устройства.
 	Name string `json:"name"`
+	// Производитель.
+	Manufacturer OptString `json:"manufacturer"`
+	// Модель.
+	Model OptString `json:"model"`
+	// Серийный номер.
+	SerialNumber OptString       `json:"serial_number"`
+	ProbeType    OptProbeType    `json:"probe_type"`
+	PixelSpacing OptPixelSpacing `json:"pixel_spacing"`
 }
 
 // GetID returns the value of ID.
@@ -4269,6 +5446,31 @@ func (s *Device) GetName() string {
 	return s.Name
 }
 
+// GetManufacturer returns the value of Manufacturer.
+func (s *Device) GetManufacturer() OptString {
+	return s.Manufacturer
+}
+
+// GetModel returns the value of Model.
+func (s *Device) GetModel() OptString {
+	return s.Model
+}
+
+// GetSerialNumber returns the value of SerialNumber.
+func (s *Device) GetSerialNumber() OptString {
+	return s.SerialNumber
+}
+
+// GetProbeType returns the value of ProbeType.
+func (s *Device) GetProbeType() OptProbeType {
+	return s.ProbeType
+}
+
+// GetPixelSpacing returns the value of PixelSpacing.
+func (s *Device) GetPixelSpacing() OptPixelSpacing {
+	return s.PixelSpacing
+}
+
 // SetID sets the value of ID.
 func (s *Device) SetID(val int) {
 	s.ID = val
@@ -4279,6 +5481,60 @@ func (s *Device) SetName(val string) {
 	s.Name = val
 }
 
+// SetManufacturer sets the value of Manufacturer.
+func (s *Device) SetManufacturer(val OptString) {
+	s.Manufacturer = val
+}
+
+// SetModel sets the value of Model.
+func (s *Device) SetModel(val OptString) {
+	s.Model = val
+}
+
+// SetSerialNumber sets the value of SerialNumber.
+func (s *Device) SetSerialNumber(val OptString) {
+	s.SerialNumber = val
+}
+
+// SetProbeType sets the value of ProbeType.
+func (s *Device) SetProbeType(val OptProbeType) {
+	s.ProbeType = val
+}
+
+// SetPixelSpacing sets the value of PixelSpacing.
+func (s *Device) SetPixelSpacing(val OptPixelSpacing) {
+	s.PixelSpacing = val
+}
+
+func (*Device) uziDeviceIDGetRes()   {}
+func (*Device) uziDeviceIDPatchRes() {}
+
+// Ref: #/components/schemas/device_node_metrics
+type DeviceNodeMetrics struct {
+	DeviceID int         `json:"device_id"`
+	Metrics  NodeMetrics `json:"metrics"`
+}
+
+// GetDeviceID returns the value of DeviceID.
+func (s *DeviceNodeMetrics) GetDeviceID() int {
+	return s.DeviceID
+}
+
+// GetMetrics returns the value of Metrics.
+func (s *DeviceNodeMetrics) GetMetrics() NodeMetrics {
+	return s.Metrics
+}
+
+// SetDeviceID sets the value of DeviceID.
+func (s *DeviceNodeMetrics) SetDeviceID(val int) {
+	s.DeviceID = val
+}
+
+// SetMetrics sets the value of Metrics.
+func (s *DeviceNodeMetrics) SetMetrics(val NodeMetrics) {
+	s.Metrics = val
+}
+
 // Врач.
 // Ref: #/components/schemas/doctor
 type Doctor struct {
@@ -4407,132 +5663,334 @@ func (s DownloadCytologyCytologyIDOriginalImageIDGetOKImagePNG) Read(p []byte) (
 func (*DownloadCytologyCytologyIDOriginalImageIDGetOKImagePNG) downloadCytologyCytologyIDOriginalImageIDGetRes() {
 }
 
-type DownloadUziIDImageIDGetOK struct {
+type DownloadUziIDImageIDGetOKImageJpeg struct {
 	Data io.Reader
 }
 
 // Read reads data from the Data reader.
 //
 // Kept to satisfy the io.Reader interface.
-func (s DownloadUziIDImageIDGetOK) Read(p []byte) (n int, err error) {
+func (s DownloadUziIDImageIDGetOKImageJpeg) Read(p []byte) (n int, err error) {
 	if s.Data == nil {
 		return 0, io.EOF
 	}
 	return s.Data.Read(p)
 }
 
-func (*DownloadUziIDImageIDGetOK) downloadUziIDImageIDGetRes() {}
+func (*DownloadUziIDImageIDGetOKImageJpeg) downloadUziIDImageIDGetRes() {}
 
-// Эхографическая информация.
-// Ref: #/components/schemas/echographics
-type Echographics struct {
-	// Id узи.
-	ID uuid.UUID `json:"id"`
-	// Контуры.
-	Contors OptString `json:"contors"`
-	// Длина левого доли.
-	LeftLobeLength OptFloat64 `json:"left_lobe_length"`
-	// Ширина левого доли.
-	LeftLobeWidth OptFloat64 `json:"left_lobe_width"`
-	// Толщина левого доли.
-	LeftLobeThick OptFloat64 `json:"left_lobe_thick"`
-	// Объем левого доли.
-	LeftLobeVolum OptFloat64 `json:"left_lobe_volum"`
-	// Длина правого доли.
-	RightLobeLength OptFloat64 `json:"right_lobe_length"`
-	// Ширина правого доли.
-	RightLobeWidth OptFloat64 `json:"right_lobe_width"`
-	// Толщина правого доли.
-	RightLobeThick OptFloat64 `json:"right_lobe_thick"`
-	// Объем правого доли.
-	RightLobeVolum OptFloat64 `json:"right_lobe_volum"`
-	// Объем железы.
-	GlandVolum OptFloat64 `json:"gland_volum"`
-	// Перешеек.
-	Isthmus OptFloat64 `json:"isthmus"`
-	// Структура.
-	Struct OptString `json:"struct"`
-	// Эхогенность.
-	Echogenicity OptString `json:"echogenicity"`
-	// Регионарные лимфоузлы.
-	RegionalLymph OptString `json:"regional_lymph"`
-	// Васкуляризация по ЦДК.
-	Vascularization OptString `json:"vascularization"`
-	// Расположение.
-	Location OptString `json:"location"`
-	// Дополнительная информация.
-	Additional OptString `json:"additional"`
-	// Заключение.
-	Conclusion OptString `json:"conclusion"`
+type DownloadUziIDImageIDGetOKImagePNG struct {
+	Data io.Reader
 }
 
-// GetID returns the value of ID.
-func (s *Echographics) GetID() uuid.UUID {
-	return s.ID
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DownloadUziIDImageIDGetOKImagePNG) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetContors returns the value of Contors.
-func (s *Echographics) GetContors() OptString {
-	return s.Contors
-}
+func (*DownloadUziIDImageIDGetOKImagePNG) downloadUziIDImageIDGetRes() {}
 
-// GetLeftLobeLength returns the value of LeftLobeLength.
-func (s *Echographics) GetLeftLobeLength() OptFloat64 {
-	return s.LeftLobeLength
-}
+type DownloadUziUziIDReportGetFormat string
 
-// GetLeftLobeWidth returns the value of LeftLobeWidth.
-func (s *Echographics) GetLeftLobeWidth() OptFloat64 {
-	return s.LeftLobeWidth
-}
+const (
+	DownloadUziUziIDReportGetFormatPdf  DownloadUziUziIDReportGetFormat = "pdf"
+	DownloadUziUziIDReportGetFormatHTML DownloadUziUziIDReportGetFormat = "html"
+)
 
-// GetLeftLobeThick returns the value of LeftLobeThick.
-func (s *Echographics) GetLeftLobeThick() OptFloat64 {
-	return s.LeftLobeThick
+// AllValues returns all DownloadUziUziIDReportGetFormat values.
+func (DownloadUziUziIDReportGetFormat) AllValues() []DownloadUziUziIDReportGetFormat {
+	return []DownloadUziUziIDReportGetFormat{
+		DownloadUziUziIDReportGetFormatPdf,
+		DownloadUziUziIDReportGetFormatHTML,
+	}
 }
 
-// GetLeftLobeVolum returns the value of LeftLobeVolum.
-func (s *Echographics) GetLeftLobeVolum() OptFloat64 {
-	return s.LeftLobeVolum
+// MarshalText implements encoding.TextMarshaler.
+func (s DownloadUziUziIDReportGetFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case DownloadUziUziIDReportGetFormatPdf:
+		return []byte(s), nil
+	case DownloadUziUziIDReportGetFormatHTML:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetRightLobeLength returns the value of RightLobeLength.
-func (s *Echographics) GetRightLobeLength() OptFloat64 {
-	return s.RightLobeLength
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DownloadUziUziIDReportGetFormat) UnmarshalText(data []byte) error {
+	switch DownloadUziUziIDReportGetFormat(data) {
+	case DownloadUziUziIDReportGetFormatPdf:
+		*s = DownloadUziUziIDReportGetFormatPdf
+		return nil
+	case DownloadUziUziIDReportGetFormatHTML:
+		*s = DownloadUziUziIDReportGetFormatHTML
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetRightLobeWidth returns the value of RightLobeWidth.
-func (s *Echographics) GetRightLobeWidth() OptFloat64 {
-	return s.RightLobeWidth
-}
+type DownloadUziUziIDReportGetInternalServerError ErrorStatusCode
 
-// GetRightLobeThick returns the value of RightLobeThick.
-func (s *Echographics) GetRightLobeThick() OptFloat64 {
-	return s.RightLobeThick
-}
+func (*DownloadUziUziIDReportGetInternalServerError) downloadUziUziIDReportGetRes() {}
 
-// GetRightLobeVolum returns the value of RightLobeVolum.
-func (s *Echographics) GetRightLobeVolum() OptFloat64 {
-	return s.RightLobeVolum
-}
+type DownloadUziUziIDReportGetNotFound ErrorStatusCode
 
-// GetGlandVolum returns the value of GlandVolum.
-func (s *Echographics) GetGlandVolum() OptFloat64 {
-	return s.GlandVolum
+func (*DownloadUziUziIDReportGetNotFound) downloadUziUziIDReportGetRes() {}
+
+type DownloadUziUziIDReportGetOKApplicationPdf struct {
+	Data io.Reader
 }
 
-// GetIsthmus returns the value of Isthmus.
-func (s *Echographics) GetIsthmus() OptFloat64 {
-	return s.Isthmus
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DownloadUziUziIDReportGetOKApplicationPdf) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetStruct returns the value of Struct.
-func (s *Echographics) GetStruct() OptString {
+func (*DownloadUziUziIDReportGetOKApplicationPdf) downloadUziUziIDReportGetRes() {}
+
+type DownloadUziUziIDReportGetOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DownloadUziUziIDReportGetOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*DownloadUziUziIDReportGetOKTextHTML) downloadUziUziIDReportGetRes() {}
+
+// Значение вне нормы.
+// Ref: #/components/schemas/echographic_flag
+type EchographicFlag struct {
+	// Поле эхографии.
+	Field EchographicFlagField `json:"field"`
+	Value float64              `json:"value"`
+	// Нижняя граница нормы.
+	Min OptFloat64 `json:"min"`
+	// Верхняя граница нормы.
+	Max OptFloat64 `json:"max"`
+}
+
+// GetField returns the value of Field.
+func (s *EchographicFlag) GetField() EchographicFlagField {
+	return s.Field
+}
+
+// GetValue returns the value of Value.
+func (s *EchographicFlag) GetValue() float64 {
+	return s.Value
+}
+
+// GetMin returns the value of Min.
+func (s *EchographicFlag) GetMin() OptFloat64 {
+	return s.Min
+}
+
+// GetMax returns the value of Max.
+func (s *EchographicFlag) GetMax() OptFloat64 {
+	return s.Max
+}
+
+// SetField sets the value of Field.
+func (s *EchographicFlag) SetField(val EchographicFlagField) {
+	s.Field = val
+}
+
+// SetValue sets the value of Value.
+func (s *EchographicFlag) SetValue(val float64) {
+	s.Value = val
+}
+
+// SetMin sets the value of Min.
+func (s *EchographicFlag) SetMin(val OptFloat64) {
+	s.Min = val
+}
+
+// SetMax sets the value of Max.
+func (s *EchographicFlag) SetMax(val OptFloat64) {
+	s.Max = val
+}
+
+// Поле эхографии.
+type EchographicFlagField string
+
+const (
+	EchographicFlagFieldGlandVolum EchographicFlagField = "gland_volum"
+	EchographicFlagFieldIsthmus    EchographicFlagField = "isthmus"
+)
+
+// AllValues returns all EchographicFlagField values.
+func (EchographicFlagField) AllValues() []EchographicFlagField {
+	return []EchographicFlagField{
+		EchographicFlagFieldGlandVolum,
+		EchographicFlagFieldIsthmus,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EchographicFlagField) MarshalText() ([]byte, error) {
+	switch s {
+	case EchographicFlagFieldGlandVolum:
+		return []byte(s), nil
+	case EchographicFlagFieldIsthmus:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EchographicFlagField) UnmarshalText(data []byte) error {
+	switch EchographicFlagField(data) {
+	case EchographicFlagFieldGlandVolum:
+		*s = EchographicFlagFieldGlandVolum
+		return nil
+	case EchographicFlagFieldIsthmus:
+		*s = EchographicFlagFieldIsthmus
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Эхографическая информация. Размеры в см, объемы в мл.
+// Ref: #/components/schemas/echographics
+type Echographics struct {
+	// Id узи.
+	ID uuid.UUID `json:"id"`
+	// Контуры.
+	Contors OptString `json:"contors"`
+	// Длина левого доли.
+	LeftLobeLength OptFloat64 `json:"left_lobe_length"`
+	// Ширина левого доли.
+	LeftLobeWidth OptFloat64 `json:"left_lobe_width"`
+	// Толщина левого доли.
+	LeftLobeThick OptFloat64 `json:"left_lobe_thick"`
+	// Объем левой доли, считается по размерам (длина ×
+	// ширина × толщина × 0.479), если они заданы.
+	LeftLobeVolum OptFloat64 `json:"left_lobe_volum"`
+	// Длина правого доли.
+	RightLobeLength OptFloat64 `json:"right_lobe_length"`
+	// Ширина правого доли.
+	RightLobeWidth OptFloat64 `json:"right_lobe_width"`
+	// Толщина правого доли.
+	RightLobeThick OptFloat64 `json:"right_lobe_thick"`
+	// Объем правой доли, считается по размерам, если они
+	// заданы.
+	RightLobeVolum OptFloat64 `json:"right_lobe_volum"`
+	// Объем железы, сумма объемов долей, если оба известны.
+	GlandVolum OptFloat64 `json:"gland_volum"`
+	// Перешеек.
+	Isthmus OptFloat64 `json:"isthmus"`
+	// Структура.
+	Struct OptEchographicsStruct `json:"struct"`
+	// Эхогенность.
+	Echogenicity OptEchographicsEchogenicity `json:"echogenicity"`
+	// Регионарные лимфоузлы.
+	RegionalLymph OptString `json:"regional_lymph"`
+	// Васкуляризация по ЦДК.
+	Vascularization OptEchographicsVascularization `json:"vascularization"`
+	// Расположение.
+	Location OptString `json:"location"`
+	// Дополнительная информация.
+	Additional OptString `json:"additional"`
+	// Заключение.
+	Conclusion OptString `json:"conclusion"`
+	// Пол пациента для норм объема железы.
+	PatientSex OptEchographicsPatientSex `json:"patient_sex"`
+	// Возраст пациента на дату узи, полных лет. Заполняется
+	// по дате рождения пациента.
+	PatientAge OptInt `json:"patient_age"`
+	// Значения вне нормы для возраста и пола.
+	Flags []EchographicFlag `json:"flags"`
+	// Заключение, собранное по шаблону.
+	GeneratedConclusion OptString `json:"generated_conclusion"`
+}
+
+// GetID returns the value of ID.
+func (s *Echographics) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetContors returns the value of Contors.
+func (s *Echographics) GetContors() OptString {
+	return s.Contors
+}
+
+// GetLeftLobeLength returns the value of LeftLobeLength.
+func (s *Echographics) GetLeftLobeLength() OptFloat64 {
+	return s.LeftLobeLength
+}
+
+// GetLeftLobeWidth returns the value of LeftLobeWidth.
+func (s *Echographics) GetLeftLobeWidth() OptFloat64 {
+	return s.LeftLobeWidth
+}
+
+// GetLeftLobeThick returns the value of LeftLobeThick.
+func (s *Echographics) GetLeftLobeThick() OptFloat64 {
+	return s.LeftLobeThick
+}
+
+// GetLeftLobeVolum returns the value of LeftLobeVolum.
+func (s *Echographics) GetLeftLobeVolum() OptFloat64 {
+	return s.LeftLobeVolum
+}
+
+// GetRightLobeLength returns the value of RightLobeLength.
+func (s *Echographics) GetRightLobeLength() OptFloat64 {
+	return s.RightLobeLength
+}
+
+// GetRightLobeWidth returns the value of RightLobeWidth.
+func (s *Echographics) GetRightLobeWidth() OptFloat64 {
+	return s.RightLobeWidth
+}
+
+// GetRightLobeThick returns the value of RightLobeThick.
+func (s *Echographics) GetRightLobeThick() OptFloat64 {
+	return s.RightLobeThick
+}
+
+// GetRightLobeVolum returns the value of RightLobeVolum.
+func (s *Echographics) GetRightLobeVolum() OptFloat64 {
+	return s.RightLobeVolum
+}
+
+// GetGlandVolum returns the value of GlandVolum.
+func (s *Echographics) GetGlandVolum() OptFloat64 {
+	return s.GlandVolum
+}
+
+// GetIsthmus returns the value of Isthmus.
+func (s *Echographics) GetIsthmus() OptFloat64 {
+	return s.Isthmus
+}
+
+// GetStruct returns the value of Struct.
+func (s *Echographics) GetStruct() OptEchographicsStruct {
 	return s.Struct
 }
 
 // GetEchogenicity returns the value of Echogenicity.
-func (s *Echographics) GetEchogenicity() OptString {
+func (s *Echographics) GetEchogenicity() OptEchographicsEchogenicity {
 	return s.Echogenicity
 }
 
@@ -4542,7 +6000,7 @@ func (s *Echographics) GetRegionalLymph() OptString {
 }
 
 // GetVascularization returns the value of Vascularization.
-func (s *Echographics) GetVascularization() OptString {
+func (s *Echographics) GetVascularization() OptEchographicsVascularization {
 	return s.Vascularization
 }
 
@@ -4561,6 +6019,26 @@ func (s *Echographics) GetConclusion() OptString {
 	return s.Conclusion
 }
 
+// GetPatientSex returns the value of PatientSex.
+func (s *Echographics) GetPatientSex() OptEchographicsPatientSex {
+	return s.PatientSex
+}
+
+// GetPatientAge returns the value of PatientAge.
+func (s *Echographics) GetPatientAge() OptInt {
+	return s.PatientAge
+}
+
+// GetFlags returns the value of Flags.
+func (s *Echographics) GetFlags() []EchographicFlag {
+	return s.Flags
+}
+
+// GetGeneratedConclusion returns the value of GeneratedConclusion.
+func (s *Echographics) GetGeneratedConclusion() OptString {
+	return s.GeneratedConclusion
+}
+
 // SetID sets the value of ID.
 func (s *Echographics) SetID(val uuid.UUID) {
 	s.ID = val
@@ -4622,12 +6100,12 @@ func (s *Echographics) SetIsthmus(val OptFloat64) {
 }
 
 // SetStruct sets the value of Struct.
-func (s *Echographics) SetStruct(val OptString) {
+func (s *Echographics) SetStruct(val OptEchographicsStruct) {
 	s.Struct = val
 }
 
 // SetEchogenicity sets the value of Echogenicity.
-func (s *Echographics) SetEchogenicity(val OptString) {
+func (s *Echographics) SetEchogenicity(val OptEchographicsEchogenicity) {
 	s.Echogenicity = val
 }
 
@@ -4637,7 +6115,7 @@ func (s *Echographics) SetRegionalLymph(val OptString) {
 }
 
 // SetVascularization sets the value of Vascularization.
-func (s *Echographics) SetVascularization(val OptString) {
+func (s *Echographics) SetVascularization(val OptEchographicsVascularization) {
 	s.Vascularization = val
 }
 
@@ -4656,93 +6134,329 @@ func (s *Echographics) SetConclusion(val OptString) {
 	s.Conclusion = val
 }
 
-func (*Echographics) uziIDEchographicsGetRes()   {}
-func (*Echographics) uziIDEchographicsPatchRes() {}
-
-// Ошибка.
-// Ref: #/components/schemas/error
-type Error struct {
-	Message string `json:"message"`
+// SetPatientSex sets the value of PatientSex.
+func (s *Echographics) SetPatientSex(val OptEchographicsPatientSex) {
+	s.PatientSex = val
 }
 
-// GetMessage returns the value of Message.
-func (s *Error) GetMessage() string {
-	return s.Message
+// SetPatientAge sets the value of PatientAge.
+func (s *Echographics) SetPatientAge(val OptInt) {
+	s.PatientAge = val
 }
 
-// SetMessage sets the value of Message.
-func (s *Error) SetMessage(val string) {
-	s.Message = val
+// SetFlags sets the value of Flags.
+func (s *Echographics) SetFlags(val []EchographicFlag) {
+	s.Flags = val
 }
 
-// ErrorStatusCode wraps Error with StatusCode.
-type ErrorStatusCode struct {
-	StatusCode int
-	Response   Error
+// SetGeneratedConclusion sets the value of GeneratedConclusion.
+func (s *Echographics) SetGeneratedConclusion(val OptString) {
+	s.GeneratedConclusion = val
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *ErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
-}
+func (*Echographics) uziIDEchographicsGetRes()   {}
+func (*Echographics) uziIDEchographicsPatchRes() {}
 
-// GetResponse returns the value of Response.
-func (s *ErrorStatusCode) GetResponse() Error {
-	return s.Response
-}
+// Эхогенность.
+type EchographicsEchogenicity string
 
-// SetStatusCode sets the value of StatusCode.
-func (s *ErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
-}
+const (
+	EchographicsEchogenicityNormal    EchographicsEchogenicity = "normal"
+	EchographicsEchogenicityIncreased EchographicsEchogenicity = "increased"
+	EchographicsEchogenicityDecreased EchographicsEchogenicity = "decreased"
+	EchographicsEchogenicityMixed     EchographicsEchogenicity = "mixed"
+)
 
-// SetResponse sets the value of Response.
-func (s *ErrorStatusCode) SetResponse(val Error) {
-	s.Response = val
+// AllValues returns all EchographicsEchogenicity values.
+func (EchographicsEchogenicity) AllValues() []EchographicsEchogenicity {
+	return []EchographicsEchogenicity{
+		EchographicsEchogenicityNormal,
+		EchographicsEchogenicityIncreased,
+		EchographicsEchogenicityDecreased,
+		EchographicsEchogenicityMixed,
+	}
 }
 
-func (*ErrorStatusCode) downloadUziIDImageIDGetRes()     {}
-func (*ErrorStatusCode) paymentProvidersGetRes()         {}
-func (*ErrorStatusCode) subscriptionsCheckActiveGetRes() {}
-func (*ErrorStatusCode) subscriptionsPurchasePostRes()   {}
-func (*ErrorStatusCode) tariffPlansGetRes()              {}
-func (*ErrorStatusCode) tariffPlansIDGetRes()            {}
-func (*ErrorStatusCode) uziDevicesGetRes()               {}
-func (*ErrorStatusCode) yookassaWebhooksPostRes()        {}
-
-// Изображение.
-// Ref: #/components/schemas/image
-type Image struct {
-	// Id изображения.
-	ID uuid.UUID `json:"id"`
-	// Id узи, к которому относится изображение.
-	UziID uuid.UUID `json:"uzi_id"`
-	// Номер страницы.
-	Page int `json:"page"`
+// MarshalText implements encoding.TextMarshaler.
+func (s EchographicsEchogenicity) MarshalText() ([]byte, error) {
+	switch s {
+	case EchographicsEchogenicityNormal:
+		return []byte(s), nil
+	case EchographicsEchogenicityIncreased:
+		return []byte(s), nil
+	case EchographicsEchogenicityDecreased:
+		return []byte(s), nil
+	case EchographicsEchogenicityMixed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetID returns the value of ID.
-func (s *Image) GetID() uuid.UUID {
-	return s.ID
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EchographicsEchogenicity) UnmarshalText(data []byte) error {
+	switch EchographicsEchogenicity(data) {
+	case EchographicsEchogenicityNormal:
+		*s = EchographicsEchogenicityNormal
+		return nil
+	case EchographicsEchogenicityIncreased:
+		*s = EchographicsEchogenicityIncreased
+		return nil
+	case EchographicsEchogenicityDecreased:
+		*s = EchographicsEchogenicityDecreased
+		return nil
+	case EchographicsEchogenicityMixed:
+		*s = EchographicsEchogenicityMixed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetUziID returns the value of UziID.
-func (s *Image) GetUziID() uuid.UUID {
-	return s.UziID
-}
+// Пол пациента для норм объема железы.
+type EchographicsPatientSex string
 
-// GetPage returns the value of Page.
-func (s *Image) GetPage() int {
-	return s.Page
-}
+const (
+	EchographicsPatientSexMale   EchographicsPatientSex = "male"
+	EchographicsPatientSexFemale EchographicsPatientSex = "female"
+)
 
-// SetID sets the value of ID.
-func (s *Image) SetID(val uuid.UUID) {
-	s.ID = val
+// AllValues returns all EchographicsPatientSex values.
+func (EchographicsPatientSex) AllValues() []EchographicsPatientSex {
+	return []EchographicsPatientSex{
+		EchographicsPatientSexMale,
+		EchographicsPatientSexFemale,
+	}
 }
 
-// SetUziID sets the value of UziID.
-func (s *Image) SetUziID(val uuid.UUID) {
+// MarshalText implements encoding.TextMarshaler.
+func (s EchographicsPatientSex) MarshalText() ([]byte, error) {
+	switch s {
+	case EchographicsPatientSexMale:
+		return []byte(s), nil
+	case EchographicsPatientSexFemale:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EchographicsPatientSex) UnmarshalText(data []byte) error {
+	switch EchographicsPatientSex(data) {
+	case EchographicsPatientSexMale:
+		*s = EchographicsPatientSexMale
+		return nil
+	case EchographicsPatientSexFemale:
+		*s = EchographicsPatientSexFemale
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Структура.
+type EchographicsStruct string
+
+const (
+	EchographicsStructHomogeneous   EchographicsStruct = "homogeneous"
+	EchographicsStructHeterogeneous EchographicsStruct = "heterogeneous"
+)
+
+// AllValues returns all EchographicsStruct values.
+func (EchographicsStruct) AllValues() []EchographicsStruct {
+	return []EchographicsStruct{
+		EchographicsStructHomogeneous,
+		EchographicsStructHeterogeneous,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EchographicsStruct) MarshalText() ([]byte, error) {
+	switch s {
+	case EchographicsStructHomogeneous:
+		return []byte(s), nil
+	case EchographicsStructHeterogeneous:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EchographicsStruct) UnmarshalText(data []byte) error {
+	switch EchographicsStruct(data) {
+	case EchographicsStructHomogeneous:
+		*s = EchographicsStructHomogeneous
+		return nil
+	case EchographicsStructHeterogeneous:
+		*s = EchographicsStructHeterogeneous
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Васкуляризация по ЦДК.
+type EchographicsVascularization string
+
+const (
+	EchographicsVascularizationNormal    EchographicsVascularization = "normal"
+	EchographicsVascularizationIncreased EchographicsVascularization = "increased"
+	EchographicsVascularizationDecreased EchographicsVascularization = "decreased"
+)
+
+// AllValues returns all EchographicsVascularization values.
+func (EchographicsVascularization) AllValues() []EchographicsVascularization {
+	return []EchographicsVascularization{
+		EchographicsVascularizationNormal,
+		EchographicsVascularizationIncreased,
+		EchographicsVascularizationDecreased,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EchographicsVascularization) MarshalText() ([]byte, error) {
+	switch s {
+	case EchographicsVascularizationNormal:
+		return []byte(s), nil
+	case EchographicsVascularizationIncreased:
+		return []byte(s), nil
+	case EchographicsVascularizationDecreased:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EchographicsVascularization) UnmarshalText(data []byte) error {
+	switch EchographicsVascularization(data) {
+	case EchographicsVascularizationNormal:
+		*s = EchographicsVascularizationNormal
+		return nil
+	case EchographicsVascularizationIncreased:
+		*s = EchographicsVascularizationIncreased
+		return nil
+	case EchographicsVascularizationDecreased:
+		*s = EchographicsVascularizationDecreased
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ошибка.
+// Ref: #/components/schemas/error
+type Error struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
+
+func (*ErrorStatusCode) downloadUziIDImageIDGetRes()     {}
+func (*ErrorStatusCode) paymentProvidersGetRes()         {}
+func (*ErrorStatusCode) subscriptionsCheckActiveGetRes() {}
+func (*ErrorStatusCode) subscriptionsPurchasePostRes()   {}
+func (*ErrorStatusCode) tariffPlansGetRes()              {}
+func (*ErrorStatusCode) tariffPlansIDGetRes()            {}
+func (*ErrorStatusCode) uziDevicesGetRes()               {}
+func (*ErrorStatusCode) uziIDReportsGetRes()             {}
+func (*ErrorStatusCode) uziNodesIDSegmentDraftsGetRes()  {}
+func (*ErrorStatusCode) uzisAnalyticsGetRes()            {}
+func (*ErrorStatusCode) uzisExternalIDGrowthGetRes()     {}
+func (*ErrorStatusCode) yookassaWebhooksPostRes()        {}
+
+// Изображение.
+// Ref: #/components/schemas/image
+type Image struct {
+	// Id изображения.
+	ID uuid.UUID `json:"id"`
+	// Id узи, к которому относится изображение.
+	UziID uuid.UUID `json:"uzi_id"`
+	// Номер страницы.
+	Page int `json:"page"`
+	// Ширина исходного кадра, px. 0 у кадров, загруженных до
+	// появления превью.
+	Width int `json:"width"`
+	// Высота исходного кадра, px.
+	Height int `json:"height"`
+	// Доступные размеры кадра для /download/{uzi_id}/{image_id},
+	// исходный всегда первый.
+	Variants []ImageVariant `json:"variants"`
+}
+
+// GetID returns the value of ID.
+func (s *Image) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUziID returns the value of UziID.
+func (s *Image) GetUziID() uuid.UUID {
+	return s.UziID
+}
+
+// GetPage returns the value of Page.
+func (s *Image) GetPage() int {
+	return s.Page
+}
+
+// GetWidth returns the value of Width.
+func (s *Image) GetWidth() int {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *Image) GetHeight() int {
+	return s.Height
+}
+
+// GetVariants returns the value of Variants.
+func (s *Image) GetVariants() []ImageVariant {
+	return s.Variants
+}
+
+// SetID sets the value of ID.
+func (s *Image) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUziID sets the value of UziID.
+func (s *Image) SetUziID(val uuid.UUID) {
 	s.UziID = val
 }
 
@@ -4751,6 +6465,123 @@ func (s *Image) SetPage(val int) {
 	s.Page = val
 }
 
+// SetWidth sets the value of Width.
+func (s *Image) SetWidth(val int) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *Image) SetHeight(val int) {
+	s.Height = val
+}
+
+// SetVariants sets the value of Variants.
+func (s *Image) SetVariants(val []ImageVariant) {
+	s.Variants = val
+}
+
+// Размер кадра, preview и thumbnail - уменьшенные jpeg копии.
+// Ref: #/components/schemas/image_size
+type ImageSize string
+
+const (
+	ImageSizeOriginal  ImageSize = "original"
+	ImageSizePreview   ImageSize = "preview"
+	ImageSizeThumbnail ImageSize = "thumbnail"
+)
+
+// AllValues returns all ImageSize values.
+func (ImageSize) AllValues() []ImageSize {
+	return []ImageSize{
+		ImageSizeOriginal,
+		ImageSizePreview,
+		ImageSizeThumbnail,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ImageSize) MarshalText() ([]byte, error) {
+	switch s {
+	case ImageSizeOriginal:
+		return []byte(s), nil
+	case ImageSizePreview:
+		return []byte(s), nil
+	case ImageSizeThumbnail:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ImageSize) UnmarshalText(data []byte) error {
+	switch ImageSize(data) {
+	case ImageSizeOriginal:
+		*s = ImageSizeOriginal
+		return nil
+	case ImageSizePreview:
+		*s = ImageSizePreview
+		return nil
+	case ImageSizeThumbnail:
+		*s = ImageSizeThumbnail
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Файл кадра определенного размера.
+// Ref: #/components/schemas/image_variant
+type ImageVariant struct {
+	Size ImageSize `json:"size"`
+	// MIME тип файла.
+	ContentType string `json:"content_type"`
+	// Ширина, px.
+	Width int `json:"width"`
+	// Высота, px.
+	Height int `json:"height"`
+}
+
+// GetSize returns the value of Size.
+func (s *ImageVariant) GetSize() ImageSize {
+	return s.Size
+}
+
+// GetContentType returns the value of ContentType.
+func (s *ImageVariant) GetContentType() string {
+	return s.ContentType
+}
+
+// GetWidth returns the value of Width.
+func (s *ImageVariant) GetWidth() int {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *ImageVariant) GetHeight() int {
+	return s.Height
+}
+
+// SetSize sets the value of Size.
+func (s *ImageVariant) SetSize(val ImageSize) {
+	s.Size = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ImageVariant) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetWidth sets the value of Width.
+func (s *ImageVariant) SetWidth(val int) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *ImageVariant) SetHeight(val int) {
+	s.Height = val
+}
+
 type LoginPostBadRequest ErrorStatusCode
 
 func (*LoginPostBadRequest) loginPostRes() {}
@@ -4819,6 +6650,50 @@ type LoginPostUnauthorized ErrorStatusCode
 
 func (*LoginPostUnauthorized) loginPostRes() {}
 
+// Единица измерения **px** - размер пикселя узи неизвестен
+// **mm** - миллиметры (площадь - мм², объем - мм³).
+// Ref: #/components/schemas/measure_unit
+type MeasureUnit string
+
+const (
+	MeasureUnitPx MeasureUnit = "px"
+	MeasureUnitMm MeasureUnit = "mm"
+)
+
+// AllValues returns all MeasureUnit values.
+func (MeasureUnit) AllValues() []MeasureUnit {
+	return []MeasureUnit{
+		MeasureUnitPx,
+		MeasureUnitMm,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s MeasureUnit) MarshalText() ([]byte, error) {
+	switch s {
+	case MeasureUnitPx:
+		return []byte(s), nil
+	case MeasureUnitMm:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MeasureUnit) UnmarshalText(data []byte) error {
+	switch MeasureUnit(data) {
+	case MeasureUnitPx:
+		*s = MeasureUnitPx
+		return nil
+	case MeasureUnitMm:
+		*s = MeasureUnitMm
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type MedCardDoctorIDPatientIDGetInternalServerError ErrorStatusCode
 
 func (*MedCardDoctorIDPatientIDGetInternalServerError) medCardDoctorIDPatientIDGetRes() {}
@@ -5053,7 +6928,9 @@ type Node struct {
 	// Вероятность наличия опухоли в 5-й группе.
 	Tirads5 float64 `json:"tirads_5"`
 	// Описание узла.
-	Description OptString `json:"description"`
+	Description OptString          `json:"description"`
+	Measurement OptNodeMeasurement `json:"measurement"`
+	Lobe        OptNodeLobe        `json:"lobe"`
 }
 
 // GetID returns the value of ID.
@@ -5096,6 +6973,16 @@ func (s *Node) GetDescription() OptString {
 	return s.Description
 }
 
+// GetMeasurement returns the value of Measurement.
+func (s *Node) GetMeasurement() OptNodeMeasurement {
+	return s.Measurement
+}
+
+// GetLobe returns the value of Lobe.
+func (s *Node) GetLobe() OptNodeLobe {
+	return s.Lobe
+}
+
 // SetID sets the value of ID.
 func (s *Node) SetID(val uuid.UUID) {
 	s.ID = val
@@ -5136,30 +7023,114 @@ func (s *Node) SetDescription(val OptString) {
 	s.Description = val
 }
 
-func (*Node) uziNodesIDPatchRes() {}
+// SetMeasurement sets the value of Measurement.
+func (s *Node) SetMeasurement(val OptNodeMeasurement) {
+	s.Measurement = val
+}
 
-// Валидация нейроночного узла врачем.
-type NodeValidation string
+// SetLobe sets the value of Lobe.
+func (s *Node) SetLobe(val OptNodeLobe) {
+	s.Lobe = val
+}
 
-const (
-	NodeValidationInvalid NodeValidation = "invalid"
-	NodeValidationValid   NodeValidation = "valid"
-)
+func (*Node) uziNodesIDPatchRes()   {}
+func (*Node) uziNodesMergePostRes() {}
 
-// AllValues returns all NodeValidation values.
-func (NodeValidation) AllValues() []NodeValidation {
-	return []NodeValidation{
-		NodeValidationInvalid,
-		NodeValidationValid,
-	}
+// Дескрипторы узла по ACR TI-RADS.
+// Ref: #/components/schemas/node_descriptors
+type NodeDescriptors struct {
+	// Состав.
+	Composition NodeDescriptorsComposition `json:"composition"`
+	// Эхогенность.
+	Echogenicity NodeDescriptorsEchogenicity `json:"echogenicity"`
+	// Форма.
+	Shape NodeDescriptorsShape `json:"shape"`
+	// Контур.
+	Margin NodeDescriptorsMargin `json:"margin"`
+	// Эхогенные включения, баллы суммируются.
+	EchogenicFoci []NodeDescriptorsEchogenicFociItem `json:"echogenic_foci"`
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s NodeValidation) MarshalText() ([]byte, error) {
-	switch s {
-	case NodeValidationInvalid:
-		return []byte(s), nil
-	case NodeValidationValid:
+// GetComposition returns the value of Composition.
+func (s *NodeDescriptors) GetComposition() NodeDescriptorsComposition {
+	return s.Composition
+}
+
+// GetEchogenicity returns the value of Echogenicity.
+func (s *NodeDescriptors) GetEchogenicity() NodeDescriptorsEchogenicity {
+	return s.Echogenicity
+}
+
+// GetShape returns the value of Shape.
+func (s *NodeDescriptors) GetShape() NodeDescriptorsShape {
+	return s.Shape
+}
+
+// GetMargin returns the value of Margin.
+func (s *NodeDescriptors) GetMargin() NodeDescriptorsMargin {
+	return s.Margin
+}
+
+// GetEchogenicFoci returns the value of EchogenicFoci.
+func (s *NodeDescriptors) GetEchogenicFoci() []NodeDescriptorsEchogenicFociItem {
+	return s.EchogenicFoci
+}
+
+// SetComposition sets the value of Composition.
+func (s *NodeDescriptors) SetComposition(val NodeDescriptorsComposition) {
+	s.Composition = val
+}
+
+// SetEchogenicity sets the value of Echogenicity.
+func (s *NodeDescriptors) SetEchogenicity(val NodeDescriptorsEchogenicity) {
+	s.Echogenicity = val
+}
+
+// SetShape sets the value of Shape.
+func (s *NodeDescriptors) SetShape(val NodeDescriptorsShape) {
+	s.Shape = val
+}
+
+// SetMargin sets the value of Margin.
+func (s *NodeDescriptors) SetMargin(val NodeDescriptorsMargin) {
+	s.Margin = val
+}
+
+// SetEchogenicFoci sets the value of EchogenicFoci.
+func (s *NodeDescriptors) SetEchogenicFoci(val []NodeDescriptorsEchogenicFociItem) {
+	s.EchogenicFoci = val
+}
+
+// Состав.
+type NodeDescriptorsComposition string
+
+const (
+	NodeDescriptorsCompositionCystic     NodeDescriptorsComposition = "cystic"
+	NodeDescriptorsCompositionSpongiform NodeDescriptorsComposition = "spongiform"
+	NodeDescriptorsCompositionMixed      NodeDescriptorsComposition = "mixed"
+	NodeDescriptorsCompositionSolid      NodeDescriptorsComposition = "solid"
+)
+
+// AllValues returns all NodeDescriptorsComposition values.
+func (NodeDescriptorsComposition) AllValues() []NodeDescriptorsComposition {
+	return []NodeDescriptorsComposition{
+		NodeDescriptorsCompositionCystic,
+		NodeDescriptorsCompositionSpongiform,
+		NodeDescriptorsCompositionMixed,
+		NodeDescriptorsCompositionSolid,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s NodeDescriptorsComposition) MarshalText() ([]byte, error) {
+	switch s {
+	case NodeDescriptorsCompositionCystic:
+		return []byte(s), nil
+	case NodeDescriptorsCompositionSpongiform:
+		return []byte(s), nil
+	case NodeDescriptorsCompositionMixed:
+		return []byte(s), nil
+	case NodeDescriptorsCompositionSolid:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -5167,603 +7138,848 @@ func (s NodeValidation) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *NodeValidation) UnmarshalText(data []byte) error {
-	switch NodeValidation(data) {
-	case NodeValidationInvalid:
-		*s = NodeValidationInvalid
+func (s *NodeDescriptorsComposition) UnmarshalText(data []byte) error {
+	switch NodeDescriptorsComposition(data) {
+	case NodeDescriptorsCompositionCystic:
+		*s = NodeDescriptorsCompositionCystic
 		return nil
-	case NodeValidationValid:
-		*s = NodeValidationValid
+	case NodeDescriptorsCompositionSpongiform:
+		*s = NodeDescriptorsCompositionSpongiform
+		return nil
+	case NodeDescriptorsCompositionMixed:
+		*s = NodeDescriptorsCompositionMixed
+		return nil
+	case NodeDescriptorsCompositionSolid:
+		*s = NodeDescriptorsCompositionSolid
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
+type NodeDescriptorsEchogenicFociItem string
+
+const (
+	NodeDescriptorsEchogenicFociItemNone                NodeDescriptorsEchogenicFociItem = "none"
+	NodeDescriptorsEchogenicFociItemMacrocalcifications NodeDescriptorsEchogenicFociItem = "macrocalcifications"
+	NodeDescriptorsEchogenicFociItemPeripheral          NodeDescriptorsEchogenicFociItem = "peripheral"
+	NodeDescriptorsEchogenicFociItemPunctate            NodeDescriptorsEchogenicFociItem = "punctate"
+)
+
+// AllValues returns all NodeDescriptorsEchogenicFociItem values.
+func (NodeDescriptorsEchogenicFociItem) AllValues() []NodeDescriptorsEchogenicFociItem {
+	return []NodeDescriptorsEchogenicFociItem{
+		NodeDescriptorsEchogenicFociItemNone,
+		NodeDescriptorsEchogenicFociItemMacrocalcifications,
+		NodeDescriptorsEchogenicFociItemPeripheral,
+		NodeDescriptorsEchogenicFociItemPunctate,
 	}
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// MarshalText implements encoding.TextMarshaler.
+func (s NodeDescriptorsEchogenicFociItem) MarshalText() ([]byte, error) {
+	switch s {
+	case NodeDescriptorsEchogenicFociItemNone:
+		return []byte(s), nil
+	case NodeDescriptorsEchogenicFociItemMacrocalcifications:
+		return []byte(s), nil
+	case NodeDescriptorsEchogenicFociItemPeripheral:
+		return []byte(s), nil
+	case NodeDescriptorsEchogenicFociItemPunctate:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NodeDescriptorsEchogenicFociItem) UnmarshalText(data []byte) error {
+	switch NodeDescriptorsEchogenicFociItem(data) {
+	case NodeDescriptorsEchogenicFociItemNone:
+		*s = NodeDescriptorsEchogenicFociItemNone
+		return nil
+	case NodeDescriptorsEchogenicFociItemMacrocalcifications:
+		*s = NodeDescriptorsEchogenicFociItemMacrocalcifications
+		return nil
+	case NodeDescriptorsEchogenicFociItemPeripheral:
+		*s = NodeDescriptorsEchogenicFociItemPeripheral
+		return nil
+	case NodeDescriptorsEchogenicFociItemPunctate:
+		*s = NodeDescriptorsEchogenicFociItemPunctate
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
-}
+// Эхогенность.
+type NodeDescriptorsEchogenicity string
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
+const (
+	NodeDescriptorsEchogenicityAnechoic NodeDescriptorsEchogenicity = "anechoic"
+	NodeDescriptorsEchogenicityHyperIso NodeDescriptorsEchogenicity = "hyper_iso"
+	NodeDescriptorsEchogenicityHypo     NodeDescriptorsEchogenicity = "hypo"
+	NodeDescriptorsEchogenicityVeryHypo NodeDescriptorsEchogenicity = "very_hypo"
+)
+
+// AllValues returns all NodeDescriptorsEchogenicity values.
+func (NodeDescriptorsEchogenicity) AllValues() []NodeDescriptorsEchogenicity {
+	return []NodeDescriptorsEchogenicity{
+		NodeDescriptorsEchogenicityAnechoic,
+		NodeDescriptorsEchogenicityHyperIso,
+		NodeDescriptorsEchogenicityHypo,
+		NodeDescriptorsEchogenicityVeryHypo,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s NodeDescriptorsEchogenicity) MarshalText() ([]byte, error) {
+	switch s {
+	case NodeDescriptorsEchogenicityAnechoic:
+		return []byte(s), nil
+	case NodeDescriptorsEchogenicityHyperIso:
+		return []byte(s), nil
+	case NodeDescriptorsEchogenicityHypo:
+		return []byte(s), nil
+	case NodeDescriptorsEchogenicityVeryHypo:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptCytologyCreateCreateCreatedDiagnosticMarking returns new OptCytologyCreateCreateCreatedDiagnosticMarking with value set to v.
-func NewOptCytologyCreateCreateCreatedDiagnosticMarking(v CytologyCreateCreateCreatedDiagnosticMarking) OptCytologyCreateCreateCreatedDiagnosticMarking {
-	return OptCytologyCreateCreateCreatedDiagnosticMarking{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NodeDescriptorsEchogenicity) UnmarshalText(data []byte) error {
+	switch NodeDescriptorsEchogenicity(data) {
+	case NodeDescriptorsEchogenicityAnechoic:
+		*s = NodeDescriptorsEchogenicityAnechoic
+		return nil
+	case NodeDescriptorsEchogenicityHyperIso:
+		*s = NodeDescriptorsEchogenicityHyperIso
+		return nil
+	case NodeDescriptorsEchogenicityHypo:
+		*s = NodeDescriptorsEchogenicityHypo
+		return nil
+	case NodeDescriptorsEchogenicityVeryHypo:
+		*s = NodeDescriptorsEchogenicityVeryHypo
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptCytologyCreateCreateCreatedDiagnosticMarking is optional CytologyCreateCreateCreatedDiagnosticMarking.
-type OptCytologyCreateCreateCreatedDiagnosticMarking struct {
-	Value CytologyCreateCreateCreatedDiagnosticMarking
-	Set   bool
-}
+// Контур.
+type NodeDescriptorsMargin string
 
-// IsSet returns true if OptCytologyCreateCreateCreatedDiagnosticMarking was set.
-func (o OptCytologyCreateCreateCreatedDiagnosticMarking) IsSet() bool { return o.Set }
+const (
+	NodeDescriptorsMarginSmooth         NodeDescriptorsMargin = "smooth"
+	NodeDescriptorsMarginIllDefined     NodeDescriptorsMargin = "ill_defined"
+	NodeDescriptorsMarginLobulated      NodeDescriptorsMargin = "lobulated"
+	NodeDescriptorsMarginExtrathyroidal NodeDescriptorsMargin = "extrathyroidal"
+)
 
-// Reset unsets value.
-func (o *OptCytologyCreateCreateCreatedDiagnosticMarking) Reset() {
-	var v CytologyCreateCreateCreatedDiagnosticMarking
-	o.Value = v
-	o.Set = false
+// AllValues returns all NodeDescriptorsMargin values.
+func (NodeDescriptorsMargin) AllValues() []NodeDescriptorsMargin {
+	return []NodeDescriptorsMargin{
+		NodeDescriptorsMarginSmooth,
+		NodeDescriptorsMarginIllDefined,
+		NodeDescriptorsMarginLobulated,
+		NodeDescriptorsMarginExtrathyroidal,
+	}
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyCreateCreateCreatedDiagnosticMarking) SetTo(v CytologyCreateCreateCreatedDiagnosticMarking) {
-	o.Set = true
-	o.Value = v
+// MarshalText implements encoding.TextMarshaler.
+func (s NodeDescriptorsMargin) MarshalText() ([]byte, error) {
+	switch s {
+	case NodeDescriptorsMarginSmooth:
+		return []byte(s), nil
+	case NodeDescriptorsMarginIllDefined:
+		return []byte(s), nil
+	case NodeDescriptorsMarginLobulated:
+		return []byte(s), nil
+	case NodeDescriptorsMarginExtrathyroidal:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyCreateCreateCreatedDiagnosticMarking) Get() (v CytologyCreateCreateCreatedDiagnosticMarking, ok bool) {
-	if !o.Set {
-		return v, false
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NodeDescriptorsMargin) UnmarshalText(data []byte) error {
+	switch NodeDescriptorsMargin(data) {
+	case NodeDescriptorsMarginSmooth:
+		*s = NodeDescriptorsMarginSmooth
+		return nil
+	case NodeDescriptorsMarginIllDefined:
+		*s = NodeDescriptorsMarginIllDefined
+		return nil
+	case NodeDescriptorsMarginLobulated:
+		*s = NodeDescriptorsMarginLobulated
+		return nil
+	case NodeDescriptorsMarginExtrathyroidal:
+		*s = NodeDescriptorsMarginExtrathyroidal
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyCreateCreateCreatedDiagnosticMarking) Or(d CytologyCreateCreateCreatedDiagnosticMarking) CytologyCreateCreateCreatedDiagnosticMarking {
-	if v, ok := o.Get(); ok {
-		return v
+// Форма.
+type NodeDescriptorsShape string
+
+const (
+	NodeDescriptorsShapeWiderThanTall  NodeDescriptorsShape = "wider_than_tall"
+	NodeDescriptorsShapeTallerThanWide NodeDescriptorsShape = "taller_than_wide"
+)
+
+// AllValues returns all NodeDescriptorsShape values.
+func (NodeDescriptorsShape) AllValues() []NodeDescriptorsShape {
+	return []NodeDescriptorsShape{
+		NodeDescriptorsShapeWiderThanTall,
+		NodeDescriptorsShapeTallerThanWide,
 	}
-	return d
 }
 
-// NewOptCytologyCreateCreateCreatedMaterialType returns new OptCytologyCreateCreateCreatedMaterialType with value set to v.
-func NewOptCytologyCreateCreateCreatedMaterialType(v CytologyCreateCreateCreatedMaterialType) OptCytologyCreateCreateCreatedMaterialType {
-	return OptCytologyCreateCreateCreatedMaterialType{
-		Value: v,
-		Set:   true,
+// MarshalText implements encoding.TextMarshaler.
+func (s NodeDescriptorsShape) MarshalText() ([]byte, error) {
+	switch s {
+	case NodeDescriptorsShapeWiderThanTall:
+		return []byte(s), nil
+	case NodeDescriptorsShapeTallerThanWide:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
 }
 
-// OptCytologyCreateCreateCreatedMaterialType is optional CytologyCreateCreateCreatedMaterialType.
-type OptCytologyCreateCreateCreatedMaterialType struct {
-	Value CytologyCreateCreateCreatedMaterialType
-	Set   bool
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NodeDescriptorsShape) UnmarshalText(data []byte) error {
+	switch NodeDescriptorsShape(data) {
+	case NodeDescriptorsShapeWiderThanTall:
+		*s = NodeDescriptorsShapeWiderThanTall
+		return nil
+	case NodeDescriptorsShapeTallerThanWide:
+		*s = NodeDescriptorsShapeTallerThanWide
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// IsSet returns true if OptCytologyCreateCreateCreatedMaterialType was set.
-func (o OptCytologyCreateCreateCreatedMaterialType) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptCytologyCreateCreateCreatedMaterialType) Reset() {
-	var v CytologyCreateCreateCreatedMaterialType
-	o.Value = v
-	o.Set = false
+// Динамика отслеживаемого узла между узи пациента.
+// Ref: #/components/schemas/node_growth
+type NodeGrowth struct {
+	// Id цепочки наблюдений узла.
+	LineageID uuid.UUID `json:"lineage_id"`
+	// Состояния узла, упорядочены по дате узи.
+	Points []NodeGrowthPoint `json:"points"`
+	// Изменение объема между первым и последним измерением
+	// в процентах.
+	VolumeChange OptFloat64 `json:"volume_change"`
+	// Время удвоения объема в днях, отсутствует если узел
+	// не растет.
+	DoublingTime OptFloat64 `json:"doubling_time"`
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyCreateCreateCreatedMaterialType) SetTo(v CytologyCreateCreateCreatedMaterialType) {
-	o.Set = true
-	o.Value = v
+// GetLineageID returns the value of LineageID.
+func (s *NodeGrowth) GetLineageID() uuid.UUID {
+	return s.LineageID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyCreateCreateCreatedMaterialType) Get() (v CytologyCreateCreateCreatedMaterialType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetPoints returns the value of Points.
+func (s *NodeGrowth) GetPoints() []NodeGrowthPoint {
+	return s.Points
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyCreateCreateCreatedMaterialType) Or(d CytologyCreateCreateCreatedMaterialType) CytologyCreateCreateCreatedMaterialType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetVolumeChange returns the value of VolumeChange.
+func (s *NodeGrowth) GetVolumeChange() OptFloat64 {
+	return s.VolumeChange
 }
 
-// NewOptCytologyCreateCreateReqDiagnosticMarking returns new OptCytologyCreateCreateReqDiagnosticMarking with value set to v.
-func NewOptCytologyCreateCreateReqDiagnosticMarking(v CytologyCreateCreateReqDiagnosticMarking) OptCytologyCreateCreateReqDiagnosticMarking {
-	return OptCytologyCreateCreateReqDiagnosticMarking{
-		Value: v,
-		Set:   true,
-	}
+// GetDoublingTime returns the value of DoublingTime.
+func (s *NodeGrowth) GetDoublingTime() OptFloat64 {
+	return s.DoublingTime
 }
 
-// OptCytologyCreateCreateReqDiagnosticMarking is optional CytologyCreateCreateReqDiagnosticMarking.
-type OptCytologyCreateCreateReqDiagnosticMarking struct {
-	Value CytologyCreateCreateReqDiagnosticMarking
-	Set   bool
+// SetLineageID sets the value of LineageID.
+func (s *NodeGrowth) SetLineageID(val uuid.UUID) {
+	s.LineageID = val
 }
 
-// IsSet returns true if OptCytologyCreateCreateReqDiagnosticMarking was set.
-func (o OptCytologyCreateCreateReqDiagnosticMarking) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptCytologyCreateCreateReqDiagnosticMarking) Reset() {
-	var v CytologyCreateCreateReqDiagnosticMarking
-	o.Value = v
-	o.Set = false
+// SetPoints sets the value of Points.
+func (s *NodeGrowth) SetPoints(val []NodeGrowthPoint) {
+	s.Points = val
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyCreateCreateReqDiagnosticMarking) SetTo(v CytologyCreateCreateReqDiagnosticMarking) {
-	o.Set = true
-	o.Value = v
+// SetVolumeChange sets the value of VolumeChange.
+func (s *NodeGrowth) SetVolumeChange(val OptFloat64) {
+	s.VolumeChange = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyCreateCreateReqDiagnosticMarking) Get() (v CytologyCreateCreateReqDiagnosticMarking, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetDoublingTime sets the value of DoublingTime.
+func (s *NodeGrowth) SetDoublingTime(val OptFloat64) {
+	s.DoublingTime = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyCreateCreateReqDiagnosticMarking) Or(d CytologyCreateCreateReqDiagnosticMarking) CytologyCreateCreateReqDiagnosticMarking {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Состояние отслеживаемого узла в одном из узи.
+// Ref: #/components/schemas/node_growth_point
+type NodeGrowthPoint struct {
+	Node  Node      `json:"node"`
+	UziID uuid.UUID `json:"uzi_id"`
+	// Дата узи.
+	CreateAt time.Time `json:"create_at"`
+	// Объем узла в мм³.
+	Volume OptFloat64 `json:"volume"`
 }
 
-// NewOptCytologyCreateCreateReqMaterialType returns new OptCytologyCreateCreateReqMaterialType with value set to v.
-func NewOptCytologyCreateCreateReqMaterialType(v CytologyCreateCreateReqMaterialType) OptCytologyCreateCreateReqMaterialType {
-	return OptCytologyCreateCreateReqMaterialType{
-		Value: v,
-		Set:   true,
-	}
+// GetNode returns the value of Node.
+func (s *NodeGrowthPoint) GetNode() Node {
+	return s.Node
 }
 
-// OptCytologyCreateCreateReqMaterialType is optional CytologyCreateCreateReqMaterialType.
-type OptCytologyCreateCreateReqMaterialType struct {
-	Value CytologyCreateCreateReqMaterialType
-	Set   bool
+// GetUziID returns the value of UziID.
+func (s *NodeGrowthPoint) GetUziID() uuid.UUID {
+	return s.UziID
 }
 
-// IsSet returns true if OptCytologyCreateCreateReqMaterialType was set.
-func (o OptCytologyCreateCreateReqMaterialType) IsSet() bool { return o.Set }
+// GetCreateAt returns the value of CreateAt.
+func (s *NodeGrowthPoint) GetCreateAt() time.Time {
+	return s.CreateAt
+}
 
-// Reset unsets value.
-func (o *OptCytologyCreateCreateReqMaterialType) Reset() {
-	var v CytologyCreateCreateReqMaterialType
-	o.Value = v
-	o.Set = false
+// GetVolume returns the value of Volume.
+func (s *NodeGrowthPoint) GetVolume() OptFloat64 {
+	return s.Volume
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyCreateCreateReqMaterialType) SetTo(v CytologyCreateCreateReqMaterialType) {
-	o.Set = true
-	o.Value = v
+// SetNode sets the value of Node.
+func (s *NodeGrowthPoint) SetNode(val Node) {
+	s.Node = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyCreateCreateReqMaterialType) Get() (v CytologyCreateCreateReqMaterialType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetUziID sets the value of UziID.
+func (s *NodeGrowthPoint) SetUziID(val uuid.UUID) {
+	s.UziID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyCreateCreateReqMaterialType) Or(d CytologyCreateCreateReqMaterialType) CytologyCreateCreateReqMaterialType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCreateAt sets the value of CreateAt.
+func (s *NodeGrowthPoint) SetCreateAt(val time.Time) {
+	s.CreateAt = val
 }
 
-// NewOptCytologyHistoryReadOKResultsItemDiagnosticMarking returns new OptCytologyHistoryReadOKResultsItemDiagnosticMarking with value set to v.
-func NewOptCytologyHistoryReadOKResultsItemDiagnosticMarking(v CytologyHistoryReadOKResultsItemDiagnosticMarking) OptCytologyHistoryReadOKResultsItemDiagnosticMarking {
-	return OptCytologyHistoryReadOKResultsItemDiagnosticMarking{
-		Value: v,
-		Set:   true,
-	}
+// SetVolume sets the value of Volume.
+func (s *NodeGrowthPoint) SetVolume(val OptFloat64) {
+	s.Volume = val
 }
 
-// OptCytologyHistoryReadOKResultsItemDiagnosticMarking is optional CytologyHistoryReadOKResultsItemDiagnosticMarking.
-type OptCytologyHistoryReadOKResultsItemDiagnosticMarking struct {
-	Value CytologyHistoryReadOKResultsItemDiagnosticMarking
-	Set   bool
+// Кандидат на связывание с узлом из другого узи
+// пациента.
+// Ref: #/components/schemas/node_link_suggestion
+type NodeLinkSuggestion struct {
+	Node Node `json:"node"`
+	// Похожесть узлов по доле и размеру.
+	Score float64 `json:"score"`
 }
 
-// IsSet returns true if OptCytologyHistoryReadOKResultsItemDiagnosticMarking was set.
-func (o OptCytologyHistoryReadOKResultsItemDiagnosticMarking) IsSet() bool { return o.Set }
+// GetNode returns the value of Node.
+func (s *NodeLinkSuggestion) GetNode() Node {
+	return s.Node
+}
 
-// Reset unsets value.
-func (o *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Reset() {
-	var v CytologyHistoryReadOKResultsItemDiagnosticMarking
-	o.Value = v
-	o.Set = false
+// GetScore returns the value of Score.
+func (s *NodeLinkSuggestion) GetScore() float64 {
+	return s.Score
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) SetTo(v CytologyHistoryReadOKResultsItemDiagnosticMarking) {
-	o.Set = true
-	o.Value = v
+// SetNode sets the value of Node.
+func (s *NodeLinkSuggestion) SetNode(val Node) {
+	s.Node = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Get() (v CytologyHistoryReadOKResultsItemDiagnosticMarking, ok bool) {
-	if !o.Set {
-		return v, false
+// SetScore sets the value of Score.
+func (s *NodeLinkSuggestion) SetScore(val float64) {
+	s.Score = val
+}
+
+// Доля щитовидной железы, в которой расположен узел.
+// Ref: #/components/schemas/node_lobe
+type NodeLobe string
+
+const (
+	NodeLobeRight   NodeLobe = "right"
+	NodeLobeLeft    NodeLobe = "left"
+	NodeLobeIsthmus NodeLobe = "isthmus"
+)
+
+// AllValues returns all NodeLobe values.
+func (NodeLobe) AllValues() []NodeLobe {
+	return []NodeLobe{
+		NodeLobeRight,
+		NodeLobeLeft,
+		NodeLobeIsthmus,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Or(d CytologyHistoryReadOKResultsItemDiagnosticMarking) CytologyHistoryReadOKResultsItemDiagnosticMarking {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s NodeLobe) MarshalText() ([]byte, error) {
+	switch s {
+	case NodeLobeRight:
+		return []byte(s), nil
+	case NodeLobeLeft:
+		return []byte(s), nil
+	case NodeLobeIsthmus:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptCytologyHistoryReadOKResultsItemMaterialType returns new OptCytologyHistoryReadOKResultsItemMaterialType with value set to v.
-func NewOptCytologyHistoryReadOKResultsItemMaterialType(v CytologyHistoryReadOKResultsItemMaterialType) OptCytologyHistoryReadOKResultsItemMaterialType {
-	return OptCytologyHistoryReadOKResultsItemMaterialType{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NodeLobe) UnmarshalText(data []byte) error {
+	switch NodeLobe(data) {
+	case NodeLobeRight:
+		*s = NodeLobeRight
+		return nil
+	case NodeLobeLeft:
+		*s = NodeLobeLeft
+		return nil
+	case NodeLobeIsthmus:
+		*s = NodeLobeIsthmus
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptCytologyHistoryReadOKResultsItemMaterialType is optional CytologyHistoryReadOKResultsItemMaterialType.
-type OptCytologyHistoryReadOKResultsItemMaterialType struct {
-	Value CytologyHistoryReadOKResultsItemMaterialType
-	Set   bool
+// Измерения узла по максимальному срезу среди
+// сегментов.
+// Ref: #/components/schemas/node_measurement
+type NodeMeasurement struct {
+	// Максимальная площадь среди сегментов.
+	Area float64 `json:"area"`
+	// Максимальный периметр среди сегментов.
+	Perimeter float64 `json:"perimeter"`
+	// Максимальная большая ось среди сегментов.
+	MajorAxis float64 `json:"major_axis"`
+	// Максимальная малая ось среди сегментов.
+	MinorAxis float64 `json:"minor_axis"`
+	// Оценка объема по эллипсоиду π/6·L·W·W.
+	Volume float64     `json:"volume"`
+	Unit   MeasureUnit `json:"unit"`
 }
 
-// IsSet returns true if OptCytologyHistoryReadOKResultsItemMaterialType was set.
-func (o OptCytologyHistoryReadOKResultsItemMaterialType) IsSet() bool { return o.Set }
+// GetArea returns the value of Area.
+func (s *NodeMeasurement) GetArea() float64 {
+	return s.Area
+}
 
-// Reset unsets value.
-func (o *OptCytologyHistoryReadOKResultsItemMaterialType) Reset() {
-	var v CytologyHistoryReadOKResultsItemMaterialType
-	o.Value = v
-	o.Set = false
+// GetPerimeter returns the value of Perimeter.
+func (s *NodeMeasurement) GetPerimeter() float64 {
+	return s.Perimeter
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyHistoryReadOKResultsItemMaterialType) SetTo(v CytologyHistoryReadOKResultsItemMaterialType) {
-	o.Set = true
-	o.Value = v
+// GetMajorAxis returns the value of MajorAxis.
+func (s *NodeMeasurement) GetMajorAxis() float64 {
+	return s.MajorAxis
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyHistoryReadOKResultsItemMaterialType) Get() (v CytologyHistoryReadOKResultsItemMaterialType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMinorAxis returns the value of MinorAxis.
+func (s *NodeMeasurement) GetMinorAxis() float64 {
+	return s.MinorAxis
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyHistoryReadOKResultsItemMaterialType) Or(d CytologyHistoryReadOKResultsItemMaterialType) CytologyHistoryReadOKResultsItemMaterialType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetVolume returns the value of Volume.
+func (s *NodeMeasurement) GetVolume() float64 {
+	return s.Volume
 }
 
-// NewOptCytologyPatientShotDiagnosticMarking returns new OptCytologyPatientShotDiagnosticMarking with value set to v.
-func NewOptCytologyPatientShotDiagnosticMarking(v CytologyPatientShotDiagnosticMarking) OptCytologyPatientShotDiagnosticMarking {
-	return OptCytologyPatientShotDiagnosticMarking{
-		Value: v,
-		Set:   true,
-	}
+// GetUnit returns the value of Unit.
+func (s *NodeMeasurement) GetUnit() MeasureUnit {
+	return s.Unit
 }
 
-// OptCytologyPatientShotDiagnosticMarking is optional CytologyPatientShotDiagnosticMarking.
-type OptCytologyPatientShotDiagnosticMarking struct {
-	Value CytologyPatientShotDiagnosticMarking
-	Set   bool
+// SetArea sets the value of Area.
+func (s *NodeMeasurement) SetArea(val float64) {
+	s.Area = val
 }
 
-// IsSet returns true if OptCytologyPatientShotDiagnosticMarking was set.
-func (o OptCytologyPatientShotDiagnosticMarking) IsSet() bool { return o.Set }
+// SetPerimeter sets the value of Perimeter.
+func (s *NodeMeasurement) SetPerimeter(val float64) {
+	s.Perimeter = val
+}
 
-// Reset unsets value.
-func (o *OptCytologyPatientShotDiagnosticMarking) Reset() {
-	var v CytologyPatientShotDiagnosticMarking
-	o.Value = v
-	o.Set = false
+// SetMajorAxis sets the value of MajorAxis.
+func (s *NodeMeasurement) SetMajorAxis(val float64) {
+	s.MajorAxis = val
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyPatientShotDiagnosticMarking) SetTo(v CytologyPatientShotDiagnosticMarking) {
-	o.Set = true
-	o.Value = v
+// SetMinorAxis sets the value of MinorAxis.
+func (s *NodeMeasurement) SetMinorAxis(val float64) {
+	s.MinorAxis = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyPatientShotDiagnosticMarking) Get() (v CytologyPatientShotDiagnosticMarking, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetVolume sets the value of Volume.
+func (s *NodeMeasurement) SetVolume(val float64) {
+	s.Volume = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyPatientShotDiagnosticMarking) Or(d CytologyPatientShotDiagnosticMarking) CytologyPatientShotDiagnosticMarking {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetUnit sets the value of Unit.
+func (s *NodeMeasurement) SetUnit(val MeasureUnit) {
+	s.Unit = val
 }
 
-// NewOptCytologyPatientShotMaterialType returns new OptCytologyPatientShotMaterialType with value set to v.
-func NewOptCytologyPatientShotMaterialType(v CytologyPatientShotMaterialType) OptCytologyPatientShotMaterialType {
-	return OptCytologyPatientShotMaterialType{
-		Value: v,
-		Set:   true,
-	}
+// Качество нейросети относительно валидации врачами.
+// Ref: #/components/schemas/node_metrics
+type NodeMetrics struct {
+	Uzis        int `json:"uzis"`
+	AiNodes     int `json:"ai_nodes"`
+	Valid       int `json:"valid"`
+	Invalid     int `json:"invalid"`
+	Unvalidated int `json:"unvalidated"`
+	ManualNodes int `json:"manual_nodes"`
+	// Valid / (valid + invalid), отсутствует без провалидированных
+	// узлов.
+	Precision OptFloat64 `json:"precision"`
+	// Доля нейросетевых узлов без валидации, отсутствует
+	// без нейросетевых узлов.
+	UnvalidatedShare OptFloat64         `json:"unvalidated_share"`
+	AiTirads         TiradsDistribution `json:"ai_tirads"`
+	ManualTirads     TiradsDistribution `json:"manual_tirads"`
 }
 
-// OptCytologyPatientShotMaterialType is optional CytologyPatientShotMaterialType.
-type OptCytologyPatientShotMaterialType struct {
-	Value CytologyPatientShotMaterialType
-	Set   bool
+// GetUzis returns the value of Uzis.
+func (s *NodeMetrics) GetUzis() int {
+	return s.Uzis
 }
 
-// IsSet returns true if OptCytologyPatientShotMaterialType was set.
-func (o OptCytologyPatientShotMaterialType) IsSet() bool { return o.Set }
+// GetAiNodes returns the value of AiNodes.
+func (s *NodeMetrics) GetAiNodes() int {
+	return s.AiNodes
+}
 
-// Reset unsets value.
-func (o *OptCytologyPatientShotMaterialType) Reset() {
-	var v CytologyPatientShotMaterialType
-	o.Value = v
-	o.Set = false
+// GetValid returns the value of Valid.
+func (s *NodeMetrics) GetValid() int {
+	return s.Valid
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyPatientShotMaterialType) SetTo(v CytologyPatientShotMaterialType) {
-	o.Set = true
-	o.Value = v
+// GetInvalid returns the value of Invalid.
+func (s *NodeMetrics) GetInvalid() int {
+	return s.Invalid
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyPatientShotMaterialType) Get() (v CytologyPatientShotMaterialType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetUnvalidated returns the value of Unvalidated.
+func (s *NodeMetrics) GetUnvalidated() int {
+	return s.Unvalidated
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyPatientShotMaterialType) Or(d CytologyPatientShotMaterialType) CytologyPatientShotMaterialType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetManualNodes returns the value of ManualNodes.
+func (s *NodeMetrics) GetManualNodes() int {
+	return s.ManualNodes
 }
 
-// NewOptCytologyReadOKInfoImageGroupDiagnosticMarking returns new OptCytologyReadOKInfoImageGroupDiagnosticMarking with value set to v.
-func NewOptCytologyReadOKInfoImageGroupDiagnosticMarking(v CytologyReadOKInfoImageGroupDiagnosticMarking) OptCytologyReadOKInfoImageGroupDiagnosticMarking {
-	return OptCytologyReadOKInfoImageGroupDiagnosticMarking{
-		Value: v,
-		Set:   true,
-	}
+// GetPrecision returns the value of Precision.
+func (s *NodeMetrics) GetPrecision() OptFloat64 {
+	return s.Precision
 }
 
-// OptCytologyReadOKInfoImageGroupDiagnosticMarking is optional CytologyReadOKInfoImageGroupDiagnosticMarking.
-type OptCytologyReadOKInfoImageGroupDiagnosticMarking struct {
-	Value CytologyReadOKInfoImageGroupDiagnosticMarking
-	Set   bool
+// GetUnvalidatedShare returns the value of UnvalidatedShare.
+func (s *NodeMetrics) GetUnvalidatedShare() OptFloat64 {
+	return s.UnvalidatedShare
 }
 
-// IsSet returns true if OptCytologyReadOKInfoImageGroupDiagnosticMarking was set.
-func (o OptCytologyReadOKInfoImageGroupDiagnosticMarking) IsSet() bool { return o.Set }
+// GetAiTirads returns the value of AiTirads.
+func (s *NodeMetrics) GetAiTirads() TiradsDistribution {
+	return s.AiTirads
+}
 
-// Reset unsets value.
-func (o *OptCytologyReadOKInfoImageGroupDiagnosticMarking) Reset() {
-	var v CytologyReadOKInfoImageGroupDiagnosticMarking
-	o.Value = v
-	o.Set = false
+// GetManualTirads returns the value of ManualTirads.
+func (s *NodeMetrics) GetManualTirads() TiradsDistribution {
+	return s.ManualTirads
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyReadOKInfoImageGroupDiagnosticMarking) SetTo(v CytologyReadOKInfoImageGroupDiagnosticMarking) {
-	o.Set = true
-	o.Value = v
+// SetUzis sets the value of Uzis.
+func (s *NodeMetrics) SetUzis(val int) {
+	s.Uzis = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyReadOKInfoImageGroupDiagnosticMarking) Get() (v CytologyReadOKInfoImageGroupDiagnosticMarking, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetAiNodes sets the value of AiNodes.
+func (s *NodeMetrics) SetAiNodes(val int) {
+	s.AiNodes = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyReadOKInfoImageGroupDiagnosticMarking) Or(d CytologyReadOKInfoImageGroupDiagnosticMarking) CytologyReadOKInfoImageGroupDiagnosticMarking {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetValid sets the value of Valid.
+func (s *NodeMetrics) SetValid(val int) {
+	s.Valid = val
 }
 
-// NewOptCytologyReadOKInfoImageGroupMaterialType returns new OptCytologyReadOKInfoImageGroupMaterialType with value set to v.
-func NewOptCytologyReadOKInfoImageGroupMaterialType(v CytologyReadOKInfoImageGroupMaterialType) OptCytologyReadOKInfoImageGroupMaterialType {
-	return OptCytologyReadOKInfoImageGroupMaterialType{
-		Value: v,
-		Set:   true,
-	}
+// SetInvalid sets the value of Invalid.
+func (s *NodeMetrics) SetInvalid(val int) {
+	s.Invalid = val
 }
 
-// OptCytologyReadOKInfoImageGroupMaterialType is optional CytologyReadOKInfoImageGroupMaterialType.
-type OptCytologyReadOKInfoImageGroupMaterialType struct {
-	Value CytologyReadOKInfoImageGroupMaterialType
-	Set   bool
+// SetUnvalidated sets the value of Unvalidated.
+func (s *NodeMetrics) SetUnvalidated(val int) {
+	s.Unvalidated = val
 }
 
-// IsSet returns true if OptCytologyReadOKInfoImageGroupMaterialType was set.
-func (o OptCytologyReadOKInfoImageGroupMaterialType) IsSet() bool { return o.Set }
+// SetManualNodes sets the value of ManualNodes.
+func (s *NodeMetrics) SetManualNodes(val int) {
+	s.ManualNodes = val
+}
 
-// Reset unsets value.
-func (o *OptCytologyReadOKInfoImageGroupMaterialType) Reset() {
-	var v CytologyReadOKInfoImageGroupMaterialType
-	o.Value = v
-	o.Set = false
+// SetPrecision sets the value of Precision.
+func (s *NodeMetrics) SetPrecision(val OptFloat64) {
+	s.Precision = val
 }
 
-// SetTo sets value to v.
-func (o *OptCytologyReadOKInfoImageGroupMaterialType) SetTo(v CytologyReadOKInfoImageGroupMaterialType) {
-	o.Set = true
-	o.Value = v
+// SetUnvalidatedShare sets the value of UnvalidatedShare.
+func (s *NodeMetrics) SetUnvalidatedShare(val OptFloat64) {
+	s.UnvalidatedShare = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyReadOKInfoImageGroupMaterialType) Get() (v CytologyReadOKInfoImageGroupMaterialType, ok bool) {
-	if !o.Set {
-		return v, false
+// SetAiTirads sets the value of AiTirads.
+func (s *NodeMetrics) SetAiTirads(val TiradsDistribution) {
+	s.AiTirads = val
+}
+
+// SetManualTirads sets the value of ManualTirads.
+func (s *NodeMetrics) SetManualTirads(val TiradsDistribution) {
+	s.ManualTirads = val
+}
+
+// Результат пакетной валидации для одного узла.
+// Ref: #/components/schemas/node_review_item
+type NodeReviewItem struct {
+	NodeID uuid.UUID `json:"node_id"`
+	// Applied - валидация изменена, unchanged - менять не требуется,
+	// not_found - узел не найден,
+	// manual_node - ручной узел не валидируется, already_validated -
+	// решение врача не перезаписывается,
+	// below_threshold - уверенность нейросети ниже порога.
+	Status NodeReviewItemStatus `json:"status"`
+	// Узел после операции, отсутствует если узел не найден.
+	Node OptNode `json:"node"`
+}
+
+// GetNodeID returns the value of NodeID.
+func (s *NodeReviewItem) GetNodeID() uuid.UUID {
+	return s.NodeID
+}
+
+// GetStatus returns the value of Status.
+func (s *NodeReviewItem) GetStatus() NodeReviewItemStatus {
+	return s.Status
+}
+
+// GetNode returns the value of Node.
+func (s *NodeReviewItem) GetNode() OptNode {
+	return s.Node
+}
+
+// SetNodeID sets the value of NodeID.
+func (s *NodeReviewItem) SetNodeID(val uuid.UUID) {
+	s.NodeID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *NodeReviewItem) SetStatus(val NodeReviewItemStatus) {
+	s.Status = val
+}
+
+// SetNode sets the value of Node.
+func (s *NodeReviewItem) SetNode(val OptNode) {
+	s.Node = val
+}
+
+// Applied - валидация изменена, unchanged - менять не требуется,
+// not_found - узел не найден,
+// manual_node - ручной узел не валидируется, already_validated -
+// решение врача не перезаписывается,
+// below_threshold - уверенность нейросети ниже порога.
+type NodeReviewItemStatus string
+
+const (
+	NodeReviewItemStatusApplied          NodeReviewItemStatus = "applied"
+	NodeReviewItemStatusUnchanged        NodeReviewItemStatus = "unchanged"
+	NodeReviewItemStatusNotFound         NodeReviewItemStatus = "not_found"
+	NodeReviewItemStatusManualNode       NodeReviewItemStatus = "manual_node"
+	NodeReviewItemStatusAlreadyValidated NodeReviewItemStatus = "already_validated"
+	NodeReviewItemStatusBelowThreshold   NodeReviewItemStatus = "below_threshold"
+)
+
+// AllValues returns all NodeReviewItemStatus values.
+func (NodeReviewItemStatus) AllValues() []NodeReviewItemStatus {
+	return []NodeReviewItemStatus{
+		NodeReviewItemStatusApplied,
+		NodeReviewItemStatusUnchanged,
+		NodeReviewItemStatusNotFound,
+		NodeReviewItemStatusManualNode,
+		NodeReviewItemStatusAlreadyValidated,
+		NodeReviewItemStatusBelowThreshold,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologyReadOKInfoImageGroupMaterialType) Or(d CytologyReadOKInfoImageGroupMaterialType) CytologyReadOKInfoImageGroupMaterialType {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s NodeReviewItemStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case NodeReviewItemStatusApplied:
+		return []byte(s), nil
+	case NodeReviewItemStatusUnchanged:
+		return []byte(s), nil
+	case NodeReviewItemStatusNotFound:
+		return []byte(s), nil
+	case NodeReviewItemStatusManualNode:
+		return []byte(s), nil
+	case NodeReviewItemStatusAlreadyValidated:
+		return []byte(s), nil
+	case NodeReviewItemStatusBelowThreshold:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptCytologySegmentGroupCreateCreateCreatedData returns new OptCytologySegmentGroupCreateCreateCreatedData with value set to v.
-func NewOptCytologySegmentGroupCreateCreateCreatedData(v CytologySegmentGroupCreateCreateCreatedData) OptCytologySegmentGroupCreateCreateCreatedData {
-	return OptCytologySegmentGroupCreateCreateCreatedData{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NodeReviewItemStatus) UnmarshalText(data []byte) error {
+	switch NodeReviewItemStatus(data) {
+	case NodeReviewItemStatusApplied:
+		*s = NodeReviewItemStatusApplied
+		return nil
+	case NodeReviewItemStatusUnchanged:
+		*s = NodeReviewItemStatusUnchanged
+		return nil
+	case NodeReviewItemStatusNotFound:
+		*s = NodeReviewItemStatusNotFound
+		return nil
+	case NodeReviewItemStatusManualNode:
+		*s = NodeReviewItemStatusManualNode
+		return nil
+	case NodeReviewItemStatusAlreadyValidated:
+		*s = NodeReviewItemStatusAlreadyValidated
+		return nil
+	case NodeReviewItemStatusBelowThreshold:
+		*s = NodeReviewItemStatusBelowThreshold
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptCytologySegmentGroupCreateCreateCreatedData is optional CytologySegmentGroupCreateCreateCreatedData.
-type OptCytologySegmentGroupCreateCreateCreatedData struct {
-	Value CytologySegmentGroupCreateCreateCreatedData
-	Set   bool
+// Узел с дескрипторами и оценкой ACR TI-RADS.
+// Ref: #/components/schemas/node_tirads
+type NodeTirads struct {
+	Node        Node               `json:"node"`
+	Descriptors OptNodeDescriptors `json:"descriptors"`
+	Score       OptTiradsScore     `json:"score"`
 }
 
-// IsSet returns true if OptCytologySegmentGroupCreateCreateCreatedData was set.
-func (o OptCytologySegmentGroupCreateCreateCreatedData) IsSet() bool { return o.Set }
+// GetNode returns the value of Node.
+func (s *NodeTirads) GetNode() Node {
+	return s.Node
+}
 
-// Reset unsets value.
-func (o *OptCytologySegmentGroupCreateCreateCreatedData) Reset() {
-	var v CytologySegmentGroupCreateCreateCreatedData
-	o.Value = v
-	o.Set = false
+// GetDescriptors returns the value of Descriptors.
+func (s *NodeTirads) GetDescriptors() OptNodeDescriptors {
+	return s.Descriptors
 }
 
-// SetTo sets value to v.
-func (o *OptCytologySegmentGroupCreateCreateCreatedData) SetTo(v CytologySegmentGroupCreateCreateCreatedData) {
-	o.Set = true
-	o.Value = v
+// GetScore returns the value of Score.
+func (s *NodeTirads) GetScore() OptTiradsScore {
+	return s.Score
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCytologySegmentGroupCreateCreateCreatedData) Get() (v CytologySegmentGroupCreateCreateCreatedData, ok bool) {
-	if !o.Set {
-		return v, false
+// SetNode sets the value of Node.
+func (s *NodeTirads) SetNode(val Node) {
+	s.Node = val
+}
+
+// SetDescriptors sets the value of Descriptors.
+func (s *NodeTirads) SetDescriptors(val OptNodeDescriptors) {
+	s.Descriptors = val
+}
+
+// SetScore sets the value of Score.
+func (s *NodeTirads) SetScore(val OptTiradsScore) {
+	s.Score = val
+}
+
+func (*NodeTirads) uziNodesIDTiradsGetRes() {}
+func (*NodeTirads) uziNodesIDTiradsPutRes() {}
+
+// Валидация нейроночного узла врачем.
+type NodeValidation string
+
+const (
+	NodeValidationInvalid NodeValidation = "invalid"
+	NodeValidationValid   NodeValidation = "valid"
+)
+
+// AllValues returns all NodeValidation values.
+func (NodeValidation) AllValues() []NodeValidation {
+	return []NodeValidation{
+		NodeValidationInvalid,
+		NodeValidationValid,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCytologySegmentGroupCreateCreateCreatedData) Or(d CytologySegmentGroupCreateCreateCreatedData) CytologySegmentGroupCreateCreateCreatedData {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s NodeValidation) MarshalText() ([]byte, error) {
+	switch s {
+	case NodeValidationInvalid:
+		return []byte(s), nil
+	case NodeValidationValid:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptCytologySegmentsListGroupType returns new OptCytologySegmentsListGroupType with value set to v.
-func NewOptCytologySegmentsListGroupType(v CytologySegmentsListGroupType) OptCytologySegmentsListGroupType {
-	return OptCytologySegmentsListGroupType{
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NodeValidation) UnmarshalText(data []byte) error {
+	switch NodeValidation(data) {
+	case NodeValidationInvalid:
+		*s = NodeValidationInvalid
+		return nil
+	case NodeValidationValid:
+		*s = NodeValidationValid
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologySegmentsListGroupType is optional CytologySegmentsListGroupType.
-type OptCytologySegmentsListGroupType struct {
-	Value CytologySegmentsListGroupType
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
 	Set   bool
 }
 
-// IsSet returns true if OptCytologySegmentsListGroupType was set.
-func (o OptCytologySegmentsListGroupType) IsSet() bool { return o.Set }
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologySegmentsListGroupType) Reset() {
-	var v CytologySegmentsListGroupType
+func (o *OptBool) Reset() {
+	var v bool
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologySegmentsListGroupType) SetTo(v CytologySegmentsListGroupType) {
+func (o *OptBool) SetTo(v bool) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologySegmentsListGroupType) Get() (v CytologySegmentsListGroupType, ok bool) {
+func (o OptBool) Get() (v bool, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -5771,45 +7987,45 @@ func (o OptCytologySegmentsListGroupType) Get() (v CytologySegmentsListGroupType
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologySegmentsListGroupType) Or(d CytologySegmentsListGroupType) CytologySegmentsListGroupType {
+func (o OptBool) Or(d bool) bool {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologySegmentsListOKResultsItemGroupType returns new OptCytologySegmentsListOKResultsItemGroupType with value set to v.
-func NewOptCytologySegmentsListOKResultsItemGroupType(v CytologySegmentsListOKResultsItemGroupType) OptCytologySegmentsListOKResultsItemGroupType {
-	return OptCytologySegmentsListOKResultsItemGroupType{
+// NewOptContor returns new OptContor with value set to v.
+func NewOptContor(v Contor) OptContor {
+	return OptContor{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologySegmentsListOKResultsItemGroupType is optional CytologySegmentsListOKResultsItemGroupType.
-type OptCytologySegmentsListOKResultsItemGroupType struct {
-	Value CytologySegmentsListOKResultsItemGroupType
+// OptContor is optional Contor.
+type OptContor struct {
+	Value Contor
 	Set   bool
 }
 
-// IsSet returns true if OptCytologySegmentsListOKResultsItemGroupType was set.
-func (o OptCytologySegmentsListOKResultsItemGroupType) IsSet() bool { return o.Set }
+// IsSet returns true if OptContor was set.
+func (o OptContor) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologySegmentsListOKResultsItemGroupType) Reset() {
-	var v CytologySegmentsListOKResultsItemGroupType
+func (o *OptContor) Reset() {
+	var v Contor
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologySegmentsListOKResultsItemGroupType) SetTo(v CytologySegmentsListOKResultsItemGroupType) {
+func (o *OptContor) SetTo(v Contor) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologySegmentsListOKResultsItemGroupType) Get() (v CytologySegmentsListOKResultsItemGroupType, ok bool) {
+func (o OptContor) Get() (v Contor, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -5817,45 +8033,45 @@ func (o OptCytologySegmentsListOKResultsItemGroupType) Get() (v CytologySegments
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologySegmentsListOKResultsItemGroupType) Or(d CytologySegmentsListOKResultsItemGroupType) CytologySegmentsListOKResultsItemGroupType {
+func (o OptContor) Or(d Contor) Contor {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologySegmentsListOKResultsItemSegType returns new OptCytologySegmentsListOKResultsItemSegType with value set to v.
-func NewOptCytologySegmentsListOKResultsItemSegType(v CytologySegmentsListOKResultsItemSegType) OptCytologySegmentsListOKResultsItemSegType {
-	return OptCytologySegmentsListOKResultsItemSegType{
+// NewOptCytologyColor returns new OptCytologyColor with value set to v.
+func NewOptCytologyColor(v CytologyColor) OptCytologyColor {
+	return OptCytologyColor{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologySegmentsListOKResultsItemSegType is optional CytologySegmentsListOKResultsItemSegType.
-type OptCytologySegmentsListOKResultsItemSegType struct {
-	Value CytologySegmentsListOKResultsItemSegType
+// OptCytologyColor is optional CytologyColor.
+type OptCytologyColor struct {
+	Value CytologyColor
 	Set   bool
 }
 
-// IsSet returns true if OptCytologySegmentsListOKResultsItemSegType was set.
-func (o OptCytologySegmentsListOKResultsItemSegType) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyColor was set.
+func (o OptCytologyColor) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologySegmentsListOKResultsItemSegType) Reset() {
-	var v CytologySegmentsListOKResultsItemSegType
+func (o *OptCytologyColor) Reset() {
+	var v CytologyColor
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologySegmentsListOKResultsItemSegType) SetTo(v CytologySegmentsListOKResultsItemSegType) {
+func (o *OptCytologyColor) SetTo(v CytologyColor) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologySegmentsListOKResultsItemSegType) Get() (v CytologySegmentsListOKResultsItemSegType, ok bool) {
+func (o OptCytologyColor) Get() (v CytologyColor, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -5863,45 +8079,45 @@ func (o OptCytologySegmentsListOKResultsItemSegType) Get() (v CytologySegmentsLi
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologySegmentsListOKResultsItemSegType) Or(d CytologySegmentsListOKResultsItemSegType) CytologySegmentsListOKResultsItemSegType {
+func (o OptCytologyColor) Or(d CytologyColor) CytologyColor {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologySegmentsListSegType returns new OptCytologySegmentsListSegType with value set to v.
-func NewOptCytologySegmentsListSegType(v CytologySegmentsListSegType) OptCytologySegmentsListSegType {
-	return OptCytologySegmentsListSegType{
+// NewOptCytologyCreateCreateCreatedDiagnosticMarking returns new OptCytologyCreateCreateCreatedDiagnosticMarking with value set to v.
+func NewOptCytologyCreateCreateCreatedDiagnosticMarking(v CytologyCreateCreateCreatedDiagnosticMarking) OptCytologyCreateCreateCreatedDiagnosticMarking {
+	return OptCytologyCreateCreateCreatedDiagnosticMarking{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologySegmentsListSegType is optional CytologySegmentsListSegType.
-type OptCytologySegmentsListSegType struct {
-	Value CytologySegmentsListSegType
+// OptCytologyCreateCreateCreatedDiagnosticMarking is optional CytologyCreateCreateCreatedDiagnosticMarking.
+type OptCytologyCreateCreateCreatedDiagnosticMarking struct {
+	Value CytologyCreateCreateCreatedDiagnosticMarking
 	Set   bool
 }
 
-// IsSet returns true if OptCytologySegmentsListSegType was set.
-func (o OptCytologySegmentsListSegType) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyCreateCreateCreatedDiagnosticMarking was set.
+func (o OptCytologyCreateCreateCreatedDiagnosticMarking) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologySegmentsListSegType) Reset() {
-	var v CytologySegmentsListSegType
+func (o *OptCytologyCreateCreateCreatedDiagnosticMarking) Reset() {
+	var v CytologyCreateCreateCreatedDiagnosticMarking
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologySegmentsListSegType) SetTo(v CytologySegmentsListSegType) {
+func (o *OptCytologyCreateCreateCreatedDiagnosticMarking) SetTo(v CytologyCreateCreateCreatedDiagnosticMarking) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologySegmentsListSegType) Get() (v CytologySegmentsListSegType, ok bool) {
+func (o OptCytologyCreateCreateCreatedDiagnosticMarking) Get() (v CytologyCreateCreateCreatedDiagnosticMarking, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -5909,45 +8125,45 @@ func (o OptCytologySegmentsListSegType) Get() (v CytologySegmentsListSegType, ok
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologySegmentsListSegType) Or(d CytologySegmentsListSegType) CytologySegmentsListSegType {
+func (o OptCytologyCreateCreateCreatedDiagnosticMarking) Or(d CytologyCreateCreateCreatedDiagnosticMarking) CytologyCreateCreateCreatedDiagnosticMarking {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologyUpdatePartialUpdateReqDiagnosticMarking returns new OptCytologyUpdatePartialUpdateReqDiagnosticMarking with value set to v.
-func NewOptCytologyUpdatePartialUpdateReqDiagnosticMarking(v CytologyUpdatePartialUpdateReqDiagnosticMarking) OptCytologyUpdatePartialUpdateReqDiagnosticMarking {
-	return OptCytologyUpdatePartialUpdateReqDiagnosticMarking{
+// NewOptCytologyCreateCreateCreatedMaterialType returns new OptCytologyCreateCreateCreatedMaterialType with value set to v.
+func NewOptCytologyCreateCreateCreatedMaterialType(v CytologyCreateCreateCreatedMaterialType) OptCytologyCreateCreateCreatedMaterialType {
+	return OptCytologyCreateCreateCreatedMaterialType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologyUpdatePartialUpdateReqDiagnosticMarking is optional CytologyUpdatePartialUpdateReqDiagnosticMarking.
-type OptCytologyUpdatePartialUpdateReqDiagnosticMarking struct {
-	Value CytologyUpdatePartialUpdateReqDiagnosticMarking
+// OptCytologyCreateCreateCreatedMaterialType is optional CytologyCreateCreateCreatedMaterialType.
+type OptCytologyCreateCreateCreatedMaterialType struct {
+	Value CytologyCreateCreateCreatedMaterialType
 	Set   bool
 }
 
-// IsSet returns true if OptCytologyUpdatePartialUpdateReqDiagnosticMarking was set.
-func (o OptCytologyUpdatePartialUpdateReqDiagnosticMarking) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyCreateCreateCreatedMaterialType was set.
+func (o OptCytologyCreateCreateCreatedMaterialType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Reset() {
-	var v CytologyUpdatePartialUpdateReqDiagnosticMarking
+func (o *OptCytologyCreateCreateCreatedMaterialType) Reset() {
+	var v CytologyCreateCreateCreatedMaterialType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) SetTo(v CytologyUpdatePartialUpdateReqDiagnosticMarking) {
+func (o *OptCytologyCreateCreateCreatedMaterialType) SetTo(v CytologyCreateCreateCreatedMaterialType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Get() (v CytologyUpdatePartialUpdateReqDiagnosticMarking, ok bool) {
+func (o OptCytologyCreateCreateCreatedMaterialType) Get() (v CytologyCreateCreateCreatedMaterialType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -5955,45 +8171,45 @@ func (o OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Get() (v CytologyUpd
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Or(d CytologyUpdatePartialUpdateReqDiagnosticMarking) CytologyUpdatePartialUpdateReqDiagnosticMarking {
+func (o OptCytologyCreateCreateCreatedMaterialType) Or(d CytologyCreateCreateCreatedMaterialType) CytologyCreateCreateCreatedMaterialType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologyUpdatePartialUpdateReqMaterialType returns new OptCytologyUpdatePartialUpdateReqMaterialType with value set to v.
-func NewOptCytologyUpdatePartialUpdateReqMaterialType(v CytologyUpdatePartialUpdateReqMaterialType) OptCytologyUpdatePartialUpdateReqMaterialType {
-	return OptCytologyUpdatePartialUpdateReqMaterialType{
+// NewOptCytologyCreateCreateReqDiagnosticMarking returns new OptCytologyCreateCreateReqDiagnosticMarking with value set to v.
+func NewOptCytologyCreateCreateReqDiagnosticMarking(v CytologyCreateCreateReqDiagnosticMarking) OptCytologyCreateCreateReqDiagnosticMarking {
+	return OptCytologyCreateCreateReqDiagnosticMarking{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologyUpdatePartialUpdateReqMaterialType is optional CytologyUpdatePartialUpdateReqMaterialType.
-type OptCytologyUpdatePartialUpdateReqMaterialType struct {
-	Value CytologyUpdatePartialUpdateReqMaterialType
+// OptCytologyCreateCreateReqDiagnosticMarking is optional CytologyCreateCreateReqDiagnosticMarking.
+type OptCytologyCreateCreateReqDiagnosticMarking struct {
+	Value CytologyCreateCreateReqDiagnosticMarking
 	Set   bool
 }
 
-// IsSet returns true if OptCytologyUpdatePartialUpdateReqMaterialType was set.
-func (o OptCytologyUpdatePartialUpdateReqMaterialType) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyCreateCreateReqDiagnosticMarking was set.
+func (o OptCytologyCreateCreateReqDiagnosticMarking) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologyUpdatePartialUpdateReqMaterialType) Reset() {
-	var v CytologyUpdatePartialUpdateReqMaterialType
+func (o *OptCytologyCreateCreateReqDiagnosticMarking) Reset() {
+	var v CytologyCreateCreateReqDiagnosticMarking
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologyUpdatePartialUpdateReqMaterialType) SetTo(v CytologyUpdatePartialUpdateReqMaterialType) {
+func (o *OptCytologyCreateCreateReqDiagnosticMarking) SetTo(v CytologyCreateCreateReqDiagnosticMarking) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyUpdatePartialUpdateReqMaterialType) Get() (v CytologyUpdatePartialUpdateReqMaterialType, ok bool) {
+func (o OptCytologyCreateCreateReqDiagnosticMarking) Get() (v CytologyCreateCreateReqDiagnosticMarking, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6001,45 +8217,45 @@ func (o OptCytologyUpdatePartialUpdateReqMaterialType) Get() (v CytologyUpdatePa
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologyUpdatePartialUpdateReqMaterialType) Or(d CytologyUpdatePartialUpdateReqMaterialType) CytologyUpdatePartialUpdateReqMaterialType {
+func (o OptCytologyCreateCreateReqDiagnosticMarking) Or(d CytologyCreateCreateReqDiagnosticMarking) CytologyCreateCreateReqDiagnosticMarking {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologyUpdateUpdateReqDetails returns new OptCytologyUpdateUpdateReqDetails with value set to v.
-func NewOptCytologyUpdateUpdateReqDetails(v CytologyUpdateUpdateReqDetails) OptCytologyUpdateUpdateReqDetails {
-	return OptCytologyUpdateUpdateReqDetails{
+// NewOptCytologyCreateCreateReqMaterialType returns new OptCytologyCreateCreateReqMaterialType with value set to v.
+func NewOptCytologyCreateCreateReqMaterialType(v CytologyCreateCreateReqMaterialType) OptCytologyCreateCreateReqMaterialType {
+	return OptCytologyCreateCreateReqMaterialType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologyUpdateUpdateReqDetails is optional CytologyUpdateUpdateReqDetails.
-type OptCytologyUpdateUpdateReqDetails struct {
-	Value CytologyUpdateUpdateReqDetails
+// OptCytologyCreateCreateReqMaterialType is optional CytologyCreateCreateReqMaterialType.
+type OptCytologyCreateCreateReqMaterialType struct {
+	Value CytologyCreateCreateReqMaterialType
 	Set   bool
 }
 
-// IsSet returns true if OptCytologyUpdateUpdateReqDetails was set.
-func (o OptCytologyUpdateUpdateReqDetails) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyCreateCreateReqMaterialType was set.
+func (o OptCytologyCreateCreateReqMaterialType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologyUpdateUpdateReqDetails) Reset() {
-	var v CytologyUpdateUpdateReqDetails
+func (o *OptCytologyCreateCreateReqMaterialType) Reset() {
+	var v CytologyCreateCreateReqMaterialType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologyUpdateUpdateReqDetails) SetTo(v CytologyUpdateUpdateReqDetails) {
+func (o *OptCytologyCreateCreateReqMaterialType) SetTo(v CytologyCreateCreateReqMaterialType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyUpdateUpdateReqDetails) Get() (v CytologyUpdateUpdateReqDetails, ok bool) {
+func (o OptCytologyCreateCreateReqMaterialType) Get() (v CytologyCreateCreateReqMaterialType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6047,45 +8263,45 @@ func (o OptCytologyUpdateUpdateReqDetails) Get() (v CytologyUpdateUpdateReqDetai
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologyUpdateUpdateReqDetails) Or(d CytologyUpdateUpdateReqDetails) CytologyUpdateUpdateReqDetails {
+func (o OptCytologyCreateCreateReqMaterialType) Or(d CytologyCreateCreateReqMaterialType) CytologyCreateCreateReqMaterialType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologyUpdateUpdateReqDetailsDiagnosticMarking returns new OptCytologyUpdateUpdateReqDetailsDiagnosticMarking with value set to v.
-func NewOptCytologyUpdateUpdateReqDetailsDiagnosticMarking(v CytologyUpdateUpdateReqDetailsDiagnosticMarking) OptCytologyUpdateUpdateReqDetailsDiagnosticMarking {
-	return OptCytologyUpdateUpdateReqDetailsDiagnosticMarking{
+// NewOptCytologyGeometryType returns new OptCytologyGeometryType with value set to v.
+func NewOptCytologyGeometryType(v CytologyGeometryType) OptCytologyGeometryType {
+	return OptCytologyGeometryType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologyUpdateUpdateReqDetailsDiagnosticMarking is optional CytologyUpdateUpdateReqDetailsDiagnosticMarking.
-type OptCytologyUpdateUpdateReqDetailsDiagnosticMarking struct {
-	Value CytologyUpdateUpdateReqDetailsDiagnosticMarking
+// OptCytologyGeometryType is optional CytologyGeometryType.
+type OptCytologyGeometryType struct {
+	Value CytologyGeometryType
 	Set   bool
 }
 
-// IsSet returns true if OptCytologyUpdateUpdateReqDetailsDiagnosticMarking was set.
-func (o OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyGeometryType was set.
+func (o OptCytologyGeometryType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Reset() {
-	var v CytologyUpdateUpdateReqDetailsDiagnosticMarking
+func (o *OptCytologyGeometryType) Reset() {
+	var v CytologyGeometryType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) SetTo(v CytologyUpdateUpdateReqDetailsDiagnosticMarking) {
+func (o *OptCytologyGeometryType) SetTo(v CytologyGeometryType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Get() (v CytologyUpdateUpdateReqDetailsDiagnosticMarking, ok bool) {
+func (o OptCytologyGeometryType) Get() (v CytologyGeometryType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6093,45 +8309,45 @@ func (o OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Get() (v CytologyUpd
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Or(d CytologyUpdateUpdateReqDetailsDiagnosticMarking) CytologyUpdateUpdateReqDetailsDiagnosticMarking {
+func (o OptCytologyGeometryType) Or(d CytologyGeometryType) CytologyGeometryType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologyUpdateUpdateReqDetailsMaterialType returns new OptCytologyUpdateUpdateReqDetailsMaterialType with value set to v.
-func NewOptCytologyUpdateUpdateReqDetailsMaterialType(v CytologyUpdateUpdateReqDetailsMaterialType) OptCytologyUpdateUpdateReqDetailsMaterialType {
-	return OptCytologyUpdateUpdateReqDetailsMaterialType{
+// NewOptCytologyHistoryReadOKResultsItemDiagnosticMarking returns new OptCytologyHistoryReadOKResultsItemDiagnosticMarking with value set to v.
+func NewOptCytologyHistoryReadOKResultsItemDiagnosticMarking(v CytologyHistoryReadOKResultsItemDiagnosticMarking) OptCytologyHistoryReadOKResultsItemDiagnosticMarking {
+	return OptCytologyHistoryReadOKResultsItemDiagnosticMarking{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologyUpdateUpdateReqDetailsMaterialType is optional CytologyUpdateUpdateReqDetailsMaterialType.
-type OptCytologyUpdateUpdateReqDetailsMaterialType struct {
-	Value CytologyUpdateUpdateReqDetailsMaterialType
+// OptCytologyHistoryReadOKResultsItemDiagnosticMarking is optional CytologyHistoryReadOKResultsItemDiagnosticMarking.
+type OptCytologyHistoryReadOKResultsItemDiagnosticMarking struct {
+	Value CytologyHistoryReadOKResultsItemDiagnosticMarking
 	Set   bool
 }
 
-// IsSet returns true if OptCytologyUpdateUpdateReqDetailsMaterialType was set.
-func (o OptCytologyUpdateUpdateReqDetailsMaterialType) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyHistoryReadOKResultsItemDiagnosticMarking was set.
+func (o OptCytologyHistoryReadOKResultsItemDiagnosticMarking) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologyUpdateUpdateReqDetailsMaterialType) Reset() {
-	var v CytologyUpdateUpdateReqDetailsMaterialType
+func (o *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Reset() {
+	var v CytologyHistoryReadOKResultsItemDiagnosticMarking
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologyUpdateUpdateReqDetailsMaterialType) SetTo(v CytologyUpdateUpdateReqDetailsMaterialType) {
+func (o *OptCytologyHistoryReadOKResultsItemDiagnosticMarking) SetTo(v CytologyHistoryReadOKResultsItemDiagnosticMarking) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyUpdateUpdateReqDetailsMaterialType) Get() (v CytologyUpdateUpdateReqDetailsMaterialType, ok bool) {
+func (o OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Get() (v CytologyHistoryReadOKResultsItemDiagnosticMarking, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6139,45 +8355,45 @@ func (o OptCytologyUpdateUpdateReqDetailsMaterialType) Get() (v CytologyUpdateUp
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologyUpdateUpdateReqDetailsMaterialType) Or(d CytologyUpdateUpdateReqDetailsMaterialType) CytologyUpdateUpdateReqDetailsMaterialType {
+func (o OptCytologyHistoryReadOKResultsItemDiagnosticMarking) Or(d CytologyHistoryReadOKResultsItemDiagnosticMarking) CytologyHistoryReadOKResultsItemDiagnosticMarking {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologyUpdateUpdateReqDiagnosticMarking returns new OptCytologyUpdateUpdateReqDiagnosticMarking with value set to v.
-func NewOptCytologyUpdateUpdateReqDiagnosticMarking(v CytologyUpdateUpdateReqDiagnosticMarking) OptCytologyUpdateUpdateReqDiagnosticMarking {
-	return OptCytologyUpdateUpdateReqDiagnosticMarking{
+// NewOptCytologyHistoryReadOKResultsItemMaterialType returns new OptCytologyHistoryReadOKResultsItemMaterialType with value set to v.
+func NewOptCytologyHistoryReadOKResultsItemMaterialType(v CytologyHistoryReadOKResultsItemMaterialType) OptCytologyHistoryReadOKResultsItemMaterialType {
+	return OptCytologyHistoryReadOKResultsItemMaterialType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologyUpdateUpdateReqDiagnosticMarking is optional CytologyUpdateUpdateReqDiagnosticMarking.
-type OptCytologyUpdateUpdateReqDiagnosticMarking struct {
-	Value CytologyUpdateUpdateReqDiagnosticMarking
+// OptCytologyHistoryReadOKResultsItemMaterialType is optional CytologyHistoryReadOKResultsItemMaterialType.
+type OptCytologyHistoryReadOKResultsItemMaterialType struct {
+	Value CytologyHistoryReadOKResultsItemMaterialType
 	Set   bool
 }
 
-// IsSet returns true if OptCytologyUpdateUpdateReqDiagnosticMarking was set.
-func (o OptCytologyUpdateUpdateReqDiagnosticMarking) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyHistoryReadOKResultsItemMaterialType was set.
+func (o OptCytologyHistoryReadOKResultsItemMaterialType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologyUpdateUpdateReqDiagnosticMarking) Reset() {
-	var v CytologyUpdateUpdateReqDiagnosticMarking
+func (o *OptCytologyHistoryReadOKResultsItemMaterialType) Reset() {
+	var v CytologyHistoryReadOKResultsItemMaterialType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologyUpdateUpdateReqDiagnosticMarking) SetTo(v CytologyUpdateUpdateReqDiagnosticMarking) {
+func (o *OptCytologyHistoryReadOKResultsItemMaterialType) SetTo(v CytologyHistoryReadOKResultsItemMaterialType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyUpdateUpdateReqDiagnosticMarking) Get() (v CytologyUpdateUpdateReqDiagnosticMarking, ok bool) {
+func (o OptCytologyHistoryReadOKResultsItemMaterialType) Get() (v CytologyHistoryReadOKResultsItemMaterialType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6185,45 +8401,45 @@ func (o OptCytologyUpdateUpdateReqDiagnosticMarking) Get() (v CytologyUpdateUpda
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologyUpdateUpdateReqDiagnosticMarking) Or(d CytologyUpdateUpdateReqDiagnosticMarking) CytologyUpdateUpdateReqDiagnosticMarking {
+func (o OptCytologyHistoryReadOKResultsItemMaterialType) Or(d CytologyHistoryReadOKResultsItemMaterialType) CytologyHistoryReadOKResultsItemMaterialType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCytologyUpdateUpdateReqMaterialType returns new OptCytologyUpdateUpdateReqMaterialType with value set to v.
-func NewOptCytologyUpdateUpdateReqMaterialType(v CytologyUpdateUpdateReqMaterialType) OptCytologyUpdateUpdateReqMaterialType {
-	return OptCytologyUpdateUpdateReqMaterialType{
+// NewOptCytologyPatientShotDiagnosticMarking returns new OptCytologyPatientShotDiagnosticMarking with value set to v.
+func NewOptCytologyPatientShotDiagnosticMarking(v CytologyPatientShotDiagnosticMarking) OptCytologyPatientShotDiagnosticMarking {
+	return OptCytologyPatientShotDiagnosticMarking{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCytologyUpdateUpdateReqMaterialType is optional CytologyUpdateUpdateReqMaterialType.
-type OptCytologyUpdateUpdateReqMaterialType struct {
-	Value CytologyUpdateUpdateReqMaterialType
+// OptCytologyPatientShotDiagnosticMarking is optional CytologyPatientShotDiagnosticMarking.
+type OptCytologyPatientShotDiagnosticMarking struct {
+	Value CytologyPatientShotDiagnosticMarking
 	Set   bool
 }
 
-// IsSet returns true if OptCytologyUpdateUpdateReqMaterialType was set.
-func (o OptCytologyUpdateUpdateReqMaterialType) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyPatientShotDiagnosticMarking was set.
+func (o OptCytologyPatientShotDiagnosticMarking) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCytologyUpdateUpdateReqMaterialType) Reset() {
-	var v CytologyUpdateUpdateReqMaterialType
+func (o *OptCytologyPatientShotDiagnosticMarking) Reset() {
+	var v CytologyPatientShotDiagnosticMarking
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCytologyUpdateUpdateReqMaterialType) SetTo(v CytologyUpdateUpdateReqMaterialType) {
+func (o *OptCytologyPatientShotDiagnosticMarking) SetTo(v CytologyPatientShotDiagnosticMarking) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCytologyUpdateUpdateReqMaterialType) Get() (v CytologyUpdateUpdateReqMaterialType, ok bool) {
+func (o OptCytologyPatientShotDiagnosticMarking) Get() (v CytologyPatientShotDiagnosticMarking, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6231,45 +8447,45 @@ func (o OptCytologyUpdateUpdateReqMaterialType) Get() (v CytologyUpdateUpdateReq
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCytologyUpdateUpdateReqMaterialType) Or(d CytologyUpdateUpdateReqMaterialType) CytologyUpdateUpdateReqMaterialType {
+func (o OptCytologyPatientShotDiagnosticMarking) Or(d CytologyPatientShotDiagnosticMarking) CytologyPatientShotDiagnosticMarking {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptDate returns new OptDate with value set to v.
-func NewOptDate(v time.Time) OptDate {
-	return OptDate{
+// NewOptCytologyPatientShotMaterialType returns new OptCytologyPatientShotMaterialType with value set to v.
+func NewOptCytologyPatientShotMaterialType(v CytologyPatientShotMaterialType) OptCytologyPatientShotMaterialType {
+	return OptCytologyPatientShotMaterialType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDate is optional time.Time.
-type OptDate struct {
-	Value time.Time
+// OptCytologyPatientShotMaterialType is optional CytologyPatientShotMaterialType.
+type OptCytologyPatientShotMaterialType struct {
+	Value CytologyPatientShotMaterialType
 	Set   bool
 }
 
-// IsSet returns true if OptDate was set.
-func (o OptDate) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyPatientShotMaterialType was set.
+func (o OptCytologyPatientShotMaterialType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDate) Reset() {
-	var v time.Time
+func (o *OptCytologyPatientShotMaterialType) Reset() {
+	var v CytologyPatientShotMaterialType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDate) SetTo(v time.Time) {
+func (o *OptCytologyPatientShotMaterialType) SetTo(v CytologyPatientShotMaterialType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDate) Get() (v time.Time, ok bool) {
+func (o OptCytologyPatientShotMaterialType) Get() (v CytologyPatientShotMaterialType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6277,45 +8493,45 @@ func (o OptDate) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDate) Or(d time.Time) time.Time {
+func (o OptCytologyPatientShotMaterialType) Or(d CytologyPatientShotMaterialType) CytologyPatientShotMaterialType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
+// NewOptCytologyReadOKInfoImageGroupDiagnosticMarking returns new OptCytologyReadOKInfoImageGroupDiagnosticMarking with value set to v.
+func NewOptCytologyReadOKInfoImageGroupDiagnosticMarking(v CytologyReadOKInfoImageGroupDiagnosticMarking) OptCytologyReadOKInfoImageGroupDiagnosticMarking {
+	return OptCytologyReadOKInfoImageGroupDiagnosticMarking{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
+// OptCytologyReadOKInfoImageGroupDiagnosticMarking is optional CytologyReadOKInfoImageGroupDiagnosticMarking.
+type OptCytologyReadOKInfoImageGroupDiagnosticMarking struct {
+	Value CytologyReadOKInfoImageGroupDiagnosticMarking
 	Set   bool
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyReadOKInfoImageGroupDiagnosticMarking was set.
+func (o OptCytologyReadOKInfoImageGroupDiagnosticMarking) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
+func (o *OptCytologyReadOKInfoImageGroupDiagnosticMarking) Reset() {
+	var v CytologyReadOKInfoImageGroupDiagnosticMarking
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
+func (o *OptCytologyReadOKInfoImageGroupDiagnosticMarking) SetTo(v CytologyReadOKInfoImageGroupDiagnosticMarking) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
+func (o OptCytologyReadOKInfoImageGroupDiagnosticMarking) Get() (v CytologyReadOKInfoImageGroupDiagnosticMarking, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6323,45 +8539,45 @@ func (o OptDateTime) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
+func (o OptCytologyReadOKInfoImageGroupDiagnosticMarking) Or(d CytologyReadOKInfoImageGroupDiagnosticMarking) CytologyReadOKInfoImageGroupDiagnosticMarking {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptFloat64 returns new OptFloat64 with value set to v.
-func NewOptFloat64(v float64) OptFloat64 {
-	return OptFloat64{
+// NewOptCytologyReadOKInfoImageGroupMaterialType returns new OptCytologyReadOKInfoImageGroupMaterialType with value set to v.
+func NewOptCytologyReadOKInfoImageGroupMaterialType(v CytologyReadOKInfoImageGroupMaterialType) OptCytologyReadOKInfoImageGroupMaterialType {
+	return OptCytologyReadOKInfoImageGroupMaterialType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptFloat64 is optional float64.
-type OptFloat64 struct {
-	Value float64
+// OptCytologyReadOKInfoImageGroupMaterialType is optional CytologyReadOKInfoImageGroupMaterialType.
+type OptCytologyReadOKInfoImageGroupMaterialType struct {
+	Value CytologyReadOKInfoImageGroupMaterialType
 	Set   bool
 }
 
-// IsSet returns true if OptFloat64 was set.
-func (o OptFloat64) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyReadOKInfoImageGroupMaterialType was set.
+func (o OptCytologyReadOKInfoImageGroupMaterialType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptFloat64) Reset() {
-	var v float64
+func (o *OptCytologyReadOKInfoImageGroupMaterialType) Reset() {
+	var v CytologyReadOKInfoImageGroupMaterialType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptFloat64) SetTo(v float64) {
+func (o *OptCytologyReadOKInfoImageGroupMaterialType) SetTo(v CytologyReadOKInfoImageGroupMaterialType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptFloat64) Get() (v float64, ok bool) {
+func (o OptCytologyReadOKInfoImageGroupMaterialType) Get() (v CytologyReadOKInfoImageGroupMaterialType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6369,45 +8585,45 @@ func (o OptFloat64) Get() (v float64, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptFloat64) Or(d float64) float64 {
+func (o OptCytologyReadOKInfoImageGroupMaterialType) Or(d CytologyReadOKInfoImageGroupMaterialType) CytologyReadOKInfoImageGroupMaterialType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+// NewOptCytologySegmentGroupCreateCreateCreatedData returns new OptCytologySegmentGroupCreateCreateCreatedData with value set to v.
+func NewOptCytologySegmentGroupCreateCreateCreatedData(v CytologySegmentGroupCreateCreateCreatedData) OptCytologySegmentGroupCreateCreateCreatedData {
+	return OptCytologySegmentGroupCreateCreateCreatedData{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
+// OptCytologySegmentGroupCreateCreateCreatedData is optional CytologySegmentGroupCreateCreateCreatedData.
+type OptCytologySegmentGroupCreateCreateCreatedData struct {
+	Value CytologySegmentGroupCreateCreateCreatedData
 	Set   bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologySegmentGroupCreateCreateCreatedData was set.
+func (o OptCytologySegmentGroupCreateCreateCreatedData) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+func (o *OptCytologySegmentGroupCreateCreateCreatedData) Reset() {
+	var v CytologySegmentGroupCreateCreateCreatedData
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptCytologySegmentGroupCreateCreateCreatedData) SetTo(v CytologySegmentGroupCreateCreateCreatedData) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptCytologySegmentGroupCreateCreateCreatedData) Get() (v CytologySegmentGroupCreateCreateCreatedData, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6415,45 +8631,45 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptCytologySegmentGroupCreateCreateCreatedData) Or(d CytologySegmentGroupCreateCreateCreatedData) CytologySegmentGroupCreateCreateCreatedData {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptMultipartFile returns new OptMultipartFile with value set to v.
-func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
-	return OptMultipartFile{
+// NewOptCytologySegmentProperties returns new OptCytologySegmentProperties with value set to v.
+func NewOptCytologySegmentProperties(v CytologySegmentProperties) OptCytologySegmentProperties {
+	return OptCytologySegmentProperties{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptMultipartFile is optional ht.MultipartFile.
-type OptMultipartFile struct {
-	Value ht.MultipartFile
+// OptCytologySegmentProperties is optional CytologySegmentProperties.
+type OptCytologySegmentProperties struct {
+	Value CytologySegmentProperties
 	Set   bool
 }
 
-// IsSet returns true if OptMultipartFile was set.
-func (o OptMultipartFile) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologySegmentProperties was set.
+func (o OptCytologySegmentProperties) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptMultipartFile) Reset() {
-	var v ht.MultipartFile
+func (o *OptCytologySegmentProperties) Reset() {
+	var v CytologySegmentProperties
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
+func (o *OptCytologySegmentProperties) SetTo(v CytologySegmentProperties) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
+func (o OptCytologySegmentProperties) Get() (v CytologySegmentProperties, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6461,62 +8677,45 @@ func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
+func (o OptCytologySegmentProperties) Or(d CytologySegmentProperties) CytologySegmentProperties {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilNodeValidation returns new OptNilNodeValidation with value set to v.
-func NewOptNilNodeValidation(v NodeValidation) OptNilNodeValidation {
-	return OptNilNodeValidation{
-		Value: v,
-		Set:   true,
+// NewOptCytologySegmentsListGroupType returns new OptCytologySegmentsListGroupType with value set to v.
+func NewOptCytologySegmentsListGroupType(v CytologySegmentsListGroupType) OptCytologySegmentsListGroupType {
+	return OptCytologySegmentsListGroupType{
+		Value: v,
+		Set:   true,
 	}
 }
 
-// OptNilNodeValidation is optional nullable NodeValidation.
-type OptNilNodeValidation struct {
-	Value NodeValidation
+// OptCytologySegmentsListGroupType is optional CytologySegmentsListGroupType.
+type OptCytologySegmentsListGroupType struct {
+	Value CytologySegmentsListGroupType
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilNodeValidation was set.
-func (o OptNilNodeValidation) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologySegmentsListGroupType was set.
+func (o OptCytologySegmentsListGroupType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilNodeValidation) Reset() {
-	var v NodeValidation
+func (o *OptCytologySegmentsListGroupType) Reset() {
+	var v CytologySegmentsListGroupType
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilNodeValidation) SetTo(v NodeValidation) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilNodeValidation) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilNodeValidation) SetToNull() {
+func (o *OptCytologySegmentsListGroupType) SetTo(v CytologySegmentsListGroupType) {
 	o.Set = true
-	o.Null = true
-	var v NodeValidation
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilNodeValidation) Get() (v NodeValidation, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptCytologySegmentsListGroupType) Get() (v CytologySegmentsListGroupType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6524,62 +8723,45 @@ func (o OptNilNodeValidation) Get() (v NodeValidation, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilNodeValidation) Or(d NodeValidation) NodeValidation {
+func (o OptCytologySegmentsListGroupType) Or(d CytologySegmentsListGroupType) CytologySegmentsListGroupType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilUUID returns new OptNilUUID with value set to v.
-func NewOptNilUUID(v uuid.UUID) OptNilUUID {
-	return OptNilUUID{
+// NewOptCytologySegmentsListOKResultsItemGroupType returns new OptCytologySegmentsListOKResultsItemGroupType with value set to v.
+func NewOptCytologySegmentsListOKResultsItemGroupType(v CytologySegmentsListOKResultsItemGroupType) OptCytologySegmentsListOKResultsItemGroupType {
+	return OptCytologySegmentsListOKResultsItemGroupType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilUUID is optional nullable uuid.UUID.
-type OptNilUUID struct {
-	Value uuid.UUID
+// OptCytologySegmentsListOKResultsItemGroupType is optional CytologySegmentsListOKResultsItemGroupType.
+type OptCytologySegmentsListOKResultsItemGroupType struct {
+	Value CytologySegmentsListOKResultsItemGroupType
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilUUID was set.
-func (o OptNilUUID) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologySegmentsListOKResultsItemGroupType was set.
+func (o OptCytologySegmentsListOKResultsItemGroupType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilUUID) Reset() {
-	var v uuid.UUID
+func (o *OptCytologySegmentsListOKResultsItemGroupType) Reset() {
+	var v CytologySegmentsListOKResultsItemGroupType
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilUUID) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilUUID) SetToNull() {
+func (o *OptCytologySegmentsListOKResultsItemGroupType) SetTo(v CytologySegmentsListOKResultsItemGroupType) {
 	o.Set = true
-	o.Null = true
-	var v uuid.UUID
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptCytologySegmentsListOKResultsItemGroupType) Get() (v CytologySegmentsListOKResultsItemGroupType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6587,62 +8769,45 @@ func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+func (o OptCytologySegmentsListOKResultsItemGroupType) Or(d CytologySegmentsListOKResultsItemGroupType) CytologySegmentsListOKResultsItemGroupType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilUziNodesIDPatchReqValidation returns new OptNilUziNodesIDPatchReqValidation with value set to v.
-func NewOptNilUziNodesIDPatchReqValidation(v UziNodesIDPatchReqValidation) OptNilUziNodesIDPatchReqValidation {
-	return OptNilUziNodesIDPatchReqValidation{
+// NewOptCytologySegmentsListOKResultsItemSegType returns new OptCytologySegmentsListOKResultsItemSegType with value set to v.
+func NewOptCytologySegmentsListOKResultsItemSegType(v CytologySegmentsListOKResultsItemSegType) OptCytologySegmentsListOKResultsItemSegType {
+	return OptCytologySegmentsListOKResultsItemSegType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilUziNodesIDPatchReqValidation is optional nullable UziNodesIDPatchReqValidation.
-type OptNilUziNodesIDPatchReqValidation struct {
-	Value UziNodesIDPatchReqValidation
+// OptCytologySegmentsListOKResultsItemSegType is optional CytologySegmentsListOKResultsItemSegType.
+type OptCytologySegmentsListOKResultsItemSegType struct {
+	Value CytologySegmentsListOKResultsItemSegType
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilUziNodesIDPatchReqValidation was set.
-func (o OptNilUziNodesIDPatchReqValidation) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologySegmentsListOKResultsItemSegType was set.
+func (o OptCytologySegmentsListOKResultsItemSegType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilUziNodesIDPatchReqValidation) Reset() {
-	var v UziNodesIDPatchReqValidation
+func (o *OptCytologySegmentsListOKResultsItemSegType) Reset() {
+	var v CytologySegmentsListOKResultsItemSegType
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilUziNodesIDPatchReqValidation) SetTo(v UziNodesIDPatchReqValidation) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilUziNodesIDPatchReqValidation) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilUziNodesIDPatchReqValidation) SetToNull() {
+func (o *OptCytologySegmentsListOKResultsItemSegType) SetTo(v CytologySegmentsListOKResultsItemSegType) {
 	o.Set = true
-	o.Null = true
-	var v UziNodesIDPatchReqValidation
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilUziNodesIDPatchReqValidation) Get() (v UziNodesIDPatchReqValidation, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptCytologySegmentsListOKResultsItemSegType) Get() (v CytologySegmentsListOKResultsItemSegType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6650,45 +8815,45 @@ func (o OptNilUziNodesIDPatchReqValidation) Get() (v UziNodesIDPatchReqValidatio
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilUziNodesIDPatchReqValidation) Or(d UziNodesIDPatchReqValidation) UziNodesIDPatchReqValidation {
+func (o OptCytologySegmentsListOKResultsItemSegType) Or(d CytologySegmentsListOKResultsItemSegType) CytologySegmentsListOKResultsItemSegType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptCytologySegmentsListSegType returns new OptCytologySegmentsListSegType with value set to v.
+func NewOptCytologySegmentsListSegType(v CytologySegmentsListSegType) OptCytologySegmentsListSegType {
+	return OptCytologySegmentsListSegType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptCytologySegmentsListSegType is optional CytologySegmentsListSegType.
+type OptCytologySegmentsListSegType struct {
+	Value CytologySegmentsListSegType
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologySegmentsListSegType was set.
+func (o OptCytologySegmentsListSegType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptCytologySegmentsListSegType) Reset() {
+	var v CytologySegmentsListSegType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptCytologySegmentsListSegType) SetTo(v CytologySegmentsListSegType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptCytologySegmentsListSegType) Get() (v CytologySegmentsListSegType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6696,45 +8861,45 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptCytologySegmentsListSegType) Or(d CytologySegmentsListSegType) CytologySegmentsListSegType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptURI returns new OptURI with value set to v.
-func NewOptURI(v url.URL) OptURI {
-	return OptURI{
+// NewOptCytologySegmentsViewportGroupType returns new OptCytologySegmentsViewportGroupType with value set to v.
+func NewOptCytologySegmentsViewportGroupType(v CytologySegmentsViewportGroupType) OptCytologySegmentsViewportGroupType {
+	return OptCytologySegmentsViewportGroupType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptURI is optional url.URL.
-type OptURI struct {
-	Value url.URL
+// OptCytologySegmentsViewportGroupType is optional CytologySegmentsViewportGroupType.
+type OptCytologySegmentsViewportGroupType struct {
+	Value CytologySegmentsViewportGroupType
 	Set   bool
 }
 
-// IsSet returns true if OptURI was set.
-func (o OptURI) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologySegmentsViewportGroupType was set.
+func (o OptCytologySegmentsViewportGroupType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptURI) Reset() {
-	var v url.URL
+func (o *OptCytologySegmentsViewportGroupType) Reset() {
+	var v CytologySegmentsViewportGroupType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptURI) SetTo(v url.URL) {
+func (o *OptCytologySegmentsViewportGroupType) SetTo(v CytologySegmentsViewportGroupType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptURI) Get() (v url.URL, ok bool) {
+func (o OptCytologySegmentsViewportGroupType) Get() (v CytologySegmentsViewportGroupType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6742,45 +8907,45 @@ func (o OptURI) Get() (v url.URL, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptURI) Or(d url.URL) url.URL {
+func (o OptCytologySegmentsViewportGroupType) Or(d CytologySegmentsViewportGroupType) CytologySegmentsViewportGroupType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptUUID returns new OptUUID with value set to v.
-func NewOptUUID(v uuid.UUID) OptUUID {
-	return OptUUID{
+// NewOptCytologySegmentsViewportSegType returns new OptCytologySegmentsViewportSegType with value set to v.
+func NewOptCytologySegmentsViewportSegType(v CytologySegmentsViewportSegType) OptCytologySegmentsViewportSegType {
+	return OptCytologySegmentsViewportSegType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptUUID is optional uuid.UUID.
-type OptUUID struct {
-	Value uuid.UUID
+// OptCytologySegmentsViewportSegType is optional CytologySegmentsViewportSegType.
+type OptCytologySegmentsViewportSegType struct {
+	Value CytologySegmentsViewportSegType
 	Set   bool
 }
 
-// IsSet returns true if OptUUID was set.
-func (o OptUUID) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologySegmentsViewportSegType was set.
+func (o OptCytologySegmentsViewportSegType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptUUID) Reset() {
-	var v uuid.UUID
+func (o *OptCytologySegmentsViewportSegType) Reset() {
+	var v CytologySegmentsViewportSegType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptUUID) SetTo(v uuid.UUID) {
+func (o *OptCytologySegmentsViewportSegType) SetTo(v CytologySegmentsViewportSegType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+func (o OptCytologySegmentsViewportSegType) Get() (v CytologySegmentsViewportSegType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6788,45 +8953,45 @@ func (o OptUUID) Get() (v uuid.UUID, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+func (o OptCytologySegmentsViewportSegType) Or(d CytologySegmentsViewportSegType) CytologySegmentsViewportSegType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptUziIDPatchReqProjection returns new OptUziIDPatchReqProjection with value set to v.
-func NewOptUziIDPatchReqProjection(v UziIDPatchReqProjection) OptUziIDPatchReqProjection {
-	return OptUziIDPatchReqProjection{
+// NewOptCytologyUpdatePartialUpdateReqDiagnosticMarking returns new OptCytologyUpdatePartialUpdateReqDiagnosticMarking with value set to v.
+func NewOptCytologyUpdatePartialUpdateReqDiagnosticMarking(v CytologyUpdatePartialUpdateReqDiagnosticMarking) OptCytologyUpdatePartialUpdateReqDiagnosticMarking {
+	return OptCytologyUpdatePartialUpdateReqDiagnosticMarking{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptUziIDPatchReqProjection is optional UziIDPatchReqProjection.
-type OptUziIDPatchReqProjection struct {
-	Value UziIDPatchReqProjection
+// OptCytologyUpdatePartialUpdateReqDiagnosticMarking is optional CytologyUpdatePartialUpdateReqDiagnosticMarking.
+type OptCytologyUpdatePartialUpdateReqDiagnosticMarking struct {
+	Value CytologyUpdatePartialUpdateReqDiagnosticMarking
 	Set   bool
 }
 
-// IsSet returns true if OptUziIDPatchReqProjection was set.
-func (o OptUziIDPatchReqProjection) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyUpdatePartialUpdateReqDiagnosticMarking was set.
+func (o OptCytologyUpdatePartialUpdateReqDiagnosticMarking) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptUziIDPatchReqProjection) Reset() {
-	var v UziIDPatchReqProjection
+func (o *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Reset() {
+	var v CytologyUpdatePartialUpdateReqDiagnosticMarking
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptUziIDPatchReqProjection) SetTo(v UziIDPatchReqProjection) {
+func (o *OptCytologyUpdatePartialUpdateReqDiagnosticMarking) SetTo(v CytologyUpdatePartialUpdateReqDiagnosticMarking) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptUziIDPatchReqProjection) Get() (v UziIDPatchReqProjection, ok bool) {
+func (o OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Get() (v CytologyUpdatePartialUpdateReqDiagnosticMarking, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6834,45 +8999,45 @@ func (o OptUziIDPatchReqProjection) Get() (v UziIDPatchReqProjection, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptUziIDPatchReqProjection) Or(d UziIDPatchReqProjection) UziIDPatchReqProjection {
+func (o OptCytologyUpdatePartialUpdateReqDiagnosticMarking) Or(d CytologyUpdatePartialUpdateReqDiagnosticMarking) CytologyUpdatePartialUpdateReqDiagnosticMarking {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptYookassaWebhookRequestObject returns new OptYookassaWebhookRequestObject with value set to v.
-func NewOptYookassaWebhookRequestObject(v YookassaWebhookRequestObject) OptYookassaWebhookRequestObject {
-	return OptYookassaWebhookRequestObject{
+// NewOptCytologyUpdatePartialUpdateReqMaterialType returns new OptCytologyUpdatePartialUpdateReqMaterialType with value set to v.
+func NewOptCytologyUpdatePartialUpdateReqMaterialType(v CytologyUpdatePartialUpdateReqMaterialType) OptCytologyUpdatePartialUpdateReqMaterialType {
+	return OptCytologyUpdatePartialUpdateReqMaterialType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptYookassaWebhookRequestObject is optional YookassaWebhookRequestObject.
-type OptYookassaWebhookRequestObject struct {
-	Value YookassaWebhookRequestObject
+// OptCytologyUpdatePartialUpdateReqMaterialType is optional CytologyUpdatePartialUpdateReqMaterialType.
+type OptCytologyUpdatePartialUpdateReqMaterialType struct {
+	Value CytologyUpdatePartialUpdateReqMaterialType
 	Set   bool
 }
 
-// IsSet returns true if OptYookassaWebhookRequestObject was set.
-func (o OptYookassaWebhookRequestObject) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyUpdatePartialUpdateReqMaterialType was set.
+func (o OptCytologyUpdatePartialUpdateReqMaterialType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptYookassaWebhookRequestObject) Reset() {
-	var v YookassaWebhookRequestObject
+func (o *OptCytologyUpdatePartialUpdateReqMaterialType) Reset() {
+	var v CytologyUpdatePartialUpdateReqMaterialType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptYookassaWebhookRequestObject) SetTo(v YookassaWebhookRequestObject) {
+func (o *OptCytologyUpdatePartialUpdateReqMaterialType) SetTo(v CytologyUpdatePartialUpdateReqMaterialType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptYookassaWebhookRequestObject) Get() (v YookassaWebhookRequestObject, ok bool) {
+func (o OptCytologyUpdatePartialUpdateReqMaterialType) Get() (v CytologyUpdatePartialUpdateReqMaterialType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6880,45 +9045,45 @@ func (o OptYookassaWebhookRequestObject) Get() (v YookassaWebhookRequestObject,
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptYookassaWebhookRequestObject) Or(d YookassaWebhookRequestObject) YookassaWebhookRequestObject {
+func (o OptCytologyUpdatePartialUpdateReqMaterialType) Or(d CytologyUpdatePartialUpdateReqMaterialType) CytologyUpdatePartialUpdateReqMaterialType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptYookassaWebhookRequestObjectAmount returns new OptYookassaWebhookRequestObjectAmount with value set to v.
-func NewOptYookassaWebhookRequestObjectAmount(v YookassaWebhookRequestObjectAmount) OptYookassaWebhookRequestObjectAmount {
-	return OptYookassaWebhookRequestObjectAmount{
+// NewOptCytologyUpdateUpdateReqDetails returns new OptCytologyUpdateUpdateReqDetails with value set to v.
+func NewOptCytologyUpdateUpdateReqDetails(v CytologyUpdateUpdateReqDetails) OptCytologyUpdateUpdateReqDetails {
+	return OptCytologyUpdateUpdateReqDetails{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptYookassaWebhookRequestObjectAmount is optional YookassaWebhookRequestObjectAmount.
-type OptYookassaWebhookRequestObjectAmount struct {
-	Value YookassaWebhookRequestObjectAmount
+// OptCytologyUpdateUpdateReqDetails is optional CytologyUpdateUpdateReqDetails.
+type OptCytologyUpdateUpdateReqDetails struct {
+	Value CytologyUpdateUpdateReqDetails
 	Set   bool
 }
 
-// IsSet returns true if OptYookassaWebhookRequestObjectAmount was set.
-func (o OptYookassaWebhookRequestObjectAmount) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyUpdateUpdateReqDetails was set.
+func (o OptCytologyUpdateUpdateReqDetails) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptYookassaWebhookRequestObjectAmount) Reset() {
-	var v YookassaWebhookRequestObjectAmount
+func (o *OptCytologyUpdateUpdateReqDetails) Reset() {
+	var v CytologyUpdateUpdateReqDetails
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptYookassaWebhookRequestObjectAmount) SetTo(v YookassaWebhookRequestObjectAmount) {
+func (o *OptCytologyUpdateUpdateReqDetails) SetTo(v CytologyUpdateUpdateReqDetails) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptYookassaWebhookRequestObjectAmount) Get() (v YookassaWebhookRequestObjectAmount, ok bool) {
+func (o OptCytologyUpdateUpdateReqDetails) Get() (v CytologyUpdateUpdateReqDetails, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6926,45 +9091,45 @@ func (o OptYookassaWebhookRequestObjectAmount) Get() (v YookassaWebhookRequestOb
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptYookassaWebhookRequestObjectAmount) Or(d YookassaWebhookRequestObjectAmount) YookassaWebhookRequestObjectAmount {
+func (o OptCytologyUpdateUpdateReqDetails) Or(d CytologyUpdateUpdateReqDetails) CytologyUpdateUpdateReqDetails {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptYookassaWebhookRequestObjectPaymentMethod returns new OptYookassaWebhookRequestObjectPaymentMethod with value set to v.
-func NewOptYookassaWebhookRequestObjectPaymentMethod(v YookassaWebhookRequestObjectPaymentMethod) OptYookassaWebhookRequestObjectPaymentMethod {
-	return OptYookassaWebhookRequestObjectPaymentMethod{
+// NewOptCytologyUpdateUpdateReqDetailsDiagnosticMarking returns new OptCytologyUpdateUpdateReqDetailsDiagnosticMarking with value set to v.
+func NewOptCytologyUpdateUpdateReqDetailsDiagnosticMarking(v CytologyUpdateUpdateReqDetailsDiagnosticMarking) OptCytologyUpdateUpdateReqDetailsDiagnosticMarking {
+	return OptCytologyUpdateUpdateReqDetailsDiagnosticMarking{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptYookassaWebhookRequestObjectPaymentMethod is optional YookassaWebhookRequestObjectPaymentMethod.
-type OptYookassaWebhookRequestObjectPaymentMethod struct {
-	Value YookassaWebhookRequestObjectPaymentMethod
+// OptCytologyUpdateUpdateReqDetailsDiagnosticMarking is optional CytologyUpdateUpdateReqDetailsDiagnosticMarking.
+type OptCytologyUpdateUpdateReqDetailsDiagnosticMarking struct {
+	Value CytologyUpdateUpdateReqDetailsDiagnosticMarking
 	Set   bool
 }
 
-// IsSet returns true if OptYookassaWebhookRequestObjectPaymentMethod was set.
-func (o OptYookassaWebhookRequestObjectPaymentMethod) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyUpdateUpdateReqDetailsDiagnosticMarking was set.
+func (o OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptYookassaWebhookRequestObjectPaymentMethod) Reset() {
-	var v YookassaWebhookRequestObjectPaymentMethod
+func (o *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Reset() {
+	var v CytologyUpdateUpdateReqDetailsDiagnosticMarking
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptYookassaWebhookRequestObjectPaymentMethod) SetTo(v YookassaWebhookRequestObjectPaymentMethod) {
+func (o *OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) SetTo(v CytologyUpdateUpdateReqDetailsDiagnosticMarking) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptYookassaWebhookRequestObjectPaymentMethod) Get() (v YookassaWebhookRequestObjectPaymentMethod, ok bool) {
+func (o OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Get() (v CytologyUpdateUpdateReqDetailsDiagnosticMarking, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -6972,45 +9137,45 @@ func (o OptYookassaWebhookRequestObjectPaymentMethod) Get() (v YookassaWebhookRe
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptYookassaWebhookRequestObjectPaymentMethod) Or(d YookassaWebhookRequestObjectPaymentMethod) YookassaWebhookRequestObjectPaymentMethod {
+func (o OptCytologyUpdateUpdateReqDetailsDiagnosticMarking) Or(d CytologyUpdateUpdateReqDetailsDiagnosticMarking) CytologyUpdateUpdateReqDetailsDiagnosticMarking {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptYookassaWebhookRequestObjectPaymentMethodCard returns new OptYookassaWebhookRequestObjectPaymentMethodCard with value set to v.
-func NewOptYookassaWebhookRequestObjectPaymentMethodCard(v YookassaWebhookRequestObjectPaymentMethodCard) OptYookassaWebhookRequestObjectPaymentMethodCard {
-	return OptYookassaWebhookRequestObjectPaymentMethodCard{
+// NewOptCytologyUpdateUpdateReqDetailsMaterialType returns new OptCytologyUpdateUpdateReqDetailsMaterialType with value set to v.
+func NewOptCytologyUpdateUpdateReqDetailsMaterialType(v CytologyUpdateUpdateReqDetailsMaterialType) OptCytologyUpdateUpdateReqDetailsMaterialType {
+	return OptCytologyUpdateUpdateReqDetailsMaterialType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptYookassaWebhookRequestObjectPaymentMethodCard is optional YookassaWebhookRequestObjectPaymentMethodCard.
-type OptYookassaWebhookRequestObjectPaymentMethodCard struct {
-	Value YookassaWebhookRequestObjectPaymentMethodCard
+// OptCytologyUpdateUpdateReqDetailsMaterialType is optional CytologyUpdateUpdateReqDetailsMaterialType.
+type OptCytologyUpdateUpdateReqDetailsMaterialType struct {
+	Value CytologyUpdateUpdateReqDetailsMaterialType
 	Set   bool
 }
 
-// IsSet returns true if OptYookassaWebhookRequestObjectPaymentMethodCard was set.
-func (o OptYookassaWebhookRequestObjectPaymentMethodCard) IsSet() bool { return o.Set }
+// IsSet returns true if OptCytologyUpdateUpdateReqDetailsMaterialType was set.
+func (o OptCytologyUpdateUpdateReqDetailsMaterialType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptYookassaWebhookRequestObjectPaymentMethodCard) Reset() {
-	var v YookassaWebhookRequestObjectPaymentMethodCard
+func (o *OptCytologyUpdateUpdateReqDetailsMaterialType) Reset() {
+	var v CytologyUpdateUpdateReqDetailsMaterialType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptYookassaWebhookRequestObjectPaymentMethodCard) SetTo(v YookassaWebhookRequestObjectPaymentMethodCard) {
+func (o *OptCytologyUpdateUpdateReqDetailsMaterialType) SetTo(v CytologyUpdateUpdateReqDetailsMaterialType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptYookassaWebhookRequestObjectPaymentMethodCard) Get() (v YookassaWebhookRequestObjectPaymentMethodCard, ok bool) {
+func (o OptCytologyUpdateUpdateReqDetailsMaterialType) Get() (v CytologyUpdateUpdateReqDetailsMaterialType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -7018,727 +9183,3839 @@ func (o OptYookassaWebhookRequestObjectPaymentMethodCard) Get() (v YookassaWebho
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptYookassaWebhookRequestObjectPaymentMethodCard) Or(d YookassaWebhookRequestObjectPaymentMethodCard) YookassaWebhookRequestObjectPaymentMethodCard {
+func (o OptCytologyUpdateUpdateReqDetailsMaterialType) Or(d CytologyUpdateUpdateReqDetailsMaterialType) CytologyUpdateUpdateReqDetailsMaterialType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// Пациент.
-// Ref: #/components/schemas/patient
-type Patient struct {
-	// Id пациента.
-	ID uuid.UUID `json:"id"`
-	// Полное имя пациента.
-	Fullname string `json:"fullname"`
-	// Email пациента.
-	Email string `json:"email"`
-	// Полис пациента.
-	Policy string `json:"policy"`
-	// Признак того, что пациент активен.
-	Active bool `json:"active"`
-	// Признак того, что пациент имеет злокачественную
-	// опухоль.
-	Malignancy bool `json:"malignancy"`
-	// Дата рождения пациента.
-	BirthDate time.Time `json:"birth_date"`
-	// Дата последнего узи.
-	LastUziDate OptDate `json:"last_uzi_date"`
-}
-
-// GetID returns the value of ID.
-func (s *Patient) GetID() uuid.UUID {
-	return s.ID
+// NewOptCytologyUpdateUpdateReqDiagnosticMarking returns new OptCytologyUpdateUpdateReqDiagnosticMarking with value set to v.
+func NewOptCytologyUpdateUpdateReqDiagnosticMarking(v CytologyUpdateUpdateReqDiagnosticMarking) OptCytologyUpdateUpdateReqDiagnosticMarking {
+	return OptCytologyUpdateUpdateReqDiagnosticMarking{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetFullname returns the value of Fullname.
-func (s *Patient) GetFullname() string {
-	return s.Fullname
+// OptCytologyUpdateUpdateReqDiagnosticMarking is optional CytologyUpdateUpdateReqDiagnosticMarking.
+type OptCytologyUpdateUpdateReqDiagnosticMarking struct {
+	Value CytologyUpdateUpdateReqDiagnosticMarking
+	Set   bool
 }
 
-// GetEmail returns the value of Email.
-func (s *Patient) GetEmail() string {
-	return s.Email
-}
+// IsSet returns true if OptCytologyUpdateUpdateReqDiagnosticMarking was set.
+func (o OptCytologyUpdateUpdateReqDiagnosticMarking) IsSet() bool { return o.Set }
 
-// GetPolicy returns the value of Policy.
-func (s *Patient) GetPolicy() string {
-	return s.Policy
+// Reset unsets value.
+func (o *OptCytologyUpdateUpdateReqDiagnosticMarking) Reset() {
+	var v CytologyUpdateUpdateReqDiagnosticMarking
+	o.Value = v
+	o.Set = false
 }
 
-// GetActive returns the value of Active.
-func (s *Patient) GetActive() bool {
-	return s.Active
+// SetTo sets value to v.
+func (o *OptCytologyUpdateUpdateReqDiagnosticMarking) SetTo(v CytologyUpdateUpdateReqDiagnosticMarking) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetMalignancy returns the value of Malignancy.
-func (s *Patient) GetMalignancy() bool {
-	return s.Malignancy
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCytologyUpdateUpdateReqDiagnosticMarking) Get() (v CytologyUpdateUpdateReqDiagnosticMarking, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetBirthDate returns the value of BirthDate.
-func (s *Patient) GetBirthDate() time.Time {
-	return s.BirthDate
+// Or returns value if set, or given parameter if does not.
+func (o OptCytologyUpdateUpdateReqDiagnosticMarking) Or(d CytologyUpdateUpdateReqDiagnosticMarking) CytologyUpdateUpdateReqDiagnosticMarking {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetLastUziDate returns the value of LastUziDate.
-func (s *Patient) GetLastUziDate() OptDate {
-	return s.LastUziDate
+// NewOptCytologyUpdateUpdateReqMaterialType returns new OptCytologyUpdateUpdateReqMaterialType with value set to v.
+func NewOptCytologyUpdateUpdateReqMaterialType(v CytologyUpdateUpdateReqMaterialType) OptCytologyUpdateUpdateReqMaterialType {
+	return OptCytologyUpdateUpdateReqMaterialType{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetID sets the value of ID.
-func (s *Patient) SetID(val uuid.UUID) {
-	s.ID = val
+// OptCytologyUpdateUpdateReqMaterialType is optional CytologyUpdateUpdateReqMaterialType.
+type OptCytologyUpdateUpdateReqMaterialType struct {
+	Value CytologyUpdateUpdateReqMaterialType
+	Set   bool
 }
 
-// SetFullname sets the value of Fullname.
-func (s *Patient) SetFullname(val string) {
-	s.Fullname = val
-}
+// IsSet returns true if OptCytologyUpdateUpdateReqMaterialType was set.
+func (o OptCytologyUpdateUpdateReqMaterialType) IsSet() bool { return o.Set }
 
-// SetEmail sets the value of Email.
-func (s *Patient) SetEmail(val string) {
-	s.Email = val
+// Reset unsets value.
+func (o *OptCytologyUpdateUpdateReqMaterialType) Reset() {
+	var v CytologyUpdateUpdateReqMaterialType
+	o.Value = v
+	o.Set = false
 }
 
-// SetPolicy sets the value of Policy.
-func (s *Patient) SetPolicy(val string) {
-	s.Policy = val
+// SetTo sets value to v.
+func (o *OptCytologyUpdateUpdateReqMaterialType) SetTo(v CytologyUpdateUpdateReqMaterialType) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetActive sets the value of Active.
-func (s *Patient) SetActive(val bool) {
-	s.Active = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCytologyUpdateUpdateReqMaterialType) Get() (v CytologyUpdateUpdateReqMaterialType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetMalignancy sets the value of Malignancy.
-func (s *Patient) SetMalignancy(val bool) {
-	s.Malignancy = val
+// Or returns value if set, or given parameter if does not.
+func (o OptCytologyUpdateUpdateReqMaterialType) Or(d CytologyUpdateUpdateReqMaterialType) CytologyUpdateUpdateReqMaterialType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetBirthDate sets the value of BirthDate.
-func (s *Patient) SetBirthDate(val time.Time) {
-	s.BirthDate = val
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetLastUziDate sets the value of LastUziDate.
-func (s *Patient) SetLastUziDate(val OptDate) {
-	s.LastUziDate = val
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
 }
 
-func (*Patient) medPatientIDGetRes()   {}
-func (*Patient) medPatientIDPatchRes() {}
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
 
-// Карта пациента.
-// Ref: #/components/schemas/patientCard
-type PatientCard struct {
-	ID                 OptInt      `json:"id"`
-	AcceptanceDatetime OptDateTime `json:"acceptance_datetime"`
-	Diagnosis          OptString   `json:"diagnosis"`
-	Patient            OptInt      `json:"patient"`
-	MedWorker          OptInt      `json:"med_worker"`
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
 }
 
-// GetID returns the value of ID.
-func (s *PatientCard) GetID() OptInt {
-	return s.ID
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetAcceptanceDatetime returns the value of AcceptanceDatetime.
-func (s *PatientCard) GetAcceptanceDatetime() OptDateTime {
-	return s.AcceptanceDatetime
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetDiagnosis returns the value of Diagnosis.
-func (s *PatientCard) GetDiagnosis() OptString {
-	return s.Diagnosis
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetPatient returns the value of Patient.
-func (s *PatientCard) GetPatient() OptInt {
-	return s.Patient
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetMedWorker returns the value of MedWorker.
-func (s *PatientCard) GetMedWorker() OptInt {
-	return s.MedWorker
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
 }
 
-// SetID sets the value of ID.
-func (s *PatientCard) SetID(val OptInt) {
-	s.ID = val
-}
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
 
-// SetAcceptanceDatetime sets the value of AcceptanceDatetime.
-func (s *PatientCard) SetAcceptanceDatetime(val OptDateTime) {
-	s.AcceptanceDatetime = val
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
 }
 
-// SetDiagnosis sets the value of Diagnosis.
-func (s *PatientCard) SetDiagnosis(val OptString) {
-	s.Diagnosis = val
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetPatient sets the value of Patient.
-func (s *PatientCard) SetPatient(val OptInt) {
-	s.Patient = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetMedWorker sets the value of MedWorker.
-func (s *PatientCard) SetMedWorker(val OptInt) {
-	s.MedWorker = val
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// Платежный провайдер.
-// Ref: #/components/schemas/PaymentProvider
-type PaymentProvider struct {
-	// Идентификатор платежного провайдера.
-	ID OptUUID `json:"id"`
-	// Название платежного провайдера.
-	Name OptString `json:"name"`
-	// Активен ли провайдер.
-	IsActive OptBool `json:"is_active"`
+// NewOptDownloadUziUziIDReportGetFormat returns new OptDownloadUziUziIDReportGetFormat with value set to v.
+func NewOptDownloadUziUziIDReportGetFormat(v DownloadUziUziIDReportGetFormat) OptDownloadUziUziIDReportGetFormat {
+	return OptDownloadUziUziIDReportGetFormat{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetID returns the value of ID.
-func (s *PaymentProvider) GetID() OptUUID {
-	return s.ID
+// OptDownloadUziUziIDReportGetFormat is optional DownloadUziUziIDReportGetFormat.
+type OptDownloadUziUziIDReportGetFormat struct {
+	Value DownloadUziUziIDReportGetFormat
+	Set   bool
 }
 
-// GetName returns the value of Name.
-func (s *PaymentProvider) GetName() OptString {
-	return s.Name
+// IsSet returns true if OptDownloadUziUziIDReportGetFormat was set.
+func (o OptDownloadUziUziIDReportGetFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDownloadUziUziIDReportGetFormat) Reset() {
+	var v DownloadUziUziIDReportGetFormat
+	o.Value = v
+	o.Set = false
 }
 
-// GetIsActive returns the value of IsActive.
-func (s *PaymentProvider) GetIsActive() OptBool {
-	return s.IsActive
+// SetTo sets value to v.
+func (o *OptDownloadUziUziIDReportGetFormat) SetTo(v DownloadUziUziIDReportGetFormat) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetID sets the value of ID.
-func (s *PaymentProvider) SetID(val OptUUID) {
-	s.ID = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDownloadUziUziIDReportGetFormat) Get() (v DownloadUziUziIDReportGetFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetName sets the value of Name.
-func (s *PaymentProvider) SetName(val OptString) {
-	s.Name = val
+// Or returns value if set, or given parameter if does not.
+func (o OptDownloadUziUziIDReportGetFormat) Or(d DownloadUziUziIDReportGetFormat) DownloadUziUziIDReportGetFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetIsActive sets the value of IsActive.
-func (s *PaymentProvider) SetIsActive(val OptBool) {
-	s.IsActive = val
+// NewOptEchographicsEchogenicity returns new OptEchographicsEchogenicity with value set to v.
+func NewOptEchographicsEchogenicity(v EchographicsEchogenicity) OptEchographicsEchogenicity {
+	return OptEchographicsEchogenicity{
+		Value: v,
+		Set:   true,
+	}
 }
 
-type PaymentProvidersGetOKApplicationJSON []PaymentProvider
+// OptEchographicsEchogenicity is optional EchographicsEchogenicity.
+type OptEchographicsEchogenicity struct {
+	Value EchographicsEchogenicity
+	Set   bool
+}
 
-func (*PaymentProvidersGetOKApplicationJSON) paymentProvidersGetRes() {}
+// IsSet returns true if OptEchographicsEchogenicity was set.
+func (o OptEchographicsEchogenicity) IsSet() bool { return o.Set }
 
-// Запрос на покупку подписки.
-// Ref: #/components/schemas/PurchaseSubscriptionRequest
-type PurchaseSubscriptionRequest struct {
-	// Идентификатор тарифного плана.
-	TariffPlanID uuid.UUID `json:"tariff_plan_id"`
-	// Идентификатор провайдера платежей.
-	PaymentProviderID uuid.UUID `json:"payment_provider_id"`
+// Reset unsets value.
+func (o *OptEchographicsEchogenicity) Reset() {
+	var v EchographicsEchogenicity
+	o.Value = v
+	o.Set = false
 }
 
-// GetTariffPlanID returns the value of TariffPlanID.
-func (s *PurchaseSubscriptionRequest) GetTariffPlanID() uuid.UUID {
-	return s.TariffPlanID
+// SetTo sets value to v.
+func (o *OptEchographicsEchogenicity) SetTo(v EchographicsEchogenicity) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetPaymentProviderID returns the value of PaymentProviderID.
-func (s *PurchaseSubscriptionRequest) GetPaymentProviderID() uuid.UUID {
-	return s.PaymentProviderID
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEchographicsEchogenicity) Get() (v EchographicsEchogenicity, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetTariffPlanID sets the value of TariffPlanID.
-func (s *PurchaseSubscriptionRequest) SetTariffPlanID(val uuid.UUID) {
-	s.TariffPlanID = val
+// Or returns value if set, or given parameter if does not.
+func (o OptEchographicsEchogenicity) Or(d EchographicsEchogenicity) EchographicsEchogenicity {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetPaymentProviderID sets the value of PaymentProviderID.
-func (s *PurchaseSubscriptionRequest) SetPaymentProviderID(val uuid.UUID) {
-	s.PaymentProviderID = val
+// NewOptEchographicsPatientSex returns new OptEchographicsPatientSex with value set to v.
+func NewOptEchographicsPatientSex(v EchographicsPatientSex) OptEchographicsPatientSex {
+	return OptEchographicsPatientSex{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// Ответ на покупку подписки.
-// Ref: #/components/schemas/PurchaseSubscriptionResponse
-type PurchaseSubscriptionResponse struct {
-	// Идентификатор подписки.
-	SubscriptionID uuid.UUID `json:"subscription_id"`
-	// URL для подтверждения подписки.
-	ConfirmationURL string `json:"confirmation_url"`
+// OptEchographicsPatientSex is optional EchographicsPatientSex.
+type OptEchographicsPatientSex struct {
+	Value EchographicsPatientSex
+	Set   bool
 }
 
-// GetSubscriptionID returns the value of SubscriptionID.
-func (s *PurchaseSubscriptionResponse) GetSubscriptionID() uuid.UUID {
-	return s.SubscriptionID
-}
+// IsSet returns true if OptEchographicsPatientSex was set.
+func (o OptEchographicsPatientSex) IsSet() bool { return o.Set }
 
-// GetConfirmationURL returns the value of ConfirmationURL.
-func (s *PurchaseSubscriptionResponse) GetConfirmationURL() string {
-	return s.ConfirmationURL
+// Reset unsets value.
+func (o *OptEchographicsPatientSex) Reset() {
+	var v EchographicsPatientSex
+	o.Value = v
+	o.Set = false
 }
 
-// SetSubscriptionID sets the value of SubscriptionID.
-func (s *PurchaseSubscriptionResponse) SetSubscriptionID(val uuid.UUID) {
-	s.SubscriptionID = val
+// SetTo sets value to v.
+func (o *OptEchographicsPatientSex) SetTo(v EchographicsPatientSex) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetConfirmationURL sets the value of ConfirmationURL.
-func (s *PurchaseSubscriptionResponse) SetConfirmationURL(val string) {
-	s.ConfirmationURL = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEchographicsPatientSex) Get() (v EchographicsPatientSex, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-func (*PurchaseSubscriptionResponse) subscriptionsPurchasePostRes() {}
+// Or returns value if set, or given parameter if does not.
+func (o OptEchographicsPatientSex) Or(d EchographicsPatientSex) EchographicsPatientSex {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-type RefreshPostBadRequest ErrorStatusCode
+// NewOptEchographicsStruct returns new OptEchographicsStruct with value set to v.
+func NewOptEchographicsStruct(v EchographicsStruct) OptEchographicsStruct {
+	return OptEchographicsStruct{
+		Value: v,
+		Set:   true,
+	}
+}
 
-func (*RefreshPostBadRequest) refreshPostRes() {}
+// OptEchographicsStruct is optional EchographicsStruct.
+type OptEchographicsStruct struct {
+	Value EchographicsStruct
+	Set   bool
+}
 
-type RefreshPostInternalServerError ErrorStatusCode
+// IsSet returns true if OptEchographicsStruct was set.
+func (o OptEchographicsStruct) IsSet() bool { return o.Set }
 
-func (*RefreshPostInternalServerError) refreshPostRes() {}
+// Reset unsets value.
+func (o *OptEchographicsStruct) Reset() {
+	var v EchographicsStruct
+	o.Value = v
+	o.Set = false
+}
 
-type RefreshPostOK struct {
-	// Access токен.
-	AccessToken string `json:"access_token"`
-	// Refresh токен.
-	RefreshToken string `json:"refresh_token"`
+// SetTo sets value to v.
+func (o *OptEchographicsStruct) SetTo(v EchographicsStruct) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetAccessToken returns the value of AccessToken.
-func (s *RefreshPostOK) GetAccessToken() string {
-	return s.AccessToken
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEchographicsStruct) Get() (v EchographicsStruct, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *RefreshPostOK) GetRefreshToken() string {
-	return s.RefreshToken
+// Or returns value if set, or given parameter if does not.
+func (o OptEchographicsStruct) Or(d EchographicsStruct) EchographicsStruct {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetAccessToken sets the value of AccessToken.
-func (s *RefreshPostOK) SetAccessToken(val string) {
-	s.AccessToken = val
+// NewOptEchographicsVascularization returns new OptEchographicsVascularization with value set to v.
+func NewOptEchographicsVascularization(v EchographicsVascularization) OptEchographicsVascularization {
+	return OptEchographicsVascularization{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *RefreshPostOK) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// OptEchographicsVascularization is optional EchographicsVascularization.
+type OptEchographicsVascularization struct {
+	Value EchographicsVascularization
+	Set   bool
 }
 
-func (*RefreshPostOK) refreshPostRes() {}
+// IsSet returns true if OptEchographicsVascularization was set.
+func (o OptEchographicsVascularization) IsSet() bool { return o.Set }
 
-type RefreshPostReq struct {
-	// Refresh токен.
-	RefreshToken string `json:"refresh_token"`
+// Reset unsets value.
+func (o *OptEchographicsVascularization) Reset() {
+	var v EchographicsVascularization
+	o.Value = v
+	o.Set = false
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *RefreshPostReq) GetRefreshToken() string {
-	return s.RefreshToken
+// SetTo sets value to v.
+func (o *OptEchographicsVascularization) SetTo(v EchographicsVascularization) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *RefreshPostReq) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEchographicsVascularization) Get() (v EchographicsVascularization, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-type RefreshPostUnauthorized ErrorStatusCode
-
-func (*RefreshPostUnauthorized) refreshPostRes() {}
-
-type RegDoctorPostBadRequest ErrorStatusCode
+// Or returns value if set, or given parameter if does not.
+func (o OptEchographicsVascularization) Or(d EchographicsVascularization) EchographicsVascularization {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-func (*RegDoctorPostBadRequest) regDoctorPostRes() {}
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptImageSize returns new OptImageSize with value set to v.
+func NewOptImageSize(v ImageSize) OptImageSize {
+	return OptImageSize{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptImageSize is optional ImageSize.
+type OptImageSize struct {
+	Value ImageSize
+	Set   bool
+}
+
+// IsSet returns true if OptImageSize was set.
+func (o OptImageSize) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptImageSize) Reset() {
+	var v ImageSize
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptImageSize) SetTo(v ImageSize) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImageSize) Get() (v ImageSize, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptImageSize) Or(d ImageSize) ImageSize {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMultipartFile returns new OptMultipartFile with value set to v.
+func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
+	return OptMultipartFile{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMultipartFile is optional ht.MultipartFile.
+type OptMultipartFile struct {
+	Value ht.MultipartFile
+	Set   bool
+}
+
+// IsSet returns true if OptMultipartFile was set.
+func (o OptMultipartFile) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMultipartFile) Reset() {
+	var v ht.MultipartFile
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNodeValidation returns new OptNilNodeValidation with value set to v.
+func NewOptNilNodeValidation(v NodeValidation) OptNilNodeValidation {
+	return OptNilNodeValidation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNodeValidation is optional nullable NodeValidation.
+type OptNilNodeValidation struct {
+	Value NodeValidation
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNodeValidation was set.
+func (o OptNilNodeValidation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNodeValidation) Reset() {
+	var v NodeValidation
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNodeValidation) SetTo(v NodeValidation) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilNodeValidation) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilNodeValidation) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v NodeValidation
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNodeValidation) Get() (v NodeValidation, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNodeValidation) Or(d NodeValidation) NodeValidation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUUID returns new OptNilUUID with value set to v.
+func NewOptNilUUID(v uuid.UUID) OptNilUUID {
+	return OptNilUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUUID is optional nullable uuid.UUID.
+type OptNilUUID struct {
+	Value uuid.UUID
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUUID was set.
+func (o OptNilUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUUID) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUUID) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v uuid.UUID
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUziNodesIDPatchReqValidation returns new OptNilUziNodesIDPatchReqValidation with value set to v.
+func NewOptNilUziNodesIDPatchReqValidation(v UziNodesIDPatchReqValidation) OptNilUziNodesIDPatchReqValidation {
+	return OptNilUziNodesIDPatchReqValidation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUziNodesIDPatchReqValidation is optional nullable UziNodesIDPatchReqValidation.
+type OptNilUziNodesIDPatchReqValidation struct {
+	Value UziNodesIDPatchReqValidation
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUziNodesIDPatchReqValidation was set.
+func (o OptNilUziNodesIDPatchReqValidation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUziNodesIDPatchReqValidation) Reset() {
+	var v UziNodesIDPatchReqValidation
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUziNodesIDPatchReqValidation) SetTo(v UziNodesIDPatchReqValidation) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUziNodesIDPatchReqValidation) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUziNodesIDPatchReqValidation) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v UziNodesIDPatchReqValidation
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUziNodesIDPatchReqValidation) Get() (v UziNodesIDPatchReqValidation, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUziNodesIDPatchReqValidation) Or(d UziNodesIDPatchReqValidation) UziNodesIDPatchReqValidation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNode returns new OptNode with value set to v.
+func NewOptNode(v Node) OptNode {
+	return OptNode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNode is optional Node.
+type OptNode struct {
+	Value Node
+	Set   bool
+}
+
+// IsSet returns true if OptNode was set.
+func (o OptNode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNode) Reset() {
+	var v Node
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNode) SetTo(v Node) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNode) Get() (v Node, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNode) Or(d Node) Node {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNodeDescriptors returns new OptNodeDescriptors with value set to v.
+func NewOptNodeDescriptors(v NodeDescriptors) OptNodeDescriptors {
+	return OptNodeDescriptors{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNodeDescriptors is optional NodeDescriptors.
+type OptNodeDescriptors struct {
+	Value NodeDescriptors
+	Set   bool
+}
+
+// IsSet returns true if OptNodeDescriptors was set.
+func (o OptNodeDescriptors) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNodeDescriptors) Reset() {
+	var v NodeDescriptors
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNodeDescriptors) SetTo(v NodeDescriptors) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNodeDescriptors) Get() (v NodeDescriptors, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNodeDescriptors) Or(d NodeDescriptors) NodeDescriptors {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNodeLobe returns new OptNodeLobe with value set to v.
+func NewOptNodeLobe(v NodeLobe) OptNodeLobe {
+	return OptNodeLobe{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNodeLobe is optional NodeLobe.
+type OptNodeLobe struct {
+	Value NodeLobe
+	Set   bool
+}
+
+// IsSet returns true if OptNodeLobe was set.
+func (o OptNodeLobe) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNodeLobe) Reset() {
+	var v NodeLobe
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNodeLobe) SetTo(v NodeLobe) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNodeLobe) Get() (v NodeLobe, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNodeLobe) Or(d NodeLobe) NodeLobe {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNodeMeasurement returns new OptNodeMeasurement with value set to v.
+func NewOptNodeMeasurement(v NodeMeasurement) OptNodeMeasurement {
+	return OptNodeMeasurement{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNodeMeasurement is optional NodeMeasurement.
+type OptNodeMeasurement struct {
+	Value NodeMeasurement
+	Set   bool
+}
+
+// IsSet returns true if OptNodeMeasurement was set.
+func (o OptNodeMeasurement) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNodeMeasurement) Reset() {
+	var v NodeMeasurement
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNodeMeasurement) SetTo(v NodeMeasurement) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNodeMeasurement) Get() (v NodeMeasurement, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNodeMeasurement) Or(d NodeMeasurement) NodeMeasurement {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPixelSpacing returns new OptPixelSpacing with value set to v.
+func NewOptPixelSpacing(v PixelSpacing) OptPixelSpacing {
+	return OptPixelSpacing{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPixelSpacing is optional PixelSpacing.
+type OptPixelSpacing struct {
+	Value PixelSpacing
+	Set   bool
+}
+
+// IsSet returns true if OptPixelSpacing was set.
+func (o OptPixelSpacing) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPixelSpacing) Reset() {
+	var v PixelSpacing
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPixelSpacing) SetTo(v PixelSpacing) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPixelSpacing) Get() (v PixelSpacing, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPixelSpacing) Or(d PixelSpacing) PixelSpacing {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptProbeType returns new OptProbeType with value set to v.
+func NewOptProbeType(v ProbeType) OptProbeType {
+	return OptProbeType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptProbeType is optional ProbeType.
+type OptProbeType struct {
+	Value ProbeType
+	Set   bool
+}
+
+// IsSet returns true if OptProbeType was set.
+func (o OptProbeType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptProbeType) Reset() {
+	var v ProbeType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptProbeType) SetTo(v ProbeType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptProbeType) Get() (v ProbeType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptProbeType) Or(d ProbeType) ProbeType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSegmentMeasurement returns new OptSegmentMeasurement with value set to v.
+func NewOptSegmentMeasurement(v SegmentMeasurement) OptSegmentMeasurement {
+	return OptSegmentMeasurement{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSegmentMeasurement is optional SegmentMeasurement.
+type OptSegmentMeasurement struct {
+	Value SegmentMeasurement
+	Set   bool
+}
+
+// IsSet returns true if OptSegmentMeasurement was set.
+func (o OptSegmentMeasurement) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSegmentMeasurement) Reset() {
+	var v SegmentMeasurement
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSegmentMeasurement) SetTo(v SegmentMeasurement) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSegmentMeasurement) Get() (v SegmentMeasurement, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSegmentMeasurement) Or(d SegmentMeasurement) SegmentMeasurement {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTiradsScore returns new OptTiradsScore with value set to v.
+func NewOptTiradsScore(v TiradsScore) OptTiradsScore {
+	return OptTiradsScore{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTiradsScore is optional TiradsScore.
+type OptTiradsScore struct {
+	Value TiradsScore
+	Set   bool
+}
+
+// IsSet returns true if OptTiradsScore was set.
+func (o OptTiradsScore) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTiradsScore) Reset() {
+	var v TiradsScore
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTiradsScore) SetTo(v TiradsScore) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTiradsScore) Get() (v TiradsScore, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTiradsScore) Or(d TiradsScore) TiradsScore {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptURI returns new OptURI with value set to v.
+func NewOptURI(v url.URL) OptURI {
+	return OptURI{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptURI is optional url.URL.
+type OptURI struct {
+	Value url.URL
+	Set   bool
+}
+
+// IsSet returns true if OptURI was set.
+func (o OptURI) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptURI) Reset() {
+	var v url.URL
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptURI) SetTo(v url.URL) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptURI) Get() (v url.URL, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptURI) Or(d url.URL) url.URL {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUziIDCheckPostReq returns new OptUziIDCheckPostReq with value set to v.
+func NewOptUziIDCheckPostReq(v UziIDCheckPostReq) OptUziIDCheckPostReq {
+	return OptUziIDCheckPostReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUziIDCheckPostReq is optional UziIDCheckPostReq.
+type OptUziIDCheckPostReq struct {
+	Value UziIDCheckPostReq
+	Set   bool
+}
+
+// IsSet returns true if OptUziIDCheckPostReq was set.
+func (o OptUziIDCheckPostReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUziIDCheckPostReq) Reset() {
+	var v UziIDCheckPostReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUziIDCheckPostReq) SetTo(v UziIDCheckPostReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUziIDCheckPostReq) Get() (v UziIDCheckPostReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUziIDCheckPostReq) Or(d UziIDCheckPostReq) UziIDCheckPostReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUziIDCheckPostReqUnvalidated returns new OptUziIDCheckPostReqUnvalidated with value set to v.
+func NewOptUziIDCheckPostReqUnvalidated(v UziIDCheckPostReqUnvalidated) OptUziIDCheckPostReqUnvalidated {
+	return OptUziIDCheckPostReqUnvalidated{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUziIDCheckPostReqUnvalidated is optional UziIDCheckPostReqUnvalidated.
+type OptUziIDCheckPostReqUnvalidated struct {
+	Value UziIDCheckPostReqUnvalidated
+	Set   bool
+}
+
+// IsSet returns true if OptUziIDCheckPostReqUnvalidated was set.
+func (o OptUziIDCheckPostReqUnvalidated) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUziIDCheckPostReqUnvalidated) Reset() {
+	var v UziIDCheckPostReqUnvalidated
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUziIDCheckPostReqUnvalidated) SetTo(v UziIDCheckPostReqUnvalidated) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUziIDCheckPostReqUnvalidated) Get() (v UziIDCheckPostReqUnvalidated, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUziIDCheckPostReqUnvalidated) Or(d UziIDCheckPostReqUnvalidated) UziIDCheckPostReqUnvalidated {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUziIDPatchReqProjection returns new OptUziIDPatchReqProjection with value set to v.
+func NewOptUziIDPatchReqProjection(v UziIDPatchReqProjection) OptUziIDPatchReqProjection {
+	return OptUziIDPatchReqProjection{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUziIDPatchReqProjection is optional UziIDPatchReqProjection.
+type OptUziIDPatchReqProjection struct {
+	Value UziIDPatchReqProjection
+	Set   bool
+}
+
+// IsSet returns true if OptUziIDPatchReqProjection was set.
+func (o OptUziIDPatchReqProjection) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUziIDPatchReqProjection) Reset() {
+	var v UziIDPatchReqProjection
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUziIDPatchReqProjection) SetTo(v UziIDPatchReqProjection) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUziIDPatchReqProjection) Get() (v UziIDPatchReqProjection, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUziIDPatchReqProjection) Or(d UziIDPatchReqProjection) UziIDPatchReqProjection {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUzisAnalyticsGetPeriod returns new OptUzisAnalyticsGetPeriod with value set to v.
+func NewOptUzisAnalyticsGetPeriod(v UzisAnalyticsGetPeriod) OptUzisAnalyticsGetPeriod {
+	return OptUzisAnalyticsGetPeriod{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUzisAnalyticsGetPeriod is optional UzisAnalyticsGetPeriod.
+type OptUzisAnalyticsGetPeriod struct {
+	Value UzisAnalyticsGetPeriod
+	Set   bool
+}
+
+// IsSet returns true if OptUzisAnalyticsGetPeriod was set.
+func (o OptUzisAnalyticsGetPeriod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUzisAnalyticsGetPeriod) Reset() {
+	var v UzisAnalyticsGetPeriod
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUzisAnalyticsGetPeriod) SetTo(v UzisAnalyticsGetPeriod) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUzisAnalyticsGetPeriod) Get() (v UzisAnalyticsGetPeriod, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUzisAnalyticsGetPeriod) Or(d UzisAnalyticsGetPeriod) UzisAnalyticsGetPeriod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUzisSearchGetOrder returns new OptUzisSearchGetOrder with value set to v.
+func NewOptUzisSearchGetOrder(v UzisSearchGetOrder) OptUzisSearchGetOrder {
+	return OptUzisSearchGetOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUzisSearchGetOrder is optional UzisSearchGetOrder.
+type OptUzisSearchGetOrder struct {
+	Value UzisSearchGetOrder
+	Set   bool
+}
+
+// IsSet returns true if OptUzisSearchGetOrder was set.
+func (o OptUzisSearchGetOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUzisSearchGetOrder) Reset() {
+	var v UzisSearchGetOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUzisSearchGetOrder) SetTo(v UzisSearchGetOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUzisSearchGetOrder) Get() (v UzisSearchGetOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUzisSearchGetOrder) Or(d UzisSearchGetOrder) UzisSearchGetOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUzisSearchGetProjection returns new OptUzisSearchGetProjection with value set to v.
+func NewOptUzisSearchGetProjection(v UzisSearchGetProjection) OptUzisSearchGetProjection {
+	return OptUzisSearchGetProjection{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUzisSearchGetProjection is optional UzisSearchGetProjection.
+type OptUzisSearchGetProjection struct {
+	Value UzisSearchGetProjection
+	Set   bool
+}
+
+// IsSet returns true if OptUzisSearchGetProjection was set.
+func (o OptUzisSearchGetProjection) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUzisSearchGetProjection) Reset() {
+	var v UzisSearchGetProjection
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUzisSearchGetProjection) SetTo(v UzisSearchGetProjection) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUzisSearchGetProjection) Get() (v UzisSearchGetProjection, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUzisSearchGetProjection) Or(d UzisSearchGetProjection) UzisSearchGetProjection {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUzisSearchGetStatus returns new OptUzisSearchGetStatus with value set to v.
+func NewOptUzisSearchGetStatus(v UzisSearchGetStatus) OptUzisSearchGetStatus {
+	return OptUzisSearchGetStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUzisSearchGetStatus is optional UzisSearchGetStatus.
+type OptUzisSearchGetStatus struct {
+	Value UzisSearchGetStatus
+	Set   bool
+}
+
+// IsSet returns true if OptUzisSearchGetStatus was set.
+func (o OptUzisSearchGetStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUzisSearchGetStatus) Reset() {
+	var v UzisSearchGetStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUzisSearchGetStatus) SetTo(v UzisSearchGetStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUzisSearchGetStatus) Get() (v UzisSearchGetStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUzisSearchGetStatus) Or(d UzisSearchGetStatus) UzisSearchGetStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptYookassaWebhookRequestObject returns new OptYookassaWebhookRequestObject with value set to v.
+func NewOptYookassaWebhookRequestObject(v YookassaWebhookRequestObject) OptYookassaWebhookRequestObject {
+	return OptYookassaWebhookRequestObject{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptYookassaWebhookRequestObject is optional YookassaWebhookRequestObject.
+type OptYookassaWebhookRequestObject struct {
+	Value YookassaWebhookRequestObject
+	Set   bool
+}
+
+// IsSet returns true if OptYookassaWebhookRequestObject was set.
+func (o OptYookassaWebhookRequestObject) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptYookassaWebhookRequestObject) Reset() {
+	var v YookassaWebhookRequestObject
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptYookassaWebhookRequestObject) SetTo(v YookassaWebhookRequestObject) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptYookassaWebhookRequestObject) Get() (v YookassaWebhookRequestObject, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptYookassaWebhookRequestObject) Or(d YookassaWebhookRequestObject) YookassaWebhookRequestObject {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptYookassaWebhookRequestObjectAmount returns new OptYookassaWebhookRequestObjectAmount with value set to v.
+func NewOptYookassaWebhookRequestObjectAmount(v YookassaWebhookRequestObjectAmount) OptYookassaWebhookRequestObjectAmount {
+	return OptYookassaWebhookRequestObjectAmount{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptYookassaWebhookRequestObjectAmount is optional YookassaWebhookRequestObjectAmount.
+type OptYookassaWebhookRequestObjectAmount struct {
+	Value YookassaWebhookRequestObjectAmount
+	Set   bool
+}
+
+// IsSet returns true if OptYookassaWebhookRequestObjectAmount was set.
+func (o OptYookassaWebhookRequestObjectAmount) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptYookassaWebhookRequestObjectAmount) Reset() {
+	var v YookassaWebhookRequestObjectAmount
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptYookassaWebhookRequestObjectAmount) SetTo(v YookassaWebhookRequestObjectAmount) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptYookassaWebhookRequestObjectAmount) Get() (v YookassaWebhookRequestObjectAmount, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptYookassaWebhookRequestObjectAmount) Or(d YookassaWebhookRequestObjectAmount) YookassaWebhookRequestObjectAmount {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptYookassaWebhookRequestObjectPaymentMethod returns new OptYookassaWebhookRequestObjectPaymentMethod with value set to v.
+func NewOptYookassaWebhookRequestObjectPaymentMethod(v YookassaWebhookRequestObjectPaymentMethod) OptYookassaWebhookRequestObjectPaymentMethod {
+	return OptYookassaWebhookRequestObjectPaymentMethod{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptYookassaWebhookRequestObjectPaymentMethod is optional YookassaWebhookRequestObjectPaymentMethod.
+type OptYookassaWebhookRequestObjectPaymentMethod struct {
+	Value YookassaWebhookRequestObjectPaymentMethod
+	Set   bool
+}
+
+// IsSet returns true if OptYookassaWebhookRequestObjectPaymentMethod was set.
+func (o OptYookassaWebhookRequestObjectPaymentMethod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptYookassaWebhookRequestObjectPaymentMethod) Reset() {
+	var v YookassaWebhookRequestObjectPaymentMethod
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptYookassaWebhookRequestObjectPaymentMethod) SetTo(v YookassaWebhookRequestObjectPaymentMethod) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptYookassaWebhookRequestObjectPaymentMethod) Get() (v YookassaWebhookRequestObjectPaymentMethod, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptYookassaWebhookRequestObjectPaymentMethod) Or(d YookassaWebhookRequestObjectPaymentMethod) YookassaWebhookRequestObjectPaymentMethod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptYookassaWebhookRequestObjectPaymentMethodCard returns new OptYookassaWebhookRequestObjectPaymentMethodCard with value set to v.
+func NewOptYookassaWebhookRequestObjectPaymentMethodCard(v YookassaWebhookRequestObjectPaymentMethodCard) OptYookassaWebhookRequestObjectPaymentMethodCard {
+	return OptYookassaWebhookRequestObjectPaymentMethodCard{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptYookassaWebhookRequestObjectPaymentMethodCard is optional YookassaWebhookRequestObjectPaymentMethodCard.
+type OptYookassaWebhookRequestObjectPaymentMethodCard struct {
+	Value YookassaWebhookRequestObjectPaymentMethodCard
+	Set   bool
+}
+
+// IsSet returns true if OptYookassaWebhookRequestObjectPaymentMethodCard was set.
+func (o OptYookassaWebhookRequestObjectPaymentMethodCard) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptYookassaWebhookRequestObjectPaymentMethodCard) Reset() {
+	var v YookassaWebhookRequestObjectPaymentMethodCard
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptYookassaWebhookRequestObjectPaymentMethodCard) SetTo(v YookassaWebhookRequestObjectPaymentMethodCard) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptYookassaWebhookRequestObjectPaymentMethodCard) Get() (v YookassaWebhookRequestObjectPaymentMethodCard, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptYookassaWebhookRequestObjectPaymentMethodCard) Or(d YookassaWebhookRequestObjectPaymentMethodCard) YookassaWebhookRequestObjectPaymentMethodCard {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Пациент.
+// Ref: #/components/schemas/patient
+type Patient struct {
+	// Id пациента.
+	ID uuid.UUID `json:"id"`
+	// Полное имя пациента.
+	Fullname string `json:"fullname"`
+	// Email пациента.
+	Email string `json:"email"`
+	// Полис пациента.
+	Policy string `json:"policy"`
+	// Признак того, что пациент активен.
+	Active bool `json:"active"`
+	// Признак того, что пациент имеет злокачественную
+	// опухоль.
+	Malignancy bool `json:"malignancy"`
+	// Дата рождения пациента.
+	BirthDate time.Time `json:"birth_date"`
+	// Дата последнего узи.
+	LastUziDate OptDate `json:"last_uzi_date"`
+}
+
+// GetID returns the value of ID.
+func (s *Patient) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetFullname returns the value of Fullname.
+func (s *Patient) GetFullname() string {
+	return s.Fullname
+}
+
+// GetEmail returns the value of Email.
+func (s *Patient) GetEmail() string {
+	return s.Email
+}
+
+// GetPolicy returns the value of Policy.
+func (s *Patient) GetPolicy() string {
+	return s.Policy
+}
+
+// GetActive returns the value of Active.
+func (s *Patient) GetActive() bool {
+	return s.Active
+}
+
+// GetMalignancy returns the value of Malignancy.
+func (s *Patient) GetMalignancy() bool {
+	return s.Malignancy
+}
+
+// GetBirthDate returns the value of BirthDate.
+func (s *Patient) GetBirthDate() time.Time {
+	return s.BirthDate
+}
+
+// GetLastUziDate returns the value of LastUziDate.
+func (s *Patient) GetLastUziDate() OptDate {
+	return s.LastUziDate
+}
+
+// SetID sets the value of ID.
+func (s *Patient) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetFullname sets the value of Fullname.
+func (s *Patient) SetFullname(val string) {
+	s.Fullname = val
+}
+
+// SetEmail sets the value of Email.
+func (s *Patient) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetPolicy sets the value of Policy.
+func (s *Patient) SetPolicy(val string) {
+	s.Policy = val
+}
+
+// SetActive sets the value of Active.
+func (s *Patient) SetActive(val bool) {
+	s.Active = val
+}
+
+// SetMalignancy sets the value of Malignancy.
+func (s *Patient) SetMalignancy(val bool) {
+	s.Malignancy = val
+}
+
+// SetBirthDate sets the value of BirthDate.
+func (s *Patient) SetBirthDate(val time.Time) {
+	s.BirthDate = val
+}
+
+// SetLastUziDate sets the value of LastUziDate.
+func (s *Patient) SetLastUziDate(val OptDate) {
+	s.LastUziDate = val
+}
+
+func (*Patient) medPatientIDGetRes()   {}
+func (*Patient) medPatientIDPatchRes() {}
+
+// Карта пациента.
+// Ref: #/components/schemas/patientCard
+type PatientCard struct {
+	ID                 OptInt      `json:"id"`
+	AcceptanceDatetime OptDateTime `json:"acceptance_datetime"`
+	Diagnosis          OptString   `json:"diagnosis"`
+	Patient            OptInt      `json:"patient"`
+	MedWorker          OptInt      `json:"med_worker"`
+}
+
+// GetID returns the value of ID.
+func (s *PatientCard) GetID() OptInt {
+	return s.ID
+}
+
+// GetAcceptanceDatetime returns the value of AcceptanceDatetime.
+func (s *PatientCard) GetAcceptanceDatetime() OptDateTime {
+	return s.AcceptanceDatetime
+}
+
+// GetDiagnosis returns the value of Diagnosis.
+func (s *PatientCard) GetDiagnosis() OptString {
+	return s.Diagnosis
+}
+
+// GetPatient returns the value of Patient.
+func (s *PatientCard) GetPatient() OptInt {
+	return s.Patient
+}
+
+// GetMedWorker returns the value of MedWorker.
+func (s *PatientCard) GetMedWorker() OptInt {
+	return s.MedWorker
+}
+
+// SetID sets the value of ID.
+func (s *PatientCard) SetID(val OptInt) {
+	s.ID = val
+}
+
+// SetAcceptanceDatetime sets the value of AcceptanceDatetime.
+func (s *PatientCard) SetAcceptanceDatetime(val OptDateTime) {
+	s.AcceptanceDatetime = val
+}
+
+// SetDiagnosis sets the value of Diagnosis.
+func (s *PatientCard) SetDiagnosis(val OptString) {
+	s.Diagnosis = val
+}
+
+// SetPatient sets the value of Patient.
+func (s *PatientCard) SetPatient(val OptInt) {
+	s.Patient = val
+}
+
+// SetMedWorker sets the value of MedWorker.
+func (s *PatientCard) SetMedWorker(val OptInt) {
+	s.MedWorker = val
+}
+
+// Платежный провайдер.
+// Ref: #/components/schemas/PaymentProvider
+type PaymentProvider struct {
+	// Идентификатор платежного провайдера.
+	ID OptUUID `json:"id"`
+	// Название платежного провайдера.
+	Name OptString `json:"name"`
+	// Активен ли провайдер.
+	IsActive OptBool `json:"is_active"`
+}
+
+// GetID returns the value of ID.
+func (s *PaymentProvider) GetID() OptUUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *PaymentProvider) GetName() OptString {
+	return s.Name
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *PaymentProvider) GetIsActive() OptBool {
+	return s.IsActive
+}
+
+// SetID sets the value of ID.
+func (s *PaymentProvider) SetID(val OptUUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *PaymentProvider) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *PaymentProvider) SetIsActive(val OptBool) {
+	s.IsActive = val
+}
+
+type PaymentProvidersGetOKApplicationJSON []PaymentProvider
+
+func (*PaymentProvidersGetOKApplicationJSON) paymentProvidersGetRes() {}
+
+// Ref: #/components/schemas/period_node_metrics
+type PeriodNodeMetrics struct {
+	// Начало периода в UTC.
+	Start   time.Time   `json:"start"`
+	Metrics NodeMetrics `json:"metrics"`
+}
+
+// GetStart returns the value of Start.
+func (s *PeriodNodeMetrics) GetStart() time.Time {
+	return s.Start
+}
+
+// GetMetrics returns the value of Metrics.
+func (s *PeriodNodeMetrics) GetMetrics() NodeMetrics {
+	return s.Metrics
+}
+
+// SetStart sets the value of Start.
+func (s *PeriodNodeMetrics) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetMetrics sets the value of Metrics.
+func (s *PeriodNodeMetrics) SetMetrics(val NodeMetrics) {
+	s.Metrics = val
+}
+
+// Размер пикселя изображения в мм.
+// Ref: #/components/schemas/pixel_spacing
+type PixelSpacing struct {
+	// Размер пикселя по горизонтали, мм.
+	X float64 `json:"x"`
+	// Размер пикселя по вертикали, мм.
+	Y float64 `json:"y"`
+}
+
+// GetX returns the value of X.
+func (s *PixelSpacing) GetX() float64 {
+	return s.X
+}
+
+// GetY returns the value of Y.
+func (s *PixelSpacing) GetY() float64 {
+	return s.Y
+}
+
+// SetX sets the value of X.
+func (s *PixelSpacing) SetX(val float64) {
+	s.X = val
+}
+
+// SetY sets the value of Y.
+func (s *PixelSpacing) SetY(val float64) {
+	s.Y = val
+}
+
+// Тип датчика.
+// Ref: #/components/schemas/probe_type
+type ProbeType string
+
+const (
+	ProbeTypeLinear ProbeType = "linear"
+	ProbeTypeConvex ProbeType = "convex"
+	ProbeTypeSector ProbeType = "sector"
+)
+
+// AllValues returns all ProbeType values.
+func (ProbeType) AllValues() []ProbeType {
+	return []ProbeType{
+		ProbeTypeLinear,
+		ProbeTypeConvex,
+		ProbeTypeSector,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ProbeType) MarshalText() ([]byte, error) {
+	switch s {
+	case ProbeTypeLinear:
+		return []byte(s), nil
+	case ProbeTypeConvex:
+		return []byte(s), nil
+	case ProbeTypeSector:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ProbeType) UnmarshalText(data []byte) error {
+	switch ProbeType(data) {
+	case ProbeTypeLinear:
+		*s = ProbeTypeLinear
+		return nil
+	case ProbeTypeConvex:
+		*s = ProbeTypeConvex
+		return nil
+	case ProbeTypeSector:
+		*s = ProbeTypeSector
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Запрос на покупку подписки.
+// Ref: #/components/schemas/PurchaseSubscriptionRequest
+type PurchaseSubscriptionRequest struct {
+	// Идентификатор тарифного плана.
+	TariffPlanID uuid.UUID `json:"tariff_plan_id"`
+	// Идентификатор провайдера платежей.
+	PaymentProviderID uuid.UUID `json:"payment_provider_id"`
+}
+
+// GetTariffPlanID returns the value of TariffPlanID.
+func (s *PurchaseSubscriptionRequest) GetTariffPlanID() uuid.UUID {
+	return s.TariffPlanID
+}
+
+// GetPaymentProviderID returns the value of PaymentProviderID.
+func (s *PurchaseSubscriptionRequest) GetPaymentProviderID() uuid.UUID {
+	return s.PaymentProviderID
+}
+
+// SetTariffPlanID sets the value of TariffPlanID.
+func (s *PurchaseSubscriptionRequest) SetTariffPlanID(val uuid.UUID) {
+	s.TariffPlanID = val
+}
+
+// SetPaymentProviderID sets the value of PaymentProviderID.
+func (s *PurchaseSubscriptionRequest) SetPaymentProviderID(val uuid.UUID) {
+	s.PaymentProviderID = val
+}
+
+// Ответ на покупку подписки.
+// Ref: #/components/schemas/PurchaseSubscriptionResponse
+type PurchaseSubscriptionResponse struct {
+	// Идентификатор подписки.
+	SubscriptionID uuid.UUID `json:"subscription_id"`
+	// URL для подтверждения подписки.
+	ConfirmationURL string `json:"confirmation_url"`
+}
+
+// GetSubscriptionID returns the value of SubscriptionID.
+func (s *PurchaseSubscriptionResponse) GetSubscriptionID() uuid.UUID {
+	return s.SubscriptionID
+}
+
+// GetConfirmationURL returns the value of ConfirmationURL.
+func (s *PurchaseSubscriptionResponse) GetConfirmationURL() string {
+	return s.ConfirmationURL
+}
+
+// SetSubscriptionID sets the value of SubscriptionID.
+func (s *PurchaseSubscriptionResponse) SetSubscriptionID(val uuid.UUID) {
+	s.SubscriptionID = val
+}
+
+// SetConfirmationURL sets the value of ConfirmationURL.
+func (s *PurchaseSubscriptionResponse) SetConfirmationURL(val string) {
+	s.ConfirmationURL = val
+}
+
+func (*PurchaseSubscriptionResponse) subscriptionsPurchasePostRes() {}
+
+// Согласие двух врачей, создававших ручные узлы на
+// одних и тех же узи.
+// Ref: #/components/schemas/reader_agreement
+type ReaderAgreement struct {
+	ReaderA uuid.UUID `json:"reader_a"`
+	ReaderB uuid.UUID `json:"reader_b"`
+	Uzis    int       `json:"uzis"`
+	// Пары узлов, сопоставленных по IoU контуров.
+	Matched int `json:"matched"`
+	// Узлы, отмеченные только одним врачом.
+	Unmatched int `json:"unmatched"`
+	// Каппа Коэна по классам TI-RADS, узел без пары считается
+	// классом "нет узла".
+	Kappa OptFloat64 `json:"kappa"`
+	// Средний IoU контуров сопоставленных узлов.
+	MeanIou OptFloat64 `json:"mean_iou"`
+}
+
+// GetReaderA returns the value of ReaderA.
+func (s *ReaderAgreement) GetReaderA() uuid.UUID {
+	return s.ReaderA
+}
+
+// GetReaderB returns the value of ReaderB.
+func (s *ReaderAgreement) GetReaderB() uuid.UUID {
+	return s.ReaderB
+}
+
+// GetUzis returns the value of Uzis.
+func (s *ReaderAgreement) GetUzis() int {
+	return s.Uzis
+}
+
+// GetMatched returns the value of Matched.
+func (s *ReaderAgreement) GetMatched() int {
+	return s.Matched
+}
+
+// GetUnmatched returns the value of Unmatched.
+func (s *ReaderAgreement) GetUnmatched() int {
+	return s.Unmatched
+}
+
+// GetKappa returns the value of Kappa.
+func (s *ReaderAgreement) GetKappa() OptFloat64 {
+	return s.Kappa
+}
+
+// GetMeanIou returns the value of MeanIou.
+func (s *ReaderAgreement) GetMeanIou() OptFloat64 {
+	return s.MeanIou
+}
+
+// SetReaderA sets the value of ReaderA.
+func (s *ReaderAgreement) SetReaderA(val uuid.UUID) {
+	s.ReaderA = val
+}
+
+// SetReaderB sets the value of ReaderB.
+func (s *ReaderAgreement) SetReaderB(val uuid.UUID) {
+	s.ReaderB = val
+}
+
+// SetUzis sets the value of Uzis.
+func (s *ReaderAgreement) SetUzis(val int) {
+	s.Uzis = val
+}
+
+// SetMatched sets the value of Matched.
+func (s *ReaderAgreement) SetMatched(val int) {
+	s.Matched = val
+}
+
+// SetUnmatched sets the value of Unmatched.
+func (s *ReaderAgreement) SetUnmatched(val int) {
+	s.Unmatched = val
+}
+
+// SetKappa sets the value of Kappa.
+func (s *ReaderAgreement) SetKappa(val OptFloat64) {
+	s.Kappa = val
+}
+
+// SetMeanIou sets the value of MeanIou.
+func (s *ReaderAgreement) SetMeanIou(val OptFloat64) {
+	s.MeanIou = val
+}
+
+type RefreshPostBadRequest ErrorStatusCode
+
+func (*RefreshPostBadRequest) refreshPostRes() {}
+
+type RefreshPostInternalServerError ErrorStatusCode
+
+func (*RefreshPostInternalServerError) refreshPostRes() {}
+
+type RefreshPostOK struct {
+	// Access токен.
+	AccessToken string `json:"access_token"`
+	// Refresh токен.
+	RefreshToken string `json:"refresh_token"`
+}
+
+// GetAccessToken returns the value of AccessToken.
+func (s *RefreshPostOK) GetAccessToken() string {
+	return s.AccessToken
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshPostOK) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetAccessToken sets the value of AccessToken.
+func (s *RefreshPostOK) SetAccessToken(val string) {
+	s.AccessToken = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshPostOK) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+func (*RefreshPostOK) refreshPostRes() {}
+
+type RefreshPostReq struct {
+	// Refresh токен.
+	RefreshToken string `json:"refresh_token"`
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshPostReq) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshPostReq) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+type RefreshPostUnauthorized ErrorStatusCode
+
+func (*RefreshPostUnauthorized) refreshPostRes() {}
+
+type RegDoctorPostBadRequest ErrorStatusCode
+
+func (*RegDoctorPostBadRequest) regDoctorPostRes() {}
+
+type RegDoctorPostConflict ErrorStatusCode
+
+func (*RegDoctorPostConflict) regDoctorPostRes() {}
+
+type RegDoctorPostInternalServerError ErrorStatusCode
+
+func (*RegDoctorPostInternalServerError) regDoctorPostRes() {}
+
+type RegDoctorPostReq struct {
+	// Email врача.
+	Email string `json:"email"`
+	// Пароль врача.
+	Password string `json:"password"`
+	// Полное имя врача.
+	Fullname string `json:"fullname"`
+	// Организация врача.
+	Org string `json:"org"`
+	// Работа врача.
+	Job string `json:"job"`
+	// Описание врача (раб. стаж).
+	Description OptString `json:"description"`
+}
+
+// GetEmail returns the value of Email.
+func (s *RegDoctorPostReq) GetEmail() string {
+	return s.Email
+}
+
+// GetPassword returns the value of Password.
+func (s *RegDoctorPostReq) GetPassword() string {
+	return s.Password
+}
+
+// GetFullname returns the value of Fullname.
+func (s *RegDoctorPostReq) GetFullname() string {
+	return s.Fullname
+}
+
+// GetOrg returns the value of Org.
+func (s *RegDoctorPostReq) GetOrg() string {
+	return s.Org
+}
+
+// GetJob returns the value of Job.
+func (s *RegDoctorPostReq) GetJob() string {
+	return s.Job
+}
+
+// GetDescription returns the value of Description.
+func (s *RegDoctorPostReq) GetDescription() OptString {
+	return s.Description
+}
+
+// SetEmail sets the value of Email.
+func (s *RegDoctorPostReq) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetPassword sets the value of Password.
+func (s *RegDoctorPostReq) SetPassword(val string) {
+	s.Password = val
+}
+
+// SetFullname sets the value of Fullname.
+func (s *RegDoctorPostReq) SetFullname(val string) {
+	s.Fullname = val
+}
+
+// SetOrg sets the value of Org.
+func (s *RegDoctorPostReq) SetOrg(val string) {
+	s.Org = val
+}
+
+// SetJob sets the value of Job.
+func (s *RegDoctorPostReq) SetJob(val string) {
+	s.Job = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RegDoctorPostReq) SetDescription(val OptString) {
+	s.Description = val
+}
+
+type RegDoctorPostUnprocessableEntity ErrorStatusCode
+
+func (*RegDoctorPostUnprocessableEntity) regDoctorPostRes() {}
+
+type RegPatientPostBadRequest ErrorStatusCode
+
+func (*RegPatientPostBadRequest) regPatientPostRes() {}
+
+type RegPatientPostConflict ErrorStatusCode
+
+func (*RegPatientPostConflict) regPatientPostRes() {}
+
+type RegPatientPostInternalServerError ErrorStatusCode
+
+func (*RegPatientPostInternalServerError) regPatientPostRes() {}
+
+type RegPatientPostReq struct {
+	// Полное имя пациента.
+	Fullname string `json:"fullname"`
+	// Полис пациента.
+	Policy string `json:"policy"`
+	// Дата рождения пациента.
+	BirthDate time.Time `json:"birth_date"`
+	// Email врача.
+	Email string `json:"email"`
+	// Пароль врача.
+	Password string `json:"password"`
+}
+
+// GetFullname returns the value of Fullname.
+func (s *RegPatientPostReq) GetFullname() string {
+	return s.Fullname
+}
+
+// GetPolicy returns the value of Policy.
+func (s *RegPatientPostReq) GetPolicy() string {
+	return s.Policy
+}
+
+// GetBirthDate returns the value of BirthDate.
+func (s *RegPatientPostReq) GetBirthDate() time.Time {
+	return s.BirthDate
+}
+
+// GetEmail returns the value of Email.
+func (s *RegPatientPostReq) GetEmail() string {
+	return s.Email
+}
+
+// GetPassword returns the value of Password.
+func (s *RegPatientPostReq) GetPassword() string {
+	return s.Password
+}
+
+// SetFullname sets the value of Fullname.
+func (s *RegPatientPostReq) SetFullname(val string) {
+	s.Fullname = val
+}
+
+// SetPolicy sets the value of Policy.
+func (s *RegPatientPostReq) SetPolicy(val string) {
+	s.Policy = val
+}
+
+// SetBirthDate sets the value of BirthDate.
+func (s *RegPatientPostReq) SetBirthDate(val time.Time) {
+	s.BirthDate = val
+}
+
+// SetEmail sets the value of Email.
+func (s *RegPatientPostReq) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetPassword sets the value of Password.
+func (s *RegPatientPostReq) SetPassword(val string) {
+	s.Password = val
+}
+
+type RegPatientPostUnprocessableEntity ErrorStatusCode
+
+func (*RegPatientPostUnprocessableEntity) regPatientPostRes() {}
+
+// Версия заключения по узи.
+// Ref: #/components/schemas/report
+type Report struct {
+	ID    uuid.UUID `json:"id"`
+	UziID uuid.UUID `json:"uzi_id"`
+	// Номер версии заключения, начиная с 1.
+	Version  int       `json:"version"`
+	CreateAt time.Time `json:"create_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Report) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUziID returns the value of UziID.
+func (s *Report) GetUziID() uuid.UUID {
+	return s.UziID
+}
+
+// GetVersion returns the value of Version.
+func (s *Report) GetVersion() int {
+	return s.Version
+}
+
+// GetCreateAt returns the value of CreateAt.
+func (s *Report) GetCreateAt() time.Time {
+	return s.CreateAt
+}
+
+// SetID sets the value of ID.
+func (s *Report) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUziID sets the value of UziID.
+func (s *Report) SetUziID(val uuid.UUID) {
+	s.UziID = val
+}
+
+// SetVersion sets the value of Version.
+func (s *Report) SetVersion(val int) {
+	s.Version = val
+}
+
+// SetCreateAt sets the value of CreateAt.
+func (s *Report) SetCreateAt(val time.Time) {
+	s.CreateAt = val
+}
+
+func (*Report) uziIDReportsPostRes() {}
+
+// Сегмент узла на изображении.
+// Ref: #/components/schemas/segment
+type Segment struct {
+	// Id сегмента.
+	ID uuid.UUID `json:"id"`
+	// Id изображения, к которому относится сегмент.
+	ImageID uuid.UUID `json:"image_id"`
+	// Id узла, к которому относится сегмент.
+	NodeID uuid.UUID `json:"node_id"`
+	// Контур сегмента.
+	Contor Contor `json:"contor"`
+	// Признак того, что сегмент был создан нейронкой.
+	Ai bool `json:"ai"`
+	// Вероятность наличия опухоли в 23-й группе.
+	Tirads23 float64 `json:"tirads_23"`
+	// Вероятность наличия опухоли в 4-й группе.
+	Tirads4 float64 `json:"tirads_4"`
+	// Вероятность наличия опухоли в 5-й группе.
+	Tirads5     float64               `json:"tirads_5"`
+	Measurement OptSegmentMeasurement `json:"measurement"`
+}
+
+// GetID returns the value of ID.
+func (s *Segment) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetImageID returns the value of ImageID.
+func (s *Segment) GetImageID() uuid.UUID {
+	return s.ImageID
+}
+
+// GetNodeID returns the value of NodeID.
+func (s *Segment) GetNodeID() uuid.UUID {
+	return s.NodeID
+}
+
+// GetContor returns the value of Contor.
+func (s *Segment) GetContor() Contor {
+	return s.Contor
+}
+
+// GetAi returns the value of Ai.
+func (s *Segment) GetAi() bool {
+	return s.Ai
+}
+
+// GetTirads23 returns the value of Tirads23.
+func (s *Segment) GetTirads23() float64 {
+	return s.Tirads23
+}
+
+// GetTirads4 returns the value of Tirads4.
+func (s *Segment) GetTirads4() float64 {
+	return s.Tirads4
+}
+
+// GetTirads5 returns the value of Tirads5.
+func (s *Segment) GetTirads5() float64 {
+	return s.Tirads5
+}
+
+// GetMeasurement returns the value of Measurement.
+func (s *Segment) GetMeasurement() OptSegmentMeasurement {
+	return s.Measurement
+}
+
+// SetID sets the value of ID.
+func (s *Segment) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetImageID sets the value of ImageID.
+func (s *Segment) SetImageID(val uuid.UUID) {
+	s.ImageID = val
+}
+
+// SetNodeID sets the value of NodeID.
+func (s *Segment) SetNodeID(val uuid.UUID) {
+	s.NodeID = val
+}
+
+// SetContor sets the value of Contor.
+func (s *Segment) SetContor(val Contor) {
+	s.Contor = val
+}
+
+// SetAi sets the value of Ai.
+func (s *Segment) SetAi(val bool) {
+	s.Ai = val
+}
+
+// SetTirads23 sets the value of Tirads23.
+func (s *Segment) SetTirads23(val float64) {
+	s.Tirads23 = val
+}
+
+// SetTirads4 sets the value of Tirads4.
+func (s *Segment) SetTirads4(val float64) {
+	s.Tirads4 = val
+}
+
+// SetTirads5 sets the value of Tirads5.
+func (s *Segment) SetTirads5(val float64) {
+	s.Tirads5 = val
+}
+
+// SetMeasurement sets the value of Measurement.
+func (s *Segment) SetMeasurement(val OptSegmentMeasurement) {
+	s.Measurement = val
+}
+
+func (*Segment) uziSegmentDraftsIDAcceptPostRes() {}
+func (*Segment) uziSegmentIDPatchRes()            {}
+
+// Контур узла, предложенный на соседнем кадре,
+// становится сегментом после подтверждения.
+// Ref: #/components/schemas/segment_draft
+type SegmentDraft struct {
+	ID     uuid.UUID `json:"id"`
+	NodeID uuid.UUID `json:"node_id"`
+	// Кадр, на котором предложен контур.
+	ImageID uuid.UUID `json:"image_id"`
+	// Сегмент, с которого начато распространение.
+	SourceSegmentID uuid.UUID `json:"source_segment_id"`
+	Contor          Contor    `json:"contor"`
+	Tirads23        float64   `json:"tirads_23"`
+	Tirads4         float64   `json:"tirads_4"`
+	Tirads5         float64   `json:"tirads_5"`
+	// Совпадение окрестности контура с предыдущим кадром,
+	// от -1 до 1.
+	Score    float64   `json:"score"`
+	CreateAt time.Time `json:"create_at"`
+}
+
+// GetID returns the value of ID.
+func (s *SegmentDraft) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetNodeID returns the value of NodeID.
+func (s *SegmentDraft) GetNodeID() uuid.UUID {
+	return s.NodeID
+}
+
+// GetImageID returns the value of ImageID.
+func (s *SegmentDraft) GetImageID() uuid.UUID {
+	return s.ImageID
+}
+
+// GetSourceSegmentID returns the value of SourceSegmentID.
+func (s *SegmentDraft) GetSourceSegmentID() uuid.UUID {
+	return s.SourceSegmentID
+}
+
+// GetContor returns the value of Contor.
+func (s *SegmentDraft) GetContor() Contor {
+	return s.Contor
+}
+
+// GetTirads23 returns the value of Tirads23.
+func (s *SegmentDraft) GetTirads23() float64 {
+	return s.Tirads23
+}
+
+// GetTirads4 returns the value of Tirads4.
+func (s *SegmentDraft) GetTirads4() float64 {
+	return s.Tirads4
+}
+
+// GetTirads5 returns the value of Tirads5.
+func (s *SegmentDraft) GetTirads5() float64 {
+	return s.Tirads5
+}
+
+// GetScore returns the value of Score.
+func (s *SegmentDraft) GetScore() float64 {
+	return s.Score
+}
+
+// GetCreateAt returns the value of CreateAt.
+func (s *SegmentDraft) GetCreateAt() time.Time {
+	return s.CreateAt
+}
+
+// SetID sets the value of ID.
+func (s *SegmentDraft) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetNodeID sets the value of NodeID.
+func (s *SegmentDraft) SetNodeID(val uuid.UUID) {
+	s.NodeID = val
+}
+
+// SetImageID sets the value of ImageID.
+func (s *SegmentDraft) SetImageID(val uuid.UUID) {
+	s.ImageID = val
+}
+
+// SetSourceSegmentID sets the value of SourceSegmentID.
+func (s *SegmentDraft) SetSourceSegmentID(val uuid.UUID) {
+	s.SourceSegmentID = val
+}
+
+// SetContor sets the value of Contor.
+func (s *SegmentDraft) SetContor(val Contor) {
+	s.Contor = val
+}
+
+// SetTirads23 sets the value of Tirads23.
+func (s *SegmentDraft) SetTirads23(val float64) {
+	s.Tirads23 = val
+}
+
+// SetTirads4 sets the value of Tirads4.
+func (s *SegmentDraft) SetTirads4(val float64) {
+	s.Tirads4 = val
+}
+
+// SetTirads5 sets the value of Tirads5.
+func (s *SegmentDraft) SetTirads5(val float64) {
+	s.Tirads5 = val
+}
+
+// SetScore sets the value of Score.
+func (s *SegmentDraft) SetScore(val float64) {
+	s.Score = val
+}
+
+// SetCreateAt sets the value of CreateAt.
+func (s *SegmentDraft) SetCreateAt(val time.Time) {
+	s.CreateAt = val
+}
+
+// Измерения сегмента, рассчитанные по контуру.
+// Ref: #/components/schemas/segment_measurement
+type SegmentMeasurement struct {
+	Bbox BoundingBox `json:"bbox"`
+	// Площадь контура.
+	Area float64 `json:"area"`
+	// Периметр контура.
+	Perimeter float64 `json:"perimeter"`
+	// Большая ось (максимальный диаметр).
+	MajorAxis float64 `json:"major_axis"`
+	// Малая ось (максимальная ширина перпендикулярно
+	// большой оси).
+	MinorAxis float64     `json:"minor_axis"`
+	Unit      MeasureUnit `json:"unit"`
+}
+
+// GetBbox returns the value of Bbox.
+func (s *SegmentMeasurement) GetBbox() BoundingBox {
+	return s.Bbox
+}
+
+// GetArea returns the value of Area.
+func (s *SegmentMeasurement) GetArea() float64 {
+	return s.Area
+}
+
+// GetPerimeter returns the value of Perimeter.
+func (s *SegmentMeasurement) GetPerimeter() float64 {
+	return s.Perimeter
+}
+
+// GetMajorAxis returns the value of MajorAxis.
+func (s *SegmentMeasurement) GetMajorAxis() float64 {
+	return s.MajorAxis
+}
+
+// GetMinorAxis returns the value of MinorAxis.
+func (s *SegmentMeasurement) GetMinorAxis() float64 {
+	return s.MinorAxis
+}
+
+// GetUnit returns the value of Unit.
+func (s *SegmentMeasurement) GetUnit() MeasureUnit {
+	return s.Unit
+}
+
+// SetBbox sets the value of Bbox.
+func (s *SegmentMeasurement) SetBbox(val BoundingBox) {
+	s.Bbox = val
+}
+
+// SetArea sets the value of Area.
+func (s *SegmentMeasurement) SetArea(val float64) {
+	s.Area = val
+}
+
+// SetPerimeter sets the value of Perimeter.
+func (s *SegmentMeasurement) SetPerimeter(val float64) {
+	s.Perimeter = val
+}
+
+// SetMajorAxis sets the value of MajorAxis.
+func (s *SegmentMeasurement) SetMajorAxis(val float64) {
+	s.MajorAxis = val
+}
+
+// SetMinorAxis sets the value of MinorAxis.
+func (s *SegmentMeasurement) SetMinorAxis(val float64) {
+	s.MinorAxis = val
+}
+
+// SetUnit sets the value of Unit.
+func (s *SegmentMeasurement) SetUnit(val MeasureUnit) {
+	s.Unit = val
+}
+
+// Сегментация.
+// Ref: #/components/schemas/segmentation
+type Segmentation struct {
+	// Id сегментации.
+	ID int `json:"id"`
+	// Id группы сегментаций.
+	SegmentationGroupID int `json:"segmentation_group_id"`
+	// Точки сегментации.
+	Points []SegmentationPoint `json:"points"`
+	// Дата создания.
+	CreateAt     time.Time               `json:"create_at"`
+	GeometryType OptCytologyGeometryType `json:"geometry_type"`
+	Color        OptCytologyColor        `json:"color"`
+	IsLocked     OptBool                 `json:"is_locked"`
+	// Уверенность модели.
+	Confidence OptFloat64                   `json:"confidence"`
+	Properties OptCytologySegmentProperties `json:"properties"`
+}
+
+// GetID returns the value of ID.
+func (s *Segmentation) GetID() int {
+	return s.ID
+}
+
+// GetSegmentationGroupID returns the value of SegmentationGroupID.
+func (s *Segmentation) GetSegmentationGroupID() int {
+	return s.SegmentationGroupID
+}
+
+// GetPoints returns the value of Points.
+func (s *Segmentation) GetPoints() []SegmentationPoint {
+	return s.Points
+}
+
+// GetCreateAt returns the value of CreateAt.
+func (s *Segmentation) GetCreateAt() time.Time {
+	return s.CreateAt
+}
+
+// GetGeometryType returns the value of GeometryType.
+func (s *Segmentation) GetGeometryType() OptCytologyGeometryType {
+	return s.GeometryType
+}
+
+// GetColor returns the value of Color.
+func (s *Segmentation) GetColor() OptCytologyColor {
+	return s.Color
+}
+
+// GetIsLocked returns the value of IsLocked.
+func (s *Segmentation) GetIsLocked() OptBool {
+	return s.IsLocked
+}
+
+// GetConfidence returns the value of Confidence.
+func (s *Segmentation) GetConfidence() OptFloat64 {
+	return s.Confidence
+}
+
+// GetProperties returns the value of Properties.
+func (s *Segmentation) GetProperties() OptCytologySegmentProperties {
+	return s.Properties
+}
+
+// SetID sets the value of ID.
+func (s *Segmentation) SetID(val int) {
+	s.ID = val
+}
+
+// SetSegmentationGroupID sets the value of SegmentationGroupID.
+func (s *Segmentation) SetSegmentationGroupID(val int) {
+	s.SegmentationGroupID = val
+}
+
+// SetPoints sets the value of Points.
+func (s *Segmentation) SetPoints(val []SegmentationPoint) {
+	s.Points = val
+}
+
+// SetCreateAt sets the value of CreateAt.
+func (s *Segmentation) SetCreateAt(val time.Time) {
+	s.CreateAt = val
+}
+
+// SetGeometryType sets the value of GeometryType.
+func (s *Segmentation) SetGeometryType(val OptCytologyGeometryType) {
+	s.GeometryType = val
+}
+
+// SetColor sets the value of Color.
+func (s *Segmentation) SetColor(val OptCytologyColor) {
+	s.Color = val
+}
+
+// SetIsLocked sets the value of IsLocked.
+func (s *Segmentation) SetIsLocked(val OptBool) {
+	s.IsLocked = val
+}
+
+// SetConfidence sets the value of Confidence.
+func (s *Segmentation) SetConfidence(val OptFloat64) {
+	s.Confidence = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *Segmentation) SetProperties(val OptCytologySegmentProperties) {
+	s.Properties = val
+}
+
+// Точка сегментации.
+// Ref: #/components/schemas/segmentationPoint
+type SegmentationPoint struct {
+	// Порядковый номер точки в сегментации.
+	ID  OptInt `json:"id"`
+	UID OptInt `json:"uid"`
+	// Координата X.
+	X int `json:"x"`
+	// Координата Y.
+	Y int `json:"y"`
+	// Номер полигона в MultiPolygon.
+	Polygon OptInt `json:"polygon"`
+	// Номер кольца в полигоне, 0 - внешний контур.
+	Ring OptInt `json:"ring"`
+}
+
+// GetID returns the value of ID.
+func (s *SegmentationPoint) GetID() OptInt {
+	return s.ID
+}
+
+// GetUID returns the value of UID.
+func (s *SegmentationPoint) GetUID() OptInt {
+	return s.UID
+}
+
+// GetX returns the value of X.
+func (s *SegmentationPoint) GetX() int {
+	return s.X
+}
+
+// GetY returns the value of Y.
+func (s *SegmentationPoint) GetY() int {
+	return s.Y
+}
+
+// GetPolygon returns the value of Polygon.
+func (s *SegmentationPoint) GetPolygon() OptInt {
+	return s.Polygon
+}
+
+// GetRing returns the value of Ring.
+func (s *SegmentationPoint) GetRing() OptInt {
+	return s.Ring
+}
+
+// SetID sets the value of ID.
+func (s *SegmentationPoint) SetID(val OptInt) {
+	s.ID = val
+}
+
+// SetUID sets the value of UID.
+func (s *SegmentationPoint) SetUID(val OptInt) {
+	s.UID = val
+}
+
+// SetX sets the value of X.
+func (s *SegmentationPoint) SetX(val int) {
+	s.X = val
+}
+
+// SetY sets the value of Y.
+func (s *SegmentationPoint) SetY(val int) {
+	s.Y = val
+}
+
+// SetPolygon sets the value of Polygon.
+func (s *SegmentationPoint) SetPolygon(val OptInt) {
+	s.Polygon = val
+}
+
+// SetRing sets the value of Ring.
+func (s *SegmentationPoint) SetRing(val OptInt) {
+	s.Ring = val
+}
+
+// Uuid.
+// Ref: #/components/schemas/simpleUuid
+type SimpleUuid struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *SimpleUuid) GetID() uuid.UUID {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *SimpleUuid) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+func (*SimpleUuid) medPatientPostRes() {}
+func (*SimpleUuid) regDoctorPostRes()  {}
+func (*SimpleUuid) regPatientPostRes() {}
+func (*SimpleUuid) uziSegmentPostRes() {}
+
+// Подписка.
+// Ref: #/components/schemas/Subscription
+type Subscription struct {
+	// Идентификатор подписки.
+	ID uuid.UUID `json:"id"`
+	// Идентификатор тарифного плана.
+	TariffPlanID uuid.UUID `json:"tariff_plan_id"`
+	// Статус подписки.
+	Status SubscriptionStatus `json:"status"`
+	// Дата начала подписки.
+	StartDate time.Time `json:"start_date"`
+	// Дата окончания подписки.
+	EndDate time.Time `json:"end_date"`
+}
 
-type RegDoctorPostConflict ErrorStatusCode
+// GetID returns the value of ID.
+func (s *Subscription) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetTariffPlanID returns the value of TariffPlanID.
+func (s *Subscription) GetTariffPlanID() uuid.UUID {
+	return s.TariffPlanID
+}
+
+// GetStatus returns the value of Status.
+func (s *Subscription) GetStatus() SubscriptionStatus {
+	return s.Status
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *Subscription) GetStartDate() time.Time {
+	return s.StartDate
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *Subscription) GetEndDate() time.Time {
+	return s.EndDate
+}
+
+// SetID sets the value of ID.
+func (s *Subscription) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetTariffPlanID sets the value of TariffPlanID.
+func (s *Subscription) SetTariffPlanID(val uuid.UUID) {
+	s.TariffPlanID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Subscription) SetStatus(val SubscriptionStatus) {
+	s.Status = val
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *Subscription) SetStartDate(val time.Time) {
+	s.StartDate = val
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *Subscription) SetEndDate(val time.Time) {
+	s.EndDate = val
+}
+
+func (*Subscription) subscriptionsGetActiveGetRes() {}
+
+// Статус подписки.
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusPendingPayment SubscriptionStatus = "pending_payment"
+	SubscriptionStatusActive         SubscriptionStatus = "active"
+	SubscriptionStatusCancelled      SubscriptionStatus = "cancelled"
+)
+
+// AllValues returns all SubscriptionStatus values.
+func (SubscriptionStatus) AllValues() []SubscriptionStatus {
+	return []SubscriptionStatus{
+		SubscriptionStatusPendingPayment,
+		SubscriptionStatusActive,
+		SubscriptionStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SubscriptionStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case SubscriptionStatusPendingPayment:
+		return []byte(s), nil
+	case SubscriptionStatusActive:
+		return []byte(s), nil
+	case SubscriptionStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SubscriptionStatus) UnmarshalText(data []byte) error {
+	switch SubscriptionStatus(data) {
+	case SubscriptionStatusPendingPayment:
+		*s = SubscriptionStatusPendingPayment
+		return nil
+	case SubscriptionStatusActive:
+		*s = SubscriptionStatusActive
+		return nil
+	case SubscriptionStatusCancelled:
+		*s = SubscriptionStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SubscriptionsCheckActiveGetOK struct {
+	HasActiveSubscription OptBool `json:"has_active_subscription"`
+}
+
+// GetHasActiveSubscription returns the value of HasActiveSubscription.
+func (s *SubscriptionsCheckActiveGetOK) GetHasActiveSubscription() OptBool {
+	return s.HasActiveSubscription
+}
+
+// SetHasActiveSubscription sets the value of HasActiveSubscription.
+func (s *SubscriptionsCheckActiveGetOK) SetHasActiveSubscription(val OptBool) {
+	s.HasActiveSubscription = val
+}
+
+func (*SubscriptionsCheckActiveGetOK) subscriptionsCheckActiveGetRes() {}
+
+type SubscriptionsGetActiveGetInternalServerError ErrorStatusCode
+
+func (*SubscriptionsGetActiveGetInternalServerError) subscriptionsGetActiveGetRes() {}
+
+type SubscriptionsGetActiveGetNotFound ErrorStatusCode
+
+func (*SubscriptionsGetActiveGetNotFound) subscriptionsGetActiveGetRes() {}
+
+// Тарифный план.
+// Ref: #/components/schemas/TariffPlan
+type TariffPlan struct {
+	// Идентификатор тарифного плана.
+	ID uuid.UUID `json:"id"`
+	// Название тарифного плана.
+	Name string `json:"name"`
+	// Описание тарифного плана.
+	Description string `json:"description"`
+	// Цена тарифного плана.
+	Price string `json:"price"`
+	// Длительность тарифного плана в наносекундах.
+	Duration int `json:"duration"`
+}
+
+// GetID returns the value of ID.
+func (s *TariffPlan) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TariffPlan) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *TariffPlan) GetDescription() string {
+	return s.Description
+}
+
+// GetPrice returns the value of Price.
+func (s *TariffPlan) GetPrice() string {
+	return s.Price
+}
+
+// GetDuration returns the value of Duration.
+func (s *TariffPlan) GetDuration() int {
+	return s.Duration
+}
+
+// SetID sets the value of ID.
+func (s *TariffPlan) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TariffPlan) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *TariffPlan) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetPrice sets the value of Price.
+func (s *TariffPlan) SetPrice(val string) {
+	s.Price = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *TariffPlan) SetDuration(val int) {
+	s.Duration = val
+}
+
+func (*TariffPlan) tariffPlansIDGetRes() {}
+
+type TariffPlansGetOKApplicationJSON []TariffPlan
+
+func (*TariffPlansGetOKApplicationJSON) tariffPlansGetRes() {}
+
+type TilerDziFilePathFilesLevelColRowFormatGetBadRequest ErrorStatusCode
+
+func (*TilerDziFilePathFilesLevelColRowFormatGetBadRequest) tilerDziFilePathFilesLevelColRowFormatGetRes() {
+}
+
+type TilerDziFilePathFilesLevelColRowFormatGetFormat string
+
+const (
+	TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg TilerDziFilePathFilesLevelColRowFormatGetFormat = "jpeg"
+	TilerDziFilePathFilesLevelColRowFormatGetFormatJPG  TilerDziFilePathFilesLevelColRowFormatGetFormat = "jpg"
+	TilerDziFilePathFilesLevelColRowFormatGetFormatPNG  TilerDziFilePathFilesLevelColRowFormatGetFormat = "png"
+)
+
+// AllValues returns all TilerDziFilePathFilesLevelColRowFormatGetFormat values.
+func (TilerDziFilePathFilesLevelColRowFormatGetFormat) AllValues() []TilerDziFilePathFilesLevelColRowFormatGetFormat {
+	return []TilerDziFilePathFilesLevelColRowFormatGetFormat{
+		TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg,
+		TilerDziFilePathFilesLevelColRowFormatGetFormatJPG,
+		TilerDziFilePathFilesLevelColRowFormatGetFormatPNG,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TilerDziFilePathFilesLevelColRowFormatGetFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg:
+		return []byte(s), nil
+	case TilerDziFilePathFilesLevelColRowFormatGetFormatJPG:
+		return []byte(s), nil
+	case TilerDziFilePathFilesLevelColRowFormatGetFormatPNG:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TilerDziFilePathFilesLevelColRowFormatGetFormat) UnmarshalText(data []byte) error {
+	switch TilerDziFilePathFilesLevelColRowFormatGetFormat(data) {
+	case TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg:
+		*s = TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg
+		return nil
+	case TilerDziFilePathFilesLevelColRowFormatGetFormatJPG:
+		*s = TilerDziFilePathFilesLevelColRowFormatGetFormatJPG
+		return nil
+	case TilerDziFilePathFilesLevelColRowFormatGetFormatPNG:
+		*s = TilerDziFilePathFilesLevelColRowFormatGetFormatPNG
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-func (*RegDoctorPostConflict) regDoctorPostRes() {}
+type TilerDziFilePathFilesLevelColRowFormatGetInternalServerError ErrorStatusCode
 
-type RegDoctorPostInternalServerError ErrorStatusCode
+func (*TilerDziFilePathFilesLevelColRowFormatGetInternalServerError) tilerDziFilePathFilesLevelColRowFormatGetRes() {
+}
 
-func (*RegDoctorPostInternalServerError) regDoctorPostRes() {}
+type TilerDziFilePathFilesLevelColRowFormatGetNotFound ErrorStatusCode
 
-type RegDoctorPostReq struct {
-	// Email врача.
-	Email string `json:"email"`
-	// Пароль врача.
-	Password string `json:"password"`
-	// Полное имя врача.
-	Fullname string `json:"fullname"`
-	// Организация врача.
-	Org string `json:"org"`
-	// Работа врача.
-	Job string `json:"job"`
-	// Описание врача (раб. стаж).
-	Description OptString `json:"description"`
+func (*TilerDziFilePathFilesLevelColRowFormatGetNotFound) tilerDziFilePathFilesLevelColRowFormatGetRes() {
 }
 
-// GetEmail returns the value of Email.
-func (s *RegDoctorPostReq) GetEmail() string {
-	return s.Email
+type TilerDziFilePathFilesLevelColRowFormatGetOKImageJpeg struct {
+	Data io.Reader
 }
 
-// GetPassword returns the value of Password.
-func (s *RegDoctorPostReq) GetPassword() string {
-	return s.Password
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s TilerDziFilePathFilesLevelColRowFormatGetOKImageJpeg) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetFullname returns the value of Fullname.
-func (s *RegDoctorPostReq) GetFullname() string {
-	return s.Fullname
+func (*TilerDziFilePathFilesLevelColRowFormatGetOKImageJpeg) tilerDziFilePathFilesLevelColRowFormatGetRes() {
 }
 
-// GetOrg returns the value of Org.
-func (s *RegDoctorPostReq) GetOrg() string {
-	return s.Org
+type TilerDziFilePathFilesLevelColRowFormatGetOKImagePNG struct {
+	Data io.Reader
 }
 
-// GetJob returns the value of Job.
-func (s *RegDoctorPostReq) GetJob() string {
-	return s.Job
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s TilerDziFilePathFilesLevelColRowFormatGetOKImagePNG) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetDescription returns the value of Description.
-func (s *RegDoctorPostReq) GetDescription() OptString {
-	return s.Description
+func (*TilerDziFilePathFilesLevelColRowFormatGetOKImagePNG) tilerDziFilePathFilesLevelColRowFormatGetRes() {
 }
 
-// SetEmail sets the value of Email.
-func (s *RegDoctorPostReq) SetEmail(val string) {
-	s.Email = val
-}
+type TilerDziFilePathGetBadRequest ErrorStatusCode
 
-// SetPassword sets the value of Password.
-func (s *RegDoctorPostReq) SetPassword(val string) {
-	s.Password = val
-}
+func (*TilerDziFilePathGetBadRequest) tilerDziFilePathGetRes() {}
 
-// SetFullname sets the value of Fullname.
-func (s *RegDoctorPostReq) SetFullname(val string) {
-	s.Fullname = val
-}
+type TilerDziFilePathGetInternalServerError ErrorStatusCode
 
-// SetOrg sets the value of Org.
-func (s *RegDoctorPostReq) SetOrg(val string) {
-	s.Org = val
-}
+func (*TilerDziFilePathGetInternalServerError) tilerDziFilePathGetRes() {}
 
-// SetJob sets the value of Job.
-func (s *RegDoctorPostReq) SetJob(val string) {
-	s.Job = val
-}
+type TilerDziFilePathGetNotFound ErrorStatusCode
 
-// SetDescription sets the value of Description.
-func (s *RegDoctorPostReq) SetDescription(val OptString) {
-	s.Description = val
-}
+func (*TilerDziFilePathGetNotFound) tilerDziFilePathGetRes() {}
 
-type RegDoctorPostUnprocessableEntity ErrorStatusCode
+type TilerDziFilePathGetOK struct {
+	Data io.Reader
+}
 
-func (*RegDoctorPostUnprocessableEntity) regDoctorPostRes() {}
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s TilerDziFilePathGetOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
 
-type RegPatientPostBadRequest ErrorStatusCode
+func (*TilerDziFilePathGetOK) tilerDziFilePathGetRes() {}
 
-func (*RegPatientPostBadRequest) regPatientPostRes() {}
+// Число узлов по классу TI-RADS с наибольшей вероятностью.
+// Ref: #/components/schemas/tirads_distribution
+type TiradsDistribution struct {
+	Tirads23 int `json:"tirads_23"`
+	Tirads4  int `json:"tirads_4"`
+	Tirads5  int `json:"tirads_5"`
+}
 
-type RegPatientPostConflict ErrorStatusCode
+// GetTirads23 returns the value of Tirads23.
+func (s *TiradsDistribution) GetTirads23() int {
+	return s.Tirads23
+}
 
-func (*RegPatientPostConflict) regPatientPostRes() {}
+// GetTirads4 returns the value of Tirads4.
+func (s *TiradsDistribution) GetTirads4() int {
+	return s.Tirads4
+}
 
-type RegPatientPostInternalServerError ErrorStatusCode
+// GetTirads5 returns the value of Tirads5.
+func (s *TiradsDistribution) GetTirads5() int {
+	return s.Tirads5
+}
 
-func (*RegPatientPostInternalServerError) regPatientPostRes() {}
+// SetTirads23 sets the value of Tirads23.
+func (s *TiradsDistribution) SetTirads23(val int) {
+	s.Tirads23 = val
+}
 
-type RegPatientPostReq struct {
-	// Полное имя пациента.
-	Fullname string `json:"fullname"`
-	// Полис пациента.
-	Policy string `json:"policy"`
-	// Дата рождения пациента.
-	BirthDate time.Time `json:"birth_date"`
-	// Email врача.
-	Email string `json:"email"`
-	// Пароль врача.
-	Password string `json:"password"`
+// SetTirads4 sets the value of Tirads4.
+func (s *TiradsDistribution) SetTirads4(val int) {
+	s.Tirads4 = val
 }
 
-// GetFullname returns the value of Fullname.
-func (s *RegPatientPostReq) GetFullname() string {
-	return s.Fullname
+// SetTirads5 sets the value of Tirads5.
+func (s *TiradsDistribution) SetTirads5(val int) {
+	s.Tirads5 = val
 }
 
-// GetPolicy returns the value of Policy.
-func (s *RegPatientPostReq) GetPolicy() string {
-	return s.Policy
+// Результат оценки по ACR TI-RADS.
+// Ref: #/components/schemas/tirads_score
+type TiradsScore struct {
+	// Сумма баллов.
+	Points   int                 `json:"points"`
+	Category TiradsScoreCategory `json:"category"`
+	// Рекомендация по размеру узла, unknown если размер в мм
+	// неизвестен.
+	Recommendation TiradsScoreRecommendation `json:"recommendation"`
+	// Максимальный диаметр узла в мм.
+	MaxDiameter OptFloat64 `json:"max_diameter"`
 }
 
-// GetBirthDate returns the value of BirthDate.
-func (s *RegPatientPostReq) GetBirthDate() time.Time {
-	return s.BirthDate
+// GetPoints returns the value of Points.
+func (s *TiradsScore) GetPoints() int {
+	return s.Points
 }
 
-// GetEmail returns the value of Email.
-func (s *RegPatientPostReq) GetEmail() string {
-	return s.Email
+// GetCategory returns the value of Category.
+func (s *TiradsScore) GetCategory() TiradsScoreCategory {
+	return s.Category
 }
 
-// GetPassword returns the value of Password.
-func (s *RegPatientPostReq) GetPassword() string {
-	return s.Password
+// GetRecommendation returns the value of Recommendation.
+func (s *TiradsScore) GetRecommendation() TiradsScoreRecommendation {
+	return s.Recommendation
 }
 
-// SetFullname sets the value of Fullname.
-func (s *RegPatientPostReq) SetFullname(val string) {
-	s.Fullname = val
+// GetMaxDiameter returns the value of MaxDiameter.
+func (s *TiradsScore) GetMaxDiameter() OptFloat64 {
+	return s.MaxDiameter
 }
 
-// SetPolicy sets the value of Policy.
-func (s *RegPatientPostReq) SetPolicy(val string) {
-	s.Policy = val
+// SetPoints sets the value of Points.
+func (s *TiradsScore) SetPoints(val int) {
+	s.Points = val
 }
 
-// SetBirthDate sets the value of BirthDate.
-func (s *RegPatientPostReq) SetBirthDate(val time.Time) {
-	s.BirthDate = val
+// SetCategory sets the value of Category.
+func (s *TiradsScore) SetCategory(val TiradsScoreCategory) {
+	s.Category = val
 }
 
-// SetEmail sets the value of Email.
-func (s *RegPatientPostReq) SetEmail(val string) {
-	s.Email = val
+// SetRecommendation sets the value of Recommendation.
+func (s *TiradsScore) SetRecommendation(val TiradsScoreRecommendation) {
+	s.Recommendation = val
 }
 
-// SetPassword sets the value of Password.
-func (s *RegPatientPostReq) SetPassword(val string) {
-	s.Password = val
+// SetMaxDiameter sets the value of MaxDiameter.
+func (s *TiradsScore) SetMaxDiameter(val OptFloat64) {
+	s.MaxDiameter = val
 }
 
-type RegPatientPostUnprocessableEntity ErrorStatusCode
+type TiradsScoreCategory string
 
-func (*RegPatientPostUnprocessableEntity) regPatientPostRes() {}
+const (
+	TiradsScoreCategoryTr1 TiradsScoreCategory = "tr1"
+	TiradsScoreCategoryTr2 TiradsScoreCategory = "tr2"
+	TiradsScoreCategoryTr3 TiradsScoreCategory = "tr3"
+	TiradsScoreCategoryTr4 TiradsScoreCategory = "tr4"
+	TiradsScoreCategoryTr5 TiradsScoreCategory = "tr5"
+)
 
-// Сегмент узла на изображении.
-// Ref: #/components/schemas/segment
-type Segment struct {
-	// Id сегмента.
-	ID uuid.UUID `json:"id"`
-	// Id изображения, к которому относится сегмент.
-	ImageID uuid.UUID `json:"image_id"`
-	// Id узла, к которому относится сегмент.
-	NodeID uuid.UUID `json:"node_id"`
-	// Контур сегмента.
-	Contor Contor `json:"contor"`
-	// Признак того, что сегмент был создан нейронкой.
-	Ai bool `json:"ai"`
-	// Вероятность наличия опухоли в 23-й группе.
-	Tirads23 float64 `json:"tirads_23"`
-	// Вероятность наличия опухоли в 4-й группе.
-	Tirads4 float64 `json:"tirads_4"`
-	// Вероятность наличия опухоли в 5-й группе.
-	Tirads5 float64 `json:"tirads_5"`
+// AllValues returns all TiradsScoreCategory values.
+func (TiradsScoreCategory) AllValues() []TiradsScoreCategory {
+	return []TiradsScoreCategory{
+		TiradsScoreCategoryTr1,
+		TiradsScoreCategoryTr2,
+		TiradsScoreCategoryTr3,
+		TiradsScoreCategoryTr4,
+		TiradsScoreCategoryTr5,
+	}
 }
 
-// GetID returns the value of ID.
-func (s *Segment) GetID() uuid.UUID {
-	return s.ID
+// MarshalText implements encoding.TextMarshaler.
+func (s TiradsScoreCategory) MarshalText() ([]byte, error) {
+	switch s {
+	case TiradsScoreCategoryTr1:
+		return []byte(s), nil
+	case TiradsScoreCategoryTr2:
+		return []byte(s), nil
+	case TiradsScoreCategoryTr3:
+		return []byte(s), nil
+	case TiradsScoreCategoryTr4:
+		return []byte(s), nil
+	case TiradsScoreCategoryTr5:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetImageID returns the value of ImageID.
-func (s *Segment) GetImageID() uuid.UUID {
-	return s.ImageID
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TiradsScoreCategory) UnmarshalText(data []byte) error {
+	switch TiradsScoreCategory(data) {
+	case TiradsScoreCategoryTr1:
+		*s = TiradsScoreCategoryTr1
+		return nil
+	case TiradsScoreCategoryTr2:
+		*s = TiradsScoreCategoryTr2
+		return nil
+	case TiradsScoreCategoryTr3:
+		*s = TiradsScoreCategoryTr3
+		return nil
+	case TiradsScoreCategoryTr4:
+		*s = TiradsScoreCategoryTr4
+		return nil
+	case TiradsScoreCategoryTr5:
+		*s = TiradsScoreCategoryTr5
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetNodeID returns the value of NodeID.
-func (s *Segment) GetNodeID() uuid.UUID {
-	return s.NodeID
+// Рекомендация по размеру узла, unknown если размер в мм
+// неизвестен.
+type TiradsScoreRecommendation string
+
+const (
+	TiradsScoreRecommendationNone     TiradsScoreRecommendation = "none"
+	TiradsScoreRecommendationFollowUp TiradsScoreRecommendation = "follow_up"
+	TiradsScoreRecommendationFna      TiradsScoreRecommendation = "fna"
+	TiradsScoreRecommendationUnknown  TiradsScoreRecommendation = "unknown"
+)
+
+// AllValues returns all TiradsScoreRecommendation values.
+func (TiradsScoreRecommendation) AllValues() []TiradsScoreRecommendation {
+	return []TiradsScoreRecommendation{
+		TiradsScoreRecommendationNone,
+		TiradsScoreRecommendationFollowUp,
+		TiradsScoreRecommendationFna,
+		TiradsScoreRecommendationUnknown,
+	}
 }
 
-// GetContor returns the value of Contor.
-func (s *Segment) GetContor() Contor {
-	return s.Contor
+// MarshalText implements encoding.TextMarshaler.
+func (s TiradsScoreRecommendation) MarshalText() ([]byte, error) {
+	switch s {
+	case TiradsScoreRecommendationNone:
+		return []byte(s), nil
+	case TiradsScoreRecommendationFollowUp:
+		return []byte(s), nil
+	case TiradsScoreRecommendationFna:
+		return []byte(s), nil
+	case TiradsScoreRecommendationUnknown:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetAi returns the value of Ai.
-func (s *Segment) GetAi() bool {
-	return s.Ai
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TiradsScoreRecommendation) UnmarshalText(data []byte) error {
+	switch TiradsScoreRecommendation(data) {
+	case TiradsScoreRecommendationNone:
+		*s = TiradsScoreRecommendationNone
+		return nil
+	case TiradsScoreRecommendationFollowUp:
+		*s = TiradsScoreRecommendationFollowUp
+		return nil
+	case TiradsScoreRecommendationFna:
+		*s = TiradsScoreRecommendationFna
+		return nil
+	case TiradsScoreRecommendationUnknown:
+		*s = TiradsScoreRecommendationUnknown
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetTirads23 returns the value of Tirads23.
-func (s *Segment) GetTirads23() float64 {
-	return s.Tirads23
+// Сессия загрузки файла частями.
+// Ref: #/components/schemas/upload
+type Upload struct {
+	// Id загрузки.
+	ID   uuid.UUID  `json:"id"`
+	Kind UploadKind `json:"kind"`
+	// Имя исходного файла.
+	FileName string `json:"file_name"`
+	// MIME тип файла.
+	ContentType string `json:"content_type"`
+	// Размер файла, байт.
+	Size int64 `json:"size"`
+	// Размер части, байт. последняя часть может быть меньше.
+	PartSize int64 `json:"part_size"`
+	// Количество частей.
+	PartsCount int `json:"parts_count"`
+	// Ожидаемая sha256 файла в hex, сверяется при обработке
+	// собранного файла. После обработки - sha256 файла с
+	// очищенными метаданными.
+	SHA256 OptString    `json:"sha256"`
+	Status UploadStatus `json:"status"`
+	// Причина отмены, если файл не прошел проверку.
+	FailReason OptString `json:"fail_reason"`
+	CreateAt   time.Time `json:"create_at"`
+	// После этого времени незавершенную загрузку нельзя
+	// продолжить.
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
-// GetTirads4 returns the value of Tirads4.
-func (s *Segment) GetTirads4() float64 {
-	return s.Tirads4
+// GetID returns the value of ID.
+func (s *Upload) GetID() uuid.UUID {
+	return s.ID
 }
 
-// GetTirads5 returns the value of Tirads5.
-func (s *Segment) GetTirads5() float64 {
-	return s.Tirads5
+// GetKind returns the value of Kind.
+func (s *Upload) GetKind() UploadKind {
+	return s.Kind
 }
 
-// SetID sets the value of ID.
-func (s *Segment) SetID(val uuid.UUID) {
-	s.ID = val
+// GetFileName returns the value of FileName.
+func (s *Upload) GetFileName() string {
+	return s.FileName
 }
 
-// SetImageID sets the value of ImageID.
-func (s *Segment) SetImageID(val uuid.UUID) {
-	s.ImageID = val
+// GetContentType returns the value of ContentType.
+func (s *Upload) GetContentType() string {
+	return s.ContentType
 }
 
-// SetNodeID sets the value of NodeID.
-func (s *Segment) SetNodeID(val uuid.UUID) {
-	s.NodeID = val
+// GetSize returns the value of Size.
+func (s *Upload) GetSize() int64 {
+	return s.Size
 }
 
-// SetContor sets the value of Contor.
-func (s *Segment) SetContor(val Contor) {
-	s.Contor = val
+// GetPartSize returns the value of PartSize.
+func (s *Upload) GetPartSize() int64 {
+	return s.PartSize
 }
 
-// SetAi sets the value of Ai.
-func (s *Segment) SetAi(val bool) {
-	s.Ai = val
+// GetPartsCount returns the value of PartsCount.
+func (s *Upload) GetPartsCount() int {
+	return s.PartsCount
 }
 
-// SetTirads23 sets the value of Tirads23.
-func (s *Segment) SetTirads23(val float64) {
-	s.Tirads23 = val
+// GetSHA256 returns the value of SHA256.
+func (s *Upload) GetSHA256() OptString {
+	return s.SHA256
 }
 
-// SetTirads4 sets the value of Tirads4.
-func (s *Segment) SetTirads4(val float64) {
-	s.Tirads4 = val
+// GetStatus returns the value of Status.
+func (s *Upload) GetStatus() UploadStatus {
+	return s.Status
 }
 
-// SetTirads5 sets the value of Tirads5.
-func (s *Segment) SetTirads5(val float64) {
-	s.Tirads5 = val
+// GetFailReason returns the value of FailReason.
+func (s *Upload) GetFailReason() OptString {
+	return s.FailReason
 }
 
-func (*Segment) uziSegmentIDPatchRes() {}
-
-// Uuid.
-// Ref: #/components/schemas/simpleUuid
-type SimpleUuid struct {
-	ID uuid.UUID `json:"id"`
+// GetCreateAt returns the value of CreateAt.
+func (s *Upload) GetCreateAt() time.Time {
+	return s.CreateAt
 }
 
-// GetID returns the value of ID.
-func (s *SimpleUuid) GetID() uuid.UUID {
-	return s.ID
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *Upload) GetExpiresAt() time.Time {
+	return s.ExpiresAt
 }
 
 // SetID sets the value of ID.
-func (s *SimpleUuid) SetID(val uuid.UUID) {
+func (s *Upload) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
-func (*SimpleUuid) medPatientPostRes() {}
-func (*SimpleUuid) regDoctorPostRes()  {}
-func (*SimpleUuid) regPatientPostRes() {}
-func (*SimpleUuid) uziPostRes()        {}
-func (*SimpleUuid) uziSegmentPostRes() {}
-
-// Подписка.
-// Ref: #/components/schemas/Subscription
-type Subscription struct {
-	// Идентификатор подписки.
-	ID uuid.UUID `json:"id"`
-	// Идентификатор тарифного плана.
-	TariffPlanID uuid.UUID `json:"tariff_plan_id"`
-	// Статус подписки.
-	Status SubscriptionStatus `json:"status"`
-	// Дата начала подписки.
-	StartDate time.Time `json:"start_date"`
-	// Дата окончания подписки.
-	EndDate time.Time `json:"end_date"`
-}
-
-// GetID returns the value of ID.
-func (s *Subscription) GetID() uuid.UUID {
-	return s.ID
+// SetKind sets the value of Kind.
+func (s *Upload) SetKind(val UploadKind) {
+	s.Kind = val
 }
 
-// GetTariffPlanID returns the value of TariffPlanID.
-func (s *Subscription) GetTariffPlanID() uuid.UUID {
-	return s.TariffPlanID
+// SetFileName sets the value of FileName.
+func (s *Upload) SetFileName(val string) {
+	s.FileName = val
 }
 
-// GetStatus returns the value of Status.
-func (s *Subscription) GetStatus() SubscriptionStatus {
-	return s.Status
+// SetContentType sets the value of ContentType.
+func (s *Upload) SetContentType(val string) {
+	s.ContentType = val
 }
 
-// GetStartDate returns the value of StartDate.
-func (s *Subscription) GetStartDate() time.Time {
-	return s.StartDate
+// SetSize sets the value of Size.
+func (s *Upload) SetSize(val int64) {
+	s.Size = val
 }
 
-// GetEndDate returns the value of EndDate.
-func (s *Subscription) GetEndDate() time.Time {
-	return s.EndDate
+// SetPartSize sets the value of PartSize.
+func (s *Upload) SetPartSize(val int64) {
+	s.PartSize = val
 }
 
-// SetID sets the value of ID.
-func (s *Subscription) SetID(val uuid.UUID) {
-	s.ID = val
+// SetPartsCount sets the value of PartsCount.
+func (s *Upload) SetPartsCount(val int) {
+	s.PartsCount = val
 }
 
-// SetTariffPlanID sets the value of TariffPlanID.
-func (s *Subscription) SetTariffPlanID(val uuid.UUID) {
-	s.TariffPlanID = val
+// SetSHA256 sets the value of SHA256.
+func (s *Upload) SetSHA256(val OptString) {
+	s.SHA256 = val
 }
 
 // SetStatus sets the value of Status.
-func (s *Subscription) SetStatus(val SubscriptionStatus) {
+func (s *Upload) SetStatus(val UploadStatus) {
 	s.Status = val
 }
 
-// SetStartDate sets the value of StartDate.
-func (s *Subscription) SetStartDate(val time.Time) {
-	s.StartDate = val
+// SetFailReason sets the value of FailReason.
+func (s *Upload) SetFailReason(val OptString) {
+	s.FailReason = val
 }
 
-// SetEndDate sets the value of EndDate.
-func (s *Subscription) SetEndDate(val time.Time) {
-	s.EndDate = val
+// SetCreateAt sets the value of CreateAt.
+func (s *Upload) SetCreateAt(val time.Time) {
+	s.CreateAt = val
 }
 
-func (*Subscription) subscriptionsGetActiveGetRes() {}
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *Upload) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
 
-// Статус подписки.
-type SubscriptionStatus string
+func (*Upload) uploadsIDCompletePostRes() {}
+func (*Upload) uploadsPostRes()           {}
+
+// Назначение загрузки, определяет куда ее можно
+// передать.
+// Ref: #/components/schemas/upload_kind
+type UploadKind string
 
 const (
-	SubscriptionStatusPendingPayment SubscriptionStatus = "pending_payment"
-	SubscriptionStatusActive         SubscriptionStatus = "active"
-	SubscriptionStatusCancelled      SubscriptionStatus = "cancelled"
+	UploadKindUzi      UploadKind = "uzi"
+	UploadKindCytology UploadKind = "cytology"
 )
 
-// AllValues returns all SubscriptionStatus values.
-func (SubscriptionStatus) AllValues() []SubscriptionStatus {
-	return []SubscriptionStatus{
-		SubscriptionStatusPendingPayment,
-		SubscriptionStatusActive,
-		SubscriptionStatusCancelled,
+// AllValues returns all UploadKind values.
+func (UploadKind) AllValues() []UploadKind {
+	return []UploadKind{
+		UploadKindUzi,
+		UploadKindCytology,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s SubscriptionStatus) MarshalText() ([]byte, error) {
+func (s UploadKind) MarshalText() ([]byte, error) {
 	switch s {
-	case SubscriptionStatusPendingPayment:
-		return []byte(s), nil
-	case SubscriptionStatusActive:
+	case UploadKindUzi:
 		return []byte(s), nil
-	case SubscriptionStatusCancelled:
+	case UploadKindCytology:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -7746,241 +13023,366 @@ func (s SubscriptionStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *SubscriptionStatus) UnmarshalText(data []byte) error {
-	switch SubscriptionStatus(data) {
-	case SubscriptionStatusPendingPayment:
-		*s = SubscriptionStatusPendingPayment
-		return nil
-	case SubscriptionStatusActive:
-		*s = SubscriptionStatusActive
+func (s *UploadKind) UnmarshalText(data []byte) error {
+	switch UploadKind(data) {
+	case UploadKindUzi:
+		*s = UploadKindUzi
 		return nil
-	case SubscriptionStatusCancelled:
-		*s = SubscriptionStatusCancelled
+	case UploadKindCytology:
+		*s = UploadKindCytology
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type SubscriptionsCheckActiveGetOK struct {
-	HasActiveSubscription OptBool `json:"has_active_subscription"`
+// Загруженная часть.
+// Ref: #/components/schemas/upload_part
+type UploadPart struct {
+	// Номер части, с 1.
+	Number int    `json:"number"`
+	Size   int64  `json:"size"`
+	Etag   string `json:"etag"`
 }
 
-// GetHasActiveSubscription returns the value of HasActiveSubscription.
-func (s *SubscriptionsCheckActiveGetOK) GetHasActiveSubscription() OptBool {
-	return s.HasActiveSubscription
+// GetNumber returns the value of Number.
+func (s *UploadPart) GetNumber() int {
+	return s.Number
 }
 
-// SetHasActiveSubscription sets the value of HasActiveSubscription.
-func (s *SubscriptionsCheckActiveGetOK) SetHasActiveSubscription(val OptBool) {
-	s.HasActiveSubscription = val
+// GetSize returns the value of Size.
+func (s *UploadPart) GetSize() int64 {
+	return s.Size
 }
 
-func (*SubscriptionsCheckActiveGetOK) subscriptionsCheckActiveGetRes() {}
-
-type SubscriptionsGetActiveGetInternalServerError ErrorStatusCode
-
-func (*SubscriptionsGetActiveGetInternalServerError) subscriptionsGetActiveGetRes() {}
-
-type SubscriptionsGetActiveGetNotFound ErrorStatusCode
+// GetEtag returns the value of Etag.
+func (s *UploadPart) GetEtag() string {
+	return s.Etag
+}
 
-func (*SubscriptionsGetActiveGetNotFound) subscriptionsGetActiveGetRes() {}
+// SetNumber sets the value of Number.
+func (s *UploadPart) SetNumber(val int) {
+	s.Number = val
+}
 
-// Тарифный план.
-// Ref: #/components/schemas/TariffPlan
-type TariffPlan struct {
-	// Идентификатор тарифного плана.
-	ID uuid.UUID `json:"id"`
-	// Название тарифного плана.
-	Name string `json:"name"`
-	// Описание тарифного плана.
-	Description string `json:"description"`
-	// Цена тарифного плана.
-	Price string `json:"price"`
-	// Длительность тарифного плана в наносекундах.
-	Duration int `json:"duration"`
+// SetSize sets the value of Size.
+func (s *UploadPart) SetSize(val int64) {
+	s.Size = val
 }
 
-// GetID returns the value of ID.
-func (s *TariffPlan) GetID() uuid.UUID {
-	return s.ID
+// SetEtag sets the value of Etag.
+func (s *UploadPart) SetEtag(val string) {
+	s.Etag = val
 }
 
-// GetName returns the value of Name.
-func (s *TariffPlan) GetName() string {
-	return s.Name
+// Presigned url для загрузки части PUT запросом с телом части.
+// Ref: #/components/schemas/upload_part_url
+type UploadPartURL struct {
+	// Номер части, с 1.
+	Number int `json:"number"`
+	// Presigned url S3.
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
-// GetDescription returns the value of Description.
-func (s *TariffPlan) GetDescription() string {
-	return s.Description
+// GetNumber returns the value of Number.
+func (s *UploadPartURL) GetNumber() int {
+	return s.Number
 }
 
-// GetPrice returns the value of Price.
-func (s *TariffPlan) GetPrice() string {
-	return s.Price
+// GetURL returns the value of URL.
+func (s *UploadPartURL) GetURL() string {
+	return s.URL
 }
 
-// GetDuration returns the value of Duration.
-func (s *TariffPlan) GetDuration() int {
-	return s.Duration
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *UploadPartURL) GetExpiresAt() time.Time {
+	return s.ExpiresAt
 }
 
-// SetID sets the value of ID.
-func (s *TariffPlan) SetID(val uuid.UUID) {
-	s.ID = val
+// SetNumber sets the value of Number.
+func (s *UploadPartURL) SetNumber(val int) {
+	s.Number = val
 }
 
-// SetName sets the value of Name.
-func (s *TariffPlan) SetName(val string) {
-	s.Name = val
+// SetURL sets the value of URL.
+func (s *UploadPartURL) SetURL(val string) {
+	s.URL = val
 }
 
-// SetDescription sets the value of Description.
-func (s *TariffPlan) SetDescription(val string) {
-	s.Description = val
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *UploadPartURL) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
 }
 
-// SetPrice sets the value of Price.
-func (s *TariffPlan) SetPrice(val string) {
-	s.Price = val
+// Состояние загрузки для возобновления.
+// Ref: #/components/schemas/upload_progress
+type UploadProgress struct {
+	Upload Upload `json:"upload"`
+	// Сколько байт уже загружено.
+	UploadedBytes int64 `json:"uploaded_bytes"`
+	// Загруженные части.
+	Parts []UploadPart `json:"parts"`
 }
 
-// SetDuration sets the value of Duration.
-func (s *TariffPlan) SetDuration(val int) {
-	s.Duration = val
+// GetUpload returns the value of Upload.
+func (s *UploadProgress) GetUpload() Upload {
+	return s.Upload
 }
 
-func (*TariffPlan) tariffPlansIDGetRes() {}
+// GetUploadedBytes returns the value of UploadedBytes.
+func (s *UploadProgress) GetUploadedBytes() int64 {
+	return s.UploadedBytes
+}
 
-type TariffPlansGetOKApplicationJSON []TariffPlan
+// GetParts returns the value of Parts.
+func (s *UploadProgress) GetParts() []UploadPart {
+	return s.Parts
+}
 
-func (*TariffPlansGetOKApplicationJSON) tariffPlansGetRes() {}
+// SetUpload sets the value of Upload.
+func (s *UploadProgress) SetUpload(val Upload) {
+	s.Upload = val
+}
 
-type TilerDziFilePathFilesLevelColRowFormatGetBadRequest ErrorStatusCode
+// SetUploadedBytes sets the value of UploadedBytes.
+func (s *UploadProgress) SetUploadedBytes(val int64) {
+	s.UploadedBytes = val
+}
 
-func (*TilerDziFilePathFilesLevelColRowFormatGetBadRequest) tilerDziFilePathFilesLevelColRowFormatGetRes() {
+// SetParts sets the value of Parts.
+func (s *UploadProgress) SetParts(val []UploadPart) {
+	s.Parts = val
 }
 
-type TilerDziFilePathFilesLevelColRowFormatGetFormat string
+func (*UploadProgress) uploadsIDGetRes() {}
+
+// Pending - части загружаются, processing - файл собран, sha256
+// сверяется и метаданные очищаются,
+// completed - файл проверен, claimed - файл передан в
+// узи/цитологию,
+// aborted - загрузка отменена или файл не прошел проверку.
+// Ref: #/components/schemas/upload_status
+type UploadStatus string
 
 const (
-	TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg TilerDziFilePathFilesLevelColRowFormatGetFormat = "jpeg"
-	TilerDziFilePathFilesLevelColRowFormatGetFormatJPG  TilerDziFilePathFilesLevelColRowFormatGetFormat = "jpg"
-	TilerDziFilePathFilesLevelColRowFormatGetFormatPNG  TilerDziFilePathFilesLevelColRowFormatGetFormat = "png"
+	UploadStatusPending    UploadStatus = "pending"
+	UploadStatusProcessing UploadStatus = "processing"
+	UploadStatusCompleted  UploadStatus = "completed"
+	UploadStatusClaimed    UploadStatus = "claimed"
+	UploadStatusAborted    UploadStatus = "aborted"
 )
 
-// AllValues returns all TilerDziFilePathFilesLevelColRowFormatGetFormat values.
-func (TilerDziFilePathFilesLevelColRowFormatGetFormat) AllValues() []TilerDziFilePathFilesLevelColRowFormatGetFormat {
-	return []TilerDziFilePathFilesLevelColRowFormatGetFormat{
-		TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg,
-		TilerDziFilePathFilesLevelColRowFormatGetFormatJPG,
-		TilerDziFilePathFilesLevelColRowFormatGetFormatPNG,
+// AllValues returns all UploadStatus values.
+func (UploadStatus) AllValues() []UploadStatus {
+	return []UploadStatus{
+		UploadStatusPending,
+		UploadStatusProcessing,
+		UploadStatusCompleted,
+		UploadStatusClaimed,
+		UploadStatusAborted,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s TilerDziFilePathFilesLevelColRowFormatGetFormat) MarshalText() ([]byte, error) {
+func (s UploadStatus) MarshalText() ([]byte, error) {
 	switch s {
-	case TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg:
+	case UploadStatusPending:
 		return []byte(s), nil
-	case TilerDziFilePathFilesLevelColRowFormatGetFormatJPG:
+	case UploadStatusProcessing:
 		return []byte(s), nil
-	case TilerDziFilePathFilesLevelColRowFormatGetFormatPNG:
+	case UploadStatusCompleted:
+		return []byte(s), nil
+	case UploadStatusClaimed:
+		return []byte(s), nil
+	case UploadStatusAborted:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TilerDziFilePathFilesLevelColRowFormatGetFormat) UnmarshalText(data []byte) error {
-	switch TilerDziFilePathFilesLevelColRowFormatGetFormat(data) {
-	case TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg:
-		*s = TilerDziFilePathFilesLevelColRowFormatGetFormatJpeg
-		return nil
-	case TilerDziFilePathFilesLevelColRowFormatGetFormatJPG:
-		*s = TilerDziFilePathFilesLevelColRowFormatGetFormatJPG
-		return nil
-	case TilerDziFilePathFilesLevelColRowFormatGetFormatPNG:
-		*s = TilerDziFilePathFilesLevelColRowFormatGetFormatPNG
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
-}
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UploadStatus) UnmarshalText(data []byte) error {
+	switch UploadStatus(data) {
+	case UploadStatusPending:
+		*s = UploadStatusPending
+		return nil
+	case UploadStatusProcessing:
+		*s = UploadStatusProcessing
+		return nil
+	case UploadStatusCompleted:
+		*s = UploadStatusCompleted
+		return nil
+	case UploadStatusClaimed:
+		*s = UploadStatusClaimed
+		return nil
+	case UploadStatusAborted:
+		*s = UploadStatusAborted
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type UploadsIDCompletePostConflict ErrorStatusCode
+
+func (*UploadsIDCompletePostConflict) uploadsIDCompletePostRes() {}
+
+type UploadsIDCompletePostInternalServerError ErrorStatusCode
+
+func (*UploadsIDCompletePostInternalServerError) uploadsIDCompletePostRes() {}
+
+type UploadsIDCompletePostNotFound ErrorStatusCode
+
+func (*UploadsIDCompletePostNotFound) uploadsIDCompletePostRes() {}
+
+type UploadsIDCompletePostUnprocessableEntity ErrorStatusCode
+
+func (*UploadsIDCompletePostUnprocessableEntity) uploadsIDCompletePostRes() {}
+
+type UploadsIDDeleteConflict ErrorStatusCode
+
+func (*UploadsIDDeleteConflict) uploadsIDDeleteRes() {}
+
+type UploadsIDDeleteInternalServerError ErrorStatusCode
+
+func (*UploadsIDDeleteInternalServerError) uploadsIDDeleteRes() {}
+
+type UploadsIDDeleteNotFound ErrorStatusCode
+
+func (*UploadsIDDeleteNotFound) uploadsIDDeleteRes() {}
+
+// UploadsIDDeleteOK is response for UploadsIDDelete operation.
+type UploadsIDDeleteOK struct{}
+
+func (*UploadsIDDeleteOK) uploadsIDDeleteRes() {}
+
+type UploadsIDGetInternalServerError ErrorStatusCode
+
+func (*UploadsIDGetInternalServerError) uploadsIDGetRes() {}
+
+type UploadsIDGetNotFound ErrorStatusCode
+
+func (*UploadsIDGetNotFound) uploadsIDGetRes() {}
 
-type TilerDziFilePathFilesLevelColRowFormatGetInternalServerError ErrorStatusCode
+type UploadsIDPartsPostBadRequest ErrorStatusCode
 
-func (*TilerDziFilePathFilesLevelColRowFormatGetInternalServerError) tilerDziFilePathFilesLevelColRowFormatGetRes() {
-}
+func (*UploadsIDPartsPostBadRequest) uploadsIDPartsPostRes() {}
 
-type TilerDziFilePathFilesLevelColRowFormatGetNotFound ErrorStatusCode
+type UploadsIDPartsPostConflict ErrorStatusCode
 
-func (*TilerDziFilePathFilesLevelColRowFormatGetNotFound) tilerDziFilePathFilesLevelColRowFormatGetRes() {
+func (*UploadsIDPartsPostConflict) uploadsIDPartsPostRes() {}
+
+type UploadsIDPartsPostInternalServerError ErrorStatusCode
+
+func (*UploadsIDPartsPostInternalServerError) uploadsIDPartsPostRes() {}
+
+type UploadsIDPartsPostNotFound ErrorStatusCode
+
+func (*UploadsIDPartsPostNotFound) uploadsIDPartsPostRes() {}
+
+type UploadsIDPartsPostOKApplicationJSON []UploadPartURL
+
+func (*UploadsIDPartsPostOKApplicationJSON) uploadsIDPartsPostRes() {}
+
+type UploadsIDPartsPostReq struct {
+	// Номера частей, если не указаны - все еще не загруженные.
+	Numbers []int `json:"numbers"`
 }
 
-type TilerDziFilePathFilesLevelColRowFormatGetOKImageJpeg struct {
-	Data io.Reader
+// GetNumbers returns the value of Numbers.
+func (s *UploadsIDPartsPostReq) GetNumbers() []int {
+	return s.Numbers
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s TilerDziFilePathFilesLevelColRowFormatGetOKImageJpeg) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetNumbers sets the value of Numbers.
+func (s *UploadsIDPartsPostReq) SetNumbers(val []int) {
+	s.Numbers = val
 }
 
-func (*TilerDziFilePathFilesLevelColRowFormatGetOKImageJpeg) tilerDziFilePathFilesLevelColRowFormatGetRes() {
+type UploadsPostBadRequest ErrorStatusCode
+
+func (*UploadsPostBadRequest) uploadsPostRes() {}
+
+type UploadsPostInternalServerError ErrorStatusCode
+
+func (*UploadsPostInternalServerError) uploadsPostRes() {}
+
+type UploadsPostReq struct {
+	Kind     UploadKind `json:"kind"`
+	FileName string     `json:"file_name"`
+	// MIME тип файла.
+	ContentType string `json:"content_type"`
+	// Размер файла, байт.
+	Size int64 `json:"size"`
+	// Желаемый размер части, байт. не меньше 5MB, по умолчанию
+	// 64MB.
+	PartSize OptInt64 `json:"part_size"`
+	// Sha256 файла в hex, сверяется при обработке собранного
+	// файла.
+	SHA256 OptString `json:"sha256"`
 }
 
-type TilerDziFilePathFilesLevelColRowFormatGetOKImagePNG struct {
-	Data io.Reader
+// GetKind returns the value of Kind.
+func (s *UploadsPostReq) GetKind() UploadKind {
+	return s.Kind
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s TilerDziFilePathFilesLevelColRowFormatGetOKImagePNG) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// GetFileName returns the value of FileName.
+func (s *UploadsPostReq) GetFileName() string {
+	return s.FileName
 }
 
-func (*TilerDziFilePathFilesLevelColRowFormatGetOKImagePNG) tilerDziFilePathFilesLevelColRowFormatGetRes() {
+// GetContentType returns the value of ContentType.
+func (s *UploadsPostReq) GetContentType() string {
+	return s.ContentType
 }
 
-type TilerDziFilePathGetBadRequest ErrorStatusCode
+// GetSize returns the value of Size.
+func (s *UploadsPostReq) GetSize() int64 {
+	return s.Size
+}
 
-func (*TilerDziFilePathGetBadRequest) tilerDziFilePathGetRes() {}
+// GetPartSize returns the value of PartSize.
+func (s *UploadsPostReq) GetPartSize() OptInt64 {
+	return s.PartSize
+}
 
-type TilerDziFilePathGetInternalServerError ErrorStatusCode
+// GetSHA256 returns the value of SHA256.
+func (s *UploadsPostReq) GetSHA256() OptString {
+	return s.SHA256
+}
 
-func (*TilerDziFilePathGetInternalServerError) tilerDziFilePathGetRes() {}
+// SetKind sets the value of Kind.
+func (s *UploadsPostReq) SetKind(val UploadKind) {
+	s.Kind = val
+}
 
-type TilerDziFilePathGetNotFound ErrorStatusCode
+// SetFileName sets the value of FileName.
+func (s *UploadsPostReq) SetFileName(val string) {
+	s.FileName = val
+}
 
-func (*TilerDziFilePathGetNotFound) tilerDziFilePathGetRes() {}
+// SetContentType sets the value of ContentType.
+func (s *UploadsPostReq) SetContentType(val string) {
+	s.ContentType = val
+}
 
-type TilerDziFilePathGetOK struct {
-	Data io.Reader
+// SetSize sets the value of Size.
+func (s *UploadsPostReq) SetSize(val int64) {
+	s.Size = val
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s TilerDziFilePathGetOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetPartSize sets the value of PartSize.
+func (s *UploadsPostReq) SetPartSize(val OptInt64) {
+	s.PartSize = val
 }
 
-func (*TilerDziFilePathGetOK) tilerDziFilePathGetRes() {}
+// SetSHA256 sets the value of SHA256.
+func (s *UploadsPostReq) SetSHA256(val OptString) {
+	s.SHA256 = val
+}
 
 // Узи.
 // Ref: #/components/schemas/uzi
@@ -8001,7 +13403,10 @@ type Uzi struct {
 	// нейронкой **completed** - обработано.
 	Status UziStatus `json:"status"`
 	// Дата создания в формате RFC3339.
-	CreateAt time.Time `json:"create_at"`
+	CreateAt     time.Time       `json:"create_at"`
+	PixelSpacing OptPixelSpacing `json:"pixel_spacing"`
+	// Sha256 загруженного файла в hex.
+	SHA256 OptString `json:"sha256"`
 }
 
 // GetID returns the value of ID.
@@ -8044,6 +13449,16 @@ func (s *Uzi) GetCreateAt() time.Time {
 	return s.CreateAt
 }
 
+// GetPixelSpacing returns the value of PixelSpacing.
+func (s *Uzi) GetPixelSpacing() OptPixelSpacing {
+	return s.PixelSpacing
+}
+
+// GetSHA256 returns the value of SHA256.
+func (s *Uzi) GetSHA256() OptString {
+	return s.SHA256
+}
+
 // SetID sets the value of ID.
 func (s *Uzi) SetID(val uuid.UUID) {
 	s.ID = val
@@ -8084,13 +13499,305 @@ func (s *Uzi) SetCreateAt(val time.Time) {
 	s.CreateAt = val
 }
 
+// SetPixelSpacing sets the value of PixelSpacing.
+func (s *Uzi) SetPixelSpacing(val OptPixelSpacing) {
+	s.PixelSpacing = val
+}
+
+// SetSHA256 sets the value of SHA256.
+func (s *Uzi) SetSHA256(val OptString) {
+	s.SHA256 = val
+}
+
 func (*Uzi) uziIDGetRes()   {}
 func (*Uzi) uziIDPatchRes() {}
 
+// Аналитика разметки узи выборки.
+// Ref: #/components/schemas/uzi_analytics
+type UziAnalytics struct {
+	Total     NodeMetrics         `json:"total"`
+	ByDevice  []DeviceNodeMetrics `json:"by_device"`
+	ByAuthor  []AuthorNodeMetrics `json:"by_author"`
+	ByPeriod  []PeriodNodeMetrics `json:"by_period"`
+	Agreement []ReaderAgreement   `json:"agreement"`
+	// Каппа по всем парам врачей вместе.
+	Kappa OptFloat64 `json:"kappa"`
+	// Средний IoU по всем парам врачей вместе.
+	MeanIou OptFloat64 `json:"mean_iou"`
+	// Узлы нейросети, слитые врачами с другими узлами.
+	AiMerged int `json:"ai_merged"`
+	// Узлы нейросети, разделенные врачами.
+	AiSplit int `json:"ai_split"`
+	// Узлы нейросети, принятые пакетными операциями.
+	BulkValid int `json:"bulk_valid"`
+	// Узлы нейросети, отклоненные пакетными операциями.
+	BulkInvalid int `json:"bulk_invalid"`
+}
+
+// GetTotal returns the value of Total.
+func (s *UziAnalytics) GetTotal() NodeMetrics {
+	return s.Total
+}
+
+// GetByDevice returns the value of ByDevice.
+func (s *UziAnalytics) GetByDevice() []DeviceNodeMetrics {
+	return s.ByDevice
+}
+
+// GetByAuthor returns the value of ByAuthor.
+func (s *UziAnalytics) GetByAuthor() []AuthorNodeMetrics {
+	return s.ByAuthor
+}
+
+// GetByPeriod returns the value of ByPeriod.
+func (s *UziAnalytics) GetByPeriod() []PeriodNodeMetrics {
+	return s.ByPeriod
+}
+
+// GetAgreement returns the value of Agreement.
+func (s *UziAnalytics) GetAgreement() []ReaderAgreement {
+	return s.Agreement
+}
+
+// GetKappa returns the value of Kappa.
+func (s *UziAnalytics) GetKappa() OptFloat64 {
+	return s.Kappa
+}
+
+// GetMeanIou returns the value of MeanIou.
+func (s *UziAnalytics) GetMeanIou() OptFloat64 {
+	return s.MeanIou
+}
+
+// GetAiMerged returns the value of AiMerged.
+func (s *UziAnalytics) GetAiMerged() int {
+	return s.AiMerged
+}
+
+// GetAiSplit returns the value of AiSplit.
+func (s *UziAnalytics) GetAiSplit() int {
+	return s.AiSplit
+}
+
+// GetBulkValid returns the value of BulkValid.
+func (s *UziAnalytics) GetBulkValid() int {
+	return s.BulkValid
+}
+
+// GetBulkInvalid returns the value of BulkInvalid.
+func (s *UziAnalytics) GetBulkInvalid() int {
+	return s.BulkInvalid
+}
+
+// SetTotal sets the value of Total.
+func (s *UziAnalytics) SetTotal(val NodeMetrics) {
+	s.Total = val
+}
+
+// SetByDevice sets the value of ByDevice.
+func (s *UziAnalytics) SetByDevice(val []DeviceNodeMetrics) {
+	s.ByDevice = val
+}
+
+// SetByAuthor sets the value of ByAuthor.
+func (s *UziAnalytics) SetByAuthor(val []AuthorNodeMetrics) {
+	s.ByAuthor = val
+}
+
+// SetByPeriod sets the value of ByPeriod.
+func (s *UziAnalytics) SetByPeriod(val []PeriodNodeMetrics) {
+	s.ByPeriod = val
+}
+
+// SetAgreement sets the value of Agreement.
+func (s *UziAnalytics) SetAgreement(val []ReaderAgreement) {
+	s.Agreement = val
+}
+
+// SetKappa sets the value of Kappa.
+func (s *UziAnalytics) SetKappa(val OptFloat64) {
+	s.Kappa = val
+}
+
+// SetMeanIou sets the value of MeanIou.
+func (s *UziAnalytics) SetMeanIou(val OptFloat64) {
+	s.MeanIou = val
+}
+
+// SetAiMerged sets the value of AiMerged.
+func (s *UziAnalytics) SetAiMerged(val int) {
+	s.AiMerged = val
+}
+
+// SetAiSplit sets the value of AiSplit.
+func (s *UziAnalytics) SetAiSplit(val int) {
+	s.AiSplit = val
+}
+
+// SetBulkValid sets the value of BulkValid.
+func (s *UziAnalytics) SetBulkValid(val int) {
+	s.BulkValid = val
+}
+
+// SetBulkInvalid sets the value of BulkInvalid.
+func (s *UziAnalytics) SetBulkInvalid(val int) {
+	s.BulkInvalid = val
+}
+
+func (*UziAnalytics) uzisAnalyticsGetRes() {}
+
+// Созданное узи.
+// Ref: #/components/schemas/uzi_created
+type UziCreated struct {
+	ID uuid.UUID `json:"id"`
+	// Узи пациента с тем же sha256 файла.
+	DuplicateOf []uuid.UUID `json:"duplicate_of"`
+}
+
+// GetID returns the value of ID.
+func (s *UziCreated) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetDuplicateOf returns the value of DuplicateOf.
+func (s *UziCreated) GetDuplicateOf() []uuid.UUID {
+	return s.DuplicateOf
+}
+
+// SetID sets the value of ID.
+func (s *UziCreated) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetDuplicateOf sets the value of DuplicateOf.
+func (s *UziCreated) SetDuplicateOf(val []uuid.UUID) {
+	s.DuplicateOf = val
+}
+
+func (*UziCreated) uziPostRes() {}
+
+type UziDeviceIDDeleteConflict ErrorStatusCode
+
+func (*UziDeviceIDDeleteConflict) uziDeviceIDDeleteRes() {}
+
+type UziDeviceIDDeleteInternalServerError ErrorStatusCode
+
+func (*UziDeviceIDDeleteInternalServerError) uziDeviceIDDeleteRes() {}
+
+type UziDeviceIDDeleteNotFound ErrorStatusCode
+
+func (*UziDeviceIDDeleteNotFound) uziDeviceIDDeleteRes() {}
+
+// UziDeviceIDDeleteOK is response for UziDeviceIDDelete operation.
+type UziDeviceIDDeleteOK struct{}
+
+func (*UziDeviceIDDeleteOK) uziDeviceIDDeleteRes() {}
+
+type UziDeviceIDGetInternalServerError ErrorStatusCode
+
+func (*UziDeviceIDGetInternalServerError) uziDeviceIDGetRes() {}
+
+type UziDeviceIDGetNotFound ErrorStatusCode
+
+func (*UziDeviceIDGetNotFound) uziDeviceIDGetRes() {}
+
+type UziDeviceIDPatchBadRequest ErrorStatusCode
+
+func (*UziDeviceIDPatchBadRequest) uziDeviceIDPatchRes() {}
+
+type UziDeviceIDPatchConflict ErrorStatusCode
+
+func (*UziDeviceIDPatchConflict) uziDeviceIDPatchRes() {}
+
+type UziDeviceIDPatchInternalServerError ErrorStatusCode
+
+func (*UziDeviceIDPatchInternalServerError) uziDeviceIDPatchRes() {}
+
+type UziDeviceIDPatchNotFound ErrorStatusCode
+
+func (*UziDeviceIDPatchNotFound) uziDeviceIDPatchRes() {}
+
+type UziDeviceIDPatchReq struct {
+	Name         OptString       `json:"name"`
+	Manufacturer OptString       `json:"manufacturer"`
+	Model        OptString       `json:"model"`
+	SerialNumber OptString       `json:"serial_number"`
+	ProbeType    OptProbeType    `json:"probe_type"`
+	PixelSpacing OptPixelSpacing `json:"pixel_spacing"`
+}
+
+// GetName returns the value of Name.
+func (s *UziDeviceIDPatchReq) GetName() OptString {
+	return s.Name
+}
+
+// GetManufacturer returns the value of Manufacturer.
+func (s *UziDeviceIDPatchReq) GetManufacturer() OptString {
+	return s.Manufacturer
+}
+
+// GetModel returns the value of Model.
+func (s *UziDeviceIDPatchReq) GetModel() OptString {
+	return s.Model
+}
+
+// GetSerialNumber returns the value of SerialNumber.
+func (s *UziDeviceIDPatchReq) GetSerialNumber() OptString {
+	return s.SerialNumber
+}
+
+// GetProbeType returns the value of ProbeType.
+func (s *UziDeviceIDPatchReq) GetProbeType() OptProbeType {
+	return s.ProbeType
+}
+
+// GetPixelSpacing returns the value of PixelSpacing.
+func (s *UziDeviceIDPatchReq) GetPixelSpacing() OptPixelSpacing {
+	return s.PixelSpacing
+}
+
+// SetName sets the value of Name.
+func (s *UziDeviceIDPatchReq) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetManufacturer sets the value of Manufacturer.
+func (s *UziDeviceIDPatchReq) SetManufacturer(val OptString) {
+	s.Manufacturer = val
+}
+
+// SetModel sets the value of Model.
+func (s *UziDeviceIDPatchReq) SetModel(val OptString) {
+	s.Model = val
+}
+
+// SetSerialNumber sets the value of SerialNumber.
+func (s *UziDeviceIDPatchReq) SetSerialNumber(val OptString) {
+	s.SerialNumber = val
+}
+
+// SetProbeType sets the value of ProbeType.
+func (s *UziDeviceIDPatchReq) SetProbeType(val OptProbeType) {
+	s.ProbeType = val
+}
+
+// SetPixelSpacing sets the value of PixelSpacing.
+func (s *UziDeviceIDPatchReq) SetPixelSpacing(val OptPixelSpacing) {
+	s.PixelSpacing = val
+}
+
+type UziDeviceIDPatchUnprocessableEntity ErrorStatusCode
+
+func (*UziDeviceIDPatchUnprocessableEntity) uziDeviceIDPatchRes() {}
+
 type UziDevicePostBadRequest ErrorStatusCode
 
 func (*UziDevicePostBadRequest) uziDevicePostRes() {}
 
+type UziDevicePostConflict ErrorStatusCode
+
+func (*UziDevicePostConflict) uziDevicePostRes() {}
+
 type UziDevicePostInternalServerError ErrorStatusCode
 
 func (*UziDevicePostInternalServerError) uziDevicePostRes() {}
@@ -8114,7 +13821,12 @@ func (*UziDevicePostOK) uziDevicePostRes() {}
 
 type UziDevicePostReq struct {
 	// Именование модели узи аппарата.
-	Name string `json:"name"`
+	Name         string          `json:"name"`
+	Manufacturer OptString       `json:"manufacturer"`
+	Model        OptString       `json:"model"`
+	SerialNumber OptString       `json:"serial_number"`
+	ProbeType    OptProbeType    `json:"probe_type"`
+	PixelSpacing OptPixelSpacing `json:"pixel_spacing"`
 }
 
 // GetName returns the value of Name.
@@ -8122,18 +13834,162 @@ func (s *UziDevicePostReq) GetName() string {
 	return s.Name
 }
 
+// GetManufacturer returns the value of Manufacturer.
+func (s *UziDevicePostReq) GetManufacturer() OptString {
+	return s.Manufacturer
+}
+
+// GetModel returns the value of Model.
+func (s *UziDevicePostReq) GetModel() OptString {
+	return s.Model
+}
+
+// GetSerialNumber returns the value of SerialNumber.
+func (s *UziDevicePostReq) GetSerialNumber() OptString {
+	return s.SerialNumber
+}
+
+// GetProbeType returns the value of ProbeType.
+func (s *UziDevicePostReq) GetProbeType() OptProbeType {
+	return s.ProbeType
+}
+
+// GetPixelSpacing returns the value of PixelSpacing.
+func (s *UziDevicePostReq) GetPixelSpacing() OptPixelSpacing {
+	return s.PixelSpacing
+}
+
 // SetName sets the value of Name.
 func (s *UziDevicePostReq) SetName(val string) {
 	s.Name = val
 }
 
-type UziDevicePostUnprocessableEntity ErrorStatusCode
+// SetManufacturer sets the value of Manufacturer.
+func (s *UziDevicePostReq) SetManufacturer(val OptString) {
+	s.Manufacturer = val
+}
+
+// SetModel sets the value of Model.
+func (s *UziDevicePostReq) SetModel(val OptString) {
+	s.Model = val
+}
+
+// SetSerialNumber sets the value of SerialNumber.
+func (s *UziDevicePostReq) SetSerialNumber(val OptString) {
+	s.SerialNumber = val
+}
+
+// SetProbeType sets the value of ProbeType.
+func (s *UziDevicePostReq) SetProbeType(val OptProbeType) {
+	s.ProbeType = val
+}
+
+// SetPixelSpacing sets the value of PixelSpacing.
+func (s *UziDevicePostReq) SetPixelSpacing(val OptPixelSpacing) {
+	s.PixelSpacing = val
+}
+
+type UziDevicePostUnprocessableEntity ErrorStatusCode
+
+func (*UziDevicePostUnprocessableEntity) uziDevicePostRes() {}
+
+type UziDevicesGetOKApplicationJSON []Device
+
+func (*UziDevicesGetOKApplicationJSON) uziDevicesGetRes() {}
+
+type UziIDCheckPostBadRequest ErrorStatusCode
+
+func (*UziIDCheckPostBadRequest) uziIDCheckPostRes() {}
+
+type UziIDCheckPostInternalServerError ErrorStatusCode
+
+func (*UziIDCheckPostInternalServerError) uziIDCheckPostRes() {}
+
+type UziIDCheckPostNotFound ErrorStatusCode
+
+func (*UziIDCheckPostNotFound) uziIDCheckPostRes() {}
+
+type UziIDCheckPostOK struct {
+	Uzi   Uzi              `json:"uzi"`
+	Items []NodeReviewItem `json:"items"`
+}
+
+// GetUzi returns the value of Uzi.
+func (s *UziIDCheckPostOK) GetUzi() Uzi {
+	return s.Uzi
+}
+
+// GetItems returns the value of Items.
+func (s *UziIDCheckPostOK) GetItems() []NodeReviewItem {
+	return s.Items
+}
+
+// SetUzi sets the value of Uzi.
+func (s *UziIDCheckPostOK) SetUzi(val Uzi) {
+	s.Uzi = val
+}
+
+// SetItems sets the value of Items.
+func (s *UziIDCheckPostOK) SetItems(val []NodeReviewItem) {
+	s.Items = val
+}
+
+func (*UziIDCheckPostOK) uziIDCheckPostRes() {}
+
+type UziIDCheckPostReq struct {
+	Unvalidated OptUziIDCheckPostReqUnvalidated `json:"unvalidated"`
+}
+
+// GetUnvalidated returns the value of Unvalidated.
+func (s *UziIDCheckPostReq) GetUnvalidated() OptUziIDCheckPostReqUnvalidated {
+	return s.Unvalidated
+}
+
+// SetUnvalidated sets the value of Unvalidated.
+func (s *UziIDCheckPostReq) SetUnvalidated(val OptUziIDCheckPostReqUnvalidated) {
+	s.Unvalidated = val
+}
+
+type UziIDCheckPostReqUnvalidated string
 
-func (*UziDevicePostUnprocessableEntity) uziDevicePostRes() {}
+const (
+	UziIDCheckPostReqUnvalidatedValid   UziIDCheckPostReqUnvalidated = "valid"
+	UziIDCheckPostReqUnvalidatedInvalid UziIDCheckPostReqUnvalidated = "invalid"
+)
 
-type UziDevicesGetOKApplicationJSON []Device
+// AllValues returns all UziIDCheckPostReqUnvalidated values.
+func (UziIDCheckPostReqUnvalidated) AllValues() []UziIDCheckPostReqUnvalidated {
+	return []UziIDCheckPostReqUnvalidated{
+		UziIDCheckPostReqUnvalidatedValid,
+		UziIDCheckPostReqUnvalidatedInvalid,
+	}
+}
 
-func (*UziDevicesGetOKApplicationJSON) uziDevicesGetRes() {}
+// MarshalText implements encoding.TextMarshaler.
+func (s UziIDCheckPostReqUnvalidated) MarshalText() ([]byte, error) {
+	switch s {
+	case UziIDCheckPostReqUnvalidatedValid:
+		return []byte(s), nil
+	case UziIDCheckPostReqUnvalidatedInvalid:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UziIDCheckPostReqUnvalidated) UnmarshalText(data []byte) error {
+	switch UziIDCheckPostReqUnvalidated(data) {
+	case UziIDCheckPostReqUnvalidatedValid:
+		*s = UziIDCheckPostReqUnvalidatedValid
+		return nil
+	case UziIDCheckPostReqUnvalidatedInvalid:
+		*s = UziIDCheckPostReqUnvalidatedInvalid
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
 type UziIDDeleteInternalServerError ErrorStatusCode
 
@@ -8192,6 +14048,36 @@ type UziIDImagesGetOKApplicationJSON []Image
 
 func (*UziIDImagesGetOKApplicationJSON) uziIDImagesGetRes() {}
 
+type UziIDNodesAcceptPostBadRequest ErrorStatusCode
+
+func (*UziIDNodesAcceptPostBadRequest) uziIDNodesAcceptPostRes() {}
+
+type UziIDNodesAcceptPostInternalServerError ErrorStatusCode
+
+func (*UziIDNodesAcceptPostInternalServerError) uziIDNodesAcceptPostRes() {}
+
+type UziIDNodesAcceptPostNotFound ErrorStatusCode
+
+func (*UziIDNodesAcceptPostNotFound) uziIDNodesAcceptPostRes() {}
+
+type UziIDNodesAcceptPostOKApplicationJSON []NodeReviewItem
+
+func (*UziIDNodesAcceptPostOKApplicationJSON) uziIDNodesAcceptPostRes() {}
+
+type UziIDNodesAcceptPostReq struct {
+	Threshold float64 `json:"threshold"`
+}
+
+// GetThreshold returns the value of Threshold.
+func (s *UziIDNodesAcceptPostReq) GetThreshold() float64 {
+	return s.Threshold
+}
+
+// SetThreshold sets the value of Threshold.
+func (s *UziIDNodesAcceptPostReq) SetThreshold(val float64) {
+	s.Threshold = val
+}
+
 type UziIDNodesGetInternalServerError ErrorStatusCode
 
 func (*UziIDNodesGetInternalServerError) uziIDNodesGetRes() {}
@@ -8394,7 +14280,8 @@ type UziIDPatchReq struct {
 	// Проекция узи.
 	Projection OptUziIDPatchReqProjection `json:"projection"`
 	// Признак того, что узи проверено врачом.
-	Checked OptBool `json:"checked"`
+	Checked      OptBool         `json:"checked"`
+	PixelSpacing OptPixelSpacing `json:"pixel_spacing"`
 }
 
 // GetProjection returns the value of Projection.
@@ -8407,6 +14294,11 @@ func (s *UziIDPatchReq) GetChecked() OptBool {
 	return s.Checked
 }
 
+// GetPixelSpacing returns the value of PixelSpacing.
+func (s *UziIDPatchReq) GetPixelSpacing() OptPixelSpacing {
+	return s.PixelSpacing
+}
+
 // SetProjection sets the value of Projection.
 func (s *UziIDPatchReq) SetProjection(val OptUziIDPatchReqProjection) {
 	s.Projection = val
@@ -8417,6 +14309,11 @@ func (s *UziIDPatchReq) SetChecked(val OptBool) {
 	s.Checked = val
 }
 
+// SetPixelSpacing sets the value of PixelSpacing.
+func (s *UziIDPatchReq) SetPixelSpacing(val OptPixelSpacing) {
+	s.PixelSpacing = val
+}
+
 // Проекция узи.
 type UziIDPatchReqProjection string
 
@@ -8463,6 +14360,22 @@ type UziIDPatchUnprocessableEntity ErrorStatusCode
 
 func (*UziIDPatchUnprocessableEntity) uziIDPatchRes() {}
 
+type UziIDReportsGetOKApplicationJSON []Report
+
+func (*UziIDReportsGetOKApplicationJSON) uziIDReportsGetRes() {}
+
+type UziIDReportsPostConflict ErrorStatusCode
+
+func (*UziIDReportsPostConflict) uziIDReportsPostRes() {}
+
+type UziIDReportsPostInternalServerError ErrorStatusCode
+
+func (*UziIDReportsPostInternalServerError) uziIDReportsPostRes() {}
+
+type UziIDReportsPostNotFound ErrorStatusCode
+
+func (*UziIDReportsPostNotFound) uziIDReportsPostRes() {}
+
 type UziImageIDNodesSegmentsGetInternalServerError ErrorStatusCode
 
 func (*UziImageIDNodesSegmentsGetInternalServerError) uziImageIDNodesSegmentsGetRes() {}
@@ -8476,121 +14389,409 @@ type UziImageIDNodesSegmentsGetOK struct {
 	Segments []Segment `json:"segments"`
 }
 
-// GetNodes returns the value of Nodes.
-func (s *UziImageIDNodesSegmentsGetOK) GetNodes() []Node {
-	return s.Nodes
+// GetNodes returns the value of Nodes.
+func (s *UziImageIDNodesSegmentsGetOK) GetNodes() []Node {
+	return s.Nodes
+}
+
+// GetSegments returns the value of Segments.
+func (s *UziImageIDNodesSegmentsGetOK) GetSegments() []Segment {
+	return s.Segments
+}
+
+// SetNodes sets the value of Nodes.
+func (s *UziImageIDNodesSegmentsGetOK) SetNodes(val []Node) {
+	s.Nodes = val
+}
+
+// SetSegments sets the value of Segments.
+func (s *UziImageIDNodesSegmentsGetOK) SetSegments(val []Segment) {
+	s.Segments = val
+}
+
+func (*UziImageIDNodesSegmentsGetOK) uziImageIDNodesSegmentsGetRes() {}
+
+type UziNodesIDDeleteInternalServerError ErrorStatusCode
+
+func (*UziNodesIDDeleteInternalServerError) uziNodesIDDeleteRes() {}
+
+type UziNodesIDDeleteNotFound ErrorStatusCode
+
+func (*UziNodesIDDeleteNotFound) uziNodesIDDeleteRes() {}
+
+// UziNodesIDDeleteOK is response for UziNodesIDDelete operation.
+type UziNodesIDDeleteOK struct{}
+
+func (*UziNodesIDDeleteOK) uziNodesIDDeleteRes() {}
+
+type UziNodesIDLinkDeleteInternalServerError ErrorStatusCode
+
+func (*UziNodesIDLinkDeleteInternalServerError) uziNodesIDLinkDeleteRes() {}
+
+type UziNodesIDLinkDeleteNotFound ErrorStatusCode
+
+func (*UziNodesIDLinkDeleteNotFound) uziNodesIDLinkDeleteRes() {}
+
+// UziNodesIDLinkDeleteOK is response for UziNodesIDLinkDelete operation.
+type UziNodesIDLinkDeleteOK struct{}
+
+func (*UziNodesIDLinkDeleteOK) uziNodesIDLinkDeleteRes() {}
+
+type UziNodesIDLinkPostBadRequest ErrorStatusCode
+
+func (*UziNodesIDLinkPostBadRequest) uziNodesIDLinkPostRes() {}
+
+type UziNodesIDLinkPostConflict ErrorStatusCode
+
+func (*UziNodesIDLinkPostConflict) uziNodesIDLinkPostRes() {}
+
+type UziNodesIDLinkPostInternalServerError ErrorStatusCode
+
+func (*UziNodesIDLinkPostInternalServerError) uziNodesIDLinkPostRes() {}
+
+type UziNodesIDLinkPostNotFound ErrorStatusCode
+
+func (*UziNodesIDLinkPostNotFound) uziNodesIDLinkPostRes() {}
+
+type UziNodesIDLinkPostOK struct {
+	LineageID uuid.UUID `json:"lineage_id"`
+}
+
+// GetLineageID returns the value of LineageID.
+func (s *UziNodesIDLinkPostOK) GetLineageID() uuid.UUID {
+	return s.LineageID
+}
+
+// SetLineageID sets the value of LineageID.
+func (s *UziNodesIDLinkPostOK) SetLineageID(val uuid.UUID) {
+	s.LineageID = val
+}
+
+func (*UziNodesIDLinkPostOK) uziNodesIDLinkPostRes() {}
+
+type UziNodesIDLinkPostReq struct {
+	TargetNodeID uuid.UUID `json:"target_node_id"`
+}
+
+// GetTargetNodeID returns the value of TargetNodeID.
+func (s *UziNodesIDLinkPostReq) GetTargetNodeID() uuid.UUID {
+	return s.TargetNodeID
+}
+
+// SetTargetNodeID sets the value of TargetNodeID.
+func (s *UziNodesIDLinkPostReq) SetTargetNodeID(val uuid.UUID) {
+	s.TargetNodeID = val
+}
+
+type UziNodesIDLinkPostUnprocessableEntity ErrorStatusCode
+
+func (*UziNodesIDLinkPostUnprocessableEntity) uziNodesIDLinkPostRes() {}
+
+type UziNodesIDLinkSuggestionsGetInternalServerError ErrorStatusCode
+
+func (*UziNodesIDLinkSuggestionsGetInternalServerError) uziNodesIDLinkSuggestionsGetRes() {}
+
+type UziNodesIDLinkSuggestionsGetNotFound ErrorStatusCode
+
+func (*UziNodesIDLinkSuggestionsGetNotFound) uziNodesIDLinkSuggestionsGetRes() {}
+
+type UziNodesIDLinkSuggestionsGetOKApplicationJSON []NodeLinkSuggestion
+
+func (*UziNodesIDLinkSuggestionsGetOKApplicationJSON) uziNodesIDLinkSuggestionsGetRes() {}
+
+type UziNodesIDPatchBadRequest ErrorStatusCode
+
+func (*UziNodesIDPatchBadRequest) uziNodesIDPatchRes() {}
+
+type UziNodesIDPatchInternalServerError ErrorStatusCode
+
+func (*UziNodesIDPatchInternalServerError) uziNodesIDPatchRes() {}
+
+type UziNodesIDPatchNotFound ErrorStatusCode
+
+func (*UziNodesIDPatchNotFound) uziNodesIDPatchRes() {}
+
+type UziNodesIDPatchReq struct {
+	Validation OptNilUziNodesIDPatchReqValidation `json:"validation"`
+	Tirads23   OptFloat64                         `json:"tirads_23"`
+	Tirads4    OptFloat64                         `json:"tirads_4"`
+	Tirads5    OptFloat64                         `json:"tirads_5"`
+	Lobe       OptNodeLobe                        `json:"lobe"`
+}
+
+// GetValidation returns the value of Validation.
+func (s *UziNodesIDPatchReq) GetValidation() OptNilUziNodesIDPatchReqValidation {
+	return s.Validation
+}
+
+// GetTirads23 returns the value of Tirads23.
+func (s *UziNodesIDPatchReq) GetTirads23() OptFloat64 {
+	return s.Tirads23
+}
+
+// GetTirads4 returns the value of Tirads4.
+func (s *UziNodesIDPatchReq) GetTirads4() OptFloat64 {
+	return s.Tirads4
+}
+
+// GetTirads5 returns the value of Tirads5.
+func (s *UziNodesIDPatchReq) GetTirads5() OptFloat64 {
+	return s.Tirads5
+}
+
+// GetLobe returns the value of Lobe.
+func (s *UziNodesIDPatchReq) GetLobe() OptNodeLobe {
+	return s.Lobe
+}
+
+// SetValidation sets the value of Validation.
+func (s *UziNodesIDPatchReq) SetValidation(val OptNilUziNodesIDPatchReqValidation) {
+	s.Validation = val
+}
+
+// SetTirads23 sets the value of Tirads23.
+func (s *UziNodesIDPatchReq) SetTirads23(val OptFloat64) {
+	s.Tirads23 = val
+}
+
+// SetTirads4 sets the value of Tirads4.
+func (s *UziNodesIDPatchReq) SetTirads4(val OptFloat64) {
+	s.Tirads4 = val
+}
+
+// SetTirads5 sets the value of Tirads5.
+func (s *UziNodesIDPatchReq) SetTirads5(val OptFloat64) {
+	s.Tirads5 = val
+}
+
+// SetLobe sets the value of Lobe.
+func (s *UziNodesIDPatchReq) SetLobe(val OptNodeLobe) {
+	s.Lobe = val
+}
+
+type UziNodesIDPatchReqValidation string
+
+const (
+	UziNodesIDPatchReqValidationInvalid UziNodesIDPatchReqValidation = "invalid"
+	UziNodesIDPatchReqValidationValid   UziNodesIDPatchReqValidation = "valid"
+)
+
+// AllValues returns all UziNodesIDPatchReqValidation values.
+func (UziNodesIDPatchReqValidation) AllValues() []UziNodesIDPatchReqValidation {
+	return []UziNodesIDPatchReqValidation{
+		UziNodesIDPatchReqValidationInvalid,
+		UziNodesIDPatchReqValidationValid,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UziNodesIDPatchReqValidation) MarshalText() ([]byte, error) {
+	switch s {
+	case UziNodesIDPatchReqValidationInvalid:
+		return []byte(s), nil
+	case UziNodesIDPatchReqValidationValid:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UziNodesIDPatchReqValidation) UnmarshalText(data []byte) error {
+	switch UziNodesIDPatchReqValidation(data) {
+	case UziNodesIDPatchReqValidationInvalid:
+		*s = UziNodesIDPatchReqValidationInvalid
+		return nil
+	case UziNodesIDPatchReqValidationValid:
+		*s = UziNodesIDPatchReqValidationValid
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type UziNodesIDPatchUnprocessableEntity ErrorStatusCode
+
+func (*UziNodesIDPatchUnprocessableEntity) uziNodesIDPatchRes() {}
+
+type UziNodesIDSegmentDraftsGetOKApplicationJSON []SegmentDraft
+
+func (*UziNodesIDSegmentDraftsGetOKApplicationJSON) uziNodesIDSegmentDraftsGetRes() {}
+
+type UziNodesIDSegmentsGetInternalServerError ErrorStatusCode
+
+func (*UziNodesIDSegmentsGetInternalServerError) uziNodesIDSegmentsGetRes() {}
+
+type UziNodesIDSegmentsGetNotFound ErrorStatusCode
+
+func (*UziNodesIDSegmentsGetNotFound) uziNodesIDSegmentsGetRes() {}
+
+type UziNodesIDSegmentsGetOKApplicationJSON []Segment
+
+func (*UziNodesIDSegmentsGetOKApplicationJSON) uziNodesIDSegmentsGetRes() {}
+
+type UziNodesIDSplitPostBadRequest ErrorStatusCode
+
+func (*UziNodesIDSplitPostBadRequest) uziNodesIDSplitPostRes() {}
+
+type UziNodesIDSplitPostInternalServerError ErrorStatusCode
+
+func (*UziNodesIDSplitPostInternalServerError) uziNodesIDSplitPostRes() {}
+
+type UziNodesIDSplitPostNotFound ErrorStatusCode
+
+func (*UziNodesIDSplitPostNotFound) uziNodesIDSplitPostRes() {}
+
+type UziNodesIDSplitPostOK struct {
+	Node    Node `json:"node"`
+	NewNode Node `json:"new_node"`
+}
+
+// GetNode returns the value of Node.
+func (s *UziNodesIDSplitPostOK) GetNode() Node {
+	return s.Node
+}
+
+// GetNewNode returns the value of NewNode.
+func (s *UziNodesIDSplitPostOK) GetNewNode() Node {
+	return s.NewNode
+}
+
+// SetNode sets the value of Node.
+func (s *UziNodesIDSplitPostOK) SetNode(val Node) {
+	s.Node = val
 }
 
-// GetSegments returns the value of Segments.
-func (s *UziImageIDNodesSegmentsGetOK) GetSegments() []Segment {
-	return s.Segments
+// SetNewNode sets the value of NewNode.
+func (s *UziNodesIDSplitPostOK) SetNewNode(val Node) {
+	s.NewNode = val
 }
 
-// SetNodes sets the value of Nodes.
-func (s *UziImageIDNodesSegmentsGetOK) SetNodes(val []Node) {
-	s.Nodes = val
+func (*UziNodesIDSplitPostOK) uziNodesIDSplitPostRes() {}
+
+type UziNodesIDSplitPostReq struct {
+	// Сегменты, которые уходят в новый узел.
+	SegmentIds []uuid.UUID `json:"segment_ids"`
 }
 
-// SetSegments sets the value of Segments.
-func (s *UziImageIDNodesSegmentsGetOK) SetSegments(val []Segment) {
-	s.Segments = val
+// GetSegmentIds returns the value of SegmentIds.
+func (s *UziNodesIDSplitPostReq) GetSegmentIds() []uuid.UUID {
+	return s.SegmentIds
 }
 
-func (*UziImageIDNodesSegmentsGetOK) uziImageIDNodesSegmentsGetRes() {}
+// SetSegmentIds sets the value of SegmentIds.
+func (s *UziNodesIDSplitPostReq) SetSegmentIds(val []uuid.UUID) {
+	s.SegmentIds = val
+}
 
-type UziNodesIDDeleteInternalServerError ErrorStatusCode
+type UziNodesIDTiradsGetInternalServerError ErrorStatusCode
 
-func (*UziNodesIDDeleteInternalServerError) uziNodesIDDeleteRes() {}
+func (*UziNodesIDTiradsGetInternalServerError) uziNodesIDTiradsGetRes() {}
 
-type UziNodesIDDeleteNotFound ErrorStatusCode
+type UziNodesIDTiradsGetNotFound ErrorStatusCode
 
-func (*UziNodesIDDeleteNotFound) uziNodesIDDeleteRes() {}
+func (*UziNodesIDTiradsGetNotFound) uziNodesIDTiradsGetRes() {}
 
-// UziNodesIDDeleteOK is response for UziNodesIDDelete operation.
-type UziNodesIDDeleteOK struct{}
+type UziNodesIDTiradsPutBadRequest ErrorStatusCode
 
-func (*UziNodesIDDeleteOK) uziNodesIDDeleteRes() {}
+func (*UziNodesIDTiradsPutBadRequest) uziNodesIDTiradsPutRes() {}
 
-type UziNodesIDPatchBadRequest ErrorStatusCode
+type UziNodesIDTiradsPutInternalServerError ErrorStatusCode
 
-func (*UziNodesIDPatchBadRequest) uziNodesIDPatchRes() {}
+func (*UziNodesIDTiradsPutInternalServerError) uziNodesIDTiradsPutRes() {}
 
-type UziNodesIDPatchInternalServerError ErrorStatusCode
+type UziNodesIDTiradsPutNotFound ErrorStatusCode
 
-func (*UziNodesIDPatchInternalServerError) uziNodesIDPatchRes() {}
+func (*UziNodesIDTiradsPutNotFound) uziNodesIDTiradsPutRes() {}
 
-type UziNodesIDPatchNotFound ErrorStatusCode
+type UziNodesIDTiradsPutUnprocessableEntity ErrorStatusCode
 
-func (*UziNodesIDPatchNotFound) uziNodesIDPatchRes() {}
+func (*UziNodesIDTiradsPutUnprocessableEntity) uziNodesIDTiradsPutRes() {}
 
-type UziNodesIDPatchReq struct {
-	Validation OptNilUziNodesIDPatchReqValidation `json:"validation"`
-	Tirads23   OptFloat64                         `json:"tirads_23"`
-	Tirads4    OptFloat64                         `json:"tirads_4"`
-	Tirads5    OptFloat64                         `json:"tirads_5"`
-}
+type UziNodesMergePostBadRequest ErrorStatusCode
 
-// GetValidation returns the value of Validation.
-func (s *UziNodesIDPatchReq) GetValidation() OptNilUziNodesIDPatchReqValidation {
-	return s.Validation
+func (*UziNodesMergePostBadRequest) uziNodesMergePostRes() {}
+
+type UziNodesMergePostInternalServerError ErrorStatusCode
+
+func (*UziNodesMergePostInternalServerError) uziNodesMergePostRes() {}
+
+type UziNodesMergePostNotFound ErrorStatusCode
+
+func (*UziNodesMergePostNotFound) uziNodesMergePostRes() {}
+
+type UziNodesMergePostReq struct {
+	NodeIds []uuid.UUID `json:"node_ids"`
 }
 
-// GetTirads23 returns the value of Tirads23.
-func (s *UziNodesIDPatchReq) GetTirads23() OptFloat64 {
-	return s.Tirads23
+// GetNodeIds returns the value of NodeIds.
+func (s *UziNodesMergePostReq) GetNodeIds() []uuid.UUID {
+	return s.NodeIds
 }
 
-// GetTirads4 returns the value of Tirads4.
-func (s *UziNodesIDPatchReq) GetTirads4() OptFloat64 {
-	return s.Tirads4
+// SetNodeIds sets the value of NodeIds.
+func (s *UziNodesMergePostReq) SetNodeIds(val []uuid.UUID) {
+	s.NodeIds = val
 }
 
-// GetTirads5 returns the value of Tirads5.
-func (s *UziNodesIDPatchReq) GetTirads5() OptFloat64 {
-	return s.Tirads5
+type UziNodesReviewPostBadRequest ErrorStatusCode
+
+func (*UziNodesReviewPostBadRequest) uziNodesReviewPostRes() {}
+
+type UziNodesReviewPostInternalServerError ErrorStatusCode
+
+func (*UziNodesReviewPostInternalServerError) uziNodesReviewPostRes() {}
+
+type UziNodesReviewPostOKApplicationJSON []NodeReviewItem
+
+func (*UziNodesReviewPostOKApplicationJSON) uziNodesReviewPostRes() {}
+
+type UziNodesReviewPostReq struct {
+	NodeIds    []uuid.UUID                     `json:"node_ids"`
+	Validation UziNodesReviewPostReqValidation `json:"validation"`
 }
 
-// SetValidation sets the value of Validation.
-func (s *UziNodesIDPatchReq) SetValidation(val OptNilUziNodesIDPatchReqValidation) {
-	s.Validation = val
+// GetNodeIds returns the value of NodeIds.
+func (s *UziNodesReviewPostReq) GetNodeIds() []uuid.UUID {
+	return s.NodeIds
 }
 
-// SetTirads23 sets the value of Tirads23.
-func (s *UziNodesIDPatchReq) SetTirads23(val OptFloat64) {
-	s.Tirads23 = val
+// GetValidation returns the value of Validation.
+func (s *UziNodesReviewPostReq) GetValidation() UziNodesReviewPostReqValidation {
+	return s.Validation
 }
 
-// SetTirads4 sets the value of Tirads4.
-func (s *UziNodesIDPatchReq) SetTirads4(val OptFloat64) {
-	s.Tirads4 = val
+// SetNodeIds sets the value of NodeIds.
+func (s *UziNodesReviewPostReq) SetNodeIds(val []uuid.UUID) {
+	s.NodeIds = val
 }
 
-// SetTirads5 sets the value of Tirads5.
-func (s *UziNodesIDPatchReq) SetTirads5(val OptFloat64) {
-	s.Tirads5 = val
+// SetValidation sets the value of Validation.
+func (s *UziNodesReviewPostReq) SetValidation(val UziNodesReviewPostReqValidation) {
+	s.Validation = val
 }
 
-type UziNodesIDPatchReqValidation string
+type UziNodesReviewPostReqValidation string
 
 const (
-	UziNodesIDPatchReqValidationInvalid UziNodesIDPatchReqValidation = "invalid"
-	UziNodesIDPatchReqValidationValid   UziNodesIDPatchReqValidation = "valid"
+	UziNodesReviewPostReqValidationValid   UziNodesReviewPostReqValidation = "valid"
+	UziNodesReviewPostReqValidationInvalid UziNodesReviewPostReqValidation = "invalid"
 )
 
-// AllValues returns all UziNodesIDPatchReqValidation values.
-func (UziNodesIDPatchReqValidation) AllValues() []UziNodesIDPatchReqValidation {
-	return []UziNodesIDPatchReqValidation{
-		UziNodesIDPatchReqValidationInvalid,
-		UziNodesIDPatchReqValidationValid,
+// AllValues returns all UziNodesReviewPostReqValidation values.
+func (UziNodesReviewPostReqValidation) AllValues() []UziNodesReviewPostReqValidation {
+	return []UziNodesReviewPostReqValidation{
+		UziNodesReviewPostReqValidationValid,
+		UziNodesReviewPostReqValidationInvalid,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s UziNodesIDPatchReqValidation) MarshalText() ([]byte, error) {
+func (s UziNodesReviewPostReqValidation) MarshalText() ([]byte, error) {
 	switch s {
-	case UziNodesIDPatchReqValidationInvalid:
+	case UziNodesReviewPostReqValidationValid:
 		return []byte(s), nil
-	case UziNodesIDPatchReqValidationValid:
+	case UziNodesReviewPostReqValidationInvalid:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -8598,46 +14799,68 @@ func (s UziNodesIDPatchReqValidation) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *UziNodesIDPatchReqValidation) UnmarshalText(data []byte) error {
-	switch UziNodesIDPatchReqValidation(data) {
-	case UziNodesIDPatchReqValidationInvalid:
-		*s = UziNodesIDPatchReqValidationInvalid
+func (s *UziNodesReviewPostReqValidation) UnmarshalText(data []byte) error {
+	switch UziNodesReviewPostReqValidation(data) {
+	case UziNodesReviewPostReqValidationValid:
+		*s = UziNodesReviewPostReqValidationValid
 		return nil
-	case UziNodesIDPatchReqValidationValid:
-		*s = UziNodesIDPatchReqValidationValid
+	case UziNodesReviewPostReqValidationInvalid:
+		*s = UziNodesReviewPostReqValidationInvalid
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type UziNodesIDPatchUnprocessableEntity ErrorStatusCode
-
-func (*UziNodesIDPatchUnprocessableEntity) uziNodesIDPatchRes() {}
-
-type UziNodesIDSegmentsGetInternalServerError ErrorStatusCode
+// Страница результатов поиска узи.
+// Ref: #/components/schemas/uzi_page
+type UziPage struct {
+	Uzis []Uzi `json:"uzis"`
+	// Курсор следующей страницы, отсутствует если страница
+	// последняя.
+	NextCursor OptString `json:"next_cursor"`
+}
 
-func (*UziNodesIDSegmentsGetInternalServerError) uziNodesIDSegmentsGetRes() {}
+// GetUzis returns the value of Uzis.
+func (s *UziPage) GetUzis() []Uzi {
+	return s.Uzis
+}
 
-type UziNodesIDSegmentsGetNotFound ErrorStatusCode
+// GetNextCursor returns the value of NextCursor.
+func (s *UziPage) GetNextCursor() OptString {
+	return s.NextCursor
+}
 
-func (*UziNodesIDSegmentsGetNotFound) uziNodesIDSegmentsGetRes() {}
+// SetUzis sets the value of Uzis.
+func (s *UziPage) SetUzis(val []Uzi) {
+	s.Uzis = val
+}
 
-type UziNodesIDSegmentsGetOKApplicationJSON []Segment
+// SetNextCursor sets the value of NextCursor.
+func (s *UziPage) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
 
-func (*UziNodesIDSegmentsGetOKApplicationJSON) uziNodesIDSegmentsGetRes() {}
+func (*UziPage) uzisSearchGetRes() {}
 
 type UziPostBadRequest ErrorStatusCode
 
 func (*UziPostBadRequest) uziPostRes() {}
 
+type UziPostConflict ErrorStatusCode
+
+func (*UziPostConflict) uziPostRes() {}
+
 type UziPostInternalServerError ErrorStatusCode
 
 func (*UziPostInternalServerError) uziPostRes() {}
 
 type UziPostReq struct {
-	// Файл узи. **обязательно с .tiff/.png/.jpeg/.jpg**.
-	File ht.MultipartFile `json:"file"`
+	// Файл узи. **обязательно с .tiff/.png/.jpeg/.jpg**. не указывается
+	// вместе с upload_id.
+	File OptMultipartFile `json:"file"`
+	// Id завершенной загрузки частями вместо file, см. /uploads.
+	UploadID OptUUID `json:"upload_id"`
 	// Проекция узи.
 	Projection UziPostReqProjection `json:"projection"`
 	// Внешний id пациента/организации etc.
@@ -8646,13 +14869,22 @@ type UziPostReq struct {
 	DeviceID int `json:"device_id"`
 	// Описание узи.
 	Description OptString `json:"description"`
+	// Размер пикселя по горизонтали из DICOM, мм.
+	PixelSpacingX OptFloat64 `json:"pixel_spacing_x"`
+	// Размер пикселя по вертикали из DICOM, мм.
+	PixelSpacingY OptFloat64 `json:"pixel_spacing_y"`
 }
 
 // GetFile returns the value of File.
-func (s *UziPostReq) GetFile() ht.MultipartFile {
+func (s *UziPostReq) GetFile() OptMultipartFile {
 	return s.File
 }
 
+// GetUploadID returns the value of UploadID.
+func (s *UziPostReq) GetUploadID() OptUUID {
+	return s.UploadID
+}
+
 // GetProjection returns the value of Projection.
 func (s *UziPostReq) GetProjection() UziPostReqProjection {
 	return s.Projection
@@ -8673,11 +14905,26 @@ func (s *UziPostReq) GetDescription() OptString {
 	return s.Description
 }
 
+// GetPixelSpacingX returns the value of PixelSpacingX.
+func (s *UziPostReq) GetPixelSpacingX() OptFloat64 {
+	return s.PixelSpacingX
+}
+
+// GetPixelSpacingY returns the value of PixelSpacingY.
+func (s *UziPostReq) GetPixelSpacingY() OptFloat64 {
+	return s.PixelSpacingY
+}
+
 // SetFile sets the value of File.
-func (s *UziPostReq) SetFile(val ht.MultipartFile) {
+func (s *UziPostReq) SetFile(val OptMultipartFile) {
 	s.File = val
 }
 
+// SetUploadID sets the value of UploadID.
+func (s *UziPostReq) SetUploadID(val OptUUID) {
+	s.UploadID = val
+}
+
 // SetProjection sets the value of Projection.
 func (s *UziPostReq) SetProjection(val UziPostReqProjection) {
 	s.Projection = val
@@ -8698,6 +14945,16 @@ func (s *UziPostReq) SetDescription(val OptString) {
 	s.Description = val
 }
 
+// SetPixelSpacingX sets the value of PixelSpacingX.
+func (s *UziPostReq) SetPixelSpacingX(val OptFloat64) {
+	s.PixelSpacingX = val
+}
+
+// SetPixelSpacingY sets the value of PixelSpacingY.
+func (s *UziPostReq) SetPixelSpacingY(val OptFloat64) {
+	s.PixelSpacingY = val
+}
+
 // Проекция узи.
 type UziPostReqProjection string
 
@@ -8786,6 +15043,35 @@ func (s *UziProjection) UnmarshalText(data []byte) error {
 	}
 }
 
+type UziSegmentDraftsIDAcceptPostConflict ErrorStatusCode
+
+func (*UziSegmentDraftsIDAcceptPostConflict) uziSegmentDraftsIDAcceptPostRes() {}
+
+type UziSegmentDraftsIDAcceptPostInternalServerError ErrorStatusCode
+
+func (*UziSegmentDraftsIDAcceptPostInternalServerError) uziSegmentDraftsIDAcceptPostRes() {}
+
+type UziSegmentDraftsIDAcceptPostNotFound ErrorStatusCode
+
+func (*UziSegmentDraftsIDAcceptPostNotFound) uziSegmentDraftsIDAcceptPostRes() {}
+
+type UziSegmentDraftsIDAcceptPostUnprocessableEntity ErrorStatusCode
+
+func (*UziSegmentDraftsIDAcceptPostUnprocessableEntity) uziSegmentDraftsIDAcceptPostRes() {}
+
+type UziSegmentDraftsIDDeleteInternalServerError ErrorStatusCode
+
+func (*UziSegmentDraftsIDDeleteInternalServerError) uziSegmentDraftsIDDeleteRes() {}
+
+type UziSegmentDraftsIDDeleteNotFound ErrorStatusCode
+
+func (*UziSegmentDraftsIDDeleteNotFound) uziSegmentDraftsIDDeleteRes() {}
+
+// UziSegmentDraftsIDDeleteOK is response for UziSegmentDraftsIDDelete operation.
+type UziSegmentDraftsIDDeleteOK struct{}
+
+func (*UziSegmentDraftsIDDeleteOK) uziSegmentDraftsIDDeleteRes() {}
+
 type UziSegmentIDDeleteInternalServerError ErrorStatusCode
 
 func (*UziSegmentIDDeleteInternalServerError) uziSegmentIDDeleteRes() {}
@@ -8812,14 +15098,14 @@ type UziSegmentIDPatchNotFound ErrorStatusCode
 func (*UziSegmentIDPatchNotFound) uziSegmentIDPatchRes() {}
 
 type UziSegmentIDPatchReq struct {
-	Contor   Contor     `json:"contor"`
+	Contor   OptContor  `json:"contor"`
 	Tirads23 OptFloat64 `json:"tirads_23"`
 	Tirads4  OptFloat64 `json:"tirads_4"`
 	Tirads5  OptFloat64 `json:"tirads_5"`
 }
 
 // GetContor returns the value of Contor.
-func (s *UziSegmentIDPatchReq) GetContor() Contor {
+func (s *UziSegmentIDPatchReq) GetContor() OptContor {
 	return s.Contor
 }
 
@@ -8839,7 +15125,7 @@ func (s *UziSegmentIDPatchReq) GetTirads5() OptFloat64 {
 }
 
 // SetContor sets the value of Contor.
-func (s *UziSegmentIDPatchReq) SetContor(val Contor) {
+func (s *UziSegmentIDPatchReq) SetContor(val OptContor) {
 	s.Contor = val
 }
 
@@ -8862,6 +15148,26 @@ type UziSegmentIDPatchUnprocessableEntity ErrorStatusCode
 
 func (*UziSegmentIDPatchUnprocessableEntity) uziSegmentIDPatchRes() {}
 
+type UziSegmentIDPropagatePostBadRequest ErrorStatusCode
+
+func (*UziSegmentIDPropagatePostBadRequest) uziSegmentIDPropagatePostRes() {}
+
+type UziSegmentIDPropagatePostInternalServerError ErrorStatusCode
+
+func (*UziSegmentIDPropagatePostInternalServerError) uziSegmentIDPropagatePostRes() {}
+
+type UziSegmentIDPropagatePostNotFound ErrorStatusCode
+
+func (*UziSegmentIDPropagatePostNotFound) uziSegmentIDPropagatePostRes() {}
+
+type UziSegmentIDPropagatePostOKApplicationJSON []SegmentDraft
+
+func (*UziSegmentIDPropagatePostOKApplicationJSON) uziSegmentIDPropagatePostRes() {}
+
+type UziSegmentIDPropagatePostUnprocessableEntity ErrorStatusCode
+
+func (*UziSegmentIDPropagatePostUnprocessableEntity) uziSegmentIDPropagatePostRes() {}
+
 type UziSegmentPostBadRequest ErrorStatusCode
 
 func (*UziSegmentPostBadRequest) uziSegmentPostRes() {}
@@ -8997,6 +15303,54 @@ func (s *UziStatus) UnmarshalText(data []byte) error {
 	}
 }
 
+type UzisAnalyticsGetPeriod string
+
+const (
+	UzisAnalyticsGetPeriodDay   UzisAnalyticsGetPeriod = "day"
+	UzisAnalyticsGetPeriodWeek  UzisAnalyticsGetPeriod = "week"
+	UzisAnalyticsGetPeriodMonth UzisAnalyticsGetPeriod = "month"
+)
+
+// AllValues returns all UzisAnalyticsGetPeriod values.
+func (UzisAnalyticsGetPeriod) AllValues() []UzisAnalyticsGetPeriod {
+	return []UzisAnalyticsGetPeriod{
+		UzisAnalyticsGetPeriodDay,
+		UzisAnalyticsGetPeriodWeek,
+		UzisAnalyticsGetPeriodMonth,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UzisAnalyticsGetPeriod) MarshalText() ([]byte, error) {
+	switch s {
+	case UzisAnalyticsGetPeriodDay:
+		return []byte(s), nil
+	case UzisAnalyticsGetPeriodWeek:
+		return []byte(s), nil
+	case UzisAnalyticsGetPeriodMonth:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UzisAnalyticsGetPeriod) UnmarshalText(data []byte) error {
+	switch UzisAnalyticsGetPeriod(data) {
+	case UzisAnalyticsGetPeriodDay:
+		*s = UzisAnalyticsGetPeriodDay
+		return nil
+	case UzisAnalyticsGetPeriodWeek:
+		*s = UzisAnalyticsGetPeriodWeek
+		return nil
+	case UzisAnalyticsGetPeriodMonth:
+		*s = UzisAnalyticsGetPeriodMonth
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type UzisAuthorIDGetInternalServerError ErrorStatusCode
 
 func (*UzisAuthorIDGetInternalServerError) uzisAuthorIDGetRes() {}
@@ -9021,6 +15375,148 @@ type UzisExternalIDGetOKApplicationJSON []Uzi
 
 func (*UzisExternalIDGetOKApplicationJSON) uzisExternalIDGetRes() {}
 
+type UzisExternalIDGrowthGetOKApplicationJSON []NodeGrowth
+
+func (*UzisExternalIDGrowthGetOKApplicationJSON) uzisExternalIDGrowthGetRes() {}
+
+type UzisSearchGetBadRequest ErrorStatusCode
+
+func (*UzisSearchGetBadRequest) uzisSearchGetRes() {}
+
+type UzisSearchGetInternalServerError ErrorStatusCode
+
+func (*UzisSearchGetInternalServerError) uzisSearchGetRes() {}
+
+type UzisSearchGetOrder string
+
+const (
+	UzisSearchGetOrderDesc UzisSearchGetOrder = "desc"
+	UzisSearchGetOrderAsc  UzisSearchGetOrder = "asc"
+)
+
+// AllValues returns all UzisSearchGetOrder values.
+func (UzisSearchGetOrder) AllValues() []UzisSearchGetOrder {
+	return []UzisSearchGetOrder{
+		UzisSearchGetOrderDesc,
+		UzisSearchGetOrderAsc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UzisSearchGetOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case UzisSearchGetOrderDesc:
+		return []byte(s), nil
+	case UzisSearchGetOrderAsc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UzisSearchGetOrder) UnmarshalText(data []byte) error {
+	switch UzisSearchGetOrder(data) {
+	case UzisSearchGetOrderDesc:
+		*s = UzisSearchGetOrderDesc
+		return nil
+	case UzisSearchGetOrderAsc:
+		*s = UzisSearchGetOrderAsc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type UzisSearchGetProjection string
+
+const (
+	UzisSearchGetProjectionCross UzisSearchGetProjection = "cross"
+	UzisSearchGetProjectionLong  UzisSearchGetProjection = "long"
+)
+
+// AllValues returns all UzisSearchGetProjection values.
+func (UzisSearchGetProjection) AllValues() []UzisSearchGetProjection {
+	return []UzisSearchGetProjection{
+		UzisSearchGetProjectionCross,
+		UzisSearchGetProjectionLong,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UzisSearchGetProjection) MarshalText() ([]byte, error) {
+	switch s {
+	case UzisSearchGetProjectionCross:
+		return []byte(s), nil
+	case UzisSearchGetProjectionLong:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UzisSearchGetProjection) UnmarshalText(data []byte) error {
+	switch UzisSearchGetProjection(data) {
+	case UzisSearchGetProjectionCross:
+		*s = UzisSearchGetProjectionCross
+		return nil
+	case UzisSearchGetProjectionLong:
+		*s = UzisSearchGetProjectionLong
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type UzisSearchGetStatus string
+
+const (
+	UzisSearchGetStatusNew       UzisSearchGetStatus = "new"
+	UzisSearchGetStatusPending   UzisSearchGetStatus = "pending"
+	UzisSearchGetStatusCompleted UzisSearchGetStatus = "completed"
+)
+
+// AllValues returns all UzisSearchGetStatus values.
+func (UzisSearchGetStatus) AllValues() []UzisSearchGetStatus {
+	return []UzisSearchGetStatus{
+		UzisSearchGetStatusNew,
+		UzisSearchGetStatusPending,
+		UzisSearchGetStatusCompleted,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UzisSearchGetStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case UzisSearchGetStatusNew:
+		return []byte(s), nil
+	case UzisSearchGetStatusPending:
+		return []byte(s), nil
+	case UzisSearchGetStatusCompleted:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UzisSearchGetStatus) UnmarshalText(data []byte) error {
+	switch UzisSearchGetStatus(data) {
+	case UzisSearchGetStatusNew:
+		*s = UzisSearchGetStatusNew
+		return nil
+	case UzisSearchGetStatusPending:
+		*s = UzisSearchGetStatusPending
+		return nil
+	case UzisSearchGetStatusCompleted:
+		*s = UzisSearchGetStatusCompleted
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Запрос вебхука от Юкассы.
 // Ref: #/components/schemas/YookassaWebhookRequest
 type YookassaWebhookRequest struct {
@@ -37,6 +37,21 @@ type Handler interface {
 	//
 	// GET /cytology/patient/{patient_id}/shots
 	CytologyPatientShotsRead(ctx context.Context, params CytologyPatientShotsReadParams) (CytologyPatientShotsReadRes, error)
+	// CytologyQuPathExport implements CytologyQuPathExport operation.
+	//
+	// Все группы сегментаций исследования одним FeatureCollection,
+	// класс и его цвет в properties.classification.
+	//
+	// GET /cytology/{id}/qupath
+	CytologyQuPathExport(ctx context.Context, params CytologyQuPathExportParams) (CytologyQuPathExportRes, error)
+	// CytologyQuPathImport implements CytologyQuPathImport operation.
+	//
+	// Создает новую версию исследования копированием,
+	// сегментации новой версии берутся из GeoJSON. Аннотации
+	// сравниваются с экспортом по id объекта QuPath.
+	//
+	// POST /cytology/{id}/qupath
+	CytologyQuPathImport(ctx context.Context, req CytologyQuPathImportReq, params CytologyQuPathImportParams) (CytologyQuPathImportRes, error)
 	// CytologyRead implements CytologyRead operation.
 	//
 	// Информация об одной группе снимков.
@@ -79,6 +94,17 @@ type Handler interface {
 	//
 	// GET /cytology/{id}/segments
 	CytologySegmentsList(ctx context.Context, params CytologySegmentsListParams) (CytologySegmentsListRes, error)
+	// CytologySegmentsViewport implements CytologySegmentsViewport operation.
+	//
+	// Сегментации исследования, ограничивающий
+	// прямоугольник которых пересекается с областью в
+	// пикселях нулевого уровня. Сегментации отсортированы
+	// по id, для следующей страницы передайте next_cursor из
+	// предыдущего ответа. При downsample больше 1 контуры
+	// упрощаются с допуском downsample пикселей.
+	//
+	// GET /cytology/{id}/segments/viewport
+	CytologySegmentsViewport(ctx context.Context, params CytologySegmentsViewportParams) (CytologySegmentsViewportRes, error)
 	// CytologyUpdatePartialUpdate implements CytologyUpdatePartialUpdate operation.
 	//
 	// Обновление всей страницы с информацией о приеме.
@@ -104,6 +130,12 @@ type Handler interface {
 	//
 	// GET /download/{uzi_id}/{image_id}
 	DownloadUziIDImageIDGet(ctx context.Context, params DownloadUziIDImageIDGetParams) (DownloadUziIDImageIDGetRes, error)
+	// DownloadUziUziIDReportGet implements GET /download/uzi/{uzi_id}/report operation.
+	//
+	// Скачать заключение по узи.
+	//
+	// GET /download/uzi/{uzi_id}/report
+	DownloadUziUziIDReportGet(ctx context.Context, params DownloadUziUziIDReportGetParams) (DownloadUziUziIDReportGetRes, error)
 	// LoginPost implements POST /login operation.
 	//
 	// Авторизация.
@@ -235,6 +267,59 @@ type Handler interface {
 	//
 	// GET /tiler/dzi/{file_path}
 	TilerDziFilePathGet(ctx context.Context, params TilerDziFilePathGetParams) (TilerDziFilePathGetRes, error)
+	// UploadsIDCompletePost implements POST /uploads/{id}/complete operation.
+	//
+	// Собирает файл из частей и ставит его в обработку. sha256
+	// сверяется и метаданные очищаются в фоне, пока
+	// загрузка в статусе processing, ее нельзя передать в узи или
+	// цитологию.
+	//
+	// POST /uploads/{id}/complete
+	UploadsIDCompletePost(ctx context.Context, params UploadsIDCompletePostParams) (UploadsIDCompletePostRes, error)
+	// UploadsIDDelete implements DELETE /uploads/{id} operation.
+	//
+	// Отменить загрузку.
+	//
+	// DELETE /uploads/{id}
+	UploadsIDDelete(ctx context.Context, params UploadsIDDeleteParams) (UploadsIDDeleteRes, error)
+	// UploadsIDGet implements GET /uploads/{id} operation.
+	//
+	// Получить состояние загрузки.
+	//
+	// GET /uploads/{id}
+	UploadsIDGet(ctx context.Context, params UploadsIDGetParams) (UploadsIDGetRes, error)
+	// UploadsIDPartsPost implements POST /uploads/{id}/parts operation.
+	//
+	// Получить url для загрузки частей.
+	//
+	// POST /uploads/{id}/parts
+	UploadsIDPartsPost(ctx context.Context, req *UploadsIDPartsPostReq, params UploadsIDPartsPostParams) (UploadsIDPartsPostRes, error)
+	// UploadsPost implements POST /uploads operation.
+	//
+	// Файл загружается напрямую в S3 частями по presigned url,
+	// после завершения id загрузки передается в POST /uzi или
+	// /cytology/create.
+	//
+	// POST /uploads
+	UploadsPost(ctx context.Context, req *UploadsPostReq) (UploadsPostRes, error)
+	// UziDeviceIDDelete implements DELETE /uzi/device/{id} operation.
+	//
+	// Аппарат, на который ссылаются узи, удалить нельзя.
+	//
+	// DELETE /uzi/device/{id}
+	UziDeviceIDDelete(ctx context.Context, params UziDeviceIDDeleteParams) (UziDeviceIDDeleteRes, error)
+	// UziDeviceIDGet implements GET /uzi/device/{id} operation.
+	//
+	// Получить uzi аппарат.
+	//
+	// GET /uzi/device/{id}
+	UziDeviceIDGet(ctx context.Context, params UziDeviceIDGetParams) (UziDeviceIDGetRes, error)
+	// UziDeviceIDPatch implements PATCH /uzi/device/{id} operation.
+	//
+	// Обновить uzi аппарат.
+	//
+	// PATCH /uzi/device/{id}
+	UziDeviceIDPatch(ctx context.Context, req *UziDeviceIDPatchReq, params UziDeviceIDPatchParams) (UziDeviceIDPatchRes, error)
 	// UziDevicePost implements POST /uzi/device operation.
 	//
 	// Добавить uzi аппарат.
@@ -247,6 +332,14 @@ type Handler interface {
 	//
 	// GET /uzi/devices
 	UziDevicesGet(ctx context.Context) (UziDevicesGetRes, error)
+	// UziIDCheckPost implements POST /uzi/{id}/check operation.
+	//
+	// Узи получает checked, при заданном unvalidated узлам нейросети
+	// без решения врача проставляется эта валидация.
+	// Выполняется в одной транзакции.
+	//
+	// POST /uzi/{id}/check
+	UziIDCheckPost(ctx context.Context, req OptUziIDCheckPostReq, params UziIDCheckPostParams) (UziIDCheckPostRes, error)
 	// UziIDDelete implements DELETE /uzi/{id} operation.
 	//
 	// Удалить узи.
@@ -277,6 +370,15 @@ type Handler interface {
 	//
 	// GET /uzi/{id}/images
 	UziIDImagesGet(ctx context.Context, params UziIDImagesGetParams) (UziIDImagesGetRes, error)
+	// UziIDNodesAcceptPost implements POST /uzi/{id}/nodes/accept operation.
+	//
+	// Узлы нейросети без решения врача, у которых
+	// вероятность наиболее вероятного класса TI-RADS не ниже
+	// threshold,
+	// становятся valid. Выполняется в одной транзакции.
+	//
+	// POST /uzi/{id}/nodes/accept
+	UziIDNodesAcceptPost(ctx context.Context, req *UziIDNodesAcceptPostReq, params UziIDNodesAcceptPostParams) (UziIDNodesAcceptPostRes, error)
 	// UziIDNodesGet implements GET /uzi/{id}/nodes operation.
 	//
 	// Получить все узлы узи.
@@ -295,6 +397,19 @@ type Handler interface {
 	//
 	// PATCH /uzi/{id}
 	UziIDPatch(ctx context.Context, req *UziIDPatchReq, params UziIDPatchParams) (UziIDPatchRes, error)
+	// UziIDReportsGet implements GET /uzi/{id}/reports operation.
+	//
+	// Получить версии заключения по узи.
+	//
+	// GET /uzi/{id}/reports
+	UziIDReportsGet(ctx context.Context, params UziIDReportsGetParams) (UziIDReportsGetRes, error)
+	// UziIDReportsPost implements POST /uzi/{id}/reports operation.
+	//
+	// Заключение собирается из эхографических признаков,
+	// узлов и ключевых кадров с контурами.
+	//
+	// POST /uzi/{id}/reports
+	UziIDReportsPost(ctx context.Context, params UziIDReportsPostParams) (UziIDReportsPostRes, error)
 	// UziImageIDNodesSegmentsGet implements GET /uzi/image/{id}/nodes-segments operation.
 	//
 	// Получит узлы и сегменты на указанном изображении.
@@ -307,24 +422,99 @@ type Handler interface {
 	//
 	// DELETE /uzi/nodes/{id}
 	UziNodesIDDelete(ctx context.Context, params UziNodesIDDeleteParams) (UziNodesIDDeleteRes, error)
+	// UziNodesIDLinkDelete implements DELETE /uzi/nodes/{id}/link operation.
+	//
+	// Отвязать узел от цепочки наблюдений.
+	//
+	// DELETE /uzi/nodes/{id}/link
+	UziNodesIDLinkDelete(ctx context.Context, params UziNodesIDLinkDeleteParams) (UziNodesIDLinkDeleteRes, error)
+	// UziNodesIDLinkPost implements POST /uzi/nodes/{id}/link operation.
+	//
+	// Узел добавляется в цепочку наблюдений целевого узла.
+	//
+	// POST /uzi/nodes/{id}/link
+	UziNodesIDLinkPost(ctx context.Context, req *UziNodesIDLinkPostReq, params UziNodesIDLinkPostParams) (UziNodesIDLinkPostRes, error)
+	// UziNodesIDLinkSuggestionsGet implements GET /uzi/nodes/{id}/link/suggestions operation.
+	//
+	// Предложить узлы из других узи пациента для связывания.
+	//
+	// GET /uzi/nodes/{id}/link/suggestions
+	UziNodesIDLinkSuggestionsGet(ctx context.Context, params UziNodesIDLinkSuggestionsGetParams) (UziNodesIDLinkSuggestionsGetRes, error)
 	// UziNodesIDPatch implements PATCH /uzi/nodes/{id} operation.
 	//
 	// Обновить узел.
 	//
 	// PATCH /uzi/nodes/{id}
 	UziNodesIDPatch(ctx context.Context, req *UziNodesIDPatchReq, params UziNodesIDPatchParams) (UziNodesIDPatchRes, error)
+	// UziNodesIDSegmentDraftsGet implements GET /uzi/nodes/{id}/segment-drafts operation.
+	//
+	// Получить черновики сегментов узла.
+	//
+	// GET /uzi/nodes/{id}/segment-drafts
+	UziNodesIDSegmentDraftsGet(ctx context.Context, params UziNodesIDSegmentDraftsGetParams) (UziNodesIDSegmentDraftsGetRes, error)
 	// UziNodesIDSegmentsGet implements GET /uzi/nodes/{id}/segments operation.
 	//
 	// Получить сегменты узла.
 	//
 	// GET /uzi/nodes/{id}/segments
 	UziNodesIDSegmentsGet(ctx context.Context, params UziNodesIDSegmentsGetParams) (UziNodesIDSegmentsGetRes, error)
+	// UziNodesIDSplitPost implements POST /uzi/nodes/{id}/split operation.
+	//
+	// Вероятности TI-RADS обоих узлов пересчитываются по их
+	// сегментам, исходный узел сохраняется для аналитики.
+	// В узле должен остаться хотя бы один сегмент.
+	//
+	// POST /uzi/nodes/{id}/split
+	UziNodesIDSplitPost(ctx context.Context, req *UziNodesIDSplitPostReq, params UziNodesIDSplitPostParams) (UziNodesIDSplitPostRes, error)
+	// UziNodesIDTiradsGet implements GET /uzi/nodes/{id}/tirads operation.
+	//
+	// Получить оценку узла по ACR TI-RADS.
+	//
+	// GET /uzi/nodes/{id}/tirads
+	UziNodesIDTiradsGet(ctx context.Context, params UziNodesIDTiradsGetParams) (UziNodesIDTiradsGetRes, error)
+	// UziNodesIDTiradsPut implements PUT /uzi/nodes/{id}/tirads operation.
+	//
+	// Задать дескрипторы узла по ACR TI-RADS.
+	//
+	// PUT /uzi/nodes/{id}/tirads
+	UziNodesIDTiradsPut(ctx context.Context, req *NodeDescriptors, params UziNodesIDTiradsPutParams) (UziNodesIDTiradsPutRes, error)
+	// UziNodesMergePost implements POST /uzi/nodes/merge operation.
+	//
+	// Сегменты всех узлов переносятся в первый из node_ids,
+	// остальные узлы удаляются.
+	// Вероятности TI-RADS узла пересчитываются по его
+	// сегментам, исходные узлы сохраняются для аналитики.
+	//
+	// POST /uzi/nodes/merge
+	UziNodesMergePost(ctx context.Context, req *UziNodesMergePostReq) (UziNodesMergePostRes, error)
+	// UziNodesReviewPost implements POST /uzi/nodes/review operation.
+	//
+	// Валидация проставляется всем узлам в одной
+	// транзакции, узлы, которые нельзя изменить,
+	// возвращаются со своим статусом.
+	// Пакетные операции учитываются в аналитике.
+	//
+	// POST /uzi/nodes/review
+	UziNodesReviewPost(ctx context.Context, req *UziNodesReviewPostReq) (UziNodesReviewPostRes, error)
 	// UziPost implements POST /uzi operation.
 	//
 	// Загрузить узи на обработку.
 	//
 	// POST /uzi
 	UziPost(ctx context.Context, req *UziPostReq) (UziPostRes, error)
+	// UziSegmentDraftsIDAcceptPost implements POST /uzi/segment-drafts/{id}/accept operation.
+	//
+	// Из черновика создается ручной сегмент узла, черновик
+	// удаляется.
+	//
+	// POST /uzi/segment-drafts/{id}/accept
+	UziSegmentDraftsIDAcceptPost(ctx context.Context, params UziSegmentDraftsIDAcceptPostParams) (UziSegmentDraftsIDAcceptPostRes, error)
+	// UziSegmentDraftsIDDelete implements DELETE /uzi/segment-drafts/{id} operation.
+	//
+	// Отклонить черновик.
+	//
+	// DELETE /uzi/segment-drafts/{id}
+	UziSegmentDraftsIDDelete(ctx context.Context, params UziSegmentDraftsIDDeleteParams) (UziSegmentDraftsIDDeleteRes, error)
 	// UziSegmentIDDelete implements DELETE /uzi/segment/{id} operation.
 	//
 	// Если у узла не останется сегментов, он будет **удален**.
@@ -337,12 +527,33 @@ type Handler interface {
 	//
 	// PATCH /uzi/segment/{id}
 	UziSegmentIDPatch(ctx context.Context, req *UziSegmentIDPatchReq, params UziSegmentIDPatchParams) (UziSegmentIDPatchRes, error)
+	// UziSegmentIDPropagatePost implements POST /uzi/segment/{id}/propagate operation.
+	//
+	// Контур сегмента переносится на соседние кадры узи,
+	// результат сохраняется черновиками.
+	// В каждую сторону распространение останавливается на
+	// кадре, где у узла уже есть сегмент, или при потере
+	// контура.
+	// Прежние черновики узла на тех же кадрах заменяются.
+	//
+	// POST /uzi/segment/{id}/propagate
+	UziSegmentIDPropagatePost(ctx context.Context, params UziSegmentIDPropagatePostParams) (UziSegmentIDPropagatePostRes, error)
 	// UziSegmentPost implements POST /uzi/segment operation.
 	//
 	// Добавить новый сегмент.
 	//
 	// POST /uzi/segment
 	UziSegmentPost(ctx context.Context, req *UziSegmentPostReq) (UziSegmentPostRes, error)
+	// UzisAnalyticsGet implements GET /uzis/analytics operation.
+	//
+	// Точность нейросети относительно валидации, доля
+	// непровалидированных узлов и распределение классов
+	// TI-RADS по всей выборке, аппаратам, авторам и периодам.
+	// Для узи, размеченных несколькими врачами, согласие
+	// врачей: каппа Коэна и IoU контуров.
+	//
+	// GET /uzis/analytics
+	UzisAnalyticsGet(ctx context.Context, params UzisAnalyticsGetParams) (UzisAnalyticsGetRes, error)
 	// UzisAuthorIDGet implements GET /uzis/author/{id} operation.
 	//
 	// Получить узи по id автора.
@@ -355,6 +566,20 @@ type Handler interface {
 	//
 	// GET /uzis/external/{id}
 	UzisExternalIDGet(ctx context.Context, params UzisExternalIDGetParams) (UzisExternalIDGetRes, error)
+	// UzisExternalIDGrowthGet implements GET /uzis/external/{id}/growth operation.
+	//
+	// Получить динамику отслеживаемых узлов пациента.
+	//
+	// GET /uzis/external/{id}/growth
+	UzisExternalIDGrowthGet(ctx context.Context, params UzisExternalIDGrowthGetParams) (UzisExternalIDGrowthGetRes, error)
+	// UzisSearchGet implements GET /uzis/search operation.
+	//
+	// Все фильтры необязательны. Узи отсортированы по дате
+	// создания, для следующей страницы передайте next_cursor из
+	// предыдущего ответа.
+	//
+	// GET /uzis/search
+	UzisSearchGet(ctx context.Context, params UzisSearchGetParams) (UzisSearchGetRes, error)
 	// YookassaWebhooksPost implements POST /yookassa/webhooks operation.
 	//
 	// Обработка уведомлений от Юкассы.
@@ -54,6 +54,27 @@ func (UnimplementedHandler) CytologyPatientShotsRead(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// CytologyQuPathExport implements CytologyQuPathExport operation.
+//
+// Все группы сегментаций исследования одним FeatureCollection,
+// класс и его цвет в properties.classification.
+//
+// GET /cytology/{id}/qupath
+func (UnimplementedHandler) CytologyQuPathExport(ctx context.Context, params CytologyQuPathExportParams) (r CytologyQuPathExportRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CytologyQuPathImport implements CytologyQuPathImport operation.
+//
+// Создает новую версию исследования копированием,
+// сегментации новой версии берутся из GeoJSON. Аннотации
+// сравниваются с экспортом по id объекта QuPath.
+//
+// POST /cytology/{id}/qupath
+func (UnimplementedHandler) CytologyQuPathImport(ctx context.Context, req CytologyQuPathImportReq, params CytologyQuPathImportParams) (r CytologyQuPathImportRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CytologyRead implements CytologyRead operation.
 //
 // Информация об одной группе снимков.
@@ -117,6 +138,20 @@ func (UnimplementedHandler) CytologySegmentsList(ctx context.Context, params Cyt
 	return r, ht.ErrNotImplemented
 }
 
+// CytologySegmentsViewport implements CytologySegmentsViewport operation.
+//
+// Сегментации исследования, ограничивающий
+// прямоугольник которых пересекается с областью в
+// пикселях нулевого уровня. Сегментации отсортированы
+// по id, для следующей страницы передайте next_cursor из
+// предыдущего ответа. При downsample больше 1 контуры
+// упрощаются с допуском downsample пикселей.
+//
+// GET /cytology/{id}/segments/viewport
+func (UnimplementedHandler) CytologySegmentsViewport(ctx context.Context, params CytologySegmentsViewportParams) (r CytologySegmentsViewportRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CytologyUpdatePartialUpdate implements CytologyUpdatePartialUpdate operation.
 //
 // Обновление всей страницы с информацией о приеме.
@@ -154,6 +189,15 @@ func (UnimplementedHandler) DownloadUziIDImageIDGet(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// DownloadUziUziIDReportGet implements GET /download/uzi/{uzi_id}/report operation.
+//
+// Скачать заключение по узи.
+//
+// GET /download/uzi/{uzi_id}/report
+func (UnimplementedHandler) DownloadUziUziIDReportGet(ctx context.Context, params DownloadUziUziIDReportGetParams) (r DownloadUziUziIDReportGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // LoginPost implements POST /login operation.
 //
 // Авторизация.
@@ -345,6 +389,83 @@ func (UnimplementedHandler) TilerDziFilePathGet(ctx context.Context, params Tile
 	return r, ht.ErrNotImplemented
 }
 
+// UploadsIDCompletePost implements POST /uploads/{id}/complete operation.
+//
+// Собирает файл из частей и ставит его в обработку. sha256
+// сверяется и метаданные очищаются в фоне, пока
+// загрузка в статусе processing, ее нельзя передать в узи или
+// цитологию.
+//
+// POST /uploads/{id}/complete
+func (UnimplementedHandler) UploadsIDCompletePost(ctx context.Context, params UploadsIDCompletePostParams) (r UploadsIDCompletePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UploadsIDDelete implements DELETE /uploads/{id} operation.
+//
+// Отменить загрузку.
+//
+// DELETE /uploads/{id}
+func (UnimplementedHandler) UploadsIDDelete(ctx context.Context, params UploadsIDDeleteParams) (r UploadsIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UploadsIDGet implements GET /uploads/{id} operation.
+//
+// Получить состояние загрузки.
+//
+// GET /uploads/{id}
+func (UnimplementedHandler) UploadsIDGet(ctx context.Context, params UploadsIDGetParams) (r UploadsIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UploadsIDPartsPost implements POST /uploads/{id}/parts operation.
+//
+// Получить url для загрузки частей.
+//
+// POST /uploads/{id}/parts
+func (UnimplementedHandler) UploadsIDPartsPost(ctx context.Context, req *UploadsIDPartsPostReq, params UploadsIDPartsPostParams) (r UploadsIDPartsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UploadsPost implements POST /uploads operation.
+//
+// Файл загружается напрямую в S3 частями по presigned url,
+// после завершения id загрузки передается в POST /uzi или
+// /cytology/create.
+//
+// POST /uploads
+func (UnimplementedHandler) UploadsPost(ctx context.Context, req *UploadsPostReq) (r UploadsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziDeviceIDDelete implements DELETE /uzi/device/{id} operation.
+//
+// Аппарат, на который ссылаются узи, удалить нельзя.
+//
+// DELETE /uzi/device/{id}
+func (UnimplementedHandler) UziDeviceIDDelete(ctx context.Context, params UziDeviceIDDeleteParams) (r UziDeviceIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziDeviceIDGet implements GET /uzi/device/{id} operation.
+//
+// Получить uzi аппарат.
+//
+// GET /uzi/device/{id}
+func (UnimplementedHandler) UziDeviceIDGet(ctx context.Context, params UziDeviceIDGetParams) (r UziDeviceIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziDeviceIDPatch implements PATCH /uzi/device/{id} operation.
+//
+// Обновить uzi аппарат.
+//
+// PATCH /uzi/device/{id}
+func (UnimplementedHandler) UziDeviceIDPatch(ctx context.Context, req *UziDeviceIDPatchReq, params UziDeviceIDPatchParams) (r UziDeviceIDPatchRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UziDevicePost implements POST /uzi/device operation.
 //
 // Добавить uzi аппарат.
@@ -363,6 +484,17 @@ func (UnimplementedHandler) UziDevicesGet(ctx context.Context) (r UziDevicesGetR
 	return r, ht.ErrNotImplemented
 }
 
+// UziIDCheckPost implements POST /uzi/{id}/check operation.
+//
+// Узи получает checked, при заданном unvalidated узлам нейросети
+// без решения врача проставляется эта валидация.
+// Выполняется в одной транзакции.
+//
+// POST /uzi/{id}/check
+func (UnimplementedHandler) UziIDCheckPost(ctx context.Context, req OptUziIDCheckPostReq, params UziIDCheckPostParams) (r UziIDCheckPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UziIDDelete implements DELETE /uzi/{id} operation.
 //
 // Удалить узи.
@@ -408,6 +540,18 @@ func (UnimplementedHandler) UziIDImagesGet(ctx context.Context, params UziIDImag
 	return r, ht.ErrNotImplemented
 }
 
+// UziIDNodesAcceptPost implements POST /uzi/{id}/nodes/accept operation.
+//
+// Узлы нейросети без решения врача, у которых
+// вероятность наиболее вероятного класса TI-RADS не ниже
+// threshold,
+// становятся valid. Выполняется в одной транзакции.
+//
+// POST /uzi/{id}/nodes/accept
+func (UnimplementedHandler) UziIDNodesAcceptPost(ctx context.Context, req *UziIDNodesAcceptPostReq, params UziIDNodesAcceptPostParams) (r UziIDNodesAcceptPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UziIDNodesGet implements GET /uzi/{id}/nodes operation.
 //
 // Получить все узлы узи.
@@ -435,6 +579,25 @@ func (UnimplementedHandler) UziIDPatch(ctx context.Context, req *UziIDPatchReq,
 	return r, ht.ErrNotImplemented
 }
 
+// UziIDReportsGet implements GET /uzi/{id}/reports operation.
+//
+// Получить версии заключения по узи.
+//
+// GET /uzi/{id}/reports
+func (UnimplementedHandler) UziIDReportsGet(ctx context.Context, params UziIDReportsGetParams) (r UziIDReportsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziIDReportsPost implements POST /uzi/{id}/reports operation.
+//
+// Заключение собирается из эхографических признаков,
+// узлов и ключевых кадров с контурами.
+//
+// POST /uzi/{id}/reports
+func (UnimplementedHandler) UziIDReportsPost(ctx context.Context, params UziIDReportsPostParams) (r UziIDReportsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UziImageIDNodesSegmentsGet implements GET /uzi/image/{id}/nodes-segments operation.
 //
 // Получит узлы и сегменты на указанном изображении.
@@ -453,6 +616,33 @@ func (UnimplementedHandler) UziNodesIDDelete(ctx context.Context, params UziNode
 	return r, ht.ErrNotImplemented
 }
 
+// UziNodesIDLinkDelete implements DELETE /uzi/nodes/{id}/link operation.
+//
+// Отвязать узел от цепочки наблюдений.
+//
+// DELETE /uzi/nodes/{id}/link
+func (UnimplementedHandler) UziNodesIDLinkDelete(ctx context.Context, params UziNodesIDLinkDeleteParams) (r UziNodesIDLinkDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziNodesIDLinkPost implements POST /uzi/nodes/{id}/link operation.
+//
+// Узел добавляется в цепочку наблюдений целевого узла.
+//
+// POST /uzi/nodes/{id}/link
+func (UnimplementedHandler) UziNodesIDLinkPost(ctx context.Context, req *UziNodesIDLinkPostReq, params UziNodesIDLinkPostParams) (r UziNodesIDLinkPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziNodesIDLinkSuggestionsGet implements GET /uzi/nodes/{id}/link/suggestions operation.
+//
+// Предложить узлы из других узи пациента для связывания.
+//
+// GET /uzi/nodes/{id}/link/suggestions
+func (UnimplementedHandler) UziNodesIDLinkSuggestionsGet(ctx context.Context, params UziNodesIDLinkSuggestionsGetParams) (r UziNodesIDLinkSuggestionsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UziNodesIDPatch implements PATCH /uzi/nodes/{id} operation.
 //
 // Обновить узел.
@@ -462,6 +652,15 @@ func (UnimplementedHandler) UziNodesIDPatch(ctx context.Context, req *UziNodesID
 	return r, ht.ErrNotImplemented
 }
 
+// UziNodesIDSegmentDraftsGet implements GET /uzi/nodes/{id}/segment-drafts operation.
+//
+// Получить черновики сегментов узла.
+//
+// GET /uzi/nodes/{id}/segment-drafts
+func (UnimplementedHandler) UziNodesIDSegmentDraftsGet(ctx context.Context, params UziNodesIDSegmentDraftsGetParams) (r UziNodesIDSegmentDraftsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UziNodesIDSegmentsGet implements GET /uzi/nodes/{id}/segments operation.
 //
 // Получить сегменты узла.
@@ -471,6 +670,59 @@ func (UnimplementedHandler) UziNodesIDSegmentsGet(ctx context.Context, params Uz
 	return r, ht.ErrNotImplemented
 }
 
+// UziNodesIDSplitPost implements POST /uzi/nodes/{id}/split operation.
+//
+// Вероятности TI-RADS обоих узлов пересчитываются по их
+// сегментам, исходный узел сохраняется для аналитики.
+// В узле должен остаться хотя бы один сегмент.
+//
+// POST /uzi/nodes/{id}/split
+func (UnimplementedHandler) UziNodesIDSplitPost(ctx context.Context, req *UziNodesIDSplitPostReq, params UziNodesIDSplitPostParams) (r UziNodesIDSplitPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziNodesIDTiradsGet implements GET /uzi/nodes/{id}/tirads operation.
+//
+// Получить оценку узла по ACR TI-RADS.
+//
+// GET /uzi/nodes/{id}/tirads
+func (UnimplementedHandler) UziNodesIDTiradsGet(ctx context.Context, params UziNodesIDTiradsGetParams) (r UziNodesIDTiradsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziNodesIDTiradsPut implements PUT /uzi/nodes/{id}/tirads operation.
+//
+// Задать дескрипторы узла по ACR TI-RADS.
+//
+// PUT /uzi/nodes/{id}/tirads
+func (UnimplementedHandler) UziNodesIDTiradsPut(ctx context.Context, req *NodeDescriptors, params UziNodesIDTiradsPutParams) (r UziNodesIDTiradsPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziNodesMergePost implements POST /uzi/nodes/merge operation.
+//
+// Сегменты всех узлов переносятся в первый из node_ids,
+// остальные узлы удаляются.
+// Вероятности TI-RADS узла пересчитываются по его
+// сегментам, исходные узлы сохраняются для аналитики.
+//
+// POST /uzi/nodes/merge
+func (UnimplementedHandler) UziNodesMergePost(ctx context.Context, req *UziNodesMergePostReq) (r UziNodesMergePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziNodesReviewPost implements POST /uzi/nodes/review operation.
+//
+// Валидация проставляется всем узлам в одной
+// транзакции, узлы, которые нельзя изменить,
+// возвращаются со своим статусом.
+// Пакетные операции учитываются в аналитике.
+//
+// POST /uzi/nodes/review
+func (UnimplementedHandler) UziNodesReviewPost(ctx context.Context, req *UziNodesReviewPostReq) (r UziNodesReviewPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UziPost implements POST /uzi operation.
 //
 // Загрузить узи на обработку.
@@ -480,6 +732,25 @@ func (UnimplementedHandler) UziPost(ctx context.Context, req *UziPostReq) (r Uzi
 	return r, ht.ErrNotImplemented
 }
 
+// UziSegmentDraftsIDAcceptPost implements POST /uzi/segment-drafts/{id}/accept operation.
+//
+// Из черновика создается ручной сегмент узла, черновик
+// удаляется.
+//
+// POST /uzi/segment-drafts/{id}/accept
+func (UnimplementedHandler) UziSegmentDraftsIDAcceptPost(ctx context.Context, params UziSegmentDraftsIDAcceptPostParams) (r UziSegmentDraftsIDAcceptPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UziSegmentDraftsIDDelete implements DELETE /uzi/segment-drafts/{id} operation.
+//
+// Отклонить черновик.
+//
+// DELETE /uzi/segment-drafts/{id}
+func (UnimplementedHandler) UziSegmentDraftsIDDelete(ctx context.Context, params UziSegmentDraftsIDDeleteParams) (r UziSegmentDraftsIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UziSegmentIDDelete implements DELETE /uzi/segment/{id} operation.
 //
 // Если у узла не останется сегментов, он будет **удален**.
@@ -498,6 +769,20 @@ func (UnimplementedHandler) UziSegmentIDPatch(ctx context.Context, req *UziSegme
 	return r, ht.ErrNotImplemented
 }
 
+// UziSegmentIDPropagatePost implements POST /uzi/segment/{id}/propagate operation.
+//
+// Контур сегмента переносится на соседние кадры узи,
+// результат сохраняется черновиками.
+// В каждую сторону распространение останавливается на
+// кадре, где у узла уже есть сегмент, или при потере
+// контура.
+// Прежние черновики узла на тех же кадрах заменяются.
+//
+// POST /uzi/segment/{id}/propagate
+func (UnimplementedHandler) UziSegmentIDPropagatePost(ctx context.Context, params UziSegmentIDPropagatePostParams) (r UziSegmentIDPropagatePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UziSegmentPost implements POST /uzi/segment operation.
 //
 // Добавить новый сегмент.
@@ -507,6 +792,19 @@ func (UnimplementedHandler) UziSegmentPost(ctx context.Context, req *UziSegmentP
 	return r, ht.ErrNotImplemented
 }
 
+// UzisAnalyticsGet implements GET /uzis/analytics operation.
+//
+// Точность нейросети относительно валидации, доля
+// непровалидированных узлов и распределение классов
+// TI-RADS по всей выборке, аппаратам, авторам и периодам.
+// Для узи, размеченных несколькими врачами, согласие
+// врачей: каппа Коэна и IoU контуров.
+//
+// GET /uzis/analytics
+func (UnimplementedHandler) UzisAnalyticsGet(ctx context.Context, params UzisAnalyticsGetParams) (r UzisAnalyticsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UzisAuthorIDGet implements GET /uzis/author/{id} operation.
 //
 // Получить узи по id автора.
@@ -525,6 +823,26 @@ func (UnimplementedHandler) UzisExternalIDGet(ctx context.Context, params UzisEx
 	return r, ht.ErrNotImplemented
 }
 
+// UzisExternalIDGrowthGet implements GET /uzis/external/{id}/growth operation.
+//
+// Получить динамику отслеживаемых узлов пациента.
+//
+// GET /uzis/external/{id}/growth
+func (UnimplementedHandler) UzisExternalIDGrowthGet(ctx context.Context, params UzisExternalIDGrowthGetParams) (r UzisExternalIDGrowthGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UzisSearchGet implements GET /uzis/search operation.
+//
+// Все фильтры необязательны. Узи отсортированы по дате
+// создания, для следующей страницы передайте next_cursor из
+// предыдущего ответа.
+//
+// GET /uzis/search
+func (UnimplementedHandler) UzisSearchGet(ctx context.Context, params UzisSearchGetParams) (r UzisSearchGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // YookassaWebhooksPost implements POST /yookassa/webhooks operation.
 //
 // Обработка уведомлений от Юкассы.
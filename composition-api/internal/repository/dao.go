@@ -8,6 +8,8 @@ import (
 type DAO interface {
 	NewFileRepo() FileRepo
 	NewFileRepoWithBucket(bucket string) FileRepo
+	NewUploadRepo() UploadRepo
+	NewDeidentificationRepo() DeidentificationRepo
 }
 
 type dao struct {
@@ -37,3 +39,17 @@ func (d *dao) NewFileRepoWithBucket(bucket string) FileRepo {
 		bucket: bucket,
 	}
 }
+
+func (d *dao) NewUploadRepo() UploadRepo {
+	return &uploadRepo{
+		s3:     &minio.Core{Client: d.s3},
+		bucket: d.s3bucket,
+	}
+}
+
+func (d *dao) NewDeidentificationRepo() DeidentificationRepo {
+	return &deidentificationRepo{
+		s3:     d.s3,
+		bucket: d.s3bucket,
+	}
+}
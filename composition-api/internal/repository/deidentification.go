@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	minio "github.com/minio/minio-go/v7"
+
+	domain "composition-api/internal/domain/deidentify"
+)
+
+// DeidentificationRepo отчеты об очистке метаданных, хранятся рядом с файлом в deidentification.json
+type DeidentificationRepo interface {
+	// SaveReport сохраняет отчет в каталог объекта objectPath, пустой bucket - основной бакет
+	SaveReport(ctx context.Context, bucket, objectPath string, report domain.Report) error
+}
+
+type deidentificationRepo struct {
+	s3     *minio.Client
+	bucket string
+}
+
+func reportPath(objectPath string) string {
+	return path.Join(path.Dir(objectPath), "deidentification.json")
+}
+
+type deidentificationRemoved struct {
+	Field  string `json:"field"`
+	Tag    string `json:"tag"`
+	Action string `json:"action"`
+}
+
+// deidentificationReport json представление отчета, то же используется в сессии загрузки
+type deidentificationReport struct {
+	Profile  string                    `json:"profile"`
+	Format   string                    `json:"format"`
+	Removed  []deidentificationRemoved `json:"removed"`
+	CreateAt time.Time                 `json:"create_at"`
+}
+
+func reportFromDomain(report domain.Report) deidentificationReport {
+	removed := make([]deidentificationRemoved, 0, len(report.Removed))
+	for _, r := range report.Removed {
+		removed = append(removed, deidentificationRemoved{
+			Field:  string(r.Field),
+			Tag:    r.Tag,
+			Action: string(r.Action),
+		})
+	}
+
+	return deidentificationReport{
+		Profile:  report.Profile.String(),
+		Format:   report.Format,
+		Removed:  removed,
+		CreateAt: report.CreateAt,
+	}
+}
+
+func (r deidentificationReport) toDomain() domain.Report {
+	removed := make([]domain.Removed, 0, len(r.Removed))
+	for _, item := range r.Removed {
+		removed = append(removed, domain.Removed{
+			Field:  domain.Field(item.Field),
+			Tag:    item.Tag,
+			Action: domain.Action(item.Action),
+		})
+	}
+
+	return domain.Report{
+		Profile:  domain.ProfileName(r.Profile),
+		Format:   r.Format,
+		Removed:  removed,
+		CreateAt: r.CreateAt,
+	}
+}
+
+func (r *deidentificationRepo) SaveReport(ctx context.Context, bucket, objectPath string, report domain.Report) error {
+	if bucket == "" {
+		bucket = r.bucket
+	}
+
+	data, err := json.Marshal(reportFromDomain(report))
+	if err != nil {
+		return fmt.Errorf("marshal report: %w", err)
+	}
+
+	_, err = r.s3.PutObject(ctx, bucket, reportPath(objectPath), bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: "application/json",
+	})
+	if err != nil {
+		return fmt.Errorf("put report: %w", err)
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ var ErrFileNotFound = errors.New("file not found")
 type FileRepo interface {
 	GetFile(ctx context.Context, path string) (io.ReadCloser, error)
 	LoadFile(ctx context.Context, path string, file ht.MultipartFile) error
+	DeleteFile(ctx context.Context, path string) error
 }
 
 type fileRepo struct {
@@ -50,3 +51,11 @@ func (r *fileRepo) LoadFile(ctx context.Context, path string, file ht.MultipartF
 
 	return nil
 }
+
+func (r *fileRepo) DeleteFile(ctx context.Context, path string) error {
+	if err := r.s3.RemoveObject(ctx, r.bucket, path, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove object: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	minio "github.com/minio/minio-go/v7"
+
+	deidentifyDomain "composition-api/internal/domain/deidentify"
+	domain "composition-api/internal/domain/upload"
+)
+
+var (
+	ErrUploadNotFound = errors.New("upload not found")
+	// ErrUploadChanged сессию изменили после чтения
+	ErrUploadChanged = errors.New("upload changed")
+)
+
+// UploadRepo сессии загрузки частями: файл собирается S3 multipart в uploads/<id>/file,
+// сессия хранится рядом в uploads/<id>/session.json,
+// очередь обработки собранных файлов - пустые объекты uploads/processing/<id>
+type UploadRepo interface {
+	SaveUpload(ctx context.Context, upload domain.Upload) error
+	// UpdateUpload сохраняет сессию, только если она не менялась с чтения (If-Match по upload.Version),
+	// иначе ErrUploadChanged. Возвращает сессию с новой версией
+	UpdateUpload(ctx context.Context, upload domain.Upload) (domain.Upload, error)
+	GetUpload(ctx context.Context, id uuid.UUID) (domain.Upload, error)
+	// ListUploads id всех сессий в бакете загрузок
+	ListUploads(ctx context.Context) ([]uuid.UUID, error)
+	// DeleteUpload удаляет сессию вместе со всеми объектами uploads/<id>/
+	DeleteUpload(ctx context.Context, id uuid.UUID) error
+
+	// NewMultipartUpload возвращает id multipart загрузки в S3
+	NewMultipartUpload(ctx context.Context, id uuid.UUID, contentType string) (string, error)
+	PresignPart(ctx context.Context, upload domain.Upload, number int, expires time.Duration) (string, error)
+	ListParts(ctx context.Context, upload domain.Upload) ([]domain.UploadPart, error)
+	CompleteMultipartUpload(ctx context.Context, upload domain.Upload, parts []domain.UploadPart) error
+	AbortMultipartUpload(ctx context.Context, upload domain.Upload) error
+
+	EnqueueProcessing(ctx context.Context, id uuid.UUID) error
+	ListProcessing(ctx context.Context) ([]uuid.UUID, error)
+	DequeueProcessing(ctx context.Context, id uuid.UUID) error
+
+	// UploadedFilePath путь собранного файла в бакете загрузок
+	UploadedFilePath(id uuid.UUID) string
+	// MoveUploadedFile переносит собранный файл в bucket/path серверным копированием,
+	// пустой bucket - бакет загрузок
+	MoveUploadedFile(ctx context.Context, id uuid.UUID, bucket, path string) error
+	DeleteUploadedFile(ctx context.Context, id uuid.UUID) error
+}
+
+type uploadRepo struct {
+	s3     *minio.Core
+	bucket string
+}
+
+func uploadDir(id uuid.UUID) string {
+	return "uploads/" + id.String()
+}
+
+func (r *uploadRepo) UploadedFilePath(id uuid.UUID) string {
+	return uploadDir(id) + "/file"
+}
+
+// uploadSession json представление сессии
+type uploadSession struct {
+	Id          uuid.UUID `json:"id"`
+	Owner       uuid.UUID `json:"owner"`
+	Kind        string    `json:"kind"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	PartSize    int64     `json:"part_size"`
+	Sha256      *string   `json:"sha256,omitempty"`
+	Status      string    `json:"status"`
+	CreateAt    time.Time `json:"create_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	S3UploadID  string    `json:"s3_upload_id"`
+
+	ProcessingUntil *time.Time `json:"processing_until,omitempty"`
+	FailReason      *string    `json:"fail_reason,omitempty"`
+
+	Deidentification *deidentificationReport `json:"deidentification,omitempty"`
+}
+
+func (r *uploadRepo) SaveUpload(ctx context.Context, upload domain.Upload) error {
+	_, err := r.putSession(ctx, upload, minio.PutObjectOptions{})
+	return err
+}
+
+func (r *uploadRepo) UpdateUpload(ctx context.Context, upload domain.Upload) (domain.Upload, error) {
+	opts := minio.PutObjectOptions{}
+	opts.SetMatchETag(upload.Version)
+
+	info, err := r.putSession(ctx, upload, opts)
+	if err != nil {
+		var errResp minio.ErrorResponse
+		if errors.As(err, &errResp) && errResp.Code == "PreconditionFailed" {
+			return domain.Upload{}, ErrUploadChanged
+		}
+		return domain.Upload{}, err
+	}
+
+	upload.Version = info.ETag
+	return upload, nil
+}
+
+func (r *uploadRepo) putSession(ctx context.Context, upload domain.Upload, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	data, err := json.Marshal(uploadSession{
+		Id:          upload.Id,
+		Owner:       upload.Owner,
+		Kind:        upload.Kind.String(),
+		FileName:    upload.FileName,
+		ContentType: upload.ContentType,
+		Size:        upload.Size,
+		PartSize:    upload.PartSize,
+		Sha256:      upload.Sha256,
+		Status:      upload.Status.String(),
+		CreateAt:    upload.CreateAt,
+		ExpiresAt:   upload.ExpiresAt,
+		S3UploadID:  upload.S3UploadID,
+
+		ProcessingUntil: upload.ProcessingUntil,
+		FailReason:      upload.FailReason,
+
+		Deidentification: deidentificationToSession(upload.Deidentification),
+	})
+	if err != nil {
+		return minio.UploadInfo{}, fmt.Errorf("marshal upload: %w", err)
+	}
+
+	opts.ContentType = "application/json"
+	info, err := r.s3.Client.PutObject(ctx, r.bucket, uploadDir(upload.Id)+"/session.json", bytes.NewReader(data), int64(len(data)), opts)
+	if err != nil {
+		return minio.UploadInfo{}, fmt.Errorf("put upload session: %w", err)
+	}
+
+	return info, nil
+}
+
+func (r *uploadRepo) GetUpload(ctx context.Context, id uuid.UUID) (domain.Upload, error) {
+	obj, err := r.s3.Client.GetObject(ctx, r.bucket, uploadDir(id)+"/session.json", minio.GetObjectOptions{})
+	if err != nil {
+		return domain.Upload{}, fmt.Errorf("get upload session: %w", err)
+	}
+	defer obj.Close()
+
+	var session uploadSession
+	if err := json.NewDecoder(obj).Decode(&session); err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return domain.Upload{}, ErrUploadNotFound
+		}
+		return domain.Upload{}, fmt.Errorf("decode upload session: %w", err)
+	}
+	info, err := obj.Stat()
+	if err != nil {
+		return domain.Upload{}, fmt.Errorf("stat upload session: %w", err)
+	}
+
+	var deidentification *deidentifyDomain.Report
+	if session.Deidentification != nil {
+		report := session.Deidentification.toDomain()
+		deidentification = &report
+	}
+
+	return domain.Upload{
+		Id:          session.Id,
+		Owner:       session.Owner,
+		Kind:        domain.UploadKind(session.Kind),
+		FileName:    session.FileName,
+		ContentType: session.ContentType,
+		Size:        session.Size,
+		PartSize:    session.PartSize,
+		Sha256:      session.Sha256,
+		Status:      domain.UploadStatus(session.Status),
+		CreateAt:    session.CreateAt,
+		ExpiresAt:   session.ExpiresAt,
+		S3UploadID:  session.S3UploadID,
+		Version:     info.ETag,
+
+		ProcessingUntil: session.ProcessingUntil,
+		FailReason:      session.FailReason,
+
+		Deidentification: deidentification,
+	}, nil
+}
+
+func (r *uploadRepo) ListUploads(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	// без Recursive S3 отдает каталоги uploads/<id>/ общими префиксами
+	for obj := range r.s3.Client.ListObjects(ctx, r.bucket, minio.ListObjectsOptions{Prefix: "uploads/"}) {
+		if obj.Err != nil {
+			return nil, fmt.Errorf("list uploads: %w", obj.Err)
+		}
+		id, err := uuid.Parse(strings.TrimSuffix(strings.TrimPrefix(obj.Key, "uploads/"), "/"))
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (r *uploadRepo) DeleteUpload(ctx context.Context, id uuid.UUID) error {
+	var keys []string
+	for obj := range r.s3.Client.ListObjects(ctx, r.bucket, minio.ListObjectsOptions{Prefix: uploadDir(id) + "/", Recursive: true}) {
+		if obj.Err != nil {
+			return fmt.Errorf("list upload objects: %w", obj.Err)
+		}
+		keys = append(keys, obj.Key)
+	}
+
+	for _, key := range keys {
+		if err := r.s3.Client.RemoveObject(ctx, r.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+			return fmt.Errorf("remove upload object %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func deidentificationToSession(report *deidentifyDomain.Report) *deidentificationReport {
+	if report == nil {
+		return nil
+	}
+	session := reportFromDomain(*report)
+	return &session
+}
+
+const processingDir = "uploads/processing/"
+
+func (r *uploadRepo) EnqueueProcessing(ctx context.Context, id uuid.UUID) error {
+	_, err := r.s3.Client.PutObject(ctx, r.bucket, processingDir+id.String(), bytes.NewReader(nil), 0, minio.PutObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("put processing marker: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadRepo) ListProcessing(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for obj := range r.s3.Client.ListObjects(ctx, r.bucket, minio.ListObjectsOptions{Prefix: processingDir}) {
+		if obj.Err != nil {
+			return nil, fmt.Errorf("list processing markers: %w", obj.Err)
+		}
+		id, err := uuid.Parse(strings.TrimPrefix(obj.Key, processingDir))
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (r *uploadRepo) DequeueProcessing(ctx context.Context, id uuid.UUID) error {
+	if err := r.s3.Client.RemoveObject(ctx, r.bucket, processingDir+id.String(), minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove processing marker: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadRepo) NewMultipartUpload(ctx context.Context, id uuid.UUID, contentType string) (string, error) {
+	s3UploadID, err := r.s3.NewMultipartUpload(ctx, r.bucket, r.UploadedFilePath(id), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("new multipart upload: %w", err)
+	}
+
+	return s3UploadID, nil
+}
+
+func (r *uploadRepo) PresignPart(ctx context.Context, upload domain.Upload, number int, expires time.Duration) (string, error) {
+	params := url.Values{}
+	params.Set("partNumber", strconv.Itoa(number))
+	params.Set("uploadId", upload.S3UploadID)
+
+	u, err := r.s3.Client.Presign(ctx, "PUT", r.bucket, r.UploadedFilePath(upload.Id), expires, params)
+	if err != nil {
+		return "", fmt.Errorf("presign part %d: %w", number, err)
+	}
+
+	return u.String(), nil
+}
+
+func (r *uploadRepo) ListParts(ctx context.Context, upload domain.Upload) ([]domain.UploadPart, error) {
+	var parts []domain.UploadPart
+
+	marker := 0
+	for {
+		res, err := r.s3.ListObjectParts(ctx, r.bucket, r.UploadedFilePath(upload.Id), upload.S3UploadID, marker, 1000)
+		if err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchUpload" {
+				return nil, ErrUploadNotFound
+			}
+			return nil, fmt.Errorf("list object parts: %w", err)
+		}
+
+		for _, part := range res.ObjectParts {
+			parts = append(parts, domain.UploadPart{
+				Number: part.PartNumber,
+				Size:   part.Size,
+				ETag:   part.ETag,
+			})
+		}
+
+		if !res.IsTruncated {
+			return parts, nil
+		}
+		marker = res.NextPartNumberMarker
+	}
+}
+
+func (r *uploadRepo) CompleteMultipartUpload(ctx context.Context, upload domain.Upload, parts []domain.UploadPart) error {
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, part := range parts {
+		completeParts = append(completeParts, minio.CompletePart{
+			PartNumber: part.Number,
+			ETag:       part.ETag,
+		})
+	}
+
+	_, err := r.s3.CompleteMultipartUpload(ctx, r.bucket, r.UploadedFilePath(upload.Id), upload.S3UploadID, completeParts, minio.PutObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("complete multipart upload: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadRepo) AbortMultipartUpload(ctx context.Context, upload domain.Upload) error {
+	err := r.s3.AbortMultipartUpload(ctx, r.bucket, r.UploadedFilePath(upload.Id), upload.S3UploadID)
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchUpload" {
+		return fmt.Errorf("abort multipart upload: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadRepo) MoveUploadedFile(ctx context.Context, id uuid.UUID, bucket, path string) error {
+	if bucket == "" {
+		bucket = r.bucket
+	}
+
+	src := minio.CopySrcOptions{Bucket: r.bucket, Object: r.UploadedFilePath(id)}
+	dst := minio.CopyDestOptions{Bucket: bucket, Object: path}
+
+	// ComposeObject копирует частями, поэтому подходит для файлов больше 5GB
+	if _, err := r.s3.Client.ComposeObject(ctx, dst, src); err != nil {
+		return fmt.Errorf("compose object: %w", err)
+	}
+
+	if err := r.s3.Client.RemoveObject(ctx, r.bucket, src.Object, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove uploaded file: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadRepo) DeleteUploadedFile(ctx context.Context, id uuid.UUID) error {
+	if err := r.s3.Client.RemoveObject(ctx, r.bucket, r.UploadedFilePath(id), minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove uploaded file: %w", err)
+	}
+
+	return nil
+}
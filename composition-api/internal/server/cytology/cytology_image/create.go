@@ -14,12 +14,14 @@ import (
 
 	api "composition-api/internal/generated/http/api"
 	mappers "composition-api/internal/server/cytology/mappers"
+	"composition-api/internal/server/security"
 )
 
 func (h *handler) CytologyCreateCreate(ctx context.Context, req *api.CytologyCreateCreateReq) (api.CytologyCreateCreateRes, error) {
 	slog.Info("CytologyCreateCreate: received request",
 		"diagnostic_number", req.DiagnosticNumber,
 		"has_image", req.Image.Set,
+		"has_upload", req.UploadID.Set,
 		"image_size", func() int64 {
 			if req.Image.Set {
 				return req.Image.Value.Size
@@ -28,6 +30,20 @@ func (h *handler) CytologyCreateCreate(ctx context.Context, req *api.CytologyCre
 		}(),
 	)
 
+	if req.Image.Set && req.UploadID.Set {
+		return &api.CytologyCreateCreateBadRequest{
+			StatusCode: http.StatusBadRequest,
+			Response: api.Error{
+				Message: "Нужно указать только одно из image или upload_id",
+			},
+		}, nil
+	}
+
+	token, err := security.ParseToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Получаем карточку пациента для извлечения patient_id и doctor_id
 	// patient_id и doctor_id должны браться из карточки
 	if !req.PatientCard.Set {
@@ -58,6 +74,7 @@ func (h *handler) CytologyCreateCreate(ctx context.Context, req *api.CytologyCre
 	doctorID := card.DoctorID
 
 	arg := mappers.CytologyImage{}.CreateArgFromCytologyCreateCreateReq(req, doctorID, patientID)
+	arg.Owner = token.Id
 
 	startTime := time.Now()
 	id, err := h.services.CytologyService.CreateCytologyImage(ctx, arg)
@@ -69,7 +86,14 @@ func (h *handler) CytologyCreateCreate(ctx context.Context, req *api.CytologyCre
 			"duration_ms", duration.Milliseconds(),
 		)
 		switch {
-		case errors.Is(err, domain.ErrBadRequest):
+		case errors.Is(err, domain.ErrConflict):
+			return &api.CytologyCreateCreateConflict{
+				StatusCode: http.StatusConflict,
+				Response: api.Error{
+					Message: "Такой файл уже загружен для пациента или загрузка не завершена",
+				},
+			}, nil
+		case errors.Is(err, domain.ErrBadRequest), errors.Is(err, domain.ErrNotFound):
 			return &api.CytologyCreateCreateBadRequest{
 				StatusCode: http.StatusBadRequest,
 				Response: api.Error{
@@ -11,11 +11,14 @@ type CytologyImageHandler interface {
 	CytologyCreateCreate(ctx context.Context, req *api.CytologyCreateCreateReq) (api.CytologyCreateCreateRes, error)
 	CytologyRead(ctx context.Context, params api.CytologyReadParams) (api.CytologyReadRes, error)
 	CytologySegmentsList(ctx context.Context, params api.CytologySegmentsListParams) (api.CytologySegmentsListRes, error)
+	CytologySegmentsViewport(ctx context.Context, params api.CytologySegmentsViewportParams) (api.CytologySegmentsViewportRes, error)
 	CytologyUpdateUpdate(ctx context.Context, req *api.CytologyUpdateUpdateReq, params api.CytologyUpdateUpdateParams) (api.CytologyUpdateUpdateRes, error)
 	CytologyUpdatePartialUpdate(ctx context.Context, req *api.CytologyUpdatePartialUpdateReq, params api.CytologyUpdatePartialUpdateParams) (api.CytologyUpdatePartialUpdateRes, error)
 	CytologyCopyCreate(ctx context.Context, req *api.CytologyCopyCreateReq) (api.CytologyCopyCreateRes, error)
 	CytologyHistoryRead(ctx context.Context, params api.CytologyHistoryReadParams) (api.CytologyHistoryReadRes, error)
 	CytologyPatientShotsRead(ctx context.Context, params api.CytologyPatientShotsReadParams) (api.CytologyPatientShotsReadRes, error)
+	CytologyQuPathExport(ctx context.Context, params api.CytologyQuPathExportParams) (api.CytologyQuPathExportRes, error)
+	CytologyQuPathImport(ctx context.Context, req api.CytologyQuPathImportReq, params api.CytologyQuPathImportParams) (api.CytologyQuPathImportRes, error)
 }
 
 type handler struct {
@@ -104,6 +104,10 @@ func (m *mockCytologyService) GetSegmentsByGroupId(context.Context, int) ([]cyto
 	panic("not implemented")
 }
 
+func (m *mockCytologyService) GetSegmentsInViewport(context.Context, cytology_srv.GetSegmentsInViewportArg) (cytology_domain.SegmentationPage, error) {
+	panic("not implemented")
+}
+
 func (m *mockCytologyService) UpdateSegmentation(context.Context, cytology_srv.UpdateSegmentationArg) (cytology_domain.Segmentation, error) {
 	panic("not implemented")
 }
@@ -120,6 +124,14 @@ func (m *mockCytologyService) GetCytologyImageHistory(context.Context, uuid.UUID
 	panic("not implemented")
 }
 
+func (m *mockCytologyService) ExportQuPath(context.Context, uuid.UUID) ([]byte, error) {
+	panic("not implemented")
+}
+
+func (m *mockCytologyService) ImportQuPath(context.Context, uuid.UUID, []byte) (cytology_domain.QuPathImport, error) {
+	panic("not implemented")
+}
+
 type mockPatientService struct {
 	patient med_domain.Patient
 	err     error
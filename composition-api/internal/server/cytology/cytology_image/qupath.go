@@ -0,0 +1,78 @@
+package cytology_image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"composition-api/internal/domain"
+	api "composition-api/internal/generated/http/api"
+)
+
+func (h *handler) CytologyQuPathExport(ctx context.Context, params api.CytologyQuPathExportParams) (api.CytologyQuPathExportRes, error) {
+	geojson, err := h.services.CytologyService.ExportQuPath(ctx, params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return &api.CytologyQuPathExportNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "Цитологическое исследование не найдено",
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return &api.CytologyQuPathExportOK{Data: bytes.NewReader(geojson)}, nil
+}
+
+func (h *handler) CytologyQuPathImport(ctx context.Context, req api.CytologyQuPathImportReq, params api.CytologyQuPathImportParams) (api.CytologyQuPathImportRes, error) {
+	geojson, err := io.ReadAll(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("read geojson: %w", err)
+	}
+
+	res, err := h.services.CytologyService.ImportQuPath(ctx, params.ID, geojson)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBadRequest):
+			return &api.CytologyQuPathImportBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: "Неверный формат GeoJSON",
+				},
+			}, nil
+		case errors.Is(err, domain.ErrNotFound):
+			return &api.CytologyQuPathImportNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "Цитологическое исследование не найдено",
+				},
+			}, nil
+		case errors.Is(err, domain.ErrUnprocessableEntity), errors.Is(err, domain.ErrConflict):
+			return &api.CytologyQuPathImportUnprocessableEntity{
+				StatusCode: http.StatusUnprocessableEntity,
+				Response: api.Error{
+					Message: "Исследование не последней версии",
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return &api.CytologyQupathImport{
+		ID:             res.CytologyImage.Id,
+		Added:          res.Added,
+		Removed:        res.Removed,
+		Changed:        res.Changed,
+		Unchanged:      res.Unchanged,
+		Skipped:        res.Skipped,
+		UnknownClasses: res.UnknownClasses,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package cytology_image
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+
+	"composition-api/internal/domain"
+	domainCytology "composition-api/internal/domain/cytology"
+	api "composition-api/internal/generated/http/api"
+	mappers "composition-api/internal/server/cytology/mappers"
+	cytologySrv "composition-api/internal/services/cytology"
+)
+
+func (h *handler) CytologySegmentsViewport(ctx context.Context, params api.CytologySegmentsViewportParams) (api.CytologySegmentsViewportRes, error) {
+	filter := domainCytology.SegmentationViewportFilter{}
+	if segType, ok := params.SegType.Get(); ok {
+		filter.SegType = pointer.To(domainCytology.SegType(segType))
+	}
+	if groupType, ok := params.GroupType.Get(); ok {
+		filter.GroupType = pointer.To(domainCytology.GroupType(groupType))
+	}
+	if isAI, ok := params.IsAi.Get(); ok {
+		filter.IsAI = &isAI
+	}
+
+	var cursor *string
+	if v, ok := params.Cursor.Get(); ok {
+		cursor = &v
+	}
+
+	page, err := h.services.CytologyService.GetSegmentsInViewport(ctx, cytologySrv.GetSegmentsInViewportArg{
+		CytologyID: params.ID,
+		BBox: domainCytology.BBox{
+			MinX: params.MinX,
+			MinY: params.MinY,
+			MaxX: params.MaxX,
+			MaxY: params.MaxY,
+		},
+		Filter:     filter,
+		Downsample: params.Downsample.Or(0),
+		Cursor:     cursor,
+		Limit:      params.Limit.Or(0),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrBadRequest) {
+			return &api.CytologySegmentsViewportBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: "Неверные параметры области просмотра",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return pointer.To(mappers.Segmentation{}.ToCytologySegmentationPage(page)), nil
+}
@@ -34,6 +34,11 @@ func (CytologyImage) CreateArgFromCytologyCreateCreateReq(req *api.CytologyCreat
 		arg.ContentType = contentType
 	}
 
+	// Или файл, заранее загруженный частями
+	if req.UploadID.Set {
+		arg.UploadID = &req.UploadID.Value
+	}
+
 	if req.DiagnosticMarking.Set {
 		marking := domain.DiagnosticMarking(req.DiagnosticMarking.Value)
 		arg.DiagnosticMarking = &marking
@@ -2,7 +2,10 @@ package mappers
 
 import (
 	"encoding/json"
+	"fmt"
 
+	"github.com/AlekSi/pointer"
+	"github.com/go-faster/jx"
 	"github.com/google/uuid"
 
 	domain "composition-api/internal/domain/cytology"
@@ -38,11 +41,14 @@ func (SegmentationGroup) ToSegmentationDataList(groups []domain.SegmentationGrou
 						Value: point.Id,
 						Set:   true,
 					},
-					UID: int(point.UID), // UID имеет тип int в API
-					X:   point.X,
-					Y:   point.Y,
+					UID:     int(point.UID), // UID имеет тип int в API
+					X:       point.X,
+					Y:       point.Y,
+					Polygon: api.NewOptInt(point.Polygon),
+					Ring:    api.NewOptInt(point.Ring),
 				})
 			}
+			meta := segmentMetaToAPI(seg)
 			dataItems = append(dataItems, api.CytologySegmentsListOKResultsItemDataItem{
 				ID: api.OptInt{
 					Value: seg.Id,
@@ -53,6 +59,11 @@ func (SegmentationGroup) ToSegmentationDataList(groups []domain.SegmentationGrou
 					// Details может быть в группе, но не в сегменте
 					Set: false,
 				},
+				GeometryType: meta.geometryType,
+				Color:        meta.color,
+				IsLocked:     meta.isLocked,
+				Confidence:   meta.confidence,
+				Properties:   meta.properties,
 			})
 		}
 
@@ -119,8 +130,10 @@ func (SegmentationGroup) CreateArgFromCytologySegmentGroupCreateCreateReq(cytolo
 	points := make([]domain.SegmentationPoint, 0, len(req.Data.Points))
 	for _, point := range req.Data.Points {
 		points = append(points, domain.SegmentationPoint{
-			X: point.X,
-			Y: point.Y,
+			Polygon: point.Polygon.Or(0),
+			Ring:    point.Ring.Or(0),
+			X:       point.X,
+			Y:       point.Y,
 		})
 	}
 
@@ -128,7 +141,12 @@ func (SegmentationGroup) CreateArgFromCytologySegmentGroupCreateCreateReq(cytolo
 	// Но group ID будет известен только после создания, поэтому возвращаем только points
 	segArg := cytologySrv.CreateSegmentationArg{
 		SegmentationGroupID: 0, // Будет установлен после создания группы
+		GeometryType:        geometryTypeFromAPI(req.Data.GeometryType),
 		Points:              points,
+		Color:               colorFromAPI(req.Data.Color),
+		IsLocked:            req.Data.IsLocked.Or(false),
+		Confidence:          confidenceFromAPI(req.Data.Confidence),
+		Properties:          propertiesFromAPI(req.Data.Properties),
 	}
 
 	return groupArg, segArg
@@ -138,26 +156,36 @@ func (SegmentationGroup) ToCytologySegmentGroupCreateCreateCreatedDataPoints(poi
 	result := make([]api.CytologySegmentGroupCreateCreateCreatedDataPointsItem, 0, len(points))
 	for _, point := range points {
 		result = append(result, api.CytologySegmentGroupCreateCreateCreatedDataPointsItem{
-			X: point.X,
-			Y: point.Y,
+			X:       point.X,
+			Y:       point.Y,
+			Polygon: point.Polygon,
+			Ring:    point.Ring,
 		})
 	}
 	return result
 }
 
 func (Segmentation) ToCytologySegmentUpdateReadOK(seg domain.Segmentation) api.CytologySegmentUpdateReadOK {
+	meta := segmentMetaToAPI(seg)
 	result := api.CytologySegmentUpdateReadOK{
 		Points: make([]api.CytologySegmentUpdateReadOKPointsItem, 0, len(seg.Points)),
 		SegmentGroup: api.OptInt{
 			Value: seg.SegmentationGroupID,
 			Set:   true,
 		},
+		GeometryType: meta.geometryType,
+		Color:        meta.color,
+		IsLocked:     meta.isLocked,
+		Confidence:   meta.confidence,
+		Properties:   meta.properties,
 	}
 
 	for _, point := range seg.Points {
 		result.Points = append(result.Points, api.CytologySegmentUpdateReadOKPointsItem{
-			X: point.X,
-			Y: point.Y,
+			X:       point.X,
+			Y:       point.Y,
+			Polygon: api.NewOptInt(point.Polygon),
+			Ring:    api.NewOptInt(point.Ring),
 		})
 	}
 
@@ -168,45 +196,73 @@ func (Segmentation) UpdateArgFromCytologySegmentUpdateUpdateReq(id int, req *api
 	points := make([]domain.SegmentationPoint, 0, len(req.Points))
 	for _, point := range req.Points {
 		points = append(points, domain.SegmentationPoint{
-			X: point.X,
-			Y: point.Y,
+			Polygon: point.Polygon.Or(0),
+			Ring:    point.Ring.Or(0),
+			X:       point.X,
+			Y:       point.Y,
 		})
 	}
 
-	return cytologySrv.UpdateSegmentationArg{
-		Id:     id,
-		Points: points,
+	arg := cytologySrv.UpdateSegmentationArg{
+		Id:           id,
+		Points:       points,
+		GeometryType: geometryTypeFromAPI(req.GeometryType),
+		Color:        colorFromAPI(req.Color),
+		Confidence:   confidenceFromAPI(req.Confidence),
+		Properties:   propertiesFromAPI(req.Properties),
 	}
+	if v, ok := req.IsLocked.Get(); ok {
+		arg.IsLocked = &v
+	}
+	return arg
 }
 
 func (Segmentation) UpdateArgFromCytologySegmentUpdatePartialUpdateReq(id int, req *api.CytologySegmentUpdatePartialUpdateReq) cytologySrv.UpdateSegmentationArg {
 	points := make([]domain.SegmentationPoint, 0, len(req.Points))
 	for _, point := range req.Points {
 		points = append(points, domain.SegmentationPoint{
-			X: point.X,
-			Y: point.Y,
+			Polygon: point.Polygon.Or(0),
+			Ring:    point.Ring.Or(0),
+			X:       point.X,
+			Y:       point.Y,
 		})
 	}
 
-	return cytologySrv.UpdateSegmentationArg{
-		Id:     id,
-		Points: points,
+	arg := cytologySrv.UpdateSegmentationArg{
+		Id:           id,
+		Points:       points,
+		GeometryType: geometryTypeFromAPI(req.GeometryType),
+		Color:        colorFromAPI(req.Color),
+		Confidence:   confidenceFromAPI(req.Confidence),
+		Properties:   propertiesFromAPI(req.Properties),
+	}
+	if v, ok := req.IsLocked.Get(); ok {
+		arg.IsLocked = &v
 	}
+	return arg
 }
 
 func (Segmentation) ToCytologySegmentUpdateUpdateOK(seg domain.Segmentation, req *api.CytologySegmentUpdateUpdateReq) api.CytologySegmentUpdateUpdateOK {
+	meta := segmentMetaToAPI(seg)
 	result := api.CytologySegmentUpdateUpdateOK{
 		Points: make([]api.CytologySegmentUpdateUpdateOKPointsItem, 0, len(seg.Points)),
 		SegmentGroup: api.OptInt{
 			Value: seg.SegmentationGroupID,
 			Set:   true,
 		},
+		GeometryType: meta.geometryType,
+		Color:        meta.color,
+		IsLocked:     meta.isLocked,
+		Confidence:   meta.confidence,
+		Properties:   meta.properties,
 	}
 
 	for _, point := range seg.Points {
 		result.Points = append(result.Points, api.CytologySegmentUpdateUpdateOKPointsItem{
-			X: point.X,
-			Y: point.Y,
+			X:       point.X,
+			Y:       point.Y,
+			Polygon: api.NewOptInt(point.Polygon),
+			Ring:    api.NewOptInt(point.Ring),
 		})
 	}
 
@@ -214,20 +270,135 @@ func (Segmentation) ToCytologySegmentUpdateUpdateOK(seg domain.Segmentation, req
 }
 
 func (Segmentation) ToCytologySegmentUpdatePartialUpdateOK(seg domain.Segmentation, req *api.CytologySegmentUpdatePartialUpdateReq) api.CytologySegmentUpdatePartialUpdateOK {
+	meta := segmentMetaToAPI(seg)
 	result := api.CytologySegmentUpdatePartialUpdateOK{
 		Points: make([]api.CytologySegmentUpdatePartialUpdateOKPointsItem, 0, len(seg.Points)),
 		SegmentGroup: api.OptInt{
 			Value: seg.SegmentationGroupID,
 			Set:   true,
 		},
+		GeometryType: meta.geometryType,
+		Color:        meta.color,
+		IsLocked:     meta.isLocked,
+		Confidence:   meta.confidence,
+		Properties:   meta.properties,
 	}
 
 	for _, point := range seg.Points {
 		result.Points = append(result.Points, api.CytologySegmentUpdatePartialUpdateOKPointsItem{
-			X: point.X,
-			Y: point.Y,
+			X:       point.X,
+			Y:       point.Y,
+			Polygon: api.NewOptInt(point.Polygon),
+			Ring:    api.NewOptInt(point.Ring),
 		})
 	}
 
 	return result
 }
+
+// segmentMeta метаданные сегментации в типах API, общие для всех ответов
+type segmentMeta struct {
+	geometryType api.OptCytologyGeometryType
+	color        api.OptCytologyColor
+	isLocked     api.OptBool
+	confidence   api.OptFloat64
+	properties   api.OptCytologySegmentProperties
+}
+
+func segmentMetaToAPI(seg domain.Segmentation) segmentMeta {
+	meta := segmentMeta{
+		isLocked: api.NewOptBool(seg.IsLocked),
+	}
+	if seg.GeometryType != "" {
+		meta.geometryType = api.NewOptCytologyGeometryType(api.CytologyGeometryType(seg.GeometryType))
+	}
+	if seg.Color != nil {
+		meta.color = api.NewOptCytologyColor(api.CytologyColor(fmt.Sprintf("#%02x%02x%02x", seg.Color.R, seg.Color.G, seg.Color.B)))
+	}
+	if seg.Confidence != nil {
+		meta.confidence = api.NewOptFloat64(*seg.Confidence)
+	}
+	if seg.Properties != nil {
+		properties := api.CytologySegmentProperties{}
+		if err := properties.Decode(jx.DecodeStr(*seg.Properties)); err == nil {
+			meta.properties = api.NewOptCytologySegmentProperties(properties)
+		}
+	}
+	return meta
+}
+
+func geometryTypeFromAPI(v api.OptCytologyGeometryType) *domain.GeometryType {
+	geometryType, ok := v.Get()
+	if !ok {
+		return nil
+	}
+	return pointer.To(domain.GeometryType(geometryType))
+}
+
+// colorFromAPI формат цвета #rrggbb проверен ogen
+func colorFromAPI(v api.OptCytologyColor) *domain.Color {
+	hex, ok := v.Get()
+	if !ok {
+		return nil
+	}
+	color := domain.Color{}
+	if _, err := fmt.Sscanf(string(hex), "#%02x%02x%02x", &color.R, &color.G, &color.B); err != nil {
+		return nil
+	}
+	return &color
+}
+
+func confidenceFromAPI(v api.OptFloat64) *float64 {
+	confidence, ok := v.Get()
+	if !ok {
+		return nil
+	}
+	return &confidence
+}
+
+func propertiesFromAPI(v api.OptCytologySegmentProperties) *string {
+	properties, ok := v.Get()
+	if !ok {
+		return nil
+	}
+	e := jx.Encoder{}
+	properties.Encode(&e)
+	return pointer.To(e.String())
+}
+
+func (Segmentation) ToCytologySegmentationPage(page domain.SegmentationPage) api.CytologySegmentationPage {
+	result := api.CytologySegmentationPage{
+		Segmentations: make([]api.Segmentation, 0, len(page.Segmentations)),
+	}
+	if page.NextCursor != nil {
+		result.NextCursor = api.NewOptString(*page.NextCursor)
+	}
+
+	for _, seg := range page.Segmentations {
+		meta := segmentMetaToAPI(seg)
+		item := api.Segmentation{
+			ID:                  seg.Id,
+			SegmentationGroupID: seg.SegmentationGroupID,
+			Points:              make([]api.SegmentationPoint, 0, len(seg.Points)),
+			CreateAt:            seg.CreateAt,
+			GeometryType:        meta.geometryType,
+			Color:               meta.color,
+			IsLocked:            meta.isLocked,
+			Confidence:          meta.confidence,
+			Properties:          meta.properties,
+		}
+		for _, point := range seg.Points {
+			item.Points = append(item.Points, api.SegmentationPoint{
+				ID:      api.NewOptInt(point.Id),
+				UID:     api.NewOptInt(int(point.UID)),
+				X:       point.X,
+				Y:       point.Y,
+				Polygon: api.NewOptInt(point.Polygon),
+				Ring:    api.NewOptInt(point.Ring),
+			})
+		}
+		result.Segmentations = append(result.Segmentations, item)
+	}
+
+	return result
+}
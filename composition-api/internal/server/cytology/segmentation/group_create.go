@@ -50,8 +50,15 @@ func (h *handler) CytologySegmentGroupCreateCreate(ctx context.Context, req *api
 	segArg.SegmentationGroupID = groupID
 	_, err = h.services.CytologyService.CreateSegmentation(ctx, segArg)
 	if err != nil {
-		// Если не удалось создать сегмент, возвращаем ошибку
 		// TODO: Возможно, стоит удалить созданную группу
+		if errors.Is(err, domain.ErrBadRequest) {
+			return &api.CytologySegmentGroupCreateCreateBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: "Некорректная геометрия сегмента",
+				},
+			}, nil
+		}
 		return nil, err
 	}
 
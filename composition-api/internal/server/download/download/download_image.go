@@ -3,16 +3,20 @@ package download
 import (
 	"context"
 
+	uzidomain "composition-api/internal/domain/uzi"
 	api "composition-api/internal/generated/http/api"
 )
 
 func (h *handler) DownloadUziIDImageIDGet(ctx context.Context, params api.DownloadUziIDImageIDGetParams) (api.DownloadUziIDImageIDGetRes, error) {
-	image, err := h.services.DownloadService.GetImage(ctx, params.UziID, params.ImageID)
+	size := uzidomain.ImageSize(params.Size.Or(api.ImageSizeOriginal))
+
+	image, served, err := h.services.DownloadService.GetImage(ctx, params.UziID, params.ImageID, size)
 	if err != nil {
 		return nil, err
 	}
 
-	return &api.DownloadUziIDImageIDGetOK{
-		Data: image,
-	}, nil
+	if served == uzidomain.ImageSizeOriginal {
+		return &api.DownloadUziIDImageIDGetOKImagePNG{Data: image}, nil
+	}
+	return &api.DownloadUziIDImageIDGetOKImageJpeg{Data: image}, nil
 }
@@ -0,0 +1,36 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"composition-api/internal/domain"
+	uzidomain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
+)
+
+func (h *handler) DownloadUziUziIDReportGet(ctx context.Context, params api.DownloadUziUziIDReportGetParams) (api.DownloadUziUziIDReportGetRes, error) {
+	format := uzidomain.ReportFormat(params.Format.Or(api.DownloadUziUziIDReportGetFormatPdf))
+
+	report, err := h.services.DownloadService.GetUziReport(ctx, params.UziID, apimappers.FromOptInt(params.Version), format)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return &api.DownloadUziUziIDReportGetNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "Заключение не найдено",
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	if format == uzidomain.ReportFormatHtml {
+		return &api.DownloadUziUziIDReportGetOKTextHTML{Data: report}, nil
+	}
+	return &api.DownloadUziUziIDReportGetOKApplicationPdf{Data: report}, nil
+}
@@ -10,6 +10,7 @@ import (
 type DownloadHandler interface {
 	DownloadUziIDImageIDGet(ctx context.Context, params api.DownloadUziIDImageIDGetParams) (api.DownloadUziIDImageIDGetRes, error)
 	DownloadCytologyCytologyIDOriginalImageIDGet(ctx context.Context, params api.DownloadCytologyCytologyIDOriginalImageIDGetParams) (api.DownloadCytologyCytologyIDOriginalImageIDGetRes, error)
+	DownloadUziUziIDReportGet(ctx context.Context, params api.DownloadUziUziIDReportGetParams) (api.DownloadUziUziIDReportGetRes, error)
 }
 
 type handler struct {
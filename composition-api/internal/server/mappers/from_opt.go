@@ -1,6 +1,10 @@
 package mappers
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
 	api "composition-api/internal/generated/http/api"
 )
 
@@ -24,3 +28,24 @@ func FromOptBool(opt api.OptBool) *bool {
 	}
 	return &opt.Value
 }
+
+func FromOptInt(opt api.OptInt) *int {
+	if !opt.Set {
+		return nil
+	}
+	return &opt.Value
+}
+
+func FromOptUUID(opt api.OptUUID) *uuid.UUID {
+	if !opt.Set {
+		return nil
+	}
+	return &opt.Value
+}
+
+func FromOptDateTime(opt api.OptDateTime) *time.Time {
+	if !opt.Set {
+		return nil
+	}
+	return &opt.Value
+}
@@ -0,0 +1,27 @@
+package security
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ActorMetadataKey пользователь запроса для сервисов, которые ведут историю изменений
+const ActorMetadataKey = "x-actor-id"
+
+// ActorClientCall передает id пользователя из токена в метаданных исходящего вызова
+func ActorClientCall(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if token, err := ParseToken(ctx); err == nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, ActorMetadataKey, token.Id.String())
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
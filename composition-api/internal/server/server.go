@@ -16,6 +16,7 @@ import (
 	"composition-api/internal/server/register"
 	"composition-api/internal/server/security"
 	"composition-api/internal/server/tiler"
+	"composition-api/internal/server/upload"
 	"composition-api/internal/server/uzi"
 	services "composition-api/internal/services"
 
@@ -31,6 +32,7 @@ type server struct {
 	billing.BillingRoute
 	cytology.CytologyRoute
 	tiler.TilerRoute
+	upload.UploadRoute
 }
 
 func New(services *services.Services) api.Handler {
@@ -42,6 +44,7 @@ func New(services *services.Services) api.Handler {
 	billingRoute := billing.NewBillingRoute(services)
 	cytologyRoute := cytology.NewCytologyRoute(services)
 	tilerRoute := tiler.NewTilerRoute(services)
+	uploadRoute := upload.NewUploadRoute(services)
 
 	return &server{
 		UziRoute:      uziRoute,
@@ -52,6 +55,7 @@ func New(services *services.Services) api.Handler {
 		BillingRoute:  billingRoute,
 		CytologyRoute: cytologyRoute,
 		TilerRoute:    tilerRoute,
+		UploadRoute:   uploadRoute,
 	}
 }
 
@@ -0,0 +1,53 @@
+package mappers
+
+import (
+	domain "composition-api/internal/domain/upload"
+	api "composition-api/internal/generated/http/api"
+	mappers "composition-api/internal/server/mappers"
+)
+
+func Upload(upload domain.Upload) api.Upload {
+	return api.Upload{
+		ID:          upload.Id,
+		Kind:        api.UploadKind(upload.Kind),
+		FileName:    upload.FileName,
+		ContentType: upload.ContentType,
+		Size:        upload.Size,
+		PartSize:    upload.PartSize,
+		PartsCount:  upload.PartsCount(),
+		SHA256:      mappers.ToOptString(upload.Sha256),
+		Status:      api.UploadStatus(upload.Status),
+		FailReason:  mappers.ToOptString(upload.FailReason),
+		CreateAt:    upload.CreateAt,
+		ExpiresAt:   upload.ExpiresAt,
+	}
+}
+
+func UploadProgress(progress domain.UploadProgress) api.UploadProgress {
+	parts := make([]api.UploadPart, 0, len(progress.Parts))
+	for _, part := range progress.Parts {
+		parts = append(parts, api.UploadPart{
+			Number: part.Number,
+			Size:   part.Size,
+			Etag:   part.ETag,
+		})
+	}
+
+	return api.UploadProgress{
+		Upload:        Upload(progress.Upload),
+		UploadedBytes: progress.UploadedBytes,
+		Parts:         parts,
+	}
+}
+
+func SliceUploadPartURL(urls []domain.UploadPartURL) []api.UploadPartURL {
+	result := make([]api.UploadPartURL, 0, len(urls))
+	for _, url := range urls {
+		result = append(result, api.UploadPartURL{
+			Number:    url.Number,
+			URL:       url.URL,
+			ExpiresAt: url.ExpiresAt,
+		})
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"composition-api/internal/domain"
+	api "composition-api/internal/generated/http/api"
+	"composition-api/internal/server/security"
+	uploadMappers "composition-api/internal/server/upload/mappers"
+
+	"github.com/AlekSi/pointer"
+)
+
+func (h *handler) UploadsIDCompletePost(ctx context.Context, params api.UploadsIDCompletePostParams) (api.UploadsIDCompletePostRes, error) {
+	token, err := security.ParseToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := h.services.UploadService.Complete(ctx, token.Id, params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return &api.UploadsIDCompletePostNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "Загрузка не найдена",
+				},
+			}, nil
+		case errors.Is(err, domain.ErrConflict):
+			return &api.UploadsIDCompletePostConflict{
+				StatusCode: http.StatusConflict,
+				Response: api.Error{
+					Message: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, domain.ErrUnprocessableEntity):
+			return &api.UploadsIDCompletePostUnprocessableEntity{
+				StatusCode: http.StatusUnprocessableEntity,
+				Response: api.Error{
+					Message: err.Error(),
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return pointer.To(uploadMappers.Upload(upload)), nil
+}
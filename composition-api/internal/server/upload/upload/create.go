@@ -0,0 +1,49 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"composition-api/internal/domain"
+	uploadDomain "composition-api/internal/domain/upload"
+	api "composition-api/internal/generated/http/api"
+	mappers "composition-api/internal/server/mappers"
+	"composition-api/internal/server/security"
+	uploadMappers "composition-api/internal/server/upload/mappers"
+	uploadSrv "composition-api/internal/services/upload"
+
+	"github.com/AlekSi/pointer"
+)
+
+func (h *handler) UploadsPost(ctx context.Context, req *api.UploadsPostReq) (api.UploadsPostRes, error) {
+	token, err := security.ParseToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := h.services.UploadService.Create(ctx, uploadSrv.CreateUploadArg{
+		Owner:       token.Id,
+		Kind:        uploadDomain.UploadKind(req.Kind),
+		FileName:    req.FileName,
+		ContentType: req.ContentType,
+		Size:        req.Size,
+		PartSize:    req.PartSize.Or(0),
+		Sha256:      mappers.FromOptString(req.SHA256),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBadRequest):
+			return &api.UploadsPostBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: err.Error(),
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return pointer.To(uploadMappers.Upload(upload)), nil
+}
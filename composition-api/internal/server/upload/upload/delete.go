@@ -0,0 +1,42 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"composition-api/internal/domain"
+	api "composition-api/internal/generated/http/api"
+	"composition-api/internal/server/security"
+)
+
+func (h *handler) UploadsIDDelete(ctx context.Context, params api.UploadsIDDeleteParams) (api.UploadsIDDeleteRes, error) {
+	token, err := security.ParseToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.services.UploadService.Abort(ctx, token.Id, params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return &api.UploadsIDDeleteNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "Загрузка не найдена",
+				},
+			}, nil
+		case errors.Is(err, domain.ErrConflict):
+			return &api.UploadsIDDeleteConflict{
+				StatusCode: http.StatusConflict,
+				Response: api.Error{
+					Message: err.Error(),
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return &api.UploadsIDDeleteOK{}, nil
+}
@@ -0,0 +1,38 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"composition-api/internal/domain"
+	api "composition-api/internal/generated/http/api"
+	"composition-api/internal/server/security"
+	uploadMappers "composition-api/internal/server/upload/mappers"
+
+	"github.com/AlekSi/pointer"
+)
+
+func (h *handler) UploadsIDGet(ctx context.Context, params api.UploadsIDGetParams) (api.UploadsIDGetRes, error) {
+	token, err := security.ParseToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := h.services.UploadService.Get(ctx, token.Id, params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return &api.UploadsIDGetNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "Загрузка не найдена",
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return pointer.To(uploadMappers.UploadProgress(progress)), nil
+}
@@ -0,0 +1,26 @@
+package upload
+
+import (
+	"context"
+
+	api "composition-api/internal/generated/http/api"
+	services "composition-api/internal/services"
+)
+
+type UploadHandler interface {
+	UploadsPost(ctx context.Context, req *api.UploadsPostReq) (api.UploadsPostRes, error)
+	UploadsIDGet(ctx context.Context, params api.UploadsIDGetParams) (api.UploadsIDGetRes, error)
+	UploadsIDPartsPost(ctx context.Context, req *api.UploadsIDPartsPostReq, params api.UploadsIDPartsPostParams) (api.UploadsIDPartsPostRes, error)
+	UploadsIDCompletePost(ctx context.Context, params api.UploadsIDCompletePostParams) (api.UploadsIDCompletePostRes, error)
+	UploadsIDDelete(ctx context.Context, params api.UploadsIDDeleteParams) (api.UploadsIDDeleteRes, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func NewHandler(services *services.Services) UploadHandler {
+	return &handler{
+		services: services,
+	}
+}
@@ -0,0 +1,52 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"composition-api/internal/domain"
+	api "composition-api/internal/generated/http/api"
+	"composition-api/internal/server/security"
+	uploadMappers "composition-api/internal/server/upload/mappers"
+
+	"github.com/AlekSi/pointer"
+)
+
+func (h *handler) UploadsIDPartsPost(ctx context.Context, req *api.UploadsIDPartsPostReq, params api.UploadsIDPartsPostParams) (api.UploadsIDPartsPostRes, error) {
+	token, err := security.ParseToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	urls, err := h.services.UploadService.PresignParts(ctx, token.Id, params.ID, req.Numbers)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBadRequest):
+			return &api.UploadsIDPartsPostBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, domain.ErrNotFound):
+			return &api.UploadsIDPartsPostNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "Загрузка не найдена",
+				},
+			}, nil
+		case errors.Is(err, domain.ErrConflict):
+			return &api.UploadsIDPartsPostConflict{
+				StatusCode: http.StatusConflict,
+				Response: api.Error{
+					Message: err.Error(),
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return pointer.To(api.UploadsIDPartsPostOKApplicationJSON(uploadMappers.SliceUploadPartURL(urls))), nil
+}
@@ -0,0 +1,22 @@
+package upload
+
+import (
+	upload "composition-api/internal/server/upload/upload"
+	services "composition-api/internal/services"
+)
+
+type UploadRoute interface {
+	upload.UploadHandler
+}
+
+type uploadRoute struct {
+	upload.UploadHandler
+}
+
+func NewUploadRoute(services *services.Services) UploadRoute {
+	handler := upload.NewHandler(services)
+
+	return &uploadRoute{
+		UploadHandler: handler,
+	}
+}
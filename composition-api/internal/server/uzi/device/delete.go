@@ -0,0 +1,16 @@
+package device
+
+import (
+	"context"
+
+	api "composition-api/internal/generated/http/api"
+)
+
+func (h *handler) UziDeviceIDDelete(ctx context.Context, params api.UziDeviceIDDeleteParams) (api.UziDeviceIDDeleteRes, error) {
+	err := h.services.DeviceService.Delete(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UziDeviceIDDeleteOK{}, nil
+}
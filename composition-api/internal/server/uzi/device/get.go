@@ -17,3 +17,12 @@ func (h *handler) UziDevicesGet(ctx context.Context) (api.UziDevicesGetRes, erro
 
 	return pointer.To(api.UziDevicesGetOKApplicationJSON(mappers.SliceDevice(devices))), nil
 }
+
+func (h *handler) UziDeviceIDGet(ctx context.Context, params api.UziDeviceIDGetParams) (api.UziDeviceIDGetRes, error) {
+	device, err := h.services.DeviceService.GetByID(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(mappers.Device(device)), nil
+}
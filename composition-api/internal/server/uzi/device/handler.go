@@ -10,6 +10,9 @@ import (
 type DeviceHandler interface {
 	UziDevicePost(ctx context.Context, req *api.UziDevicePostReq) (api.UziDevicePostRes, error)
 	UziDevicesGet(ctx context.Context) (api.UziDevicesGetRes, error)
+	UziDeviceIDGet(ctx context.Context, params api.UziDeviceIDGetParams) (api.UziDeviceIDGetRes, error)
+	UziDeviceIDPatch(ctx context.Context, req *api.UziDeviceIDPatchReq, params api.UziDeviceIDPatchParams) (api.UziDeviceIDPatchRes, error)
+	UziDeviceIDDelete(ctx context.Context, params api.UziDeviceIDDeleteParams) (api.UziDeviceIDDeleteRes, error)
 }
 
 type handler struct {
@@ -0,0 +1,29 @@
+package device
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+
+	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
+	mappers "composition-api/internal/server/uzi/mappers"
+	deviceSrv "composition-api/internal/services/device"
+)
+
+func (h *handler) UziDeviceIDPatch(ctx context.Context, req *api.UziDeviceIDPatchReq, params api.UziDeviceIDPatchParams) (api.UziDeviceIDPatchRes, error) {
+	device, err := h.services.DeviceService.Update(ctx, deviceSrv.UpdateDeviceArg{
+		Id:           params.ID,
+		Name:         apimappers.FromOptString(req.Name),
+		Manufacturer: apimappers.FromOptString(req.Manufacturer),
+		Model:        apimappers.FromOptString(req.Model),
+		SerialNumber: apimappers.FromOptString(req.SerialNumber),
+		ProbeType:    probeType(req.ProbeType),
+		PixelSpacing: pixelSpacing(req.PixelSpacing),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(mappers.Device(device)), nil
+}
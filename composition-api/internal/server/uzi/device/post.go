@@ -3,11 +3,38 @@ package device
 import (
 	"context"
 
+	uzi_domain "composition-api/internal/domain/uzi"
 	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
+	deviceSrv "composition-api/internal/services/device"
 )
 
+func probeType(opt api.OptProbeType) *uzi_domain.ProbeType {
+	value, ok := opt.Get()
+	if !ok {
+		return nil
+	}
+	probeType := uzi_domain.ProbeType(value)
+	return &probeType
+}
+
+func pixelSpacing(opt api.OptPixelSpacing) *uzi_domain.PixelSpacing {
+	spacing, ok := opt.Get()
+	if !ok {
+		return nil
+	}
+	return &uzi_domain.PixelSpacing{X: spacing.X, Y: spacing.Y}
+}
+
 func (h *handler) UziDevicePost(ctx context.Context, req *api.UziDevicePostReq) (api.UziDevicePostRes, error) {
-	id, err := h.services.DeviceService.Create(ctx, req.Name)
+	id, err := h.services.DeviceService.Create(ctx, deviceSrv.CreateDeviceArg{
+		Name:         req.Name,
+		Manufacturer: apimappers.FromOptString(req.Manufacturer),
+		Model:        apimappers.FromOptString(req.Model),
+		SerialNumber: apimappers.FromOptString(req.SerialNumber),
+		ProbeType:    probeType(req.ProbeType),
+		PixelSpacing: pixelSpacing(req.PixelSpacing),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,28 @@
+package lineage
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+
+	api "composition-api/internal/generated/http/api"
+	mappers "composition-api/internal/server/uzi/mappers"
+)
+
+func (h *handler) UziNodesIDLinkSuggestionsGet(ctx context.Context, params api.UziNodesIDLinkSuggestionsGetParams) (api.UziNodesIDLinkSuggestionsGetRes, error) {
+	suggestions, err := h.services.LineageService.SuggestNodeLinks(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(api.UziNodesIDLinkSuggestionsGetOKApplicationJSON(mappers.NodeLinkSuggestion{}.SliceDomain(suggestions))), nil
+}
+
+func (h *handler) UzisExternalIDGrowthGet(ctx context.Context, params api.UzisExternalIDGrowthGetParams) (api.UzisExternalIDGrowthGetRes, error) {
+	report, err := h.services.LineageService.GetGrowthReport(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(api.UzisExternalIDGrowthGetOKApplicationJSON(mappers.NodeGrowth{}.SliceDomain(report))), nil
+}
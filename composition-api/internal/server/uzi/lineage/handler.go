@@ -0,0 +1,25 @@
+package lineage
+
+import (
+	"context"
+
+	api "composition-api/internal/generated/http/api"
+	services "composition-api/internal/services"
+)
+
+type LineageHandler interface {
+	UziNodesIDLinkPost(ctx context.Context, req *api.UziNodesIDLinkPostReq, params api.UziNodesIDLinkPostParams) (api.UziNodesIDLinkPostRes, error)
+	UziNodesIDLinkDelete(ctx context.Context, params api.UziNodesIDLinkDeleteParams) (api.UziNodesIDLinkDeleteRes, error)
+	UziNodesIDLinkSuggestionsGet(ctx context.Context, params api.UziNodesIDLinkSuggestionsGetParams) (api.UziNodesIDLinkSuggestionsGetRes, error)
+	UzisExternalIDGrowthGet(ctx context.Context, params api.UzisExternalIDGrowthGetParams) (api.UzisExternalIDGrowthGetRes, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func NewHandler(services *services.Services) LineageHandler {
+	return &handler{
+		services: services,
+	}
+}
@@ -0,0 +1,24 @@
+package lineage
+
+import (
+	"context"
+
+	api "composition-api/internal/generated/http/api"
+)
+
+func (h *handler) UziNodesIDLinkPost(ctx context.Context, req *api.UziNodesIDLinkPostReq, params api.UziNodesIDLinkPostParams) (api.UziNodesIDLinkPostRes, error) {
+	lineageID, err := h.services.LineageService.LinkNodes(ctx, params.ID, req.TargetNodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UziNodesIDLinkPostOK{LineageID: lineageID}, nil
+}
+
+func (h *handler) UziNodesIDLinkDelete(ctx context.Context, params api.UziNodesIDLinkDeleteParams) (api.UziNodesIDLinkDeleteRes, error) {
+	if err := h.services.LineageService.UnlinkNode(ctx, params.ID); err != nil {
+		return nil, err
+	}
+
+	return &api.UziNodesIDLinkDeleteOK{}, nil
+}
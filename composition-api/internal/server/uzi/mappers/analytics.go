@@ -0,0 +1,94 @@
+package mappers
+
+import (
+	domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
+)
+
+type TiradsDistribution struct{}
+
+func (TiradsDistribution) Domain(distribution domain.TiradsDistribution) api.TiradsDistribution {
+	return api.TiradsDistribution{
+		Tirads23: distribution.Tirads23,
+		Tirads4:  distribution.Tirads4,
+		Tirads5:  distribution.Tirads5,
+	}
+}
+
+type NodeMetrics struct{}
+
+func (NodeMetrics) Domain(metrics domain.NodeMetrics) api.NodeMetrics {
+	return api.NodeMetrics{
+		Uzis:             metrics.Uzis,
+		AiNodes:          metrics.AiNodes,
+		Valid:            metrics.Valid,
+		Invalid:          metrics.Invalid,
+		Unvalidated:      metrics.Unvalidated,
+		ManualNodes:      metrics.ManualNodes,
+		Precision:        apimappers.ToOptFloat64(metrics.Precision),
+		UnvalidatedShare: apimappers.ToOptFloat64(metrics.UnvalidatedShare),
+		AiTirads:         TiradsDistribution{}.Domain(metrics.AiTirads),
+		ManualTirads:     TiradsDistribution{}.Domain(metrics.ManualTirads),
+	}
+}
+
+type DeviceNodeMetrics struct{}
+
+func (DeviceNodeMetrics) Domain(metrics domain.DeviceNodeMetrics) api.DeviceNodeMetrics {
+	return api.DeviceNodeMetrics{
+		DeviceID: metrics.DeviceID,
+		Metrics:  NodeMetrics{}.Domain(metrics.Metrics),
+	}
+}
+
+type AuthorNodeMetrics struct{}
+
+func (AuthorNodeMetrics) Domain(metrics domain.AuthorNodeMetrics) api.AuthorNodeMetrics {
+	return api.AuthorNodeMetrics{
+		AuthorID: metrics.Author,
+		Metrics:  NodeMetrics{}.Domain(metrics.Metrics),
+	}
+}
+
+type PeriodNodeMetrics struct{}
+
+func (PeriodNodeMetrics) Domain(metrics domain.PeriodNodeMetrics) api.PeriodNodeMetrics {
+	return api.PeriodNodeMetrics{
+		Start:   metrics.Start,
+		Metrics: NodeMetrics{}.Domain(metrics.Metrics),
+	}
+}
+
+type ReaderAgreement struct{}
+
+func (ReaderAgreement) Domain(agreement domain.ReaderAgreement) api.ReaderAgreement {
+	return api.ReaderAgreement{
+		ReaderA:   agreement.ReaderA,
+		ReaderB:   agreement.ReaderB,
+		Uzis:      agreement.Uzis,
+		Matched:   agreement.Matched,
+		Unmatched: agreement.Unmatched,
+		Kappa:     apimappers.ToOptFloat64(agreement.Kappa),
+		MeanIou:   apimappers.ToOptFloat64(agreement.MeanIoU),
+	}
+}
+
+type UziAnalytics struct{}
+
+func (UziAnalytics) Domain(analytics domain.Analytics) api.UziAnalytics {
+	return api.UziAnalytics{
+		Total:     NodeMetrics{}.Domain(analytics.Total),
+		ByDevice:  slice(analytics.ByDevice, DeviceNodeMetrics{}),
+		ByAuthor:  slice(analytics.ByAuthor, AuthorNodeMetrics{}),
+		ByPeriod:  slice(analytics.ByPeriod, PeriodNodeMetrics{}),
+		Agreement: slice(analytics.Agreement, ReaderAgreement{}),
+		Kappa:     apimappers.ToOptFloat64(analytics.Kappa),
+		MeanIou:   apimappers.ToOptFloat64(analytics.MeanIoU),
+		AiMerged:  analytics.AiMerged,
+		AiSplit:   analytics.AiSplit,
+
+		BulkValid:   analytics.BulkValid,
+		BulkInvalid: analytics.BulkInvalid,
+	}
+}
@@ -3,12 +3,23 @@ package mappers
 import (
 	domain "composition-api/internal/domain/uzi"
 	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
 )
 
 func Device(device domain.Device) api.Device {
+	var probeType api.OptProbeType
+	if device.ProbeType != nil {
+		probeType = api.NewOptProbeType(api.ProbeType(*device.ProbeType))
+	}
+
 	return api.Device{
-		ID:   device.Id,
-		Name: device.Name,
+		ID:           device.Id,
+		Name:         device.Name,
+		Manufacturer: apimappers.ToOptString(device.Manufacturer),
+		Model:        apimappers.ToOptString(device.Model),
+		SerialNumber: apimappers.ToOptString(device.SerialNumber),
+		ProbeType:    probeType,
+		PixelSpacing: PixelSpacing{}.Domain(device.PixelSpacing),
 	}
 }
 
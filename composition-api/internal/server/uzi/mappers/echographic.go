@@ -7,27 +7,53 @@ import (
 )
 
 func Echographics(echographics domain.Echographic) api.Echographics {
-	return api.Echographics{
-		ID:              echographics.Id,
-		Contors:         mappers.ToOptString(echographics.Contors),
-		LeftLobeLength:  mappers.ToOptFloat64(echographics.LeftLobeLength),
-		LeftLobeWidth:   mappers.ToOptFloat64(echographics.LeftLobeWidth),
-		LeftLobeThick:   mappers.ToOptFloat64(echographics.LeftLobeThick),
-		LeftLobeVolum:   mappers.ToOptFloat64(echographics.LeftLobeVolum),
-		RightLobeLength: mappers.ToOptFloat64(echographics.RightLobeLength),
-		RightLobeWidth:  mappers.ToOptFloat64(echographics.RightLobeWidth),
-		RightLobeThick:  mappers.ToOptFloat64(echographics.RightLobeThick),
-		RightLobeVolum:  mappers.ToOptFloat64(echographics.RightLobeVolum),
-		GlandVolum:      mappers.ToOptFloat64(echographics.GlandVolum),
-		Isthmus:         mappers.ToOptFloat64(echographics.Isthmus),
-		Struct:          mappers.ToOptString(echographics.Struct),
-		Echogenicity:    mappers.ToOptString(echographics.Echogenicity),
-		RegionalLymph:   mappers.ToOptString(echographics.RegionalLymph),
-		Vascularization: mappers.ToOptString(echographics.Vascularization),
-		Location:        mappers.ToOptString(echographics.Location),
-		Additional:      mappers.ToOptString(echographics.Additional),
-		Conclusion:      mappers.ToOptString(echographics.Conclusion),
+	result := api.Echographics{
+		ID:                  echographics.Id,
+		Contors:             mappers.ToOptString(echographics.Contors),
+		LeftLobeLength:      mappers.ToOptFloat64(echographics.LeftLobeLength),
+		LeftLobeWidth:       mappers.ToOptFloat64(echographics.LeftLobeWidth),
+		LeftLobeThick:       mappers.ToOptFloat64(echographics.LeftLobeThick),
+		LeftLobeVolum:       mappers.ToOptFloat64(echographics.LeftLobeVolum),
+		RightLobeLength:     mappers.ToOptFloat64(echographics.RightLobeLength),
+		RightLobeWidth:      mappers.ToOptFloat64(echographics.RightLobeWidth),
+		RightLobeThick:      mappers.ToOptFloat64(echographics.RightLobeThick),
+		RightLobeVolum:      mappers.ToOptFloat64(echographics.RightLobeVolum),
+		GlandVolum:          mappers.ToOptFloat64(echographics.GlandVolum),
+		Isthmus:             mappers.ToOptFloat64(echographics.Isthmus),
+		RegionalLymph:       mappers.ToOptString(echographics.RegionalLymph),
+		Location:            mappers.ToOptString(echographics.Location),
+		Additional:          mappers.ToOptString(echographics.Additional),
+		Conclusion:          mappers.ToOptString(echographics.Conclusion),
+		Flags:               make([]api.EchographicFlag, 0, len(echographics.Flags)),
+		GeneratedConclusion: api.NewOptString(echographics.GeneratedConclusion),
 	}
+
+	if echographics.Struct != nil {
+		result.Struct = api.NewOptEchographicsStruct(api.EchographicsStruct(*echographics.Struct))
+	}
+	if echographics.Echogenicity != nil {
+		result.Echogenicity = api.NewOptEchographicsEchogenicity(api.EchographicsEchogenicity(*echographics.Echogenicity))
+	}
+	if echographics.Vascularization != nil {
+		result.Vascularization = api.NewOptEchographicsVascularization(api.EchographicsVascularization(*echographics.Vascularization))
+	}
+	if echographics.PatientSex != nil {
+		result.PatientSex = api.NewOptEchographicsPatientSex(api.EchographicsPatientSex(*echographics.PatientSex))
+	}
+	if echographics.PatientAge != nil {
+		result.PatientAge = api.NewOptInt(*echographics.PatientAge)
+	}
+
+	for _, flag := range echographics.Flags {
+		result.Flags = append(result.Flags, api.EchographicFlag{
+			Field: api.EchographicFlagField(flag.Field),
+			Value: flag.Value,
+			Min:   mappers.ToOptFloat64(flag.Min),
+			Max:   mappers.ToOptFloat64(flag.Max),
+		})
+	}
+
+	return result
 }
 
 func SliceEchographics(echographics []domain.Echographic) []api.Echographics {
@@ -6,10 +6,23 @@ import (
 )
 
 func Image(image domain.Image) api.Image {
+	variants := make([]api.ImageVariant, 0, len(image.Variants))
+	for _, v := range image.Variants {
+		variants = append(variants, api.ImageVariant{
+			Size:        api.ImageSize(v.Size),
+			ContentType: v.ContentType,
+			Width:       v.Width,
+			Height:      v.Height,
+		})
+	}
+
 	return api.Image{
-		ID:    image.Id,
-		UziID: image.UziID,
-		Page:  image.Page,
+		ID:       image.Id,
+		UziID:    image.UziID,
+		Page:     image.Page,
+		Width:    image.Width,
+		Height:   image.Height,
+		Variants: variants,
 	}
 }
 
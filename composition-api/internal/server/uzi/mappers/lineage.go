@@ -0,0 +1,46 @@
+package mappers
+
+import (
+	domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
+)
+
+type NodeLinkSuggestion struct{}
+
+func (NodeLinkSuggestion) Domain(suggestion domain.NodeLinkSuggestion) api.NodeLinkSuggestion {
+	return api.NodeLinkSuggestion{
+		Node:  Node{}.Domain(suggestion.Node),
+		Score: suggestion.Score,
+	}
+}
+
+func (NodeLinkSuggestion) SliceDomain(suggestions []domain.NodeLinkSuggestion) []api.NodeLinkSuggestion {
+	return slice(suggestions, NodeLinkSuggestion{})
+}
+
+type NodeGrowthPoint struct{}
+
+func (NodeGrowthPoint) Domain(point domain.NodeGrowthPoint) api.NodeGrowthPoint {
+	return api.NodeGrowthPoint{
+		Node:     Node{}.Domain(point.Node),
+		UziID:    point.UziID,
+		CreateAt: point.CreateAt,
+		Volume:   apimappers.ToOptFloat64(point.Volume),
+	}
+}
+
+type NodeGrowth struct{}
+
+func (NodeGrowth) Domain(growth domain.NodeGrowth) api.NodeGrowth {
+	return api.NodeGrowth{
+		LineageID:    growth.LineageID,
+		Points:       slice(growth.Points, NodeGrowthPoint{}),
+		VolumeChange: apimappers.ToOptFloat64(growth.VolumeChange),
+		DoublingTime: apimappers.ToOptFloat64(growth.DoublingTime),
+	}
+}
+
+func (NodeGrowth) SliceDomain(growths []domain.NodeGrowth) []api.NodeGrowth {
+	return slice(growths, NodeGrowth{})
+}
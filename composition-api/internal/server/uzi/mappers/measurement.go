@@ -0,0 +1,55 @@
+package mappers
+
+import (
+	domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+)
+
+type PixelSpacing struct{}
+
+func (PixelSpacing) Domain(spacing *domain.PixelSpacing) api.OptPixelSpacing {
+	if spacing == nil {
+		return api.OptPixelSpacing{}
+	}
+	return api.NewOptPixelSpacing(api.PixelSpacing{
+		X: spacing.X,
+		Y: spacing.Y,
+	})
+}
+
+type SegmentMeasurement struct{}
+
+func (SegmentMeasurement) Domain(m *domain.SegmentMeasurement) api.OptSegmentMeasurement {
+	if m == nil {
+		return api.OptSegmentMeasurement{}
+	}
+	return api.NewOptSegmentMeasurement(api.SegmentMeasurement{
+		Bbox: api.BoundingBox{
+			X:      m.BBox.X,
+			Y:      m.BBox.Y,
+			Width:  m.BBox.Width,
+			Height: m.BBox.Height,
+		},
+		Area:      m.Area,
+		Perimeter: m.Perimeter,
+		MajorAxis: m.MajorAxis,
+		MinorAxis: m.MinorAxis,
+		Unit:      api.MeasureUnit(m.Unit),
+	})
+}
+
+type NodeMeasurement struct{}
+
+func (NodeMeasurement) Domain(m *domain.NodeMeasurement) api.OptNodeMeasurement {
+	if m == nil {
+		return api.OptNodeMeasurement{}
+	}
+	return api.NewOptNodeMeasurement(api.NodeMeasurement{
+		Area:      m.Area,
+		Perimeter: m.Perimeter,
+		MajorAxis: m.MajorAxis,
+		MinorAxis: m.MinorAxis,
+		Volume:    m.Volume,
+		Unit:      api.MeasureUnit(m.Unit),
+	})
+}
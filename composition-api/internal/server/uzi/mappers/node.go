@@ -23,6 +23,11 @@ func (Node) Domain(node domain.Node) api.Node {
 		}
 	}
 
+	var lobe api.OptNodeLobe
+	if node.Lobe != nil {
+		lobe = api.NewOptNodeLobe(api.NodeLobe(*node.Lobe))
+	}
+
 	return api.Node{
 		ID:          node.Id,
 		Ai:          node.Ai,
@@ -32,6 +37,8 @@ func (Node) Domain(node domain.Node) api.Node {
 		Tirads4:     node.Tirads4,
 		Tirads5:     node.Tirads5,
 		Description: apimappers.ToOptString(node.Description),
+		Measurement: NodeMeasurement{}.Domain(node.Measurement),
+		Lobe:        lobe,
 	}
 }
 
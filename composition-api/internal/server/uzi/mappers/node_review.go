@@ -0,0 +1,29 @@
+package mappers
+
+import (
+	domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+)
+
+type NodeReviewItem struct{}
+
+func (NodeReviewItem) Domain(item domain.NodeReviewItem) api.NodeReviewItem {
+	var node api.OptNode
+	if item.Node != nil {
+		node = api.NewOptNode(Node{}.Domain(*item.Node))
+	}
+
+	return api.NodeReviewItem{
+		NodeID: item.NodeID,
+		Status: api.NodeReviewItemStatus(item.Status),
+		Node:   node,
+	}
+}
+
+func (NodeReviewItem) SliceDomain(items []domain.NodeReviewItem) []api.NodeReviewItem {
+	result := make([]api.NodeReviewItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, NodeReviewItem{}.Domain(item))
+	}
+	return result
+}
@@ -0,0 +1,21 @@
+package mappers
+
+import (
+	domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+)
+
+type Report struct{}
+
+func (Report) Domain(report domain.Report) api.Report {
+	return api.Report{
+		ID:       report.Id,
+		UziID:    report.UziID,
+		Version:  report.Version,
+		CreateAt: report.CreateAt,
+	}
+}
+
+func (Report) SliceDomain(reports []domain.Report) []api.Report {
+	return slice(reports, Report{})
+}
@@ -22,6 +22,8 @@ func (Segment) Domain(segment domain.Segment) (api.Segment, error) {
 		Tirads23: segment.Tirads23,
 		Tirads4:  segment.Tirads4,
 		Tirads5:  segment.Tirads5,
+
+		Measurement: SegmentMeasurement{}.Domain(segment.Measurement),
 	}, nil
 }
 
@@ -36,3 +38,37 @@ func (Segment) SliceDomain(segments []domain.Segment) ([]api.Segment, error) {
 	}
 	return result, nil
 }
+
+type SegmentDraft struct{}
+
+func (SegmentDraft) Domain(draft domain.SegmentDraft) (api.SegmentDraft, error) {
+	contor, err := Contor(draft.Contor)
+	if err != nil {
+		return api.SegmentDraft{}, err
+	}
+
+	return api.SegmentDraft{
+		ID:              draft.Id,
+		NodeID:          draft.NodeID,
+		ImageID:         draft.ImageID,
+		SourceSegmentID: draft.SourceSegmentID,
+		Contor:          contor,
+		Tirads23:        draft.Tirads23,
+		Tirads4:         draft.Tirads4,
+		Tirads5:         draft.Tirads5,
+		Score:           draft.Score,
+		CreateAt:        draft.CreateAt,
+	}, nil
+}
+
+func (SegmentDraft) SliceDomain(drafts []domain.SegmentDraft) ([]api.SegmentDraft, error) {
+	result := make([]api.SegmentDraft, 0, len(drafts))
+	for _, draft := range drafts {
+		draft, err := SegmentDraft{}.Domain(draft)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, draft)
+	}
+	return result, nil
+}
@@ -0,0 +1,52 @@
+package mappers
+
+import (
+	domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
+)
+
+type NodeDescriptors struct{}
+
+func (NodeDescriptors) Domain(d *domain.NodeDescriptors) api.OptNodeDescriptors {
+	if d == nil {
+		return api.OptNodeDescriptors{}
+	}
+
+	foci := make([]api.NodeDescriptorsEchogenicFociItem, 0, len(d.EchogenicFoci))
+	for _, v := range d.EchogenicFoci {
+		foci = append(foci, api.NodeDescriptorsEchogenicFociItem(v))
+	}
+
+	return api.NewOptNodeDescriptors(api.NodeDescriptors{
+		Composition:   api.NodeDescriptorsComposition(d.Composition),
+		Echogenicity:  api.NodeDescriptorsEchogenicity(d.Echogenicity),
+		Shape:         api.NodeDescriptorsShape(d.Shape),
+		Margin:        api.NodeDescriptorsMargin(d.Margin),
+		EchogenicFoci: foci,
+	})
+}
+
+type TiradsScore struct{}
+
+func (TiradsScore) Domain(score *domain.TiradsScore) api.OptTiradsScore {
+	if score == nil {
+		return api.OptTiradsScore{}
+	}
+	return api.NewOptTiradsScore(api.TiradsScore{
+		Points:         score.Points,
+		Category:       api.TiradsScoreCategory(score.Category),
+		Recommendation: api.TiradsScoreRecommendation(score.Recommendation),
+		MaxDiameter:    apimappers.ToOptFloat64(score.MaxDiameter),
+	})
+}
+
+type NodeTirads struct{}
+
+func (NodeTirads) Domain(tirads domain.NodeTirads) api.NodeTirads {
+	return api.NodeTirads{
+		Node:        Node{}.Domain(tirads.Node),
+		Descriptors: NodeDescriptors{}.Domain(tirads.Descriptors),
+		Score:       TiradsScore{}.Domain(tirads.Score),
+	}
+}
@@ -3,6 +3,7 @@ package mappers
 import (
 	domain "composition-api/internal/domain/uzi"
 	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
 )
 
 type Uzi struct{}
@@ -17,9 +18,21 @@ func (Uzi) Domain(uzi domain.Uzi) api.Uzi {
 		DeviceID:   uzi.DeviceID,
 		Status:     api.UziStatus(uzi.Status),
 		CreateAt:   uzi.CreateAt,
+
+		PixelSpacing: PixelSpacing{}.Domain(uzi.PixelSpacing),
+		SHA256:       apimappers.ToOptString(uzi.Sha256),
 	}
 }
 
 func (Uzi) SliceDomain(uzis []domain.Uzi) []api.Uzi {
 	return slice(uzis, Uzi{})
 }
+
+type UziPage struct{}
+
+func (UziPage) Domain(page domain.UziPage) api.UziPage {
+	return api.UziPage{
+		Uzis:       Uzi{}.SliceDomain(page.Uzis),
+		NextCursor: apimappers.ToOptString(page.NextCursor),
+	}
+}
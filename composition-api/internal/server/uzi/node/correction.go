@@ -0,0 +1,31 @@
+package node
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+
+	api "composition-api/internal/generated/http/api"
+	mappers "composition-api/internal/server/uzi/mappers"
+)
+
+func (h *handler) UziNodesMergePost(ctx context.Context, req *api.UziNodesMergePostReq) (api.UziNodesMergePostRes, error) {
+	node, err := h.services.NodeService.MergeNodes(ctx, req.NodeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(mappers.Node{}.Domain(node)), nil
+}
+
+func (h *handler) UziNodesIDSplitPost(ctx context.Context, req *api.UziNodesIDSplitPostReq, params api.UziNodesIDSplitPostParams) (api.UziNodesIDSplitPostRes, error) {
+	node, newNode, err := h.services.NodeService.SplitNode(ctx, params.ID, req.SegmentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UziNodesIDSplitPostOK{
+		Node:    mappers.Node{}.Domain(node),
+		NewNode: mappers.Node{}.Domain(newNode),
+	}, nil
+}
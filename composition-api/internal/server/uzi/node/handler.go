@@ -11,6 +11,13 @@ type NodeHandler interface {
 	UziIDNodesGet(ctx context.Context, params api.UziIDNodesGetParams) (api.UziIDNodesGetRes, error)
 	UziNodesIDPatch(ctx context.Context, req *api.UziNodesIDPatchReq, params api.UziNodesIDPatchParams) (api.UziNodesIDPatchRes, error)
 	UziNodesIDDelete(ctx context.Context, params api.UziNodesIDDeleteParams) (api.UziNodesIDDeleteRes, error)
+	UziNodesMergePost(ctx context.Context, req *api.UziNodesMergePostReq) (api.UziNodesMergePostRes, error)
+	UziNodesIDSplitPost(ctx context.Context, req *api.UziNodesIDSplitPostReq, params api.UziNodesIDSplitPostParams) (api.UziNodesIDSplitPostRes, error)
+	UziNodesReviewPost(ctx context.Context, req *api.UziNodesReviewPostReq) (api.UziNodesReviewPostRes, error)
+	UziIDCheckPost(ctx context.Context, req api.OptUziIDCheckPostReq, params api.UziIDCheckPostParams) (api.UziIDCheckPostRes, error)
+	UziIDNodesAcceptPost(ctx context.Context, req *api.UziIDNodesAcceptPostReq, params api.UziIDNodesAcceptPostParams) (api.UziIDNodesAcceptPostRes, error)
+	UziNodesIDTiradsGet(ctx context.Context, params api.UziNodesIDTiradsGetParams) (api.UziNodesIDTiradsGetRes, error)
+	UziNodesIDTiradsPut(ctx context.Context, req *api.NodeDescriptors, params api.UziNodesIDTiradsPutParams) (api.UziNodesIDTiradsPutRes, error)
 }
 
 type handler struct {
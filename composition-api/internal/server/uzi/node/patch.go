@@ -21,12 +21,18 @@ func (h *handler) UziNodesIDPatch(ctx context.Context, req *api.UziNodesIDPatchR
 		validation = pointer.To(domain.NodeValidation(req.Validation.Value))
 	}
 
+	var lobe *domain.NodeLobe
+	if req.Lobe.IsSet() {
+		lobe = pointer.To(domain.NodeLobe(req.Lobe.Value))
+	}
+
 	node, err := h.services.NodeService.UpdateNode(ctx, nodeSrv.UpdateNodeArg{
 		Id:         params.ID,
 		Validation: validation,
 		Tirads_23:  apimappers.FromOptFloat64(req.Tirads23),
 		Tirads_4:   apimappers.FromOptFloat64(req.Tirads4),
 		Tirads_5:   apimappers.FromOptFloat64(req.Tirads5),
+		Lobe:       lobe,
 	})
 	if err != nil {
 		return nil, err
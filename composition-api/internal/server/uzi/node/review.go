@@ -0,0 +1,91 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+
+	baseDomain "composition-api/internal/domain"
+	domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+	mappers "composition-api/internal/server/uzi/mappers"
+)
+
+func (h *handler) UziNodesReviewPost(ctx context.Context, req *api.UziNodesReviewPostReq) (api.UziNodesReviewPostRes, error) {
+	items, err := h.services.NodeService.ReviewNodes(ctx, req.NodeIds, domain.NodeValidation(req.Validation))
+	if err != nil {
+		if errors.Is(err, baseDomain.ErrBadRequest) {
+			return &api.UziNodesReviewPostBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: "Неверный формат запроса",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return pointer.To(api.UziNodesReviewPostOKApplicationJSON(mappers.NodeReviewItem{}.SliceDomain(items))), nil
+}
+
+func (h *handler) UziIDCheckPost(ctx context.Context, req api.OptUziIDCheckPostReq, params api.UziIDCheckPostParams) (api.UziIDCheckPostRes, error) {
+	var unvalidated *domain.NodeValidation
+	if req.IsSet() && req.Value.Unvalidated.IsSet() {
+		unvalidated = (*domain.NodeValidation)(&req.Value.Unvalidated.Value)
+	}
+
+	uzi, items, err := h.services.NodeService.CheckUzi(ctx, params.ID, unvalidated)
+	if err != nil {
+		switch {
+		case errors.Is(err, baseDomain.ErrNotFound):
+			return &api.UziIDCheckPostNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "УЗИ не найдено",
+				},
+			}, nil
+		case errors.Is(err, baseDomain.ErrBadRequest):
+			return &api.UziIDCheckPostBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: "Неверный формат запроса",
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return &api.UziIDCheckPostOK{
+		Uzi:   mappers.Uzi{}.Domain(uzi),
+		Items: mappers.NodeReviewItem{}.SliceDomain(items),
+	}, nil
+}
+
+func (h *handler) UziIDNodesAcceptPost(ctx context.Context, req *api.UziIDNodesAcceptPostReq, params api.UziIDNodesAcceptPostParams) (api.UziIDNodesAcceptPostRes, error) {
+	items, err := h.services.NodeService.AcceptAiNodes(ctx, params.ID, req.Threshold)
+	if err != nil {
+		switch {
+		case errors.Is(err, baseDomain.ErrNotFound):
+			return &api.UziIDNodesAcceptPostNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "УЗИ не найдено",
+				},
+			}, nil
+		case errors.Is(err, baseDomain.ErrBadRequest):
+			return &api.UziIDNodesAcceptPostBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: "Неверный формат запроса",
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return pointer.To(api.UziIDNodesAcceptPostOKApplicationJSON(mappers.NodeReviewItem{}.SliceDomain(items))), nil
+}
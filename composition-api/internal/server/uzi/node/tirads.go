@@ -0,0 +1,41 @@
+package node
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+
+	domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+	mappers "composition-api/internal/server/uzi/mappers"
+)
+
+func (h *handler) UziNodesIDTiradsGet(ctx context.Context, params api.UziNodesIDTiradsGetParams) (api.UziNodesIDTiradsGetRes, error) {
+	tirads, err := h.services.NodeService.GetNodeTirads(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(mappers.NodeTirads{}.Domain(tirads)), nil
+}
+
+func (h *handler) UziNodesIDTiradsPut(ctx context.Context, req *api.NodeDescriptors, params api.UziNodesIDTiradsPutParams) (api.UziNodesIDTiradsPutRes, error) {
+	foci := make([]domain.TiradsEchogenicFoci, 0, len(req.EchogenicFoci))
+	for _, v := range req.EchogenicFoci {
+		foci = append(foci, domain.TiradsEchogenicFoci(v))
+	}
+
+	tirads, err := h.services.NodeService.SetNodeDescriptors(ctx, domain.NodeDescriptors{
+		NodeID:        params.ID,
+		Composition:   domain.TiradsComposition(req.Composition),
+		Echogenicity:  domain.TiradsEchogenicity(req.Echogenicity),
+		Shape:         domain.TiradsShape(req.Shape),
+		Margin:        domain.TiradsMargin(req.Margin),
+		EchogenicFoci: foci,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(mappers.NodeTirads{}.Domain(tirads)), nil
+}
@@ -0,0 +1,19 @@
+package report
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+
+	api "composition-api/internal/generated/http/api"
+	mappers "composition-api/internal/server/uzi/mappers"
+)
+
+func (h *handler) UziIDReportsGet(ctx context.Context, params api.UziIDReportsGetParams) (api.UziIDReportsGetRes, error) {
+	reports, err := h.services.ReportService.GetList(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(api.UziIDReportsGetOKApplicationJSON(mappers.Report{}.SliceDomain(reports))), nil
+}
@@ -0,0 +1,23 @@
+package report
+
+import (
+	"context"
+
+	api "composition-api/internal/generated/http/api"
+	services "composition-api/internal/services"
+)
+
+type ReportHandler interface {
+	UziIDReportsPost(ctx context.Context, params api.UziIDReportsPostParams) (api.UziIDReportsPostRes, error)
+	UziIDReportsGet(ctx context.Context, params api.UziIDReportsGetParams) (api.UziIDReportsGetRes, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func NewHandler(services *services.Services) ReportHandler {
+	return &handler{
+		services: services,
+	}
+}
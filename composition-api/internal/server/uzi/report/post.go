@@ -0,0 +1,39 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+
+	"composition-api/internal/domain"
+	api "composition-api/internal/generated/http/api"
+	mappers "composition-api/internal/server/uzi/mappers"
+)
+
+func (h *handler) UziIDReportsPost(ctx context.Context, params api.UziIDReportsPostParams) (api.UziIDReportsPostRes, error) {
+	report, err := h.services.ReportService.Generate(ctx, params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return &api.UziIDReportsPostNotFound{
+				StatusCode: http.StatusNotFound,
+				Response: api.Error{
+					Message: "УЗИ не найдено",
+				},
+			}, nil
+		case errors.Is(err, domain.ErrConflict):
+			return &api.UziIDReportsPostConflict{
+				StatusCode: http.StatusConflict,
+				Response: api.Error{
+					Message: "Заключение уже формируется, повторите запрос",
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return pointer.To(mappers.Report{}.Domain(report)), nil
+}
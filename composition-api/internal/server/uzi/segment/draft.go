@@ -0,0 +1,60 @@
+package segment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlekSi/pointer"
+
+	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
+	"composition-api/internal/server/uzi/mappers"
+)
+
+func (h *handler) UziSegmentIDPropagatePost(ctx context.Context, params api.UziSegmentIDPropagatePostParams) (api.UziSegmentIDPropagatePostRes, error) {
+	drafts, err := h.services.SegmentService.Propose(ctx, params.ID, apimappers.FromOptInt(params.Depth))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := mappers.SegmentDraft{}.SliceDomain(drafts)
+	if err != nil {
+		return nil, fmt.Errorf("map segment drafts to output: %w", err)
+	}
+
+	return pointer.To(api.UziSegmentIDPropagatePostOKApplicationJSON(response)), nil
+}
+
+func (h *handler) UziNodesIDSegmentDraftsGet(ctx context.Context, params api.UziNodesIDSegmentDraftsGetParams) (api.UziNodesIDSegmentDraftsGetRes, error) {
+	drafts, err := h.services.SegmentService.GetDraftsByNodeID(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := mappers.SegmentDraft{}.SliceDomain(drafts)
+	if err != nil {
+		return nil, fmt.Errorf("map segment drafts to output: %w", err)
+	}
+
+	return pointer.To(api.UziNodesIDSegmentDraftsGetOKApplicationJSON(response)), nil
+}
+
+func (h *handler) UziSegmentDraftsIDAcceptPost(ctx context.Context, params api.UziSegmentDraftsIDAcceptPostParams) (api.UziSegmentDraftsIDAcceptPostRes, error) {
+	segment, err := h.services.SegmentService.AcceptDraft(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := mappers.Segment{}.Domain(segment)
+	if err != nil {
+		return nil, fmt.Errorf("map segment to output: %w", err)
+	}
+	return &resp, nil
+}
+
+func (h *handler) UziSegmentDraftsIDDelete(ctx context.Context, params api.UziSegmentDraftsIDDeleteParams) (api.UziSegmentDraftsIDDeleteRes, error) {
+	if err := h.services.SegmentService.DiscardDraft(ctx, params.ID); err != nil {
+		return nil, err
+	}
+	return &api.UziSegmentDraftsIDDeleteOK{}, nil
+}
@@ -12,6 +12,11 @@ type SegmentHandler interface {
 	UziNodesIDSegmentsGet(ctx context.Context, params api.UziNodesIDSegmentsGetParams) (api.UziNodesIDSegmentsGetRes, error)
 	UziSegmentIDPatch(ctx context.Context, req *api.UziSegmentIDPatchReq, params api.UziSegmentIDPatchParams) (api.UziSegmentIDPatchRes, error)
 	UziSegmentIDDelete(ctx context.Context, params api.UziSegmentIDDeleteParams) (api.UziSegmentIDDeleteRes, error)
+
+	UziSegmentIDPropagatePost(ctx context.Context, params api.UziSegmentIDPropagatePostParams) (api.UziSegmentIDPropagatePostRes, error)
+	UziNodesIDSegmentDraftsGet(ctx context.Context, params api.UziNodesIDSegmentDraftsGetParams) (api.UziNodesIDSegmentDraftsGetRes, error)
+	UziSegmentDraftsIDAcceptPost(ctx context.Context, params api.UziSegmentDraftsIDAcceptPostParams) (api.UziSegmentDraftsIDAcceptPostRes, error)
+	UziSegmentDraftsIDDelete(ctx context.Context, params api.UziSegmentDraftsIDDeleteParams) (api.UziSegmentDraftsIDDeleteRes, error)
 }
 
 type handler struct {
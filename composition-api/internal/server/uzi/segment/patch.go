@@ -13,8 +13,8 @@ import (
 
 func (h *handler) UziSegmentIDPatch(ctx context.Context, req *api.UziSegmentIDPatchReq, params api.UziSegmentIDPatchParams) (api.UziSegmentIDPatchRes, error) {
 	var contor []byte
-	if req.Contor != nil {
-		contorParsed, err := json.Marshal(req.Contor)
+	if reqContor, ok := req.Contor.Get(); ok {
+		contorParsed, err := json.Marshal(reqContor)
 		if err != nil {
 			return nil, fmt.Errorf("parse input contor: %w", err)
 		}
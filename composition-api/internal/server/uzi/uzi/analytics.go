@@ -0,0 +1,27 @@
+package uzi
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+
+	uzi_domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
+	mappers "composition-api/internal/server/uzi/mappers"
+)
+
+func (h *handler) UzisAnalyticsGet(ctx context.Context, params api.UzisAnalyticsGetParams) (api.UzisAnalyticsGetRes, error) {
+	analytics, err := h.services.UziService.Analytics(ctx, uzi_domain.AnalyticsFilter{
+		DeviceID:   apimappers.FromOptInt(params.DeviceID),
+		Author:     apimappers.FromOptUUID(params.AuthorID),
+		CreateFrom: apimappers.FromOptDateTime(params.CreateFrom),
+		CreateTo:   apimappers.FromOptDateTime(params.CreateTo),
+		Period:     uzi_domain.AnalyticsPeriod(params.Period.Or(api.UzisAnalyticsGetPeriodMonth)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(mappers.UziAnalytics{}.Domain(analytics)), nil
+}
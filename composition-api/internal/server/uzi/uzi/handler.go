@@ -12,6 +12,8 @@ type UziHandler interface {
 	UziIDGet(ctx context.Context, params api.UziIDGetParams) (api.UziIDGetRes, error)
 	UzisExternalIDGet(ctx context.Context, params api.UzisExternalIDGetParams) (api.UzisExternalIDGetRes, error)
 	UzisAuthorIDGet(ctx context.Context, params api.UzisAuthorIDGetParams) (api.UzisAuthorIDGetRes, error)
+	UzisSearchGet(ctx context.Context, params api.UzisSearchGetParams) (api.UzisSearchGetRes, error)
+	UzisAnalyticsGet(ctx context.Context, params api.UzisAnalyticsGetParams) (api.UzisAnalyticsGetRes, error)
 	UziIDEchographicsGet(ctx context.Context, params api.UziIDEchographicsGetParams) (api.UziIDEchographicsGetRes, error)
 	UziIDPatch(ctx context.Context, req *api.UziIDPatchReq, params api.UziIDPatchParams) (api.UziIDPatchRes, error)
 	UziIDEchographicsPatch(ctx context.Context, req *api.Echographics, params api.UziIDEchographicsPatchParams) (api.UziIDEchographicsPatchRes, error)
@@ -16,6 +16,14 @@ import (
 	uziSrv "composition-api/internal/services/uzi"
 )
 
+func pixelSpacing(opt api.OptPixelSpacing) *uzi_domain.PixelSpacing {
+	spacing, ok := opt.Get()
+	if !ok {
+		return nil
+	}
+	return &uzi_domain.PixelSpacing{X: spacing.X, Y: spacing.Y}
+}
+
 func (h *handler) UziIDPatch(ctx context.Context, req *api.UziIDPatchReq, params api.UziIDPatchParams) (api.UziIDPatchRes, error) {
 	var projection *uzi_domain.UziProjection
 	if req.Projection.IsSet() {
@@ -26,6 +34,8 @@ func (h *handler) UziIDPatch(ctx context.Context, req *api.UziIDPatchReq, params
 		Id:         params.ID,
 		Projection: projection,
 		Checked:    apimappers.FromOptBool(req.Checked),
+
+		PixelSpacing: pixelSpacing(req.PixelSpacing),
 	})
 	if err != nil {
 		switch {
@@ -58,6 +68,25 @@ func (h *handler) UziIDPatch(ctx context.Context, req *api.UziIDPatchReq, params
 }
 
 func (h *handler) UziIDEchographicsPatch(ctx context.Context, req *api.Echographics, params api.UziIDEchographicsPatchParams) (api.UziIDEchographicsPatchRes, error) {
+	var (
+		structure       *uzi_domain.EchographicStruct
+		echogenicity    *uzi_domain.EchographicEchogenicity
+		vascularization *uzi_domain.EchographicVascularization
+		patientSex      *uzi_domain.PatientSex
+	)
+	if req.Struct.IsSet() {
+		structure = (*uzi_domain.EchographicStruct)(&req.Struct.Value)
+	}
+	if req.Echogenicity.IsSet() {
+		echogenicity = (*uzi_domain.EchographicEchogenicity)(&req.Echogenicity.Value)
+	}
+	if req.Vascularization.IsSet() {
+		vascularization = (*uzi_domain.EchographicVascularization)(&req.Vascularization.Value)
+	}
+	if req.PatientSex.IsSet() {
+		patientSex = (*uzi_domain.PatientSex)(&req.PatientSex.Value)
+	}
+
 	echographics, err := h.services.UziService.UpdateEchographics(ctx, uzi_domain.Echographic{
 		Id:              params.ID,
 		Contors:         apimappers.FromOptString(req.Contors),
@@ -71,13 +100,14 @@ func (h *handler) UziIDEchographicsPatch(ctx context.Context, req *api.Echograph
 		RightLobeVolum:  apimappers.FromOptFloat64(req.RightLobeVolum),
 		GlandVolum:      apimappers.FromOptFloat64(req.GlandVolum),
 		Isthmus:         apimappers.FromOptFloat64(req.Isthmus),
-		Struct:          apimappers.FromOptString(req.Struct),
-		Echogenicity:    apimappers.FromOptString(req.Echogenicity),
+		Struct:          structure,
+		Echogenicity:    echogenicity,
 		RegionalLymph:   apimappers.FromOptString(req.RegionalLymph),
-		Vascularization: apimappers.FromOptString(req.Vascularization),
+		Vascularization: vascularization,
 		Location:        apimappers.FromOptString(req.Location),
 		Additional:      apimappers.FromOptString(req.Additional),
 		Conclusion:      apimappers.FromOptString(req.Conclusion),
+		PatientSex:      patientSex,
 	})
 	if err != nil {
 		switch {
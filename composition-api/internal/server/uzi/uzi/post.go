@@ -7,9 +7,11 @@ import (
 	"net/http"
 
 	"composition-api/internal/domain"
+	upload_domain "composition-api/internal/domain/upload"
 	uzi_domain "composition-api/internal/domain/uzi"
 
 	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
 
 	api "composition-api/internal/generated/http/api"
 	mappers "composition-api/internal/server/mappers"
@@ -28,7 +30,45 @@ func (h *handler) UziPost(ctx context.Context, req *api.UziPostReq) (api.UziPost
 		return nil, err
 	}
 
-	contentType := req.File.Header.Get("Content-Type")
+	// файл передается либо в запросе, либо заранее загруженным частями
+	if req.File.IsSet() == req.UploadID.IsSet() {
+		return &api.UziPostBadRequest{
+			StatusCode: http.StatusBadRequest,
+			Response: api.Error{
+				Message: "Нужно указать ровно одно из file или upload_id",
+			},
+		}, nil
+	}
+
+	var contentType string
+	var uploadID *uuid.UUID
+	if file, ok := req.File.Get(); ok {
+		contentType = file.Header.Get("Content-Type")
+	} else {
+		progress, err := h.services.UploadService.Get(ctx, token.Id, req.UploadID.Value)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return &api.UziPostBadRequest{
+					StatusCode: http.StatusBadRequest,
+					Response: api.Error{
+						Message: "Загрузка не найдена",
+					},
+				}, nil
+			}
+			return nil, err
+		}
+		if progress.Upload.Kind != upload_domain.UploadKindUzi || progress.Upload.Status != upload_domain.UploadStatusCompleted {
+			return &api.UziPostBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: fmt.Sprintf("Ожидается завершенная загрузка узи, получено: %s, %s", progress.Upload.Kind, progress.Upload.Status),
+				},
+			}, nil
+		}
+		contentType = progress.Upload.ContentType
+		uploadID = &progress.Upload.Id
+	}
+
 	allowedContentTypes := map[string]bool{
 		"image/tiff": true,
 		"image/png":  true,
@@ -44,17 +84,43 @@ func (h *handler) UziPost(ctx context.Context, req *api.UziPostReq) (api.UziPost
 		}, nil
 	}
 
-	uziID, err := h.services.UziService.Create(ctx, uziSrv.CreateUziArg{
-		File:        req.File,
+	// размер пикселя передается только парой
+	var pixelSpacing *uzi_domain.PixelSpacing
+	if req.PixelSpacingX.IsSet() || req.PixelSpacingY.IsSet() {
+		x, okX := req.PixelSpacingX.Get()
+		y, okY := req.PixelSpacingY.Get()
+		if !okX || !okY || x <= 0 || y <= 0 {
+			return &api.UziPostBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: "pixel_spacing_x и pixel_spacing_y должны быть заданы вместе и быть положительными",
+				},
+			}, nil
+		}
+		pixelSpacing = &uzi_domain.PixelSpacing{X: x, Y: y}
+	}
+
+	uziID, duplicates, err := h.services.UziService.Create(ctx, uziSrv.CreateUziArg{
+		File:        req.File.Value,
+		UploadID:    uploadID,
 		Projection:  uziProjectionMap[req.Projection],
 		ExternalID:  req.ExternalID,
 		Author:      token.Id,
 		DeviceID:    req.DeviceID,
 		Description: mappers.FromOptString(req.Description),
+
+		PixelSpacing: pixelSpacing,
 	})
 	if err != nil {
 		switch {
-		case errors.Is(err, domain.ErrBadRequest):
+		case errors.Is(err, domain.ErrConflict):
+			return &api.UziPostConflict{
+				StatusCode: http.StatusConflict,
+				Response: api.Error{
+					Message: "Такой файл уже загружен для пациента",
+				},
+			}, nil
+		case errors.Is(err, domain.ErrBadRequest), errors.Is(err, domain.ErrNotFound):
 			return &api.UziPostBadRequest{
 				StatusCode: http.StatusBadRequest,
 				Response: api.Error{
@@ -73,5 +139,5 @@ func (h *handler) UziPost(ctx context.Context, req *api.UziPostReq) (api.UziPost
 		}
 	}
 
-	return pointer.To(api.SimpleUuid{ID: uziID}), nil
+	return pointer.To(api.UziCreated{ID: uziID, DuplicateOf: duplicates}), nil
 }
@@ -0,0 +1,57 @@
+package uzi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+
+	"composition-api/internal/domain"
+	uzi_domain "composition-api/internal/domain/uzi"
+	api "composition-api/internal/generated/http/api"
+	apimappers "composition-api/internal/server/mappers"
+	mappers "composition-api/internal/server/uzi/mappers"
+	uziSrv "composition-api/internal/services/uzi"
+)
+
+func (h *handler) UzisSearchGet(ctx context.Context, params api.UzisSearchGetParams) (api.UzisSearchGetRes, error) {
+	filter := uzi_domain.UziFilter{
+		Author:       apimappers.FromOptUUID(params.AuthorID),
+		ExternalID:   apimappers.FromOptUUID(params.ExternalID),
+		DeviceID:     apimappers.FromOptInt(params.DeviceID),
+		Checked:      apimappers.FromOptBool(params.Checked),
+		CreateFrom:   apimappers.FromOptDateTime(params.CreateFrom),
+		CreateTo:     apimappers.FromOptDateTime(params.CreateTo),
+		AiTirads4Min: apimappers.FromOptFloat64(params.AiTirads4Min),
+		AiTirads5Min: apimappers.FromOptFloat64(params.AiTirads5Min),
+	}
+	if status, ok := params.Status.Get(); ok {
+		filter.Status = pointer.To(uzi_domain.UziStatus(status))
+	}
+	if projection, ok := params.Projection.Get(); ok {
+		filter.Projection = pointer.To(uzi_domain.UziProjection(projection))
+	}
+
+	page, err := h.services.UziService.Search(ctx, uziSrv.SearchUziArg{
+		Filter: filter,
+		Order:  uzi_domain.SortOrder(params.Order.Or(api.UzisSearchGetOrderDesc)),
+		Cursor: apimappers.FromOptString(params.Cursor),
+		Limit:  params.Limit.Or(0),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBadRequest):
+			return &api.UzisSearchGetBadRequest{
+				StatusCode: http.StatusBadRequest,
+				Response: api.Error{
+					Message: "Неверные параметры поиска",
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return pointer.To(mappers.UziPage{}.Domain(page)), nil
+}
@@ -3,8 +3,10 @@ package uzi
 import (
 	"composition-api/internal/server/uzi/device"
 	"composition-api/internal/server/uzi/image"
+	"composition-api/internal/server/uzi/lineage"
 	"composition-api/internal/server/uzi/node"
 	"composition-api/internal/server/uzi/node_segment"
+	"composition-api/internal/server/uzi/report"
 	"composition-api/internal/server/uzi/segment"
 	"composition-api/internal/server/uzi/uzi"
 	services "composition-api/internal/services"
@@ -16,6 +18,8 @@ type UziRoute interface {
 	device.DeviceHandler
 	image.ImageHandler
 	node.NodeHandler
+	lineage.LineageHandler
+	report.ReportHandler
 	uzi.UziHandler
 }
 
@@ -25,6 +29,8 @@ type uziRoute struct {
 	device.DeviceHandler
 	image.ImageHandler
 	node.NodeHandler
+	lineage.LineageHandler
+	report.ReportHandler
 	uzi.UziHandler
 }
 
@@ -34,6 +40,8 @@ func NewUziRoute(services *services.Services) UziRoute {
 	deviceHandler := device.NewHandler(services)
 	imageHandler := image.NewHandler(services)
 	nodeHandler := node.NewHandler(services)
+	lineageHandler := lineage.NewHandler(services)
+	reportHandler := report.NewHandler(services)
 	uziHandler := uzi.NewHandler(services)
 
 	return &uziRoute{
@@ -42,6 +50,8 @@ func NewUziRoute(services *services.Services) UziRoute {
 		DeviceHandler:      deviceHandler,
 		ImageHandler:       imageHandler,
 		NodeHandler:        nodeHandler,
+		LineageHandler:     lineageHandler,
+		ReportHandler:      reportHandler,
 		UziHandler:         uziHandler,
 	}
 }
@@ -8,9 +8,57 @@ import (
 
 	"composition-api/internal/adapters/cytology"
 	domain "composition-api/internal/domain/cytology"
+	deidentifyDomain "composition-api/internal/domain/deidentify"
+	uploadDomain "composition-api/internal/domain/upload"
+	"composition-api/internal/services/upload"
 )
 
+// Используем bucket "cytology" для цитологических файлов
+const cytologyBucket = "cytology"
+
+// originalImagePath путь в S3: {cytology_id}/{original_image_id}/{original_image_id}
+// Используем "/" для S3, так как filepath.Join может давать разные результаты на разных ОС
+func originalImagePath(cytologyID, originalImageID uuid.UUID) string {
+	return cytologyID.String() + "/" + originalImageID.String() + "/" + originalImageID.String()
+}
+
 func (s *service) CreateCytologyImage(ctx context.Context, arg CreateCytologyImageArg) (uuid.UUID, error) {
+	hasFile := arg.File != nil && (*arg.File).File != nil
+
+	// метаданные очищаются и sha256 считается до создания записей,
+	// загрузка частями очищается и хешируется в фоне после завершения
+	var sum *string
+	var report deidentifyDomain.Report
+	contentType := arg.ContentType
+	if hasFile {
+		file, fileReport, cleanup, err := s.deidentify.MultipartFile(*arg.File)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("deidentify cytology file: %w", err)
+		}
+		defer cleanup()
+		arg.File, report = &file, fileReport
+
+		fileSum, err := upload.HashMultipartFile(file)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("hash cytology file: %w", err)
+		}
+		sum = &fileSum
+	}
+
+	// загрузка занимается до создания записей, чтобы параллельный запрос с тем же upload_id не создал вторую
+	var claimed *uploadDomain.Upload
+	if arg.UploadID != nil {
+		u, err := s.upload.Claim(ctx, upload.ClaimUploadArg{
+			Id:    *arg.UploadID,
+			Owner: arg.Owner,
+			Kind:  uploadDomain.UploadKindCytology,
+		})
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("claim cytology upload: %w", err)
+		}
+		claimed, contentType, sum = &u, u.ContentType, u.Sha256
+	}
+
 	// Сначала создаем запись в БД через gRPC (без файла)
 	cytologyID, err := s.adapters.Cytology.CreateCytologyImage(ctx, cytology.CreateCytologyImageIn{
 		ExternalID:        arg.ExternalID,
@@ -25,43 +73,65 @@ func (s *service) CreateCytologyImage(ctx context.Context, arg CreateCytologyIma
 		Details:           arg.Details,
 		PrevID:            arg.PrevID,
 		ParentPrevID:      arg.ParentPrevID,
-		File:              nil, // Не передаем файл через gRPC
-		ContentType:       "",
 	})
 	if err != nil {
+		if claimed != nil {
+			_ = s.upload.Release(ctx, *claimed)
+		}
 		return uuid.Nil, fmt.Errorf("create cytology image in microservice: %w", err)
 	}
 
-	// Если передан файл, загружаем его в S3 напрямую (как в УЗИ)
-	if arg.File != nil && (*arg.File).File != nil {
-		// Генерируем ID для original_image заранее, чтобы использовать один и тот же ID
-		originalImageID := uuid.New()
+	if !hasFile && claimed == nil {
+		return cytologyID, nil
+	}
 
-		// Формируем путь в S3: {cytology_id}/{original_image_id}/{original_image_id}
-		// Используем "/" для S3, так как filepath.Join может давать разные результаты на разных ОС
-		imagePath := cytologyID.String() + "/" + originalImageID.String() + "/" + originalImageID.String()
+	// Генерируем ID для original_image заранее, чтобы использовать один и тот же ID
+	originalImageID := uuid.New()
+	imagePath := originalImagePath(cytologyID, originalImageID)
+	fileRepo := s.dao.NewFileRepoWithBucket(cytologyBucket)
 
+	// без изображения исследование не нужно
+	rollback := func() {
+		_ = fileRepo.DeleteFile(ctx, imagePath)
+		_ = s.adapters.Cytology.DeleteCytologyImage(ctx, cytologyID)
+	}
+
+	if claimed != nil {
+		// Загрузка частями уже собрана в S3, переносим ее серверным копированием
+		if err := s.upload.Move(ctx, *claimed, upload.MoveUploadArg{Bucket: cytologyBucket, Path: imagePath}); err != nil {
+			rollback()
+			_ = s.upload.Release(ctx, *claimed)
+			return uuid.Nil, fmt.Errorf("move cytology upload: %w", err)
+		}
+	} else {
 		// Загружаем файл в S3 напрямую (потоковая загрузка, без чтения в память)
-		// Используем bucket "cytology" для цитологических файлов
-		err = s.dao.NewFileRepoWithBucket("cytology").LoadFile(ctx, imagePath, *arg.File)
-		if err != nil {
+		if err := fileRepo.LoadFile(ctx, imagePath, *arg.File); err != nil {
+			rollback()
 			return uuid.Nil, fmt.Errorf("load cytology file to s3: %w", err)
 		}
-
-		// Создаем original_image через gRPC, передавая только путь к файлу
-		// Файл уже загружен в S3, поэтому передаем путь вместо файла
-		// Это избегает повторной передачи файла по сети и чтения его в память
-		_, err = s.adapters.Cytology.CreateOriginalImage(ctx, cytology.CreateOriginalImageIn{
-			CytologyID:  cytologyID,
-			ContentType: arg.ContentType,
-			DelayTime:   nil,
-			ImagePath:   &imagePath, // Передаем путь к файлу в S3
-		})
-		if err != nil {
-			return uuid.Nil, fmt.Errorf("create original image: %w", err)
+		// отчет загрузки частями сохраняется при переносе файла
+		if err := s.deidentify.SaveReport(ctx, cytologyBucket, imagePath, report); err != nil {
+			rollback()
+			return uuid.Nil, fmt.Errorf("save deidentification report: %w", err)
 		}
 	}
 
+	// Создаем original_image через gRPC, передавая только путь к файлу
+	// Файл уже загружен в S3, поэтому передаем путь вместо файла
+	// Это избегает повторной передачи файла по сети и чтения его в память
+	_, err = s.adapters.Cytology.CreateOriginalImage(ctx, cytology.CreateOriginalImageIn{
+		CytologyID:  cytologyID,
+		ContentType: contentType,
+		DelayTime:   nil,
+		ImagePath:   imagePath, // Передаем путь к файлу в S3
+		Sha256:      sum,
+	})
+	if err != nil {
+		// в том числе когда файл отклонен как дубль
+		rollback()
+		return uuid.Nil, fmt.Errorf("create original image: %w", err)
+	}
+
 	return cytologyID, nil
 }
 
@@ -20,8 +20,11 @@ type CreateCytologyImageArg struct {
 	Details           *string
 	PrevID            *uuid.UUID
 	ParentPrevID      *uuid.UUID
-	File              *ht.MultipartFile
-	ContentType       string
+	// File или UploadID завершенной загрузки частями
+	File        *ht.MultipartFile
+	UploadID    *uuid.UUID
+	Owner       uuid.UUID
+	ContentType string
 }
 
 type UpdateCytologyImageArg struct {
@@ -38,10 +41,11 @@ type UpdateCytologyImageArg struct {
 }
 
 type CreateOriginalImageArg struct {
-	CytologyID  uuid.UUID
-	File        ht.MultipartFile
-	ContentType string
-	DelayTime   *float64
+	CytologyID uuid.UUID
+	// UploadID завершенной загрузки частями с файлом изображения
+	UploadID  uuid.UUID
+	Owner     uuid.UUID
+	DelayTime *float64
 }
 
 type UpdateOriginalImageArg struct {
@@ -66,10 +70,34 @@ type UpdateSegmentationGroupArg struct {
 
 type CreateSegmentationArg struct {
 	SegmentationGroupID int
-	Points              []domain.SegmentationPoint
+	// не передан - определяется по точкам
+	GeometryType *domain.GeometryType
+	Points       []domain.SegmentationPoint
+	Color        *domain.Color
+	IsLocked     bool
+	Confidence   *float64
+	Properties   *string
+}
+
+type GetSegmentsInViewportArg struct {
+	CytologyID uuid.UUID
+	// в пикселях нулевого уровня
+	BBox   domain.BBox
+	Filter domain.SegmentationViewportFilter
+	// пикселей нулевого уровня в пикселе экрана, больше 1 - контуры упрощаются
+	Downsample float64
+	Cursor     *string
+	// 0 - размер страницы по умолчанию
+	Limit int
 }
 
+// UpdateSegmentationArg точки заменяются целиком, метаданные - только переданные
 type UpdateSegmentationArg struct {
-	Id     int
-	Points []domain.SegmentationPoint
+	Id           int
+	Points       []domain.SegmentationPoint
+	GeometryType *domain.GeometryType
+	Color        *domain.Color
+	IsLocked     *bool
+	Confidence   *float64
+	Properties   *string
 }
@@ -2,20 +2,48 @@ package cytology
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
 
 	"composition-api/internal/adapters/cytology"
 	domain "composition-api/internal/domain/cytology"
+	uploadDomain "composition-api/internal/domain/upload"
+	"composition-api/internal/services/upload"
 )
 
 func (s *service) CreateOriginalImage(ctx context.Context, arg CreateOriginalImageArg) (uuid.UUID, error) {
-	return s.adapters.Cytology.CreateOriginalImage(ctx, cytology.CreateOriginalImageIn{
+	imagePath := originalImagePath(arg.CytologyID, uuid.New())
+
+	claimed, err := s.upload.Claim(ctx, upload.ClaimUploadArg{
+		Id:    arg.UploadID,
+		Owner: arg.Owner,
+		Kind:  uploadDomain.UploadKindCytology,
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("claim cytology upload: %w", err)
+	}
+
+	if err := s.upload.Move(ctx, claimed, upload.MoveUploadArg{Bucket: cytologyBucket, Path: imagePath}); err != nil {
+		_ = s.dao.NewFileRepoWithBucket(cytologyBucket).DeleteFile(ctx, imagePath)
+		_ = s.upload.Release(ctx, claimed)
+		return uuid.Nil, fmt.Errorf("move cytology upload: %w", err)
+	}
+
+	id, err := s.adapters.Cytology.CreateOriginalImage(ctx, cytology.CreateOriginalImageIn{
 		CytologyID:  arg.CytologyID,
-		File:        arg.File,
-		ContentType: arg.ContentType,
+		ContentType: claimed.ContentType,
 		DelayTime:   arg.DelayTime,
+		ImagePath:   imagePath,
+		Sha256:      claimed.Sha256,
 	})
+	if err != nil {
+		// запись не создана, перенесенный файл больше никому не нужен
+		_ = s.dao.NewFileRepoWithBucket(cytologyBucket).DeleteFile(ctx, imagePath)
+		return uuid.Nil, err
+	}
+
+	return id, nil
 }
 
 func (s *service) GetOriginalImageById(ctx context.Context, id uuid.UUID) (domain.OriginalImage, error) {
@@ -0,0 +1,17 @@
+package cytology
+
+import (
+	"context"
+
+	domain "composition-api/internal/domain/cytology"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) ExportQuPath(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	return s.adapters.Cytology.ExportQuPathGeoJson(ctx, id)
+}
+
+func (s *service) ImportQuPath(ctx context.Context, id uuid.UUID, geojson []byte) (domain.QuPathImport, error) {
+	return s.adapters.Cytology.ImportQuPathGeoJson(ctx, id, geojson)
+}
@@ -38,7 +38,12 @@ func (s *service) DeleteSegmentationGroup(ctx context.Context, id int) error {
 func (s *service) CreateSegmentation(ctx context.Context, arg CreateSegmentationArg) (int, error) {
 	return s.adapters.Cytology.CreateSegmentation(ctx, cytology.CreateSegmentationIn{
 		SegmentationGroupID: arg.SegmentationGroupID,
+		GeometryType:        arg.GeometryType,
 		Points:              arg.Points,
+		Color:               arg.Color,
+		IsLocked:            arg.IsLocked,
+		Confidence:          arg.Confidence,
+		Properties:          arg.Properties,
 	})
 }
 
@@ -50,10 +55,26 @@ func (s *service) GetSegmentsByGroupId(ctx context.Context, id int) ([]domain.Se
 	return s.adapters.Cytology.GetSegmentsByGroupId(ctx, id)
 }
 
+func (s *service) GetSegmentsInViewport(ctx context.Context, arg GetSegmentsInViewportArg) (domain.SegmentationPage, error) {
+	return s.adapters.Cytology.GetSegmentsInViewport(ctx, cytology.GetSegmentsInViewportIn{
+		CytologyID: arg.CytologyID,
+		BBox:       arg.BBox,
+		Filter:     arg.Filter,
+		Downsample: arg.Downsample,
+		Cursor:     arg.Cursor,
+		Limit:      arg.Limit,
+	})
+}
+
 func (s *service) UpdateSegmentation(ctx context.Context, arg UpdateSegmentationArg) (domain.Segmentation, error) {
 	return s.adapters.Cytology.UpdateSegmentation(ctx, cytology.UpdateSegmentationIn{
-		Id:     arg.Id,
-		Points: arg.Points,
+		Id:           arg.Id,
+		Points:       arg.Points,
+		GeometryType: arg.GeometryType,
+		Color:        arg.Color,
+		IsLocked:     arg.IsLocked,
+		Confidence:   arg.Confidence,
+		Properties:   arg.Properties,
 	})
 }
 
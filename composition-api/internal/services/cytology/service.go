@@ -9,6 +9,8 @@ import (
 	dbus "composition-api/internal/dbus/producers"
 	domain "composition-api/internal/domain/cytology"
 	"composition-api/internal/repository"
+	"composition-api/internal/services/deidentify"
+	"composition-api/internal/services/upload"
 )
 
 type Service interface {
@@ -33,23 +35,32 @@ type Service interface {
 	CreateSegmentation(ctx context.Context, arg CreateSegmentationArg) (int, error)
 	GetSegmentationById(ctx context.Context, id int) (domain.Segmentation, error)
 	GetSegmentsByGroupId(ctx context.Context, id int) ([]domain.Segmentation, error)
+	GetSegmentsInViewport(ctx context.Context, arg GetSegmentsInViewportArg) (domain.SegmentationPage, error)
 	UpdateSegmentation(ctx context.Context, arg UpdateSegmentationArg) (domain.Segmentation, error)
 	DeleteSegmentation(ctx context.Context, id int) error
 
 	CopyCytologyImage(ctx context.Context, id uuid.UUID) (domain.CytologyImage, error)
 	GetCytologyImageHistory(ctx context.Context, id uuid.UUID) ([]domain.CytologyImage, error)
+
+	ExportQuPath(ctx context.Context, id uuid.UUID) ([]byte, error)
+	// ImportQuPath сохраняет отредактированный в QuPath GeoJSON новой версией исследования
+	ImportQuPath(ctx context.Context, id uuid.UUID, geojson []byte) (domain.QuPathImport, error)
 }
 
 type service struct {
-	adapters *adapters.Adapters
-	dao      repository.DAO
-	dbus     dbus.Producer
+	adapters   *adapters.Adapters
+	dao        repository.DAO
+	dbus       dbus.Producer
+	upload     upload.Service
+	deidentify deidentify.Service
 }
 
-func New(adapters *adapters.Adapters, dao repository.DAO, dbus dbus.Producer) Service {
+func New(adapters *adapters.Adapters, dao repository.DAO, dbus dbus.Producer, upload upload.Service, deidentify deidentify.Service) Service {
 	return &service{
-		adapters: adapters,
-		dao:      dao,
-		dbus:     dbus,
+		adapters:   adapters,
+		dao:        dao,
+		dbus:       dbus,
+		upload:     upload,
+		deidentify: deidentify,
 	}
 }
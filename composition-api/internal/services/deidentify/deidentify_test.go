@@ -0,0 +1,267 @@
+package deidentify
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	baseDomain "composition-api/internal/domain"
+	domain "composition-api/internal/domain/deidentify"
+)
+
+func tempFile(t *testing.T, data []byte) *os.File {
+	f, err := os.CreateTemp(t.TempDir(), "file")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	_, err = f.Write(data)
+	require.NoError(t, err)
+	return f
+}
+
+func readAll(t *testing.T, f *os.File) []byte {
+	data, err := os.ReadFile(f.Name())
+	require.NoError(t, err)
+	return data
+}
+
+// littleTiff tiff из одного IFD с ASCII тегами, значения длиннее 4 байт лежат после IFD
+func littleTiff(tags map[uint16]string) []byte {
+	order := binary.LittleEndian
+	ids := []uint16{}
+	for id := range tags {
+		ids = append(ids, id)
+	}
+	// записи IFD отсортированы по тегу
+	slices.Sort(ids)
+
+	buf := []byte("II*\x00")
+	buf = order.AppendUint32(buf, 8)
+	buf = order.AppendUint16(buf, uint16(len(ids)))
+
+	valuesOffset := 8 + 2 + 12*len(ids) + 4
+	var values []byte
+	for _, id := range ids {
+		value := append([]byte(tags[id]), 0)
+		buf = order.AppendUint16(buf, id)
+		buf = order.AppendUint16(buf, tiffTypeASCII)
+		buf = order.AppendUint32(buf, uint32(len(value)))
+		if len(value) <= 4 {
+			inline := make([]byte, 4)
+			copy(inline, value)
+			buf = append(buf, inline...)
+			continue
+		}
+		buf = order.AppendUint32(buf, uint32(valuesOffset+len(values)))
+		values = append(values, value...)
+	}
+	buf = order.AppendUint32(buf, 0)
+
+	return append(buf, values...)
+}
+
+func TestTiff(t *testing.T) {
+	srv := &service{profile: domain.NewProfile(domain.ProfileBasic), key: []byte("key")}
+	data := littleTiff(map[uint16]string{
+		270: "Aperio |MPP = 0.25",
+		269: "STUDY-12345",
+		315: "Ivanov I.I.",
+		306: "2024:01:01",
+	})
+	f := tempFile(t, data)
+
+	report, err := srv.File(f)
+	require.NoError(t, err)
+	require.Equal(t, "tiff", report.Format)
+	require.ElementsMatch(t, []domain.Removed{
+		{Field: domain.FieldDocumentName, Tag: "tiff:269 DocumentName", Action: domain.ActionPseudonymize},
+		{Field: domain.FieldDateTime, Tag: "tiff:306 DateTime", Action: domain.ActionStrip},
+		{Field: domain.FieldArtist, Tag: "tiff:315 Artist", Action: domain.ActionStrip},
+	}, report.Removed)
+
+	cleaned := readAll(t, f)
+	require.Len(t, cleaned, len(data))
+	require.NotContains(t, string(cleaned), "Ivanov")
+	require.NotContains(t, string(cleaned), "STUDY-12345")
+	require.NotContains(t, string(cleaned), "2024:01:01")
+	// в описании масштаб сканера, basic его не трогает
+	require.Contains(t, string(cleaned), "Aperio |MPP = 0.25")
+	// псевдоним той же длины и детерминирован
+	require.Contains(t, string(cleaned), string(srv.pseudonym([]byte("STUDY-12345"), len("STUDY-12345"))))
+
+	// повторная очистка ничего не находит
+	report, err = srv.File(f)
+	require.NoError(t, err)
+	require.Len(t, report.Removed, 1)
+	require.Equal(t, domain.FieldDocumentName, report.Removed[0].Field)
+}
+
+func TestTiffLoop(t *testing.T) {
+	srv := &service{profile: domain.NewProfile(domain.ProfileStrict)}
+
+	// следующий IFD указывает сам на себя
+	data := littleTiff(map[uint16]string{315: "Ivanov"})
+	binary.LittleEndian.PutUint32(data[8+2+12:], 8)
+
+	_, err := srv.File(tempFile(t, data))
+	require.ErrorIs(t, err, errMalformedTiff)
+}
+
+// explicitElement элемент explicit VR little endian
+func explicitElement(group, element uint16, vr, value string) []byte {
+	order := binary.LittleEndian
+	if len(value)%2 != 0 {
+		value += " "
+	}
+	out := order.AppendUint16(nil, group)
+	out = order.AppendUint16(out, element)
+	out = append(out, vr...)
+	if _, ok := dicomLongVRs[vr]; ok {
+		out = append(out, 0, 0)
+		return append(order.AppendUint32(out, uint32(len(value))), value...)
+	}
+	return append(order.AppendUint16(out, uint16(len(value))), value...)
+}
+
+// explicitDicom файл с метаинформацией и набором данных, преамбула похожа на tiff
+func explicitDicom(transferSyntax string, dataset ...[]byte) []byte {
+	preamble := make([]byte, dicomPreamble)
+	copy(preamble, "II*\x00")
+	data := append(preamble, dicomMagic...)
+	data = append(data, explicitElement(0x0002, 0x0010, "UI", transferSyntax+"\x00")...)
+	for _, e := range dataset {
+		data = append(data, e...)
+	}
+	return data
+}
+
+func TestDicom(t *testing.T) {
+	srv := &service{profile: domain.NewProfile(domain.ProfileBasic), key: []byte("key")}
+
+	// последовательность неопределенной длины пропускается до разделителя
+	order := binary.LittleEndian
+	sequence := explicitElement(0x0008, 0x0006, "SQ", "")
+	order.PutUint32(sequence[8:], dicomUndefinedLength)
+	sequence = order.AppendUint32(sequence, 0xE000FFFE)
+	sequence = order.AppendUint32(sequence, dicomUndefinedLength)
+	sequence = append(sequence, explicitElement(0x0008, 0x0100, "SH", "ru")...)
+	sequence = order.AppendUint32(sequence, 0xE00DFFFE)
+	sequence = order.AppendUint32(sequence, 0)
+	sequence = order.AppendUint32(sequence, 0xE0DDFFFE)
+	sequence = order.AppendUint32(sequence, 0)
+
+	data := explicitDicom("1.2.840.10008.1.2.1",
+		sequence,
+		explicitElement(0x0008, 0x0080, "LO", "City Hospital"),
+		explicitElement(0x0010, 0x0010, "PN", "Ivanov^Ivan"),
+		explicitElement(0x0010, 0x0030, "DA", "19800101"),
+		explicitElement(0x7FE0, 0x0010, "OB", "pixels"),
+	)
+	f := tempFile(t, data)
+
+	report, err := srv.File(f)
+	require.NoError(t, err)
+	require.Equal(t, "dicom", report.Format)
+	require.ElementsMatch(t, []domain.Removed{
+		{Field: domain.FieldInstitution, Tag: "dicom:(0008,0080) InstitutionName", Action: domain.ActionStrip},
+		{Field: domain.FieldPatientName, Tag: "dicom:(0010,0010) PatientName", Action: domain.ActionPseudonymize},
+		{Field: domain.FieldBirthDate, Tag: "dicom:(0010,0030) PatientBirthDate", Action: domain.ActionStrip},
+	}, report.Removed)
+
+	cleaned := readAll(t, f)
+	require.Len(t, cleaned, len(data))
+	require.NotContains(t, string(cleaned), "City Hospital")
+	require.NotContains(t, string(cleaned), "Ivanov")
+	require.NotContains(t, string(cleaned), "19800101")
+	require.Contains(t, string(cleaned), string(srv.pseudonym([]byte("Ivanov^Ivan"), len("Ivanov^Ivan "))))
+	require.Contains(t, string(cleaned), "pixels")
+
+	// повторная очистка находит только псевдоним
+	report, err = srv.File(f)
+	require.NoError(t, err)
+	require.Len(t, report.Removed, 1)
+	require.Equal(t, domain.FieldPatientName, report.Removed[0].Field)
+}
+
+func TestDicomDeflated(t *testing.T) {
+	srv := &service{profile: domain.NewProfile(domain.ProfileBasic)}
+
+	data := explicitDicom(dicomDeflated, []byte("compressed"))
+	_, err := srv.File(tempFile(t, data))
+	require.ErrorIs(t, err, baseDomain.ErrBadRequest)
+}
+
+func TestJpeg(t *testing.T) {
+	srv := &service{profile: domain.NewProfile(domain.ProfileBasic)}
+
+	var encoded bytes.Buffer
+	require.NoError(t, jpeg.Encode(&encoded, image.NewGray(image.Rect(0, 0, 4, 4)), nil))
+
+	segment := func(marker byte, payload string) []byte {
+		out := []byte{0xFF, marker}
+		out = binary.BigEndian.AppendUint16(out, uint16(len(payload)+2))
+		return append(out, payload...)
+	}
+	data := append([]byte{}, encoded.Bytes()[:2]...)
+	data = append(data, segment(jpegMarkerAPP1, "Exif\x00\x00Ivanov")...)
+	data = append(data, segment(jpegMarkerCOM, "patient Ivanov")...)
+	data = append(data, encoded.Bytes()[2:]...)
+	f := tempFile(t, data)
+
+	report, err := srv.File(f)
+	require.NoError(t, err)
+	require.Equal(t, "jpeg", report.Format)
+	require.Equal(t, []domain.Removed{
+		{Field: domain.FieldExif, Tag: "jpeg:APP1 Exif", Action: domain.ActionStrip},
+		{Field: domain.FieldComment, Tag: "jpeg:COM", Action: domain.ActionStrip},
+	}, report.Removed)
+
+	cleaned := readAll(t, f)
+	require.Equal(t, encoded.Bytes(), cleaned)
+}
+
+func TestPng(t *testing.T) {
+	srv := &service{profile: domain.NewProfile(domain.ProfileBasic)}
+
+	var encoded bytes.Buffer
+	require.NoError(t, png.Encode(&encoded, image.NewGray(image.Rect(0, 0, 4, 4))))
+
+	text := "Author\x00Ivanov"
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(text)))
+	chunk = append(chunk, "tEXt"+text...)
+	// crc не проверяется при удалении
+	chunk = append(chunk, 0, 0, 0, 0)
+
+	// сигнатура и IHDR
+	ihdrEnd := len(pngSignature) + 25
+	data := append([]byte{}, encoded.Bytes()[:ihdrEnd]...)
+	data = append(data, chunk...)
+	data = append(data, encoded.Bytes()[ihdrEnd:]...)
+	f := tempFile(t, data)
+
+	report, err := srv.File(f)
+	require.NoError(t, err)
+	require.Equal(t, []domain.Removed{
+		{Field: domain.FieldComment, Tag: "png:tEXt", Action: domain.ActionStrip},
+	}, report.Removed)
+	require.Equal(t, encoded.Bytes(), readAll(t, f))
+}
+
+func TestProfileOff(t *testing.T) {
+	srv := &service{profile: domain.NewProfile(domain.ProfileOff)}
+
+	data := littleTiff(map[uint16]string{315: "Ivanov I.I."})
+	f := tempFile(t, data)
+
+	report, err := srv.File(f)
+	require.NoError(t, err)
+	require.False(t, report.Changed())
+	require.Equal(t, data, readAll(t, f))
+}
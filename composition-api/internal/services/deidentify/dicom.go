@@ -0,0 +1,252 @@
+package deidentify
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	baseDomain "composition-api/internal/domain"
+	domain "composition-api/internal/domain/deidentify"
+)
+
+// файл с меткой DICM, который не разобрать, нельзя сохранить без очистки
+var errMalformedDicom = fmt.Errorf("malformed dicom: %w", baseDomain.ErrBadRequest)
+
+type dicomTag struct {
+	field domain.Field
+	name  string
+}
+
+// теги верхнего уровня набора данных, ключ - группа и элемент
+var dicomTags = map[uint32]dicomTag{
+	0x00080080: {domain.FieldInstitution, "(0008,0080) InstitutionName"},
+	0x00100010: {domain.FieldPatientName, "(0010,0010) PatientName"},
+	0x00100030: {domain.FieldBirthDate, "(0010,0030) PatientBirthDate"},
+}
+
+const (
+	dicomPreamble = 128
+
+	dicomTagTransferSyntax           = 0x00020010
+	dicomTagItemDelimiter            = 0xFFFEE00D
+	dicomTagSequenceDelimiter        = 0xFFFEE0DD
+	dicomUndefinedLength      uint32 = 0xFFFFFFFF
+
+	// после группы 0010 нужных тегов нет, элементы верхнего уровня идут по возрастанию тега
+	dicomLastTag = 0x0010FFFF
+
+	dicomImplicitLittleEndian = "1.2.840.10008.1.2"
+	dicomExplicitBigEndian    = "1.2.840.10008.1.2.2"
+	dicomDeflated             = "1.2.840.10008.1.2.1.99"
+
+	// защита от зацикленных вложенных последовательностей
+	dicomMaxDepth = 32
+)
+
+var dicomMagic = []byte("DICM")
+
+// VR с 4 байтами длины в explicit VR
+var dicomLongVRs = map[string]struct{}{
+	"OB": {}, "OD": {}, "OF": {}, "OL": {}, "OV": {}, "OW": {}, "SQ": {}, "SV": {}, "UC": {}, "UN": {}, "UR": {}, "UT": {}, "UV": {},
+}
+
+// dicomFile значения перезаписываются на месте той же длины: strip заполняет значение пробелами,
+// которыми DICOM дополняет текстовые значения, псевдоним - hex строка той же длины
+type dicomFile struct {
+	rw interface {
+		io.ReaderAt
+		io.WriterAt
+	}
+	order    binary.ByteOrder
+	implicit bool
+}
+
+type dicomElement struct {
+	tag    uint32
+	length uint32
+	// смещение значения
+	value uint64
+}
+
+func isDicom(r io.ReaderAt) bool {
+	magic := make([]byte, len(dicomMagic))
+	n, _ := r.ReadAt(magic, dicomPreamble)
+	return n == len(magic) && bytes.Equal(magic, dicomMagic)
+}
+
+func (s *service) dicom(rw interface {
+	io.ReaderAt
+	io.WriterAt
+}) ([]domain.Removed, error) {
+	// метаинформация файла всегда explicit VR little endian
+	meta := &dicomFile{rw: rw, order: binary.LittleEndian}
+	offset := uint64(dicomPreamble + len(dicomMagic))
+
+	var transferSyntax string
+	for {
+		e, err := meta.element(offset)
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if e.tag>>16 != 0x0002 {
+			break
+		}
+		if e.tag == dicomTagTransferSyntax {
+			value, err := meta.value(e)
+			if err != nil {
+				return nil, err
+			}
+			transferSyntax = strings.TrimRight(string(value), "\x00 ")
+		}
+		if offset, err = meta.end(e, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	d := &dicomFile{rw: rw, order: binary.LittleEndian}
+	switch transferSyntax {
+	case dicomImplicitLittleEndian:
+		d.implicit = true
+	case dicomExplicitBigEndian:
+		d.order = binary.BigEndian
+	case dicomDeflated:
+		// сжатый набор данных не переписать на месте, сохранять его без очистки нельзя
+		return nil, fmt.Errorf("deflated transfer syntax is not supported: %w", baseDomain.ErrBadRequest)
+	}
+
+	removed := &removedSet{}
+	for {
+		e, err := d.element(offset)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if e.tag > dicomLastTag {
+			break
+		}
+
+		if tag, ok := dicomTags[e.tag]; ok && e.length != dicomUndefinedLength {
+			if action, ok := s.profile.Fields[tag.field]; ok {
+				changed, err := s.dicomValue(d, e, action)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", tag.name, err)
+				}
+				if changed {
+					removed.add(tag.field, "dicom:"+tag.name, action)
+				}
+			}
+		}
+
+		if offset, err = d.end(e, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	return removed.removed, nil
+}
+
+// element заголовок элемента, io.EOF - конец файла ровно на границе элемента
+func (d *dicomFile) element(offset uint64) (dicomElement, error) {
+	head := make([]byte, 12)
+	n, err := d.rw.ReadAt(head[:8], int64(offset))
+	if n == 0 && errors.Is(err, io.EOF) {
+		return dicomElement{}, io.EOF
+	}
+	if n < 8 {
+		return dicomElement{}, errMalformedDicom
+	}
+
+	group, element := d.order.Uint16(head[0:2]), d.order.Uint16(head[2:4])
+	e := dicomElement{tag: uint32(group)<<16 | uint32(element)}
+
+	// элементы и разделители последовательностей записываются без VR
+	if d.implicit || group == 0xFFFE {
+		e.length = d.order.Uint32(head[4:8])
+		e.value = offset + 8
+		return e, nil
+	}
+
+	if _, ok := dicomLongVRs[string(head[4:6])]; !ok {
+		e.length = uint32(d.order.Uint16(head[6:8]))
+		e.value = offset + 8
+		return e, nil
+	}
+
+	if _, err := d.rw.ReadAt(head[8:12], int64(offset+8)); err != nil {
+		return dicomElement{}, errMalformedDicom
+	}
+	e.length = d.order.Uint32(head[8:12])
+	e.value = offset + 12
+	return e, nil
+}
+
+// end смещение после элемента, у элементов неопределенной длины пропускает вложенные до разделителя
+func (d *dicomFile) end(e dicomElement, depth int) (uint64, error) {
+	if e.length != dicomUndefinedLength {
+		return e.value + uint64(e.length), nil
+	}
+	if depth > dicomMaxDepth {
+		return 0, errMalformedDicom
+	}
+
+	offset := e.value
+	for {
+		child, err := d.element(offset)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return 0, errMalformedDicom
+			}
+			return 0, err
+		}
+		if child.tag == dicomTagItemDelimiter || child.tag == dicomTagSequenceDelimiter {
+			return child.value, nil
+		}
+		if offset, err = d.end(child, depth+1); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (d *dicomFile) value(e dicomElement) ([]byte, error) {
+	if e.length > tiffMaxValue {
+		return nil, errMalformedDicom
+	}
+	value := make([]byte, e.length)
+	if _, err := d.rw.ReadAt(value, int64(e.value)); err != nil {
+		return nil, errMalformedDicom
+	}
+	return value, nil
+}
+
+// dicomValue false, если значение уже пустое
+func (s *service) dicomValue(d *dicomFile, e dicomElement, action domain.Action) (bool, error) {
+	value, err := d.value(e)
+	if err != nil {
+		return false, err
+	}
+	trimmed := bytes.TrimRight(value, "\x00 ")
+	if len(trimmed) == 0 {
+		return false, nil
+	}
+
+	var out []byte
+	switch action {
+	case domain.ActionPseudonymize:
+		out = s.pseudonym(trimmed, len(value))
+	default:
+		out = bytes.Repeat([]byte{' '}, len(value))
+	}
+
+	if _, err := d.rw.WriteAt(out, int64(e.value)); err != nil {
+		return false, fmt.Errorf("write value: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,125 @@
+package deidentify
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	domain "composition-api/internal/domain/deidentify"
+)
+
+var errMalformedJpeg = errors.New("malformed jpeg")
+
+const (
+	jpegMarkerSOS  = 0xDA
+	jpegMarkerEOI  = 0xD9
+	jpegMarkerAPP1 = 0xE1
+	// Photoshop IRB с IPTC
+	jpegMarkerAPP13 = 0xED
+	jpegMarkerCOM   = 0xFE
+)
+
+// jpegSegmentField группа метаданных сегмента, false для сегментов без персональных данных (JFIF, ICC, Adobe)
+func jpegSegmentField(marker byte, payload []byte) (domain.Field, string, bool) {
+	switch marker {
+	case jpegMarkerAPP1:
+		if bytes.HasPrefix(payload, []byte("Exif\x00")) {
+			return domain.FieldExif, "jpeg:APP1 Exif", true
+		}
+		return domain.FieldXmp, "jpeg:APP1 XMP", true
+	case jpegMarkerAPP13:
+		return domain.FieldIptc, "jpeg:APP13 IPTC", true
+	case jpegMarkerCOM:
+		return domain.FieldComment, "jpeg:COM", true
+	default:
+		return "", "", false
+	}
+}
+
+// jpeg сегменты до начала скана копируются или пропускаются, данные скана копируются как есть.
+// Вместе с Exif удаляется и ориентация снимка, ультразвуковые кадры ее не используют
+func (s *service) jpeg(dst io.Writer, src io.Reader) ([]domain.Removed, error) {
+	r := bufio.NewReader(src)
+	w := bufio.NewWriter(dst)
+
+	soi := make([]byte, 2)
+	if _, err := io.ReadFull(r, soi); err != nil {
+		return nil, fmt.Errorf("read soi: %w", err)
+	}
+	if _, err := w.Write(soi); err != nil {
+		return nil, err
+	}
+
+	removed := &removedSet{}
+	for {
+		marker, err := jpegMarker(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if marker == jpegMarkerSOS || marker == jpegMarkerEOI {
+			if _, err := w.Write([]byte{0xFF, marker}); err != nil {
+				return nil, err
+			}
+			if _, err := io.Copy(w, r); err != nil {
+				return nil, fmt.Errorf("copy scan: %w", err)
+			}
+			break
+		}
+
+		length := make([]byte, 2)
+		if _, err := io.ReadFull(r, length); err != nil {
+			return nil, fmt.Errorf("read segment length: %w", err)
+		}
+		size := int(binary.BigEndian.Uint16(length))
+		if size < 2 {
+			return nil, errMalformedJpeg
+		}
+		payload := make([]byte, size-2)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return nil, fmt.Errorf("read segment: %w", err)
+		}
+
+		if field, tag, ok := jpegSegmentField(marker, payload); ok {
+			if _, ok := s.profile.Fields[field]; ok {
+				removed.add(field, tag, domain.ActionStrip)
+				continue
+			}
+		}
+
+		for _, part := range [][]byte{{0xFF, marker}, length, payload} {
+			if _, err := w.Write(part); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+
+	return removed.removed, nil
+}
+
+// jpegMarker читает маркер, пропуская байты-заполнители 0xFF
+func jpegMarker(r *bufio.Reader) (byte, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, fmt.Errorf("read marker: %w", err)
+	}
+	if b != 0xFF {
+		return 0, errMalformedJpeg
+	}
+	for {
+		b, err = r.ReadByte()
+		if err != nil {
+			return 0, fmt.Errorf("read marker: %w", err)
+		}
+		if b != 0xFF {
+			return b, nil
+		}
+	}
+}
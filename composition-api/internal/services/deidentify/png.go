@@ -0,0 +1,79 @@
+package deidentify
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	domain "composition-api/internal/domain/deidentify"
+)
+
+var errMalformedPng = errors.New("malformed png")
+
+// чанки с метаданными, остальные копируются как есть
+var pngChunkFields = map[string]domain.Field{
+	"tEXt": domain.FieldComment,
+	"zTXt": domain.FieldComment,
+	"iTXt": domain.FieldComment,
+	"eXIf": domain.FieldExif,
+	"tIME": domain.FieldDateTime,
+}
+
+const pngMaxChunk = 1 << 31
+
+func (s *service) png(dst io.Writer, src io.Reader) ([]domain.Removed, error) {
+	r := bufio.NewReader(src)
+	w := bufio.NewWriter(dst)
+
+	signature := make([]byte, len(pngSignature))
+	if _, err := io.ReadFull(r, signature); err != nil {
+		return nil, fmt.Errorf("read signature: %w", err)
+	}
+	if _, err := w.Write(signature); err != nil {
+		return nil, err
+	}
+
+	removed := &removedSet{}
+	header := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil, fmt.Errorf("read chunk header: %w", err)
+		}
+		length := binary.BigEndian.Uint32(header[:4])
+		if length >= pngMaxChunk {
+			return nil, errMalformedPng
+		}
+		typ := string(header[4:8])
+
+		// данные чанка и crc
+		body := io.LimitReader(r, int64(length)+4)
+		if field, ok := pngChunkFields[typ]; ok {
+			if _, ok := s.profile.Fields[field]; ok {
+				if _, err := io.Copy(io.Discard, body); err != nil {
+					return nil, fmt.Errorf("skip chunk %s: %w", typ, err)
+				}
+				removed.add(field, "png:"+typ, domain.ActionStrip)
+				continue
+			}
+		}
+
+		if _, err := w.Write(header); err != nil {
+			return nil, err
+		}
+		if n, err := io.Copy(w, body); err != nil || n != int64(length)+4 {
+			return nil, fmt.Errorf("copy chunk %s: %w", typ, errors.Join(err, errMalformedPng))
+		}
+
+		if typ == "IEND" {
+			break
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+
+	return removed.removed, nil
+}
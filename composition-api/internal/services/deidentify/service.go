@@ -0,0 +1,209 @@
+package deidentify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	ht "github.com/ogen-go/ogen/http"
+
+	domain "composition-api/internal/domain/deidentify"
+	"composition-api/internal/repository"
+)
+
+type Service interface {
+	// File очищает метаданные файла на месте, f открыт на чтение и запись.
+	// Форматы кроме dicom, tiff, jpeg и png не меняются, в отчете пустой Format
+	File(f *os.File) (domain.Report, error)
+	// MultipartFile копирует файл формы во временный и очищает его,
+	// cleanup удаляет временный файл после загрузки в S3
+	MultipartFile(file ht.MultipartFile) (cleaned ht.MultipartFile, report domain.Report, cleanup func(), err error)
+	// SaveReport сохраняет отчет рядом с объектом, пустой bucket - основной бакет
+	SaveReport(ctx context.Context, bucket, objectPath string, report domain.Report) error
+}
+
+type Config struct {
+	Profile domain.Profile
+	// ключ HMAC для псевдонимов, без него псевдоним можно подобрать перебором
+	Key string
+}
+
+type service struct {
+	dao     repository.DAO
+	profile domain.Profile
+	key     []byte
+}
+
+func New(
+	dao repository.DAO,
+	cfg Config,
+) Service {
+	return &service{
+		dao:     dao,
+		profile: cfg.Profile,
+		key:     []byte(cfg.Key),
+	}
+}
+
+var (
+	tiffLittleEndian = []byte("II")
+	tiffBigEndian    = []byte("MM")
+	jpegSignature    = []byte{0xFF, 0xD8, 0xFF}
+	pngSignature     = []byte("\x89PNG\r\n\x1a\n")
+)
+
+func (s *service) File(f *os.File) (domain.Report, error) {
+	report := domain.Report{Profile: s.profile.Name, CreateAt: time.Now()}
+	if len(s.profile.Fields) == 0 {
+		return report, nil
+	}
+
+	head := make([]byte, 8)
+	n, err := f.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return domain.Report{}, fmt.Errorf("read file header: %w", err)
+	}
+	head = head[:n]
+
+	switch {
+	// преамбула dicom произвольная и может совпасть с сигнатурой другого формата
+	case isDicom(f):
+		report.Format = "dicom"
+		report.Removed, err = s.dicom(f)
+	case bytes.HasPrefix(head, tiffLittleEndian), bytes.HasPrefix(head, tiffBigEndian):
+		report.Format = "tiff"
+		report.Removed, err = s.tiff(f)
+	case bytes.HasPrefix(head, jpegSignature):
+		report.Format = "jpeg"
+		report.Removed, err = s.rewrite(f, s.jpeg)
+	case bytes.Equal(head, pngSignature):
+		report.Format = "png"
+		report.Removed, err = s.rewrite(f, s.png)
+	}
+	if err != nil {
+		return domain.Report{}, fmt.Errorf("deidentify %s: %w", report.Format, err)
+	}
+
+	return report, nil
+}
+
+// rewrite форматы без смещений внутри файла переписываются потоком без удаленных сегментов
+func (s *service) rewrite(f *os.File, filter func(dst io.Writer, src io.Reader) ([]domain.Removed, error)) ([]domain.Removed, error) {
+	temp, err := os.CreateTemp("", "deidentify-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temp file: %w", err)
+	}
+	defer func() {
+		_ = temp.Close()
+		_ = os.Remove(temp.Name())
+	}()
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("seek file: %w", err)
+	}
+
+	removed, err := filter(temp, f)
+	if err != nil {
+		return nil, err
+	}
+	if len(removed) == 0 {
+		return nil, nil
+	}
+
+	if _, err := temp.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("seek temp file: %w", err)
+	}
+	if err := f.Truncate(0); err != nil {
+		return nil, fmt.Errorf("truncate file: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("seek file: %w", err)
+	}
+	if _, err := io.Copy(f, temp); err != nil {
+		return nil, fmt.Errorf("copy cleaned file: %w", err)
+	}
+
+	return removed, nil
+}
+
+func (s *service) MultipartFile(file ht.MultipartFile) (ht.MultipartFile, domain.Report, func(), error) {
+	if len(s.profile.Fields) == 0 {
+		return file, domain.Report{Profile: s.profile.Name, CreateAt: time.Now()}, func() {}, nil
+	}
+
+	temp, err := os.CreateTemp("", "deidentify-*")
+	if err != nil {
+		return ht.MultipartFile{}, domain.Report{}, nil, fmt.Errorf("create temp file: %w", err)
+	}
+	cleanup := func() {
+		_ = temp.Close()
+		_ = os.Remove(temp.Name())
+	}
+
+	if _, err := io.Copy(temp, file.File); err != nil {
+		cleanup()
+		return ht.MultipartFile{}, domain.Report{}, nil, fmt.Errorf("copy multipart file: %w", err)
+	}
+
+	report, err := s.File(temp)
+	if err != nil {
+		cleanup()
+		return ht.MultipartFile{}, domain.Report{}, nil, err
+	}
+
+	size, err := temp.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = temp.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		cleanup()
+		return ht.MultipartFile{}, domain.Report{}, nil, fmt.Errorf("seek temp file: %w", err)
+	}
+
+	return ht.MultipartFile{
+		Name:   file.Name,
+		File:   temp,
+		Size:   size,
+		Header: file.Header,
+	}, report, cleanup, nil
+}
+
+func (s *service) SaveReport(ctx context.Context, bucket, objectPath string, report domain.Report) error {
+	return s.dao.NewDeidentificationRepo().SaveReport(ctx, bucket, objectPath, report)
+}
+
+// pseudonym HMAC значения в hex длиной n, одинаковые значения дают одинаковый псевдоним
+func (s *service) pseudonym(value []byte, n int) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(value)
+	sum := []byte(hex.EncodeToString(mac.Sum(nil)))
+
+	out := make([]byte, n)
+	for i := range out {
+		out[i] = sum[i%len(sum)]
+	}
+	return out
+}
+
+// removedSet отчет без повторов: в многостраничном tiff одни и те же теги есть на каждой странице
+type removedSet struct {
+	seen    map[string]struct{}
+	removed []domain.Removed
+}
+
+func (r *removedSet) add(field domain.Field, tag string, action domain.Action) {
+	if r.seen == nil {
+		r.seen = map[string]struct{}{}
+	}
+	if _, ok := r.seen[tag]; ok {
+		return
+	}
+	r.seen[tag] = struct{}{}
+	r.removed = append(r.removed, domain.Removed{Field: field, Tag: tag, Action: action})
+}
@@ -0,0 +1,310 @@
+package deidentify
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	domain "composition-api/internal/domain/deidentify"
+)
+
+var errMalformedTiff = errors.New("malformed tiff")
+
+type tiffTag struct {
+	field domain.Field
+	name  string
+}
+
+// теги основного IFD каждой страницы
+var tiffTags = map[uint16]tiffTag{
+	269:   {domain.FieldDocumentName, "DocumentName"},
+	270:   {domain.FieldDescription, "ImageDescription"},
+	285:   {domain.FieldPageName, "PageName"},
+	306:   {domain.FieldDateTime, "DateTime"},
+	315:   {domain.FieldArtist, "Artist"},
+	316:   {domain.FieldHostComputer, "HostComputer"},
+	700:   {domain.FieldXmp, "XMP"},
+	33432: {domain.FieldCopyright, "Copyright"},
+	33723: {domain.FieldIptc, "IPTC"},
+	34377: {domain.FieldIptc, "Photoshop"},
+}
+
+// теги EXIF IFD
+var exifTags = map[uint16]tiffTag{
+	36867: {domain.FieldDateTime, "DateTimeOriginal"},
+	36868: {domain.FieldDateTime, "DateTimeDigitized"},
+	37510: {domain.FieldComment, "UserComment"},
+	42016: {domain.FieldExif, "ImageUniqueID"},
+	42032: {domain.FieldExif, "CameraOwnerName"},
+	42033: {domain.FieldExif, "BodySerialNumber"},
+}
+
+const (
+	tiffTypeASCII = 2
+
+	tiffTagExifIFD = 34665
+	tiffTagGpsIFD  = 34853
+
+	// защита от зацикленных и битых цепочек IFD
+	tiffMaxIFDs    = 100000
+	tiffMaxEntries = 10000
+	tiffMaxValue   = 64 << 20
+)
+
+// размер значения каждого типа TIFF 6.0 и BigTIFF
+var tiffTypeSizes = map[uint16]uint64{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8, 13: 4, 16: 8, 17: 8, 18: 8,
+}
+
+// tiffFile значения перезаписываются на месте той же длины, поэтому смещения
+// страниц и сжатые данные не меняются
+type tiffFile struct {
+	rw interface {
+		io.ReaderAt
+		io.WriterAt
+	}
+	order binary.ByteOrder
+	big   bool
+
+	visited map[uint64]struct{}
+}
+
+func (s *service) tiff(rw interface {
+	io.ReaderAt
+	io.WriterAt
+}) ([]domain.Removed, error) {
+	t := &tiffFile{rw: rw, visited: map[uint64]struct{}{}}
+
+	header := make([]byte, 16)
+	if _, err := rw.ReadAt(header[:8], 0); err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	switch {
+	case bytes.HasPrefix(header, tiffLittleEndian):
+		t.order = binary.LittleEndian
+	case bytes.HasPrefix(header, tiffBigEndian):
+		t.order = binary.BigEndian
+	}
+
+	var offset uint64
+	switch t.order.Uint16(header[2:4]) {
+	case 42:
+		offset = uint64(t.order.Uint32(header[4:8]))
+	case 43:
+		t.big = true
+		if _, err := rw.ReadAt(header[8:16], 8); err != nil {
+			return nil, fmt.Errorf("read bigtiff header: %w", err)
+		}
+		offset = t.order.Uint64(header[8:16])
+	default:
+		return nil, errMalformedTiff
+	}
+
+	removed := &removedSet{}
+	for offset != 0 {
+		next, err := s.tiffIFD(t, offset, tiffTags, removed)
+		if err != nil {
+			return nil, err
+		}
+		offset = next
+	}
+
+	return removed.removed, nil
+}
+
+type tiffEntry struct {
+	tag    uint16
+	typ    uint16
+	count  uint64
+	size   uint64
+	offset uint64
+}
+
+// entries читает записи IFD и смещение следующего IFD
+func (t *tiffFile) entries(offset uint64) ([]tiffEntry, uint64, error) {
+	if _, ok := t.visited[offset]; ok || len(t.visited) >= tiffMaxIFDs {
+		return nil, 0, errMalformedTiff
+	}
+	t.visited[offset] = struct{}{}
+
+	countSize, entrySize, valueSize := uint64(2), uint64(12), uint64(4)
+	if t.big {
+		countSize, entrySize, valueSize = 8, 20, 8
+	}
+
+	buf := make([]byte, countSize)
+	if _, err := t.rw.ReadAt(buf, int64(offset)); err != nil {
+		return nil, 0, fmt.Errorf("read ifd count: %w", err)
+	}
+	var count uint64
+	if t.big {
+		count = t.order.Uint64(buf)
+	} else {
+		count = uint64(t.order.Uint16(buf))
+	}
+	if count > tiffMaxEntries {
+		return nil, 0, errMalformedTiff
+	}
+
+	buf = make([]byte, count*entrySize+valueSize)
+	if _, err := t.rw.ReadAt(buf, int64(offset+countSize)); err != nil {
+		return nil, 0, fmt.Errorf("read ifd entries: %w", err)
+	}
+
+	entries := make([]tiffEntry, 0, count)
+	for i := uint64(0); i < count; i++ {
+		raw := buf[i*entrySize : (i+1)*entrySize]
+		entry := tiffEntry{
+			tag: t.order.Uint16(raw[0:2]),
+			typ: t.order.Uint16(raw[2:4]),
+		}
+		value := raw[8:]
+		if t.big {
+			entry.count = t.order.Uint64(raw[4:12])
+			value = raw[12:]
+		} else {
+			entry.count = uint64(t.order.Uint32(raw[4:8]))
+		}
+		entry.size = entry.count * tiffTypeSizes[entry.typ]
+
+		// короткие значения хранятся прямо в записи
+		if entry.size <= valueSize {
+			entry.offset = offset + countSize + i*entrySize + (entrySize - valueSize)
+		} else if t.big {
+			entry.offset = t.order.Uint64(value)
+		} else {
+			entry.offset = uint64(t.order.Uint32(value))
+		}
+		entries = append(entries, entry)
+	}
+
+	next := buf[count*entrySize:]
+	if t.big {
+		return entries, t.order.Uint64(next), nil
+	}
+	return entries, uint64(t.order.Uint32(next)), nil
+}
+
+// pointer значение тега-указателя на вложенный IFD
+func (t *tiffFile) pointer(entry tiffEntry) (uint64, error) {
+	buf := make([]byte, tiffTypeSizes[entry.typ])
+	if len(buf) != 4 && len(buf) != 8 {
+		return 0, errMalformedTiff
+	}
+	if _, err := t.rw.ReadAt(buf, int64(entry.offset)); err != nil {
+		return 0, fmt.Errorf("read ifd pointer: %w", err)
+	}
+	if len(buf) == 8 {
+		return t.order.Uint64(buf), nil
+	}
+	return uint64(t.order.Uint32(buf)), nil
+}
+
+func (s *service) tiffIFD(t *tiffFile, offset uint64, tags map[uint16]tiffTag, removed *removedSet) (uint64, error) {
+	entries, next, err := t.entries(offset)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range entries {
+		switch {
+		case entry.tag == tiffTagExifIFD:
+			exifOffset, err := t.pointer(entry)
+			if err != nil {
+				return 0, err
+			}
+			if _, err := s.tiffIFD(t, exifOffset, exifTags, removed); err != nil {
+				return 0, fmt.Errorf("exif ifd: %w", err)
+			}
+		case entry.tag == tiffTagGpsIFD:
+			if _, ok := s.profile.Fields[domain.FieldGps]; !ok {
+				continue
+			}
+			gpsOffset, err := t.pointer(entry)
+			if err != nil {
+				return 0, err
+			}
+			changed, err := s.tiffStripIFD(t, gpsOffset)
+			if err != nil {
+				return 0, fmt.Errorf("gps ifd: %w", err)
+			}
+			if changed {
+				removed.add(domain.FieldGps, "tiff:34853 GPSInfo", domain.ActionStrip)
+			}
+		default:
+			tag, ok := tags[entry.tag]
+			if !ok {
+				continue
+			}
+			action, ok := s.profile.Fields[tag.field]
+			if !ok {
+				continue
+			}
+			changed, err := s.tiffValue(t, entry, action)
+			if err != nil {
+				return 0, fmt.Errorf("tag %d: %w", entry.tag, err)
+			}
+			if changed {
+				// бинарные значения псевдонимом не заменяются
+				if entry.typ != tiffTypeASCII {
+					action = domain.ActionStrip
+				}
+				removed.add(tag.field, fmt.Sprintf("tiff:%d %s", entry.tag, tag.name), action)
+			}
+		}
+	}
+
+	return next, nil
+}
+
+// tiffValue затирает или заменяет псевдонимом значение тега, false если оно уже пустое
+func (s *service) tiffValue(t *tiffFile, entry tiffEntry, action domain.Action) (bool, error) {
+	if entry.size == 0 {
+		return false, nil
+	}
+	if entry.size > tiffMaxValue {
+		return false, errMalformedTiff
+	}
+
+	value := make([]byte, entry.size)
+	if _, err := t.rw.ReadAt(value, int64(entry.offset)); err != nil {
+		return false, fmt.Errorf("read value: %w", err)
+	}
+	text := bytes.TrimRight(value, "\x00")
+	if len(text) == 0 {
+		return false, nil
+	}
+
+	cleaned := make([]byte, entry.size)
+	// строка заканчивается NUL, псевдоним занимает место до него
+	if action == domain.ActionPseudonymize && entry.typ == tiffTypeASCII && entry.size > 1 {
+		copy(cleaned, s.pseudonym(text, int(entry.size)-1))
+	}
+
+	if _, err := t.rw.WriteAt(cleaned, int64(entry.offset)); err != nil {
+		return false, fmt.Errorf("write value: %w", err)
+	}
+
+	return true, nil
+}
+
+// tiffStripIFD затирает все значения вложенного IFD, сами записи остаются
+func (s *service) tiffStripIFD(t *tiffFile, offset uint64) (bool, error) {
+	entries, _, err := t.entries(offset)
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	for _, entry := range entries {
+		ok, err := s.tiffValue(t, entry, domain.ActionStrip)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || ok
+	}
+
+	return changed, nil
+}
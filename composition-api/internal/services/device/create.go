@@ -2,10 +2,19 @@ package device
 
 import (
 	"context"
+
+	adapter "composition-api/internal/adapters/uzi"
 )
 
-func (s *service) Create(ctx context.Context, name string) (int, error) {
-	id, err := s.adapters.Uzi.CreateDevice(ctx, name)
+func (s *service) Create(ctx context.Context, arg CreateDeviceArg) (int, error) {
+	id, err := s.adapters.Uzi.CreateDevice(ctx, adapter.CreateDeviceIn{
+		Name:         arg.Name,
+		Manufacturer: arg.Manufacturer,
+		Model:        arg.Model,
+		SerialNumber: arg.SerialNumber,
+		ProbeType:    arg.ProbeType,
+		PixelSpacing: arg.PixelSpacing,
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -0,0 +1,13 @@
+package device
+
+import (
+	"context"
+)
+
+func (s *service) Delete(ctx context.Context, id int) error {
+	err := s.adapters.Uzi.DeleteDevice(ctx, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package device
+
+import (
+	domain "composition-api/internal/domain/uzi"
+)
+
+type CreateDeviceArg struct {
+	Name         string
+	Manufacturer *string
+	Model        *string
+	SerialNumber *string
+	ProbeType    *domain.ProbeType
+	PixelSpacing *domain.PixelSpacing
+}
+
+type UpdateDeviceArg struct {
+	Id           int
+	Name         *string
+	Manufacturer *string
+	Model        *string
+	SerialNumber *string
+	ProbeType    *domain.ProbeType
+	PixelSpacing *domain.PixelSpacing
+}
@@ -13,3 +13,11 @@ func (s *service) GetAll(ctx context.Context) ([]domain.Device, error) {
 	}
 	return devices, nil
 }
+
+func (s *service) GetByID(ctx context.Context, id int) (domain.Device, error) {
+	device, err := s.adapters.Uzi.GetDeviceById(ctx, id)
+	if err != nil {
+		return domain.Device{}, err
+	}
+	return device, nil
+}
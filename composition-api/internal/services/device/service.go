@@ -8,8 +8,11 @@ import (
 )
 
 type Service interface {
-	Create(ctx context.Context, name string) (int, error)
+	Create(ctx context.Context, arg CreateDeviceArg) (int, error)
 	GetAll(ctx context.Context) ([]domain.Device, error)
+	GetByID(ctx context.Context, id int) (domain.Device, error)
+	Update(ctx context.Context, arg UpdateDeviceArg) (domain.Device, error)
+	Delete(ctx context.Context, id int) error
 }
 
 type service struct {
@@ -0,0 +1,24 @@
+package device
+
+import (
+	"context"
+
+	adapter "composition-api/internal/adapters/uzi"
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) Update(ctx context.Context, arg UpdateDeviceArg) (domain.Device, error) {
+	device, err := s.adapters.Uzi.UpdateDevice(ctx, adapter.UpdateDeviceIn{
+		Id:           arg.Id,
+		Name:         arg.Name,
+		Manufacturer: arg.Manufacturer,
+		Model:        arg.Model,
+		SerialNumber: arg.SerialNumber,
+		ProbeType:    arg.ProbeType,
+		PixelSpacing: arg.PixelSpacing,
+	})
+	if err != nil {
+		return domain.Device{}, err
+	}
+	return device, nil
+}
@@ -2,14 +2,33 @@ package download
 
 import (
 	"context"
+	"errors"
 	"io"
 	"path/filepath"
 
+	domain "composition-api/internal/domain/uzi"
+	"composition-api/internal/repository"
+
 	"github.com/google/uuid"
 )
 
-func (s *service) GetImage(ctx context.Context, uziID uuid.UUID, imageID uuid.UUID) (io.ReadCloser, error) {
-	return s.repo.NewFileRepo().GetFile(
+var imageSizeFiles = map[domain.ImageSize]string{
+	domain.ImageSizePreview:   "preview.jpg",
+	domain.ImageSizeThumbnail: "thumbnail.jpg",
+}
+
+func (s *service) GetImage(ctx context.Context, uziID uuid.UUID, imageID uuid.UUID, size domain.ImageSize) (io.ReadCloser, domain.ImageSize, error) {
+	fileRepo := s.repo.NewFileRepo()
+
+	if name, ok := imageSizeFiles[size]; ok {
+		file, err := fileRepo.GetFile(ctx, filepath.Join(uziID.String(), imageID.String(), name))
+		// у кадров, разбитых до появления превью, есть только исходный файл
+		if !errors.Is(err, repository.ErrFileNotFound) {
+			return file, size, err
+		}
+	}
+
+	file, err := fileRepo.GetFile(
 		ctx,
 		filepath.Join(
 			uziID.String(),
@@ -17,4 +36,5 @@ func (s *service) GetImage(ctx context.Context, uziID uuid.UUID, imageID uuid.UU
 			imageID.String(),
 		),
 	)
+	return file, domain.ImageSizeOriginal, err
 }
@@ -0,0 +1,24 @@
+package download
+
+import (
+	"context"
+	"io"
+
+	domain "composition-api/internal/domain/uzi"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) GetUziReport(ctx context.Context, uziID uuid.UUID, version *int, format domain.ReportFormat) (io.ReadCloser, error) {
+	report, err := s.reportService.Get(ctx, uziID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	path := report.PdfPath
+	if format == domain.ReportFormatHtml {
+		path = report.HtmlPath
+	}
+
+	return s.repo.NewFileRepo().GetFile(ctx, path)
+}
@@ -4,28 +4,36 @@ import (
 	"context"
 	"io"
 
+	domain "composition-api/internal/domain/uzi"
 	"composition-api/internal/repository"
 	"composition-api/internal/services/cytology"
+	"composition-api/internal/services/report"
 
 	"github.com/google/uuid"
 )
 
 type Service interface {
-	GetImage(ctx context.Context, uziID uuid.UUID, imageID uuid.UUID) (io.ReadCloser, error)
+	// GetImage возвращает кадр указанного размера и размер, который фактически отдан
+	GetImage(ctx context.Context, uziID uuid.UUID, imageID uuid.UUID, size domain.ImageSize) (io.ReadCloser, domain.ImageSize, error)
 	GetCytologyImage(ctx context.Context, cytologyID uuid.UUID, originalImageID uuid.UUID) (io.ReadCloser, error)
+	// GetUziReport возвращает файл заключения указанной версии, без версии - последней
+	GetUziReport(ctx context.Context, uziID uuid.UUID, version *int, format domain.ReportFormat) (io.ReadCloser, error)
 }
 
 type service struct {
 	repo            repository.DAO
 	cytologyService cytology.Service
+	reportService   report.Service
 }
 
 func New(
 	repo repository.DAO,
 	cytologyService cytology.Service,
+	reportService report.Service,
 ) Service {
 	return &service{
 		repo:            repo,
 		cytologyService: cytologyService,
+		reportService:   reportService,
 	}
 }
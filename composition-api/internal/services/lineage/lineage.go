@@ -0,0 +1,25 @@
+package lineage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) LinkNodes(ctx context.Context, nodeID, targetNodeID uuid.UUID) (uuid.UUID, error) {
+	return s.adapters.Uzi.LinkNodes(ctx, nodeID, targetNodeID)
+}
+
+func (s *service) UnlinkNode(ctx context.Context, nodeID uuid.UUID) error {
+	return s.adapters.Uzi.UnlinkNode(ctx, nodeID)
+}
+
+func (s *service) SuggestNodeLinks(ctx context.Context, nodeID uuid.UUID) ([]domain.NodeLinkSuggestion, error) {
+	return s.adapters.Uzi.SuggestNodeLinks(ctx, nodeID)
+}
+
+func (s *service) GetGrowthReport(ctx context.Context, externalID uuid.UUID) ([]domain.NodeGrowth, error) {
+	return s.adapters.Uzi.GetGrowthReport(ctx, externalID)
+}
@@ -0,0 +1,29 @@
+package lineage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"composition-api/internal/adapters"
+	domain "composition-api/internal/domain/uzi"
+)
+
+type Service interface {
+	LinkNodes(ctx context.Context, nodeID, targetNodeID uuid.UUID) (uuid.UUID, error)
+	UnlinkNode(ctx context.Context, nodeID uuid.UUID) error
+	SuggestNodeLinks(ctx context.Context, nodeID uuid.UUID) ([]domain.NodeLinkSuggestion, error)
+	GetGrowthReport(ctx context.Context, externalID uuid.UUID) ([]domain.NodeGrowth, error)
+}
+
+type service struct {
+	adapters *adapters.Adapters
+}
+
+func New(
+	adapters *adapters.Adapters,
+) Service {
+	return &service{
+		adapters: adapters,
+	}
+}
@@ -0,0 +1,17 @@
+package node
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) MergeNodes(ctx context.Context, ids []uuid.UUID) (domain.Node, error) {
+	return s.adapters.Uzi.MergeNodes(ctx, ids)
+}
+
+func (s *service) SplitNode(ctx context.Context, id uuid.UUID, segmentIDs []uuid.UUID) (domain.Node, domain.Node, error) {
+	return s.adapters.Uzi.SplitNode(ctx, id, segmentIDs)
+}
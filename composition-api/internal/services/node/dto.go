@@ -12,4 +12,5 @@ type UpdateNodeArg struct {
 	Tirads_23  *float64
 	Tirads_4   *float64
 	Tirads_5   *float64
+	Lobe       *domain.NodeLobe
 }
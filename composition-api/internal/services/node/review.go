@@ -0,0 +1,21 @@
+package node
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) ReviewNodes(ctx context.Context, ids []uuid.UUID, validation domain.NodeValidation) ([]domain.NodeReviewItem, error) {
+	return s.adapters.Uzi.ReviewNodes(ctx, ids, validation)
+}
+
+func (s *service) CheckUzi(ctx context.Context, uziID uuid.UUID, unvalidated *domain.NodeValidation) (domain.Uzi, []domain.NodeReviewItem, error) {
+	return s.adapters.Uzi.CheckUzi(ctx, uziID, unvalidated)
+}
+
+func (s *service) AcceptAiNodes(ctx context.Context, uziID uuid.UUID, threshold float64) ([]domain.NodeReviewItem, error) {
+	return s.adapters.Uzi.AcceptAiNodes(ctx, uziID, threshold)
+}
@@ -13,6 +13,18 @@ type Service interface {
 	GetNodesByUziID(ctx context.Context, uziID uuid.UUID) ([]domain.Node, error)
 	UpdateNode(ctx context.Context, arg UpdateNodeArg) (domain.Node, error)
 	DeleteNode(ctx context.Context, id uuid.UUID) error
+	// MergeNodes сливает узлы в первый из ids
+	MergeNodes(ctx context.Context, ids []uuid.UUID) (domain.Node, error)
+	// SplitNode выделяет сегменты узла в новый узел, возвращает исходный и новый узлы
+	SplitNode(ctx context.Context, id uuid.UUID, segmentIDs []uuid.UUID) (domain.Node, domain.Node, error)
+	SetNodeDescriptors(ctx context.Context, descriptors domain.NodeDescriptors) (domain.NodeTirads, error)
+	GetNodeTirads(ctx context.Context, nodeID uuid.UUID) (domain.NodeTirads, error)
+	// ReviewNodes проставляет валидацию узлам нейросети одним пакетом
+	ReviewNodes(ctx context.Context, ids []uuid.UUID, validation domain.NodeValidation) ([]domain.NodeReviewItem, error)
+	// CheckUzi отмечает узи проверенным, unvalidated проставляется узлам нейросети без решения врача
+	CheckUzi(ctx context.Context, uziID uuid.UUID, unvalidated *domain.NodeValidation) (domain.Uzi, []domain.NodeReviewItem, error)
+	// AcceptAiNodes принимает узлы нейросети без решения врача с уверенностью не ниже threshold
+	AcceptAiNodes(ctx context.Context, uziID uuid.UUID, threshold float64) ([]domain.NodeReviewItem, error)
 }
 
 type service struct {
@@ -0,0 +1,17 @@
+package node
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) SetNodeDescriptors(ctx context.Context, descriptors domain.NodeDescriptors) (domain.NodeTirads, error) {
+	return s.adapters.Uzi.SetNodeDescriptors(ctx, descriptors)
+}
+
+func (s *service) GetNodeTirads(ctx context.Context, nodeID uuid.UUID) (domain.NodeTirads, error) {
+	return s.adapters.Uzi.GetNodeTirads(ctx, nodeID)
+}
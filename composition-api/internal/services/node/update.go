@@ -14,6 +14,7 @@ func (s *service) UpdateNode(ctx context.Context, arg UpdateNodeArg) (domain.Nod
 		Tirads_23:  arg.Tirads_23,
 		Tirads_4:   arg.Tirads_4,
 		Tirads_5:   arg.Tirads_5,
+		Lobe:       arg.Lobe,
 	})
 	if err != nil {
 		return domain.Node{}, err
@@ -0,0 +1,17 @@
+package report
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) Generate(ctx context.Context, uziID uuid.UUID) (domain.Report, error) {
+	report, err := s.adapters.Uzi.GenerateReport(ctx, uziID)
+	if err != nil {
+		return domain.Report{}, err
+	}
+	return report, nil
+}
@@ -0,0 +1,25 @@
+package report
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) GetList(ctx context.Context, uziID uuid.UUID) ([]domain.Report, error) {
+	reports, err := s.adapters.Uzi.GetReports(ctx, uziID)
+	if err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+func (s *service) Get(ctx context.Context, uziID uuid.UUID, version *int) (domain.Report, error) {
+	report, err := s.adapters.Uzi.GetReport(ctx, uziID, version)
+	if err != nil {
+		return domain.Report{}, err
+	}
+	return report, nil
+}
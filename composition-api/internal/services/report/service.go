@@ -0,0 +1,29 @@
+package report
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"composition-api/internal/adapters"
+	domain "composition-api/internal/domain/uzi"
+)
+
+type Service interface {
+	Generate(ctx context.Context, uziID uuid.UUID) (domain.Report, error)
+	GetList(ctx context.Context, uziID uuid.UUID) ([]domain.Report, error)
+	// Get возвращает указанную версию заключения, без версии - последнюю
+	Get(ctx context.Context, uziID uuid.UUID, version *int) (domain.Report, error)
+}
+
+type service struct {
+	adapters *adapters.Adapters
+}
+
+func New(
+	adapters *adapters.Adapters,
+) Service {
+	return &service{
+		adapters: adapters,
+	}
+}
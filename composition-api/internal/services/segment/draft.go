@@ -0,0 +1,25 @@
+package segment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) Propose(ctx context.Context, segmentID uuid.UUID, depth *int) ([]domain.SegmentDraft, error) {
+	return s.adapters.Uzi.ProposeSegments(ctx, segmentID, depth)
+}
+
+func (s *service) GetDraftsByNodeID(ctx context.Context, nodeID uuid.UUID) ([]domain.SegmentDraft, error) {
+	return s.adapters.Uzi.GetSegmentDraftsByNodeId(ctx, nodeID)
+}
+
+func (s *service) AcceptDraft(ctx context.Context, id uuid.UUID) (domain.Segment, error) {
+	return s.adapters.Uzi.AcceptSegmentDraft(ctx, id)
+}
+
+func (s *service) DiscardDraft(ctx context.Context, id uuid.UUID) error {
+	return s.adapters.Uzi.DiscardSegmentDraft(ctx, id)
+}
@@ -14,6 +14,13 @@ type Service interface {
 	GetByNodeID(ctx context.Context, nodeID uuid.UUID) ([]domain.Segment, error)
 	Update(ctx context.Context, arg UpdateSegmentArg) (domain.Segment, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Propose предлагает контуры узла на соседних кадрах по сегменту, depth nil - по умолчанию
+	Propose(ctx context.Context, segmentID uuid.UUID, depth *int) ([]domain.SegmentDraft, error)
+	GetDraftsByNodeID(ctx context.Context, nodeID uuid.UUID) ([]domain.SegmentDraft, error)
+	// AcceptDraft создает из черновика сегмент узла
+	AcceptDraft(ctx context.Context, id uuid.UUID) (domain.Segment, error)
+	DiscardDraft(ctx context.Context, id uuid.UUID) error
 }
 
 type service struct {
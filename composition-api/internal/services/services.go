@@ -6,20 +6,24 @@ import (
 	"composition-api/internal/repository"
 	"composition-api/internal/services/card"
 	"composition-api/internal/services/cytology"
+	"composition-api/internal/services/deidentify"
 	"composition-api/internal/services/device"
 	"composition-api/internal/services/doctor"
 	"composition-api/internal/services/download"
 	"composition-api/internal/services/image"
+	"composition-api/internal/services/lineage"
 	"composition-api/internal/services/node"
 	"composition-api/internal/services/node_segment"
 	"composition-api/internal/services/patient"
 	"composition-api/internal/services/payment_provider"
 	"composition-api/internal/services/register"
+	"composition-api/internal/services/report"
 	"composition-api/internal/services/segment"
 	"composition-api/internal/services/subscription"
 	"composition-api/internal/services/tariff_plan"
 	"composition-api/internal/services/tiler"
 	"composition-api/internal/services/tokens"
+	"composition-api/internal/services/upload"
 	"composition-api/internal/services/uzi"
 	"composition-api/internal/services/yookassa_webhook"
 )
@@ -31,6 +35,8 @@ type Services struct {
 	NodeService            node.Service
 	SegmentService         segment.Service
 	NodeSegmentService     node_segment.Service
+	LineageService         lineage.Service
+	ReportService          report.Service
 	TokensService          tokens.Service
 	CardService            card.Service
 	DoctorService          doctor.Service
@@ -43,26 +49,32 @@ type Services struct {
 	YookassaWebhookService yookassa_webhook.Service
 	CytologyService        cytology.Service
 	TilerService           tiler.Service
+	UploadService          upload.Service
 }
 
 func New(
 	adapters *adapters.Adapters,
 	producers producers.Producer,
 	dao repository.DAO,
+	deidentifyCfg deidentify.Config,
 ) *Services {
+	deidentifyService := deidentify.New(dao, deidentifyCfg)
+	uploadService := upload.New(dao, deidentifyService)
 	deviceService := device.New(adapters)
-	uziService := uzi.New(adapters, dao, producers)
+	uziService := uzi.New(adapters, dao, producers, uploadService, deidentifyService)
 	imageService := image.New(adapters)
 	nodeService := node.New(adapters)
 	segmentService := segment.New(adapters)
 	nodeSegmentService := node_segment.New(adapters)
+	lineageService := lineage.New(adapters)
+	reportService := report.New(adapters)
 	tokenService := tokens.New(adapters)
 	cardService := card.New(adapters)
 	doctorService := doctor.New(adapters)
 	patientService := patient.New(adapters)
 	registerService := register.New(adapters)
-	cytologyService := cytology.New(adapters, dao, producers)
-	downloadService := download.New(dao, cytologyService)
+	cytologyService := cytology.New(adapters, dao, producers, uploadService, deidentifyService)
+	downloadService := download.New(dao, cytologyService, reportService)
 	tariffPlanService := tariff_plan.New(adapters)
 	subscriptionService := subscription.New(adapters)
 	paymentProviderService := payment_provider.New(adapters)
@@ -76,6 +88,8 @@ func New(
 		NodeService:            nodeService,
 		SegmentService:         segmentService,
 		NodeSegmentService:     nodeSegmentService,
+		LineageService:         lineageService,
+		ReportService:          reportService,
 		TokensService:          tokenService,
 		CardService:            cardService,
 		DoctorService:          doctorService,
@@ -88,5 +102,6 @@ func New(
 		YookassaWebhookService: yookassaWebhookService,
 		CytologyService:        cytologyService,
 		TilerService:           tilerService,
+		UploadService:          uploadService,
 	}
 }
@@ -0,0 +1,25 @@
+package upload
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/upload"
+)
+
+func (s *service) Abort(ctx context.Context, owner uuid.UUID, id uuid.UUID) error {
+	upload, err := s.getPending(ctx, owner, id)
+	if err != nil {
+		return err
+	}
+
+	uploadRepo := s.dao.NewUploadRepo()
+	if err := uploadRepo.AbortMultipartUpload(ctx, upload); err != nil {
+		return err
+	}
+
+	upload.Status = domain.UploadStatusAborted
+	_, err = s.updateUpload(ctx, upload)
+	return err
+}
@@ -0,0 +1,60 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	baseDomain "composition-api/internal/domain"
+	domain "composition-api/internal/domain/upload"
+	"composition-api/internal/repository"
+)
+
+func (s *service) Claim(ctx context.Context, arg ClaimUploadArg) (domain.Upload, error) {
+	upload, err := s.getOwned(ctx, arg.Owner, arg.Id)
+	if err != nil {
+		return domain.Upload{}, err
+	}
+	if upload.Kind != arg.Kind {
+		return domain.Upload{}, fmt.Errorf("upload is for %s, not %s: %w", upload.Kind, arg.Kind, baseDomain.ErrBadRequest)
+	}
+	if upload.Status != domain.UploadStatusCompleted {
+		return domain.Upload{}, fmt.Errorf("upload is %s, not completed: %w", upload.Status, baseDomain.ErrConflict)
+	}
+
+	upload.Status = domain.UploadStatusClaimed
+	return s.updateUpload(ctx, upload)
+}
+
+func (s *service) Move(ctx context.Context, upload domain.Upload, arg MoveUploadArg) error {
+	if err := s.dao.NewUploadRepo().MoveUploadedFile(ctx, upload.Id, arg.Bucket, arg.Path); err != nil {
+		return fmt.Errorf("move uploaded file: %w", err)
+	}
+
+	if upload.Deidentification != nil {
+		if err := s.deidentify.SaveReport(ctx, arg.Bucket, arg.Path, *upload.Deidentification); err != nil {
+			return fmt.Errorf("save deidentification report: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) Release(ctx context.Context, upload domain.Upload) error {
+	upload.Status = domain.UploadStatusCompleted
+	_, err := s.updateUpload(ctx, upload)
+	return err
+}
+
+// updateUpload сохраняет сессию, если ее не изменил параллельный запрос
+func (s *service) updateUpload(ctx context.Context, upload domain.Upload) (domain.Upload, error) {
+	upload, err := s.dao.NewUploadRepo().UpdateUpload(ctx, upload)
+	if err != nil {
+		if errors.Is(err, repository.ErrUploadChanged) {
+			return domain.Upload{}, fmt.Errorf("upload was changed concurrently: %w", baseDomain.ErrConflict)
+		}
+		return domain.Upload{}, fmt.Errorf("update upload: %w", err)
+	}
+
+	return upload, nil
+}
@@ -0,0 +1,186 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/textproto"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	ht "github.com/ogen-go/ogen/http"
+
+	baseDomain "composition-api/internal/domain"
+	deidentifyDomain "composition-api/internal/domain/deidentify"
+	domain "composition-api/internal/domain/upload"
+)
+
+var errSha256Mismatch = errors.New("sha256 mismatch")
+
+func (s *service) Complete(ctx context.Context, owner uuid.UUID, id uuid.UUID) (domain.Upload, error) {
+	upload, err := s.getPending(ctx, owner, id)
+	if err != nil {
+		return domain.Upload{}, err
+	}
+
+	uploadRepo := s.dao.NewUploadRepo()
+
+	parts, err := uploadRepo.ListParts(ctx, upload)
+	if err != nil {
+		return domain.Upload{}, fmt.Errorf("list parts: %w", err)
+	}
+	if missing := missingParts(upload, parts); len(missing) > 0 {
+		return domain.Upload{}, fmt.Errorf("parts %v are missing or incomplete: %w", missing, baseDomain.ErrUnprocessableEntity)
+	}
+
+	if err := uploadRepo.CompleteMultipartUpload(ctx, upload, parts); err != nil {
+		return domain.Upload{}, err
+	}
+
+	// задача ставится до смены статуса, чтобы обработка не потерялась при падении между ними
+	if err := uploadRepo.EnqueueProcessing(ctx, upload.Id); err != nil {
+		return domain.Upload{}, err
+	}
+
+	upload.Status = domain.UploadStatusProcessing
+	upload, err = s.updateUpload(ctx, upload)
+	if err != nil {
+		return domain.Upload{}, err
+	}
+
+	// первая попытка сразу, при ошибке или падении файл обработает RunProcessing
+	go func() {
+		if err := s.processUpload(context.WithoutCancel(ctx), upload.Id); err != nil {
+			slog.Warn("process upload", "upload_id", upload.Id, "err", err)
+		}
+	}()
+
+	return upload, nil
+}
+
+func (s *service) RunProcessing(ctx context.Context) (int, error) {
+	ids, err := s.dao.NewUploadRepo().ListProcessing(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	done := 0
+	for _, id := range ids {
+		if err := s.processUpload(ctx, id); err != nil {
+			slog.Warn("process upload", "upload_id", id, "err", err)
+			continue
+		}
+		done++
+	}
+
+	return done, nil
+}
+
+// processUpload хеширует и очищает собранный файл и завершает сессию.
+// Реплика занимает сессию на processingLease, занятые другими пропускаются
+func (s *service) processUpload(ctx context.Context, id uuid.UUID) error {
+	uploadRepo := s.dao.NewUploadRepo()
+
+	upload, err := uploadRepo.GetUpload(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get upload: %w", err)
+	}
+	if upload.Status != domain.UploadStatusProcessing {
+		return uploadRepo.DequeueProcessing(ctx, id)
+	}
+	if upload.ProcessingUntil != nil && time.Now().Before(*upload.ProcessingUntil) {
+		return nil
+	}
+
+	until := time.Now().Add(processingLease)
+	upload.ProcessingUntil = &until
+	upload, err = uploadRepo.UpdateUpload(ctx, upload)
+	if err != nil {
+		return fmt.Errorf("lease upload: %w", err)
+	}
+
+	sum, report, err := s.cleanUploadedFile(ctx, upload)
+	switch {
+	case errors.Is(err, errSha256Mismatch), errors.Is(err, baseDomain.ErrBadRequest):
+		// части уже собраны и загрузить их заново нельзя, сессия отменяется;
+		// файл, который не удалось очистить, повтор не исправит
+		reason := err.Error()
+		upload.Status = domain.UploadStatusAborted
+		upload.FailReason = &reason
+		_ = uploadRepo.DeleteUploadedFile(ctx, upload.Id)
+	case err != nil:
+		// следующая попытка не ждет конца аренды
+		upload.ProcessingUntil = nil
+		_, _ = uploadRepo.UpdateUpload(ctx, upload)
+		return err
+	default:
+		upload.Sha256 = &sum
+		upload.Deidentification = &report
+		upload.Status = domain.UploadStatusCompleted
+	}
+
+	upload.ProcessingUntil = nil
+	if _, err := uploadRepo.UpdateUpload(ctx, upload); err != nil {
+		return fmt.Errorf("save processed upload: %w", err)
+	}
+
+	return uploadRepo.DequeueProcessing(ctx, id)
+}
+
+// cleanUploadedFile скачивает собранный файл во временный, сверяет sha256 клиента и очищает метаданные.
+// Возвращает sha256 хранимого файла, он нужен сервисам для поиска дублей
+func (s *service) cleanUploadedFile(ctx context.Context, upload domain.Upload) (string, deidentifyDomain.Report, error) {
+	path := s.dao.NewUploadRepo().UploadedFilePath(upload.Id)
+
+	file, err := s.dao.NewFileRepo().GetFile(ctx, path)
+	if err != nil {
+		return "", deidentifyDomain.Report{}, fmt.Errorf("get uploaded file: %w", err)
+	}
+	defer file.Close()
+
+	temp, err := os.CreateTemp("", "upload-*")
+	if err != nil {
+		return "", deidentifyDomain.Report{}, fmt.Errorf("create temp file: %w", err)
+	}
+	defer func() {
+		_ = temp.Close()
+		_ = os.Remove(temp.Name())
+	}()
+
+	sum, err := hashReader(io.TeeReader(file, temp))
+	if err != nil {
+		return "", deidentifyDomain.Report{}, err
+	}
+
+	if upload.Sha256 != nil && sum != *upload.Sha256 {
+		return "", deidentifyDomain.Report{}, fmt.Errorf("%w, got %s", errSha256Mismatch, sum)
+	}
+
+	report, err := s.deidentify.File(temp)
+	if err != nil {
+		return "", deidentifyDomain.Report{}, err
+	}
+	if !report.Changed() {
+		return sum, report, nil
+	}
+
+	// собранный файл заменяется очищенным
+	if _, err := temp.Seek(0, io.SeekStart); err != nil {
+		return "", deidentifyDomain.Report{}, fmt.Errorf("seek temp file: %w", err)
+	}
+	cleaned := ht.MultipartFile{
+		File:   temp,
+		Header: textproto.MIMEHeader{"Content-Type": []string{upload.ContentType}},
+	}
+	if sum, err = HashMultipartFile(cleaned); err != nil {
+		return "", deidentifyDomain.Report{}, err
+	}
+	if err := s.dao.NewFileRepo().LoadFile(ctx, path, cleaned); err != nil {
+		return "", deidentifyDomain.Report{}, fmt.Errorf("load cleaned file: %w", err)
+	}
+
+	return sum, report, nil
+}
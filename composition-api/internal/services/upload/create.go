@@ -0,0 +1,81 @@
+package upload
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	baseDomain "composition-api/internal/domain"
+	domain "composition-api/internal/domain/upload"
+)
+
+func (s *service) Create(ctx context.Context, arg CreateUploadArg) (domain.Upload, error) {
+	if arg.Kind != domain.UploadKindUzi && arg.Kind != domain.UploadKindCytology {
+		return domain.Upload{}, fmt.Errorf("unknown upload kind %q: %w", arg.Kind, baseDomain.ErrBadRequest)
+	}
+	if arg.Size <= 0 {
+		return domain.Upload{}, fmt.Errorf("size must be positive: %w", baseDomain.ErrBadRequest)
+	}
+
+	partSize, err := choosePartSize(arg.Size, arg.PartSize)
+	if err != nil {
+		return domain.Upload{}, err
+	}
+
+	var checksum *string
+	if arg.Sha256 != nil {
+		sum := strings.ToLower(*arg.Sha256)
+		if b, err := hex.DecodeString(sum); err != nil || len(b) != 32 {
+			return domain.Upload{}, fmt.Errorf("sha256 must be 64 hex chars: %w", baseDomain.ErrBadRequest)
+		}
+		checksum = &sum
+	}
+
+	now := time.Now()
+	upload := domain.Upload{
+		Id:          uuid.New(),
+		Owner:       arg.Owner,
+		Kind:        arg.Kind,
+		FileName:    arg.FileName,
+		ContentType: arg.ContentType,
+		Size:        arg.Size,
+		PartSize:    partSize,
+		Sha256:      checksum,
+		Status:      domain.UploadStatusPending,
+		CreateAt:    now,
+		ExpiresAt:   now.Add(uploadTTL),
+	}
+
+	uploadRepo := s.dao.NewUploadRepo()
+	upload.S3UploadID, err = uploadRepo.NewMultipartUpload(ctx, upload.Id, upload.ContentType)
+	if err != nil {
+		return domain.Upload{}, fmt.Errorf("start multipart upload: %w", err)
+	}
+
+	if err := uploadRepo.SaveUpload(ctx, upload); err != nil {
+		return domain.Upload{}, fmt.Errorf("save upload: %w", err)
+	}
+
+	return upload, nil
+}
+
+// choosePartSize увеличивает размер части, если файл не помещается в maxParts частей
+func choosePartSize(size, requested int64) (int64, error) {
+	partSize := requested
+	if partSize == 0 {
+		partSize = defaultPartSize
+	}
+	if partSize < minPartSize {
+		return 0, fmt.Errorf("part size must be at least %d bytes: %w", minPartSize, baseDomain.ErrBadRequest)
+	}
+
+	for (size+partSize-1)/partSize > maxParts {
+		partSize *= 2
+	}
+
+	return partSize, nil
+}
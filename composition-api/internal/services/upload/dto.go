@@ -0,0 +1,30 @@
+package upload
+
+import (
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/upload"
+)
+
+type CreateUploadArg struct {
+	Owner       uuid.UUID
+	Kind        domain.UploadKind
+	FileName    string
+	ContentType string
+	Size        int64
+	// 0 - размер части по умолчанию
+	PartSize int64
+	Sha256   *string
+}
+
+type ClaimUploadArg struct {
+	Id    uuid.UUID
+	Owner uuid.UUID
+	Kind  domain.UploadKind
+}
+
+type MoveUploadArg struct {
+	// пустой Bucket - основной бакет
+	Bucket string
+	Path   string
+}
@@ -0,0 +1,82 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/upload"
+	"composition-api/internal/repository"
+)
+
+// failReasonExpired причина отмены брошенной загрузки
+const failReasonExpired = "upload expired"
+
+func (s *service) RunExpiration(ctx context.Context) (int, error) {
+	ids, err := s.dao.NewUploadRepo().ListUploads(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, id := range ids {
+		ok, err := s.expireUpload(ctx, id)
+		if err != nil {
+			slog.Warn("expire upload", "upload_id", id, "err", err)
+			continue
+		}
+		if ok {
+			expired++
+		}
+	}
+
+	return expired, nil
+}
+
+// expireUpload удаляет объекты незавершенной или отмененной загрузки с истекшим сроком.
+// Незавершенная сессия сначала переводится в aborted условной записью, чтобы параллельный Complete не собрал файл
+func (s *service) expireUpload(ctx context.Context, id uuid.UUID) (bool, error) {
+	uploadRepo := s.dao.NewUploadRepo()
+
+	upload, err := uploadRepo.GetUpload(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrUploadNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get upload: %w", err)
+	}
+	if time.Now().Before(upload.ExpiresAt) {
+		return false, nil
+	}
+
+	switch upload.Status {
+	case domain.UploadStatusPending:
+		reason := failReasonExpired
+		upload.Status = domain.UploadStatusAborted
+		upload.FailReason = &reason
+		upload, err = uploadRepo.UpdateUpload(ctx, upload)
+		if err != nil {
+			if errors.Is(err, repository.ErrUploadChanged) {
+				return false, nil
+			}
+			return false, fmt.Errorf("abort upload: %w", err)
+		}
+	// отмененная сессия остается, если удаление прервалось, и удаляется повторно
+	case domain.UploadStatusAborted:
+	default:
+		return false, nil
+	}
+
+	if err := uploadRepo.AbortMultipartUpload(ctx, upload); err != nil {
+		return false, err
+	}
+	if err := uploadRepo.DeleteUpload(ctx, id); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,71 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	baseDomain "composition-api/internal/domain"
+	domain "composition-api/internal/domain/upload"
+	"composition-api/internal/repository"
+)
+
+func (s *service) Get(ctx context.Context, owner uuid.UUID, id uuid.UUID) (domain.UploadProgress, error) {
+	upload, err := s.getOwned(ctx, owner, id)
+	if err != nil {
+		return domain.UploadProgress{}, err
+	}
+
+	progress := domain.UploadProgress{Upload: upload}
+	if upload.Status != domain.UploadStatusPending {
+		if upload.Status != domain.UploadStatusAborted {
+			progress.UploadedBytes = upload.Size
+		}
+		return progress, nil
+	}
+
+	progress.Parts, err = s.dao.NewUploadRepo().ListParts(ctx, upload)
+	if err != nil {
+		return domain.UploadProgress{}, fmt.Errorf("list parts: %w", err)
+	}
+	for _, part := range progress.Parts {
+		progress.UploadedBytes += part.Size
+	}
+
+	return progress, nil
+}
+
+// getOwned чужие сессии не отличаются от несуществующих
+func (s *service) getOwned(ctx context.Context, owner uuid.UUID, id uuid.UUID) (domain.Upload, error) {
+	upload, err := s.dao.NewUploadRepo().GetUpload(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrUploadNotFound) {
+			return domain.Upload{}, baseDomain.ErrNotFound
+		}
+		return domain.Upload{}, fmt.Errorf("get upload: %w", err)
+	}
+	if upload.Owner != owner {
+		return domain.Upload{}, baseDomain.ErrNotFound
+	}
+
+	return upload, nil
+}
+
+// getPending сессия, в которую еще можно загружать части
+func (s *service) getPending(ctx context.Context, owner uuid.UUID, id uuid.UUID) (domain.Upload, error) {
+	upload, err := s.getOwned(ctx, owner, id)
+	if err != nil {
+		return domain.Upload{}, err
+	}
+	if upload.Status != domain.UploadStatusPending {
+		return domain.Upload{}, fmt.Errorf("upload is %s: %w", upload.Status, baseDomain.ErrConflict)
+	}
+	if time.Now().After(upload.ExpiresAt) {
+		return domain.Upload{}, fmt.Errorf("upload expired at %s: %w", upload.ExpiresAt, baseDomain.ErrConflict)
+	}
+
+	return upload, nil
+}
@@ -0,0 +1,40 @@
+package upload
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// HashMultipartFile считает sha256 файла из формы и возвращает чтение в начало,
+// чтобы файл можно было загрузить в S3 следом
+func HashMultipartFile(file ht.MultipartFile) (string, error) {
+	seeker, ok := file.File.(io.Seeker)
+	if !ok {
+		return "", errors.New("multipart file is not seekable")
+	}
+
+	sum, err := hashReader(file.File)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("rewind multipart file: %w", err)
+	}
+
+	return sum, nil
+}
+
+func hashReader(r io.Reader) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return "", fmt.Errorf("hash file: %w", err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
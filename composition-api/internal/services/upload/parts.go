@@ -0,0 +1,66 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	baseDomain "composition-api/internal/domain"
+	domain "composition-api/internal/domain/upload"
+)
+
+func (s *service) PresignParts(ctx context.Context, owner uuid.UUID, id uuid.UUID, numbers []int) ([]domain.UploadPartURL, error) {
+	upload, err := s.getPending(ctx, owner, id)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadRepo := s.dao.NewUploadRepo()
+
+	if len(numbers) == 0 {
+		parts, err := uploadRepo.ListParts(ctx, upload)
+		if err != nil {
+			return nil, fmt.Errorf("list parts: %w", err)
+		}
+		numbers = missingParts(upload, parts)
+	}
+
+	expiresAt := time.Now().Add(partURLTTL)
+	urls := make([]domain.UploadPartURL, 0, len(numbers))
+	for _, number := range numbers {
+		if number < 1 || number > upload.PartsCount() {
+			return nil, fmt.Errorf("part %d out of range 1..%d: %w", number, upload.PartsCount(), baseDomain.ErrBadRequest)
+		}
+
+		url, err := uploadRepo.PresignPart(ctx, upload, number, partURLTTL)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, domain.UploadPartURL{
+			Number:    number,
+			URL:       url,
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	return urls, nil
+}
+
+// missingParts номера частей, которых еще нет в S3 или которые загружены не целиком
+func missingParts(upload domain.Upload, parts []domain.UploadPart) []int {
+	uploaded := make(map[int]int64, len(parts))
+	for _, part := range parts {
+		uploaded[part.Number] = part.Size
+	}
+
+	var missing []int
+	for number := 1; number <= upload.PartsCount(); number++ {
+		if size, ok := uploaded[number]; !ok || size != upload.PartLength(number) {
+			missing = append(missing, number)
+		}
+	}
+
+	return missing
+}
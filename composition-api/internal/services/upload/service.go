@@ -0,0 +1,65 @@
+package upload
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	domain "composition-api/internal/domain/upload"
+	"composition-api/internal/repository"
+	"composition-api/internal/services/deidentify"
+)
+
+const (
+	// S3 требует части не меньше 5MB, кроме последней, и не больше 10000 частей
+	minPartSize     = 5 << 20
+	defaultPartSize = 64 << 20
+	maxParts        = 10000
+
+	// сессия живет сутки, незавершенную загрузку можно продолжать до истечения
+	uploadTTL = 24 * time.Hour
+	// время жизни presigned URL части
+	partURLTTL = time.Hour
+	// реплика обрабатывает собранный файл не дольше, потом его может взять другая
+	processingLease = 30 * time.Minute
+)
+
+type Service interface {
+	Create(ctx context.Context, arg CreateUploadArg) (domain.Upload, error)
+	// Get сессия с уже загруженными частями для возобновления
+	Get(ctx context.Context, owner uuid.UUID, id uuid.UUID) (domain.UploadProgress, error)
+	// PresignParts выдает URL для указанных частей, без номеров - для всех незагруженных
+	PresignParts(ctx context.Context, owner uuid.UUID, id uuid.UUID, numbers []int) ([]domain.UploadPartURL, error)
+	// Complete собирает файл из частей и ставит его в обработку: sha256 сверяется
+	// и метаданные очищаются в фоне, до конца обработки сессия в статусе processing
+	Complete(ctx context.Context, owner uuid.UUID, id uuid.UUID) (domain.Upload, error)
+	// RunProcessing обрабатывает собранные файлы, первая попытка которых не завершилась
+	RunProcessing(ctx context.Context) (int, error)
+	// RunExpiration отменяет незавершенные загрузки с истекшим сроком и удаляет их части и сессии вместе с отмененными
+	RunExpiration(ctx context.Context) (int, error)
+	Abort(ctx context.Context, owner uuid.UUID, id uuid.UUID) error
+
+	// Claim занимает завершенную загрузку, из двух параллельных запросов ее получает один.
+	// Запись под файл создается после Claim, при ошибке сессию нужно вернуть через Release
+	Claim(ctx context.Context, arg ClaimUploadArg) (domain.Upload, error)
+	// Move переносит файл занятой загрузки в bucket/path, после переноса сессию нельзя использовать повторно
+	Move(ctx context.Context, upload domain.Upload, arg MoveUploadArg) error
+	// Release возвращает занятую загрузку в завершенные, если файл не был перенесен
+	Release(ctx context.Context, upload domain.Upload) error
+}
+
+type service struct {
+	dao        repository.DAO
+	deidentify deidentify.Service
+}
+
+func New(
+	dao repository.DAO,
+	deidentify deidentify.Service,
+) Service {
+	return &service{
+		dao:        dao,
+		deidentify: deidentify,
+	}
+}
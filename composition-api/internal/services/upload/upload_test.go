@@ -0,0 +1,142 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	ht "github.com/ogen-go/ogen/http"
+	"github.com/stretchr/testify/require"
+
+	baseDomain "composition-api/internal/domain"
+	domain "composition-api/internal/domain/upload"
+	"composition-api/internal/repository"
+)
+
+func TestChoosePartSize(t *testing.T) {
+	size, err := choosePartSize(1<<30, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(defaultPartSize), size)
+
+	_, err = choosePartSize(1<<30, 1<<20)
+	require.ErrorIs(t, err, baseDomain.ErrBadRequest)
+
+	// 1TB частями по 64MB - 16384 частей, размер части удваивается
+	size, err = choosePartSize(1<<40, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(128<<20), size)
+}
+
+func TestMissingParts(t *testing.T) {
+	upload := domain.Upload{Size: 25, PartSize: 10}
+
+	require.Equal(t, []int{1, 2, 3}, missingParts(upload, nil))
+	require.Equal(t, []int{2}, missingParts(upload, []domain.UploadPart{
+		{Number: 1, Size: 10},
+		{Number: 2, Size: 4},
+		{Number: 3, Size: 5},
+	}))
+	require.Empty(t, missingParts(upload, []domain.UploadPart{
+		{Number: 3, Size: 5},
+		{Number: 1, Size: 10},
+		{Number: 2, Size: 10},
+	}))
+}
+
+func TestHashMultipartFile(t *testing.T) {
+	file := ht.MultipartFile{File: bytes.NewReader([]byte("abc"))}
+
+	sum, err := HashMultipartFile(file)
+	require.NoError(t, err)
+	require.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", sum)
+
+	// после хеширования файл читается с начала
+	data, err := io.ReadAll(file.File)
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(data))
+
+	_, err = HashMultipartFile(ht.MultipartFile{File: io.MultiReader(bytes.NewReader([]byte("abc")))})
+	require.Error(t, err)
+}
+
+// memoryUploads сессии в памяти, остальные методы репозитория не используются
+type memoryUploads struct {
+	repository.UploadRepo
+
+	sessions map[uuid.UUID]domain.Upload
+	aborted  []uuid.UUID
+}
+
+func (m *memoryUploads) ListUploads(context.Context) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(m.sessions))
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (m *memoryUploads) GetUpload(_ context.Context, id uuid.UUID) (domain.Upload, error) {
+	upload, ok := m.sessions[id]
+	if !ok {
+		return domain.Upload{}, repository.ErrUploadNotFound
+	}
+	return upload, nil
+}
+
+func (m *memoryUploads) UpdateUpload(_ context.Context, upload domain.Upload) (domain.Upload, error) {
+	if m.sessions[upload.Id].Version != upload.Version {
+		return domain.Upload{}, repository.ErrUploadChanged
+	}
+	version, _ := strconv.Atoi(upload.Version)
+	upload.Version = strconv.Itoa(version + 1)
+	m.sessions[upload.Id] = upload
+	return upload, nil
+}
+
+func (m *memoryUploads) AbortMultipartUpload(_ context.Context, upload domain.Upload) error {
+	m.aborted = append(m.aborted, upload.Id)
+	return nil
+}
+
+func (m *memoryUploads) DeleteUpload(_ context.Context, id uuid.UUID) error {
+	delete(m.sessions, id)
+	return nil
+}
+
+type memoryDAO struct {
+	repository.DAO
+
+	uploads *memoryUploads
+}
+
+func (d memoryDAO) NewUploadRepo() repository.UploadRepo {
+	return d.uploads
+}
+
+func TestRunExpiration(t *testing.T) {
+	now := time.Now()
+	expired := domain.Upload{Id: uuid.New(), Status: domain.UploadStatusPending, ExpiresAt: now.Add(-time.Minute)}
+	active := domain.Upload{Id: uuid.New(), Status: domain.UploadStatusPending, ExpiresAt: now.Add(time.Hour)}
+	aborted := domain.Upload{Id: uuid.New(), Status: domain.UploadStatusAborted, ExpiresAt: now.Add(-time.Minute)}
+	completed := domain.Upload{Id: uuid.New(), Status: domain.UploadStatusCompleted, ExpiresAt: now.Add(-time.Minute)}
+
+	uploads := &memoryUploads{sessions: map[uuid.UUID]domain.Upload{}}
+	for _, upload := range []domain.Upload{expired, active, aborted, completed} {
+		uploads.sessions[upload.Id] = upload
+	}
+	s := &service{dao: memoryDAO{uploads: uploads}}
+
+	n, err := s.RunExpiration(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	require.ElementsMatch(t, []uuid.UUID{expired.Id, aborted.Id}, uploads.aborted)
+	require.NotContains(t, uploads.sessions, expired.Id)
+	require.NotContains(t, uploads.sessions, aborted.Id)
+	require.Contains(t, uploads.sessions, active.Id)
+	require.Contains(t, uploads.sessions, completed.Id)
+}
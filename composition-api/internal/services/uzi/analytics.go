@@ -0,0 +1,15 @@
+package uzi
+
+import (
+	"context"
+
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) Analytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.Analytics, error) {
+	analytics, err := s.adapters.Uzi.GetUziAnalytics(ctx, filter)
+	if err != nil {
+		return domain.Analytics{}, err
+	}
+	return analytics, nil
+}
@@ -8,31 +8,87 @@ import (
 	"github.com/google/uuid"
 
 	adapter "composition-api/internal/adapters/uzi"
+	deidentifyDomain "composition-api/internal/domain/deidentify"
+	uploadDomain "composition-api/internal/domain/upload"
 	uziuploadpb "composition-api/internal/generated/dbus/produce/uziupload"
+	"composition-api/internal/services/upload"
 )
 
-func (s *service) Create(ctx context.Context, in CreateUziArg) (uuid.UUID, error) {
-	uziID, err := s.adapters.Uzi.CreateUzi(ctx, adapter.CreateUziIn{
+func (s *service) Create(ctx context.Context, in CreateUziArg) (uuid.UUID, []uuid.UUID, error) {
+	// файл из формы очищается и хешируется здесь, загрузка частями - в фоне после завершения
+	var (
+		report  deidentifyDomain.Report
+		sum     *string
+		claimed *uploadDomain.Upload
+	)
+	if in.UploadID == nil {
+		file, fileReport, cleanup, err := s.deidentify.MultipartFile(in.File)
+		if err != nil {
+			return uuid.UUID{}, nil, fmt.Errorf("deidentify uzi file: %w", err)
+		}
+		defer cleanup()
+		in.File, report = file, fileReport
+
+		fileSum, err := upload.HashMultipartFile(in.File)
+		if err != nil {
+			return uuid.UUID{}, nil, fmt.Errorf("hash uzi file: %w", err)
+		}
+		sum = &fileSum
+	} else {
+		// загрузка занимается до создания узи, чтобы параллельный запрос с тем же upload_id не создал второе
+		u, err := s.upload.Claim(ctx, upload.ClaimUploadArg{
+			Id:    *in.UploadID,
+			Owner: in.Author,
+			Kind:  uploadDomain.UploadKindUzi,
+		})
+		if err != nil {
+			return uuid.UUID{}, nil, fmt.Errorf("claim uzi upload: %w", err)
+		}
+		claimed, sum = &u, u.Sha256
+	}
+
+	uziID, duplicates, err := s.adapters.Uzi.CreateUzi(ctx, adapter.CreateUziIn{
 		Projection:  in.Projection,
 		ExternalID:  in.ExternalID,
 		Author:      in.Author,
 		DeviceID:    in.DeviceID,
 		Description: in.Description,
+
+		PixelSpacing: in.PixelSpacing,
+		Sha256:       sum,
 	})
 	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("create uzi in microservice: %w", err)
+		if claimed != nil {
+			_ = s.upload.Release(ctx, *claimed)
+		}
+		return uuid.UUID{}, nil, fmt.Errorf("create uzi in microservice: %w", err)
 	}
 
-	err = s.dao.NewFileRepo().LoadFile(ctx, filepath.Join(uziID.String(), uziID.String()), in.File)
-	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("load uzi file to s3: %w", err)
+	path := filepath.Join(uziID.String(), uziID.String())
+	if claimed != nil {
+		if err := s.upload.Move(ctx, *claimed, upload.MoveUploadArg{Path: path}); err != nil {
+			_ = s.adapters.Uzi.DeleteUzi(ctx, uziID)
+			_ = s.upload.Release(ctx, *claimed)
+			return uuid.UUID{}, nil, fmt.Errorf("move uzi upload: %w", err)
+		}
+	} else {
+		if err := s.dao.NewFileRepo().LoadFile(ctx, path, in.File); err != nil {
+			_ = s.adapters.Uzi.DeleteUzi(ctx, uziID)
+			return uuid.UUID{}, nil, fmt.Errorf("load uzi file to s3: %w", err)
+		}
+		if err := s.deidentify.SaveReport(ctx, "", path, report); err != nil {
+			_ = s.adapters.Uzi.DeleteUzi(ctx, uziID)
+			return uuid.UUID{}, nil, fmt.Errorf("save deidentification report: %w", err)
+		}
 	}
 
 	// TODO: сделать сагу
 	err = s.dbus.SendUziUpload(ctx, &uziuploadpb.UziUpload{UziId: uziID.String()})
 	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("send uzi upload to dbus: %w", err)
+		// файлы удаленного узи убирает сервис uzi
+		_ = s.adapters.Uzi.DeleteUzi(ctx, uziID)
+		return uuid.UUID{}, nil, fmt.Errorf("send uzi upload to dbus: %w", err)
 	}
 
-	return uziID, nil
+	return uziID, duplicates, nil
 }
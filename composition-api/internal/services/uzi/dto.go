@@ -8,16 +8,28 @@ import (
 )
 
 type CreateUziArg struct {
+	// File или UploadID завершенной загрузки частями
 	File        ht.MultipartFile
+	UploadID    *uuid.UUID
 	Projection  domain.UziProjection
 	ExternalID  uuid.UUID
 	Author      uuid.UUID
 	DeviceID    int
 	Description *string
+
+	PixelSpacing *domain.PixelSpacing
 }
 
 type UpdateUziArg struct {
-	Id         uuid.UUID
-	Projection *domain.UziProjection
-	Checked    *bool
+	Id           uuid.UUID
+	Projection   *domain.UziProjection
+	Checked      *bool
+	PixelSpacing *domain.PixelSpacing
+}
+
+type SearchUziArg struct {
+	Filter domain.UziFilter
+	Order  domain.SortOrder
+	Cursor *string
+	Limit  int
 }
@@ -0,0 +1,21 @@
+package uzi
+
+import (
+	"context"
+
+	adapter "composition-api/internal/adapters/uzi"
+	domain "composition-api/internal/domain/uzi"
+)
+
+func (s *service) Search(ctx context.Context, arg SearchUziArg) (domain.UziPage, error) {
+	page, err := s.adapters.Uzi.SearchUzis(ctx, adapter.SearchUzisIn{
+		Filter: arg.Filter,
+		Order:  arg.Order,
+		Cursor: arg.Cursor,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return domain.UziPage{}, err
+	}
+	return page, nil
+}
@@ -9,14 +9,20 @@ import (
 	dbus "composition-api/internal/dbus/producers"
 	domain "composition-api/internal/domain/uzi"
 	"composition-api/internal/repository"
+	"composition-api/internal/services/deidentify"
+	"composition-api/internal/services/upload"
 )
 
 type Service interface {
-	Create(ctx context.Context, arg CreateUziArg) (uuid.UUID, error)
+	// Create возвращает id узи и узи пациента с тем же файлом
+	Create(ctx context.Context, arg CreateUziArg) (uuid.UUID, []uuid.UUID, error)
 
 	GetByID(ctx context.Context, id uuid.UUID) (domain.Uzi, error)
 	GetByExternalID(ctx context.Context, externalID uuid.UUID) ([]domain.Uzi, error)
 	GetByAuthor(ctx context.Context, author uuid.UUID) ([]domain.Uzi, error)
+	Search(ctx context.Context, arg SearchUziArg) (domain.UziPage, error)
+	// Analytics точность нейросети и согласие врачей по узи выборки
+	Analytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.Analytics, error)
 	GetEchographicsByID(ctx context.Context, id uuid.UUID) (domain.Echographic, error)
 	DeleteByID(ctx context.Context, id uuid.UUID) error
 
@@ -25,19 +31,25 @@ type Service interface {
 }
 
 type service struct {
-	adapters *adapters.Adapters
-	dao      repository.DAO
-	dbus     dbus.Producer
+	adapters   *adapters.Adapters
+	dao        repository.DAO
+	dbus       dbus.Producer
+	upload     upload.Service
+	deidentify deidentify.Service
 }
 
 func New(
 	adapters *adapters.Adapters,
 	dao repository.DAO,
 	dbus dbus.Producer,
+	upload upload.Service,
+	deidentify deidentify.Service,
 ) Service {
 	return &service{
-		adapters: adapters,
-		dao:      dao,
-		dbus:     dbus,
+		adapters:   adapters,
+		dao:        dao,
+		dbus:       dbus,
+		upload:     upload,
+		deidentify: deidentify,
 	}
 }
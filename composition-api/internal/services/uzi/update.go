@@ -2,16 +2,23 @@ package uzi
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AlekSi/pointer"
 
 	adapter "composition-api/internal/adapters/uzi"
+	baseDomain "composition-api/internal/domain"
 	domain "composition-api/internal/domain/uzi"
 )
 
 func (s *service) Update(ctx context.Context, arg UpdateUziArg) (domain.Uzi, error) {
 	uzi, err := s.adapters.Uzi.UpdateUzi(ctx, adapter.UpdateUziIn{
-		Id:         arg.Id,
-		Projection: arg.Projection,
-		Checked:    arg.Checked,
+		Id:           arg.Id,
+		Projection:   arg.Projection,
+		Checked:      arg.Checked,
+		PixelSpacing: arg.PixelSpacing,
 	})
 	if err != nil {
 		return domain.Uzi{}, err
@@ -19,7 +26,30 @@ func (s *service) Update(ctx context.Context, arg UpdateUziArg) (domain.Uzi, err
 	return uzi, nil
 }
 
+// ageAt полных лет на дату
+func ageAt(birthDate, date time.Time) int {
+	age := date.Year() - birthDate.Year()
+	if date.Month() < birthDate.Month() || (date.Month() == birthDate.Month() && date.Day() < birthDate.Day()) {
+		age--
+	}
+	return age
+}
+
 func (s *service) UpdateEchographics(ctx context.Context, arg domain.Echographic) (domain.Echographic, error) {
+	uzi, err := s.adapters.Uzi.GetUziById(ctx, arg.Id)
+	if err != nil {
+		return domain.Echographic{}, err
+	}
+
+	// возраст для норм объема железы берется из карты пациента, пациента может не быть в med
+	patient, err := s.adapters.Med.GetPatient(ctx, uzi.ExternalID)
+	switch {
+	case err == nil && !patient.BirthDate.IsZero():
+		arg.PatientAge = pointer.To(max(ageAt(patient.BirthDate, uzi.CreateAt), 0))
+	case err != nil && !errors.Is(err, baseDomain.ErrNotFound):
+		return domain.Echographic{}, fmt.Errorf("get patient: %w", err)
+	}
+
 	echographics, err := s.adapters.Uzi.UpdateEchographic(ctx, arg)
 	if err != nil {
 		return domain.Echographic{}, err
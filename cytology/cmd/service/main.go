@@ -14,12 +14,14 @@ import (
 	loglib "github.com/WantBeASleep/med_ml_lib/observer/log"
 
 	"cytology/internal/config"
+	"cytology/internal/domain"
 
 	"github.com/ilyakaznacheev/cleanenv"
 
 	"cytology/internal/repository"
 
 	services "cytology/internal/services"
+	originalimagesrv "cytology/internal/services/original_image"
 
 	pb "cytology/internal/generated/grpc/service"
 
@@ -116,9 +118,18 @@ func run() (exitCode int) {
 
 	dao := repository.NewRepository(db, client, bucketName)
 
+	duplicatePolicy, err := domain.DuplicatePolicy.Parse("", cfg.Integrity.DuplicatePolicy)
+	if err != nil {
+		slog.Error("parse duplicate policy", "err", err)
+		return failExitCode
+	}
+
 	services := services.New(
 		dao,
 		dbusAdapter,
+		originalimagesrv.Config{
+			DuplicatePolicy: duplicatePolicy,
+		},
 	)
 
 	handler := grpchandler.New(services)
@@ -183,7 +194,43 @@ func run() (exitCode int) {
 		}
 	}()
 
+	go runPeriodic(context.Background(), "integrity verify", cfg.Integrity.VerifyInterval, func(ctx context.Context) error {
+		report, err := services.OriginalImage.VerifyIntegrity(ctx)
+		if err != nil {
+			return err
+		}
+		for _, mismatch := range report.Mismatches {
+			slog.Error("integrity verify: sha256 mismatch", "original_image", mismatch.OriginalImageID, "expected", mismatch.Expected, "actual", mismatch.Actual)
+		}
+		for _, id := range report.Missing {
+			slog.Error("integrity verify: file missing", "original_image", id)
+		}
+		slog.Info("integrity verify", "checked", report.Checked, "mismatches", len(report.Mismatches), "missing", len(report.Missing))
+		return nil
+	})
+
 	<-close
 
 	return successExitCode
 }
+
+// runPeriodic выполняет job раз в interval, ошибки только логируются; interval 0 отключает job
+func runPeriodic(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				slog.Error(name, "err", err)
+			}
+		}
+	}
+}
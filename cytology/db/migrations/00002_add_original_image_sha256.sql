@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE original_image
+    ADD COLUMN sha256 char(64);
+
+CREATE INDEX idx_original_image_sha256 ON original_image(sha256);
+
+COMMENT ON COLUMN original_image.sha256 IS 'SHA-256 файла в hex. NULL - изображение загружено до подсчета';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_original_image_sha256;
+
+ALTER TABLE original_image
+    DROP COLUMN IF EXISTS sha256;
+-- +goose StatementEnd
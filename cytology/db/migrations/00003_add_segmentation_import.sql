@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE segmentation_group
+    ADD COLUMN original_image_id uuid REFERENCES original_image (id) ON DELETE CASCADE;
+
+CREATE INDEX idx_segmentation_group_original_image_id ON segmentation_group(original_image_id);
+
+COMMENT ON COLUMN segmentation_group.original_image_id IS 'ID изображения, по которому получена AI разметка. NULL - группа создана вручную';
+
+CREATE TABLE segmentation_import
+(
+    id                SERIAL          PRIMARY KEY,
+    cytology_id       uuid            NOT NULL REFERENCES cytology_image (id) ON DELETE CASCADE,
+    original_image_id uuid            NOT NULL REFERENCES original_image (id) ON DELETE CASCADE,
+    features_seen     integer         NOT NULL,
+    features_skipped  integer         NOT NULL,
+    unknown_classes   text[]          NOT NULL DEFAULT '{}',
+    groups_created    integer         NOT NULL,
+    segments_created  integer         NOT NULL,
+    groups_replaced   integer         NOT NULL,
+    create_at         timestamp       NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE segmentation_import IS 'Журнал импорта AI разметки из CytologyProcessed';
+COMMENT ON COLUMN segmentation_import.features_seen IS 'Число GeoJSON features в сообщении';
+COMMENT ON COLUMN segmentation_import.features_skipped IS 'Features без класса, геометрии или с неизвестным классом';
+COMMENT ON COLUMN segmentation_import.unknown_classes IS 'Нераспознанные имена классов';
+COMMENT ON COLUMN segmentation_import.groups_replaced IS 'Удалено AI групп предыдущего импорта';
+
+CREATE INDEX idx_segmentation_import_original_image_id ON segmentation_import(original_image_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS segmentation_import;
+
+DROP INDEX IF EXISTS idx_segmentation_group_original_image_id;
+
+ALTER TABLE segmentation_group
+    DROP COLUMN IF EXISTS original_image_id;
+-- +goose StatementEnd
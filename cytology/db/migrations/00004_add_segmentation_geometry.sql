@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE segmentation
+    ADD COLUMN geometry_type varchar(16) NOT NULL DEFAULT 'Polygon',
+    ADD COLUMN color         char(7),
+    ADD COLUMN is_locked     boolean     NOT NULL DEFAULT false,
+    ADD COLUMN confidence    real,
+    ADD COLUMN properties    jsonb;
+
+COMMENT ON COLUMN segmentation.geometry_type IS 'Тип геометрии (Point, LineString, Polygon, MultiPolygon)';
+COMMENT ON COLUMN segmentation.color IS 'Цвет аннотации (#rrggbb)';
+COMMENT ON COLUMN segmentation.is_locked IS 'Аннотация заблокирована для редактирования';
+COMMENT ON COLUMN segmentation.confidence IS 'Уверенность модели [0, 1]';
+COMMENT ON COLUMN segmentation.properties IS 'Произвольные properties аннотации (JSON)';
+
+ALTER TABLE segmentation_point
+    ADD COLUMN polygon_index integer NOT NULL DEFAULT 0,
+    ADD COLUMN ring_index    integer NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN segmentation_point.polygon_index IS 'Номер полигона в MultiPolygon';
+COMMENT ON COLUMN segmentation_point.ring_index IS 'Номер кольца в полигоне, 0 - внешний контур';
+
+-- раньше хранился только внешний контур, короткие контуры - точки и линии
+UPDATE segmentation s
+SET geometry_type = CASE p.cnt WHEN 1 THEN 'Point' ELSE 'LineString' END
+FROM (
+    SELECT segmentation_id, count(*) AS cnt
+    FROM segmentation_point
+    GROUP BY segmentation_id
+) p
+WHERE p.segmentation_id = s.id AND p.cnt < 3;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE segmentation_point
+    DROP COLUMN IF EXISTS ring_index,
+    DROP COLUMN IF EXISTS polygon_index;
+
+ALTER TABLE segmentation
+    DROP COLUMN IF EXISTS properties,
+    DROP COLUMN IF EXISTS confidence,
+    DROP COLUMN IF EXISTS is_locked,
+    DROP COLUMN IF EXISTS color,
+    DROP COLUMN IF EXISTS geometry_type;
+-- +goose StatementEnd
@@ -0,0 +1,120 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE segmentation
+    ADD COLUMN coordinates integer[] NOT NULL DEFAULT '{}',
+    ADD COLUMN rings       integer[] NOT NULL DEFAULT '{}',
+    ADD COLUMN point_uids  bigint[],
+    ADD COLUMN point_ids   integer[] NOT NULL DEFAULT '{}',
+    ADD COLUMN min_x       integer   NOT NULL DEFAULT 0,
+    ADD COLUMN min_y       integer   NOT NULL DEFAULT 0,
+    ADD COLUMN max_x       integer   NOT NULL DEFAULT 0,
+    ADD COLUMN max_y       integer   NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN segmentation.coordinates IS 'Координаты точек всех колец по порядку: x0, y0, x1, y1, ...';
+COMMENT ON COLUMN segmentation.rings IS 'Кольца по порядку парами: номер полигона, число точек';
+COMMENT ON COLUMN segmentation.point_uids IS 'UID точек. NULL - у всех точек 0';
+COMMENT ON COLUMN segmentation.point_ids IS 'id точек из последовательности segmentation_point_id_seq';
+COMMENT ON COLUMN segmentation.min_x IS 'Ограничивающий прямоугольник геометрии';
+
+WITH coords AS (
+    SELECT p.segmentation_id,
+           array_agg(c.v ORDER BY p.polygon_index, p.ring_index, p.id, c.k) AS coordinates
+    FROM segmentation_point p,
+         unnest(ARRAY[p.x, p.y]) WITH ORDINALITY AS c(v, k)
+    GROUP BY p.segmentation_id
+),
+ring_sizes AS (
+    SELECT segmentation_id, polygon_index, ring_index, count(*)::integer AS n
+    FROM segmentation_point
+    GROUP BY segmentation_id, polygon_index, ring_index
+),
+rings AS (
+    SELECT r.segmentation_id,
+           array_agg(c.v ORDER BY r.polygon_index, r.ring_index, c.k) AS rings
+    FROM ring_sizes r,
+         unnest(ARRAY[r.polygon_index, r.n]) WITH ORDINALITY AS c(v, k)
+    GROUP BY r.segmentation_id
+),
+stats AS (
+    SELECT segmentation_id,
+           min(x) AS min_x, min(y) AS min_y, max(x) AS max_x, max(y) AS max_y,
+           CASE WHEN bool_or(uid <> 0) THEN array_agg(uid::bigint ORDER BY polygon_index, ring_index, id) END AS point_uids,
+           array_agg(id ORDER BY polygon_index, ring_index, id) AS point_ids
+    FROM segmentation_point
+    GROUP BY segmentation_id
+)
+UPDATE segmentation s
+SET coordinates = c.coordinates,
+    rings       = r.rings,
+    point_uids  = st.point_uids,
+    point_ids   = st.point_ids,
+    min_x       = st.min_x,
+    min_y       = st.min_y,
+    max_x       = st.max_x,
+    max_y       = st.max_y
+FROM coords c
+JOIN rings r USING (segmentation_id)
+JOIN stats st USING (segmentation_id)
+WHERE s.id = c.segmentation_id;
+
+-- последовательность остается, из нее выдаются id новых точек
+ALTER SEQUENCE segmentation_point_id_seq OWNED BY NONE;
+
+DROP TABLE segmentation_point;
+
+CREATE INDEX idx_segmentation_bbox ON segmentation USING gist (box(point(min_x, min_y), point(max_x, max_y)));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE segmentation_point
+(
+    id              INTEGER         PRIMARY KEY DEFAULT nextval('segmentation_point_id_seq'),
+    segmentation_id INTEGER         NOT NULL REFERENCES segmentation (id) ON DELETE CASCADE,
+    x               integer         NOT NULL,
+    y               integer         NOT NULL,
+    uid             integer         NOT NULL,
+    create_at       timestamp       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    polygon_index   integer         NOT NULL DEFAULT 0,
+    ring_index      integer         NOT NULL DEFAULT 0
+);
+
+ALTER SEQUENCE segmentation_point_id_seq OWNED BY segmentation_point.id;
+
+CREATE INDEX idx_segmentation_point_segmentation_id ON segmentation_point(segmentation_id);
+
+WITH ring_rows AS (
+    SELECT s.id AS segmentation_id, k AS ring_no, s.rings[2 * k - 1] AS polygon_index, s.rings[2 * k] AS n
+    FROM segmentation s,
+         generate_series(1, coalesce(array_length(s.rings, 1), 0) / 2) AS k
+),
+ring_bounds AS (
+    SELECT segmentation_id, ring_no, polygon_index, n,
+           sum(n) OVER (PARTITION BY segmentation_id ORDER BY ring_no) - n AS start,
+           row_number() OVER (PARTITION BY segmentation_id, polygon_index ORDER BY ring_no) - 1 AS ring_index
+    FROM ring_rows
+)
+INSERT INTO segmentation_point (id, segmentation_id, polygon_index, ring_index, x, y, uid, create_at)
+SELECT coalesce(s.point_ids[b.start + j], nextval('segmentation_point_id_seq')),
+       b.segmentation_id, b.polygon_index, b.ring_index,
+       s.coordinates[2 * (b.start + j) - 1],
+       s.coordinates[2 * (b.start + j)],
+       coalesce(s.point_uids[b.start + j], 0),
+       s.create_at
+FROM ring_bounds b
+JOIN segmentation s ON s.id = b.segmentation_id,
+     generate_series(1, b.n) AS j
+ORDER BY b.segmentation_id, b.ring_no, j;
+
+DROP INDEX IF EXISTS idx_segmentation_bbox;
+
+ALTER TABLE segmentation
+    DROP COLUMN IF EXISTS max_y,
+    DROP COLUMN IF EXISTS max_x,
+    DROP COLUMN IF EXISTS min_y,
+    DROP COLUMN IF EXISTS min_x,
+    DROP COLUMN IF EXISTS point_ids,
+    DROP COLUMN IF EXISTS point_uids,
+    DROP COLUMN IF EXISTS rings,
+    DROP COLUMN IF EXISTS coordinates;
+-- +goose StatementEnd
@@ -1,10 +1,13 @@
 package config
 
+import "time"
+
 type Config struct {
-	App    App
-	DB     DB
-	S3     S3
-	Broker Broker
+	App       App
+	DB        DB
+	S3        S3
+	Broker    Broker
+	Integrity Integrity
 }
 
 type App struct {
@@ -24,3 +27,10 @@ type S3 struct {
 type Broker struct {
 	Addrs []string `env:"BROKER_ADDRS" env-required:"true"`
 }
+
+type Integrity struct {
+	// повторная загрузка того же файла для пациента: warn - создать и вернуть дубликаты, reject - отклонить
+	DuplicatePolicy string `env:"CYTOLOGY_DUPLICATE_POLICY" env-default:"warn"`
+	// период пересчета sha256 оригинальных изображений, 0 - только по запросу
+	VerifyInterval time.Duration `env:"STORAGE_VERIFY_INTERVAL" env-default:"0s"`
+}
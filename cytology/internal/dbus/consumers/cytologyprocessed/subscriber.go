@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"log/slog"
 
 	"github.com/WantBeASleep/med_ml_lib/dbus"
 	"github.com/google/uuid"
@@ -12,50 +12,9 @@ import (
 	"cytology/internal/domain"
 	pb "cytology/internal/generated/dbus/consume/cytologyprocessed"
 	"cytology/internal/services"
-	"cytology/internal/services/segmentation"
-	"cytology/internal/services/segmentation_group"
+	"cytology/internal/services/segmentation_import"
 )
 
-// Маппинг имен классов из GeoJSON в SegType
-var classNameToSegType = map[string]domain.SegType{
-	"Нормальная клетка":            domain.SegTypeNIL,
-	"Клетка Гюртле":                domain.SegTypeNIR,
-	"Макрофаг":                     domain.SegTypeNIM,
-	"Скопление упорядоченное":      domain.SegTypeCNO,
-	"Скопление неупорядоченное":    domain.SegTypeCGE,
-	"Скопление микрофолликулярное": domain.SegTypeC2N,
-	"Скопление папиллярное":        domain.SegTypeCPS,
-	"Скопление фолликулярное":      domain.SegTypeCFC,
-	"Скопление лимфоидное":         domain.SegTypeCLY,
-	"Метастаз отсутствует":         domain.SegTypeSOS,
-	"Метастаз сомнительный":        domain.SegTypeSDS,
-	"Метастаз вероятный":           domain.SegTypeSMS,
-	"Метастаз определенный":        domain.SegTypeSTS,
-	"Метастаз папиллярный":         domain.SegTypeSPS,
-	"Метастаз отсутствует (нет)":   domain.SegTypeSNM,
-	"Метастаз тиреоидный":          domain.SegTypeSTM,
-}
-
-// Маппинг SegType в GroupType
-var segTypeToGroupType = map[domain.SegType]domain.GroupType{
-	domain.SegTypeNIL: domain.GroupTypeCE,
-	domain.SegTypeNIR: domain.GroupTypeCE,
-	domain.SegTypeNIM: domain.GroupTypeCE,
-	domain.SegTypeCNO: domain.GroupTypeCL,
-	domain.SegTypeCGE: domain.GroupTypeCL,
-	domain.SegTypeC2N: domain.GroupTypeCL,
-	domain.SegTypeCPS: domain.GroupTypeCL,
-	domain.SegTypeCFC: domain.GroupTypeCL,
-	domain.SegTypeCLY: domain.GroupTypeCL,
-	domain.SegTypeSOS: domain.GroupTypeME,
-	domain.SegTypeSDS: domain.GroupTypeME,
-	domain.SegTypeSMS: domain.GroupTypeME,
-	domain.SegTypeSTS: domain.GroupTypeME,
-	domain.SegTypeSPS: domain.GroupTypeME,
-	domain.SegTypeSNM: domain.GroupTypeME,
-	domain.SegTypeSTM: domain.GroupTypeME,
-}
-
 type subscriber struct {
 	services *services.Services
 }
@@ -68,25 +27,6 @@ func New(
 	}
 }
 
-func getSegTypeFromClassName(className string) (domain.SegType, domain.GroupType, bool) {
-	// Пробуем точное совпадение
-	if segType, ok := classNameToSegType[className]; ok {
-		groupType := segTypeToGroupType[segType]
-		return segType, groupType, true
-	}
-
-	// Пробуем найти по частичному совпадению (без учета регистра)
-	classNameLower := strings.ToLower(className)
-	for name, segType := range classNameToSegType {
-		if strings.Contains(strings.ToLower(name), classNameLower) || strings.Contains(classNameLower, strings.ToLower(name)) {
-			groupType := segTypeToGroupType[segType]
-			return segType, groupType, true
-		}
-	}
-
-	return "", "", false
-}
-
 func (h *subscriber) Consume(ctx context.Context, message *pb.CytologyProcessed) error {
 	// Валидация UUID
 	cytologyID, err := uuid.Parse(message.CytologyId)
@@ -94,107 +34,127 @@ func (h *subscriber) Consume(ctx context.Context, message *pb.CytologyProcessed)
 		return fmt.Errorf("cytology id is not uuid: %s", message.CytologyId)
 	}
 
-	if _, err := uuid.Parse(message.OriginalImageId); err != nil {
+	originalImageID, err := uuid.Parse(message.OriginalImageId)
+	if err != nil {
 		return fmt.Errorf("original image id is not uuid: %s", message.OriginalImageId)
 	}
 
-	// Обрабатываем каждую feature из protobuf структуры
 	featureCollection := message.GeojsonFeatures
 	if featureCollection == nil {
 		return errors.New("geojson_features is nil")
 	}
 
+	// Пустые и неизвестные features учитываются в статистике импорта
+	features := make([]segmentation_import.Feature, 0, len(featureCollection.Features))
 	for _, feature := range featureCollection.Features {
-		if feature == nil {
-			continue
-		}
+		features = append(features, importFeature(feature))
+	}
 
-		// Получаем имя класса из properties
-		properties := feature.Properties
-		if properties == nil {
-			continue
-		}
+	stats, err := h.services.SegmentationImport.ImportAISegmentation(ctx, segmentation_import.ImportArg{
+		CytologyID:      cytologyID,
+		OriginalImageID: originalImageID,
+		Features:        features,
+	})
+	if err != nil {
+		return fmt.Errorf("import ai segmentation: %w", err)
+	}
 
-		classification := properties.Classification
-		if classification == nil {
-			continue
-		}
+	if len(stats.UnknownClasses) > 0 {
+		slog.Warn("unknown classes in cytologyprocessed",
+			"original_image_id", originalImageID,
+			"classes", stats.UnknownClasses,
+		)
+	}
 
-		className := classification.Name
-		if className == "" {
-			continue
-		}
+	return nil
+}
 
-		// Получаем тип сегмента по имени класса
-		segType, groupType, found := getSegTypeFromClassName(className)
-		if !found {
-			// Пропускаем неизвестные классы
-			continue
-		}
+func importFeature(feature *pb.Feature) segmentation_import.Feature {
+	res := segmentation_import.Feature{}
+	if feature == nil {
+		return res
+	}
 
-		// Создаем группу сегментов
-		groupArg := segmentation_group.CreateSegmentationGroupArg{
-			CytologyID: cytologyID,
-			SegType:    segType,
-			GroupType:  groupType,
-			IsAI:       true,
-			Details:    nil,
-		}
+	res.GeometryType, res.Points = featureGeometry(feature.Geometry)
 
-		groupID, err := h.services.SegmentationGroup.CreateSegmentationGroup(ctx, groupArg)
-		if err != nil {
-			return fmt.Errorf("create segmentation group: %w", err)
+	properties := feature.Properties
+	if properties == nil {
+		return res
+	}
+	res.IsLocked = properties.IsLocked
+	res.Confidence = properties.Confidence
+	if properties.Extra != nil {
+		res.Properties = []byte(*properties.Extra)
+	}
+	if classification := properties.Classification; classification != nil {
+		res.ClassName = classification.Name
+		if c := classification.Color; c != nil {
+			res.Color = &domain.Color{R: int(c.R), G: int(c.G), B: int(c.B)}
 		}
+	}
 
-		// Получаем geometry
-		geometry := feature.Geometry
-		if geometry == nil {
-			continue
-		}
+	return res
+}
 
-		var points []domain.SegmentationPoint
+// featureGeometry точки геометрии с номерами полигонов и колец
+func featureGeometry(geometry *pb.Geometry) (domain.GeometryType, []domain.SegmentationPoint) {
+	if geometry == nil {
+		return "", nil
+	}
 
-		// Обрабатываем координаты в зависимости от типа geometry
-		switch g := geometry.GeometryType.(type) {
-		case *pb.Geometry_Point:
-			if g.Point != nil {
-				points = append(points, domain.SegmentationPoint{
-					X:   int(g.Point.X),
-					Y:   int(g.Point.Y),
-					UID: 0, // Будет установлен при создании
-				})
-			}
-		case *pb.Geometry_Polygon:
-			if g.Polygon != nil {
-				// Обрабатываем первое кольцо полигона (внешний контур)
-				if len(g.Polygon.Rings) > 0 {
-					ring := g.Polygon.Rings[0]
-					for _, point := range ring.Points {
-						points = append(points, domain.SegmentationPoint{
-							X:   int(point.X),
-							Y:   int(point.Y),
-							UID: 0, // Будет установлен при создании
-						})
-					}
-				}
-			}
+	switch g := geometry.GeometryType.(type) {
+	case *pb.Geometry_Point:
+		if g.Point == nil {
+			return "", nil
 		}
-
-		if len(points) == 0 {
-			continue
+		return domain.GeometryTypePoint, []domain.SegmentationPoint{toPoint(g.Point, 0, 0)}
+	case *pb.Geometry_LineString:
+		if g.LineString == nil {
+			return "", nil
 		}
-
-		// Создаем сегментацию с точками
-		segArg := segmentation.CreateSegmentationArg{
-			SegmentationGroupID: groupID,
-			Points:              points,
+		var points []domain.SegmentationPoint
+		for _, point := range g.LineString.Points {
+			points = append(points, toPoint(point, 0, 0))
 		}
+		return domain.GeometryTypeLineString, points
+	case *pb.Geometry_Polygon:
+		return domain.GeometryTypePolygon, polygonPoints(g.Polygon, 0)
+	case *pb.Geometry_MultiPolygon:
+		if g.MultiPolygon == nil {
+			return "", nil
+		}
+		var points []domain.SegmentationPoint
+		for i, polygon := range g.MultiPolygon.Polygons {
+			points = append(points, polygonPoints(polygon, i)...)
+		}
+		return domain.GeometryTypeMultiPolygon, points
+	}
 
-		_, err = h.services.Segmentation.CreateSegmentation(ctx, segArg)
-		if err != nil {
-			return fmt.Errorf("create segmentation: %w", err)
+	return "", nil
+}
+
+func polygonPoints(polygon *pb.Polygon, polygonIdx int) []domain.SegmentationPoint {
+	if polygon == nil {
+		return nil
+	}
+
+	var points []domain.SegmentationPoint
+	for ringIdx, ring := range polygon.Rings {
+		if ring == nil {
+			continue
+		}
+		for _, point := range ring.Points {
+			points = append(points, toPoint(point, polygonIdx, ringIdx))
 		}
 	}
+	return points
+}
 
-	return nil
+func toPoint(point *pb.Point, polygonIdx, ringIdx int) domain.SegmentationPoint {
+	return domain.SegmentationPoint{
+		Polygon: polygonIdx,
+		Ring:    ringIdx,
+		X:       int(point.GetX()),
+		Y:       int(point.GetY()),
+	}
 }
@@ -13,4 +13,6 @@ type OriginalImage struct {
 	CreateDate time.Time
 	DelayTime  *float64
 	ViewedFlag bool
+	// sha256 файла в hex
+	Sha256 *string
 }
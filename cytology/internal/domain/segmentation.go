@@ -2,6 +2,7 @@ package domain
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -47,25 +48,176 @@ func (g GroupType) String() string {
 type SegmentationGroup struct {
 	Id         int
 	CytologyID uuid.UUID
-	SegType    SegType
-	GroupType  GroupType
-	IsAI       bool
-	Details    json.RawMessage
-	CreateAt   time.Time
+	// изображение, по которому получена AI разметка
+	OriginalImageID *uuid.UUID
+	SegType         SegType
+	GroupType       GroupType
+	IsAI            bool
+	Details         json.RawMessage
+	CreateAt        time.Time
+}
+
+// GeometryType тип геометрии сегментации в терминах GeoJSON
+type GeometryType string
+
+const (
+	GeometryTypePoint        GeometryType = "Point"
+	GeometryTypeLineString   GeometryType = "LineString"
+	GeometryTypePolygon      GeometryType = "Polygon"
+	GeometryTypeMultiPolygon GeometryType = "MultiPolygon"
+)
+
+func (g GeometryType) String() string {
+	return string(g)
+}
+
+func (g GeometryType) Parse(geometryType string) (GeometryType, error) {
+	switch geometryType {
+	case "Point":
+		return GeometryTypePoint, nil
+	case "LineString":
+		return GeometryTypeLineString, nil
+	case "Polygon":
+		return GeometryTypePolygon, nil
+	case "MultiPolygon":
+		return GeometryTypeMultiPolygon, nil
+	default:
+		return "", fmt.Errorf("invalid geometry type: %s", geometryType)
+	}
+}
+
+// Color цвет аннотации в RGB
+type Color struct {
+	R int
+	G int
+	B int
 }
 
 type SegmentationPoint struct {
 	Id             int
 	SegmentationID int
-	X              int
-	Y              int
-	UID            int64
-	CreateAt       time.Time
+	// номер полигона в MultiPolygon
+	Polygon int
+	// номер кольца в полигоне, 0 - внешний контур, остальные - дыры
+	Ring     int
+	X        int
+	Y        int
+	UID      int64
+	CreateAt time.Time
 }
 
 type Segmentation struct {
 	Id                  int
 	SegmentationGroupID int
-	Points              []SegmentationPoint
-	CreateAt            time.Time
+	GeometryType        GeometryType
+	// точки всех колец всех полигонов по порядку
+	Points     []SegmentationPoint
+	Color      *Color
+	IsLocked   bool
+	Confidence *float64
+	// произвольные properties аннотации, JSON объект
+	Properties json.RawMessage
+	CreateAt   time.Time
+}
+
+// GeometryTypeOf тип геометрии по точкам, для клиентов, не передающих тип
+func GeometryTypeOf(points []SegmentationPoint) GeometryType {
+	geometryType := GeometryTypePolygon
+	switch len(points) {
+	case 1:
+		geometryType = GeometryTypePoint
+	case 2:
+		geometryType = GeometryTypeLineString
+	}
+
+	for _, p := range points {
+		if p.Polygon > 0 {
+			return GeometryTypeMultiPolygon
+		}
+		if p.Ring > 0 {
+			geometryType = GeometryTypePolygon
+		}
+	}
+	return geometryType
+}
+
+// минимальное число точек кольца полигона
+const minRingPoints = 3
+
+// Validate проверяет, что точки образуют геометрию своего типа, а метаданные корректны
+func (s Segmentation) Validate() error {
+	if len(s.Points) == 0 {
+		return fmt.Errorf("%w: segmentation without points", ErrBadRequest)
+	}
+
+	switch s.GeometryType {
+	case GeometryTypePoint, GeometryTypeLineString:
+		for _, p := range s.Points {
+			if p.Polygon != 0 || p.Ring != 0 {
+				return fmt.Errorf("%w: %s has no rings", ErrBadRequest, s.GeometryType)
+			}
+		}
+		if s.GeometryType == GeometryTypePoint && len(s.Points) != 1 {
+			return fmt.Errorf("%w: point must have exactly one point", ErrBadRequest)
+		}
+		if s.GeometryType == GeometryTypeLineString && len(s.Points) < 2 {
+			return fmt.Errorf("%w: line string must have at least 2 points", ErrBadRequest)
+		}
+	case GeometryTypePolygon, GeometryTypeMultiPolygon:
+		if err := validateRings(s.GeometryType, s.Points); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: invalid geometry type: %s", ErrBadRequest, s.GeometryType)
+	}
+
+	if s.Color != nil {
+		for _, c := range []int{s.Color.R, s.Color.G, s.Color.B} {
+			if c < 0 || c > 255 {
+				return fmt.Errorf("%w: color component out of range: %d", ErrBadRequest, c)
+			}
+		}
+	}
+
+	if s.Confidence != nil && (*s.Confidence < 0 || *s.Confidence > 1) {
+		return fmt.Errorf("%w: confidence out of [0, 1]: %v", ErrBadRequest, *s.Confidence)
+	}
+
+	if len(s.Properties) > 0 {
+		var properties map[string]json.RawMessage
+		if err := json.Unmarshal(s.Properties, &properties); err != nil {
+			return fmt.Errorf("%w: properties is not json object", ErrBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// validateRings точки идут кольцами по порядку: номера полигонов и колец без пропусков,
+// в каждом кольце не меньше minRingPoints точек
+func validateRings(geometryType GeometryType, points []SegmentationPoint) error {
+	polygon, ring, ringPoints := 0, 0, 0
+	for _, p := range points {
+		switch {
+		case p.Polygon == polygon && p.Ring == ring:
+		case p.Polygon == polygon && p.Ring == ring+1,
+			p.Polygon == polygon+1 && p.Ring == 0:
+			if ringPoints < minRingPoints {
+				return fmt.Errorf("%w: ring %d of polygon %d has %d points", ErrBadRequest, ring, polygon, ringPoints)
+			}
+			polygon, ring, ringPoints = p.Polygon, p.Ring, 0
+		default:
+			return fmt.Errorf("%w: unexpected ring %d of polygon %d", ErrBadRequest, p.Ring, p.Polygon)
+		}
+		ringPoints++
+	}
+	if ringPoints < minRingPoints {
+		return fmt.Errorf("%w: ring %d of polygon %d has %d points", ErrBadRequest, ring, polygon, ringPoints)
+	}
+
+	if geometryType == GeometryTypePolygon && polygon != 0 {
+		return fmt.Errorf("%w: polygon must have one outer ring", ErrBadRequest)
+	}
+
+	return nil
 }
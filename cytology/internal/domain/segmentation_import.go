@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SegmentationImport итог импорта AI разметки изображения
+type SegmentationImport struct {
+	Id              int
+	CytologyID      uuid.UUID
+	OriginalImageID uuid.UUID
+	// число features в сообщении
+	FeaturesSeen int
+	// features без класса, геометрии или с неизвестным классом
+	FeaturesSkipped int
+	UnknownClasses  []string
+	GroupsCreated   int
+	SegmentsCreated int
+	// удалено AI групп предыдущего импорта того же изображения
+	GroupsReplaced int
+	CreateAt       time.Time
+}
+
+// QuPathImport итог импорта GeoJSON из QuPath новой версией исследования
+type QuPathImport struct {
+	// новая версия исследования
+	CytologyImage CytologyImage
+	// аннотации сравниваются с экспортом по id объекта QuPath
+	Added     int
+	Removed   int
+	Changed   int
+	Unchanged int
+	// features без класса, геометрии или с неизвестным классом
+	Skipped        int
+	UnknownClasses []string
+}
@@ -0,0 +1,71 @@
+package domain_test
+
+import (
+	"testing"
+
+	"cytology/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentationValidate(t *testing.T) {
+	ring := func(polygon, ring int) []domain.SegmentationPoint {
+		return []domain.SegmentationPoint{
+			{Polygon: polygon, Ring: ring, X: 0, Y: 0},
+			{Polygon: polygon, Ring: ring, X: 10, Y: 0},
+			{Polygon: polygon, Ring: ring, X: 0, Y: 10},
+		}
+	}
+	concat := func(rings ...[]domain.SegmentationPoint) []domain.SegmentationPoint {
+		var res []domain.SegmentationPoint
+		for _, r := range rings {
+			res = append(res, r...)
+		}
+		return res
+	}
+	confidence := 1.5
+
+	tests := []struct {
+		name string
+		seg  domain.Segmentation
+		ok   bool
+	}{
+		{"point", domain.Segmentation{GeometryType: domain.GeometryTypePoint, Points: ring(0, 0)[:1]}, true},
+		{"point with 2 points", domain.Segmentation{GeometryType: domain.GeometryTypePoint, Points: ring(0, 0)[:2]}, false},
+		{"line string", domain.Segmentation{GeometryType: domain.GeometryTypeLineString, Points: ring(0, 0)[:2]}, true},
+		{"line string with ring", domain.Segmentation{GeometryType: domain.GeometryTypeLineString, Points: ring(0, 1)}, false},
+		{"polygon", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: ring(0, 0)}, true},
+		{"polygon with hole", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: concat(ring(0, 0), ring(0, 1))}, true},
+		{"polygon without outer ring", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: ring(0, 1)}, false},
+		{"polygon with skipped ring", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: concat(ring(0, 0), ring(0, 2))}, false},
+		{"polygon with short ring", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: concat(ring(0, 0), ring(0, 1)[:2])}, false},
+		{"polygon with 2 polygons", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: concat(ring(0, 0), ring(1, 0))}, false},
+		{"multi polygon", domain.Segmentation{GeometryType: domain.GeometryTypeMultiPolygon, Points: concat(ring(0, 0), ring(0, 1), ring(1, 0))}, true},
+		{"multi polygon starts with hole", domain.Segmentation{GeometryType: domain.GeometryTypeMultiPolygon, Points: concat(ring(0, 0), ring(1, 1))}, false},
+		{"no points", domain.Segmentation{GeometryType: domain.GeometryTypePolygon}, false},
+		{"unknown type", domain.Segmentation{GeometryType: "Circle", Points: ring(0, 0)}, false},
+		{"bad color", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: ring(0, 0), Color: &domain.Color{R: 256}}, false},
+		{"bad confidence", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: ring(0, 0), Confidence: &confidence}, false},
+		{"properties not object", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: ring(0, 0), Properties: []byte(`[1]`)}, false},
+		{"properties object", domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: ring(0, 0), Properties: []byte(`{"a":1}`)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.seg.Validate()
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, domain.ErrBadRequest)
+			}
+		})
+	}
+}
+
+func TestGeometryTypeOf(t *testing.T) {
+	require.Equal(t, domain.GeometryTypePoint, domain.GeometryTypeOf([]domain.SegmentationPoint{{}}))
+	require.Equal(t, domain.GeometryTypeLineString, domain.GeometryTypeOf([]domain.SegmentationPoint{{}, {}}))
+	require.Equal(t, domain.GeometryTypePolygon, domain.GeometryTypeOf([]domain.SegmentationPoint{{}, {}, {}}))
+	require.Equal(t, domain.GeometryTypePolygon, domain.GeometryTypeOf([]domain.SegmentationPoint{{}, {}, {}, {Ring: 1}}))
+	require.Equal(t, domain.GeometryTypeMultiPolygon, domain.GeometryTypeOf([]domain.SegmentationPoint{{}, {}, {}, {Polygon: 1}}))
+}
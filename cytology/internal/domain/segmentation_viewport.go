@@ -0,0 +1,31 @@
+package domain
+
+import "fmt"
+
+// BBox прямоугольник в пикселях нулевого уровня изображения, границы включительно
+type BBox struct {
+	MinX int
+	MinY int
+	MaxX int
+	MaxY int
+}
+
+func (b BBox) Validate() error {
+	if b.MinX > b.MaxX || b.MinY > b.MaxY {
+		return fmt.Errorf("%w: bbox min is greater than max", ErrBadRequest)
+	}
+	return nil
+}
+
+// SegmentationViewportFilter фильтры по группе сегментации, все необязательны
+type SegmentationViewportFilter struct {
+	SegType   *SegType
+	GroupType *GroupType
+	IsAI      *bool
+}
+
+type SegmentationPage struct {
+	Segmentations []Segmentation
+	// nil, если страница последняя
+	NextCursor *string
+}
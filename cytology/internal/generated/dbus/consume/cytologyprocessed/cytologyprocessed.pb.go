@@ -183,6 +183,8 @@ type Geometry struct {
 	//
 	//	*Geometry_Point
 	//	*Geometry_Polygon
+	//	*Geometry_LineString
+	//	*Geometry_MultiPolygon
 	GeometryType  isGeometry_GeometryType `protobuf_oneof:"geometry_type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -243,6 +245,24 @@ func (x *Geometry) GetPolygon() *Polygon {
 	return nil
 }
 
+func (x *Geometry) GetLineString() *LineString {
+	if x != nil {
+		if x, ok := x.GeometryType.(*Geometry_LineString); ok {
+			return x.LineString
+		}
+	}
+	return nil
+}
+
+func (x *Geometry) GetMultiPolygon() *MultiPolygon {
+	if x != nil {
+		if x, ok := x.GeometryType.(*Geometry_MultiPolygon); ok {
+			return x.MultiPolygon
+		}
+	}
+	return nil
+}
+
 type isGeometry_GeometryType interface {
 	isGeometry_GeometryType()
 }
@@ -255,10 +275,22 @@ type Geometry_Polygon struct {
 	Polygon *Polygon `protobuf:"bytes,200,opt,name=polygon,proto3,oneof"`
 }
 
+type Geometry_LineString struct {
+	LineString *LineString `protobuf:"bytes,300,opt,name=line_string,json=lineString,proto3,oneof"`
+}
+
+type Geometry_MultiPolygon struct {
+	MultiPolygon *MultiPolygon `protobuf:"bytes,400,opt,name=multi_polygon,json=multiPolygon,proto3,oneof"`
+}
+
 func (*Geometry_Point) isGeometry_GeometryType() {}
 
 func (*Geometry_Polygon) isGeometry_GeometryType() {}
 
+func (*Geometry_LineString) isGeometry_GeometryType() {}
+
+func (*Geometry_MultiPolygon) isGeometry_GeometryType() {}
+
 type Point struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             float64                `protobuf:"fixed64,100,opt,name=x,proto3" json:"x,omitempty"`
@@ -311,6 +343,7 @@ func (x *Point) GetY() float64 {
 	return 0
 }
 
+// первое кольцо - внешний контур, остальные - дыры
 type Polygon struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rings         []*Ring                `protobuf:"bytes,100,rep,name=rings,proto3" json:"rings,omitempty"`
@@ -355,6 +388,94 @@ func (x *Polygon) GetRings() []*Ring {
 	return nil
 }
 
+type MultiPolygon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Polygons      []*Polygon             `protobuf:"bytes,100,rep,name=polygons,proto3" json:"polygons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiPolygon) Reset() {
+	*x = MultiPolygon{}
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiPolygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiPolygon) ProtoMessage() {}
+
+func (x *MultiPolygon) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiPolygon.ProtoReflect.Descriptor instead.
+func (*MultiPolygon) Descriptor() ([]byte, []int) {
+	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MultiPolygon) GetPolygons() []*Polygon {
+	if x != nil {
+		return x.Polygons
+	}
+	return nil
+}
+
+type LineString struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*Point               `protobuf:"bytes,100,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineString) Reset() {
+	*x = LineString{}
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineString) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineString) ProtoMessage() {}
+
+func (x *LineString) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineString.ProtoReflect.Descriptor instead.
+func (*LineString) Descriptor() ([]byte, []int) {
+	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LineString) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
 type Ring struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Points        []*Point               `protobuf:"bytes,100,rep,name=points,proto3" json:"points,omitempty"`
@@ -364,7 +485,7 @@ type Ring struct {
 
 func (x *Ring) Reset() {
 	*x = Ring{}
-	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[6]
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -376,7 +497,7 @@ func (x *Ring) String() string {
 func (*Ring) ProtoMessage() {}
 
 func (x *Ring) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[6]
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +510,7 @@ func (x *Ring) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ring.ProtoReflect.Descriptor instead.
 func (*Ring) Descriptor() ([]byte, []int) {
-	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{6}
+	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Ring) GetPoints() []*Point {
@@ -403,13 +524,16 @@ type Properties struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Classification *Classification        `protobuf:"bytes,100,opt,name=classification,proto3" json:"classification,omitempty"`
 	IsLocked       bool                   `protobuf:"varint,200,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	Confidence     *float64               `protobuf:"fixed64,300,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"`
+	// остальные properties feature (measurements, name, ...), JSON object
+	Extra         *string `protobuf:"bytes,400,opt,name=extra,proto3,oneof" json:"extra,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Properties) Reset() {
 	*x = Properties{}
-	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[7]
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -421,7 +545,7 @@ func (x *Properties) String() string {
 func (*Properties) ProtoMessage() {}
 
 func (x *Properties) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[7]
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +558,7 @@ func (x *Properties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Properties.ProtoReflect.Descriptor instead.
 func (*Properties) Descriptor() ([]byte, []int) {
-	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{7}
+	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Properties) GetClassification() *Classification {
@@ -451,6 +575,20 @@ func (x *Properties) GetIsLocked() bool {
 	return false
 }
 
+func (x *Properties) GetConfidence() float64 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *Properties) GetExtra() string {
+	if x != nil && x.Extra != nil {
+		return *x.Extra
+	}
+	return ""
+}
+
 type Classification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
@@ -461,7 +599,7 @@ type Classification struct {
 
 func (x *Classification) Reset() {
 	*x = Classification{}
-	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[8]
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -473,7 +611,7 @@ func (x *Classification) String() string {
 func (*Classification) ProtoMessage() {}
 
 func (x *Classification) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[8]
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,7 +624,7 @@ func (x *Classification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Classification.ProtoReflect.Descriptor instead.
 func (*Classification) Descriptor() ([]byte, []int) {
-	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{8}
+	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Classification) GetName() string {
@@ -514,7 +652,7 @@ type Color struct {
 
 func (x *Color) Reset() {
 	*x = Color{}
-	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[9]
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +664,7 @@ func (x *Color) String() string {
 func (*Color) ProtoMessage() {}
 
 func (x *Color) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[9]
+	mi := &file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +677,7 @@ func (x *Color) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Color.ProtoReflect.Descriptor instead.
 func (*Color) Descriptor() ([]byte, []int) {
-	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{9}
+	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Color) GetR() int32 {
@@ -579,22 +717,36 @@ const file_proto_dbus_consume_cytologyprocessed_proto_rawDesc = "" +
 	"\bgeometry\x18d \x01(\v2\t.GeometryR\bgeometry\x12,\n" +
 	"\n" +
 	"properties\x18\xc8\x01 \x01(\v2\v.PropertiesR\n" +
-	"properties\"b\n" +
+	"properties\"\xca\x01\n" +
 	"\bGeometry\x12\x1e\n" +
 	"\x05point\x18d \x01(\v2\x06.PointH\x00R\x05point\x12%\n" +
-	"\apolygon\x18\xc8\x01 \x01(\v2\b.PolygonH\x00R\apolygonB\x0f\n" +
+	"\apolygon\x18\xc8\x01 \x01(\v2\b.PolygonH\x00R\apolygon\x12/\n" +
+	"\vline_string\x18\xac\x02 \x01(\v2\v.LineStringH\x00R\n" +
+	"lineString\x125\n" +
+	"\rmulti_polygon\x18\x90\x03 \x01(\v2\r.MultiPolygonH\x00R\fmultiPolygonB\x0f\n" +
 	"\rgeometry_type\"$\n" +
 	"\x05Point\x12\f\n" +
 	"\x01x\x18d \x01(\x01R\x01x\x12\r\n" +
 	"\x01y\x18\xc8\x01 \x01(\x01R\x01y\"&\n" +
 	"\aPolygon\x12\x1b\n" +
-	"\x05rings\x18d \x03(\v2\x05.RingR\x05rings\"&\n" +
+	"\x05rings\x18d \x03(\v2\x05.RingR\x05rings\"4\n" +
+	"\fMultiPolygon\x12$\n" +
+	"\bpolygons\x18d \x03(\v2\b.PolygonR\bpolygons\",\n" +
+	"\n" +
+	"LineString\x12\x1e\n" +
+	"\x06points\x18d \x03(\v2\x06.PointR\x06points\"&\n" +
 	"\x04Ring\x12\x1e\n" +
-	"\x06points\x18d \x03(\v2\x06.PointR\x06points\"c\n" +
+	"\x06points\x18d \x03(\v2\x06.PointR\x06points\"\xbe\x01\n" +
 	"\n" +
 	"Properties\x127\n" +
 	"\x0eclassification\x18d \x01(\v2\x0f.ClassificationR\x0eclassification\x12\x1c\n" +
-	"\tis_locked\x18\xc8\x01 \x01(\bR\bisLocked\"C\n" +
+	"\tis_locked\x18\xc8\x01 \x01(\bR\bisLocked\x12$\n" +
+	"\n" +
+	"confidence\x18\xac\x02 \x01(\x01H\x00R\n" +
+	"confidence\x88\x01\x01\x12\x1a\n" +
+	"\x05extra\x18\x90\x03 \x01(\tH\x01R\x05extra\x88\x01\x01B\r\n" +
+	"\v_confidenceB\b\n" +
+	"\x06_extra\"C\n" +
 	"\x0eClassification\x12\x12\n" +
 	"\x04name\x18d \x01(\tR\x04name\x12\x1d\n" +
 	"\x05color\x18\xc8\x01 \x01(\v2\x06.ColorR\x05color\"3\n" +
@@ -615,7 +767,7 @@ func file_proto_dbus_consume_cytologyprocessed_proto_rawDescGZIP() []byte {
 	return file_proto_dbus_consume_cytologyprocessed_proto_rawDescData
 }
 
-var file_proto_dbus_consume_cytologyprocessed_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_dbus_consume_cytologyprocessed_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_proto_dbus_consume_cytologyprocessed_proto_goTypes = []any{
 	(*CytologyProcessed)(nil), // 0: CytologyProcessed
 	(*FeatureCollection)(nil), // 1: FeatureCollection
@@ -623,27 +775,33 @@ var file_proto_dbus_consume_cytologyprocessed_proto_goTypes = []any{
 	(*Geometry)(nil),          // 3: Geometry
 	(*Point)(nil),             // 4: Point
 	(*Polygon)(nil),           // 5: Polygon
-	(*Ring)(nil),              // 6: Ring
-	(*Properties)(nil),        // 7: Properties
-	(*Classification)(nil),    // 8: Classification
-	(*Color)(nil),             // 9: Color
+	(*MultiPolygon)(nil),      // 6: MultiPolygon
+	(*LineString)(nil),        // 7: LineString
+	(*Ring)(nil),              // 8: Ring
+	(*Properties)(nil),        // 9: Properties
+	(*Classification)(nil),    // 10: Classification
+	(*Color)(nil),             // 11: Color
 }
 var file_proto_dbus_consume_cytologyprocessed_proto_depIdxs = []int32{
 	1,  // 0: CytologyProcessed.geojson_features:type_name -> FeatureCollection
 	2,  // 1: FeatureCollection.features:type_name -> Feature
 	3,  // 2: Feature.geometry:type_name -> Geometry
-	7,  // 3: Feature.properties:type_name -> Properties
+	9,  // 3: Feature.properties:type_name -> Properties
 	4,  // 4: Geometry.point:type_name -> Point
 	5,  // 5: Geometry.polygon:type_name -> Polygon
-	6,  // 6: Polygon.rings:type_name -> Ring
-	4,  // 7: Ring.points:type_name -> Point
-	8,  // 8: Properties.classification:type_name -> Classification
-	9,  // 9: Classification.color:type_name -> Color
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	7,  // 6: Geometry.line_string:type_name -> LineString
+	6,  // 7: Geometry.multi_polygon:type_name -> MultiPolygon
+	8,  // 8: Polygon.rings:type_name -> Ring
+	5,  // 9: MultiPolygon.polygons:type_name -> Polygon
+	4,  // 10: LineString.points:type_name -> Point
+	4,  // 11: Ring.points:type_name -> Point
+	10, // 12: Properties.classification:type_name -> Classification
+	11, // 13: Classification.color:type_name -> Color
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_proto_dbus_consume_cytologyprocessed_proto_init() }
@@ -654,14 +812,17 @@ func file_proto_dbus_consume_cytologyprocessed_proto_init() {
 	file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[3].OneofWrappers = []any{
 		(*Geometry_Point)(nil),
 		(*Geometry_Polygon)(nil),
+		(*Geometry_LineString)(nil),
+		(*Geometry_MultiPolygon)(nil),
 	}
+	file_proto_dbus_consume_cytologyprocessed_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_dbus_consume_cytologyprocessed_proto_rawDesc), len(file_proto_dbus_consume_cytologyprocessed_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -272,6 +272,61 @@ func (GroupType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_grpc_service_proto_rawDescGZIP(), []int{3}
 }
 
+type GeometryType int32
+
+const (
+	GeometryType_GEOMETRY_TYPE_UNSPECIFIED   GeometryType = 0
+	GeometryType_GEOMETRY_TYPE_POINT         GeometryType = 1
+	GeometryType_GEOMETRY_TYPE_LINE_STRING   GeometryType = 2
+	GeometryType_GEOMETRY_TYPE_POLYGON       GeometryType = 3
+	GeometryType_GEOMETRY_TYPE_MULTI_POLYGON GeometryType = 4
+)
+
+// Enum value maps for GeometryType.
+var (
+	GeometryType_name = map[int32]string{
+		0: "GEOMETRY_TYPE_UNSPECIFIED",
+		1: "GEOMETRY_TYPE_POINT",
+		2: "GEOMETRY_TYPE_LINE_STRING",
+		3: "GEOMETRY_TYPE_POLYGON",
+		4: "GEOMETRY_TYPE_MULTI_POLYGON",
+	}
+	GeometryType_value = map[string]int32{
+		"GEOMETRY_TYPE_UNSPECIFIED":   0,
+		"GEOMETRY_TYPE_POINT":         1,
+		"GEOMETRY_TYPE_LINE_STRING":   2,
+		"GEOMETRY_TYPE_POLYGON":       3,
+		"GEOMETRY_TYPE_MULTI_POLYGON": 4,
+	}
+)
+
+func (x GeometryType) Enum() *GeometryType {
+	p := new(GeometryType)
+	*p = x
+	return p
+}
+
+func (x GeometryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GeometryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[4].Descriptor()
+}
+
+func (GeometryType) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[4]
+}
+
+func (x GeometryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GeometryType.Descriptor instead.
+func (GeometryType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{4}
+}
+
 type CytologyImage struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
@@ -450,8 +505,8 @@ type CreateCytologyImageIn struct {
 	Details           *string                `protobuf:"bytes,1000,opt,name=details,proto3,oneof" json:"details,omitempty"` // JSON string
 	PrevId            *string                `protobuf:"bytes,1100,opt,name=prev_id,json=prevId,proto3,oneof" json:"prev_id,omitempty"`
 	ParentPrevId      *string                `protobuf:"bytes,1200,opt,name=parent_prev_id,json=parentPrevId,proto3,oneof" json:"parent_prev_id,omitempty"`
-	File              []byte                 `protobuf:"bytes,1300,opt,name=file,proto3,oneof" json:"file,omitempty"`                                  // файл изображения
 	ContentType       *string                `protobuf:"bytes,1400,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"` // Content-Type файла
+	ImagePath         *string                `protobuf:"bytes,1500,opt,name=image_path,json=imagePath,proto3,oneof" json:"image_path,omitempty"`       // путь к уже загруженному в S3 файлу
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -570,13 +625,6 @@ func (x *CreateCytologyImageIn) GetParentPrevId() string {
 	return ""
 }
 
-func (x *CreateCytologyImageIn) GetFile() []byte {
-	if x != nil {
-		return x.File
-	}
-	return nil
-}
-
 func (x *CreateCytologyImageIn) GetContentType() string {
 	if x != nil && x.ContentType != nil {
 		return *x.ContentType
@@ -584,6 +632,13 @@ func (x *CreateCytologyImageIn) GetContentType() string {
 	return ""
 }
 
+func (x *CreateCytologyImageIn) GetImagePath() string {
+	if x != nil && x.ImagePath != nil {
+		return *x.ImagePath
+	}
+	return ""
+}
+
 type CreateCytologyImageOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
@@ -1480,6 +1535,7 @@ type OriginalImage struct {
 	CreateDate    string                 `protobuf:"bytes,400,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
 	DelayTime     *float64               `protobuf:"fixed64,500,opt,name=delay_time,json=delayTime,proto3,oneof" json:"delay_time,omitempty"`
 	ViewedFlag    bool                   `protobuf:"varint,600,opt,name=viewed_flag,json=viewedFlag,proto3" json:"viewed_flag,omitempty"`
+	Sha256        *string                `protobuf:"bytes,700,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"` // sha256 файла в hex, не задана у загруженных до подсчета
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1556,13 +1612,20 @@ func (x *OriginalImage) GetViewedFlag() bool {
 	return false
 }
 
+func (x *OriginalImage) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
 type CreateOriginalImageIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CytologyId    string                 `protobuf:"bytes,100,opt,name=cytology_id,json=cytologyId,proto3" json:"cytology_id,omitempty"`
-	File          []byte                 `protobuf:"bytes,200,opt,name=file,proto3" json:"file,omitempty"`
 	ContentType   string                 `protobuf:"bytes,300,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	DelayTime     *float64               `protobuf:"fixed64,400,opt,name=delay_time,json=delayTime,proto3,oneof" json:"delay_time,omitempty"`
-	ImagePath     *string                `protobuf:"bytes,500,opt,name=image_path,json=imagePath,proto3,oneof" json:"image_path,omitempty"` // путь к файлу в S3 (если файл уже загружен)
+	ImagePath     *string                `protobuf:"bytes,500,opt,name=image_path,json=imagePath,proto3,oneof" json:"image_path,omitempty"` // путь к уже загруженному в S3 файлу, обязателен
+	Sha256        *string                `protobuf:"bytes,600,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`                        // sha256 файла в hex, по ней ищутся повторные загрузки пациента
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1604,13 +1667,6 @@ func (x *CreateOriginalImageIn) GetCytologyId() string {
 	return ""
 }
 
-func (x *CreateOriginalImageIn) GetFile() []byte {
-	if x != nil {
-		return x.File
-	}
-	return nil
-}
-
 func (x *CreateOriginalImageIn) GetContentType() string {
 	if x != nil {
 		return x.ContentType
@@ -1632,9 +1688,17 @@ func (x *CreateOriginalImageIn) GetImagePath() string {
 	return ""
 }
 
+func (x *CreateOriginalImageIn) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
 type CreateOriginalImageOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	DuplicateOf   []string               `protobuf:"bytes,200,rep,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"` // ранее загруженные изображения пациента с тем же файлом
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1676,6 +1740,13 @@ func (x *CreateOriginalImageOut) GetId() string {
 	return ""
 }
 
+func (x *CreateOriginalImageOut) GetDuplicateOf() []string {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return nil
+}
+
 type GetOriginalImageByIdIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
@@ -1956,6 +2027,162 @@ func (x *UpdateOriginalImageOut) GetOriginalImage() *OriginalImage {
 	return nil
 }
 
+type VerifyOriginalImageIntegrityIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyOriginalImageIntegrityIn) Reset() {
+	*x = VerifyOriginalImageIntegrityIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyOriginalImageIntegrityIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyOriginalImageIntegrityIn) ProtoMessage() {}
+
+func (x *VerifyOriginalImageIntegrityIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyOriginalImageIntegrityIn.ProtoReflect.Descriptor instead.
+func (*VerifyOriginalImageIntegrityIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{29}
+}
+
+type OriginalImageIntegrityMismatch struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OriginalImageId string                 `protobuf:"bytes,100,opt,name=original_image_id,json=originalImageId,proto3" json:"original_image_id,omitempty"`
+	Expected        string                 `protobuf:"bytes,200,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual          string                 `protobuf:"bytes,300,opt,name=actual,proto3" json:"actual,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OriginalImageIntegrityMismatch) Reset() {
+	*x = OriginalImageIntegrityMismatch{}
+	mi := &file_proto_grpc_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OriginalImageIntegrityMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginalImageIntegrityMismatch) ProtoMessage() {}
+
+func (x *OriginalImageIntegrityMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginalImageIntegrityMismatch.ProtoReflect.Descriptor instead.
+func (*OriginalImageIntegrityMismatch) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *OriginalImageIntegrityMismatch) GetOriginalImageId() string {
+	if x != nil {
+		return x.OriginalImageId
+	}
+	return ""
+}
+
+func (x *OriginalImageIntegrityMismatch) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *OriginalImageIntegrityMismatch) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+type VerifyOriginalImageIntegrityOut struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Checked       int64                             `protobuf:"varint,100,opt,name=checked,proto3" json:"checked,omitempty"` // число изображений с сохраненной sha256
+	Missing       []string                          `protobuf:"bytes,200,rep,name=missing,proto3" json:"missing,omitempty"`  // изображения, файл которых отсутствует в S3
+	Mismatches    []*OriginalImageIntegrityMismatch `protobuf:"bytes,300,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyOriginalImageIntegrityOut) Reset() {
+	*x = VerifyOriginalImageIntegrityOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyOriginalImageIntegrityOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyOriginalImageIntegrityOut) ProtoMessage() {}
+
+func (x *VerifyOriginalImageIntegrityOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyOriginalImageIntegrityOut.ProtoReflect.Descriptor instead.
+func (*VerifyOriginalImageIntegrityOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VerifyOriginalImageIntegrityOut) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *VerifyOriginalImageIntegrityOut) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *VerifyOriginalImageIntegrityOut) GetMismatches() []*OriginalImageIntegrityMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
 type SegmentationGroup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
@@ -1971,7 +2198,7 @@ type SegmentationGroup struct {
 
 func (x *SegmentationGroup) Reset() {
 	*x = SegmentationGroup{}
-	mi := &file_proto_grpc_service_proto_msgTypes[29]
+	mi := &file_proto_grpc_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1983,7 +2210,7 @@ func (x *SegmentationGroup) String() string {
 func (*SegmentationGroup) ProtoMessage() {}
 
 func (x *SegmentationGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[29]
+	mi := &file_proto_grpc_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1996,7 +2223,7 @@ func (x *SegmentationGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SegmentationGroup.ProtoReflect.Descriptor instead.
 func (*SegmentationGroup) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{29}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SegmentationGroup) GetId() int32 {
@@ -2061,7 +2288,7 @@ type CreateSegmentationGroupIn struct {
 
 func (x *CreateSegmentationGroupIn) Reset() {
 	*x = CreateSegmentationGroupIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[30]
+	mi := &file_proto_grpc_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2073,7 +2300,7 @@ func (x *CreateSegmentationGroupIn) String() string {
 func (*CreateSegmentationGroupIn) ProtoMessage() {}
 
 func (x *CreateSegmentationGroupIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[30]
+	mi := &file_proto_grpc_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2086,7 +2313,7 @@ func (x *CreateSegmentationGroupIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSegmentationGroupIn.ProtoReflect.Descriptor instead.
 func (*CreateSegmentationGroupIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{30}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CreateSegmentationGroupIn) GetCytologyId() string {
@@ -2133,7 +2360,7 @@ type CreateSegmentationGroupOut struct {
 
 func (x *CreateSegmentationGroupOut) Reset() {
 	*x = CreateSegmentationGroupOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[31]
+	mi := &file_proto_grpc_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2145,7 +2372,7 @@ func (x *CreateSegmentationGroupOut) String() string {
 func (*CreateSegmentationGroupOut) ProtoMessage() {}
 
 func (x *CreateSegmentationGroupOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[31]
+	mi := &file_proto_grpc_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,7 +2385,7 @@ func (x *CreateSegmentationGroupOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSegmentationGroupOut.ProtoReflect.Descriptor instead.
 func (*CreateSegmentationGroupOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{31}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CreateSegmentationGroupOut) GetId() int32 {
@@ -2180,7 +2407,7 @@ type GetSegmentationGroupsByCytologyIdIn struct {
 
 func (x *GetSegmentationGroupsByCytologyIdIn) Reset() {
 	*x = GetSegmentationGroupsByCytologyIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[32]
+	mi := &file_proto_grpc_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2192,7 +2419,7 @@ func (x *GetSegmentationGroupsByCytologyIdIn) String() string {
 func (*GetSegmentationGroupsByCytologyIdIn) ProtoMessage() {}
 
 func (x *GetSegmentationGroupsByCytologyIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[32]
+	mi := &file_proto_grpc_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2205,7 +2432,7 @@ func (x *GetSegmentationGroupsByCytologyIdIn) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetSegmentationGroupsByCytologyIdIn.ProtoReflect.Descriptor instead.
 func (*GetSegmentationGroupsByCytologyIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{32}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetSegmentationGroupsByCytologyIdIn) GetCytologyId() string {
@@ -2245,7 +2472,7 @@ type GetSegmentationGroupsByCytologyIdOut struct {
 
 func (x *GetSegmentationGroupsByCytologyIdOut) Reset() {
 	*x = GetSegmentationGroupsByCytologyIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[33]
+	mi := &file_proto_grpc_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2257,7 +2484,7 @@ func (x *GetSegmentationGroupsByCytologyIdOut) String() string {
 func (*GetSegmentationGroupsByCytologyIdOut) ProtoMessage() {}
 
 func (x *GetSegmentationGroupsByCytologyIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[33]
+	mi := &file_proto_grpc_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,7 +2497,7 @@ func (x *GetSegmentationGroupsByCytologyIdOut) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetSegmentationGroupsByCytologyIdOut.ProtoReflect.Descriptor instead.
 func (*GetSegmentationGroupsByCytologyIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{33}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetSegmentationGroupsByCytologyIdOut) GetSegmentationGroups() []*SegmentationGroup {
@@ -2291,7 +2518,7 @@ type UpdateSegmentationGroupIn struct {
 
 func (x *UpdateSegmentationGroupIn) Reset() {
 	*x = UpdateSegmentationGroupIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[34]
+	mi := &file_proto_grpc_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2303,7 +2530,7 @@ func (x *UpdateSegmentationGroupIn) String() string {
 func (*UpdateSegmentationGroupIn) ProtoMessage() {}
 
 func (x *UpdateSegmentationGroupIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[34]
+	mi := &file_proto_grpc_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,7 +2543,7 @@ func (x *UpdateSegmentationGroupIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSegmentationGroupIn.ProtoReflect.Descriptor instead.
 func (*UpdateSegmentationGroupIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{34}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UpdateSegmentationGroupIn) GetId() int32 {
@@ -2349,7 +2576,7 @@ type UpdateSegmentationGroupOut struct {
 
 func (x *UpdateSegmentationGroupOut) Reset() {
 	*x = UpdateSegmentationGroupOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[35]
+	mi := &file_proto_grpc_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2361,7 +2588,7 @@ func (x *UpdateSegmentationGroupOut) String() string {
 func (*UpdateSegmentationGroupOut) ProtoMessage() {}
 
 func (x *UpdateSegmentationGroupOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[35]
+	mi := &file_proto_grpc_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2374,7 +2601,7 @@ func (x *UpdateSegmentationGroupOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSegmentationGroupOut.ProtoReflect.Descriptor instead.
 func (*UpdateSegmentationGroupOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{35}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UpdateSegmentationGroupOut) GetSegmentationGroup() *SegmentationGroup {
@@ -2393,7 +2620,7 @@ type DeleteSegmentationGroupIn struct {
 
 func (x *DeleteSegmentationGroupIn) Reset() {
 	*x = DeleteSegmentationGroupIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[36]
+	mi := &file_proto_grpc_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2405,7 +2632,7 @@ func (x *DeleteSegmentationGroupIn) String() string {
 func (*DeleteSegmentationGroupIn) ProtoMessage() {}
 
 func (x *DeleteSegmentationGroupIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[36]
+	mi := &file_proto_grpc_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +2645,7 @@ func (x *DeleteSegmentationGroupIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSegmentationGroupIn.ProtoReflect.Descriptor instead.
 func (*DeleteSegmentationGroupIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{36}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DeleteSegmentationGroupIn) GetId() int32 {
@@ -2428,32 +2655,30 @@ func (x *DeleteSegmentationGroupIn) GetId() int32 {
 	return 0
 }
 
-type SegmentationPoint struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int32                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
-	SegmentationId int32                  `protobuf:"varint,200,opt,name=segmentation_id,json=segmentationId,proto3" json:"segmentation_id,omitempty"`
-	X              int32                  `protobuf:"varint,300,opt,name=x,proto3" json:"x,omitempty"`
-	Y              int32                  `protobuf:"varint,400,opt,name=y,proto3" json:"y,omitempty"`
-	Uid            int64                  `protobuf:"varint,500,opt,name=uid,proto3" json:"uid,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type Color struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	R             int32                  `protobuf:"varint,100,opt,name=r,proto3" json:"r,omitempty"`
+	G             int32                  `protobuf:"varint,200,opt,name=g,proto3" json:"g,omitempty"`
+	B             int32                  `protobuf:"varint,300,opt,name=b,proto3" json:"b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SegmentationPoint) Reset() {
-	*x = SegmentationPoint{}
-	mi := &file_proto_grpc_service_proto_msgTypes[37]
+func (x *Color) Reset() {
+	*x = Color{}
+	mi := &file_proto_grpc_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SegmentationPoint) String() string {
+func (x *Color) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SegmentationPoint) ProtoMessage() {}
+func (*Color) ProtoMessage() {}
 
-func (x *SegmentationPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[37]
+func (x *Color) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2464,59 +2689,145 @@ func (x *SegmentationPoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SegmentationPoint.ProtoReflect.Descriptor instead.
-func (*SegmentationPoint) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *SegmentationPoint) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use Color.ProtoReflect.Descriptor instead.
+func (*Color) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SegmentationPoint) GetSegmentationId() int32 {
+func (x *Color) GetR() int32 {
 	if x != nil {
-		return x.SegmentationId
+		return x.R
 	}
 	return 0
 }
 
-func (x *SegmentationPoint) GetX() int32 {
+func (x *Color) GetG() int32 {
 	if x != nil {
-		return x.X
+		return x.G
 	}
 	return 0
 }
 
-func (x *SegmentationPoint) GetY() int32 {
+func (x *Color) GetB() int32 {
 	if x != nil {
-		return x.Y
+		return x.B
 	}
 	return 0
 }
 
-func (x *SegmentationPoint) GetUid() int64 {
-	if x != nil {
-		return x.Uid
-	}
-	return 0
-}
+type SegmentationPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	SegmentationId int32                  `protobuf:"varint,200,opt,name=segmentation_id,json=segmentationId,proto3" json:"segmentation_id,omitempty"`
+	X              int32                  `protobuf:"varint,300,opt,name=x,proto3" json:"x,omitempty"`
+	Y              int32                  `protobuf:"varint,400,opt,name=y,proto3" json:"y,omitempty"`
+	Uid            int64                  `protobuf:"varint,500,opt,name=uid,proto3" json:"uid,omitempty"`
+	// номер полигона в MultiPolygon
+	Polygon int32 `protobuf:"varint,600,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	// номер кольца в полигоне, 0 - внешний контур, остальные - дыры
+	Ring          int32 `protobuf:"varint,700,opt,name=ring,proto3" json:"ring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentationPoint) Reset() {
+	*x = SegmentationPoint{}
+	mi := &file_proto_grpc_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentationPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentationPoint) ProtoMessage() {}
+
+func (x *SegmentationPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentationPoint.ProtoReflect.Descriptor instead.
+func (*SegmentationPoint) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SegmentationPoint) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SegmentationPoint) GetSegmentationId() int32 {
+	if x != nil {
+		return x.SegmentationId
+	}
+	return 0
+}
+
+func (x *SegmentationPoint) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SegmentationPoint) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SegmentationPoint) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SegmentationPoint) GetPolygon() int32 {
+	if x != nil {
+		return x.Polygon
+	}
+	return 0
+}
+
+func (x *SegmentationPoint) GetRing() int32 {
+	if x != nil {
+		return x.Ring
+	}
+	return 0
+}
 
 type Segmentation struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Id                  int32                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
 	SegmentationGroupId int32                  `protobuf:"varint,200,opt,name=segmentation_group_id,json=segmentationGroupId,proto3" json:"segmentation_group_id,omitempty"`
-	Points              []*SegmentationPoint   `protobuf:"bytes,300,rep,name=points,proto3" json:"points,omitempty"`
-	CreateAt            string                 `protobuf:"bytes,400,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// точки всех колец всех полигонов по порядку
+	Points        []*SegmentationPoint `protobuf:"bytes,300,rep,name=points,proto3" json:"points,omitempty"`
+	CreateAt      string               `protobuf:"bytes,400,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	GeometryType  GeometryType         `protobuf:"varint,500,opt,name=geometry_type,json=geometryType,proto3,enum=GeometryType" json:"geometry_type,omitempty"`
+	Color         *Color               `protobuf:"bytes,600,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	IsLocked      bool                 `protobuf:"varint,700,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
+	Confidence    *float64             `protobuf:"fixed64,800,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"`
+	Properties    *string              `protobuf:"bytes,900,opt,name=properties,proto3,oneof" json:"properties,omitempty"` // JSON object
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Segmentation) Reset() {
 	*x = Segmentation{}
-	mi := &file_proto_grpc_service_proto_msgTypes[38]
+	mi := &file_proto_grpc_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2528,7 +2839,7 @@ func (x *Segmentation) String() string {
 func (*Segmentation) ProtoMessage() {}
 
 func (x *Segmentation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[38]
+	mi := &file_proto_grpc_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2541,7 +2852,7 @@ func (x *Segmentation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Segmentation.ProtoReflect.Descriptor instead.
 func (*Segmentation) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{38}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *Segmentation) GetId() int32 {
@@ -2572,17 +2883,58 @@ func (x *Segmentation) GetCreateAt() string {
 	return ""
 }
 
+func (x *Segmentation) GetGeometryType() GeometryType {
+	if x != nil {
+		return x.GeometryType
+	}
+	return GeometryType_GEOMETRY_TYPE_UNSPECIFIED
+}
+
+func (x *Segmentation) GetColor() *Color {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *Segmentation) GetIsLocked() bool {
+	if x != nil {
+		return x.IsLocked
+	}
+	return false
+}
+
+func (x *Segmentation) GetConfidence() float64 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *Segmentation) GetProperties() string {
+	if x != nil && x.Properties != nil {
+		return *x.Properties
+	}
+	return ""
+}
+
 type CreateSegmentationIn struct {
 	state               protoimpl.MessageState     `protogen:"open.v1"`
 	SegmentationGroupId int32                      `protobuf:"varint,100,opt,name=segmentation_group_id,json=segmentationGroupId,proto3" json:"segmentation_group_id,omitempty"`
 	Points              []*SegmentationPointCreate `protobuf:"bytes,200,rep,name=points,proto3" json:"points,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// не передан - определяется по точкам
+	GeometryType  GeometryType `protobuf:"varint,300,opt,name=geometry_type,json=geometryType,proto3,enum=GeometryType" json:"geometry_type,omitempty"`
+	Color         *Color       `protobuf:"bytes,400,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	IsLocked      bool         `protobuf:"varint,500,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
+	Confidence    *float64     `protobuf:"fixed64,600,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"`
+	Properties    *string      `protobuf:"bytes,700,opt,name=properties,proto3,oneof" json:"properties,omitempty"` // JSON object
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSegmentationIn) Reset() {
 	*x = CreateSegmentationIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[39]
+	mi := &file_proto_grpc_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2594,7 +2946,7 @@ func (x *CreateSegmentationIn) String() string {
 func (*CreateSegmentationIn) ProtoMessage() {}
 
 func (x *CreateSegmentationIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[39]
+	mi := &file_proto_grpc_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2607,7 +2959,7 @@ func (x *CreateSegmentationIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSegmentationIn.ProtoReflect.Descriptor instead.
 func (*CreateSegmentationIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{39}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *CreateSegmentationIn) GetSegmentationGroupId() int32 {
@@ -2624,17 +2976,54 @@ func (x *CreateSegmentationIn) GetPoints() []*SegmentationPointCreate {
 	return nil
 }
 
+func (x *CreateSegmentationIn) GetGeometryType() GeometryType {
+	if x != nil {
+		return x.GeometryType
+	}
+	return GeometryType_GEOMETRY_TYPE_UNSPECIFIED
+}
+
+func (x *CreateSegmentationIn) GetColor() *Color {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *CreateSegmentationIn) GetIsLocked() bool {
+	if x != nil {
+		return x.IsLocked
+	}
+	return false
+}
+
+func (x *CreateSegmentationIn) GetConfidence() float64 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *CreateSegmentationIn) GetProperties() string {
+	if x != nil && x.Properties != nil {
+		return *x.Properties
+	}
+	return ""
+}
+
 type SegmentationPointCreate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             int32                  `protobuf:"varint,100,opt,name=x,proto3" json:"x,omitempty"`
 	Y             int32                  `protobuf:"varint,200,opt,name=y,proto3" json:"y,omitempty"`
+	Polygon       int32                  `protobuf:"varint,300,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Ring          int32                  `protobuf:"varint,400,opt,name=ring,proto3" json:"ring,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SegmentationPointCreate) Reset() {
 	*x = SegmentationPointCreate{}
-	mi := &file_proto_grpc_service_proto_msgTypes[40]
+	mi := &file_proto_grpc_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2646,7 +3035,7 @@ func (x *SegmentationPointCreate) String() string {
 func (*SegmentationPointCreate) ProtoMessage() {}
 
 func (x *SegmentationPointCreate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[40]
+	mi := &file_proto_grpc_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2659,7 +3048,7 @@ func (x *SegmentationPointCreate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SegmentationPointCreate.ProtoReflect.Descriptor instead.
 func (*SegmentationPointCreate) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{40}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SegmentationPointCreate) GetX() int32 {
@@ -2676,6 +3065,20 @@ func (x *SegmentationPointCreate) GetY() int32 {
 	return 0
 }
 
+func (x *SegmentationPointCreate) GetPolygon() int32 {
+	if x != nil {
+		return x.Polygon
+	}
+	return 0
+}
+
+func (x *SegmentationPointCreate) GetRing() int32 {
+	if x != nil {
+		return x.Ring
+	}
+	return 0
+}
+
 type CreateSegmentationOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
@@ -2685,19 +3088,414 @@ type CreateSegmentationOut struct {
 
 func (x *CreateSegmentationOut) Reset() {
 	*x = CreateSegmentationOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[41]
+	mi := &file_proto_grpc_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSegmentationOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSegmentationOut) ProtoMessage() {}
+
+func (x *CreateSegmentationOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSegmentationOut.ProtoReflect.Descriptor instead.
+func (*CreateSegmentationOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateSegmentationOut) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSegmentationByIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentationByIdIn) Reset() {
+	*x = GetSegmentationByIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentationByIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentationByIdIn) ProtoMessage() {}
+
+func (x *GetSegmentationByIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentationByIdIn.ProtoReflect.Descriptor instead.
+func (*GetSegmentationByIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetSegmentationByIdIn) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSegmentationByIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segmentation  *Segmentation          `protobuf:"bytes,100,opt,name=segmentation,proto3" json:"segmentation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentationByIdOut) Reset() {
+	*x = GetSegmentationByIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentationByIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentationByIdOut) ProtoMessage() {}
+
+func (x *GetSegmentationByIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentationByIdOut.ProtoReflect.Descriptor instead.
+func (*GetSegmentationByIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSegmentationByIdOut) GetSegmentation() *Segmentation {
+	if x != nil {
+		return x.Segmentation
+	}
+	return nil
+}
+
+type GetSegmentsByGroupIdIn struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SegmentationGroupId int32                  `protobuf:"varint,100,opt,name=segmentation_group_id,json=segmentationGroupId,proto3" json:"segmentation_group_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetSegmentsByGroupIdIn) Reset() {
+	*x = GetSegmentsByGroupIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentsByGroupIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentsByGroupIdIn) ProtoMessage() {}
+
+func (x *GetSegmentsByGroupIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentsByGroupIdIn.ProtoReflect.Descriptor instead.
+func (*GetSegmentsByGroupIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetSegmentsByGroupIdIn) GetSegmentationGroupId() int32 {
+	if x != nil {
+		return x.SegmentationGroupId
+	}
+	return 0
+}
+
+type GetSegmentsByGroupIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segmentations []*Segmentation        `protobuf:"bytes,100,rep,name=segmentations,proto3" json:"segmentations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentsByGroupIdOut) Reset() {
+	*x = GetSegmentsByGroupIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentsByGroupIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentsByGroupIdOut) ProtoMessage() {}
+
+func (x *GetSegmentsByGroupIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentsByGroupIdOut.ProtoReflect.Descriptor instead.
+func (*GetSegmentsByGroupIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetSegmentsByGroupIdOut) GetSegmentations() []*Segmentation {
+	if x != nil {
+		return x.Segmentations
+	}
+	return nil
+}
+
+// прямоугольник в пикселях нулевого уровня, границы включительно
+type BBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinX          int32                  `protobuf:"varint,100,opt,name=min_x,json=minX,proto3" json:"min_x,omitempty"`
+	MinY          int32                  `protobuf:"varint,200,opt,name=min_y,json=minY,proto3" json:"min_y,omitempty"`
+	MaxX          int32                  `protobuf:"varint,300,opt,name=max_x,json=maxX,proto3" json:"max_x,omitempty"`
+	MaxY          int32                  `protobuf:"varint,400,opt,name=max_y,json=maxY,proto3" json:"max_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BBox) Reset() {
+	*x = BBox{}
+	mi := &file_proto_grpc_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BBox) ProtoMessage() {}
+
+func (x *BBox) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BBox.ProtoReflect.Descriptor instead.
+func (*BBox) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BBox) GetMinX() int32 {
+	if x != nil {
+		return x.MinX
+	}
+	return 0
+}
+
+func (x *BBox) GetMinY() int32 {
+	if x != nil {
+		return x.MinY
+	}
+	return 0
+}
+
+func (x *BBox) GetMaxX() int32 {
+	if x != nil {
+		return x.MaxX
+	}
+	return 0
+}
+
+func (x *BBox) GetMaxY() int32 {
+	if x != nil {
+		return x.MaxY
+	}
+	return 0
+}
+
+// сегментации, ограничивающий прямоугольник которых пересекается с bbox, по возрастанию id
+type GetSegmentsInViewportIn struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CytologyId string                 `protobuf:"bytes,100,opt,name=cytology_id,json=cytologyId,proto3" json:"cytology_id,omitempty"`
+	Bbox       *BBox                  `protobuf:"bytes,200,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	SegType    *SegType               `protobuf:"varint,300,opt,name=seg_type,json=segType,proto3,enum=SegType,oneof" json:"seg_type,omitempty"`
+	GroupType  *GroupType             `protobuf:"varint,400,opt,name=group_type,json=groupType,proto3,enum=GroupType,oneof" json:"group_type,omitempty"`
+	IsAi       *bool                  `protobuf:"varint,500,opt,name=is_ai,json=isAi,proto3,oneof" json:"is_ai,omitempty"`
+	// пикселей нулевого уровня в пикселе экрана, больше 1 - контуры упрощаются
+	Downsample float64 `protobuf:"fixed64,600,opt,name=downsample,proto3" json:"downsample,omitempty"`
+	// next_cursor из предыдущей страницы
+	Cursor *string `protobuf:"bytes,700,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// 0 - размер страницы по умолчанию
+	Limit         int32 `protobuf:"varint,800,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentsInViewportIn) Reset() {
+	*x = GetSegmentsInViewportIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentsInViewportIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentsInViewportIn) ProtoMessage() {}
+
+func (x *GetSegmentsInViewportIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentsInViewportIn.ProtoReflect.Descriptor instead.
+func (*GetSegmentsInViewportIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetSegmentsInViewportIn) GetCytologyId() string {
+	if x != nil {
+		return x.CytologyId
+	}
+	return ""
+}
+
+func (x *GetSegmentsInViewportIn) GetBbox() *BBox {
+	if x != nil {
+		return x.Bbox
+	}
+	return nil
+}
+
+func (x *GetSegmentsInViewportIn) GetSegType() SegType {
+	if x != nil && x.SegType != nil {
+		return *x.SegType
+	}
+	return SegType_SEG_TYPE_UNSPECIFIED
+}
+
+func (x *GetSegmentsInViewportIn) GetGroupType() GroupType {
+	if x != nil && x.GroupType != nil {
+		return *x.GroupType
+	}
+	return GroupType_GROUP_TYPE_UNSPECIFIED
+}
+
+func (x *GetSegmentsInViewportIn) GetIsAi() bool {
+	if x != nil && x.IsAi != nil {
+		return *x.IsAi
+	}
+	return false
+}
+
+func (x *GetSegmentsInViewportIn) GetDownsample() float64 {
+	if x != nil {
+		return x.Downsample
+	}
+	return 0
+}
+
+func (x *GetSegmentsInViewportIn) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *GetSegmentsInViewportIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSegmentsInViewportOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segmentations []*Segmentation        `protobuf:"bytes,100,rep,name=segmentations,proto3" json:"segmentations,omitempty"`
+	// отсутствует, если страница последняя
+	NextCursor    *string `protobuf:"bytes,200,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentsInViewportOut) Reset() {
+	*x = GetSegmentsInViewportOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSegmentationOut) String() string {
+func (x *GetSegmentsInViewportOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSegmentationOut) ProtoMessage() {}
+func (*GetSegmentsInViewportOut) ProtoMessage() {}
 
-func (x *CreateSegmentationOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[41]
+func (x *GetSegmentsInViewportOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2708,40 +3506,54 @@ func (x *CreateSegmentationOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSegmentationOut.ProtoReflect.Descriptor instead.
-func (*CreateSegmentationOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetSegmentsInViewportOut.ProtoReflect.Descriptor instead.
+func (*GetSegmentsInViewportOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreateSegmentationOut) GetId() int32 {
+func (x *GetSegmentsInViewportOut) GetSegmentations() []*Segmentation {
 	if x != nil {
-		return x.Id
+		return x.Segmentations
 	}
-	return 0
+	return nil
 }
 
-type GetSegmentationByIdIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+func (x *GetSegmentsInViewportOut) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// точки заменяются целиком, метаданные - только переданные
+type UpdateSegmentationIn struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Id            int32                      `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	Points        []*SegmentationPointCreate `protobuf:"bytes,200,rep,name=points,proto3" json:"points,omitempty"`
+	GeometryType  *GeometryType              `protobuf:"varint,300,opt,name=geometry_type,json=geometryType,proto3,enum=GeometryType,oneof" json:"geometry_type,omitempty"`
+	Color         *Color                     `protobuf:"bytes,400,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	IsLocked      *bool                      `protobuf:"varint,500,opt,name=is_locked,json=isLocked,proto3,oneof" json:"is_locked,omitempty"`
+	Confidence    *float64                   `protobuf:"fixed64,600,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"`
+	Properties    *string                    `protobuf:"bytes,700,opt,name=properties,proto3,oneof" json:"properties,omitempty"` // JSON object
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSegmentationByIdIn) Reset() {
-	*x = GetSegmentationByIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[42]
+func (x *UpdateSegmentationIn) Reset() {
+	*x = UpdateSegmentationIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSegmentationByIdIn) String() string {
+func (x *UpdateSegmentationIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentationByIdIn) ProtoMessage() {}
+func (*UpdateSegmentationIn) ProtoMessage() {}
 
-func (x *GetSegmentationByIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[42]
+func (x *UpdateSegmentationIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2752,40 +3564,82 @@ func (x *GetSegmentationByIdIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentationByIdIn.ProtoReflect.Descriptor instead.
-func (*GetSegmentationByIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use UpdateSegmentationIn.ProtoReflect.Descriptor instead.
+func (*UpdateSegmentationIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetSegmentationByIdIn) GetId() int32 {
+func (x *UpdateSegmentationIn) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetSegmentationByIdOut struct {
+func (x *UpdateSegmentationIn) GetPoints() []*SegmentationPointCreate {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *UpdateSegmentationIn) GetGeometryType() GeometryType {
+	if x != nil && x.GeometryType != nil {
+		return *x.GeometryType
+	}
+	return GeometryType_GEOMETRY_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateSegmentationIn) GetColor() *Color {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *UpdateSegmentationIn) GetIsLocked() bool {
+	if x != nil && x.IsLocked != nil {
+		return *x.IsLocked
+	}
+	return false
+}
+
+func (x *UpdateSegmentationIn) GetConfidence() float64 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *UpdateSegmentationIn) GetProperties() string {
+	if x != nil && x.Properties != nil {
+		return *x.Properties
+	}
+	return ""
+}
+
+type UpdateSegmentationOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Segmentation  *Segmentation          `protobuf:"bytes,100,opt,name=segmentation,proto3" json:"segmentation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSegmentationByIdOut) Reset() {
-	*x = GetSegmentationByIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[43]
+func (x *UpdateSegmentationOut) Reset() {
+	*x = UpdateSegmentationOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSegmentationByIdOut) String() string {
+func (x *UpdateSegmentationOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentationByIdOut) ProtoMessage() {}
+func (*UpdateSegmentationOut) ProtoMessage() {}
 
-func (x *GetSegmentationByIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[43]
+func (x *UpdateSegmentationOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2796,40 +3650,40 @@ func (x *GetSegmentationByIdOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentationByIdOut.ProtoReflect.Descriptor instead.
-func (*GetSegmentationByIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use UpdateSegmentationOut.ProtoReflect.Descriptor instead.
+func (*UpdateSegmentationOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetSegmentationByIdOut) GetSegmentation() *Segmentation {
+func (x *UpdateSegmentationOut) GetSegmentation() *Segmentation {
 	if x != nil {
 		return x.Segmentation
 	}
 	return nil
 }
 
-type GetSegmentsByGroupIdIn struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	SegmentationGroupId int32                  `protobuf:"varint,100,opt,name=segmentation_group_id,json=segmentationGroupId,proto3" json:"segmentation_group_id,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type DeleteSegmentationIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSegmentsByGroupIdIn) Reset() {
-	*x = GetSegmentsByGroupIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[44]
+func (x *DeleteSegmentationIn) Reset() {
+	*x = DeleteSegmentationIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSegmentsByGroupIdIn) String() string {
+func (x *DeleteSegmentationIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentsByGroupIdIn) ProtoMessage() {}
+func (*DeleteSegmentationIn) ProtoMessage() {}
 
-func (x *GetSegmentsByGroupIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[44]
+func (x *DeleteSegmentationIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2840,40 +3694,40 @@ func (x *GetSegmentsByGroupIdIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentsByGroupIdIn.ProtoReflect.Descriptor instead.
-func (*GetSegmentsByGroupIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use DeleteSegmentationIn.ProtoReflect.Descriptor instead.
+func (*DeleteSegmentationIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetSegmentsByGroupIdIn) GetSegmentationGroupId() int32 {
+func (x *DeleteSegmentationIn) GetId() int32 {
 	if x != nil {
-		return x.SegmentationGroupId
+		return x.Id
 	}
 	return 0
 }
 
-type GetSegmentsByGroupIdOut struct {
+type ExportQuPathGeoJsonIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Segmentations []*Segmentation        `protobuf:"bytes,100,rep,name=segmentations,proto3" json:"segmentations,omitempty"`
+	CytologyId    string                 `protobuf:"bytes,100,opt,name=cytology_id,json=cytologyId,proto3" json:"cytology_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSegmentsByGroupIdOut) Reset() {
-	*x = GetSegmentsByGroupIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[45]
+func (x *ExportQuPathGeoJsonIn) Reset() {
+	*x = ExportQuPathGeoJsonIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSegmentsByGroupIdOut) String() string {
+func (x *ExportQuPathGeoJsonIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentsByGroupIdOut) ProtoMessage() {}
+func (*ExportQuPathGeoJsonIn) ProtoMessage() {}
 
-func (x *GetSegmentsByGroupIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[45]
+func (x *ExportQuPathGeoJsonIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2884,41 +3738,41 @@ func (x *GetSegmentsByGroupIdOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentsByGroupIdOut.ProtoReflect.Descriptor instead.
-func (*GetSegmentsByGroupIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ExportQuPathGeoJsonIn.ProtoReflect.Descriptor instead.
+func (*ExportQuPathGeoJsonIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetSegmentsByGroupIdOut) GetSegmentations() []*Segmentation {
+func (x *ExportQuPathGeoJsonIn) GetCytologyId() string {
 	if x != nil {
-		return x.Segmentations
+		return x.CytologyId
 	}
-	return nil
+	return ""
 }
 
-type UpdateSegmentationIn struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Id            int32                      `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
-	Points        []*SegmentationPointCreate `protobuf:"bytes,200,rep,name=points,proto3" json:"points,omitempty"`
+type ExportQuPathGeoJsonOut struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// FeatureCollection, классы и цвета в properties.classification
+	Geojson       string `protobuf:"bytes,100,opt,name=geojson,proto3" json:"geojson,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSegmentationIn) Reset() {
-	*x = UpdateSegmentationIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[46]
+func (x *ExportQuPathGeoJsonOut) Reset() {
+	*x = ExportQuPathGeoJsonOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSegmentationIn) String() string {
+func (x *ExportQuPathGeoJsonOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSegmentationIn) ProtoMessage() {}
+func (*ExportQuPathGeoJsonOut) ProtoMessage() {}
 
-func (x *UpdateSegmentationIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[46]
+func (x *ExportQuPathGeoJsonOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2929,47 +3783,43 @@ func (x *UpdateSegmentationIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSegmentationIn.ProtoReflect.Descriptor instead.
-func (*UpdateSegmentationIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *UpdateSegmentationIn) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ExportQuPathGeoJsonOut.ProtoReflect.Descriptor instead.
+func (*ExportQuPathGeoJsonOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UpdateSegmentationIn) GetPoints() []*SegmentationPointCreate {
+func (x *ExportQuPathGeoJsonOut) GetGeojson() string {
 	if x != nil {
-		return x.Points
+		return x.Geojson
 	}
-	return nil
+	return ""
 }
 
-type UpdateSegmentationOut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Segmentation  *Segmentation          `protobuf:"bytes,100,opt,name=segmentation,proto3" json:"segmentation,omitempty"`
+// импорт создает новую версию исследования через копирование
+type ImportQuPathGeoJsonIn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// версия исследования, из которой сделан экспорт
+	CytologyId    string `protobuf:"bytes,100,opt,name=cytology_id,json=cytologyId,proto3" json:"cytology_id,omitempty"`
+	Geojson       string `protobuf:"bytes,200,opt,name=geojson,proto3" json:"geojson,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSegmentationOut) Reset() {
-	*x = UpdateSegmentationOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[47]
+func (x *ImportQuPathGeoJsonIn) Reset() {
+	*x = ImportQuPathGeoJsonIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSegmentationOut) String() string {
+func (x *ImportQuPathGeoJsonIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSegmentationOut) ProtoMessage() {}
+func (*ImportQuPathGeoJsonIn) ProtoMessage() {}
 
-func (x *UpdateSegmentationOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[47]
+func (x *ImportQuPathGeoJsonIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2980,40 +3830,55 @@ func (x *UpdateSegmentationOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSegmentationOut.ProtoReflect.Descriptor instead.
-func (*UpdateSegmentationOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ImportQuPathGeoJsonIn.ProtoReflect.Descriptor instead.
+func (*ImportQuPathGeoJsonIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UpdateSegmentationOut) GetSegmentation() *Segmentation {
+func (x *ImportQuPathGeoJsonIn) GetCytologyId() string {
 	if x != nil {
-		return x.Segmentation
+		return x.CytologyId
 	}
-	return nil
+	return ""
 }
 
-type DeleteSegmentationIn struct {
+func (x *ImportQuPathGeoJsonIn) GetGeojson() string {
+	if x != nil {
+		return x.Geojson
+	}
+	return ""
+}
+
+// аннотации сравниваются с экспортом по id объекта QuPath
+type ImportQuPathGeoJsonOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CytologyImage *CytologyImage         `protobuf:"bytes,100,opt,name=cytology_image,json=cytologyImage,proto3" json:"cytology_image,omitempty"`
+	Added         int32                  `protobuf:"varint,200,opt,name=added,proto3" json:"added,omitempty"`
+	Removed       int32                  `protobuf:"varint,300,opt,name=removed,proto3" json:"removed,omitempty"`
+	Changed       int32                  `protobuf:"varint,400,opt,name=changed,proto3" json:"changed,omitempty"`
+	Unchanged     int32                  `protobuf:"varint,500,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	// features без класса, геометрии или с неизвестным классом
+	Skipped        int32    `protobuf:"varint,600,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	UnknownClasses []string `protobuf:"bytes,700,rep,name=unknown_classes,json=unknownClasses,proto3" json:"unknown_classes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteSegmentationIn) Reset() {
-	*x = DeleteSegmentationIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[48]
+func (x *ImportQuPathGeoJsonOut) Reset() {
+	*x = ImportQuPathGeoJsonOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSegmentationIn) String() string {
+func (x *ImportQuPathGeoJsonOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSegmentationIn) ProtoMessage() {}
+func (*ImportQuPathGeoJsonOut) ProtoMessage() {}
 
-func (x *DeleteSegmentationIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[48]
+func (x *ImportQuPathGeoJsonOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3024,18 +3889,60 @@ func (x *DeleteSegmentationIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSegmentationIn.ProtoReflect.Descriptor instead.
-func (*DeleteSegmentationIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ImportQuPathGeoJsonOut.ProtoReflect.Descriptor instead.
+func (*ImportQuPathGeoJsonOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *DeleteSegmentationIn) GetId() int32 {
+func (x *ImportQuPathGeoJsonOut) GetCytologyImage() *CytologyImage {
 	if x != nil {
-		return x.Id
+		return x.CytologyImage
+	}
+	return nil
+}
+
+func (x *ImportQuPathGeoJsonOut) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *ImportQuPathGeoJsonOut) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *ImportQuPathGeoJsonOut) GetChanged() int32 {
+	if x != nil {
+		return x.Changed
+	}
+	return 0
+}
+
+func (x *ImportQuPathGeoJsonOut) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportQuPathGeoJsonOut) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
 	}
 	return 0
 }
 
+func (x *ImportQuPathGeoJsonOut) GetUnknownClasses() []string {
+	if x != nil {
+		return x.UnknownClasses
+	}
+	return nil
+}
+
 var File_proto_grpc_service_proto protoreflect.FileDescriptor
 
 const file_proto_grpc_service_proto_rawDesc = "" +
@@ -3073,7 +3980,7 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\b_detailsB\n" +
 	"\n" +
 	"\b_prev_idB\x11\n" +
-	"\x0f_parent_prev_id\"\x84\x06\n" +
+	"\x0f_parent_prev_id\"\x9d\x06\n" +
 	"\x15CreateCytologyImageIn\x12\x1f\n" +
 	"\vexternal_id\x18d \x01(\tR\n" +
 	"externalId\x12\x1c\n" +
@@ -3090,11 +3997,11 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\rthyroglobulin\x18\x84\a \x01(\x05H\x04R\rthyroglobulin\x88\x01\x01\x12\x1e\n" +
 	"\adetails\x18\xe8\a \x01(\tH\x05R\adetails\x88\x01\x01\x12\x1d\n" +
 	"\aprev_id\x18\xcc\b \x01(\tH\x06R\x06prevId\x88\x01\x01\x12*\n" +
-	"\x0eparent_prev_id\x18\xb0\t \x01(\tH\aR\fparentPrevId\x88\x01\x01\x12\x18\n" +
-	"\x04file\x18\x94\n" +
-	" \x01(\fH\bR\x04file\x88\x01\x01\x12'\n" +
+	"\x0eparent_prev_id\x18\xb0\t \x01(\tH\aR\fparentPrevId\x88\x01\x01\x12'\n" +
 	"\fcontent_type\x18\xf8\n" +
-	" \x01(\tH\tR\vcontentType\x88\x01\x01B\x15\n" +
+	" \x01(\tH\bR\vcontentType\x88\x01\x01\x12#\n" +
+	"\n" +
+	"image_path\x18\xdc\v \x01(\tH\tR\timagePath\x88\x01\x01B\x15\n" +
 	"\x13_diagnostic_markingB\x10\n" +
 	"\x0e_material_typeB\r\n" +
 	"\v_calcitoninB\x16\n" +
@@ -3104,9 +4011,11 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\b_detailsB\n" +
 	"\n" +
 	"\b_prev_idB\x11\n" +
-	"\x0f_parent_prev_idB\a\n" +
-	"\x05_fileB\x0f\n" +
-	"\r_content_type\"(\n" +
+	"\x0f_parent_prev_idB\x0f\n" +
+	"\r_content_typeB\r\n" +
+	"\v_image_pathJ\x06\b\x94\n" +
+	"\x10\x95\n" +
+	"\"(\n" +
 	"\x16CreateCytologyImageOut\x12\x0e\n" +
 	"\x02id\x18d \x01(\tR\x02id\"(\n" +
 	"\x16GetCytologyImageByIdIn\x12\x0e\n" +
@@ -3173,7 +4082,7 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\x19GetCytologyImageHistoryIn\x12\x0e\n" +
 	"\x02id\x18d \x01(\tR\x02id\"U\n" +
 	"\x1aGetCytologyImageHistoryOut\x127\n" +
-	"\x0fcytology_images\x18d \x03(\v2\x0e.CytologyImageR\x0ecytologyImages\"\xd9\x01\n" +
+	"\x0fcytology_images\x18d \x03(\v2\x0e.CytologyImageR\x0ecytologyImages\"\x82\x02\n" +
 	"\rOriginalImage\x12\x0e\n" +
 	"\x02id\x18d \x01(\tR\x02id\x12 \n" +
 	"\vcytology_id\x18\xc8\x01 \x01(\tR\n" +
@@ -3185,21 +4094,25 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\n" +
 	"delay_time\x18\xf4\x03 \x01(\x01H\x00R\tdelayTime\x88\x01\x01\x12 \n" +
 	"\vviewed_flag\x18\xd8\x04 \x01(\bR\n" +
-	"viewedFlagB\r\n" +
-	"\v_delay_time\"\xd9\x01\n" +
+	"viewedFlag\x12\x1c\n" +
+	"\x06sha256\x18\xbc\x05 \x01(\tH\x01R\x06sha256\x88\x01\x01B\r\n" +
+	"\v_delay_timeB\t\n" +
+	"\a_sha256\"\xf5\x01\n" +
 	"\x15CreateOriginalImageIn\x12\x1f\n" +
 	"\vcytology_id\x18d \x01(\tR\n" +
-	"cytologyId\x12\x13\n" +
-	"\x04file\x18\xc8\x01 \x01(\fR\x04file\x12\"\n" +
+	"cytologyId\x12\"\n" +
 	"\fcontent_type\x18\xac\x02 \x01(\tR\vcontentType\x12#\n" +
 	"\n" +
 	"delay_time\x18\x90\x03 \x01(\x01H\x00R\tdelayTime\x88\x01\x01\x12#\n" +
 	"\n" +
-	"image_path\x18\xf4\x03 \x01(\tH\x01R\timagePath\x88\x01\x01B\r\n" +
+	"image_path\x18\xf4\x03 \x01(\tH\x01R\timagePath\x88\x01\x01\x12\x1c\n" +
+	"\x06sha256\x18\xd8\x04 \x01(\tH\x02R\x06sha256\x88\x01\x01B\r\n" +
 	"\v_delay_timeB\r\n" +
-	"\v_image_path\"(\n" +
+	"\v_image_pathB\t\n" +
+	"\a_sha256J\x06\b\xc8\x01\x10\xc9\x01\"L\n" +
 	"\x16CreateOriginalImageOut\x12\x0e\n" +
-	"\x02id\x18d \x01(\tR\x02id\"(\n" +
+	"\x02id\x18d \x01(\tR\x02id\x12\"\n" +
+	"\fduplicate_of\x18\xc8\x01 \x03(\tR\vduplicateOf\"(\n" +
 	"\x16GetOriginalImageByIdIn\x12\x0e\n" +
 	"\x02id\x18d \x01(\tR\x02id\"P\n" +
 	"\x17GetOriginalImageByIdOut\x125\n" +
@@ -3218,7 +4131,18 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\v_delay_timeB\x0e\n" +
 	"\f_viewed_flag\"O\n" +
 	"\x16UpdateOriginalImageOut\x125\n" +
-	"\x0eoriginal_image\x18d \x01(\v2\x0e.OriginalImageR\roriginalImage\"\xf7\x01\n" +
+	"\x0eoriginal_image\x18d \x01(\v2\x0e.OriginalImageR\roriginalImage\" \n" +
+	"\x1eVerifyOriginalImageIntegrityIn\"\x82\x01\n" +
+	"\x1eOriginalImageIntegrityMismatch\x12*\n" +
+	"\x11original_image_id\x18d \x01(\tR\x0foriginalImageId\x12\x1b\n" +
+	"\bexpected\x18\xc8\x01 \x01(\tR\bexpected\x12\x17\n" +
+	"\x06actual\x18\xac\x02 \x01(\tR\x06actual\"\x98\x01\n" +
+	"\x1fVerifyOriginalImageIntegrityOut\x12\x18\n" +
+	"\achecked\x18d \x01(\x03R\achecked\x12\x19\n" +
+	"\amissing\x18\xc8\x01 \x03(\tR\amissing\x12@\n" +
+	"\n" +
+	"mismatches\x18\xac\x02 \x03(\v2\x1f.OriginalImageIntegrityMismatchR\n" +
+	"mismatches\"\xf7\x01\n" +
 	"\x11SegmentationGroup\x12\x0e\n" +
 	"\x02id\x18d \x01(\x05R\x02id\x12 \n" +
 	"\vcytology_id\x18\xc8\x01 \x01(\tR\n" +
@@ -3268,24 +4192,56 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\x1aUpdateSegmentationGroupOut\x12A\n" +
 	"\x12segmentation_group\x18d \x01(\v2\x12.SegmentationGroupR\x11segmentationGroup\"+\n" +
 	"\x19DeleteSegmentationGroupIn\x12\x0e\n" +
-	"\x02id\x18d \x01(\x05R\x02id\"~\n" +
+	"\x02id\x18d \x01(\x05R\x02id\"3\n" +
+	"\x05Color\x12\f\n" +
+	"\x01r\x18d \x01(\x05R\x01r\x12\r\n" +
+	"\x01g\x18\xc8\x01 \x01(\x05R\x01g\x12\r\n" +
+	"\x01b\x18\xac\x02 \x01(\x05R\x01b\"\xae\x01\n" +
 	"\x11SegmentationPoint\x12\x0e\n" +
 	"\x02id\x18d \x01(\x05R\x02id\x12(\n" +
 	"\x0fsegmentation_id\x18\xc8\x01 \x01(\x05R\x0esegmentationId\x12\r\n" +
 	"\x01x\x18\xac\x02 \x01(\x05R\x01x\x12\r\n" +
 	"\x01y\x18\x90\x03 \x01(\x05R\x01y\x12\x11\n" +
-	"\x03uid\x18\xf4\x03 \x01(\x03R\x03uid\"\x9e\x01\n" +
+	"\x03uid\x18\xf4\x03 \x01(\x03R\x03uid\x12\x19\n" +
+	"\apolygon\x18\xd8\x04 \x01(\x05R\apolygon\x12\x13\n" +
+	"\x04ring\x18\xbc\x05 \x01(\x05R\x04ring\"\x89\x03\n" +
 	"\fSegmentation\x12\x0e\n" +
 	"\x02id\x18d \x01(\x05R\x02id\x123\n" +
 	"\x15segmentation_group_id\x18\xc8\x01 \x01(\x05R\x13segmentationGroupId\x12+\n" +
 	"\x06points\x18\xac\x02 \x03(\v2\x12.SegmentationPointR\x06points\x12\x1c\n" +
-	"\tcreate_at\x18\x90\x03 \x01(\tR\bcreateAt\"}\n" +
+	"\tcreate_at\x18\x90\x03 \x01(\tR\bcreateAt\x123\n" +
+	"\rgeometry_type\x18\xf4\x03 \x01(\x0e2\r.GeometryTypeR\fgeometryType\x12\"\n" +
+	"\x05color\x18\xd8\x04 \x01(\v2\x06.ColorH\x00R\x05color\x88\x01\x01\x12\x1c\n" +
+	"\tis_locked\x18\xbc\x05 \x01(\bR\bisLocked\x12$\n" +
+	"\n" +
+	"confidence\x18\xa0\x06 \x01(\x01H\x01R\n" +
+	"confidence\x88\x01\x01\x12$\n" +
+	"\n" +
+	"properties\x18\x84\a \x01(\tH\x02R\n" +
+	"properties\x88\x01\x01B\b\n" +
+	"\x06_colorB\r\n" +
+	"\v_confidenceB\r\n" +
+	"\v_properties\"\xe8\x02\n" +
 	"\x14CreateSegmentationIn\x122\n" +
 	"\x15segmentation_group_id\x18d \x01(\x05R\x13segmentationGroupId\x121\n" +
-	"\x06points\x18\xc8\x01 \x03(\v2\x18.SegmentationPointCreateR\x06points\"6\n" +
+	"\x06points\x18\xc8\x01 \x03(\v2\x18.SegmentationPointCreateR\x06points\x123\n" +
+	"\rgeometry_type\x18\xac\x02 \x01(\x0e2\r.GeometryTypeR\fgeometryType\x12\"\n" +
+	"\x05color\x18\x90\x03 \x01(\v2\x06.ColorH\x00R\x05color\x88\x01\x01\x12\x1c\n" +
+	"\tis_locked\x18\xf4\x03 \x01(\bR\bisLocked\x12$\n" +
+	"\n" +
+	"confidence\x18\xd8\x04 \x01(\x01H\x01R\n" +
+	"confidence\x88\x01\x01\x12$\n" +
+	"\n" +
+	"properties\x18\xbc\x05 \x01(\tH\x02R\n" +
+	"properties\x88\x01\x01B\b\n" +
+	"\x06_colorB\r\n" +
+	"\v_confidenceB\r\n" +
+	"\v_properties\"f\n" +
 	"\x17SegmentationPointCreate\x12\f\n" +
 	"\x01x\x18d \x01(\x05R\x01x\x12\r\n" +
-	"\x01y\x18\xc8\x01 \x01(\x05R\x01y\"'\n" +
+	"\x01y\x18\xc8\x01 \x01(\x05R\x01y\x12\x19\n" +
+	"\apolygon\x18\xac\x02 \x01(\x05R\apolygon\x12\x13\n" +
+	"\x04ring\x18\x90\x03 \x01(\x05R\x04ring\"'\n" +
 	"\x15CreateSegmentationOut\x12\x0e\n" +
 	"\x02id\x18d \x01(\x05R\x02id\"'\n" +
 	"\x15GetSegmentationByIdIn\x12\x0e\n" +
@@ -3295,14 +4251,74 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\x16GetSegmentsByGroupIdIn\x122\n" +
 	"\x15segmentation_group_id\x18d \x01(\x05R\x13segmentationGroupId\"N\n" +
 	"\x17GetSegmentsByGroupIdOut\x123\n" +
-	"\rsegmentations\x18d \x03(\v2\r.SegmentationR\rsegmentations\"Y\n" +
+	"\rsegmentations\x18d \x03(\v2\r.SegmentationR\rsegmentations\"]\n" +
+	"\x04BBox\x12\x13\n" +
+	"\x05min_x\x18d \x01(\x05R\x04minX\x12\x14\n" +
+	"\x05min_y\x18\xc8\x01 \x01(\x05R\x04minY\x12\x14\n" +
+	"\x05max_x\x18\xac\x02 \x01(\x05R\x04maxX\x12\x14\n" +
+	"\x05max_y\x18\x90\x03 \x01(\x05R\x04maxY\"\xd4\x02\n" +
+	"\x17GetSegmentsInViewportIn\x12\x1f\n" +
+	"\vcytology_id\x18d \x01(\tR\n" +
+	"cytologyId\x12\x1a\n" +
+	"\x04bbox\x18\xc8\x01 \x01(\v2\x05.BBoxR\x04bbox\x12)\n" +
+	"\bseg_type\x18\xac\x02 \x01(\x0e2\b.SegTypeH\x00R\asegType\x88\x01\x01\x12/\n" +
+	"\n" +
+	"group_type\x18\x90\x03 \x01(\x0e2\n" +
+	".GroupTypeH\x01R\tgroupType\x88\x01\x01\x12\x19\n" +
+	"\x05is_ai\x18\xf4\x03 \x01(\bH\x02R\x04isAi\x88\x01\x01\x12\x1f\n" +
+	"\n" +
+	"downsample\x18\xd8\x04 \x01(\x01R\n" +
+	"downsample\x12\x1c\n" +
+	"\x06cursor\x18\xbc\x05 \x01(\tH\x03R\x06cursor\x88\x01\x01\x12\x15\n" +
+	"\x05limit\x18\xa0\x06 \x01(\x05R\x05limitB\v\n" +
+	"\t_seg_typeB\r\n" +
+	"\v_group_typeB\b\n" +
+	"\x06_is_aiB\t\n" +
+	"\a_cursor\"\x86\x01\n" +
+	"\x18GetSegmentsInViewportOut\x123\n" +
+	"\rsegmentations\x18d \x03(\v2\r.SegmentationR\rsegmentations\x12%\n" +
+	"\vnext_cursor\x18\xc8\x01 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\xee\x02\n" +
 	"\x14UpdateSegmentationIn\x12\x0e\n" +
 	"\x02id\x18d \x01(\x05R\x02id\x121\n" +
-	"\x06points\x18\xc8\x01 \x03(\v2\x18.SegmentationPointCreateR\x06points\"J\n" +
+	"\x06points\x18\xc8\x01 \x03(\v2\x18.SegmentationPointCreateR\x06points\x128\n" +
+	"\rgeometry_type\x18\xac\x02 \x01(\x0e2\r.GeometryTypeH\x00R\fgeometryType\x88\x01\x01\x12\"\n" +
+	"\x05color\x18\x90\x03 \x01(\v2\x06.ColorH\x01R\x05color\x88\x01\x01\x12!\n" +
+	"\tis_locked\x18\xf4\x03 \x01(\bH\x02R\bisLocked\x88\x01\x01\x12$\n" +
+	"\n" +
+	"confidence\x18\xd8\x04 \x01(\x01H\x03R\n" +
+	"confidence\x88\x01\x01\x12$\n" +
+	"\n" +
+	"properties\x18\xbc\x05 \x01(\tH\x04R\n" +
+	"properties\x88\x01\x01B\x10\n" +
+	"\x0e_geometry_typeB\b\n" +
+	"\x06_colorB\f\n" +
+	"\n" +
+	"_is_lockedB\r\n" +
+	"\v_confidenceB\r\n" +
+	"\v_properties\"J\n" +
 	"\x15UpdateSegmentationOut\x121\n" +
 	"\fsegmentation\x18d \x01(\v2\r.SegmentationR\fsegmentation\"&\n" +
 	"\x14DeleteSegmentationIn\x12\x0e\n" +
-	"\x02id\x18d \x01(\x05R\x02id*o\n" +
+	"\x02id\x18d \x01(\x05R\x02id\"8\n" +
+	"\x15ExportQuPathGeoJsonIn\x12\x1f\n" +
+	"\vcytology_id\x18d \x01(\tR\n" +
+	"cytologyId\"2\n" +
+	"\x16ExportQuPathGeoJsonOut\x12\x18\n" +
+	"\ageojson\x18d \x01(\tR\ageojson\"S\n" +
+	"\x15ImportQuPathGeoJsonIn\x12\x1f\n" +
+	"\vcytology_id\x18d \x01(\tR\n" +
+	"cytologyId\x12\x19\n" +
+	"\ageojson\x18\xc8\x01 \x01(\tR\ageojson\"\x80\x02\n" +
+	"\x16ImportQuPathGeoJsonOut\x125\n" +
+	"\x0ecytology_image\x18d \x01(\v2\x0e.CytologyImageR\rcytologyImage\x12\x15\n" +
+	"\x05added\x18\xc8\x01 \x01(\x05R\x05added\x12\x19\n" +
+	"\aremoved\x18\xac\x02 \x01(\x05R\aremoved\x12\x19\n" +
+	"\achanged\x18\x90\x03 \x01(\x05R\achanged\x12\x1d\n" +
+	"\tunchanged\x18\xf4\x03 \x01(\x05R\tunchanged\x12\x19\n" +
+	"\askipped\x18\xd8\x04 \x01(\x05R\askipped\x12(\n" +
+	"\x0funknown_classes\x18\xbc\x05 \x03(\tR\x0eunknownClasses*o\n" +
 	"\x11DiagnosticMarking\x12\"\n" +
 	"\x1eDIAGNOSTIC_MARKING_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16DIAGNOSTIC_MARKING_P11\x10\x01\x12\x1a\n" +
@@ -3337,7 +4353,13 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\x16GROUP_TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rGROUP_TYPE_CE\x10\x01\x12\x11\n" +
 	"\rGROUP_TYPE_CL\x10\x02\x12\x11\n" +
-	"\rGROUP_TYPE_ME\x10\x032\xaa\x0f\n" +
+	"\rGROUP_TYPE_ME\x10\x03*\xa1\x01\n" +
+	"\fGeometryType\x12\x1d\n" +
+	"\x19GEOMETRY_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13GEOMETRY_TYPE_POINT\x10\x01\x12\x1d\n" +
+	"\x19GEOMETRY_TYPE_LINE_STRING\x10\x02\x12\x19\n" +
+	"\x15GEOMETRY_TYPE_POLYGON\x10\x03\x12\x1f\n" +
+	"\x1bGEOMETRY_TYPE_MULTI_POLYGON\x10\x042\xeb\x11\n" +
 	"\vCytologySrv\x12F\n" +
 	"\x13CreateCytologyImage\x12\x16.CreateCytologyImageIn\x1a\x17.CreateCytologyImageOut\x12I\n" +
 	"\x14GetCytologyImageById\x12\x17.GetCytologyImageByIdIn\x1a\x18.GetCytologyImageByIdOut\x12d\n" +
@@ -3352,16 +4374,20 @@ const file_proto_grpc_service_proto_rawDesc = "" +
 	"\x13CreateOriginalImage\x12\x16.CreateOriginalImageIn\x1a\x17.CreateOriginalImageOut\x12I\n" +
 	"\x14GetOriginalImageById\x12\x17.GetOriginalImageByIdIn\x1a\x18.GetOriginalImageByIdOut\x12d\n" +
 	"\x1dGetOriginalImagesByCytologyId\x12 .GetOriginalImagesByCytologyIdIn\x1a!.GetOriginalImagesByCytologyIdOut\x12F\n" +
-	"\x13UpdateOriginalImage\x12\x16.UpdateOriginalImageIn\x1a\x17.UpdateOriginalImageOut\x12R\n" +
+	"\x13UpdateOriginalImage\x12\x16.UpdateOriginalImageIn\x1a\x17.UpdateOriginalImageOut\x12a\n" +
+	"\x1cVerifyOriginalImageIntegrity\x12\x1f.VerifyOriginalImageIntegrityIn\x1a .VerifyOriginalImageIntegrityOut\x12R\n" +
 	"\x17CreateSegmentationGroup\x12\x1a.CreateSegmentationGroupIn\x1a\x1b.CreateSegmentationGroupOut\x12p\n" +
 	"!GetSegmentationGroupsByCytologyId\x12$.GetSegmentationGroupsByCytologyIdIn\x1a%.GetSegmentationGroupsByCytologyIdOut\x12R\n" +
 	"\x17UpdateSegmentationGroup\x12\x1a.UpdateSegmentationGroupIn\x1a\x1b.UpdateSegmentationGroupOut\x12M\n" +
 	"\x17DeleteSegmentationGroup\x12\x1a.DeleteSegmentationGroupIn\x1a\x16.google.protobuf.Empty\x12C\n" +
 	"\x12CreateSegmentation\x12\x15.CreateSegmentationIn\x1a\x16.CreateSegmentationOut\x12F\n" +
 	"\x13GetSegmentationById\x12\x16.GetSegmentationByIdIn\x1a\x17.GetSegmentationByIdOut\x12I\n" +
-	"\x14GetSegmentsByGroupId\x12\x17.GetSegmentsByGroupIdIn\x1a\x18.GetSegmentsByGroupIdOut\x12C\n" +
+	"\x14GetSegmentsByGroupId\x12\x17.GetSegmentsByGroupIdIn\x1a\x18.GetSegmentsByGroupIdOut\x12L\n" +
+	"\x15GetSegmentsInViewport\x12\x18.GetSegmentsInViewportIn\x1a\x19.GetSegmentsInViewportOut\x12C\n" +
 	"\x12UpdateSegmentation\x12\x15.UpdateSegmentationIn\x1a\x16.UpdateSegmentationOut\x12C\n" +
-	"\x12DeleteSegmentation\x12\x15.DeleteSegmentationIn\x1a\x16.google.protobuf.EmptyB!Z\x1finternal/generated/grpc/serviceb\x06proto3"
+	"\x12DeleteSegmentation\x12\x15.DeleteSegmentationIn\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x13ExportQuPathGeoJson\x12\x16.ExportQuPathGeoJsonIn\x1a\x17.ExportQuPathGeoJsonOut\x12F\n" +
+	"\x13ImportQuPathGeoJson\x12\x16.ImportQuPathGeoJsonIn\x1a\x17.ImportQuPathGeoJsonOutB!Z\x1finternal/generated/grpc/serviceb\x06proto3"
 
 var (
 	file_proto_grpc_service_proto_rawDescOnce sync.Once
@@ -3375,148 +4401,180 @@ func file_proto_grpc_service_proto_rawDescGZIP() []byte {
 	return file_proto_grpc_service_proto_rawDescData
 }
 
-var file_proto_grpc_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_grpc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_proto_grpc_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_grpc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_proto_grpc_service_proto_goTypes = []any{
 	(DiagnosticMarking)(0),                               // 0: DiagnosticMarking
 	(MaterialType)(0),                                    // 1: MaterialType
 	(SegType)(0),                                         // 2: SegType
 	(GroupType)(0),                                       // 3: GroupType
-	(*CytologyImage)(nil),                                // 4: CytologyImage
-	(*CreateCytologyImageIn)(nil),                        // 5: CreateCytologyImageIn
-	(*CreateCytologyImageOut)(nil),                       // 6: CreateCytologyImageOut
-	(*GetCytologyImageByIdIn)(nil),                       // 7: GetCytologyImageByIdIn
-	(*GetCytologyImageByIdOut)(nil),                      // 8: GetCytologyImageByIdOut
-	(*GetCytologyImagesByExternalIdIn)(nil),              // 9: GetCytologyImagesByExternalIdIn
-	(*GetCytologyImagesByExternalIdOut)(nil),             // 10: GetCytologyImagesByExternalIdOut
-	(*GetCytologyImagesByDoctorIdAndPatientIdIn)(nil),    // 11: GetCytologyImagesByDoctorIdAndPatientIdIn
-	(*GetCytologyImagesByDoctorIdAndPatientIdOut)(nil),   // 12: GetCytologyImagesByDoctorIdAndPatientIdOut
-	(*GetCytologyImagesByPatientIdIn)(nil),               // 13: GetCytologyImagesByPatientIdIn
-	(*GetCytologyImagesByPatientIdOut)(nil),              // 14: GetCytologyImagesByPatientIdOut
-	(*GetCytologyImageIdsByDoctorIdAndPatientIdIn)(nil),  // 15: GetCytologyImageIdsByDoctorIdAndPatientIdIn
-	(*GetCytologyImageIdsByDoctorIdAndPatientIdOut)(nil), // 16: GetCytologyImageIdsByDoctorIdAndPatientIdOut
-	(*UpdateCytologyImageIn)(nil),                        // 17: UpdateCytologyImageIn
-	(*UpdateCytologyImageOut)(nil),                       // 18: UpdateCytologyImageOut
-	(*DeleteCytologyImageIn)(nil),                        // 19: DeleteCytologyImageIn
-	(*CopyCytologyImageIn)(nil),                          // 20: CopyCytologyImageIn
-	(*CopyCytologyImageOut)(nil),                         // 21: CopyCytologyImageOut
-	(*GetCytologyImageHistoryIn)(nil),                    // 22: GetCytologyImageHistoryIn
-	(*GetCytologyImageHistoryOut)(nil),                   // 23: GetCytologyImageHistoryOut
-	(*OriginalImage)(nil),                                // 24: OriginalImage
-	(*CreateOriginalImageIn)(nil),                        // 25: CreateOriginalImageIn
-	(*CreateOriginalImageOut)(nil),                       // 26: CreateOriginalImageOut
-	(*GetOriginalImageByIdIn)(nil),                       // 27: GetOriginalImageByIdIn
-	(*GetOriginalImageByIdOut)(nil),                      // 28: GetOriginalImageByIdOut
-	(*GetOriginalImagesByCytologyIdIn)(nil),              // 29: GetOriginalImagesByCytologyIdIn
-	(*GetOriginalImagesByCytologyIdOut)(nil),             // 30: GetOriginalImagesByCytologyIdOut
-	(*UpdateOriginalImageIn)(nil),                        // 31: UpdateOriginalImageIn
-	(*UpdateOriginalImageOut)(nil),                       // 32: UpdateOriginalImageOut
-	(*SegmentationGroup)(nil),                            // 33: SegmentationGroup
-	(*CreateSegmentationGroupIn)(nil),                    // 34: CreateSegmentationGroupIn
-	(*CreateSegmentationGroupOut)(nil),                   // 35: CreateSegmentationGroupOut
-	(*GetSegmentationGroupsByCytologyIdIn)(nil),          // 36: GetSegmentationGroupsByCytologyIdIn
-	(*GetSegmentationGroupsByCytologyIdOut)(nil),         // 37: GetSegmentationGroupsByCytologyIdOut
-	(*UpdateSegmentationGroupIn)(nil),                    // 38: UpdateSegmentationGroupIn
-	(*UpdateSegmentationGroupOut)(nil),                   // 39: UpdateSegmentationGroupOut
-	(*DeleteSegmentationGroupIn)(nil),                    // 40: DeleteSegmentationGroupIn
-	(*SegmentationPoint)(nil),                            // 41: SegmentationPoint
-	(*Segmentation)(nil),                                 // 42: Segmentation
-	(*CreateSegmentationIn)(nil),                         // 43: CreateSegmentationIn
-	(*SegmentationPointCreate)(nil),                      // 44: SegmentationPointCreate
-	(*CreateSegmentationOut)(nil),                        // 45: CreateSegmentationOut
-	(*GetSegmentationByIdIn)(nil),                        // 46: GetSegmentationByIdIn
-	(*GetSegmentationByIdOut)(nil),                       // 47: GetSegmentationByIdOut
-	(*GetSegmentsByGroupIdIn)(nil),                       // 48: GetSegmentsByGroupIdIn
-	(*GetSegmentsByGroupIdOut)(nil),                      // 49: GetSegmentsByGroupIdOut
-	(*UpdateSegmentationIn)(nil),                         // 50: UpdateSegmentationIn
-	(*UpdateSegmentationOut)(nil),                        // 51: UpdateSegmentationOut
-	(*DeleteSegmentationIn)(nil),                         // 52: DeleteSegmentationIn
-	(*emptypb.Empty)(nil),                                // 53: google.protobuf.Empty
+	(GeometryType)(0),                                    // 4: GeometryType
+	(*CytologyImage)(nil),                                // 5: CytologyImage
+	(*CreateCytologyImageIn)(nil),                        // 6: CreateCytologyImageIn
+	(*CreateCytologyImageOut)(nil),                       // 7: CreateCytologyImageOut
+	(*GetCytologyImageByIdIn)(nil),                       // 8: GetCytologyImageByIdIn
+	(*GetCytologyImageByIdOut)(nil),                      // 9: GetCytologyImageByIdOut
+	(*GetCytologyImagesByExternalIdIn)(nil),              // 10: GetCytologyImagesByExternalIdIn
+	(*GetCytologyImagesByExternalIdOut)(nil),             // 11: GetCytologyImagesByExternalIdOut
+	(*GetCytologyImagesByDoctorIdAndPatientIdIn)(nil),    // 12: GetCytologyImagesByDoctorIdAndPatientIdIn
+	(*GetCytologyImagesByDoctorIdAndPatientIdOut)(nil),   // 13: GetCytologyImagesByDoctorIdAndPatientIdOut
+	(*GetCytologyImagesByPatientIdIn)(nil),               // 14: GetCytologyImagesByPatientIdIn
+	(*GetCytologyImagesByPatientIdOut)(nil),              // 15: GetCytologyImagesByPatientIdOut
+	(*GetCytologyImageIdsByDoctorIdAndPatientIdIn)(nil),  // 16: GetCytologyImageIdsByDoctorIdAndPatientIdIn
+	(*GetCytologyImageIdsByDoctorIdAndPatientIdOut)(nil), // 17: GetCytologyImageIdsByDoctorIdAndPatientIdOut
+	(*UpdateCytologyImageIn)(nil),                        // 18: UpdateCytologyImageIn
+	(*UpdateCytologyImageOut)(nil),                       // 19: UpdateCytologyImageOut
+	(*DeleteCytologyImageIn)(nil),                        // 20: DeleteCytologyImageIn
+	(*CopyCytologyImageIn)(nil),                          // 21: CopyCytologyImageIn
+	(*CopyCytologyImageOut)(nil),                         // 22: CopyCytologyImageOut
+	(*GetCytologyImageHistoryIn)(nil),                    // 23: GetCytologyImageHistoryIn
+	(*GetCytologyImageHistoryOut)(nil),                   // 24: GetCytologyImageHistoryOut
+	(*OriginalImage)(nil),                                // 25: OriginalImage
+	(*CreateOriginalImageIn)(nil),                        // 26: CreateOriginalImageIn
+	(*CreateOriginalImageOut)(nil),                       // 27: CreateOriginalImageOut
+	(*GetOriginalImageByIdIn)(nil),                       // 28: GetOriginalImageByIdIn
+	(*GetOriginalImageByIdOut)(nil),                      // 29: GetOriginalImageByIdOut
+	(*GetOriginalImagesByCytologyIdIn)(nil),              // 30: GetOriginalImagesByCytologyIdIn
+	(*GetOriginalImagesByCytologyIdOut)(nil),             // 31: GetOriginalImagesByCytologyIdOut
+	(*UpdateOriginalImageIn)(nil),                        // 32: UpdateOriginalImageIn
+	(*UpdateOriginalImageOut)(nil),                       // 33: UpdateOriginalImageOut
+	(*VerifyOriginalImageIntegrityIn)(nil),               // 34: VerifyOriginalImageIntegrityIn
+	(*OriginalImageIntegrityMismatch)(nil),               // 35: OriginalImageIntegrityMismatch
+	(*VerifyOriginalImageIntegrityOut)(nil),              // 36: VerifyOriginalImageIntegrityOut
+	(*SegmentationGroup)(nil),                            // 37: SegmentationGroup
+	(*CreateSegmentationGroupIn)(nil),                    // 38: CreateSegmentationGroupIn
+	(*CreateSegmentationGroupOut)(nil),                   // 39: CreateSegmentationGroupOut
+	(*GetSegmentationGroupsByCytologyIdIn)(nil),          // 40: GetSegmentationGroupsByCytologyIdIn
+	(*GetSegmentationGroupsByCytologyIdOut)(nil),         // 41: GetSegmentationGroupsByCytologyIdOut
+	(*UpdateSegmentationGroupIn)(nil),                    // 42: UpdateSegmentationGroupIn
+	(*UpdateSegmentationGroupOut)(nil),                   // 43: UpdateSegmentationGroupOut
+	(*DeleteSegmentationGroupIn)(nil),                    // 44: DeleteSegmentationGroupIn
+	(*Color)(nil),                                        // 45: Color
+	(*SegmentationPoint)(nil),                            // 46: SegmentationPoint
+	(*Segmentation)(nil),                                 // 47: Segmentation
+	(*CreateSegmentationIn)(nil),                         // 48: CreateSegmentationIn
+	(*SegmentationPointCreate)(nil),                      // 49: SegmentationPointCreate
+	(*CreateSegmentationOut)(nil),                        // 50: CreateSegmentationOut
+	(*GetSegmentationByIdIn)(nil),                        // 51: GetSegmentationByIdIn
+	(*GetSegmentationByIdOut)(nil),                       // 52: GetSegmentationByIdOut
+	(*GetSegmentsByGroupIdIn)(nil),                       // 53: GetSegmentsByGroupIdIn
+	(*GetSegmentsByGroupIdOut)(nil),                      // 54: GetSegmentsByGroupIdOut
+	(*BBox)(nil),                                         // 55: BBox
+	(*GetSegmentsInViewportIn)(nil),                      // 56: GetSegmentsInViewportIn
+	(*GetSegmentsInViewportOut)(nil),                     // 57: GetSegmentsInViewportOut
+	(*UpdateSegmentationIn)(nil),                         // 58: UpdateSegmentationIn
+	(*UpdateSegmentationOut)(nil),                        // 59: UpdateSegmentationOut
+	(*DeleteSegmentationIn)(nil),                         // 60: DeleteSegmentationIn
+	(*ExportQuPathGeoJsonIn)(nil),                        // 61: ExportQuPathGeoJsonIn
+	(*ExportQuPathGeoJsonOut)(nil),                       // 62: ExportQuPathGeoJsonOut
+	(*ImportQuPathGeoJsonIn)(nil),                        // 63: ImportQuPathGeoJsonIn
+	(*ImportQuPathGeoJsonOut)(nil),                       // 64: ImportQuPathGeoJsonOut
+	(*emptypb.Empty)(nil),                                // 65: google.protobuf.Empty
 }
 var file_proto_grpc_service_proto_depIdxs = []int32{
 	0,  // 0: CytologyImage.diagnostic_marking:type_name -> DiagnosticMarking
 	1,  // 1: CytologyImage.material_type:type_name -> MaterialType
 	0,  // 2: CreateCytologyImageIn.diagnostic_marking:type_name -> DiagnosticMarking
 	1,  // 3: CreateCytologyImageIn.material_type:type_name -> MaterialType
-	4,  // 4: GetCytologyImageByIdOut.cytology_image:type_name -> CytologyImage
-	24, // 5: GetCytologyImageByIdOut.original_image:type_name -> OriginalImage
-	4,  // 6: GetCytologyImagesByExternalIdOut.cytology_images:type_name -> CytologyImage
-	4,  // 7: GetCytologyImagesByDoctorIdAndPatientIdOut.cytology_images:type_name -> CytologyImage
-	4,  // 8: GetCytologyImagesByPatientIdOut.cytology_images:type_name -> CytologyImage
+	5,  // 4: GetCytologyImageByIdOut.cytology_image:type_name -> CytologyImage
+	25, // 5: GetCytologyImageByIdOut.original_image:type_name -> OriginalImage
+	5,  // 6: GetCytologyImagesByExternalIdOut.cytology_images:type_name -> CytologyImage
+	5,  // 7: GetCytologyImagesByDoctorIdAndPatientIdOut.cytology_images:type_name -> CytologyImage
+	5,  // 8: GetCytologyImagesByPatientIdOut.cytology_images:type_name -> CytologyImage
 	0,  // 9: UpdateCytologyImageIn.diagnostic_marking:type_name -> DiagnosticMarking
 	1,  // 10: UpdateCytologyImageIn.material_type:type_name -> MaterialType
-	4,  // 11: UpdateCytologyImageOut.cytology_image:type_name -> CytologyImage
-	4,  // 12: CopyCytologyImageOut.cytology_image:type_name -> CytologyImage
-	4,  // 13: GetCytologyImageHistoryOut.cytology_images:type_name -> CytologyImage
-	24, // 14: GetOriginalImageByIdOut.original_image:type_name -> OriginalImage
-	24, // 15: GetOriginalImagesByCytologyIdOut.original_images:type_name -> OriginalImage
-	24, // 16: UpdateOriginalImageOut.original_image:type_name -> OriginalImage
-	2,  // 17: SegmentationGroup.seg_type:type_name -> SegType
-	3,  // 18: SegmentationGroup.group_type:type_name -> GroupType
-	2,  // 19: CreateSegmentationGroupIn.seg_type:type_name -> SegType
-	3,  // 20: CreateSegmentationGroupIn.group_type:type_name -> GroupType
-	2,  // 21: GetSegmentationGroupsByCytologyIdIn.seg_type:type_name -> SegType
-	3,  // 22: GetSegmentationGroupsByCytologyIdIn.group_type:type_name -> GroupType
-	33, // 23: GetSegmentationGroupsByCytologyIdOut.segmentation_groups:type_name -> SegmentationGroup
-	2,  // 24: UpdateSegmentationGroupIn.seg_type:type_name -> SegType
-	33, // 25: UpdateSegmentationGroupOut.segmentation_group:type_name -> SegmentationGroup
-	41, // 26: Segmentation.points:type_name -> SegmentationPoint
-	44, // 27: CreateSegmentationIn.points:type_name -> SegmentationPointCreate
-	42, // 28: GetSegmentationByIdOut.segmentation:type_name -> Segmentation
-	42, // 29: GetSegmentsByGroupIdOut.segmentations:type_name -> Segmentation
-	44, // 30: UpdateSegmentationIn.points:type_name -> SegmentationPointCreate
-	42, // 31: UpdateSegmentationOut.segmentation:type_name -> Segmentation
-	5,  // 32: CytologySrv.CreateCytologyImage:input_type -> CreateCytologyImageIn
-	7,  // 33: CytologySrv.GetCytologyImageById:input_type -> GetCytologyImageByIdIn
-	9,  // 34: CytologySrv.GetCytologyImagesByExternalId:input_type -> GetCytologyImagesByExternalIdIn
-	11, // 35: CytologySrv.GetCytologyImagesByDoctorIdAndPatientId:input_type -> GetCytologyImagesByDoctorIdAndPatientIdIn
-	13, // 36: CytologySrv.GetCytologyImagesByPatientId:input_type -> GetCytologyImagesByPatientIdIn
-	15, // 37: CytologySrv.GetCytologyImageIdsByDoctorIdAndPatientId:input_type -> GetCytologyImageIdsByDoctorIdAndPatientIdIn
-	17, // 38: CytologySrv.UpdateCytologyImage:input_type -> UpdateCytologyImageIn
-	19, // 39: CytologySrv.DeleteCytologyImage:input_type -> DeleteCytologyImageIn
-	20, // 40: CytologySrv.CopyCytologyImage:input_type -> CopyCytologyImageIn
-	22, // 41: CytologySrv.GetCytologyImageHistory:input_type -> GetCytologyImageHistoryIn
-	25, // 42: CytologySrv.CreateOriginalImage:input_type -> CreateOriginalImageIn
-	27, // 43: CytologySrv.GetOriginalImageById:input_type -> GetOriginalImageByIdIn
-	29, // 44: CytologySrv.GetOriginalImagesByCytologyId:input_type -> GetOriginalImagesByCytologyIdIn
-	31, // 45: CytologySrv.UpdateOriginalImage:input_type -> UpdateOriginalImageIn
-	34, // 46: CytologySrv.CreateSegmentationGroup:input_type -> CreateSegmentationGroupIn
-	36, // 47: CytologySrv.GetSegmentationGroupsByCytologyId:input_type -> GetSegmentationGroupsByCytologyIdIn
-	38, // 48: CytologySrv.UpdateSegmentationGroup:input_type -> UpdateSegmentationGroupIn
-	40, // 49: CytologySrv.DeleteSegmentationGroup:input_type -> DeleteSegmentationGroupIn
-	43, // 50: CytologySrv.CreateSegmentation:input_type -> CreateSegmentationIn
-	46, // 51: CytologySrv.GetSegmentationById:input_type -> GetSegmentationByIdIn
-	48, // 52: CytologySrv.GetSegmentsByGroupId:input_type -> GetSegmentsByGroupIdIn
-	50, // 53: CytologySrv.UpdateSegmentation:input_type -> UpdateSegmentationIn
-	52, // 54: CytologySrv.DeleteSegmentation:input_type -> DeleteSegmentationIn
-	6,  // 55: CytologySrv.CreateCytologyImage:output_type -> CreateCytologyImageOut
-	8,  // 56: CytologySrv.GetCytologyImageById:output_type -> GetCytologyImageByIdOut
-	10, // 57: CytologySrv.GetCytologyImagesByExternalId:output_type -> GetCytologyImagesByExternalIdOut
-	12, // 58: CytologySrv.GetCytologyImagesByDoctorIdAndPatientId:output_type -> GetCytologyImagesByDoctorIdAndPatientIdOut
-	14, // 59: CytologySrv.GetCytologyImagesByPatientId:output_type -> GetCytologyImagesByPatientIdOut
-	16, // 60: CytologySrv.GetCytologyImageIdsByDoctorIdAndPatientId:output_type -> GetCytologyImageIdsByDoctorIdAndPatientIdOut
-	18, // 61: CytologySrv.UpdateCytologyImage:output_type -> UpdateCytologyImageOut
-	53, // 62: CytologySrv.DeleteCytologyImage:output_type -> google.protobuf.Empty
-	21, // 63: CytologySrv.CopyCytologyImage:output_type -> CopyCytologyImageOut
-	23, // 64: CytologySrv.GetCytologyImageHistory:output_type -> GetCytologyImageHistoryOut
-	26, // 65: CytologySrv.CreateOriginalImage:output_type -> CreateOriginalImageOut
-	28, // 66: CytologySrv.GetOriginalImageById:output_type -> GetOriginalImageByIdOut
-	30, // 67: CytologySrv.GetOriginalImagesByCytologyId:output_type -> GetOriginalImagesByCytologyIdOut
-	32, // 68: CytologySrv.UpdateOriginalImage:output_type -> UpdateOriginalImageOut
-	35, // 69: CytologySrv.CreateSegmentationGroup:output_type -> CreateSegmentationGroupOut
-	37, // 70: CytologySrv.GetSegmentationGroupsByCytologyId:output_type -> GetSegmentationGroupsByCytologyIdOut
-	39, // 71: CytologySrv.UpdateSegmentationGroup:output_type -> UpdateSegmentationGroupOut
-	53, // 72: CytologySrv.DeleteSegmentationGroup:output_type -> google.protobuf.Empty
-	45, // 73: CytologySrv.CreateSegmentation:output_type -> CreateSegmentationOut
-	47, // 74: CytologySrv.GetSegmentationById:output_type -> GetSegmentationByIdOut
-	49, // 75: CytologySrv.GetSegmentsByGroupId:output_type -> GetSegmentsByGroupIdOut
-	51, // 76: CytologySrv.UpdateSegmentation:output_type -> UpdateSegmentationOut
-	53, // 77: CytologySrv.DeleteSegmentation:output_type -> google.protobuf.Empty
-	55, // [55:78] is the sub-list for method output_type
-	32, // [32:55] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	5,  // 11: UpdateCytologyImageOut.cytology_image:type_name -> CytologyImage
+	5,  // 12: CopyCytologyImageOut.cytology_image:type_name -> CytologyImage
+	5,  // 13: GetCytologyImageHistoryOut.cytology_images:type_name -> CytologyImage
+	25, // 14: GetOriginalImageByIdOut.original_image:type_name -> OriginalImage
+	25, // 15: GetOriginalImagesByCytologyIdOut.original_images:type_name -> OriginalImage
+	25, // 16: UpdateOriginalImageOut.original_image:type_name -> OriginalImage
+	35, // 17: VerifyOriginalImageIntegrityOut.mismatches:type_name -> OriginalImageIntegrityMismatch
+	2,  // 18: SegmentationGroup.seg_type:type_name -> SegType
+	3,  // 19: SegmentationGroup.group_type:type_name -> GroupType
+	2,  // 20: CreateSegmentationGroupIn.seg_type:type_name -> SegType
+	3,  // 21: CreateSegmentationGroupIn.group_type:type_name -> GroupType
+	2,  // 22: GetSegmentationGroupsByCytologyIdIn.seg_type:type_name -> SegType
+	3,  // 23: GetSegmentationGroupsByCytologyIdIn.group_type:type_name -> GroupType
+	37, // 24: GetSegmentationGroupsByCytologyIdOut.segmentation_groups:type_name -> SegmentationGroup
+	2,  // 25: UpdateSegmentationGroupIn.seg_type:type_name -> SegType
+	37, // 26: UpdateSegmentationGroupOut.segmentation_group:type_name -> SegmentationGroup
+	46, // 27: Segmentation.points:type_name -> SegmentationPoint
+	4,  // 28: Segmentation.geometry_type:type_name -> GeometryType
+	45, // 29: Segmentation.color:type_name -> Color
+	49, // 30: CreateSegmentationIn.points:type_name -> SegmentationPointCreate
+	4,  // 31: CreateSegmentationIn.geometry_type:type_name -> GeometryType
+	45, // 32: CreateSegmentationIn.color:type_name -> Color
+	47, // 33: GetSegmentationByIdOut.segmentation:type_name -> Segmentation
+	47, // 34: GetSegmentsByGroupIdOut.segmentations:type_name -> Segmentation
+	55, // 35: GetSegmentsInViewportIn.bbox:type_name -> BBox
+	2,  // 36: GetSegmentsInViewportIn.seg_type:type_name -> SegType
+	3,  // 37: GetSegmentsInViewportIn.group_type:type_name -> GroupType
+	47, // 38: GetSegmentsInViewportOut.segmentations:type_name -> Segmentation
+	49, // 39: UpdateSegmentationIn.points:type_name -> SegmentationPointCreate
+	4,  // 40: UpdateSegmentationIn.geometry_type:type_name -> GeometryType
+	45, // 41: UpdateSegmentationIn.color:type_name -> Color
+	47, // 42: UpdateSegmentationOut.segmentation:type_name -> Segmentation
+	5,  // 43: ImportQuPathGeoJsonOut.cytology_image:type_name -> CytologyImage
+	6,  // 44: CytologySrv.CreateCytologyImage:input_type -> CreateCytologyImageIn
+	8,  // 45: CytologySrv.GetCytologyImageById:input_type -> GetCytologyImageByIdIn
+	10, // 46: CytologySrv.GetCytologyImagesByExternalId:input_type -> GetCytologyImagesByExternalIdIn
+	12, // 47: CytologySrv.GetCytologyImagesByDoctorIdAndPatientId:input_type -> GetCytologyImagesByDoctorIdAndPatientIdIn
+	14, // 48: CytologySrv.GetCytologyImagesByPatientId:input_type -> GetCytologyImagesByPatientIdIn
+	16, // 49: CytologySrv.GetCytologyImageIdsByDoctorIdAndPatientId:input_type -> GetCytologyImageIdsByDoctorIdAndPatientIdIn
+	18, // 50: CytologySrv.UpdateCytologyImage:input_type -> UpdateCytologyImageIn
+	20, // 51: CytologySrv.DeleteCytologyImage:input_type -> DeleteCytologyImageIn
+	21, // 52: CytologySrv.CopyCytologyImage:input_type -> CopyCytologyImageIn
+	23, // 53: CytologySrv.GetCytologyImageHistory:input_type -> GetCytologyImageHistoryIn
+	26, // 54: CytologySrv.CreateOriginalImage:input_type -> CreateOriginalImageIn
+	28, // 55: CytologySrv.GetOriginalImageById:input_type -> GetOriginalImageByIdIn
+	30, // 56: CytologySrv.GetOriginalImagesByCytologyId:input_type -> GetOriginalImagesByCytologyIdIn
+	32, // 57: CytologySrv.UpdateOriginalImage:input_type -> UpdateOriginalImageIn
+	34, // 58: CytologySrv.VerifyOriginalImageIntegrity:input_type -> VerifyOriginalImageIntegrityIn
+	38, // 59: CytologySrv.CreateSegmentationGroup:input_type -> CreateSegmentationGroupIn
+	40, // 60: CytologySrv.GetSegmentationGroupsByCytologyId:input_type -> GetSegmentationGroupsByCytologyIdIn
+	42, // 61: CytologySrv.UpdateSegmentationGroup:input_type -> UpdateSegmentationGroupIn
+	44, // 62: CytologySrv.DeleteSegmentationGroup:input_type -> DeleteSegmentationGroupIn
+	48, // 63: CytologySrv.CreateSegmentation:input_type -> CreateSegmentationIn
+	51, // 64: CytologySrv.GetSegmentationById:input_type -> GetSegmentationByIdIn
+	53, // 65: CytologySrv.GetSegmentsByGroupId:input_type -> GetSegmentsByGroupIdIn
+	56, // 66: CytologySrv.GetSegmentsInViewport:input_type -> GetSegmentsInViewportIn
+	58, // 67: CytologySrv.UpdateSegmentation:input_type -> UpdateSegmentationIn
+	60, // 68: CytologySrv.DeleteSegmentation:input_type -> DeleteSegmentationIn
+	61, // 69: CytologySrv.ExportQuPathGeoJson:input_type -> ExportQuPathGeoJsonIn
+	63, // 70: CytologySrv.ImportQuPathGeoJson:input_type -> ImportQuPathGeoJsonIn
+	7,  // 71: CytologySrv.CreateCytologyImage:output_type -> CreateCytologyImageOut
+	9,  // 72: CytologySrv.GetCytologyImageById:output_type -> GetCytologyImageByIdOut
+	11, // 73: CytologySrv.GetCytologyImagesByExternalId:output_type -> GetCytologyImagesByExternalIdOut
+	13, // 74: CytologySrv.GetCytologyImagesByDoctorIdAndPatientId:output_type -> GetCytologyImagesByDoctorIdAndPatientIdOut
+	15, // 75: CytologySrv.GetCytologyImagesByPatientId:output_type -> GetCytologyImagesByPatientIdOut
+	17, // 76: CytologySrv.GetCytologyImageIdsByDoctorIdAndPatientId:output_type -> GetCytologyImageIdsByDoctorIdAndPatientIdOut
+	19, // 77: CytologySrv.UpdateCytologyImage:output_type -> UpdateCytologyImageOut
+	65, // 78: CytologySrv.DeleteCytologyImage:output_type -> google.protobuf.Empty
+	22, // 79: CytologySrv.CopyCytologyImage:output_type -> CopyCytologyImageOut
+	24, // 80: CytologySrv.GetCytologyImageHistory:output_type -> GetCytologyImageHistoryOut
+	27, // 81: CytologySrv.CreateOriginalImage:output_type -> CreateOriginalImageOut
+	29, // 82: CytologySrv.GetOriginalImageById:output_type -> GetOriginalImageByIdOut
+	31, // 83: CytologySrv.GetOriginalImagesByCytologyId:output_type -> GetOriginalImagesByCytologyIdOut
+	33, // 84: CytologySrv.UpdateOriginalImage:output_type -> UpdateOriginalImageOut
+	36, // 85: CytologySrv.VerifyOriginalImageIntegrity:output_type -> VerifyOriginalImageIntegrityOut
+	39, // 86: CytologySrv.CreateSegmentationGroup:output_type -> CreateSegmentationGroupOut
+	41, // 87: CytologySrv.GetSegmentationGroupsByCytologyId:output_type -> GetSegmentationGroupsByCytologyIdOut
+	43, // 88: CytologySrv.UpdateSegmentationGroup:output_type -> UpdateSegmentationGroupOut
+	65, // 89: CytologySrv.DeleteSegmentationGroup:output_type -> google.protobuf.Empty
+	50, // 90: CytologySrv.CreateSegmentation:output_type -> CreateSegmentationOut
+	52, // 91: CytologySrv.GetSegmentationById:output_type -> GetSegmentationByIdOut
+	54, // 92: CytologySrv.GetSegmentsByGroupId:output_type -> GetSegmentsByGroupIdOut
+	57, // 93: CytologySrv.GetSegmentsInViewport:output_type -> GetSegmentsInViewportOut
+	59, // 94: CytologySrv.UpdateSegmentation:output_type -> UpdateSegmentationOut
+	65, // 95: CytologySrv.DeleteSegmentation:output_type -> google.protobuf.Empty
+	62, // 96: CytologySrv.ExportQuPathGeoJson:output_type -> ExportQuPathGeoJsonOut
+	64, // 97: CytologySrv.ImportQuPathGeoJson:output_type -> ImportQuPathGeoJsonOut
+	71, // [71:98] is the sub-list for method output_type
+	44, // [44:71] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_proto_grpc_service_proto_init() }
@@ -3531,17 +4589,22 @@ func file_proto_grpc_service_proto_init() {
 	file_proto_grpc_service_proto_msgTypes[20].OneofWrappers = []any{}
 	file_proto_grpc_service_proto_msgTypes[21].OneofWrappers = []any{}
 	file_proto_grpc_service_proto_msgTypes[27].OneofWrappers = []any{}
-	file_proto_grpc_service_proto_msgTypes[29].OneofWrappers = []any{}
-	file_proto_grpc_service_proto_msgTypes[30].OneofWrappers = []any{}
 	file_proto_grpc_service_proto_msgTypes[32].OneofWrappers = []any{}
-	file_proto_grpc_service_proto_msgTypes[34].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[35].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[42].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[51].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[52].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[53].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_grpc_service_proto_rawDesc), len(file_proto_grpc_service_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   49,
+			NumEnums:      5,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
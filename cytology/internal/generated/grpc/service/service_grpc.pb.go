@@ -34,6 +34,7 @@ const (
 	CytologySrv_GetOriginalImageById_FullMethodName                      = "/CytologySrv/GetOriginalImageById"
 	CytologySrv_GetOriginalImagesByCytologyId_FullMethodName             = "/CytologySrv/GetOriginalImagesByCytologyId"
 	CytologySrv_UpdateOriginalImage_FullMethodName                       = "/CytologySrv/UpdateOriginalImage"
+	CytologySrv_VerifyOriginalImageIntegrity_FullMethodName              = "/CytologySrv/VerifyOriginalImageIntegrity"
 	CytologySrv_CreateSegmentationGroup_FullMethodName                   = "/CytologySrv/CreateSegmentationGroup"
 	CytologySrv_GetSegmentationGroupsByCytologyId_FullMethodName         = "/CytologySrv/GetSegmentationGroupsByCytologyId"
 	CytologySrv_UpdateSegmentationGroup_FullMethodName                   = "/CytologySrv/UpdateSegmentationGroup"
@@ -41,8 +42,11 @@ const (
 	CytologySrv_CreateSegmentation_FullMethodName                        = "/CytologySrv/CreateSegmentation"
 	CytologySrv_GetSegmentationById_FullMethodName                       = "/CytologySrv/GetSegmentationById"
 	CytologySrv_GetSegmentsByGroupId_FullMethodName                      = "/CytologySrv/GetSegmentsByGroupId"
+	CytologySrv_GetSegmentsInViewport_FullMethodName                     = "/CytologySrv/GetSegmentsInViewport"
 	CytologySrv_UpdateSegmentation_FullMethodName                        = "/CytologySrv/UpdateSegmentation"
 	CytologySrv_DeleteSegmentation_FullMethodName                        = "/CytologySrv/DeleteSegmentation"
+	CytologySrv_ExportQuPathGeoJson_FullMethodName                       = "/CytologySrv/ExportQuPathGeoJson"
+	CytologySrv_ImportQuPathGeoJson_FullMethodName                       = "/CytologySrv/ImportQuPathGeoJson"
 )
 
 // CytologySrvClient is the client API for CytologySrv service.
@@ -65,6 +69,7 @@ type CytologySrvClient interface {
 	GetOriginalImageById(ctx context.Context, in *GetOriginalImageByIdIn, opts ...grpc.CallOption) (*GetOriginalImageByIdOut, error)
 	GetOriginalImagesByCytologyId(ctx context.Context, in *GetOriginalImagesByCytologyIdIn, opts ...grpc.CallOption) (*GetOriginalImagesByCytologyIdOut, error)
 	UpdateOriginalImage(ctx context.Context, in *UpdateOriginalImageIn, opts ...grpc.CallOption) (*UpdateOriginalImageOut, error)
+	VerifyOriginalImageIntegrity(ctx context.Context, in *VerifyOriginalImageIntegrityIn, opts ...grpc.CallOption) (*VerifyOriginalImageIntegrityOut, error)
 	// SEGMENTATION GROUP
 	CreateSegmentationGroup(ctx context.Context, in *CreateSegmentationGroupIn, opts ...grpc.CallOption) (*CreateSegmentationGroupOut, error)
 	GetSegmentationGroupsByCytologyId(ctx context.Context, in *GetSegmentationGroupsByCytologyIdIn, opts ...grpc.CallOption) (*GetSegmentationGroupsByCytologyIdOut, error)
@@ -74,8 +79,12 @@ type CytologySrvClient interface {
 	CreateSegmentation(ctx context.Context, in *CreateSegmentationIn, opts ...grpc.CallOption) (*CreateSegmentationOut, error)
 	GetSegmentationById(ctx context.Context, in *GetSegmentationByIdIn, opts ...grpc.CallOption) (*GetSegmentationByIdOut, error)
 	GetSegmentsByGroupId(ctx context.Context, in *GetSegmentsByGroupIdIn, opts ...grpc.CallOption) (*GetSegmentsByGroupIdOut, error)
+	GetSegmentsInViewport(ctx context.Context, in *GetSegmentsInViewportIn, opts ...grpc.CallOption) (*GetSegmentsInViewportOut, error)
 	UpdateSegmentation(ctx context.Context, in *UpdateSegmentationIn, opts ...grpc.CallOption) (*UpdateSegmentationOut, error)
 	DeleteSegmentation(ctx context.Context, in *DeleteSegmentationIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// QUPATH
+	ExportQuPathGeoJson(ctx context.Context, in *ExportQuPathGeoJsonIn, opts ...grpc.CallOption) (*ExportQuPathGeoJsonOut, error)
+	ImportQuPathGeoJson(ctx context.Context, in *ImportQuPathGeoJsonIn, opts ...grpc.CallOption) (*ImportQuPathGeoJsonOut, error)
 }
 
 type cytologySrvClient struct {
@@ -226,6 +235,16 @@ func (c *cytologySrvClient) UpdateOriginalImage(ctx context.Context, in *UpdateO
 	return out, nil
 }
 
+func (c *cytologySrvClient) VerifyOriginalImageIntegrity(ctx context.Context, in *VerifyOriginalImageIntegrityIn, opts ...grpc.CallOption) (*VerifyOriginalImageIntegrityOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyOriginalImageIntegrityOut)
+	err := c.cc.Invoke(ctx, CytologySrv_VerifyOriginalImageIntegrity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cytologySrvClient) CreateSegmentationGroup(ctx context.Context, in *CreateSegmentationGroupIn, opts ...grpc.CallOption) (*CreateSegmentationGroupOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSegmentationGroupOut)
@@ -296,6 +315,16 @@ func (c *cytologySrvClient) GetSegmentsByGroupId(ctx context.Context, in *GetSeg
 	return out, nil
 }
 
+func (c *cytologySrvClient) GetSegmentsInViewport(ctx context.Context, in *GetSegmentsInViewportIn, opts ...grpc.CallOption) (*GetSegmentsInViewportOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSegmentsInViewportOut)
+	err := c.cc.Invoke(ctx, CytologySrv_GetSegmentsInViewport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cytologySrvClient) UpdateSegmentation(ctx context.Context, in *UpdateSegmentationIn, opts ...grpc.CallOption) (*UpdateSegmentationOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateSegmentationOut)
@@ -316,6 +345,26 @@ func (c *cytologySrvClient) DeleteSegmentation(ctx context.Context, in *DeleteSe
 	return out, nil
 }
 
+func (c *cytologySrvClient) ExportQuPathGeoJson(ctx context.Context, in *ExportQuPathGeoJsonIn, opts ...grpc.CallOption) (*ExportQuPathGeoJsonOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportQuPathGeoJsonOut)
+	err := c.cc.Invoke(ctx, CytologySrv_ExportQuPathGeoJson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cytologySrvClient) ImportQuPathGeoJson(ctx context.Context, in *ImportQuPathGeoJsonIn, opts ...grpc.CallOption) (*ImportQuPathGeoJsonOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportQuPathGeoJsonOut)
+	err := c.cc.Invoke(ctx, CytologySrv_ImportQuPathGeoJson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CytologySrvServer is the server API for CytologySrv service.
 // All implementations must embed UnimplementedCytologySrvServer
 // for forward compatibility.
@@ -336,6 +385,7 @@ type CytologySrvServer interface {
 	GetOriginalImageById(context.Context, *GetOriginalImageByIdIn) (*GetOriginalImageByIdOut, error)
 	GetOriginalImagesByCytologyId(context.Context, *GetOriginalImagesByCytologyIdIn) (*GetOriginalImagesByCytologyIdOut, error)
 	UpdateOriginalImage(context.Context, *UpdateOriginalImageIn) (*UpdateOriginalImageOut, error)
+	VerifyOriginalImageIntegrity(context.Context, *VerifyOriginalImageIntegrityIn) (*VerifyOriginalImageIntegrityOut, error)
 	// SEGMENTATION GROUP
 	CreateSegmentationGroup(context.Context, *CreateSegmentationGroupIn) (*CreateSegmentationGroupOut, error)
 	GetSegmentationGroupsByCytologyId(context.Context, *GetSegmentationGroupsByCytologyIdIn) (*GetSegmentationGroupsByCytologyIdOut, error)
@@ -345,8 +395,12 @@ type CytologySrvServer interface {
 	CreateSegmentation(context.Context, *CreateSegmentationIn) (*CreateSegmentationOut, error)
 	GetSegmentationById(context.Context, *GetSegmentationByIdIn) (*GetSegmentationByIdOut, error)
 	GetSegmentsByGroupId(context.Context, *GetSegmentsByGroupIdIn) (*GetSegmentsByGroupIdOut, error)
+	GetSegmentsInViewport(context.Context, *GetSegmentsInViewportIn) (*GetSegmentsInViewportOut, error)
 	UpdateSegmentation(context.Context, *UpdateSegmentationIn) (*UpdateSegmentationOut, error)
 	DeleteSegmentation(context.Context, *DeleteSegmentationIn) (*emptypb.Empty, error)
+	// QUPATH
+	ExportQuPathGeoJson(context.Context, *ExportQuPathGeoJsonIn) (*ExportQuPathGeoJsonOut, error)
+	ImportQuPathGeoJson(context.Context, *ImportQuPathGeoJsonIn) (*ImportQuPathGeoJsonOut, error)
 	mustEmbedUnimplementedCytologySrvServer()
 }
 
@@ -399,6 +453,9 @@ func (UnimplementedCytologySrvServer) GetOriginalImagesByCytologyId(context.Cont
 func (UnimplementedCytologySrvServer) UpdateOriginalImage(context.Context, *UpdateOriginalImageIn) (*UpdateOriginalImageOut, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateOriginalImage not implemented")
 }
+func (UnimplementedCytologySrvServer) VerifyOriginalImageIntegrity(context.Context, *VerifyOriginalImageIntegrityIn) (*VerifyOriginalImageIntegrityOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyOriginalImageIntegrity not implemented")
+}
 func (UnimplementedCytologySrvServer) CreateSegmentationGroup(context.Context, *CreateSegmentationGroupIn) (*CreateSegmentationGroupOut, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateSegmentationGroup not implemented")
 }
@@ -420,12 +477,21 @@ func (UnimplementedCytologySrvServer) GetSegmentationById(context.Context, *GetS
 func (UnimplementedCytologySrvServer) GetSegmentsByGroupId(context.Context, *GetSegmentsByGroupIdIn) (*GetSegmentsByGroupIdOut, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSegmentsByGroupId not implemented")
 }
+func (UnimplementedCytologySrvServer) GetSegmentsInViewport(context.Context, *GetSegmentsInViewportIn) (*GetSegmentsInViewportOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSegmentsInViewport not implemented")
+}
 func (UnimplementedCytologySrvServer) UpdateSegmentation(context.Context, *UpdateSegmentationIn) (*UpdateSegmentationOut, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateSegmentation not implemented")
 }
 func (UnimplementedCytologySrvServer) DeleteSegmentation(context.Context, *DeleteSegmentationIn) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteSegmentation not implemented")
 }
+func (UnimplementedCytologySrvServer) ExportQuPathGeoJson(context.Context, *ExportQuPathGeoJsonIn) (*ExportQuPathGeoJsonOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportQuPathGeoJson not implemented")
+}
+func (UnimplementedCytologySrvServer) ImportQuPathGeoJson(context.Context, *ImportQuPathGeoJsonIn) (*ImportQuPathGeoJsonOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportQuPathGeoJson not implemented")
+}
 func (UnimplementedCytologySrvServer) mustEmbedUnimplementedCytologySrvServer() {}
 func (UnimplementedCytologySrvServer) testEmbeddedByValue()                     {}
 
@@ -699,6 +765,24 @@ func _CytologySrv_UpdateOriginalImage_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CytologySrv_VerifyOriginalImageIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyOriginalImageIntegrityIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CytologySrvServer).VerifyOriginalImageIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CytologySrv_VerifyOriginalImageIntegrity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CytologySrvServer).VerifyOriginalImageIntegrity(ctx, req.(*VerifyOriginalImageIntegrityIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CytologySrv_CreateSegmentationGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSegmentationGroupIn)
 	if err := dec(in); err != nil {
@@ -825,6 +909,24 @@ func _CytologySrv_GetSegmentsByGroupId_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CytologySrv_GetSegmentsInViewport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentsInViewportIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CytologySrvServer).GetSegmentsInViewport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CytologySrv_GetSegmentsInViewport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CytologySrvServer).GetSegmentsInViewport(ctx, req.(*GetSegmentsInViewportIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CytologySrv_UpdateSegmentation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSegmentationIn)
 	if err := dec(in); err != nil {
@@ -861,6 +963,42 @@ func _CytologySrv_DeleteSegmentation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CytologySrv_ExportQuPathGeoJson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportQuPathGeoJsonIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CytologySrvServer).ExportQuPathGeoJson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CytologySrv_ExportQuPathGeoJson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CytologySrvServer).ExportQuPathGeoJson(ctx, req.(*ExportQuPathGeoJsonIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CytologySrv_ImportQuPathGeoJson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportQuPathGeoJsonIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CytologySrvServer).ImportQuPathGeoJson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CytologySrv_ImportQuPathGeoJson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CytologySrvServer).ImportQuPathGeoJson(ctx, req.(*ImportQuPathGeoJsonIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CytologySrv_ServiceDesc is the grpc.ServiceDesc for CytologySrv service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -924,6 +1062,10 @@ var CytologySrv_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOriginalImage",
 			Handler:    _CytologySrv_UpdateOriginalImage_Handler,
 		},
+		{
+			MethodName: "VerifyOriginalImageIntegrity",
+			Handler:    _CytologySrv_VerifyOriginalImageIntegrity_Handler,
+		},
 		{
 			MethodName: "CreateSegmentationGroup",
 			Handler:    _CytologySrv_CreateSegmentationGroup_Handler,
@@ -952,6 +1094,10 @@ var CytologySrv_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSegmentsByGroupId",
 			Handler:    _CytologySrv_GetSegmentsByGroupId_Handler,
 		},
+		{
+			MethodName: "GetSegmentsInViewport",
+			Handler:    _CytologySrv_GetSegmentsInViewport_Handler,
+		},
 		{
 			MethodName: "UpdateSegmentation",
 			Handler:    _CytologySrv_UpdateSegmentation_Handler,
@@ -960,6 +1106,14 @@ var CytologySrv_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSegmentation",
 			Handler:    _CytologySrv_DeleteSegmentation_Handler,
 		},
+		{
+			MethodName: "ExportQuPathGeoJson",
+			Handler:    _CytologySrv_ExportQuPathGeoJson_Handler,
+		},
+		{
+			MethodName: "ImportQuPathGeoJson",
+			Handler:    _CytologySrv_ImportQuPathGeoJson_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/grpc/service.proto",
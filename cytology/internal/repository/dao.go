@@ -11,6 +11,7 @@ import (
 	"cytology/internal/repository/original_image"
 	"cytology/internal/repository/segmentation"
 	"cytology/internal/repository/segmentation_group"
+	"cytology/internal/repository/segmentation_import"
 )
 
 type DAO interface {
@@ -20,6 +21,7 @@ type DAO interface {
 	NewOriginalImageQuery(ctx context.Context) original_image.Repository
 	NewSegmentationGroupQuery(ctx context.Context) segmentation_group.Repository
 	NewSegmentationQuery(ctx context.Context) segmentation.Repository
+	NewSegmentationImportQuery(ctx context.Context) segmentation_import.Repository
 }
 
 type dao struct {
@@ -69,3 +71,9 @@ func (d *dao) NewSegmentationQuery(ctx context.Context) segmentation.Repository
 	d.NewRepo(ctx, query)
 	return query
 }
+
+func (d *dao) NewSegmentationImportQuery(ctx context.Context) segmentation_import.Repository {
+	query := segmentation_import.NewR()
+	d.NewRepo(ctx, query)
+	return query
+}
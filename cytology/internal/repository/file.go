@@ -16,6 +16,8 @@ var ErrFileNotFound = errors.New("file not found")
 
 type FileRepo interface {
 	GetFileViaTemp(ctx context.Context, path string) (domain.File, func() error, error)
+	// GetFile поток объекта без копирования во временный файл
+	GetFile(ctx context.Context, path string) (io.ReadCloser, error)
 	LoadFile(ctx context.Context, path string, file domain.File) error
 }
 
@@ -76,6 +78,24 @@ func (r *fileRepo) GetFileViaTemp(ctx context.Context, path string) (domain.File
 	}, closer, nil
 }
 
+func (r *fileRepo) GetFile(ctx context.Context, path string) (io.ReadCloser, error) {
+	_, err := r.s3.StatObject(ctx, r.bucket, path, minio.GetObjectOptions{})
+	if err != nil {
+		errResp := minio.ToErrorResponse(err)
+		if errResp.Code == "NoSuchKey" {
+			return nil, ErrFileNotFound
+		}
+		return nil, fmt.Errorf("get stat of object: %w", err)
+	}
+
+	obj, err := r.s3.GetObject(ctx, r.bucket, path, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get file from s3: %w", err)
+	}
+
+	return obj, nil
+}
+
 func (r *fileRepo) LoadFile(ctx context.Context, path string, file domain.File) error {
 	sizeUploaded, err := r.s3.PutObject(ctx, r.bucket, path, file.Buf, file.Size, minio.PutObjectOptions{ContentType: file.Format})
 	if err != nil {
@@ -15,6 +15,7 @@ func (q *repo) InsertOriginalImage(img entity.OriginalImage) error {
 			columnCreateDate,
 			columnDelayTime,
 			columnViewedFlag,
+			columnSha256,
 		).
 		Values(
 			img.Id,
@@ -23,6 +24,7 @@ func (q *repo) InsertOriginalImage(img entity.OriginalImage) error {
 			img.CreateDate,
 			img.DelayTime,
 			img.ViewedFlag,
+			img.Sha256,
 		)
 
 	_, err := q.Runner().Execx(q.Context(), query)
@@ -11,12 +11,13 @@ import (
 )
 
 type OriginalImage struct {
-	Id         uuid.UUID      `db:"id"`
-	CytologyID uuid.UUID      `db:"cytology_id"`
-	ImagePath  string         `db:"image_path"`
-	CreateDate time.Time      `db:"create_date"`
+	Id         uuid.UUID       `db:"id"`
+	CytologyID uuid.UUID       `db:"cytology_id"`
+	ImagePath  string          `db:"image_path"`
+	CreateDate time.Time       `db:"create_date"`
 	DelayTime  sql.NullFloat64 `db:"delay_time"`
-	ViewedFlag bool           `db:"viewed_flag"`
+	ViewedFlag bool            `db:"viewed_flag"`
+	Sha256     sql.NullString  `db:"sha256"`
 }
 
 func (OriginalImage) FromDomain(d domain.OriginalImage) OriginalImage {
@@ -27,6 +28,7 @@ func (OriginalImage) FromDomain(d domain.OriginalImage) OriginalImage {
 		CreateDate: d.CreateDate,
 		DelayTime:  gtc.Float64.PointerToSql(d.DelayTime),
 		ViewedFlag: d.ViewedFlag,
+		Sha256:     gtc.String.PointerToSql(d.Sha256),
 	}
 }
 
@@ -38,6 +40,7 @@ func (d OriginalImage) ToDomain() domain.OriginalImage {
 		CreateDate: d.CreateDate,
 		DelayTime:  gtc.Float64.SqlToPointer(d.DelayTime),
 		ViewedFlag: d.ViewedFlag,
+		Sha256:     gtc.String.SqlToPointer(d.Sha256),
 	}
 }
 
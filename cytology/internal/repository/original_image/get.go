@@ -20,6 +20,7 @@ func (q *repo) GetOriginalImageByID(id uuid.UUID) (entity.OriginalImage, error)
 			columnCreateDate,
 			columnDelayTime,
 			columnViewedFlag,
+			columnSha256,
 		).
 		From(table).
 		Where(sq.Eq{
@@ -46,6 +47,7 @@ func (q *repo) GetOriginalImagesByCytologyID(cytologyID uuid.UUID) ([]entity.Ori
 			columnCreateDate,
 			columnDelayTime,
 			columnViewedFlag,
+			columnSha256,
 		).
 		From(table).
 		Where(sq.Eq{
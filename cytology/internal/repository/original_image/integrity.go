@@ -0,0 +1,58 @@
+package original_image
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"cytology/internal/repository/original_image/entity"
+)
+
+const (
+	cytologyImageTable           = "cytology_image"
+	cytologyImageColumnID        = "cytology_image.id"
+	cytologyImageColumnPatientID = "cytology_image.patient_id"
+)
+
+func (q *repo) GetOriginalImageIDsByPatientAndSha256(patientID uuid.UUID, sha256 string) ([]uuid.UUID, error) {
+	query := q.QueryBuilder().
+		Select(table + "." + columnID).
+		From(table).
+		Join(cytologyImageTable + " ON " + cytologyImageColumnID + " = " + table + "." + columnCytologyID).
+		Where(sq.Eq{
+			cytologyImageColumnPatientID: patientID,
+			table + "." + columnSha256:   sha256,
+		}).
+		OrderBy(table + "." + columnCreateDate)
+
+	var ids []uuid.UUID
+	if err := q.Runner().Selectx(q.Context(), &ids, query); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (q *repo) GetOriginalImagesWithSha256(after uuid.UUID, limit int) ([]entity.OriginalImage, error) {
+	query := q.QueryBuilder().
+		Select(
+			columnID,
+			columnCytologyID,
+			columnImagePath,
+			columnCreateDate,
+			columnDelayTime,
+			columnViewedFlag,
+			columnSha256,
+		).
+		From(table).
+		Where(sq.NotEq{columnSha256: nil}).
+		Where(sq.Gt{columnID: after}).
+		OrderBy(columnID).
+		Limit(uint64(limit))
+
+	var images []entity.OriginalImage
+	if err := q.Runner().Selectx(q.Context(), &images, query); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
@@ -16,6 +16,7 @@ const (
 	columnCreateDate = "create_date"
 	columnDelayTime  = "delay_time"
 	columnViewedFlag = "viewed_flag"
+	columnSha256     = "sha256"
 )
 
 type Repository interface {
@@ -23,6 +24,11 @@ type Repository interface {
 	GetOriginalImageByID(id uuid.UUID) (entity.OriginalImage, error)
 	GetOriginalImagesByCytologyID(cytologyID uuid.UUID) ([]entity.OriginalImage, error)
 	UpdateOriginalImage(img entity.OriginalImage) error
+
+	// GetOriginalImageIDsByPatientAndSha256 изображения пациента с тем же файлом, от старых к новым
+	GetOriginalImageIDsByPatientAndSha256(patientID uuid.UUID, sha256 string) ([]uuid.UUID, error)
+	// GetOriginalImagesWithSha256 страница изображений с сохраненной sha256 по возрастанию id
+	GetOriginalImagesWithSha256(after uuid.UUID, limit int) ([]entity.OriginalImage, error)
 }
 
 type repo struct {
@@ -1,20 +1,48 @@
 package segmentation
 
 import (
+	"fmt"
+
 	repoEntity "cytology/internal/repository/entity"
 	"cytology/internal/repository/segmentation/entity"
 )
 
 func (q *repo) InsertSegmentation(seg entity.Segmentation) (int, error) {
-	// Вставляем сегментацию (ID будет сгенерирован автоматически, так как это SERIAL)
+	// ID будет сгенерирован автоматически, так как это SERIAL
 	query := q.QueryBuilder().
 		Insert(table).
 		Columns(
 			columnSegmentationGroupID,
+			columnGeometryType,
+			columnCoordinates,
+			columnRings,
+			columnPointUIDs,
+			columnPointIDs,
+			columnMinX,
+			columnMinY,
+			columnMaxX,
+			columnMaxY,
+			columnColor,
+			columnIsLocked,
+			columnConfidence,
+			columnProperties,
 			columnCreateAt,
 		).
 		Values(
 			seg.SegmentationGroupID,
+			seg.GeometryType,
+			seg.Coordinates,
+			seg.Rings,
+			seg.PointUIDs,
+			newPointIDs(len(seg.Coordinates)/2),
+			seg.MinX,
+			seg.MinY,
+			seg.MaxX,
+			seg.MaxY,
+			seg.Color,
+			seg.IsLocked,
+			seg.Confidence,
+			seg.Properties,
 			seg.CreateAt,
 		).
 		Suffix("RETURNING id")
@@ -25,33 +53,79 @@ func (q *repo) InsertSegmentation(seg entity.Segmentation) (int, error) {
 		return 0, repoEntity.WrapDBError(err)
 	}
 
-	// Вставляем точки (ID будут сгенерированы автоматически)
-	if len(seg.Points) > 0 {
-		pointsQuery := q.QueryBuilder().
-			Insert(pointTable).
+	return id, nil
+}
+
+func (q *repo) InsertSegmentations(segs []entity.Segmentation) ([]int, error) {
+	ids := make([]int, 0, len(segs))
+	for start := 0; start < len(segs); start += insertSegmentationsBatch {
+		end := min(start+insertSegmentationsBatch, len(segs))
+
+		// порядок строк RETURNING не гарантирован, поэтому id берутся заранее и вставляются явно
+		batchIDs, err := q.nextIDs(end - start)
+		if err != nil {
+			return nil, err
+		}
+
+		query := q.QueryBuilder().
+			Insert(table).
 			Columns(
-				pointColumnSegmentationID,
-				pointColumnX,
-				pointColumnY,
-				pointColumnUID,
-				pointColumnCreateAt,
+				columnID,
+				columnSegmentationGroupID,
+				columnGeometryType,
+				columnCoordinates,
+				columnRings,
+				columnPointUIDs,
+				columnPointIDs,
+				columnMinX,
+				columnMinY,
+				columnMaxX,
+				columnMaxY,
+				columnColor,
+				columnIsLocked,
+				columnConfidence,
+				columnProperties,
+				columnCreateAt,
 			)
-
-		for _, point := range seg.Points {
-			pointsQuery = pointsQuery.Values(
-				id, // Используем созданный ID сегментации
-				point.X,
-				point.Y,
-				point.UID,
-				point.CreateAt,
+		for i, seg := range segs[start:end] {
+			query = query.Values(
+				batchIDs[i],
+				seg.SegmentationGroupID,
+				seg.GeometryType,
+				seg.Coordinates,
+				seg.Rings,
+				seg.PointUIDs,
+				newPointIDs(len(seg.Coordinates)/2),
+				seg.MinX,
+				seg.MinY,
+				seg.MaxX,
+				seg.MaxY,
+				seg.Color,
+				seg.IsLocked,
+				seg.Confidence,
+				seg.Properties,
+				seg.CreateAt,
 			)
 		}
 
-		_, err = q.Runner().Execx(q.Context(), pointsQuery)
-		if err != nil {
-			return 0, repoEntity.WrapDBError(err)
+		if _, err := q.Runner().Execx(q.Context(), query); err != nil {
+			return nil, repoEntity.WrapDBError(err)
 		}
+		ids = append(ids, batchIDs...)
 	}
 
-	return id, nil
+	return ids, nil
+}
+
+// nextIDs n новых id сегментаций
+func (q *repo) nextIDs(n int) ([]int, error) {
+	query := q.QueryBuilder().
+		Select("nextval('" + idSequence + "')::integer").
+		From(fmt.Sprintf("generate_series(1, %d)", n))
+
+	var ids []int
+	if err := q.Runner().Selectx(q.Context(), &ids, query); err != nil {
+		return nil, repoEntity.WrapDBError(err)
+	}
+	return ids, nil
 }
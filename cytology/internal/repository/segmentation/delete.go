@@ -7,7 +7,6 @@ import (
 )
 
 func (r *repo) DeleteSegmentation(id int) error {
-	// Точки удалятся каскадно из-за ON DELETE CASCADE
 	query := r.QueryBuilder().
 		Delete(table).
 		Where(sq.Eq{
@@ -1,74 +1,112 @@
 package entity
 
 import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/lib/pq"
+
 	"cytology/internal/domain"
 )
 
 type Segmentation struct {
-	Id                  int       `db:"id"`
-	SegmentationGroupID int       `db:"segmentation_group_id"`
-	CreateAt            time.Time `db:"create_at"`
-	Points              []SegmentationPoint
+	Id                  int             `db:"id"`
+	SegmentationGroupID int             `db:"segmentation_group_id"`
+	GeometryType        string          `db:"geometry_type"`
+	Coordinates         pq.Int32Array   `db:"coordinates"`
+	Rings               pq.Int32Array   `db:"rings"`
+	PointUIDs           pq.Int64Array   `db:"point_uids"`
+	PointIDs            pq.Int32Array   `db:"point_ids"`
+	MinX                int             `db:"min_x"`
+	MinY                int             `db:"min_y"`
+	MaxX                int             `db:"max_x"`
+	MaxY                int             `db:"max_y"`
+	Color               sql.NullString  `db:"color"`
+	IsLocked            bool            `db:"is_locked"`
+	Confidence          sql.NullFloat64 `db:"confidence"`
+	Properties          sql.NullString  `db:"properties"`
+	CreateAt            time.Time       `db:"create_at"`
 }
 
-type SegmentationPoint struct {
-	Id             int       `db:"id"`
-	SegmentationID int       `db:"segmentation_id"`
-	X              int       `db:"x"`
-	Y              int       `db:"y"`
-	UID            int64     `db:"uid"`
-	CreateAt       time.Time `db:"create_at"`
-}
+func (Segmentation) FromDomain(d domain.Segmentation) Segmentation {
+	geometry := encodeGeometry(d.Points)
 
-func (SegmentationPoint) FromDomain(d domain.SegmentationPoint) SegmentationPoint {
-	return SegmentationPoint{
-		Id:             d.Id,
-		SegmentationID: d.SegmentationID,
-		X:              d.X,
-		Y:              d.Y,
-		UID:            d.UID,
-		CreateAt:       d.CreateAt,
+	var color sql.NullString
+	if d.Color != nil {
+		color = sql.NullString{String: fmt.Sprintf("#%02x%02x%02x", d.Color.R, d.Color.G, d.Color.B), Valid: true}
 	}
-}
 
-func (d SegmentationPoint) ToDomain() domain.SegmentationPoint {
-	return domain.SegmentationPoint{
-		Id:             d.Id,
-		SegmentationID: d.SegmentationID,
-		X:              d.X,
-		Y:              d.Y,
-		UID:            d.UID,
-		CreateAt:       d.CreateAt,
+	var confidence sql.NullFloat64
+	if d.Confidence != nil {
+		confidence = sql.NullFloat64{Float64: *d.Confidence, Valid: true}
 	}
-}
 
-func (Segmentation) FromDomain(d domain.Segmentation) Segmentation {
-	points := make([]SegmentationPoint, 0, len(d.Points))
-	for _, p := range d.Points {
-		points = append(points, SegmentationPoint{}.FromDomain(p))
+	var properties sql.NullString
+	if len(d.Properties) > 0 && string(d.Properties) != "null" {
+		properties = sql.NullString{String: string(d.Properties), Valid: true}
+	}
+
+	geometryType := d.GeometryType
+	if geometryType == "" {
+		geometryType = domain.GeometryTypePolygon
 	}
 
 	return Segmentation{
 		Id:                  d.Id,
 		SegmentationGroupID: d.SegmentationGroupID,
+		GeometryType:        geometryType.String(),
+		Coordinates:         geometry.coordinates,
+		Rings:               geometry.rings,
+		PointUIDs:           geometry.uids,
+		MinX:                geometry.minX,
+		MinY:                geometry.minY,
+		MaxX:                geometry.maxX,
+		MaxY:                geometry.maxY,
+		Color:               color,
+		IsLocked:            d.IsLocked,
+		Confidence:          confidence,
+		Properties:          properties,
 		CreateAt:            d.CreateAt,
-		Points:              points,
 	}
 }
 
 func (d Segmentation) ToDomain() domain.Segmentation {
-	points := make([]domain.SegmentationPoint, 0, len(d.Points))
-	for _, p := range d.Points {
-		points = append(points, p.ToDomain())
+	points := decodeGeometry(d.Coordinates, d.Rings, d.PointUIDs, d.PointIDs)
+	for i := range points {
+		points[i].SegmentationID = d.Id
+		points[i].CreateAt = d.CreateAt
+	}
+
+	var color *domain.Color
+	if d.Color.Valid {
+		c := domain.Color{}
+		if _, err := fmt.Sscanf(d.Color.String, "#%02x%02x%02x", &c.R, &c.G, &c.B); err == nil {
+			color = &c
+		}
+	}
+
+	var confidence *float64
+	if d.Confidence.Valid {
+		confidence = &d.Confidence.Float64
+	}
+
+	var properties json.RawMessage
+	if d.Properties.Valid {
+		properties = json.RawMessage(d.Properties.String)
 	}
 
 	return domain.Segmentation{
 		Id:                  d.Id,
 		SegmentationGroupID: d.SegmentationGroupID,
-		CreateAt:            d.CreateAt,
+		GeometryType:        domain.GeometryType(d.GeometryType),
 		Points:              points,
+		Color:               color,
+		IsLocked:            d.IsLocked,
+		Confidence:          confidence,
+		Properties:          properties,
+		CreateAt:            d.CreateAt,
 	}
 }
 
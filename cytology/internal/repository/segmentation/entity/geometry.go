@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"github.com/lib/pq"
+
+	"cytology/internal/domain"
+)
+
+// geometry компактное хранение точек сегментации одной строкой
+type geometry struct {
+	// x0, y0, x1, y1, ...
+	coordinates pq.Int32Array
+	// кольца по порядку парами: номер полигона, число точек
+	rings pq.Int32Array
+	// nil, если у всех точек uid 0
+	uids                   pq.Int64Array
+	minX, minY, maxX, maxY int
+}
+
+// encodeGeometry точки должны идти кольцами по порядку, как требует domain.Segmentation.Validate
+func encodeGeometry(points []domain.SegmentationPoint) geometry {
+	g := geometry{
+		coordinates: make(pq.Int32Array, 0, 2*len(points)),
+		rings:       pq.Int32Array{},
+	}
+
+	hasUIDs := false
+	for i, p := range points {
+		g.coordinates = append(g.coordinates, int32(p.X), int32(p.Y))
+
+		if i == 0 || p.Polygon != points[i-1].Polygon || p.Ring != points[i-1].Ring {
+			g.rings = append(g.rings, int32(p.Polygon), 0)
+		}
+		g.rings[len(g.rings)-1]++
+
+		if i == 0 {
+			g.minX, g.minY, g.maxX, g.maxY = p.X, p.Y, p.X, p.Y
+		}
+		g.minX, g.minY = min(g.minX, p.X), min(g.minY, p.Y)
+		g.maxX, g.maxY = max(g.maxX, p.X), max(g.maxY, p.Y)
+
+		hasUIDs = hasUIDs || p.UID != 0
+	}
+
+	if hasUIDs {
+		g.uids = make(pq.Int64Array, 0, len(points))
+		for _, p := range points {
+			g.uids = append(g.uids, p.UID)
+		}
+	}
+
+	return g
+}
+
+// decodeGeometry id точек выдает репозиторий при записи геометрии, encodeGeometry их не пишет
+func decodeGeometry(coordinates, rings pq.Int32Array, uids pq.Int64Array, ids pq.Int32Array) []domain.SegmentationPoint {
+	points := make([]domain.SegmentationPoint, 0, len(coordinates)/2)
+
+	ring := 0
+	for k := 0; k+1 < len(rings); k += 2 {
+		polygon, n := int(rings[k]), int(rings[k+1])
+		if k > 0 && polygon != int(rings[k-2]) {
+			ring = 0
+		}
+
+		for j := 0; j < n; j++ {
+			i := len(points)
+			if 2*i+1 >= len(coordinates) {
+				return points
+			}
+
+			point := domain.SegmentationPoint{
+				Polygon: polygon,
+				Ring:    ring,
+				X:       int(coordinates[2*i]),
+				Y:       int(coordinates[2*i+1]),
+			}
+			if i < len(uids) {
+				point.UID = uids[i]
+			}
+			if i < len(ids) {
+				point.Id = int(ids[i])
+			}
+			points = append(points, point)
+		}
+		ring++
+	}
+
+	return points
+}
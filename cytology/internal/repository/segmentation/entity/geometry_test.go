@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"testing"
+
+	"cytology/internal/domain"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometryRoundTrip(t *testing.T) {
+	points := []domain.SegmentationPoint{
+		{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10},
+		{Ring: 1, X: 1, Y: 1}, {Ring: 1, X: 2, Y: 1}, {Ring: 1, X: 1, Y: 2},
+		{Polygon: 1, X: 20, Y: -5}, {Polygon: 1, X: 30, Y: 20}, {Polygon: 1, X: 20, Y: 30},
+	}
+
+	g := encodeGeometry(points)
+	require.Equal(t, pq.Int32Array{0, 3, 0, 3, 1, 3}, g.rings)
+	require.Len(t, g.coordinates, 18)
+	require.Nil(t, g.uids)
+	require.Equal(t, []int{0, -5, 30, 30}, []int{g.minX, g.minY, g.maxX, g.maxY})
+
+	ids := make(pq.Int32Array, 0, len(points))
+	for i := range points {
+		points[i].Id = 100 + i
+		ids = append(ids, int32(100+i))
+	}
+	decoded := decodeGeometry(g.coordinates, g.rings, g.uids, ids)
+	require.Equal(t, points, decoded)
+}
+
+func TestGeometryUIDs(t *testing.T) {
+	points := []domain.SegmentationPoint{{X: 1, Y: 2}, {X: 3, Y: 4, UID: 7}}
+
+	g := encodeGeometry(points)
+	require.Equal(t, pq.Int64Array{0, 7}, g.uids)
+
+	decoded := decodeGeometry(g.coordinates, g.rings, g.uids, nil)
+	require.Equal(t, int64(7), decoded[1].UID)
+}
+
+func TestGeometryEmpty(t *testing.T) {
+	g := encodeGeometry(nil)
+	require.Empty(t, g.coordinates)
+	require.Empty(t, g.rings)
+	require.Empty(t, decodeGeometry(g.coordinates, g.rings, g.uids, nil))
+}
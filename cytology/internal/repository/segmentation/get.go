@@ -11,11 +11,23 @@ import (
 )
 
 func (q *repo) GetSegmentationByID(id int) (entity.Segmentation, error) {
-	// Получаем сегментацию
 	query := q.QueryBuilder().
 		Select(
 			columnID,
 			columnSegmentationGroupID,
+			columnGeometryType,
+			columnCoordinates,
+			columnRings,
+			columnPointUIDs,
+			columnPointIDs,
+			columnMinX,
+			columnMinY,
+			columnMaxX,
+			columnMaxY,
+			columnColor,
+			columnIsLocked,
+			columnConfidence,
+			columnProperties,
 			columnCreateAt,
 		).
 		From(table).
@@ -31,42 +43,34 @@ func (q *repo) GetSegmentationByID(id int) (entity.Segmentation, error) {
 		return entity.Segmentation{}, err
 	}
 
-	// Получаем точки
-	pointsQuery := q.QueryBuilder().
-		Select(
-			pointColumnID,
-			pointColumnSegmentationID,
-			pointColumnX,
-			pointColumnY,
-			pointColumnUID,
-			pointColumnCreateAt,
-		).
-		From(pointTable).
-		Where(sq.Eq{
-			pointColumnSegmentationID: id,
-		})
-
-	var points []entity.SegmentationPoint
-	if err := q.Runner().Selectx(q.Context(), &points, pointsQuery); err != nil {
-		return entity.Segmentation{}, err
-	}
-
-	seg.Points = points
 	return seg, nil
 }
 
 func (q *repo) GetSegmentsByGroupID(groupID int) ([]entity.Segmentation, error) {
-	// Получаем все сегментации группы
 	query := q.QueryBuilder().
 		Select(
 			columnID,
 			columnSegmentationGroupID,
+			columnGeometryType,
+			columnCoordinates,
+			columnRings,
+			columnPointUIDs,
+			columnPointIDs,
+			columnMinX,
+			columnMinY,
+			columnMaxX,
+			columnMaxY,
+			columnColor,
+			columnIsLocked,
+			columnConfidence,
+			columnProperties,
 			columnCreateAt,
 		).
 		From(table).
 		Where(sq.Eq{
 			columnSegmentationGroupID: groupID,
-		})
+		}).
+		OrderBy(columnID)
 
 	var segs []entity.Segmentation
 	if err := q.Runner().Selectx(q.Context(), &segs, query); err != nil {
@@ -77,29 +81,5 @@ func (q *repo) GetSegmentsByGroupID(groupID int) ([]entity.Segmentation, error)
 		return nil, daoEntity.ErrNotFound
 	}
 
-	// Получаем точки для каждой сегментации
-	for i := range segs {
-		pointsQuery := q.QueryBuilder().
-			Select(
-				pointColumnID,
-				pointColumnSegmentationID,
-				pointColumnX,
-				pointColumnY,
-				pointColumnUID,
-				pointColumnCreateAt,
-			).
-			From(pointTable).
-			Where(sq.Eq{
-				pointColumnSegmentationID: segs[i].Id,
-			})
-
-		var points []entity.SegmentationPoint
-		if err := q.Runner().Selectx(q.Context(), &points, pointsQuery); err != nil {
-			return nil, err
-		}
-
-		segs[i].Points = points
-	}
-
 	return segs, nil
 }
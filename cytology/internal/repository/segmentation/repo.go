@@ -1,8 +1,11 @@
 package segmentation
 
 import (
+	sq "github.com/Masterminds/squirrel"
 	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+	"github.com/google/uuid"
 
+	"cytology/internal/domain"
 	"cytology/internal/repository/segmentation/entity"
 )
 
@@ -11,24 +14,50 @@ const (
 
 	columnID                  = "id"
 	columnSegmentationGroupID = "segmentation_group_id"
+	columnGeometryType        = "geometry_type"
+	columnCoordinates         = "coordinates"
+	columnRings               = "rings"
+	columnPointUIDs           = "point_uids"
+	columnPointIDs            = "point_ids"
+	columnMinX                = "min_x"
+	columnMinY                = "min_y"
+	columnMaxX                = "max_x"
+	columnMaxY                = "max_y"
+	columnColor               = "color"
+	columnIsLocked            = "is_locked"
+	columnConfidence          = "confidence"
+	columnProperties          = "properties"
 	columnCreateAt            = "create_at"
 )
 
-const (
-	pointTable = "segmentation_point"
-
-	pointColumnID             = "id"
-	pointColumnSegmentationID = "segmentation_id"
-	pointColumnX              = "x"
-	pointColumnY              = "y"
-	pointColumnUID            = "uid"
-	pointColumnCreateAt       = "create_at"
-)
+// сколько строк вставляется одним запросом, чтобы не превысить лимит параметров postgres
+const insertSegmentationsBatch = 1000
+
+// последовательность id точек, осталась от таблицы segmentation_point
+const pointIDSequence = "segmentation_point_id_seq"
+
+// последовательность SERIAL колонки id
+const idSequence = "segmentation_id_seq"
+
+// newPointIDs выражение с n новыми id точек
+func newPointIDs(n int) sq.Sqlizer {
+	return sq.Expr("ARRAY(SELECT nextval('"+pointIDSequence+"')::integer FROM generate_series(1, ?))", n)
+}
 
 type Repository interface {
 	InsertSegmentation(seg entity.Segmentation) (int, error)
+	// InsertSegmentations вставляет сегментации пачками, id возвращаются в порядке segs
+	InsertSegmentations(segs []entity.Segmentation) ([]int, error)
 	GetSegmentationByID(id int) (entity.Segmentation, error)
 	GetSegmentsByGroupID(groupID int) ([]entity.Segmentation, error)
+	// GetSegmentsInBBox сегментации исследования в прямоугольнике, страница после afterID
+	GetSegmentsInBBox(
+		cytologyID uuid.UUID,
+		bbox domain.BBox,
+		filter domain.SegmentationViewportFilter,
+		afterID int,
+		limit int,
+	) ([]entity.Segmentation, error)
 	UpdateSegmentation(seg entity.Segmentation) error
 	DeleteSegmentation(id int) error
 }
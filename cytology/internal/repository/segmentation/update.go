@@ -8,45 +8,36 @@ import (
 )
 
 func (q *repo) UpdateSegmentation(seg entity.Segmentation) error {
-	// Удаляем старые точки
-	deleteQuery := q.QueryBuilder().
-		Delete(pointTable).
+	// при той же геометрии точки сохраняют id
+	pointIDs := sq.Expr(
+		"CASE WHEN "+columnCoordinates+" = ? AND "+columnRings+" = ? THEN "+columnPointIDs+" ELSE ? END",
+		seg.Coordinates, seg.Rings, newPointIDs(len(seg.Coordinates)/2),
+	)
+
+	query := q.QueryBuilder().
+		Update(table).
+		SetMap(sq.Eq{
+			columnGeometryType: seg.GeometryType,
+			columnCoordinates:  seg.Coordinates,
+			columnRings:        seg.Rings,
+			columnPointUIDs:    seg.PointUIDs,
+			columnPointIDs:     pointIDs,
+			columnMinX:         seg.MinX,
+			columnMinY:         seg.MinY,
+			columnMaxX:         seg.MaxX,
+			columnMaxY:         seg.MaxY,
+			columnColor:        seg.Color,
+			columnIsLocked:     seg.IsLocked,
+			columnConfidence:   seg.Confidence,
+			columnProperties:   seg.Properties,
+		}).
 		Where(sq.Eq{
-			pointColumnSegmentationID: seg.Id,
+			columnID: seg.Id,
 		})
 
-	_, err := q.Runner().Execx(q.Context(), deleteQuery)
-	if err != nil {
+	if _, err := q.Runner().Execx(q.Context(), query); err != nil {
 		return repoEntity.WrapDBError(err)
 	}
 
-	// Вставляем новые точки (ID будут сгенерированы автоматически БД)
-	if len(seg.Points) > 0 {
-		pointsQuery := q.QueryBuilder().
-			Insert(pointTable).
-			Columns(
-				pointColumnSegmentationID,
-				pointColumnX,
-				pointColumnY,
-				pointColumnUID,
-				pointColumnCreateAt,
-			)
-
-		for _, point := range seg.Points {
-			pointsQuery = pointsQuery.Values(
-				point.SegmentationID,
-				point.X,
-				point.Y,
-				point.UID,
-				point.CreateAt,
-			)
-		}
-
-		_, err = q.Runner().Execx(q.Context(), pointsQuery)
-		if err != nil {
-			return repoEntity.WrapDBError(err)
-		}
-	}
-
 	return nil
 }
@@ -0,0 +1,83 @@
+package segmentation
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"cytology/internal/domain"
+	"cytology/internal/repository/segmentation/entity"
+)
+
+const (
+	groupTable            = "segmentation_group"
+	groupColumnID         = groupTable + ".id"
+	groupColumnCytologyID = groupTable + ".cytology_id"
+	groupColumnSegType    = groupTable + ".seg_type"
+	groupColumnGroupType  = groupTable + ".group_type"
+	groupColumnIsAI       = groupTable + ".is_ai"
+)
+
+// GetSegmentsInBBox возвращает не более limit сегментаций исследования с id больше afterID,
+// ограничивающий прямоугольник которых пересекается с bbox, отсортированных по id
+func (q *repo) GetSegmentsInBBox(
+	cytologyID uuid.UUID,
+	bbox domain.BBox,
+	filter domain.SegmentationViewportFilter,
+	afterID int,
+	limit int,
+) ([]entity.Segmentation, error) {
+	column := func(name string) string { return table + "." + name }
+
+	// выражение совпадает с idx_segmentation_bbox, чтобы использовался GiST индекс
+	intersects := sq.Expr(
+		"box(point("+column(columnMinX)+", "+column(columnMinY)+"), point("+column(columnMaxX)+", "+column(columnMaxY)+")) && box(point(?, ?), point(?, ?))",
+		bbox.MinX, bbox.MinY, bbox.MaxX, bbox.MaxY,
+	)
+
+	where := sq.And{
+		sq.Eq{groupColumnCytologyID: cytologyID},
+		intersects,
+		sq.Gt{column(columnID): afterID},
+	}
+	if filter.SegType != nil {
+		where = append(where, sq.Eq{groupColumnSegType: filter.SegType.String()})
+	}
+	if filter.GroupType != nil {
+		where = append(where, sq.Eq{groupColumnGroupType: filter.GroupType.String()})
+	}
+	if filter.IsAI != nil {
+		where = append(where, sq.Eq{groupColumnIsAI: *filter.IsAI})
+	}
+
+	query := q.QueryBuilder().
+		Select(
+			column(columnID),
+			column(columnSegmentationGroupID),
+			column(columnGeometryType),
+			column(columnCoordinates),
+			column(columnRings),
+			column(columnPointUIDs),
+			column(columnPointIDs),
+			column(columnMinX),
+			column(columnMinY),
+			column(columnMaxX),
+			column(columnMaxY),
+			column(columnColor),
+			column(columnIsLocked),
+			column(columnConfidence),
+			column(columnProperties),
+			column(columnCreateAt),
+		).
+		From(table).
+		Join(groupTable + " ON " + groupColumnID + " = " + column(columnSegmentationGroupID)).
+		Where(where).
+		OrderBy(column(columnID)).
+		Limit(uint64(limit))
+
+	var segs []entity.Segmentation
+	if err := q.Runner().Selectx(q.Context(), &segs, query); err != nil {
+		return nil, err
+	}
+
+	return segs, nil
+}
@@ -10,6 +10,7 @@ func (q *repo) InsertSegmentationGroup(group entity.SegmentationGroup) (int, err
 		Insert(table).
 		Columns(
 			columnCytologyID,
+			columnOriginalImageID,
 			columnSegType,
 			columnGroupType,
 			columnIsAI,
@@ -18,6 +19,7 @@ func (q *repo) InsertSegmentationGroup(group entity.SegmentationGroup) (int, err
 		).
 		Values(
 			group.CytologyID,
+			group.OriginalImageID,
 			group.SegType,
 			group.GroupType,
 			group.IsAI,
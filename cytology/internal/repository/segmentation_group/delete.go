@@ -2,6 +2,7 @@ package segmentation_group
 
 import (
 	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 
 	repoEntity "cytology/internal/repository/entity"
 )
@@ -20,3 +21,25 @@ func (r *repo) DeleteSegmentationGroup(id int) error {
 
 	return nil
 }
+
+func (r *repo) DeleteAISegmentationGroupsByOriginalImageID(cytologyID, originalImageID uuid.UUID) (int, error) {
+	query := r.QueryBuilder().
+		Delete(table).
+		Where(sq.Eq{
+			columnCytologyID:      cytologyID,
+			columnOriginalImageID: originalImageID,
+			columnIsAI:            true,
+		})
+
+	res, err := r.Runner().Execx(r.Context(), query)
+	if err != nil {
+		return 0, repoEntity.WrapDBError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
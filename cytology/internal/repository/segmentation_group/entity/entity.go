@@ -11,13 +11,14 @@ import (
 )
 
 type SegmentationGroup struct {
-	Id         int            `db:"id"`
-	CytologyID uuid.UUID      `db:"cytology_id"`
-	SegType    string         `db:"seg_type"`
-	GroupType  string         `db:"group_type"`
-	IsAI       bool           `db:"is_ai"`
-	Details    sql.NullString `db:"details"`
-	CreateAt   time.Time      `db:"create_at"`
+	Id              int            `db:"id"`
+	CytologyID      uuid.UUID      `db:"cytology_id"`
+	OriginalImageID uuid.NullUUID  `db:"original_image_id"`
+	SegType         string         `db:"seg_type"`
+	GroupType       string         `db:"group_type"`
+	IsAI            bool           `db:"is_ai"`
+	Details         sql.NullString `db:"details"`
+	CreateAt        time.Time      `db:"create_at"`
 }
 
 func (SegmentationGroup) FromDomain(d domain.SegmentationGroup) SegmentationGroup {
@@ -26,14 +27,20 @@ func (SegmentationGroup) FromDomain(d domain.SegmentationGroup) SegmentationGrou
 		details = sql.NullString{String: string(d.Details), Valid: true}
 	}
 
+	var originalImageID uuid.NullUUID
+	if d.OriginalImageID != nil {
+		originalImageID = uuid.NullUUID{UUID: *d.OriginalImageID, Valid: true}
+	}
+
 	return SegmentationGroup{
-		Id:         d.Id,
-		CytologyID: d.CytologyID,
-		SegType:    d.SegType.String(),
-		GroupType:  d.GroupType.String(),
-		IsAI:       d.IsAI,
-		Details:    details,
-		CreateAt:   d.CreateAt,
+		Id:              d.Id,
+		CytologyID:      d.CytologyID,
+		OriginalImageID: originalImageID,
+		SegType:         d.SegType.String(),
+		GroupType:       d.GroupType.String(),
+		IsAI:            d.IsAI,
+		Details:         details,
+		CreateAt:        d.CreateAt,
 	}
 }
 
@@ -49,14 +56,20 @@ func (d SegmentationGroup) ToDomain() domain.SegmentationGroup {
 		details = json.RawMessage(d.Details.String)
 	}
 
+	var originalImageID *uuid.UUID
+	if d.OriginalImageID.Valid {
+		originalImageID = &d.OriginalImageID.UUID
+	}
+
 	return domain.SegmentationGroup{
-		Id:         d.Id,
-		CytologyID: d.CytologyID,
-		SegType:    segType,
-		GroupType:  groupType,
-		IsAI:       d.IsAI,
-		Details:    details,
-		CreateAt:   d.CreateAt,
+		Id:              d.Id,
+		CytologyID:      d.CytologyID,
+		OriginalImageID: originalImageID,
+		SegType:         segType,
+		GroupType:       groupType,
+		IsAI:            d.IsAI,
+		Details:         details,
+		CreateAt:        d.CreateAt,
 	}
 }
 
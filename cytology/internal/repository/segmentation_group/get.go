@@ -16,6 +16,7 @@ func (q *repo) GetSegmentationGroupByID(id int) (entity.SegmentationGroup, error
 		Select(
 			columnID,
 			columnCytologyID,
+			columnOriginalImageID,
 			columnSegType,
 			columnGroupType,
 			columnIsAI,
@@ -43,6 +44,7 @@ func (q *repo) GetSegmentationGroupsByCytologyID(cytologyID uuid.UUID) ([]entity
 		Select(
 			columnID,
 			columnCytologyID,
+			columnOriginalImageID,
 			columnSegType,
 			columnGroupType,
 			columnIsAI,
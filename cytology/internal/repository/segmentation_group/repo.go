@@ -10,13 +10,14 @@ import (
 const (
 	table = "segmentation_group"
 
-	columnID         = "id"
-	columnCytologyID = "cytology_id"
-	columnSegType    = "seg_type"
-	columnGroupType  = "group_type"
-	columnIsAI       = "is_ai"
-	columnDetails    = "details"
-	columnCreateAt   = "create_at"
+	columnID              = "id"
+	columnCytologyID      = "cytology_id"
+	columnOriginalImageID = "original_image_id"
+	columnSegType         = "seg_type"
+	columnGroupType       = "group_type"
+	columnIsAI            = "is_ai"
+	columnDetails         = "details"
+	columnCreateAt        = "create_at"
 )
 
 type Repository interface {
@@ -25,6 +26,8 @@ type Repository interface {
 	GetSegmentationGroupsByCytologyID(cytologyID uuid.UUID) ([]entity.SegmentationGroup, error)
 	UpdateSegmentationGroup(group entity.SegmentationGroup) error
 	DeleteSegmentationGroup(id int) error
+	// DeleteAISegmentationGroupsByOriginalImageID удаляет AI группы исследования, полученные по изображению
+	DeleteAISegmentationGroupsByOriginalImageID(cytologyID, originalImageID uuid.UUID) (int, error)
 }
 
 type repo struct {
@@ -0,0 +1,41 @@
+package segmentation_import
+
+import (
+	repoEntity "cytology/internal/repository/entity"
+	"cytology/internal/repository/segmentation_import/entity"
+)
+
+func (q *repo) InsertSegmentationImport(imp entity.SegmentationImport) (int, error) {
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(
+			columnCytologyID,
+			columnOriginalImageID,
+			columnFeaturesSeen,
+			columnFeaturesSkipped,
+			columnUnknownClasses,
+			columnGroupsCreated,
+			columnSegmentsCreated,
+			columnGroupsReplaced,
+			columnCreateAt,
+		).
+		Values(
+			imp.CytologyID,
+			imp.OriginalImageID,
+			imp.FeaturesSeen,
+			imp.FeaturesSkipped,
+			imp.UnknownClasses,
+			imp.GroupsCreated,
+			imp.SegmentsCreated,
+			imp.GroupsReplaced,
+			imp.CreateAt,
+		).
+		Suffix("RETURNING id")
+
+	var id int
+	if err := q.Runner().Getx(q.Context(), &id, query); err != nil {
+		return 0, repoEntity.WrapDBError(err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"cytology/internal/domain"
+)
+
+type SegmentationImport struct {
+	Id              int            `db:"id"`
+	CytologyID      uuid.UUID      `db:"cytology_id"`
+	OriginalImageID uuid.UUID      `db:"original_image_id"`
+	FeaturesSeen    int            `db:"features_seen"`
+	FeaturesSkipped int            `db:"features_skipped"`
+	UnknownClasses  pq.StringArray `db:"unknown_classes"`
+	GroupsCreated   int            `db:"groups_created"`
+	SegmentsCreated int            `db:"segments_created"`
+	GroupsReplaced  int            `db:"groups_replaced"`
+	CreateAt        time.Time      `db:"create_at"`
+}
+
+func (SegmentationImport) FromDomain(d domain.SegmentationImport) SegmentationImport {
+	unknownClasses := make(pq.StringArray, 0, len(d.UnknownClasses))
+	unknownClasses = append(unknownClasses, d.UnknownClasses...)
+
+	return SegmentationImport{
+		Id:              d.Id,
+		CytologyID:      d.CytologyID,
+		OriginalImageID: d.OriginalImageID,
+		FeaturesSeen:    d.FeaturesSeen,
+		FeaturesSkipped: d.FeaturesSkipped,
+		UnknownClasses:  unknownClasses,
+		GroupsCreated:   d.GroupsCreated,
+		SegmentsCreated: d.SegmentsCreated,
+		GroupsReplaced:  d.GroupsReplaced,
+		CreateAt:        d.CreateAt,
+	}
+}
+
+func (d SegmentationImport) ToDomain() domain.SegmentationImport {
+	return domain.SegmentationImport{
+		Id:              d.Id,
+		CytologyID:      d.CytologyID,
+		OriginalImageID: d.OriginalImageID,
+		FeaturesSeen:    d.FeaturesSeen,
+		FeaturesSkipped: d.FeaturesSkipped,
+		UnknownClasses:  []string(d.UnknownClasses),
+		GroupsCreated:   d.GroupsCreated,
+		SegmentsCreated: d.SegmentsCreated,
+		GroupsReplaced:  d.GroupsReplaced,
+		CreateAt:        d.CreateAt,
+	}
+}
@@ -0,0 +1,38 @@
+package segmentation_import
+
+import (
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+
+	"cytology/internal/repository/segmentation_import/entity"
+)
+
+const (
+	table = "segmentation_import"
+
+	columnID              = "id"
+	columnCytologyID      = "cytology_id"
+	columnOriginalImageID = "original_image_id"
+	columnFeaturesSeen    = "features_seen"
+	columnFeaturesSkipped = "features_skipped"
+	columnUnknownClasses  = "unknown_classes"
+	columnGroupsCreated   = "groups_created"
+	columnSegmentsCreated = "segments_created"
+	columnGroupsReplaced  = "groups_replaced"
+	columnCreateAt        = "create_at"
+)
+
+type Repository interface {
+	InsertSegmentationImport(imp entity.SegmentationImport) (int, error)
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewR() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
@@ -55,7 +55,7 @@ func (m *mockCytologyImageByPatientService) DeleteCytologyImage(context.Context,
 	panic("not implemented")
 }
 
-func (m *mockCytologyImageByPatientService) CopyCytologyImage(context.Context, uuid.UUID) (domain.CytologyImage, error) {
+func (m *mockCytologyImageByPatientService) CopyCytologyImage(context.Context, uuid.UUID, ...cytologyimageservice.CopyOption) (domain.CytologyImage, error) {
 	panic("not implemented")
 }
 
@@ -54,7 +54,7 @@ func (m *mockCytologyImageService) DeleteCytologyImage(context.Context, uuid.UUI
 	panic("not implemented")
 }
 
-func (m *mockCytologyImageService) CopyCytologyImage(context.Context, uuid.UUID) (domain.CytologyImage, error) {
+func (m *mockCytologyImageService) CopyCytologyImage(context.Context, uuid.UUID, ...cytologyimageservice.CopyOption) (domain.CytologyImage, error) {
 	panic("not implemented")
 }
 
@@ -73,12 +73,8 @@ func CreateCytologyImageArgFromProto(in *pb.CreateCytologyImageIn, externalID, d
 		arg.Details = []byte(*in.Details)
 	}
 
-	if in.File != nil && len(in.File) > 0 {
-		arg.File = in.File
-	}
-
-	if in.ContentType != nil && *in.ContentType != "" {
-		arg.ContentType = *in.ContentType
+	if in.ImagePath != nil && *in.ImagePath != "" {
+		arg.ImagePath = in.ImagePath
 	}
 
 	return arg
@@ -208,5 +204,10 @@ func OriginalImageToProto(d domain.OriginalImage) *pb.OriginalImage {
 		pbImg.DelayTime = &delay
 	}
 
+	if d.Sha256 != nil {
+		sum := *d.Sha256
+		pbImg.Sha256 = &sum
+	}
+
 	return pbImg
 }
@@ -55,6 +55,20 @@ var GroupTypeReverseMap = map[pb.GroupType]domain.GroupType{
 	pb.GroupType_GROUP_TYPE_ME: domain.GroupTypeME,
 }
 
+var GeometryTypeMap = map[domain.GeometryType]pb.GeometryType{
+	domain.GeometryTypePoint:        pb.GeometryType_GEOMETRY_TYPE_POINT,
+	domain.GeometryTypeLineString:   pb.GeometryType_GEOMETRY_TYPE_LINE_STRING,
+	domain.GeometryTypePolygon:      pb.GeometryType_GEOMETRY_TYPE_POLYGON,
+	domain.GeometryTypeMultiPolygon: pb.GeometryType_GEOMETRY_TYPE_MULTI_POLYGON,
+}
+
+var GeometryTypeReverseMap = map[pb.GeometryType]domain.GeometryType{
+	pb.GeometryType_GEOMETRY_TYPE_POINT:         domain.GeometryTypePoint,
+	pb.GeometryType_GEOMETRY_TYPE_LINE_STRING:   domain.GeometryTypeLineString,
+	pb.GeometryType_GEOMETRY_TYPE_POLYGON:       domain.GeometryTypePolygon,
+	pb.GeometryType_GEOMETRY_TYPE_MULTI_POLYGON: domain.GeometryTypeMultiPolygon,
+}
+
 func SegmentationGroupToProto(d domain.SegmentationGroup) *pb.SegmentationGroup {
 	pbGroup := &pb.SegmentationGroup{
 		Id:         int32(d.Id),
@@ -87,6 +101,18 @@ func SegmentationToProto(d domain.Segmentation) *pb.Segmentation {
 		SegmentationGroupId: int32(d.SegmentationGroupID),
 		CreateAt:            d.CreateAt.Format("2006-01-02T15:04:05Z07:00"),
 		Points:              make([]*pb.SegmentationPoint, 0, len(d.Points)),
+		GeometryType:        GeometryTypeMap[d.GeometryType],
+		IsLocked:            d.IsLocked,
+		Confidence:          d.Confidence,
+	}
+
+	if d.Color != nil {
+		pbSeg.Color = ColorToProto(*d.Color)
+	}
+
+	if len(d.Properties) > 0 {
+		properties := string(d.Properties)
+		pbSeg.Properties = &properties
 	}
 
 	for _, point := range d.Points {
@@ -96,6 +122,8 @@ func SegmentationToProto(d domain.Segmentation) *pb.Segmentation {
 			X:              int32(point.X),
 			Y:              int32(point.Y),
 			Uid:            point.UID,
+			Polygon:        int32(point.Polygon),
+			Ring:           int32(point.Ring),
 		})
 	}
 
@@ -109,3 +137,35 @@ func SegmentationSliceToProto(segs []domain.Segmentation) []*pb.Segmentation {
 	}
 	return pbSegs
 }
+
+func ColorToProto(d domain.Color) *pb.Color {
+	return &pb.Color{
+		R: int32(d.R),
+		G: int32(d.G),
+		B: int32(d.B),
+	}
+}
+
+func ColorFromProto(c *pb.Color) *domain.Color {
+	if c == nil {
+		return nil
+	}
+	return &domain.Color{
+		R: int(c.R),
+		G: int(c.G),
+		B: int(c.B),
+	}
+}
+
+func SegmentationPointsFromProto(points []*pb.SegmentationPointCreate) []domain.SegmentationPoint {
+	res := make([]domain.SegmentationPoint, 0, len(points))
+	for _, p := range points {
+		res = append(res, domain.SegmentationPoint{
+			Polygon: int(p.Polygon),
+			Ring:    int(p.Ring),
+			X:       int(p.X),
+			Y:       int(p.Y),
+		})
+	}
+	return res
+}
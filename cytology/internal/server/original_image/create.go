@@ -2,10 +2,11 @@ package original_image
 
 import (
 	"context"
+	"errors"
 
+	cytologysplittedpb "cytology/internal/generated/dbus/produce/cytologysplitted"
 	pb "cytology/internal/generated/grpc/service"
 	"cytology/internal/services/original_image"
-	cytologysplittedpb "cytology/internal/generated/dbus/produce/cytologysplitted"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -18,21 +19,14 @@ func (h *handler) CreateOriginalImage(ctx context.Context, in *pb.CreateOriginal
 		return nil, status.Errorf(codes.InvalidArgument, "cytology_id is not a valid uuid: %s", err.Error())
 	}
 
-	// Если передан путь к файлу, используем его (файл уже загружен в S3)
-	// Иначе проверяем, что передан файл
+	// файл загружается в S3 composition-api, сюда приходит только путь
 	imagePath := in.GetImagePath()
-	var imagePathPtr *string
-	if imagePath != "" {
-		imagePathPtr = &imagePath
+	if imagePath == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "image_path is required")
 	}
 
-	if imagePathPtr == nil || *imagePathPtr == "" {
-		if len(in.File) == 0 {
-			return nil, status.Errorf(codes.InvalidArgument, "file or image_path is required")
-		}
-		if in.ContentType == "" {
-			return nil, status.Errorf(codes.InvalidArgument, "content_type is required")
-		}
+	if in.Sha256 != nil && !isSha256Hex(*in.Sha256) {
+		return nil, status.Errorf(codes.InvalidArgument, "sha256 must be 64 lowercase hex chars")
 	}
 
 	var delayTimePtr *float64
@@ -42,15 +36,20 @@ func (h *handler) CreateOriginalImage(ctx context.Context, in *pb.CreateOriginal
 	}
 
 	arg := original_image.CreateOriginalImageArg{
-		CytologyID:  cytologyID,
-		File:        in.File,
-		ContentType: in.ContentType,
-		DelayTime:   delayTimePtr,
-		ImagePath:   imagePathPtr,
+		CytologyID: cytologyID,
+		DelayTime:  delayTimePtr,
+		ImagePath:  imagePath,
+		Sha256:     in.Sha256,
 	}
 
-	id, err := h.services.OriginalImage.CreateOriginalImage(ctx, arg)
+	id, duplicates, err := h.services.OriginalImage.CreateOriginalImage(ctx, arg)
 	if err != nil {
+		if errors.Is(err, original_image.ErrInvalidImagePath) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		if errors.Is(err, original_image.ErrDuplicateImage) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
 	}
 
@@ -66,5 +65,22 @@ func (h *handler) CreateOriginalImage(ctx context.Context, in *pb.CreateOriginal
 		}
 	}
 
-	return &pb.CreateOriginalImageOut{Id: id.String()}, nil
+	duplicateOf := make([]string, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		duplicateOf = append(duplicateOf, duplicate.String())
+	}
+
+	return &pb.CreateOriginalImageOut{Id: id.String(), DuplicateOf: duplicateOf}, nil
+}
+
+func isSha256Hex(sum string) bool {
+	if len(sum) != 64 {
+		return false
+	}
+	for _, c := range sum {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
 }
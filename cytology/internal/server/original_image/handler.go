@@ -12,6 +12,7 @@ type OriginalImageHandler interface {
 	GetOriginalImageById(ctx context.Context, req *pb.GetOriginalImageByIdIn) (*pb.GetOriginalImageByIdOut, error)
 	GetOriginalImagesByCytologyId(ctx context.Context, req *pb.GetOriginalImagesByCytologyIdIn) (*pb.GetOriginalImagesByCytologyIdOut, error)
 	UpdateOriginalImage(ctx context.Context, req *pb.UpdateOriginalImageIn) (*pb.UpdateOriginalImageOut, error)
+	VerifyOriginalImageIntegrity(ctx context.Context, req *pb.VerifyOriginalImageIntegrityIn) (*pb.VerifyOriginalImageIntegrityOut, error)
 }
 
 type handler struct {
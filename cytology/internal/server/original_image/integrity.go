@@ -0,0 +1,37 @@
+package original_image
+
+import (
+	"context"
+
+	pb "cytology/internal/generated/grpc/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *handler) VerifyOriginalImageIntegrity(ctx context.Context, _ *pb.VerifyOriginalImageIntegrityIn) (*pb.VerifyOriginalImageIntegrityOut, error) {
+	report, err := h.services.OriginalImage.VerifyIntegrity(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	missing := make([]string, 0, len(report.Missing))
+	for _, id := range report.Missing {
+		missing = append(missing, id.String())
+	}
+
+	mismatches := make([]*pb.OriginalImageIntegrityMismatch, 0, len(report.Mismatches))
+	for _, mismatch := range report.Mismatches {
+		mismatches = append(mismatches, &pb.OriginalImageIntegrityMismatch{
+			OriginalImageId: mismatch.OriginalImageID.String(),
+			Expected:        mismatch.Expected,
+			Actual:          mismatch.Actual,
+		})
+	}
+
+	return &pb.VerifyOriginalImageIntegrityOut{
+		Checked:    int64(report.Checked),
+		Missing:    missing,
+		Mismatches: mismatches,
+	}, nil
+}
@@ -2,9 +2,11 @@ package segmentation
 
 import (
 	"context"
+	"errors"
 
 	"cytology/internal/domain"
 	pb "cytology/internal/generated/grpc/service"
+	"cytology/internal/server/mappers"
 	"cytology/internal/services/segmentation"
 
 	"google.golang.org/grpc/codes"
@@ -14,21 +16,23 @@ import (
 func (h *handler) CreateSegmentation(ctx context.Context, in *pb.CreateSegmentationIn) (*pb.CreateSegmentationOut, error) {
 	groupID := int(in.SegmentationGroupId)
 
-	points := make([]domain.SegmentationPoint, 0, len(in.Points))
-	for _, p := range in.Points {
-		points = append(points, domain.SegmentationPoint{
-			X: int(p.X),
-			Y: int(p.Y),
-		})
-	}
-
 	arg := segmentation.CreateSegmentationArg{
 		SegmentationGroupID: groupID,
-		Points:              points,
+		GeometryType:        mappers.GeometryTypeReverseMap[in.GeometryType],
+		Points:              mappers.SegmentationPointsFromProto(in.Points),
+		Color:               mappers.ColorFromProto(in.Color),
+		IsLocked:            in.IsLocked,
+		Confidence:          in.Confidence,
+	}
+	if in.Properties != nil {
+		arg.Properties = []byte(*in.Properties)
 	}
 
 	id, err := h.services.Segmentation.CreateSegmentation(ctx, arg)
 	if err != nil {
+		if errors.Is(err, domain.ErrBadRequest) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
 	}
 
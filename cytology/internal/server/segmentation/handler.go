@@ -13,6 +13,7 @@ type SegmentationHandler interface {
 	CreateSegmentation(ctx context.Context, req *pb.CreateSegmentationIn) (*pb.CreateSegmentationOut, error)
 	GetSegmentationById(ctx context.Context, req *pb.GetSegmentationByIdIn) (*pb.GetSegmentationByIdOut, error)
 	GetSegmentsByGroupId(ctx context.Context, req *pb.GetSegmentsByGroupIdIn) (*pb.GetSegmentsByGroupIdOut, error)
+	GetSegmentsInViewport(ctx context.Context, req *pb.GetSegmentsInViewportIn) (*pb.GetSegmentsInViewportOut, error)
 	UpdateSegmentation(ctx context.Context, req *pb.UpdateSegmentationIn) (*pb.UpdateSegmentationOut, error)
 	DeleteSegmentation(ctx context.Context, req *pb.DeleteSegmentationIn) (*emptypb.Empty, error)
 }
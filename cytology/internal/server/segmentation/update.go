@@ -2,6 +2,7 @@ package segmentation
 
 import (
 	"context"
+	"errors"
 
 	"cytology/internal/domain"
 	pb "cytology/internal/generated/grpc/service"
@@ -15,21 +16,26 @@ import (
 func (h *handler) UpdateSegmentation(ctx context.Context, in *pb.UpdateSegmentationIn) (*pb.UpdateSegmentationOut, error) {
 	id := int(in.Id)
 
-	points := make([]domain.SegmentationPoint, 0, len(in.Points))
-	for _, p := range in.Points {
-		points = append(points, domain.SegmentationPoint{
-			X: int(p.X),
-			Y: int(p.Y),
-		})
-	}
-
 	arg := segmentation.UpdateSegmentationArg{
-		Id:     id,
-		Points: points,
+		Id:         id,
+		Points:     mappers.SegmentationPointsFromProto(in.Points),
+		Color:      mappers.ColorFromProto(in.Color),
+		IsLocked:   in.IsLocked,
+		Confidence: in.Confidence,
+	}
+	if in.GeometryType != nil {
+		geometryType := mappers.GeometryTypeReverseMap[*in.GeometryType]
+		arg.GeometryType = &geometryType
+	}
+	if in.Properties != nil {
+		arg.Properties = []byte(*in.Properties)
 	}
 
 	seg, err := h.services.Segmentation.UpdateSegmentation(ctx, arg)
 	if err != nil {
+		if errors.Is(err, domain.ErrBadRequest) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
 	}
 
@@ -0,0 +1,60 @@
+package segmentation
+
+import (
+	"context"
+	"errors"
+
+	"cytology/internal/domain"
+	pb "cytology/internal/generated/grpc/service"
+	"cytology/internal/server/mappers"
+	"cytology/internal/services/segmentation"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *handler) GetSegmentsInViewport(ctx context.Context, in *pb.GetSegmentsInViewportIn) (*pb.GetSegmentsInViewportOut, error) {
+	cytologyID, err := uuid.Parse(in.CytologyId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cytology_id is not a valid uuid: %s", err.Error())
+	}
+	if in.Bbox == nil {
+		return nil, status.Error(codes.InvalidArgument, "bbox is required")
+	}
+
+	filter := domain.SegmentationViewportFilter{IsAI: in.IsAi}
+	if in.SegType != nil {
+		segType := mappers.SegTypeReverseMap[*in.SegType]
+		filter.SegType = &segType
+	}
+	if in.GroupType != nil {
+		groupType := mappers.GroupTypeReverseMap[*in.GroupType]
+		filter.GroupType = &groupType
+	}
+
+	page, err := h.services.Segmentation.GetSegmentsInViewport(ctx, segmentation.GetSegmentsInViewportArg{
+		CytologyID: cytologyID,
+		BBox: domain.BBox{
+			MinX: int(in.Bbox.MinX),
+			MinY: int(in.Bbox.MinY),
+			MaxX: int(in.Bbox.MaxX),
+			MaxY: int(in.Bbox.MaxY),
+		},
+		Filter:     filter,
+		Downsample: in.Downsample,
+		Cursor:     in.Cursor,
+		Limit:      int(in.Limit),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrBadRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "Неверные параметры запроса: %s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	return &pb.GetSegmentsInViewportOut{
+		Segmentations: mappers.SegmentationSliceToProto(page.Segmentations),
+		NextCursor:    page.NextCursor,
+	}, nil
+}
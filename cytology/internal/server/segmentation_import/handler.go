@@ -0,0 +1,23 @@
+package segmentation_import
+
+import (
+	"context"
+
+	pb "cytology/internal/generated/grpc/service"
+	"cytology/internal/services"
+)
+
+type SegmentationImportHandler interface {
+	ExportQuPathGeoJson(ctx context.Context, req *pb.ExportQuPathGeoJsonIn) (*pb.ExportQuPathGeoJsonOut, error)
+	ImportQuPathGeoJson(ctx context.Context, req *pb.ImportQuPathGeoJsonIn) (*pb.ImportQuPathGeoJsonOut, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(services *services.Services) SegmentationImportHandler {
+	return &handler{
+		services: services,
+	}
+}
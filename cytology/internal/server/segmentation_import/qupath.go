@@ -0,0 +1,66 @@
+package segmentation_import
+
+import (
+	"context"
+	"errors"
+
+	"cytology/internal/domain"
+	pb "cytology/internal/generated/grpc/service"
+	"cytology/internal/server/mappers"
+	"cytology/internal/services/segmentation_import"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *handler) ExportQuPathGeoJson(ctx context.Context, in *pb.ExportQuPathGeoJsonIn) (*pb.ExportQuPathGeoJsonOut, error) {
+	cytologyID, err := uuid.Parse(in.CytologyId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cytology_id is not a valid uuid: %s", err.Error())
+	}
+
+	geojson, err := h.services.SegmentationImport.ExportQuPath(ctx, cytologyID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "cytology image not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	return &pb.ExportQuPathGeoJsonOut{Geojson: string(geojson)}, nil
+}
+
+func (h *handler) ImportQuPathGeoJson(ctx context.Context, in *pb.ImportQuPathGeoJsonIn) (*pb.ImportQuPathGeoJsonOut, error) {
+	cytologyID, err := uuid.Parse(in.CytologyId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cytology_id is not a valid uuid: %s", err.Error())
+	}
+
+	res, err := h.services.SegmentationImport.ImportQuPath(ctx, segmentation_import.QuPathImportArg{
+		CytologyID: cytologyID,
+		GeoJSON:    []byte(in.Geojson),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "cytology image not found")
+		case errors.Is(err, domain.ErrConflict):
+			return nil, status.Errorf(codes.FailedPrecondition, "can only import to last version")
+		case errors.Is(err, domain.ErrBadRequest):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	return &pb.ImportQuPathGeoJsonOut{
+		CytologyImage:  mappers.CytologyImageToProto(res.CytologyImage),
+		Added:          int32(res.Added),
+		Removed:        int32(res.Removed),
+		Changed:        int32(res.Changed),
+		Unchanged:      int32(res.Unchanged),
+		Skipped:        int32(res.Skipped),
+		UnknownClasses: res.UnknownClasses,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"cytology/internal/server/original_image"
 	"cytology/internal/server/segmentation"
 	"cytology/internal/server/segmentation_group"
+	"cytology/internal/server/segmentation_import"
 	"cytology/internal/services"
 )
 
@@ -14,6 +15,7 @@ type Handler struct {
 	original_image.OriginalImageHandler
 	segmentation_group.SegmentationGroupHandler
 	segmentation.SegmentationHandler
+	segmentation_import.SegmentationImportHandler
 
 	service.UnsafeCytologySrvServer
 }
@@ -25,11 +27,13 @@ func New(
 	originalImageHandler := original_image.New(services)
 	segmentationGroupHandler := segmentation_group.New(services)
 	segmentationHandler := segmentation.New(services)
+	segmentationImportHandler := segmentation_import.New(services)
 
 	return &Handler{
 		CytologyImageHandler:     cytologyImageHandler,
 		OriginalImageHandler:      originalImageHandler,
 		SegmentationGroupHandler: segmentationGroupHandler,
 		SegmentationHandler:      segmentationHandler,
+		SegmentationImportHandler: segmentationImportHandler,
 	}
 }
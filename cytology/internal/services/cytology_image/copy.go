@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"cytology/internal/domain"
@@ -15,7 +16,29 @@ import (
 	"github.com/google/uuid"
 )
 
-func (s *service) CopyCytologyImage(ctx context.Context, id uuid.UUID) (domain.CytologyImage, error) {
+// FillSegments заполняет сегменты новой версии исследования в транзакции копирования.
+// originalImageID - оригинальное изображение старой версии, nil если его нет
+type FillSegments func(ctx context.Context, oldCytologyID, newCytologyID uuid.UUID, originalImageID *uuid.UUID) error
+
+type copyOptions struct {
+	fillSegments FillSegments
+}
+
+type CopyOption func(*copyOptions)
+
+// WithSegments новая версия получает сегменты от fill вместо копии сегментов старой
+func WithSegments(fill FillSegments) CopyOption {
+	return func(o *copyOptions) {
+		o.fillSegments = fill
+	}
+}
+
+func (s *service) CopyCytologyImage(ctx context.Context, id uuid.UUID, opts ...CopyOption) (domain.CytologyImage, error) {
+	options := copyOptions{fillSegments: s.copySegments}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	ctx, err := s.dao.BeginTx(ctx)
 	if err != nil {
 		return domain.CytologyImage{}, fmt.Errorf("begin transaction: %w", err)
@@ -73,9 +96,14 @@ func (s *service) CopyCytologyImage(ctx context.Context, id uuid.UUID) (domain.C
 		return domain.CytologyImage{}, fmt.Errorf("insert cytology image: %w", err)
 	}
 
+	originalImageID, err := s.versionOriginalImageID(ctx, id, *parentPrevID)
+	if err != nil {
+		return domain.CytologyImage{}, err
+	}
+
 	// Копируем сегменты
-	if err := s.copySegments(ctx, id, newImg.Id); err != nil {
-		return domain.CytologyImage{}, fmt.Errorf("copy segments: %w", err)
+	if err := options.fillSegments(ctx, id, newImg.Id, originalImageID); err != nil {
+		return domain.CytologyImage{}, fmt.Errorf("fill segments: %w", err)
 	}
 
 	if err := s.dao.CommitTx(ctx); err != nil {
@@ -85,7 +113,29 @@ func (s *service) CopyCytologyImage(ctx context.Context, id uuid.UUID) (domain.C
 	return newImg, nil
 }
 
-func (s *service) copySegments(ctx context.Context, oldCytologyID, newCytologyID uuid.UUID) error {
+// versionOriginalImageID последнее оригинальное изображение версии. Копия исследования изображения не копирует,
+// поэтому у версии без своих изображений берется изображение первой версии
+func (s *service) versionOriginalImageID(ctx context.Context, cytologyID, parentPrevID uuid.UUID) (*uuid.UUID, error) {
+	for _, id := range []uuid.UUID{cytologyID, parentPrevID} {
+		images, err := s.originalImage.GetOriginalImagesByCytologyID(ctx, id)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("get original images: %w", err)
+		}
+		if len(images) == 0 {
+			continue
+		}
+
+		latest := slices.MaxFunc(images, func(a, b domain.OriginalImage) int {
+			return a.CreateDate.Compare(b.CreateDate)
+		})
+		return &latest.Id, nil
+	}
+
+	return nil, nil
+}
+
+// copySegments группы копии ссылаются на те же изображения, что и группы старой версии
+func (s *service) copySegments(ctx context.Context, oldCytologyID, newCytologyID uuid.UUID, _ *uuid.UUID) error {
 	// Получаем все группы сегментов для старого исследования
 	oldGroups, err := s.dao.NewSegmentationGroupQuery(ctx).GetSegmentationGroupsByCytologyID(oldCytologyID)
 	if err != nil && !errors.Is(err, domain.ErrNotFound) {
@@ -96,13 +146,14 @@ func (s *service) copySegments(ctx context.Context, oldCytologyID, newCytologyID
 	for _, oldGroup := range oldGroups {
 		oldGroupDomain := oldGroup.ToDomain()
 		newGroup := domain.SegmentationGroup{
-			Id:         0, // ID будет сгенерирован БД
-			CytologyID: newCytologyID,
-			SegType:    oldGroupDomain.SegType,
-			GroupType:  oldGroupDomain.GroupType,
-			IsAI:       oldGroupDomain.IsAI,
-			Details:    oldGroupDomain.Details,
-			CreateAt:   time.Now(),
+			Id:              0, // ID будет сгенерирован БД
+			CytologyID:      newCytologyID,
+			OriginalImageID: oldGroupDomain.OriginalImageID,
+			SegType:         oldGroupDomain.SegType,
+			GroupType:       oldGroupDomain.GroupType,
+			IsAI:            oldGroupDomain.IsAI,
+			Details:         oldGroupDomain.Details,
+			CreateAt:        time.Now(),
 		}
 
 		newGroupID, err := s.dao.NewSegmentationGroupQuery(ctx).InsertSegmentationGroup(segmentationGroupEntity.SegmentationGroup{}.FromDomain(newGroup))
@@ -122,7 +173,12 @@ func (s *service) copySegments(ctx context.Context, oldCytologyID, newCytologyID
 			newSegment := domain.Segmentation{
 				Id:                  0, // ID будет сгенерирован БД
 				SegmentationGroupID: newGroupID,
+				GeometryType:        oldSegmentDomain.GeometryType,
 				Points:              oldSegmentDomain.Points,
+				Color:               oldSegmentDomain.Color,
+				IsLocked:            oldSegmentDomain.IsLocked,
+				Confidence:          oldSegmentDomain.Confidence,
+				Properties:          oldSegmentDomain.Properties,
 				CreateAt:            time.Now(),
 			}
 
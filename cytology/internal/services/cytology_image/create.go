@@ -48,14 +48,12 @@ func (s *service) CreateCytologyImage(ctx context.Context, arg CreateCytologyIma
 		return uuid.Nil, fmt.Errorf("insert cytology image: %w", err)
 	}
 
-	// Если передан файл, создаем original_image
-	if len(arg.File) > 0 && arg.ContentType != "" {
-		originalImageService := original_image.New(s.dao)
-		_, err = originalImageService.CreateOriginalImage(ctx, original_image.CreateOriginalImageArg{
-			CytologyID:  img.Id,
-			File:        arg.File,
-			ContentType: arg.ContentType,
-			DelayTime:   nil,
+	// Если файл уже загружен в S3, создаем original_image
+	if arg.ImagePath != nil {
+		_, _, err = s.originalImage.CreateOriginalImage(ctx, original_image.CreateOriginalImageArg{
+			CytologyID: img.Id,
+			DelayTime:  nil,
+			ImagePath:  *arg.ImagePath,
 		})
 		if err != nil {
 			return uuid.Nil, fmt.Errorf("create original image: %w", err)
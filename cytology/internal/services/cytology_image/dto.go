@@ -19,8 +19,8 @@ type CreateCytologyImageArg struct {
 	Details           []byte
 	PrevID            *uuid.UUID
 	ParentPrevID      *uuid.UUID
-	File              []byte
-	ContentType       string
+	// путь к уже загруженному в S3 файлу, nil - исследование без изображения
+	ImagePath *string
 }
 
 type UpdateCytologyImageArg struct {
@@ -5,6 +5,7 @@ import (
 
 	"cytology/internal/domain"
 	"cytology/internal/repository"
+	"cytology/internal/services/original_image"
 
 	"github.com/google/uuid"
 )
@@ -18,16 +19,18 @@ type Service interface {
 	GetCytologyImageIdsByDoctorIdAndPatientId(ctx context.Context, doctorID, patientID uuid.UUID) ([]uuid.UUID, error)
 	UpdateCytologyImage(ctx context.Context, arg UpdateCytologyImageArg) (domain.CytologyImage, error)
 	DeleteCytologyImage(ctx context.Context, id uuid.UUID) error
-	CopyCytologyImage(ctx context.Context, id uuid.UUID) (domain.CytologyImage, error)
+	CopyCytologyImage(ctx context.Context, id uuid.UUID, opts ...CopyOption) (domain.CytologyImage, error)
 	GetCytologyImageHistory(ctx context.Context, id uuid.UUID) ([]domain.CytologyImage, error)
 }
 
 type service struct {
-	dao repository.DAO
+	dao           repository.DAO
+	originalImage original_image.Service
 }
 
-func New(dao repository.DAO) Service {
+func New(dao repository.DAO, originalImage original_image.Service) Service {
 	return &service{
-		dao: dao,
+		dao:           dao,
+		originalImage: originalImage,
 	}
 }
@@ -0,0 +1,83 @@
+package original_image
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"cytology/internal/domain"
+	"cytology/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// сколько изображений читается из БД за раз при проверке
+const verifyPageSize = 100
+
+func (s *service) FindDuplicates(ctx context.Context, cytologyID uuid.UUID, sum string) ([]uuid.UUID, error) {
+	cytology, err := s.dao.NewCytologyImageQuery(ctx).GetCytologyImageByID(cytologyID)
+	if err != nil {
+		return nil, fmt.Errorf("get cytology image: %w", err)
+	}
+
+	ids, err := s.dao.NewOriginalImageQuery(ctx).GetOriginalImageIDsByPatientAndSha256(cytology.PatientID, sum)
+	if err != nil {
+		return nil, fmt.Errorf("get original images by sha256: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (s *service) VerifyIntegrity(ctx context.Context) (domain.IntegrityReport, error) {
+	report := domain.IntegrityReport{}
+
+	after := uuid.Nil
+	for {
+		images, err := s.dao.NewOriginalImageQuery(ctx).GetOriginalImagesWithSha256(after, verifyPageSize)
+		if err != nil {
+			return domain.IntegrityReport{}, fmt.Errorf("get original images with sha256: %w", err)
+		}
+
+		for _, img := range images {
+			expected := img.Sha256.String
+			actual, err := s.hashFile(ctx, img.ImagePath)
+			switch {
+			case errors.Is(err, repository.ErrFileNotFound):
+				report.Missing = append(report.Missing, img.Id)
+			case err != nil:
+				return domain.IntegrityReport{}, fmt.Errorf("hash original image %s: %w", img.Id, err)
+			case actual != expected:
+				report.Mismatches = append(report.Mismatches, domain.IntegrityMismatch{
+					OriginalImageID: img.Id,
+					Expected:        expected,
+					Actual:          actual,
+				})
+			}
+			report.Checked++
+		}
+
+		if len(images) < verifyPageSize {
+			return report, nil
+		}
+		after = images[len(images)-1].Id
+	}
+}
+
+// hashFile читает файл потоком, не сохраняя его на диск
+func (s *service) hashFile(ctx context.Context, path string) (string, error) {
+	file, err := s.dao.NewFileRepo().GetFile(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("read file: %w", err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
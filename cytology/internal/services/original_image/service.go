@@ -1,8 +1,8 @@
 package original_image
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -15,28 +15,46 @@ import (
 )
 
 type Service interface {
-	CreateOriginalImage(ctx context.Context, arg CreateOriginalImageArg) (uuid.UUID, error)
+	CreateOriginalImage(ctx context.Context, arg CreateOriginalImageArg) (uuid.UUID, []uuid.UUID, error)
 	GetOriginalImageByID(ctx context.Context, id uuid.UUID) (domain.OriginalImage, error)
 	GetOriginalImagesByCytologyID(ctx context.Context, cytologyID uuid.UUID) ([]domain.OriginalImage, error)
 	UpdateOriginalImage(ctx context.Context, arg UpdateOriginalImageArg) (domain.OriginalImage, error)
+
+	// FindDuplicates изображения пациента исследования с тем же файлом
+	FindDuplicates(ctx context.Context, cytologyID uuid.UUID, sha256 string) ([]uuid.UUID, error)
+	// VerifyIntegrity пересчитывает sha256 файлов всех изображений, для которых она сохранена
+	VerifyIntegrity(ctx context.Context) (domain.IntegrityReport, error)
+}
+
+type Config struct {
+	// повторная загрузка того же файла для пациента
+	DuplicatePolicy domain.DuplicatePolicy
 }
 
 type service struct {
 	dao repository.DAO
+	cfg Config
 }
 
-func New(dao repository.DAO) Service {
+func New(dao repository.DAO, cfg Config) Service {
 	return &service{
 		dao: dao,
+		cfg: cfg,
 	}
 }
 
+var (
+	ErrInvalidImagePath = errors.New("invalid image_path")
+	ErrDuplicateImage   = errors.New("image with the same file already uploaded")
+)
+
 type CreateOriginalImageArg struct {
-	CytologyID  uuid.UUID
-	File        []byte // Используется только если ImagePath не указан
-	ContentType string
-	DelayTime   *float64
-	ImagePath   *string // Путь к файлу в S3 (если файл уже загружен)
+	CytologyID uuid.UUID
+	DelayTime  *float64
+	// Путь к уже загруженному в S3 файлу, оканчивается на {image_id}/{image_id}
+	ImagePath string
+	// sha256 файла в hex, если посчитана при загрузке
+	Sha256 *string
 }
 
 type UpdateOriginalImageArg struct {
@@ -45,43 +63,28 @@ type UpdateOriginalImageArg struct {
 	ViewedFlag *bool
 }
 
-func (s *service) CreateOriginalImage(ctx context.Context, arg CreateOriginalImageArg) (uuid.UUID, error) {
-	var imageID uuid.UUID
-	var imagePath string
-
-	// Если передан путь к файлу, используем его (файл уже загружен в S3)
-	if arg.ImagePath != nil && *arg.ImagePath != "" {
-		imagePath = *arg.ImagePath
-		// Извлекаем imageID из пути: {cytology_id}/{image_id}/{image_id}
-		// Путь имеет формат: {cytology_id}/{image_id}/{image_id}
-		parts := strings.Split(imagePath, "/")
-		if len(parts) >= 2 {
-			var err error
-			imageID, err = uuid.Parse(parts[1])
-			if err != nil {
-				return uuid.Nil, fmt.Errorf("invalid image_id in image_path: %w", err)
-			}
-		} else {
-			return uuid.Nil, fmt.Errorf("invalid image_path format: %s", imagePath)
-		}
-	} else {
-		// Если путь не передан, генерируем ID и загружаем файл в S3
-		imageID = uuid.New()
-
-		// Формируем путь в S3: {cytology_id}/{image_id}/{image_id}
-		// Используем "/" для S3, так как filepath.Join может давать разные результаты на разных ОС
-		imagePath = arg.CytologyID.String() + "/" + imageID.String() + "/" + imageID.String()
-
-		// Загружаем файл в S3
-		fileRepo := s.dao.NewFileRepo()
-		file := domain.File{
-			Format: arg.ContentType,
-			Size:   int64(len(arg.File)),
-			Buf:    bytes.NewReader(arg.File),
-		}
+// CreateOriginalImage возвращает id изображения и ранее загруженные изображения пациента с тем же файлом.
+// При DuplicatePolicyReject и найденных дубликатах изображение не создается, возвращается ErrDuplicateImage
+func (s *service) CreateOriginalImage(ctx context.Context, arg CreateOriginalImageArg) (uuid.UUID, []uuid.UUID, error) {
+	// Извлекаем imageID из пути: .../{image_id}/{image_id}
+	parts := strings.Split(arg.ImagePath, "/")
+	if len(parts) < 2 || parts[len(parts)-2] != parts[len(parts)-1] {
+		return uuid.Nil, nil, fmt.Errorf("%w: %s", ErrInvalidImagePath, arg.ImagePath)
+	}
+	imageID, err := uuid.Parse(parts[len(parts)-1])
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("%w: image_id: %w", ErrInvalidImagePath, err)
+	}
+	imagePath := arg.ImagePath
 
-		if err := fileRepo.LoadFile(ctx, imagePath, file); err != nil {
-			return uuid.Nil, fmt.Errorf("load file to S3: %w", err)
+	var duplicates []uuid.UUID
+	if arg.Sha256 != nil {
+		duplicates, err = s.FindDuplicates(ctx, arg.CytologyID, *arg.Sha256)
+		if err != nil {
+			return uuid.Nil, nil, err
+		}
+		if len(duplicates) > 0 && s.cfg.DuplicatePolicy == domain.DuplicatePolicyReject {
+			return uuid.Nil, duplicates, ErrDuplicateImage
 		}
 	}
 
@@ -93,14 +96,15 @@ func (s *service) CreateOriginalImage(ctx context.Context, arg CreateOriginalIma
 		CreateDate: time.Now(),
 		DelayTime:  arg.DelayTime,
 		ViewedFlag: false,
+		Sha256:     arg.Sha256,
 	}
 
 	entityImg := original_imageEntity.OriginalImage{}.FromDomain(img)
 	if err := s.dao.NewOriginalImageQuery(ctx).InsertOriginalImage(entityImg); err != nil {
-		return uuid.Nil, err
+		return uuid.Nil, nil, err
 	}
 
-	return img.Id, nil
+	return img.Id, duplicates, nil
 }
 
 func (s *service) GetOriginalImageByID(ctx context.Context, id uuid.UUID) (domain.OriginalImage, error) {
@@ -0,0 +1,28 @@
+package segmentation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"cytology/internal/domain"
+)
+
+// encodeCursor курсор непрозрачен для клиента, внутри id последней сегментации страницы
+func encodeCursor(id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(id)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("decode cursor: %w", domain.ErrBadRequest)
+	}
+
+	id, err := strconv.Atoi(string(raw))
+	if err != nil || id < 0 {
+		return 0, fmt.Errorf("parse cursor id: %w", domain.ErrBadRequest)
+	}
+
+	return id, nil
+}
@@ -13,6 +13,8 @@ type Service interface {
 	CreateSegmentation(ctx context.Context, arg CreateSegmentationArg) (int, error)
 	GetSegmentationByID(ctx context.Context, id int) (domain.Segmentation, error)
 	GetSegmentsByGroupID(ctx context.Context, groupID int) ([]domain.Segmentation, error)
+	// GetSegmentsInViewport сегментации исследования, пересекающие область просмотра
+	GetSegmentsInViewport(ctx context.Context, arg GetSegmentsInViewportArg) (domain.SegmentationPage, error)
 	UpdateSegmentation(ctx context.Context, arg UpdateSegmentationArg) (domain.Segmentation, error)
 	DeleteSegmentation(ctx context.Context, id int) error
 }
@@ -29,27 +31,45 @@ func New(dao repository.DAO) Service {
 
 type CreateSegmentationArg struct {
 	SegmentationGroupID int
-	Points              []domain.SegmentationPoint
+	// не передан - определяется по точкам
+	GeometryType domain.GeometryType
+	Points       []domain.SegmentationPoint
+	Color        *domain.Color
+	IsLocked     bool
+	Confidence   *float64
+	Properties   []byte
 }
 
+// UpdateSegmentationArg точки заменяются целиком, метаданные - только переданные
 type UpdateSegmentationArg struct {
-	Id     int
-	Points []domain.SegmentationPoint
+	Id           int
+	Points       []domain.SegmentationPoint
+	GeometryType *domain.GeometryType
+	Color        *domain.Color
+	IsLocked     *bool
+	Confidence   *float64
+	Properties   []byte
 }
 
 func (s *service) CreateSegmentation(ctx context.Context, arg CreateSegmentationArg) (int, error) {
+	geometryType := arg.GeometryType
+	if geometryType == "" {
+		geometryType = domain.GeometryTypeOf(arg.Points)
+	}
+
 	seg := domain.Segmentation{
 		Id:                  0, // ID будет сгенерирован БД
 		SegmentationGroupID: arg.SegmentationGroupID,
+		GeometryType:        geometryType,
 		Points:              arg.Points,
+		Color:               arg.Color,
+		IsLocked:            arg.IsLocked,
+		Confidence:          arg.Confidence,
+		Properties:          arg.Properties,
 		CreateAt:            time.Now(),
 	}
-
-	// ID для точек будут сгенерированы БД
-	for i := range seg.Points {
-		seg.Points[i].Id = 0             // ID будет сгенерирован БД
-		seg.Points[i].SegmentationID = 0 // Будет установлен после создания сегментации
-		seg.Points[i].CreateAt = time.Now()
+	if err := seg.Validate(); err != nil {
+		return 0, err
 	}
 
 	entitySeg := segmentationEntity.Segmentation{}.FromDomain(seg)
@@ -85,12 +105,23 @@ func (s *service) UpdateSegmentation(ctx context.Context, arg UpdateSegmentation
 
 	domainSeg := seg.ToDomain()
 	domainSeg.Points = arg.Points
-
-	// ID для точек будут сгенерированы БД при обновлении
-	for i := range domainSeg.Points {
-		domainSeg.Points[i].Id = 0 // ID будет сгенерирован БД
-		domainSeg.Points[i].SegmentationID = domainSeg.Id
-		domainSeg.Points[i].CreateAt = time.Now()
+	if arg.GeometryType != nil {
+		domainSeg.GeometryType = *arg.GeometryType
+	}
+	if arg.Color != nil {
+		domainSeg.Color = arg.Color
+	}
+	if arg.IsLocked != nil {
+		domainSeg.IsLocked = *arg.IsLocked
+	}
+	if arg.Confidence != nil {
+		domainSeg.Confidence = arg.Confidence
+	}
+	if arg.Properties != nil {
+		domainSeg.Properties = arg.Properties
+	}
+	if err := domainSeg.Validate(); err != nil {
+		return domain.Segmentation{}, err
 	}
 
 	entitySeg := segmentationEntity.Segmentation{}.FromDomain(domainSeg)
@@ -98,7 +129,8 @@ func (s *service) UpdateSegmentation(ctx context.Context, arg UpdateSegmentation
 		return domain.Segmentation{}, err
 	}
 
-	return domainSeg, nil
+	// id точек выдает БД
+	return s.GetSegmentationByID(ctx, arg.Id)
 }
 
 func (s *service) DeleteSegmentation(ctx context.Context, id int) error {
@@ -0,0 +1,100 @@
+package segmentation
+
+import (
+	"math"
+
+	"cytology/internal/domain"
+)
+
+// кольцо полигона после упрощения должно остаться полигоном
+const minRingPoints = 3
+
+// simplifyPoints упрощает контуры алгоритмом Дугласа-Пекера с допуском tolerance пикселей,
+// точки сохраняют свои id, номера полигонов и колец
+func simplifyPoints(geometryType domain.GeometryType, points []domain.SegmentationPoint, tolerance float64) []domain.SegmentationPoint {
+	switch geometryType {
+	case domain.GeometryTypeLineString:
+		return douglasPeucker(points, tolerance)
+	case domain.GeometryTypePolygon, domain.GeometryTypeMultiPolygon:
+		simplified := make([]domain.SegmentationPoint, 0, len(points))
+		start := 0
+		for i := 1; i <= len(points); i++ {
+			if i < len(points) && points[i].Polygon == points[start].Polygon && points[i].Ring == points[start].Ring {
+				continue
+			}
+			simplified = append(simplified, simplifyRing(points[start:i], tolerance)...)
+			start = i
+		}
+		return simplified
+	default:
+		return points
+	}
+}
+
+func simplifyRing(ring []domain.SegmentationPoint, tolerance float64) []domain.SegmentationPoint {
+	if len(ring) <= minRingPoints {
+		return ring
+	}
+
+	// замыкаем кольцо первой точкой, чтобы она осталась концом отрезка
+	closed := append(ring[:len(ring):len(ring)], ring[0])
+	kept := douglasPeucker(closed, tolerance)
+	kept = kept[:len(kept)-1]
+
+	// кольцо меньше допуска схлопнулось, оставляем треугольник
+	if len(kept) < minRingPoints {
+		n := len(ring)
+		return []domain.SegmentationPoint{ring[0], ring[n/3], ring[2*n/3]}
+	}
+	return kept
+}
+
+func douglasPeucker(points []domain.SegmentationPoint, tolerance float64) []domain.SegmentationPoint {
+	if len(points) <= 2 {
+		return points
+	}
+
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+
+	stack := [][2]int{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		first, last := stack[len(stack)-1][0], stack[len(stack)-1][1]
+		stack = stack[:len(stack)-1]
+
+		farthest, maxDistance := 0, 0.0
+		for i := first + 1; i < last; i++ {
+			if d := segmentDistance(points[i], points[first], points[last]); d > maxDistance {
+				farthest, maxDistance = i, d
+			}
+		}
+
+		if maxDistance > tolerance {
+			keep[farthest] = true
+			stack = append(stack, [2]int{first, farthest}, [2]int{farthest, last})
+		}
+	}
+
+	simplified := make([]domain.SegmentationPoint, 0, len(points))
+	for i, p := range points {
+		if keep[i] {
+			simplified = append(simplified, p)
+		}
+	}
+	return simplified
+}
+
+// segmentDistance расстояние от точки p до отрезка ab
+func segmentDistance(p, a, b domain.SegmentationPoint) float64 {
+	px, py := float64(p.X), float64(p.Y)
+	ax, ay := float64(a.X), float64(a.Y)
+	dx, dy := float64(b.X-a.X), float64(b.Y-a.Y)
+
+	if dx == 0 && dy == 0 {
+		return math.Hypot(px-ax, py-ay)
+	}
+
+	t := ((px-ax)*dx + (py-ay)*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(px-(ax+t*dx), py-(ay+t*dy))
+}
@@ -0,0 +1,76 @@
+package segmentation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"cytology/internal/domain"
+	segmentationEntity "cytology/internal/repository/segmentation/entity"
+)
+
+const (
+	defaultViewportLimit = 500
+	maxViewportLimit     = 5000
+)
+
+type GetSegmentsInViewportArg struct {
+	CytologyID uuid.UUID
+	// в пикселях нулевого уровня
+	BBox   domain.BBox
+	Filter domain.SegmentationViewportFilter
+	// сколько пикселей нулевого уровня в пикселе экрана, больше 1 - контуры упрощаются
+	Downsample float64
+	Cursor     *string
+	// 0 - размер страницы по умолчанию
+	Limit int
+}
+
+func (s *service) GetSegmentsInViewport(ctx context.Context, arg GetSegmentsInViewportArg) (domain.SegmentationPage, error) {
+	if err := arg.BBox.Validate(); err != nil {
+		return domain.SegmentationPage{}, err
+	}
+
+	limit := arg.Limit
+	if limit == 0 {
+		limit = defaultViewportLimit
+	}
+	if limit < 0 || limit > maxViewportLimit {
+		return domain.SegmentationPage{}, fmt.Errorf("limit must be in [1, %d]: %w", maxViewportLimit, domain.ErrBadRequest)
+	}
+
+	if arg.Downsample < 0 {
+		return domain.SegmentationPage{}, fmt.Errorf("downsample must not be negative: %w", domain.ErrBadRequest)
+	}
+
+	afterID := 0
+	if arg.Cursor != nil {
+		id, err := decodeCursor(*arg.Cursor)
+		if err != nil {
+			return domain.SegmentationPage{}, err
+		}
+		afterID = id
+	}
+
+	// берем на одну запись больше, чтобы понять есть ли следующая страница
+	segs, err := s.dao.NewSegmentationQuery(ctx).GetSegmentsInBBox(arg.CytologyID, arg.BBox, arg.Filter, afterID, limit+1)
+	if err != nil {
+		return domain.SegmentationPage{}, fmt.Errorf("get segments in bbox: %w", err)
+	}
+
+	page := domain.SegmentationPage{Segmentations: segmentationEntity.Segmentation{}.SliceToDomain(segs)}
+	if len(page.Segmentations) > limit {
+		page.Segmentations = page.Segmentations[:limit]
+		cursor := encodeCursor(page.Segmentations[limit-1].Id)
+		page.NextCursor = &cursor
+	}
+
+	if arg.Downsample > 1 {
+		for i := range page.Segmentations {
+			page.Segmentations[i].Points = simplifyPoints(page.Segmentations[i].GeometryType, page.Segmentations[i].Points, arg.Downsample)
+		}
+	}
+
+	return page, nil
+}
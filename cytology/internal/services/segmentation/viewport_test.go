@@ -0,0 +1,49 @@
+package segmentation
+
+import (
+	"testing"
+
+	"cytology/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimplifyPoints_Polygon(t *testing.T) {
+	// квадрат с дрожанием на сторонах и дырой
+	points := []domain.SegmentationPoint{
+		{Id: 1, X: 0, Y: 0}, {Id: 2, X: 50, Y: 1}, {Id: 3, X: 100, Y: 0},
+		{Id: 4, X: 99, Y: 50}, {Id: 5, X: 100, Y: 100}, {Id: 6, X: 50, Y: 101},
+		{Id: 7, X: 0, Y: 100}, {Id: 8, X: 1, Y: 50},
+		{Id: 9, Ring: 1, X: 40, Y: 40}, {Id: 10, Ring: 1, X: 41, Y: 41}, {Id: 11, Ring: 1, X: 40, Y: 42}, {Id: 12, Ring: 1, X: 39, Y: 41},
+	}
+
+	simplified := simplifyPoints(domain.GeometryTypePolygon, points, 4)
+
+	ids := make([]int, 0, len(simplified))
+	for _, p := range simplified {
+		ids = append(ids, p.Id)
+	}
+	require.Equal(t, []int{1, 3, 5, 7, 9, 10, 11}, ids)
+
+	seg := domain.Segmentation{GeometryType: domain.GeometryTypePolygon, Points: simplified}
+	require.NoError(t, seg.Validate())
+}
+
+func TestSimplifyPoints_LineStringAndPoint(t *testing.T) {
+	line := []domain.SegmentationPoint{{Id: 1, X: 0, Y: 0}, {Id: 2, X: 5, Y: 1}, {Id: 3, X: 10, Y: 0}, {Id: 4, X: 10, Y: 20}}
+	require.Equal(t, []domain.SegmentationPoint{line[0], line[2], line[3]}, simplifyPoints(domain.GeometryTypeLineString, line, 2))
+
+	point := []domain.SegmentationPoint{{Id: 1, X: 3, Y: 4}}
+	require.Equal(t, point, simplifyPoints(domain.GeometryTypePoint, point, 100))
+}
+
+func TestCursor(t *testing.T) {
+	id, err := decodeCursor(encodeCursor(42))
+	require.NoError(t, err)
+	require.Equal(t, 42, id)
+
+	for _, cursor := range []string{"not base64!", "YWJj", "LTE"} {
+		_, err := decodeCursor(cursor)
+		require.ErrorIs(t, err, domain.ErrBadRequest, cursor)
+	}
+}
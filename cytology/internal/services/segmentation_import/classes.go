@@ -0,0 +1,107 @@
+package segmentation_import
+
+import (
+	"strings"
+
+	"cytology/internal/domain"
+)
+
+// Маппинг имен классов из GeoJSON в SegType
+var classNameToSegType = map[string]domain.SegType{
+	"Нормальная клетка":            domain.SegTypeNIL,
+	"Клетка Гюртле":                domain.SegTypeNIR,
+	"Макрофаг":                     domain.SegTypeNIM,
+	"Скопление упорядоченное":      domain.SegTypeCNO,
+	"Скопление неупорядоченное":    domain.SegTypeCGE,
+	"Скопление микрофолликулярное": domain.SegTypeC2N,
+	"Скопление папиллярное":        domain.SegTypeCPS,
+	"Скопление фолликулярное":      domain.SegTypeCFC,
+	"Скопление лимфоидное":         domain.SegTypeCLY,
+	"Метастаз отсутствует":         domain.SegTypeSOS,
+	"Метастаз сомнительный":        domain.SegTypeSDS,
+	"Метастаз вероятный":           domain.SegTypeSMS,
+	"Метастаз определенный":        domain.SegTypeSTS,
+	"Метастаз папиллярный":         domain.SegTypeSPS,
+	"Метастаз отсутствует (нет)":   domain.SegTypeSNM,
+	"Метастаз тиреоидный":          domain.SegTypeSTM,
+}
+
+// segTypeToClassName обратный classNameToSegType, имена классов для экспорта
+var segTypeToClassName = func() map[domain.SegType]string {
+	names := make(map[domain.SegType]string, len(classNameToSegType))
+	for name, segType := range classNameToSegType {
+		names[segType] = name
+	}
+	return names
+}()
+
+// цвета классов при экспорте в QuPath
+var segTypeToColor = map[domain.SegType]domain.Color{
+	domain.SegTypeNIL: {R: 0, G: 200, B: 0},
+	domain.SegTypeNIR: {R: 255, G: 140, B: 0},
+	domain.SegTypeNIM: {R: 139, G: 69, B: 19},
+	domain.SegTypeCNO: {R: 0, G: 128, B: 255},
+	domain.SegTypeCGE: {R: 255, G: 0, B: 255},
+	domain.SegTypeC2N: {R: 0, G: 200, B: 200},
+	domain.SegTypeCPS: {R: 220, G: 20, B: 60},
+	domain.SegTypeCFC: {R: 70, G: 130, B: 180},
+	domain.SegTypeCLY: {R: 128, G: 0, B: 128},
+	domain.SegTypeSOS: {R: 34, G: 139, B: 34},
+	domain.SegTypeSDS: {R: 255, G: 215, B: 0},
+	domain.SegTypeSMS: {R: 255, G: 99, B: 71},
+	domain.SegTypeSTS: {R: 178, G: 34, B: 34},
+	domain.SegTypeSPS: {R: 199, G: 21, B: 133},
+	domain.SegTypeSNM: {R: 112, G: 128, B: 144},
+	domain.SegTypeSTM: {R: 75, G: 0, B: 130},
+}
+
+// Маппинг SegType в GroupType
+var segTypeToGroupType = map[domain.SegType]domain.GroupType{
+	domain.SegTypeNIL: domain.GroupTypeCE,
+	domain.SegTypeNIR: domain.GroupTypeCE,
+	domain.SegTypeNIM: domain.GroupTypeCE,
+	domain.SegTypeCNO: domain.GroupTypeCL,
+	domain.SegTypeCGE: domain.GroupTypeCL,
+	domain.SegTypeC2N: domain.GroupTypeCL,
+	domain.SegTypeCPS: domain.GroupTypeCL,
+	domain.SegTypeCFC: domain.GroupTypeCL,
+	domain.SegTypeCLY: domain.GroupTypeCL,
+	domain.SegTypeSOS: domain.GroupTypeME,
+	domain.SegTypeSDS: domain.GroupTypeME,
+	domain.SegTypeSMS: domain.GroupTypeME,
+	domain.SegTypeSTS: domain.GroupTypeME,
+	domain.SegTypeSPS: domain.GroupTypeME,
+	domain.SegTypeSNM: domain.GroupTypeME,
+	domain.SegTypeSTM: domain.GroupTypeME,
+}
+
+// classifier определяет SegType и GroupType по имени класса
+type classifier func(className string) (domain.SegType, domain.GroupType, bool)
+
+// getSegTypeFromExactClassName только имена, которые пишет экспорт в QuPath
+func getSegTypeFromExactClassName(className string) (domain.SegType, domain.GroupType, bool) {
+	segType, ok := classNameToSegType[className]
+	if !ok {
+		return "", "", false
+	}
+	return segType, segTypeToGroupType[segType], true
+}
+
+// getSegTypeFromClassName для AI разметки, кроме точного имени допускает частичное совпадение
+func getSegTypeFromClassName(className string) (domain.SegType, domain.GroupType, bool) {
+	// Пробуем точное совпадение
+	if segType, groupType, ok := getSegTypeFromExactClassName(className); ok {
+		return segType, groupType, true
+	}
+
+	// Пробуем найти по частичному совпадению (без учета регистра)
+	classNameLower := strings.ToLower(className)
+	for name, segType := range classNameToSegType {
+		if strings.Contains(strings.ToLower(name), classNameLower) || strings.Contains(classNameLower, strings.ToLower(name)) {
+			groupType := segTypeToGroupType[segType]
+			return segType, groupType, true
+		}
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,59 @@
+package segmentation_import
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"cytology/internal/domain"
+	daoEntity "cytology/internal/repository/entity"
+)
+
+func (s *service) ExportQuPath(ctx context.Context, cytologyID uuid.UUID) ([]byte, error) {
+	if _, err := s.dao.NewCytologyImageQuery(ctx).GetCytologyImageByID(cytologyID); err != nil {
+		if errors.Is(err, daoEntity.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get cytology image: %w", err)
+	}
+
+	annotations, err := s.annotations(ctx, cytologyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeQuPath(cytologyID, annotations)
+}
+
+// annotations сегментации всех групп исследования по возрастанию id
+func (s *service) annotations(ctx context.Context, cytologyID uuid.UUID) ([]annotation, error) {
+	groups, err := s.dao.NewSegmentationGroupQuery(ctx).GetSegmentationGroupsByCytologyID(cytologyID)
+	if err != nil {
+		if errors.Is(err, daoEntity.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get segmentation groups: %w", err)
+	}
+
+	var annotations []annotation
+	for _, group := range groups {
+		segs, err := s.dao.NewSegmentationQuery(ctx).GetSegmentsByGroupID(group.Id)
+		if err != nil {
+			if errors.Is(err, daoEntity.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("get segments: %w", err)
+		}
+
+		segType := group.ToDomain().SegType
+		for _, seg := range segs {
+			annotations = append(annotations, annotation{segType: segType, seg: seg.ToDomain()})
+		}
+	}
+
+	sort.Slice(annotations, func(i, j int) bool { return annotations[i].seg.Id < annotations[j].seg.Id })
+	return annotations, nil
+}
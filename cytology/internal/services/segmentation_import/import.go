@@ -0,0 +1,189 @@
+package segmentation_import
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cytology/internal/domain"
+	segmentationEntity "cytology/internal/repository/segmentation/entity"
+	segmentationGroupEntity "cytology/internal/repository/segmentation_group/entity"
+	segmentationImportEntity "cytology/internal/repository/segmentation_import/entity"
+	"cytology/internal/services/cytology_image"
+)
+
+// classGroup features одного класса, сохраняются одной группой
+type classGroup struct {
+	segType   domain.SegType
+	groupType domain.GroupType
+	segments  []domain.Segmentation
+}
+
+func featureSegmentation(feature Feature) domain.Segmentation {
+	return domain.Segmentation{
+		GeometryType: feature.GeometryType,
+		Points:       feature.Points,
+		Color:        feature.Color,
+		IsLocked:     feature.IsLocked,
+		Confidence:   feature.Confidence,
+		Properties:   feature.Properties,
+	}
+}
+
+// groupFeatures раскладывает features по классам в порядке первого появления класса
+func groupFeatures(features []Feature, classify classifier) ([]classGroup, domain.SegmentationImport) {
+	stats := domain.SegmentationImport{FeaturesSeen: len(features)}
+
+	groups := []classGroup{}
+	groupIdx := map[domain.SegType]int{}
+	unknown := map[string]struct{}{}
+	for _, feature := range features {
+		seg := featureSegmentation(feature)
+		if feature.ClassName == "" || seg.Validate() != nil {
+			stats.FeaturesSkipped++
+			continue
+		}
+
+		segType, groupType, found := classify(feature.ClassName)
+		if !found {
+			stats.FeaturesSkipped++
+			if _, ok := unknown[feature.ClassName]; !ok {
+				unknown[feature.ClassName] = struct{}{}
+				stats.UnknownClasses = append(stats.UnknownClasses, feature.ClassName)
+			}
+			continue
+		}
+
+		idx, ok := groupIdx[segType]
+		if !ok {
+			idx = len(groups)
+			groupIdx[segType] = idx
+			groups = append(groups, classGroup{segType: segType, groupType: groupType})
+		}
+		groups[idx].segments = append(groups[idx].segments, seg)
+	}
+
+	stats.GroupsCreated = len(groups)
+	for _, group := range groups {
+		stats.SegmentsCreated += len(group.segments)
+	}
+
+	return groups, stats
+}
+
+func (s *service) ImportAISegmentation(ctx context.Context, arg ImportArg) (domain.SegmentationImport, error) {
+	groups, stats := groupFeatures(arg.Features, getSegTypeFromClassName)
+	stats.CytologyID = arg.CytologyID
+	stats.OriginalImageID = arg.OriginalImageID
+	stats.CreateAt = time.Now()
+
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.SegmentationImport{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	img, err := s.dao.NewOriginalImageQuery(ctx).GetOriginalImageByID(arg.OriginalImageID)
+	if err != nil {
+		return domain.SegmentationImport{}, fmt.Errorf("get original image: %w", err)
+	}
+	if img.CytologyID != arg.CytologyID {
+		return domain.SegmentationImport{}, domain.ErrBadRequest
+	}
+
+	// повторная доставка сообщения заменяет разметку, а не дублирует ее
+	stats.GroupsReplaced, err = s.dao.NewSegmentationGroupQuery(ctx).DeleteAISegmentationGroupsByOriginalImageID(arg.CytologyID, arg.OriginalImageID)
+	if err != nil {
+		return domain.SegmentationImport{}, fmt.Errorf("delete previous ai groups: %w", err)
+	}
+
+	if err := s.insertGroups(ctx, arg.CytologyID, &arg.OriginalImageID, true, groups, stats.CreateAt); err != nil {
+		return domain.SegmentationImport{}, err
+	}
+
+	stats.Id, err = s.dao.NewSegmentationImportQuery(ctx).InsertSegmentationImport(segmentationImportEntity.SegmentationImport{}.FromDomain(stats))
+	if err != nil {
+		return domain.SegmentationImport{}, fmt.Errorf("insert segmentation import: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.SegmentationImport{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return stats, nil
+}
+
+// insertGroups создает по группе на класс, сегментации всех групп вставляются пачками
+func (s *service) insertGroups(
+	ctx context.Context,
+	cytologyID uuid.UUID,
+	originalImageID *uuid.UUID,
+	isAI bool,
+	groups []classGroup,
+	createAt time.Time,
+) error {
+	segs := []segmentationEntity.Segmentation{}
+	for _, group := range groups {
+		groupID, err := s.dao.NewSegmentationGroupQuery(ctx).InsertSegmentationGroup(segmentationGroupEntity.SegmentationGroup{}.FromDomain(domain.SegmentationGroup{
+			CytologyID:      cytologyID,
+			OriginalImageID: originalImageID,
+			SegType:         group.segType,
+			GroupType:       group.groupType,
+			IsAI:            isAI,
+			CreateAt:        createAt,
+		}))
+		if err != nil {
+			return fmt.Errorf("insert segmentation group: %w", err)
+		}
+
+		for _, seg := range group.segments {
+			seg.SegmentationGroupID = groupID
+			seg.CreateAt = createAt
+			segs = append(segs, segmentationEntity.Segmentation{}.FromDomain(seg))
+		}
+	}
+
+	if _, err := s.dao.NewSegmentationQuery(ctx).InsertSegmentations(segs); err != nil {
+		return fmt.Errorf("insert segmentations: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) ImportQuPath(ctx context.Context, arg QuPathImportArg) (domain.QuPathImport, error) {
+	features, err := decodeQuPath(arg.GeoJSON)
+	if err != nil {
+		return domain.QuPathImport{}, err
+	}
+	// файл QuPath получен экспортом, классы в нем только с точными именами
+	groups, stats := groupFeatures(features, getSegTypeFromExactClassName)
+
+	var res domain.QuPathImport
+	// версия после QuPath проверена врачом, группы создаются не AI
+	fill := func(ctx context.Context, oldCytologyID, newCytologyID uuid.UUID, originalImageID *uuid.UUID) error {
+		annotations, err := s.annotations(ctx, oldCytologyID)
+		if err != nil {
+			return err
+		}
+		res = diffQuPath(oldCytologyID, annotations, features)
+
+		return s.insertGroups(ctx, newCytologyID, originalImageID, false, groups, time.Now())
+	}
+
+	img, err := s.cytologyImage.CopyCytologyImage(ctx, arg.CytologyID, cytology_image.WithSegments(fill))
+	if err != nil {
+		// копировать можно только последнюю версию
+		if errors.Is(err, domain.ErrBadRequest) {
+			return domain.QuPathImport{}, fmt.Errorf("%w: cytology image is not the last version", domain.ErrConflict)
+		}
+		return domain.QuPathImport{}, fmt.Errorf("copy cytology image: %w", err)
+	}
+
+	res.CytologyImage = img
+	res.Skipped = stats.FeaturesSkipped
+	res.UnknownClasses = stats.UnknownClasses
+	return res, nil
+}
@@ -0,0 +1,97 @@
+package segmentation_import
+
+import (
+	"testing"
+
+	"cytology/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupFeatures(t *testing.T) {
+	triangle := []domain.SegmentationPoint{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}}
+	polygon := func(className string) Feature {
+		return Feature{ClassName: className, GeometryType: domain.GeometryTypePolygon, Points: triangle}
+	}
+
+	groups, stats := groupFeatures([]Feature{
+		polygon("Макрофаг"),
+		polygon("Скопление папиллярное"),
+		polygon("Макрофаг"),
+		polygon("Неизвестный"),
+		polygon("Неизвестный"),
+		polygon(""),
+		{ClassName: "Макрофаг", GeometryType: domain.GeometryTypePolygon},
+		{ClassName: "Макрофаг", Points: triangle},
+	}, getSegTypeFromClassName)
+
+	require.Len(t, groups, 2)
+	require.Equal(t, domain.SegTypeNIM, groups[0].segType)
+	require.Equal(t, domain.GroupTypeCE, groups[0].groupType)
+	require.Len(t, groups[0].segments, 2)
+	require.Equal(t, domain.SegTypeCPS, groups[1].segType)
+	require.Equal(t, domain.GroupTypeCL, groups[1].groupType)
+	require.Len(t, groups[1].segments, 1)
+
+	require.Equal(t, 8, stats.FeaturesSeen)
+	require.Equal(t, 5, stats.FeaturesSkipped)
+	require.Equal(t, []string{"Неизвестный"}, stats.UnknownClasses)
+	require.Equal(t, 2, stats.GroupsCreated)
+	require.Equal(t, 3, stats.SegmentsCreated)
+}
+
+func TestGroupFeatures_ExactClasses(t *testing.T) {
+	triangle := []domain.SegmentationPoint{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}}
+	polygon := func(className string) Feature {
+		return Feature{ClassName: className, GeometryType: domain.GeometryTypePolygon, Points: triangle}
+	}
+
+	// частичное совпадение неоднозначно: "Метастаз" входит в имена нескольких классов
+	groups, stats := groupFeatures([]Feature{
+		polygon("Макрофаг"),
+		polygon("макрофаг"),
+		polygon("Метастаз"),
+	}, getSegTypeFromExactClassName)
+
+	require.Len(t, groups, 1)
+	require.Equal(t, domain.SegTypeNIM, groups[0].segType)
+	require.Equal(t, 2, stats.FeaturesSkipped)
+	require.Equal(t, []string{"макрофаг", "Метастаз"}, stats.UnknownClasses)
+}
+
+func TestGroupFeaturesKeepsMetadata(t *testing.T) {
+	confidence := 0.9
+	feature := Feature{
+		ClassName:    "Макрофаг",
+		GeometryType: domain.GeometryTypeMultiPolygon,
+		Points: []domain.SegmentationPoint{
+			{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10},
+			{Ring: 1, X: 1, Y: 1}, {Ring: 1, X: 2, Y: 1}, {Ring: 1, X: 1, Y: 2},
+			{Polygon: 1, X: 20, Y: 20}, {Polygon: 1, X: 30, Y: 20}, {Polygon: 1, X: 20, Y: 30},
+		},
+		Color:      &domain.Color{R: 255, G: 0, B: 128},
+		IsLocked:   true,
+		Confidence: &confidence,
+		Properties: []byte(`{"name":"a"}`),
+	}
+
+	groups, stats := groupFeatures([]Feature{feature}, getSegTypeFromClassName)
+
+	require.Zero(t, stats.FeaturesSkipped)
+	require.Len(t, groups, 1)
+	require.Equal(t, domain.Segmentation{
+		GeometryType: feature.GeometryType,
+		Points:       feature.Points,
+		Color:        feature.Color,
+		IsLocked:     true,
+		Confidence:   &confidence,
+		Properties:   feature.Properties,
+	}, groups[0].segments[0])
+}
+
+func TestGroupFeaturesEmpty(t *testing.T) {
+	groups, stats := groupFeatures(nil, getSegTypeFromClassName)
+
+	require.Empty(t, groups)
+	require.Equal(t, domain.SegmentationImport{}, stats)
+}
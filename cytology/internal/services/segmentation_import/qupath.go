@@ -0,0 +1,340 @@
+package segmentation_import
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"cytology/internal/domain"
+)
+
+// GeoJSON в формате экспорта QuPath: FeatureCollection или массив features
+type qupathFeatureCollection struct {
+	Type     string          `json:"type"`
+	Features []qupathFeature `json:"features"`
+}
+
+type qupathFeature struct {
+	Type       string                     `json:"type"`
+	ID         string                     `json:"id,omitempty"`
+	Geometry   *qupathGeometry            `json:"geometry"`
+	Properties map[string]json.RawMessage `json:"properties"`
+}
+
+type qupathGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+type qupathClassification struct {
+	Name  string `json:"name"`
+	Color []int  `json:"color,omitempty"`
+}
+
+// свойства QuPath, остальные properties аннотации пишутся рядом с ними
+const (
+	propertyObjectType     = "objectType"
+	propertyClassification = "classification"
+	propertyIsLocked       = "isLocked"
+	propertyColor          = "color"
+	propertyMeasurements   = "measurements"
+
+	measurementConfidence = "confidence"
+)
+
+// annotation сегментация с классом ее группы
+type annotation struct {
+	segType domain.SegType
+	seg     domain.Segmentation
+}
+
+// annotationID id объекта QuPath, по нему импорт сопоставляется с экспортом
+func annotationID(cytologyID uuid.UUID, segID int) string {
+	return uuid.NewSHA1(cytologyID, []byte(strconv.Itoa(segID))).String()
+}
+
+func encodeQuPath(cytologyID uuid.UUID, annotations []annotation) ([]byte, error) {
+	collection := qupathFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]qupathFeature, 0, len(annotations)),
+	}
+
+	for _, a := range annotations {
+		coordinates, err := json.Marshal(coordinatesOf(a.seg.GeometryType, a.seg.Points))
+		if err != nil {
+			return nil, fmt.Errorf("marshal coordinates: %w", err)
+		}
+
+		properties := map[string]json.RawMessage{}
+		if len(a.seg.Properties) > 0 {
+			if err := json.Unmarshal(a.seg.Properties, &properties); err != nil {
+				return nil, fmt.Errorf("unmarshal properties: %w", err)
+			}
+		}
+
+		color := segTypeToColor[a.segType]
+		properties[propertyObjectType] = rawJSON("annotation")
+		properties[propertyClassification] = rawJSON(qupathClassification{
+			Name:  segTypeToClassName[a.segType],
+			Color: []int{color.R, color.G, color.B},
+		})
+		properties[propertyIsLocked] = rawJSON(a.seg.IsLocked)
+		if a.seg.Color != nil {
+			properties[propertyColor] = rawJSON([]int{a.seg.Color.R, a.seg.Color.G, a.seg.Color.B})
+		}
+		if a.seg.Confidence != nil {
+			properties[propertyMeasurements] = rawJSON(map[string]float64{measurementConfidence: *a.seg.Confidence})
+		}
+
+		feature := qupathFeature{
+			Type:       "Feature",
+			ID:         annotationID(cytologyID, a.seg.Id),
+			Geometry:   &qupathGeometry{Type: a.seg.GeometryType.String(), Coordinates: coordinates},
+			Properties: properties,
+		}
+		collection.Features = append(collection.Features, feature)
+	}
+
+	return json.Marshal(collection)
+}
+
+// rawJSON для значений, которые всегда сериализуются
+func rawJSON(v any) json.RawMessage {
+	data, _ := json.Marshal(v)
+	return data
+}
+
+// coordinatesOf координаты GeoJSON, кольца полигонов замыкаются первой точкой
+func coordinatesOf(geometryType domain.GeometryType, points []domain.SegmentationPoint) any {
+	switch geometryType {
+	case domain.GeometryTypePoint:
+		if len(points) == 0 {
+			return []int{}
+		}
+		return []int{points[0].X, points[0].Y}
+	case domain.GeometryTypeLineString:
+		line := make([][]int, 0, len(points))
+		for _, p := range points {
+			line = append(line, []int{p.X, p.Y})
+		}
+		return line
+	}
+
+	polygons := [][][][]int{}
+	for i, p := range points {
+		if i == 0 || p.Polygon != points[i-1].Polygon {
+			polygons = append(polygons, [][][]int{})
+		}
+		polygon := &polygons[len(polygons)-1]
+		if i == 0 || p.Polygon != points[i-1].Polygon || p.Ring != points[i-1].Ring {
+			*polygon = append(*polygon, [][]int{})
+		}
+		ring := &(*polygon)[len(*polygon)-1]
+		*ring = append(*ring, []int{p.X, p.Y})
+	}
+	for _, polygon := range polygons {
+		for i, ring := range polygon {
+			polygon[i] = append(ring, ring[0])
+		}
+	}
+
+	if geometryType == domain.GeometryTypeMultiPolygon {
+		return polygons
+	}
+	if len(polygons) == 0 {
+		return [][][]int{}
+	}
+	return polygons[0]
+}
+
+// decodeQuPath features GeoJSON из QuPath, features с нечитаемой геометрией остаются без точек
+func decodeQuPath(data []byte) ([]Feature, error) {
+	var features []qupathFeature
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &features); err != nil {
+			return nil, fmt.Errorf("%w: invalid geojson: %s", domain.ErrBadRequest, err.Error())
+		}
+	} else {
+		var collection qupathFeatureCollection
+		if err := json.Unmarshal(data, &collection); err != nil {
+			return nil, fmt.Errorf("%w: invalid geojson: %s", domain.ErrBadRequest, err.Error())
+		}
+		if collection.Type != "FeatureCollection" {
+			return nil, fmt.Errorf("%w: geojson is not a feature collection", domain.ErrBadRequest)
+		}
+		features = collection.Features
+	}
+
+	res := make([]Feature, 0, len(features))
+	for _, f := range features {
+		res = append(res, decodeQuPathFeature(f))
+	}
+	return res, nil
+}
+
+func decodeQuPathFeature(f qupathFeature) Feature {
+	feature := Feature{ID: f.ID}
+	if f.Geometry != nil {
+		feature.GeometryType, feature.Points = decodeGeometry(*f.Geometry)
+	}
+
+	extra := map[string]json.RawMessage{}
+	for key, value := range f.Properties {
+		switch key {
+		case propertyObjectType:
+		case propertyClassification:
+			var classification qupathClassification
+			if json.Unmarshal(value, &classification) == nil {
+				feature.ClassName = classification.Name
+			}
+		case propertyIsLocked:
+			_ = json.Unmarshal(value, &feature.IsLocked)
+		case propertyColor:
+			var color []int
+			if json.Unmarshal(value, &color) == nil && len(color) == 3 {
+				feature.Color = &domain.Color{R: color[0], G: color[1], B: color[2]}
+			}
+		case propertyMeasurements:
+			var measurements map[string]float64
+			if json.Unmarshal(value, &measurements) == nil {
+				if confidence, ok := measurements[measurementConfidence]; ok {
+					feature.Confidence = &confidence
+				}
+			}
+		default:
+			extra[key] = value
+		}
+	}
+	if len(extra) > 0 {
+		feature.Properties, _ = json.Marshal(extra)
+	}
+
+	return feature
+}
+
+func decodeGeometry(geometry qupathGeometry) (domain.GeometryType, []domain.SegmentationPoint) {
+	switch geometry.Type {
+	case domain.GeometryTypePoint.String():
+		var point []float64
+		if json.Unmarshal(geometry.Coordinates, &point) != nil || len(point) < 2 {
+			return "", nil
+		}
+		return domain.GeometryTypePoint, []domain.SegmentationPoint{toPoint(point, 0, 0)}
+	case domain.GeometryTypeLineString.String():
+		var line [][]float64
+		if json.Unmarshal(geometry.Coordinates, &line) != nil {
+			return "", nil
+		}
+		points := make([]domain.SegmentationPoint, 0, len(line))
+		for _, point := range line {
+			points = append(points, toPoint(point, 0, 0))
+		}
+		return domain.GeometryTypeLineString, points
+	case domain.GeometryTypePolygon.String():
+		var polygon [][][]float64
+		if json.Unmarshal(geometry.Coordinates, &polygon) != nil {
+			return "", nil
+		}
+		return domain.GeometryTypePolygon, polygonPoints(polygon, 0)
+	case domain.GeometryTypeMultiPolygon.String():
+		var polygons [][][][]float64
+		if json.Unmarshal(geometry.Coordinates, &polygons) != nil {
+			return "", nil
+		}
+		var points []domain.SegmentationPoint
+		for i, polygon := range polygons {
+			points = append(points, polygonPoints(polygon, i)...)
+		}
+		return domain.GeometryTypeMultiPolygon, points
+	}
+	return "", nil
+}
+
+// polygonPoints точки колец без замыкающей точки
+func polygonPoints(polygon [][][]float64, polygonIdx int) []domain.SegmentationPoint {
+	var points []domain.SegmentationPoint
+	for ringIdx, ring := range polygon {
+		if len(ring) > 1 && equalCoordinates(ring[0], ring[len(ring)-1]) {
+			ring = ring[:len(ring)-1]
+		}
+		for _, point := range ring {
+			points = append(points, toPoint(point, polygonIdx, ringIdx))
+		}
+	}
+	return points
+}
+
+func equalCoordinates(a, b []float64) bool {
+	return len(a) >= 2 && len(b) >= 2 && a[0] == b[0] && a[1] == b[1]
+}
+
+// toPoint координаты QuPath дробные, сегментации хранятся в целых пикселях
+func toPoint(point []float64, polygonIdx, ringIdx int) domain.SegmentationPoint {
+	p := domain.SegmentationPoint{Polygon: polygonIdx, Ring: ringIdx}
+	if len(point) >= 2 {
+		p.X = int(math.Round(point[0]))
+		p.Y = int(math.Round(point[1]))
+	}
+	return p
+}
+
+// diffQuPath сравнивает импортируемые features с аннотациями исследования, из которого они экспортированы
+func diffQuPath(cytologyID uuid.UUID, annotations []annotation, features []Feature) domain.QuPathImport {
+	byID := make(map[string]annotation, len(annotations))
+	for _, a := range annotations {
+		byID[annotationID(cytologyID, a.seg.Id)] = a
+	}
+
+	res := domain.QuPathImport{}
+	matched := map[string]struct{}{}
+	for _, feature := range features {
+		seg := featureSegmentation(feature)
+		if feature.ClassName == "" || seg.Validate() != nil {
+			continue
+		}
+		segType, _, found := getSegTypeFromExactClassName(feature.ClassName)
+		if !found {
+			continue
+		}
+
+		old, ok := byID[feature.ID]
+		if _, seen := matched[feature.ID]; !ok || seen {
+			res.Added++
+			continue
+		}
+		matched[feature.ID] = struct{}{}
+
+		if old.segType == segType && sameAnnotation(old.seg, seg) {
+			res.Unchanged++
+		} else {
+			res.Changed++
+		}
+	}
+	res.Removed = len(annotations) - len(matched)
+
+	return res
+}
+
+// sameAnnotation совпадают геометрия, цвет, блокировка и уверенность
+func sameAnnotation(a, b domain.Segmentation) bool {
+	if a.GeometryType != b.GeometryType || len(a.Points) != len(b.Points) || a.IsLocked != b.IsLocked {
+		return false
+	}
+	for i := range a.Points {
+		pa, pb := a.Points[i], b.Points[i]
+		if pa.X != pb.X || pa.Y != pb.Y || pa.Polygon != pb.Polygon || pa.Ring != pb.Ring {
+			return false
+		}
+	}
+	if (a.Color == nil) != (b.Color == nil) || (a.Color != nil && *a.Color != *b.Color) {
+		return false
+	}
+	if (a.Confidence == nil) != (b.Confidence == nil) || (a.Confidence != nil && *a.Confidence != *b.Confidence) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package segmentation_import
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cytology/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func qupathAnnotations() []annotation {
+	confidence := 0.75
+	return []annotation{
+		{segType: domain.SegTypeNIM, seg: domain.Segmentation{
+			Id:           1,
+			GeometryType: domain.GeometryTypePolygon,
+			Points: []domain.SegmentationPoint{
+				{X: 0, Y: 0}, {X: 100, Y: 0}, {X: 100, Y: 100}, {X: 0, Y: 100},
+				{Ring: 1, X: 10, Y: 10}, {Ring: 1, X: 20, Y: 10}, {Ring: 1, X: 10, Y: 20},
+			},
+			Color:      &domain.Color{R: 1, G: 2, B: 3},
+			IsLocked:   true,
+			Confidence: &confidence,
+			Properties: json.RawMessage(`{"note":"проверить"}`),
+		}},
+		{segType: domain.SegTypeCPS, seg: domain.Segmentation{
+			Id:           2,
+			GeometryType: domain.GeometryTypeMultiPolygon,
+			Points: []domain.SegmentationPoint{
+				{X: 0, Y: 0}, {X: 5, Y: 0}, {X: 0, Y: 5},
+				{Polygon: 1, X: 50, Y: 50}, {Polygon: 1, X: 55, Y: 50}, {Polygon: 1, X: 50, Y: 55},
+			},
+		}},
+		{segType: domain.SegTypeNIL, seg: domain.Segmentation{
+			Id:           3,
+			GeometryType: domain.GeometryTypePoint,
+			Points:       []domain.SegmentationPoint{{X: 7, Y: 8}},
+		}},
+	}
+}
+
+func TestQuPath_RoundTrip(t *testing.T) {
+	cytologyID := uuid.New()
+	annotations := qupathAnnotations()
+
+	data, err := encodeQuPath(cytologyID, annotations)
+	require.NoError(t, err)
+
+	features, err := decodeQuPath(data)
+	require.NoError(t, err)
+	require.Len(t, features, len(annotations))
+
+	require.Equal(t, "Макрофаг", features[0].ClassName)
+	require.Equal(t, annotations[0].seg.Points, features[0].Points)
+	require.Equal(t, annotations[0].seg.Color, features[0].Color)
+	require.True(t, features[0].IsLocked)
+	require.Equal(t, annotations[0].seg.Confidence, features[0].Confidence)
+	require.JSONEq(t, `{"note":"проверить"}`, string(features[0].Properties))
+	require.Equal(t, annotations[1].seg.Points, features[1].Points)
+	require.Equal(t, domain.GeometryTypePoint, features[2].GeometryType)
+
+	diff := diffQuPath(cytologyID, annotations, features)
+	require.Equal(t, domain.QuPathImport{Unchanged: 3}, diff)
+}
+
+func TestQuPath_Diff(t *testing.T) {
+	cytologyID := uuid.New()
+	annotations := qupathAnnotations()
+
+	data, err := encodeQuPath(cytologyID, annotations)
+	require.NoError(t, err)
+	features, err := decodeQuPath(data)
+	require.NoError(t, err)
+
+	// первая аннотация сдвинута, вторая переклассифицирована, третья удалена, добавлена новая
+	features[0].Points[0].X = 1
+	features[1].ClassName = "Скопление фолликулярное"
+	features = append(features[:2], Feature{
+		ClassName:    "Макрофаг",
+		GeometryType: domain.GeometryTypePolygon,
+		Points:       []domain.SegmentationPoint{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 0, Y: 3}},
+	}, Feature{ClassName: "Неизвестный", GeometryType: domain.GeometryTypePoint, Points: []domain.SegmentationPoint{{X: 1, Y: 1}}},
+		// неточное имя класса не сопоставляется
+		Feature{ClassName: "Метастаз", GeometryType: domain.GeometryTypePoint, Points: []domain.SegmentationPoint{{X: 1, Y: 1}}})
+
+	diff := diffQuPath(cytologyID, annotations, features)
+	require.Equal(t, domain.QuPathImport{Added: 1, Removed: 1, Changed: 2}, diff)
+}
+
+func TestDecodeQuPath(t *testing.T) {
+	features, err := decodeQuPath([]byte(`[{"type":"Feature","geometry":{"type":"LineString","coordinates":[[0.4,0.6],[10.5,3]]},"properties":{"classification":{"name":"Макрофаг"}}}]`))
+	require.NoError(t, err)
+	require.Len(t, features, 1)
+	require.Equal(t, domain.GeometryTypeLineString, features[0].GeometryType)
+	require.Equal(t, []domain.SegmentationPoint{{X: 0, Y: 1}, {X: 11, Y: 3}}, features[0].Points)
+	require.Nil(t, features[0].Properties)
+
+	for _, data := range []string{"", "{", `{"type":"Feature"}`} {
+		_, err := decodeQuPath([]byte(data))
+		require.ErrorIs(t, err, domain.ErrBadRequest, data)
+	}
+}
@@ -0,0 +1,60 @@
+package segmentation_import
+
+import (
+	"context"
+
+	"cytology/internal/domain"
+	"cytology/internal/repository"
+	"cytology/internal/services/cytology_image"
+
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	// ImportAISegmentation заменяет AI разметку изображения результатом обработки одной транзакцией
+	ImportAISegmentation(ctx context.Context, arg ImportArg) (domain.SegmentationImport, error)
+	// ExportQuPath все сегментации исследования в GeoJSON для QuPath
+	ExportQuPath(ctx context.Context, cytologyID uuid.UUID) ([]byte, error)
+	// ImportQuPath сохраняет отредактированный в QuPath GeoJSON новой версией исследования
+	ImportQuPath(ctx context.Context, arg QuPathImportArg) (domain.QuPathImport, error)
+}
+
+type service struct {
+	dao           repository.DAO
+	cytologyImage cytology_image.Service
+}
+
+func New(dao repository.DAO, cytologyImage cytology_image.Service) Service {
+	return &service{
+		dao:           dao,
+		cytologyImage: cytologyImage,
+	}
+}
+
+// Feature объект GeoJSON результата обработки
+type Feature struct {
+	// id объекта QuPath, по нему импорт сопоставляется с экспортом
+	ID string
+	// имя класса из properties.classification
+	ClassName    string
+	GeometryType domain.GeometryType
+	// точки всех колец всех полигонов по порядку
+	Points     []domain.SegmentationPoint
+	Color      *domain.Color
+	IsLocked   bool
+	Confidence *float64
+	// остальные properties, JSON объект
+	Properties []byte
+}
+
+type ImportArg struct {
+	CytologyID      uuid.UUID
+	OriginalImageID uuid.UUID
+	Features        []Feature
+}
+
+type QuPathImportArg struct {
+	// версия исследования, из которой сделан экспорт
+	CytologyID uuid.UUID
+	GeoJSON    []byte
+}
@@ -7,6 +7,7 @@ import (
 	"cytology/internal/services/original_image"
 	"cytology/internal/services/segmentation"
 	"cytology/internal/services/segmentation_group"
+	"cytology/internal/services/segmentation_import"
 )
 
 type Services struct {
@@ -14,23 +15,27 @@ type Services struct {
 	OriginalImage      original_image.Service
 	SegmentationGroup  segmentation_group.Service
 	Segmentation       segmentation.Service
+	SegmentationImport segmentation_import.Service
 	Producer           dbus.Producer
 }
 
 func New(
 	dao repository.DAO,
 	producer dbus.Producer,
+	originalImageCfg original_image.Config,
 ) *Services {
-	cytologyImage := cytology_image.New(dao)
-	originalImage := original_image.New(dao)
+	originalImage := original_image.New(dao, originalImageCfg)
+	cytologyImage := cytology_image.New(dao, originalImage)
 	segmentationGroup := segmentation_group.New(dao)
 	segmentation := segmentation.New(dao)
+	segmentationImport := segmentation_import.New(dao, cytologyImage)
 
 	return &Services{
-		CytologyImage:     cytologyImage,
-		OriginalImage:     originalImage,
-		SegmentationGroup: segmentationGroup,
-		Segmentation:      segmentation,
-		Producer:          producer,
+		CytologyImage:      cytologyImage,
+		OriginalImage:      originalImage,
+		SegmentationGroup:  segmentationGroup,
+		Segmentation:       segmentation,
+		SegmentationImport: segmentationImport,
+		Producer:           producer,
 	}
 }
@@ -80,6 +80,59 @@ func (suite *TestSuite) TestUpdateSegmentation_Success() {
 	require.Len(suite.T(), resp.Segmentation.Points, 3)
 }
 
+func (suite *TestSuite) TestUpdateSegmentation_PointIDs() {
+	data, err := flow.New(
+		suite.deps,
+		flow.CytologyImageInit,
+		flow.SegmentationGroupInit,
+		flow.SegmentationInit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	created, err := suite.deps.Adapter.GetSegmentationById(
+		suite.T().Context(),
+		&pb.GetSegmentationByIdIn{Id: data.SegmentationID},
+	)
+	require.NoError(suite.T(), err)
+
+	ids := make([]int32, 0, len(created.Segmentation.Points))
+	points := make([]*pb.SegmentationPointCreate, 0, len(created.Segmentation.Points))
+	for _, p := range created.Segmentation.Points {
+		require.NotZero(suite.T(), p.Id)
+		ids = append(ids, p.Id)
+		points = append(points, &pb.SegmentationPointCreate{X: p.X, Y: p.Y, Polygon: p.Polygon, Ring: p.Ring})
+	}
+
+	// та же геометрия - те же id
+	isLocked := true
+	same, err := suite.deps.Adapter.UpdateSegmentation(
+		suite.T().Context(),
+		&pb.UpdateSegmentationIn{Id: data.SegmentationID, Points: points, IsLocked: &isLocked},
+	)
+	require.NoError(suite.T(), err)
+	for i, p := range same.Segmentation.Points {
+		require.Equal(suite.T(), ids[i], p.Id)
+	}
+
+	// новая геометрия - новые id
+	changed, err := suite.deps.Adapter.UpdateSegmentation(
+		suite.T().Context(),
+		&pb.UpdateSegmentationIn{
+			Id: data.SegmentationID,
+			Points: []*pb.SegmentationPointCreate{
+				{X: 1, Y: 2},
+				{X: 3, Y: 4},
+				{X: 5, Y: 6},
+			},
+		},
+	)
+	require.NoError(suite.T(), err)
+	for _, p := range changed.Segmentation.Points {
+		require.NotZero(suite.T(), p.Id)
+		require.NotContains(suite.T(), ids, p.Id)
+	}
+}
+
 func (suite *TestSuite) TestDeleteSegmentation_Success() {
 	data, err := flow.New(
 		suite.deps,
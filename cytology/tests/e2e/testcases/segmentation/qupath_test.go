@@ -0,0 +1,44 @@
+//go:build e2e
+
+package segmentation_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	pb "cytology/internal/generated/grpc/service"
+	"cytology/tests/e2e/flow"
+)
+
+func (suite *TestSuite) TestQuPathGeoJson_RoundTrip() {
+	data, err := flow.New(
+		suite.deps,
+		flow.CytologyImageInit,
+		flow.OriginalImageInit,
+		flow.SegmentationGroupInit,
+		flow.SegmentationInit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	exported, err := suite.deps.Adapter.ExportQuPathGeoJson(
+		suite.T().Context(),
+		&pb.ExportQuPathGeoJsonIn{CytologyId: data.CytologyImageID.String()},
+	)
+	require.NoError(suite.T(), err)
+	require.Contains(suite.T(), exported.Geojson, "Нормальная клетка")
+
+	imported, err := suite.deps.Adapter.ImportQuPathGeoJson(
+		suite.T().Context(),
+		&pb.ImportQuPathGeoJsonIn{CytologyId: data.CytologyImageID.String(), Geojson: exported.Geojson},
+	)
+	require.NoError(suite.T(), err)
+	require.NotEqual(suite.T(), data.CytologyImageID.String(), imported.CytologyImage.Id)
+	require.Equal(suite.T(), int32(1), imported.Unchanged)
+	require.Zero(suite.T(), imported.Added+imported.Removed+imported.Changed+imported.Skipped)
+
+	// импорт возможен только в последнюю версию
+	_, err = suite.deps.Adapter.ImportQuPathGeoJson(
+		suite.T().Context(),
+		&pb.ImportQuPathGeoJsonIn{CytologyId: data.CytologyImageID.String(), Geojson: exported.Geojson},
+	)
+	require.Error(suite.T(), err)
+}
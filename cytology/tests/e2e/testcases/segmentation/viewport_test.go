@@ -0,0 +1,62 @@
+//go:build e2e
+
+package segmentation_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	pb "cytology/internal/generated/grpc/service"
+	"cytology/tests/e2e/flow"
+)
+
+func (suite *TestSuite) TestGetSegmentsInViewport_Success() {
+	data, err := flow.New(
+		suite.deps,
+		flow.CytologyImageInit,
+		flow.SegmentationGroupInit,
+		flow.SegmentationInit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	// сегментация от (10, 20) до (30, 40)
+	inside, err := suite.deps.Adapter.GetSegmentsInViewport(
+		suite.T().Context(),
+		&pb.GetSegmentsInViewportIn{
+			CytologyId: data.CytologyImageID.String(),
+			Bbox:       &pb.BBox{MinX: 0, MinY: 0, MaxX: 15, MaxY: 25},
+		},
+	)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), inside.Segmentations, 1)
+	require.Equal(suite.T(), data.SegmentationID, inside.Segmentations[0].Id)
+	require.Nil(suite.T(), inside.NextCursor)
+
+	outside, err := suite.deps.Adapter.GetSegmentsInViewport(
+		suite.T().Context(),
+		&pb.GetSegmentsInViewportIn{
+			CytologyId: data.CytologyImageID.String(),
+			Bbox:       &pb.BBox{MinX: 100, MinY: 100, MaxX: 200, MaxY: 200},
+		},
+	)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), outside.Segmentations)
+}
+
+func (suite *TestSuite) TestGetSegmentsInViewport_InvalidCursor() {
+	data, err := flow.New(
+		suite.deps,
+		flow.CytologyImageInit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	cursor := "not a cursor"
+	_, err = suite.deps.Adapter.GetSegmentsInViewport(
+		suite.T().Context(),
+		&pb.GetSegmentsInViewportIn{
+			CytologyId: data.CytologyImageID.String(),
+			Bbox:       &pb.BBox{MaxX: 100, MaxY: 100},
+			Cursor:     &cursor,
+		},
+	)
+	require.Error(suite.T(), err)
+}
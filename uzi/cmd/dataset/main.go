@@ -0,0 +1,260 @@
+// Работа с разметкой узи вне сервиса:
+// export - выгрузка провалидированной разметки в COCO/YOLO датасет на локальный диск,
+// import - загрузка разметки COCO/CVAT в ручные узлы, с -dry-run печатает только отчет
+//
+//	DB_DSN=... S3_ENDPOINT=... S3_TOKEN_ACCESS=... S3_TOKEN_SECRET=... \
+//	  go run ./cmd/dataset export -out ./dataset -format yolo -ai-valid=false
+//	go run ./cmd/dataset import -file annotations.xml -format cvat -uzi-id <id> -dry-run
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	loglib "github.com/WantBeASleep/med_ml_lib/observer/log"
+	"github.com/google/uuid"
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"uzi/internal/config"
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+	"uzi/internal/services/dataset"
+	"uzi/internal/services/history"
+	"uzi/internal/services/measurement"
+	"uzi/internal/services/node_segment"
+	"uzi/internal/services/tirads"
+)
+
+const (
+	successExitCode = 0
+	failExitCode    = 1
+)
+
+// брокер и grpc для работы с разметкой не нужны
+type datasetConfig struct {
+	DB config.DB
+	S3 config.S3
+}
+
+func main() {
+	os.Exit(run())
+}
+
+func run() (exitCode int) {
+	loglib.InitLogger(loglib.WithEnv())
+
+	if len(os.Args) < 2 || (os.Args[1] != "export" && os.Args[1] != "import") {
+		slog.Error("usage: dataset export|import [flags]")
+		return failExitCode
+	}
+	command, args := os.Args[1], os.Args[2:]
+
+	cfg := datasetConfig{}
+	if err := cleanenv.ReadEnv(&cfg); err != nil {
+		slog.Error("init config", "err", err)
+		return failExitCode
+	}
+
+	db, err := sqlx.Open("postgres", cfg.DB.Dsn)
+	if err != nil {
+		slog.Error("init db", "err", err)
+		return failExitCode
+	}
+	defer db.Close()
+
+	if err := db.Ping(); err != nil {
+		slog.Error("ping db", "err", err)
+		return failExitCode
+	}
+
+	client, err := minio.New(cfg.S3.Endpoint, &minio.Options{
+		Secure: false,
+		Creds:  credentials.NewStaticV4(cfg.S3.Access_Token, cfg.S3.Secret_Token, ""),
+	})
+	if err != nil {
+		slog.Error("init s3", "err", err)
+		return failExitCode
+	}
+
+	dao := repository.NewRepository(db, client, "uzi")
+	measurementSrv := measurement.New(dao)
+	nodeSegmentSrv := node_segment.New(dao, measurementSrv, history.New(dao, measurementSrv))
+	srv := dataset.New(dao, tirads.New(dao), nodeSegmentSrv)
+
+	switch command {
+	case "export":
+		err = runExport(context.Background(), srv, args)
+	case "import":
+		err = runImport(context.Background(), srv, args)
+	}
+	if err != nil {
+		slog.Error(command, "err", err)
+		return failExitCode
+	}
+
+	return successExitCode
+}
+
+func runExport(ctx context.Context, srv dataset.Service, args []string) error {
+	arg, out, err := parseExportFlags(args)
+	if err != nil {
+		return fmt.Errorf("parse flags: %w", err)
+	}
+
+	export, err := srv.Export(ctx, arg, dataset.NewDirWriter(out))
+	if err != nil {
+		return fmt.Errorf("export dataset: %w", err)
+	}
+
+	slog.Info(
+		"dataset exported",
+		slog.String("path", export.Path),
+		slog.String("format", export.Format.String()),
+		slog.Int("train_images", export.TrainImages),
+		slog.Int("val_images", export.ValImages),
+		slog.Int("annotations", export.Annotations),
+	)
+
+	return nil
+}
+
+func runImport(ctx context.Context, srv dataset.Service, args []string) error {
+	arg, err := parseImportFlags(args)
+	if err != nil {
+		return fmt.Errorf("parse flags: %w", err)
+	}
+
+	report, err := srv.Import(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("import annotations: %w", err)
+	}
+
+	// отчет печатается целиком, чтобы его можно было сохранить и просмотреть до настоящего импорта
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("print report: %w", err)
+	}
+
+	slog.Info(
+		"annotations imported",
+		slog.Bool("dry_run", report.DryRun),
+		slog.Int("nodes", len(report.Nodes)),
+		slog.Int("skipped", len(report.Skipped)),
+	)
+
+	return nil
+}
+
+func parseExportFlags(args []string) (dataset.ExportArg, string, error) {
+	var (
+		arg      dataset.ExportArg
+		out      string
+		format   string
+		valRatio float64
+	)
+
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.StringVar(&out, "out", "", "каталог, куда будет записан датасет")
+	fs.StringVar(&format, "format", domain.DatasetFormatCoco.String(), "формат датасета: coco или yolo")
+	fs.BoolVar(&arg.Nodes.Manual, "manual", true, "выгружать узлы, размеченные врачом")
+	fs.BoolVar(&arg.Nodes.AiValid, "ai-valid", true, "выгружать узлы нейросети с validation=valid")
+	fs.Float64Var(&valRatio, "val-ratio", 0.2, "доля пациентов в валидационной выборке")
+	fs.StringVar(&arg.Seed, "seed", "", "соль разбиения на выборки и имен файлов")
+
+	fs.Func("author", "id автора узи", func(v string) error {
+		id, err := uuid.Parse(v)
+		arg.Filter.Author = &id
+		return err
+	})
+	fs.Func("external-id", "id пациента", func(v string) error {
+		id, err := uuid.Parse(v)
+		arg.Filter.ExternalID = &id
+		return err
+	})
+	fs.Func("device-id", "id аппарата", func(v string) error {
+		id, err := strconv.Atoi(v)
+		arg.Filter.DeviceID = &id
+		return err
+	})
+	fs.Func("checked", "только проверенные (true) или непроверенные (false) узи", func(v string) error {
+		checked, err := strconv.ParseBool(v)
+		arg.Filter.Checked = &checked
+		return err
+	})
+	fs.Func("create-from", "начало периода создания узи, RFC3339", func(v string) error {
+		t, err := time.Parse(time.RFC3339, v)
+		arg.Filter.CreateFrom = &t
+		return err
+	})
+	fs.Func("create-to", "конец периода создания узи, RFC3339", func(v string) error {
+		t, err := time.Parse(time.RFC3339, v)
+		arg.Filter.CreateTo = &t
+		return err
+	})
+
+	if err := fs.Parse(args); err != nil {
+		return dataset.ExportArg{}, "", err
+	}
+	if out == "" {
+		return dataset.ExportArg{}, "", fmt.Errorf("-out is required")
+	}
+
+	parsed, err := domain.DatasetFormat("").Parse(format)
+	if err != nil {
+		return dataset.ExportArg{}, "", err
+	}
+	arg.Format = parsed
+	arg.ValRatio = &valRatio
+
+	return arg, out, nil
+}
+
+func parseImportFlags(args []string) (dataset.ImportArg, error) {
+	var (
+		arg    dataset.ImportArg
+		file   string
+		format string
+	)
+
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.StringVar(&file, "file", "", "файл разметки")
+	fs.StringVar(&format, "format", domain.AnnotationFormatCoco.String(), "формат разметки: coco или cvat")
+	fs.BoolVar(&arg.DryRun, "dry-run", false, "только показать, что будет создано и пропущено")
+	fs.Func("uzi-id", "узи для кадров, в пути которых нет id узи", func(v string) error {
+		id, err := uuid.Parse(v)
+		arg.UziID = &id
+		return err
+	})
+
+	if err := fs.Parse(args); err != nil {
+		return dataset.ImportArg{}, err
+	}
+	if file == "" {
+		return dataset.ImportArg{}, fmt.Errorf("-file is required")
+	}
+
+	parsed, err := domain.AnnotationFormat("").Parse(format)
+	if err != nil {
+		return dataset.ImportArg{}, err
+	}
+	arg.Format = parsed
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return dataset.ImportArg{}, fmt.Errorf("read file: %w", err)
+	}
+	arg.Content = content
+
+	return arg, nil
+}
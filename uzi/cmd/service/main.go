@@ -3,9 +3,12 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net"
 	"os"
+	"time"
 
 	dbuslib "github.com/WantBeASleep/med_ml_lib/dbus"
 	grpclib "github.com/WantBeASleep/med_ml_lib/grpc"
@@ -14,12 +17,17 @@ import (
 	loglib "github.com/WantBeASleep/med_ml_lib/observer/log"
 
 	"uzi/internal/config"
+	"uzi/internal/domain"
 
 	"github.com/ilyakaznacheev/cleanenv"
 
 	"uzi/internal/repository"
 
 	services "uzi/internal/services"
+	devicesrv "uzi/internal/services/device"
+	imagesrv "uzi/internal/services/image"
+	retentionsrv "uzi/internal/services/retention"
+	uzisrv "uzi/internal/services/uzi"
 
 	pb "uzi/internal/generated/grpc/service"
 
@@ -110,11 +118,58 @@ func run() (exitCode int) {
 
 	dao := repository.NewRepository(db, client, "uzi")
 
+	duplicatePolicy, err := domain.DuplicatePolicy.Parse("", cfg.Integrity.DuplicatePolicy)
+	if err != nil {
+		slog.Error("parse duplicate policy", "err", err)
+		return failExitCode
+	}
+
+	mask := domain.EdgeMask{
+		TopPercent:    cfg.Masking.TopPercent,
+		BottomPercent: cfg.Masking.BottomPercent,
+	}
+	if err := mask.Validate(); err != nil {
+		slog.Error("validate masking config", "err", err)
+		return failExitCode
+	}
+
+	conclusionTemplate := uzisrv.DefaultConclusionTemplate()
+	if cfg.Echographic.ConclusionTemplatePath != "" {
+		text, err := os.ReadFile(cfg.Echographic.ConclusionTemplatePath)
+		if err != nil {
+			slog.Error("read conclusion template", "err", err)
+			return failExitCode
+		}
+		conclusionTemplate, err = uzisrv.ParseConclusionTemplate(string(text))
+		if err != nil {
+			slog.Error("parse conclusion template", "err", err)
+			return failExitCode
+		}
+	}
+
 	services := services.New(
 		dao,
 		dbusAdapter,
+		retentionsrv.Config{
+			SoftDeleteWindow: cfg.Retention.SoftDeleteWindow,
+			OrphanGrace:      cfg.Retention.OrphanGrace,
+		},
+		uzisrv.Config{
+			DuplicatePolicy:    duplicatePolicy,
+			ConclusionTemplate: conclusionTemplate,
+		},
+		imagesrv.Config{
+			Mask: mask,
+		},
 	)
 
+	if cfg.Devices.SeedPath != "" {
+		if err := seedDevices(context.Background(), services.Device, cfg.Devices.SeedPath); err != nil {
+			slog.Error("seed devices", "err", err)
+			return failExitCode
+		}
+	}
+
 	handler := grpchandler.New(services)
 
 	server := grpc.NewServer(
@@ -122,6 +177,7 @@ func run() (exitCode int) {
 			grpclib.PanicRecover,
 			observergrpclib.CrossServerCall,
 			observergrpclib.LogServerCall,
+			grpchandler.ActorServerCall,
 		),
 	)
 	pb.RegisterUziSrvServer(server, handler)
@@ -180,7 +236,111 @@ func run() (exitCode int) {
 		}
 	}()
 
+	go runPeriodic(context.Background(), "storage cleanup", cfg.Retention.CleanupInterval, func(ctx context.Context) error {
+		purged, err := services.Retention.PurgeDeletedUzis(ctx)
+		if err != nil {
+			return fmt.Errorf("purge deleted uzis: %w", err)
+		}
+		cleaned, err := services.Retention.RunCleanup(ctx)
+		if err != nil {
+			return fmt.Errorf("run cleanup: %w", err)
+		}
+		if purged > 0 || cleaned > 0 {
+			slog.Info("storage cleanup", "purged uzis", purged, "cleaned prefixes", cleaned)
+		}
+		return nil
+	})
+	go runPeriodic(context.Background(), "orphan sweep", cfg.Retention.SweepInterval, func(ctx context.Context) error {
+		sweep, err := services.Retention.SweepOrphans(ctx, false)
+		if err != nil {
+			return err
+		}
+		if len(sweep.Orphans) > 0 {
+			slog.Info("orphan sweep", "checked", sweep.Checked, "removed", len(sweep.Orphans))
+		}
+		return nil
+	})
+
+	go runPeriodic(context.Background(), "integrity verify", cfg.Integrity.VerifyInterval, func(ctx context.Context) error {
+		report, err := services.Integrity.Verify(ctx)
+		if err != nil {
+			return err
+		}
+		for _, mismatch := range report.Mismatches {
+			slog.Error("integrity verify: sha256 mismatch", "uzi", mismatch.UziID, "expected", mismatch.Expected, "actual", mismatch.Actual)
+		}
+		for _, id := range report.Missing {
+			slog.Error("integrity verify: original file missing", "uzi", id)
+		}
+		slog.Info("integrity verify", "checked", report.Checked, "mismatches", len(report.Mismatches), "missing", len(report.Missing))
+		return nil
+	})
+
 	<-close
 
 	return successExitCode
 }
+
+// runPeriodic выполняет job раз в interval, ошибки только логируются; interval 0 отключает job
+func runPeriodic(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				slog.Error(name, "err", err)
+			}
+		}
+	}
+}
+
+func seedDevices(ctx context.Context, srv devicesrv.Service, path string) error {
+	seed, err := config.ReadDeviceSeed(path)
+	if err != nil {
+		return fmt.Errorf("read device seed: %w", err)
+	}
+
+	args := make([]devicesrv.CreateDeviceArg, 0, len(seed.Devices))
+	for _, v := range seed.Devices {
+		if v.Name == "" {
+			return errors.New("device name is required")
+		}
+
+		arg := devicesrv.CreateDeviceArg{
+			Name:         v.Name,
+			Manufacturer: v.Manufacturer,
+			Model:        v.Model,
+			SerialNumber: v.SerialNumber,
+		}
+
+		if v.ProbeType != nil {
+			probeType, err := domain.ProbeType.Parse("", *v.ProbeType)
+			if err != nil {
+				return fmt.Errorf("device %q: %w", v.Name, err)
+			}
+			arg.ProbeType = &probeType
+		}
+
+		switch {
+		case v.PixelSpacingX == nil && v.PixelSpacingY == nil:
+		case v.PixelSpacingX == nil || v.PixelSpacingY == nil:
+			return fmt.Errorf("device %q: both pixel_spacing_x and pixel_spacing_y must be set", v.Name)
+		case *v.PixelSpacingX <= 0 || *v.PixelSpacingY <= 0:
+			return fmt.Errorf("device %q: pixel spacing must be positive", v.Name)
+		default:
+			arg.PixelSpacing = &domain.PixelSpacing{X: *v.PixelSpacingX, Y: *v.PixelSpacingY}
+		}
+
+		args = append(args, arg)
+	}
+
+	return srv.SeedDevices(ctx, args)
+}
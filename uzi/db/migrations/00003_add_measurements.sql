@@ -0,0 +1,71 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE uzi
+    ADD COLUMN pixel_spacing_x real,
+    ADD COLUMN pixel_spacing_y real;
+
+COMMENT ON COLUMN uzi.pixel_spacing_x IS 'Размер пикселя по горизонтали, мм';
+COMMENT ON COLUMN uzi.pixel_spacing_y IS 'Размер пикселя по вертикали, мм';
+
+ALTER TABLE segment
+    ADD COLUMN bbox_x       integer,
+    ADD COLUMN bbox_y       integer,
+    ADD COLUMN bbox_width   integer,
+    ADD COLUMN bbox_height  integer,
+    ADD COLUMN area         real,
+    ADD COLUMN perimeter    real,
+    ADD COLUMN major_axis   real,
+    ADD COLUMN minor_axis   real,
+    ADD COLUMN measure_unit varchar(255);
+
+COMMENT ON COLUMN segment.bbox_x IS 'Ограничивающий прямоугольник: x, px';
+COMMENT ON COLUMN segment.bbox_y IS 'Ограничивающий прямоугольник: y, px';
+COMMENT ON COLUMN segment.bbox_width IS 'Ограничивающий прямоугольник: ширина, px';
+COMMENT ON COLUMN segment.bbox_height IS 'Ограничивающий прямоугольник: высота, px';
+COMMENT ON COLUMN segment.area IS 'Площадь контура';
+COMMENT ON COLUMN segment.perimeter IS 'Периметр контура';
+COMMENT ON COLUMN segment.major_axis IS 'Большая ось контура';
+COMMENT ON COLUMN segment.minor_axis IS 'Малая ось контура';
+COMMENT ON COLUMN segment.measure_unit IS 'Единица измерения: px/mm';
+
+ALTER TABLE node
+    ADD COLUMN area         real,
+    ADD COLUMN perimeter    real,
+    ADD COLUMN major_axis   real,
+    ADD COLUMN minor_axis   real,
+    ADD COLUMN volume       real,
+    ADD COLUMN measure_unit varchar(255);
+
+COMMENT ON COLUMN node.area IS 'Максимальная площадь среди сегментов';
+COMMENT ON COLUMN node.perimeter IS 'Максимальный периметр среди сегментов';
+COMMENT ON COLUMN node.major_axis IS 'Максимальная большая ось среди сегментов';
+COMMENT ON COLUMN node.minor_axis IS 'Максимальная малая ось среди сегментов';
+COMMENT ON COLUMN node.volume IS 'Оценка объема узла по эллипсоиду';
+COMMENT ON COLUMN node.measure_unit IS 'Единица измерения: px/mm';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE node
+    DROP COLUMN area,
+    DROP COLUMN perimeter,
+    DROP COLUMN major_axis,
+    DROP COLUMN minor_axis,
+    DROP COLUMN volume,
+    DROP COLUMN measure_unit;
+
+ALTER TABLE segment
+    DROP COLUMN bbox_x,
+    DROP COLUMN bbox_y,
+    DROP COLUMN bbox_width,
+    DROP COLUMN bbox_height,
+    DROP COLUMN area,
+    DROP COLUMN perimeter,
+    DROP COLUMN major_axis,
+    DROP COLUMN minor_axis,
+    DROP COLUMN measure_unit;
+
+ALTER TABLE uzi
+    DROP COLUMN pixel_spacing_x,
+    DROP COLUMN pixel_spacing_y;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE node_descriptors
+(
+    node_id        uuid PRIMARY KEY,
+    composition    varchar(255)   NOT NULL,
+    echogenicity   varchar(255)   NOT NULL,
+    shape          varchar(255)   NOT NULL,
+    margin         varchar(255)   NOT NULL,
+    echogenic_foci varchar(255)[] NOT NULL DEFAULT '{}'
+);
+
+COMMENT ON TABLE node_descriptors IS 'Дескрипторы ACR TI-RADS узла, заполненные врачом';
+COMMENT ON COLUMN node_descriptors.node_id IS 'ID узла';
+COMMENT ON COLUMN node_descriptors.composition IS 'Состав: cystic/spongiform/mixed/solid';
+COMMENT ON COLUMN node_descriptors.echogenicity IS 'Эхогенность: anechoic/hyper_iso/hypo/very_hypo';
+COMMENT ON COLUMN node_descriptors.shape IS 'Форма: wider_than_tall/taller_than_wide';
+COMMENT ON COLUMN node_descriptors.margin IS 'Края: smooth/ill_defined/lobulated/extrathyroidal';
+COMMENT ON COLUMN node_descriptors.echogenic_foci IS 'Эхогенные включения: none/macrocalcifications/peripheral/punctate';
+
+ALTER TABLE node_descriptors
+    ADD CONSTRAINT fk_node_descriptors_node
+    FOREIGN KEY (node_id)
+    REFERENCES node (id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE node_descriptors
+    DROP CONSTRAINT fk_node_descriptors_node;
+
+DROP TABLE IF EXISTS node_descriptors CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE node ADD COLUMN lobe varchar(255);
+
+COMMENT ON COLUMN node.lobe IS 'Доля щитовидной железы в которой расположен узел (right, left, isthmus)';
+
+CREATE TABLE node_lineage
+(
+    node_id     uuid    PRIMARY KEY REFERENCES node (id) ON DELETE CASCADE,
+    lineage_id  uuid    NOT NULL
+);
+
+CREATE INDEX node_lineage_lineage_id_idx ON node_lineage (lineage_id);
+
+COMMENT ON TABLE node_lineage IS 'Связи узлов одного и того же образования между узи пациента';
+COMMENT ON COLUMN node_lineage.lineage_id IS 'Идентификатор цепочки наблюдений образования';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS node_lineage;
+ALTER TABLE node DROP COLUMN IF EXISTS lobe;
+-- +goose StatementEnd
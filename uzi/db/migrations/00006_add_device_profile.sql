@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE device
+    ADD COLUMN manufacturer     varchar(255),
+    ADD COLUMN model            varchar(255),
+    ADD COLUMN serial_number    varchar(255) UNIQUE,
+    ADD COLUMN probe_type       varchar(255),
+    ADD COLUMN pixel_spacing_x  real CHECK (pixel_spacing_x > 0),
+    ADD COLUMN pixel_spacing_y  real CHECK (pixel_spacing_y > 0);
+
+COMMENT ON COLUMN device.manufacturer IS 'Производитель аппарата';
+COMMENT ON COLUMN device.model IS 'Модель аппарата';
+COMMENT ON COLUMN device.serial_number IS 'Серийный номер аппарата';
+COMMENT ON COLUMN device.probe_type IS 'Тип датчика (linear, convex, sector)';
+COMMENT ON COLUMN device.pixel_spacing_x IS 'Калибровка: размер пикселя по горизонтали в мм, используется если у узи не задан свой';
+COMMENT ON COLUMN device.pixel_spacing_y IS 'Калибровка: размер пикселя по вертикали в мм, используется если у узи не задан свой';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE device
+    DROP COLUMN IF EXISTS manufacturer,
+    DROP COLUMN IF EXISTS model,
+    DROP COLUMN IF EXISTS serial_number,
+    DROP COLUMN IF EXISTS probe_type,
+    DROP COLUMN IF EXISTS pixel_spacing_x,
+    DROP COLUMN IF EXISTS pixel_spacing_y;
+-- +goose StatementEnd
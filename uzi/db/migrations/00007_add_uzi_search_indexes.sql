@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX uzi_author_create_at_idx ON uzi (author, create_at, id);
+CREATE INDEX uzi_external_id_create_at_idx ON uzi (external_id, create_at, id);
+CREATE INDEX uzi_create_at_idx ON uzi (create_at, id);
+CREATE INDEX uzi_device_id_idx ON uzi (device_id);
+
+-- поиск узи с нейросетевыми узлами выше порога tirads
+CREATE INDEX node_ai_uzi_id_idx ON node (uzi_id, tirads_5, tirads_4) WHERE ai;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS node_ai_uzi_id_idx;
+DROP INDEX IF EXISTS uzi_device_id_idx;
+DROP INDEX IF EXISTS uzi_create_at_idx;
+DROP INDEX IF EXISTS uzi_external_id_create_at_idx;
+DROP INDEX IF EXISTS uzi_author_create_at_idx;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE report
+(
+    id          uuid        PRIMARY KEY,
+    uzi_id      uuid        NOT NULL REFERENCES uzi (id) ON DELETE CASCADE,
+    version     integer     NOT NULL CHECK (version > 0),
+    html_path   text        NOT NULL,
+    pdf_path    text        NOT NULL,
+    create_at   timestamptz NOT NULL,
+    UNIQUE (uzi_id, version)
+);
+
+COMMENT ON TABLE report IS 'Версии сформированных заключений по узи';
+COMMENT ON COLUMN report.version IS 'Номер версии заключения в рамках узи, начиная с 1';
+COMMENT ON COLUMN report.html_path IS 'Путь к HTML заключению в S3';
+COMMENT ON COLUMN report.pdf_path IS 'Путь к PDF заключению в S3';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS report;
+-- +goose StatementEnd
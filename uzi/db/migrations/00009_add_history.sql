@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE node_history
+(
+    node_id     uuid        NOT NULL,
+    uzi_id      uuid        NOT NULL,
+    version     integer     NOT NULL CHECK (version > 0),
+    action      varchar(16) NOT NULL,
+    actor       uuid,
+    before      jsonb,
+    after       jsonb,
+    diff        jsonb       NOT NULL,
+    create_at   timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (node_id, version)
+);
+
+COMMENT ON TABLE node_history IS 'Версии узлов, история переживает удаление узла, поэтому без внешних ключей';
+COMMENT ON COLUMN node_history.action IS 'create, update, delete, restore или snapshot - состояние узла, созданного до ведения истории';
+COMMENT ON COLUMN node_history.actor IS 'Пользователь из metadata запроса, NULL для изменений сервисом';
+COMMENT ON COLUMN node_history.before IS 'Состояние узла до изменения, NULL при создании';
+COMMENT ON COLUMN node_history.after IS 'Состояние узла после изменения, NULL при удалении';
+COMMENT ON COLUMN node_history.diff IS 'Изменившиеся поля со значениями до и после';
+COMMENT ON COLUMN node_history.create_at IS 'Время транзакции, общее для всех записей одной операции';
+
+CREATE INDEX node_history_uzi_id_idx ON node_history (uzi_id);
+
+CREATE TABLE segment_history
+(
+    segment_id  uuid        NOT NULL,
+    node_id     uuid        NOT NULL,
+    version     integer     NOT NULL CHECK (version > 0),
+    action      varchar(16) NOT NULL,
+    actor       uuid,
+    before      jsonb,
+    after       jsonb,
+    diff        jsonb       NOT NULL,
+    create_at   timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (segment_id, version)
+);
+
+COMMENT ON TABLE segment_history IS 'Версии сегментов, история переживает удаление сегмента, поэтому без внешних ключей';
+COMMENT ON COLUMN segment_history.node_id IS 'Узел сегмента на момент изменения';
+
+CREATE INDEX segment_history_node_id_idx ON segment_history (node_id, segment_id, version DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS segment_history;
+DROP TABLE IF EXISTS node_history;
+-- +goose StatementEnd
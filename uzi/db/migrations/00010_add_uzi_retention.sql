@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE uzi ADD COLUMN delete_at timestamptz;
+
+COMMENT ON COLUMN uzi.delete_at IS 'Время мягкого удаления, узи скрыто и окончательно удаляется после окна восстановления';
+
+CREATE INDEX uzi_delete_at_idx ON uzi (delete_at) WHERE delete_at IS NOT NULL;
+
+CREATE TABLE storage_cleanup
+(
+    prefix          text        PRIMARY KEY,
+    attempts        integer     NOT NULL DEFAULT 0,
+    last_error      text,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    create_at       timestamptz NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE storage_cleanup IS 'Очередь удаления объектов S3 удаленных узи, задача живет до успешной очистки';
+COMMENT ON COLUMN storage_cleanup.prefix IS 'Префикс объектов в бакете, для узи - "<uzi_id>/"';
+COMMENT ON COLUMN storage_cleanup.attempts IS 'Число неудачных попыток';
+COMMENT ON COLUMN storage_cleanup.last_error IS 'Ошибка последней неудачной попытки';
+COMMENT ON COLUMN storage_cleanup.next_attempt_at IS 'Время следующей попытки, сдвигается с экспоненциальной задержкой';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS storage_cleanup;
+DROP INDEX IF EXISTS uzi_delete_at_idx;
+ALTER TABLE uzi DROP COLUMN IF EXISTS delete_at;
+-- +goose StatementEnd
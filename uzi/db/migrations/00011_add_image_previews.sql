@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE image
+    ADD COLUMN width    integer NOT NULL DEFAULT 0,
+    ADD COLUMN height   integer NOT NULL DEFAULT 0,
+    ADD COLUMN previews boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN image.width IS 'Ширина исходного кадра, px. 0 - кадр разбит до появления превью';
+COMMENT ON COLUMN image.height IS 'Высота исходного кадра, px. 0 - кадр разбит до появления превью';
+COMMENT ON COLUMN image.previews IS 'Созданы ли preview.jpg и thumbnail.jpg рядом с кадром в S3';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE image
+    DROP COLUMN IF EXISTS previews,
+    DROP COLUMN IF EXISTS height,
+    DROP COLUMN IF EXISTS width;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE uzi
+    ADD COLUMN sha256 char(64);
+
+CREATE INDEX uzi_external_id_sha256_idx ON uzi (external_id, sha256) WHERE delete_at IS NULL;
+
+COMMENT ON COLUMN uzi.sha256 IS 'SHA-256 исходного файла в hex. NULL - узи загружено до подсчета';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uzi_external_id_sha256_idx;
+
+ALTER TABLE uzi
+    DROP COLUMN IF EXISTS sha256;
+-- +goose StatementEnd
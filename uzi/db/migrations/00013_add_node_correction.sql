@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE node_correction
+(
+    id           uuid        PRIMARY KEY,
+    uzi_id       uuid        NOT NULL REFERENCES uzi (id) ON DELETE CASCADE,
+    kind         varchar(16) NOT NULL,
+    actor        uuid,
+    sources      jsonb       NOT NULL,
+    result_ids   uuid[]      NOT NULL,
+    create_at    timestamptz NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE node_correction IS 'Слияния и разделения узлов, исходные узлы сохраняются для аналитики ошибок нейросети';
+COMMENT ON COLUMN node_correction.kind IS 'merge или split';
+COMMENT ON COLUMN node_correction.actor IS 'Пользователь из metadata запроса';
+COMMENT ON COLUMN node_correction.sources IS 'Узлы до операции вместе с id их сегментов';
+COMMENT ON COLUMN node_correction.result_ids IS 'Узлы после операции';
+
+CREATE INDEX node_correction_uzi_id_idx ON node_correction (uzi_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS node_correction;
+-- +goose StatementEnd
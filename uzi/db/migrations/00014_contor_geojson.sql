@@ -0,0 +1,115 @@
+-- +goose Up
+-- +goose StatementBegin
+-- контур версии 1: GeoJSON Polygon из одного замкнутого кольца против часовой стрелки.
+-- Точки за кадром прижимаются к его границам, сервис при записи отсекает контур точнее.
+-- Контуры меньше чем из 3 разных точек не трогаются, они и раньше не читались
+CREATE FUNCTION contor_v1(contor jsonb, width integer, height integer) RETURNS jsonb AS
+$$
+DECLARE
+    ring  jsonb            := '[]'::jsonb;
+    prev  jsonb;
+    pt    jsonb;
+    x     double precision;
+    y     double precision;
+    area  double precision := 0;
+    n     integer;
+BEGIN
+    IF contor IS NULL OR jsonb_typeof(contor) <> 'array' THEN
+        RETURN contor;
+    END IF;
+
+    FOR pt IN SELECT value FROM jsonb_array_elements(contor)
+        LOOP
+            x := (pt ->> 'x')::double precision;
+            y := (pt ->> 'y')::double precision;
+            IF x IS NULL OR y IS NULL THEN
+                RETURN contor;
+            END IF;
+            IF width > 0 AND height > 0 THEN
+                x := least(greatest(x, 0), width);
+                y := least(greatest(y, 0), height);
+            END IF;
+
+            pt := jsonb_build_array(x, y);
+            IF prev IS NULL OR pt <> prev THEN
+                ring := ring || jsonb_build_array(pt);
+            END IF;
+            prev := pt;
+        END LOOP;
+
+    WHILE jsonb_array_length(ring) > 1 AND ring -> 0 = ring -> -1
+        LOOP
+            ring := ring - (-1);
+        END LOOP;
+
+    n := jsonb_array_length(ring);
+    IF n < 3 THEN
+        RETURN contor;
+    END IF;
+
+    FOR i IN 0..n - 1
+        LOOP
+            area := area
+                + (ring -> i ->> 0)::double precision * (ring -> ((i + 1) % n) ->> 1)::double precision
+                - (ring -> ((i + 1) % n) ->> 0)::double precision * (ring -> i ->> 1)::double precision;
+        END LOOP;
+    IF area < 0 THEN
+        SELECT jsonb_agg(value ORDER BY ord DESC)
+        INTO ring
+        FROM jsonb_array_elements(ring) WITH ORDINALITY AS t(value, ord);
+    END IF;
+
+    RETURN jsonb_build_object(
+            'type', 'Polygon',
+            'version', 1,
+            'coordinates', jsonb_build_array(ring || jsonb_build_array(ring -> 0))
+           );
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+UPDATE segment
+SET contor = contor_v1(segment.contor, image.width, image.height)
+FROM image
+WHERE image.id = segment.image_id
+  AND jsonb_typeof(segment.contor) = 'array';
+
+-- снапшоты истории тоже переводятся, чтобы восстановление не возвращало старую схему
+UPDATE segment_history
+SET before = jsonb_set(before, '{contor}', contor_v1(before -> 'contor', 0, 0))
+WHERE jsonb_typeof(before -> 'contor') = 'array';
+
+UPDATE segment_history
+SET after = jsonb_set(after, '{contor}', contor_v1(after -> 'contor', 0, 0))
+WHERE jsonb_typeof(after -> 'contor') = 'array';
+
+DROP FUNCTION contor_v1(jsonb, integer, integer);
+
+COMMENT ON COLUMN segment.contor IS 'Контур сегмента: GeoJSON Polygon в пикселях кадра, версия схемы в поле version';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- обратно в массив точек [{"x": 1, "y": 2}, ...] без замыкающей точки, обрезка и порядок точек не откатываются
+CREATE FUNCTION contor_legacy(contor jsonb) RETURNS jsonb AS
+$$
+SELECT jsonb_agg(jsonb_build_object('x', value -> 0, 'y', value -> 1) ORDER BY ord)
+FROM jsonb_array_elements(contor -> 'coordinates' -> 0) WITH ORDINALITY AS t(value, ord)
+WHERE ord < jsonb_array_length(contor -> 'coordinates' -> 0)
+$$ LANGUAGE sql IMMUTABLE;
+
+UPDATE segment
+SET contor = contor_legacy(contor)
+WHERE contor ->> 'type' = 'Polygon';
+
+UPDATE segment_history
+SET before = jsonb_set(before, '{contor}', contor_legacy(before -> 'contor'))
+WHERE before -> 'contor' ->> 'type' = 'Polygon';
+
+UPDATE segment_history
+SET after = jsonb_set(after, '{contor}', contor_legacy(after -> 'contor'))
+WHERE after -> 'contor' ->> 'type' = 'Polygon';
+
+DROP FUNCTION contor_legacy(jsonb);
+
+COMMENT ON COLUMN segment.contor IS 'контур узла (JSON)';
+-- +goose StatementEnd
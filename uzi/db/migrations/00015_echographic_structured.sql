@@ -0,0 +1,102 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE echographic
+    ADD COLUMN patient_sex varchar(8),
+    ADD COLUMN patient_age integer,
+    ALTER COLUMN additional TYPE text;
+
+COMMENT ON COLUMN echographic.patient_sex IS 'Пол пациента для норм объема (male, female)';
+COMMENT ON COLUMN echographic.patient_age IS 'Возраст пациента на дату узи, полных лет';
+COMMENT ON COLUMN echographic.struct IS 'Структура (homogeneous, heterogeneous)';
+COMMENT ON COLUMN echographic.echogenicity IS 'Эхогенность (normal, increased, decreased, mixed)';
+COMMENT ON COLUMN echographic.vascularization IS 'Васкуляризация по ЦДК (normal, increased, decreased)';
+
+-- свободный текст переводится в значения перечислений по ключевым словам,
+-- то, что не распознано, дописывается в additional, чтобы не потерять
+CREATE FUNCTION echographic_struct(value text) RETURNS text AS
+$$
+SELECT CASE
+           WHEN lower(value) LIKE '%неоднород%' THEN 'heterogeneous'
+           WHEN lower(value) LIKE '%однород%' THEN 'homogeneous'
+           END
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE FUNCTION echographic_echogenicity(value text) RETURNS text AS
+$$
+SELECT CASE
+           WHEN lower(value) LIKE '%смешан%' THEN 'mixed'
+           WHEN lower(value) LIKE '%пониж%' OR lower(value) LIKE '%гипоэхо%' THEN 'decreased'
+           WHEN lower(value) LIKE '%повыш%' OR lower(value) LIKE '%гиперэхо%' THEN 'increased'
+           WHEN lower(value) LIKE '%средн%' OR lower(value) LIKE '%норм%' OR lower(value) LIKE '%изоэхо%'
+               THEN 'normal'
+           END
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE FUNCTION echographic_vascularization(value text) RETURNS text AS
+$$
+SELECT CASE
+           WHEN lower(value) LIKE '%не измен%' OR lower(value) LIKE '%норм%' OR lower(value) LIKE '%обычн%'
+               THEN 'normal'
+           WHEN lower(value) LIKE '%усил%' OR lower(value) LIKE '%повыш%' THEN 'increased'
+           WHEN lower(value) LIKE '%сниж%' OR lower(value) LIKE '%обедн%' OR lower(value) LIKE '%пониж%'
+               THEN 'decreased'
+           END
+$$ LANGUAGE sql IMMUTABLE;
+
+UPDATE echographic
+SET additional = concat_ws(
+        E'\n',
+        additional,
+        CASE WHEN struct IS NOT NULL AND echographic_struct(struct) IS NULL THEN 'Структура: ' || struct END,
+        CASE
+            WHEN echogenicity IS NOT NULL AND echographic_echogenicity(echogenicity) IS NULL
+                THEN 'Эхогенность: ' || echogenicity END,
+        CASE
+            WHEN vascularization IS NOT NULL AND echographic_vascularization(vascularization) IS NULL
+                THEN 'Васкуляризация: ' || vascularization END
+                 )
+WHERE (struct IS NOT NULL AND echographic_struct(struct) IS NULL)
+   OR (echogenicity IS NOT NULL AND echographic_echogenicity(echogenicity) IS NULL)
+   OR (vascularization IS NOT NULL AND echographic_vascularization(vascularization) IS NULL);
+
+UPDATE echographic
+SET struct          = echographic_struct(struct),
+    echogenicity    = echographic_echogenicity(echogenicity),
+    vascularization = echographic_vascularization(vascularization)
+WHERE struct IS NOT NULL
+   OR echogenicity IS NOT NULL
+   OR vascularization IS NOT NULL;
+
+DROP FUNCTION echographic_struct(text);
+DROP FUNCTION echographic_echogenicity(text);
+DROP FUNCTION echographic_vascularization(text);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- значения перечислений возвращаются текстом, перенесенный в additional текст и тип additional не откатываются
+UPDATE echographic
+SET struct          = CASE struct
+                          WHEN 'homogeneous' THEN 'однородная'
+                          WHEN 'heterogeneous' THEN 'неоднородная'
+                          ELSE struct END,
+    echogenicity    = CASE echogenicity
+                          WHEN 'normal' THEN 'средняя'
+                          WHEN 'increased' THEN 'повышенная'
+                          WHEN 'decreased' THEN 'пониженная'
+                          WHEN 'mixed' THEN 'смешанная'
+                          ELSE echogenicity END,
+    vascularization = CASE vascularization
+                          WHEN 'normal' THEN 'не изменена'
+                          WHEN 'increased' THEN 'усилена'
+                          WHEN 'decreased' THEN 'снижена'
+                          ELSE vascularization END;
+
+COMMENT ON COLUMN echographic.struct IS 'Структура';
+COMMENT ON COLUMN echographic.echogenicity IS 'Эхогенность';
+COMMENT ON COLUMN echographic.vascularization IS 'Васкуляризацие по ЦДК';
+
+ALTER TABLE echographic
+    DROP COLUMN patient_sex,
+    DROP COLUMN patient_age;
+-- +goose StatementEnd
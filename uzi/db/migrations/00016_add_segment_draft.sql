@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE segment_draft
+(
+    id                uuid        PRIMARY KEY,
+    node_id           uuid        NOT NULL REFERENCES node (id) ON DELETE CASCADE,
+    image_id          uuid        NOT NULL REFERENCES image (id) ON DELETE CASCADE,
+    source_segment_id uuid        NOT NULL,
+    contor            jsonb       NOT NULL,
+    tirads_23         real        NOT NULL,
+    tirads_4          real        NOT NULL,
+    tirads_5          real        NOT NULL,
+    score             real        NOT NULL,
+    create_at         timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (node_id, image_id)
+);
+
+COMMENT ON TABLE segment_draft IS 'Контуры, предложенные на соседних кадрах по сегменту узла, ждут решения врача';
+COMMENT ON COLUMN segment_draft.source_segment_id IS 'Сегмент, от которого распространялся контур';
+COMMENT ON COLUMN segment_draft.contor IS 'Контур сегмента: GeoJSON Polygon версии 1 в пикселях кадра';
+COMMENT ON COLUMN segment_draft.score IS 'Нормированная корреляция окрестности контура с исходным кадром, от -1 до 1';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS segment_draft;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE node_review
+(
+    id           uuid        PRIMARY KEY,
+    uzi_id       uuid        NOT NULL REFERENCES uzi (id) ON DELETE CASCADE,
+    kind         varchar(16) NOT NULL,
+    actor        uuid,
+    validation   varchar(8),
+    threshold    double precision,
+    node_ids     uuid[]      NOT NULL,
+    create_at    timestamptz NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE node_review IS 'Пакетная валидация узлов нейросети, по одной записи на узи пакета';
+COMMENT ON COLUMN node_review.kind IS 'nodes, uzi или threshold';
+COMMENT ON COLUMN node_review.actor IS 'Пользователь из metadata запроса';
+COMMENT ON COLUMN node_review.validation IS 'Проставленная валидация, NULL если узи отмечено проверенным без валидации узлов';
+COMMENT ON COLUMN node_review.threshold IS 'Порог уверенности для kind = threshold';
+COMMENT ON COLUMN node_review.node_ids IS 'Узлы, валидация которых изменена';
+
+CREATE INDEX node_review_uzi_id_idx ON node_review (uzi_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS node_review;
+-- +goose StatementEnd
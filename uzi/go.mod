@@ -9,6 +9,7 @@ require (
 	github.com/WantBeASleep/med_ml_lib v1.0.8
 	github.com/brianvoe/gofakeit/v7 v7.2.1
 	github.com/chai2010/tiff v0.0.0-20211005095045-4ec2aa243943
+	github.com/go-pdf/fpdf v0.9.0
 	github.com/golang/protobuf v1.5.4
 	github.com/google/uuid v1.6.0
 	github.com/ilyakaznacheev/cleanenv v1.5.0
@@ -16,6 +17,7 @@ require (
 	github.com/lib/pq v1.10.9
 	github.com/minio/minio-go/v7 v7.0.86
 	github.com/stretchr/testify v1.10.0
+	golang.org/x/image v0.24.0
 	google.golang.org/grpc v1.70.0
 	google.golang.org/protobuf v1.36.5
 )
@@ -1,10 +1,17 @@
 package config
 
+import "time"
+
 type Config struct {
-	App    App
-	DB     DB
-	S3     S3
-	Broker Broker
+	App         App
+	DB          DB
+	S3          S3
+	Broker      Broker
+	Devices     Devices
+	Retention   Retention
+	Integrity   Integrity
+	Masking     Masking
+	Echographic Echographic
 }
 
 type App struct {
@@ -24,3 +31,37 @@ type S3 struct {
 type Broker struct {
 	Addrs []string `env:"BROKER_ADDRS" env-required:"true"`
 }
+
+type Devices struct {
+	// путь до файла с каталогом аппаратов, загружается при старте
+	SeedPath string `env:"DEVICES_SEED_PATH"`
+}
+
+type Retention struct {
+	// окно восстановления удаленного узи, 0 - объекты удаляются сразу
+	SoftDeleteWindow time.Duration `env:"UZI_SOFT_DELETE_WINDOW" env-default:"0s"`
+	// период очистки S3 и окончательного удаления узи с истекшим окном
+	CleanupInterval time.Duration `env:"STORAGE_CLEANUP_INTERVAL" env-default:"1m"`
+	// период сверки бакета с БД, 0 - только по запросу
+	SweepInterval time.Duration `env:"STORAGE_SWEEP_INTERVAL" env-default:"0s"`
+	// объекты моложе не считаются сиротами
+	OrphanGrace time.Duration `env:"STORAGE_ORPHAN_GRACE" env-default:"24h"`
+}
+
+type Integrity struct {
+	// повторная загрузка того же файла для пациента: warn - создать и вернуть дубликаты, reject - отклонить
+	DuplicatePolicy string `env:"UZI_DUPLICATE_POLICY" env-default:"warn"`
+	// период пересчета sha256 исходных файлов, 0 - только по запросу
+	VerifyInterval time.Duration `env:"STORAGE_VERIFY_INTERVAL" env-default:"0s"`
+}
+
+type Masking struct {
+	// доля высоты кадра сверху и снизу в процентах, закрашиваемая при разбиении: там аппараты печатают ФИО и дату
+	TopPercent    float64 `env:"UZI_MASK_TOP_PERCENT" env-default:"0"`
+	BottomPercent float64 `env:"UZI_MASK_BOTTOM_PERCENT" env-default:"0"`
+}
+
+type Echographic struct {
+	// путь до text/template заключения эхографии, пусто - встроенный шаблон
+	ConclusionTemplatePath string `env:"ECHOGRAPHIC_CONCLUSION_TEMPLATE"`
+}
@@ -0,0 +1,26 @@
+package config
+
+import "github.com/ilyakaznacheev/cleanenv"
+
+// DeviceSeed каталог аппаратов, аппараты сопоставляются с уже созданными по названию
+type DeviceSeed struct {
+	Devices []DeviceSeedItem `yaml:"devices" json:"devices"`
+}
+
+type DeviceSeedItem struct {
+	Name          string   `yaml:"name" json:"name"`
+	Manufacturer  *string  `yaml:"manufacturer" json:"manufacturer"`
+	Model         *string  `yaml:"model" json:"model"`
+	SerialNumber  *string  `yaml:"serial_number" json:"serial_number"`
+	ProbeType     *string  `yaml:"probe_type" json:"probe_type"`
+	PixelSpacingX *float64 `yaml:"pixel_spacing_x" json:"pixel_spacing_x"`
+	PixelSpacingY *float64 `yaml:"pixel_spacing_y" json:"pixel_spacing_y"`
+}
+
+func ReadDeviceSeed(path string) (DeviceSeed, error) {
+	var seed DeviceSeed
+	if err := cleanenv.ReadConfig(path, &seed); err != nil {
+		return DeviceSeed{}, err
+	}
+	return seed, nil
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDeviceSeed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devices.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+devices:
+  - name: "first"
+    manufacturer: "vendor"
+    probe_type: "linear"
+    pixel_spacing_x: 0.1
+    pixel_spacing_y: 0.2
+  - name: "second"
+`), 0o600))
+
+	seed, err := ReadDeviceSeed(path)
+	require.NoError(t, err)
+	require.Len(t, seed.Devices, 2)
+
+	require.Equal(t, "first", seed.Devices[0].Name)
+	require.Equal(t, "vendor", *seed.Devices[0].Manufacturer)
+	require.Equal(t, "linear", *seed.Devices[0].ProbeType)
+	require.InDelta(t, 0.1, *seed.Devices[0].PixelSpacingX, 1e-9)
+	require.InDelta(t, 0.2, *seed.Devices[0].PixelSpacingY, 1e-9)
+
+	require.Equal(t, "second", seed.Devices[1].Name)
+	require.Nil(t, seed.Devices[1].Manufacturer)
+	require.Nil(t, seed.Devices[1].PixelSpacingX)
+}
+
+func TestReadDeviceSeed_ExampleFile(t *testing.T) {
+	seed, err := ReadDeviceSeed(filepath.Join("..", "..", "devices.example.yaml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, seed.Devices)
+}
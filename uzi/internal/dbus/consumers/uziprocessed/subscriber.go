@@ -3,19 +3,17 @@ package uziprocessed
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	"github.com/WantBeASleep/med_ml_lib/dbus"
 	"github.com/google/uuid"
 
+	"uzi/internal/domain"
 	pb "uzi/internal/generated/dbus/consume/uziprocessed"
 	"uzi/internal/services"
 	"uzi/internal/services/node_segment"
 )
 
-var ErrInvalidContor = errors.New("invalid contor")
-
 type subscriber struct {
 	services *services.Services
 }
@@ -51,9 +49,9 @@ func (h *subscriber) Consume(ctx context.Context, message *pb.UziProcessed) erro
 
 		segments := make([]node_segment.CreateNodesWithSegmentsArgSegment, 0, len(v.Segments))
 		for _, segment := range v.Segments {
-			// contor json parse
-			if !json.Valid(segment.Contor) {
-				return ErrInvalidContor
+			// схема контура, исправляется он уже в сервисе по размеру кадра
+			if _, err := domain.ParseContor(segment.Contor); err != nil {
+				return fmt.Errorf("segment on image %s: %w", segment.ImageId, err)
 			}
 
 			segments = append(segments, node_segment.CreateNodesWithSegmentsArgSegment{
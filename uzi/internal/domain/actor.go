@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type actorKey struct{}
+
+// WithActor кладет в контекст пользователя, от имени которого выполняется запрос
+func WithActor(ctx context.Context, actor uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext nil, если запрос выполняется не от имени пользователя
+func ActorFromContext(ctx context.Context) *uuid.UUID {
+	actor, ok := ctx.Value(actorKey{}).(uuid.UUID)
+	if !ok {
+		return nil
+	}
+	return &actor
+}
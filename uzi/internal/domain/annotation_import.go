@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type AnnotationFormat string
+
+const (
+	// COCO instance segmentation json
+	AnnotationFormatCoco AnnotationFormat = "coco"
+	// CVAT for images/video 1.1 xml
+	AnnotationFormatCvat AnnotationFormat = "cvat"
+)
+
+func (f AnnotationFormat) String() string {
+	return string(f)
+}
+
+func (f AnnotationFormat) Parse(format string) (AnnotationFormat, error) {
+	switch format {
+	case "coco":
+		return AnnotationFormatCoco, nil
+	case "cvat":
+		return AnnotationFormatCvat, nil
+	default:
+		return "", fmt.Errorf("invalid annotation format: %s", format)
+	}
+}
+
+// AnnotationImportReport что создано (или было бы создано при пробном запуске) и что пропущено
+type AnnotationImportReport struct {
+	DryRun  bool
+	Nodes   []AnnotationImportNode
+	Skipped []AnnotationImportSkip
+}
+
+type AnnotationImportNode struct {
+	// откуда взят узел во внешней разметке, например "coco annotation 12"
+	Source string
+	UziID  uuid.UUID
+	// nil при пробном запуске
+	NodeID *uuid.UUID
+	// nil, если метка не распознана как класс TI-RADS
+	Class    *DatasetClass
+	Segments int
+}
+
+type AnnotationImportSkip struct {
+	Source string
+	Reason string
+}
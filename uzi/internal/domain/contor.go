@@ -0,0 +1,286 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ContorVersion версия схемы контура, которую пишет сервис
+const ContorVersion = 1
+
+const contorTypePolygon = "Polygon"
+
+var ErrInvalidContor = errors.New("invalid contor")
+
+// ContorPoint точка контура в пикселях исходного кадра: x вправо, y вниз
+type ContorPoint struct {
+	X float64
+	Y float64
+}
+
+// contorGeoJSON контур версии 1: GeoJSON Polygon из одного замкнутого кольца без дыр,
+// точки против часовой стрелки в осях x, y
+type contorGeoJSON struct {
+	Type        string         `json:"type"`
+	Version     int            `json:"version"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+// legacyContorPoint точка контура до версии 1: массив [{"x": 1, "y": 2}, ...]
+type legacyContorPoint struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// ParseContor читает контур версии 1 или старый массив точек, возвращает кольцо без замыкающей точки.
+// Контур не исправляется, для этого есть NormalizeContor
+func ParseContor(contor json.RawMessage) ([]ContorPoint, error) {
+	points, err := parseContorPoints(contor)
+	if err != nil {
+		return nil, err
+	}
+
+	// последняя точка может дублировать первую
+	if len(points) > 1 && points[0] == points[len(points)-1] {
+		points = points[:len(points)-1]
+	}
+
+	if len(points) < 3 {
+		return nil, fmt.Errorf("%w: ring has %d points", ErrInvalidContor, len(points))
+	}
+
+	return points, nil
+}
+
+func parseContorPoints(contor json.RawMessage) ([]ContorPoint, error) {
+	var legacy []legacyContorPoint
+	if err := json.Unmarshal(contor, &legacy); err == nil {
+		points := make([]ContorPoint, 0, len(legacy))
+		for _, p := range legacy {
+			points = append(points, ContorPoint{X: p.X, Y: p.Y})
+		}
+		return points, nil
+	}
+
+	var geo contorGeoJSON
+	if err := json.Unmarshal(contor, &geo); err != nil {
+		return nil, errors.Join(ErrInvalidContor, err)
+	}
+	if geo.Type != contorTypePolygon {
+		return nil, fmt.Errorf("%w: unsupported geometry type %q", ErrInvalidContor, geo.Type)
+	}
+	if geo.Version != ContorVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidContor, geo.Version)
+	}
+	if len(geo.Coordinates) != 1 {
+		return nil, fmt.Errorf("%w: polygon must have exactly one ring, got %d", ErrInvalidContor, len(geo.Coordinates))
+	}
+
+	points := make([]ContorPoint, 0, len(geo.Coordinates[0]))
+	for _, p := range geo.Coordinates[0] {
+		points = append(points, ContorPoint{X: p[0], Y: p[1]})
+	}
+	return points, nil
+}
+
+// MarshalContor записывает кольцо контуром версии 1, кольцо замыкается
+func MarshalContor(points []ContorPoint) json.RawMessage {
+	ring := make([][2]float64, 0, len(points)+1)
+	for _, p := range points {
+		ring = append(ring, [2]float64{p.X, p.Y})
+	}
+	if len(points) > 0 {
+		ring = append(ring, [2]float64{points[0].X, points[0].Y})
+	}
+
+	contor, _ := json.Marshal(contorGeoJSON{
+		Type:        contorTypePolygon,
+		Version:     ContorVersion,
+		Coordinates: [][][2]float64{ring},
+	})
+	return contor
+}
+
+// NormalizeContor приводит контур к версии 1: замыкает кольцо, убирает повторы точек,
+// обрезает по границам кадра и разворачивает против часовой стрелки.
+// Размер кадра 0 - границы неизвестны и не проверяются. Самопересекающийся или вырожденный контур не исправить
+func NormalizeContor(contor json.RawMessage, width, height int) (json.RawMessage, error) {
+	points, err := parseContorPoints(contor)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err = NormalizeContorPoints(points, width, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalContor(points), nil
+}
+
+// NormalizeContorPoints то же, что NormalizeContor, для уже разобранного кольца
+func NormalizeContorPoints(points []ContorPoint, width, height int) ([]ContorPoint, error) {
+	for _, p := range points {
+		if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) {
+			return nil, fmt.Errorf("%w: non finite coordinates", ErrInvalidContor)
+		}
+	}
+
+	points = dedupContor(points)
+	if len(points) < 3 {
+		return nil, fmt.Errorf("%w: ring has %d distinct points", ErrInvalidContor, len(points))
+	}
+	// проверяется до обрезки: у вогнутого контура на границе кадра появляются совпадающие ребра
+	if contorSelfIntersecting(points) {
+		return nil, fmt.Errorf("%w: self-intersecting ring", ErrInvalidContor)
+	}
+
+	if width > 0 && height > 0 {
+		points = dedupContor(clipContor(points, float64(width), float64(height)))
+		if len(points) < 3 {
+			return nil, fmt.Errorf("%w: ring is outside of %dx%d frame", ErrInvalidContor, width, height)
+		}
+	}
+
+	area := contorSignedArea(points)
+	if area == 0 {
+		return nil, fmt.Errorf("%w: zero area", ErrInvalidContor)
+	}
+
+	if area < 0 {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+
+	return points, nil
+}
+
+// dedupContor убирает подряд идущие одинаковые точки и замыкающую точку кольца
+func dedupContor(points []ContorPoint) []ContorPoint {
+	res := make([]ContorPoint, 0, len(points))
+	for _, p := range points {
+		if len(res) > 0 && res[len(res)-1] == p {
+			continue
+		}
+		res = append(res, p)
+	}
+	for len(res) > 1 && res[0] == res[len(res)-1] {
+		res = res[:len(res)-1]
+	}
+	return res
+}
+
+// contorSignedArea площадь по формуле шнурования, больше 0 - против часовой стрелки в осях x, y
+func contorSignedArea(points []ContorPoint) float64 {
+	var sum float64
+	for i := range points {
+		next := points[(i+1)%len(points)]
+		sum += points[i].X*next.Y - next.X*points[i].Y
+	}
+	return sum / 2
+}
+
+// clipContor отсечение кольца прямоугольником кадра (Сазерленд - Ходжмен)
+func clipContor(points []ContorPoint, width, height float64) []ContorPoint {
+	edges := []struct {
+		inside func(p ContorPoint) bool
+		cross  func(a, b ContorPoint) ContorPoint
+	}{
+		{
+			inside: func(p ContorPoint) bool { return p.X >= 0 },
+			cross:  func(a, b ContorPoint) ContorPoint { return crossX(a, b, 0) },
+		},
+		{
+			inside: func(p ContorPoint) bool { return p.X <= width },
+			cross:  func(a, b ContorPoint) ContorPoint { return crossX(a, b, width) },
+		},
+		{
+			inside: func(p ContorPoint) bool { return p.Y >= 0 },
+			cross:  func(a, b ContorPoint) ContorPoint { return crossY(a, b, 0) },
+		},
+		{
+			inside: func(p ContorPoint) bool { return p.Y <= height },
+			cross:  func(a, b ContorPoint) ContorPoint { return crossY(a, b, height) },
+		},
+	}
+
+	for _, edge := range edges {
+		if len(points) == 0 {
+			return nil
+		}
+
+		input := points
+		points = make([]ContorPoint, 0, len(input)+4)
+		prev := input[len(input)-1]
+		for _, cur := range input {
+			switch {
+			case edge.inside(cur) && !edge.inside(prev):
+				points = append(points, edge.cross(prev, cur), cur)
+			case edge.inside(cur):
+				points = append(points, cur)
+			case edge.inside(prev):
+				points = append(points, edge.cross(prev, cur))
+			}
+			prev = cur
+		}
+	}
+
+	return points
+}
+
+func crossX(a, b ContorPoint, x float64) ContorPoint {
+	t := (x - a.X) / (b.X - a.X)
+	return ContorPoint{X: x, Y: a.Y + t*(b.Y-a.Y)}
+}
+
+func crossY(a, b ContorPoint, y float64) ContorPoint {
+	t := (y - a.Y) / (b.Y - a.Y)
+	return ContorPoint{X: a.X + t*(b.X-a.X), Y: y}
+}
+
+// contorSelfIntersecting проверяет пересечение несмежных ребер, O(n^2) достаточно для контуров узлов
+func contorSelfIntersecting(points []ContorPoint) bool {
+	n := len(points)
+	for i := range n {
+		a, b := points[i], points[(i+1)%n]
+		for j := i + 2; j < n; j++ {
+			// первое и последнее ребра смежные
+			if i == 0 && j == n-1 {
+				continue
+			}
+			if contorEdgesIntersect(a, b, points[j], points[(j+1)%n]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func contorEdgesIntersect(p1, p2, p3, p4 ContorPoint) bool {
+	d1 := contorOrientation(p3, p4, p1)
+	d2 := contorOrientation(p3, p4, p2)
+	d3 := contorOrientation(p1, p2, p3)
+	d4 := contorOrientation(p1, p2, p4)
+
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+
+	return (d1 == 0 && contorOnEdge(p3, p4, p1)) ||
+		(d2 == 0 && contorOnEdge(p3, p4, p2)) ||
+		(d3 == 0 && contorOnEdge(p1, p2, p3)) ||
+		(d4 == 0 && contorOnEdge(p1, p2, p4))
+}
+
+func contorOrientation(a, b, c ContorPoint) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+// contorOnEdge точка c лежит на прямой ab, проверяем попадание в отрезок
+func contorOnEdge(a, b, c ContorPoint) bool {
+	return math.Min(a.X, b.X) <= c.X && c.X <= math.Max(a.X, b.X) &&
+		math.Min(a.Y, b.Y) <= c.Y && c.Y <= math.Max(a.Y, b.Y)
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeContor(t *testing.T) {
+	// старый формат, по часовой стрелке, с повтором точки
+	legacy := json.RawMessage(`[{"x":0,"y":0},{"x":0,"y":10},{"x":0,"y":10},{"x":10,"y":10},{"x":10,"y":0}]`)
+
+	contor, err := NormalizeContor(legacy, 0, 0)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"Polygon","version":1,"coordinates":[[[10,0],[10,10],[0,10],[0,0],[10,0]]]}`, string(contor))
+
+	// нормализованный контур не меняется
+	again, err := NormalizeContor(contor, 0, 0)
+	require.NoError(t, err)
+	require.JSONEq(t, string(contor), string(again))
+
+	points, err := ParseContor(contor)
+	require.NoError(t, err)
+	require.Len(t, points, 4)
+	require.Greater(t, contorSignedArea(points), 0.0)
+}
+
+func TestNormalizeContor_Clip(t *testing.T) {
+	contor, err := NormalizeContor(json.RawMessage(`{"type":"Polygon","version":1,"coordinates":[[[-5,2],[5,2],[5,8],[-5,8]]]}`), 20, 20)
+	require.NoError(t, err)
+
+	points, err := ParseContor(contor)
+	require.NoError(t, err)
+	require.InDelta(t, 30, contorSignedArea(points), 1e-9)
+	for _, p := range points {
+		require.GreaterOrEqual(t, p.X, 0.0)
+	}
+
+	_, err = NormalizeContor(json.RawMessage(`[{"x":30,"y":30},{"x":40,"y":30},{"x":40,"y":40}]`), 20, 20)
+	require.ErrorIs(t, err, ErrInvalidContor)
+}
+
+func TestNormalizeContor_Invalid(t *testing.T) {
+	for _, contor := range []string{
+		`{}`,
+		`[]`,
+		`[{"x":1,"y":1},{"x":2,"y":2},{"x":1,"y":1}]`,
+		// на одной прямой
+		`[{"x":0,"y":0},{"x":1,"y":1},{"x":2,"y":2}]`,
+		// восьмерка
+		`[{"x":0,"y":0},{"x":10,"y":10},{"x":10,"y":0},{"x":0,"y":10}]`,
+		`{"type":"Point","version":1,"coordinates":[1,2]}`,
+		`{"type":"Polygon","version":2,"coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`,
+		// дыры не поддерживаются
+		`{"type":"Polygon","version":1,"coordinates":[[[0,0],[9,0],[9,9],[0,0]],[[1,1],[2,1],[2,2],[1,1]]]}`,
+	} {
+		_, err := NormalizeContor(json.RawMessage(contor), 0, 0)
+		require.ErrorIs(t, err, ErrInvalidContor, contor)
+	}
+}
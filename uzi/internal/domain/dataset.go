@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type DatasetFormat string
+
+const (
+	// COCO instance segmentation, json аннотации на каждую выборку
+	DatasetFormatCoco DatasetFormat = "coco"
+	// YOLO segmentation, txt аннотации на каждый кадр
+	DatasetFormatYolo DatasetFormat = "yolo"
+)
+
+func (f DatasetFormat) String() string {
+	return string(f)
+}
+
+func (f DatasetFormat) Parse(format string) (DatasetFormat, error) {
+	switch format {
+	case "coco":
+		return DatasetFormatCoco, nil
+	case "yolo":
+		return DatasetFormatYolo, nil
+	default:
+		return "", fmt.Errorf("invalid dataset format: %s", format)
+	}
+}
+
+// DatasetClass класс узла в датасете, совпадает с выходами нейросети
+type DatasetClass string
+
+const (
+	DatasetClassTirads23 DatasetClass = "tirads_23"
+	DatasetClassTirads4  DatasetClass = "tirads_4"
+	DatasetClassTirads5  DatasetClass = "tirads_5"
+)
+
+// DatasetClasses порядок классов задает их индексы в датасете
+var DatasetClasses = []DatasetClass{
+	DatasetClassTirads23,
+	DatasetClassTirads4,
+	DatasetClassTirads5,
+}
+
+func (c DatasetClass) String() string {
+	return string(c)
+}
+
+// DatasetNodeFilter какие узлы попадают в датасет, нужно выбрать хотя бы один источник
+type DatasetNodeFilter struct {
+	// узлы, размеченные врачом вручную
+	Manual bool
+	// узлы нейросети, подтвержденные врачом (validation=valid)
+	AiValid bool
+}
+
+type DatasetSplit string
+
+const (
+	DatasetSplitTrain DatasetSplit = "train"
+	DatasetSplitVal   DatasetSplit = "val"
+)
+
+func (s DatasetSplit) String() string {
+	return string(s)
+}
+
+// DatasetExport результат выгрузки датасета
+type DatasetExport struct {
+	Id     uuid.UUID
+	Format DatasetFormat
+	// каталог или префикс в S3, куда записан датасет
+	Path        string
+	TrainImages int
+	ValImages   int
+	Annotations int
+}
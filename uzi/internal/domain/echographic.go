@@ -1,9 +1,13 @@
 package domain
 
 import (
+	"fmt"
+	"math"
+
 	"github.com/google/uuid"
 )
 
+// Размеры долей и перешейка в см, объемы в мл
 type Echographic struct {
 	Id              uuid.UUID
 	Contors         *string
@@ -17,11 +21,273 @@ type Echographic struct {
 	RightLobeVolum  *float64
 	GlandVolum      *float64
 	Isthmus         *float64
-	Struct          *string
-	Echogenicity    *string
+	Struct          *EchographicStruct
+	Echogenicity    *EchographicEchogenicity
 	RegionalLymph   *string
-	Vascularization *string
+	Vascularization *EchographicVascularization
 	Location        *string
 	Additional      *string
 	Conclusion      *string
+	// пол и возраст (полных лет на дату узи) для сравнения с нормами
+	PatientSex *PatientSex
+	PatientAge *int
+
+	// вычисляются при чтении, не хранятся
+	Flags               []EchographicFlag
+	GeneratedConclusion string
+}
+
+type EchographicStruct string
+
+const (
+	EchographicStructHomogeneous   EchographicStruct = "homogeneous"
+	EchographicStructHeterogeneous EchographicStruct = "heterogeneous"
+)
+
+func (s EchographicStruct) String() string {
+	return string(s)
+}
+
+func (s EchographicStruct) Parse(value string) (EchographicStruct, error) {
+	switch value {
+	case "homogeneous":
+		return EchographicStructHomogeneous, nil
+	case "heterogeneous":
+		return EchographicStructHeterogeneous, nil
+	default:
+		return "", fmt.Errorf("invalid struct: %s", value)
+	}
+}
+
+func (s EchographicStruct) Label() string {
+	switch s {
+	case EchographicStructHomogeneous:
+		return "однородная"
+	case EchographicStructHeterogeneous:
+		return "неоднородная"
+	default:
+		return string(s)
+	}
+}
+
+type EchographicEchogenicity string
+
+const (
+	EchographicEchogenicityNormal    EchographicEchogenicity = "normal"
+	EchographicEchogenicityIncreased EchographicEchogenicity = "increased"
+	EchographicEchogenicityDecreased EchographicEchogenicity = "decreased"
+	EchographicEchogenicityMixed     EchographicEchogenicity = "mixed"
+)
+
+func (e EchographicEchogenicity) String() string {
+	return string(e)
+}
+
+func (e EchographicEchogenicity) Parse(value string) (EchographicEchogenicity, error) {
+	switch value {
+	case "normal":
+		return EchographicEchogenicityNormal, nil
+	case "increased":
+		return EchographicEchogenicityIncreased, nil
+	case "decreased":
+		return EchographicEchogenicityDecreased, nil
+	case "mixed":
+		return EchographicEchogenicityMixed, nil
+	default:
+		return "", fmt.Errorf("invalid echogenicity: %s", value)
+	}
+}
+
+func (e EchographicEchogenicity) Label() string {
+	switch e {
+	case EchographicEchogenicityNormal:
+		return "средняя"
+	case EchographicEchogenicityIncreased:
+		return "повышенная"
+	case EchographicEchogenicityDecreased:
+		return "пониженная"
+	case EchographicEchogenicityMixed:
+		return "смешанная"
+	default:
+		return string(e)
+	}
+}
+
+type EchographicVascularization string
+
+const (
+	EchographicVascularizationNormal    EchographicVascularization = "normal"
+	EchographicVascularizationIncreased EchographicVascularization = "increased"
+	EchographicVascularizationDecreased EchographicVascularization = "decreased"
+)
+
+func (v EchographicVascularization) String() string {
+	return string(v)
+}
+
+func (v EchographicVascularization) Parse(value string) (EchographicVascularization, error) {
+	switch value {
+	case "normal":
+		return EchographicVascularizationNormal, nil
+	case "increased":
+		return EchographicVascularizationIncreased, nil
+	case "decreased":
+		return EchographicVascularizationDecreased, nil
+	default:
+		return "", fmt.Errorf("invalid vascularization: %s", value)
+	}
+}
+
+func (v EchographicVascularization) Label() string {
+	switch v {
+	case EchographicVascularizationNormal:
+		return "не изменена"
+	case EchographicVascularizationIncreased:
+		return "усилена"
+	case EchographicVascularizationDecreased:
+		return "снижена"
+	default:
+		return string(v)
+	}
+}
+
+type PatientSex string
+
+const (
+	PatientSexMale   PatientSex = "male"
+	PatientSexFemale PatientSex = "female"
+)
+
+func (s PatientSex) String() string {
+	return string(s)
+}
+
+func (s PatientSex) Parse(value string) (PatientSex, error) {
+	switch value {
+	case "male":
+		return PatientSexMale, nil
+	case "female":
+		return PatientSexFemale, nil
+	default:
+		return "", fmt.Errorf("invalid sex: %s", value)
+	}
+}
+
+// lobeVolumeCoef коэффициент эллипсоида (Brunn) для объема доли щитовидной железы
+const lobeVolumeCoef = 0.479
+
+// LobeVolume объем доли в мл по длине, ширине и толщине в см, nil если размеры неполные
+func LobeVolume(length, width, thick *float64) *float64 {
+	if length == nil || width == nil || thick == nil {
+		return nil
+	}
+	if *length <= 0 || *width <= 0 || *thick <= 0 {
+		return nil
+	}
+
+	volume := roundVolume(*length * *width * *thick * lobeVolumeCoef)
+	return &volume
+}
+
+// ComputeVolumes пересчитывает объемы долей по размерам и объем железы как сумму долей.
+// Если размеров доли нет, остается введенный вручную объем
+func (e *Echographic) ComputeVolumes() {
+	if volume := LobeVolume(e.LeftLobeLength, e.LeftLobeWidth, e.LeftLobeThick); volume != nil {
+		e.LeftLobeVolum = volume
+	}
+	if volume := LobeVolume(e.RightLobeLength, e.RightLobeWidth, e.RightLobeThick); volume != nil {
+		e.RightLobeVolum = volume
+	}
+	if e.LeftLobeVolum != nil && e.RightLobeVolum != nil {
+		volume := roundVolume(*e.LeftLobeVolum + *e.RightLobeVolum)
+		e.GlandVolum = &volume
+	}
+}
+
+func roundVolume(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// EchographicFlag значение вне нормы, Min и Max - границы нормы (nil - граница не задана)
+type EchographicFlag struct {
+	Field string
+	Value float64
+	Min   *float64
+	Max   *float64
+}
+
+const (
+	EchographicFieldGlandVolum = "gland_volum"
+	EchographicFieldIsthmus    = "isthmus"
+)
+
+// объем железы у взрослых (с 16 лет), мл
+var (
+	adultGlandVolumFemale = [2]float64{4.4, 18}
+	adultGlandVolumMale   = [2]float64{7.7, 25}
+)
+
+// childGlandVolumMax 97 перцентиль объема железы у детей 6-15 лет (ВОЗ, Zimmermann 2004), мл
+var childGlandVolumMax = map[PatientSex][10]float64{
+	PatientSexMale:   {2.91, 3.29, 3.71, 4.19, 4.73, 5.34, 6.03, 6.80, 7.68, 8.67},
+	PatientSexFemale: {2.84, 3.26, 3.76, 4.32, 4.98, 5.73, 6.59, 7.58, 8.72, 10.0},
+}
+
+// isthmusMax толщина перешейка, см
+const isthmusMax = 0.5
+
+// glandVolumNorm границы нормы объема железы по возрасту и полу.
+// Без пола берутся самые широкие границы, до 6 лет норм нет
+func glandVolumNorm(sex *PatientSex, age *int) (lower, upper *float64, ok bool) {
+	if age != nil && *age < 6 {
+		return nil, nil, false
+	}
+
+	if age != nil && *age <= 15 {
+		var limit float64
+		if sex != nil {
+			limit = childGlandVolumMax[*sex][*age-6]
+		} else {
+			limit = math.Max(childGlandVolumMax[PatientSexMale][*age-6], childGlandVolumMax[PatientSexFemale][*age-6])
+		}
+		return nil, &limit, true
+	}
+
+	norm := [2]float64{adultGlandVolumFemale[0], adultGlandVolumMale[1]}
+	switch {
+	case sex != nil && *sex == PatientSexMale:
+		norm = adultGlandVolumMale
+	case sex != nil && *sex == PatientSexFemale:
+		norm = adultGlandVolumFemale
+	}
+	return &norm[0], &norm[1], true
+}
+
+// CheckNorms сравнивает объем железы и толщину перешейка с нормами для возраста и пола пациента
+func (e Echographic) CheckNorms() []EchographicFlag {
+	var flags []EchographicFlag
+
+	if e.GlandVolum != nil {
+		if lower, upper, ok := glandVolumNorm(e.PatientSex, e.PatientAge); ok {
+			if (lower != nil && *e.GlandVolum < *lower) || (upper != nil && *e.GlandVolum > *upper) {
+				flags = append(flags, EchographicFlag{
+					Field: EchographicFieldGlandVolum,
+					Value: *e.GlandVolum,
+					Min:   lower,
+					Max:   upper,
+				})
+			}
+		}
+	}
+
+	if e.Isthmus != nil && *e.Isthmus > isthmusMax {
+		upper := isthmusMax
+		flags = append(flags, EchographicFlag{
+			Field: EchographicFieldIsthmus,
+			Value: *e.Isthmus,
+			Max:   &upper,
+		})
+	}
+
+	return flags
 }
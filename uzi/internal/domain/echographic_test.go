@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEchographicComputeVolumes(t *testing.T) {
+	e := Echographic{
+		LeftLobeLength:  pointer.To(4.0),
+		LeftLobeWidth:   pointer.To(1.5),
+		LeftLobeThick:   pointer.To(1.5),
+		RightLobeLength: pointer.To(5.0),
+		RightLobeWidth:  pointer.To(2.0),
+		// размеры правой доли неполные, объем введен вручную
+		RightLobeVolum: pointer.To(5.5),
+		GlandVolum:     pointer.To(100.0),
+	}
+	e.ComputeVolumes()
+
+	require.Equal(t, 4.31, *e.LeftLobeVolum)
+	require.Equal(t, 5.5, *e.RightLobeVolum)
+	require.Equal(t, 9.81, *e.GlandVolum)
+
+	require.Nil(t, LobeVolume(pointer.To(4.0), pointer.To(0.0), pointer.To(1.0)))
+}
+
+func TestEchographicCheckNorms(t *testing.T) {
+	female, male := PatientSexFemale, PatientSexMale
+
+	for _, tc := range []struct {
+		name  string
+		e     Echographic
+		field string
+		lower *float64
+		upper *float64
+	}{
+		{
+			name:  "взрослая женщина, увеличена",
+			e:     Echographic{GlandVolum: pointer.To(20.0), PatientSex: &female, PatientAge: pointer.To(40)},
+			field: EchographicFieldGlandVolum,
+			lower: pointer.To(4.4),
+			upper: pointer.To(18.0),
+		},
+		{
+			name: "взрослый мужчина, норма",
+			e:    Echographic{GlandVolum: pointer.To(20.0), PatientSex: &male, PatientAge: pointer.To(40)},
+		},
+		{
+			name:  "пол неизвестен, уменьшена",
+			e:     Echographic{GlandVolum: pointer.To(3.0)},
+			field: EchographicFieldGlandVolum,
+			lower: pointer.To(4.4),
+			upper: pointer.To(25.0),
+		},
+		{
+			name:  "ребенок 10 лет",
+			e:     Echographic{GlandVolum: pointer.To(5.0), PatientSex: &male, PatientAge: pointer.To(10)},
+			field: EchographicFieldGlandVolum,
+			upper: pointer.To(4.73),
+		},
+		{
+			name: "до 6 лет норм нет",
+			e:    Echographic{GlandVolum: pointer.To(50.0), PatientAge: pointer.To(3)},
+		},
+		{
+			name:  "перешеек",
+			e:     Echographic{Isthmus: pointer.To(0.8)},
+			field: EchographicFieldIsthmus,
+			upper: pointer.To(0.5),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := tc.e.CheckNorms()
+			if tc.field == "" {
+				require.Empty(t, flags)
+				return
+			}
+
+			require.Len(t, flags, 1)
+			require.Equal(t, tc.field, flags[0].Field)
+			require.Equal(t, tc.lower, flags[0].Min)
+			require.Equal(t, tc.upper, flags[0].Max)
+		})
+	}
+}
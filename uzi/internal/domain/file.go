@@ -2,6 +2,7 @@ package domain
 
 import (
 	"io"
+	"time"
 )
 
 type File struct {
@@ -9,3 +10,10 @@ type File struct {
 	Size   int64
 	Buf    io.Reader
 }
+
+// FileInfo объект в хранилище без содержимого
+type FileInfo struct {
+	Path         string
+	Size         int64
+	LastModified time.Time
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type HistoryAction string
+
+const (
+	HistoryActionCreate  HistoryAction = "create"
+	HistoryActionUpdate  HistoryAction = "update"
+	HistoryActionDelete  HistoryAction = "delete"
+	HistoryActionRestore HistoryAction = "restore"
+	// состояние объекта, созданного до ведения истории, пишется перед его первым изменением
+	HistoryActionSnapshot HistoryAction = "snapshot"
+)
+
+func (a HistoryAction) String() string {
+	return string(a)
+}
+
+func (a HistoryAction) Parse(action string) (HistoryAction, error) {
+	switch action {
+	case "create":
+		return HistoryActionCreate, nil
+	case "update":
+		return HistoryActionUpdate, nil
+	case "delete":
+		return HistoryActionDelete, nil
+	case "restore":
+		return HistoryActionRestore, nil
+	case "snapshot":
+		return HistoryActionSnapshot, nil
+	default:
+		return "", fmt.Errorf("invalid history action: %s", action)
+	}
+}
+
+// FieldChange значения поля в json, nil - поля нет (объект создан или удален)
+type FieldChange struct {
+	Field  string
+	Before json.RawMessage
+	After  json.RawMessage
+}
+
+// NodeVersion версия узла, измерения не версионируются - они пересчитываются по контурам
+type NodeVersion struct {
+	NodeID  uuid.UUID
+	UziID   uuid.UUID
+	Version int
+	Action  HistoryAction
+	// nil, если изменение сделано сервисом или пользователь не передан
+	Actor    *uuid.UUID
+	Before   *Node
+	After    *Node
+	Diff     []FieldChange
+	CreateAt time.Time
+}
+
+type SegmentVersion struct {
+	SegmentID uuid.UUID
+	NodeID    uuid.UUID
+	Version   int
+	Action    HistoryAction
+	Actor     *uuid.UUID
+	Before    *Segment
+	After     *Segment
+	Diff      []FieldChange
+	CreateAt  time.Time
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "github.com/google/uuid"
+
+// UniqueIDs убирает повторы id, порядок первых вхождений сохраняется
+func UniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniqueIDs(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	require.Equal(t, []uuid.UUID{a, b}, UniqueIDs([]uuid.UUID{a, b, a, b}))
+	require.Empty(t, UniqueIDs(nil))
+}
@@ -1,11 +1,100 @@
 package domain
 
 import (
+	"path"
+
 	"github.com/google/uuid"
 )
 
+type ImageSize string
+
+const (
+	// кадр в исходном разрешении, png
+	ImageSizeOriginal ImageSize = "original"
+	// уменьшенная копия для просмотра в браузере, jpeg
+	ImageSizePreview ImageSize = "preview"
+	// миниатюра для списка кадров, jpeg
+	ImageSizeThumbnail ImageSize = "thumbnail"
+)
+
+// максимальная сторона уменьшенных копий, меньшие кадры не увеличиваются
+const (
+	PreviewMaxSide   = 1024
+	ThumbnailMaxSide = 256
+)
+
 type Image struct {
 	Id    uuid.UUID
 	UziID uuid.UUID
 	Page  int
+	// размер исходного кадра, 0 у кадров, разбитых до появления превью
+	Width  int
+	Height int
+	// созданы ли превью и миниатюра
+	Previews bool
+}
+
+// ImageVariant файл кадра определенного размера в S3
+type ImageVariant struct {
+	Size        ImageSize
+	Path        string
+	ContentType string
+	Width       int
+	Height      int
+}
+
+func (i Image) Path(size ImageSize) string {
+	dir := path.Join(i.UziID.String(), i.Id.String())
+	switch size {
+	case ImageSizePreview:
+		return path.Join(dir, "preview.jpg")
+	case ImageSizeThumbnail:
+		return path.Join(dir, "thumbnail.jpg")
+	default:
+		return path.Join(dir, i.Id.String())
+	}
+}
+
+// Variants доступные размеры кадра, исходный всегда первый
+func (i Image) Variants() []ImageVariant {
+	variants := []ImageVariant{{
+		Size:        ImageSizeOriginal,
+		Path:        i.Path(ImageSizeOriginal),
+		ContentType: "image/png",
+		Width:       i.Width,
+		Height:      i.Height,
+	}}
+	if !i.Previews {
+		return variants
+	}
+
+	for _, v := range []struct {
+		size    ImageSize
+		maxSide int
+	}{
+		{ImageSizePreview, PreviewMaxSide},
+		{ImageSizeThumbnail, ThumbnailMaxSide},
+	} {
+		width, height := FitSize(i.Width, i.Height, v.maxSide)
+		variants = append(variants, ImageVariant{
+			Size:        v.size,
+			Path:        i.Path(v.size),
+			ContentType: "image/jpeg",
+			Width:       width,
+			Height:      height,
+		})
+	}
+
+	return variants
+}
+
+// FitSize вписывает кадр в квадрат maxSide с сохранением пропорций
+func FitSize(width, height, maxSide int) (int, int) {
+	if width <= maxSide && height <= maxSide {
+		return width, height
+	}
+	if width >= height {
+		return maxSide, max(1, height*maxSide/width)
+	}
+	return max(1, width*maxSide/height), maxSide
 }
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// DuplicatePolicy что делать с повторной загрузкой того же файла для пациента
+type DuplicatePolicy string
+
+const (
+	// узи создается, ранее загруженные возвращаются вместе с ним
+	DuplicatePolicyWarn DuplicatePolicy = "warn"
+	// узи не создается
+	DuplicatePolicyReject DuplicatePolicy = "reject"
+)
+
+func (p DuplicatePolicy) String() string {
+	return string(p)
+}
+
+func (p DuplicatePolicy) Parse(policy string) (DuplicatePolicy, error) {
+	switch policy {
+	case "warn":
+		return DuplicatePolicyWarn, nil
+	case "reject":
+		return DuplicatePolicyReject, nil
+	default:
+		return "", fmt.Errorf("invalid duplicate policy: %s", policy)
+	}
+}
+
+// IntegrityMismatch sha256 файла в S3 не совпала с сохраненной при загрузке
+type IntegrityMismatch struct {
+	UziID    uuid.UUID
+	Expected string
+	Actual   string
+}
+
+// IntegrityReport результат пересчета sha256 исходных файлов узи
+type IntegrityReport struct {
+	// число узи с сохраненной sha256
+	Checked int
+	// узи без исходного файла в S3
+	Missing    []uuid.UUID
+	Mismatches []IntegrityMismatch
+}
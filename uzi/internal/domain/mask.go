@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EdgeMask полосы сверху и снизу кадра, закрашиваемые черным при разбиении:
+// аппараты впечатывают туда ФИО пациента, дату и название клиники
+type EdgeMask struct {
+	// доля высоты кадра в процентах
+	TopPercent    float64
+	BottomPercent float64
+}
+
+func (m EdgeMask) Validate() error {
+	for _, percent := range []float64{m.TopPercent, m.BottomPercent} {
+		if percent < 0 || percent >= 50 {
+			return fmt.Errorf("mask percent must be in [0, 50): %v", percent)
+		}
+	}
+	return nil
+}
+
+// MaskedRegion закрашенный прямоугольник кадра в пикселях
+type MaskedRegion struct {
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+// Regions полосы маски для кадра заданного размера, пустые полосы не возвращаются
+func (m EdgeMask) Regions(width, height int) []MaskedRegion {
+	var regions []MaskedRegion
+	if top := int(float64(height) * m.TopPercent / 100); top > 0 {
+		regions = append(regions, MaskedRegion{X: 0, Y: 0, Width: width, Height: top})
+	}
+	if bottom := int(float64(height) * m.BottomPercent / 100); bottom > 0 {
+		regions = append(regions, MaskedRegion{X: 0, Y: height - bottom, Width: width, Height: bottom})
+	}
+	return regions
+}
+
+// FrameMasking закрашенные области одного кадра
+type FrameMasking struct {
+	Page    int
+	Regions []MaskedRegion
+}
+
+// MaskingReport что было закрашено при разбиении узи, хранится в {uzi_id}/masking.json
+type MaskingReport struct {
+	UziID    uuid.UUID
+	Mask     EdgeMask
+	Frames   []FrameMasking
+	CreateAt time.Time
+}
+
+func (r MaskingReport) Path() string {
+	return path.Join(r.UziID.String(), "masking.json")
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "fmt"
+
+type MeasureUnit string
+
+const (
+	// измерения в пикселях, размер пикселя неизвестен
+	MeasureUnitPx MeasureUnit = "px"
+	// измерения в миллиметрах
+	MeasureUnitMm MeasureUnit = "mm"
+)
+
+func (u MeasureUnit) String() string {
+	return string(u)
+}
+
+func (u MeasureUnit) Parse(unit string) (MeasureUnit, error) {
+	switch unit {
+	case "px":
+		return MeasureUnitPx, nil
+	case "mm":
+		return MeasureUnitMm, nil
+	default:
+		return "", fmt.Errorf("invalid measure unit: %s", unit)
+	}
+}
+
+// размер пикселя изображения в мм
+type PixelSpacing struct {
+	X float64
+	Y float64
+}
+
+// ограничивающий прямоугольник в пикселях изображения
+type BoundingBox struct {
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+type SegmentMeasurement struct {
+	BBox      BoundingBox
+	Area      float64
+	Perimeter float64
+	MajorAxis float64
+	MinorAxis float64
+	Unit      MeasureUnit
+}
+
+type NodeMeasurement struct {
+	Area      float64
+	Perimeter float64
+	MajorAxis float64
+	MinorAxis float64
+	Volume    float64
+	Unit      MeasureUnit
+}
@@ -11,4 +11,16 @@ type Node struct {
 	Tirads4     float64
 	Tirads5     float64
 	Description *string
+	Lobe        *NodeLobe
+	Measurement *NodeMeasurement
+}
+
+// Validated врач уже принял решение по узлу нейросети
+func (n Node) Validated() bool {
+	return n.Validation != nil && *n.Validation != NodeValidationNull
+}
+
+// Confidence уверенность нейросети - вероятность наиболее вероятного класса TI-RADS
+func (n Node) Confidence() float64 {
+	return max(n.Tirads23, n.Tirads4, n.Tirads5)
 }
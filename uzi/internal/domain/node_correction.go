@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NodeCorrectionKind string
+
+const (
+	// сегменты нескольких узлов перенесены в один
+	NodeCorrectionKindMerge NodeCorrectionKind = "merge"
+	// часть сегментов узла перенесена в новый узел
+	NodeCorrectionKindSplit NodeCorrectionKind = "split"
+)
+
+func (k NodeCorrectionKind) String() string {
+	return string(k)
+}
+
+func (k NodeCorrectionKind) Parse(kind string) (NodeCorrectionKind, error) {
+	switch kind {
+	case "merge":
+		return NodeCorrectionKindMerge, nil
+	case "split":
+		return NodeCorrectionKindSplit, nil
+	default:
+		return "", fmt.Errorf("invalid node correction kind: %s", kind)
+	}
+}
+
+// CorrectedNode узел до слияния или разделения
+type CorrectedNode struct {
+	Node       Node
+	SegmentIDs []uuid.UUID
+}
+
+// NodeCorrection слияние или разделение узлов, исходные узлы нейросети остаются здесь после удаления
+type NodeCorrection struct {
+	Id    uuid.UUID
+	UziID uuid.UUID
+	Kind  NodeCorrectionKind
+	// nil, если пользователь не передан
+	Actor     *uuid.UUID
+	Sources   []CorrectedNode
+	ResultIDs []uuid.UUID
+	CreateAt  time.Time
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NodeReviewKind string
+
+const (
+	// валидация выбранных узлов
+	NodeReviewKindNodes NodeReviewKind = "nodes"
+	// узи целиком отмечено проверенным
+	NodeReviewKindUzi NodeReviewKind = "uzi"
+	// приняты узлы нейросети с уверенностью не ниже порога
+	NodeReviewKindThreshold NodeReviewKind = "threshold"
+)
+
+func (k NodeReviewKind) String() string {
+	return string(k)
+}
+
+func (k NodeReviewKind) Parse(kind string) (NodeReviewKind, error) {
+	switch kind {
+	case "nodes":
+		return NodeReviewKindNodes, nil
+	case "uzi":
+		return NodeReviewKindUzi, nil
+	case "threshold":
+		return NodeReviewKindThreshold, nil
+	default:
+		return "", fmt.Errorf("invalid node review kind: %s", kind)
+	}
+}
+
+// NodeReviewStatus результат пакетной операции для одного узла
+type NodeReviewStatus string
+
+const (
+	// валидация узла изменена
+	NodeReviewStatusApplied NodeReviewStatus = "applied"
+	// у узла уже такая валидация или менять ее не требуется
+	NodeReviewStatusUnchanged NodeReviewStatus = "unchanged"
+	NodeReviewStatusNotFound  NodeReviewStatus = "not_found"
+	// ручной узел не валидируется
+	NodeReviewStatusManualNode NodeReviewStatus = "manual_node"
+	// решение врача не перезаписывается
+	NodeReviewStatusAlreadyValidated NodeReviewStatus = "already_validated"
+	NodeReviewStatusBelowThreshold   NodeReviewStatus = "below_threshold"
+)
+
+func (s NodeReviewStatus) String() string {
+	return string(s)
+}
+
+// NodeReviewItem узел пакета, Node nil если узел не найден
+type NodeReviewItem struct {
+	NodeID uuid.UUID
+	Status NodeReviewStatus
+	Node   *Node
+}
+
+// NodeReview пакетная валидация узлов одного узи, пишется для аналитики
+type NodeReview struct {
+	Id    uuid.UUID
+	UziID uuid.UUID
+	Kind  NodeReviewKind
+	// nil, если пользователь не передан
+	Actor *uuid.UUID
+	// nil, если валидация узлов не менялась (узи отмечено проверенным без валидации)
+	Validation *NodeValidation
+	Threshold  *float64
+	// узлы, валидация которых изменена
+	NodeIDs  []uuid.UUID
+	CreateAt time.Time
+}
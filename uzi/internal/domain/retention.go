@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// CleanupJob задача удаления объектов S3 по префиксу, повторяется до успеха
+type CleanupJob struct {
+	Prefix        string
+	Attempts      int
+	LastError     *string
+	NextAttemptAt time.Time
+	CreateAt      time.Time
+}
+
+// OrphanSweep результат сверки бакета с таблицами uzi и image
+type OrphanSweep struct {
+	DryRun bool
+	// число проверенных объектов, без свежих и служебных
+	Checked int
+	// объекты без узи или кадра в БД
+	Orphans []string
+}
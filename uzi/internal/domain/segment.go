@@ -15,4 +15,6 @@ type Segment struct {
 	Tirads23 float64
 	Tirads4  float64
 	Tirads5  float64
+
+	Measurement *SegmentMeasurement
 }
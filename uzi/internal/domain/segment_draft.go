@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SegmentDraft контур, предложенный на соседнем кадре по сегменту узла.
+// Становится сегментом только после подтверждения врачом
+type SegmentDraft struct {
+	Id              uuid.UUID
+	NodeID          uuid.UUID
+	ImageID         uuid.UUID
+	SourceSegmentID uuid.UUID
+	Contor          json.RawMessage
+	// вероятности TI-RADS копируются из исходного сегмента
+	Tirads23 float64
+	Tirads4  float64
+	Tirads5  float64
+	// нормированная корреляция окрестности контура с предыдущим кадром, от -1 до 1
+	Score    float64
+	CreateAt time.Time
+}
@@ -16,4 +16,8 @@ type Uzi struct {
 	Status      UziStatus
 	Description *string
 	CreateAt    time.Time
+
+	PixelSpacing *PixelSpacing
+	// sha256 исходного файла в hex
+	Sha256 *string
 }
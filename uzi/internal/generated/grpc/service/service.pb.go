@@ -23,6 +23,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProbeType int32
+
+const (
+	ProbeType_PROBE_TYPE_LINEAR ProbeType = 0
+	ProbeType_PROBE_TYPE_CONVEX ProbeType = 1
+	ProbeType_PROBE_TYPE_SECTOR ProbeType = 2
+)
+
+// Enum value maps for ProbeType.
+var (
+	ProbeType_name = map[int32]string{
+		0: "PROBE_TYPE_LINEAR",
+		1: "PROBE_TYPE_CONVEX",
+		2: "PROBE_TYPE_SECTOR",
+	}
+	ProbeType_value = map[string]int32{
+		"PROBE_TYPE_LINEAR": 0,
+		"PROBE_TYPE_CONVEX": 1,
+		"PROBE_TYPE_SECTOR": 2,
+	}
+)
+
+func (x ProbeType) Enum() *ProbeType {
+	p := new(ProbeType)
+	*p = x
+	return p
+}
+
+func (x ProbeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProbeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ProbeType) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[0]
+}
+
+func (x ProbeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProbeType.Descriptor instead.
+func (ProbeType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{0}
+}
+
 type UziStatus int32
 
 const (
@@ -56,11 +105,11 @@ func (x UziStatus) String() string {
 }
 
 func (UziStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_grpc_service_proto_enumTypes[0].Descriptor()
+	return file_proto_grpc_service_proto_enumTypes[1].Descriptor()
 }
 
 func (UziStatus) Type() protoreflect.EnumType {
-	return &file_proto_grpc_service_proto_enumTypes[0]
+	return &file_proto_grpc_service_proto_enumTypes[1]
 }
 
 func (x UziStatus) Number() protoreflect.EnumNumber {
@@ -69,7 +118,7 @@ func (x UziStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UziStatus.Descriptor instead.
 func (UziStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{0}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{1}
 }
 
 type NodeValidation int32
@@ -105,11 +154,11 @@ func (x NodeValidation) String() string {
 }
 
 func (NodeValidation) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_grpc_service_proto_enumTypes[1].Descriptor()
+	return file_proto_grpc_service_proto_enumTypes[2].Descriptor()
 }
 
 func (NodeValidation) Type() protoreflect.EnumType {
-	return &file_proto_grpc_service_proto_enumTypes[1]
+	return &file_proto_grpc_service_proto_enumTypes[2]
 }
 
 func (x NodeValidation) Number() protoreflect.EnumNumber {
@@ -118,7 +167,56 @@ func (x NodeValidation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NodeValidation.Descriptor instead.
 func (NodeValidation) EnumDescriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{1}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{2}
+}
+
+type NodeLobe int32
+
+const (
+	NodeLobe_NODE_LOBE_RIGHT   NodeLobe = 0
+	NodeLobe_NODE_LOBE_LEFT    NodeLobe = 1
+	NodeLobe_NODE_LOBE_ISTHMUS NodeLobe = 2
+)
+
+// Enum value maps for NodeLobe.
+var (
+	NodeLobe_name = map[int32]string{
+		0: "NODE_LOBE_RIGHT",
+		1: "NODE_LOBE_LEFT",
+		2: "NODE_LOBE_ISTHMUS",
+	}
+	NodeLobe_value = map[string]int32{
+		"NODE_LOBE_RIGHT":   0,
+		"NODE_LOBE_LEFT":    1,
+		"NODE_LOBE_ISTHMUS": 2,
+	}
+)
+
+func (x NodeLobe) Enum() *NodeLobe {
+	p := new(NodeLobe)
+	*p = x
+	return p
+}
+
+func (x NodeLobe) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NodeLobe) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[3].Descriptor()
+}
+
+func (NodeLobe) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[3]
+}
+
+func (x NodeLobe) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NodeLobe.Descriptor instead.
+func (NodeLobe) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{3}
 }
 
 type UziProjection int32
@@ -151,11 +249,11 @@ func (x UziProjection) String() string {
 }
 
 func (UziProjection) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_grpc_service_proto_enumTypes[2].Descriptor()
+	return file_proto_grpc_service_proto_enumTypes[4].Descriptor()
 }
 
 func (UziProjection) Type() protoreflect.EnumType {
-	return &file_proto_grpc_service_proto_enumTypes[2]
+	return &file_proto_grpc_service_proto_enumTypes[4]
 }
 
 func (x UziProjection) Number() protoreflect.EnumNumber {
@@ -164,83 +262,6023 @@ func (x UziProjection) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UziProjection.Descriptor instead.
 func (UziProjection) EnumDescriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{2}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{4}
 }
 
-type Device struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,200,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EchographicStruct int32
+
+const (
+	EchographicStruct_ECHOGRAPHIC_STRUCT_HOMOGENEOUS   EchographicStruct = 0
+	EchographicStruct_ECHOGRAPHIC_STRUCT_HETEROGENEOUS EchographicStruct = 1
+)
+
+// Enum value maps for EchographicStruct.
+var (
+	EchographicStruct_name = map[int32]string{
+		0: "ECHOGRAPHIC_STRUCT_HOMOGENEOUS",
+		1: "ECHOGRAPHIC_STRUCT_HETEROGENEOUS",
+	}
+	EchographicStruct_value = map[string]int32{
+		"ECHOGRAPHIC_STRUCT_HOMOGENEOUS":   0,
+		"ECHOGRAPHIC_STRUCT_HETEROGENEOUS": 1,
+	}
+)
+
+func (x EchographicStruct) Enum() *EchographicStruct {
+	p := new(EchographicStruct)
+	*p = x
+	return p
 }
 
-func (x *Device) Reset() {
-	*x = Device{}
-	mi := &file_proto_grpc_service_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x EchographicStruct) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Device) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (EchographicStruct) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[5].Descriptor()
 }
 
-func (*Device) ProtoMessage() {}
+func (EchographicStruct) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[5]
+}
 
-func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x EchographicStruct) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EchographicStruct.Descriptor instead.
+func (EchographicStruct) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{5}
+}
+
+type EchographicEchogenicity int32
+
+const (
+	EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_NORMAL    EchographicEchogenicity = 0
+	EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_INCREASED EchographicEchogenicity = 1
+	EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_DECREASED EchographicEchogenicity = 2
+	EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_MIXED     EchographicEchogenicity = 3
+)
+
+// Enum value maps for EchographicEchogenicity.
+var (
+	EchographicEchogenicity_name = map[int32]string{
+		0: "ECHOGRAPHIC_ECHOGENICITY_NORMAL",
+		1: "ECHOGRAPHIC_ECHOGENICITY_INCREASED",
+		2: "ECHOGRAPHIC_ECHOGENICITY_DECREASED",
+		3: "ECHOGRAPHIC_ECHOGENICITY_MIXED",
+	}
+	EchographicEchogenicity_value = map[string]int32{
+		"ECHOGRAPHIC_ECHOGENICITY_NORMAL":    0,
+		"ECHOGRAPHIC_ECHOGENICITY_INCREASED": 1,
+		"ECHOGRAPHIC_ECHOGENICITY_DECREASED": 2,
+		"ECHOGRAPHIC_ECHOGENICITY_MIXED":     3,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x EchographicEchogenicity) Enum() *EchographicEchogenicity {
+	p := new(EchographicEchogenicity)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Device.ProtoReflect.Descriptor instead.
-func (*Device) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{0}
+func (x EchographicEchogenicity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Device) GetId() int64 {
-	if x != nil {
-		return x.Id
+func (EchographicEchogenicity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[6].Descriptor()
+}
+
+func (EchographicEchogenicity) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[6]
+}
+
+func (x EchographicEchogenicity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EchographicEchogenicity.Descriptor instead.
+func (EchographicEchogenicity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{6}
+}
+
+type EchographicVascularization int32
+
+const (
+	EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_NORMAL    EchographicVascularization = 0
+	EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_INCREASED EchographicVascularization = 1
+	EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_DECREASED EchographicVascularization = 2
+)
+
+// Enum value maps for EchographicVascularization.
+var (
+	EchographicVascularization_name = map[int32]string{
+		0: "ECHOGRAPHIC_VASCULARIZATION_NORMAL",
+		1: "ECHOGRAPHIC_VASCULARIZATION_INCREASED",
+		2: "ECHOGRAPHIC_VASCULARIZATION_DECREASED",
 	}
-	return 0
+	EchographicVascularization_value = map[string]int32{
+		"ECHOGRAPHIC_VASCULARIZATION_NORMAL":    0,
+		"ECHOGRAPHIC_VASCULARIZATION_INCREASED": 1,
+		"ECHOGRAPHIC_VASCULARIZATION_DECREASED": 2,
+	}
+)
+
+func (x EchographicVascularization) Enum() *EchographicVascularization {
+	p := new(EchographicVascularization)
+	*p = x
+	return p
 }
 
-func (x *Device) GetName() string {
-	if x != nil {
-		return x.Name
+func (x EchographicVascularization) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EchographicVascularization) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[7].Descriptor()
+}
+
+func (EchographicVascularization) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[7]
+}
+
+func (x EchographicVascularization) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EchographicVascularization.Descriptor instead.
+func (EchographicVascularization) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{7}
+}
+
+type PatientSex int32
+
+const (
+	PatientSex_PATIENT_SEX_MALE   PatientSex = 0
+	PatientSex_PATIENT_SEX_FEMALE PatientSex = 1
+)
+
+// Enum value maps for PatientSex.
+var (
+	PatientSex_name = map[int32]string{
+		0: "PATIENT_SEX_MALE",
+		1: "PATIENT_SEX_FEMALE",
 	}
-	return ""
+	PatientSex_value = map[string]int32{
+		"PATIENT_SEX_MALE":   0,
+		"PATIENT_SEX_FEMALE": 1,
+	}
+)
+
+func (x PatientSex) Enum() *PatientSex {
+	p := new(PatientSex)
+	*p = x
+	return p
 }
 
-type CreateDeviceIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x PatientSex) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreateDeviceIn) Reset() {
-	*x = CreateDeviceIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[1]
+func (PatientSex) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[8].Descriptor()
+}
+
+func (PatientSex) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[8]
+}
+
+func (x PatientSex) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PatientSex.Descriptor instead.
+func (PatientSex) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{8}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_DESC SortOrder = 0
+	SortOrder_SORT_ORDER_ASC  SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_DESC",
+		1: "SORT_ORDER_ASC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_DESC": 0,
+		"SORT_ORDER_ASC":  1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[9].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[9]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{9}
+}
+
+type ImageSize int32
+
+const (
+	ImageSize_IMAGE_SIZE_ORIGINAL  ImageSize = 0
+	ImageSize_IMAGE_SIZE_PREVIEW   ImageSize = 1
+	ImageSize_IMAGE_SIZE_THUMBNAIL ImageSize = 2
+)
+
+// Enum value maps for ImageSize.
+var (
+	ImageSize_name = map[int32]string{
+		0: "IMAGE_SIZE_ORIGINAL",
+		1: "IMAGE_SIZE_PREVIEW",
+		2: "IMAGE_SIZE_THUMBNAIL",
+	}
+	ImageSize_value = map[string]int32{
+		"IMAGE_SIZE_ORIGINAL":  0,
+		"IMAGE_SIZE_PREVIEW":   1,
+		"IMAGE_SIZE_THUMBNAIL": 2,
+	}
+)
+
+func (x ImageSize) Enum() *ImageSize {
+	p := new(ImageSize)
+	*p = x
+	return p
+}
+
+func (x ImageSize) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageSize) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[10].Descriptor()
+}
+
+func (ImageSize) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[10]
+}
+
+func (x ImageSize) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageSize.Descriptor instead.
+func (ImageSize) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{10}
+}
+
+type MeasureUnit int32
+
+const (
+	MeasureUnit_MEASURE_UNIT_PX MeasureUnit = 0
+	MeasureUnit_MEASURE_UNIT_MM MeasureUnit = 1
+)
+
+// Enum value maps for MeasureUnit.
+var (
+	MeasureUnit_name = map[int32]string{
+		0: "MEASURE_UNIT_PX",
+		1: "MEASURE_UNIT_MM",
+	}
+	MeasureUnit_value = map[string]int32{
+		"MEASURE_UNIT_PX": 0,
+		"MEASURE_UNIT_MM": 1,
+	}
+)
+
+func (x MeasureUnit) Enum() *MeasureUnit {
+	p := new(MeasureUnit)
+	*p = x
+	return p
+}
+
+func (x MeasureUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MeasureUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[11].Descriptor()
+}
+
+func (MeasureUnit) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[11]
+}
+
+func (x MeasureUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MeasureUnit.Descriptor instead.
+func (MeasureUnit) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{11}
+}
+
+type NodeReviewStatus int32
+
+const (
+	// валидация узла изменена
+	NodeReviewStatus_NODE_REVIEW_STATUS_APPLIED NodeReviewStatus = 0
+	// у узла уже такая валидация или менять ее не требуется
+	NodeReviewStatus_NODE_REVIEW_STATUS_UNCHANGED NodeReviewStatus = 1
+	NodeReviewStatus_NODE_REVIEW_STATUS_NOT_FOUND NodeReviewStatus = 2
+	// ручной узел не валидируется
+	NodeReviewStatus_NODE_REVIEW_STATUS_MANUAL_NODE NodeReviewStatus = 3
+	// решение врача не перезаписывается
+	NodeReviewStatus_NODE_REVIEW_STATUS_ALREADY_VALIDATED NodeReviewStatus = 4
+	NodeReviewStatus_NODE_REVIEW_STATUS_BELOW_THRESHOLD   NodeReviewStatus = 5
+)
+
+// Enum value maps for NodeReviewStatus.
+var (
+	NodeReviewStatus_name = map[int32]string{
+		0: "NODE_REVIEW_STATUS_APPLIED",
+		1: "NODE_REVIEW_STATUS_UNCHANGED",
+		2: "NODE_REVIEW_STATUS_NOT_FOUND",
+		3: "NODE_REVIEW_STATUS_MANUAL_NODE",
+		4: "NODE_REVIEW_STATUS_ALREADY_VALIDATED",
+		5: "NODE_REVIEW_STATUS_BELOW_THRESHOLD",
+	}
+	NodeReviewStatus_value = map[string]int32{
+		"NODE_REVIEW_STATUS_APPLIED":           0,
+		"NODE_REVIEW_STATUS_UNCHANGED":         1,
+		"NODE_REVIEW_STATUS_NOT_FOUND":         2,
+		"NODE_REVIEW_STATUS_MANUAL_NODE":       3,
+		"NODE_REVIEW_STATUS_ALREADY_VALIDATED": 4,
+		"NODE_REVIEW_STATUS_BELOW_THRESHOLD":   5,
+	}
+)
+
+func (x NodeReviewStatus) Enum() *NodeReviewStatus {
+	p := new(NodeReviewStatus)
+	*p = x
+	return p
+}
+
+func (x NodeReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NodeReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[12].Descriptor()
+}
+
+func (NodeReviewStatus) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[12]
+}
+
+func (x NodeReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NodeReviewStatus.Descriptor instead.
+func (NodeReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{12}
+}
+
+type TiradsComposition int32
+
+const (
+	TiradsComposition_TIRADS_COMPOSITION_CYSTIC     TiradsComposition = 0
+	TiradsComposition_TIRADS_COMPOSITION_SPONGIFORM TiradsComposition = 1
+	TiradsComposition_TIRADS_COMPOSITION_MIXED      TiradsComposition = 2
+	TiradsComposition_TIRADS_COMPOSITION_SOLID      TiradsComposition = 3
+)
+
+// Enum value maps for TiradsComposition.
+var (
+	TiradsComposition_name = map[int32]string{
+		0: "TIRADS_COMPOSITION_CYSTIC",
+		1: "TIRADS_COMPOSITION_SPONGIFORM",
+		2: "TIRADS_COMPOSITION_MIXED",
+		3: "TIRADS_COMPOSITION_SOLID",
+	}
+	TiradsComposition_value = map[string]int32{
+		"TIRADS_COMPOSITION_CYSTIC":     0,
+		"TIRADS_COMPOSITION_SPONGIFORM": 1,
+		"TIRADS_COMPOSITION_MIXED":      2,
+		"TIRADS_COMPOSITION_SOLID":      3,
+	}
+)
+
+func (x TiradsComposition) Enum() *TiradsComposition {
+	p := new(TiradsComposition)
+	*p = x
+	return p
+}
+
+func (x TiradsComposition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TiradsComposition) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[13].Descriptor()
+}
+
+func (TiradsComposition) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[13]
+}
+
+func (x TiradsComposition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TiradsComposition.Descriptor instead.
+func (TiradsComposition) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{13}
+}
+
+type TiradsEchogenicity int32
+
+const (
+	TiradsEchogenicity_TIRADS_ECHOGENICITY_ANECHOIC  TiradsEchogenicity = 0
+	TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPER_ISO TiradsEchogenicity = 1
+	TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPO      TiradsEchogenicity = 2
+	TiradsEchogenicity_TIRADS_ECHOGENICITY_VERY_HYPO TiradsEchogenicity = 3
+)
+
+// Enum value maps for TiradsEchogenicity.
+var (
+	TiradsEchogenicity_name = map[int32]string{
+		0: "TIRADS_ECHOGENICITY_ANECHOIC",
+		1: "TIRADS_ECHOGENICITY_HYPER_ISO",
+		2: "TIRADS_ECHOGENICITY_HYPO",
+		3: "TIRADS_ECHOGENICITY_VERY_HYPO",
+	}
+	TiradsEchogenicity_value = map[string]int32{
+		"TIRADS_ECHOGENICITY_ANECHOIC":  0,
+		"TIRADS_ECHOGENICITY_HYPER_ISO": 1,
+		"TIRADS_ECHOGENICITY_HYPO":      2,
+		"TIRADS_ECHOGENICITY_VERY_HYPO": 3,
+	}
+)
+
+func (x TiradsEchogenicity) Enum() *TiradsEchogenicity {
+	p := new(TiradsEchogenicity)
+	*p = x
+	return p
+}
+
+func (x TiradsEchogenicity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TiradsEchogenicity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[14].Descriptor()
+}
+
+func (TiradsEchogenicity) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[14]
+}
+
+func (x TiradsEchogenicity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TiradsEchogenicity.Descriptor instead.
+func (TiradsEchogenicity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{14}
+}
+
+type TiradsShape int32
+
+const (
+	TiradsShape_TIRADS_SHAPE_WIDER_THAN_TALL  TiradsShape = 0
+	TiradsShape_TIRADS_SHAPE_TALLER_THAN_WIDE TiradsShape = 1
+)
+
+// Enum value maps for TiradsShape.
+var (
+	TiradsShape_name = map[int32]string{
+		0: "TIRADS_SHAPE_WIDER_THAN_TALL",
+		1: "TIRADS_SHAPE_TALLER_THAN_WIDE",
+	}
+	TiradsShape_value = map[string]int32{
+		"TIRADS_SHAPE_WIDER_THAN_TALL":  0,
+		"TIRADS_SHAPE_TALLER_THAN_WIDE": 1,
+	}
+)
+
+func (x TiradsShape) Enum() *TiradsShape {
+	p := new(TiradsShape)
+	*p = x
+	return p
+}
+
+func (x TiradsShape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TiradsShape) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[15].Descriptor()
+}
+
+func (TiradsShape) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[15]
+}
+
+func (x TiradsShape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TiradsShape.Descriptor instead.
+func (TiradsShape) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{15}
+}
+
+type TiradsMargin int32
+
+const (
+	TiradsMargin_TIRADS_MARGIN_SMOOTH         TiradsMargin = 0
+	TiradsMargin_TIRADS_MARGIN_ILL_DEFINED    TiradsMargin = 1
+	TiradsMargin_TIRADS_MARGIN_LOBULATED      TiradsMargin = 2
+	TiradsMargin_TIRADS_MARGIN_EXTRATHYROIDAL TiradsMargin = 3
+)
+
+// Enum value maps for TiradsMargin.
+var (
+	TiradsMargin_name = map[int32]string{
+		0: "TIRADS_MARGIN_SMOOTH",
+		1: "TIRADS_MARGIN_ILL_DEFINED",
+		2: "TIRADS_MARGIN_LOBULATED",
+		3: "TIRADS_MARGIN_EXTRATHYROIDAL",
+	}
+	TiradsMargin_value = map[string]int32{
+		"TIRADS_MARGIN_SMOOTH":         0,
+		"TIRADS_MARGIN_ILL_DEFINED":    1,
+		"TIRADS_MARGIN_LOBULATED":      2,
+		"TIRADS_MARGIN_EXTRATHYROIDAL": 3,
+	}
+)
+
+func (x TiradsMargin) Enum() *TiradsMargin {
+	p := new(TiradsMargin)
+	*p = x
+	return p
+}
+
+func (x TiradsMargin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TiradsMargin) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[16].Descriptor()
+}
+
+func (TiradsMargin) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[16]
+}
+
+func (x TiradsMargin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TiradsMargin.Descriptor instead.
+func (TiradsMargin) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{16}
+}
+
+type TiradsEchogenicFoci int32
+
+const (
+	TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_NONE                TiradsEchogenicFoci = 0
+	TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_MACROCALCIFICATIONS TiradsEchogenicFoci = 1
+	TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PERIPHERAL          TiradsEchogenicFoci = 2
+	TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PUNCTATE            TiradsEchogenicFoci = 3
+)
+
+// Enum value maps for TiradsEchogenicFoci.
+var (
+	TiradsEchogenicFoci_name = map[int32]string{
+		0: "TIRADS_ECHOGENIC_FOCI_NONE",
+		1: "TIRADS_ECHOGENIC_FOCI_MACROCALCIFICATIONS",
+		2: "TIRADS_ECHOGENIC_FOCI_PERIPHERAL",
+		3: "TIRADS_ECHOGENIC_FOCI_PUNCTATE",
+	}
+	TiradsEchogenicFoci_value = map[string]int32{
+		"TIRADS_ECHOGENIC_FOCI_NONE":                0,
+		"TIRADS_ECHOGENIC_FOCI_MACROCALCIFICATIONS": 1,
+		"TIRADS_ECHOGENIC_FOCI_PERIPHERAL":          2,
+		"TIRADS_ECHOGENIC_FOCI_PUNCTATE":            3,
+	}
+)
+
+func (x TiradsEchogenicFoci) Enum() *TiradsEchogenicFoci {
+	p := new(TiradsEchogenicFoci)
+	*p = x
+	return p
+}
+
+func (x TiradsEchogenicFoci) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TiradsEchogenicFoci) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[17].Descriptor()
+}
+
+func (TiradsEchogenicFoci) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[17]
+}
+
+func (x TiradsEchogenicFoci) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TiradsEchogenicFoci.Descriptor instead.
+func (TiradsEchogenicFoci) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{17}
+}
+
+type TiradsCategory int32
+
+const (
+	TiradsCategory_TIRADS_CATEGORY_TR1 TiradsCategory = 0
+	TiradsCategory_TIRADS_CATEGORY_TR2 TiradsCategory = 1
+	TiradsCategory_TIRADS_CATEGORY_TR3 TiradsCategory = 2
+	TiradsCategory_TIRADS_CATEGORY_TR4 TiradsCategory = 3
+	TiradsCategory_TIRADS_CATEGORY_TR5 TiradsCategory = 4
+)
+
+// Enum value maps for TiradsCategory.
+var (
+	TiradsCategory_name = map[int32]string{
+		0: "TIRADS_CATEGORY_TR1",
+		1: "TIRADS_CATEGORY_TR2",
+		2: "TIRADS_CATEGORY_TR3",
+		3: "TIRADS_CATEGORY_TR4",
+		4: "TIRADS_CATEGORY_TR5",
+	}
+	TiradsCategory_value = map[string]int32{
+		"TIRADS_CATEGORY_TR1": 0,
+		"TIRADS_CATEGORY_TR2": 1,
+		"TIRADS_CATEGORY_TR3": 2,
+		"TIRADS_CATEGORY_TR4": 3,
+		"TIRADS_CATEGORY_TR5": 4,
+	}
+)
+
+func (x TiradsCategory) Enum() *TiradsCategory {
+	p := new(TiradsCategory)
+	*p = x
+	return p
+}
+
+func (x TiradsCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TiradsCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[18].Descriptor()
+}
+
+func (TiradsCategory) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[18]
+}
+
+func (x TiradsCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TiradsCategory.Descriptor instead.
+func (TiradsCategory) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{18}
+}
+
+type TiradsRecommendation int32
+
+const (
+	TiradsRecommendation_TIRADS_RECOMMENDATION_NONE      TiradsRecommendation = 0
+	TiradsRecommendation_TIRADS_RECOMMENDATION_FOLLOW_UP TiradsRecommendation = 1
+	TiradsRecommendation_TIRADS_RECOMMENDATION_FNA       TiradsRecommendation = 2
+	// размер узла в мм неизвестен
+	TiradsRecommendation_TIRADS_RECOMMENDATION_UNKNOWN TiradsRecommendation = 3
+)
+
+// Enum value maps for TiradsRecommendation.
+var (
+	TiradsRecommendation_name = map[int32]string{
+		0: "TIRADS_RECOMMENDATION_NONE",
+		1: "TIRADS_RECOMMENDATION_FOLLOW_UP",
+		2: "TIRADS_RECOMMENDATION_FNA",
+		3: "TIRADS_RECOMMENDATION_UNKNOWN",
+	}
+	TiradsRecommendation_value = map[string]int32{
+		"TIRADS_RECOMMENDATION_NONE":      0,
+		"TIRADS_RECOMMENDATION_FOLLOW_UP": 1,
+		"TIRADS_RECOMMENDATION_FNA":       2,
+		"TIRADS_RECOMMENDATION_UNKNOWN":   3,
+	}
+)
+
+func (x TiradsRecommendation) Enum() *TiradsRecommendation {
+	p := new(TiradsRecommendation)
+	*p = x
+	return p
+}
+
+func (x TiradsRecommendation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TiradsRecommendation) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[19].Descriptor()
+}
+
+func (TiradsRecommendation) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[19]
+}
+
+func (x TiradsRecommendation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TiradsRecommendation.Descriptor instead.
+func (TiradsRecommendation) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{19}
+}
+
+type DatasetFormat int32
+
+const (
+	DatasetFormat_DATASET_FORMAT_COCO DatasetFormat = 0
+	DatasetFormat_DATASET_FORMAT_YOLO DatasetFormat = 1
+)
+
+// Enum value maps for DatasetFormat.
+var (
+	DatasetFormat_name = map[int32]string{
+		0: "DATASET_FORMAT_COCO",
+		1: "DATASET_FORMAT_YOLO",
+	}
+	DatasetFormat_value = map[string]int32{
+		"DATASET_FORMAT_COCO": 0,
+		"DATASET_FORMAT_YOLO": 1,
+	}
+)
+
+func (x DatasetFormat) Enum() *DatasetFormat {
+	p := new(DatasetFormat)
+	*p = x
+	return p
+}
+
+func (x DatasetFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatasetFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[20].Descriptor()
+}
+
+func (DatasetFormat) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[20]
+}
+
+func (x DatasetFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatasetFormat.Descriptor instead.
+func (DatasetFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{20}
+}
+
+type AnnotationFormat int32
+
+const (
+	AnnotationFormat_ANNOTATION_FORMAT_COCO AnnotationFormat = 0
+	AnnotationFormat_ANNOTATION_FORMAT_CVAT AnnotationFormat = 1
+)
+
+// Enum value maps for AnnotationFormat.
+var (
+	AnnotationFormat_name = map[int32]string{
+		0: "ANNOTATION_FORMAT_COCO",
+		1: "ANNOTATION_FORMAT_CVAT",
+	}
+	AnnotationFormat_value = map[string]int32{
+		"ANNOTATION_FORMAT_COCO": 0,
+		"ANNOTATION_FORMAT_CVAT": 1,
+	}
+)
+
+func (x AnnotationFormat) Enum() *AnnotationFormat {
+	p := new(AnnotationFormat)
+	*p = x
+	return p
+}
+
+func (x AnnotationFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnotationFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[21].Descriptor()
+}
+
+func (AnnotationFormat) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[21]
+}
+
+func (x AnnotationFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnotationFormat.Descriptor instead.
+func (AnnotationFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{21}
+}
+
+type HistoryAction int32
+
+const (
+	HistoryAction_HISTORY_ACTION_CREATE  HistoryAction = 0
+	HistoryAction_HISTORY_ACTION_UPDATE  HistoryAction = 1
+	HistoryAction_HISTORY_ACTION_DELETE  HistoryAction = 2
+	HistoryAction_HISTORY_ACTION_RESTORE HistoryAction = 3
+	// исходное состояние объекта, созданного до ведения истории
+	HistoryAction_HISTORY_ACTION_SNAPSHOT HistoryAction = 4
+)
+
+// Enum value maps for HistoryAction.
+var (
+	HistoryAction_name = map[int32]string{
+		0: "HISTORY_ACTION_CREATE",
+		1: "HISTORY_ACTION_UPDATE",
+		2: "HISTORY_ACTION_DELETE",
+		3: "HISTORY_ACTION_RESTORE",
+		4: "HISTORY_ACTION_SNAPSHOT",
+	}
+	HistoryAction_value = map[string]int32{
+		"HISTORY_ACTION_CREATE":   0,
+		"HISTORY_ACTION_UPDATE":   1,
+		"HISTORY_ACTION_DELETE":   2,
+		"HISTORY_ACTION_RESTORE":  3,
+		"HISTORY_ACTION_SNAPSHOT": 4,
+	}
+)
+
+func (x HistoryAction) Enum() *HistoryAction {
+	p := new(HistoryAction)
+	*p = x
+	return p
+}
+
+func (x HistoryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistoryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[22].Descriptor()
+}
+
+func (HistoryAction) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[22]
+}
+
+func (x HistoryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoryAction.Descriptor instead.
+func (HistoryAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{22}
+}
+
+type AnalyticsPeriod int32
+
+const (
+	AnalyticsPeriod_ANALYTICS_PERIOD_MONTH AnalyticsPeriod = 0
+	AnalyticsPeriod_ANALYTICS_PERIOD_WEEK  AnalyticsPeriod = 1
+	AnalyticsPeriod_ANALYTICS_PERIOD_DAY   AnalyticsPeriod = 2
+)
+
+// Enum value maps for AnalyticsPeriod.
+var (
+	AnalyticsPeriod_name = map[int32]string{
+		0: "ANALYTICS_PERIOD_MONTH",
+		1: "ANALYTICS_PERIOD_WEEK",
+		2: "ANALYTICS_PERIOD_DAY",
+	}
+	AnalyticsPeriod_value = map[string]int32{
+		"ANALYTICS_PERIOD_MONTH": 0,
+		"ANALYTICS_PERIOD_WEEK":  1,
+		"ANALYTICS_PERIOD_DAY":   2,
+	}
+)
+
+func (x AnalyticsPeriod) Enum() *AnalyticsPeriod {
+	p := new(AnalyticsPeriod)
+	*p = x
+	return p
+}
+
+func (x AnalyticsPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalyticsPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_grpc_service_proto_enumTypes[23].Descriptor()
+}
+
+func (AnalyticsPeriod) Type() protoreflect.EnumType {
+	return &file_proto_grpc_service_proto_enumTypes[23]
+}
+
+func (x AnalyticsPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalyticsPeriod.Descriptor instead.
+func (AnalyticsPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{23}
+}
+
+type Device struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,200,opt,name=name,proto3" json:"name,omitempty"`
+	Manufacturer *string                `protobuf:"bytes,300,opt,name=manufacturer,proto3,oneof" json:"manufacturer,omitempty"`
+	Model        *string                `protobuf:"bytes,400,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	SerialNumber *string                `protobuf:"bytes,500,opt,name=serial_number,json=serialNumber,proto3,oneof" json:"serial_number,omitempty"`
+	ProbeType    *ProbeType             `protobuf:"varint,600,opt,name=probe_type,json=probeType,proto3,enum=ProbeType,oneof" json:"probe_type,omitempty"`
+	// калибровка по умолчанию для узи без собственного шага пикселя
+	PixelSpacing  *PixelSpacing `protobuf:"bytes,700,opt,name=pixel_spacing,json=pixelSpacing,proto3" json:"pixel_spacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_proto_grpc_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Device) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Device) GetManufacturer() string {
+	if x != nil && x.Manufacturer != nil {
+		return *x.Manufacturer
+	}
+	return ""
+}
+
+func (x *Device) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *Device) GetSerialNumber() string {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Device) GetProbeType() ProbeType {
+	if x != nil && x.ProbeType != nil {
+		return *x.ProbeType
+	}
+	return ProbeType_PROBE_TYPE_LINEAR
+}
+
+func (x *Device) GetPixelSpacing() *PixelSpacing {
+	if x != nil {
+		return x.PixelSpacing
+	}
+	return nil
+}
+
+type CreateDeviceIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Manufacturer  *string                `protobuf:"bytes,200,opt,name=manufacturer,proto3,oneof" json:"manufacturer,omitempty"`
+	Model         *string                `protobuf:"bytes,300,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	SerialNumber  *string                `protobuf:"bytes,400,opt,name=serial_number,json=serialNumber,proto3,oneof" json:"serial_number,omitempty"`
+	ProbeType     *ProbeType             `protobuf:"varint,500,opt,name=probe_type,json=probeType,proto3,enum=ProbeType,oneof" json:"probe_type,omitempty"`
+	PixelSpacing  *PixelSpacing          `protobuf:"bytes,600,opt,name=pixel_spacing,json=pixelSpacing,proto3" json:"pixel_spacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeviceIn) Reset() {
+	*x = CreateDeviceIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeviceIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeviceIn) ProtoMessage() {}
+
+func (x *CreateDeviceIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeviceIn.ProtoReflect.Descriptor instead.
+func (*CreateDeviceIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateDeviceIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDeviceIn) GetManufacturer() string {
+	if x != nil && x.Manufacturer != nil {
+		return *x.Manufacturer
+	}
+	return ""
+}
+
+func (x *CreateDeviceIn) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *CreateDeviceIn) GetSerialNumber() string {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return ""
+}
+
+func (x *CreateDeviceIn) GetProbeType() ProbeType {
+	if x != nil && x.ProbeType != nil {
+		return *x.ProbeType
+	}
+	return ProbeType_PROBE_TYPE_LINEAR
+}
+
+func (x *CreateDeviceIn) GetPixelSpacing() *PixelSpacing {
+	if x != nil {
+		return x.PixelSpacing
+	}
+	return nil
+}
+
+type CreateDeviceOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeviceOut) Reset() {
+	*x = CreateDeviceOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeviceOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeviceOut) ProtoMessage() {}
+
+func (x *CreateDeviceOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeviceOut.ProtoReflect.Descriptor instead.
+func (*CreateDeviceOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateDeviceOut) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetDeviceListOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*Device              `protobuf:"bytes,100,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceListOut) Reset() {
+	*x = GetDeviceListOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceListOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceListOut) ProtoMessage() {}
+
+func (x *GetDeviceListOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceListOut.ProtoReflect.Descriptor instead.
+func (*GetDeviceListOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDeviceListOut) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type GetDeviceByIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceByIdIn) Reset() {
+	*x = GetDeviceByIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceByIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceByIdIn) ProtoMessage() {}
+
+func (x *GetDeviceByIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceByIdIn.ProtoReflect.Descriptor instead.
+func (*GetDeviceByIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDeviceByIdIn) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetDeviceByIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,100,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceByIdOut) Reset() {
+	*x = GetDeviceByIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceByIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceByIdOut) ProtoMessage() {}
+
+func (x *GetDeviceByIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceByIdOut.ProtoReflect.Descriptor instead.
+func (*GetDeviceByIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDeviceByIdOut) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type UpdateDeviceIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,200,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Manufacturer  *string                `protobuf:"bytes,300,opt,name=manufacturer,proto3,oneof" json:"manufacturer,omitempty"`
+	Model         *string                `protobuf:"bytes,400,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	SerialNumber  *string                `protobuf:"bytes,500,opt,name=serial_number,json=serialNumber,proto3,oneof" json:"serial_number,omitempty"`
+	ProbeType     *ProbeType             `protobuf:"varint,600,opt,name=probe_type,json=probeType,proto3,enum=ProbeType,oneof" json:"probe_type,omitempty"`
+	PixelSpacing  *PixelSpacing          `protobuf:"bytes,700,opt,name=pixel_spacing,json=pixelSpacing,proto3" json:"pixel_spacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeviceIn) Reset() {
+	*x = UpdateDeviceIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeviceIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceIn) ProtoMessage() {}
+
+func (x *UpdateDeviceIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceIn.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateDeviceIn) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateDeviceIn) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDeviceIn) GetManufacturer() string {
+	if x != nil && x.Manufacturer != nil {
+		return *x.Manufacturer
+	}
+	return ""
+}
+
+func (x *UpdateDeviceIn) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *UpdateDeviceIn) GetSerialNumber() string {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return ""
+}
+
+func (x *UpdateDeviceIn) GetProbeType() ProbeType {
+	if x != nil && x.ProbeType != nil {
+		return *x.ProbeType
+	}
+	return ProbeType_PROBE_TYPE_LINEAR
+}
+
+func (x *UpdateDeviceIn) GetPixelSpacing() *PixelSpacing {
+	if x != nil {
+		return x.PixelSpacing
+	}
+	return nil
+}
+
+type UpdateDeviceOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,100,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeviceOut) Reset() {
+	*x = UpdateDeviceOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeviceOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceOut) ProtoMessage() {}
+
+func (x *UpdateDeviceOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceOut.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateDeviceOut) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type DeleteDeviceIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeviceIn) Reset() {
+	*x = DeleteDeviceIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeviceIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeviceIn) ProtoMessage() {}
+
+func (x *DeleteDeviceIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeviceIn.ProtoReflect.Descriptor instead.
+func (*DeleteDeviceIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteDeviceIn) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Uzi struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Projection  UziProjection          `protobuf:"varint,200,opt,name=projection,proto3,enum=UziProjection" json:"projection,omitempty"`
+	Checked     bool                   `protobuf:"varint,300,opt,name=checked,proto3" json:"checked,omitempty"`
+	ExternalId  string                 `protobuf:"bytes,400,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Author      string                 `protobuf:"bytes,500,opt,name=author,proto3" json:"author,omitempty"`
+	DeviceId    int64                  `protobuf:"varint,600,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Status      UziStatus              `protobuf:"varint,700,opt,name=status,proto3,enum=UziStatus" json:"status,omitempty"`
+	Description *string                `protobuf:"bytes,800,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CreateAt    string                 `protobuf:"bytes,1000,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	// размер пикселя, мм. Если не задан - измерения в пикселях
+	PixelSpacing *PixelSpacing `protobuf:"bytes,1100,opt,name=pixel_spacing,json=pixelSpacing,proto3" json:"pixel_spacing,omitempty"`
+	// sha256 исходного файла в hex. Не задан у узи, загруженных до подсчета
+	Sha256        *string `protobuf:"bytes,1200,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Uzi) Reset() {
+	*x = Uzi{}
+	mi := &file_proto_grpc_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Uzi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Uzi) ProtoMessage() {}
+
+func (x *Uzi) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Uzi.ProtoReflect.Descriptor instead.
+func (*Uzi) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Uzi) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Uzi) GetProjection() UziProjection {
+	if x != nil {
+		return x.Projection
+	}
+	return UziProjection_UZI_PROJECTION_LONG
+}
+
+func (x *Uzi) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *Uzi) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *Uzi) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Uzi) GetDeviceId() int64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *Uzi) GetStatus() UziStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UziStatus_UZI_STATUS_NEW
+}
+
+func (x *Uzi) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Uzi) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+func (x *Uzi) GetPixelSpacing() *PixelSpacing {
+	if x != nil {
+		return x.PixelSpacing
+	}
+	return nil
+}
+
+func (x *Uzi) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+// значение вне нормы для возраста и пола, границы нормы необязательны
+type EchographicFlag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// имя поля Echographic: gland_volum, isthmus
+	Field         string   `protobuf:"bytes,100,opt,name=field,proto3" json:"field,omitempty"`
+	Value         float64  `protobuf:"fixed64,200,opt,name=value,proto3" json:"value,omitempty"`
+	Min           *float64 `protobuf:"fixed64,300,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64 `protobuf:"fixed64,400,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EchographicFlag) Reset() {
+	*x = EchographicFlag{}
+	mi := &file_proto_grpc_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EchographicFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchographicFlag) ProtoMessage() {}
+
+func (x *EchographicFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchographicFlag.ProtoReflect.Descriptor instead.
+func (*EchographicFlag) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EchographicFlag) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *EchographicFlag) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *EchographicFlag) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *EchographicFlag) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// размеры в см, объемы в мл. Объемы долей считаются по размерам, объем железы - сумма долей
+type Echographic struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	Id              string                      `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Contors         *string                     `protobuf:"bytes,200,opt,name=contors,proto3,oneof" json:"contors,omitempty"`
+	LeftLobeLength  *float64                    `protobuf:"fixed64,300,opt,name=left_lobe_length,json=leftLobeLength,proto3,oneof" json:"left_lobe_length,omitempty"`
+	LeftLobeWidth   *float64                    `protobuf:"fixed64,400,opt,name=left_lobe_width,json=leftLobeWidth,proto3,oneof" json:"left_lobe_width,omitempty"`
+	LeftLobeThick   *float64                    `protobuf:"fixed64,500,opt,name=left_lobe_thick,json=leftLobeThick,proto3,oneof" json:"left_lobe_thick,omitempty"`
+	LeftLobeVolum   *float64                    `protobuf:"fixed64,600,opt,name=left_lobe_volum,json=leftLobeVolum,proto3,oneof" json:"left_lobe_volum,omitempty"`
+	RightLobeLength *float64                    `protobuf:"fixed64,700,opt,name=right_lobe_length,json=rightLobeLength,proto3,oneof" json:"right_lobe_length,omitempty"`
+	RightLobeWidth  *float64                    `protobuf:"fixed64,800,opt,name=right_lobe_width,json=rightLobeWidth,proto3,oneof" json:"right_lobe_width,omitempty"`
+	RightLobeThick  *float64                    `protobuf:"fixed64,900,opt,name=right_lobe_thick,json=rightLobeThick,proto3,oneof" json:"right_lobe_thick,omitempty"`
+	RightLobeVolum  *float64                    `protobuf:"fixed64,1000,opt,name=right_lobe_volum,json=rightLobeVolum,proto3,oneof" json:"right_lobe_volum,omitempty"`
+	GlandVolum      *float64                    `protobuf:"fixed64,1100,opt,name=gland_volum,json=glandVolum,proto3,oneof" json:"gland_volum,omitempty"`
+	Isthmus         *float64                    `protobuf:"fixed64,1200,opt,name=isthmus,proto3,oneof" json:"isthmus,omitempty"`
+	Struct          *EchographicStruct          `protobuf:"varint,1300,opt,name=struct,proto3,enum=EchographicStruct,oneof" json:"struct,omitempty"`
+	Echogenicity    *EchographicEchogenicity    `protobuf:"varint,1400,opt,name=echogenicity,proto3,enum=EchographicEchogenicity,oneof" json:"echogenicity,omitempty"`
+	RegionalLymph   *string                     `protobuf:"bytes,1500,opt,name=regional_lymph,json=regionalLymph,proto3,oneof" json:"regional_lymph,omitempty"`
+	Vascularization *EchographicVascularization `protobuf:"varint,1600,opt,name=vascularization,proto3,enum=EchographicVascularization,oneof" json:"vascularization,omitempty"`
+	Location        *string                     `protobuf:"bytes,1700,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Additional      *string                     `protobuf:"bytes,1800,opt,name=additional,proto3,oneof" json:"additional,omitempty"`
+	Conclusion      *string                     `protobuf:"bytes,1900,opt,name=conclusion,proto3,oneof" json:"conclusion,omitempty"`
+	PatientSex      *PatientSex                 `protobuf:"varint,2000,opt,name=patient_sex,json=patientSex,proto3,enum=PatientSex,oneof" json:"patient_sex,omitempty"`
+	// полных лет на дату узи
+	PatientAge *int64 `protobuf:"varint,2100,opt,name=patient_age,json=patientAge,proto3,oneof" json:"patient_age,omitempty"`
+	// только чтение
+	Flags []*EchographicFlag `protobuf:"bytes,2200,rep,name=flags,proto3" json:"flags,omitempty"`
+	// только чтение, заключение по шаблону
+	GeneratedConclusion string `protobuf:"bytes,2300,opt,name=generated_conclusion,json=generatedConclusion,proto3" json:"generated_conclusion,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Echographic) Reset() {
+	*x = Echographic{}
+	mi := &file_proto_grpc_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Echographic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Echographic) ProtoMessage() {}
+
+func (x *Echographic) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Echographic.ProtoReflect.Descriptor instead.
+func (*Echographic) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Echographic) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Echographic) GetContors() string {
+	if x != nil && x.Contors != nil {
+		return *x.Contors
+	}
+	return ""
+}
+
+func (x *Echographic) GetLeftLobeLength() float64 {
+	if x != nil && x.LeftLobeLength != nil {
+		return *x.LeftLobeLength
+	}
+	return 0
+}
+
+func (x *Echographic) GetLeftLobeWidth() float64 {
+	if x != nil && x.LeftLobeWidth != nil {
+		return *x.LeftLobeWidth
+	}
+	return 0
+}
+
+func (x *Echographic) GetLeftLobeThick() float64 {
+	if x != nil && x.LeftLobeThick != nil {
+		return *x.LeftLobeThick
+	}
+	return 0
+}
+
+func (x *Echographic) GetLeftLobeVolum() float64 {
+	if x != nil && x.LeftLobeVolum != nil {
+		return *x.LeftLobeVolum
+	}
+	return 0
+}
+
+func (x *Echographic) GetRightLobeLength() float64 {
+	if x != nil && x.RightLobeLength != nil {
+		return *x.RightLobeLength
+	}
+	return 0
+}
+
+func (x *Echographic) GetRightLobeWidth() float64 {
+	if x != nil && x.RightLobeWidth != nil {
+		return *x.RightLobeWidth
+	}
+	return 0
+}
+
+func (x *Echographic) GetRightLobeThick() float64 {
+	if x != nil && x.RightLobeThick != nil {
+		return *x.RightLobeThick
+	}
+	return 0
+}
+
+func (x *Echographic) GetRightLobeVolum() float64 {
+	if x != nil && x.RightLobeVolum != nil {
+		return *x.RightLobeVolum
+	}
+	return 0
+}
+
+func (x *Echographic) GetGlandVolum() float64 {
+	if x != nil && x.GlandVolum != nil {
+		return *x.GlandVolum
+	}
+	return 0
+}
+
+func (x *Echographic) GetIsthmus() float64 {
+	if x != nil && x.Isthmus != nil {
+		return *x.Isthmus
+	}
+	return 0
+}
+
+func (x *Echographic) GetStruct() EchographicStruct {
+	if x != nil && x.Struct != nil {
+		return *x.Struct
+	}
+	return EchographicStruct_ECHOGRAPHIC_STRUCT_HOMOGENEOUS
+}
+
+func (x *Echographic) GetEchogenicity() EchographicEchogenicity {
+	if x != nil && x.Echogenicity != nil {
+		return *x.Echogenicity
+	}
+	return EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_NORMAL
+}
+
+func (x *Echographic) GetRegionalLymph() string {
+	if x != nil && x.RegionalLymph != nil {
+		return *x.RegionalLymph
+	}
+	return ""
+}
+
+func (x *Echographic) GetVascularization() EchographicVascularization {
+	if x != nil && x.Vascularization != nil {
+		return *x.Vascularization
+	}
+	return EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_NORMAL
+}
+
+func (x *Echographic) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *Echographic) GetAdditional() string {
+	if x != nil && x.Additional != nil {
+		return *x.Additional
+	}
+	return ""
+}
+
+func (x *Echographic) GetConclusion() string {
+	if x != nil && x.Conclusion != nil {
+		return *x.Conclusion
+	}
+	return ""
+}
+
+func (x *Echographic) GetPatientSex() PatientSex {
+	if x != nil && x.PatientSex != nil {
+		return *x.PatientSex
+	}
+	return PatientSex_PATIENT_SEX_MALE
+}
+
+func (x *Echographic) GetPatientAge() int64 {
+	if x != nil && x.PatientAge != nil {
+		return *x.PatientAge
+	}
+	return 0
+}
+
+func (x *Echographic) GetFlags() []*EchographicFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Echographic) GetGeneratedConclusion() string {
+	if x != nil {
+		return x.GeneratedConclusion
+	}
+	return ""
+}
+
+type CreateUziIn struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Projection   UziProjection          `protobuf:"varint,100,opt,name=projection,proto3,enum=UziProjection" json:"projection,omitempty"`
+	ExternalId   string                 `protobuf:"bytes,200,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Author       string                 `protobuf:"bytes,300,opt,name=author,proto3" json:"author,omitempty"`
+	DeviceId     int64                  `protobuf:"varint,400,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Description  *string                `protobuf:"bytes,500,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	PixelSpacing *PixelSpacing          `protobuf:"bytes,600,opt,name=pixel_spacing,json=pixelSpacing,proto3" json:"pixel_spacing,omitempty"`
+	// sha256 исходного файла в hex, по нему ищутся повторные загрузки пациента
+	Sha256        *string `protobuf:"bytes,700,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUziIn) Reset() {
+	*x = CreateUziIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUziIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUziIn) ProtoMessage() {}
+
+func (x *CreateUziIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUziIn.ProtoReflect.Descriptor instead.
+func (*CreateUziIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateUziIn) GetProjection() UziProjection {
+	if x != nil {
+		return x.Projection
+	}
+	return UziProjection_UZI_PROJECTION_LONG
+}
+
+func (x *CreateUziIn) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *CreateUziIn) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CreateUziIn) GetDeviceId() int64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *CreateUziIn) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateUziIn) GetPixelSpacing() *PixelSpacing {
+	if x != nil {
+		return x.PixelSpacing
+	}
+	return nil
+}
+
+func (x *CreateUziIn) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+type CreateUziOut struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	// ранее загруженные узи пациента с тем же файлом
+	DuplicateOf   []string `protobuf:"bytes,200,rep,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUziOut) Reset() {
+	*x = CreateUziOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUziOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUziOut) ProtoMessage() {}
+
+func (x *CreateUziOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUziOut.ProtoReflect.Descriptor instead.
+func (*CreateUziOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateUziOut) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateUziOut) GetDuplicateOf() []string {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return nil
+}
+
+type GetUziByIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUziByIdIn) Reset() {
+	*x = GetUziByIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUziByIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUziByIdIn) ProtoMessage() {}
+
+func (x *GetUziByIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUziByIdIn.ProtoReflect.Descriptor instead.
+func (*GetUziByIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetUziByIdIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetUziByIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uzi           *Uzi                   `protobuf:"bytes,100,opt,name=uzi,proto3" json:"uzi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUziByIdOut) Reset() {
+	*x = GetUziByIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUziByIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUziByIdOut) ProtoMessage() {}
+
+func (x *GetUziByIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUziByIdOut.ProtoReflect.Descriptor instead.
+func (*GetUziByIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUziByIdOut) GetUzi() *Uzi {
+	if x != nil {
+		return x.Uzi
+	}
+	return nil
+}
+
+type GetUzisByExternalIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalId    string                 `protobuf:"bytes,100,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUzisByExternalIdIn) Reset() {
+	*x = GetUzisByExternalIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUzisByExternalIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUzisByExternalIdIn) ProtoMessage() {}
+
+func (x *GetUzisByExternalIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUzisByExternalIdIn.ProtoReflect.Descriptor instead.
+func (*GetUzisByExternalIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUzisByExternalIdIn) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type GetUzisByExternalIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uzis          []*Uzi                 `protobuf:"bytes,100,rep,name=uzis,proto3" json:"uzis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUzisByExternalIdOut) Reset() {
+	*x = GetUzisByExternalIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUzisByExternalIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUzisByExternalIdOut) ProtoMessage() {}
+
+func (x *GetUzisByExternalIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUzisByExternalIdOut.ProtoReflect.Descriptor instead.
+func (*GetUzisByExternalIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUzisByExternalIdOut) GetUzis() []*Uzi {
+	if x != nil {
+		return x.Uzis
+	}
+	return nil
+}
+
+type GetUzisByAuthorIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        string                 `protobuf:"bytes,100,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUzisByAuthorIn) Reset() {
+	*x = GetUzisByAuthorIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUzisByAuthorIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUzisByAuthorIn) ProtoMessage() {}
+
+func (x *GetUzisByAuthorIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUzisByAuthorIn.ProtoReflect.Descriptor instead.
+func (*GetUzisByAuthorIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUzisByAuthorIn) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type GetUzisByAuthorOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uzis          []*Uzi                 `protobuf:"bytes,100,rep,name=uzis,proto3" json:"uzis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUzisByAuthorOut) Reset() {
+	*x = GetUzisByAuthorOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUzisByAuthorOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUzisByAuthorOut) ProtoMessage() {}
+
+func (x *GetUzisByAuthorOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUzisByAuthorOut.ProtoReflect.Descriptor instead.
+func (*GetUzisByAuthorOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUzisByAuthorOut) GetUzis() []*Uzi {
+	if x != nil {
+		return x.Uzis
+	}
+	return nil
+}
+
+// все фильтры необязательны, сортировка по дате создания и id
+type SearchUzisIn struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Author     *string                `protobuf:"bytes,100,opt,name=author,proto3,oneof" json:"author,omitempty"`
+	ExternalId *string                `protobuf:"bytes,200,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	Status     *UziStatus             `protobuf:"varint,300,opt,name=status,proto3,enum=UziStatus,oneof" json:"status,omitempty"`
+	Projection *UziProjection         `protobuf:"varint,400,opt,name=projection,proto3,enum=UziProjection,oneof" json:"projection,omitempty"`
+	DeviceId   *int64                 `protobuf:"varint,500,opt,name=device_id,json=deviceId,proto3,oneof" json:"device_id,omitempty"`
+	Checked    *bool                  `protobuf:"varint,600,opt,name=checked,proto3,oneof" json:"checked,omitempty"`
+	// границы даты создания включительно, RFC3339
+	CreateFrom *string `protobuf:"bytes,700,opt,name=create_from,json=createFrom,proto3,oneof" json:"create_from,omitempty"`
+	CreateTo   *string `protobuf:"bytes,800,opt,name=create_to,json=createTo,proto3,oneof" json:"create_to,omitempty"`
+	// есть нейросетевой узел с вероятностями классов не ниже порогов
+	AiTirads_4Min *float64  `protobuf:"fixed64,900,opt,name=ai_tirads_4_min,json=aiTirads4Min,proto3,oneof" json:"ai_tirads_4_min,omitempty"`
+	AiTirads_5Min *float64  `protobuf:"fixed64,1000,opt,name=ai_tirads_5_min,json=aiTirads5Min,proto3,oneof" json:"ai_tirads_5_min,omitempty"`
+	Order         SortOrder `protobuf:"varint,1100,opt,name=order,proto3,enum=SortOrder" json:"order,omitempty"`
+	// next_cursor из предыдущей страницы
+	Cursor *string `protobuf:"bytes,1200,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// 0 - размер страницы по умолчанию
+	Limit         int64 `protobuf:"varint,1300,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUzisIn) Reset() {
+	*x = SearchUzisIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUzisIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUzisIn) ProtoMessage() {}
+
+func (x *SearchUzisIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUzisIn.ProtoReflect.Descriptor instead.
+func (*SearchUzisIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchUzisIn) GetAuthor() string {
+	if x != nil && x.Author != nil {
+		return *x.Author
+	}
+	return ""
+}
+
+func (x *SearchUzisIn) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *SearchUzisIn) GetStatus() UziStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return UziStatus_UZI_STATUS_NEW
+}
+
+func (x *SearchUzisIn) GetProjection() UziProjection {
+	if x != nil && x.Projection != nil {
+		return *x.Projection
+	}
+	return UziProjection_UZI_PROJECTION_LONG
+}
+
+func (x *SearchUzisIn) GetDeviceId() int64 {
+	if x != nil && x.DeviceId != nil {
+		return *x.DeviceId
+	}
+	return 0
+}
+
+func (x *SearchUzisIn) GetChecked() bool {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return false
+}
+
+func (x *SearchUzisIn) GetCreateFrom() string {
+	if x != nil && x.CreateFrom != nil {
+		return *x.CreateFrom
+	}
+	return ""
+}
+
+func (x *SearchUzisIn) GetCreateTo() string {
+	if x != nil && x.CreateTo != nil {
+		return *x.CreateTo
+	}
+	return ""
+}
+
+func (x *SearchUzisIn) GetAiTirads_4Min() float64 {
+	if x != nil && x.AiTirads_4Min != nil {
+		return *x.AiTirads_4Min
+	}
+	return 0
+}
+
+func (x *SearchUzisIn) GetAiTirads_5Min() float64 {
+	if x != nil && x.AiTirads_5Min != nil {
+		return *x.AiTirads_5Min
+	}
+	return 0
+}
+
+func (x *SearchUzisIn) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_DESC
+}
+
+func (x *SearchUzisIn) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchUzisIn) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchUzisOut struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uzis  []*Uzi                 `protobuf:"bytes,100,rep,name=uzis,proto3" json:"uzis,omitempty"`
+	// отсутствует, если страница последняя
+	NextCursor    *string `protobuf:"bytes,200,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUzisOut) Reset() {
+	*x = SearchUzisOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUzisOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUzisOut) ProtoMessage() {}
+
+func (x *SearchUzisOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUzisOut.ProtoReflect.Descriptor instead.
+func (*SearchUzisOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchUzisOut) GetUzis() []*Uzi {
+	if x != nil {
+		return x.Uzis
+	}
+	return nil
+}
+
+func (x *SearchUzisOut) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type GetEchographicByUziIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEchographicByUziIdIn) Reset() {
+	*x = GetEchographicByUziIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEchographicByUziIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEchographicByUziIdIn) ProtoMessage() {}
+
+func (x *GetEchographicByUziIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEchographicByUziIdIn.ProtoReflect.Descriptor instead.
+func (*GetEchographicByUziIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetEchographicByUziIdIn) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+type GetEchographicByUziIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Echographic   *Echographic           `protobuf:"bytes,100,opt,name=echographic,proto3" json:"echographic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEchographicByUziIdOut) Reset() {
+	*x = GetEchographicByUziIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEchographicByUziIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEchographicByUziIdOut) ProtoMessage() {}
+
+func (x *GetEchographicByUziIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEchographicByUziIdOut.ProtoReflect.Descriptor instead.
+func (*GetEchographicByUziIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetEchographicByUziIdOut) GetEchographic() *Echographic {
+	if x != nil {
+		return x.Echographic
+	}
+	return nil
+}
+
+type UpdateUziIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Projection    *UziProjection         `protobuf:"varint,200,opt,name=projection,proto3,enum=UziProjection,oneof" json:"projection,omitempty"`
+	Checked       *bool                  `protobuf:"varint,300,opt,name=checked,proto3,oneof" json:"checked,omitempty"`
+	PixelSpacing  *PixelSpacing          `protobuf:"bytes,400,opt,name=pixel_spacing,json=pixelSpacing,proto3" json:"pixel_spacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUziIn) Reset() {
+	*x = UpdateUziIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUziIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUziIn) ProtoMessage() {}
+
+func (x *UpdateUziIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUziIn.ProtoReflect.Descriptor instead.
+func (*UpdateUziIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateUziIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateUziIn) GetProjection() UziProjection {
+	if x != nil && x.Projection != nil {
+		return *x.Projection
+	}
+	return UziProjection_UZI_PROJECTION_LONG
+}
+
+func (x *UpdateUziIn) GetChecked() bool {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return false
+}
+
+func (x *UpdateUziIn) GetPixelSpacing() *PixelSpacing {
+	if x != nil {
+		return x.PixelSpacing
+	}
+	return nil
+}
+
+type UpdateUziOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uzi           *Uzi                   `protobuf:"bytes,100,opt,name=uzi,proto3" json:"uzi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUziOut) Reset() {
+	*x = UpdateUziOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUziOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUziOut) ProtoMessage() {}
+
+func (x *UpdateUziOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUziOut.ProtoReflect.Descriptor instead.
+func (*UpdateUziOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateUziOut) GetUzi() *Uzi {
+	if x != nil {
+		return x.Uzi
+	}
+	return nil
+}
+
+type UpdateEchographicIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Echographic   *Echographic           `protobuf:"bytes,100,opt,name=echographic,proto3" json:"echographic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEchographicIn) Reset() {
+	*x = UpdateEchographicIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEchographicIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEchographicIn) ProtoMessage() {}
+
+func (x *UpdateEchographicIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEchographicIn.ProtoReflect.Descriptor instead.
+func (*UpdateEchographicIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateEchographicIn) GetEchographic() *Echographic {
+	if x != nil {
+		return x.Echographic
+	}
+	return nil
+}
+
+type UpdateEchographicOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Echographic   *Echographic           `protobuf:"bytes,100,opt,name=echographic,proto3" json:"echographic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEchographicOut) Reset() {
+	*x = UpdateEchographicOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEchographicOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEchographicOut) ProtoMessage() {}
+
+func (x *UpdateEchographicOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEchographicOut.ProtoReflect.Descriptor instead.
+func (*UpdateEchographicOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateEchographicOut) GetEchographic() *Echographic {
+	if x != nil {
+		return x.Echographic
+	}
+	return nil
+}
+
+type DeleteUziIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUziIn) Reset() {
+	*x = DeleteUziIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUziIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUziIn) ProtoMessage() {}
+
+func (x *DeleteUziIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUziIn.ProtoReflect.Descriptor instead.
+func (*DeleteUziIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteUziIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// файл кадра определенного размера в S3
+type ImageVariant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          ImageSize              `protobuf:"varint,100,opt,name=size,proto3,enum=ImageSize" json:"size,omitempty"`
+	Path          string                 `protobuf:"bytes,200,opt,name=path,proto3" json:"path,omitempty"`
+	ContentType   string                 `protobuf:"bytes,300,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Width         int64                  `protobuf:"varint,400,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int64                  `protobuf:"varint,500,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageVariant) Reset() {
+	*x = ImageVariant{}
+	mi := &file_proto_grpc_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageVariant) ProtoMessage() {}
+
+func (x *ImageVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
+func (*ImageVariant) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImageVariant) GetSize() ImageSize {
+	if x != nil {
+		return x.Size
+	}
+	return ImageSize_IMAGE_SIZE_ORIGINAL
+}
+
+func (x *ImageVariant) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type Image struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	UziId string                 `protobuf:"bytes,200,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	Page  int64                  `protobuf:"varint,300,opt,name=page,proto3" json:"page,omitempty"`
+	// размер исходного кадра, 0 у кадров, разбитых до появления превью
+	Width  int64 `protobuf:"varint,400,opt,name=width,proto3" json:"width,omitempty"`
+	Height int64 `protobuf:"varint,500,opt,name=height,proto3" json:"height,omitempty"`
+	// исходный кадр всегда первый, превью и миниатюра - если созданы
+	Variants      []*ImageVariant `protobuf:"bytes,600,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_proto_grpc_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Image) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Image) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+func (x *Image) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Image) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Image) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Image) GetVariants() []*ImageVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type GetImagesByUziIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImagesByUziIdIn) Reset() {
+	*x = GetImagesByUziIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImagesByUziIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImagesByUziIdIn) ProtoMessage() {}
+
+func (x *GetImagesByUziIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImagesByUziIdIn.ProtoReflect.Descriptor instead.
+func (*GetImagesByUziIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetImagesByUziIdIn) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+type GetImagesByUziIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*Image               `protobuf:"bytes,100,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImagesByUziIdOut) Reset() {
+	*x = GetImagesByUziIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImagesByUziIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImagesByUziIdOut) ProtoMessage() {}
+
+func (x *GetImagesByUziIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImagesByUziIdOut.ProtoReflect.Descriptor instead.
+func (*GetImagesByUziIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetImagesByUziIdOut) GetImages() []*Image {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type PixelSpacing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,100,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,200,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PixelSpacing) Reset() {
+	*x = PixelSpacing{}
+	mi := &file_proto_grpc_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PixelSpacing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PixelSpacing) ProtoMessage() {}
+
+func (x *PixelSpacing) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PixelSpacing.ProtoReflect.Descriptor instead.
+func (*PixelSpacing) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PixelSpacing) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PixelSpacing) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// в пикселях изображения
+type BoundingBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int64                  `protobuf:"varint,100,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int64                  `protobuf:"varint,200,opt,name=y,proto3" json:"y,omitempty"`
+	Width         int64                  `protobuf:"varint,300,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int64                  `protobuf:"varint,400,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	mi := &file_proto_grpc_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BoundingBox) GetX() int64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetY() int64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type SegmentMeasurement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bbox          *BoundingBox           `protobuf:"bytes,100,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	Area          float64                `protobuf:"fixed64,200,opt,name=area,proto3" json:"area,omitempty"`
+	Perimeter     float64                `protobuf:"fixed64,300,opt,name=perimeter,proto3" json:"perimeter,omitempty"`
+	MajorAxis     float64                `protobuf:"fixed64,400,opt,name=major_axis,json=majorAxis,proto3" json:"major_axis,omitempty"`
+	MinorAxis     float64                `protobuf:"fixed64,500,opt,name=minor_axis,json=minorAxis,proto3" json:"minor_axis,omitempty"`
+	Unit          MeasureUnit            `protobuf:"varint,600,opt,name=unit,proto3,enum=MeasureUnit" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentMeasurement) Reset() {
+	*x = SegmentMeasurement{}
+	mi := &file_proto_grpc_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentMeasurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentMeasurement) ProtoMessage() {}
+
+func (x *SegmentMeasurement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentMeasurement.ProtoReflect.Descriptor instead.
+func (*SegmentMeasurement) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SegmentMeasurement) GetBbox() *BoundingBox {
+	if x != nil {
+		return x.Bbox
+	}
+	return nil
+}
+
+func (x *SegmentMeasurement) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *SegmentMeasurement) GetPerimeter() float64 {
+	if x != nil {
+		return x.Perimeter
+	}
+	return 0
+}
+
+func (x *SegmentMeasurement) GetMajorAxis() float64 {
+	if x != nil {
+		return x.MajorAxis
+	}
+	return 0
+}
+
+func (x *SegmentMeasurement) GetMinorAxis() float64 {
+	if x != nil {
+		return x.MinorAxis
+	}
+	return 0
+}
+
+func (x *SegmentMeasurement) GetUnit() MeasureUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return MeasureUnit_MEASURE_UNIT_PX
+}
+
+type NodeMeasurement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Area          float64                `protobuf:"fixed64,100,opt,name=area,proto3" json:"area,omitempty"`
+	Perimeter     float64                `protobuf:"fixed64,200,opt,name=perimeter,proto3" json:"perimeter,omitempty"`
+	MajorAxis     float64                `protobuf:"fixed64,300,opt,name=major_axis,json=majorAxis,proto3" json:"major_axis,omitempty"`
+	MinorAxis     float64                `protobuf:"fixed64,400,opt,name=minor_axis,json=minorAxis,proto3" json:"minor_axis,omitempty"`
+	Volume        float64                `protobuf:"fixed64,500,opt,name=volume,proto3" json:"volume,omitempty"`
+	Unit          MeasureUnit            `protobuf:"varint,600,opt,name=unit,proto3,enum=MeasureUnit" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeMeasurement) Reset() {
+	*x = NodeMeasurement{}
+	mi := &file_proto_grpc_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeMeasurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeMeasurement) ProtoMessage() {}
+
+func (x *NodeMeasurement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeMeasurement.ProtoReflect.Descriptor instead.
+func (*NodeMeasurement) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *NodeMeasurement) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *NodeMeasurement) GetPerimeter() float64 {
+	if x != nil {
+		return x.Perimeter
+	}
+	return 0
+}
+
+func (x *NodeMeasurement) GetMajorAxis() float64 {
+	if x != nil {
+		return x.MajorAxis
+	}
+	return 0
+}
+
+func (x *NodeMeasurement) GetMinorAxis() float64 {
+	if x != nil {
+		return x.MinorAxis
+	}
+	return 0
+}
+
+func (x *NodeMeasurement) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *NodeMeasurement) GetUnit() MeasureUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return MeasureUnit_MEASURE_UNIT_PX
+}
+
+type Node struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Ai            bool                   `protobuf:"varint,200,opt,name=ai,proto3" json:"ai,omitempty"`
+	Validation    *NodeValidation        `protobuf:"varint,300,opt,name=validation,proto3,enum=NodeValidation,oneof" json:"validation,omitempty"`
+	UziId         string                 `protobuf:"bytes,400,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	Tirads_23     float64                `protobuf:"fixed64,500,opt,name=tirads_23,json=tirads23,proto3" json:"tirads_23,omitempty"`
+	Tirads_4      float64                `protobuf:"fixed64,600,opt,name=tirads_4,json=tirads4,proto3" json:"tirads_4,omitempty"`
+	Tirads_5      float64                `protobuf:"fixed64,700,opt,name=tirads_5,json=tirads5,proto3" json:"tirads_5,omitempty"`
+	Description   *string                `protobuf:"bytes,800,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Measurement   *NodeMeasurement       `protobuf:"bytes,900,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	Lobe          *NodeLobe              `protobuf:"varint,1000,opt,name=lobe,proto3,enum=NodeLobe,oneof" json:"lobe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_proto_grpc_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Node) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Node) GetAi() bool {
+	if x != nil {
+		return x.Ai
+	}
+	return false
+}
+
+func (x *Node) GetValidation() NodeValidation {
+	if x != nil && x.Validation != nil {
+		return *x.Validation
+	}
+	return NodeValidation_NODE_VALIDATION_NULL
+}
+
+func (x *Node) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+func (x *Node) GetTirads_23() float64 {
+	if x != nil {
+		return x.Tirads_23
+	}
+	return 0
+}
+
+func (x *Node) GetTirads_4() float64 {
+	if x != nil {
+		return x.Tirads_4
+	}
+	return 0
+}
+
+func (x *Node) GetTirads_5() float64 {
+	if x != nil {
+		return x.Tirads_5
+	}
+	return 0
+}
+
+func (x *Node) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Node) GetMeasurement() *NodeMeasurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+func (x *Node) GetLobe() NodeLobe {
+	if x != nil && x.Lobe != nil {
+		return *x.Lobe
+	}
+	return NodeLobe_NODE_LOBE_RIGHT
+}
+
+type GetNodesByUziIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodesByUziIdIn) Reset() {
+	*x = GetNodesByUziIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodesByUziIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesByUziIdIn) ProtoMessage() {}
+
+func (x *GetNodesByUziIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesByUziIdIn.ProtoReflect.Descriptor instead.
+func (*GetNodesByUziIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetNodesByUziIdIn) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+type GetNodesByUziIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*Node                `protobuf:"bytes,100,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodesByUziIdOut) Reset() {
+	*x = GetNodesByUziIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodesByUziIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesByUziIdOut) ProtoMessage() {}
+
+func (x *GetNodesByUziIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesByUziIdOut.ProtoReflect.Descriptor instead.
+func (*GetNodesByUziIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetNodesByUziIdOut) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type UpdateNodeIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Validation    *NodeValidation        `protobuf:"varint,200,opt,name=validation,proto3,enum=NodeValidation,oneof" json:"validation,omitempty"`
+	Tirads_23     *float64               `protobuf:"fixed64,300,opt,name=tirads_23,json=tirads23,proto3,oneof" json:"tirads_23,omitempty"`
+	Tirads_4      *float64               `protobuf:"fixed64,400,opt,name=tirads_4,json=tirads4,proto3,oneof" json:"tirads_4,omitempty"`
+	Tirads_5      *float64               `protobuf:"fixed64,500,opt,name=tirads_5,json=tirads5,proto3,oneof" json:"tirads_5,omitempty"`
+	Lobe          *NodeLobe              `protobuf:"varint,600,opt,name=lobe,proto3,enum=NodeLobe,oneof" json:"lobe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNodeIn) Reset() {
+	*x = UpdateNodeIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNodeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodeIn) ProtoMessage() {}
+
+func (x *UpdateNodeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNodeIn.ProtoReflect.Descriptor instead.
+func (*UpdateNodeIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateNodeIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateNodeIn) GetValidation() NodeValidation {
+	if x != nil && x.Validation != nil {
+		return *x.Validation
+	}
+	return NodeValidation_NODE_VALIDATION_NULL
+}
+
+func (x *UpdateNodeIn) GetTirads_23() float64 {
+	if x != nil && x.Tirads_23 != nil {
+		return *x.Tirads_23
+	}
+	return 0
+}
+
+func (x *UpdateNodeIn) GetTirads_4() float64 {
+	if x != nil && x.Tirads_4 != nil {
+		return *x.Tirads_4
+	}
+	return 0
+}
+
+func (x *UpdateNodeIn) GetTirads_5() float64 {
+	if x != nil && x.Tirads_5 != nil {
+		return *x.Tirads_5
+	}
+	return 0
+}
+
+func (x *UpdateNodeIn) GetLobe() NodeLobe {
+	if x != nil && x.Lobe != nil {
+		return *x.Lobe
+	}
+	return NodeLobe_NODE_LOBE_RIGHT
+}
+
+type UpdateNodeOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *Node                  `protobuf:"bytes,100,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNodeOut) Reset() {
+	*x = UpdateNodeOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNodeOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodeOut) ProtoMessage() {}
+
+func (x *UpdateNodeOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNodeOut.ProtoReflect.Descriptor instead.
+func (*UpdateNodeOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateNodeOut) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type NodeReviewItem struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Status NodeReviewStatus       `protobuf:"varint,200,opt,name=status,proto3,enum=NodeReviewStatus" json:"status,omitempty"`
+	// отсутствует, если узел не найден
+	Node          *Node `protobuf:"bytes,300,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeReviewItem) Reset() {
+	*x = NodeReviewItem{}
+	mi := &file_proto_grpc_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeReviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeReviewItem) ProtoMessage() {}
+
+func (x *NodeReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeReviewItem.ProtoReflect.Descriptor instead.
+func (*NodeReviewItem) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NodeReviewItem) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeReviewItem) GetStatus() NodeReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NodeReviewStatus_NODE_REVIEW_STATUS_APPLIED
+}
+
+func (x *NodeReviewItem) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type ReviewNodesIn struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	NodeIds []string               `protobuf:"bytes,100,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	// valid или invalid
+	Validation    NodeValidation `protobuf:"varint,200,opt,name=validation,proto3,enum=NodeValidation" json:"validation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewNodesIn) Reset() {
+	*x = ReviewNodesIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewNodesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewNodesIn) ProtoMessage() {}
+
+func (x *ReviewNodesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewNodesIn.ProtoReflect.Descriptor instead.
+func (*ReviewNodesIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReviewNodesIn) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *ReviewNodesIn) GetValidation() NodeValidation {
+	if x != nil {
+		return x.Validation
+	}
+	return NodeValidation_NODE_VALIDATION_NULL
+}
+
+type ReviewNodesOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NodeReviewItem      `protobuf:"bytes,100,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewNodesOut) Reset() {
+	*x = ReviewNodesOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewNodesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewNodesOut) ProtoMessage() {}
+
+func (x *ReviewNodesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewNodesOut.ProtoReflect.Descriptor instead.
+func (*ReviewNodesOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReviewNodesOut) GetItems() []*NodeReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CheckUziIn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	UziId string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	// проставляется узлам нейросети без решения врача, если не задано - валидация не меняется
+	Unvalidated   *NodeValidation `protobuf:"varint,200,opt,name=unvalidated,proto3,enum=NodeValidation,oneof" json:"unvalidated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUziIn) Reset() {
+	*x = CheckUziIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUziIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUziIn) ProtoMessage() {}
+
+func (x *CheckUziIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUziIn.ProtoReflect.Descriptor instead.
+func (*CheckUziIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CheckUziIn) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+func (x *CheckUziIn) GetUnvalidated() NodeValidation {
+	if x != nil && x.Unvalidated != nil {
+		return *x.Unvalidated
+	}
+	return NodeValidation_NODE_VALIDATION_NULL
+}
+
+type CheckUziOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uzi           *Uzi                   `protobuf:"bytes,100,opt,name=uzi,proto3" json:"uzi,omitempty"`
+	Items         []*NodeReviewItem      `protobuf:"bytes,200,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUziOut) Reset() {
+	*x = CheckUziOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUziOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUziOut) ProtoMessage() {}
+
+func (x *CheckUziOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUziOut.ProtoReflect.Descriptor instead.
+func (*CheckUziOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CheckUziOut) GetUzi() *Uzi {
+	if x != nil {
+		return x.Uzi
+	}
+	return nil
+}
+
+func (x *CheckUziOut) GetItems() []*NodeReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// принимаются узлы нейросети без решения врача, у которых вероятность
+// наиболее вероятного класса TI-RADS не ниже threshold
+type AcceptAiNodesIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,200,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptAiNodesIn) Reset() {
+	*x = AcceptAiNodesIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptAiNodesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptAiNodesIn) ProtoMessage() {}
+
+func (x *AcceptAiNodesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptAiNodesIn.ProtoReflect.Descriptor instead.
+func (*AcceptAiNodesIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AcceptAiNodesIn) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+func (x *AcceptAiNodesIn) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type AcceptAiNodesOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NodeReviewItem      `protobuf:"bytes,100,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptAiNodesOut) Reset() {
+	*x = AcceptAiNodesOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptAiNodesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptAiNodesOut) ProtoMessage() {}
+
+func (x *AcceptAiNodesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptAiNodesOut.ProtoReflect.Descriptor instead.
+func (*AcceptAiNodesOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AcceptAiNodesOut) GetItems() []*NodeReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Segment struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	ImageId string                 `protobuf:"bytes,200,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	NodeId  string                 `protobuf:"bytes,300,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// GeoJSON Polygon версии 1 в пикселях кадра. На запись принимается и старый массив точек
+	// [{"x": 1, "y": 2}, ...], контур приводится к версии 1 и обрезается по кадру
+	Contor        []byte              `protobuf:"bytes,400,opt,name=contor,proto3" json:"contor,omitempty"`
+	Ai            bool                `protobuf:"varint,500,opt,name=ai,proto3" json:"ai,omitempty"`
+	Tirads_23     float64             `protobuf:"fixed64,600,opt,name=tirads_23,json=tirads23,proto3" json:"tirads_23,omitempty"`
+	Tirads_4      float64             `protobuf:"fixed64,700,opt,name=tirads_4,json=tirads4,proto3" json:"tirads_4,omitempty"`
+	Tirads_5      float64             `protobuf:"fixed64,800,opt,name=tirads_5,json=tirads5,proto3" json:"tirads_5,omitempty"`
+	Measurement   *SegmentMeasurement `protobuf:"bytes,900,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Segment) Reset() {
+	*x = Segment{}
+	mi := &file_proto_grpc_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Segment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Segment) ProtoMessage() {}
+
+func (x *Segment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Segment.ProtoReflect.Descriptor instead.
+func (*Segment) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Segment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Segment) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *Segment) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *Segment) GetContor() []byte {
+	if x != nil {
+		return x.Contor
+	}
+	return nil
+}
+
+func (x *Segment) GetAi() bool {
+	if x != nil {
+		return x.Ai
+	}
+	return false
+}
+
+func (x *Segment) GetTirads_23() float64 {
+	if x != nil {
+		return x.Tirads_23
+	}
+	return 0
+}
+
+func (x *Segment) GetTirads_4() float64 {
+	if x != nil {
+		return x.Tirads_4
+	}
+	return 0
+}
+
+func (x *Segment) GetTirads_5() float64 {
+	if x != nil {
+		return x.Tirads_5
+	}
+	return 0
+}
+
+func (x *Segment) GetMeasurement() *SegmentMeasurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+type CreateSegmentIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageId       string                 `protobuf:"bytes,100,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,200,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Contor        []byte                 `protobuf:"bytes,300,opt,name=contor,proto3" json:"contor,omitempty"`
+	Tirads_23     float64                `protobuf:"fixed64,400,opt,name=tirads_23,json=tirads23,proto3" json:"tirads_23,omitempty"`
+	Tirads_4      float64                `protobuf:"fixed64,500,opt,name=tirads_4,json=tirads4,proto3" json:"tirads_4,omitempty"`
+	Tirads_5      float64                `protobuf:"fixed64,600,opt,name=tirads_5,json=tirads5,proto3" json:"tirads_5,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSegmentIn) Reset() {
+	*x = CreateSegmentIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSegmentIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSegmentIn) ProtoMessage() {}
+
+func (x *CreateSegmentIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSegmentIn.ProtoReflect.Descriptor instead.
+func (*CreateSegmentIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateSegmentIn) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *CreateSegmentIn) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CreateSegmentIn) GetContor() []byte {
+	if x != nil {
+		return x.Contor
+	}
+	return nil
+}
+
+func (x *CreateSegmentIn) GetTirads_23() float64 {
+	if x != nil {
+		return x.Tirads_23
+	}
+	return 0
+}
+
+func (x *CreateSegmentIn) GetTirads_4() float64 {
+	if x != nil {
+		return x.Tirads_4
+	}
+	return 0
+}
+
+func (x *CreateSegmentIn) GetTirads_5() float64 {
+	if x != nil {
+		return x.Tirads_5
+	}
+	return 0
+}
+
+type CreateSegmentOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSegmentOut) Reset() {
+	*x = CreateSegmentOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSegmentOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSegmentOut) ProtoMessage() {}
+
+func (x *CreateSegmentOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSegmentOut.ProtoReflect.Descriptor instead.
+func (*CreateSegmentOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateSegmentOut) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSegmentsByNodeIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentsByNodeIdIn) Reset() {
+	*x = GetSegmentsByNodeIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentsByNodeIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentsByNodeIdIn) ProtoMessage() {}
+
+func (x *GetSegmentsByNodeIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentsByNodeIdIn.ProtoReflect.Descriptor instead.
+func (*GetSegmentsByNodeIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetSegmentsByNodeIdIn) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type GetSegmentsByNodeIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segments      []*Segment             `protobuf:"bytes,100,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentsByNodeIdOut) Reset() {
+	*x = GetSegmentsByNodeIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentsByNodeIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentsByNodeIdOut) ProtoMessage() {}
+
+func (x *GetSegmentsByNodeIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentsByNodeIdOut.ProtoReflect.Descriptor instead.
+func (*GetSegmentsByNodeIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetSegmentsByNodeIdOut) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type UpdateSegmentIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Contor        []byte                 `protobuf:"bytes,200,opt,name=contor,proto3,oneof" json:"contor,omitempty"`
+	Tirads_23     *float64               `protobuf:"fixed64,300,opt,name=tirads_23,json=tirads23,proto3,oneof" json:"tirads_23,omitempty"`
+	Tirads_4      *float64               `protobuf:"fixed64,400,opt,name=tirads_4,json=tirads4,proto3,oneof" json:"tirads_4,omitempty"`
+	Tirads_5      *float64               `protobuf:"fixed64,500,opt,name=tirads_5,json=tirads5,proto3,oneof" json:"tirads_5,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSegmentIn) Reset() {
+	*x = UpdateSegmentIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSegmentIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSegmentIn) ProtoMessage() {}
+
+func (x *UpdateSegmentIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSegmentIn.ProtoReflect.Descriptor instead.
+func (*UpdateSegmentIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateSegmentIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSegmentIn) GetContor() []byte {
+	if x != nil {
+		return x.Contor
+	}
+	return nil
+}
+
+func (x *UpdateSegmentIn) GetTirads_23() float64 {
+	if x != nil && x.Tirads_23 != nil {
+		return *x.Tirads_23
+	}
+	return 0
+}
+
+func (x *UpdateSegmentIn) GetTirads_4() float64 {
+	if x != nil && x.Tirads_4 != nil {
+		return *x.Tirads_4
+	}
+	return 0
+}
+
+func (x *UpdateSegmentIn) GetTirads_5() float64 {
+	if x != nil && x.Tirads_5 != nil {
+		return *x.Tirads_5
+	}
+	return 0
+}
+
+type UpdateSegmentOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segment       *Segment               `protobuf:"bytes,100,opt,name=segment,proto3" json:"segment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSegmentOut) Reset() {
+	*x = UpdateSegmentOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSegmentOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSegmentOut) ProtoMessage() {}
+
+func (x *UpdateSegmentOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSegmentOut.ProtoReflect.Descriptor instead.
+func (*UpdateSegmentOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateSegmentOut) GetSegment() *Segment {
+	if x != nil {
+		return x.Segment
+	}
+	return nil
+}
+
+type CreateNodeWithSegmentsIn struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	UziId         string                              `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	Node          *CreateNodeWithSegmentsIn_Node      `protobuf:"bytes,200,opt,name=node,proto3" json:"node,omitempty"`
+	Segments      []*CreateNodeWithSegmentsIn_Segment `protobuf:"bytes,300,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNodeWithSegmentsIn) Reset() {
+	*x = CreateNodeWithSegmentsIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNodeWithSegmentsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNodeWithSegmentsIn) ProtoMessage() {}
+
+func (x *CreateNodeWithSegmentsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNodeWithSegmentsIn.ProtoReflect.Descriptor instead.
+func (*CreateNodeWithSegmentsIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateNodeWithSegmentsIn) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+func (x *CreateNodeWithSegmentsIn) GetNode() *CreateNodeWithSegmentsIn_Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *CreateNodeWithSegmentsIn) GetSegments() []*CreateNodeWithSegmentsIn_Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type CreateNodeWithSegmentsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SegmentIds    []string               `protobuf:"bytes,200,rep,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNodeWithSegmentsOut) Reset() {
+	*x = CreateNodeWithSegmentsOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNodeWithSegmentsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNodeWithSegmentsOut) ProtoMessage() {}
+
+func (x *CreateNodeWithSegmentsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNodeWithSegmentsOut.ProtoReflect.Descriptor instead.
+func (*CreateNodeWithSegmentsOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateNodeWithSegmentsOut) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CreateNodeWithSegmentsOut) GetSegmentIds() []string {
+	if x != nil {
+		return x.SegmentIds
+	}
+	return nil
+}
+
+type GetNodesWithSegmentsByImageIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodesWithSegmentsByImageIdIn) Reset() {
+	*x = GetNodesWithSegmentsByImageIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodesWithSegmentsByImageIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesWithSegmentsByImageIdIn) ProtoMessage() {}
+
+func (x *GetNodesWithSegmentsByImageIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesWithSegmentsByImageIdIn.ProtoReflect.Descriptor instead.
+func (*GetNodesWithSegmentsByImageIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetNodesWithSegmentsByImageIdIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetNodesWithSegmentsByImageIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*Node                `protobuf:"bytes,100,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Segments      []*Segment             `protobuf:"bytes,200,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodesWithSegmentsByImageIdOut) Reset() {
+	*x = GetNodesWithSegmentsByImageIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodesWithSegmentsByImageIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesWithSegmentsByImageIdOut) ProtoMessage() {}
+
+func (x *GetNodesWithSegmentsByImageIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesWithSegmentsByImageIdOut.ProtoReflect.Descriptor instead.
+func (*GetNodesWithSegmentsByImageIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetNodesWithSegmentsByImageIdOut) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetNodesWithSegmentsByImageIdOut) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type DeleteNodeIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNodeIn) Reset() {
+	*x = DeleteNodeIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNodeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNodeIn) ProtoMessage() {}
+
+func (x *DeleteNodeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNodeIn.ProtoReflect.Descriptor instead.
+func (*DeleteNodeIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteNodeIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSegmentIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSegmentIn) Reset() {
+	*x = DeleteSegmentIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSegmentIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSegmentIn) ProtoMessage() {}
+
+func (x *DeleteSegmentIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSegmentIn.ProtoReflect.Descriptor instead.
+func (*DeleteSegmentIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteSegmentIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RecalculateMeasurementsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalculateMeasurementsIn) Reset() {
+	*x = RecalculateMeasurementsIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateMeasurementsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateMeasurementsIn) ProtoMessage() {}
+
+func (x *RecalculateMeasurementsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateMeasurementsIn.ProtoReflect.Descriptor instead.
+func (*RecalculateMeasurementsIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RecalculateMeasurementsIn) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+type RecalculateMeasurementsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*Node                `protobuf:"bytes,100,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Segments      []*Segment             `protobuf:"bytes,200,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalculateMeasurementsOut) Reset() {
+	*x = RecalculateMeasurementsOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateMeasurementsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateMeasurementsOut) ProtoMessage() {}
+
+func (x *RecalculateMeasurementsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateMeasurementsOut.ProtoReflect.Descriptor instead.
+func (*RecalculateMeasurementsOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RecalculateMeasurementsOut) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *RecalculateMeasurementsOut) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// узлы сливаются в первый из node_ids
+type MergeNodesIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeIds       []string               `protobuf:"bytes,100,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeNodesIn) Reset() {
+	*x = MergeNodesIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeNodesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeNodesIn) ProtoMessage() {}
+
+func (x *MergeNodesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeNodesIn.ProtoReflect.Descriptor instead.
+func (*MergeNodesIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MergeNodesIn) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+type MergeNodesOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *Node                  `protobuf:"bytes,100,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeNodesOut) Reset() {
+	*x = MergeNodesOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeNodesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeNodesOut) ProtoMessage() {}
+
+func (x *MergeNodesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeNodesOut.ProtoReflect.Descriptor instead.
+func (*MergeNodesOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MergeNodesOut) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type SplitNodeIn struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// сегменты, которые уходят в новый узел
+	SegmentIds    []string `protobuf:"bytes,200,rep,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitNodeIn) Reset() {
+	*x = SplitNodeIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitNodeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitNodeIn) ProtoMessage() {}
+
+func (x *SplitNodeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitNodeIn.ProtoReflect.Descriptor instead.
+func (*SplitNodeIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SplitNodeIn) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SplitNodeIn) GetSegmentIds() []string {
+	if x != nil {
+		return x.SegmentIds
+	}
+	return nil
+}
+
+type SplitNodeOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *Node                  `protobuf:"bytes,100,opt,name=node,proto3" json:"node,omitempty"`
+	NewNode       *Node                  `protobuf:"bytes,200,opt,name=new_node,json=newNode,proto3" json:"new_node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitNodeOut) Reset() {
+	*x = SplitNodeOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitNodeOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitNodeOut) ProtoMessage() {}
+
+func (x *SplitNodeOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitNodeOut.ProtoReflect.Descriptor instead.
+func (*SplitNodeOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SplitNodeOut) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *SplitNodeOut) GetNewNode() *Node {
+	if x != nil {
+		return x.NewNode
+	}
+	return nil
+}
+
+type SegmentDraft struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId  string                 `protobuf:"bytes,200,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ImageId string                 `protobuf:"bytes,300,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	// сегмент, с которого начато распространение
+	SourceSegmentId string `protobuf:"bytes,400,opt,name=source_segment_id,json=sourceSegmentId,proto3" json:"source_segment_id,omitempty"`
+	// GeoJSON Polygon версии 1 в пикселях кадра
+	Contor    []byte  `protobuf:"bytes,500,opt,name=contor,proto3" json:"contor,omitempty"`
+	Tirads_23 float64 `protobuf:"fixed64,600,opt,name=tirads_23,json=tirads23,proto3" json:"tirads_23,omitempty"`
+	Tirads_4  float64 `protobuf:"fixed64,700,opt,name=tirads_4,json=tirads4,proto3" json:"tirads_4,omitempty"`
+	Tirads_5  float64 `protobuf:"fixed64,800,opt,name=tirads_5,json=tirads5,proto3" json:"tirads_5,omitempty"`
+	// нормированная корреляция окрестности контура с предыдущим кадром, от -1 до 1
+	Score         float64 `protobuf:"fixed64,900,opt,name=score,proto3" json:"score,omitempty"`
+	CreateAt      string  `protobuf:"bytes,1000,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentDraft) Reset() {
+	*x = SegmentDraft{}
+	mi := &file_proto_grpc_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentDraft) ProtoMessage() {}
+
+func (x *SegmentDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentDraft.ProtoReflect.Descriptor instead.
+func (*SegmentDraft) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SegmentDraft) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SegmentDraft) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SegmentDraft) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *SegmentDraft) GetSourceSegmentId() string {
+	if x != nil {
+		return x.SourceSegmentId
+	}
+	return ""
+}
+
+func (x *SegmentDraft) GetContor() []byte {
+	if x != nil {
+		return x.Contor
+	}
+	return nil
+}
+
+func (x *SegmentDraft) GetTirads_23() float64 {
+	if x != nil {
+		return x.Tirads_23
+	}
+	return 0
+}
+
+func (x *SegmentDraft) GetTirads_4() float64 {
+	if x != nil {
+		return x.Tirads_4
+	}
+	return 0
+}
+
+func (x *SegmentDraft) GetTirads_5() float64 {
+	if x != nil {
+		return x.Tirads_5
+	}
+	return 0
+}
+
+func (x *SegmentDraft) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SegmentDraft) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+type ProposeSegmentsIn struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SegmentId string                 `protobuf:"bytes,100,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	// сколько кадров в каждую сторону, по умолчанию 1, не больше 10
+	Depth         *int64 `protobuf:"varint,200,opt,name=depth,proto3,oneof" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeSegmentsIn) Reset() {
+	*x = ProposeSegmentsIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeSegmentsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeSegmentsIn) ProtoMessage() {}
+
+func (x *ProposeSegmentsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeSegmentsIn.ProtoReflect.Descriptor instead.
+func (*ProposeSegmentsIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ProposeSegmentsIn) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *ProposeSegmentsIn) GetDepth() int64 {
+	if x != nil && x.Depth != nil {
+		return *x.Depth
+	}
+	return 0
+}
+
+type ProposeSegmentsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drafts        []*SegmentDraft        `protobuf:"bytes,100,rep,name=drafts,proto3" json:"drafts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeSegmentsOut) Reset() {
+	*x = ProposeSegmentsOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeSegmentsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeSegmentsOut) ProtoMessage() {}
+
+func (x *ProposeSegmentsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeSegmentsOut.ProtoReflect.Descriptor instead.
+func (*ProposeSegmentsOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ProposeSegmentsOut) GetDrafts() []*SegmentDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+type GetSegmentDraftsByNodeIdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentDraftsByNodeIdIn) Reset() {
+	*x = GetSegmentDraftsByNodeIdIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentDraftsByNodeIdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentDraftsByNodeIdIn) ProtoMessage() {}
+
+func (x *GetSegmentDraftsByNodeIdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentDraftsByNodeIdIn.ProtoReflect.Descriptor instead.
+func (*GetSegmentDraftsByNodeIdIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetSegmentDraftsByNodeIdIn) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type GetSegmentDraftsByNodeIdOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drafts        []*SegmentDraft        `protobuf:"bytes,100,rep,name=drafts,proto3" json:"drafts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentDraftsByNodeIdOut) Reset() {
+	*x = GetSegmentDraftsByNodeIdOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentDraftsByNodeIdOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentDraftsByNodeIdOut) ProtoMessage() {}
+
+func (x *GetSegmentDraftsByNodeIdOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentDraftsByNodeIdOut.ProtoReflect.Descriptor instead.
+func (*GetSegmentDraftsByNodeIdOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetSegmentDraftsByNodeIdOut) GetDrafts() []*SegmentDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+type AcceptSegmentDraftIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptSegmentDraftIn) Reset() {
+	*x = AcceptSegmentDraftIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptSegmentDraftIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSegmentDraftIn) ProtoMessage() {}
+
+func (x *AcceptSegmentDraftIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSegmentDraftIn.ProtoReflect.Descriptor instead.
+func (*AcceptSegmentDraftIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AcceptSegmentDraftIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AcceptSegmentDraftOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segment       *Segment               `protobuf:"bytes,100,opt,name=segment,proto3" json:"segment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptSegmentDraftOut) Reset() {
+	*x = AcceptSegmentDraftOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptSegmentDraftOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSegmentDraftOut) ProtoMessage() {}
+
+func (x *AcceptSegmentDraftOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSegmentDraftOut.ProtoReflect.Descriptor instead.
+func (*AcceptSegmentDraftOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AcceptSegmentDraftOut) GetSegment() *Segment {
+	if x != nil {
+		return x.Segment
+	}
+	return nil
+}
+
+type DiscardSegmentDraftIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardSegmentDraftIn) Reset() {
+	*x = DiscardSegmentDraftIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardSegmentDraftIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardSegmentDraftIn) ProtoMessage() {}
+
+func (x *DiscardSegmentDraftIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardSegmentDraftIn.ProtoReflect.Descriptor instead.
+func (*DiscardSegmentDraftIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DiscardSegmentDraftIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type NodeDescriptors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Composition   TiradsComposition      `protobuf:"varint,200,opt,name=composition,proto3,enum=TiradsComposition" json:"composition,omitempty"`
+	Echogenicity  TiradsEchogenicity     `protobuf:"varint,300,opt,name=echogenicity,proto3,enum=TiradsEchogenicity" json:"echogenicity,omitempty"`
+	Shape         TiradsShape            `protobuf:"varint,400,opt,name=shape,proto3,enum=TiradsShape" json:"shape,omitempty"`
+	Margin        TiradsMargin           `protobuf:"varint,500,opt,name=margin,proto3,enum=TiradsMargin" json:"margin,omitempty"`
+	EchogenicFoci []TiradsEchogenicFoci  `protobuf:"varint,600,rep,packed,name=echogenic_foci,json=echogenicFoci,proto3,enum=TiradsEchogenicFoci" json:"echogenic_foci,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeDescriptors) Reset() {
+	*x = NodeDescriptors{}
+	mi := &file_proto_grpc_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeDescriptors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDescriptors) ProtoMessage() {}
+
+func (x *NodeDescriptors) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDescriptors.ProtoReflect.Descriptor instead.
+func (*NodeDescriptors) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *NodeDescriptors) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeDescriptors) GetComposition() TiradsComposition {
+	if x != nil {
+		return x.Composition
+	}
+	return TiradsComposition_TIRADS_COMPOSITION_CYSTIC
+}
+
+func (x *NodeDescriptors) GetEchogenicity() TiradsEchogenicity {
+	if x != nil {
+		return x.Echogenicity
+	}
+	return TiradsEchogenicity_TIRADS_ECHOGENICITY_ANECHOIC
+}
+
+func (x *NodeDescriptors) GetShape() TiradsShape {
+	if x != nil {
+		return x.Shape
+	}
+	return TiradsShape_TIRADS_SHAPE_WIDER_THAN_TALL
+}
+
+func (x *NodeDescriptors) GetMargin() TiradsMargin {
+	if x != nil {
+		return x.Margin
+	}
+	return TiradsMargin_TIRADS_MARGIN_SMOOTH
+}
+
+func (x *NodeDescriptors) GetEchogenicFoci() []TiradsEchogenicFoci {
+	if x != nil {
+		return x.EchogenicFoci
+	}
+	return nil
+}
+
+type TiradsScore struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Points         int64                  `protobuf:"varint,100,opt,name=points,proto3" json:"points,omitempty"`
+	Category       TiradsCategory         `protobuf:"varint,200,opt,name=category,proto3,enum=TiradsCategory" json:"category,omitempty"`
+	Recommendation TiradsRecommendation   `protobuf:"varint,300,opt,name=recommendation,proto3,enum=TiradsRecommendation" json:"recommendation,omitempty"`
+	// максимальный размер узла в мм
+	MaxDiameter   *float64 `protobuf:"fixed64,400,opt,name=max_diameter,json=maxDiameter,proto3,oneof" json:"max_diameter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TiradsScore) Reset() {
+	*x = TiradsScore{}
+	mi := &file_proto_grpc_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TiradsScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TiradsScore) ProtoMessage() {}
+
+func (x *TiradsScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TiradsScore.ProtoReflect.Descriptor instead.
+func (*TiradsScore) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TiradsScore) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *TiradsScore) GetCategory() TiradsCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TiradsCategory_TIRADS_CATEGORY_TR1
+}
+
+func (x *TiradsScore) GetRecommendation() TiradsRecommendation {
+	if x != nil {
+		return x.Recommendation
+	}
+	return TiradsRecommendation_TIRADS_RECOMMENDATION_NONE
+}
+
+func (x *TiradsScore) GetMaxDiameter() float64 {
+	if x != nil && x.MaxDiameter != nil {
+		return *x.MaxDiameter
+	}
+	return 0
+}
+
+// descriptors и score не заданы, пока врач не заполнил дескрипторы
+type NodeTirads struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *Node                  `protobuf:"bytes,100,opt,name=node,proto3" json:"node,omitempty"`
+	Descriptors   *NodeDescriptors       `protobuf:"bytes,200,opt,name=descriptors,proto3" json:"descriptors,omitempty"`
+	Score         *TiradsScore           `protobuf:"bytes,300,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeTirads) Reset() {
+	*x = NodeTirads{}
+	mi := &file_proto_grpc_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeTirads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeTirads) ProtoMessage() {}
+
+func (x *NodeTirads) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeTirads.ProtoReflect.Descriptor instead.
+func (*NodeTirads) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *NodeTirads) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *NodeTirads) GetDescriptors() *NodeDescriptors {
+	if x != nil {
+		return x.Descriptors
+	}
+	return nil
+}
+
+func (x *NodeTirads) GetScore() *TiradsScore {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+type SetNodeDescriptorsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Descriptors   *NodeDescriptors       `protobuf:"bytes,100,opt,name=descriptors,proto3" json:"descriptors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeDescriptorsIn) Reset() {
+	*x = SetNodeDescriptorsIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeDescriptorsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeDescriptorsIn) ProtoMessage() {}
+
+func (x *SetNodeDescriptorsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeDescriptorsIn.ProtoReflect.Descriptor instead.
+func (*SetNodeDescriptorsIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SetNodeDescriptorsIn) GetDescriptors() *NodeDescriptors {
+	if x != nil {
+		return x.Descriptors
+	}
+	return nil
+}
+
+type SetNodeDescriptorsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tirads        *NodeTirads            `protobuf:"bytes,100,opt,name=tirads,proto3" json:"tirads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeDescriptorsOut) Reset() {
+	*x = SetNodeDescriptorsOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeDescriptorsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeDescriptorsOut) ProtoMessage() {}
+
+func (x *SetNodeDescriptorsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeDescriptorsOut.ProtoReflect.Descriptor instead.
+func (*SetNodeDescriptorsOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetNodeDescriptorsOut) GetTirads() *NodeTirads {
+	if x != nil {
+		return x.Tirads
+	}
+	return nil
+}
+
+type GetNodeTiradsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeTiradsIn) Reset() {
+	*x = GetNodeTiradsIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeTiradsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeTiradsIn) ProtoMessage() {}
+
+func (x *GetNodeTiradsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeTiradsIn.ProtoReflect.Descriptor instead.
+func (*GetNodeTiradsIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetNodeTiradsIn) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type GetNodeTiradsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tirads        *NodeTirads            `protobuf:"bytes,100,opt,name=tirads,proto3" json:"tirads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeTiradsOut) Reset() {
+	*x = GetNodeTiradsOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeTiradsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeTiradsOut) ProtoMessage() {}
+
+func (x *GetNodeTiradsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeTiradsOut.ProtoReflect.Descriptor instead.
+func (*GetNodeTiradsOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetNodeTiradsOut) GetTirads() *NodeTirads {
+	if x != nil {
+		return x.Tirads
+	}
+	return nil
+}
+
+type LinkNodesIn struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// узел из другого узи того же пациента, к цепочке которого добавляется node_id
+	TargetNodeId  string `protobuf:"bytes,200,opt,name=target_node_id,json=targetNodeId,proto3" json:"target_node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkNodesIn) Reset() {
+	*x = LinkNodesIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkNodesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkNodesIn) ProtoMessage() {}
+
+func (x *LinkNodesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkNodesIn.ProtoReflect.Descriptor instead.
+func (*LinkNodesIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *LinkNodesIn) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *LinkNodesIn) GetTargetNodeId() string {
+	if x != nil {
+		return x.TargetNodeId
+	}
+	return ""
+}
+
+type LinkNodesOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineageId     string                 `protobuf:"bytes,100,opt,name=lineage_id,json=lineageId,proto3" json:"lineage_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkNodesOut) Reset() {
+	*x = LinkNodesOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDeviceIn) String() string {
+func (x *LinkNodesOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDeviceIn) ProtoMessage() {}
+func (*LinkNodesOut) ProtoMessage() {}
 
-func (x *CreateDeviceIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[1]
+func (x *LinkNodesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,40 +6289,40 @@ func (x *CreateDeviceIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDeviceIn.ProtoReflect.Descriptor instead.
-func (*CreateDeviceIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use LinkNodesOut.ProtoReflect.Descriptor instead.
+func (*LinkNodesOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *CreateDeviceIn) GetName() string {
+func (x *LinkNodesOut) GetLineageId() string {
 	if x != nil {
-		return x.Name
+		return x.LineageId
 	}
 	return ""
 }
 
-type CreateDeviceOut struct {
+type UnlinkNodeIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,100,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDeviceOut) Reset() {
-	*x = CreateDeviceOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[2]
+func (x *UnlinkNodeIn) Reset() {
+	*x = UnlinkNodeIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDeviceOut) String() string {
+func (x *UnlinkNodeIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDeviceOut) ProtoMessage() {}
+func (*UnlinkNodeIn) ProtoMessage() {}
 
-func (x *CreateDeviceOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[2]
+func (x *UnlinkNodeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,40 +6333,40 @@ func (x *CreateDeviceOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDeviceOut.ProtoReflect.Descriptor instead.
-func (*CreateDeviceOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use UnlinkNodeIn.ProtoReflect.Descriptor instead.
+func (*UnlinkNodeIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateDeviceOut) GetId() int64 {
+func (x *UnlinkNodeIn) GetNodeId() string {
 	if x != nil {
-		return x.Id
+		return x.NodeId
 	}
-	return 0
+	return ""
 }
 
-type GetDeviceListOut struct {
+type SuggestNodeLinksIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devices       []*Device              `protobuf:"bytes,100,rep,name=devices,proto3" json:"devices,omitempty"`
+	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeviceListOut) Reset() {
-	*x = GetDeviceListOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[3]
+func (x *SuggestNodeLinksIn) Reset() {
+	*x = SuggestNodeLinksIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeviceListOut) String() string {
+func (x *SuggestNodeLinksIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeviceListOut) ProtoMessage() {}
+func (*SuggestNodeLinksIn) ProtoMessage() {}
 
-func (x *GetDeviceListOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[3]
+func (x *SuggestNodeLinksIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,48 +6377,42 @@ func (x *GetDeviceListOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeviceListOut.ProtoReflect.Descriptor instead.
-func (*GetDeviceListOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SuggestNodeLinksIn.ProtoReflect.Descriptor instead.
+func (*SuggestNodeLinksIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetDeviceListOut) GetDevices() []*Device {
+func (x *SuggestNodeLinksIn) GetNodeId() string {
 	if x != nil {
-		return x.Devices
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-type Uzi struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
-	Projection    UziProjection          `protobuf:"varint,200,opt,name=projection,proto3,enum=UziProjection" json:"projection,omitempty"`
-	Checked       bool                   `protobuf:"varint,300,opt,name=checked,proto3" json:"checked,omitempty"`
-	ExternalId    string                 `protobuf:"bytes,400,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	Author        string                 `protobuf:"bytes,500,opt,name=author,proto3" json:"author,omitempty"`
-	DeviceId      int64                  `protobuf:"varint,600,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	Status        UziStatus              `protobuf:"varint,700,opt,name=status,proto3,enum=UziStatus" json:"status,omitempty"`
-	Description   *string                `protobuf:"bytes,800,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	CreateAt      string                 `protobuf:"bytes,1000,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+type NodeLinkSuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Node  *Node                  `protobuf:"bytes,100,opt,name=node,proto3" json:"node,omitempty"`
+	// похожесть узлов от 0 до 1
+	Score         float64 `protobuf:"fixed64,200,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Uzi) Reset() {
-	*x = Uzi{}
-	mi := &file_proto_grpc_service_proto_msgTypes[4]
+func (x *NodeLinkSuggestion) Reset() {
+	*x = NodeLinkSuggestion{}
+	mi := &file_proto_grpc_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Uzi) String() string {
+func (x *NodeLinkSuggestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Uzi) ProtoMessage() {}
+func (*NodeLinkSuggestion) ProtoMessage() {}
 
-func (x *Uzi) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[4]
+func (x *NodeLinkSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,114 +6423,91 @@ func (x *Uzi) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Uzi.ProtoReflect.Descriptor instead.
-func (*Uzi) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use NodeLinkSuggestion.ProtoReflect.Descriptor instead.
+func (*NodeLinkSuggestion) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *Uzi) GetId() string {
+func (x *NodeLinkSuggestion) GetNode() *Node {
 	if x != nil {
-		return x.Id
+		return x.Node
 	}
-	return ""
+	return nil
 }
 
-func (x *Uzi) GetProjection() UziProjection {
+func (x *NodeLinkSuggestion) GetScore() float64 {
 	if x != nil {
-		return x.Projection
+		return x.Score
 	}
-	return UziProjection_UZI_PROJECTION_LONG
+	return 0
 }
 
-func (x *Uzi) GetChecked() bool {
-	if x != nil {
-		return x.Checked
-	}
-	return false
+type SuggestNodeLinksOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*NodeLinkSuggestion  `protobuf:"bytes,100,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Uzi) GetExternalId() string {
-	if x != nil {
-		return x.ExternalId
-	}
-	return ""
+func (x *SuggestNodeLinksOut) Reset() {
+	*x = SuggestNodeLinksOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Uzi) GetAuthor() string {
-	if x != nil {
-		return x.Author
-	}
-	return ""
+func (x *SuggestNodeLinksOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Uzi) GetDeviceId() int64 {
-	if x != nil {
-		return x.DeviceId
-	}
-	return 0
-}
+func (*SuggestNodeLinksOut) ProtoMessage() {}
 
-func (x *Uzi) GetStatus() UziStatus {
+func (x *SuggestNodeLinksOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[88]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return UziStatus_UZI_STATUS_NEW
+	return mi.MessageOf(x)
 }
 
-func (x *Uzi) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+// Deprecated: Use SuggestNodeLinksOut.ProtoReflect.Descriptor instead.
+func (*SuggestNodeLinksOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *Uzi) GetCreateAt() string {
+func (x *SuggestNodeLinksOut) GetSuggestions() []*NodeLinkSuggestion {
 	if x != nil {
-		return x.CreateAt
+		return x.Suggestions
 	}
-	return ""
+	return nil
 }
 
-type Echographic struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
-	Contors         *string                `protobuf:"bytes,200,opt,name=contors,proto3,oneof" json:"contors,omitempty"`
-	LeftLobeLength  *float64               `protobuf:"fixed64,300,opt,name=left_lobe_length,json=leftLobeLength,proto3,oneof" json:"left_lobe_length,omitempty"`
-	LeftLobeWidth   *float64               `protobuf:"fixed64,400,opt,name=left_lobe_width,json=leftLobeWidth,proto3,oneof" json:"left_lobe_width,omitempty"`
-	LeftLobeThick   *float64               `protobuf:"fixed64,500,opt,name=left_lobe_thick,json=leftLobeThick,proto3,oneof" json:"left_lobe_thick,omitempty"`
-	LeftLobeVolum   *float64               `protobuf:"fixed64,600,opt,name=left_lobe_volum,json=leftLobeVolum,proto3,oneof" json:"left_lobe_volum,omitempty"`
-	RightLobeLength *float64               `protobuf:"fixed64,700,opt,name=right_lobe_length,json=rightLobeLength,proto3,oneof" json:"right_lobe_length,omitempty"`
-	RightLobeWidth  *float64               `protobuf:"fixed64,800,opt,name=right_lobe_width,json=rightLobeWidth,proto3,oneof" json:"right_lobe_width,omitempty"`
-	RightLobeThick  *float64               `protobuf:"fixed64,900,opt,name=right_lobe_thick,json=rightLobeThick,proto3,oneof" json:"right_lobe_thick,omitempty"`
-	RightLobeVolum  *float64               `protobuf:"fixed64,1000,opt,name=right_lobe_volum,json=rightLobeVolum,proto3,oneof" json:"right_lobe_volum,omitempty"`
-	GlandVolum      *float64               `protobuf:"fixed64,1100,opt,name=gland_volum,json=glandVolum,proto3,oneof" json:"gland_volum,omitempty"`
-	Isthmus         *float64               `protobuf:"fixed64,1200,opt,name=isthmus,proto3,oneof" json:"isthmus,omitempty"`
-	Struct          *string                `protobuf:"bytes,1300,opt,name=struct,proto3,oneof" json:"struct,omitempty"`
-	Echogenicity    *string                `protobuf:"bytes,1400,opt,name=echogenicity,proto3,oneof" json:"echogenicity,omitempty"`
-	RegionalLymph   *string                `protobuf:"bytes,1500,opt,name=regional_lymph,json=regionalLymph,proto3,oneof" json:"regional_lymph,omitempty"`
-	Vascularization *string                `protobuf:"bytes,1600,opt,name=vascularization,proto3,oneof" json:"vascularization,omitempty"`
-	Location        *string                `protobuf:"bytes,1700,opt,name=location,proto3,oneof" json:"location,omitempty"`
-	Additional      *string                `protobuf:"bytes,1800,opt,name=additional,proto3,oneof" json:"additional,omitempty"`
-	Conclusion      *string                `protobuf:"bytes,1900,opt,name=conclusion,proto3,oneof" json:"conclusion,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetGrowthReportIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalId    string                 `protobuf:"bytes,100,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Echographic) Reset() {
-	*x = Echographic{}
-	mi := &file_proto_grpc_service_proto_msgTypes[5]
+func (x *GetGrowthReportIn) Reset() {
+	*x = GetGrowthReportIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Echographic) String() string {
+func (x *GetGrowthReportIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Echographic) ProtoMessage() {}
+func (*GetGrowthReportIn) ProtoMessage() {}
 
-func (x *Echographic) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[5]
+func (x *GetGrowthReportIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,170 +6518,114 @@ func (x *Echographic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Echographic.ProtoReflect.Descriptor instead.
-func (*Echographic) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetGrowthReportIn.ProtoReflect.Descriptor instead.
+func (*GetGrowthReportIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *Echographic) GetId() string {
+func (x *GetGrowthReportIn) GetExternalId() string {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Echographic) GetContors() string {
-	if x != nil && x.Contors != nil {
-		return *x.Contors
+		return x.ExternalId
 	}
 	return ""
 }
 
-func (x *Echographic) GetLeftLobeLength() float64 {
-	if x != nil && x.LeftLobeLength != nil {
-		return *x.LeftLobeLength
-	}
-	return 0
-}
-
-func (x *Echographic) GetLeftLobeWidth() float64 {
-	if x != nil && x.LeftLobeWidth != nil {
-		return *x.LeftLobeWidth
-	}
-	return 0
-}
-
-func (x *Echographic) GetLeftLobeThick() float64 {
-	if x != nil && x.LeftLobeThick != nil {
-		return *x.LeftLobeThick
-	}
-	return 0
-}
-
-func (x *Echographic) GetLeftLobeVolum() float64 {
-	if x != nil && x.LeftLobeVolum != nil {
-		return *x.LeftLobeVolum
-	}
-	return 0
-}
-
-func (x *Echographic) GetRightLobeLength() float64 {
-	if x != nil && x.RightLobeLength != nil {
-		return *x.RightLobeLength
-	}
-	return 0
-}
-
-func (x *Echographic) GetRightLobeWidth() float64 {
-	if x != nil && x.RightLobeWidth != nil {
-		return *x.RightLobeWidth
-	}
-	return 0
-}
-
-func (x *Echographic) GetRightLobeThick() float64 {
-	if x != nil && x.RightLobeThick != nil {
-		return *x.RightLobeThick
-	}
-	return 0
-}
-
-func (x *Echographic) GetRightLobeVolum() float64 {
-	if x != nil && x.RightLobeVolum != nil {
-		return *x.RightLobeVolum
-	}
-	return 0
+type NodeGrowthPoint struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Node     *Node                  `protobuf:"bytes,100,opt,name=node,proto3" json:"node,omitempty"`
+	UziId    string                 `protobuf:"bytes,200,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	CreateAt string                 `protobuf:"bytes,300,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	// объем в мм³
+	Volume        *float64 `protobuf:"fixed64,400,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Echographic) GetGlandVolum() float64 {
-	if x != nil && x.GlandVolum != nil {
-		return *x.GlandVolum
-	}
-	return 0
+func (x *NodeGrowthPoint) Reset() {
+	*x = NodeGrowthPoint{}
+	mi := &file_proto_grpc_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Echographic) GetIsthmus() float64 {
-	if x != nil && x.Isthmus != nil {
-		return *x.Isthmus
-	}
-	return 0
+func (x *NodeGrowthPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Echographic) GetStruct() string {
-	if x != nil && x.Struct != nil {
-		return *x.Struct
-	}
-	return ""
-}
+func (*NodeGrowthPoint) ProtoMessage() {}
 
-func (x *Echographic) GetEchogenicity() string {
-	if x != nil && x.Echogenicity != nil {
-		return *x.Echogenicity
+func (x *NodeGrowthPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Echographic) GetRegionalLymph() string {
-	if x != nil && x.RegionalLymph != nil {
-		return *x.RegionalLymph
-	}
-	return ""
+// Deprecated: Use NodeGrowthPoint.ProtoReflect.Descriptor instead.
+func (*NodeGrowthPoint) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *Echographic) GetVascularization() string {
-	if x != nil && x.Vascularization != nil {
-		return *x.Vascularization
+func (x *NodeGrowthPoint) GetNode() *Node {
+	if x != nil {
+		return x.Node
 	}
-	return ""
+	return nil
 }
 
-func (x *Echographic) GetLocation() string {
-	if x != nil && x.Location != nil {
-		return *x.Location
+func (x *NodeGrowthPoint) GetUziId() string {
+	if x != nil {
+		return x.UziId
 	}
 	return ""
 }
 
-func (x *Echographic) GetAdditional() string {
-	if x != nil && x.Additional != nil {
-		return *x.Additional
+func (x *NodeGrowthPoint) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
 	}
 	return ""
 }
 
-func (x *Echographic) GetConclusion() string {
-	if x != nil && x.Conclusion != nil {
-		return *x.Conclusion
+func (x *NodeGrowthPoint) GetVolume() float64 {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
 	}
-	return ""
+	return 0
 }
 
-type CreateUziIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projection    UziProjection          `protobuf:"varint,100,opt,name=projection,proto3,enum=UziProjection" json:"projection,omitempty"`
-	ExternalId    string                 `protobuf:"bytes,200,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	Author        string                 `protobuf:"bytes,300,opt,name=author,proto3" json:"author,omitempty"`
-	DeviceId      int64                  `protobuf:"varint,400,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	Description   *string                `protobuf:"bytes,500,opt,name=description,proto3,oneof" json:"description,omitempty"`
+type NodeGrowth struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	LineageId string                 `protobuf:"bytes,100,opt,name=lineage_id,json=lineageId,proto3" json:"lineage_id,omitempty"`
+	Points    []*NodeGrowthPoint     `protobuf:"bytes,200,rep,name=points,proto3" json:"points,omitempty"`
+	// изменение объема между первым и последним измерением в процентах
+	VolumeChange *float64 `protobuf:"fixed64,300,opt,name=volume_change,json=volumeChange,proto3,oneof" json:"volume_change,omitempty"`
+	// время удвоения объема в днях
+	DoublingTime  *float64 `protobuf:"fixed64,400,opt,name=doubling_time,json=doublingTime,proto3,oneof" json:"doubling_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUziIn) Reset() {
-	*x = CreateUziIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[6]
+func (x *NodeGrowth) Reset() {
+	*x = NodeGrowth{}
+	mi := &file_proto_grpc_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUziIn) String() string {
+func (x *NodeGrowth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUziIn) ProtoMessage() {}
+func (*NodeGrowth) ProtoMessage() {}
 
-func (x *CreateUziIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[6]
+func (x *NodeGrowth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,68 +6636,61 @@ func (x *CreateUziIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUziIn.ProtoReflect.Descriptor instead.
-func (*CreateUziIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *CreateUziIn) GetProjection() UziProjection {
-	if x != nil {
-		return x.Projection
-	}
-	return UziProjection_UZI_PROJECTION_LONG
+// Deprecated: Use NodeGrowth.ProtoReflect.Descriptor instead.
+func (*NodeGrowth) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *CreateUziIn) GetExternalId() string {
+func (x *NodeGrowth) GetLineageId() string {
 	if x != nil {
-		return x.ExternalId
+		return x.LineageId
 	}
 	return ""
 }
 
-func (x *CreateUziIn) GetAuthor() string {
+func (x *NodeGrowth) GetPoints() []*NodeGrowthPoint {
 	if x != nil {
-		return x.Author
+		return x.Points
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateUziIn) GetDeviceId() int64 {
-	if x != nil {
-		return x.DeviceId
+func (x *NodeGrowth) GetVolumeChange() float64 {
+	if x != nil && x.VolumeChange != nil {
+		return *x.VolumeChange
 	}
 	return 0
 }
 
-func (x *CreateUziIn) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *NodeGrowth) GetDoublingTime() float64 {
+	if x != nil && x.DoublingTime != nil {
+		return *x.DoublingTime
 	}
-	return ""
+	return 0
 }
 
-type CreateUziOut struct {
+type GetGrowthReportOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Lineages      []*NodeGrowth          `protobuf:"bytes,100,rep,name=lineages,proto3" json:"lineages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUziOut) Reset() {
-	*x = CreateUziOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[7]
+func (x *GetGrowthReportOut) Reset() {
+	*x = GetGrowthReportOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUziOut) String() string {
+func (x *GetGrowthReportOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUziOut) ProtoMessage() {}
+func (*GetGrowthReportOut) ProtoMessage() {}
 
-func (x *CreateUziOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[7]
+func (x *GetGrowthReportOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,40 +6701,46 @@ func (x *CreateUziOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUziOut.ProtoReflect.Descriptor instead.
-func (*CreateUziOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetGrowthReportOut.ProtoReflect.Descriptor instead.
+func (*GetGrowthReportOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *CreateUziOut) GetId() string {
+func (x *GetGrowthReportOut) GetLineages() []*NodeGrowth {
 	if x != nil {
-		return x.Id
+		return x.Lineages
 	}
-	return ""
+	return nil
 }
 
-type GetUziByIdIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+type Report struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	UziId   string                 `protobuf:"bytes,200,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	Version int64                  `protobuf:"varint,300,opt,name=version,proto3" json:"version,omitempty"`
+	// пути к файлам заключения в S3
+	HtmlPath      string `protobuf:"bytes,400,opt,name=html_path,json=htmlPath,proto3" json:"html_path,omitempty"`
+	PdfPath       string `protobuf:"bytes,500,opt,name=pdf_path,json=pdfPath,proto3" json:"pdf_path,omitempty"`
+	CreateAt      string `protobuf:"bytes,600,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUziByIdIn) Reset() {
-	*x = GetUziByIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[8]
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_proto_grpc_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUziByIdIn) String() string {
+func (x *Report) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUziByIdIn) ProtoMessage() {}
+func (*Report) ProtoMessage() {}
 
-func (x *GetUziByIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[8]
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,40 +6751,75 @@ func (x *GetUziByIdIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUziByIdIn.ProtoReflect.Descriptor instead.
-func (*GetUziByIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetUziByIdIn) GetId() string {
+func (x *Report) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetUziByIdOut struct {
+func (x *Report) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+func (x *Report) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Report) GetHtmlPath() string {
+	if x != nil {
+		return x.HtmlPath
+	}
+	return ""
+}
+
+func (x *Report) GetPdfPath() string {
+	if x != nil {
+		return x.PdfPath
+	}
+	return ""
+}
+
+func (x *Report) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+type GenerateReportIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uzi           *Uzi                   `protobuf:"bytes,100,opt,name=uzi,proto3" json:"uzi,omitempty"`
+	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUziByIdOut) Reset() {
-	*x = GetUziByIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[9]
+func (x *GenerateReportIn) Reset() {
+	*x = GenerateReportIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUziByIdOut) String() string {
+func (x *GenerateReportIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUziByIdOut) ProtoMessage() {}
+func (*GenerateReportIn) ProtoMessage() {}
 
-func (x *GetUziByIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[9]
+func (x *GenerateReportIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,40 +6830,40 @@ func (x *GetUziByIdOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUziByIdOut.ProtoReflect.Descriptor instead.
-func (*GetUziByIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GenerateReportIn.ProtoReflect.Descriptor instead.
+func (*GenerateReportIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetUziByIdOut) GetUzi() *Uzi {
+func (x *GenerateReportIn) GetUziId() string {
 	if x != nil {
-		return x.Uzi
+		return x.UziId
 	}
-	return nil
+	return ""
 }
 
-type GetUzisByExternalIdIn struct {
+type GenerateReportOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExternalId    string                 `protobuf:"bytes,100,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Report        *Report                `protobuf:"bytes,100,opt,name=report,proto3" json:"report,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUzisByExternalIdIn) Reset() {
-	*x = GetUzisByExternalIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[10]
+func (x *GenerateReportOut) Reset() {
+	*x = GenerateReportOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUzisByExternalIdIn) String() string {
+func (x *GenerateReportOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUzisByExternalIdIn) ProtoMessage() {}
+func (*GenerateReportOut) ProtoMessage() {}
 
-func (x *GetUzisByExternalIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[10]
+func (x *GenerateReportOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,40 +6874,40 @@ func (x *GetUzisByExternalIdIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUzisByExternalIdIn.ProtoReflect.Descriptor instead.
-func (*GetUzisByExternalIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GenerateReportOut.ProtoReflect.Descriptor instead.
+func (*GenerateReportOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GetUzisByExternalIdIn) GetExternalId() string {
+func (x *GenerateReportOut) GetReport() *Report {
 	if x != nil {
-		return x.ExternalId
+		return x.Report
 	}
-	return ""
+	return nil
 }
 
-type GetUzisByExternalIdOut struct {
+type GetReportsIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uzis          []*Uzi                 `protobuf:"bytes,100,rep,name=uzis,proto3" json:"uzis,omitempty"`
+	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUzisByExternalIdOut) Reset() {
-	*x = GetUzisByExternalIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[11]
+func (x *GetReportsIn) Reset() {
+	*x = GetReportsIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUzisByExternalIdOut) String() string {
+func (x *GetReportsIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUzisByExternalIdOut) ProtoMessage() {}
+func (*GetReportsIn) ProtoMessage() {}
 
-func (x *GetUzisByExternalIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[11]
+func (x *GetReportsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,40 +6918,40 @@ func (x *GetUzisByExternalIdOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUzisByExternalIdOut.ProtoReflect.Descriptor instead.
-func (*GetUzisByExternalIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetReportsIn.ProtoReflect.Descriptor instead.
+func (*GetReportsIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetUzisByExternalIdOut) GetUzis() []*Uzi {
+func (x *GetReportsIn) GetUziId() string {
 	if x != nil {
-		return x.Uzis
+		return x.UziId
 	}
-	return nil
+	return ""
 }
 
-type GetUzisByAuthorIn struct {
+type GetReportsOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Author        string                 `protobuf:"bytes,100,opt,name=author,proto3" json:"author,omitempty"`
+	Reports       []*Report              `protobuf:"bytes,100,rep,name=reports,proto3" json:"reports,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUzisByAuthorIn) Reset() {
-	*x = GetUzisByAuthorIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[12]
+func (x *GetReportsOut) Reset() {
+	*x = GetReportsOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUzisByAuthorIn) String() string {
+func (x *GetReportsOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUzisByAuthorIn) ProtoMessage() {}
+func (*GetReportsOut) ProtoMessage() {}
 
-func (x *GetUzisByAuthorIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[12]
+func (x *GetReportsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,40 +6962,42 @@ func (x *GetUzisByAuthorIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUzisByAuthorIn.ProtoReflect.Descriptor instead.
-func (*GetUzisByAuthorIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetReportsOut.ProtoReflect.Descriptor instead.
+func (*GetReportsOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetUzisByAuthorIn) GetAuthor() string {
+func (x *GetReportsOut) GetReports() []*Report {
 	if x != nil {
-		return x.Author
+		return x.Reports
 	}
-	return ""
+	return nil
 }
 
-type GetUzisByAuthorOut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uzis          []*Uzi                 `protobuf:"bytes,100,rep,name=uzis,proto3" json:"uzis,omitempty"`
+type GetReportIn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	UziId string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	// без версии - последняя
+	Version       *int64 `protobuf:"varint,200,opt,name=version,proto3,oneof" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUzisByAuthorOut) Reset() {
-	*x = GetUzisByAuthorOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[13]
+func (x *GetReportIn) Reset() {
+	*x = GetReportIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUzisByAuthorOut) String() string {
+func (x *GetReportIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUzisByAuthorOut) ProtoMessage() {}
+func (*GetReportIn) ProtoMessage() {}
 
-func (x *GetUzisByAuthorOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[13]
+func (x *GetReportIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,40 +7008,47 @@ func (x *GetUzisByAuthorOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUzisByAuthorOut.ProtoReflect.Descriptor instead.
-func (*GetUzisByAuthorOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetReportIn.ProtoReflect.Descriptor instead.
+func (*GetReportIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetUzisByAuthorOut) GetUzis() []*Uzi {
+func (x *GetReportIn) GetUziId() string {
 	if x != nil {
-		return x.Uzis
+		return x.UziId
 	}
-	return nil
+	return ""
 }
 
-type GetEchographicByUziIdIn struct {
+func (x *GetReportIn) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type GetReportOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	Report        *Report                `protobuf:"bytes,100,opt,name=report,proto3" json:"report,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEchographicByUziIdIn) Reset() {
-	*x = GetEchographicByUziIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[14]
+func (x *GetReportOut) Reset() {
+	*x = GetReportOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEchographicByUziIdIn) String() string {
+func (x *GetReportOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEchographicByUziIdIn) ProtoMessage() {}
+func (*GetReportOut) ProtoMessage() {}
 
-func (x *GetEchographicByUziIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[14]
+func (x *GetReportOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1063,40 +7059,58 @@ func (x *GetEchographicByUziIdIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEchographicByUziIdIn.ProtoReflect.Descriptor instead.
-func (*GetEchographicByUziIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetReportOut.ProtoReflect.Descriptor instead.
+func (*GetReportOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetEchographicByUziIdIn) GetUziId() string {
+func (x *GetReportOut) GetReport() *Report {
 	if x != nil {
-		return x.UziId
+		return x.Report
 	}
-	return ""
+	return nil
 }
 
-type GetEchographicByUziIdOut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Echographic   *Echographic           `protobuf:"bytes,100,opt,name=echographic,proto3" json:"echographic,omitempty"`
+// фильтры узи как в searchUzis, нужно выбрать хотя бы один источник узлов
+type ExportDatasetIn struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Author     *string                `protobuf:"bytes,100,opt,name=author,proto3,oneof" json:"author,omitempty"`
+	ExternalId *string                `protobuf:"bytes,200,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	Status     *UziStatus             `protobuf:"varint,300,opt,name=status,proto3,enum=UziStatus,oneof" json:"status,omitempty"`
+	Projection *UziProjection         `protobuf:"varint,400,opt,name=projection,proto3,enum=UziProjection,oneof" json:"projection,omitempty"`
+	DeviceId   *int64                 `protobuf:"varint,500,opt,name=device_id,json=deviceId,proto3,oneof" json:"device_id,omitempty"`
+	Checked    *bool                  `protobuf:"varint,600,opt,name=checked,proto3,oneof" json:"checked,omitempty"`
+	// границы даты создания включительно, RFC3339
+	CreateFrom *string `protobuf:"bytes,700,opt,name=create_from,json=createFrom,proto3,oneof" json:"create_from,omitempty"`
+	CreateTo   *string `protobuf:"bytes,800,opt,name=create_to,json=createTo,proto3,oneof" json:"create_to,omitempty"`
+	// узлы, размеченные врачом вручную
+	Manual bool `protobuf:"varint,900,opt,name=manual,proto3" json:"manual,omitempty"`
+	// узлы нейросети с validation=valid
+	AiValid bool          `protobuf:"varint,1000,opt,name=ai_valid,json=aiValid,proto3" json:"ai_valid,omitempty"`
+	Format  DatasetFormat `protobuf:"varint,1100,opt,name=format,proto3,enum=DatasetFormat" json:"format,omitempty"`
+	// доля пациентов в валидационной выборке, по умолчанию 0.2
+	ValRatio *float64 `protobuf:"fixed64,1200,opt,name=val_ratio,json=valRatio,proto3,oneof" json:"val_ratio,omitempty"`
+	// соль разбиения и имен файлов
+	Seed          string `protobuf:"bytes,1300,opt,name=seed,proto3" json:"seed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEchographicByUziIdOut) Reset() {
-	*x = GetEchographicByUziIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[15]
+func (x *ExportDatasetIn) Reset() {
+	*x = ExportDatasetIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEchographicByUziIdOut) String() string {
+func (x *ExportDatasetIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEchographicByUziIdOut) ProtoMessage() {}
+func (*ExportDatasetIn) ProtoMessage() {}
 
-func (x *GetEchographicByUziIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[15]
+func (x *ExportDatasetIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,42 +7121,129 @@ func (x *GetEchographicByUziIdOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEchographicByUziIdOut.ProtoReflect.Descriptor instead.
-func (*GetEchographicByUziIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ExportDatasetIn.ProtoReflect.Descriptor instead.
+func (*ExportDatasetIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ExportDatasetIn) GetAuthor() string {
+	if x != nil && x.Author != nil {
+		return *x.Author
+	}
+	return ""
+}
+
+func (x *ExportDatasetIn) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *ExportDatasetIn) GetStatus() UziStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return UziStatus_UZI_STATUS_NEW
+}
+
+func (x *ExportDatasetIn) GetProjection() UziProjection {
+	if x != nil && x.Projection != nil {
+		return *x.Projection
+	}
+	return UziProjection_UZI_PROJECTION_LONG
+}
+
+func (x *ExportDatasetIn) GetDeviceId() int64 {
+	if x != nil && x.DeviceId != nil {
+		return *x.DeviceId
+	}
+	return 0
+}
+
+func (x *ExportDatasetIn) GetChecked() bool {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return false
+}
+
+func (x *ExportDatasetIn) GetCreateFrom() string {
+	if x != nil && x.CreateFrom != nil {
+		return *x.CreateFrom
+	}
+	return ""
+}
+
+func (x *ExportDatasetIn) GetCreateTo() string {
+	if x != nil && x.CreateTo != nil {
+		return *x.CreateTo
+	}
+	return ""
+}
+
+func (x *ExportDatasetIn) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *ExportDatasetIn) GetAiValid() bool {
+	if x != nil {
+		return x.AiValid
+	}
+	return false
+}
+
+func (x *ExportDatasetIn) GetFormat() DatasetFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DatasetFormat_DATASET_FORMAT_COCO
+}
+
+func (x *ExportDatasetIn) GetValRatio() float64 {
+	if x != nil && x.ValRatio != nil {
+		return *x.ValRatio
+	}
+	return 0
 }
 
-func (x *GetEchographicByUziIdOut) GetEchographic() *Echographic {
+func (x *ExportDatasetIn) GetSeed() string {
 	if x != nil {
-		return x.Echographic
+		return x.Seed
 	}
-	return nil
+	return ""
 }
 
-type UpdateUziIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
-	Projection    *UziProjection         `protobuf:"varint,200,opt,name=projection,proto3,enum=UziProjection,oneof" json:"projection,omitempty"`
-	Checked       *bool                  `protobuf:"varint,300,opt,name=checked,proto3,oneof" json:"checked,omitempty"`
+type ExportDatasetOut struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	// префикс датасета в S3
+	Path          string `protobuf:"bytes,200,opt,name=path,proto3" json:"path,omitempty"`
+	TrainImages   int64  `protobuf:"varint,300,opt,name=train_images,json=trainImages,proto3" json:"train_images,omitempty"`
+	ValImages     int64  `protobuf:"varint,400,opt,name=val_images,json=valImages,proto3" json:"val_images,omitempty"`
+	Annotations   int64  `protobuf:"varint,500,opt,name=annotations,proto3" json:"annotations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUziIn) Reset() {
-	*x = UpdateUziIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[16]
+func (x *ExportDatasetOut) Reset() {
+	*x = ExportDatasetOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUziIn) String() string {
+func (x *ExportDatasetOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUziIn) ProtoMessage() {}
+func (*ExportDatasetOut) ProtoMessage() {}
 
-func (x *UpdateUziIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[16]
+func (x *ExportDatasetOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,54 +7254,73 @@ func (x *UpdateUziIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUziIn.ProtoReflect.Descriptor instead.
-func (*UpdateUziIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ExportDatasetOut.ProtoReflect.Descriptor instead.
+func (*ExportDatasetOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *UpdateUziIn) GetId() string {
+func (x *ExportDatasetOut) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateUziIn) GetProjection() UziProjection {
-	if x != nil && x.Projection != nil {
-		return *x.Projection
+func (x *ExportDatasetOut) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
-	return UziProjection_UZI_PROJECTION_LONG
+	return ""
 }
 
-func (x *UpdateUziIn) GetChecked() bool {
-	if x != nil && x.Checked != nil {
-		return *x.Checked
+func (x *ExportDatasetOut) GetTrainImages() int64 {
+	if x != nil {
+		return x.TrainImages
 	}
-	return false
+	return 0
 }
 
-type UpdateUziOut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uzi           *Uzi                   `protobuf:"bytes,100,opt,name=uzi,proto3" json:"uzi,omitempty"`
+func (x *ExportDatasetOut) GetValImages() int64 {
+	if x != nil {
+		return x.ValImages
+	}
+	return 0
+}
+
+func (x *ExportDatasetOut) GetAnnotations() int64 {
+	if x != nil {
+		return x.Annotations
+	}
+	return 0
+}
+
+type ImportAnnotationsIn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// узи для кадров, в пути которых нет id узи
+	UziId   *string          `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3,oneof" json:"uzi_id,omitempty"`
+	Format  AnnotationFormat `protobuf:"varint,200,opt,name=format,proto3,enum=AnnotationFormat" json:"format,omitempty"`
+	Content []byte           `protobuf:"bytes,300,opt,name=content,proto3" json:"content,omitempty"`
+	// только отчет, без создания узлов
+	DryRun        bool `protobuf:"varint,400,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUziOut) Reset() {
-	*x = UpdateUziOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[17]
+func (x *ImportAnnotationsIn) Reset() {
+	*x = ImportAnnotationsIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUziOut) String() string {
+func (x *ImportAnnotationsIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUziOut) ProtoMessage() {}
+func (*ImportAnnotationsIn) ProtoMessage() {}
 
-func (x *UpdateUziOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[17]
+func (x *ImportAnnotationsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1211,40 +7331,63 @@ func (x *UpdateUziOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUziOut.ProtoReflect.Descriptor instead.
-func (*UpdateUziOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ImportAnnotationsIn.ProtoReflect.Descriptor instead.
+func (*ImportAnnotationsIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *UpdateUziOut) GetUzi() *Uzi {
+func (x *ImportAnnotationsIn) GetUziId() string {
+	if x != nil && x.UziId != nil {
+		return *x.UziId
+	}
+	return ""
+}
+
+func (x *ImportAnnotationsIn) GetFormat() AnnotationFormat {
 	if x != nil {
-		return x.Uzi
+		return x.Format
+	}
+	return AnnotationFormat_ANNOTATION_FORMAT_COCO
+}
+
+func (x *ImportAnnotationsIn) GetContent() []byte {
+	if x != nil {
+		return x.Content
 	}
 	return nil
 }
 
-type UpdateEchographicIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Echographic   *Echographic           `protobuf:"bytes,100,opt,name=echographic,proto3" json:"echographic,omitempty"`
+func (x *ImportAnnotationsIn) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportAnnotationsOut struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	DryRun        bool                            `protobuf:"varint,100,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Nodes         []*ImportAnnotationsOut_Node    `protobuf:"bytes,200,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Skipped       []*ImportAnnotationsOut_Skipped `protobuf:"bytes,300,rep,name=skipped,proto3" json:"skipped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEchographicIn) Reset() {
-	*x = UpdateEchographicIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[18]
+func (x *ImportAnnotationsOut) Reset() {
+	*x = ImportAnnotationsOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEchographicIn) String() string {
+func (x *ImportAnnotationsOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEchographicIn) ProtoMessage() {}
+func (*ImportAnnotationsOut) ProtoMessage() {}
 
-func (x *UpdateEchographicIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[18]
+func (x *ImportAnnotationsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,40 +7398,57 @@ func (x *UpdateEchographicIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEchographicIn.ProtoReflect.Descriptor instead.
-func (*UpdateEchographicIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ImportAnnotationsOut.ProtoReflect.Descriptor instead.
+func (*ImportAnnotationsOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *UpdateEchographicIn) GetEchographic() *Echographic {
+func (x *ImportAnnotationsOut) GetDryRun() bool {
 	if x != nil {
-		return x.Echographic
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportAnnotationsOut) GetNodes() []*ImportAnnotationsOut_Node {
+	if x != nil {
+		return x.Nodes
 	}
 	return nil
 }
 
-type UpdateEchographicOut struct {
+func (x *ImportAnnotationsOut) GetSkipped() []*ImportAnnotationsOut_Skipped {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+// значения поля в json, отсутствуют при создании и удалении объекта
+type FieldChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Echographic   *Echographic           `protobuf:"bytes,100,opt,name=echographic,proto3" json:"echographic,omitempty"`
+	Field         string                 `protobuf:"bytes,100,opt,name=field,proto3" json:"field,omitempty"`
+	Before        []byte                 `protobuf:"bytes,200,opt,name=before,proto3,oneof" json:"before,omitempty"`
+	After         []byte                 `protobuf:"bytes,300,opt,name=after,proto3,oneof" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEchographicOut) Reset() {
-	*x = UpdateEchographicOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[19]
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_grpc_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEchographicOut) String() string {
+func (x *FieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEchographicOut) ProtoMessage() {}
+func (*FieldChange) ProtoMessage() {}
 
-func (x *UpdateEchographicOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[19]
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1299,40 +7459,64 @@ func (x *UpdateEchographicOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEchographicOut.ProtoReflect.Descriptor instead.
-func (*UpdateEchographicOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *UpdateEchographicOut) GetEchographic() *Echographic {
+func (x *FieldChange) GetField() string {
 	if x != nil {
-		return x.Echographic
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetBefore() []byte {
+	if x != nil {
+		return x.Before
 	}
 	return nil
 }
 
-type DeleteUziIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+func (x *FieldChange) GetAfter() []byte {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// состояния объекта без измерений, before отсутствует при создании, after - при удалении
+type NodeVersion struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	NodeId  string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	UziId   string                 `protobuf:"bytes,200,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	Version int64                  `protobuf:"varint,300,opt,name=version,proto3" json:"version,omitempty"`
+	Action  HistoryAction          `protobuf:"varint,400,opt,name=action,proto3,enum=HistoryAction" json:"action,omitempty"`
+	// отсутствует, если изменение сделано сервисом
+	Actor         *string        `protobuf:"bytes,500,opt,name=actor,proto3,oneof" json:"actor,omitempty"`
+	Before        *Node          `protobuf:"bytes,600,opt,name=before,proto3" json:"before,omitempty"`
+	After         *Node          `protobuf:"bytes,700,opt,name=after,proto3" json:"after,omitempty"`
+	Diff          []*FieldChange `protobuf:"bytes,800,rep,name=diff,proto3" json:"diff,omitempty"`
+	CreateAt      string         `protobuf:"bytes,900,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUziIn) Reset() {
-	*x = DeleteUziIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[20]
+func (x *NodeVersion) Reset() {
+	*x = NodeVersion{}
+	mi := &file_proto_grpc_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUziIn) String() string {
+func (x *NodeVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUziIn) ProtoMessage() {}
+func (*NodeVersion) ProtoMessage() {}
 
-func (x *DeleteUziIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[20]
+func (x *NodeVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,42 +7527,104 @@ func (x *DeleteUziIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUziIn.ProtoReflect.Descriptor instead.
-func (*DeleteUziIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use NodeVersion.ProtoReflect.Descriptor instead.
+func (*NodeVersion) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *DeleteUziIn) GetId() string {
+func (x *NodeVersion) GetNodeId() string {
 	if x != nil {
-		return x.Id
+		return x.NodeId
 	}
 	return ""
 }
 
-type Image struct {
+func (x *NodeVersion) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+func (x *NodeVersion) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NodeVersion) GetAction() HistoryAction {
+	if x != nil {
+		return x.Action
+	}
+	return HistoryAction_HISTORY_ACTION_CREATE
+}
+
+func (x *NodeVersion) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+func (x *NodeVersion) GetBefore() *Node {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *NodeVersion) GetAfter() *Node {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *NodeVersion) GetDiff() []*FieldChange {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *NodeVersion) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+type SegmentVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
-	UziId         string                 `protobuf:"bytes,200,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
-	Page          int64                  `protobuf:"varint,300,opt,name=page,proto3" json:"page,omitempty"`
+	SegmentId     string                 `protobuf:"bytes,100,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,200,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Version       int64                  `protobuf:"varint,300,opt,name=version,proto3" json:"version,omitempty"`
+	Action        HistoryAction          `protobuf:"varint,400,opt,name=action,proto3,enum=HistoryAction" json:"action,omitempty"`
+	Actor         *string                `protobuf:"bytes,500,opt,name=actor,proto3,oneof" json:"actor,omitempty"`
+	Before        *Segment               `protobuf:"bytes,600,opt,name=before,proto3" json:"before,omitempty"`
+	After         *Segment               `protobuf:"bytes,700,opt,name=after,proto3" json:"after,omitempty"`
+	Diff          []*FieldChange         `protobuf:"bytes,800,rep,name=diff,proto3" json:"diff,omitempty"`
+	CreateAt      string                 `protobuf:"bytes,900,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Image) Reset() {
-	*x = Image{}
-	mi := &file_proto_grpc_service_proto_msgTypes[21]
+func (x *SegmentVersion) Reset() {
+	*x = SegmentVersion{}
+	mi := &file_proto_grpc_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Image) String() string {
+func (x *SegmentVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Image) ProtoMessage() {}
+func (*SegmentVersion) ProtoMessage() {}
 
-func (x *Image) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[21]
+func (x *SegmentVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,54 +7635,96 @@ func (x *Image) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Image.ProtoReflect.Descriptor instead.
-func (*Image) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SegmentVersion.ProtoReflect.Descriptor instead.
+func (*SegmentVersion) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *Image) GetId() string {
+func (x *SegmentVersion) GetSegmentId() string {
 	if x != nil {
-		return x.Id
+		return x.SegmentId
 	}
 	return ""
 }
 
-func (x *Image) GetUziId() string {
+func (x *SegmentVersion) GetNodeId() string {
 	if x != nil {
-		return x.UziId
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *Image) GetPage() int64 {
+func (x *SegmentVersion) GetVersion() int64 {
 	if x != nil {
-		return x.Page
+		return x.Version
 	}
 	return 0
 }
 
-type GetImagesByUziIdIn struct {
+func (x *SegmentVersion) GetAction() HistoryAction {
+	if x != nil {
+		return x.Action
+	}
+	return HistoryAction_HISTORY_ACTION_CREATE
+}
+
+func (x *SegmentVersion) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+func (x *SegmentVersion) GetBefore() *Segment {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *SegmentVersion) GetAfter() *Segment {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *SegmentVersion) GetDiff() []*FieldChange {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *SegmentVersion) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+type GetNodeHistoryIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetImagesByUziIdIn) Reset() {
-	*x = GetImagesByUziIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[22]
+func (x *GetNodeHistoryIn) Reset() {
+	*x = GetNodeHistoryIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetImagesByUziIdIn) String() string {
+func (x *GetNodeHistoryIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetImagesByUziIdIn) ProtoMessage() {}
+func (*GetNodeHistoryIn) ProtoMessage() {}
 
-func (x *GetImagesByUziIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[22]
+func (x *GetNodeHistoryIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,40 +7735,40 @@ func (x *GetImagesByUziIdIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetImagesByUziIdIn.ProtoReflect.Descriptor instead.
-func (*GetImagesByUziIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetNodeHistoryIn.ProtoReflect.Descriptor instead.
+func (*GetNodeHistoryIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetImagesByUziIdIn) GetUziId() string {
+func (x *GetNodeHistoryIn) GetNodeId() string {
 	if x != nil {
-		return x.UziId
+		return x.NodeId
 	}
 	return ""
 }
 
-type GetImagesByUziIdOut struct {
+type GetNodeHistoryOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Images        []*Image               `protobuf:"bytes,100,rep,name=images,proto3" json:"images,omitempty"`
+	Versions      []*NodeVersion         `protobuf:"bytes,100,rep,name=versions,proto3" json:"versions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetImagesByUziIdOut) Reset() {
-	*x = GetImagesByUziIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[23]
+func (x *GetNodeHistoryOut) Reset() {
+	*x = GetNodeHistoryOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetImagesByUziIdOut) String() string {
+func (x *GetNodeHistoryOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetImagesByUziIdOut) ProtoMessage() {}
+func (*GetNodeHistoryOut) ProtoMessage() {}
 
-func (x *GetImagesByUziIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[23]
+func (x *GetNodeHistoryOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,47 +7779,40 @@ func (x *GetImagesByUziIdOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetImagesByUziIdOut.ProtoReflect.Descriptor instead.
-func (*GetImagesByUziIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetNodeHistoryOut.ProtoReflect.Descriptor instead.
+func (*GetNodeHistoryOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetImagesByUziIdOut) GetImages() []*Image {
+func (x *GetNodeHistoryOut) GetVersions() []*NodeVersion {
 	if x != nil {
-		return x.Images
+		return x.Versions
 	}
 	return nil
 }
 
-type Node struct {
+type GetSegmentHistoryIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
-	Ai            bool                   `protobuf:"varint,200,opt,name=ai,proto3" json:"ai,omitempty"`
-	Validation    *NodeValidation        `protobuf:"varint,300,opt,name=validation,proto3,enum=NodeValidation,oneof" json:"validation,omitempty"`
-	UziId         string                 `protobuf:"bytes,400,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
-	Tirads_23     float64                `protobuf:"fixed64,500,opt,name=tirads_23,json=tirads23,proto3" json:"tirads_23,omitempty"`
-	Tirads_4      float64                `protobuf:"fixed64,600,opt,name=tirads_4,json=tirads4,proto3" json:"tirads_4,omitempty"`
-	Tirads_5      float64                `protobuf:"fixed64,700,opt,name=tirads_5,json=tirads5,proto3" json:"tirads_5,omitempty"`
-	Description   *string                `protobuf:"bytes,800,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	SegmentId     string                 `protobuf:"bytes,100,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Node) Reset() {
-	*x = Node{}
-	mi := &file_proto_grpc_service_proto_msgTypes[24]
+func (x *GetSegmentHistoryIn) Reset() {
+	*x = GetSegmentHistoryIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Node) String() string {
+func (x *GetSegmentHistoryIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Node) ProtoMessage() {}
+func (*GetSegmentHistoryIn) ProtoMessage() {}
 
-func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[24]
+func (x *GetSegmentHistoryIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,89 +7823,85 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Node.ProtoReflect.Descriptor instead.
-func (*Node) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetSegmentHistoryIn.ProtoReflect.Descriptor instead.
+func (*GetSegmentHistoryIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *Node) GetId() string {
+func (x *GetSegmentHistoryIn) GetSegmentId() string {
 	if x != nil {
-		return x.Id
+		return x.SegmentId
 	}
 	return ""
 }
 
-func (x *Node) GetAi() bool {
-	if x != nil {
-		return x.Ai
-	}
-	return false
+type GetSegmentHistoryOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*SegmentVersion      `protobuf:"bytes,100,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Node) GetValidation() NodeValidation {
-	if x != nil && x.Validation != nil {
-		return *x.Validation
-	}
-	return NodeValidation_NODE_VALIDATION_NULL
+func (x *GetSegmentHistoryOut) Reset() {
+	*x = GetSegmentHistoryOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Node) GetUziId() string {
-	if x != nil {
-		return x.UziId
-	}
-	return ""
+func (x *GetSegmentHistoryOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Node) GetTirads_23() float64 {
-	if x != nil {
-		return x.Tirads_23
-	}
-	return 0
-}
+func (*GetSegmentHistoryOut) ProtoMessage() {}
 
-func (x *Node) GetTirads_4() float64 {
+func (x *GetSegmentHistoryOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[110]
 	if x != nil {
-		return x.Tirads_4
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Node) GetTirads_5() float64 {
-	if x != nil {
-		return x.Tirads_5
-	}
-	return 0
+// Deprecated: Use GetSegmentHistoryOut.ProtoReflect.Descriptor instead.
+func (*GetSegmentHistoryOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *Node) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *GetSegmentHistoryOut) GetVersions() []*SegmentVersion {
+	if x != nil {
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-type GetNodesByUziIdIn struct {
+type RestoreNodeIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Version       int64                  `protobuf:"varint,200,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodesByUziIdIn) Reset() {
-	*x = GetNodesByUziIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[25]
+func (x *RestoreNodeIn) Reset() {
+	*x = RestoreNodeIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodesByUziIdIn) String() string {
+func (x *RestoreNodeIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodesByUziIdIn) ProtoMessage() {}
+func (*RestoreNodeIn) ProtoMessage() {}
 
-func (x *GetNodesByUziIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[25]
+func (x *RestoreNodeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,40 +7912,47 @@ func (x *GetNodesByUziIdIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodesByUziIdIn.ProtoReflect.Descriptor instead.
-func (*GetNodesByUziIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use RestoreNodeIn.ProtoReflect.Descriptor instead.
+func (*RestoreNodeIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetNodesByUziIdIn) GetUziId() string {
+func (x *RestoreNodeIn) GetNodeId() string {
 	if x != nil {
-		return x.UziId
+		return x.NodeId
 	}
 	return ""
 }
 
-type GetNodesByUziIdOut struct {
+func (x *RestoreNodeIn) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RestoreNodeOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*Node                `protobuf:"bytes,100,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Node          *Node                  `protobuf:"bytes,100,opt,name=node,proto3" json:"node,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodesByUziIdOut) Reset() {
-	*x = GetNodesByUziIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[26]
+func (x *RestoreNodeOut) Reset() {
+	*x = RestoreNodeOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodesByUziIdOut) String() string {
+func (x *RestoreNodeOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodesByUziIdOut) ProtoMessage() {}
+func (*RestoreNodeOut) ProtoMessage() {}
 
-func (x *GetNodesByUziIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[26]
+func (x *RestoreNodeOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,44 +7963,41 @@ func (x *GetNodesByUziIdOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodesByUziIdOut.ProtoReflect.Descriptor instead.
-func (*GetNodesByUziIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use RestoreNodeOut.ProtoReflect.Descriptor instead.
+func (*RestoreNodeOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetNodesByUziIdOut) GetNodes() []*Node {
+func (x *RestoreNodeOut) GetNode() *Node {
 	if x != nil {
-		return x.Nodes
+		return x.Node
 	}
 	return nil
 }
 
-type UpdateNodeIn struct {
+type RestoreSegmentIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
-	Validation    *NodeValidation        `protobuf:"varint,200,opt,name=validation,proto3,enum=NodeValidation,oneof" json:"validation,omitempty"`
-	Tirads_23     *float64               `protobuf:"fixed64,300,opt,name=tirads_23,json=tirads23,proto3,oneof" json:"tirads_23,omitempty"`
-	Tirads_4      *float64               `protobuf:"fixed64,400,opt,name=tirads_4,json=tirads4,proto3,oneof" json:"tirads_4,omitempty"`
-	Tirads_5      *float64               `protobuf:"fixed64,500,opt,name=tirads_5,json=tirads5,proto3,oneof" json:"tirads_5,omitempty"`
+	SegmentId     string                 `protobuf:"bytes,100,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Version       int64                  `protobuf:"varint,200,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateNodeIn) Reset() {
-	*x = UpdateNodeIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[27]
+func (x *RestoreSegmentIn) Reset() {
+	*x = RestoreSegmentIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNodeIn) String() string {
+func (x *RestoreSegmentIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNodeIn) ProtoMessage() {}
+func (*RestoreSegmentIn) ProtoMessage() {}
 
-func (x *UpdateNodeIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[27]
+func (x *RestoreSegmentIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1727,68 +8008,47 @@ func (x *UpdateNodeIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNodeIn.ProtoReflect.Descriptor instead.
-func (*UpdateNodeIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use RestoreSegmentIn.ProtoReflect.Descriptor instead.
+func (*RestoreSegmentIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *UpdateNodeIn) GetId() string {
+func (x *RestoreSegmentIn) GetSegmentId() string {
 	if x != nil {
-		return x.Id
+		return x.SegmentId
 	}
 	return ""
 }
 
-func (x *UpdateNodeIn) GetValidation() NodeValidation {
-	if x != nil && x.Validation != nil {
-		return *x.Validation
-	}
-	return NodeValidation_NODE_VALIDATION_NULL
-}
-
-func (x *UpdateNodeIn) GetTirads_23() float64 {
-	if x != nil && x.Tirads_23 != nil {
-		return *x.Tirads_23
-	}
-	return 0
-}
-
-func (x *UpdateNodeIn) GetTirads_4() float64 {
-	if x != nil && x.Tirads_4 != nil {
-		return *x.Tirads_4
-	}
-	return 0
-}
-
-func (x *UpdateNodeIn) GetTirads_5() float64 {
-	if x != nil && x.Tirads_5 != nil {
-		return *x.Tirads_5
+func (x *RestoreSegmentIn) GetVersion() int64 {
+	if x != nil {
+		return x.Version
 	}
 	return 0
 }
 
-type UpdateNodeOut struct {
+type RestoreSegmentOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *Node                  `protobuf:"bytes,100,opt,name=node,proto3" json:"node,omitempty"`
+	Segment       *Segment               `protobuf:"bytes,100,opt,name=segment,proto3" json:"segment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateNodeOut) Reset() {
-	*x = UpdateNodeOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[28]
+func (x *RestoreSegmentOut) Reset() {
+	*x = RestoreSegmentOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNodeOut) String() string {
+func (x *RestoreSegmentOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNodeOut) ProtoMessage() {}
+func (*RestoreSegmentOut) ProtoMessage() {}
 
-func (x *UpdateNodeOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[28]
+func (x *RestoreSegmentOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,47 +8059,40 @@ func (x *UpdateNodeOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNodeOut.ProtoReflect.Descriptor instead.
-func (*UpdateNodeOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RestoreSegmentOut.ProtoReflect.Descriptor instead.
+func (*RestoreSegmentOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *UpdateNodeOut) GetNode() *Node {
+func (x *RestoreSegmentOut) GetSegment() *Segment {
 	if x != nil {
-		return x.Node
+		return x.Segment
 	}
 	return nil
 }
 
-type Segment struct {
+type RestoreUziIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
-	ImageId       string                 `protobuf:"bytes,200,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,300,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Contor        []byte                 `protobuf:"bytes,400,opt,name=contor,proto3" json:"contor,omitempty"`
-	Ai            bool                   `protobuf:"varint,500,opt,name=ai,proto3" json:"ai,omitempty"`
-	Tirads_23     float64                `protobuf:"fixed64,600,opt,name=tirads_23,json=tirads23,proto3" json:"tirads_23,omitempty"`
-	Tirads_4      float64                `protobuf:"fixed64,700,opt,name=tirads_4,json=tirads4,proto3" json:"tirads_4,omitempty"`
-	Tirads_5      float64                `protobuf:"fixed64,800,opt,name=tirads_5,json=tirads5,proto3" json:"tirads_5,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Segment) Reset() {
-	*x = Segment{}
-	mi := &file_proto_grpc_service_proto_msgTypes[29]
+func (x *RestoreUziIn) Reset() {
+	*x = RestoreUziIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Segment) String() string {
+func (x *RestoreUziIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Segment) ProtoMessage() {}
+func (*RestoreUziIn) ProtoMessage() {}
 
-func (x *Segment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[29]
+func (x *RestoreUziIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,94 +8103,85 @@ func (x *Segment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Segment.ProtoReflect.Descriptor instead.
-func (*Segment) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use RestoreUziIn.ProtoReflect.Descriptor instead.
+func (*RestoreUziIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *Segment) GetId() string {
+func (x *RestoreUziIn) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Segment) GetImageId() string {
-	if x != nil {
-		return x.ImageId
-	}
-	return ""
+type RestoreUziOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uzi           *Uzi                   `protobuf:"bytes,100,opt,name=uzi,proto3" json:"uzi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Segment) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
+func (x *RestoreUziOut) Reset() {
+	*x = RestoreUziOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Segment) GetContor() []byte {
-	if x != nil {
-		return x.Contor
-	}
-	return nil
+func (x *RestoreUziOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Segment) GetAi() bool {
-	if x != nil {
-		return x.Ai
-	}
-	return false
-}
+func (*RestoreUziOut) ProtoMessage() {}
 
-func (x *Segment) GetTirads_23() float64 {
+func (x *RestoreUziOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[116]
 	if x != nil {
-		return x.Tirads_23
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Segment) GetTirads_4() float64 {
-	if x != nil {
-		return x.Tirads_4
-	}
-	return 0
+// Deprecated: Use RestoreUziOut.ProtoReflect.Descriptor instead.
+func (*RestoreUziOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *Segment) GetTirads_5() float64 {
+func (x *RestoreUziOut) GetUzi() *Uzi {
 	if x != nil {
-		return x.Tirads_5
+		return x.Uzi
 	}
-	return 0
+	return nil
 }
 
-type CreateSegmentIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImageId       string                 `protobuf:"bytes,100,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,200,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Contor        []byte                 `protobuf:"bytes,300,opt,name=contor,proto3" json:"contor,omitempty"`
-	Tirads_23     float64                `protobuf:"fixed64,400,opt,name=tirads_23,json=tirads23,proto3" json:"tirads_23,omitempty"`
-	Tirads_4      float64                `protobuf:"fixed64,500,opt,name=tirads_4,json=tirads4,proto3" json:"tirads_4,omitempty"`
-	Tirads_5      float64                `protobuf:"fixed64,600,opt,name=tirads_5,json=tirads5,proto3" json:"tirads_5,omitempty"`
+type SweepStorageOrphansIn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// только найти объекты-сироты, не удаляя
+	DryRun        bool `protobuf:"varint,100,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSegmentIn) Reset() {
-	*x = CreateSegmentIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[30]
+func (x *SweepStorageOrphansIn) Reset() {
+	*x = SweepStorageOrphansIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSegmentIn) String() string {
+func (x *SweepStorageOrphansIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSegmentIn) ProtoMessage() {}
+func (*SweepStorageOrphansIn) ProtoMessage() {}
 
-func (x *CreateSegmentIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[30]
+func (x *SweepStorageOrphansIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1948,75 +8192,100 @@ func (x *CreateSegmentIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSegmentIn.ProtoReflect.Descriptor instead.
-func (*CreateSegmentIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SweepStorageOrphansIn.ProtoReflect.Descriptor instead.
+func (*SweepStorageOrphansIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SweepStorageOrphansIn) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SweepStorageOrphansOut struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	DryRun  bool                   `protobuf:"varint,100,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Checked int64                  `protobuf:"varint,200,opt,name=checked,proto3" json:"checked,omitempty"`
+	// пути объектов без узи или кадра в БД
+	Orphans       []string `protobuf:"bytes,300,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SweepStorageOrphansOut) Reset() {
+	*x = SweepStorageOrphansOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SweepStorageOrphansOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateSegmentIn) GetImageId() string {
-	if x != nil {
-		return x.ImageId
-	}
-	return ""
-}
+func (*SweepStorageOrphansOut) ProtoMessage() {}
 
-func (x *CreateSegmentIn) GetNodeId() string {
+func (x *SweepStorageOrphansOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[118]
 	if x != nil {
-		return x.NodeId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSegmentIn) GetContor() []byte {
-	if x != nil {
-		return x.Contor
-	}
-	return nil
+// Deprecated: Use SweepStorageOrphansOut.ProtoReflect.Descriptor instead.
+func (*SweepStorageOrphansOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *CreateSegmentIn) GetTirads_23() float64 {
+func (x *SweepStorageOrphansOut) GetDryRun() bool {
 	if x != nil {
-		return x.Tirads_23
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *CreateSegmentIn) GetTirads_4() float64 {
+func (x *SweepStorageOrphansOut) GetChecked() int64 {
 	if x != nil {
-		return x.Tirads_4
+		return x.Checked
 	}
 	return 0
 }
 
-func (x *CreateSegmentIn) GetTirads_5() float64 {
+func (x *SweepStorageOrphansOut) GetOrphans() []string {
 	if x != nil {
-		return x.Tirads_5
+		return x.Orphans
 	}
-	return 0
+	return nil
 }
 
-type CreateSegmentOut struct {
+type VerifyUziIntegrityIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSegmentOut) Reset() {
-	*x = CreateSegmentOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[31]
+func (x *VerifyUziIntegrityIn) Reset() {
+	*x = VerifyUziIntegrityIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSegmentOut) String() string {
+func (x *VerifyUziIntegrityIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSegmentOut) ProtoMessage() {}
+func (*VerifyUziIntegrityIn) ProtoMessage() {}
 
-func (x *CreateSegmentOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[31]
+func (x *VerifyUziIntegrityIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2027,40 +8296,35 @@ func (x *CreateSegmentOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSegmentOut.ProtoReflect.Descriptor instead.
-func (*CreateSegmentOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *CreateSegmentOut) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use VerifyUziIntegrityIn.ProtoReflect.Descriptor instead.
+func (*VerifyUziIntegrityIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{119}
 }
 
-type GetSegmentsByNodeIdIn struct {
+type IntegrityMismatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	UziId         string                 `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	Expected      string                 `protobuf:"bytes,200,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual        string                 `protobuf:"bytes,300,opt,name=actual,proto3" json:"actual,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSegmentsByNodeIdIn) Reset() {
-	*x = GetSegmentsByNodeIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[32]
+func (x *IntegrityMismatch) Reset() {
+	*x = IntegrityMismatch{}
+	mi := &file_proto_grpc_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSegmentsByNodeIdIn) String() string {
+func (x *IntegrityMismatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentsByNodeIdIn) ProtoMessage() {}
+func (*IntegrityMismatch) ProtoMessage() {}
 
-func (x *GetSegmentsByNodeIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[32]
+func (x *IntegrityMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2071,40 +8335,58 @@ func (x *GetSegmentsByNodeIdIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentsByNodeIdIn.ProtoReflect.Descriptor instead.
-func (*GetSegmentsByNodeIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use IntegrityMismatch.ProtoReflect.Descriptor instead.
+func (*IntegrityMismatch) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GetSegmentsByNodeIdIn) GetNodeId() string {
+func (x *IntegrityMismatch) GetUziId() string {
 	if x != nil {
-		return x.NodeId
+		return x.UziId
 	}
 	return ""
 }
 
-type GetSegmentsByNodeIdOut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Segments      []*Segment             `protobuf:"bytes,100,rep,name=segments,proto3" json:"segments,omitempty"`
+func (x *IntegrityMismatch) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *IntegrityMismatch) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+type VerifyUziIntegrityOut struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// число проверенных узи с сохраненной sha256
+	Checked int64 `protobuf:"varint,100,opt,name=checked,proto3" json:"checked,omitempty"`
+	// узи, исходный файл которых отсутствует в S3
+	Missing       []string             `protobuf:"bytes,200,rep,name=missing,proto3" json:"missing,omitempty"`
+	Mismatches    []*IntegrityMismatch `protobuf:"bytes,300,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSegmentsByNodeIdOut) Reset() {
-	*x = GetSegmentsByNodeIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[33]
+func (x *VerifyUziIntegrityOut) Reset() {
+	*x = VerifyUziIntegrityOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSegmentsByNodeIdOut) String() string {
+func (x *VerifyUziIntegrityOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentsByNodeIdOut) ProtoMessage() {}
+func (*VerifyUziIntegrityOut) ProtoMessage() {}
 
-func (x *GetSegmentsByNodeIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[33]
+func (x *VerifyUziIntegrityOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2115,44 +8397,61 @@ func (x *GetSegmentsByNodeIdOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentsByNodeIdOut.ProtoReflect.Descriptor instead.
-func (*GetSegmentsByNodeIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use VerifyUziIntegrityOut.ProtoReflect.Descriptor instead.
+func (*VerifyUziIntegrityOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GetSegmentsByNodeIdOut) GetSegments() []*Segment {
+func (x *VerifyUziIntegrityOut) GetChecked() int64 {
 	if x != nil {
-		return x.Segments
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *VerifyUziIntegrityOut) GetMissing() []string {
+	if x != nil {
+		return x.Missing
 	}
 	return nil
 }
 
-type UpdateSegmentIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
-	Contor        []byte                 `protobuf:"bytes,200,opt,name=contor,proto3,oneof" json:"contor,omitempty"`
-	Tirads_23     *float64               `protobuf:"fixed64,300,opt,name=tirads_23,json=tirads23,proto3,oneof" json:"tirads_23,omitempty"`
-	Tirads_4      *float64               `protobuf:"fixed64,400,opt,name=tirads_4,json=tirads4,proto3,oneof" json:"tirads_4,omitempty"`
-	Tirads_5      *float64               `protobuf:"fixed64,500,opt,name=tirads_5,json=tirads5,proto3,oneof" json:"tirads_5,omitempty"`
+func (x *VerifyUziIntegrityOut) GetMismatches() []*IntegrityMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+// все фильтры необязательны, мягко удаленные узи не учитываются
+type GetUziAnalyticsIn struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId *int64                 `protobuf:"varint,100,opt,name=device_id,json=deviceId,proto3,oneof" json:"device_id,omitempty"`
+	Author   *string                `protobuf:"bytes,200,opt,name=author,proto3,oneof" json:"author,omitempty"`
+	// границы даты создания узи включительно, RFC3339
+	CreateFrom *string `protobuf:"bytes,300,opt,name=create_from,json=createFrom,proto3,oneof" json:"create_from,omitempty"`
+	CreateTo   *string `protobuf:"bytes,400,opt,name=create_to,json=createTo,proto3,oneof" json:"create_to,omitempty"`
+	// шаг группировки by_period, периоды в UTC
+	Period        AnalyticsPeriod `protobuf:"varint,500,opt,name=period,proto3,enum=AnalyticsPeriod" json:"period,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSegmentIn) Reset() {
-	*x = UpdateSegmentIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[34]
+func (x *GetUziAnalyticsIn) Reset() {
+	*x = GetUziAnalyticsIn{}
+	mi := &file_proto_grpc_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSegmentIn) String() string {
+func (x *GetUziAnalyticsIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSegmentIn) ProtoMessage() {}
+func (*GetUziAnalyticsIn) ProtoMessage() {}
 
-func (x *UpdateSegmentIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[34]
+func (x *GetUziAnalyticsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,68 +8462,71 @@ func (x *UpdateSegmentIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSegmentIn.ProtoReflect.Descriptor instead.
-func (*UpdateSegmentIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetUziAnalyticsIn.ProtoReflect.Descriptor instead.
+func (*GetUziAnalyticsIn) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *UpdateSegmentIn) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *GetUziAnalyticsIn) GetDeviceId() int64 {
+	if x != nil && x.DeviceId != nil {
+		return *x.DeviceId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSegmentIn) GetContor() []byte {
-	if x != nil {
-		return x.Contor
+func (x *GetUziAnalyticsIn) GetAuthor() string {
+	if x != nil && x.Author != nil {
+		return *x.Author
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateSegmentIn) GetTirads_23() float64 {
-	if x != nil && x.Tirads_23 != nil {
-		return *x.Tirads_23
+func (x *GetUziAnalyticsIn) GetCreateFrom() string {
+	if x != nil && x.CreateFrom != nil {
+		return *x.CreateFrom
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateSegmentIn) GetTirads_4() float64 {
-	if x != nil && x.Tirads_4 != nil {
-		return *x.Tirads_4
+func (x *GetUziAnalyticsIn) GetCreateTo() string {
+	if x != nil && x.CreateTo != nil {
+		return *x.CreateTo
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateSegmentIn) GetTirads_5() float64 {
-	if x != nil && x.Tirads_5 != nil {
-		return *x.Tirads_5
+func (x *GetUziAnalyticsIn) GetPeriod() AnalyticsPeriod {
+	if x != nil {
+		return x.Period
 	}
-	return 0
+	return AnalyticsPeriod_ANALYTICS_PERIOD_MONTH
 }
 
-type UpdateSegmentOut struct {
+// число узлов по классу с наибольшей вероятностью
+type TiradsDistribution struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Segment       *Segment               `protobuf:"bytes,100,opt,name=segment,proto3" json:"segment,omitempty"`
+	Tirads_23     int64                  `protobuf:"varint,100,opt,name=tirads_23,json=tirads23,proto3" json:"tirads_23,omitempty"`
+	Tirads_4      int64                  `protobuf:"varint,200,opt,name=tirads_4,json=tirads4,proto3" json:"tirads_4,omitempty"`
+	Tirads_5      int64                  `protobuf:"varint,300,opt,name=tirads_5,json=tirads5,proto3" json:"tirads_5,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSegmentOut) Reset() {
-	*x = UpdateSegmentOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[35]
+func (x *TiradsDistribution) Reset() {
+	*x = TiradsDistribution{}
+	mi := &file_proto_grpc_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSegmentOut) String() string {
+func (x *TiradsDistribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSegmentOut) ProtoMessage() {}
+func (*TiradsDistribution) ProtoMessage() {}
 
-func (x *UpdateSegmentOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[35]
+func (x *TiradsDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2235,42 +8537,65 @@ func (x *UpdateSegmentOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSegmentOut.ProtoReflect.Descriptor instead.
-func (*UpdateSegmentOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use TiradsDistribution.ProtoReflect.Descriptor instead.
+func (*TiradsDistribution) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *UpdateSegmentOut) GetSegment() *Segment {
+func (x *TiradsDistribution) GetTirads_23() int64 {
 	if x != nil {
-		return x.Segment
+		return x.Tirads_23
 	}
-	return nil
+	return 0
 }
 
-type CreateNodeWithSegmentsIn struct {
-	state         protoimpl.MessageState              `protogen:"open.v1"`
-	UziId         string                              `protobuf:"bytes,100,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
-	Node          *CreateNodeWithSegmentsIn_Node      `protobuf:"bytes,200,opt,name=node,proto3" json:"node,omitempty"`
-	Segments      []*CreateNodeWithSegmentsIn_Segment `protobuf:"bytes,300,rep,name=segments,proto3" json:"segments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TiradsDistribution) GetTirads_4() int64 {
+	if x != nil {
+		return x.Tirads_4
+	}
+	return 0
 }
 
-func (x *CreateNodeWithSegmentsIn) Reset() {
-	*x = CreateNodeWithSegmentsIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[36]
+func (x *TiradsDistribution) GetTirads_5() int64 {
+	if x != nil {
+		return x.Tirads_5
+	}
+	return 0
+}
+
+type NodeMetrics struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Uzis        int64                  `protobuf:"varint,100,opt,name=uzis,proto3" json:"uzis,omitempty"`
+	AiNodes     int64                  `protobuf:"varint,200,opt,name=ai_nodes,json=aiNodes,proto3" json:"ai_nodes,omitempty"`
+	Valid       int64                  `protobuf:"varint,300,opt,name=valid,proto3" json:"valid,omitempty"`
+	Invalid     int64                  `protobuf:"varint,400,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	Unvalidated int64                  `protobuf:"varint,500,opt,name=unvalidated,proto3" json:"unvalidated,omitempty"`
+	ManualNodes int64                  `protobuf:"varint,600,opt,name=manual_nodes,json=manualNodes,proto3" json:"manual_nodes,omitempty"`
+	// valid / (valid + invalid), отсутствует без провалидированных узлов
+	Precision *float64 `protobuf:"fixed64,700,opt,name=precision,proto3,oneof" json:"precision,omitempty"`
+	// unvalidated / ai_nodes, отсутствует без нейросетевых узлов
+	UnvalidatedShare *float64            `protobuf:"fixed64,800,opt,name=unvalidated_share,json=unvalidatedShare,proto3,oneof" json:"unvalidated_share,omitempty"`
+	AiTirads         *TiradsDistribution `protobuf:"bytes,900,opt,name=ai_tirads,json=aiTirads,proto3" json:"ai_tirads,omitempty"`
+	ManualTirads     *TiradsDistribution `protobuf:"bytes,1000,opt,name=manual_tirads,json=manualTirads,proto3" json:"manual_tirads,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NodeMetrics) Reset() {
+	*x = NodeMetrics{}
+	mi := &file_proto_grpc_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNodeWithSegmentsIn) String() string {
+func (x *NodeMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNodeWithSegmentsIn) ProtoMessage() {}
+func (*NodeMetrics) ProtoMessage() {}
 
-func (x *CreateNodeWithSegmentsIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[36]
+func (x *NodeMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2281,55 +8606,104 @@ func (x *CreateNodeWithSegmentsIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNodeWithSegmentsIn.ProtoReflect.Descriptor instead.
-func (*CreateNodeWithSegmentsIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use NodeMetrics.ProtoReflect.Descriptor instead.
+func (*NodeMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *CreateNodeWithSegmentsIn) GetUziId() string {
+func (x *NodeMetrics) GetUzis() int64 {
 	if x != nil {
-		return x.UziId
+		return x.Uzis
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateNodeWithSegmentsIn) GetNode() *CreateNodeWithSegmentsIn_Node {
+func (x *NodeMetrics) GetAiNodes() int64 {
 	if x != nil {
-		return x.Node
+		return x.AiNodes
+	}
+	return 0
+}
+
+func (x *NodeMetrics) GetValid() int64 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *NodeMetrics) GetInvalid() int64 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *NodeMetrics) GetUnvalidated() int64 {
+	if x != nil {
+		return x.Unvalidated
+	}
+	return 0
+}
+
+func (x *NodeMetrics) GetManualNodes() int64 {
+	if x != nil {
+		return x.ManualNodes
+	}
+	return 0
+}
+
+func (x *NodeMetrics) GetPrecision() float64 {
+	if x != nil && x.Precision != nil {
+		return *x.Precision
+	}
+	return 0
+}
+
+func (x *NodeMetrics) GetUnvalidatedShare() float64 {
+	if x != nil && x.UnvalidatedShare != nil {
+		return *x.UnvalidatedShare
+	}
+	return 0
+}
+
+func (x *NodeMetrics) GetAiTirads() *TiradsDistribution {
+	if x != nil {
+		return x.AiTirads
 	}
 	return nil
 }
 
-func (x *CreateNodeWithSegmentsIn) GetSegments() []*CreateNodeWithSegmentsIn_Segment {
+func (x *NodeMetrics) GetManualTirads() *TiradsDistribution {
 	if x != nil {
-		return x.Segments
+		return x.ManualTirads
 	}
 	return nil
 }
 
-type CreateNodeWithSegmentsOut struct {
+type DeviceNodeMetrics struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,100,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	SegmentIds    []string               `protobuf:"bytes,200,rep,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+	DeviceId      int64                  `protobuf:"varint,100,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Metrics       *NodeMetrics           `protobuf:"bytes,200,opt,name=metrics,proto3" json:"metrics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNodeWithSegmentsOut) Reset() {
-	*x = CreateNodeWithSegmentsOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[37]
+func (x *DeviceNodeMetrics) Reset() {
+	*x = DeviceNodeMetrics{}
+	mi := &file_proto_grpc_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNodeWithSegmentsOut) String() string {
+func (x *DeviceNodeMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNodeWithSegmentsOut) ProtoMessage() {}
+func (*DeviceNodeMetrics) ProtoMessage() {}
 
-func (x *CreateNodeWithSegmentsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[37]
+func (x *DeviceNodeMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2340,47 +8714,49 @@ func (x *CreateNodeWithSegmentsOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNodeWithSegmentsOut.ProtoReflect.Descriptor instead.
-func (*CreateNodeWithSegmentsOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use DeviceNodeMetrics.ProtoReflect.Descriptor instead.
+func (*DeviceNodeMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *CreateNodeWithSegmentsOut) GetNodeId() string {
+func (x *DeviceNodeMetrics) GetDeviceId() int64 {
 	if x != nil {
-		return x.NodeId
+		return x.DeviceId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateNodeWithSegmentsOut) GetSegmentIds() []string {
+func (x *DeviceNodeMetrics) GetMetrics() *NodeMetrics {
 	if x != nil {
-		return x.SegmentIds
+		return x.Metrics
 	}
 	return nil
 }
 
-type GetNodesWithSegmentsByImageIdIn struct {
+// по автору узи
+type AuthorNodeMetrics struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	Author        string                 `protobuf:"bytes,100,opt,name=author,proto3" json:"author,omitempty"`
+	Metrics       *NodeMetrics           `protobuf:"bytes,200,opt,name=metrics,proto3" json:"metrics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodesWithSegmentsByImageIdIn) Reset() {
-	*x = GetNodesWithSegmentsByImageIdIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[38]
+func (x *AuthorNodeMetrics) Reset() {
+	*x = AuthorNodeMetrics{}
+	mi := &file_proto_grpc_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodesWithSegmentsByImageIdIn) String() string {
+func (x *AuthorNodeMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodesWithSegmentsByImageIdIn) ProtoMessage() {}
+func (*AuthorNodeMetrics) ProtoMessage() {}
 
-func (x *GetNodesWithSegmentsByImageIdIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[38]
+func (x *AuthorNodeMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2391,41 +8767,49 @@ func (x *GetNodesWithSegmentsByImageIdIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodesWithSegmentsByImageIdIn.ProtoReflect.Descriptor instead.
-func (*GetNodesWithSegmentsByImageIdIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use AuthorNodeMetrics.ProtoReflect.Descriptor instead.
+func (*AuthorNodeMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GetNodesWithSegmentsByImageIdIn) GetId() string {
+func (x *AuthorNodeMetrics) GetAuthor() string {
 	if x != nil {
-		return x.Id
+		return x.Author
 	}
 	return ""
 }
 
-type GetNodesWithSegmentsByImageIdOut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*Node                `protobuf:"bytes,100,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Segments      []*Segment             `protobuf:"bytes,200,rep,name=segments,proto3" json:"segments,omitempty"`
+func (x *AuthorNodeMetrics) GetMetrics() *NodeMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type PeriodNodeMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// начало периода, RFC3339
+	Start         string       `protobuf:"bytes,100,opt,name=start,proto3" json:"start,omitempty"`
+	Metrics       *NodeMetrics `protobuf:"bytes,200,opt,name=metrics,proto3" json:"metrics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodesWithSegmentsByImageIdOut) Reset() {
-	*x = GetNodesWithSegmentsByImageIdOut{}
-	mi := &file_proto_grpc_service_proto_msgTypes[39]
+func (x *PeriodNodeMetrics) Reset() {
+	*x = PeriodNodeMetrics{}
+	mi := &file_proto_grpc_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodesWithSegmentsByImageIdOut) String() string {
+func (x *PeriodNodeMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodesWithSegmentsByImageIdOut) ProtoMessage() {}
+func (*PeriodNodeMetrics) ProtoMessage() {}
 
-func (x *GetNodesWithSegmentsByImageIdOut) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[39]
+func (x *PeriodNodeMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,47 +8820,58 @@ func (x *GetNodesWithSegmentsByImageIdOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodesWithSegmentsByImageIdOut.ProtoReflect.Descriptor instead.
-func (*GetNodesWithSegmentsByImageIdOut) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use PeriodNodeMetrics.ProtoReflect.Descriptor instead.
+func (*PeriodNodeMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GetNodesWithSegmentsByImageIdOut) GetNodes() []*Node {
+func (x *PeriodNodeMetrics) GetStart() string {
 	if x != nil {
-		return x.Nodes
+		return x.Start
 	}
-	return nil
+	return ""
 }
 
-func (x *GetNodesWithSegmentsByImageIdOut) GetSegments() []*Segment {
+func (x *PeriodNodeMetrics) GetMetrics() *NodeMetrics {
 	if x != nil {
-		return x.Segments
+		return x.Metrics
 	}
 	return nil
 }
 
-type DeleteNodeIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+// согласие двух врачей, создававших ручные узлы на одних и тех же узи
+type ReaderAgreement struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ReaderA string                 `protobuf:"bytes,100,opt,name=reader_a,json=readerA,proto3" json:"reader_a,omitempty"`
+	ReaderB string                 `protobuf:"bytes,200,opt,name=reader_b,json=readerB,proto3" json:"reader_b,omitempty"`
+	Uzis    int64                  `protobuf:"varint,300,opt,name=uzis,proto3" json:"uzis,omitempty"`
+	// пары узлов, сопоставленных по IoU контуров
+	Matched int64 `protobuf:"varint,400,opt,name=matched,proto3" json:"matched,omitempty"`
+	// узлы, отмеченные только одним врачом
+	Unmatched int64 `protobuf:"varint,500,opt,name=unmatched,proto3" json:"unmatched,omitempty"`
+	// каппа Коэна по классам TI-RADS, несопоставленный узел - класс "нет узла"
+	Kappa *float64 `protobuf:"fixed64,600,opt,name=kappa,proto3,oneof" json:"kappa,omitempty"`
+	// средний IoU контуров сопоставленных узлов
+	MeanIou       *float64 `protobuf:"fixed64,700,opt,name=mean_iou,json=meanIou,proto3,oneof" json:"mean_iou,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNodeIn) Reset() {
-	*x = DeleteNodeIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[40]
+func (x *ReaderAgreement) Reset() {
+	*x = ReaderAgreement{}
+	mi := &file_proto_grpc_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNodeIn) String() string {
+func (x *ReaderAgreement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNodeIn) ProtoMessage() {}
+func (*ReaderAgreement) ProtoMessage() {}
 
-func (x *DeleteNodeIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[40]
+func (x *ReaderAgreement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2487,40 +8882,95 @@ func (x *DeleteNodeIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNodeIn.ProtoReflect.Descriptor instead.
-func (*DeleteNodeIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ReaderAgreement.ProtoReflect.Descriptor instead.
+func (*ReaderAgreement) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *DeleteNodeIn) GetId() string {
+func (x *ReaderAgreement) GetReaderA() string {
 	if x != nil {
-		return x.Id
+		return x.ReaderA
 	}
 	return ""
 }
 
-type DeleteSegmentIn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ReaderAgreement) GetReaderB() string {
+	if x != nil {
+		return x.ReaderB
+	}
+	return ""
+}
+
+func (x *ReaderAgreement) GetUzis() int64 {
+	if x != nil {
+		return x.Uzis
+	}
+	return 0
+}
+
+func (x *ReaderAgreement) GetMatched() int64 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ReaderAgreement) GetUnmatched() int64 {
+	if x != nil {
+		return x.Unmatched
+	}
+	return 0
+}
+
+func (x *ReaderAgreement) GetKappa() float64 {
+	if x != nil && x.Kappa != nil {
+		return *x.Kappa
+	}
+	return 0
+}
+
+func (x *ReaderAgreement) GetMeanIou() float64 {
+	if x != nil && x.MeanIou != nil {
+		return *x.MeanIou
+	}
+	return 0
+}
+
+type GetUziAnalyticsOut struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Total     *NodeMetrics           `protobuf:"bytes,100,opt,name=total,proto3" json:"total,omitempty"`
+	ByDevice  []*DeviceNodeMetrics   `protobuf:"bytes,200,rep,name=by_device,json=byDevice,proto3" json:"by_device,omitempty"`
+	ByAuthor  []*AuthorNodeMetrics   `protobuf:"bytes,300,rep,name=by_author,json=byAuthor,proto3" json:"by_author,omitempty"`
+	ByPeriod  []*PeriodNodeMetrics   `protobuf:"bytes,400,rep,name=by_period,json=byPeriod,proto3" json:"by_period,omitempty"`
+	Agreement []*ReaderAgreement     `protobuf:"bytes,500,rep,name=agreement,proto3" json:"agreement,omitempty"`
+	// по всем парам врачей вместе
+	Kappa   *float64 `protobuf:"fixed64,600,opt,name=kappa,proto3,oneof" json:"kappa,omitempty"`
+	MeanIou *float64 `protobuf:"fixed64,700,opt,name=mean_iou,json=meanIou,proto3,oneof" json:"mean_iou,omitempty"`
+	// узлы нейросети, слитые с другими или разделенные врачами
+	AiMerged int64 `protobuf:"varint,800,opt,name=ai_merged,json=aiMerged,proto3" json:"ai_merged,omitempty"`
+	AiSplit  int64 `protobuf:"varint,900,opt,name=ai_split,json=aiSplit,proto3" json:"ai_split,omitempty"`
+	// узлы нейросети, провалидированные пакетными операциями
+	BulkValid     int64 `protobuf:"varint,1000,opt,name=bulk_valid,json=bulkValid,proto3" json:"bulk_valid,omitempty"`
+	BulkInvalid   int64 `protobuf:"varint,1100,opt,name=bulk_invalid,json=bulkInvalid,proto3" json:"bulk_invalid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSegmentIn) Reset() {
-	*x = DeleteSegmentIn{}
-	mi := &file_proto_grpc_service_proto_msgTypes[41]
+func (x *GetUziAnalyticsOut) Reset() {
+	*x = GetUziAnalyticsOut{}
+	mi := &file_proto_grpc_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSegmentIn) String() string {
+func (x *GetUziAnalyticsOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSegmentIn) ProtoMessage() {}
+func (*GetUziAnalyticsOut) ProtoMessage() {}
 
-func (x *DeleteSegmentIn) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[41]
+func (x *GetUziAnalyticsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2531,16 +8981,86 @@ func (x *DeleteSegmentIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSegmentIn.ProtoReflect.Descriptor instead.
-func (*DeleteSegmentIn) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetUziAnalyticsOut.ProtoReflect.Descriptor instead.
+func (*GetUziAnalyticsOut) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *DeleteSegmentIn) GetId() string {
+func (x *GetUziAnalyticsOut) GetTotal() *NodeMetrics {
 	if x != nil {
-		return x.Id
+		return x.Total
 	}
-	return ""
+	return nil
+}
+
+func (x *GetUziAnalyticsOut) GetByDevice() []*DeviceNodeMetrics {
+	if x != nil {
+		return x.ByDevice
+	}
+	return nil
+}
+
+func (x *GetUziAnalyticsOut) GetByAuthor() []*AuthorNodeMetrics {
+	if x != nil {
+		return x.ByAuthor
+	}
+	return nil
+}
+
+func (x *GetUziAnalyticsOut) GetByPeriod() []*PeriodNodeMetrics {
+	if x != nil {
+		return x.ByPeriod
+	}
+	return nil
+}
+
+func (x *GetUziAnalyticsOut) GetAgreement() []*ReaderAgreement {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *GetUziAnalyticsOut) GetKappa() float64 {
+	if x != nil && x.Kappa != nil {
+		return *x.Kappa
+	}
+	return 0
+}
+
+func (x *GetUziAnalyticsOut) GetMeanIou() float64 {
+	if x != nil && x.MeanIou != nil {
+		return *x.MeanIou
+	}
+	return 0
+}
+
+func (x *GetUziAnalyticsOut) GetAiMerged() int64 {
+	if x != nil {
+		return x.AiMerged
+	}
+	return 0
+}
+
+func (x *GetUziAnalyticsOut) GetAiSplit() int64 {
+	if x != nil {
+		return x.AiSplit
+	}
+	return 0
+}
+
+func (x *GetUziAnalyticsOut) GetBulkValid() int64 {
+	if x != nil {
+		return x.BulkValid
+	}
+	return 0
+}
+
+func (x *GetUziAnalyticsOut) GetBulkInvalid() int64 {
+	if x != nil {
+		return x.BulkInvalid
+	}
+	return 0
 }
 
 type CreateNodeWithSegmentsIn_Node struct {
@@ -2555,7 +9075,7 @@ type CreateNodeWithSegmentsIn_Node struct {
 
 func (x *CreateNodeWithSegmentsIn_Node) Reset() {
 	*x = CreateNodeWithSegmentsIn_Node{}
-	mi := &file_proto_grpc_service_proto_msgTypes[42]
+	mi := &file_proto_grpc_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2567,7 +9087,7 @@ func (x *CreateNodeWithSegmentsIn_Node) String() string {
 func (*CreateNodeWithSegmentsIn_Node) ProtoMessage() {}
 
 func (x *CreateNodeWithSegmentsIn_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[42]
+	mi := &file_proto_grpc_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2580,7 +9100,7 @@ func (x *CreateNodeWithSegmentsIn_Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNodeWithSegmentsIn_Node.ProtoReflect.Descriptor instead.
 func (*CreateNodeWithSegmentsIn_Node) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{36, 0}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{56, 0}
 }
 
 func (x *CreateNodeWithSegmentsIn_Node) GetTirads_23() float64 {
@@ -2624,7 +9144,7 @@ type CreateNodeWithSegmentsIn_Segment struct {
 
 func (x *CreateNodeWithSegmentsIn_Segment) Reset() {
 	*x = CreateNodeWithSegmentsIn_Segment{}
-	mi := &file_proto_grpc_service_proto_msgTypes[43]
+	mi := &file_proto_grpc_service_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2636,7 +9156,7 @@ func (x *CreateNodeWithSegmentsIn_Segment) String() string {
 func (*CreateNodeWithSegmentsIn_Segment) ProtoMessage() {}
 
 func (x *CreateNodeWithSegmentsIn_Segment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpc_service_proto_msgTypes[43]
+	mi := &file_proto_grpc_service_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2649,7 +9169,7 @@ func (x *CreateNodeWithSegmentsIn_Segment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNodeWithSegmentsIn_Segment.ProtoReflect.Descriptor instead.
 func (*CreateNodeWithSegmentsIn_Segment) Descriptor() ([]byte, []int) {
-	return file_proto_grpc_service_proto_rawDescGZIP(), []int{36, 1}
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{56, 1}
 }
 
 func (x *CreateNodeWithSegmentsIn_Segment) GetImageId() string {
@@ -2687,93 +9207,320 @@ func (x *CreateNodeWithSegmentsIn_Segment) GetTirads_5() float64 {
 	return 0
 }
 
+type ImportAnnotationsOut_Node struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Source string                 `protobuf:"bytes,100,opt,name=source,proto3" json:"source,omitempty"`
+	UziId  string                 `protobuf:"bytes,200,opt,name=uzi_id,json=uziId,proto3" json:"uzi_id,omitempty"`
+	// отсутствует при пробном запуске
+	NodeId *string `protobuf:"bytes,300,opt,name=node_id,json=nodeId,proto3,oneof" json:"node_id,omitempty"`
+	// отсутствует, если метка не распознана как класс TI-RADS
+	Class         *string `protobuf:"bytes,400,opt,name=class,proto3,oneof" json:"class,omitempty"`
+	Segments      int64   `protobuf:"varint,500,opt,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportAnnotationsOut_Node) Reset() {
+	*x = ImportAnnotationsOut_Node{}
+	mi := &file_proto_grpc_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAnnotationsOut_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAnnotationsOut_Node) ProtoMessage() {}
+
+func (x *ImportAnnotationsOut_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAnnotationsOut_Node.ProtoReflect.Descriptor instead.
+func (*ImportAnnotationsOut_Node) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{103, 0}
+}
+
+func (x *ImportAnnotationsOut_Node) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportAnnotationsOut_Node) GetUziId() string {
+	if x != nil {
+		return x.UziId
+	}
+	return ""
+}
+
+func (x *ImportAnnotationsOut_Node) GetNodeId() string {
+	if x != nil && x.NodeId != nil {
+		return *x.NodeId
+	}
+	return ""
+}
+
+func (x *ImportAnnotationsOut_Node) GetClass() string {
+	if x != nil && x.Class != nil {
+		return *x.Class
+	}
+	return ""
+}
+
+func (x *ImportAnnotationsOut_Node) GetSegments() int64 {
+	if x != nil {
+		return x.Segments
+	}
+	return 0
+}
+
+type ImportAnnotationsOut_Skipped struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,100,opt,name=source,proto3" json:"source,omitempty"`
+	Reason        string                 `protobuf:"bytes,200,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportAnnotationsOut_Skipped) Reset() {
+	*x = ImportAnnotationsOut_Skipped{}
+	mi := &file_proto_grpc_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAnnotationsOut_Skipped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAnnotationsOut_Skipped) ProtoMessage() {}
+
+func (x *ImportAnnotationsOut_Skipped) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpc_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAnnotationsOut_Skipped.ProtoReflect.Descriptor instead.
+func (*ImportAnnotationsOut_Skipped) Descriptor() ([]byte, []int) {
+	return file_proto_grpc_service_proto_rawDescGZIP(), []int{103, 1}
+}
+
+func (x *ImportAnnotationsOut_Skipped) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportAnnotationsOut_Skipped) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_proto_grpc_service_proto protoreflect.FileDescriptor
 
 var file_proto_grpc_service_proto_rawDesc = string([]byte{
 	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2d, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x13, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x21, 0x0a, 0x0f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x35, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x64,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0xb5, 0x02, 0x0a, 0x03, 0x55, 0x7a, 0x69, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x55, 0x7a, 0x69, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x19, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x06,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0xbc, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x55, 0x7a, 0x69, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xa0, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0xe8, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcf,
-	0x08, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e,
-	0x0a, 0x10, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0e, 0x6c, 0x65, 0x66,
-	0x74, 0x4c, 0x6f, 0x62, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2c,
-	0x0a, 0x0f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74,
-	0x68, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x0d, 0x6c, 0x65, 0x66, 0x74,
-	0x4c, 0x6f, 0x62, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f,
-	0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x18,
-	0xf4, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x0d, 0x6c, 0x65, 0x66, 0x74, 0x4c, 0x6f,
-	0x62, 0x65, 0x54, 0x68, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6c, 0x65,
-	0x66, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x18, 0xd8, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x65, 0x66, 0x74, 0x4c, 0x6f, 0x62, 0x65,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x72, 0x69, 0x67, 0x68,
-	0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0xbc, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x0f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f, 0x62,
-	0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0xa0,
-	0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x06, 0x52, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f,
-	0x62, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x18, 0x84,
-	0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x07, 0x52, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f,
-	0x62, 0x65, 0x54, 0x68, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x18, 0xe8,
-	0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x08, 0x52, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f,
-	0x62, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x67, 0x6c,
-	0x61, 0x6e, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x18, 0xcc, 0x08, 0x20, 0x01, 0x28, 0x01,
-	0x48, 0x09, 0x52, 0x0a, 0x67, 0x6c, 0x61, 0x6e, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x88, 0x01,
-	0x01, 0x12, 0x1e, 0x0a, 0x07, 0x69, 0x73, 0x74, 0x68, 0x6d, 0x75, 0x73, 0x18, 0xb0, 0x09, 0x20,
-	0x01, 0x28, 0x01, 0x48, 0x0a, 0x52, 0x07, 0x69, 0x73, 0x74, 0x68, 0x6d, 0x75, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x1c, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x18, 0x94, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x0b, 0x52, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x28, 0x0a, 0x0c, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63, 0x69, 0x74, 0x79, 0x18,
-	0xf8, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0c, 0x52, 0x0c, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x65,
-	0x6e, 0x69, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x79, 0x6d, 0x70, 0x68, 0x18, 0xdc, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4c, 0x79,
-	0x6d, 0x70, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x0f, 0x76, 0x61, 0x73, 0x63, 0x75, 0x6c,
-	0x61, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc0, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x0e, 0x52, 0x0f, 0x76, 0x61, 0x73, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0xa4, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0f, 0x52, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x88, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x10, 0x52,
-	0x0a, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x24,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xec, 0x0e, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x11, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x73,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x02, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a,
+	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0xf4,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x69, 0x78,
+	0x65, 0x6c, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67,
+	0x52, 0x0c, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb7, 0x02, 0x0a, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75,
+	0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x69,
+	0x78, 0x65, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x69, 0x78, 0x65,
+	0x6c, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x6e,
+	0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x21,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x79, 0x49, 0x64, 0x49,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x33, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x79,
+	0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xd6, 0x02, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x72, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6d, 0x61,
+	0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x5f, 0x73,
+	0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x50, 0x69, 0x78, 0x65, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x69,
+	0x78, 0x65, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x32, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4f,
+	0x75, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x20, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x93, 0x03, 0x0a, 0x03, 0x55, 0x7a, 0x69, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x55, 0x7a, 0x69, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0xbc, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x55, 0x7a, 0x69, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xa0, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0xe8, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x33, 0x0a,
+	0x0d, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0xcc,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x53, 0x70, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0xb0, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x88, 0x01, 0x01,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x7e, 0x0a, 0x0f, 0x45,
+	0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x15, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0xc8, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x03, 0x6d,
+	0x69, 0x6e, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0xf2, 0x0a, 0x0a, 0x0b,
+	0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x6c,
+	0x65, 0x66, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0xac, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x4c, 0x6f,
+	0x62, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6c,
+	0x65, 0x66, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x90,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x0d, 0x6c, 0x65, 0x66, 0x74, 0x4c, 0x6f, 0x62,
+	0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6c, 0x65, 0x66,
+	0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x18, 0xf4, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x0d, 0x6c, 0x65, 0x66, 0x74, 0x4c, 0x6f, 0x62, 0x65, 0x54,
+	0x68, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6c, 0x65, 0x66, 0x74, 0x5f,
+	0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x65, 0x66, 0x74, 0x4c, 0x6f, 0x62, 0x65, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c,
+	0x6f, 0x62, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x05, 0x52, 0x0f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f, 0x62, 0x65, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0xa0, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x06, 0x52, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f, 0x62, 0x65, 0x57,
+	0x69, 0x64, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x18, 0x84, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x07, 0x52, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f, 0x62, 0x65, 0x54,
+	0x68, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x18, 0xe8, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x08, 0x52, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f, 0x62, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x67, 0x6c, 0x61, 0x6e, 0x64,
+	0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x18, 0xcc, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x09, 0x52,
+	0x0a, 0x67, 0x6c, 0x61, 0x6e, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1e,
+	0x0a, 0x07, 0x69, 0x73, 0x74, 0x68, 0x6d, 0x75, 0x73, 0x18, 0xb0, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x0a, 0x52, 0x07, 0x69, 0x73, 0x74, 0x68, 0x6d, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x18, 0x94, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x48, 0x0b, 0x52, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x42, 0x0a, 0x0c, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0xf8, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x69, 0x63, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63, 0x69, 0x74,
+	0x79, 0x48, 0x0c, 0x52, 0x0c, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x6c, 0x79, 0x6d, 0x70, 0x68, 0x18, 0xdc, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0d, 0x52,
+	0x0d, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4c, 0x79, 0x6d, 0x70, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x4b, 0x0a, 0x0f, 0x76, 0x61, 0x73, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc0, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x45, 0x63,
+	0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x56, 0x61, 0x73, 0x63, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x0e, 0x52, 0x0f, 0x76, 0x61, 0x73, 0x63,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xa4, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x0f, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x88,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x10, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0xec, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x11, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0b,
+	0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x78, 0x18, 0xd0, 0x0f, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x78, 0x48,
+	0x12, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x78, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x18,
+	0xb4, 0x10, 0x20, 0x01, 0x28, 0x03, 0x48, 0x13, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e,
+	0x74, 0x41, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x98, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x69, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x32, 0x0a, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xfc, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x73,
 	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x5f, 0x6c,
 	0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6c,
 	0x6f, 0x62, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x65,
@@ -2793,7 +9540,9 @@ var file_proto_grpc_service_proto_rawDesc = string([]byte{
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
 	0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xce, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x78,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x67, 0x65,
+	0x22, 0xac, 0x02, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e,
 	0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x64,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x55, 0x7a, 0x69, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
@@ -2805,68 +9554,176 @@ var file_proto_grpc_service_proto_rawDesc = string([]byte{
 	0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x65, 0x73,
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
 	0x00, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x1e, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x4f, 0x75,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x42, 0x79, 0x49, 0x64, 0x49,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x27, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x42, 0x79, 0x49, 0x64, 0x4f,
-	0x75, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x7a, 0x69, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x04, 0x2e, 0x55, 0x7a, 0x69, 0x52, 0x03, 0x75, 0x7a, 0x69, 0x22, 0x38, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
-	0x64, 0x49, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42,
-	0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x18,
-	0x0a, 0x04, 0x75, 0x7a, 0x69, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x55,
-	0x7a, 0x69, 0x52, 0x04, 0x75, 0x7a, 0x69, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55,
-	0x7a, 0x69, 0x73, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73,
-	0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x75,
-	0x7a, 0x69, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x55, 0x7a, 0x69, 0x52,
-	0x04, 0x75, 0x7a, 0x69, 0x73, 0x22, 0x30, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x63, 0x68, 0x6f,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e,
-	0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x63,
-	0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64,
-	0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x69, 0x63, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x52, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x69, 0x63, 0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x7a,
-	0x69, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x55, 0x7a, 0x69, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x07, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x65, 0x64, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x07, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x65, 0x64, 0x22, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x7a,
-	0x69, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x7a, 0x69, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x04, 0x2e, 0x55, 0x7a, 0x69, 0x52, 0x03, 0x75, 0x7a, 0x69, 0x22, 0x45, 0x0a, 0x13,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69,
-	0x63, 0x49, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x69, 0x63, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x52, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x69, 0x63, 0x22, 0x46, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x63, 0x68,
-	0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x65,
+	0x01, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x69, 0x78, 0x65,
+	0x6c, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x53,
+	0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22,
+	0x42, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x4f, 0x75, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x18,
+	0xc8, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x4f, 0x66, 0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x42, 0x79, 0x49,
+	0x64, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x42, 0x79, 0x49,
+	0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x7a, 0x69, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x04, 0x2e, 0x55, 0x7a, 0x69, 0x52, 0x03, 0x75, 0x7a, 0x69, 0x22, 0x38, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x49, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69,
+	0x73, 0x42, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x4f, 0x75, 0x74,
+	0x12, 0x18, 0x0a, 0x04, 0x75, 0x7a, 0x69, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x04,
+	0x2e, 0x55, 0x7a, 0x69, 0x52, 0x04, 0x75, 0x7a, 0x69, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x7a,
+	0x69, 0x73, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a,
+	0x04, 0x75, 0x7a, 0x69, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x55, 0x7a,
+	0x69, 0x52, 0x04, 0x75, 0x7a, 0x69, 0x73, 0x22, 0x91, 0x05, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x7a, 0x69, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e,
+	0x55, 0x7a, 0x69, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x02, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x55, 0x7a,
+	0x69, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x04, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x1e, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x05, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0xbc,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x6f, 0x18, 0xa0, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x61, 0x69, 0x5f,
+	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x84, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x08, 0x52, 0x0c, 0x61, 0x69, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34,
+	0x4d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x61, 0x69, 0x5f, 0x74, 0x69, 0x72,
+	0x61, 0x64, 0x73, 0x5f, 0x35, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x09, 0x52, 0x0c, 0x61, 0x69, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x35, 0x4d, 0x69, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0xcc, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0xb0, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x94, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x69, 0x5f,
+	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x61, 0x69, 0x5f, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x5f, 0x6d, 0x69, 0x6e,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x0d, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x7a, 0x69, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x04,
+	0x75, 0x7a, 0x69, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x55, 0x7a, 0x69,
+	0x52, 0x04, 0x75, 0x7a, 0x69, 0x73, 0x12, 0x25, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x30, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x42,
+	0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f,
+	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x22,
+	0x4a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69,
+	0x63, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x65,
 	0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0c, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x52, 0x0b,
-	0x65, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x22, 0x1d, 0x0a, 0x0b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x05, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x22, 0x2b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x55,
-	0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x22, 0x35, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49,
-	0x64, 0x4f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x64,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a,
+	0x65, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x22, 0xc3, 0x01, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x55, 0x7a, 0x69, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x1e, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0xac, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x69, 0x78, 0x65,
+	0x6c, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x53,
+	0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x22, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x4f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x7a, 0x69, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04,
+	0x2e, 0x55, 0x7a, 0x69, 0x52, 0x03, 0x75, 0x7a, 0x69, 0x22, 0x45, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x49, 0x6e,
+	0x12, 0x2e, 0x0a, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x69, 0x63, 0x52, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63,
+	0x22, 0x46, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x65, 0x63, 0x68, 0x6f,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x52, 0x0b, 0x65, 0x63, 0x68,
+	0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x22, 0x1d, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x13, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0xac, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x15, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xa0, 0x01, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a,
+	0x69, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0xac, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x17, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0xd8, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a,
+	0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49,
+	0x64, 0x22, 0x35, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79,
+	0x55, 0x7a, 0x69, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x0c, 0x50, 0x69, 0x78, 0x65,
+	0x6c, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0d, 0x0a, 0x01, 0x79, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x01, 0x79, 0x22, 0x5a, 0x0a, 0x0b, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x6f, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x01, 0x78, 0x12, 0x0d, 0x0a, 0x01, 0x79, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01,
+	0x79, 0x12, 0x15, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xcd, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x62, 0x62, 0x6f, 0x78,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x6f, 0x78, 0x52, 0x04, 0x62, 0x62, 0x6f, 0x78, 0x12, 0x13, 0x0a, 0x04, 0x61, 0x72,
+	0x65, 0x61, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12,
+	0x1d, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0xac, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x5f, 0x61, 0x78, 0x69, 0x73, 0x18, 0x90, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x5f, 0x61, 0x78, 0x69, 0x73, 0x18, 0xf4, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x12, 0x21,
+	0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x22, 0xc0, 0x01, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x1d, 0x0a, 0x09, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x6a, 0x6f,
+	0x72, 0x5f, 0x61, 0x78, 0x69, 0x73, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d,
+	0x61, 0x6a, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x6f,
+	0x72, 0x5f, 0x61, 0x78, 0x69, 0x73, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04,
+	0x75, 0x6e, 0x69, 0x74, 0x22, 0xf6, 0x02, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0f, 0x0a,
 	0x02, 0x61, 0x69, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x61, 0x69, 0x12, 0x35,
 	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xac, 0x02, 0x20,
@@ -2881,228 +9738,1053 @@ var file_proto_grpc_service_proto_rawDesc = string([]byte{
 	0x73, 0x5f, 0x35, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61,
 	0x64, 0x73, 0x35, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
 	0x6f, 0x6e, 0x18, 0xa0, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x12,
-	0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x05,
-	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0b, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x84, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x04, 0x6c, 0x6f, 0x62, 0x65, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x09, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x62, 0x65, 0x48, 0x02, 0x52, 0x04, 0x6c, 0x6f,
+	0x62, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x22, 0x2a, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64,
+	0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12,
+	0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x9f, 0x02, 0x0a,
+	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32,
+	0x33, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x32, 0x33, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64,
+	0x73, 0x5f, 0x34, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x07, 0x74, 0x69,
+	0x72, 0x61, 0x64, 0x73, 0x34, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x5f, 0x35, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x07, 0x74,
+	0x69, 0x72, 0x61, 0x64, 0x73, 0x35, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x6c, 0x6f, 0x62,
+	0x65, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c,
+	0x6f, 0x62, 0x65, 0x48, 0x04, 0x52, 0x04, 0x6c, 0x6f, 0x62, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x72,
+	0x61, 0x64, 0x73, 0x5f, 0x35, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x6f, 0x62, 0x65, 0x22, 0x2a,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x12,
+	0x19, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x71, 0x0a, 0x0e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0xc8, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x5c, 0x0a,
+	0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x0e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x25, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x6c, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x7a, 0x69,
+	0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0b, 0x75, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0xac,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32,
-	0x33, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34,
-	0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64,
-	0x73, 0x34, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f,
-	0x35, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61,
-	0x64, 0x73, 0x35, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73,
-	0x5f, 0x32, 0x33, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x22, 0x2a, 0x0a,
-	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x19,
-	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x07, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xac, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x63,
-	0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f,
-	0x6e, 0x74, 0x6f, 0x72, 0x12, 0x0f, 0x0a, 0x02, 0x61, 0x69, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x02, 0x61, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f,
-	0x32, 0x33, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64,
-	0x73, 0x32, 0x33, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18,
-	0xbc, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x18, 0xa0, 0x06, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x35, 0x22, 0xb5, 0x01, 0x0a, 0x0f,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x12,
-	0x19, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0xac,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
-	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64,
-	0x73, 0x5f, 0x35, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61,
-	0x64, 0x73, 0x35, 0x22, 0x22, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x49, 0x6e,
-	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64,
-	0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0f, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x74,
-	0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48,
-	0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x88, 0x01, 0x01, 0x12, 0x1f,
-	0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x48, 0x02, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34, 0x88, 0x01, 0x01, 0x12,
-	0x1f, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x18, 0xf4, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x48, 0x03, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x35, 0x88, 0x01, 0x01,
-	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69,
-	0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x72, 0x61, 0x64,
-	0x73, 0x5f, 0x35, 0x22, 0x36, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xd3, 0x03, 0x0a, 0x18,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f,
-	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x12,
-	0x33, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0xac, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x49, 0x6e, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x1a, 0x94, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28,
+	0x48, 0x00, 0x52, 0x0b, 0x75, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x4d, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x7a, 0x69, 0x4f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x7a, 0x69, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04,
+	0x2e, 0x55, 0x7a, 0x69, 0x52, 0x03, 0x75, 0x7a, 0x69, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x47, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x69, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x39, 0x0a, 0x10, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x41, 0x69, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x25,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f,
+	0x72, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72,
+	0x12, 0x0f, 0x0a, 0x02, 0x61, 0x69, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x61,
+	0x69, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0xd8,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0xbc, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x18, 0xa0, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x35, 0x12, 0x36, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x84, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0xb5, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x74,
+	0x6f, 0x72, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0x90,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0xf4, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x35, 0x22, 0x22, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x49, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x3e, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd7, 0x01,
+	0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0xac, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0x90,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x18,
+	0xf4, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73,
+	0x35, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74,
+	0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x22, 0x36, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0xd3, 0x03, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06,
+	0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a,
+	0x69, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0xac, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x94, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0xc8,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0xac, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x35, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x93, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72,
+	0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0xac, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72,
+	0x61, 0x64, 0x73, 0x5f, 0x35, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x69,
+	0x72, 0x61, 0x64, 0x73, 0x35, 0x22, 0x56, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f,
+	0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x31, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x49, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x66, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x64, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0xc8, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x21, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x19, 0x52,
+	0x65, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f,
+	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x22,
+	0x60, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x08, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x29, 0x0a, 0x0c, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x49,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x64, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0x2a, 0x0a, 0x0d,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x19, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x0a, 0x0b, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0xc8, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x22, 0x4c, 0x0a, 0x0c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4f,
+	0x75, 0x74, 0x12, 0x19, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a,
+	0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65,
+	0x22, 0xa5, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x90, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0xf4,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28,
 	0x01, 0x52, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
 	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x34, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64,
-	0x73, 0x5f, 0x35, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61,
-	0x64, 0x73, 0x35, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x93, 0x01, 0x0a, 0x07,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0xc8, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72,
-	0x61, 0x64, 0x73, 0x5f, 0x34, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x69,
-	0x72, 0x61, 0x64, 0x73, 0x34, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f,
-	0x35, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73,
-	0x35, 0x22, 0x56, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57,
-	0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x17,
+	0x73, 0x5f, 0x35, 0x18, 0xa0, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x35, 0x12, 0x15, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x84, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x22, 0x58, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x05,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x22, 0x3b, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x64, 0x72, 0x61, 0x66,
+	0x74, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x22,
+	0x35, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x49, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x18,
+	0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x22, 0x26, 0x0a, 0x14,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a,
+	0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa6, 0x02, 0x0a, 0x0f, 0x4e,
+	0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x17,
 	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x1f, 0x47, 0x65, 0x74,
-	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x20,
-	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x4f, 0x75, 0x74,
-	0x12, 0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x25, 0x0a,
-	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x1e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x21, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x51, 0x0a, 0x09, 0x55, 0x7a, 0x69, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x5a, 0x49, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x5a, 0x49, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01,
-	0x12, 0x18, 0x0a, 0x14, 0x55, 0x5a, 0x49, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
-	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x62, 0x0a, 0x0e, 0x4e, 0x6f,
-	0x64, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x14,
-	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
-	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x02, 0x2a, 0x42,
-	0x0a, 0x0d, 0x55, 0x7a, 0x69, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x17, 0x0a, 0x13, 0x55, 0x5a, 0x49, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x5a, 0x49, 0x5f,
-	0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53,
-	0x10, 0x01, 0x32, 0xc2, 0x09, 0x0a, 0x06, 0x55, 0x7a, 0x69, 0x53, 0x72, 0x76, 0x12, 0x31, 0x0a,
-	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x1a, 0x10,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74,
-	0x12, 0x3a, 0x0a, 0x0d, 0x67, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x12, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x1a, 0x0d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x55, 0x7a, 0x69, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x55, 0x7a, 0x69,
-	0x42, 0x79, 0x49, 0x64, 0x12, 0x0d, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x42, 0x79, 0x49,
-	0x64, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x42, 0x79, 0x49, 0x64,
-	0x4f, 0x75, 0x74, 0x12, 0x46, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
-	0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0f, 0x67,
-	0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x4c, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x45, 0x63,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38,
+	0x0a, 0x0c, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63, 0x69, 0x74, 0x79, 0x18, 0xac,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x45, 0x63,
+	0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x65, 0x63, 0x68, 0x6f,
+	0x67, 0x65, 0x6e, 0x69, 0x63, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70,
+	0x65, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x54, 0x69, 0x72, 0x61, 0x64,
+	0x73, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x26, 0x0a,
+	0x06, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x3c, 0x0a, 0x0e, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e,
+	0x69, 0x63, 0x5f, 0x66, 0x6f, 0x63, 0x69, 0x18, 0xd8, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63,
+	0x46, 0x6f, 0x63, 0x69, 0x52, 0x0d, 0x65, 0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63, 0x46,
+	0x6f, 0x63, 0x69, 0x22, 0xcd, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xac, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x22, 0x81, 0x01, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x12, 0x19, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x18, 0xc8, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0xac, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x4a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e, 0x12,
+	0x32, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x06,
+	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x52, 0x06, 0x74, 0x69, 0x72, 0x61, 0x64,
+	0x73, 0x22, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x49, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x4f, 0x75,
+	0x74, 0x12, 0x23, 0x0a, 0x06, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x52, 0x06,
+	0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x22, 0x4d, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x6b, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x0c, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a,
+	0x12, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x73, 0x49, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x12,
+	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x22, 0x4c, 0x0a, 0x13, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x4e, 0x6f, 0x64,
+	0x65, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69,
+	0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0xac, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x47,
+	0x72, 0x6f, 0x77, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0xc8,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x77,
+	0x74, 0x68, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x29, 0x0a, 0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x90, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x01, 0x52, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x12,
+	0x27, 0x0a, 0x08, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x08,
+	0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x74, 0x6d, 0x6c,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x66, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0xd8, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x22, 0x29,
+	0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x25, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x12,
+	0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x50, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69,
+	0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x06,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xd3, 0x04,
+	0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49,
+	0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0xac, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x55, 0x7a, 0x69, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x48, 0x02, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x34, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x90, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x55, 0x7a, 0x69, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x07, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0xa0, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x18, 0x84, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x69, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x61, 0x69, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0xcc, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x21, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0xb0, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x08, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x94, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x6f, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x61, 0x6c, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x13, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a,
+	0x0c, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0xac, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x90, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x06,
+	0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
+	0x75, 0x7a, 0x69, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0xac, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x7a,
+	0x69, 0x5f, 0x69, 0x64, 0x22, 0xff, 0x02, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x31, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0xc8, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0xac, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f,
+	0x75, 0x74, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x1a, 0xa4, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0xc8,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x1a, 0x3a, 0x0a, 0x07, 0x53, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xa7, 0x02, 0x0a, 0x0b, 0x4e,
+	0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0xc8, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0xa0, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x84, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0xb8, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xac, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0xf4, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0xbc, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0xa0, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x84, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22,
+	0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x49, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x75,
+	0x74, 0x12, 0x28, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x64, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x34, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x43, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x0e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x19, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x1e, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x27, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x7a, 0x69, 0x4f, 0x75, 0x74,
+	0x12, 0x16, 0x0a, 0x03, 0x75, 0x7a, 0x69, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e,
+	0x55, 0x7a, 0x69, 0x52, 0x03, 0x75, 0x7a, 0x69, 0x22, 0x30, 0x0a, 0x15, 0x53, 0x77, 0x65, 0x65,
+	0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x73, 0x49,
+	0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x67, 0x0a, 0x16, 0x53, 0x77,
+	0x65, 0x65, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x73, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x19, 0x0a,
+	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x6f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x73, 0x18, 0xac, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x7a, 0x69,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x22, 0x60, 0x0a, 0x11, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x15, 0x0a, 0x06, 0x75, 0x7a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0xac,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x22, 0x81, 0x01,
+	0x0a, 0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x69, 0x74, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0xc8, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x0a,
+	0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0xac, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x22, 0xff, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x12, 0x20, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x90, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x88, 0x01,
+	0x01, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0xf4, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x6f, 0x22, 0x69, 0x0a, 0x12, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x72,
+	0x61, 0x64, 0x73, 0x5f, 0x32, 0x33, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x69,
+	0x72, 0x61, 0x64, 0x73, 0x32, 0x33, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73,
+	0x5f, 0x34, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64,
+	0x73, 0x34, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x5f, 0x35, 0x18, 0xac,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x35, 0x22, 0x9f,
+	0x03, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x7a, 0x69, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x7a,
+	0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x69, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0xc8,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x69, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x15,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0b, 0x75, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0xf4, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x6e, 0x75,
+	0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x70,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x11, 0x75,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0xa0, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x10, 0x75, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x09, 0x61, 0x69, 0x5f, 0x74, 0x69, 0x72, 0x61, 0x64, 0x73, 0x18, 0x84, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x61, 0x69, 0x54, 0x69, 0x72, 0x61, 0x64,
+	0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x75,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x22, 0x59, 0x0a, 0x11, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0xc8, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x54, 0x0a, 0x11, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x22, 0x52, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x07,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x61, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x41, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x62,
+	0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42,
+	0x12, 0x13, 0x0a, 0x04, 0x75, 0x7a, 0x69, 0x73, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x75, 0x7a, 0x69, 0x73, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0xf4, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x05, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x05, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6d,
+	0x65, 0x61, 0x6e, 0x5f, 0x69, 0x6f, 0x75, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01,
+	0x52, 0x07, 0x6d, 0x65, 0x61, 0x6e, 0x49, 0x6f, 0x75, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x5f,
+	0x69, 0x6f, 0x75, 0x22, 0xd1, 0x03, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x30,
+	0x0a, 0x09, 0x62, 0x79, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x08, 0x62, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x30, 0x0a, 0x09, 0x62, 0x79, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0xac, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x08, 0x62, 0x79, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x62, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x90, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x08, 0x62, 0x79, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0xf4, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x05, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x18, 0xd8,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x69, 0x6f, 0x75, 0x18, 0xbc, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x61, 0x6e, 0x49, 0x6f, 0x75, 0x88,
+	0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x69, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18,
+	0xa0, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x69, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x69, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x84, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x69, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x62, 0x75, 0x6c, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0xcc, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x75, 0x6c, 0x6b, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d,
+	0x65, 0x61, 0x6e, 0x5f, 0x69, 0x6f, 0x75, 0x2a, 0x50, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50,
+	0x52, 0x4f, 0x42, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x58,
+	0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x09, 0x55, 0x7a, 0x69,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x5a, 0x49, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x5a,
+	0x49, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x5a, 0x49, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x62, 0x0a, 0x0e,
+	0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x14, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x4f, 0x44, 0x45,
+	0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x02,
+	0x2a, 0x4a, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x62, 0x65, 0x12, 0x13, 0x0a, 0x0f,
+	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f, 0x42, 0x45, 0x5f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f, 0x42, 0x45, 0x5f, 0x4c,
+	0x45, 0x46, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f,
+	0x42, 0x45, 0x5f, 0x49, 0x53, 0x54, 0x48, 0x4d, 0x55, 0x53, 0x10, 0x02, 0x2a, 0x42, 0x0a, 0x0d,
+	0x55, 0x7a, 0x69, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x13, 0x55, 0x5a, 0x49, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x5a, 0x49, 0x5f, 0x50, 0x52,
+	0x4f, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x10, 0x01,
+	0x2a, 0x5d, 0x0a, 0x11, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x52, 0x41,
+	0x50, 0x48, 0x49, 0x43, 0x5f, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x5f, 0x48, 0x4f, 0x4d, 0x4f,
+	0x47, 0x45, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x43, 0x48,
+	0x4f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x49, 0x43, 0x5f, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x5f,
+	0x48, 0x45, 0x54, 0x45, 0x52, 0x4f, 0x47, 0x45, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x01, 0x2a,
+	0xb2, 0x01, 0x0a, 0x17, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x45,
+	0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x1f, 0x45,
+	0x43, 0x48, 0x4f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x49, 0x43, 0x5f, 0x45, 0x43, 0x48, 0x4f, 0x47,
+	0x45, 0x4e, 0x49, 0x43, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00,
+	0x12, 0x26, 0x0a, 0x22, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x49, 0x43, 0x5f,
+	0x45, 0x43, 0x48, 0x4f, 0x47, 0x45, 0x4e, 0x49, 0x43, 0x49, 0x54, 0x59, 0x5f, 0x49, 0x4e, 0x43,
+	0x52, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x43, 0x48, 0x4f,
+	0x47, 0x52, 0x41, 0x50, 0x48, 0x49, 0x43, 0x5f, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x45, 0x4e, 0x49,
+	0x43, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x22, 0x0a, 0x1e, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x49, 0x43, 0x5f,
+	0x45, 0x43, 0x48, 0x4f, 0x47, 0x45, 0x4e, 0x49, 0x43, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x49, 0x58,
+	0x45, 0x44, 0x10, 0x03, 0x2a, 0x9a, 0x01, 0x0a, 0x1a, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x69, 0x63, 0x56, 0x61, 0x73, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x52, 0x41, 0x50, 0x48,
+	0x49, 0x43, 0x5f, 0x56, 0x41, 0x53, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x45,
+	0x43, 0x48, 0x4f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x49, 0x43, 0x5f, 0x56, 0x41, 0x53, 0x43, 0x55,
+	0x4c, 0x41, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45,
+	0x41, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x52,
+	0x41, 0x50, 0x48, 0x49, 0x43, 0x5f, 0x56, 0x41, 0x53, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x5a,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10,
+	0x02, 0x2a, 0x3a, 0x0a, 0x0a, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x78, 0x12,
+	0x14, 0x0a, 0x10, 0x50, 0x41, 0x54, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x58, 0x5f, 0x4d,
+	0x41, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x41, 0x54, 0x49, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x45, 0x58, 0x5f, 0x46, 0x45, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x01, 0x2a, 0x34, 0x0a,
+	0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x53,
+	0x43, 0x10, 0x01, 0x2a, 0x56, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x17, 0x0a, 0x13, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x4f,
+	0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x41,
+	0x47, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f,
+	0x54, 0x48, 0x55, 0x4d, 0x42, 0x4e, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x2a, 0x37, 0x0a, 0x0b, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45,
+	0x41, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x50, 0x58, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x4d, 0x45, 0x41, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f,
+	0x4d, 0x4d, 0x10, 0x01, 0x2a, 0xec, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x41, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a,
+	0x1e, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10,
+	0x03, 0x12, 0x28, 0x0a, 0x24, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c,
+	0x44, 0x10, 0x05, 0x2a, 0x91, 0x01, 0x0a, 0x11, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x52,
+	0x41, 0x44, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x59, 0x53, 0x54, 0x49, 0x43, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x49, 0x52, 0x41,
+	0x44, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x50, 0x4f, 0x4e, 0x47, 0x49, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54,
+	0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x49, 0x58, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x52,
+	0x41, 0x44, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x4f, 0x4c, 0x49, 0x44, 0x10, 0x03, 0x2a, 0x9a, 0x01, 0x0a, 0x12, 0x54, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x65, 0x6e, 0x69, 0x63, 0x69, 0x74, 0x79, 0x12, 0x20,
+	0x0a, 0x1c, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x45, 0x4e,
+	0x49, 0x43, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x4e, 0x45, 0x43, 0x48, 0x4f, 0x49, 0x43, 0x10, 0x00,
+	0x12, 0x21, 0x0a, 0x1d, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x45, 0x43, 0x48, 0x4f, 0x47,
+	0x45, 0x4e, 0x49, 0x43, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x59, 0x50, 0x45, 0x52, 0x5f, 0x49, 0x53,
+	0x4f, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x45, 0x43,
+	0x48, 0x4f, 0x47, 0x45, 0x4e, 0x49, 0x43, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x59, 0x50, 0x4f, 0x10,
+	0x02, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x45, 0x43, 0x48, 0x4f,
+	0x47, 0x45, 0x4e, 0x49, 0x43, 0x49, 0x54, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x48, 0x59,
+	0x50, 0x4f, 0x10, 0x03, 0x2a, 0x52, 0x0a, 0x0b, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x53, 0x68,
+	0x61, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x53, 0x48,
+	0x41, 0x50, 0x45, 0x5f, 0x57, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x54,
+	0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f,
+	0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41,
+	0x4e, 0x5f, 0x57, 0x49, 0x44, 0x45, 0x10, 0x01, 0x2a, 0x86, 0x01, 0x0a, 0x0c, 0x54, 0x69, 0x72,
+	0x61, 0x64, 0x73, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x49, 0x52,
+	0x41, 0x44, 0x53, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x4d, 0x4f, 0x4f, 0x54,
+	0x48, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x4d, 0x41,
+	0x52, 0x47, 0x49, 0x4e, 0x5f, 0x49, 0x4c, 0x4c, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x4d, 0x41, 0x52,
+	0x47, 0x49, 0x4e, 0x5f, 0x4c, 0x4f, 0x42, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x20, 0x0a, 0x1c, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e,
+	0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x54, 0x48, 0x59, 0x52, 0x4f, 0x49, 0x44, 0x41, 0x4c, 0x10,
+	0x03, 0x2a, 0xae, 0x01, 0x0a, 0x13, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x45, 0x63, 0x68, 0x6f,
+	0x67, 0x65, 0x6e, 0x69, 0x63, 0x46, 0x6f, 0x63, 0x69, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x49, 0x52,
+	0x41, 0x44, 0x53, 0x5f, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x45, 0x4e, 0x49, 0x43, 0x5f, 0x46, 0x4f,
+	0x43, 0x49, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x2d, 0x0a, 0x29, 0x54, 0x49, 0x52,
+	0x41, 0x44, 0x53, 0x5f, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x45, 0x4e, 0x49, 0x43, 0x5f, 0x46, 0x4f,
+	0x43, 0x49, 0x5f, 0x4d, 0x41, 0x43, 0x52, 0x4f, 0x43, 0x41, 0x4c, 0x43, 0x49, 0x46, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x49, 0x52, 0x41,
+	0x44, 0x53, 0x5f, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x45, 0x4e, 0x49, 0x43, 0x5f, 0x46, 0x4f, 0x43,
+	0x49, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x50, 0x48, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x22,
+	0x0a, 0x1e, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x45, 0x43, 0x48, 0x4f, 0x47, 0x45, 0x4e,
+	0x49, 0x43, 0x5f, 0x46, 0x4f, 0x43, 0x49, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x54, 0x41, 0x54, 0x45,
+	0x10, 0x03, 0x2a, 0x8d, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x52, 0x31, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52,
+	0x59, 0x5f, 0x54, 0x52, 0x32, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x52, 0x41, 0x44,
+	0x53, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x52, 0x33, 0x10, 0x02,
+	0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x54, 0x52, 0x34, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x52,
+	0x41, 0x44, 0x53, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x52, 0x35,
+	0x10, 0x04, 0x2a, 0x9d, 0x01, 0x0a, 0x14, 0x54, 0x69, 0x72, 0x61, 0x64, 0x73, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x54,
+	0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x44, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x54,
+	0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x44, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x55, 0x50, 0x10, 0x01,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4d,
+	0x4d, 0x45, 0x4e, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4e, 0x41, 0x10, 0x02, 0x12,
+	0x21, 0x0a, 0x1d, 0x54, 0x49, 0x52, 0x41, 0x44, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d,
+	0x45, 0x4e, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x03, 0x2a, 0x41, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x41, 0x53, 0x45, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x4f, 0x43, 0x4f, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x44, 0x41, 0x54, 0x41, 0x53, 0x45, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x59,
+	0x4f, 0x4c, 0x4f, 0x10, 0x01, 0x2a, 0x4a, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4e, 0x4e,
+	0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43,
+	0x4f, 0x43, 0x4f, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x56, 0x41, 0x54, 0x10,
+	0x01, 0x2a, 0x99, 0x01, 0x0a, 0x0d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x49, 0x53,
+	0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x03,
+	0x12, 0x1b, 0x0a, 0x17, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x04, 0x2a, 0x62, 0x0a,
+	0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x50, 0x45,
+	0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
+	0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x4e, 0x41, 0x4c, 0x59,
+	0x54, 0x49, 0x43, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x44, 0x41, 0x59, 0x10,
+	0x02, 0x32, 0x9f, 0x18, 0x0a, 0x06, 0x55, 0x7a, 0x69, 0x53, 0x72, 0x76, 0x12, 0x31, 0x0a, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x1a, 0x10, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x12,
+	0x3a, 0x0a, 0x0d, 0x67, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x0d, 0x67,
+	0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x10, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x79, 0x49, 0x64, 0x49, 0x6e, 0x1a, 0x11,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x79, 0x49, 0x64, 0x4f, 0x75,
+	0x74, 0x12, 0x31, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x0f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x28, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x12, 0x0c, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x1a, 0x0d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x7a, 0x69, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x55, 0x7a,
+	0x69, 0x42, 0x79, 0x49, 0x64, 0x12, 0x0d, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x42, 0x79,
+	0x49, 0x64, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x42, 0x79, 0x49,
+	0x64, 0x4f, 0x75, 0x74, 0x12, 0x46, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42,
+	0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0f,
+	0x67, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
+	0x12, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x73, 0x42, 0x79, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x7a, 0x69, 0x73, 0x12, 0x0d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x7a, 0x69, 0x73, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x7a,
+	0x69, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x4c, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x45, 0x63, 0x68, 0x6f,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x18,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x42,
+	0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x63,
 	0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64,
-	0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69,
-	0x63, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x42, 0x79, 0x55, 0x7a, 0x69,
-	0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x7a, 0x69, 0x12, 0x0c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e,
-	0x1a, 0x0d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x4f, 0x75, 0x74, 0x12,
-	0x40, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61,
-	0x70, 0x68, 0x69, 0x63, 0x12, 0x14, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x63, 0x68,
-	0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x4f, 0x75,
-	0x74, 0x12, 0x31, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x12, 0x0c,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x1a, 0x14, 0x2e,
-	0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64,
-	0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42,
-	0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
-	0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74,
-	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12,
-	0x2b, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x0d,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a,
-	0x11, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
-	0x75, 0x74, 0x12, 0x46, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x49,
-	0x6e, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42,
-	0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x0d, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a, 0x11, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74,
-	0x12, 0x4f, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75,
-	0x74, 0x12, 0x64, 0x0a, 0x1d, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57, 0x69, 0x74,
-	0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x12, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57, 0x69, 0x74,
-	0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x49, 0x6e, 0x1a, 0x21, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57,
-	0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a,
+	0x4f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69,
+	0x12, 0x0c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x1a, 0x0d,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x4f, 0x75, 0x74, 0x12, 0x40, 0x0a,
+	0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x69, 0x63, 0x12, 0x14, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x63, 0x68, 0x6f, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x63, 0x68, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x12,
+	0x31, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x12, 0x0c, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3d, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42,
+	0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x4f, 0x75,
+	0x74, 0x12, 0x3a, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x79, 0x55,
+	0x7a, 0x69, 0x49, 0x64, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42,
+	0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x42, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x55, 0x7a, 0x69, 0x12, 0x0b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x7a,
+	0x69, 0x49, 0x6e, 0x1a, 0x0c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x7a, 0x69, 0x4f, 0x75,
+	0x74, 0x12, 0x34, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x69, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x10, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x69, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x69, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x46, 0x0a,
+	0x13, 0x67, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x4f, 0x0a, 0x16, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e,
+	0x1a, 0x1a, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x64, 0x0a, 0x1d,
+	0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x20, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x49, 0x6e, 0x1a,
+	0x21, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x4f,
+	0x75, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x0d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x21, 0x5a, 0x1f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x52, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e,
+	0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x1b, 0x2e, 0x52, 0x65, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x4f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x09, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x0c, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x1a, 0x0d,
+	0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a,
+	0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x12, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x55, 0x0a, 0x18, 0x67, 0x65, 0x74,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x42, 0x79, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x49, 0x6e, 0x1a, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x4f, 0x75, 0x74,
+	0x12, 0x43, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x15, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x1a, 0x16, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x45, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x16, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x12,
+	0x73, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x15, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x53, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x75,
+	0x74, 0x12, 0x34, 0x0a, 0x0d, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x72, 0x61,
+	0x64, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69,
+	0x72, 0x61, 0x64, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x0c, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x49, 0x6e, 0x1a, 0x0d, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4f, 0x75,
+	0x74, 0x12, 0x33, 0x0a, 0x0a, 0x75, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x0d, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x10, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x13, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x49, 0x6e, 0x1a,
+	0x14, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x77,
+	0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x77, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75,
+	0x74, 0x12, 0x37, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x1a, 0x12, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x1a, 0x0d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75,
+	0x74, 0x12, 0x34, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x12, 0x10, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x11, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0e, 0x67, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x11, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x1a, 0x12,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4f,
+	0x75, 0x74, 0x12, 0x40, 0x0a, 0x11, 0x67, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x1a, 0x15, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x0e, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x4f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a, 0x12, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a,
+	0x0a, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x7a, 0x69, 0x12, 0x0d, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x55, 0x7a, 0x69, 0x4f, 0x75, 0x74, 0x12, 0x46, 0x0a, 0x13, 0x73, 0x77,
+	0x65, 0x65, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x73, 0x12, 0x16, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x73, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x53, 0x77, 0x65, 0x65,
+	0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x73, 0x4f,
+	0x75, 0x74, 0x12, 0x43, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x7a, 0x69, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x15, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x1a,
+	0x16, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x7a, 0x69, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x69, 0x74, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x55, 0x7a,
+	0x69, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x7a, 0x69, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x1a, 0x13,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x7a, 0x69, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x4f, 0x75, 0x74, 0x42, 0x21, 0x5a, 0x1f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -3117,127 +10799,401 @@ func file_proto_grpc_service_proto_rawDescGZIP() []byte {
 	return file_proto_grpc_service_proto_rawDescData
 }
 
-var file_proto_grpc_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_proto_grpc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_proto_grpc_service_proto_enumTypes = make([]protoimpl.EnumInfo, 24)
+var file_proto_grpc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 134)
 var file_proto_grpc_service_proto_goTypes = []any{
-	(UziStatus)(0),                           // 0: UziStatus
-	(NodeValidation)(0),                      // 1: NodeValidation
-	(UziProjection)(0),                       // 2: UziProjection
-	(*Device)(nil),                           // 3: Device
-	(*CreateDeviceIn)(nil),                   // 4: createDeviceIn
-	(*CreateDeviceOut)(nil),                  // 5: createDeviceOut
-	(*GetDeviceListOut)(nil),                 // 6: GetDeviceListOut
-	(*Uzi)(nil),                              // 7: Uzi
-	(*Echographic)(nil),                      // 8: Echographic
-	(*CreateUziIn)(nil),                      // 9: CreateUziIn
-	(*CreateUziOut)(nil),                     // 10: CreateUziOut
-	(*GetUziByIdIn)(nil),                     // 11: GetUziByIdIn
-	(*GetUziByIdOut)(nil),                    // 12: GetUziByIdOut
-	(*GetUzisByExternalIdIn)(nil),            // 13: GetUzisByExternalIdIn
-	(*GetUzisByExternalIdOut)(nil),           // 14: GetUzisByExternalIdOut
-	(*GetUzisByAuthorIn)(nil),                // 15: GetUzisByAuthorIn
-	(*GetUzisByAuthorOut)(nil),               // 16: GetUzisByAuthorOut
-	(*GetEchographicByUziIdIn)(nil),          // 17: GetEchographicByUziIdIn
-	(*GetEchographicByUziIdOut)(nil),         // 18: GetEchographicByUziIdOut
-	(*UpdateUziIn)(nil),                      // 19: UpdateUziIn
-	(*UpdateUziOut)(nil),                     // 20: UpdateUziOut
-	(*UpdateEchographicIn)(nil),              // 21: UpdateEchographicIn
-	(*UpdateEchographicOut)(nil),             // 22: UpdateEchographicOut
-	(*DeleteUziIn)(nil),                      // 23: DeleteUziIn
-	(*Image)(nil),                            // 24: Image
-	(*GetImagesByUziIdIn)(nil),               // 25: GetImagesByUziIdIn
-	(*GetImagesByUziIdOut)(nil),              // 26: GetImagesByUziIdOut
-	(*Node)(nil),                             // 27: Node
-	(*GetNodesByUziIdIn)(nil),                // 28: GetNodesByUziIdIn
-	(*GetNodesByUziIdOut)(nil),               // 29: GetNodesByUziIdOut
-	(*UpdateNodeIn)(nil),                     // 30: UpdateNodeIn
-	(*UpdateNodeOut)(nil),                    // 31: UpdateNodeOut
-	(*Segment)(nil),                          // 32: Segment
-	(*CreateSegmentIn)(nil),                  // 33: CreateSegmentIn
-	(*CreateSegmentOut)(nil),                 // 34: CreateSegmentOut
-	(*GetSegmentsByNodeIdIn)(nil),            // 35: GetSegmentsByNodeIdIn
-	(*GetSegmentsByNodeIdOut)(nil),           // 36: GetSegmentsByNodeIdOut
-	(*UpdateSegmentIn)(nil),                  // 37: UpdateSegmentIn
-	(*UpdateSegmentOut)(nil),                 // 38: UpdateSegmentOut
-	(*CreateNodeWithSegmentsIn)(nil),         // 39: CreateNodeWithSegmentsIn
-	(*CreateNodeWithSegmentsOut)(nil),        // 40: CreateNodeWithSegmentsOut
-	(*GetNodesWithSegmentsByImageIdIn)(nil),  // 41: GetNodesWithSegmentsByImageIdIn
-	(*GetNodesWithSegmentsByImageIdOut)(nil), // 42: GetNodesWithSegmentsByImageIdOut
-	(*DeleteNodeIn)(nil),                     // 43: DeleteNodeIn
-	(*DeleteSegmentIn)(nil),                  // 44: DeleteSegmentIn
-	(*CreateNodeWithSegmentsIn_Node)(nil),    // 45: CreateNodeWithSegmentsIn.Node
-	(*CreateNodeWithSegmentsIn_Segment)(nil), // 46: CreateNodeWithSegmentsIn.Segment
-	(*emptypb.Empty)(nil),                    // 47: google.protobuf.Empty
+	(ProbeType)(0),                           // 0: ProbeType
+	(UziStatus)(0),                           // 1: UziStatus
+	(NodeValidation)(0),                      // 2: NodeValidation
+	(NodeLobe)(0),                            // 3: NodeLobe
+	(UziProjection)(0),                       // 4: UziProjection
+	(EchographicStruct)(0),                   // 5: EchographicStruct
+	(EchographicEchogenicity)(0),             // 6: EchographicEchogenicity
+	(EchographicVascularization)(0),          // 7: EchographicVascularization
+	(PatientSex)(0),                          // 8: PatientSex
+	(SortOrder)(0),                           // 9: SortOrder
+	(ImageSize)(0),                           // 10: ImageSize
+	(MeasureUnit)(0),                         // 11: MeasureUnit
+	(NodeReviewStatus)(0),                    // 12: NodeReviewStatus
+	(TiradsComposition)(0),                   // 13: TiradsComposition
+	(TiradsEchogenicity)(0),                  // 14: TiradsEchogenicity
+	(TiradsShape)(0),                         // 15: TiradsShape
+	(TiradsMargin)(0),                        // 16: TiradsMargin
+	(TiradsEchogenicFoci)(0),                 // 17: TiradsEchogenicFoci
+	(TiradsCategory)(0),                      // 18: TiradsCategory
+	(TiradsRecommendation)(0),                // 19: TiradsRecommendation
+	(DatasetFormat)(0),                       // 20: DatasetFormat
+	(AnnotationFormat)(0),                    // 21: AnnotationFormat
+	(HistoryAction)(0),                       // 22: HistoryAction
+	(AnalyticsPeriod)(0),                     // 23: AnalyticsPeriod
+	(*Device)(nil),                           // 24: Device
+	(*CreateDeviceIn)(nil),                   // 25: createDeviceIn
+	(*CreateDeviceOut)(nil),                  // 26: createDeviceOut
+	(*GetDeviceListOut)(nil),                 // 27: GetDeviceListOut
+	(*GetDeviceByIdIn)(nil),                  // 28: GetDeviceByIdIn
+	(*GetDeviceByIdOut)(nil),                 // 29: GetDeviceByIdOut
+	(*UpdateDeviceIn)(nil),                   // 30: UpdateDeviceIn
+	(*UpdateDeviceOut)(nil),                  // 31: UpdateDeviceOut
+	(*DeleteDeviceIn)(nil),                   // 32: DeleteDeviceIn
+	(*Uzi)(nil),                              // 33: Uzi
+	(*EchographicFlag)(nil),                  // 34: EchographicFlag
+	(*Echographic)(nil),                      // 35: Echographic
+	(*CreateUziIn)(nil),                      // 36: CreateUziIn
+	(*CreateUziOut)(nil),                     // 37: CreateUziOut
+	(*GetUziByIdIn)(nil),                     // 38: GetUziByIdIn
+	(*GetUziByIdOut)(nil),                    // 39: GetUziByIdOut
+	(*GetUzisByExternalIdIn)(nil),            // 40: GetUzisByExternalIdIn
+	(*GetUzisByExternalIdOut)(nil),           // 41: GetUzisByExternalIdOut
+	(*GetUzisByAuthorIn)(nil),                // 42: GetUzisByAuthorIn
+	(*GetUzisByAuthorOut)(nil),               // 43: GetUzisByAuthorOut
+	(*SearchUzisIn)(nil),                     // 44: SearchUzisIn
+	(*SearchUzisOut)(nil),                    // 45: SearchUzisOut
+	(*GetEchographicByUziIdIn)(nil),          // 46: GetEchographicByUziIdIn
+	(*GetEchographicByUziIdOut)(nil),         // 47: GetEchographicByUziIdOut
+	(*UpdateUziIn)(nil),                      // 48: UpdateUziIn
+	(*UpdateUziOut)(nil),                     // 49: UpdateUziOut
+	(*UpdateEchographicIn)(nil),              // 50: UpdateEchographicIn
+	(*UpdateEchographicOut)(nil),             // 51: UpdateEchographicOut
+	(*DeleteUziIn)(nil),                      // 52: DeleteUziIn
+	(*ImageVariant)(nil),                     // 53: ImageVariant
+	(*Image)(nil),                            // 54: Image
+	(*GetImagesByUziIdIn)(nil),               // 55: GetImagesByUziIdIn
+	(*GetImagesByUziIdOut)(nil),              // 56: GetImagesByUziIdOut
+	(*PixelSpacing)(nil),                     // 57: PixelSpacing
+	(*BoundingBox)(nil),                      // 58: BoundingBox
+	(*SegmentMeasurement)(nil),               // 59: SegmentMeasurement
+	(*NodeMeasurement)(nil),                  // 60: NodeMeasurement
+	(*Node)(nil),                             // 61: Node
+	(*GetNodesByUziIdIn)(nil),                // 62: GetNodesByUziIdIn
+	(*GetNodesByUziIdOut)(nil),               // 63: GetNodesByUziIdOut
+	(*UpdateNodeIn)(nil),                     // 64: UpdateNodeIn
+	(*UpdateNodeOut)(nil),                    // 65: UpdateNodeOut
+	(*NodeReviewItem)(nil),                   // 66: NodeReviewItem
+	(*ReviewNodesIn)(nil),                    // 67: ReviewNodesIn
+	(*ReviewNodesOut)(nil),                   // 68: ReviewNodesOut
+	(*CheckUziIn)(nil),                       // 69: CheckUziIn
+	(*CheckUziOut)(nil),                      // 70: CheckUziOut
+	(*AcceptAiNodesIn)(nil),                  // 71: AcceptAiNodesIn
+	(*AcceptAiNodesOut)(nil),                 // 72: AcceptAiNodesOut
+	(*Segment)(nil),                          // 73: Segment
+	(*CreateSegmentIn)(nil),                  // 74: CreateSegmentIn
+	(*CreateSegmentOut)(nil),                 // 75: CreateSegmentOut
+	(*GetSegmentsByNodeIdIn)(nil),            // 76: GetSegmentsByNodeIdIn
+	(*GetSegmentsByNodeIdOut)(nil),           // 77: GetSegmentsByNodeIdOut
+	(*UpdateSegmentIn)(nil),                  // 78: UpdateSegmentIn
+	(*UpdateSegmentOut)(nil),                 // 79: UpdateSegmentOut
+	(*CreateNodeWithSegmentsIn)(nil),         // 80: CreateNodeWithSegmentsIn
+	(*CreateNodeWithSegmentsOut)(nil),        // 81: CreateNodeWithSegmentsOut
+	(*GetNodesWithSegmentsByImageIdIn)(nil),  // 82: GetNodesWithSegmentsByImageIdIn
+	(*GetNodesWithSegmentsByImageIdOut)(nil), // 83: GetNodesWithSegmentsByImageIdOut
+	(*DeleteNodeIn)(nil),                     // 84: DeleteNodeIn
+	(*DeleteSegmentIn)(nil),                  // 85: DeleteSegmentIn
+	(*RecalculateMeasurementsIn)(nil),        // 86: RecalculateMeasurementsIn
+	(*RecalculateMeasurementsOut)(nil),       // 87: RecalculateMeasurementsOut
+	(*MergeNodesIn)(nil),                     // 88: MergeNodesIn
+	(*MergeNodesOut)(nil),                    // 89: MergeNodesOut
+	(*SplitNodeIn)(nil),                      // 90: SplitNodeIn
+	(*SplitNodeOut)(nil),                     // 91: SplitNodeOut
+	(*SegmentDraft)(nil),                     // 92: SegmentDraft
+	(*ProposeSegmentsIn)(nil),                // 93: ProposeSegmentsIn
+	(*ProposeSegmentsOut)(nil),               // 94: ProposeSegmentsOut
+	(*GetSegmentDraftsByNodeIdIn)(nil),       // 95: GetSegmentDraftsByNodeIdIn
+	(*GetSegmentDraftsByNodeIdOut)(nil),      // 96: GetSegmentDraftsByNodeIdOut
+	(*AcceptSegmentDraftIn)(nil),             // 97: AcceptSegmentDraftIn
+	(*AcceptSegmentDraftOut)(nil),            // 98: AcceptSegmentDraftOut
+	(*DiscardSegmentDraftIn)(nil),            // 99: DiscardSegmentDraftIn
+	(*NodeDescriptors)(nil),                  // 100: NodeDescriptors
+	(*TiradsScore)(nil),                      // 101: TiradsScore
+	(*NodeTirads)(nil),                       // 102: NodeTirads
+	(*SetNodeDescriptorsIn)(nil),             // 103: SetNodeDescriptorsIn
+	(*SetNodeDescriptorsOut)(nil),            // 104: SetNodeDescriptorsOut
+	(*GetNodeTiradsIn)(nil),                  // 105: GetNodeTiradsIn
+	(*GetNodeTiradsOut)(nil),                 // 106: GetNodeTiradsOut
+	(*LinkNodesIn)(nil),                      // 107: LinkNodesIn
+	(*LinkNodesOut)(nil),                     // 108: LinkNodesOut
+	(*UnlinkNodeIn)(nil),                     // 109: UnlinkNodeIn
+	(*SuggestNodeLinksIn)(nil),               // 110: SuggestNodeLinksIn
+	(*NodeLinkSuggestion)(nil),               // 111: NodeLinkSuggestion
+	(*SuggestNodeLinksOut)(nil),              // 112: SuggestNodeLinksOut
+	(*GetGrowthReportIn)(nil),                // 113: GetGrowthReportIn
+	(*NodeGrowthPoint)(nil),                  // 114: NodeGrowthPoint
+	(*NodeGrowth)(nil),                       // 115: NodeGrowth
+	(*GetGrowthReportOut)(nil),               // 116: GetGrowthReportOut
+	(*Report)(nil),                           // 117: Report
+	(*GenerateReportIn)(nil),                 // 118: GenerateReportIn
+	(*GenerateReportOut)(nil),                // 119: GenerateReportOut
+	(*GetReportsIn)(nil),                     // 120: GetReportsIn
+	(*GetReportsOut)(nil),                    // 121: GetReportsOut
+	(*GetReportIn)(nil),                      // 122: GetReportIn
+	(*GetReportOut)(nil),                     // 123: GetReportOut
+	(*ExportDatasetIn)(nil),                  // 124: ExportDatasetIn
+	(*ExportDatasetOut)(nil),                 // 125: ExportDatasetOut
+	(*ImportAnnotationsIn)(nil),              // 126: ImportAnnotationsIn
+	(*ImportAnnotationsOut)(nil),             // 127: ImportAnnotationsOut
+	(*FieldChange)(nil),                      // 128: FieldChange
+	(*NodeVersion)(nil),                      // 129: NodeVersion
+	(*SegmentVersion)(nil),                   // 130: SegmentVersion
+	(*GetNodeHistoryIn)(nil),                 // 131: GetNodeHistoryIn
+	(*GetNodeHistoryOut)(nil),                // 132: GetNodeHistoryOut
+	(*GetSegmentHistoryIn)(nil),              // 133: GetSegmentHistoryIn
+	(*GetSegmentHistoryOut)(nil),             // 134: GetSegmentHistoryOut
+	(*RestoreNodeIn)(nil),                    // 135: RestoreNodeIn
+	(*RestoreNodeOut)(nil),                   // 136: RestoreNodeOut
+	(*RestoreSegmentIn)(nil),                 // 137: RestoreSegmentIn
+	(*RestoreSegmentOut)(nil),                // 138: RestoreSegmentOut
+	(*RestoreUziIn)(nil),                     // 139: RestoreUziIn
+	(*RestoreUziOut)(nil),                    // 140: RestoreUziOut
+	(*SweepStorageOrphansIn)(nil),            // 141: SweepStorageOrphansIn
+	(*SweepStorageOrphansOut)(nil),           // 142: SweepStorageOrphansOut
+	(*VerifyUziIntegrityIn)(nil),             // 143: VerifyUziIntegrityIn
+	(*IntegrityMismatch)(nil),                // 144: IntegrityMismatch
+	(*VerifyUziIntegrityOut)(nil),            // 145: VerifyUziIntegrityOut
+	(*GetUziAnalyticsIn)(nil),                // 146: GetUziAnalyticsIn
+	(*TiradsDistribution)(nil),               // 147: TiradsDistribution
+	(*NodeMetrics)(nil),                      // 148: NodeMetrics
+	(*DeviceNodeMetrics)(nil),                // 149: DeviceNodeMetrics
+	(*AuthorNodeMetrics)(nil),                // 150: AuthorNodeMetrics
+	(*PeriodNodeMetrics)(nil),                // 151: PeriodNodeMetrics
+	(*ReaderAgreement)(nil),                  // 152: ReaderAgreement
+	(*GetUziAnalyticsOut)(nil),               // 153: GetUziAnalyticsOut
+	(*CreateNodeWithSegmentsIn_Node)(nil),    // 154: CreateNodeWithSegmentsIn.Node
+	(*CreateNodeWithSegmentsIn_Segment)(nil), // 155: CreateNodeWithSegmentsIn.Segment
+	(*ImportAnnotationsOut_Node)(nil),        // 156: ImportAnnotationsOut.Node
+	(*ImportAnnotationsOut_Skipped)(nil),     // 157: ImportAnnotationsOut.Skipped
+	(*emptypb.Empty)(nil),                    // 158: google.protobuf.Empty
 }
 var file_proto_grpc_service_proto_depIdxs = []int32{
-	3,  // 0: GetDeviceListOut.devices:type_name -> Device
-	2,  // 1: Uzi.projection:type_name -> UziProjection
-	0,  // 2: Uzi.status:type_name -> UziStatus
-	2,  // 3: CreateUziIn.projection:type_name -> UziProjection
-	7,  // 4: GetUziByIdOut.uzi:type_name -> Uzi
-	7,  // 5: GetUzisByExternalIdOut.uzis:type_name -> Uzi
-	7,  // 6: GetUzisByAuthorOut.uzis:type_name -> Uzi
-	8,  // 7: GetEchographicByUziIdOut.echographic:type_name -> Echographic
-	2,  // 8: UpdateUziIn.projection:type_name -> UziProjection
-	7,  // 9: UpdateUziOut.uzi:type_name -> Uzi
-	8,  // 10: UpdateEchographicIn.echographic:type_name -> Echographic
-	8,  // 11: UpdateEchographicOut.echographic:type_name -> Echographic
-	24, // 12: GetImagesByUziIdOut.images:type_name -> Image
-	1,  // 13: Node.validation:type_name -> NodeValidation
-	27, // 14: GetNodesByUziIdOut.nodes:type_name -> Node
-	1,  // 15: UpdateNodeIn.validation:type_name -> NodeValidation
-	27, // 16: UpdateNodeOut.node:type_name -> Node
-	32, // 17: GetSegmentsByNodeIdOut.segments:type_name -> Segment
-	32, // 18: UpdateSegmentOut.segment:type_name -> Segment
-	45, // 19: CreateNodeWithSegmentsIn.node:type_name -> CreateNodeWithSegmentsIn.Node
-	46, // 20: CreateNodeWithSegmentsIn.segments:type_name -> CreateNodeWithSegmentsIn.Segment
-	27, // 21: GetNodesWithSegmentsByImageIdOut.nodes:type_name -> Node
-	32, // 22: GetNodesWithSegmentsByImageIdOut.segments:type_name -> Segment
-	4,  // 23: UziSrv.createDevice:input_type -> createDeviceIn
-	47, // 24: UziSrv.getDeviceList:input_type -> google.protobuf.Empty
-	9,  // 25: UziSrv.createUzi:input_type -> CreateUziIn
-	11, // 26: UziSrv.getUziById:input_type -> GetUziByIdIn
-	13, // 27: UziSrv.getUzisByExternalId:input_type -> GetUzisByExternalIdIn
-	15, // 28: UziSrv.getUzisByAuthor:input_type -> GetUzisByAuthorIn
-	17, // 29: UziSrv.getEchographicByUziId:input_type -> GetEchographicByUziIdIn
-	19, // 30: UziSrv.updateUzi:input_type -> UpdateUziIn
-	21, // 31: UziSrv.updateEchographic:input_type -> UpdateEchographicIn
-	23, // 32: UziSrv.deleteUzi:input_type -> DeleteUziIn
-	25, // 33: UziSrv.getImagesByUziId:input_type -> GetImagesByUziIdIn
-	28, // 34: UziSrv.getNodesByUziId:input_type -> GetNodesByUziIdIn
-	30, // 35: UziSrv.updateNode:input_type -> UpdateNodeIn
-	33, // 36: UziSrv.createSegment:input_type -> CreateSegmentIn
-	35, // 37: UziSrv.getSegmentsByNodeId:input_type -> GetSegmentsByNodeIdIn
-	37, // 38: UziSrv.updateSegment:input_type -> UpdateSegmentIn
-	39, // 39: UziSrv.createNodeWithSegments:input_type -> CreateNodeWithSegmentsIn
-	41, // 40: UziSrv.getNodesWithSegmentsByImageId:input_type -> GetNodesWithSegmentsByImageIdIn
-	43, // 41: UziSrv.deleteNode:input_type -> DeleteNodeIn
-	44, // 42: UziSrv.deleteSegment:input_type -> DeleteSegmentIn
-	5,  // 43: UziSrv.createDevice:output_type -> createDeviceOut
-	6,  // 44: UziSrv.getDeviceList:output_type -> GetDeviceListOut
-	10, // 45: UziSrv.createUzi:output_type -> CreateUziOut
-	12, // 46: UziSrv.getUziById:output_type -> GetUziByIdOut
-	14, // 47: UziSrv.getUzisByExternalId:output_type -> GetUzisByExternalIdOut
-	16, // 48: UziSrv.getUzisByAuthor:output_type -> GetUzisByAuthorOut
-	18, // 49: UziSrv.getEchographicByUziId:output_type -> GetEchographicByUziIdOut
-	20, // 50: UziSrv.updateUzi:output_type -> UpdateUziOut
-	22, // 51: UziSrv.updateEchographic:output_type -> UpdateEchographicOut
-	47, // 52: UziSrv.deleteUzi:output_type -> google.protobuf.Empty
-	26, // 53: UziSrv.getImagesByUziId:output_type -> GetImagesByUziIdOut
-	29, // 54: UziSrv.getNodesByUziId:output_type -> GetNodesByUziIdOut
-	31, // 55: UziSrv.updateNode:output_type -> UpdateNodeOut
-	34, // 56: UziSrv.createSegment:output_type -> CreateSegmentOut
-	36, // 57: UziSrv.getSegmentsByNodeId:output_type -> GetSegmentsByNodeIdOut
-	38, // 58: UziSrv.updateSegment:output_type -> UpdateSegmentOut
-	40, // 59: UziSrv.createNodeWithSegments:output_type -> CreateNodeWithSegmentsOut
-	42, // 60: UziSrv.getNodesWithSegmentsByImageId:output_type -> GetNodesWithSegmentsByImageIdOut
-	47, // 61: UziSrv.deleteNode:output_type -> google.protobuf.Empty
-	47, // 62: UziSrv.deleteSegment:output_type -> google.protobuf.Empty
-	43, // [43:63] is the sub-list for method output_type
-	23, // [23:43] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	0,   // 0: Device.probe_type:type_name -> ProbeType
+	57,  // 1: Device.pixel_spacing:type_name -> PixelSpacing
+	0,   // 2: createDeviceIn.probe_type:type_name -> ProbeType
+	57,  // 3: createDeviceIn.pixel_spacing:type_name -> PixelSpacing
+	24,  // 4: GetDeviceListOut.devices:type_name -> Device
+	24,  // 5: GetDeviceByIdOut.device:type_name -> Device
+	0,   // 6: UpdateDeviceIn.probe_type:type_name -> ProbeType
+	57,  // 7: UpdateDeviceIn.pixel_spacing:type_name -> PixelSpacing
+	24,  // 8: UpdateDeviceOut.device:type_name -> Device
+	4,   // 9: Uzi.projection:type_name -> UziProjection
+	1,   // 10: Uzi.status:type_name -> UziStatus
+	57,  // 11: Uzi.pixel_spacing:type_name -> PixelSpacing
+	5,   // 12: Echographic.struct:type_name -> EchographicStruct
+	6,   // 13: Echographic.echogenicity:type_name -> EchographicEchogenicity
+	7,   // 14: Echographic.vascularization:type_name -> EchographicVascularization
+	8,   // 15: Echographic.patient_sex:type_name -> PatientSex
+	34,  // 16: Echographic.flags:type_name -> EchographicFlag
+	4,   // 17: CreateUziIn.projection:type_name -> UziProjection
+	57,  // 18: CreateUziIn.pixel_spacing:type_name -> PixelSpacing
+	33,  // 19: GetUziByIdOut.uzi:type_name -> Uzi
+	33,  // 20: GetUzisByExternalIdOut.uzis:type_name -> Uzi
+	33,  // 21: GetUzisByAuthorOut.uzis:type_name -> Uzi
+	1,   // 22: SearchUzisIn.status:type_name -> UziStatus
+	4,   // 23: SearchUzisIn.projection:type_name -> UziProjection
+	9,   // 24: SearchUzisIn.order:type_name -> SortOrder
+	33,  // 25: SearchUzisOut.uzis:type_name -> Uzi
+	35,  // 26: GetEchographicByUziIdOut.echographic:type_name -> Echographic
+	4,   // 27: UpdateUziIn.projection:type_name -> UziProjection
+	57,  // 28: UpdateUziIn.pixel_spacing:type_name -> PixelSpacing
+	33,  // 29: UpdateUziOut.uzi:type_name -> Uzi
+	35,  // 30: UpdateEchographicIn.echographic:type_name -> Echographic
+	35,  // 31: UpdateEchographicOut.echographic:type_name -> Echographic
+	10,  // 32: ImageVariant.size:type_name -> ImageSize
+	53,  // 33: Image.variants:type_name -> ImageVariant
+	54,  // 34: GetImagesByUziIdOut.images:type_name -> Image
+	58,  // 35: SegmentMeasurement.bbox:type_name -> BoundingBox
+	11,  // 36: SegmentMeasurement.unit:type_name -> MeasureUnit
+	11,  // 37: NodeMeasurement.unit:type_name -> MeasureUnit
+	2,   // 38: Node.validation:type_name -> NodeValidation
+	60,  // 39: Node.measurement:type_name -> NodeMeasurement
+	3,   // 40: Node.lobe:type_name -> NodeLobe
+	61,  // 41: GetNodesByUziIdOut.nodes:type_name -> Node
+	2,   // 42: UpdateNodeIn.validation:type_name -> NodeValidation
+	3,   // 43: UpdateNodeIn.lobe:type_name -> NodeLobe
+	61,  // 44: UpdateNodeOut.node:type_name -> Node
+	12,  // 45: NodeReviewItem.status:type_name -> NodeReviewStatus
+	61,  // 46: NodeReviewItem.node:type_name -> Node
+	2,   // 47: ReviewNodesIn.validation:type_name -> NodeValidation
+	66,  // 48: ReviewNodesOut.items:type_name -> NodeReviewItem
+	2,   // 49: CheckUziIn.unvalidated:type_name -> NodeValidation
+	33,  // 50: CheckUziOut.uzi:type_name -> Uzi
+	66,  // 51: CheckUziOut.items:type_name -> NodeReviewItem
+	66,  // 52: AcceptAiNodesOut.items:type_name -> NodeReviewItem
+	59,  // 53: Segment.measurement:type_name -> SegmentMeasurement
+	73,  // 54: GetSegmentsByNodeIdOut.segments:type_name -> Segment
+	73,  // 55: UpdateSegmentOut.segment:type_name -> Segment
+	154, // 56: CreateNodeWithSegmentsIn.node:type_name -> CreateNodeWithSegmentsIn.Node
+	155, // 57: CreateNodeWithSegmentsIn.segments:type_name -> CreateNodeWithSegmentsIn.Segment
+	61,  // 58: GetNodesWithSegmentsByImageIdOut.nodes:type_name -> Node
+	73,  // 59: GetNodesWithSegmentsByImageIdOut.segments:type_name -> Segment
+	61,  // 60: RecalculateMeasurementsOut.nodes:type_name -> Node
+	73,  // 61: RecalculateMeasurementsOut.segments:type_name -> Segment
+	61,  // 62: MergeNodesOut.node:type_name -> Node
+	61,  // 63: SplitNodeOut.node:type_name -> Node
+	61,  // 64: SplitNodeOut.new_node:type_name -> Node
+	92,  // 65: ProposeSegmentsOut.drafts:type_name -> SegmentDraft
+	92,  // 66: GetSegmentDraftsByNodeIdOut.drafts:type_name -> SegmentDraft
+	73,  // 67: AcceptSegmentDraftOut.segment:type_name -> Segment
+	13,  // 68: NodeDescriptors.composition:type_name -> TiradsComposition
+	14,  // 69: NodeDescriptors.echogenicity:type_name -> TiradsEchogenicity
+	15,  // 70: NodeDescriptors.shape:type_name -> TiradsShape
+	16,  // 71: NodeDescriptors.margin:type_name -> TiradsMargin
+	17,  // 72: NodeDescriptors.echogenic_foci:type_name -> TiradsEchogenicFoci
+	18,  // 73: TiradsScore.category:type_name -> TiradsCategory
+	19,  // 74: TiradsScore.recommendation:type_name -> TiradsRecommendation
+	61,  // 75: NodeTirads.node:type_name -> Node
+	100, // 76: NodeTirads.descriptors:type_name -> NodeDescriptors
+	101, // 77: NodeTirads.score:type_name -> TiradsScore
+	100, // 78: SetNodeDescriptorsIn.descriptors:type_name -> NodeDescriptors
+	102, // 79: SetNodeDescriptorsOut.tirads:type_name -> NodeTirads
+	102, // 80: GetNodeTiradsOut.tirads:type_name -> NodeTirads
+	61,  // 81: NodeLinkSuggestion.node:type_name -> Node
+	111, // 82: SuggestNodeLinksOut.suggestions:type_name -> NodeLinkSuggestion
+	61,  // 83: NodeGrowthPoint.node:type_name -> Node
+	114, // 84: NodeGrowth.points:type_name -> NodeGrowthPoint
+	115, // 85: GetGrowthReportOut.lineages:type_name -> NodeGrowth
+	117, // 86: GenerateReportOut.report:type_name -> Report
+	117, // 87: GetReportsOut.reports:type_name -> Report
+	117, // 88: GetReportOut.report:type_name -> Report
+	1,   // 89: ExportDatasetIn.status:type_name -> UziStatus
+	4,   // 90: ExportDatasetIn.projection:type_name -> UziProjection
+	20,  // 91: ExportDatasetIn.format:type_name -> DatasetFormat
+	21,  // 92: ImportAnnotationsIn.format:type_name -> AnnotationFormat
+	156, // 93: ImportAnnotationsOut.nodes:type_name -> ImportAnnotationsOut.Node
+	157, // 94: ImportAnnotationsOut.skipped:type_name -> ImportAnnotationsOut.Skipped
+	22,  // 95: NodeVersion.action:type_name -> HistoryAction
+	61,  // 96: NodeVersion.before:type_name -> Node
+	61,  // 97: NodeVersion.after:type_name -> Node
+	128, // 98: NodeVersion.diff:type_name -> FieldChange
+	22,  // 99: SegmentVersion.action:type_name -> HistoryAction
+	73,  // 100: SegmentVersion.before:type_name -> Segment
+	73,  // 101: SegmentVersion.after:type_name -> Segment
+	128, // 102: SegmentVersion.diff:type_name -> FieldChange
+	129, // 103: GetNodeHistoryOut.versions:type_name -> NodeVersion
+	130, // 104: GetSegmentHistoryOut.versions:type_name -> SegmentVersion
+	61,  // 105: RestoreNodeOut.node:type_name -> Node
+	73,  // 106: RestoreSegmentOut.segment:type_name -> Segment
+	33,  // 107: RestoreUziOut.uzi:type_name -> Uzi
+	144, // 108: VerifyUziIntegrityOut.mismatches:type_name -> IntegrityMismatch
+	23,  // 109: GetUziAnalyticsIn.period:type_name -> AnalyticsPeriod
+	147, // 110: NodeMetrics.ai_tirads:type_name -> TiradsDistribution
+	147, // 111: NodeMetrics.manual_tirads:type_name -> TiradsDistribution
+	148, // 112: DeviceNodeMetrics.metrics:type_name -> NodeMetrics
+	148, // 113: AuthorNodeMetrics.metrics:type_name -> NodeMetrics
+	148, // 114: PeriodNodeMetrics.metrics:type_name -> NodeMetrics
+	148, // 115: GetUziAnalyticsOut.total:type_name -> NodeMetrics
+	149, // 116: GetUziAnalyticsOut.by_device:type_name -> DeviceNodeMetrics
+	150, // 117: GetUziAnalyticsOut.by_author:type_name -> AuthorNodeMetrics
+	151, // 118: GetUziAnalyticsOut.by_period:type_name -> PeriodNodeMetrics
+	152, // 119: GetUziAnalyticsOut.agreement:type_name -> ReaderAgreement
+	25,  // 120: UziSrv.createDevice:input_type -> createDeviceIn
+	158, // 121: UziSrv.getDeviceList:input_type -> google.protobuf.Empty
+	28,  // 122: UziSrv.getDeviceById:input_type -> GetDeviceByIdIn
+	30,  // 123: UziSrv.updateDevice:input_type -> UpdateDeviceIn
+	32,  // 124: UziSrv.deleteDevice:input_type -> DeleteDeviceIn
+	36,  // 125: UziSrv.createUzi:input_type -> CreateUziIn
+	38,  // 126: UziSrv.getUziById:input_type -> GetUziByIdIn
+	40,  // 127: UziSrv.getUzisByExternalId:input_type -> GetUzisByExternalIdIn
+	42,  // 128: UziSrv.getUzisByAuthor:input_type -> GetUzisByAuthorIn
+	44,  // 129: UziSrv.searchUzis:input_type -> SearchUzisIn
+	46,  // 130: UziSrv.getEchographicByUziId:input_type -> GetEchographicByUziIdIn
+	48,  // 131: UziSrv.updateUzi:input_type -> UpdateUziIn
+	50,  // 132: UziSrv.updateEchographic:input_type -> UpdateEchographicIn
+	52,  // 133: UziSrv.deleteUzi:input_type -> DeleteUziIn
+	55,  // 134: UziSrv.getImagesByUziId:input_type -> GetImagesByUziIdIn
+	62,  // 135: UziSrv.getNodesByUziId:input_type -> GetNodesByUziIdIn
+	64,  // 136: UziSrv.updateNode:input_type -> UpdateNodeIn
+	67,  // 137: UziSrv.reviewNodes:input_type -> ReviewNodesIn
+	69,  // 138: UziSrv.checkUzi:input_type -> CheckUziIn
+	71,  // 139: UziSrv.acceptAiNodes:input_type -> AcceptAiNodesIn
+	74,  // 140: UziSrv.createSegment:input_type -> CreateSegmentIn
+	76,  // 141: UziSrv.getSegmentsByNodeId:input_type -> GetSegmentsByNodeIdIn
+	78,  // 142: UziSrv.updateSegment:input_type -> UpdateSegmentIn
+	80,  // 143: UziSrv.createNodeWithSegments:input_type -> CreateNodeWithSegmentsIn
+	82,  // 144: UziSrv.getNodesWithSegmentsByImageId:input_type -> GetNodesWithSegmentsByImageIdIn
+	84,  // 145: UziSrv.deleteNode:input_type -> DeleteNodeIn
+	85,  // 146: UziSrv.deleteSegment:input_type -> DeleteSegmentIn
+	86,  // 147: UziSrv.recalculateMeasurements:input_type -> RecalculateMeasurementsIn
+	88,  // 148: UziSrv.mergeNodes:input_type -> MergeNodesIn
+	90,  // 149: UziSrv.splitNode:input_type -> SplitNodeIn
+	93,  // 150: UziSrv.proposeSegments:input_type -> ProposeSegmentsIn
+	95,  // 151: UziSrv.getSegmentDraftsByNodeId:input_type -> GetSegmentDraftsByNodeIdIn
+	97,  // 152: UziSrv.acceptSegmentDraft:input_type -> AcceptSegmentDraftIn
+	99,  // 153: UziSrv.discardSegmentDraft:input_type -> DiscardSegmentDraftIn
+	103, // 154: UziSrv.setNodeDescriptors:input_type -> SetNodeDescriptorsIn
+	105, // 155: UziSrv.getNodeTirads:input_type -> GetNodeTiradsIn
+	107, // 156: UziSrv.linkNodes:input_type -> LinkNodesIn
+	109, // 157: UziSrv.unlinkNode:input_type -> UnlinkNodeIn
+	110, // 158: UziSrv.suggestNodeLinks:input_type -> SuggestNodeLinksIn
+	113, // 159: UziSrv.getGrowthReport:input_type -> GetGrowthReportIn
+	118, // 160: UziSrv.generateReport:input_type -> GenerateReportIn
+	120, // 161: UziSrv.getReports:input_type -> GetReportsIn
+	122, // 162: UziSrv.getReport:input_type -> GetReportIn
+	124, // 163: UziSrv.exportDataset:input_type -> ExportDatasetIn
+	126, // 164: UziSrv.importAnnotations:input_type -> ImportAnnotationsIn
+	131, // 165: UziSrv.getNodeHistory:input_type -> GetNodeHistoryIn
+	133, // 166: UziSrv.getSegmentHistory:input_type -> GetSegmentHistoryIn
+	135, // 167: UziSrv.restoreNode:input_type -> RestoreNodeIn
+	137, // 168: UziSrv.restoreSegment:input_type -> RestoreSegmentIn
+	139, // 169: UziSrv.restoreUzi:input_type -> RestoreUziIn
+	141, // 170: UziSrv.sweepStorageOrphans:input_type -> SweepStorageOrphansIn
+	143, // 171: UziSrv.verifyUziIntegrity:input_type -> VerifyUziIntegrityIn
+	146, // 172: UziSrv.getUziAnalytics:input_type -> GetUziAnalyticsIn
+	26,  // 173: UziSrv.createDevice:output_type -> createDeviceOut
+	27,  // 174: UziSrv.getDeviceList:output_type -> GetDeviceListOut
+	29,  // 175: UziSrv.getDeviceById:output_type -> GetDeviceByIdOut
+	31,  // 176: UziSrv.updateDevice:output_type -> UpdateDeviceOut
+	158, // 177: UziSrv.deleteDevice:output_type -> google.protobuf.Empty
+	37,  // 178: UziSrv.createUzi:output_type -> CreateUziOut
+	39,  // 179: UziSrv.getUziById:output_type -> GetUziByIdOut
+	41,  // 180: UziSrv.getUzisByExternalId:output_type -> GetUzisByExternalIdOut
+	43,  // 181: UziSrv.getUzisByAuthor:output_type -> GetUzisByAuthorOut
+	45,  // 182: UziSrv.searchUzis:output_type -> SearchUzisOut
+	47,  // 183: UziSrv.getEchographicByUziId:output_type -> GetEchographicByUziIdOut
+	49,  // 184: UziSrv.updateUzi:output_type -> UpdateUziOut
+	51,  // 185: UziSrv.updateEchographic:output_type -> UpdateEchographicOut
+	158, // 186: UziSrv.deleteUzi:output_type -> google.protobuf.Empty
+	56,  // 187: UziSrv.getImagesByUziId:output_type -> GetImagesByUziIdOut
+	63,  // 188: UziSrv.getNodesByUziId:output_type -> GetNodesByUziIdOut
+	65,  // 189: UziSrv.updateNode:output_type -> UpdateNodeOut
+	68,  // 190: UziSrv.reviewNodes:output_type -> ReviewNodesOut
+	70,  // 191: UziSrv.checkUzi:output_type -> CheckUziOut
+	72,  // 192: UziSrv.acceptAiNodes:output_type -> AcceptAiNodesOut
+	75,  // 193: UziSrv.createSegment:output_type -> CreateSegmentOut
+	77,  // 194: UziSrv.getSegmentsByNodeId:output_type -> GetSegmentsByNodeIdOut
+	79,  // 195: UziSrv.updateSegment:output_type -> UpdateSegmentOut
+	81,  // 196: UziSrv.createNodeWithSegments:output_type -> CreateNodeWithSegmentsOut
+	83,  // 197: UziSrv.getNodesWithSegmentsByImageId:output_type -> GetNodesWithSegmentsByImageIdOut
+	158, // 198: UziSrv.deleteNode:output_type -> google.protobuf.Empty
+	158, // 199: UziSrv.deleteSegment:output_type -> google.protobuf.Empty
+	87,  // 200: UziSrv.recalculateMeasurements:output_type -> RecalculateMeasurementsOut
+	89,  // 201: UziSrv.mergeNodes:output_type -> MergeNodesOut
+	91,  // 202: UziSrv.splitNode:output_type -> SplitNodeOut
+	94,  // 203: UziSrv.proposeSegments:output_type -> ProposeSegmentsOut
+	96,  // 204: UziSrv.getSegmentDraftsByNodeId:output_type -> GetSegmentDraftsByNodeIdOut
+	98,  // 205: UziSrv.acceptSegmentDraft:output_type -> AcceptSegmentDraftOut
+	158, // 206: UziSrv.discardSegmentDraft:output_type -> google.protobuf.Empty
+	104, // 207: UziSrv.setNodeDescriptors:output_type -> SetNodeDescriptorsOut
+	106, // 208: UziSrv.getNodeTirads:output_type -> GetNodeTiradsOut
+	108, // 209: UziSrv.linkNodes:output_type -> LinkNodesOut
+	158, // 210: UziSrv.unlinkNode:output_type -> google.protobuf.Empty
+	112, // 211: UziSrv.suggestNodeLinks:output_type -> SuggestNodeLinksOut
+	116, // 212: UziSrv.getGrowthReport:output_type -> GetGrowthReportOut
+	119, // 213: UziSrv.generateReport:output_type -> GenerateReportOut
+	121, // 214: UziSrv.getReports:output_type -> GetReportsOut
+	123, // 215: UziSrv.getReport:output_type -> GetReportOut
+	125, // 216: UziSrv.exportDataset:output_type -> ExportDatasetOut
+	127, // 217: UziSrv.importAnnotations:output_type -> ImportAnnotationsOut
+	132, // 218: UziSrv.getNodeHistory:output_type -> GetNodeHistoryOut
+	134, // 219: UziSrv.getSegmentHistory:output_type -> GetSegmentHistoryOut
+	136, // 220: UziSrv.restoreNode:output_type -> RestoreNodeOut
+	138, // 221: UziSrv.restoreSegment:output_type -> RestoreSegmentOut
+	140, // 222: UziSrv.restoreUzi:output_type -> RestoreUziOut
+	142, // 223: UziSrv.sweepStorageOrphans:output_type -> SweepStorageOrphansOut
+	145, // 224: UziSrv.verifyUziIntegrity:output_type -> VerifyUziIntegrityOut
+	153, // 225: UziSrv.getUziAnalytics:output_type -> GetUziAnalyticsOut
+	173, // [173:226] is the sub-list for method output_type
+	120, // [120:173] is the sub-list for method input_type
+	120, // [120:120] is the sub-list for extension type_name
+	120, // [120:120] is the sub-list for extension extendee
+	0,   // [0:120] is the sub-list for field type_name
 }
 
 func init() { file_proto_grpc_service_proto_init() }
@@ -3245,21 +11201,43 @@ func file_proto_grpc_service_proto_init() {
 	if File_proto_grpc_service_proto != nil {
 		return
 	}
-	file_proto_grpc_service_proto_msgTypes[4].OneofWrappers = []any{}
-	file_proto_grpc_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[1].OneofWrappers = []any{}
 	file_proto_grpc_service_proto_msgTypes[6].OneofWrappers = []any{}
-	file_proto_grpc_service_proto_msgTypes[16].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[21].OneofWrappers = []any{}
 	file_proto_grpc_service_proto_msgTypes[24].OneofWrappers = []any{}
-	file_proto_grpc_service_proto_msgTypes[27].OneofWrappers = []any{}
-	file_proto_grpc_service_proto_msgTypes[34].OneofWrappers = []any{}
-	file_proto_grpc_service_proto_msgTypes[42].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[40].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[45].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[54].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[69].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[77].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[90].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[91].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[98].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[100].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[102].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[104].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[105].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[106].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[122].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[124].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[128].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[129].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[130].OneofWrappers = []any{}
+	file_proto_grpc_service_proto_msgTypes[132].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_grpc_service_proto_rawDesc), len(file_proto_grpc_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   44,
+			NumEnums:      24,
+			NumMessages:   134,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,10 +23,14 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	UziSrv_CreateDevice_FullMethodName                  = "/UziSrv/createDevice"
 	UziSrv_GetDeviceList_FullMethodName                 = "/UziSrv/getDeviceList"
+	UziSrv_GetDeviceById_FullMethodName                 = "/UziSrv/getDeviceById"
+	UziSrv_UpdateDevice_FullMethodName                  = "/UziSrv/updateDevice"
+	UziSrv_DeleteDevice_FullMethodName                  = "/UziSrv/deleteDevice"
 	UziSrv_CreateUzi_FullMethodName                     = "/UziSrv/createUzi"
 	UziSrv_GetUziById_FullMethodName                    = "/UziSrv/getUziById"
 	UziSrv_GetUzisByExternalId_FullMethodName           = "/UziSrv/getUzisByExternalId"
 	UziSrv_GetUzisByAuthor_FullMethodName               = "/UziSrv/getUzisByAuthor"
+	UziSrv_SearchUzis_FullMethodName                    = "/UziSrv/searchUzis"
 	UziSrv_GetEchographicByUziId_FullMethodName         = "/UziSrv/getEchographicByUziId"
 	UziSrv_UpdateUzi_FullMethodName                     = "/UziSrv/updateUzi"
 	UziSrv_UpdateEchographic_FullMethodName             = "/UziSrv/updateEchographic"
@@ -34,6 +38,9 @@ const (
 	UziSrv_GetImagesByUziId_FullMethodName              = "/UziSrv/getImagesByUziId"
 	UziSrv_GetNodesByUziId_FullMethodName               = "/UziSrv/getNodesByUziId"
 	UziSrv_UpdateNode_FullMethodName                    = "/UziSrv/updateNode"
+	UziSrv_ReviewNodes_FullMethodName                   = "/UziSrv/reviewNodes"
+	UziSrv_CheckUzi_FullMethodName                      = "/UziSrv/checkUzi"
+	UziSrv_AcceptAiNodes_FullMethodName                 = "/UziSrv/acceptAiNodes"
 	UziSrv_CreateSegment_FullMethodName                 = "/UziSrv/createSegment"
 	UziSrv_GetSegmentsByNodeId_FullMethodName           = "/UziSrv/getSegmentsByNodeId"
 	UziSrv_UpdateSegment_FullMethodName                 = "/UziSrv/updateSegment"
@@ -41,6 +48,32 @@ const (
 	UziSrv_GetNodesWithSegmentsByImageId_FullMethodName = "/UziSrv/getNodesWithSegmentsByImageId"
 	UziSrv_DeleteNode_FullMethodName                    = "/UziSrv/deleteNode"
 	UziSrv_DeleteSegment_FullMethodName                 = "/UziSrv/deleteSegment"
+	UziSrv_RecalculateMeasurements_FullMethodName       = "/UziSrv/recalculateMeasurements"
+	UziSrv_MergeNodes_FullMethodName                    = "/UziSrv/mergeNodes"
+	UziSrv_SplitNode_FullMethodName                     = "/UziSrv/splitNode"
+	UziSrv_ProposeSegments_FullMethodName               = "/UziSrv/proposeSegments"
+	UziSrv_GetSegmentDraftsByNodeId_FullMethodName      = "/UziSrv/getSegmentDraftsByNodeId"
+	UziSrv_AcceptSegmentDraft_FullMethodName            = "/UziSrv/acceptSegmentDraft"
+	UziSrv_DiscardSegmentDraft_FullMethodName           = "/UziSrv/discardSegmentDraft"
+	UziSrv_SetNodeDescriptors_FullMethodName            = "/UziSrv/setNodeDescriptors"
+	UziSrv_GetNodeTirads_FullMethodName                 = "/UziSrv/getNodeTirads"
+	UziSrv_LinkNodes_FullMethodName                     = "/UziSrv/linkNodes"
+	UziSrv_UnlinkNode_FullMethodName                    = "/UziSrv/unlinkNode"
+	UziSrv_SuggestNodeLinks_FullMethodName              = "/UziSrv/suggestNodeLinks"
+	UziSrv_GetGrowthReport_FullMethodName               = "/UziSrv/getGrowthReport"
+	UziSrv_GenerateReport_FullMethodName                = "/UziSrv/generateReport"
+	UziSrv_GetReports_FullMethodName                    = "/UziSrv/getReports"
+	UziSrv_GetReport_FullMethodName                     = "/UziSrv/getReport"
+	UziSrv_ExportDataset_FullMethodName                 = "/UziSrv/exportDataset"
+	UziSrv_ImportAnnotations_FullMethodName             = "/UziSrv/importAnnotations"
+	UziSrv_GetNodeHistory_FullMethodName                = "/UziSrv/getNodeHistory"
+	UziSrv_GetSegmentHistory_FullMethodName             = "/UziSrv/getSegmentHistory"
+	UziSrv_RestoreNode_FullMethodName                   = "/UziSrv/restoreNode"
+	UziSrv_RestoreSegment_FullMethodName                = "/UziSrv/restoreSegment"
+	UziSrv_RestoreUzi_FullMethodName                    = "/UziSrv/restoreUzi"
+	UziSrv_SweepStorageOrphans_FullMethodName           = "/UziSrv/sweepStorageOrphans"
+	UziSrv_VerifyUziIntegrity_FullMethodName            = "/UziSrv/verifyUziIntegrity"
+	UziSrv_GetUziAnalytics_FullMethodName               = "/UziSrv/getUziAnalytics"
 )
 
 // UziSrvClient is the client API for UziSrv service.
@@ -50,11 +83,15 @@ type UziSrvClient interface {
 	// DEVICE
 	CreateDevice(ctx context.Context, in *CreateDeviceIn, opts ...grpc.CallOption) (*CreateDeviceOut, error)
 	GetDeviceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDeviceListOut, error)
+	GetDeviceById(ctx context.Context, in *GetDeviceByIdIn, opts ...grpc.CallOption) (*GetDeviceByIdOut, error)
+	UpdateDevice(ctx context.Context, in *UpdateDeviceIn, opts ...grpc.CallOption) (*UpdateDeviceOut, error)
+	DeleteDevice(ctx context.Context, in *DeleteDeviceIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// UZI
 	CreateUzi(ctx context.Context, in *CreateUziIn, opts ...grpc.CallOption) (*CreateUziOut, error)
 	GetUziById(ctx context.Context, in *GetUziByIdIn, opts ...grpc.CallOption) (*GetUziByIdOut, error)
 	GetUzisByExternalId(ctx context.Context, in *GetUzisByExternalIdIn, opts ...grpc.CallOption) (*GetUzisByExternalIdOut, error)
 	GetUzisByAuthor(ctx context.Context, in *GetUzisByAuthorIn, opts ...grpc.CallOption) (*GetUzisByAuthorOut, error)
+	SearchUzis(ctx context.Context, in *SearchUzisIn, opts ...grpc.CallOption) (*SearchUzisOut, error)
 	GetEchographicByUziId(ctx context.Context, in *GetEchographicByUziIdIn, opts ...grpc.CallOption) (*GetEchographicByUziIdOut, error)
 	UpdateUzi(ctx context.Context, in *UpdateUziIn, opts ...grpc.CallOption) (*UpdateUziOut, error)
 	UpdateEchographic(ctx context.Context, in *UpdateEchographicIn, opts ...grpc.CallOption) (*UpdateEchographicOut, error)
@@ -64,6 +101,10 @@ type UziSrvClient interface {
 	// NODE
 	GetNodesByUziId(ctx context.Context, in *GetNodesByUziIdIn, opts ...grpc.CallOption) (*GetNodesByUziIdOut, error)
 	UpdateNode(ctx context.Context, in *UpdateNodeIn, opts ...grpc.CallOption) (*UpdateNodeOut, error)
+	// пакетная валидация узлов нейросети, каждый пакет выполняется в одной транзакции
+	ReviewNodes(ctx context.Context, in *ReviewNodesIn, opts ...grpc.CallOption) (*ReviewNodesOut, error)
+	CheckUzi(ctx context.Context, in *CheckUziIn, opts ...grpc.CallOption) (*CheckUziOut, error)
+	AcceptAiNodes(ctx context.Context, in *AcceptAiNodesIn, opts ...grpc.CallOption) (*AcceptAiNodesOut, error)
 	// SEGMENT
 	CreateSegment(ctx context.Context, in *CreateSegmentIn, opts ...grpc.CallOption) (*CreateSegmentOut, error)
 	GetSegmentsByNodeId(ctx context.Context, in *GetSegmentsByNodeIdIn, opts ...grpc.CallOption) (*GetSegmentsByNodeIdOut, error)
@@ -74,6 +115,53 @@ type UziSrvClient interface {
 	GetNodesWithSegmentsByImageId(ctx context.Context, in *GetNodesWithSegmentsByImageIdIn, opts ...grpc.CallOption) (*GetNodesWithSegmentsByImageIdOut, error)
 	DeleteNode(ctx context.Context, in *DeleteNodeIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteSegment(ctx context.Context, in *DeleteSegmentIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// пересчет измерений узлов и сегментов узи по контурам
+	RecalculateMeasurements(ctx context.Context, in *RecalculateMeasurementsIn, opts ...grpc.CallOption) (*RecalculateMeasurementsOut, error)
+	// исправление разбиения нейросети на узлы: сегменты переносятся между узлами
+	MergeNodes(ctx context.Context, in *MergeNodesIn, opts ...grpc.CallOption) (*MergeNodesOut, error)
+	SplitNode(ctx context.Context, in *SplitNodeIn, opts ...grpc.CallOption) (*SplitNodeOut, error)
+	// PROPAGATION
+	// предложение контуров узла на соседних кадрах, черновики подтверждаются врачом
+	ProposeSegments(ctx context.Context, in *ProposeSegmentsIn, opts ...grpc.CallOption) (*ProposeSegmentsOut, error)
+	GetSegmentDraftsByNodeId(ctx context.Context, in *GetSegmentDraftsByNodeIdIn, opts ...grpc.CallOption) (*GetSegmentDraftsByNodeIdOut, error)
+	AcceptSegmentDraft(ctx context.Context, in *AcceptSegmentDraftIn, opts ...grpc.CallOption) (*AcceptSegmentDraftOut, error)
+	DiscardSegmentDraft(ctx context.Context, in *DiscardSegmentDraftIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TIRADS
+	SetNodeDescriptors(ctx context.Context, in *SetNodeDescriptorsIn, opts ...grpc.CallOption) (*SetNodeDescriptorsOut, error)
+	GetNodeTirads(ctx context.Context, in *GetNodeTiradsIn, opts ...grpc.CallOption) (*GetNodeTiradsOut, error)
+	// LINEAGE
+	// отслеживание одного и того же узла между узи пациента
+	LinkNodes(ctx context.Context, in *LinkNodesIn, opts ...grpc.CallOption) (*LinkNodesOut, error)
+	UnlinkNode(ctx context.Context, in *UnlinkNodeIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SuggestNodeLinks(ctx context.Context, in *SuggestNodeLinksIn, opts ...grpc.CallOption) (*SuggestNodeLinksOut, error)
+	GetGrowthReport(ctx context.Context, in *GetGrowthReportIn, opts ...grpc.CallOption) (*GetGrowthReportOut, error)
+	// REPORT
+	GenerateReport(ctx context.Context, in *GenerateReportIn, opts ...grpc.CallOption) (*GenerateReportOut, error)
+	GetReports(ctx context.Context, in *GetReportsIn, opts ...grpc.CallOption) (*GetReportsOut, error)
+	GetReport(ctx context.Context, in *GetReportIn, opts ...grpc.CallOption) (*GetReportOut, error)
+	// DATASET
+	// выгрузка провалидированной разметки для обучения моделей
+	ExportDataset(ctx context.Context, in *ExportDatasetIn, opts ...grpc.CallOption) (*ExportDatasetOut, error)
+	// загрузка внешней разметки COCO/CVAT в ручные узлы
+	ImportAnnotations(ctx context.Context, in *ImportAnnotationsIn, opts ...grpc.CallOption) (*ImportAnnotationsOut, error)
+	// HISTORY
+	// версии узлов и сегментов от новых к старым
+	GetNodeHistory(ctx context.Context, in *GetNodeHistoryIn, opts ...grpc.CallOption) (*GetNodeHistoryOut, error)
+	GetSegmentHistory(ctx context.Context, in *GetSegmentHistoryIn, opts ...grpc.CallOption) (*GetSegmentHistoryOut, error)
+	// возврат к состоянию после указанной версии, удаленный объект создается заново
+	RestoreNode(ctx context.Context, in *RestoreNodeIn, opts ...grpc.CallOption) (*RestoreNodeOut, error)
+	RestoreSegment(ctx context.Context, in *RestoreSegmentIn, opts ...grpc.CallOption) (*RestoreSegmentOut, error)
+	// RETENTION
+	// возврат мягко удаленного узи в течение окна восстановления
+	RestoreUzi(ctx context.Context, in *RestoreUziIn, opts ...grpc.CallOption) (*RestoreUziOut, error)
+	// сверка бакета с таблицами uzi и image
+	SweepStorageOrphans(ctx context.Context, in *SweepStorageOrphansIn, opts ...grpc.CallOption) (*SweepStorageOrphansOut, error)
+	// INTEGRITY
+	// пересчет sha256 исходных файлов узи в S3 и сверка с сохраненными
+	VerifyUziIntegrity(ctx context.Context, in *VerifyUziIntegrityIn, opts ...grpc.CallOption) (*VerifyUziIntegrityOut, error)
+	// ANALYTICS
+	// точность нейросети относительно валидации и согласие врачей по узи из выборки
+	GetUziAnalytics(ctx context.Context, in *GetUziAnalyticsIn, opts ...grpc.CallOption) (*GetUziAnalyticsOut, error)
 }
 
 type uziSrvClient struct {
@@ -104,6 +192,36 @@ func (c *uziSrvClient) GetDeviceList(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *uziSrvClient) GetDeviceById(ctx context.Context, in *GetDeviceByIdIn, opts ...grpc.CallOption) (*GetDeviceByIdOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeviceByIdOut)
+	err := c.cc.Invoke(ctx, UziSrv_GetDeviceById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) UpdateDevice(ctx context.Context, in *UpdateDeviceIn, opts ...grpc.CallOption) (*UpdateDeviceOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDeviceOut)
+	err := c.cc.Invoke(ctx, UziSrv_UpdateDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) DeleteDevice(ctx context.Context, in *DeleteDeviceIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UziSrv_DeleteDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *uziSrvClient) CreateUzi(ctx context.Context, in *CreateUziIn, opts ...grpc.CallOption) (*CreateUziOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateUziOut)
@@ -144,6 +262,16 @@ func (c *uziSrvClient) GetUzisByAuthor(ctx context.Context, in *GetUzisByAuthorI
 	return out, nil
 }
 
+func (c *uziSrvClient) SearchUzis(ctx context.Context, in *SearchUzisIn, opts ...grpc.CallOption) (*SearchUzisOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUzisOut)
+	err := c.cc.Invoke(ctx, UziSrv_SearchUzis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *uziSrvClient) GetEchographicByUziId(ctx context.Context, in *GetEchographicByUziIdIn, opts ...grpc.CallOption) (*GetEchographicByUziIdOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEchographicByUziIdOut)
@@ -214,6 +342,36 @@ func (c *uziSrvClient) UpdateNode(ctx context.Context, in *UpdateNodeIn, opts ..
 	return out, nil
 }
 
+func (c *uziSrvClient) ReviewNodes(ctx context.Context, in *ReviewNodesIn, opts ...grpc.CallOption) (*ReviewNodesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewNodesOut)
+	err := c.cc.Invoke(ctx, UziSrv_ReviewNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) CheckUzi(ctx context.Context, in *CheckUziIn, opts ...grpc.CallOption) (*CheckUziOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckUziOut)
+	err := c.cc.Invoke(ctx, UziSrv_CheckUzi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) AcceptAiNodes(ctx context.Context, in *AcceptAiNodesIn, opts ...grpc.CallOption) (*AcceptAiNodesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptAiNodesOut)
+	err := c.cc.Invoke(ctx, UziSrv_AcceptAiNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *uziSrvClient) CreateSegment(ctx context.Context, in *CreateSegmentIn, opts ...grpc.CallOption) (*CreateSegmentOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSegmentOut)
@@ -284,6 +442,266 @@ func (c *uziSrvClient) DeleteSegment(ctx context.Context, in *DeleteSegmentIn, o
 	return out, nil
 }
 
+func (c *uziSrvClient) RecalculateMeasurements(ctx context.Context, in *RecalculateMeasurementsIn, opts ...grpc.CallOption) (*RecalculateMeasurementsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecalculateMeasurementsOut)
+	err := c.cc.Invoke(ctx, UziSrv_RecalculateMeasurements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) MergeNodes(ctx context.Context, in *MergeNodesIn, opts ...grpc.CallOption) (*MergeNodesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeNodesOut)
+	err := c.cc.Invoke(ctx, UziSrv_MergeNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) SplitNode(ctx context.Context, in *SplitNodeIn, opts ...grpc.CallOption) (*SplitNodeOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SplitNodeOut)
+	err := c.cc.Invoke(ctx, UziSrv_SplitNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) ProposeSegments(ctx context.Context, in *ProposeSegmentsIn, opts ...grpc.CallOption) (*ProposeSegmentsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposeSegmentsOut)
+	err := c.cc.Invoke(ctx, UziSrv_ProposeSegments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) GetSegmentDraftsByNodeId(ctx context.Context, in *GetSegmentDraftsByNodeIdIn, opts ...grpc.CallOption) (*GetSegmentDraftsByNodeIdOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSegmentDraftsByNodeIdOut)
+	err := c.cc.Invoke(ctx, UziSrv_GetSegmentDraftsByNodeId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) AcceptSegmentDraft(ctx context.Context, in *AcceptSegmentDraftIn, opts ...grpc.CallOption) (*AcceptSegmentDraftOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptSegmentDraftOut)
+	err := c.cc.Invoke(ctx, UziSrv_AcceptSegmentDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) DiscardSegmentDraft(ctx context.Context, in *DiscardSegmentDraftIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UziSrv_DiscardSegmentDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) SetNodeDescriptors(ctx context.Context, in *SetNodeDescriptorsIn, opts ...grpc.CallOption) (*SetNodeDescriptorsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNodeDescriptorsOut)
+	err := c.cc.Invoke(ctx, UziSrv_SetNodeDescriptors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) GetNodeTirads(ctx context.Context, in *GetNodeTiradsIn, opts ...grpc.CallOption) (*GetNodeTiradsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNodeTiradsOut)
+	err := c.cc.Invoke(ctx, UziSrv_GetNodeTirads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) LinkNodes(ctx context.Context, in *LinkNodesIn, opts ...grpc.CallOption) (*LinkNodesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkNodesOut)
+	err := c.cc.Invoke(ctx, UziSrv_LinkNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) UnlinkNode(ctx context.Context, in *UnlinkNodeIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UziSrv_UnlinkNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) SuggestNodeLinks(ctx context.Context, in *SuggestNodeLinksIn, opts ...grpc.CallOption) (*SuggestNodeLinksOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestNodeLinksOut)
+	err := c.cc.Invoke(ctx, UziSrv_SuggestNodeLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) GetGrowthReport(ctx context.Context, in *GetGrowthReportIn, opts ...grpc.CallOption) (*GetGrowthReportOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGrowthReportOut)
+	err := c.cc.Invoke(ctx, UziSrv_GetGrowthReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) GenerateReport(ctx context.Context, in *GenerateReportIn, opts ...grpc.CallOption) (*GenerateReportOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateReportOut)
+	err := c.cc.Invoke(ctx, UziSrv_GenerateReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) GetReports(ctx context.Context, in *GetReportsIn, opts ...grpc.CallOption) (*GetReportsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportsOut)
+	err := c.cc.Invoke(ctx, UziSrv_GetReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) GetReport(ctx context.Context, in *GetReportIn, opts ...grpc.CallOption) (*GetReportOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportOut)
+	err := c.cc.Invoke(ctx, UziSrv_GetReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) ExportDataset(ctx context.Context, in *ExportDatasetIn, opts ...grpc.CallOption) (*ExportDatasetOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportDatasetOut)
+	err := c.cc.Invoke(ctx, UziSrv_ExportDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) ImportAnnotations(ctx context.Context, in *ImportAnnotationsIn, opts ...grpc.CallOption) (*ImportAnnotationsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportAnnotationsOut)
+	err := c.cc.Invoke(ctx, UziSrv_ImportAnnotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) GetNodeHistory(ctx context.Context, in *GetNodeHistoryIn, opts ...grpc.CallOption) (*GetNodeHistoryOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNodeHistoryOut)
+	err := c.cc.Invoke(ctx, UziSrv_GetNodeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) GetSegmentHistory(ctx context.Context, in *GetSegmentHistoryIn, opts ...grpc.CallOption) (*GetSegmentHistoryOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSegmentHistoryOut)
+	err := c.cc.Invoke(ctx, UziSrv_GetSegmentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) RestoreNode(ctx context.Context, in *RestoreNodeIn, opts ...grpc.CallOption) (*RestoreNodeOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreNodeOut)
+	err := c.cc.Invoke(ctx, UziSrv_RestoreNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) RestoreSegment(ctx context.Context, in *RestoreSegmentIn, opts ...grpc.CallOption) (*RestoreSegmentOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreSegmentOut)
+	err := c.cc.Invoke(ctx, UziSrv_RestoreSegment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) RestoreUzi(ctx context.Context, in *RestoreUziIn, opts ...grpc.CallOption) (*RestoreUziOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreUziOut)
+	err := c.cc.Invoke(ctx, UziSrv_RestoreUzi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) SweepStorageOrphans(ctx context.Context, in *SweepStorageOrphansIn, opts ...grpc.CallOption) (*SweepStorageOrphansOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SweepStorageOrphansOut)
+	err := c.cc.Invoke(ctx, UziSrv_SweepStorageOrphans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) VerifyUziIntegrity(ctx context.Context, in *VerifyUziIntegrityIn, opts ...grpc.CallOption) (*VerifyUziIntegrityOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyUziIntegrityOut)
+	err := c.cc.Invoke(ctx, UziSrv_VerifyUziIntegrity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uziSrvClient) GetUziAnalytics(ctx context.Context, in *GetUziAnalyticsIn, opts ...grpc.CallOption) (*GetUziAnalyticsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUziAnalyticsOut)
+	err := c.cc.Invoke(ctx, UziSrv_GetUziAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UziSrvServer is the server API for UziSrv service.
 // All implementations must embed UnimplementedUziSrvServer
 // for forward compatibility.
@@ -291,11 +709,15 @@ type UziSrvServer interface {
 	// DEVICE
 	CreateDevice(context.Context, *CreateDeviceIn) (*CreateDeviceOut, error)
 	GetDeviceList(context.Context, *emptypb.Empty) (*GetDeviceListOut, error)
+	GetDeviceById(context.Context, *GetDeviceByIdIn) (*GetDeviceByIdOut, error)
+	UpdateDevice(context.Context, *UpdateDeviceIn) (*UpdateDeviceOut, error)
+	DeleteDevice(context.Context, *DeleteDeviceIn) (*emptypb.Empty, error)
 	// UZI
 	CreateUzi(context.Context, *CreateUziIn) (*CreateUziOut, error)
 	GetUziById(context.Context, *GetUziByIdIn) (*GetUziByIdOut, error)
 	GetUzisByExternalId(context.Context, *GetUzisByExternalIdIn) (*GetUzisByExternalIdOut, error)
 	GetUzisByAuthor(context.Context, *GetUzisByAuthorIn) (*GetUzisByAuthorOut, error)
+	SearchUzis(context.Context, *SearchUzisIn) (*SearchUzisOut, error)
 	GetEchographicByUziId(context.Context, *GetEchographicByUziIdIn) (*GetEchographicByUziIdOut, error)
 	UpdateUzi(context.Context, *UpdateUziIn) (*UpdateUziOut, error)
 	UpdateEchographic(context.Context, *UpdateEchographicIn) (*UpdateEchographicOut, error)
@@ -305,6 +727,10 @@ type UziSrvServer interface {
 	// NODE
 	GetNodesByUziId(context.Context, *GetNodesByUziIdIn) (*GetNodesByUziIdOut, error)
 	UpdateNode(context.Context, *UpdateNodeIn) (*UpdateNodeOut, error)
+	// пакетная валидация узлов нейросети, каждый пакет выполняется в одной транзакции
+	ReviewNodes(context.Context, *ReviewNodesIn) (*ReviewNodesOut, error)
+	CheckUzi(context.Context, *CheckUziIn) (*CheckUziOut, error)
+	AcceptAiNodes(context.Context, *AcceptAiNodesIn) (*AcceptAiNodesOut, error)
 	// SEGMENT
 	CreateSegment(context.Context, *CreateSegmentIn) (*CreateSegmentOut, error)
 	GetSegmentsByNodeId(context.Context, *GetSegmentsByNodeIdIn) (*GetSegmentsByNodeIdOut, error)
@@ -315,6 +741,53 @@ type UziSrvServer interface {
 	GetNodesWithSegmentsByImageId(context.Context, *GetNodesWithSegmentsByImageIdIn) (*GetNodesWithSegmentsByImageIdOut, error)
 	DeleteNode(context.Context, *DeleteNodeIn) (*emptypb.Empty, error)
 	DeleteSegment(context.Context, *DeleteSegmentIn) (*emptypb.Empty, error)
+	// пересчет измерений узлов и сегментов узи по контурам
+	RecalculateMeasurements(context.Context, *RecalculateMeasurementsIn) (*RecalculateMeasurementsOut, error)
+	// исправление разбиения нейросети на узлы: сегменты переносятся между узлами
+	MergeNodes(context.Context, *MergeNodesIn) (*MergeNodesOut, error)
+	SplitNode(context.Context, *SplitNodeIn) (*SplitNodeOut, error)
+	// PROPAGATION
+	// предложение контуров узла на соседних кадрах, черновики подтверждаются врачом
+	ProposeSegments(context.Context, *ProposeSegmentsIn) (*ProposeSegmentsOut, error)
+	GetSegmentDraftsByNodeId(context.Context, *GetSegmentDraftsByNodeIdIn) (*GetSegmentDraftsByNodeIdOut, error)
+	AcceptSegmentDraft(context.Context, *AcceptSegmentDraftIn) (*AcceptSegmentDraftOut, error)
+	DiscardSegmentDraft(context.Context, *DiscardSegmentDraftIn) (*emptypb.Empty, error)
+	// TIRADS
+	SetNodeDescriptors(context.Context, *SetNodeDescriptorsIn) (*SetNodeDescriptorsOut, error)
+	GetNodeTirads(context.Context, *GetNodeTiradsIn) (*GetNodeTiradsOut, error)
+	// LINEAGE
+	// отслеживание одного и того же узла между узи пациента
+	LinkNodes(context.Context, *LinkNodesIn) (*LinkNodesOut, error)
+	UnlinkNode(context.Context, *UnlinkNodeIn) (*emptypb.Empty, error)
+	SuggestNodeLinks(context.Context, *SuggestNodeLinksIn) (*SuggestNodeLinksOut, error)
+	GetGrowthReport(context.Context, *GetGrowthReportIn) (*GetGrowthReportOut, error)
+	// REPORT
+	GenerateReport(context.Context, *GenerateReportIn) (*GenerateReportOut, error)
+	GetReports(context.Context, *GetReportsIn) (*GetReportsOut, error)
+	GetReport(context.Context, *GetReportIn) (*GetReportOut, error)
+	// DATASET
+	// выгрузка провалидированной разметки для обучения моделей
+	ExportDataset(context.Context, *ExportDatasetIn) (*ExportDatasetOut, error)
+	// загрузка внешней разметки COCO/CVAT в ручные узлы
+	ImportAnnotations(context.Context, *ImportAnnotationsIn) (*ImportAnnotationsOut, error)
+	// HISTORY
+	// версии узлов и сегментов от новых к старым
+	GetNodeHistory(context.Context, *GetNodeHistoryIn) (*GetNodeHistoryOut, error)
+	GetSegmentHistory(context.Context, *GetSegmentHistoryIn) (*GetSegmentHistoryOut, error)
+	// возврат к состоянию после указанной версии, удаленный объект создается заново
+	RestoreNode(context.Context, *RestoreNodeIn) (*RestoreNodeOut, error)
+	RestoreSegment(context.Context, *RestoreSegmentIn) (*RestoreSegmentOut, error)
+	// RETENTION
+	// возврат мягко удаленного узи в течение окна восстановления
+	RestoreUzi(context.Context, *RestoreUziIn) (*RestoreUziOut, error)
+	// сверка бакета с таблицами uzi и image
+	SweepStorageOrphans(context.Context, *SweepStorageOrphansIn) (*SweepStorageOrphansOut, error)
+	// INTEGRITY
+	// пересчет sha256 исходных файлов узи в S3 и сверка с сохраненными
+	VerifyUziIntegrity(context.Context, *VerifyUziIntegrityIn) (*VerifyUziIntegrityOut, error)
+	// ANALYTICS
+	// точность нейросети относительно валидации и согласие врачей по узи из выборки
+	GetUziAnalytics(context.Context, *GetUziAnalyticsIn) (*GetUziAnalyticsOut, error)
 	mustEmbedUnimplementedUziSrvServer()
 }
 
@@ -331,6 +804,15 @@ func (UnimplementedUziSrvServer) CreateDevice(context.Context, *CreateDeviceIn)
 func (UnimplementedUziSrvServer) GetDeviceList(context.Context, *emptypb.Empty) (*GetDeviceListOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceList not implemented")
 }
+func (UnimplementedUziSrvServer) GetDeviceById(context.Context, *GetDeviceByIdIn) (*GetDeviceByIdOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceById not implemented")
+}
+func (UnimplementedUziSrvServer) UpdateDevice(context.Context, *UpdateDeviceIn) (*UpdateDeviceOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDevice not implemented")
+}
+func (UnimplementedUziSrvServer) DeleteDevice(context.Context, *DeleteDeviceIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDevice not implemented")
+}
 func (UnimplementedUziSrvServer) CreateUzi(context.Context, *CreateUziIn) (*CreateUziOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUzi not implemented")
 }
@@ -343,6 +825,9 @@ func (UnimplementedUziSrvServer) GetUzisByExternalId(context.Context, *GetUzisBy
 func (UnimplementedUziSrvServer) GetUzisByAuthor(context.Context, *GetUzisByAuthorIn) (*GetUzisByAuthorOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUzisByAuthor not implemented")
 }
+func (UnimplementedUziSrvServer) SearchUzis(context.Context, *SearchUzisIn) (*SearchUzisOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUzis not implemented")
+}
 func (UnimplementedUziSrvServer) GetEchographicByUziId(context.Context, *GetEchographicByUziIdIn) (*GetEchographicByUziIdOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEchographicByUziId not implemented")
 }
@@ -364,6 +849,15 @@ func (UnimplementedUziSrvServer) GetNodesByUziId(context.Context, *GetNodesByUzi
 func (UnimplementedUziSrvServer) UpdateNode(context.Context, *UpdateNodeIn) (*UpdateNodeOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateNode not implemented")
 }
+func (UnimplementedUziSrvServer) ReviewNodes(context.Context, *ReviewNodesIn) (*ReviewNodesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewNodes not implemented")
+}
+func (UnimplementedUziSrvServer) CheckUzi(context.Context, *CheckUziIn) (*CheckUziOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUzi not implemented")
+}
+func (UnimplementedUziSrvServer) AcceptAiNodes(context.Context, *AcceptAiNodesIn) (*AcceptAiNodesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAiNodes not implemented")
+}
 func (UnimplementedUziSrvServer) CreateSegment(context.Context, *CreateSegmentIn) (*CreateSegmentOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
 }
@@ -376,392 +870,1064 @@ func (UnimplementedUziSrvServer) UpdateSegment(context.Context, *UpdateSegmentIn
 func (UnimplementedUziSrvServer) CreateNodeWithSegments(context.Context, *CreateNodeWithSegmentsIn) (*CreateNodeWithSegmentsOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNodeWithSegments not implemented")
 }
-func (UnimplementedUziSrvServer) GetNodesWithSegmentsByImageId(context.Context, *GetNodesWithSegmentsByImageIdIn) (*GetNodesWithSegmentsByImageIdOut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNodesWithSegmentsByImageId not implemented")
+func (UnimplementedUziSrvServer) GetNodesWithSegmentsByImageId(context.Context, *GetNodesWithSegmentsByImageIdIn) (*GetNodesWithSegmentsByImageIdOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodesWithSegmentsByImageId not implemented")
+}
+func (UnimplementedUziSrvServer) DeleteNode(context.Context, *DeleteNodeIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNode not implemented")
+}
+func (UnimplementedUziSrvServer) DeleteSegment(context.Context, *DeleteSegmentIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSegment not implemented")
+}
+func (UnimplementedUziSrvServer) RecalculateMeasurements(context.Context, *RecalculateMeasurementsIn) (*RecalculateMeasurementsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecalculateMeasurements not implemented")
+}
+func (UnimplementedUziSrvServer) MergeNodes(context.Context, *MergeNodesIn) (*MergeNodesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeNodes not implemented")
+}
+func (UnimplementedUziSrvServer) SplitNode(context.Context, *SplitNodeIn) (*SplitNodeOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitNode not implemented")
+}
+func (UnimplementedUziSrvServer) ProposeSegments(context.Context, *ProposeSegmentsIn) (*ProposeSegmentsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeSegments not implemented")
+}
+func (UnimplementedUziSrvServer) GetSegmentDraftsByNodeId(context.Context, *GetSegmentDraftsByNodeIdIn) (*GetSegmentDraftsByNodeIdOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentDraftsByNodeId not implemented")
+}
+func (UnimplementedUziSrvServer) AcceptSegmentDraft(context.Context, *AcceptSegmentDraftIn) (*AcceptSegmentDraftOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSegmentDraft not implemented")
+}
+func (UnimplementedUziSrvServer) DiscardSegmentDraft(context.Context, *DiscardSegmentDraftIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardSegmentDraft not implemented")
+}
+func (UnimplementedUziSrvServer) SetNodeDescriptors(context.Context, *SetNodeDescriptorsIn) (*SetNodeDescriptorsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNodeDescriptors not implemented")
+}
+func (UnimplementedUziSrvServer) GetNodeTirads(context.Context, *GetNodeTiradsIn) (*GetNodeTiradsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeTirads not implemented")
+}
+func (UnimplementedUziSrvServer) LinkNodes(context.Context, *LinkNodesIn) (*LinkNodesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkNodes not implemented")
+}
+func (UnimplementedUziSrvServer) UnlinkNode(context.Context, *UnlinkNodeIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkNode not implemented")
+}
+func (UnimplementedUziSrvServer) SuggestNodeLinks(context.Context, *SuggestNodeLinksIn) (*SuggestNodeLinksOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestNodeLinks not implemented")
+}
+func (UnimplementedUziSrvServer) GetGrowthReport(context.Context, *GetGrowthReportIn) (*GetGrowthReportOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGrowthReport not implemented")
+}
+func (UnimplementedUziSrvServer) GenerateReport(context.Context, *GenerateReportIn) (*GenerateReportOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateReport not implemented")
+}
+func (UnimplementedUziSrvServer) GetReports(context.Context, *GetReportsIn) (*GetReportsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReports not implemented")
+}
+func (UnimplementedUziSrvServer) GetReport(context.Context, *GetReportIn) (*GetReportOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedUziSrvServer) ExportDataset(context.Context, *ExportDatasetIn) (*ExportDatasetOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDataset not implemented")
+}
+func (UnimplementedUziSrvServer) ImportAnnotations(context.Context, *ImportAnnotationsIn) (*ImportAnnotationsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAnnotations not implemented")
+}
+func (UnimplementedUziSrvServer) GetNodeHistory(context.Context, *GetNodeHistoryIn) (*GetNodeHistoryOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeHistory not implemented")
+}
+func (UnimplementedUziSrvServer) GetSegmentHistory(context.Context, *GetSegmentHistoryIn) (*GetSegmentHistoryOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentHistory not implemented")
+}
+func (UnimplementedUziSrvServer) RestoreNode(context.Context, *RestoreNodeIn) (*RestoreNodeOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNode not implemented")
+}
+func (UnimplementedUziSrvServer) RestoreSegment(context.Context, *RestoreSegmentIn) (*RestoreSegmentOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSegment not implemented")
+}
+func (UnimplementedUziSrvServer) RestoreUzi(context.Context, *RestoreUziIn) (*RestoreUziOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUzi not implemented")
+}
+func (UnimplementedUziSrvServer) SweepStorageOrphans(context.Context, *SweepStorageOrphansIn) (*SweepStorageOrphansOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepStorageOrphans not implemented")
+}
+func (UnimplementedUziSrvServer) VerifyUziIntegrity(context.Context, *VerifyUziIntegrityIn) (*VerifyUziIntegrityOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyUziIntegrity not implemented")
+}
+func (UnimplementedUziSrvServer) GetUziAnalytics(context.Context, *GetUziAnalyticsIn) (*GetUziAnalyticsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUziAnalytics not implemented")
+}
+func (UnimplementedUziSrvServer) mustEmbedUnimplementedUziSrvServer() {}
+func (UnimplementedUziSrvServer) testEmbeddedByValue()                {}
+
+// UnsafeUziSrvServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UziSrvServer will
+// result in compilation errors.
+type UnsafeUziSrvServer interface {
+	mustEmbedUnimplementedUziSrvServer()
+}
+
+func RegisterUziSrvServer(s grpc.ServiceRegistrar, srv UziSrvServer) {
+	// If the following call pancis, it indicates UnimplementedUziSrvServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UziSrv_ServiceDesc, srv)
+}
+
+func _UziSrv_CreateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).CreateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_CreateDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).CreateDevice(ctx, req.(*CreateDeviceIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetDeviceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetDeviceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetDeviceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetDeviceList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetDeviceById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceByIdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetDeviceById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetDeviceById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetDeviceById(ctx, req.(*GetDeviceByIdIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_UpdateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).UpdateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_UpdateDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).UpdateDevice(ctx, req.(*UpdateDeviceIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).DeleteDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_DeleteDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).DeleteDevice(ctx, req.(*DeleteDeviceIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_CreateUzi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUziIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).CreateUzi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_CreateUzi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).CreateUzi(ctx, req.(*CreateUziIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetUziById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUziByIdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetUziById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetUziById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetUziById(ctx, req.(*GetUziByIdIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetUzisByExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUzisByExternalIdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetUzisByExternalId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetUzisByExternalId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetUzisByExternalId(ctx, req.(*GetUzisByExternalIdIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetUzisByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUzisByAuthorIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetUzisByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetUzisByAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetUzisByAuthor(ctx, req.(*GetUzisByAuthorIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_SearchUzis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUzisIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).SearchUzis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_SearchUzis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).SearchUzis(ctx, req.(*SearchUzisIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetEchographicByUziId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEchographicByUziIdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetEchographicByUziId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetEchographicByUziId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetEchographicByUziId(ctx, req.(*GetEchographicByUziIdIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_UpdateUzi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUziIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).UpdateUzi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_UpdateUzi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).UpdateUzi(ctx, req.(*UpdateUziIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_UpdateEchographic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEchographicIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).UpdateEchographic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_UpdateEchographic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).UpdateEchographic(ctx, req.(*UpdateEchographicIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_DeleteUzi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUziIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).DeleteUzi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_DeleteUzi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).DeleteUzi(ctx, req.(*DeleteUziIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetImagesByUziId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImagesByUziIdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetImagesByUziId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetImagesByUziId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetImagesByUziId(ctx, req.(*GetImagesByUziIdIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetNodesByUziId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodesByUziIdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetNodesByUziId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetNodesByUziId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetNodesByUziId(ctx, req.(*GetNodesByUziIdIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_UpdateNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).UpdateNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_UpdateNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).UpdateNode(ctx, req.(*UpdateNodeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_ReviewNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewNodesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).ReviewNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_ReviewNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).ReviewNodes(ctx, req.(*ReviewNodesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_CheckUzi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUziIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).CheckUzi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_CheckUzi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).CheckUzi(ctx, req.(*CheckUziIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_AcceptAiNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptAiNodesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).AcceptAiNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_AcceptAiNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).AcceptAiNodes(ctx, req.(*AcceptAiNodesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSegmentIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).CreateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_CreateSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).CreateSegment(ctx, req.(*CreateSegmentIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetSegmentsByNodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentsByNodeIdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetSegmentsByNodeId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetSegmentsByNodeId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetSegmentsByNodeId(ctx, req.(*GetSegmentsByNodeIdIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_UpdateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSegmentIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).UpdateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_UpdateSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).UpdateSegment(ctx, req.(*UpdateSegmentIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_CreateNodeWithSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNodeWithSegmentsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).CreateNodeWithSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_CreateNodeWithSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).CreateNodeWithSegments(ctx, req.(*CreateNodeWithSegmentsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_GetNodesWithSegmentsByImageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodesWithSegmentsByImageIdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetNodesWithSegmentsByImageId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetNodesWithSegmentsByImageId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetNodesWithSegmentsByImageId(ctx, req.(*GetNodesWithSegmentsByImageIdIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_DeleteNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNodeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).DeleteNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_DeleteNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).DeleteNode(ctx, req.(*DeleteNodeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_DeleteSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSegmentIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).DeleteSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_DeleteSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).DeleteSegment(ctx, req.(*DeleteSegmentIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_RecalculateMeasurements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalculateMeasurementsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).RecalculateMeasurements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_RecalculateMeasurements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).RecalculateMeasurements(ctx, req.(*RecalculateMeasurementsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UziSrv_MergeNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeNodesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).MergeNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_MergeNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).MergeNodes(ctx, req.(*MergeNodesIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUziSrvServer) DeleteNode(context.Context, *DeleteNodeIn) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteNode not implemented")
+
+func _UziSrv_SplitNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitNodeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).SplitNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_SplitNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).SplitNode(ctx, req.(*SplitNodeIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUziSrvServer) DeleteSegment(context.Context, *DeleteSegmentIn) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSegment not implemented")
+
+func _UziSrv_ProposeSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeSegmentsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).ProposeSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_ProposeSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).ProposeSegments(ctx, req.(*ProposeSegmentsIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUziSrvServer) mustEmbedUnimplementedUziSrvServer() {}
-func (UnimplementedUziSrvServer) testEmbeddedByValue()                {}
 
-// UnsafeUziSrvServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UziSrvServer will
-// result in compilation errors.
-type UnsafeUziSrvServer interface {
-	mustEmbedUnimplementedUziSrvServer()
+func _UziSrv_GetSegmentDraftsByNodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentDraftsByNodeIdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UziSrvServer).GetSegmentDraftsByNodeId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_GetSegmentDraftsByNodeId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).GetSegmentDraftsByNodeId(ctx, req.(*GetSegmentDraftsByNodeIdIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUziSrvServer(s grpc.ServiceRegistrar, srv UziSrvServer) {
-	// If the following call pancis, it indicates UnimplementedUziSrvServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _UziSrv_AcceptSegmentDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSegmentDraftIn)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&UziSrv_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(UziSrvServer).AcceptSegmentDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UziSrv_AcceptSegmentDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UziSrvServer).AcceptSegmentDraft(ctx, req.(*AcceptSegmentDraftIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_CreateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDeviceIn)
+func _UziSrv_DiscardSegmentDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardSegmentDraftIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).CreateDevice(ctx, in)
+		return srv.(UziSrvServer).DiscardSegmentDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_CreateDevice_FullMethodName,
+		FullMethod: UziSrv_DiscardSegmentDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).CreateDevice(ctx, req.(*CreateDeviceIn))
+		return srv.(UziSrvServer).DiscardSegmentDraft(ctx, req.(*DiscardSegmentDraftIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_GetDeviceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _UziSrv_SetNodeDescriptors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeDescriptorsIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).GetDeviceList(ctx, in)
+		return srv.(UziSrvServer).SetNodeDescriptors(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_GetDeviceList_FullMethodName,
+		FullMethod: UziSrv_SetNodeDescriptors_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).GetDeviceList(ctx, req.(*emptypb.Empty))
+		return srv.(UziSrvServer).SetNodeDescriptors(ctx, req.(*SetNodeDescriptorsIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_CreateUzi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUziIn)
+func _UziSrv_GetNodeTirads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeTiradsIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).CreateUzi(ctx, in)
+		return srv.(UziSrvServer).GetNodeTirads(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_CreateUzi_FullMethodName,
+		FullMethod: UziSrv_GetNodeTirads_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).CreateUzi(ctx, req.(*CreateUziIn))
+		return srv.(UziSrvServer).GetNodeTirads(ctx, req.(*GetNodeTiradsIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_GetUziById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUziByIdIn)
+func _UziSrv_LinkNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkNodesIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).GetUziById(ctx, in)
+		return srv.(UziSrvServer).LinkNodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_GetUziById_FullMethodName,
+		FullMethod: UziSrv_LinkNodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).GetUziById(ctx, req.(*GetUziByIdIn))
+		return srv.(UziSrvServer).LinkNodes(ctx, req.(*LinkNodesIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_GetUzisByExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUzisByExternalIdIn)
+func _UziSrv_UnlinkNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkNodeIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).GetUzisByExternalId(ctx, in)
+		return srv.(UziSrvServer).UnlinkNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_GetUzisByExternalId_FullMethodName,
+		FullMethod: UziSrv_UnlinkNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).GetUzisByExternalId(ctx, req.(*GetUzisByExternalIdIn))
+		return srv.(UziSrvServer).UnlinkNode(ctx, req.(*UnlinkNodeIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_GetUzisByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUzisByAuthorIn)
+func _UziSrv_SuggestNodeLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestNodeLinksIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).GetUzisByAuthor(ctx, in)
+		return srv.(UziSrvServer).SuggestNodeLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_GetUzisByAuthor_FullMethodName,
+		FullMethod: UziSrv_SuggestNodeLinks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).GetUzisByAuthor(ctx, req.(*GetUzisByAuthorIn))
+		return srv.(UziSrvServer).SuggestNodeLinks(ctx, req.(*SuggestNodeLinksIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_GetEchographicByUziId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEchographicByUziIdIn)
+func _UziSrv_GetGrowthReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGrowthReportIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).GetEchographicByUziId(ctx, in)
+		return srv.(UziSrvServer).GetGrowthReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_GetEchographicByUziId_FullMethodName,
+		FullMethod: UziSrv_GetGrowthReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).GetEchographicByUziId(ctx, req.(*GetEchographicByUziIdIn))
+		return srv.(UziSrvServer).GetGrowthReport(ctx, req.(*GetGrowthReportIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_UpdateUzi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUziIn)
+func _UziSrv_GenerateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReportIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).UpdateUzi(ctx, in)
+		return srv.(UziSrvServer).GenerateReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_UpdateUzi_FullMethodName,
+		FullMethod: UziSrv_GenerateReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).UpdateUzi(ctx, req.(*UpdateUziIn))
+		return srv.(UziSrvServer).GenerateReport(ctx, req.(*GenerateReportIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_UpdateEchographic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEchographicIn)
+func _UziSrv_GetReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportsIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).UpdateEchographic(ctx, in)
+		return srv.(UziSrvServer).GetReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_UpdateEchographic_FullMethodName,
+		FullMethod: UziSrv_GetReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).UpdateEchographic(ctx, req.(*UpdateEchographicIn))
+		return srv.(UziSrvServer).GetReports(ctx, req.(*GetReportsIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_DeleteUzi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUziIn)
+func _UziSrv_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).DeleteUzi(ctx, in)
+		return srv.(UziSrvServer).GetReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_DeleteUzi_FullMethodName,
+		FullMethod: UziSrv_GetReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).DeleteUzi(ctx, req.(*DeleteUziIn))
+		return srv.(UziSrvServer).GetReport(ctx, req.(*GetReportIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_GetImagesByUziId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetImagesByUziIdIn)
+func _UziSrv_ExportDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDatasetIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).GetImagesByUziId(ctx, in)
+		return srv.(UziSrvServer).ExportDataset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_GetImagesByUziId_FullMethodName,
+		FullMethod: UziSrv_ExportDataset_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).GetImagesByUziId(ctx, req.(*GetImagesByUziIdIn))
+		return srv.(UziSrvServer).ExportDataset(ctx, req.(*ExportDatasetIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_GetNodesByUziId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNodesByUziIdIn)
+func _UziSrv_ImportAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAnnotationsIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).GetNodesByUziId(ctx, in)
+		return srv.(UziSrvServer).ImportAnnotations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_GetNodesByUziId_FullMethodName,
+		FullMethod: UziSrv_ImportAnnotations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).GetNodesByUziId(ctx, req.(*GetNodesByUziIdIn))
+		return srv.(UziSrvServer).ImportAnnotations(ctx, req.(*ImportAnnotationsIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_UpdateNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateNodeIn)
+func _UziSrv_GetNodeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeHistoryIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).UpdateNode(ctx, in)
+		return srv.(UziSrvServer).GetNodeHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_UpdateNode_FullMethodName,
+		FullMethod: UziSrv_GetNodeHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).UpdateNode(ctx, req.(*UpdateNodeIn))
+		return srv.(UziSrvServer).GetNodeHistory(ctx, req.(*GetNodeHistoryIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSegmentIn)
+func _UziSrv_GetSegmentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentHistoryIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).CreateSegment(ctx, in)
+		return srv.(UziSrvServer).GetSegmentHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_CreateSegment_FullMethodName,
+		FullMethod: UziSrv_GetSegmentHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).CreateSegment(ctx, req.(*CreateSegmentIn))
+		return srv.(UziSrvServer).GetSegmentHistory(ctx, req.(*GetSegmentHistoryIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_GetSegmentsByNodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentsByNodeIdIn)
+func _UziSrv_RestoreNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNodeIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).GetSegmentsByNodeId(ctx, in)
+		return srv.(UziSrvServer).RestoreNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_GetSegmentsByNodeId_FullMethodName,
+		FullMethod: UziSrv_RestoreNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).GetSegmentsByNodeId(ctx, req.(*GetSegmentsByNodeIdIn))
+		return srv.(UziSrvServer).RestoreNode(ctx, req.(*RestoreNodeIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_UpdateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSegmentIn)
+func _UziSrv_RestoreSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSegmentIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).UpdateSegment(ctx, in)
+		return srv.(UziSrvServer).RestoreSegment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_UpdateSegment_FullMethodName,
+		FullMethod: UziSrv_RestoreSegment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).UpdateSegment(ctx, req.(*UpdateSegmentIn))
+		return srv.(UziSrvServer).RestoreSegment(ctx, req.(*RestoreSegmentIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_CreateNodeWithSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNodeWithSegmentsIn)
+func _UziSrv_RestoreUzi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUziIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).CreateNodeWithSegments(ctx, in)
+		return srv.(UziSrvServer).RestoreUzi(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_CreateNodeWithSegments_FullMethodName,
+		FullMethod: UziSrv_RestoreUzi_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).CreateNodeWithSegments(ctx, req.(*CreateNodeWithSegmentsIn))
+		return srv.(UziSrvServer).RestoreUzi(ctx, req.(*RestoreUziIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_GetNodesWithSegmentsByImageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNodesWithSegmentsByImageIdIn)
+func _UziSrv_SweepStorageOrphans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepStorageOrphansIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).GetNodesWithSegmentsByImageId(ctx, in)
+		return srv.(UziSrvServer).SweepStorageOrphans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_GetNodesWithSegmentsByImageId_FullMethodName,
+		FullMethod: UziSrv_SweepStorageOrphans_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).GetNodesWithSegmentsByImageId(ctx, req.(*GetNodesWithSegmentsByImageIdIn))
+		return srv.(UziSrvServer).SweepStorageOrphans(ctx, req.(*SweepStorageOrphansIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_DeleteNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNodeIn)
+func _UziSrv_VerifyUziIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUziIntegrityIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).DeleteNode(ctx, in)
+		return srv.(UziSrvServer).VerifyUziIntegrity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_DeleteNode_FullMethodName,
+		FullMethod: UziSrv_VerifyUziIntegrity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).DeleteNode(ctx, req.(*DeleteNodeIn))
+		return srv.(UziSrvServer).VerifyUziIntegrity(ctx, req.(*VerifyUziIntegrityIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UziSrv_DeleteSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSegmentIn)
+func _UziSrv_GetUziAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUziAnalyticsIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UziSrvServer).DeleteSegment(ctx, in)
+		return srv.(UziSrvServer).GetUziAnalytics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UziSrv_DeleteSegment_FullMethodName,
+		FullMethod: UziSrv_GetUziAnalytics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UziSrvServer).DeleteSegment(ctx, req.(*DeleteSegmentIn))
+		return srv.(UziSrvServer).GetUziAnalytics(ctx, req.(*GetUziAnalyticsIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -781,6 +1947,18 @@ var UziSrv_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getDeviceList",
 			Handler:    _UziSrv_GetDeviceList_Handler,
 		},
+		{
+			MethodName: "getDeviceById",
+			Handler:    _UziSrv_GetDeviceById_Handler,
+		},
+		{
+			MethodName: "updateDevice",
+			Handler:    _UziSrv_UpdateDevice_Handler,
+		},
+		{
+			MethodName: "deleteDevice",
+			Handler:    _UziSrv_DeleteDevice_Handler,
+		},
 		{
 			MethodName: "createUzi",
 			Handler:    _UziSrv_CreateUzi_Handler,
@@ -797,6 +1975,10 @@ var UziSrv_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getUzisByAuthor",
 			Handler:    _UziSrv_GetUzisByAuthor_Handler,
 		},
+		{
+			MethodName: "searchUzis",
+			Handler:    _UziSrv_SearchUzis_Handler,
+		},
 		{
 			MethodName: "getEchographicByUziId",
 			Handler:    _UziSrv_GetEchographicByUziId_Handler,
@@ -825,6 +2007,18 @@ var UziSrv_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "updateNode",
 			Handler:    _UziSrv_UpdateNode_Handler,
 		},
+		{
+			MethodName: "reviewNodes",
+			Handler:    _UziSrv_ReviewNodes_Handler,
+		},
+		{
+			MethodName: "checkUzi",
+			Handler:    _UziSrv_CheckUzi_Handler,
+		},
+		{
+			MethodName: "acceptAiNodes",
+			Handler:    _UziSrv_AcceptAiNodes_Handler,
+		},
 		{
 			MethodName: "createSegment",
 			Handler:    _UziSrv_CreateSegment_Handler,
@@ -853,6 +2047,110 @@ var UziSrv_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "deleteSegment",
 			Handler:    _UziSrv_DeleteSegment_Handler,
 		},
+		{
+			MethodName: "recalculateMeasurements",
+			Handler:    _UziSrv_RecalculateMeasurements_Handler,
+		},
+		{
+			MethodName: "mergeNodes",
+			Handler:    _UziSrv_MergeNodes_Handler,
+		},
+		{
+			MethodName: "splitNode",
+			Handler:    _UziSrv_SplitNode_Handler,
+		},
+		{
+			MethodName: "proposeSegments",
+			Handler:    _UziSrv_ProposeSegments_Handler,
+		},
+		{
+			MethodName: "getSegmentDraftsByNodeId",
+			Handler:    _UziSrv_GetSegmentDraftsByNodeId_Handler,
+		},
+		{
+			MethodName: "acceptSegmentDraft",
+			Handler:    _UziSrv_AcceptSegmentDraft_Handler,
+		},
+		{
+			MethodName: "discardSegmentDraft",
+			Handler:    _UziSrv_DiscardSegmentDraft_Handler,
+		},
+		{
+			MethodName: "setNodeDescriptors",
+			Handler:    _UziSrv_SetNodeDescriptors_Handler,
+		},
+		{
+			MethodName: "getNodeTirads",
+			Handler:    _UziSrv_GetNodeTirads_Handler,
+		},
+		{
+			MethodName: "linkNodes",
+			Handler:    _UziSrv_LinkNodes_Handler,
+		},
+		{
+			MethodName: "unlinkNode",
+			Handler:    _UziSrv_UnlinkNode_Handler,
+		},
+		{
+			MethodName: "suggestNodeLinks",
+			Handler:    _UziSrv_SuggestNodeLinks_Handler,
+		},
+		{
+			MethodName: "getGrowthReport",
+			Handler:    _UziSrv_GetGrowthReport_Handler,
+		},
+		{
+			MethodName: "generateReport",
+			Handler:    _UziSrv_GenerateReport_Handler,
+		},
+		{
+			MethodName: "getReports",
+			Handler:    _UziSrv_GetReports_Handler,
+		},
+		{
+			MethodName: "getReport",
+			Handler:    _UziSrv_GetReport_Handler,
+		},
+		{
+			MethodName: "exportDataset",
+			Handler:    _UziSrv_ExportDataset_Handler,
+		},
+		{
+			MethodName: "importAnnotations",
+			Handler:    _UziSrv_ImportAnnotations_Handler,
+		},
+		{
+			MethodName: "getNodeHistory",
+			Handler:    _UziSrv_GetNodeHistory_Handler,
+		},
+		{
+			MethodName: "getSegmentHistory",
+			Handler:    _UziSrv_GetSegmentHistory_Handler,
+		},
+		{
+			MethodName: "restoreNode",
+			Handler:    _UziSrv_RestoreNode_Handler,
+		},
+		{
+			MethodName: "restoreSegment",
+			Handler:    _UziSrv_RestoreSegment_Handler,
+		},
+		{
+			MethodName: "restoreUzi",
+			Handler:    _UziSrv_RestoreUzi_Handler,
+		},
+		{
+			MethodName: "sweepStorageOrphans",
+			Handler:    _UziSrv_SweepStorageOrphans_Handler,
+		},
+		{
+			MethodName: "verifyUziIntegrity",
+			Handler:    _UziSrv_VerifyUziIntegrity_Handler,
+		},
+		{
+			MethodName: "getUziAnalytics",
+			Handler:    _UziSrv_GetUziAnalytics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/grpc/service.proto",
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AnalyticsNode struct {
+	Id         uuid.UUID      `db:"id"`
+	UziID      uuid.UUID      `db:"uzi_id"`
+	Ai         bool           `db:"ai"`
+	Validation sql.NullString `db:"validation"`
+	Tirads23   float64        `db:"tirads_23"`
+	Tirads4    float64        `db:"tirads_4"`
+	Tirads5    float64        `db:"tirads_5"`
+	Author     uuid.UUID      `db:"author"`
+	DeviceID   int            `db:"device_id"`
+	CreateAt   time.Time      `db:"create_at"`
+	Creator    uuid.NullUUID  `db:"creator"`
+}
+
+type NodeContor struct {
+	NodeID  uuid.UUID       `db:"node_id"`
+	ImageID uuid.UUID       `db:"image_id"`
+	Contor  json.RawMessage `db:"contor"`
+}
+
+type AiCorrection struct {
+	Kind  string `db:"kind"`
+	Nodes int    `db:"nodes"`
+}
+
+type BulkReview struct {
+	Validation string `db:"validation"`
+	Nodes      int    `db:"nodes"`
+}
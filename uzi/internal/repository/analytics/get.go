@@ -0,0 +1,119 @@
+package analytics
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/analytics/entity"
+)
+
+// uziFilter выборка не удаленных узи
+func uziFilter(filter domain.AnalyticsFilter) sq.And {
+	where := sq.And{sq.Eq{"uzi.delete_at": nil}}
+	if filter.DeviceID != nil {
+		where = append(where, sq.Eq{"uzi.device_id": *filter.DeviceID})
+	}
+	if filter.Author != nil {
+		where = append(where, sq.Eq{"uzi.author": *filter.Author})
+	}
+	if filter.CreateFrom != nil {
+		where = append(where, sq.GtOrEq{"uzi.create_at": *filter.CreateFrom})
+	}
+	if filter.CreateTo != nil {
+		where = append(where, sq.LtOrEq{"uzi.create_at": *filter.CreateTo})
+	}
+	return where
+}
+
+func (q *repo) GetAnalyticsNodes(filter domain.AnalyticsFilter) ([]entity.AnalyticsNode, error) {
+	// создатель узла - пользователь первой версии в истории, у узлов до ведения истории его нет
+	query := q.QueryBuilder().
+		Select(
+			"node.id",
+			"node.uzi_id",
+			"node.ai",
+			"node.validation",
+			"node.tirads_23",
+			"node.tirads_4",
+			"node.tirads_5",
+			"uzi.author",
+			"uzi.device_id",
+			"uzi.create_at",
+			"(SELECT node_history.actor FROM node_history WHERE node_history.node_id = node.id ORDER BY node_history.version LIMIT 1) AS creator",
+		).
+		From("node").
+		Join("uzi ON uzi.id = node.uzi_id").
+		Where(uziFilter(filter)).
+		OrderBy("uzi.create_at", "node.id")
+
+	var nodes []entity.AnalyticsNode
+	if err := q.Runner().Selectx(q.Context(), &nodes, query); err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+func (q *repo) GetNodeContors(nodeIDs []uuid.UUID) ([]entity.NodeContor, error) {
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+
+	query := q.QueryBuilder().
+		Select(
+			"node_id",
+			"image_id",
+			"contor",
+		).
+		From("segment").
+		Where(sq.Eq{"node_id": nodeIDs})
+
+	var contors []entity.NodeContor
+	if err := q.Runner().Selectx(q.Context(), &contors, query); err != nil {
+		return nil, err
+	}
+
+	return contors, nil
+}
+
+func (q *repo) GetAiCorrections(filter domain.AnalyticsFilter) ([]entity.AiCorrection, error) {
+	query := q.QueryBuilder().
+		Select(
+			"node_correction.kind",
+			"count(*) AS nodes",
+		).
+		From("node_correction").
+		Join("uzi ON uzi.id = node_correction.uzi_id").
+		JoinClause("CROSS JOIN jsonb_array_elements(node_correction.sources) AS source").
+		Where(uziFilter(filter)).
+		Where("(source->>'ai')::boolean").
+		GroupBy("node_correction.kind")
+
+	var corrections []entity.AiCorrection
+	if err := q.Runner().Selectx(q.Context(), &corrections, query); err != nil {
+		return nil, err
+	}
+
+	return corrections, nil
+}
+
+func (q *repo) GetBulkReviews(filter domain.AnalyticsFilter) ([]entity.BulkReview, error) {
+	query := q.QueryBuilder().
+		Select(
+			"node_review.validation",
+			"sum(cardinality(node_review.node_ids)) AS nodes",
+		).
+		From("node_review").
+		Join("uzi ON uzi.id = node_review.uzi_id").
+		Where(uziFilter(filter)).
+		Where("node_review.validation IS NOT NULL").
+		GroupBy("node_review.validation")
+
+	var reviews []entity.BulkReview
+	if err := q.Runner().Selectx(q.Context(), &reviews, query); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
@@ -0,0 +1,33 @@
+package analytics
+
+import (
+	"uzi/internal/domain"
+	"uzi/internal/repository/analytics/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+	"github.com/google/uuid"
+)
+
+// Repository только чтение: выборки узлов вместе с узи для расчета аналитики
+type Repository interface {
+	// GetAnalyticsNodes узлы не удаленных узи из выборки вместе с автором, аппаратом и датой узи
+	GetAnalyticsNodes(filter domain.AnalyticsFilter) ([]entity.AnalyticsNode, error)
+	// GetNodeContors контуры сегментов узлов
+	GetNodeContors(nodeIDs []uuid.UUID) ([]entity.NodeContor, error)
+	// GetAiCorrections число исходных узлов нейросети в слияниях и разделениях по виду исправления
+	GetAiCorrections(filter domain.AnalyticsFilter) ([]entity.AiCorrection, error)
+	// GetBulkReviews число узлов, провалидированных пакетно, по проставленной валидации
+	GetBulkReviews(filter domain.AnalyticsFilter) ([]entity.BulkReview, error)
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
@@ -7,11 +7,21 @@ import (
 	"github.com/jmoiron/sqlx"
 	minio "github.com/minio/minio-go/v7"
 
+	"uzi/internal/repository/analytics"
 	"uzi/internal/repository/device"
 	"uzi/internal/repository/echographic"
 	"uzi/internal/repository/image"
 	"uzi/internal/repository/node"
+	"uzi/internal/repository/node_correction"
+	"uzi/internal/repository/node_descriptors"
+	"uzi/internal/repository/node_history"
+	"uzi/internal/repository/node_lineage"
+	"uzi/internal/repository/node_review"
+	"uzi/internal/repository/report"
 	"uzi/internal/repository/segment"
+	"uzi/internal/repository/segment_draft"
+	"uzi/internal/repository/segment_history"
+	"uzi/internal/repository/storage_cleanup"
 	"uzi/internal/repository/uzi"
 )
 
@@ -24,6 +34,16 @@ type DAO interface {
 	NewSegmentQuery(ctx context.Context) segment.Repository
 	NewNodeQuery(ctx context.Context) node.Repository
 	NewEchographicQuery(ctx context.Context) echographic.Repository
+	NewNodeDescriptorsQuery(ctx context.Context) node_descriptors.Repository
+	NewNodeLineageQuery(ctx context.Context) node_lineage.Repository
+	NewReportQuery(ctx context.Context) report.Repository
+	NewNodeHistoryQuery(ctx context.Context) node_history.Repository
+	NewSegmentHistoryQuery(ctx context.Context) segment_history.Repository
+	NewStorageCleanupQuery(ctx context.Context) storage_cleanup.Repository
+	NewAnalyticsQuery(ctx context.Context) analytics.Repository
+	NewNodeCorrectionQuery(ctx context.Context) node_correction.Repository
+	NewSegmentDraftQuery(ctx context.Context) segment_draft.Repository
+	NewNodeReviewQuery(ctx context.Context) node_review.Repository
 }
 
 type dao struct {
@@ -91,3 +111,73 @@ func (d *dao) NewEchographicQuery(ctx context.Context) echographic.Repository {
 
 	return echographic
 }
+
+func (d *dao) NewNodeDescriptorsQuery(ctx context.Context) node_descriptors.Repository {
+	nodeDescriptors := node_descriptors.NewRepo()
+	d.NewRepo(ctx, nodeDescriptors)
+
+	return nodeDescriptors
+}
+
+func (d *dao) NewNodeLineageQuery(ctx context.Context) node_lineage.Repository {
+	nodeLineage := node_lineage.NewRepo()
+	d.NewRepo(ctx, nodeLineage)
+
+	return nodeLineage
+}
+
+func (d *dao) NewReportQuery(ctx context.Context) report.Repository {
+	reportQuery := report.NewRepo()
+	d.NewRepo(ctx, reportQuery)
+
+	return reportQuery
+}
+
+func (d *dao) NewNodeHistoryQuery(ctx context.Context) node_history.Repository {
+	nodeHistoryQuery := node_history.NewRepo()
+	d.NewRepo(ctx, nodeHistoryQuery)
+
+	return nodeHistoryQuery
+}
+
+func (d *dao) NewSegmentHistoryQuery(ctx context.Context) segment_history.Repository {
+	segmentHistoryQuery := segment_history.NewRepo()
+	d.NewRepo(ctx, segmentHistoryQuery)
+
+	return segmentHistoryQuery
+}
+
+func (d *dao) NewStorageCleanupQuery(ctx context.Context) storage_cleanup.Repository {
+	storageCleanupQuery := storage_cleanup.NewRepo()
+	d.NewRepo(ctx, storageCleanupQuery)
+
+	return storageCleanupQuery
+}
+
+func (d *dao) NewAnalyticsQuery(ctx context.Context) analytics.Repository {
+	analyticsQuery := analytics.NewRepo()
+	d.NewRepo(ctx, analyticsQuery)
+
+	return analyticsQuery
+}
+
+func (d *dao) NewNodeCorrectionQuery(ctx context.Context) node_correction.Repository {
+	nodeCorrectionQuery := node_correction.NewRepo()
+	d.NewRepo(ctx, nodeCorrectionQuery)
+
+	return nodeCorrectionQuery
+}
+
+func (d *dao) NewSegmentDraftQuery(ctx context.Context) segment_draft.Repository {
+	segmentDraftQuery := segment_draft.NewRepo()
+	d.NewRepo(ctx, segmentDraftQuery)
+
+	return segmentDraftQuery
+}
+
+func (d *dao) NewNodeReviewQuery(ctx context.Context) node_review.Repository {
+	nodeReviewQuery := node_review.NewRepo()
+	d.NewRepo(ctx, nodeReviewQuery)
+
+	return nodeReviewQuery
+}
@@ -1,17 +1,30 @@
 package device
 
 import (
+	"uzi/internal/repository/device/entity"
 	repoEntity "uzi/internal/repository/entity"
 )
 
-func (q *repo) CreateDevice(name string) (int, error) {
+func (q *repo) CreateDevice(device entity.Device) (int, error) {
 	query := q.QueryBuilder().
 		Insert(table).
 		Columns(
 			columnName,
+			columnManufacturer,
+			columnModel,
+			columnSerialNumber,
+			columnProbeType,
+			columnPixelSpacingX,
+			columnPixelSpacingY,
 		).
 		Values(
-			name,
+			device.Name,
+			device.Manufacturer,
+			device.Model,
+			device.SerialNumber,
+			device.ProbeType,
+			device.PixelSpacingX,
+			device.PixelSpacingY,
 		).
 		Suffix("RETURNING id")
 
@@ -0,0 +1,22 @@
+package device
+
+import (
+	sq "github.com/Masterminds/squirrel"
+
+	repoEntity "uzi/internal/repository/entity"
+)
+
+func (q *repo) DeleteDevice(id int) error {
+	query := q.QueryBuilder().
+		Delete(table).
+		Where(sq.Eq{
+			columnId: id,
+		})
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -1,24 +1,65 @@
 package entity
 
-import "uzi/internal/domain"
+import (
+	"database/sql"
+
+	"github.com/WantBeASleep/med_ml_lib/gtc"
+
+	"uzi/internal/domain"
+)
 
 type Device struct {
-	Id   int    `db:"id"`
-	Name string `db:"name"`
+	Id           int            `db:"id"`
+	Name         string         `db:"name"`
+	Manufacturer sql.NullString `db:"manufacturer"`
+	Model        sql.NullString `db:"model"`
+	SerialNumber sql.NullString `db:"serial_number"`
+	ProbeType    sql.NullString `db:"probe_type"`
+
+	PixelSpacingX sql.NullFloat64 `db:"pixel_spacing_x"`
+	PixelSpacingY sql.NullFloat64 `db:"pixel_spacing_y"`
 }
 
 func (Device) FromDomain(d domain.Device) Device {
-	return Device{
-		Id:   d.Id,
-		Name: d.Name,
+	device := Device{
+		Id:           d.Id,
+		Name:         d.Name,
+		Manufacturer: gtc.String.PointerToSql(d.Manufacturer),
+		Model:        gtc.String.PointerToSql(d.Model),
+		SerialNumber: gtc.String.PointerToSql(d.SerialNumber),
+		ProbeType:    gtc.String.PointerToSql((*string)(d.ProbeType)),
 	}
+
+	if d.PixelSpacing != nil {
+		device.PixelSpacingX = sql.NullFloat64{Valid: true, Float64: d.PixelSpacing.X}
+		device.PixelSpacingY = sql.NullFloat64{Valid: true, Float64: d.PixelSpacing.Y}
+	}
+
+	return device
 }
 
 func (d Device) ToDomain() domain.Device {
-	return domain.Device{
-		Id:   d.Id,
-		Name: d.Name,
+	device := domain.Device{
+		Id:           d.Id,
+		Name:         d.Name,
+		Manufacturer: gtc.String.SqlToPointer(d.Manufacturer),
+		Model:        gtc.String.SqlToPointer(d.Model),
+		SerialNumber: gtc.String.SqlToPointer(d.SerialNumber),
 	}
+
+	if d.ProbeType.Valid {
+		probeType, _ := domain.ProbeType.Parse("", d.ProbeType.String)
+		device.ProbeType = &probeType
+	}
+
+	if d.PixelSpacingX.Valid && d.PixelSpacingY.Valid {
+		device.PixelSpacing = &domain.PixelSpacing{
+			X: d.PixelSpacingX.Float64,
+			Y: d.PixelSpacingY.Float64,
+		}
+	}
+
+	return device
 }
 
 func (Device) SliceToDomain(slice []Device) []domain.Device {
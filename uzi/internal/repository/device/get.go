@@ -1,16 +1,71 @@
 package device
 
 import (
+	"database/sql"
+	"errors"
+
 	"uzi/internal/repository/device/entity"
+	daoEntity "uzi/internal/repository/entity"
+
+	sq "github.com/Masterminds/squirrel"
 )
 
+var columns = []string{
+	columnId,
+	columnName,
+	columnManufacturer,
+	columnModel,
+	columnSerialNumber,
+	columnProbeType,
+	columnPixelSpacingX,
+	columnPixelSpacingY,
+}
+
+func (q *repo) GetDeviceByID(id int) (entity.Device, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnId: id,
+		})
+
+	var device entity.Device
+	if err := q.Runner().Getx(q.Context(), &device, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Device{}, daoEntity.ErrNotFound
+		}
+		return entity.Device{}, err
+	}
+
+	return device, nil
+}
+
+func (q *repo) GetDeviceByName(name string) (entity.Device, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnName: name,
+		}).
+		OrderBy(columnId).
+		Limit(1)
+
+	var device entity.Device
+	if err := q.Runner().Getx(q.Context(), &device, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Device{}, daoEntity.ErrNotFound
+		}
+		return entity.Device{}, err
+	}
+
+	return device, nil
+}
+
 func (q *repo) GetDeviceList() ([]entity.Device, error) {
 	query := q.QueryBuilder().
-		Select(
-			columnId,
-			columnName,
-		).
-		From(table)
+		Select(columns...).
+		From(table).
+		OrderBy(columnId)
 
 	var devices []entity.Device
 	if err := q.Runner().Selectx(q.Context(), &devices, query); err != nil {
@@ -9,14 +9,26 @@ import (
 const (
 	table = "device"
 
-	columnId   = "id"
-	columnName = "name"
+	columnId            = "id"
+	columnName          = "name"
+	columnManufacturer  = "manufacturer"
+	columnModel         = "model"
+	columnSerialNumber  = "serial_number"
+	columnProbeType     = "probe_type"
+	columnPixelSpacingX = "pixel_spacing_x"
+	columnPixelSpacingY = "pixel_spacing_y"
 )
 
 type Repository interface {
-	CreateDevice(name string) (int, error)
+	CreateDevice(device entity.Device) (int, error)
 
+	GetDeviceByID(id int) (entity.Device, error)
+	GetDeviceByName(name string) (entity.Device, error)
 	GetDeviceList() ([]entity.Device, error)
+
+	UpdateDevice(device entity.Device) error
+
+	DeleteDevice(id int) error
 }
 
 type repo struct {
@@ -0,0 +1,32 @@
+package device
+
+import (
+	"uzi/internal/repository/device/entity"
+	repoEntity "uzi/internal/repository/entity"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+func (q *repo) UpdateDevice(device entity.Device) error {
+	query := q.QueryBuilder().
+		Update(table).
+		SetMap(sq.Eq{
+			columnName:          device.Name,
+			columnManufacturer:  device.Manufacturer,
+			columnModel:         device.Model,
+			columnSerialNumber:  device.SerialNumber,
+			columnProbeType:     device.ProbeType,
+			columnPixelSpacingX: device.PixelSpacingX,
+			columnPixelSpacingY: device.PixelSpacingY,
+		}).
+		Where(sq.Eq{
+			columnId: device.Id,
+		})
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -28,6 +28,8 @@ func (q *repo) InsertEchographic(echographic entity.Echographic) error {
 			columnLocation,
 			columnAdditional,
 			columnConclusion,
+			columnPatientSex,
+			columnPatientAge,
 		).
 		Values(
 			echographic.Id,
@@ -49,6 +51,8 @@ func (q *repo) InsertEchographic(echographic entity.Echographic) error {
 			echographic.Location,
 			echographic.Additional,
 			echographic.Conclusion,
+			echographic.PatientSex,
+			echographic.PatientAge,
 		)
 
 	_, err := q.Runner().Execx(q.Context(), query)
@@ -29,10 +29,12 @@ type Echographic struct {
 	Location        sql.NullString  `db:"location"`
 	Additional      sql.NullString  `db:"additional"`
 	Conclusion      sql.NullString  `db:"conclusion"`
+	PatientSex      sql.NullString  `db:"patient_sex"`
+	PatientAge      sql.NullInt64   `db:"patient_age"`
 }
 
 func (Echographic) FromDomain(d domain.Echographic) Echographic {
-	return Echographic{
+	echographic := Echographic{
 		Id:              d.Id,
 		Contors:         gtc.String.PointerToSql(d.Contors),
 		LeftLobeLength:  gtc.Float64.PointerToSql(d.LeftLobeLength),
@@ -45,18 +47,25 @@ func (Echographic) FromDomain(d domain.Echographic) Echographic {
 		RightLobeVolum:  gtc.Float64.PointerToSql(d.RightLobeVolum),
 		GlandVolum:      gtc.Float64.PointerToSql(d.GlandVolum),
 		Isthmus:         gtc.Float64.PointerToSql(d.Isthmus),
-		Struct:          gtc.String.PointerToSql(d.Struct),
-		Echogenicity:    gtc.String.PointerToSql(d.Echogenicity),
+		Struct:          gtc.String.PointerToSql((*string)(d.Struct)),
+		Echogenicity:    gtc.String.PointerToSql((*string)(d.Echogenicity)),
 		RegionalLymph:   gtc.String.PointerToSql(d.RegionalLymph),
-		Vascularization: gtc.String.PointerToSql(d.Vascularization),
+		Vascularization: gtc.String.PointerToSql((*string)(d.Vascularization)),
 		Location:        gtc.String.PointerToSql(d.Location),
 		Additional:      gtc.String.PointerToSql(d.Additional),
 		Conclusion:      gtc.String.PointerToSql(d.Conclusion),
+		PatientSex:      gtc.String.PointerToSql((*string)(d.PatientSex)),
 	}
+
+	if d.PatientAge != nil {
+		echographic.PatientAge = sql.NullInt64{Valid: true, Int64: int64(*d.PatientAge)}
+	}
+
+	return echographic
 }
 
 func (d Echographic) ToDomain() domain.Echographic {
-	return domain.Echographic{
+	echographic := domain.Echographic{
 		Id:              d.Id,
 		Contors:         gtc.String.SqlToPointer(d.Contors),
 		LeftLobeLength:  gtc.Float64.SqlToPointer(d.LeftLobeLength),
@@ -69,12 +78,36 @@ func (d Echographic) ToDomain() domain.Echographic {
 		RightLobeVolum:  gtc.Float64.SqlToPointer(d.RightLobeVolum),
 		GlandVolum:      gtc.Float64.SqlToPointer(d.GlandVolum),
 		Isthmus:         gtc.Float64.SqlToPointer(d.Isthmus),
-		Struct:          gtc.String.SqlToPointer(d.Struct),
-		Echogenicity:    gtc.String.SqlToPointer(d.Echogenicity),
 		RegionalLymph:   gtc.String.SqlToPointer(d.RegionalLymph),
-		Vascularization: gtc.String.SqlToPointer(d.Vascularization),
 		Location:        gtc.String.SqlToPointer(d.Location),
 		Additional:      gtc.String.SqlToPointer(d.Additional),
 		Conclusion:      gtc.String.SqlToPointer(d.Conclusion),
 	}
+
+	if d.Struct.Valid {
+		value, _ := domain.EchographicStruct.Parse("", d.Struct.String)
+		echographic.Struct = &value
+	}
+
+	if d.Echogenicity.Valid {
+		value, _ := domain.EchographicEchogenicity.Parse("", d.Echogenicity.String)
+		echographic.Echogenicity = &value
+	}
+
+	if d.Vascularization.Valid {
+		value, _ := domain.EchographicVascularization.Parse("", d.Vascularization.String)
+		echographic.Vascularization = &value
+	}
+
+	if d.PatientSex.Valid {
+		sex, _ := domain.PatientSex.Parse("", d.PatientSex.String)
+		echographic.PatientSex = &sex
+	}
+
+	if d.PatientAge.Valid {
+		age := int(d.PatientAge.Int64)
+		echographic.PatientAge = &age
+	}
+
+	return echographic
 }
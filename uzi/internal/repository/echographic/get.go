@@ -33,6 +33,8 @@ func (q *repo) GetEchographicByID(id uuid.UUID) (entity.Echographic, error) {
 			columnLocation,
 			columnAdditional,
 			columnConclusion,
+			columnPatientSex,
+			columnPatientAge,
 		).
 		From(table).
 		Where(sq.Eq{
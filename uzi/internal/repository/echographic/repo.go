@@ -29,6 +29,8 @@ const (
 	columnLocation        = "location"
 	columnAdditional      = "additional"
 	columnConclusion      = "conclusion"
+	columnPatientSex      = "patient_sex"
+	columnPatientAge      = "patient_age"
 )
 
 type Repository interface {
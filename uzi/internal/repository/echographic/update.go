@@ -29,6 +29,8 @@ func (q *repo) UpdateEchographic(echographic entity.Echographic) error {
 			columnLocation:        echographic.Location,
 			columnAdditional:      echographic.Additional,
 			columnConclusion:      echographic.Conclusion,
+			columnPatientSex:      echographic.PatientSex,
+			columnPatientAge:      echographic.PatientAge,
 		}).
 		Where(sq.Eq{
 			columnID: echographic.Id,
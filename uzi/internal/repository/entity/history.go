@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"uzi/internal/domain"
+)
+
+// FieldChange изменение поля json снапшота в таблицах истории
+type FieldChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// SnapshotDiff поля, различающиеся в json снапшотах объекта, nil снапшот - объекта нет.
+// Поля отсортированы по имени, чтобы diff был воспроизводимым
+func SnapshotDiff(before, after []byte) []FieldChange {
+	beforeFields := map[string]json.RawMessage{}
+	afterFields := map[string]json.RawMessage{}
+	// снапшоты сериализуются из структур, ошибок разбора здесь не бывает
+	_ = json.Unmarshal(before, &beforeFields)
+	_ = json.Unmarshal(after, &afterFields)
+
+	fields := make([]string, 0, len(beforeFields)+len(afterFields))
+	for field := range beforeFields {
+		fields = append(fields, field)
+	}
+	for field := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+
+	diff := []FieldChange{}
+	for _, field := range fields {
+		if bytes.Equal(beforeFields[field], afterFields[field]) {
+			continue
+		}
+		diff = append(diff, FieldChange{Field: field, Before: beforeFields[field], After: afterFields[field]})
+	}
+
+	return diff
+}
+
+func FieldChangesToDomain(raw []byte) []domain.FieldChange {
+	var changes []FieldChange
+	_ = json.Unmarshal(raw, &changes)
+
+	res := make([]domain.FieldChange, 0, len(changes))
+	for _, v := range changes {
+		res = append(res, domain.FieldChange{Field: v.Field, Before: v.Before, After: v.After})
+	}
+	return res
+}
+
+// NullJSON nil снапшот пишется как NULL, а не пустой строкой
+func NullJSON(raw []byte) any {
+	if raw == nil {
+		return nil
+	}
+	return raw
+}
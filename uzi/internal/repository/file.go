@@ -16,7 +16,13 @@ var ErrFileNotFound = errors.New("file not found")
 
 type FileRepo interface {
 	GetFileViaTemp(ctx context.Context, path string) (domain.File, func() error, error)
+	// GetFile поток объекта без копирования во временный файл
+	GetFile(ctx context.Context, path string) (io.ReadCloser, error)
 	LoadFile(ctx context.Context, path string, file domain.File) error
+	// ListFiles все объекты под префиксом, пустой префикс - весь бакет
+	ListFiles(ctx context.Context, prefix string) ([]domain.FileInfo, error)
+	// DeleteFiles отсутствующие объекты не считаются ошибкой
+	DeleteFiles(ctx context.Context, paths ...string) error
 }
 
 type fileRepo struct {
@@ -76,6 +82,24 @@ func (r *fileRepo) GetFileViaTemp(ctx context.Context, path string) (domain.File
 	}, closer, nil
 }
 
+func (r *fileRepo) GetFile(ctx context.Context, path string) (io.ReadCloser, error) {
+	_, err := r.s3.StatObject(ctx, r.bucket, path, minio.GetObjectOptions{})
+	if err != nil {
+		errResp := minio.ToErrorResponse(err)
+		if errResp.Code == "NoSuchKey" {
+			return nil, ErrFileNotFound
+		}
+		return nil, fmt.Errorf("get stat of object: %w", err)
+	}
+
+	obj, err := r.s3.GetObject(ctx, r.bucket, path, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get file from s3: %w", err)
+	}
+
+	return obj, nil
+}
+
 func (r *fileRepo) LoadFile(ctx context.Context, path string, file domain.File) error {
 	sizeUploaded, err := r.s3.PutObject(ctx, r.bucket, path, file.Buf, file.Size, minio.PutObjectOptions{ContentType: file.Format})
 	if err != nil {
@@ -88,3 +112,37 @@ func (r *fileRepo) LoadFile(ctx context.Context, path string, file domain.File)
 
 	return nil
 }
+
+func (r *fileRepo) ListFiles(ctx context.Context, prefix string) ([]domain.FileInfo, error) {
+	var files []domain.FileInfo
+	for obj := range r.s3.ListObjects(ctx, r.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, fmt.Errorf("list objects: %w", obj.Err)
+		}
+		files = append(files, domain.FileInfo{
+			Path:         obj.Key,
+			Size:         obj.Size,
+			LastModified: obj.LastModified,
+		})
+	}
+
+	return files, nil
+}
+
+func (r *fileRepo) DeleteFiles(ctx context.Context, paths ...string) error {
+	objects := make(chan minio.ObjectInfo, len(paths))
+	for _, path := range paths {
+		objects <- minio.ObjectInfo{Key: path}
+	}
+	close(objects)
+
+	var errs []error
+	for removeErr := range r.s3.RemoveObjects(ctx, r.bucket, objects, minio.RemoveObjectsOptions{}) {
+		if minio.ToErrorResponse(removeErr.Err).Code == "NoSuchKey" {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("remove %s: %w", removeErr.ObjectName, removeErr.Err))
+	}
+
+	return errors.Join(errs...)
+}
@@ -12,6 +12,9 @@ func (q *repo) InsertImages(images ...entity.Image) error {
 			columnId,
 			columnUziId,
 			columnPage,
+			columnWidth,
+			columnHeight,
+			columnPreviews,
 		)
 
 	for _, v := range images {
@@ -19,6 +22,9 @@ func (q *repo) InsertImages(images ...entity.Image) error {
 			v.Id,
 			v.UziID,
 			v.Page,
+			v.Width,
+			v.Height,
+			v.Previews,
 		)
 	}
 
@@ -7,16 +7,22 @@ import (
 )
 
 type Image struct {
-	Id    uuid.UUID `db:"id"`
-	UziID uuid.UUID `db:"uzi_id"`
-	Page  int       `db:"page"`
+	Id       uuid.UUID `db:"id"`
+	UziID    uuid.UUID `db:"uzi_id"`
+	Page     int       `db:"page"`
+	Width    int       `db:"width"`
+	Height   int       `db:"height"`
+	Previews bool      `db:"previews"`
 }
 
 func (Image) FromDomain(d domain.Image) Image {
 	return Image{
-		Id:    d.Id,
-		UziID: d.UziID,
-		Page:  d.Page,
+		Id:       d.Id,
+		UziID:    d.UziID,
+		Page:     d.Page,
+		Width:    d.Width,
+		Height:   d.Height,
+		Previews: d.Previews,
 	}
 }
 
@@ -30,9 +36,12 @@ func (Image) SliceFromDomain(slice []domain.Image) []Image {
 
 func (d Image) ToDomain() domain.Image {
 	return domain.Image{
-		Id:    d.Id,
-		UziID: d.UziID,
-		Page:  d.Page,
+		Id:       d.Id,
+		UziID:    d.UziID,
+		Page:     d.Page,
+		Width:    d.Width,
+		Height:   d.Height,
+		Previews: d.Previews,
 	}
 }
 
@@ -14,6 +14,9 @@ func (q *repo) GetImagesByUziID(uziID uuid.UUID) ([]entity.Image, error) {
 			columnId,
 			columnUziId,
 			columnPage,
+			columnWidth,
+			columnHeight,
+			columnPreviews,
 		).
 		From(table).
 		Where(sq.Eq{
@@ -31,3 +34,42 @@ func (q *repo) GetImagesByUziID(uziID uuid.UUID) ([]entity.Image, error) {
 
 	return images, nil
 }
+
+func (q *repo) GetExistingImageIDs(ids []uuid.UUID) ([]uuid.UUID, error) {
+	query := q.QueryBuilder().
+		Select(columnId).
+		From(table).
+		Where(sq.Eq{columnId: ids})
+
+	var existing []uuid.UUID
+	if err := q.Runner().Selectx(q.Context(), &existing, query); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+func (q *repo) GetImagesByIDs(ids []uuid.UUID) ([]entity.Image, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := q.QueryBuilder().
+		Select(
+			columnId,
+			columnUziId,
+			columnPage,
+			columnWidth,
+			columnHeight,
+			columnPreviews,
+		).
+		From(table).
+		Where(sq.Eq{columnId: ids})
+
+	var images []entity.Image
+	if err := q.Runner().Selectx(q.Context(), &images, query); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
@@ -13,12 +13,20 @@ const (
 	columnId    = "id"
 	columnUziId = "uzi_id"
 	columnPage  = "page"
+
+	columnWidth    = "width"
+	columnHeight   = "height"
+	columnPreviews = "previews"
 )
 
 type Repository interface {
 	InsertImages(images ...entity.Image) error
 
 	GetImagesByUziID(uziID uuid.UUID) ([]entity.Image, error)
+	// GetImagesByIDs кадры с указанными id, отсутствующие пропускаются
+	GetImagesByIDs(ids []uuid.UUID) ([]entity.Image, error)
+	// GetExistingImageIDs какие из ids есть в таблице
+	GetExistingImageIDs(ids []uuid.UUID) ([]uuid.UUID, error)
 }
 
 type repo struct {
@@ -17,6 +17,13 @@ func (q *repo) InsertNodes(nodes ...entity.Node) error {
 			columnTirads4,
 			columnTirads5,
 			columnDescription,
+			columnLobe,
+			columnArea,
+			columnPerimeter,
+			columnMajorAxis,
+			columnMinorAxis,
+			columnVolume,
+			columnMeasureUnit,
 		)
 
 	for _, v := range nodes {
@@ -29,6 +36,13 @@ func (q *repo) InsertNodes(nodes ...entity.Node) error {
 			v.Tirads4,
 			v.Tirads5,
 			v.Description,
+			v.Lobe,
+			v.Area,
+			v.Perimeter,
+			v.MajorAxis,
+			v.MinorAxis,
+			v.Volume,
+			v.MeasureUnit,
 		)
 	}
 	_, err := q.Runner().Execx(q.Context(), query)
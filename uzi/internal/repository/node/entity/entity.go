@@ -18,10 +18,18 @@ type Node struct {
 	Tirads4     float64        `db:"tirads_4"`
 	Tirads5     float64        `db:"tirads_5"`
 	Description sql.NullString `db:"description"`
+	Lobe        sql.NullString `db:"lobe"`
+
+	Area        sql.NullFloat64 `db:"area"`
+	Perimeter   sql.NullFloat64 `db:"perimeter"`
+	MajorAxis   sql.NullFloat64 `db:"major_axis"`
+	MinorAxis   sql.NullFloat64 `db:"minor_axis"`
+	Volume      sql.NullFloat64 `db:"volume"`
+	MeasureUnit sql.NullString  `db:"measure_unit"`
 }
 
 func (Node) FromDomain(d domain.Node) Node {
-	return Node{
+	node := Node{
 		Id:          d.Id,
 		Ai:          d.Ai,
 		UziID:       d.UziID,
@@ -30,7 +38,19 @@ func (Node) FromDomain(d domain.Node) Node {
 		Tirads4:     d.Tirads4,
 		Tirads5:     d.Tirads5,
 		Description: gtc.String.PointerToSql(d.Description),
+		Lobe:        gtc.String.PointerToSql((*string)(d.Lobe)),
+	}
+
+	if m := d.Measurement; m != nil {
+		node.Area = sql.NullFloat64{Valid: true, Float64: m.Area}
+		node.Perimeter = sql.NullFloat64{Valid: true, Float64: m.Perimeter}
+		node.MajorAxis = sql.NullFloat64{Valid: true, Float64: m.MajorAxis}
+		node.MinorAxis = sql.NullFloat64{Valid: true, Float64: m.MinorAxis}
+		node.Volume = sql.NullFloat64{Valid: true, Float64: m.Volume}
+		node.MeasureUnit = sql.NullString{Valid: true, String: m.Unit.String()}
 	}
+
+	return node
 }
 
 func (Node) SliceFromDomain(slice []domain.Node) []Node {
@@ -57,6 +77,24 @@ func (d Node) ToDomain() domain.Node {
 		node.Validation = &validation
 	}
 
+	if d.Lobe.Valid {
+		lobe, _ := domain.NodeLobe.Parse("", d.Lobe.String)
+		node.Lobe = &lobe
+	}
+
+	// измерения пишутся целиком, достаточно проверить единицу измерения
+	if d.MeasureUnit.Valid {
+		unit, _ := domain.MeasureUnit.Parse("", d.MeasureUnit.String)
+		node.Measurement = &domain.NodeMeasurement{
+			Area:      d.Area.Float64,
+			Perimeter: d.Perimeter.Float64,
+			MajorAxis: d.MajorAxis.Float64,
+			MinorAxis: d.MinorAxis.Float64,
+			Volume:    d.Volume.Float64,
+			Unit:      unit,
+		}
+	}
+
 	return node
 }
 
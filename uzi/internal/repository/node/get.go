@@ -23,6 +23,13 @@ func (q *repo) GetNodeByID(id uuid.UUID) (entity.Node, error) {
 			columnTirads4,
 			columnTirads5,
 			columnDescription,
+			columnLobe,
+			columnArea,
+			columnPerimeter,
+			columnMajorAxis,
+			columnMinorAxis,
+			columnVolume,
+			columnMeasureUnit,
 		).
 		From(table).
 		Where(sq.Eq{
@@ -51,6 +58,13 @@ func (q *repo) GetNodesByImageID(id uuid.UUID) ([]entity.Node, error) {
 			fmt.Sprintf("%s.%s", table, columnTirads4),
 			fmt.Sprintf("%s.%s", table, columnTirads5),
 			fmt.Sprintf("%s.%s", table, columnDescription),
+			fmt.Sprintf("%s.%s", table, columnLobe),
+			fmt.Sprintf("%s.%s", table, columnArea),
+			fmt.Sprintf("%s.%s", table, columnPerimeter),
+			fmt.Sprintf("%s.%s", table, columnMajorAxis),
+			fmt.Sprintf("%s.%s", table, columnMinorAxis),
+			fmt.Sprintf("%s.%s", table, columnVolume),
+			fmt.Sprintf("%s.%s", table, columnMeasureUnit),
 		).
 		From(table). // TODO: вынести константы таблиц в отдельный пакет, тут пересечение с segment
 		InnerJoin("segment ON segment.node_id = node.id").
@@ -82,6 +96,13 @@ func (q *repo) GetNodesByUziID(id uuid.UUID) ([]entity.Node, error) {
 			columnTirads4,
 			columnTirads5,
 			columnDescription,
+			columnLobe,
+			columnArea,
+			columnPerimeter,
+			columnMajorAxis,
+			columnMinorAxis,
+			columnVolume,
+			columnMeasureUnit,
 		).
 		From(table).
 		Where(sq.Eq{
@@ -99,3 +120,39 @@ func (q *repo) GetNodesByUziID(id uuid.UUID) ([]entity.Node, error) {
 
 	return uzi, nil
 }
+
+func (q *repo) GetNodesByIDs(ids []uuid.UUID) ([]entity.Node, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := q.QueryBuilder().
+		Select(
+			columnID,
+			columnAI,
+			columnUziID,
+			columnValidation,
+			columnTirads23,
+			columnTirads4,
+			columnTirads5,
+			columnDescription,
+			columnLobe,
+			columnArea,
+			columnPerimeter,
+			columnMajorAxis,
+			columnMinorAxis,
+			columnVolume,
+			columnMeasureUnit,
+		).
+		From(table).
+		Where(sq.Eq{
+			columnID: ids,
+		})
+
+	var nodes []entity.Node
+	if err := q.Runner().Selectx(q.Context(), &nodes, query); err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
@@ -18,12 +18,22 @@ const (
 	columnTirads4     = "tirads_4"
 	columnTirads5     = "tirads_5"
 	columnDescription = "description"
+	columnLobe        = "lobe"
+
+	columnArea        = "area"
+	columnPerimeter   = "perimeter"
+	columnMajorAxis   = "major_axis"
+	columnMinorAxis   = "minor_axis"
+	columnVolume      = "volume"
+	columnMeasureUnit = "measure_unit"
 )
 
 type Repository interface {
 	InsertNodes(nodes ...entity.Node) error
 
 	GetNodeByID(id uuid.UUID) (entity.Node, error)
+	// GetNodesByIDs узлы с указанными id, отсутствующие пропускаются
+	GetNodesByIDs(ids []uuid.UUID) ([]entity.Node, error)
 	GetNodesByImageID(id uuid.UUID) ([]entity.Node, error)
 	GetNodesByUziID(id uuid.UUID) ([]entity.Node, error)
 
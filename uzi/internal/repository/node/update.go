@@ -11,10 +11,18 @@ func (q *repo) UpdateNode(node entity.Node) error {
 	query := q.QueryBuilder().
 		Update(table).
 		SetMap(sq.Eq{
-			columnValidation: node.Validation,
-			columnTirads23:   node.Tirads23,
-			columnTirads4:    node.Tirads4,
-			columnTirads5:    node.Tirads5,
+			columnValidation:  node.Validation,
+			columnTirads23:    node.Tirads23,
+			columnTirads4:     node.Tirads4,
+			columnTirads5:     node.Tirads5,
+			columnDescription: node.Description,
+			columnLobe:        node.Lobe,
+			columnArea:        node.Area,
+			columnPerimeter:   node.Perimeter,
+			columnMajorAxis:   node.MajorAxis,
+			columnMinorAxis:   node.MinorAxis,
+			columnVolume:      node.Volume,
+			columnMeasureUnit: node.MeasureUnit,
 		}).
 		Where(sq.Eq{
 			columnID: node.Id,
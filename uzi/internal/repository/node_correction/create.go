@@ -0,0 +1,34 @@
+package node_correction
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/node_correction/entity"
+)
+
+func (q *repo) InsertNodeCorrection(correction entity.NodeCorrection) error {
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(
+			columnID,
+			columnUziID,
+			columnKind,
+			columnActor,
+			columnSources,
+			columnResultIDs,
+		).
+		Values(
+			correction.Id,
+			correction.UziID,
+			correction.Kind,
+			correction.Actor,
+			correction.Sources,
+			correction.ResultIDs,
+		)
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"uzi/internal/domain"
+)
+
+type NodeCorrection struct {
+	Id        uuid.UUID      `db:"id"`
+	UziID     uuid.UUID      `db:"uzi_id"`
+	Kind      string         `db:"kind"`
+	Actor     uuid.NullUUID  `db:"actor"`
+	Sources   []byte         `db:"sources"`
+	ResultIDs pq.StringArray `db:"result_ids"`
+	CreateAt  time.Time      `db:"create_at"`
+}
+
+// correctedNode исходный узел в sources, без измерений - они пересчитываются по контурам
+type correctedNode struct {
+	Id          uuid.UUID   `json:"id"`
+	Ai          bool        `json:"ai"`
+	Validation  *string     `json:"validation"`
+	Tirads23    float64     `json:"tirads_23"`
+	Tirads4     float64     `json:"tirads_4"`
+	Tirads5     float64     `json:"tirads_5"`
+	Description *string     `json:"description"`
+	Lobe        *string     `json:"lobe"`
+	SegmentIDs  []uuid.UUID `json:"segment_ids"`
+}
+
+func (NodeCorrection) FromDomain(d domain.NodeCorrection) NodeCorrection {
+	sources := make([]correctedNode, 0, len(d.Sources))
+	for _, v := range d.Sources {
+		sources = append(sources, correctedNode{
+			Id:          v.Node.Id,
+			Ai:          v.Node.Ai,
+			Validation:  (*string)(v.Node.Validation),
+			Tirads23:    v.Node.Tirads23,
+			Tirads4:     v.Node.Tirads4,
+			Tirads5:     v.Node.Tirads5,
+			Description: v.Node.Description,
+			Lobe:        (*string)(v.Node.Lobe),
+			SegmentIDs:  v.SegmentIDs,
+		})
+	}
+	sourcesJSON, _ := json.Marshal(sources)
+
+	resultIDs := make(pq.StringArray, 0, len(d.ResultIDs))
+	for _, id := range d.ResultIDs {
+		resultIDs = append(resultIDs, id.String())
+	}
+
+	correction := NodeCorrection{
+		Id:        d.Id,
+		UziID:     d.UziID,
+		Kind:      d.Kind.String(),
+		Sources:   sourcesJSON,
+		ResultIDs: resultIDs,
+		CreateAt:  d.CreateAt,
+	}
+	if d.Actor != nil {
+		correction.Actor = uuid.NullUUID{Valid: true, UUID: *d.Actor}
+	}
+
+	return correction
+}
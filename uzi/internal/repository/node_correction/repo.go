@@ -0,0 +1,35 @@
+package node_correction
+
+import (
+	"uzi/internal/repository/node_correction/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+)
+
+const (
+	table = "node_correction"
+
+	columnID        = "id"
+	columnUziID     = "uzi_id"
+	columnKind      = "kind"
+	columnActor     = "actor"
+	columnSources   = "sources"
+	columnResultIDs = "result_ids"
+	columnCreateAt  = "create_at"
+)
+
+type Repository interface {
+	InsertNodeCorrection(correction entity.NodeCorrection) error
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
@@ -0,0 +1,40 @@
+package node_descriptors
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/node_descriptors/entity"
+)
+
+func (q *repo) UpsertNodeDescriptors(descriptors entity.NodeDescriptors) error {
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(
+			columnNodeID,
+			columnComposition,
+			columnEchogenicity,
+			columnShape,
+			columnMargin,
+			columnEchogenicFoci,
+		).
+		Values(
+			descriptors.NodeID,
+			descriptors.Composition,
+			descriptors.Echogenicity,
+			descriptors.Shape,
+			descriptors.Margin,
+			descriptors.EchogenicFoci,
+		).
+		Suffix(`ON CONFLICT (node_id) DO UPDATE SET
+			composition = EXCLUDED.composition,
+			echogenicity = EXCLUDED.echogenicity,
+			shape = EXCLUDED.shape,
+			margin = EXCLUDED.margin,
+			echogenic_foci = EXCLUDED.echogenic_foci`)
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"uzi/internal/domain"
+)
+
+type NodeDescriptors struct {
+	NodeID        uuid.UUID      `db:"node_id"`
+	Composition   string         `db:"composition"`
+	Echogenicity  string         `db:"echogenicity"`
+	Shape         string         `db:"shape"`
+	Margin        string         `db:"margin"`
+	EchogenicFoci pq.StringArray `db:"echogenic_foci"`
+}
+
+func (NodeDescriptors) FromDomain(d domain.NodeDescriptors) NodeDescriptors {
+	foci := make(pq.StringArray, 0, len(d.EchogenicFoci))
+	for _, v := range d.EchogenicFoci {
+		foci = append(foci, v.String())
+	}
+
+	return NodeDescriptors{
+		NodeID:        d.NodeID,
+		Composition:   d.Composition.String(),
+		Echogenicity:  d.Echogenicity.String(),
+		Shape:         d.Shape.String(),
+		Margin:        d.Margin.String(),
+		EchogenicFoci: foci,
+	}
+}
+
+func (d NodeDescriptors) ToDomain() domain.NodeDescriptors {
+	// TODO: обработать ошибку
+	// но нигде встретиться не должна
+	composition, _ := domain.TiradsComposition.Parse("", d.Composition)
+	echogenicity, _ := domain.TiradsEchogenicity.Parse("", d.Echogenicity)
+	shape, _ := domain.TiradsShape.Parse("", d.Shape)
+	margin, _ := domain.TiradsMargin.Parse("", d.Margin)
+
+	foci := make([]domain.TiradsEchogenicFoci, 0, len(d.EchogenicFoci))
+	for _, v := range d.EchogenicFoci {
+		f, _ := domain.TiradsEchogenicFoci.Parse("", v)
+		foci = append(foci, f)
+	}
+
+	return domain.NodeDescriptors{
+		NodeID:        d.NodeID,
+		Composition:   composition,
+		Echogenicity:  echogenicity,
+		Shape:         shape,
+		Margin:        margin,
+		EchogenicFoci: foci,
+	}
+}
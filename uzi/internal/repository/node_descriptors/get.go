@@ -0,0 +1,38 @@
+package node_descriptors
+
+import (
+	"database/sql"
+	"errors"
+
+	daoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/node_descriptors/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func (q *repo) GetNodeDescriptorsByNodeID(id uuid.UUID) (entity.NodeDescriptors, error) {
+	query := q.QueryBuilder().
+		Select(
+			columnNodeID,
+			columnComposition,
+			columnEchogenicity,
+			columnShape,
+			columnMargin,
+			columnEchogenicFoci,
+		).
+		From(table).
+		Where(sq.Eq{
+			columnNodeID: id,
+		})
+
+	var descriptors entity.NodeDescriptors
+	if err := q.Runner().Getx(q.Context(), &descriptors, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.NodeDescriptors{}, daoEntity.ErrNotFound
+		}
+		return entity.NodeDescriptors{}, err
+	}
+
+	return descriptors, nil
+}
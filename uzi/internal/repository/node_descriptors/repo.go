@@ -0,0 +1,37 @@
+package node_descriptors
+
+import (
+	"uzi/internal/repository/node_descriptors/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+	"github.com/google/uuid"
+)
+
+const (
+	table = "node_descriptors"
+
+	columnNodeID        = "node_id"
+	columnComposition   = "composition"
+	columnEchogenicity  = "echogenicity"
+	columnShape         = "shape"
+	columnMargin        = "margin"
+	columnEchogenicFoci = "echogenic_foci"
+)
+
+type Repository interface {
+	UpsertNodeDescriptors(descriptors entity.NodeDescriptors) error
+
+	GetNodeDescriptorsByNodeID(id uuid.UUID) (entity.NodeDescriptors, error)
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
@@ -0,0 +1,29 @@
+package node_history
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/node_history/entity"
+)
+
+func (q *repo) InsertNodeHistory(history entity.NodeHistory) error {
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(columns[:len(columns)-1]...).
+		Values(
+			history.NodeID,
+			history.UziID,
+			history.Version,
+			history.Action,
+			history.Actor,
+			repoEntity.NullJSON(history.Before),
+			repoEntity.NullJSON(history.After),
+			history.Diff,
+		)
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package node_history
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	repoEntity "uzi/internal/repository/entity"
+)
+
+func (q *repo) DeleteNodeHistoryByUziID(uziID uuid.UUID) error {
+	query := q.QueryBuilder().
+		Delete(table).
+		Where(sq.Eq{columnUziID: uziID})
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	repoEntity "uzi/internal/repository/entity"
+)
+
+type NodeHistory struct {
+	NodeID   uuid.UUID     `db:"node_id"`
+	UziID    uuid.UUID     `db:"uzi_id"`
+	Version  int           `db:"version"`
+	Action   string        `db:"action"`
+	Actor    uuid.NullUUID `db:"actor"`
+	Before   []byte        `db:"before"`
+	After    []byte        `db:"after"`
+	Diff     []byte        `db:"diff"`
+	CreateAt time.Time     `db:"create_at"`
+}
+
+// nodeSnapshot состояние узла в истории, без измерений
+type nodeSnapshot struct {
+	Ai          bool      `json:"ai"`
+	UziID       uuid.UUID `json:"uzi_id"`
+	Validation  *string   `json:"validation"`
+	Tirads23    float64   `json:"tirads_23"`
+	Tirads4     float64   `json:"tirads_4"`
+	Tirads5     float64   `json:"tirads_5"`
+	Description *string   `json:"description"`
+	Lobe        *string   `json:"lobe"`
+}
+
+func snapshotFromDomain(d *domain.Node) []byte {
+	if d == nil {
+		return nil
+	}
+	// сериализация плоской структуры не может завершиться ошибкой
+	raw, _ := json.Marshal(nodeSnapshot{
+		Ai:          d.Ai,
+		UziID:       d.UziID,
+		Validation:  (*string)(d.Validation),
+		Tirads23:    d.Tirads23,
+		Tirads4:     d.Tirads4,
+		Tirads5:     d.Tirads5,
+		Description: d.Description,
+		Lobe:        (*string)(d.Lobe),
+	})
+	return raw
+}
+
+func snapshotToDomain(id uuid.UUID, raw []byte) *domain.Node {
+	if raw == nil {
+		return nil
+	}
+	var s nodeSnapshot
+	_ = json.Unmarshal(raw, &s)
+
+	node := &domain.Node{
+		Id:          id,
+		Ai:          s.Ai,
+		UziID:       s.UziID,
+		Tirads23:    s.Tirads23,
+		Tirads4:     s.Tirads4,
+		Tirads5:     s.Tirads5,
+		Description: s.Description,
+	}
+	if s.Validation != nil {
+		validation, _ := domain.NodeValidation.Parse("", *s.Validation)
+		node.Validation = &validation
+	}
+	if s.Lobe != nil {
+		lobe, _ := domain.NodeLobe.Parse("", *s.Lobe)
+		node.Lobe = &lobe
+	}
+	return node
+}
+
+// FromDomain diff считается по снапшотам, поле Diff домена игнорируется
+func (NodeHistory) FromDomain(d domain.NodeVersion) NodeHistory {
+	history := NodeHistory{
+		NodeID:   d.NodeID,
+		UziID:    d.UziID,
+		Version:  d.Version,
+		Action:   d.Action.String(),
+		Before:   snapshotFromDomain(d.Before),
+		After:    snapshotFromDomain(d.After),
+		CreateAt: d.CreateAt,
+	}
+	if d.Actor != nil {
+		history.Actor = uuid.NullUUID{UUID: *d.Actor, Valid: true}
+	}
+	history.Diff, _ = json.Marshal(repoEntity.SnapshotDiff(history.Before, history.After))
+
+	return history
+}
+
+func (d NodeHistory) ToDomain() domain.NodeVersion {
+	action, _ := domain.HistoryAction.Parse("", d.Action)
+	version := domain.NodeVersion{
+		NodeID:   d.NodeID,
+		UziID:    d.UziID,
+		Version:  d.Version,
+		Action:   action,
+		Before:   snapshotToDomain(d.NodeID, d.Before),
+		After:    snapshotToDomain(d.NodeID, d.After),
+		Diff:     repoEntity.FieldChangesToDomain(d.Diff),
+		CreateAt: d.CreateAt,
+	}
+	if d.Actor.Valid {
+		version.Actor = &d.Actor.UUID
+	}
+	return version
+}
+
+func (NodeHistory) SliceToDomain(slice []NodeHistory) []domain.NodeVersion {
+	res := make([]domain.NodeVersion, 0, len(slice))
+	for _, v := range slice {
+		res = append(res, v.ToDomain())
+	}
+	return res
+}
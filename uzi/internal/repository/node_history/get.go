@@ -0,0 +1,74 @@
+package node_history
+
+import (
+	"database/sql"
+	"errors"
+
+	daoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/node_history/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func (q *repo) GetNodeHistory(nodeID uuid.UUID) ([]entity.NodeHistory, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnNodeID: nodeID,
+		}).
+		OrderBy(columnVersion + " DESC")
+
+	var history []entity.NodeHistory
+	if err := q.Runner().Selectx(q.Context(), &history, query); err != nil {
+		return nil, err
+	}
+
+	if len(history) == 0 {
+		return nil, daoEntity.ErrNotFound
+	}
+
+	return history, nil
+}
+
+func (q *repo) GetNodeHistoryByVersion(nodeID uuid.UUID, version int) (entity.NodeHistory, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnNodeID:  nodeID,
+			columnVersion: version,
+		})
+
+	var history entity.NodeHistory
+	if err := q.Runner().Getx(q.Context(), &history, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.NodeHistory{}, daoEntity.ErrNotFound
+		}
+		return entity.NodeHistory{}, err
+	}
+
+	return history, nil
+}
+
+func (q *repo) GetLastNodeHistory(nodeID uuid.UUID) (entity.NodeHistory, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnNodeID: nodeID,
+		}).
+		OrderBy(columnVersion + " DESC").
+		Limit(1)
+
+	var history entity.NodeHistory
+	if err := q.Runner().Getx(q.Context(), &history, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.NodeHistory{}, daoEntity.ErrNotFound
+		}
+		return entity.NodeHistory{}, err
+	}
+
+	return history, nil
+}
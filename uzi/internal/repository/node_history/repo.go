@@ -0,0 +1,58 @@
+package node_history
+
+import (
+	"uzi/internal/repository/node_history/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+	"github.com/google/uuid"
+)
+
+const (
+	table = "node_history"
+
+	columnNodeID   = "node_id"
+	columnUziID    = "uzi_id"
+	columnVersion  = "version"
+	columnAction   = "action"
+	columnActor    = "actor"
+	columnBefore   = "before"
+	columnAfter    = "after"
+	columnDiff     = "diff"
+	columnCreateAt = "create_at"
+)
+
+var columns = []string{
+	columnNodeID,
+	columnUziID,
+	columnVersion,
+	columnAction,
+	columnActor,
+	columnBefore,
+	columnAfter,
+	columnDiff,
+	columnCreateAt,
+}
+
+type Repository interface {
+	// InsertNodeHistory create_at проставляет БД временем транзакции
+	InsertNodeHistory(history entity.NodeHistory) error
+
+	GetNodeHistory(nodeID uuid.UUID) ([]entity.NodeHistory, error)
+	GetNodeHistoryByVersion(nodeID uuid.UUID, version int) (entity.NodeHistory, error)
+	GetLastNodeHistory(nodeID uuid.UUID) (entity.NodeHistory, error)
+
+	// DeleteNodeHistoryByUziID история удаляется только вместе с узи
+	DeleteNodeHistoryByUziID(uziID uuid.UUID) error
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
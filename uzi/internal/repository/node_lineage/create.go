@@ -0,0 +1,26 @@
+package node_lineage
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/node_lineage/entity"
+)
+
+func (q *repo) InsertNodeLineage(lineage entity.NodeLineage) error {
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(
+			columnNodeID,
+			columnLineageID,
+		).
+		Values(
+			lineage.NodeID,
+			lineage.LineageID,
+		)
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
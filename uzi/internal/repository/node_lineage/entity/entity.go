@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+)
+
+type NodeLineage struct {
+	NodeID    uuid.UUID `db:"node_id"`
+	LineageID uuid.UUID `db:"lineage_id"`
+}
+
+func (NodeLineage) FromDomain(d domain.NodeLineage) NodeLineage {
+	return NodeLineage{
+		NodeID:    d.NodeID,
+		LineageID: d.LineageID,
+	}
+}
+
+func (d NodeLineage) ToDomain() domain.NodeLineage {
+	return domain.NodeLineage{
+		NodeID:    d.NodeID,
+		LineageID: d.LineageID,
+	}
+}
+
+func (NodeLineage) SliceToDomain(slice []NodeLineage) []domain.NodeLineage {
+	res := make([]domain.NodeLineage, 0, len(slice))
+	for _, v := range slice {
+		res = append(res, v.ToDomain())
+	}
+	return res
+}
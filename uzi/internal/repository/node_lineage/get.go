@@ -0,0 +1,83 @@
+package node_lineage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	daoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/node_lineage/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func (q *repo) GetNodeLineageByNodeID(id uuid.UUID) (entity.NodeLineage, error) {
+	query := q.QueryBuilder().
+		Select(
+			columnNodeID,
+			columnLineageID,
+		).
+		From(table).
+		Where(sq.Eq{
+			columnNodeID: id,
+		})
+
+	var lineage entity.NodeLineage
+	if err := q.Runner().Getx(q.Context(), &lineage, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.NodeLineage{}, daoEntity.ErrNotFound
+		}
+		return entity.NodeLineage{}, err
+	}
+
+	return lineage, nil
+}
+
+func (q *repo) GetNodeLineagesByLineageID(id uuid.UUID) ([]entity.NodeLineage, error) {
+	query := q.QueryBuilder().
+		Select(
+			columnNodeID,
+			columnLineageID,
+		).
+		From(table).
+		Where(sq.Eq{
+			columnLineageID: id,
+		})
+
+	var lineages []entity.NodeLineage
+	if err := q.Runner().Selectx(q.Context(), &lineages, query); err != nil {
+		return nil, err
+	}
+
+	if len(lineages) == 0 {
+		return nil, daoEntity.ErrNotFound
+	}
+
+	return lineages, nil
+}
+
+func (q *repo) GetNodeLineagesByExternalID(id uuid.UUID) ([]entity.NodeLineage, error) {
+	query := q.QueryBuilder().
+		Select(
+			fmt.Sprintf("%s.%s", table, columnNodeID),
+			fmt.Sprintf("%s.%s", table, columnLineageID),
+		).
+		From(table).
+		InnerJoin("node ON node.id = node_lineage.node_id").
+		InnerJoin("uzi ON uzi.id = node.uzi_id").
+		Where(sq.Eq{
+			"uzi.external_id": id,
+		})
+
+	var lineages []entity.NodeLineage
+	if err := q.Runner().Selectx(q.Context(), &lineages, query); err != nil {
+		return nil, err
+	}
+
+	if len(lineages) == 0 {
+		return nil, daoEntity.ErrNotFound
+	}
+
+	return lineages, nil
+}
@@ -0,0 +1,37 @@
+package node_lineage
+
+import (
+	"uzi/internal/repository/node_lineage/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+	"github.com/google/uuid"
+)
+
+const (
+	table = "node_lineage"
+
+	columnNodeID    = "node_id"
+	columnLineageID = "lineage_id"
+)
+
+type Repository interface {
+	InsertNodeLineage(lineage entity.NodeLineage) error
+
+	GetNodeLineageByNodeID(id uuid.UUID) (entity.NodeLineage, error)
+	GetNodeLineagesByLineageID(id uuid.UUID) ([]entity.NodeLineage, error)
+	GetNodeLineagesByExternalID(id uuid.UUID) ([]entity.NodeLineage, error)
+
+	DeleteNodeLineageByNodeID(id uuid.UUID) error
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
@@ -0,0 +1,45 @@
+package node_review
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/node_review/entity"
+)
+
+func (q *repo) InsertNodeReviews(reviews ...entity.NodeReview) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(
+			columnID,
+			columnUziID,
+			columnKind,
+			columnActor,
+			columnValidation,
+			columnThreshold,
+			columnNodeIDs,
+			columnCreateAt,
+		)
+
+	for _, review := range reviews {
+		query = query.Values(
+			review.Id,
+			review.UziID,
+			review.Kind,
+			review.Actor,
+			review.Validation,
+			review.Threshold,
+			review.NodeIDs,
+			review.CreateAt,
+		)
+	}
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
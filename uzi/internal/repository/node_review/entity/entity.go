@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/WantBeASleep/med_ml_lib/gtc"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"uzi/internal/domain"
+)
+
+type NodeReview struct {
+	Id         uuid.UUID       `db:"id"`
+	UziID      uuid.UUID       `db:"uzi_id"`
+	Kind       string          `db:"kind"`
+	Actor      uuid.NullUUID   `db:"actor"`
+	Validation sql.NullString  `db:"validation"`
+	Threshold  sql.NullFloat64 `db:"threshold"`
+	NodeIDs    pq.StringArray  `db:"node_ids"`
+	CreateAt   time.Time       `db:"create_at"`
+}
+
+func (NodeReview) FromDomain(d domain.NodeReview) NodeReview {
+	nodeIDs := make(pq.StringArray, 0, len(d.NodeIDs))
+	for _, id := range d.NodeIDs {
+		nodeIDs = append(nodeIDs, id.String())
+	}
+
+	review := NodeReview{
+		Id:         d.Id,
+		UziID:      d.UziID,
+		Kind:       d.Kind.String(),
+		Validation: gtc.String.PointerToSql((*string)(d.Validation)),
+		NodeIDs:    nodeIDs,
+		CreateAt:   d.CreateAt,
+	}
+	if d.Actor != nil {
+		review.Actor = uuid.NullUUID{Valid: true, UUID: *d.Actor}
+	}
+	if d.Threshold != nil {
+		review.Threshold = sql.NullFloat64{Valid: true, Float64: *d.Threshold}
+	}
+
+	return review
+}
@@ -0,0 +1,36 @@
+package node_review
+
+import (
+	"uzi/internal/repository/node_review/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+)
+
+const (
+	table = "node_review"
+
+	columnID         = "id"
+	columnUziID      = "uzi_id"
+	columnKind       = "kind"
+	columnActor      = "actor"
+	columnValidation = "validation"
+	columnThreshold  = "threshold"
+	columnNodeIDs    = "node_ids"
+	columnCreateAt   = "create_at"
+)
+
+type Repository interface {
+	InsertNodeReviews(reviews ...entity.NodeReview) error
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
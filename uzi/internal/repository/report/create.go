@@ -0,0 +1,27 @@
+package report
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/report/entity"
+)
+
+func (q *repo) InsertReport(report entity.Report) error {
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(columns...).
+		Values(
+			report.Id,
+			report.UziID,
+			report.Version,
+			report.HtmlPath,
+			report.PdfPath,
+			report.CreateAt,
+		)
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+)
+
+type Report struct {
+	Id       uuid.UUID `db:"id"`
+	UziID    uuid.UUID `db:"uzi_id"`
+	Version  int       `db:"version"`
+	HtmlPath string    `db:"html_path"`
+	PdfPath  string    `db:"pdf_path"`
+	CreateAt time.Time `db:"create_at"`
+}
+
+func (Report) FromDomain(d domain.Report) Report {
+	return Report{
+		Id:       d.Id,
+		UziID:    d.UziID,
+		Version:  d.Version,
+		HtmlPath: d.HtmlPath,
+		PdfPath:  d.PdfPath,
+		CreateAt: d.CreateAt,
+	}
+}
+
+func (d Report) ToDomain() domain.Report {
+	return domain.Report{
+		Id:       d.Id,
+		UziID:    d.UziID,
+		Version:  d.Version,
+		HtmlPath: d.HtmlPath,
+		PdfPath:  d.PdfPath,
+		CreateAt: d.CreateAt,
+	}
+}
+
+func (Report) SliceToDomain(slice []Report) []domain.Report {
+	res := make([]domain.Report, 0, len(slice))
+	for _, v := range slice {
+		res = append(res, v.ToDomain())
+	}
+	return res
+}
@@ -0,0 +1,74 @@
+package report
+
+import (
+	"database/sql"
+	"errors"
+
+	daoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/report/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func (q *repo) GetReportsByUziID(uziID uuid.UUID) ([]entity.Report, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnUziID: uziID,
+		}).
+		OrderBy(columnVersion + " DESC")
+
+	var reports []entity.Report
+	if err := q.Runner().Selectx(q.Context(), &reports, query); err != nil {
+		return nil, err
+	}
+
+	if len(reports) == 0 {
+		return nil, daoEntity.ErrNotFound
+	}
+
+	return reports, nil
+}
+
+func (q *repo) GetReportByVersion(uziID uuid.UUID, version int) (entity.Report, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnUziID:   uziID,
+			columnVersion: version,
+		})
+
+	var report entity.Report
+	if err := q.Runner().Getx(q.Context(), &report, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Report{}, daoEntity.ErrNotFound
+		}
+		return entity.Report{}, err
+	}
+
+	return report, nil
+}
+
+func (q *repo) GetLastReportByUziID(uziID uuid.UUID) (entity.Report, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnUziID: uziID,
+		}).
+		OrderBy(columnVersion + " DESC").
+		Limit(1)
+
+	var report entity.Report
+	if err := q.Runner().Getx(q.Context(), &report, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Report{}, daoEntity.ErrNotFound
+		}
+		return entity.Report{}, err
+	}
+
+	return report, nil
+}
@@ -0,0 +1,48 @@
+package report
+
+import (
+	"uzi/internal/repository/report/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+	"github.com/google/uuid"
+)
+
+const (
+	table = "report"
+
+	columnID       = "id"
+	columnUziID    = "uzi_id"
+	columnVersion  = "version"
+	columnHtmlPath = "html_path"
+	columnPdfPath  = "pdf_path"
+	columnCreateAt = "create_at"
+)
+
+var columns = []string{
+	columnID,
+	columnUziID,
+	columnVersion,
+	columnHtmlPath,
+	columnPdfPath,
+	columnCreateAt,
+}
+
+type Repository interface {
+	InsertReport(report entity.Report) error
+
+	GetReportsByUziID(uziID uuid.UUID) ([]entity.Report, error)
+	GetReportByVersion(uziID uuid.UUID, version int) (entity.Report, error)
+	GetLastReportByUziID(uziID uuid.UUID) (entity.Report, error)
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
@@ -17,6 +17,15 @@ func (q *repo) InsertSegments(segments ...entity.Segment) error {
 			columnTirads23,
 			columnTirads4,
 			columnTirads5,
+			columnBBoxX,
+			columnBBoxY,
+			columnBBoxWidth,
+			columnBBoxHeight,
+			columnArea,
+			columnPerimeter,
+			columnMajorAxis,
+			columnMinorAxis,
+			columnMeasureUnit,
 		)
 
 	for _, v := range segments {
@@ -29,6 +38,15 @@ func (q *repo) InsertSegments(segments ...entity.Segment) error {
 			v.Tirads23,
 			v.Tirads4,
 			v.Tirads5,
+			v.BBoxX,
+			v.BBoxY,
+			v.BBoxWidth,
+			v.BBoxHeight,
+			v.Area,
+			v.Perimeter,
+			v.MajorAxis,
+			v.MinorAxis,
+			v.MeasureUnit,
 		)
 	}
 	_, err := q.Runner().Execx(q.Context(), query)
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"database/sql"
 	"encoding/json"
 
 	"uzi/internal/domain"
@@ -17,10 +18,20 @@ type Segment struct {
 	Tirads23 float64         `db:"tirads_23"`
 	Tirads4  float64         `db:"tirads_4"`
 	Tirads5  float64         `db:"tirads_5"`
+
+	BBoxX       sql.NullInt64   `db:"bbox_x"`
+	BBoxY       sql.NullInt64   `db:"bbox_y"`
+	BBoxWidth   sql.NullInt64   `db:"bbox_width"`
+	BBoxHeight  sql.NullInt64   `db:"bbox_height"`
+	Area        sql.NullFloat64 `db:"area"`
+	Perimeter   sql.NullFloat64 `db:"perimeter"`
+	MajorAxis   sql.NullFloat64 `db:"major_axis"`
+	MinorAxis   sql.NullFloat64 `db:"minor_axis"`
+	MeasureUnit sql.NullString  `db:"measure_unit"`
 }
 
 func (Segment) FromDomain(d domain.Segment) Segment {
-	return Segment{
+	segment := Segment{
 		Id:       d.Id,
 		ImageID:  d.ImageID,
 		NodeID:   d.NodeID,
@@ -30,6 +41,20 @@ func (Segment) FromDomain(d domain.Segment) Segment {
 		Tirads4:  d.Tirads4,
 		Tirads5:  d.Tirads5,
 	}
+
+	if m := d.Measurement; m != nil {
+		segment.BBoxX = sql.NullInt64{Valid: true, Int64: int64(m.BBox.X)}
+		segment.BBoxY = sql.NullInt64{Valid: true, Int64: int64(m.BBox.Y)}
+		segment.BBoxWidth = sql.NullInt64{Valid: true, Int64: int64(m.BBox.Width)}
+		segment.BBoxHeight = sql.NullInt64{Valid: true, Int64: int64(m.BBox.Height)}
+		segment.Area = sql.NullFloat64{Valid: true, Float64: m.Area}
+		segment.Perimeter = sql.NullFloat64{Valid: true, Float64: m.Perimeter}
+		segment.MajorAxis = sql.NullFloat64{Valid: true, Float64: m.MajorAxis}
+		segment.MinorAxis = sql.NullFloat64{Valid: true, Float64: m.MinorAxis}
+		segment.MeasureUnit = sql.NullString{Valid: true, String: m.Unit.String()}
+	}
+
+	return segment
 }
 
 func (Segment) SliceFromDomain(slice []domain.Segment) []Segment {
@@ -41,7 +66,7 @@ func (Segment) SliceFromDomain(slice []domain.Segment) []Segment {
 }
 
 func (d Segment) ToDomain() domain.Segment {
-	return domain.Segment{
+	segment := domain.Segment{
 		Id:       d.Id,
 		ImageID:  d.ImageID,
 		NodeID:   d.NodeID,
@@ -51,6 +76,26 @@ func (d Segment) ToDomain() domain.Segment {
 		Tirads4:  d.Tirads4,
 		Tirads5:  d.Tirads5,
 	}
+
+	// измерения пишутся целиком, достаточно проверить единицу измерения
+	if d.MeasureUnit.Valid {
+		unit, _ := domain.MeasureUnit.Parse("", d.MeasureUnit.String)
+		segment.Measurement = &domain.SegmentMeasurement{
+			BBox: domain.BoundingBox{
+				X:      int(d.BBoxX.Int64),
+				Y:      int(d.BBoxY.Int64),
+				Width:  int(d.BBoxWidth.Int64),
+				Height: int(d.BBoxHeight.Int64),
+			},
+			Area:      d.Area.Float64,
+			Perimeter: d.Perimeter.Float64,
+			MajorAxis: d.MajorAxis.Float64,
+			MinorAxis: d.MinorAxis.Float64,
+			Unit:      unit,
+		}
+	}
+
+	return segment
 }
 
 func (Segment) SliceToDomain(slice []Segment) []domain.Segment {
@@ -22,6 +22,15 @@ func (q *repo) GetSegmentByID(id uuid.UUID) (entity.Segment, error) {
 			columnTirads23,
 			columnTirads4,
 			columnTirads5,
+			columnBBoxX,
+			columnBBoxY,
+			columnBBoxWidth,
+			columnBBoxHeight,
+			columnArea,
+			columnPerimeter,
+			columnMajorAxis,
+			columnMinorAxis,
+			columnMeasureUnit,
 		).
 		From(table).
 		Where(sq.Eq{
@@ -50,6 +59,15 @@ func (q *repo) GetSegmentsByNodeID(id uuid.UUID) ([]entity.Segment, error) {
 			columnTirads23,
 			columnTirads4,
 			columnTirads5,
+			columnBBoxX,
+			columnBBoxY,
+			columnBBoxWidth,
+			columnBBoxHeight,
+			columnArea,
+			columnPerimeter,
+			columnMajorAxis,
+			columnMinorAxis,
+			columnMeasureUnit,
 		).
 		From(table).
 		Where(sq.Eq{
@@ -79,6 +97,15 @@ func (q *repo) GetSegmentsByImageID(id uuid.UUID) ([]entity.Segment, error) {
 			columnTirads23,
 			columnTirads4,
 			columnTirads5,
+			columnBBoxX,
+			columnBBoxY,
+			columnBBoxWidth,
+			columnBBoxHeight,
+			columnArea,
+			columnPerimeter,
+			columnMajorAxis,
+			columnMinorAxis,
+			columnMeasureUnit,
 		).
 		From(table).
 		Where(sq.Eq{
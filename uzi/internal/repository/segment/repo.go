@@ -18,6 +18,16 @@ const (
 	columnTirads23 = "tirads_23"
 	columnTirads4  = "tirads_4"
 	columnTirads5  = "tirads_5"
+
+	columnBBoxX       = "bbox_x"
+	columnBBoxY       = "bbox_y"
+	columnBBoxWidth   = "bbox_width"
+	columnBBoxHeight  = "bbox_height"
+	columnArea        = "area"
+	columnPerimeter   = "perimeter"
+	columnMajorAxis   = "major_axis"
+	columnMinorAxis   = "minor_axis"
+	columnMeasureUnit = "measure_unit"
 )
 
 type Repository interface {
@@ -28,6 +38,8 @@ type Repository interface {
 	GetSegmentsByImageID(id uuid.UUID) ([]entity.Segment, error)
 
 	UpdateSegment(segment entity.Segment) error
+	// UpdateSegmentsNodeID переносит сегменты в другой узел
+	UpdateSegmentsNodeID(ids []uuid.UUID, nodeID uuid.UUID) error
 
 	DeleteSegmentByID(id uuid.UUID) error
 	DeleteSegmentsByUziID(id uuid.UUID) error
@@ -5,16 +5,26 @@ import (
 	"uzi/internal/repository/segment/entity"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 )
 
 func (q *repo) UpdateSegment(segment entity.Segment) error {
 	query := q.QueryBuilder().
 		Update(table).
 		SetMap(sq.Eq{
-			columnContor:   segment.Contor,
-			columnTirads23: segment.Tirads23,
-			columnTirads4:  segment.Tirads4,
-			columnTirads5:  segment.Tirads5,
+			columnContor:      segment.Contor,
+			columnTirads23:    segment.Tirads23,
+			columnTirads4:     segment.Tirads4,
+			columnTirads5:     segment.Tirads5,
+			columnBBoxX:       segment.BBoxX,
+			columnBBoxY:       segment.BBoxY,
+			columnBBoxWidth:   segment.BBoxWidth,
+			columnBBoxHeight:  segment.BBoxHeight,
+			columnArea:        segment.Area,
+			columnPerimeter:   segment.Perimeter,
+			columnMajorAxis:   segment.MajorAxis,
+			columnMinorAxis:   segment.MinorAxis,
+			columnMeasureUnit: segment.MeasureUnit,
 		}).
 		Where(sq.Eq{
 			columnID: segment.Id,
@@ -27,3 +37,19 @@ func (q *repo) UpdateSegment(segment entity.Segment) error {
 
 	return nil
 }
+
+func (q *repo) UpdateSegmentsNodeID(ids []uuid.UUID, nodeID uuid.UUID) error {
+	query := q.QueryBuilder().
+		Update(table).
+		Set(columnNodeID, nodeID).
+		Where(sq.Eq{
+			columnID: ids,
+		})
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
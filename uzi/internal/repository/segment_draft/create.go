@@ -0,0 +1,59 @@
+package segment_draft
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/segment_draft/entity"
+)
+
+func (q *repo) InsertSegmentDrafts(drafts ...entity.SegmentDraft) error {
+	if len(drafts) == 0 {
+		return nil
+	}
+
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(
+			columnID,
+			columnNodeID,
+			columnImageID,
+			columnSourceSegmentID,
+			columnContor,
+			columnTirads23,
+			columnTirads4,
+			columnTirads5,
+			columnScore,
+			columnCreateAt,
+		)
+
+	for _, draft := range drafts {
+		query = query.Values(
+			draft.Id,
+			draft.NodeID,
+			draft.ImageID,
+			draft.SourceSegmentID,
+			draft.Contor,
+			draft.Tirads23,
+			draft.Tirads4,
+			draft.Tirads5,
+			draft.Score,
+			draft.CreateAt,
+		)
+	}
+
+	query = query.Suffix(`ON CONFLICT (node_id, image_id) DO UPDATE SET
+		id = EXCLUDED.id,
+		source_segment_id = EXCLUDED.source_segment_id,
+		contor = EXCLUDED.contor,
+		tirads_23 = EXCLUDED.tirads_23,
+		tirads_4 = EXCLUDED.tirads_4,
+		tirads_5 = EXCLUDED.tirads_5,
+		score = EXCLUDED.score,
+		create_at = EXCLUDED.create_at`)
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package segment_draft
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func (q *repo) DeleteSegmentDraftByID(id uuid.UUID) error {
+	query := q.QueryBuilder().
+		Delete(table).
+		Where(sq.Eq{
+			columnID: id,
+		})
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
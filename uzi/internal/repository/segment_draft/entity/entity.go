@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+)
+
+type SegmentDraft struct {
+	Id              uuid.UUID       `db:"id"`
+	NodeID          uuid.UUID       `db:"node_id"`
+	ImageID         uuid.UUID       `db:"image_id"`
+	SourceSegmentID uuid.UUID       `db:"source_segment_id"`
+	Contor          json.RawMessage `db:"contor"`
+	Tirads23        float64         `db:"tirads_23"`
+	Tirads4         float64         `db:"tirads_4"`
+	Tirads5         float64         `db:"tirads_5"`
+	Score           float64         `db:"score"`
+	CreateAt        time.Time       `db:"create_at"`
+}
+
+func (SegmentDraft) FromDomain(d domain.SegmentDraft) SegmentDraft {
+	return SegmentDraft{
+		Id:              d.Id,
+		NodeID:          d.NodeID,
+		ImageID:         d.ImageID,
+		SourceSegmentID: d.SourceSegmentID,
+		Contor:          d.Contor,
+		Tirads23:        d.Tirads23,
+		Tirads4:         d.Tirads4,
+		Tirads5:         d.Tirads5,
+		Score:           d.Score,
+		CreateAt:        d.CreateAt,
+	}
+}
+
+func (d SegmentDraft) ToDomain() domain.SegmentDraft {
+	return domain.SegmentDraft{
+		Id:              d.Id,
+		NodeID:          d.NodeID,
+		ImageID:         d.ImageID,
+		SourceSegmentID: d.SourceSegmentID,
+		Contor:          d.Contor,
+		Tirads23:        d.Tirads23,
+		Tirads4:         d.Tirads4,
+		Tirads5:         d.Tirads5,
+		Score:           d.Score,
+		CreateAt:        d.CreateAt,
+	}
+}
+
+func (SegmentDraft) SliceToDomain(drafts []SegmentDraft) []domain.SegmentDraft {
+	res := make([]domain.SegmentDraft, 0, len(drafts))
+	for _, v := range drafts {
+		res = append(res, v.ToDomain())
+	}
+	return res
+}
@@ -0,0 +1,61 @@
+package segment_draft
+
+import (
+	"database/sql"
+	"errors"
+
+	daoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/segment_draft/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+var columns = []string{
+	columnID,
+	columnNodeID,
+	columnImageID,
+	columnSourceSegmentID,
+	columnContor,
+	columnTirads23,
+	columnTirads4,
+	columnTirads5,
+	columnScore,
+	columnCreateAt,
+}
+
+func (q *repo) GetSegmentDraftByID(id uuid.UUID) (entity.SegmentDraft, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnID: id,
+		})
+
+	var draft entity.SegmentDraft
+	if err := q.Runner().Getx(q.Context(), &draft, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.SegmentDraft{}, daoEntity.ErrNotFound
+		}
+		return entity.SegmentDraft{}, err
+	}
+
+	return draft, nil
+}
+
+func (q *repo) GetSegmentDraftsByNodeID(nodeID uuid.UUID) ([]entity.SegmentDraft, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnNodeID: nodeID,
+		}).
+		OrderBy(columnCreateAt, columnID)
+
+	var drafts []entity.SegmentDraft
+	if err := q.Runner().Selectx(q.Context(), &drafts, query); err != nil {
+		return nil, err
+	}
+
+	return drafts, nil
+}
@@ -0,0 +1,45 @@
+package segment_draft
+
+import (
+	"uzi/internal/repository/segment_draft/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+	"github.com/google/uuid"
+)
+
+const (
+	table = "segment_draft"
+
+	columnID              = "id"
+	columnNodeID          = "node_id"
+	columnImageID         = "image_id"
+	columnSourceSegmentID = "source_segment_id"
+	columnContor          = "contor"
+	columnTirads23        = "tirads_23"
+	columnTirads4         = "tirads_4"
+	columnTirads5         = "tirads_5"
+	columnScore           = "score"
+	columnCreateAt        = "create_at"
+)
+
+type Repository interface {
+	// InsertSegmentDrafts заменяет прежние черновики узла на тех же кадрах
+	InsertSegmentDrafts(drafts ...entity.SegmentDraft) error
+
+	GetSegmentDraftByID(id uuid.UUID) (entity.SegmentDraft, error)
+	GetSegmentDraftsByNodeID(nodeID uuid.UUID) ([]entity.SegmentDraft, error)
+
+	DeleteSegmentDraftByID(id uuid.UUID) error
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
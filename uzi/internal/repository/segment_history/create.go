@@ -0,0 +1,29 @@
+package segment_history
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/segment_history/entity"
+)
+
+func (q *repo) InsertSegmentHistory(history entity.SegmentHistory) error {
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(columns[:len(columns)-1]...).
+		Values(
+			history.SegmentID,
+			history.NodeID,
+			history.Version,
+			history.Action,
+			history.Actor,
+			repoEntity.NullJSON(history.Before),
+			repoEntity.NullJSON(history.After),
+			history.Diff,
+		)
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package segment_history
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	repoEntity "uzi/internal/repository/entity"
+)
+
+func (q *repo) DeleteSegmentHistoryByUziID(uziID uuid.UUID) error {
+	// у сегментов нет uzi_id: узлы берутся из текущих узлов узи и из истории уже удаленных
+	query := q.QueryBuilder().
+		Delete(table).
+		Where(sq.Expr(
+			columnNodeID+" IN (SELECT id FROM node WHERE uzi_id = ? UNION SELECT node_id FROM node_history WHERE uzi_id = ?)",
+			uziID, uziID,
+		))
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	repoEntity "uzi/internal/repository/entity"
+)
+
+type SegmentHistory struct {
+	SegmentID uuid.UUID     `db:"segment_id"`
+	NodeID    uuid.UUID     `db:"node_id"`
+	Version   int           `db:"version"`
+	Action    string        `db:"action"`
+	Actor     uuid.NullUUID `db:"actor"`
+	Before    []byte        `db:"before"`
+	After     []byte        `db:"after"`
+	Diff      []byte        `db:"diff"`
+	CreateAt  time.Time     `db:"create_at"`
+}
+
+// segmentSnapshot состояние сегмента в истории, без измерений
+type segmentSnapshot struct {
+	ImageID  uuid.UUID       `json:"image_id"`
+	NodeID   uuid.UUID       `json:"node_id"`
+	Contor   json.RawMessage `json:"contor"`
+	Ai       bool            `json:"ai"`
+	Tirads23 float64         `json:"tirads_23"`
+	Tirads4  float64         `json:"tirads_4"`
+	Tirads5  float64         `json:"tirads_5"`
+}
+
+func snapshotFromDomain(d *domain.Segment) []byte {
+	if d == nil {
+		return nil
+	}
+	contor := d.Contor
+	// битый контур сохраняем строкой, чтобы не потерять снапшот целиком
+	if !json.Valid(contor) {
+		contor, _ = json.Marshal(string(d.Contor))
+	}
+	raw, _ := json.Marshal(segmentSnapshot{
+		ImageID:  d.ImageID,
+		NodeID:   d.NodeID,
+		Contor:   contor,
+		Ai:       d.Ai,
+		Tirads23: d.Tirads23,
+		Tirads4:  d.Tirads4,
+		Tirads5:  d.Tirads5,
+	})
+	return raw
+}
+
+func snapshotToDomain(id uuid.UUID, raw []byte) *domain.Segment {
+	if raw == nil {
+		return nil
+	}
+	var s segmentSnapshot
+	_ = json.Unmarshal(raw, &s)
+
+	return &domain.Segment{
+		Id:       id,
+		ImageID:  s.ImageID,
+		NodeID:   s.NodeID,
+		Contor:   s.Contor,
+		Ai:       s.Ai,
+		Tirads23: s.Tirads23,
+		Tirads4:  s.Tirads4,
+		Tirads5:  s.Tirads5,
+	}
+}
+
+// FromDomain diff считается по снапшотам, поле Diff домена игнорируется
+func (SegmentHistory) FromDomain(d domain.SegmentVersion) SegmentHistory {
+	history := SegmentHistory{
+		SegmentID: d.SegmentID,
+		NodeID:    d.NodeID,
+		Version:   d.Version,
+		Action:    d.Action.String(),
+		Before:    snapshotFromDomain(d.Before),
+		After:     snapshotFromDomain(d.After),
+		CreateAt:  d.CreateAt,
+	}
+	if d.Actor != nil {
+		history.Actor = uuid.NullUUID{UUID: *d.Actor, Valid: true}
+	}
+	history.Diff, _ = json.Marshal(repoEntity.SnapshotDiff(history.Before, history.After))
+
+	return history
+}
+
+func (d SegmentHistory) ToDomain() domain.SegmentVersion {
+	action, _ := domain.HistoryAction.Parse("", d.Action)
+	version := domain.SegmentVersion{
+		SegmentID: d.SegmentID,
+		NodeID:    d.NodeID,
+		Version:   d.Version,
+		Action:    action,
+		Before:    snapshotToDomain(d.SegmentID, d.Before),
+		After:     snapshotToDomain(d.SegmentID, d.After),
+		Diff:      repoEntity.FieldChangesToDomain(d.Diff),
+		CreateAt:  d.CreateAt,
+	}
+	if d.Actor.Valid {
+		version.Actor = &d.Actor.UUID
+	}
+	return version
+}
+
+func (SegmentHistory) SliceToDomain(slice []SegmentHistory) []domain.SegmentVersion {
+	res := make([]domain.SegmentVersion, 0, len(slice))
+	for _, v := range slice {
+		res = append(res, v.ToDomain())
+	}
+	return res
+}
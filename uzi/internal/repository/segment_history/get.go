@@ -0,0 +1,93 @@
+package segment_history
+
+import (
+	"database/sql"
+	"errors"
+
+	daoEntity "uzi/internal/repository/entity"
+	"uzi/internal/repository/segment_history/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func (q *repo) GetSegmentHistory(segmentID uuid.UUID) ([]entity.SegmentHistory, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnSegmentID: segmentID,
+		}).
+		OrderBy(columnVersion + " DESC")
+
+	var history []entity.SegmentHistory
+	if err := q.Runner().Selectx(q.Context(), &history, query); err != nil {
+		return nil, err
+	}
+
+	if len(history) == 0 {
+		return nil, daoEntity.ErrNotFound
+	}
+
+	return history, nil
+}
+
+func (q *repo) GetSegmentHistoryByVersion(segmentID uuid.UUID, version int) (entity.SegmentHistory, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnSegmentID: segmentID,
+			columnVersion:   version,
+		})
+
+	var history entity.SegmentHistory
+	if err := q.Runner().Getx(q.Context(), &history, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.SegmentHistory{}, daoEntity.ErrNotFound
+		}
+		return entity.SegmentHistory{}, err
+	}
+
+	return history, nil
+}
+
+func (q *repo) GetLastSegmentHistory(segmentID uuid.UUID) (entity.SegmentHistory, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.Eq{
+			columnSegmentID: segmentID,
+		}).
+		OrderBy(columnVersion + " DESC").
+		Limit(1)
+
+	var history entity.SegmentHistory
+	if err := q.Runner().Getx(q.Context(), &history, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.SegmentHistory{}, daoEntity.ErrNotFound
+		}
+		return entity.SegmentHistory{}, err
+	}
+
+	return history, nil
+}
+
+// GetLastSegmentHistoriesByNodeID последняя версия каждого сегмента, когда-либо принадлежавшего узлу
+func (q *repo) GetLastSegmentHistoriesByNodeID(nodeID uuid.UUID) ([]entity.SegmentHistory, error) {
+	query := q.QueryBuilder().
+		Select(columns...).
+		Options("DISTINCT ON ("+columnSegmentID+")").
+		From(table).
+		Where(sq.Eq{
+			columnNodeID: nodeID,
+		}).
+		OrderBy(columnSegmentID, columnVersion+" DESC")
+
+	var history []entity.SegmentHistory
+	if err := q.Runner().Selectx(q.Context(), &history, query); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
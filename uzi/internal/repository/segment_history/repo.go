@@ -0,0 +1,59 @@
+package segment_history
+
+import (
+	"uzi/internal/repository/segment_history/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+	"github.com/google/uuid"
+)
+
+const (
+	table = "segment_history"
+
+	columnSegmentID = "segment_id"
+	columnNodeID    = "node_id"
+	columnVersion   = "version"
+	columnAction    = "action"
+	columnActor     = "actor"
+	columnBefore    = "before"
+	columnAfter     = "after"
+	columnDiff      = "diff"
+	columnCreateAt  = "create_at"
+)
+
+var columns = []string{
+	columnSegmentID,
+	columnNodeID,
+	columnVersion,
+	columnAction,
+	columnActor,
+	columnBefore,
+	columnAfter,
+	columnDiff,
+	columnCreateAt,
+}
+
+type Repository interface {
+	// InsertSegmentHistory create_at проставляет БД временем транзакции
+	InsertSegmentHistory(history entity.SegmentHistory) error
+
+	GetSegmentHistory(segmentID uuid.UUID) ([]entity.SegmentHistory, error)
+	GetSegmentHistoryByVersion(segmentID uuid.UUID, version int) (entity.SegmentHistory, error)
+	GetLastSegmentHistory(segmentID uuid.UUID) (entity.SegmentHistory, error)
+	GetLastSegmentHistoriesByNodeID(nodeID uuid.UUID) ([]entity.SegmentHistory, error)
+
+	// DeleteSegmentHistoryByUziID история сегментов узлов узи, вызывать до удаления истории узлов
+	DeleteSegmentHistoryByUziID(uziID uuid.UUID) error
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
@@ -0,0 +1,23 @@
+package storage_cleanup
+
+import (
+	repoEntity "uzi/internal/repository/entity"
+)
+
+func (q *repo) InsertCleanupJobs(prefixes ...string) error {
+	query := q.QueryBuilder().
+		Insert(table).
+		Columns(columnPrefix)
+
+	for _, prefix := range prefixes {
+		query = query.Values(prefix)
+	}
+	query = query.Suffix("ON CONFLICT (" + columnPrefix + ") DO NOTHING")
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
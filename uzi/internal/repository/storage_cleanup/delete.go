@@ -0,0 +1,20 @@
+package storage_cleanup
+
+import (
+	sq "github.com/Masterminds/squirrel"
+
+	repoEntity "uzi/internal/repository/entity"
+)
+
+func (q *repo) DeleteCleanupJob(prefix string) error {
+	query := q.QueryBuilder().
+		Delete(table).
+		Where(sq.Eq{columnPrefix: prefix})
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/WantBeASleep/med_ml_lib/gtc"
+
+	"uzi/internal/domain"
+)
+
+type CleanupJob struct {
+	Prefix        string         `db:"prefix"`
+	Attempts      int            `db:"attempts"`
+	LastError     sql.NullString `db:"last_error"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+	CreateAt      time.Time      `db:"create_at"`
+}
+
+func (d CleanupJob) ToDomain() domain.CleanupJob {
+	return domain.CleanupJob{
+		Prefix:        d.Prefix,
+		Attempts:      d.Attempts,
+		LastError:     gtc.String.SqlToPointer(d.LastError),
+		NextAttemptAt: d.NextAttemptAt,
+		CreateAt:      d.CreateAt,
+	}
+}
+
+func (CleanupJob) SliceToDomain(jobs []CleanupJob) []domain.CleanupJob {
+	res := make([]domain.CleanupJob, 0, len(jobs))
+	for _, v := range jobs {
+		res = append(res, v.ToDomain())
+	}
+	return res
+}
@@ -0,0 +1,27 @@
+package storage_cleanup
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"uzi/internal/repository/storage_cleanup/entity"
+)
+
+func (q *repo) GetDueCleanupJobs(now time.Time, limit int) ([]entity.CleanupJob, error) {
+	// SKIP LOCKED позволяет нескольким репликам разбирать очередь параллельно
+	query := q.QueryBuilder().
+		Select(columns...).
+		From(table).
+		Where(sq.LtOrEq{columnNextAttemptAt: now}).
+		OrderBy(columnNextAttemptAt).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	var jobs []entity.CleanupJob
+	if err := q.Runner().Selectx(q.Context(), &jobs, query); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
@@ -0,0 +1,53 @@
+package storage_cleanup
+
+import (
+	"time"
+
+	"uzi/internal/repository/storage_cleanup/entity"
+
+	daolib "github.com/WantBeASleep/med_ml_lib/dao"
+)
+
+const (
+	table = "storage_cleanup"
+
+	columnPrefix        = "prefix"
+	columnAttempts      = "attempts"
+	columnLastError     = "last_error"
+	columnNextAttemptAt = "next_attempt_at"
+	columnCreateAt      = "create_at"
+)
+
+var columns = []string{
+	columnPrefix,
+	columnAttempts,
+	columnLastError,
+	columnNextAttemptAt,
+	columnCreateAt,
+}
+
+type Repository interface {
+	// InsertCleanupJobs уже поставленные префиксы пропускаются
+	InsertCleanupJobs(prefixes ...string) error
+
+	// GetDueCleanupJobs задачи, время попытки которых наступило, блокируются до конца транзакции
+	GetDueCleanupJobs(now time.Time, limit int) ([]entity.CleanupJob, error)
+
+	UpdateCleanupJobFailure(prefix string, lastError string, nextAttemptAt time.Time) error
+	// PostponeCleanupJobs откладывает задачи, взятые в работу, чтобы их не взяли другие реплики
+	PostponeCleanupJobs(prefixes []string, nextAttemptAt time.Time) error
+
+	DeleteCleanupJob(prefix string) error
+}
+
+type repo struct {
+	*daolib.BaseQuery
+}
+
+func NewRepo() *repo {
+	return &repo{}
+}
+
+func (q *repo) SetBaseQuery(baseQuery *daolib.BaseQuery) {
+	q.BaseQuery = baseQuery
+}
@@ -0,0 +1,39 @@
+package storage_cleanup
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	repoEntity "uzi/internal/repository/entity"
+)
+
+func (q *repo) UpdateCleanupJobFailure(prefix string, lastError string, nextAttemptAt time.Time) error {
+	query := q.QueryBuilder().
+		Update(table).
+		Set(columnAttempts, sq.Expr(columnAttempts+" + 1")).
+		Set(columnLastError, lastError).
+		Set(columnNextAttemptAt, nextAttemptAt).
+		Where(sq.Eq{columnPrefix: prefix})
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
+
+func (q *repo) PostponeCleanupJobs(prefixes []string, nextAttemptAt time.Time) error {
+	query := q.QueryBuilder().
+		Update(table).
+		Set(columnNextAttemptAt, nextAttemptAt).
+		Where(sq.Eq{columnPrefix: prefixes})
+
+	_, err := q.Runner().Execx(q.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return nil
+}
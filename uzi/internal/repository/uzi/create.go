@@ -18,6 +18,9 @@ func (q *repo) InsertUzi(uzi entity.Uzi) error {
 			columnStatus,
 			columnDescription,
 			columnCreateAt,
+			columnPixelSpacingX,
+			columnPixelSpacingY,
+			columnSha256,
 		).
 		Values(
 			uzi.Id,
@@ -29,6 +32,9 @@ func (q *repo) InsertUzi(uzi entity.Uzi) error {
 			uzi.Status,
 			uzi.Description,
 			uzi.CreateAt,
+			uzi.PixelSpacingX,
+			uzi.PixelSpacingY,
+			uzi.Sha256,
 		)
 
 	_, err := q.Runner().Execx(q.Context(), query)
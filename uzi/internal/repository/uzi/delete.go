@@ -1,6 +1,8 @@
 package uzi
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	sq "github.com/Masterminds/squirrel"
@@ -15,10 +17,82 @@ func (r *repo) DeleteUzi(id uuid.UUID) error {
 			columnID: id,
 		})
 
-	_, err := r.Runner().Execx(r.Context(), query)
+	res, err := r.Runner().Execx(r.Context(), query)
 	if err != nil {
 		return repoEntity.WrapDBError(err)
 	}
 
+	return affectedOrNotFound(res.RowsAffected())
+}
+
+func (r *repo) SoftDeleteUzi(id uuid.UUID) error {
+	query := r.QueryBuilder().
+		Update(table).
+		Set(columnDeleteAt, sq.Expr("now()")).
+		Where(sq.Eq{
+			columnID:       id,
+			columnDeleteAt: nil,
+		})
+
+	res, err := r.Runner().Execx(r.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return affectedOrNotFound(res.RowsAffected())
+}
+
+func (r *repo) RestoreUzi(id uuid.UUID) error {
+	query := r.QueryBuilder().
+		Update(table).
+		Set(columnDeleteAt, nil).
+		Where(sq.And{
+			sq.Eq{columnID: id},
+			sq.NotEq{columnDeleteAt: nil},
+		})
+
+	res, err := r.Runner().Execx(r.Context(), query)
+	if err != nil {
+		return repoEntity.WrapDBError(err)
+	}
+
+	return affectedOrNotFound(res.RowsAffected())
+}
+
+func (r *repo) GetUziIDsDeletedBefore(before time.Time) ([]uuid.UUID, error) {
+	query := r.QueryBuilder().
+		Select(columnID).
+		From(table).
+		Where(sq.Lt{columnDeleteAt: before})
+
+	var ids []uuid.UUID
+	if err := r.Runner().Selectx(r.Context(), &ids, query); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *repo) GetExistingUziIDs(ids []uuid.UUID) ([]uuid.UUID, error) {
+	query := r.QueryBuilder().
+		Select(columnID).
+		From(table).
+		Where(sq.Eq{columnID: ids})
+
+	var existing []uuid.UUID
+	if err := r.Runner().Selectx(r.Context(), &existing, query); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+func affectedOrNotFound(affected int64, err error) error {
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repoEntity.ErrNotFound
+	}
 	return nil
 }
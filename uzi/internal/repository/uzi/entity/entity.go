@@ -20,10 +20,14 @@ type Uzi struct {
 	Status      string         `db:"status"`
 	Description sql.NullString `db:"description"`
 	CreateAt    time.Time      `db:"create_at"`
+
+	PixelSpacingX sql.NullFloat64 `db:"pixel_spacing_x"`
+	PixelSpacingY sql.NullFloat64 `db:"pixel_spacing_y"`
+	Sha256        sql.NullString  `db:"sha256"`
 }
 
 func (Uzi) FromDomain(d domain.Uzi) Uzi {
-	return Uzi{
+	uzi := Uzi{
 		Id:          d.Id,
 		Projection:  d.Projection.String(),
 		Checked:     d.Checked,
@@ -33,7 +37,15 @@ func (Uzi) FromDomain(d domain.Uzi) Uzi {
 		Status:      d.Status.String(),
 		Description: gtc.String.PointerToSql(d.Description),
 		CreateAt:    d.CreateAt,
+		Sha256:      gtc.String.PointerToSql(d.Sha256),
+	}
+
+	if d.PixelSpacing != nil {
+		uzi.PixelSpacingX = sql.NullFloat64{Valid: true, Float64: d.PixelSpacing.X}
+		uzi.PixelSpacingY = sql.NullFloat64{Valid: true, Float64: d.PixelSpacing.Y}
 	}
+
+	return uzi
 }
 
 func (d Uzi) ToDomain() domain.Uzi {
@@ -42,7 +54,7 @@ func (d Uzi) ToDomain() domain.Uzi {
 	status, _ := domain.UziStatus.Parse("", d.Status)
 	projection, _ := domain.UziProjection.Parse("", d.Projection)
 
-	return domain.Uzi{
+	uzi := domain.Uzi{
 		Id:          d.Id,
 		Projection:  projection,
 		Checked:     d.Checked,
@@ -52,7 +64,17 @@ func (d Uzi) ToDomain() domain.Uzi {
 		Status:      status,
 		Description: gtc.String.SqlToPointer(d.Description),
 		CreateAt:    d.CreateAt,
+		Sha256:      gtc.String.SqlToPointer(d.Sha256),
 	}
+
+	if d.PixelSpacingX.Valid && d.PixelSpacingY.Valid {
+		uzi.PixelSpacing = &domain.PixelSpacing{
+			X: d.PixelSpacingX.Float64,
+			Y: d.PixelSpacingY.Float64,
+		}
+	}
+
+	return uzi
 }
 
 func (Uzi) SliceToDomain(uzis []Uzi) []domain.Uzi {
@@ -23,10 +23,14 @@ func (q *repo) GetUziByID(id uuid.UUID) (entity.Uzi, error) {
 			columnStatus,
 			columnDescription,
 			columnCreateAt,
+			columnPixelSpacingX,
+			columnPixelSpacingY,
+			columnSha256,
 		).
 		From(table).
 		Where(sq.Eq{
-			columnID: id,
+			columnID:       id,
+			columnDeleteAt: nil,
 		})
 
 	var uzi entity.Uzi
@@ -40,6 +44,40 @@ func (q *repo) GetUziByID(id uuid.UUID) (entity.Uzi, error) {
 	return uzi, nil
 }
 
+func (q *repo) GetUzisByIDs(ids []uuid.UUID) ([]entity.Uzi, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := q.QueryBuilder().
+		Select(
+			columnID,
+			columnProjection,
+			columnChecked,
+			columnExternalID,
+			columnAuthor,
+			columnDeviceID,
+			columnStatus,
+			columnDescription,
+			columnCreateAt,
+			columnPixelSpacingX,
+			columnPixelSpacingY,
+			columnSha256,
+		).
+		From(table).
+		Where(sq.Eq{
+			columnID:       ids,
+			columnDeleteAt: nil,
+		})
+
+	var uzis []entity.Uzi
+	if err := q.Runner().Selectx(q.Context(), &uzis, query); err != nil {
+		return nil, err
+	}
+
+	return uzis, nil
+}
+
 func (q *repo) GetUzisByExternalID(externalID uuid.UUID) ([]entity.Uzi, error) {
 	query := q.QueryBuilder().
 		Select(
@@ -52,10 +90,14 @@ func (q *repo) GetUzisByExternalID(externalID uuid.UUID) ([]entity.Uzi, error) {
 			columnStatus,
 			columnDescription,
 			columnCreateAt,
+			columnPixelSpacingX,
+			columnPixelSpacingY,
+			columnSha256,
 		).
 		From(table).
 		Where(sq.Eq{
 			columnExternalID: externalID,
+			columnDeleteAt:   nil,
 		})
 
 	var uzi []entity.Uzi
@@ -82,10 +124,14 @@ func (q *repo) GetUzisByAuthor(author uuid.UUID) ([]entity.Uzi, error) {
 			columnStatus,
 			columnDescription,
 			columnCreateAt,
+			columnPixelSpacingX,
+			columnPixelSpacingY,
+			columnSha256,
 		).
 		From(table).
 		Where(sq.Eq{
-			columnAuthor: author,
+			columnAuthor:   author,
+			columnDeleteAt: nil,
 		})
 
 	var uzi []entity.Uzi
@@ -116,7 +162,8 @@ func (q *repo) CheckExist(id uuid.UUID) (bool, error) {
 		Prefix("SELECT EXISTS (").
 		From(table).
 		Where(sq.Eq{
-			columnID: id,
+			columnID:       id,
+			columnDeleteAt: nil,
 		}).
 		Suffix(")")
 
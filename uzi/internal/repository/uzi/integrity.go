@@ -0,0 +1,48 @@
+package uzi
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"uzi/internal/repository/uzi/entity"
+)
+
+func (q *repo) GetUziIDsBySha256(externalID uuid.UUID, sha256 string) ([]uuid.UUID, error) {
+	query := q.QueryBuilder().
+		Select(columnID).
+		From(table).
+		Where(sq.Eq{
+			columnExternalID: externalID,
+			columnSha256:     sha256,
+			columnDeleteAt:   nil,
+		}).
+		OrderBy(columnCreateAt)
+
+	var ids []uuid.UUID
+	if err := q.Runner().Selectx(q.Context(), &ids, query); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (q *repo) GetUzisWithSha256(after uuid.UUID, limit int) ([]entity.Uzi, error) {
+	query := q.QueryBuilder().
+		Select(
+			columnID,
+			columnSha256,
+		).
+		From(table).
+		Where(sq.NotEq{columnSha256: nil}).
+		Where(sq.Eq{columnDeleteAt: nil}).
+		Where(sq.Gt{columnID: after}).
+		OrderBy(columnID).
+		Limit(uint64(limit))
+
+	var uzis []entity.Uzi
+	if err := q.Runner().Selectx(q.Context(), &uzis, query); err != nil {
+		return nil, err
+	}
+
+	return uzis, nil
+}
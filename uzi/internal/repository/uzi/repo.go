@@ -1,6 +1,9 @@
 package uzi
 
 import (
+	"time"
+
+	"uzi/internal/domain"
 	"uzi/internal/repository/uzi/entity"
 
 	daolib "github.com/WantBeASleep/med_ml_lib/dao"
@@ -19,6 +22,11 @@ const (
 	columnStatus      = "status"
 	columnDescription = "description"
 	columnCreateAt    = "create_at"
+	columnDeleteAt    = "delete_at"
+
+	columnPixelSpacingX = "pixel_spacing_x"
+	columnPixelSpacingY = "pixel_spacing_y"
+	columnSha256        = "sha256"
 )
 
 type Repository interface {
@@ -27,13 +35,30 @@ type Repository interface {
 	InsertUzi(uzi entity.Uzi) error
 
 	GetUziByID(id uuid.UUID) (entity.Uzi, error)
+	// GetUzisByIDs узи с указанными id, отсутствующие и мягко удаленные пропускаются
+	GetUzisByIDs(ids []uuid.UUID) ([]entity.Uzi, error)
 	GetUzisByExternalID(externalID uuid.UUID) ([]entity.Uzi, error)
 	GetUzisByAuthor(author uuid.UUID) ([]entity.Uzi, error)
+	SearchUzis(filter domain.UziFilter, order domain.SortOrder, after *domain.UziCursor, limit int) ([]entity.Uzi, error)
 
 	UpdateUzi(uzi entity.Uzi) error
 	UpdateUziStatus(id uuid.UUID, status string) error
 
+	// DeleteUzi удаляет узи вместе с зависимыми строками, в том числе мягко удаленное
 	DeleteUzi(id uuid.UUID) error
+	// SoftDeleteUzi скрывает узи из чтения до окончательного удаления
+	SoftDeleteUzi(id uuid.UUID) error
+	RestoreUzi(id uuid.UUID) error
+	// GetUziIDsDeletedBefore мягко удаленные до указанного времени
+	GetUziIDsDeletedBefore(before time.Time) ([]uuid.UUID, error)
+	// GetExistingUziIDs какие из ids есть в таблице, включая мягко удаленные
+	GetExistingUziIDs(ids []uuid.UUID) ([]uuid.UUID, error)
+
+	// GetUziIDsBySha256 узи пациента с тем же исходным файлом, от старых к новым
+	GetUziIDsBySha256(externalID uuid.UUID, sha256 string) ([]uuid.UUID, error)
+	// GetUzisWithSha256 страница узи с сохраненной sha256 по возрастанию id без мягко удаленных.
+	// Заполнены только id и sha256
+	GetUzisWithSha256(after uuid.UUID, limit int) ([]entity.Uzi, error)
 }
 
 type repo struct {
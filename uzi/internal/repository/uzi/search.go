@@ -0,0 +1,118 @@
+package uzi
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/uzi/entity"
+)
+
+// SearchUzis возвращает не более limit узи после курсора, отсортированных по (create_at, id)
+func (q *repo) SearchUzis(
+	filter domain.UziFilter,
+	order domain.SortOrder,
+	after *domain.UziCursor,
+	limit int,
+) ([]entity.Uzi, error) {
+	where, err := searchFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	query := q.QueryBuilder().
+		Select(
+			columnID,
+			columnProjection,
+			columnChecked,
+			columnExternalID,
+			columnAuthor,
+			columnDeviceID,
+			columnStatus,
+			columnDescription,
+			columnCreateAt,
+			columnPixelSpacingX,
+			columnPixelSpacingY,
+			columnSha256,
+		).
+		From(table).
+		Where(where)
+
+	direction, cmp := "DESC", "<"
+	if order == domain.SortOrderAsc {
+		direction, cmp = "ASC", ">"
+	}
+
+	if after != nil {
+		query = query.Where(
+			fmt.Sprintf("(%s, %s) %s (?, ?)", columnCreateAt, columnID, cmp),
+			after.CreateAt, after.Id,
+		)
+	}
+
+	query = query.
+		OrderBy(
+			fmt.Sprintf("%s %s", columnCreateAt, direction),
+			fmt.Sprintf("%s %s", columnID, direction),
+		).
+		Limit(uint64(limit))
+
+	var uzis []entity.Uzi
+	if err := q.Runner().Selectx(q.Context(), &uzis, query); err != nil {
+		return nil, err
+	}
+
+	return uzis, nil
+}
+
+func searchFilter(filter domain.UziFilter) (sq.And, error) {
+	// мягко удаленные узи скрыты из поиска
+	where := sq.And{sq.Eq{columnDeleteAt: nil}}
+
+	if filter.Author != nil {
+		where = append(where, sq.Eq{columnAuthor: *filter.Author})
+	}
+	if filter.ExternalID != nil {
+		where = append(where, sq.Eq{columnExternalID: *filter.ExternalID})
+	}
+	if filter.Status != nil {
+		where = append(where, sq.Eq{columnStatus: filter.Status.String()})
+	}
+	if filter.Projection != nil {
+		where = append(where, sq.Eq{columnProjection: filter.Projection.String()})
+	}
+	if filter.DeviceID != nil {
+		where = append(where, sq.Eq{columnDeviceID: *filter.DeviceID})
+	}
+	if filter.Checked != nil {
+		where = append(where, sq.Eq{columnChecked: *filter.Checked})
+	}
+	if filter.CreateFrom != nil {
+		where = append(where, sq.GtOrEq{columnCreateAt: *filter.CreateFrom})
+	}
+	if filter.CreateTo != nil {
+		where = append(where, sq.LtOrEq{columnCreateAt: *filter.CreateTo})
+	}
+
+	if filter.AiTirads4Min != nil || filter.AiTirads5Min != nil {
+		node := sq.And{
+			sq.Expr("node.uzi_id = uzi.id"),
+			sq.Eq{"node.ai": true},
+		}
+		if filter.AiTirads4Min != nil {
+			node = append(node, sq.GtOrEq{"node.tirads_4": *filter.AiTirads4Min})
+		}
+		if filter.AiTirads5Min != nil {
+			node = append(node, sq.GtOrEq{"node.tirads_5": *filter.AiTirads5Min})
+		}
+
+		sql, args, err := node.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("build node filter: %w", err)
+		}
+		where = append(where, sq.Expr(fmt.Sprintf("EXISTS (SELECT 1 FROM node WHERE %s)", sql), args...))
+	}
+
+	return where, nil
+}
@@ -12,9 +12,11 @@ func (q *repo) UpdateUzi(uzi entity.Uzi) error {
 	query := q.QueryBuilder().
 		Update(table).
 		SetMap(sq.Eq{
-			columnProjection: uzi.Projection,
-			columnChecked:    uzi.Checked,
-			columnStatus:     uzi.Status,
+			columnProjection:    uzi.Projection,
+			columnChecked:       uzi.Checked,
+			columnStatus:        uzi.Status,
+			columnPixelSpacingX: uzi.PixelSpacingX,
+			columnPixelSpacingY: uzi.PixelSpacingY,
 		}).
 		Where(sq.Eq{
 			columnID: uzi.Id,
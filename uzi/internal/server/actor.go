@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"uzi/internal/domain"
+)
+
+// ActorMetadataKey пользователь, от имени которого gateway делает запрос, пишется в историю изменений
+const ActorMetadataKey = "x-actor-id"
+
+// ActorServerCall переносит пользователя из метаданных запроса в контекст.
+// Некорректное значение игнорируется: запрос выполняется, но без автора
+func ActorServerCall(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if values := md.Get(ActorMetadataKey); len(values) > 0 {
+			if actor, err := uuid.Parse(values[0]); err == nil {
+				ctx = domain.WithActor(ctx, actor)
+			}
+		}
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,45 @@
+package analytics
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) GetUziAnalytics(ctx context.Context, in *pb.GetUziAnalyticsIn) (*pb.GetUziAnalyticsOut, error) {
+	author, err := mappers.ParseOptUUID("author", in.Author)
+	if err != nil {
+		return nil, err
+	}
+	createFrom, err := mappers.ParseOptTime("create_from", in.CreateFrom)
+	if err != nil {
+		return nil, err
+	}
+	createTo, err := mappers.ParseOptTime("create_to", in.CreateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.AnalyticsFilter{
+		Author:     author,
+		CreateFrom: createFrom,
+		CreateTo:   createTo,
+		Period:     mappers.AnalyticsPeriodReverseMap[in.Period],
+	}
+	if in.DeviceId != nil {
+		filter.DeviceID = pointer.To(int(*in.DeviceId))
+	}
+
+	analytics, err := h.services.Analytics.GetAnalytics(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	return mappers.AnalyticsFromDomain(analytics), nil
+}
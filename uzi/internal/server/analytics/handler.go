@@ -0,0 +1,24 @@
+package analytics
+
+import (
+	"context"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/services"
+)
+
+type AnalyticsHandler interface {
+	GetUziAnalytics(ctx context.Context, in *pb.GetUziAnalyticsIn) (*pb.GetUziAnalyticsOut, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(
+	services *services.Services,
+) AnalyticsHandler {
+	return &handler{
+		services: services,
+	}
+}
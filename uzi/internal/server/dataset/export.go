@@ -0,0 +1,79 @@
+package dataset
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlekSi/pointer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+	"uzi/internal/services/dataset"
+)
+
+func (h *handler) ExportDataset(ctx context.Context, in *pb.ExportDatasetIn) (*pb.ExportDatasetOut, error) {
+	author, err := mappers.ParseOptUUID("author", in.Author)
+	if err != nil {
+		return nil, err
+	}
+	externalID, err := mappers.ParseOptUUID("external_id", in.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	createFrom, err := mappers.ParseOptTime("create_from", in.CreateFrom)
+	if err != nil {
+		return nil, err
+	}
+	createTo, err := mappers.ParseOptTime("create_to", in.CreateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.UziFilter{
+		Author:     author,
+		ExternalID: externalID,
+		Checked:    in.Checked,
+		CreateFrom: createFrom,
+		CreateTo:   createTo,
+	}
+	if in.Status != nil {
+		filter.Status = pointer.To(mappers.UziStatusReverseMap[*in.Status])
+	}
+	if in.Projection != nil {
+		filter.Projection = pointer.To(mappers.UziProjectionReverseMap[*in.Projection])
+	}
+	if in.DeviceId != nil {
+		filter.DeviceID = pointer.To(int(*in.DeviceId))
+	}
+
+	export, err := h.services.Dataset.ExportToStorage(ctx, dataset.ExportArg{
+		Filter: filter,
+		Nodes: domain.DatasetNodeFilter{
+			Manual:  in.Manual,
+			AiValid: in.AiValid,
+		},
+		Format:   mappers.DatasetFormatReverseMap[in.Format],
+		ValRatio: in.ValRatio,
+		Seed:     in.Seed,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBadRequest):
+			return nil, status.Errorf(codes.InvalidArgument, "Неверные параметры выгрузки: %s", err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.ExportDatasetOut)
+	out.Id = export.Id.String()
+	out.Path = export.Path
+	out.TrainImages = int64(export.TrainImages)
+	out.ValImages = int64(export.ValImages)
+	out.Annotations = int64(export.Annotations)
+
+	return out, nil
+}
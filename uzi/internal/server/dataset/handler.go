@@ -0,0 +1,25 @@
+package dataset
+
+import (
+	"context"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/services"
+)
+
+type DatasetHandler interface {
+	ExportDataset(ctx context.Context, in *pb.ExportDatasetIn) (*pb.ExportDatasetOut, error)
+	ImportAnnotations(ctx context.Context, in *pb.ImportAnnotationsIn) (*pb.ImportAnnotationsOut, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(
+	services *services.Services,
+) DatasetHandler {
+	return &handler{
+		services: services,
+	}
+}
@@ -0,0 +1,38 @@
+package dataset
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+	"uzi/internal/services/dataset"
+)
+
+func (h *handler) ImportAnnotations(ctx context.Context, in *pb.ImportAnnotationsIn) (*pb.ImportAnnotationsOut, error) {
+	uziID, err := mappers.ParseOptUUID("uzi_id", in.UziId)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.services.Dataset.Import(ctx, dataset.ImportArg{
+		UziID:   uziID,
+		Format:  mappers.AnnotationFormatReverseMap[in.Format],
+		Content: in.Content,
+		DryRun:  in.DryRun,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBadRequest):
+			return nil, status.Errorf(codes.InvalidArgument, "Не удалось разобрать разметку: %s", err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	return mappers.AnnotationImportReportFromDomain(report), nil
+}
@@ -2,18 +2,55 @@ package device
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"uzi/internal/domain"
 	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+	"uzi/internal/services/device"
 )
 
 func (h *handler) CreateDevice(ctx context.Context, in *pb.CreateDeviceIn) (*pb.CreateDeviceOut, error) {
-	deviceID, err := h.services.Device.CreateDevice(ctx, in.Name)
+	if in.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if err := validatePixelSpacing(in.PixelSpacing); err != nil {
+		return nil, err
+	}
+
+	deviceID, err := h.services.Device.CreateDevice(ctx, device.CreateDeviceArg{
+		Name:         in.Name,
+		Manufacturer: in.Manufacturer,
+		Model:        in.Model,
+		SerialNumber: in.SerialNumber,
+		ProbeType:    mappers.ProbeTypeToDomain(in.ProbeType),
+		PixelSpacing: mappers.PixelSpacingToDomain(in.PixelSpacing),
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Что то пошло не так: create device: %s", err.Error())
+		switch {
+		case errors.Is(err, domain.ErrConflict):
+			return nil, status.Errorf(codes.AlreadyExists, "Аппарат с таким серийным номером уже существует")
+		case errors.Is(err, domain.ErrUnprocessableEntity):
+			return nil, status.Errorf(codes.FailedPrecondition, "Ошибка валидации данных")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: create device: %s", err.Error())
+		}
 	}
 
 	return &pb.CreateDeviceOut{Id: int64(deviceID)}, nil
 }
+
+func validatePixelSpacing(spacing *pb.PixelSpacing) error {
+	if spacing == nil {
+		return nil
+	}
+
+	if spacing.X <= 0 || spacing.Y <= 0 {
+		return status.Errorf(codes.InvalidArgument, "pixel_spacing must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package device
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+func (h *handler) DeleteDevice(ctx context.Context, in *pb.DeleteDeviceIn) (*empty.Empty, error) {
+	if err := h.services.Device.DeleteDevice(ctx, int(in.Id)); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Аппарат не найден")
+		case errors.Is(err, domain.ErrConflict):
+			return nil, status.Errorf(codes.FailedPrecondition, "Аппарат используется в узи")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
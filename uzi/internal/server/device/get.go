@@ -2,11 +2,13 @@ package device
 
 import (
 	"context"
+	"errors"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"uzi/internal/domain"
 	pb "uzi/internal/generated/grpc/service"
 	"uzi/internal/server/mappers"
 )
@@ -22,3 +24,20 @@ func (h *handler) GetDeviceList(ctx context.Context, _ *empty.Empty) (*pb.GetDev
 
 	return out, nil
 }
+
+func (h *handler) GetDeviceById(ctx context.Context, in *pb.GetDeviceByIdIn) (*pb.GetDeviceByIdOut, error) {
+	device, err := h.services.Device.GetDeviceByID(ctx, int(in.Id))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Аппарат не найден")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.GetDeviceByIdOut)
+	out.Device = mappers.DeviceFromDomain(device)
+
+	return out, nil
+}
@@ -12,6 +12,9 @@ import (
 type DeviceHandler interface {
 	CreateDevice(ctx context.Context, in *pb.CreateDeviceIn) (*pb.CreateDeviceOut, error)
 	GetDeviceList(ctx context.Context, _ *empty.Empty) (*pb.GetDeviceListOut, error)
+	GetDeviceById(ctx context.Context, in *pb.GetDeviceByIdIn) (*pb.GetDeviceByIdOut, error)
+	UpdateDevice(ctx context.Context, in *pb.UpdateDeviceIn) (*pb.UpdateDeviceOut, error)
+	DeleteDevice(ctx context.Context, in *pb.DeleteDeviceIn) (*empty.Empty, error)
 }
 
 type handler struct {
@@ -0,0 +1,50 @@
+package device
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+	"uzi/internal/services/device"
+)
+
+func (h *handler) UpdateDevice(ctx context.Context, in *pb.UpdateDeviceIn) (*pb.UpdateDeviceOut, error) {
+	if in.Name != nil && *in.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name can't be empty")
+	}
+	if err := validatePixelSpacing(in.PixelSpacing); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.services.Device.UpdateDevice(ctx, device.UpdateDeviceArg{
+		Id:           int(in.Id),
+		Name:         in.Name,
+		Manufacturer: in.Manufacturer,
+		Model:        in.Model,
+		SerialNumber: in.SerialNumber,
+		ProbeType:    mappers.ProbeTypeToDomain(in.ProbeType),
+		PixelSpacing: mappers.PixelSpacingToDomain(in.PixelSpacing),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Аппарат не найден")
+		case errors.Is(err, domain.ErrConflict):
+			return nil, status.Errorf(codes.AlreadyExists, "Аппарат с таким серийным номером уже существует")
+		case errors.Is(err, domain.ErrUnprocessableEntity):
+			return nil, status.Errorf(codes.FailedPrecondition, "Ошибка валидации данных")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.UpdateDeviceOut)
+	out.Device = mappers.DeviceFromDomain(updated)
+
+	return out, nil
+}
@@ -0,0 +1,44 @@
+package history
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) GetNodeHistory(ctx context.Context, in *pb.GetNodeHistoryIn) (*pb.GetNodeHistoryOut, error) {
+	if _, err := uuid.Parse(in.NodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "node_id is not a valid uuid: %s", err.Error())
+	}
+
+	versions, err := h.services.History.GetNodeHistory(ctx, uuid.MustParse(in.NodeId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	out := new(pb.GetNodeHistoryOut)
+	out.Versions = mappers.SliceNodeVersionFromDomain(versions)
+
+	return out, nil
+}
+
+func (h *handler) GetSegmentHistory(ctx context.Context, in *pb.GetSegmentHistoryIn) (*pb.GetSegmentHistoryOut, error) {
+	if _, err := uuid.Parse(in.SegmentId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "segment_id is not a valid uuid: %s", err.Error())
+	}
+
+	versions, err := h.services.History.GetSegmentHistory(ctx, uuid.MustParse(in.SegmentId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	out := new(pb.GetSegmentHistoryOut)
+	out.Versions = mappers.SliceSegmentVersionFromDomain(versions)
+
+	return out, nil
+}
@@ -0,0 +1,27 @@
+package history
+
+import (
+	"context"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/services"
+)
+
+type HistoryHandler interface {
+	GetNodeHistory(ctx context.Context, in *pb.GetNodeHistoryIn) (*pb.GetNodeHistoryOut, error)
+	GetSegmentHistory(ctx context.Context, in *pb.GetSegmentHistoryIn) (*pb.GetSegmentHistoryOut, error)
+	RestoreNode(ctx context.Context, in *pb.RestoreNodeIn) (*pb.RestoreNodeOut, error)
+	RestoreSegment(ctx context.Context, in *pb.RestoreSegmentIn) (*pb.RestoreSegmentOut, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(
+	services *services.Services,
+) HistoryHandler {
+	return &handler{
+		services: services,
+	}
+}
@@ -0,0 +1,62 @@
+package history
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/repository/entity"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) RestoreNode(ctx context.Context, in *pb.RestoreNodeIn) (*pb.RestoreNodeOut, error) {
+	if _, err := uuid.Parse(in.NodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "node_id is not a valid uuid: %s", err.Error())
+	}
+
+	node, err := h.services.History.RestoreNode(ctx, uuid.MustParse(in.NodeId), int(in.Version))
+	if err != nil {
+		return nil, restoreStatus(err, "Версия узла не найдена")
+	}
+
+	out := new(pb.RestoreNodeOut)
+	out.Node = mappers.NodeFromDomain(node)
+
+	return out, nil
+}
+
+func (h *handler) RestoreSegment(ctx context.Context, in *pb.RestoreSegmentIn) (*pb.RestoreSegmentOut, error) {
+	if _, err := uuid.Parse(in.SegmentId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "segment_id is not a valid uuid: %s", err.Error())
+	}
+
+	segment, err := h.services.History.RestoreSegment(ctx, uuid.MustParse(in.SegmentId), int(in.Version))
+	if err != nil {
+		return nil, restoreStatus(err, "Версия сегмента не найдена")
+	}
+
+	out := new(pb.RestoreSegmentOut)
+	out.Segment = mappers.SegmentFromDomain(segment)
+
+	return out, nil
+}
+
+func restoreStatus(err error, notFound string) error {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s", notFound)
+	case errors.Is(err, domain.ErrBadRequest):
+		return status.Errorf(codes.InvalidArgument, "Версия является удалением: %s", err.Error())
+	case errors.Is(err, domain.ErrUnprocessableEntity):
+		return status.Errorf(codes.FailedPrecondition, "Узел сегмента удален: %s", err.Error())
+	case errors.Is(err, domain.ErrConflict):
+		return status.Errorf(codes.AlreadyExists, "Конфликт данных")
+	default:
+		return status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+}
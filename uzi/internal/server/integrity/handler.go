@@ -0,0 +1,24 @@
+package integrity
+
+import (
+	"context"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/services"
+)
+
+type IntegrityHandler interface {
+	VerifyUziIntegrity(ctx context.Context, in *pb.VerifyUziIntegrityIn) (*pb.VerifyUziIntegrityOut, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(
+	services *services.Services,
+) IntegrityHandler {
+	return &handler{
+		services: services,
+	}
+}
@@ -0,0 +1,33 @@
+package integrity
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) VerifyUziIntegrity(ctx context.Context, _ *pb.VerifyUziIntegrityIn) (*pb.VerifyUziIntegrityOut, error) {
+	report, err := h.services.Integrity.Verify(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	mismatches := make([]*pb.IntegrityMismatch, 0, len(report.Mismatches))
+	for _, mismatch := range report.Mismatches {
+		mismatches = append(mismatches, &pb.IntegrityMismatch{
+			UziId:    mismatch.UziID.String(),
+			Expected: mismatch.Expected,
+			Actual:   mismatch.Actual,
+		})
+	}
+
+	return &pb.VerifyUziIntegrityOut{
+		Checked:    int64(report.Checked),
+		Missing:    mappers.UUIDsToStrings(report.Missing),
+		Mismatches: mismatches,
+	}, nil
+}
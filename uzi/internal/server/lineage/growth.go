@@ -0,0 +1,28 @@
+package lineage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) GetGrowthReport(ctx context.Context, in *pb.GetGrowthReportIn) (*pb.GetGrowthReportOut, error) {
+	if _, err := uuid.Parse(in.ExternalId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "external_id is not a valid uuid: %s", err.Error())
+	}
+
+	report, err := h.services.Lineage.GetGrowthReport(ctx, uuid.MustParse(in.ExternalId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	out := new(pb.GetGrowthReportOut)
+	out.Lineages = mappers.SliceNodeGrowthFromDomain(report)
+
+	return out, nil
+}
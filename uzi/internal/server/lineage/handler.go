@@ -0,0 +1,29 @@
+package lineage
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/services"
+)
+
+type LineageHandler interface {
+	LinkNodes(ctx context.Context, in *pb.LinkNodesIn) (*pb.LinkNodesOut, error)
+	UnlinkNode(ctx context.Context, in *pb.UnlinkNodeIn) (*emptypb.Empty, error)
+	SuggestNodeLinks(ctx context.Context, in *pb.SuggestNodeLinksIn) (*pb.SuggestNodeLinksOut, error)
+	GetGrowthReport(ctx context.Context, in *pb.GetGrowthReportIn) (*pb.GetGrowthReportOut, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(
+	services *services.Services,
+) LineageHandler {
+	return &handler{
+		services: services,
+	}
+}
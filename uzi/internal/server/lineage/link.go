@@ -0,0 +1,58 @@
+package lineage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+func (h *handler) LinkNodes(ctx context.Context, in *pb.LinkNodesIn) (*pb.LinkNodesOut, error) {
+	if _, err := uuid.Parse(in.NodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "node_id is not a valid uuid: %s", err.Error())
+	}
+	if _, err := uuid.Parse(in.TargetNodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "target_node_id is not a valid uuid: %s", err.Error())
+	}
+
+	lineageID, err := h.services.Lineage.LinkNodes(ctx, uuid.MustParse(in.NodeId), uuid.MustParse(in.TargetNodeId))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Узел не найден")
+		case errors.Is(err, domain.ErrBadRequest):
+			return nil, status.Errorf(codes.InvalidArgument, "Нельзя связать узел с самим собой")
+		case errors.Is(err, domain.ErrUnprocessableEntity):
+			return nil, status.Errorf(codes.FailedPrecondition, "Узлы должны принадлежать разным узи одного пациента")
+		case errors.Is(err, domain.ErrConflict):
+			return nil, status.Errorf(codes.AlreadyExists, "Конфликт данных")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	return &pb.LinkNodesOut{LineageId: lineageID.String()}, nil
+}
+
+func (h *handler) UnlinkNode(ctx context.Context, in *pb.UnlinkNodeIn) (*emptypb.Empty, error) {
+	if _, err := uuid.Parse(in.NodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "node_id is not a valid uuid: %s", err.Error())
+	}
+
+	if err := h.services.Lineage.UnlinkNode(ctx, uuid.MustParse(in.NodeId)); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Узел не отслеживается")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,35 @@
+package lineage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) SuggestNodeLinks(ctx context.Context, in *pb.SuggestNodeLinksIn) (*pb.SuggestNodeLinksOut, error) {
+	if _, err := uuid.Parse(in.NodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "node_id is not a valid uuid: %s", err.Error())
+	}
+
+	suggestions, err := h.services.Lineage.SuggestNodeLinks(ctx, uuid.MustParse(in.NodeId))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Узел не найден")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.SuggestNodeLinksOut)
+	out.Suggestions = mappers.SliceNodeLinkSuggestionFromDomain(suggestions)
+
+	return out, nil
+}
@@ -0,0 +1,86 @@
+package mappers
+
+import (
+	"time"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+var AnalyticsPeriodReverseMap = map[pb.AnalyticsPeriod]domain.AnalyticsPeriod{
+	pb.AnalyticsPeriod_ANALYTICS_PERIOD_MONTH: domain.AnalyticsPeriodMonth,
+	pb.AnalyticsPeriod_ANALYTICS_PERIOD_WEEK:  domain.AnalyticsPeriodWeek,
+	pb.AnalyticsPeriod_ANALYTICS_PERIOD_DAY:   domain.AnalyticsPeriodDay,
+}
+
+func tiradsDistributionFromDomain(d domain.TiradsDistribution) *pb.TiradsDistribution {
+	return &pb.TiradsDistribution{
+		Tirads_23: int64(d.Tirads23),
+		Tirads_4:  int64(d.Tirads4),
+		Tirads_5:  int64(d.Tirads5),
+	}
+}
+
+func nodeMetricsFromDomain(d domain.NodeMetrics) *pb.NodeMetrics {
+	return &pb.NodeMetrics{
+		Uzis:             int64(d.Uzis),
+		AiNodes:          int64(d.AiNodes),
+		Valid:            int64(d.Valid),
+		Invalid:          int64(d.Invalid),
+		Unvalidated:      int64(d.Unvalidated),
+		ManualNodes:      int64(d.ManualNodes),
+		Precision:        d.Precision,
+		UnvalidatedShare: d.UnvalidatedShare,
+		AiTirads:         tiradsDistributionFromDomain(d.AiTirads),
+		ManualTirads:     tiradsDistributionFromDomain(d.ManualTirads),
+	}
+}
+
+func AnalyticsFromDomain(d domain.Analytics) *pb.GetUziAnalyticsOut {
+	out := &pb.GetUziAnalyticsOut{
+		Total:     nodeMetricsFromDomain(d.Total),
+		ByDevice:  make([]*pb.DeviceNodeMetrics, 0, len(d.ByDevice)),
+		ByAuthor:  make([]*pb.AuthorNodeMetrics, 0, len(d.ByAuthor)),
+		ByPeriod:  make([]*pb.PeriodNodeMetrics, 0, len(d.ByPeriod)),
+		Agreement: make([]*pb.ReaderAgreement, 0, len(d.Agreement)),
+		Kappa:     d.Kappa,
+		MeanIou:   d.MeanIoU,
+		AiMerged:  int64(d.AiMerged),
+		AiSplit:   int64(d.AiSplit),
+
+		BulkValid:   int64(d.BulkValid),
+		BulkInvalid: int64(d.BulkInvalid),
+	}
+
+	for _, v := range d.ByDevice {
+		out.ByDevice = append(out.ByDevice, &pb.DeviceNodeMetrics{
+			DeviceId: int64(v.DeviceID),
+			Metrics:  nodeMetricsFromDomain(v.Metrics),
+		})
+	}
+	for _, v := range d.ByAuthor {
+		out.ByAuthor = append(out.ByAuthor, &pb.AuthorNodeMetrics{
+			Author:  v.Author.String(),
+			Metrics: nodeMetricsFromDomain(v.Metrics),
+		})
+	}
+	for _, v := range d.ByPeriod {
+		out.ByPeriod = append(out.ByPeriod, &pb.PeriodNodeMetrics{
+			Start:   v.Start.Format(time.RFC3339),
+			Metrics: nodeMetricsFromDomain(v.Metrics),
+		})
+	}
+	for _, v := range d.Agreement {
+		out.Agreement = append(out.Agreement, &pb.ReaderAgreement{
+			ReaderA:   v.ReaderA.String(),
+			ReaderB:   v.ReaderB.String(),
+			Uzis:      int64(v.Uzis),
+			Matched:   int64(v.Matched),
+			Unmatched: int64(v.Unmatched),
+			Kappa:     v.Kappa,
+			MeanIou:   v.MeanIoU,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,50 @@
+package mappers
+
+import (
+	"github.com/AlekSi/pointer"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+var DatasetFormatReverseMap = map[pb.DatasetFormat]domain.DatasetFormat{
+	pb.DatasetFormat_DATASET_FORMAT_COCO: domain.DatasetFormatCoco,
+	pb.DatasetFormat_DATASET_FORMAT_YOLO: domain.DatasetFormatYolo,
+}
+
+var AnnotationFormatReverseMap = map[pb.AnnotationFormat]domain.AnnotationFormat{
+	pb.AnnotationFormat_ANNOTATION_FORMAT_COCO: domain.AnnotationFormatCoco,
+	pb.AnnotationFormat_ANNOTATION_FORMAT_CVAT: domain.AnnotationFormatCvat,
+}
+
+func AnnotationImportReportFromDomain(domain domain.AnnotationImportReport) *pb.ImportAnnotationsOut {
+	out := &pb.ImportAnnotationsOut{
+		DryRun:  domain.DryRun,
+		Nodes:   make([]*pb.ImportAnnotationsOut_Node, 0, len(domain.Nodes)),
+		Skipped: make([]*pb.ImportAnnotationsOut_Skipped, 0, len(domain.Skipped)),
+	}
+
+	for _, node := range domain.Nodes {
+		pbNode := &pb.ImportAnnotationsOut_Node{
+			Source:   node.Source,
+			UziId:    node.UziID.String(),
+			Segments: int64(node.Segments),
+		}
+		if node.NodeID != nil {
+			pbNode.NodeId = pointer.To(node.NodeID.String())
+		}
+		if node.Class != nil {
+			pbNode.Class = pointer.To(node.Class.String())
+		}
+		out.Nodes = append(out.Nodes, pbNode)
+	}
+
+	for _, skipped := range domain.Skipped {
+		out.Skipped = append(out.Skipped, &pb.ImportAnnotationsOut_Skipped{
+			Source: skipped.Source,
+			Reason: skipped.Reason,
+		})
+	}
+
+	return out
+}
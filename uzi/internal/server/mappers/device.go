@@ -5,10 +5,43 @@ import (
 	pb "uzi/internal/generated/grpc/service"
 )
 
+var probeTypeFromDomainMap = map[domain.ProbeType]pb.ProbeType{
+	domain.ProbeTypeLinear: pb.ProbeType_PROBE_TYPE_LINEAR,
+	domain.ProbeTypeConvex: pb.ProbeType_PROBE_TYPE_CONVEX,
+	domain.ProbeTypeSector: pb.ProbeType_PROBE_TYPE_SECTOR,
+}
+
+var probeTypeToDomainMap = map[pb.ProbeType]domain.ProbeType{
+	pb.ProbeType_PROBE_TYPE_LINEAR: domain.ProbeTypeLinear,
+	pb.ProbeType_PROBE_TYPE_CONVEX: domain.ProbeTypeConvex,
+	pb.ProbeType_PROBE_TYPE_SECTOR: domain.ProbeTypeSector,
+}
+
+func ProbeTypeFromDomain(probeType *domain.ProbeType) *pb.ProbeType {
+	if probeType == nil {
+		return nil
+	}
+	v := probeTypeFromDomainMap[*probeType]
+	return &v
+}
+
+func ProbeTypeToDomain(probeType *pb.ProbeType) *domain.ProbeType {
+	if probeType == nil {
+		return nil
+	}
+	v := probeTypeToDomainMap[*probeType]
+	return &v
+}
+
 func DeviceFromDomain(domain domain.Device) *pb.Device {
 	return &pb.Device{
-		Id:   int64(domain.Id),
-		Name: domain.Name,
+		Id:           int64(domain.Id),
+		Name:         domain.Name,
+		Manufacturer: domain.Manufacturer,
+		Model:        domain.Model,
+		SerialNumber: domain.SerialNumber,
+		ProbeType:    ProbeTypeFromDomain(domain.ProbeType),
+		PixelSpacing: PixelSpacingFromDomain(domain.PixelSpacing),
 	}
 }
 
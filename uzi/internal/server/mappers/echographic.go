@@ -1,32 +1,121 @@
 package mappers
 
 import (
+	"github.com/AlekSi/pointer"
+
 	"uzi/internal/domain"
 	pb "uzi/internal/generated/grpc/service"
 )
 
+var EchographicStructMap = map[domain.EchographicStruct]pb.EchographicStruct{
+	domain.EchographicStructHomogeneous:   pb.EchographicStruct_ECHOGRAPHIC_STRUCT_HOMOGENEOUS,
+	domain.EchographicStructHeterogeneous: pb.EchographicStruct_ECHOGRAPHIC_STRUCT_HETEROGENEOUS,
+}
+
+var EchographicStructReverseMap = map[pb.EchographicStruct]domain.EchographicStruct{
+	pb.EchographicStruct_ECHOGRAPHIC_STRUCT_HOMOGENEOUS:   domain.EchographicStructHomogeneous,
+	pb.EchographicStruct_ECHOGRAPHIC_STRUCT_HETEROGENEOUS: domain.EchographicStructHeterogeneous,
+}
+
+var EchographicEchogenicityMap = map[domain.EchographicEchogenicity]pb.EchographicEchogenicity{
+	domain.EchographicEchogenicityNormal:    pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_NORMAL,
+	domain.EchographicEchogenicityIncreased: pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_INCREASED,
+	domain.EchographicEchogenicityDecreased: pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_DECREASED,
+	domain.EchographicEchogenicityMixed:     pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_MIXED,
+}
+
+var EchographicEchogenicityReverseMap = map[pb.EchographicEchogenicity]domain.EchographicEchogenicity{
+	pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_NORMAL:    domain.EchographicEchogenicityNormal,
+	pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_INCREASED: domain.EchographicEchogenicityIncreased,
+	pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_DECREASED: domain.EchographicEchogenicityDecreased,
+	pb.EchographicEchogenicity_ECHOGRAPHIC_ECHOGENICITY_MIXED:     domain.EchographicEchogenicityMixed,
+}
+
+var EchographicVascularizationMap = map[domain.EchographicVascularization]pb.EchographicVascularization{
+	domain.EchographicVascularizationNormal:    pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_NORMAL,
+	domain.EchographicVascularizationIncreased: pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_INCREASED,
+	domain.EchographicVascularizationDecreased: pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_DECREASED,
+}
+
+var EchographicVascularizationReverseMap = map[pb.EchographicVascularization]domain.EchographicVascularization{
+	pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_NORMAL:    domain.EchographicVascularizationNormal,
+	pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_INCREASED: domain.EchographicVascularizationIncreased,
+	pb.EchographicVascularization_ECHOGRAPHIC_VASCULARIZATION_DECREASED: domain.EchographicVascularizationDecreased,
+}
+
+var PatientSexMap = map[domain.PatientSex]pb.PatientSex{
+	domain.PatientSexMale:   pb.PatientSex_PATIENT_SEX_MALE,
+	domain.PatientSexFemale: pb.PatientSex_PATIENT_SEX_FEMALE,
+}
+
+var PatientSexReverseMap = map[pb.PatientSex]domain.PatientSex{
+	pb.PatientSex_PATIENT_SEX_MALE:   domain.PatientSexMale,
+	pb.PatientSex_PATIENT_SEX_FEMALE: domain.PatientSexFemale,
+}
+
+// mapPointer переводит необязательное значение перечисления
+func mapPointer[F comparable, T any](v *F, m map[F]T) *T {
+	if v == nil {
+		return nil
+	}
+	return pointer.To(m[*v])
+}
+
+func EchographicStructFromPb(v *pb.EchographicStruct) *domain.EchographicStruct {
+	return mapPointer(v, EchographicStructReverseMap)
+}
+
+func EchographicEchogenicityFromPb(v *pb.EchographicEchogenicity) *domain.EchographicEchogenicity {
+	return mapPointer(v, EchographicEchogenicityReverseMap)
+}
+
+func EchographicVascularizationFromPb(v *pb.EchographicVascularization) *domain.EchographicVascularization {
+	return mapPointer(v, EchographicVascularizationReverseMap)
+}
+
+func PatientSexFromPb(v *pb.PatientSex) *domain.PatientSex {
+	return mapPointer(v, PatientSexReverseMap)
+}
+
 func EchographicFromDomain(domain domain.Echographic) *pb.Echographic {
-	return &pb.Echographic{
-		Id:              domain.Id.String(),
-		Contors:         domain.Contors,
-		LeftLobeLength:  domain.LeftLobeLength,
-		LeftLobeWidth:   domain.LeftLobeWidth,
-		LeftLobeThick:   domain.LeftLobeThick,
-		LeftLobeVolum:   domain.LeftLobeVolum,
-		RightLobeLength: domain.RightLobeLength,
-		RightLobeWidth:  domain.RightLobeWidth,
-		RightLobeThick:  domain.RightLobeThick,
-		RightLobeVolum:  domain.RightLobeVolum,
-		GlandVolum:      domain.GlandVolum,
-		Isthmus:         domain.Isthmus,
-		Struct:          domain.Struct,
-		Echogenicity:    domain.Echogenicity,
-		RegionalLymph:   domain.RegionalLymph,
-		Vascularization: domain.Vascularization,
-		Location:        domain.Location,
-		Additional:      domain.Additional,
-		Conclusion:      domain.Conclusion,
+	echographic := &pb.Echographic{
+		Id:                  domain.Id.String(),
+		Contors:             domain.Contors,
+		LeftLobeLength:      domain.LeftLobeLength,
+		LeftLobeWidth:       domain.LeftLobeWidth,
+		LeftLobeThick:       domain.LeftLobeThick,
+		LeftLobeVolum:       domain.LeftLobeVolum,
+		RightLobeLength:     domain.RightLobeLength,
+		RightLobeWidth:      domain.RightLobeWidth,
+		RightLobeThick:      domain.RightLobeThick,
+		RightLobeVolum:      domain.RightLobeVolum,
+		GlandVolum:          domain.GlandVolum,
+		Isthmus:             domain.Isthmus,
+		Struct:              mapPointer(domain.Struct, EchographicStructMap),
+		Echogenicity:        mapPointer(domain.Echogenicity, EchographicEchogenicityMap),
+		RegionalLymph:       domain.RegionalLymph,
+		Vascularization:     mapPointer(domain.Vascularization, EchographicVascularizationMap),
+		Location:            domain.Location,
+		Additional:          domain.Additional,
+		Conclusion:          domain.Conclusion,
+		PatientSex:          mapPointer(domain.PatientSex, PatientSexMap),
+		GeneratedConclusion: domain.GeneratedConclusion,
+	}
+
+	if domain.PatientAge != nil {
+		echographic.PatientAge = pointer.To(int64(*domain.PatientAge))
+	}
+
+	for _, flag := range domain.Flags {
+		echographic.Flags = append(echographic.Flags, &pb.EchographicFlag{
+			Field: flag.Field,
+			Value: flag.Value,
+			Min:   flag.Min,
+			Max:   flag.Max,
+		})
 	}
+
+	return echographic
 }
 
 func SliceEchographicFromDomain(domains []domain.Echographic) []*pb.Echographic {
@@ -0,0 +1,95 @@
+package mappers
+
+import (
+	"time"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+
+	"github.com/AlekSi/pointer"
+)
+
+var historyActionFromDomainMap = map[domain.HistoryAction]pb.HistoryAction{
+	domain.HistoryActionCreate:   pb.HistoryAction_HISTORY_ACTION_CREATE,
+	domain.HistoryActionUpdate:   pb.HistoryAction_HISTORY_ACTION_UPDATE,
+	domain.HistoryActionDelete:   pb.HistoryAction_HISTORY_ACTION_DELETE,
+	domain.HistoryActionRestore:  pb.HistoryAction_HISTORY_ACTION_RESTORE,
+	domain.HistoryActionSnapshot: pb.HistoryAction_HISTORY_ACTION_SNAPSHOT,
+}
+
+func FieldChangeFromDomain(domain domain.FieldChange) *pb.FieldChange {
+	change := &pb.FieldChange{Field: domain.Field}
+	if domain.Before != nil {
+		change.Before = domain.Before
+	}
+	if domain.After != nil {
+		change.After = domain.After
+	}
+	return change
+}
+
+func SliceFieldChangeFromDomain(domains []domain.FieldChange) []*pb.FieldChange {
+	pbs := make([]*pb.FieldChange, 0, len(domains))
+	for _, d := range domains {
+		pbs = append(pbs, FieldChangeFromDomain(d))
+	}
+	return pbs
+}
+
+func NodeVersionFromDomain(domain domain.NodeVersion) *pb.NodeVersion {
+	version := &pb.NodeVersion{
+		NodeId:   domain.NodeID.String(),
+		UziId:    domain.UziID.String(),
+		Version:  int64(domain.Version),
+		Action:   historyActionFromDomainMap[domain.Action],
+		Diff:     SliceFieldChangeFromDomain(domain.Diff),
+		CreateAt: domain.CreateAt.Format(time.RFC3339),
+	}
+	if domain.Actor != nil {
+		version.Actor = pointer.To(domain.Actor.String())
+	}
+	if domain.Before != nil {
+		version.Before = NodeFromDomain(*domain.Before)
+	}
+	if domain.After != nil {
+		version.After = NodeFromDomain(*domain.After)
+	}
+	return version
+}
+
+func SliceNodeVersionFromDomain(domains []domain.NodeVersion) []*pb.NodeVersion {
+	pbs := make([]*pb.NodeVersion, 0, len(domains))
+	for _, d := range domains {
+		pbs = append(pbs, NodeVersionFromDomain(d))
+	}
+	return pbs
+}
+
+func SegmentVersionFromDomain(domain domain.SegmentVersion) *pb.SegmentVersion {
+	version := &pb.SegmentVersion{
+		SegmentId: domain.SegmentID.String(),
+		NodeId:    domain.NodeID.String(),
+		Version:   int64(domain.Version),
+		Action:    historyActionFromDomainMap[domain.Action],
+		Diff:      SliceFieldChangeFromDomain(domain.Diff),
+		CreateAt:  domain.CreateAt.Format(time.RFC3339),
+	}
+	if domain.Actor != nil {
+		version.Actor = pointer.To(domain.Actor.String())
+	}
+	if domain.Before != nil {
+		version.Before = SegmentFromDomain(*domain.Before)
+	}
+	if domain.After != nil {
+		version.After = SegmentFromDomain(*domain.After)
+	}
+	return version
+}
+
+func SliceSegmentVersionFromDomain(domains []domain.SegmentVersion) []*pb.SegmentVersion {
+	pbs := make([]*pb.SegmentVersion, 0, len(domains))
+	for _, d := range domains {
+		pbs = append(pbs, SegmentVersionFromDomain(d))
+	}
+	return pbs
+}
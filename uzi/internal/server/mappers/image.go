@@ -5,11 +5,32 @@ import (
 	pb "uzi/internal/generated/grpc/service"
 )
 
+var imageSizeMap = map[domain.ImageSize]pb.ImageSize{
+	domain.ImageSizeOriginal:  pb.ImageSize_IMAGE_SIZE_ORIGINAL,
+	domain.ImageSizePreview:   pb.ImageSize_IMAGE_SIZE_PREVIEW,
+	domain.ImageSizeThumbnail: pb.ImageSize_IMAGE_SIZE_THUMBNAIL,
+}
+
 func ImageFromDomain(domain domain.Image) *pb.Image {
+	variants := domain.Variants()
+	pbVariants := make([]*pb.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		pbVariants = append(pbVariants, &pb.ImageVariant{
+			Size:        imageSizeMap[v.Size],
+			Path:        v.Path,
+			ContentType: v.ContentType,
+			Width:       int64(v.Width),
+			Height:      int64(v.Height),
+		})
+	}
+
 	return &pb.Image{
-		Id:    domain.Id.String(),
-		UziId: domain.UziID.String(),
-		Page:  int64(domain.Page),
+		Id:       domain.Id.String(),
+		UziId:    domain.UziID.String(),
+		Page:     int64(domain.Page),
+		Width:    int64(domain.Width),
+		Height:   int64(domain.Height),
+		Variants: pbVariants,
 	}
 }
 
@@ -0,0 +1,54 @@
+package mappers
+
+import (
+	"time"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+func NodeLinkSuggestionFromDomain(domain domain.NodeLinkSuggestion) *pb.NodeLinkSuggestion {
+	return &pb.NodeLinkSuggestion{
+		Node:  NodeFromDomain(domain.Node),
+		Score: domain.Score,
+	}
+}
+
+func SliceNodeLinkSuggestionFromDomain(domains []domain.NodeLinkSuggestion) []*pb.NodeLinkSuggestion {
+	pbs := make([]*pb.NodeLinkSuggestion, 0, len(domains))
+	for _, d := range domains {
+		pbs = append(pbs, NodeLinkSuggestionFromDomain(d))
+	}
+	return pbs
+}
+
+func NodeGrowthPointFromDomain(domain domain.NodeGrowthPoint) *pb.NodeGrowthPoint {
+	return &pb.NodeGrowthPoint{
+		Node:     NodeFromDomain(domain.Node),
+		UziId:    domain.UziID.String(),
+		CreateAt: domain.CreateAt.Format(time.RFC3339),
+		Volume:   domain.Volume,
+	}
+}
+
+func NodeGrowthFromDomain(domain domain.NodeGrowth) *pb.NodeGrowth {
+	points := make([]*pb.NodeGrowthPoint, 0, len(domain.Points))
+	for _, p := range domain.Points {
+		points = append(points, NodeGrowthPointFromDomain(p))
+	}
+
+	return &pb.NodeGrowth{
+		LineageId:    domain.LineageID.String(),
+		Points:       points,
+		VolumeChange: domain.VolumeChange,
+		DoublingTime: domain.DoublingTime,
+	}
+}
+
+func SliceNodeGrowthFromDomain(domains []domain.NodeGrowth) []*pb.NodeGrowth {
+	pbs := make([]*pb.NodeGrowth, 0, len(domains))
+	for _, d := range domains {
+		pbs = append(pbs, NodeGrowthFromDomain(d))
+	}
+	return pbs
+}
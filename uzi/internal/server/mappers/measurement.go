@@ -0,0 +1,64 @@
+package mappers
+
+import (
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+var measureUnitFromDomainMap = map[domain.MeasureUnit]pb.MeasureUnit{
+	domain.MeasureUnitPx: pb.MeasureUnit_MEASURE_UNIT_PX,
+	domain.MeasureUnitMm: pb.MeasureUnit_MEASURE_UNIT_MM,
+}
+
+func PixelSpacingFromDomain(domain *domain.PixelSpacing) *pb.PixelSpacing {
+	if domain == nil {
+		return nil
+	}
+	return &pb.PixelSpacing{
+		X: domain.X,
+		Y: domain.Y,
+	}
+}
+
+func PixelSpacingToDomain(pb *pb.PixelSpacing) *domain.PixelSpacing {
+	if pb == nil {
+		return nil
+	}
+	return &domain.PixelSpacing{
+		X: pb.X,
+		Y: pb.Y,
+	}
+}
+
+func SegmentMeasurementFromDomain(domain *domain.SegmentMeasurement) *pb.SegmentMeasurement {
+	if domain == nil {
+		return nil
+	}
+	return &pb.SegmentMeasurement{
+		Bbox: &pb.BoundingBox{
+			X:      int64(domain.BBox.X),
+			Y:      int64(domain.BBox.Y),
+			Width:  int64(domain.BBox.Width),
+			Height: int64(domain.BBox.Height),
+		},
+		Area:      domain.Area,
+		Perimeter: domain.Perimeter,
+		MajorAxis: domain.MajorAxis,
+		MinorAxis: domain.MinorAxis,
+		Unit:      measureUnitFromDomainMap[domain.Unit],
+	}
+}
+
+func NodeMeasurementFromDomain(domain *domain.NodeMeasurement) *pb.NodeMeasurement {
+	if domain == nil {
+		return nil
+	}
+	return &pb.NodeMeasurement{
+		Area:      domain.Area,
+		Perimeter: domain.Perimeter,
+		MajorAxis: domain.MajorAxis,
+		MinorAxis: domain.MinorAxis,
+		Volume:    domain.Volume,
+		Unit:      measureUnitFromDomainMap[domain.Unit],
+	}
+}
@@ -17,6 +17,34 @@ var nodeValidationToDomainMap = map[pb.NodeValidation]domain.NodeValidation{
 	pb.NodeValidation_NODE_VALIDATION_VALID:   domain.NodeValidationValid,
 }
 
+var nodeLobeFromDomainMap = map[domain.NodeLobe]pb.NodeLobe{
+	domain.NodeLobeRight:   pb.NodeLobe_NODE_LOBE_RIGHT,
+	domain.NodeLobeLeft:    pb.NodeLobe_NODE_LOBE_LEFT,
+	domain.NodeLobeIsthmus: pb.NodeLobe_NODE_LOBE_ISTHMUS,
+}
+
+var nodeLobeToDomainMap = map[pb.NodeLobe]domain.NodeLobe{
+	pb.NodeLobe_NODE_LOBE_RIGHT:   domain.NodeLobeRight,
+	pb.NodeLobe_NODE_LOBE_LEFT:    domain.NodeLobeLeft,
+	pb.NodeLobe_NODE_LOBE_ISTHMUS: domain.NodeLobeIsthmus,
+}
+
+func NodeLobeFromDomain(lobe *domain.NodeLobe) *pb.NodeLobe {
+	if lobe == nil {
+		return nil
+	}
+	v := nodeLobeFromDomainMap[*lobe]
+	return &v
+}
+
+func NodeLobeToDomain(lobe *pb.NodeLobe) *domain.NodeLobe {
+	if lobe == nil {
+		return nil
+	}
+	v := nodeLobeToDomainMap[*lobe]
+	return &v
+}
+
 func NodeValidationFromDomain(validation *domain.NodeValidation) *pb.NodeValidation {
 	if validation == nil {
 		return nil
@@ -43,6 +71,8 @@ func NodeFromDomain(domain domain.Node) *pb.Node {
 		Tirads_4:    domain.Tirads4,
 		Tirads_5:    domain.Tirads5,
 		Description: domain.Description,
+		Measurement: NodeMeasurementFromDomain(domain.Measurement),
+		Lobe:        NodeLobeFromDomain(domain.Lobe),
 	}
 }
 
@@ -0,0 +1,34 @@
+package mappers
+
+import (
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+var nodeReviewStatusFromDomainMap = map[domain.NodeReviewStatus]pb.NodeReviewStatus{
+	domain.NodeReviewStatusApplied:          pb.NodeReviewStatus_NODE_REVIEW_STATUS_APPLIED,
+	domain.NodeReviewStatusUnchanged:        pb.NodeReviewStatus_NODE_REVIEW_STATUS_UNCHANGED,
+	domain.NodeReviewStatusNotFound:         pb.NodeReviewStatus_NODE_REVIEW_STATUS_NOT_FOUND,
+	domain.NodeReviewStatusManualNode:       pb.NodeReviewStatus_NODE_REVIEW_STATUS_MANUAL_NODE,
+	domain.NodeReviewStatusAlreadyValidated: pb.NodeReviewStatus_NODE_REVIEW_STATUS_ALREADY_VALIDATED,
+	domain.NodeReviewStatusBelowThreshold:   pb.NodeReviewStatus_NODE_REVIEW_STATUS_BELOW_THRESHOLD,
+}
+
+func NodeReviewItemFromDomain(domain domain.NodeReviewItem) *pb.NodeReviewItem {
+	item := &pb.NodeReviewItem{
+		NodeId: domain.NodeID.String(),
+		Status: nodeReviewStatusFromDomainMap[domain.Status],
+	}
+	if domain.Node != nil {
+		item.Node = NodeFromDomain(*domain.Node)
+	}
+	return item
+}
+
+func SliceNodeReviewItemFromDomain(domains []domain.NodeReviewItem) []*pb.NodeReviewItem {
+	pbs := make([]*pb.NodeReviewItem, 0, len(domains))
+	for _, d := range domains {
+		pbs = append(pbs, NodeReviewItemFromDomain(d))
+	}
+	return pbs
+}
@@ -0,0 +1,41 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ParseOptUUID разбирает необязательное поле запроса, ошибка уже в виде grpc статуса
+func ParseOptUUID(field string, value *string) (*uuid.UUID, error) {
+	if value == nil {
+		return nil, nil
+	}
+	id, err := uuid.Parse(*value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a valid uuid: %s", field, err.Error())
+	}
+	return &id, nil
+}
+
+// ParseOptTime разбирает необязательное время в RFC3339, ошибка уже в виде grpc статуса
+func ParseOptTime(field string, value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a valid RFC3339 time: %s", field, err.Error())
+	}
+	return &t, nil
+}
+
+func UUIDsToStrings(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
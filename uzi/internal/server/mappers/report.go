@@ -0,0 +1,27 @@
+package mappers
+
+import (
+	"time"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+func ReportFromDomain(domain domain.Report) *pb.Report {
+	return &pb.Report{
+		Id:       domain.Id.String(),
+		UziId:    domain.UziID.String(),
+		Version:  int64(domain.Version),
+		HtmlPath: domain.HtmlPath,
+		PdfPath:  domain.PdfPath,
+		CreateAt: domain.CreateAt.Format(time.RFC3339),
+	}
+}
+
+func SliceReportFromDomain(domains []domain.Report) []*pb.Report {
+	pbs := make([]*pb.Report, 0, len(domains))
+	for _, d := range domains {
+		pbs = append(pbs, ReportFromDomain(d))
+	}
+	return pbs
+}
@@ -15,6 +15,8 @@ func SegmentFromDomain(domain domain.Segment) *pb.Segment {
 		Tirads_23: domain.Tirads23,
 		Tirads_4:  domain.Tirads4,
 		Tirads_5:  domain.Tirads5,
+
+		Measurement: SegmentMeasurementFromDomain(domain.Measurement),
 	}
 }
 
@@ -0,0 +1,31 @@
+package mappers
+
+import (
+	"time"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+func SegmentDraftFromDomain(domain domain.SegmentDraft) *pb.SegmentDraft {
+	return &pb.SegmentDraft{
+		Id:              domain.Id.String(),
+		NodeId:          domain.NodeID.String(),
+		ImageId:         domain.ImageID.String(),
+		SourceSegmentId: domain.SourceSegmentID.String(),
+		Contor:          domain.Contor,
+		Tirads_23:       domain.Tirads23,
+		Tirads_4:        domain.Tirads4,
+		Tirads_5:        domain.Tirads5,
+		Score:           domain.Score,
+		CreateAt:        domain.CreateAt.Format(time.RFC3339),
+	}
+}
+
+func SliceSegmentDraftFromDomain(domains []domain.SegmentDraft) []*pb.SegmentDraft {
+	pbs := make([]*pb.SegmentDraft, 0, len(domains))
+	for _, d := range domains {
+		pbs = append(pbs, SegmentDraftFromDomain(d))
+	}
+	return pbs
+}
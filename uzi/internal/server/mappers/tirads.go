@@ -0,0 +1,127 @@
+package mappers
+
+import (
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+)
+
+var tiradsCompositionFromDomainMap = map[domain.TiradsComposition]pb.TiradsComposition{
+	domain.TiradsCompositionCystic:     pb.TiradsComposition_TIRADS_COMPOSITION_CYSTIC,
+	domain.TiradsCompositionSpongiform: pb.TiradsComposition_TIRADS_COMPOSITION_SPONGIFORM,
+	domain.TiradsCompositionMixed:      pb.TiradsComposition_TIRADS_COMPOSITION_MIXED,
+	domain.TiradsCompositionSolid:      pb.TiradsComposition_TIRADS_COMPOSITION_SOLID,
+}
+
+var TiradsCompositionToDomainMap = map[pb.TiradsComposition]domain.TiradsComposition{
+	pb.TiradsComposition_TIRADS_COMPOSITION_CYSTIC:     domain.TiradsCompositionCystic,
+	pb.TiradsComposition_TIRADS_COMPOSITION_SPONGIFORM: domain.TiradsCompositionSpongiform,
+	pb.TiradsComposition_TIRADS_COMPOSITION_MIXED:      domain.TiradsCompositionMixed,
+	pb.TiradsComposition_TIRADS_COMPOSITION_SOLID:      domain.TiradsCompositionSolid,
+}
+
+var tiradsEchogenicityFromDomainMap = map[domain.TiradsEchogenicity]pb.TiradsEchogenicity{
+	domain.TiradsEchogenicityAnechoic: pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_ANECHOIC,
+	domain.TiradsEchogenicityHyperIso: pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPER_ISO,
+	domain.TiradsEchogenicityHypo:     pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPO,
+	domain.TiradsEchogenicityVeryHypo: pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_VERY_HYPO,
+}
+
+var TiradsEchogenicityToDomainMap = map[pb.TiradsEchogenicity]domain.TiradsEchogenicity{
+	pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_ANECHOIC:  domain.TiradsEchogenicityAnechoic,
+	pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPER_ISO: domain.TiradsEchogenicityHyperIso,
+	pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPO:      domain.TiradsEchogenicityHypo,
+	pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_VERY_HYPO: domain.TiradsEchogenicityVeryHypo,
+}
+
+var tiradsShapeFromDomainMap = map[domain.TiradsShape]pb.TiradsShape{
+	domain.TiradsShapeWiderThanTall:  pb.TiradsShape_TIRADS_SHAPE_WIDER_THAN_TALL,
+	domain.TiradsShapeTallerThanWide: pb.TiradsShape_TIRADS_SHAPE_TALLER_THAN_WIDE,
+}
+
+var TiradsShapeToDomainMap = map[pb.TiradsShape]domain.TiradsShape{
+	pb.TiradsShape_TIRADS_SHAPE_WIDER_THAN_TALL:  domain.TiradsShapeWiderThanTall,
+	pb.TiradsShape_TIRADS_SHAPE_TALLER_THAN_WIDE: domain.TiradsShapeTallerThanWide,
+}
+
+var tiradsMarginFromDomainMap = map[domain.TiradsMargin]pb.TiradsMargin{
+	domain.TiradsMarginSmooth:         pb.TiradsMargin_TIRADS_MARGIN_SMOOTH,
+	domain.TiradsMarginIllDefined:     pb.TiradsMargin_TIRADS_MARGIN_ILL_DEFINED,
+	domain.TiradsMarginLobulated:      pb.TiradsMargin_TIRADS_MARGIN_LOBULATED,
+	domain.TiradsMarginExtrathyroidal: pb.TiradsMargin_TIRADS_MARGIN_EXTRATHYROIDAL,
+}
+
+var TiradsMarginToDomainMap = map[pb.TiradsMargin]domain.TiradsMargin{
+	pb.TiradsMargin_TIRADS_MARGIN_SMOOTH:         domain.TiradsMarginSmooth,
+	pb.TiradsMargin_TIRADS_MARGIN_ILL_DEFINED:    domain.TiradsMarginIllDefined,
+	pb.TiradsMargin_TIRADS_MARGIN_LOBULATED:      domain.TiradsMarginLobulated,
+	pb.TiradsMargin_TIRADS_MARGIN_EXTRATHYROIDAL: domain.TiradsMarginExtrathyroidal,
+}
+
+var tiradsEchogenicFociFromDomainMap = map[domain.TiradsEchogenicFoci]pb.TiradsEchogenicFoci{
+	domain.TiradsEchogenicFociNone:                pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_NONE,
+	domain.TiradsEchogenicFociMacrocalcifications: pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_MACROCALCIFICATIONS,
+	domain.TiradsEchogenicFociPeripheral:          pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PERIPHERAL,
+	domain.TiradsEchogenicFociPunctate:            pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PUNCTATE,
+}
+
+var TiradsEchogenicFociToDomainMap = map[pb.TiradsEchogenicFoci]domain.TiradsEchogenicFoci{
+	pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_NONE:                domain.TiradsEchogenicFociNone,
+	pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_MACROCALCIFICATIONS: domain.TiradsEchogenicFociMacrocalcifications,
+	pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PERIPHERAL:          domain.TiradsEchogenicFociPeripheral,
+	pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PUNCTATE:            domain.TiradsEchogenicFociPunctate,
+}
+
+var tiradsCategoryFromDomainMap = map[domain.TiradsCategory]pb.TiradsCategory{
+	domain.TiradsCategoryTR1: pb.TiradsCategory_TIRADS_CATEGORY_TR1,
+	domain.TiradsCategoryTR2: pb.TiradsCategory_TIRADS_CATEGORY_TR2,
+	domain.TiradsCategoryTR3: pb.TiradsCategory_TIRADS_CATEGORY_TR3,
+	domain.TiradsCategoryTR4: pb.TiradsCategory_TIRADS_CATEGORY_TR4,
+	domain.TiradsCategoryTR5: pb.TiradsCategory_TIRADS_CATEGORY_TR5,
+}
+
+var tiradsRecommendationFromDomainMap = map[domain.TiradsRecommendation]pb.TiradsRecommendation{
+	domain.TiradsRecommendationNone:     pb.TiradsRecommendation_TIRADS_RECOMMENDATION_NONE,
+	domain.TiradsRecommendationFollowUp: pb.TiradsRecommendation_TIRADS_RECOMMENDATION_FOLLOW_UP,
+	domain.TiradsRecommendationFNA:      pb.TiradsRecommendation_TIRADS_RECOMMENDATION_FNA,
+	domain.TiradsRecommendationUnknown:  pb.TiradsRecommendation_TIRADS_RECOMMENDATION_UNKNOWN,
+}
+
+func NodeDescriptorsFromDomain(domain *domain.NodeDescriptors) *pb.NodeDescriptors {
+	if domain == nil {
+		return nil
+	}
+
+	foci := make([]pb.TiradsEchogenicFoci, 0, len(domain.EchogenicFoci))
+	for _, v := range domain.EchogenicFoci {
+		foci = append(foci, tiradsEchogenicFociFromDomainMap[v])
+	}
+
+	return &pb.NodeDescriptors{
+		NodeId:        domain.NodeID.String(),
+		Composition:   tiradsCompositionFromDomainMap[domain.Composition],
+		Echogenicity:  tiradsEchogenicityFromDomainMap[domain.Echogenicity],
+		Shape:         tiradsShapeFromDomainMap[domain.Shape],
+		Margin:        tiradsMarginFromDomainMap[domain.Margin],
+		EchogenicFoci: foci,
+	}
+}
+
+func TiradsScoreFromDomain(domain *domain.TiradsScore) *pb.TiradsScore {
+	if domain == nil {
+		return nil
+	}
+	return &pb.TiradsScore{
+		Points:         int64(domain.Points),
+		Category:       tiradsCategoryFromDomainMap[domain.Category],
+		Recommendation: tiradsRecommendationFromDomainMap[domain.Recommendation],
+		MaxDiameter:    domain.MaxDiameter,
+	}
+}
+
+func NodeTiradsFromDomain(domain domain.NodeTirads) *pb.NodeTirads {
+	return &pb.NodeTirads{
+		Node:        NodeFromDomain(domain.Node),
+		Descriptors: NodeDescriptorsFromDomain(domain.Descriptors),
+		Score:       TiradsScoreFromDomain(domain.Score),
+	}
+}
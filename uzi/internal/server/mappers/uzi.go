@@ -13,6 +13,17 @@ var uziStatusMap = map[domain.UziStatus]pb.UziStatus{
 	domain.UziStatusCompleted: pb.UziStatus_UZI_STATUS_COMPLETED,
 }
 
+var UziStatusReverseMap = map[pb.UziStatus]domain.UziStatus{
+	pb.UziStatus_UZI_STATUS_NEW:       domain.UziStatusNew,
+	pb.UziStatus_UZI_STATUS_PENDING:   domain.UziStatusPending,
+	pb.UziStatus_UZI_STATUS_COMPLETED: domain.UziStatusCompleted,
+}
+
+var SortOrderReverseMap = map[pb.SortOrder]domain.SortOrder{
+	pb.SortOrder_SORT_ORDER_DESC: domain.SortOrderDesc,
+	pb.SortOrder_SORT_ORDER_ASC:  domain.SortOrderAsc,
+}
+
 var UziProjectionMap = map[domain.UziProjection]pb.UziProjection{
 	domain.UziProjectionLong:  pb.UziProjection_UZI_PROJECTION_LONG,
 	domain.UziProjectionCross: pb.UziProjection_UZI_PROJECTION_CROSS,
@@ -34,6 +45,9 @@ func UziFromDomain(domain domain.Uzi) *pb.Uzi {
 		Status:      uziStatusMap[domain.Status],
 		Description: domain.Description,
 		CreateAt:    domain.CreateAt.Format(time.RFC3339),
+
+		PixelSpacing: PixelSpacingFromDomain(domain.PixelSpacing),
+		Sha256:       domain.Sha256,
 	}
 }
 
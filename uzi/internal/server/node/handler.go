@@ -13,6 +13,10 @@ type NodeHandler interface {
 	GetNodesByUziId(ctx context.Context, in *pb.GetNodesByUziIdIn) (*pb.GetNodesByUziIdOut, error)
 	UpdateNode(ctx context.Context, in *pb.UpdateNodeIn) (*pb.UpdateNodeOut, error)
 	DeleteNode(ctx context.Context, in *pb.DeleteNodeIn) (*empty.Empty, error)
+
+	ReviewNodes(ctx context.Context, in *pb.ReviewNodesIn) (*pb.ReviewNodesOut, error)
+	CheckUzi(ctx context.Context, in *pb.CheckUziIn) (*pb.CheckUziOut, error)
+	AcceptAiNodes(ctx context.Context, in *pb.AcceptAiNodesIn) (*pb.AcceptAiNodesOut, error)
 }
 
 type handler struct {
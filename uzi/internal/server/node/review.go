@@ -0,0 +1,72 @@
+package node
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) ReviewNodes(ctx context.Context, in *pb.ReviewNodesIn) (*pb.ReviewNodesOut, error) {
+	ids := make([]uuid.UUID, 0, len(in.NodeIds))
+	for _, v := range in.NodeIds {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "node_ids is not a valid uuid: %s", err.Error())
+		}
+		ids = append(ids, id)
+	}
+
+	items, err := h.services.Review.ReviewNodes(ctx, ids, *mappers.NodeValidationToDomain(&in.Validation))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	return &pb.ReviewNodesOut{Items: mappers.SliceNodeReviewItemFromDomain(items)}, nil
+}
+
+func (h *handler) CheckUzi(ctx context.Context, in *pb.CheckUziIn) (*pb.CheckUziOut, error) {
+	if _, err := uuid.Parse(in.UziId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "uzi_id is not a valid uuid: %s", err.Error())
+	}
+
+	uzi, items, err := h.services.Review.CheckUzi(ctx, uuid.MustParse(in.UziId), mappers.NodeValidationToDomain(in.Unvalidated))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	return &pb.CheckUziOut{
+		Uzi:   mappers.UziFromDomain(uzi),
+		Items: mappers.SliceNodeReviewItemFromDomain(items),
+	}, nil
+}
+
+func (h *handler) AcceptAiNodes(ctx context.Context, in *pb.AcceptAiNodesIn) (*pb.AcceptAiNodesOut, error) {
+	if _, err := uuid.Parse(in.UziId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "uzi_id is not a valid uuid: %s", err.Error())
+	}
+
+	items, err := h.services.Review.AcceptAiNodes(ctx, uuid.MustParse(in.UziId), in.Threshold)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	return &pb.AcceptAiNodesOut{Items: mappers.SliceNodeReviewItemFromDomain(items)}, nil
+}
+
+func reviewStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBadRequest):
+		return status.Errorf(codes.InvalidArgument, "Некорректный запрос: %s", err.Error())
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Errorf(codes.NotFound, "Узи не найдено")
+	default:
+		return status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+}
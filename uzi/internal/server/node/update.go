@@ -25,6 +25,7 @@ func (h *handler) UpdateNode(ctx context.Context, in *pb.UpdateNodeIn) (*pb.Upda
 			Tirads23:   in.Tirads_23,
 			Tirads4:    in.Tirads_4,
 			Tirads5:    in.Tirads_5,
+			Lobe:       mappers.NodeLobeToDomain(in.Lobe),
 		},
 	)
 	if err != nil {
@@ -0,0 +1,69 @@
+package node_segment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/repository/entity"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) MergeNodes(ctx context.Context, in *pb.MergeNodesIn) (*pb.MergeNodesOut, error) {
+	ids := make([]uuid.UUID, 0, len(in.NodeIds))
+	for _, v := range in.NodeIds {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "node_ids is not a valid uuid: %s", err.Error())
+		}
+		ids = append(ids, id)
+	}
+
+	node, err := h.services.NodeSegment.MergeNodes(ctx, ids)
+	if err != nil {
+		return nil, correctionStatus(err)
+	}
+
+	return &pb.MergeNodesOut{Node: mappers.NodeFromDomain(node)}, nil
+}
+
+func (h *handler) SplitNode(ctx context.Context, in *pb.SplitNodeIn) (*pb.SplitNodeOut, error) {
+	if _, err := uuid.Parse(in.NodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "node_id is not a valid uuid: %s", err.Error())
+	}
+
+	segmentIDs := make([]uuid.UUID, 0, len(in.SegmentIds))
+	for _, v := range in.SegmentIds {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "segment_ids is not a valid uuid: %s", err.Error())
+		}
+		segmentIDs = append(segmentIDs, id)
+	}
+
+	node, newNode, err := h.services.NodeSegment.SplitNode(ctx, uuid.MustParse(in.NodeId), segmentIDs)
+	if err != nil {
+		return nil, correctionStatus(err)
+	}
+
+	return &pb.SplitNodeOut{
+		Node:    mappers.NodeFromDomain(node),
+		NewNode: mappers.NodeFromDomain(newNode),
+	}, nil
+}
+
+func correctionStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBadRequest):
+		return status.Errorf(codes.InvalidArgument, "Некорректное исправление узлов: %s", err.Error())
+	case errors.Is(err, entity.ErrNotFound):
+		return status.Errorf(codes.NotFound, "Узел не найден")
+	default:
+		return status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+}
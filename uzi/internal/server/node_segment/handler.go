@@ -11,6 +11,11 @@ type NodeSegmentHandler interface {
 	CreateNodeWithSegments(ctx context.Context, in *pb.CreateNodeWithSegmentsIn) (*pb.CreateNodeWithSegmentsOut, error)
 
 	GetNodesWithSegmentsByImageId(ctx context.Context, in *pb.GetNodesWithSegmentsByImageIdIn) (*pb.GetNodesWithSegmentsByImageIdOut, error)
+
+	RecalculateMeasurements(ctx context.Context, in *pb.RecalculateMeasurementsIn) (*pb.RecalculateMeasurementsOut, error)
+
+	MergeNodes(ctx context.Context, in *pb.MergeNodesIn) (*pb.MergeNodesOut, error)
+	SplitNode(ctx context.Context, in *pb.SplitNodeIn) (*pb.SplitNodeOut, error)
 }
 
 type handler struct {
@@ -0,0 +1,36 @@
+package node_segment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/repository/entity"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) RecalculateMeasurements(ctx context.Context, in *pb.RecalculateMeasurementsIn) (*pb.RecalculateMeasurementsOut, error) {
+	if _, err := uuid.Parse(in.UziId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "uzi_id is not a valid uuid: %s", err.Error())
+	}
+
+	nodes, segments, err := h.services.NodeSegment.RecalculateMeasurements(ctx, uuid.MustParse(in.UziId))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "УЗИ не найдено")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.RecalculateMeasurementsOut)
+	out.Nodes = mappers.SliceNodeFromDomain(nodes)
+	out.Segments = mappers.SliceSegmentFromDomain(segments)
+
+	return out, nil
+}
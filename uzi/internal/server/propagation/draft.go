@@ -0,0 +1,51 @@
+package propagation
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) GetSegmentDraftsByNodeId(ctx context.Context, in *pb.GetSegmentDraftsByNodeIdIn) (*pb.GetSegmentDraftsByNodeIdOut, error) {
+	if _, err := uuid.Parse(in.NodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "node_id is not a valid uuid: %s", err.Error())
+	}
+
+	drafts, err := h.services.Propagation.GetSegmentDraftsByNodeID(ctx, uuid.MustParse(in.NodeId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	return &pb.GetSegmentDraftsByNodeIdOut{Drafts: mappers.SliceSegmentDraftFromDomain(drafts)}, nil
+}
+
+func (h *handler) AcceptSegmentDraft(ctx context.Context, in *pb.AcceptSegmentDraftIn) (*pb.AcceptSegmentDraftOut, error) {
+	if _, err := uuid.Parse(in.Id); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "id is not a valid uuid: %s", err.Error())
+	}
+
+	segment, err := h.services.Propagation.AcceptSegmentDraft(ctx, uuid.MustParse(in.Id))
+	if err != nil {
+		return nil, propagationStatus(err)
+	}
+
+	return &pb.AcceptSegmentDraftOut{Segment: mappers.SegmentFromDomain(segment)}, nil
+}
+
+func (h *handler) DiscardSegmentDraft(ctx context.Context, in *pb.DiscardSegmentDraftIn) (*empty.Empty, error) {
+	if _, err := uuid.Parse(in.Id); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "id is not a valid uuid: %s", err.Error())
+	}
+
+	if err := h.services.Propagation.DiscardSegmentDraft(ctx, uuid.MustParse(in.Id)); err != nil {
+		return nil, propagationStatus(err)
+	}
+
+	return &empty.Empty{}, nil
+}
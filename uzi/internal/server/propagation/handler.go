@@ -0,0 +1,32 @@
+package propagation
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/services"
+)
+
+type PropagationHandler interface {
+	ProposeSegments(ctx context.Context, in *pb.ProposeSegmentsIn) (*pb.ProposeSegmentsOut, error)
+
+	GetSegmentDraftsByNodeId(ctx context.Context, in *pb.GetSegmentDraftsByNodeIdIn) (*pb.GetSegmentDraftsByNodeIdOut, error)
+
+	AcceptSegmentDraft(ctx context.Context, in *pb.AcceptSegmentDraftIn) (*pb.AcceptSegmentDraftOut, error)
+
+	DiscardSegmentDraft(ctx context.Context, in *pb.DiscardSegmentDraftIn) (*empty.Empty, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(
+	services *services.Services,
+) PropagationHandler {
+	return &handler{
+		services: services,
+	}
+}
@@ -0,0 +1,50 @@
+package propagation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/repository/entity"
+	"uzi/internal/server/mappers"
+	"uzi/internal/services/propagation"
+	"uzi/internal/services/segment"
+)
+
+func (h *handler) ProposeSegments(ctx context.Context, in *pb.ProposeSegmentsIn) (*pb.ProposeSegmentsOut, error) {
+	if _, err := uuid.Parse(in.SegmentId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "segment_id is not a valid uuid: %s", err.Error())
+	}
+
+	depth := propagation.DefaultDepth
+	if in.Depth != nil {
+		depth = int(*in.Depth)
+	}
+
+	drafts, err := h.services.Propagation.ProposeSegments(ctx, uuid.MustParse(in.SegmentId), depth)
+	if err != nil {
+		return nil, propagationStatus(err)
+	}
+
+	return &pb.ProposeSegmentsOut{Drafts: mappers.SliceSegmentDraftFromDomain(drafts)}, nil
+}
+
+func propagationStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBadRequest), errors.Is(err, domain.ErrInvalidContor):
+		return status.Errorf(codes.InvalidArgument, "Некорректный запрос: %s", err.Error())
+	case errors.Is(err, segment.ErrAddSegmentToAiNode):
+		return status.Errorf(codes.FailedPrecondition, "Узел нейросети не редактируется: %s", err.Error())
+	case errors.Is(err, domain.ErrConflict):
+		return status.Errorf(codes.AlreadyExists, "%s", err.Error())
+	case errors.Is(err, entity.ErrNotFound):
+		return status.Errorf(codes.NotFound, "Не найдено")
+	default:
+		return status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+}
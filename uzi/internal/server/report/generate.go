@@ -0,0 +1,37 @@
+package report
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) GenerateReport(ctx context.Context, in *pb.GenerateReportIn) (*pb.GenerateReportOut, error) {
+	if _, err := uuid.Parse(in.UziId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "uzi_id is not a valid uuid: %s", err.Error())
+	}
+
+	report, err := h.services.Report.GenerateReport(ctx, uuid.MustParse(in.UziId))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "УЗИ не найдено")
+		case errors.Is(err, domain.ErrConflict):
+			return nil, status.Errorf(codes.AlreadyExists, "Заключение уже формируется")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.GenerateReportOut)
+	out.Report = mappers.ReportFromDomain(report)
+
+	return out, nil
+}
@@ -0,0 +1,57 @@
+package report
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) GetReports(ctx context.Context, in *pb.GetReportsIn) (*pb.GetReportsOut, error) {
+	if _, err := uuid.Parse(in.UziId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "uzi_id is not a valid uuid: %s", err.Error())
+	}
+
+	reports, err := h.services.Report.GetReports(ctx, uuid.MustParse(in.UziId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	out := new(pb.GetReportsOut)
+	out.Reports = mappers.SliceReportFromDomain(reports)
+
+	return out, nil
+}
+
+func (h *handler) GetReport(ctx context.Context, in *pb.GetReportIn) (*pb.GetReportOut, error) {
+	if _, err := uuid.Parse(in.UziId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "uzi_id is not a valid uuid: %s", err.Error())
+	}
+
+	var version *int
+	if in.Version != nil {
+		version = pointer.To(int(*in.Version))
+	}
+
+	report, err := h.services.Report.GetReport(ctx, uuid.MustParse(in.UziId), version)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Заключение не найдено")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.GetReportOut)
+	out.Report = mappers.ReportFromDomain(report)
+
+	return out, nil
+}
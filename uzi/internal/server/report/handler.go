@@ -0,0 +1,26 @@
+package report
+
+import (
+	"context"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/services"
+)
+
+type ReportHandler interface {
+	GenerateReport(ctx context.Context, in *pb.GenerateReportIn) (*pb.GenerateReportOut, error)
+	GetReports(ctx context.Context, in *pb.GetReportsIn) (*pb.GetReportsOut, error)
+	GetReport(ctx context.Context, in *pb.GetReportIn) (*pb.GetReportOut, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(
+	services *services.Services,
+) ReportHandler {
+	return &handler{
+		services: services,
+	}
+}
@@ -0,0 +1,25 @@
+package retention
+
+import (
+	"context"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/services"
+)
+
+type RetentionHandler interface {
+	RestoreUzi(ctx context.Context, in *pb.RestoreUziIn) (*pb.RestoreUziOut, error)
+	SweepStorageOrphans(ctx context.Context, in *pb.SweepStorageOrphansIn) (*pb.SweepStorageOrphansOut, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(
+	services *services.Services,
+) RetentionHandler {
+	return &handler{
+		services: services,
+	}
+}
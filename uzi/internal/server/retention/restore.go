@@ -0,0 +1,35 @@
+package retention
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) RestoreUzi(ctx context.Context, in *pb.RestoreUziIn) (*pb.RestoreUziOut, error) {
+	if _, err := uuid.Parse(in.Id); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "id is not a valid uuid: %s", err.Error())
+	}
+
+	uzi, err := h.services.Retention.RestoreUzi(ctx, uuid.MustParse(in.Id))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Удаленное УЗИ не найдено")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.RestoreUziOut)
+	out.Uzi = mappers.UziFromDomain(uzi)
+
+	return out, nil
+}
@@ -0,0 +1,23 @@
+package retention
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "uzi/internal/generated/grpc/service"
+)
+
+func (h *handler) SweepStorageOrphans(ctx context.Context, in *pb.SweepStorageOrphansIn) (*pb.SweepStorageOrphansOut, error) {
+	sweep, err := h.services.Retention.SweepOrphans(ctx, in.DryRun)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+	}
+
+	return &pb.SweepStorageOrphansOut{
+		DryRun:  sweep.DryRun,
+		Checked: int64(sweep.Checked),
+		Orphans: sweep.Orphans,
+	}, nil
+}
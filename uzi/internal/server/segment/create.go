@@ -3,11 +3,13 @@ package segment
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"uzi/internal/domain"
 	pb "uzi/internal/generated/grpc/service"
 	"uzi/internal/services/segment"
 )
@@ -34,7 +36,12 @@ func (h *handler) CreateSegment(ctx context.Context, in *pb.CreateSegmentIn) (*p
 		Tirads5:  in.Tirads_5,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		switch {
+		case errors.Is(err, domain.ErrInvalidContor):
+			return nil, status.Errorf(codes.InvalidArgument, "Некорректный контур: %s", err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
 	}
 
 	return &pb.CreateSegmentOut{
@@ -3,12 +3,14 @@ package segment
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/AlekSi/pointer"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"uzi/internal/domain"
 	pb "uzi/internal/generated/grpc/service"
 	"uzi/internal/server/mappers"
 	"uzi/internal/services/segment"
@@ -38,7 +40,12 @@ func (h *handler) UpdateSegment(ctx context.Context, in *pb.UpdateSegmentIn) (*p
 		},
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		switch {
+		case errors.Is(err, domain.ErrInvalidContor):
+			return nil, status.Errorf(codes.InvalidArgument, "Некорректный контур: %s", err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
 	}
 
 	out := new(pb.UpdateSegmentOut)
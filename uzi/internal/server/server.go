@@ -2,11 +2,20 @@ package grpc
 
 import (
 	"uzi/internal/generated/grpc/service"
+	"uzi/internal/server/analytics"
+	"uzi/internal/server/dataset"
 	"uzi/internal/server/device"
+	"uzi/internal/server/history"
 	"uzi/internal/server/image"
+	"uzi/internal/server/integrity"
+	"uzi/internal/server/lineage"
 	"uzi/internal/server/node"
 	"uzi/internal/server/node_segment"
+	"uzi/internal/server/propagation"
+	"uzi/internal/server/report"
+	"uzi/internal/server/retention"
 	"uzi/internal/server/segment"
+	"uzi/internal/server/tirads"
 	"uzi/internal/server/uzi"
 	"uzi/internal/services"
 )
@@ -19,6 +28,15 @@ type Handler struct {
 	node_segment.NodeSegmentHandler
 	node.NodeHandler
 	segment.SegmentHandler
+	tirads.TiradsHandler
+	lineage.LineageHandler
+	report.ReportHandler
+	dataset.DatasetHandler
+	history.HistoryHandler
+	retention.RetentionHandler
+	integrity.IntegrityHandler
+	analytics.AnalyticsHandler
+	propagation.PropagationHandler
 
 	service.UnsafeUziSrvServer
 }
@@ -32,6 +50,15 @@ func New(
 	nodeSegmentHandler := node_segment.New(services)
 	nodeHandler := node.New(services)
 	segmentHandler := segment.New(services)
+	tiradsHandler := tirads.New(services)
+	lineageHandler := lineage.New(services)
+	reportHandler := report.New(services)
+	datasetHandler := dataset.New(services)
+	historyHandler := history.New(services)
+	retentionHandler := retention.New(services)
+	integrityHandler := integrity.New(services)
+	analyticsHandler := analytics.New(services)
+	propagationHandler := propagation.New(services)
 
 	return &Handler{
 		DeviceHandler:      deviceHandler,
@@ -40,5 +67,14 @@ func New(
 		NodeSegmentHandler: nodeSegmentHandler,
 		NodeHandler:        nodeHandler,
 		SegmentHandler:     segmentHandler,
+		TiradsHandler:      tiradsHandler,
+		LineageHandler:     lineageHandler,
+		ReportHandler:      reportHandler,
+		DatasetHandler:     datasetHandler,
+		HistoryHandler:     historyHandler,
+		RetentionHandler:   retentionHandler,
+		IntegrityHandler:   integrityHandler,
+		AnalyticsHandler:   analyticsHandler,
+		PropagationHandler: propagationHandler,
 	}
 }
@@ -0,0 +1,35 @@
+package tirads
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+)
+
+func (h *handler) GetNodeTirads(ctx context.Context, in *pb.GetNodeTiradsIn) (*pb.GetNodeTiradsOut, error) {
+	if _, err := uuid.Parse(in.NodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "node_id is not a valid uuid: %s", err.Error())
+	}
+
+	nodeTirads, err := h.services.Tirads.GetNodeTirads(ctx, uuid.MustParse(in.NodeId))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Узел не найден")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.GetNodeTiradsOut)
+	out.Tirads = mappers.NodeTiradsFromDomain(nodeTirads)
+
+	return out, nil
+}
@@ -0,0 +1,25 @@
+package tirads
+
+import (
+	"context"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/services"
+)
+
+type TiradsHandler interface {
+	SetNodeDescriptors(ctx context.Context, in *pb.SetNodeDescriptorsIn) (*pb.SetNodeDescriptorsOut, error)
+	GetNodeTirads(ctx context.Context, in *pb.GetNodeTiradsIn) (*pb.GetNodeTiradsOut, error)
+}
+
+type handler struct {
+	services *services.Services
+}
+
+func New(
+	services *services.Services,
+) TiradsHandler {
+	return &handler{
+		services: services,
+	}
+}
@@ -0,0 +1,54 @@
+package tirads
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+	"uzi/internal/services/tirads"
+)
+
+func (h *handler) SetNodeDescriptors(ctx context.Context, in *pb.SetNodeDescriptorsIn) (*pb.SetNodeDescriptorsOut, error) {
+	if in.Descriptors == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "descriptors is required")
+	}
+
+	if _, err := uuid.Parse(in.Descriptors.NodeId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "node_id is not a valid uuid: %s", err.Error())
+	}
+
+	foci := make([]domain.TiradsEchogenicFoci, 0, len(in.Descriptors.EchogenicFoci))
+	for _, v := range in.Descriptors.EchogenicFoci {
+		foci = append(foci, mappers.TiradsEchogenicFociToDomainMap[v])
+	}
+
+	nodeTirads, err := h.services.Tirads.SetNodeDescriptors(ctx, tirads.SetNodeDescriptorsArg{
+		NodeID:        uuid.MustParse(in.Descriptors.NodeId),
+		Composition:   mappers.TiradsCompositionToDomainMap[in.Descriptors.Composition],
+		Echogenicity:  mappers.TiradsEchogenicityToDomainMap[in.Descriptors.Echogenicity],
+		Shape:         mappers.TiradsShapeToDomainMap[in.Descriptors.Shape],
+		Margin:        mappers.TiradsMarginToDomainMap[in.Descriptors.Margin],
+		EchogenicFoci: foci,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "Узел не найден")
+		case errors.Is(err, domain.ErrUnprocessableEntity):
+			return nil, status.Errorf(codes.FailedPrecondition, "Ошибка валидации данных")
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.SetNodeDescriptorsOut)
+	out.Tirads = mappers.NodeTiradsFromDomain(nodeTirads)
+
+	return out, nil
+}
@@ -23,15 +23,30 @@ func (h *handler) CreateUzi(ctx context.Context, in *pb.CreateUziIn) (*pb.Create
 		return nil, status.Errorf(codes.InvalidArgument, "author is not a valid uuid: %s", err.Error())
 	}
 
-	id, err := h.services.Uzi.CreateUzi(ctx, uzi.CreateUziArg{
+	if err := validatePixelSpacing(in.PixelSpacing); err != nil {
+		return nil, err
+	}
+
+	if in.Sha256 != nil && !isSha256Hex(*in.Sha256) {
+		return nil, status.Errorf(codes.InvalidArgument, "sha256 must be 64 lowercase hex chars")
+	}
+
+	id, duplicates, err := h.services.Uzi.CreateUzi(ctx, uzi.CreateUziArg{
 		Projection:  mappers.UziProjectionReverseMap[in.Projection],
 		ExternalID:  uuid.MustParse(in.ExternalId),
 		Author:      uuid.MustParse(in.Author),
 		DeviceID:    int(in.DeviceId),
 		Description: in.Description,
+
+		PixelSpacing: mappers.PixelSpacingToDomain(in.PixelSpacing),
+		Sha256:       in.Sha256,
 	})
 	if err != nil {
 		switch {
+		case errors.Is(err, uzi.ErrDuplicateUzi):
+			return nil, status.Errorf(codes.AlreadyExists, "Узи с тем же файлом уже загружено: %s", mappers.UUIDsToStrings(duplicates))
+		case errors.Is(err, uzi.ErrDeviceNotFound):
+			return nil, status.Errorf(codes.FailedPrecondition, "Аппарат не найден")
 		case errors.Is(err, domain.ErrUnprocessableEntity):
 			return nil, status.Errorf(codes.FailedPrecondition, "Ошибка валидации данных")
 		default:
@@ -39,5 +54,32 @@ func (h *handler) CreateUzi(ctx context.Context, in *pb.CreateUziIn) (*pb.Create
 		}
 	}
 
-	return &pb.CreateUziOut{Id: id.String()}, nil
+	return &pb.CreateUziOut{
+		Id:          id.String(),
+		DuplicateOf: mappers.UUIDsToStrings(duplicates),
+	}, nil
+}
+
+func isSha256Hex(sum string) bool {
+	if len(sum) != 64 {
+		return false
+	}
+	for _, c := range sum {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func validatePixelSpacing(spacing *pb.PixelSpacing) error {
+	if spacing == nil {
+		return nil
+	}
+
+	if spacing.X <= 0 || spacing.Y <= 0 {
+		return status.Errorf(codes.InvalidArgument, "pixel_spacing must be positive")
+	}
+
+	return nil
 }
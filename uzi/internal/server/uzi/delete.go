@@ -20,7 +20,7 @@ func (h *handler) DeleteUzi(ctx context.Context, req *pb.DeleteUziIn) (*emptypb.
 		return nil, status.Errorf(codes.InvalidArgument, "invalid uzi id: %v", err)
 	}
 
-	err = h.services.Uzi.DeleteUzi(ctx, id)
+	err = h.services.Retention.DeleteUzi(ctx, id)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrNotFound):
@@ -0,0 +1,87 @@
+package uzi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlekSi/pointer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"uzi/internal/domain"
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/internal/server/mappers"
+	"uzi/internal/services/uzi"
+)
+
+func validateProbability(field string, value *float64) error {
+	if value != nil && (*value < 0 || *value > 1) {
+		return status.Errorf(codes.InvalidArgument, "%s must be in [0, 1]", field)
+	}
+	return nil
+}
+
+func (h *handler) SearchUzis(ctx context.Context, in *pb.SearchUzisIn) (*pb.SearchUzisOut, error) {
+	author, err := mappers.ParseOptUUID("author", in.Author)
+	if err != nil {
+		return nil, err
+	}
+	externalID, err := mappers.ParseOptUUID("external_id", in.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	createFrom, err := mappers.ParseOptTime("create_from", in.CreateFrom)
+	if err != nil {
+		return nil, err
+	}
+	createTo, err := mappers.ParseOptTime("create_to", in.CreateTo)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateProbability("ai_tirads_4_min", in.AiTirads_4Min); err != nil {
+		return nil, err
+	}
+	if err := validateProbability("ai_tirads_5_min", in.AiTirads_5Min); err != nil {
+		return nil, err
+	}
+
+	filter := domain.UziFilter{
+		Author:       author,
+		ExternalID:   externalID,
+		Checked:      in.Checked,
+		CreateFrom:   createFrom,
+		CreateTo:     createTo,
+		AiTirads4Min: in.AiTirads_4Min,
+		AiTirads5Min: in.AiTirads_5Min,
+	}
+	if in.Status != nil {
+		filter.Status = pointer.To(mappers.UziStatusReverseMap[*in.Status])
+	}
+	if in.Projection != nil {
+		filter.Projection = pointer.To(mappers.UziProjectionReverseMap[*in.Projection])
+	}
+	if in.DeviceId != nil {
+		filter.DeviceID = pointer.To(int(*in.DeviceId))
+	}
+
+	page, err := h.services.Uzi.SearchUzis(ctx, uzi.SearchUzisArg{
+		Filter: filter,
+		Order:  mappers.SortOrderReverseMap[in.Order],
+		Cursor: in.Cursor,
+		Limit:  int(in.Limit),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBadRequest):
+			return nil, status.Errorf(codes.InvalidArgument, "Неверные параметры поиска: %s", err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "Что то пошло не так: %s", err.Error())
+		}
+	}
+
+	out := new(pb.SearchUzisOut)
+	out.Uzis = mappers.SliceUziFromDomain(page.Uzis)
+	out.NextCursor = page.NextCursor
+
+	return out, nil
+}
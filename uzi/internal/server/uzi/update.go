@@ -22,15 +22,20 @@ func (h *handler) UpdateUzi(ctx context.Context, in *pb.UpdateUziIn) (*pb.Update
 		return nil, status.Errorf(codes.InvalidArgument, "id is not a valid uuid: %s", err.Error())
 	}
 
+	if err := validatePixelSpacing(in.PixelSpacing); err != nil {
+		return nil, err
+	}
+
 	var projection *domain.UziProjection
 	if in.Projection != nil {
 		projection = pointer.To(mappers.UziProjectionReverseMap[*in.Projection])
 	}
 
 	uzi, err := h.services.Uzi.UpdateUzi(ctx, uzi.UpdateUziArg{
-		Id:         uuid.MustParse(in.Id),
-		Projection: projection,
-		Checked:    in.Checked,
+		Id:           uuid.MustParse(in.Id),
+		Projection:   projection,
+		Checked:      in.Checked,
+		PixelSpacing: mappers.PixelSpacingToDomain(in.PixelSpacing),
 	})
 	if err != nil {
 		switch {
@@ -56,6 +61,15 @@ func (h *handler) UpdateEchographic(ctx context.Context, in *pb.UpdateEchographi
 		return nil, status.Errorf(codes.InvalidArgument, "id is not a valid uuid: %s", err.Error())
 	}
 
+	if in.Echographic.PatientAge != nil && *in.Echographic.PatientAge < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "patient_age must not be negative")
+	}
+
+	var patientAge *int
+	if in.Echographic.PatientAge != nil {
+		patientAge = pointer.To(int(*in.Echographic.PatientAge))
+	}
+
 	echographic, err := h.services.Uzi.UpdateEchographic(
 		ctx,
 		uzi.UpdateEchographicArg{
@@ -71,13 +85,15 @@ func (h *handler) UpdateEchographic(ctx context.Context, in *pb.UpdateEchographi
 			RightLobeVolum:  in.Echographic.RightLobeVolum,
 			GlandVolum:      in.Echographic.GlandVolum,
 			Isthmus:         in.Echographic.Isthmus,
-			Struct:          in.Echographic.Struct,
-			Echogenicity:    in.Echographic.Echogenicity,
+			Struct:          mappers.EchographicStructFromPb(in.Echographic.Struct),
+			Echogenicity:    mappers.EchographicEchogenicityFromPb(in.Echographic.Echogenicity),
 			RegionalLymph:   in.Echographic.RegionalLymph,
-			Vascularization: in.Echographic.Vascularization,
+			Vascularization: mappers.EchographicVascularizationFromPb(in.Echographic.Vascularization),
 			Location:        in.Echographic.Location,
 			Additional:      in.Echographic.Additional,
 			Conclusion:      in.Echographic.Conclusion,
+			PatientSex:      mappers.PatientSexFromPb(in.Echographic.PatientSex),
+			PatientAge:      patientAge,
 		},
 	)
 	if err != nil {
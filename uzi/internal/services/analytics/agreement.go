@@ -0,0 +1,235 @@
+package analytics
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/analytics/entity"
+)
+
+// узлы двух врачей считаются одним, если IoU контуров не ниже порога:
+// границы узла врачи обводят по-разному, поэтому порог ниже принятого для детекции 0.5
+const matchIoU = 0.3
+
+// nodeContors контуры узла по кадрам
+type nodeContors map[uuid.UUID][][]domain.ContorPoint
+
+// reader врач, создавший ручной узел. У узлов без истории создателем считается автор узи
+func reader(node entity.AnalyticsNode) uuid.UUID {
+	if node.Creator.Valid {
+		return node.Creator.UUID
+	}
+	return node.Author
+}
+
+// readersByUzi ручные узлы каждого врача, только для узи, размеченных несколькими врачами
+func readersByUzi(nodes []entity.AnalyticsNode) map[uuid.UUID]map[uuid.UUID][]entity.AnalyticsNode {
+	byUzi := map[uuid.UUID]map[uuid.UUID][]entity.AnalyticsNode{}
+	for _, node := range nodes {
+		if node.Ai {
+			continue
+		}
+		if byUzi[node.UziID] == nil {
+			byUzi[node.UziID] = map[uuid.UUID][]entity.AnalyticsNode{}
+		}
+		byUzi[node.UziID][reader(node)] = append(byUzi[node.UziID][reader(node)], node)
+	}
+
+	for uziID, readers := range byUzi {
+		if len(readers) < 2 {
+			delete(byUzi, uziID)
+		}
+	}
+
+	return byUzi
+}
+
+// nodeIoU отношение пересечения к объединению контуров узлов по всем кадрам
+func nodeIoU(a, b nodeContors) float64 {
+	var intersection, union float64
+	for imageID, polygons := range a {
+		i, u := overlap(polygons, b[imageID])
+		intersection += i
+		union += u
+	}
+	for imageID, polygons := range b {
+		if _, ok := a[imageID]; ok {
+			continue
+		}
+		_, u := overlap(nil, polygons)
+		union += u
+	}
+
+	if union == 0 {
+		return 0
+	}
+	return intersection / union
+}
+
+type agreementAcc struct {
+	uzis      int
+	matched   int
+	unmatched int
+	iouSum    float64
+	// строки - класс узла первого врача, столбцы - второго
+	confusion [classCount][classCount]int
+}
+
+// match жадно сопоставляет узлы двух врачей на одном узи по убыванию IoU
+func (acc *agreementAcc) match(a, b []entity.AnalyticsNode, contors map[uuid.UUID]nodeContors) {
+	type candidate struct {
+		i, j int
+		iou  float64
+	}
+
+	candidates := []candidate{}
+	for i := range a {
+		for j := range b {
+			iou := nodeIoU(contors[a[i].Id], contors[b[j].Id])
+			if iou >= matchIoU {
+				candidates = append(candidates, candidate{i: i, j: j, iou: iou})
+			}
+		}
+	}
+	slices.SortStableFunc(candidates, func(x, y candidate) int {
+		switch {
+		case x.iou > y.iou:
+			return -1
+		case x.iou < y.iou:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	for _, c := range candidates {
+		if matchedA[c.i] || matchedB[c.j] {
+			continue
+		}
+		matchedA[c.i], matchedB[c.j] = true, true
+
+		acc.matched++
+		acc.iouSum += c.iou
+		acc.confusion[nodeClass(a[c.i])][nodeClass(b[c.j])]++
+	}
+
+	for i, ok := range matchedA {
+		if !ok {
+			acc.unmatched++
+			acc.confusion[nodeClass(a[i])][classAbsent]++
+		}
+	}
+	for j, ok := range matchedB {
+		if !ok {
+			acc.unmatched++
+			acc.confusion[classAbsent][nodeClass(b[j])]++
+		}
+	}
+}
+
+func (acc *agreementAcc) add(other *agreementAcc) {
+	acc.matched += other.matched
+	acc.unmatched += other.unmatched
+	acc.iouSum += other.iouSum
+	for i := range acc.confusion {
+		for j := range acc.confusion[i] {
+			acc.confusion[i][j] += other.confusion[i][j]
+		}
+	}
+}
+
+func (acc *agreementAcc) meanIoU() *float64 {
+	if acc.matched == 0 {
+		return nil
+	}
+	mean := acc.iouSum / float64(acc.matched)
+	return &mean
+}
+
+// cohenKappa nil, если согласие случайно полностью: все оценки в одном классе
+func cohenKappa(confusion [classCount][classCount]int) *float64 {
+	var total int
+	var rows, cols [classCount]int
+	var agree int
+	for i := range confusion {
+		for j, n := range confusion[i] {
+			total += n
+			rows[i] += n
+			cols[j] += n
+			if i == j {
+				agree += n
+			}
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	observed := float64(agree) / float64(total)
+	var expected float64
+	for i := range rows {
+		expected += float64(rows[i]) * float64(cols[i]) / float64(total) / float64(total)
+	}
+	if expected == 1 {
+		return nil
+	}
+
+	kappa := (observed - expected) / (1 - expected)
+	return &kappa
+}
+
+// agreement согласие по каждой паре врачей и по всем парам вместе
+func agreement(nodes []entity.AnalyticsNode, contors map[uuid.UUID]nodeContors, analytics *domain.Analytics) {
+	type pairKey struct{ a, b uuid.UUID }
+
+	pairs := map[pairKey]*agreementAcc{}
+	for _, readers := range readersByUzi(nodes) {
+		ids := make([]uuid.UUID, 0, len(readers))
+		for id := range readers {
+			ids = append(ids, id)
+		}
+		slices.SortFunc(ids, func(a, b uuid.UUID) int {
+			return strings.Compare(a.String(), b.String())
+		})
+
+		for i := range ids {
+			for j := i + 1; j < len(ids); j++ {
+				key := pairKey{a: ids[i], b: ids[j]}
+				if pairs[key] == nil {
+					pairs[key] = &agreementAcc{}
+				}
+				pairs[key].uzis++
+				pairs[key].match(readers[ids[i]], readers[ids[j]], contors)
+			}
+		}
+	}
+
+	total := &agreementAcc{}
+	analytics.Agreement = make([]domain.ReaderAgreement, 0, len(pairs))
+	for key, acc := range pairs {
+		total.add(acc)
+		analytics.Agreement = append(analytics.Agreement, domain.ReaderAgreement{
+			ReaderA:   key.a,
+			ReaderB:   key.b,
+			Uzis:      acc.uzis,
+			Matched:   acc.matched,
+			Unmatched: acc.unmatched,
+			Kappa:     cohenKappa(acc.confusion),
+			MeanIoU:   acc.meanIoU(),
+		})
+	}
+	slices.SortFunc(analytics.Agreement, func(a, b domain.ReaderAgreement) int {
+		if c := strings.Compare(a.ReaderA.String(), b.ReaderA.String()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ReaderB.String(), b.ReaderB.String())
+	})
+
+	analytics.Kappa = cohenKappa(total.confusion)
+	analytics.MeanIoU = total.meanIoU()
+}
@@ -0,0 +1,172 @@
+package analytics
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/analytics/entity"
+)
+
+func square(x, y, size float64) []domain.ContorPoint {
+	return []domain.ContorPoint{{X: x, Y: y}, {X: x + size, Y: y}, {X: x + size, Y: y + size}, {X: x, Y: y + size}}
+}
+
+func TestOverlap(t *testing.T) {
+	intersection, union := overlap([][]domain.ContorPoint{square(0, 0, 10)}, [][]domain.ContorPoint{square(5, 0, 10)})
+	require.InDelta(t, 50, intersection, 1)
+	require.InDelta(t, 150, union, 1)
+
+	intersection, union = overlap([][]domain.ContorPoint{square(0, 0, 10)}, nil)
+	require.Zero(t, intersection)
+	require.InDelta(t, 100, union, 1)
+
+	imageID := uuid.New()
+	require.InDelta(t, 1.0, nodeIoU(
+		nodeContors{imageID: {square(0, 0, 10)}},
+		nodeContors{imageID: {square(0, 0, 10)}},
+	), 0.01)
+	// узел второго врача есть еще на одном кадре
+	require.InDelta(t, 0.5, nodeIoU(
+		nodeContors{imageID: {square(0, 0, 10)}},
+		nodeContors{imageID: {square(0, 0, 10)}, uuid.New(): {square(0, 0, 10)}},
+	), 0.01)
+}
+
+func TestCohenKappa(t *testing.T) {
+	var confusion [classCount][classCount]int
+	require.Nil(t, cohenKappa(confusion))
+
+	// полное согласие в разных классах
+	confusion[classTirads23][classTirads23] = 5
+	confusion[classTirads5][classTirads5] = 5
+	require.InDelta(t, 1.0, *cohenKappa(confusion), 1e-9)
+
+	// пример из учебника: po = 0.7, pe = 0.5
+	confusion = [classCount][classCount]int{}
+	confusion[classTirads4][classTirads4] = 20
+	confusion[classTirads4][classTirads5] = 5
+	confusion[classTirads5][classTirads4] = 10
+	confusion[classTirads5][classTirads5] = 15
+	require.InDelta(t, 0.4, *cohenKappa(confusion), 1e-9)
+
+	// все оценки в одном классе
+	confusion = [classCount][classCount]int{}
+	confusion[classTirads4][classTirads4] = 3
+	require.Nil(t, cohenKappa(confusion))
+}
+
+func TestGroupMetrics(t *testing.T) {
+	authorA, authorB := uuid.New(), uuid.New()
+	uziA, uziB := uuid.New(), uuid.New()
+	january := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	february := time.Date(2024, 2, 3, 10, 0, 0, 0, time.UTC)
+
+	validation := func(v domain.NodeValidation) sql.NullString {
+		return sql.NullString{Valid: true, String: v.String()}
+	}
+
+	nodes := []entity.AnalyticsNode{
+		{UziID: uziA, Ai: true, Validation: validation(domain.NodeValidationValid), Tirads5: 0.8, Author: authorA, DeviceID: 1, CreateAt: january},
+		{UziID: uziA, Ai: true, Validation: validation(domain.NodeValidationInvalid), Tirads23: 0.9, Author: authorA, DeviceID: 1, CreateAt: january},
+		{UziID: uziA, Ai: true, Validation: validation(domain.NodeValidationNull), Tirads4: 0.6, Author: authorA, DeviceID: 1, CreateAt: january},
+		{UziID: uziB, Ai: true, Tirads4: 0.7, Author: authorB, DeviceID: 2, CreateAt: february},
+		{UziID: uziB, Ai: false, Tirads4: 1, Author: authorB, DeviceID: 2, CreateAt: february},
+	}
+
+	analytics := domain.Analytics{}
+	groupMetrics(nodes, domain.AnalyticsPeriodMonth, &analytics)
+
+	total := analytics.Total
+	require.Equal(t, 2, total.Uzis)
+	require.Equal(t, 4, total.AiNodes)
+	require.Equal(t, 1, total.ManualNodes)
+	require.Equal(t, 2, total.Unvalidated)
+	require.InDelta(t, 0.5, *total.Precision, 1e-9)
+	require.InDelta(t, 0.5, *total.UnvalidatedShare, 1e-9)
+	require.Equal(t, domain.TiradsDistribution{Tirads23: 1, Tirads4: 2, Tirads5: 1}, total.AiTirads)
+	require.Equal(t, domain.TiradsDistribution{Tirads4: 1}, total.ManualTirads)
+
+	require.Len(t, analytics.ByDevice, 2)
+	require.Equal(t, 1, analytics.ByDevice[0].DeviceID)
+	require.Equal(t, 3, analytics.ByDevice[0].Metrics.AiNodes)
+	// на втором аппарате узлы нейросети не провалидированы
+	require.Nil(t, analytics.ByDevice[1].Metrics.Precision)
+	require.InDelta(t, 1.0, *analytics.ByDevice[1].Metrics.UnvalidatedShare, 1e-9)
+
+	require.Len(t, analytics.ByAuthor, 2)
+
+	require.Len(t, analytics.ByPeriod, 2)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), analytics.ByPeriod[0].Start)
+	require.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), analytics.ByPeriod[1].Start)
+}
+
+func TestAgreement(t *testing.T) {
+	author, doctorA, doctorB := uuid.New(), uuid.New(), uuid.New()
+	uziID, imageID := uuid.New(), uuid.New()
+
+	manual := func(creator uuid.UUID, tirads5 float64) entity.AnalyticsNode {
+		return entity.AnalyticsNode{
+			Id:       uuid.New(),
+			UziID:    uziID,
+			Tirads23: 0.5,
+			Tirads5:  tirads5,
+			Author:   author,
+			Creator:  uuid.NullUUID{Valid: true, UUID: creator},
+		}
+	}
+
+	// оба врача нашли узел в одном месте, но оценили по-разному; второй узел только у врача A
+	a1, a2 := manual(doctorA, 1), manual(doctorA, 0)
+	b1 := manual(doctorB, 0)
+	// узел нейросети не участвует в согласии
+	ai := entity.AnalyticsNode{Id: uuid.New(), UziID: uziID, Ai: true, Author: author}
+
+	contors := map[uuid.UUID]nodeContors{
+		a1.Id: {imageID: {square(0, 0, 10)}},
+		a2.Id: {imageID: {square(50, 50, 10)}},
+		b1.Id: {imageID: {square(1, 0, 10)}},
+	}
+
+	analytics := domain.Analytics{}
+	agreement([]entity.AnalyticsNode{a1, a2, b1, ai}, contors, &analytics)
+
+	require.Len(t, analytics.Agreement, 1)
+	pair := analytics.Agreement[0]
+	require.ElementsMatch(t, []uuid.UUID{doctorA, doctorB}, []uuid.UUID{pair.ReaderA, pair.ReaderB})
+	require.Equal(t, 1, pair.Uzis)
+	require.Equal(t, 1, pair.Matched)
+	require.Equal(t, 1, pair.Unmatched)
+	require.InDelta(t, 0.9/1.1, *pair.MeanIoU, 0.01)
+	require.NotNil(t, pair.Kappa)
+	require.Less(t, *pair.Kappa, 0.0)
+	require.Equal(t, pair.Kappa, analytics.Kappa)
+}
+
+func TestAgreement_SingleReader(t *testing.T) {
+	author, uziID := uuid.New(), uuid.New()
+	nodes := []entity.AnalyticsNode{
+		{Id: uuid.New(), UziID: uziID, Author: author},
+		{Id: uuid.New(), UziID: uziID, Author: author},
+	}
+
+	analytics := domain.Analytics{}
+	agreement(nodes, nil, &analytics)
+
+	require.Empty(t, analytics.Agreement)
+	require.Nil(t, analytics.Kappa)
+	require.Nil(t, analytics.MeanIoU)
+}
+
+func TestPeriodStart(t *testing.T) {
+	// среда
+	at := time.Date(2024, 5, 15, 23, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	require.Equal(t, time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC), domain.AnalyticsPeriodDay.Start(at))
+	require.Equal(t, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), domain.AnalyticsPeriodWeek.Start(at))
+	require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), domain.AnalyticsPeriodMonth.Start(at))
+}
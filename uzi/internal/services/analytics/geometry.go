@@ -0,0 +1,67 @@
+package analytics
+
+import (
+	"math"
+
+	"uzi/internal/domain"
+)
+
+// число ячеек сетки по большей стороне при растеризации контуров
+const rasterCells = 256
+
+// contains правило четности, точка на границе может попасть в любую сторону
+func contains(polygon []domain.ContorPoint, p domain.ContorPoint) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func containsAny(polygons [][]domain.ContorPoint, p domain.ContorPoint) bool {
+	for _, polygon := range polygons {
+		if contains(polygon, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// overlap площади пересечения и объединения двух областей одного кадра в пикселях.
+// Область - объединение контуров, считается по центрам ячеек сетки поверх общей рамки
+func overlap(a, b [][]domain.ContorPoint) (float64, float64) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, polygons := range [][][]domain.ContorPoint{a, b} {
+		for _, polygon := range polygons {
+			for _, p := range polygon {
+				minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+				minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+			}
+		}
+	}
+	if math.IsInf(minX, 1) {
+		return 0, 0
+	}
+
+	cell := math.Max(math.Max(maxX-minX, maxY-minY)/rasterCells, 1)
+
+	var intersection, union int
+	for y := minY + cell/2; y < maxY; y += cell {
+		for x := minX + cell/2; x < maxX; x += cell {
+			p := domain.ContorPoint{X: x, Y: y}
+			inA, inB := containsAny(a, p), containsAny(b, p)
+			if inA && inB {
+				intersection++
+			}
+			if inA || inB {
+				union++
+			}
+		}
+	}
+
+	return float64(intersection) * cell * cell, float64(union) * cell * cell
+}
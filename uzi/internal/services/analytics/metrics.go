@@ -0,0 +1,141 @@
+package analytics
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/analytics/entity"
+)
+
+// классы TI-RADS по выходам нейросети и класс "нет узла" для несопоставленных узлов
+const (
+	classTirads23 = iota
+	classTirads4
+	classTirads5
+	classAbsent
+
+	classCount
+)
+
+// nodeClass класс с наибольшей вероятностью, при равенстве выбирается более тяжелый
+func nodeClass(node entity.AnalyticsNode) int {
+	switch {
+	case node.Tirads5 >= node.Tirads4 && node.Tirads5 >= node.Tirads23:
+		return classTirads5
+	case node.Tirads4 >= node.Tirads23:
+		return classTirads4
+	default:
+		return classTirads23
+	}
+}
+
+func addClass(distribution *domain.TiradsDistribution, class int) {
+	switch class {
+	case classTirads5:
+		distribution.Tirads5++
+	case classTirads4:
+		distribution.Tirads4++
+	default:
+		distribution.Tirads23++
+	}
+}
+
+type metricsAcc struct {
+	metrics domain.NodeMetrics
+	uzis    map[uuid.UUID]struct{}
+}
+
+func (m *metricsAcc) add(node entity.AnalyticsNode) {
+	if m.uzis == nil {
+		m.uzis = map[uuid.UUID]struct{}{}
+	}
+	m.uzis[node.UziID] = struct{}{}
+
+	if !node.Ai {
+		m.metrics.ManualNodes++
+		addClass(&m.metrics.ManualTirads, nodeClass(node))
+		return
+	}
+
+	m.metrics.AiNodes++
+	addClass(&m.metrics.AiTirads, nodeClass(node))
+	switch node.Validation.String {
+	case domain.NodeValidationValid.String():
+		m.metrics.Valid++
+	case domain.NodeValidationInvalid.String():
+		m.metrics.Invalid++
+	default:
+		m.metrics.Unvalidated++
+	}
+}
+
+func (m *metricsAcc) result() domain.NodeMetrics {
+	metrics := m.metrics
+	metrics.Uzis = len(m.uzis)
+	if validated := metrics.Valid + metrics.Invalid; validated > 0 {
+		precision := float64(metrics.Valid) / float64(validated)
+		metrics.Precision = &precision
+	}
+	if metrics.AiNodes > 0 {
+		share := float64(metrics.Unvalidated) / float64(metrics.AiNodes)
+		metrics.UnvalidatedShare = &share
+	}
+	return metrics
+}
+
+// groupMetrics метрики всей выборки и групп по аппарату, автору узи и периоду
+func groupMetrics(nodes []entity.AnalyticsNode, period domain.AnalyticsPeriod, analytics *domain.Analytics) {
+	total := &metricsAcc{}
+	byDevice := map[int]*metricsAcc{}
+	byAuthor := map[uuid.UUID]*metricsAcc{}
+	byPeriod := map[time.Time]*metricsAcc{}
+
+	group := func(acc *metricsAcc) *metricsAcc {
+		if acc == nil {
+			return &metricsAcc{}
+		}
+		return acc
+	}
+
+	for _, node := range nodes {
+		start := period.Start(node.CreateAt)
+		byDevice[node.DeviceID] = group(byDevice[node.DeviceID])
+		byAuthor[node.Author] = group(byAuthor[node.Author])
+		byPeriod[start] = group(byPeriod[start])
+
+		total.add(node)
+		byDevice[node.DeviceID].add(node)
+		byAuthor[node.Author].add(node)
+		byPeriod[start].add(node)
+	}
+
+	analytics.Total = total.result()
+
+	analytics.ByDevice = make([]domain.DeviceNodeMetrics, 0, len(byDevice))
+	for deviceID, acc := range byDevice {
+		analytics.ByDevice = append(analytics.ByDevice, domain.DeviceNodeMetrics{DeviceID: deviceID, Metrics: acc.result()})
+	}
+	slices.SortFunc(analytics.ByDevice, func(a, b domain.DeviceNodeMetrics) int {
+		return a.DeviceID - b.DeviceID
+	})
+
+	analytics.ByAuthor = make([]domain.AuthorNodeMetrics, 0, len(byAuthor))
+	for author, acc := range byAuthor {
+		analytics.ByAuthor = append(analytics.ByAuthor, domain.AuthorNodeMetrics{Author: author, Metrics: acc.result()})
+	}
+	slices.SortFunc(analytics.ByAuthor, func(a, b domain.AuthorNodeMetrics) int {
+		return strings.Compare(a.Author.String(), b.Author.String())
+	})
+
+	analytics.ByPeriod = make([]domain.PeriodNodeMetrics, 0, len(byPeriod))
+	for start, acc := range byPeriod {
+		analytics.ByPeriod = append(analytics.ByPeriod, domain.PeriodNodeMetrics{Start: start, Metrics: acc.result()})
+	}
+	slices.SortFunc(analytics.ByPeriod, func(a, b domain.PeriodNodeMetrics) int {
+		return a.Start.Compare(b.Start)
+	})
+}
@@ -0,0 +1,100 @@
+// Аналитика разметки узи: точность нейросети относительно валидации врачами и согласие врачей между собой
+package analytics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+)
+
+type Service interface {
+	GetAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.Analytics, error)
+}
+
+type service struct {
+	dao repository.DAO
+}
+
+func New(
+	dao repository.DAO,
+) Service {
+	return &service{
+		dao: dao,
+	}
+}
+
+func (s *service) GetAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.Analytics, error) {
+	if filter.Period == "" {
+		filter.Period = domain.AnalyticsPeriodMonth
+	}
+
+	nodes, err := s.dao.NewAnalyticsQuery(ctx).GetAnalyticsNodes(filter)
+	if err != nil {
+		return domain.Analytics{}, fmt.Errorf("get analytics nodes: %w", err)
+	}
+
+	analytics := domain.Analytics{}
+	groupMetrics(nodes, filter.Period, &analytics)
+
+	// контуры нужны только узлам узи, размеченных несколькими врачами
+	nodeIDs := []uuid.UUID{}
+	for _, readers := range readersByUzi(nodes) {
+		for _, readerNodes := range readers {
+			for _, node := range readerNodes {
+				nodeIDs = append(nodeIDs, node.Id)
+			}
+		}
+	}
+
+	contorsDB, err := s.dao.NewAnalyticsQuery(ctx).GetNodeContors(nodeIDs)
+	if err != nil {
+		return domain.Analytics{}, fmt.Errorf("get node contors: %w", err)
+	}
+
+	contors := map[uuid.UUID]nodeContors{}
+	for _, v := range contorsDB {
+		// битый контур не мешает остальной аналитике, узел просто не сопоставится
+		polygon, err := domain.ParseContor(v.Contor)
+		if err != nil {
+			continue
+		}
+		if contors[v.NodeID] == nil {
+			contors[v.NodeID] = nodeContors{}
+		}
+		contors[v.NodeID][v.ImageID] = append(contors[v.NodeID][v.ImageID], polygon)
+	}
+
+	agreement(nodes, contors, &analytics)
+
+	corrections, err := s.dao.NewAnalyticsQuery(ctx).GetAiCorrections(filter)
+	if err != nil {
+		return domain.Analytics{}, fmt.Errorf("get ai corrections: %w", err)
+	}
+	for _, v := range corrections {
+		switch v.Kind {
+		case domain.NodeCorrectionKindMerge.String():
+			analytics.AiMerged = v.Nodes
+		case domain.NodeCorrectionKindSplit.String():
+			analytics.AiSplit = v.Nodes
+		}
+	}
+
+	reviews, err := s.dao.NewAnalyticsQuery(ctx).GetBulkReviews(filter)
+	if err != nil {
+		return domain.Analytics{}, fmt.Errorf("get bulk reviews: %w", err)
+	}
+	for _, v := range reviews {
+		switch v.Validation {
+		case domain.NodeValidationValid.String():
+			analytics.BulkValid = v.Nodes
+		case domain.NodeValidationInvalid.String():
+			analytics.BulkInvalid = v.Nodes
+		}
+	}
+
+	return analytics, nil
+}
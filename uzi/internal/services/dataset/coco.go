@@ -0,0 +1,111 @@
+package dataset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"uzi/internal/domain"
+)
+
+type cocoDataset struct {
+	Info        cocoInfo         `json:"info"`
+	Images      []cocoImage      `json:"images"`
+	Annotations []cocoAnnotation `json:"annotations"`
+	Categories  []cocoCategory   `json:"categories"`
+}
+
+type cocoInfo struct {
+	Description string `json:"description"`
+}
+
+type cocoImage struct {
+	Id       int    `json:"id"`
+	FileName string `json:"file_name"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+}
+
+type cocoAnnotation struct {
+	Id           int         `json:"id"`
+	ImageId      int         `json:"image_id"`
+	CategoryId   int         `json:"category_id"`
+	Segmentation [][]float64 `json:"segmentation"`
+	Area         float64     `json:"area"`
+	Bbox         [4]float64  `json:"bbox"`
+	IsCrowd      int         `json:"iscrowd"`
+}
+
+type cocoCategory struct {
+	Id            int    `json:"id"`
+	Name          string `json:"name"`
+	Supercategory string `json:"supercategory"`
+}
+
+// cocoBuilder копит аннотации в памяти и пишет по json на выборку в конце выгрузки
+type cocoBuilder struct {
+	splits map[domain.DatasetSplit]*cocoDataset
+}
+
+func newCocoBuilder() *cocoBuilder {
+	categories := make([]cocoCategory, 0, len(domain.DatasetClasses))
+	for i, class := range domain.DatasetClasses {
+		// в COCO категории нумеруются с 1
+		categories = append(categories, cocoCategory{Id: i + 1, Name: class.String(), Supercategory: "node"})
+	}
+
+	b := &cocoBuilder{splits: make(map[domain.DatasetSplit]*cocoDataset, 2)}
+	for _, split := range []domain.DatasetSplit{domain.DatasetSplitTrain, domain.DatasetSplitVal} {
+		b.splits[split] = &cocoDataset{
+			Info:        cocoInfo{Description: "thyroid nodes, " + split.String()},
+			Images:      []cocoImage{},
+			Annotations: []cocoAnnotation{},
+			Categories:  categories,
+		}
+	}
+	return b
+}
+
+func (b *cocoBuilder) add(_ context.Context, f frame, name string, width, height int) error {
+	dataset := b.splits[f.Split]
+
+	image := cocoImage{
+		Id:       len(dataset.Images) + 1,
+		FileName: path.Base(imagePath(f.Split, name)),
+		Width:    width,
+		Height:   height,
+	}
+	dataset.Images = append(dataset.Images, image)
+
+	for _, obj := range f.Objects {
+		segmentation := make([]float64, 0, len(obj.Polygon)*2)
+		for _, p := range obj.Polygon {
+			segmentation = append(segmentation, p.X, p.Y)
+		}
+
+		dataset.Annotations = append(dataset.Annotations, cocoAnnotation{
+			Id:           len(dataset.Annotations) + 1,
+			ImageId:      image.Id,
+			CategoryId:   classIndex(obj.Class) + 1,
+			Segmentation: [][]float64{segmentation},
+			Area:         polygonArea(obj.Polygon),
+			Bbox:         boundingBox(obj.Polygon),
+		})
+	}
+
+	return nil
+}
+
+func (b *cocoBuilder) finish(ctx context.Context, dst Writer) error {
+	for split, dataset := range b.splits {
+		content, err := json.MarshalIndent(dataset, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal coco %s: %w", split, err)
+		}
+		if err := dst.WriteFile(ctx, path.Join("annotations", "instances_"+split.String()+".json"), content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package dataset
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	imageEntity "uzi/internal/repository/image/entity"
+	nodeEntity "uzi/internal/repository/node/entity"
+	segmentEntity "uzi/internal/repository/segment/entity"
+	uziEntity "uzi/internal/repository/uzi/entity"
+
+	"github.com/google/uuid"
+)
+
+// размер страницы при обходе узи
+const uziBatchSize = 100
+
+// object размеченный узел на кадре
+type object struct {
+	Class   domain.DatasetClass
+	Polygon []domain.ContorPoint
+}
+
+// frame кадр узи, на котором есть хотя бы один выбранный узел
+type frame struct {
+	UziID   uuid.UUID
+	ImageID uuid.UUID
+	Split   domain.DatasetSplit
+	Objects []object
+}
+
+// eachUzi обходит подходящие под фильтр узи от старых к новым
+func (s *service) eachUzi(ctx context.Context, filter domain.UziFilter, fn func(uzi domain.Uzi) error) error {
+	var after *domain.UziCursor
+	for {
+		uzisDB, err := s.dao.NewUziQuery(ctx).SearchUzis(filter, domain.SortOrderAsc, after, uziBatchSize)
+		if err != nil {
+			return fmt.Errorf("search uzis: %w", err)
+		}
+
+		uzis := uziEntity.Uzi{}.SliceToDomain(uzisDB)
+		for _, uzi := range uzis {
+			if err := fn(uzi); err != nil {
+				return err
+			}
+		}
+
+		if len(uzis) < uziBatchSize {
+			return nil
+		}
+		last := uzis[len(uzis)-1]
+		after = &domain.UziCursor{CreateAt: last.CreateAt, Id: last.Id}
+	}
+}
+
+// frames собирает кадры узи с контурами выбранных узлов в порядке страниц
+func (s *service) frames(ctx context.Context, uzi domain.Uzi, arg ExportArg, split domain.DatasetSplit) ([]frame, error) {
+	nodesDB, err := s.dao.NewNodeQuery(ctx).GetNodesByUziID(uzi.Id)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get nodes by uzi_id: %w", err)
+	}
+
+	// порядок узлов и сегментов из БД не гарантирован, а датасет должен быть воспроизводимым
+	nodes := nodeEntity.Node{}.SliceToDomain(nodesDB)
+	slices.SortFunc(nodes, func(a, b domain.Node) int { return bytes.Compare(a.Id[:], b.Id[:]) })
+
+	objects := make(map[uuid.UUID][]object)
+	for _, node := range nodes {
+		if !nodeSelected(arg.Nodes, node) {
+			continue
+		}
+
+		tirads, err := s.tirads.GetNodeTirads(ctx, node.Id)
+		if err != nil {
+			return nil, fmt.Errorf("get node tirads: %w", err)
+		}
+		class := nodeClass(tirads)
+
+		segmentsDB, err := s.dao.NewSegmentQuery(ctx).GetSegmentsByNodeID(node.Id)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("get segments by node_id: %w", err)
+		}
+		segments := segmentEntity.Segment{}.SliceToDomain(segmentsDB)
+		slices.SortFunc(segments, func(a, b domain.Segment) int { return bytes.Compare(a.Id[:], b.Id[:]) })
+
+		for _, segment := range segments {
+			polygon, err := domain.ParseContor(segment.Contor)
+			// вырожденные контуры не годятся для обучения
+			if err != nil {
+				continue
+			}
+			objects[segment.ImageID] = append(objects[segment.ImageID], object{Class: class, Polygon: polygon})
+		}
+	}
+	if len(objects) == 0 {
+		return nil, nil
+	}
+
+	imagesDB, err := s.dao.NewImageQuery(ctx).GetImagesByUziID(uzi.Id)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		return nil, fmt.Errorf("get images by uzi_id: %w", err)
+	}
+	images := imageEntity.Image{}.SliceToDomain(imagesDB)
+	slices.SortFunc(images, func(a, b domain.Image) int { return a.Page - b.Page })
+
+	frames := make([]frame, 0, len(objects))
+	for _, image := range images {
+		if len(objects[image.Id]) == 0 {
+			continue
+		}
+		frames = append(frames, frame{
+			UziID:   uzi.Id,
+			ImageID: image.Id,
+			Split:   split,
+			Objects: objects[image.Id],
+		})
+	}
+
+	return frames, nil
+}
@@ -0,0 +1,119 @@
+package dataset
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+type memWriter struct {
+	files map[string][]byte
+}
+
+func (w *memWriter) WriteFile(_ context.Context, name string, content []byte) error {
+	w.files[name] = content
+	return nil
+}
+
+func (w *memWriter) Path() string { return "mem" }
+
+func square() []domain.ContorPoint {
+	return []domain.ContorPoint{{X: 10, Y: 10}, {X: 30, Y: 10}, {X: 30, Y: 20}, {X: 10, Y: 20}}
+}
+
+func TestSplitOf_DeterministicAndBalanced(t *testing.T) {
+	patient := uuid.New()
+	require.Equal(t, splitOf("seed", patient, 0.3), splitOf("seed", patient, 0.3))
+	require.Equal(t, domain.DatasetSplitTrain, splitOf("seed", patient, 0))
+
+	val := 0
+	for range 2000 {
+		if splitOf("seed", uuid.New(), 0.2) == domain.DatasetSplitVal {
+			val++
+		}
+	}
+	require.InDelta(t, 400, val, 80)
+}
+
+func TestFileName_Anonymised(t *testing.T) {
+	imageID := uuid.New()
+
+	name := fileName("seed", imageID)
+	require.Len(t, name, fileNameLen)
+	require.NotContains(t, name, imageID.String()[:8])
+	require.Equal(t, name, fileName("seed", imageID))
+	require.NotEqual(t, name, fileName("other", imageID))
+}
+
+func TestGeometry(t *testing.T) {
+	require.InDelta(t, 200, polygonArea(square()), 1e-9)
+	require.Equal(t, [4]float64{10, 10, 20, 10}, boundingBox(square()))
+}
+
+func TestNodeClass(t *testing.T) {
+	ai := domain.NodeTirads{Node: domain.Node{Tirads23: 0.1, Tirads4: 0.7, Tirads5: 0.2}}
+	require.Equal(t, domain.DatasetClassTirads4, nodeClass(ai))
+
+	ai.Score = &domain.TiradsScore{Category: domain.TiradsCategoryTR5}
+	require.Equal(t, domain.DatasetClassTirads5, nodeClass(ai))
+
+	ai.Score = &domain.TiradsScore{Category: domain.TiradsCategoryTR2}
+	require.Equal(t, domain.DatasetClassTirads23, nodeClass(ai))
+}
+
+func TestNodeSelected(t *testing.T) {
+	manual := domain.Node{Ai: false}
+	valid := domain.Node{Ai: true, Validation: pointer.To(domain.NodeValidationValid)}
+	unchecked := domain.Node{Ai: true}
+
+	filter := domain.DatasetNodeFilter{Manual: true}
+	require.True(t, nodeSelected(filter, manual))
+	require.False(t, nodeSelected(filter, valid))
+
+	filter = domain.DatasetNodeFilter{AiValid: true}
+	require.False(t, nodeSelected(filter, manual))
+	require.True(t, nodeSelected(filter, valid))
+	require.False(t, nodeSelected(filter, unchecked))
+}
+
+func TestCocoBuilder(t *testing.T) {
+	dst := &memWriter{files: map[string][]byte{}}
+	b := newCocoBuilder()
+
+	f := frame{Split: domain.DatasetSplitVal, Objects: []object{{Class: domain.DatasetClassTirads5, Polygon: square()}}}
+	require.NoError(t, b.add(t.Context(), f, "abc", 40, 30))
+	require.NoError(t, b.finish(t.Context(), dst))
+
+	require.Contains(t, dst.files, "annotations/instances_train.json")
+
+	var dataset cocoDataset
+	require.NoError(t, json.Unmarshal(dst.files["annotations/instances_val.json"], &dataset))
+	require.Len(t, dataset.Images, 1)
+	require.Equal(t, "abc.png", dataset.Images[0].FileName)
+	require.Equal(t, 40, dataset.Images[0].Width)
+	require.Len(t, dataset.Annotations, 1)
+	require.Equal(t, 3, dataset.Annotations[0].CategoryId)
+	require.Equal(t, []float64{10, 10, 30, 10, 30, 20, 10, 20}, dataset.Annotations[0].Segmentation[0])
+	require.InDelta(t, 200, dataset.Annotations[0].Area, 1e-9)
+	require.Len(t, dataset.Categories, len(domain.DatasetClasses))
+}
+
+func TestYoloBuilder(t *testing.T) {
+	dst := &memWriter{files: map[string][]byte{}}
+	b := newYoloBuilder(dst)
+
+	f := frame{Split: domain.DatasetSplitTrain, Objects: []object{{Class: domain.DatasetClassTirads4, Polygon: square()}}}
+	require.NoError(t, b.add(t.Context(), f, "abc", 40, 20))
+	require.NoError(t, b.finish(t.Context(), dst))
+
+	label := string(dst.files["labels/train/abc.txt"])
+	require.Equal(t, "1 0.250000 0.500000 0.750000 0.500000 0.750000 1.000000 0.250000 1.000000\n", label)
+	require.True(t, strings.Contains(string(dst.files["data.yaml"]), "2: tirads_5"))
+}
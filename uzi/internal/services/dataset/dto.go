@@ -0,0 +1,27 @@
+package dataset
+
+import (
+	"uzi/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type ExportArg struct {
+	Filter domain.UziFilter
+	Nodes  domain.DatasetNodeFilter
+	// по умолчанию COCO
+	Format domain.DatasetFormat
+	// доля пациентов в валидационной выборке, по умолчанию 0.2
+	ValRatio *float64
+	// соль для разбиения и имен файлов, одинаковые аргументы дают одинаковый датасет
+	Seed string
+}
+
+type ImportArg struct {
+	// узи для кадров, в пути которых нет id узи
+	UziID   *uuid.UUID
+	Format  domain.AnnotationFormat
+	Content []byte
+	// только отчет, без создания узлов
+	DryRun bool
+}
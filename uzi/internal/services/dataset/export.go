@@ -0,0 +1,135 @@
+package dataset
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image/png"
+	"io"
+	"path"
+	"path/filepath"
+
+	"uzi/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const defaultValRatio = 0.2
+
+type builder interface {
+	add(ctx context.Context, f frame, name string, width, height int) error
+	finish(ctx context.Context, dst Writer) error
+}
+
+func imagePath(split domain.DatasetSplit, name string) string {
+	return path.Join("images", split.String(), name+".png")
+}
+
+func (s *service) ExportToStorage(ctx context.Context, arg ExportArg) (domain.DatasetExport, error) {
+	id := uuid.New()
+	export, err := s.Export(ctx, arg, newStorageWriter(s.dao.NewFileRepo(), filepath.Join("datasets", id.String())))
+	if err != nil {
+		return domain.DatasetExport{}, err
+	}
+	export.Id = id
+
+	return export, nil
+}
+
+func (s *service) Export(ctx context.Context, arg ExportArg, dst Writer) (domain.DatasetExport, error) {
+	if !arg.Nodes.Manual && !arg.Nodes.AiValid {
+		return domain.DatasetExport{}, fmt.Errorf("no node source selected: %w", domain.ErrBadRequest)
+	}
+
+	valRatio := defaultValRatio
+	if arg.ValRatio != nil {
+		valRatio = *arg.ValRatio
+	}
+	if valRatio < 0 || valRatio >= 1 {
+		return domain.DatasetExport{}, fmt.Errorf("val ratio must be in [0, 1): %w", domain.ErrBadRequest)
+	}
+
+	format := arg.Format
+	if format == "" {
+		format = domain.DatasetFormatCoco
+	}
+
+	var b builder
+	switch format {
+	case domain.DatasetFormatCoco:
+		b = newCocoBuilder()
+	case domain.DatasetFormatYolo:
+		b = newYoloBuilder(dst)
+	default:
+		return domain.DatasetExport{}, fmt.Errorf("unknown dataset format %q: %w", format, domain.ErrBadRequest)
+	}
+
+	export := domain.DatasetExport{Format: format, Path: dst.Path()}
+	err := s.eachUzi(ctx, arg.Filter, func(uzi domain.Uzi) error {
+		frames, err := s.frames(ctx, uzi, arg, splitOf(arg.Seed, uzi.ExternalID, valRatio))
+		if err != nil {
+			return err
+		}
+
+		for _, f := range frames {
+			if err := s.exportFrame(ctx, b, dst, arg.Seed, f); err != nil {
+				return err
+			}
+
+			switch f.Split {
+			case domain.DatasetSplitTrain:
+				export.TrainImages++
+			case domain.DatasetSplitVal:
+				export.ValImages++
+			}
+			export.Annotations += len(f.Objects)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.DatasetExport{}, err
+	}
+
+	if err := b.finish(ctx, dst); err != nil {
+		return domain.DatasetExport{}, err
+	}
+
+	return export, nil
+}
+
+func (s *service) exportFrame(ctx context.Context, b builder, dst Writer, seed string, f frame) error {
+	content, err := s.loadImage(ctx, f.UziID, f.ImageID)
+	if err != nil {
+		return err
+	}
+
+	config, err := png.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return fmt.Errorf("decode image config: %w", err)
+	}
+
+	name := fileName(seed, f.ImageID)
+	if err := dst.WriteFile(ctx, imagePath(f.Split, name), content); err != nil {
+		return err
+	}
+
+	return b.add(ctx, f, name, config.Width, config.Height)
+}
+
+func (s *service) loadImage(ctx context.Context, uziID, imageID uuid.UUID) ([]byte, error) {
+	file, closer, err := s.dao.NewFileRepo().GetFileViaTemp(
+		ctx,
+		filepath.Join(uziID.String(), imageID.String(), imageID.String()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get image from s3: %w", err)
+	}
+	defer closer()
+
+	content, err := io.ReadAll(file.Buf)
+	if err != nil {
+		return nil, fmt.Errorf("read image: %w", err)
+	}
+
+	return content, nil
+}
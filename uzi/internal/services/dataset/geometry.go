@@ -0,0 +1,34 @@
+package dataset
+
+import (
+	"math"
+
+	"uzi/internal/domain"
+)
+
+// polygonArea площадь по формуле шнурования
+func polygonArea(points []domain.ContorPoint) float64 {
+	var sum float64
+	for i := range points {
+		next := points[(i+1)%len(points)]
+		sum += points[i].X*next.Y - next.X*points[i].Y
+	}
+	return math.Abs(sum) / 2
+}
+
+// boundingBox возвращает x, y левого верхнего угла, ширину и высоту
+func boundingBox(points []domain.ContorPoint) [4]float64 {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, p := range points {
+		minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+		minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+	}
+	return [4]float64{minX, minY, maxX - minX, maxY - minY}
+}
+
+// validatePolygon проверяет контур теми же правилами, что и при сохранении, размер кадра 0 - не проверять границы
+func validatePolygon(points []domain.ContorPoint, width, height int) error {
+	_, err := domain.NormalizeContorPoints(points, width, height)
+	return err
+}
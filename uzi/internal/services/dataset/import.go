@@ -0,0 +1,209 @@
+package dataset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	imageEntity "uzi/internal/repository/image/entity"
+	"uzi/internal/services/node_segment"
+
+	"github.com/google/uuid"
+)
+
+var (
+	uuidPattern = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	pagePattern = regexp.MustCompile(`\d+`)
+)
+
+// resolveFrame кадр называется номером страницы узи (1.png, page_012.png),
+// id узи можно указать в пути (<uzi_id>/3.png), иначе берется узи по умолчанию
+func resolveFrame(fileName string, defaultUzi *uuid.UUID) (uuid.UUID, int, error) {
+	uziID := defaultUzi
+	if match := uuidPattern.FindString(fileName); match != "" {
+		id := uuid.MustParse(match)
+		uziID = &id
+	}
+	if uziID == nil {
+		return uuid.Nil, 0, fmt.Errorf("no uzi id in %q and no default uzi", fileName)
+	}
+
+	base := uuidPattern.ReplaceAllString(path.Base(fileName), "")
+	numbers := pagePattern.FindAllString(base, -1)
+	if len(numbers) == 0 {
+		return uuid.Nil, 0, fmt.Errorf("no page number in %q", fileName)
+	}
+	page, err := strconv.Atoi(numbers[len(numbers)-1])
+	if err != nil {
+		return uuid.Nil, 0, fmt.Errorf("parse page of %q: %w", fileName, err)
+	}
+
+	return *uziID, page, nil
+}
+
+func (s *service) Import(ctx context.Context, arg ImportArg) (domain.AnnotationImportReport, error) {
+	var (
+		nodes []importedNode
+		err   error
+	)
+	switch arg.Format {
+	case domain.AnnotationFormatCoco:
+		nodes, err = parseCoco(arg.Content)
+	case domain.AnnotationFormatCvat:
+		nodes, err = parseCvat(arg.Content)
+	default:
+		return domain.AnnotationImportReport{}, fmt.Errorf("unknown annotation format %q: %w", arg.Format, domain.ErrBadRequest)
+	}
+	if err != nil {
+		return domain.AnnotationImportReport{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+	}
+
+	report := domain.AnnotationImportReport{DryRun: arg.DryRun}
+	skip := func(source, reason string) {
+		report.Skipped = append(report.Skipped, domain.AnnotationImportSkip{Source: source, Reason: reason})
+	}
+
+	// узлы одного узи создаются одним вызовом, индексы указывают на report.Nodes
+	var uzis []uuid.UUID
+	creates := make(map[uuid.UUID][]node_segment.CreateNodesWithSegmentsArg)
+	indexes := make(map[uuid.UUID][]int)
+	pages := make(map[uuid.UUID]map[int]uuid.UUID)
+
+	for _, node := range nodes {
+		class := labelClass(node.Label)
+		create := node_segment.CreateNodesWithSegmentsArg{Node: nodeArg(class)}
+
+		var nodeUzi *uuid.UUID
+		for _, segment := range node.Segments {
+			if err := validatePolygon(segment.Polygon, segment.Width, segment.Height); err != nil {
+				skip(segment.Source, err.Error())
+				continue
+			}
+
+			uziID, page, err := resolveFrame(segment.FileName, arg.UziID)
+			if err != nil {
+				skip(segment.Source, err.Error())
+				continue
+			}
+			if nodeUzi != nil && *nodeUzi != uziID {
+				skip(segment.Source, "segment belongs to another uzi than the rest of the node")
+				continue
+			}
+
+			uziPages, err := s.uziPages(ctx, pages, uziID)
+			if err != nil {
+				return domain.AnnotationImportReport{}, err
+			}
+			if uziPages == nil {
+				skip(segment.Source, fmt.Sprintf("uzi %s not found", uziID))
+				continue
+			}
+			imageID, ok := uziPages[page]
+			if !ok {
+				skip(segment.Source, fmt.Sprintf("uzi %s has no page %d", uziID, page))
+				continue
+			}
+
+			contor := domain.MarshalContor(segment.Polygon)
+
+			nodeUzi = &uziID
+			create.Segments = append(create.Segments, node_segment.CreateNodesWithSegmentsArgSegment{
+				ImageID:  imageID,
+				Contor:   contor,
+				Tirads23: create.Node.Tirads23,
+				Tirads4:  create.Node.Tirads4,
+				Tirads5:  create.Node.Tirads5,
+			})
+		}
+
+		if nodeUzi == nil {
+			skip(node.Source, "node has no valid segments")
+			continue
+		}
+
+		if _, ok := creates[*nodeUzi]; !ok {
+			uzis = append(uzis, *nodeUzi)
+		}
+		creates[*nodeUzi] = append(creates[*nodeUzi], create)
+		indexes[*nodeUzi] = append(indexes[*nodeUzi], len(report.Nodes))
+		report.Nodes = append(report.Nodes, domain.AnnotationImportNode{
+			Source:   node.Source,
+			UziID:    *nodeUzi,
+			Class:    class,
+			Segments: len(create.Segments),
+		})
+	}
+
+	if arg.DryRun {
+		return report, nil
+	}
+
+	// все узлы создаются одной транзакцией, при ошибке не создается ни один
+	createArgs := make([]node_segment.CreateUziNodesWithSegmentsArg, 0, len(uzis))
+	for _, uziID := range uzis {
+		createArgs = append(createArgs, node_segment.CreateUziNodesWithSegmentsArg{UziID: uziID, Nodes: creates[uziID]})
+	}
+	ids, err := s.nodeSegment.CreateManualNodesWithSegmentsForUzis(ctx, createArgs)
+	if err != nil {
+		return domain.AnnotationImportReport{}, fmt.Errorf("create nodes: %w", err)
+	}
+	for i, uziID := range uzis {
+		for j, id := range ids[i] {
+			report.Nodes[indexes[uziID][j]].NodeID = &id.NodeID
+		}
+	}
+
+	return report, nil
+}
+
+// uziPages страницы узи с кэшированием, nil - узи не найдено
+func (s *service) uziPages(ctx context.Context, cache map[uuid.UUID]map[int]uuid.UUID, uziID uuid.UUID) (map[int]uuid.UUID, error) {
+	if pages, ok := cache[uziID]; ok {
+		return pages, nil
+	}
+
+	_, err := s.dao.NewUziQuery(ctx).GetUziByID(uziID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			cache[uziID] = nil
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get uzi by id: %w", err)
+	}
+
+	imagesDB, err := s.dao.NewImageQuery(ctx).GetImagesByUziID(uziID)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		return nil, fmt.Errorf("get images by uzi_id: %w", err)
+	}
+
+	images := imageEntity.Image{}.SliceToDomain(imagesDB)
+	pages := make(map[int]uuid.UUID, len(images))
+	for _, image := range images {
+		pages[image.Page] = image.Id
+	}
+	cache[uziID] = pages
+
+	return pages, nil
+}
+
+// nodeArg ручной узел получает вероятность 1 у класса из метки
+func nodeArg(class *domain.DatasetClass) node_segment.CreateNodesWithSegmentsArgNode {
+	var node node_segment.CreateNodesWithSegmentsArgNode
+	if class == nil {
+		return node
+	}
+	switch *class {
+	case domain.DatasetClassTirads23:
+		node.Tirads23 = 1
+	case domain.DatasetClassTirads4:
+		node.Tirads4 = 1
+	case domain.DatasetClassTirads5:
+		node.Tirads5 = 1
+	}
+	return node
+}
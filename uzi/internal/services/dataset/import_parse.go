@@ -0,0 +1,231 @@
+package dataset
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"uzi/internal/domain"
+)
+
+// importedNode узел из внешней разметки до сопоставления с кадрами узи
+type importedNode struct {
+	Source   string
+	Label    string
+	Segments []importedSegment
+}
+
+type importedSegment struct {
+	Source   string
+	FileName string
+	// 0, если размер кадра в разметке не указан
+	Width   int
+	Height  int
+	Polygon []domain.ContorPoint
+}
+
+type cocoImportFile struct {
+	Images []struct {
+		Id       int    `json:"id"`
+		FileName string `json:"file_name"`
+		Width    int    `json:"width"`
+		Height   int    `json:"height"`
+	} `json:"images"`
+	Annotations []struct {
+		Id           int             `json:"id"`
+		ImageId      int             `json:"image_id"`
+		CategoryId   int             `json:"category_id"`
+		Segmentation json.RawMessage `json:"segmentation"`
+		Attributes   struct {
+			// CVAT выгружает треки в COCO через этот атрибут
+			TrackId *int `json:"track_id"`
+		} `json:"attributes"`
+	} `json:"annotations"`
+	Categories []struct {
+		Id   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"categories"`
+}
+
+// parseCoco аннотации одного трека собираются в один узел, остальные - каждая в свой
+func parseCoco(content []byte) ([]importedNode, error) {
+	var file cocoImportFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("parse coco: %w", err)
+	}
+
+	type image struct {
+		name          string
+		width, height int
+	}
+	images := make(map[int]image, len(file.Images))
+	for _, v := range file.Images {
+		images[v.Id] = image{name: v.FileName, width: v.Width, height: v.Height}
+	}
+	categories := make(map[int]string, len(file.Categories))
+	for _, v := range file.Categories {
+		categories[v.Id] = v.Name
+	}
+
+	var nodes []importedNode
+	tracks := make(map[int]int)
+	for _, annotation := range file.Annotations {
+		source := fmt.Sprintf("coco annotation %d", annotation.Id)
+
+		segment := importedSegment{Source: source}
+		if image, ok := images[annotation.ImageId]; ok {
+			segment.FileName, segment.Width, segment.Height = image.name, image.width, image.height
+		}
+
+		// RLE маски не поддерживаются, только полигоны
+		var polygons [][]float64
+		if err := json.Unmarshal(annotation.Segmentation, &polygons); err == nil && len(polygons) > 0 {
+			segment.Polygon = pairsToPoints(polygons[0])
+		}
+
+		if track := annotation.Attributes.TrackId; track != nil {
+			if i, ok := tracks[*track]; ok {
+				nodes[i].Segments = append(nodes[i].Segments, segment)
+				continue
+			}
+			tracks[*track] = len(nodes)
+			source = fmt.Sprintf("coco track %d", *track)
+		}
+
+		nodes = append(nodes, importedNode{
+			Source:   source,
+			Label:    categories[annotation.CategoryId],
+			Segments: []importedSegment{segment},
+		})
+	}
+
+	return nodes, nil
+}
+
+type cvatImportFile struct {
+	Images []struct {
+		Id       int           `xml:"id,attr"`
+		Name     string        `xml:"name,attr"`
+		Width    int           `xml:"width,attr"`
+		Height   int           `xml:"height,attr"`
+		Polygons []cvatPolygon `xml:"polygon"`
+	} `xml:"image"`
+	Tracks []struct {
+		Id       int           `xml:"id,attr"`
+		Label    string        `xml:"label,attr"`
+		Polygons []cvatPolygon `xml:"polygon"`
+	} `xml:"track"`
+}
+
+type cvatPolygon struct {
+	Label   string `xml:"label,attr"`
+	Frame   int    `xml:"frame,attr"`
+	Outside int    `xml:"outside,attr"`
+	GroupId int    `xml:"group_id,attr"`
+	Points  string `xml:"points,attr"`
+}
+
+// parseCvat треки и группы полигонов (group_id) собираются в один узел
+func parseCvat(content []byte) ([]importedNode, error) {
+	var file cvatImportFile
+	if err := xml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("parse cvat: %w", err)
+	}
+
+	var nodes []importedNode
+	groups := make(map[int]int)
+	for _, image := range file.Images {
+		for i, polygon := range image.Polygons {
+			source := fmt.Sprintf("cvat image %d polygon %d", image.Id, i)
+			segment := importedSegment{
+				Source:   source,
+				FileName: image.Name,
+				Width:    image.Width,
+				Height:   image.Height,
+				Polygon:  parseCvatPoints(polygon.Points),
+			}
+
+			if polygon.GroupId != 0 {
+				if j, ok := groups[polygon.GroupId]; ok {
+					nodes[j].Segments = append(nodes[j].Segments, segment)
+					continue
+				}
+				groups[polygon.GroupId] = len(nodes)
+				source = fmt.Sprintf("cvat group %d", polygon.GroupId)
+			}
+
+			nodes = append(nodes, importedNode{Source: source, Label: polygon.Label, Segments: []importedSegment{segment}})
+		}
+	}
+
+	// кадры треков задаются номером, имя и размер берутся из описания кадров
+	frames := make(map[int]int, len(file.Images))
+	for i, image := range file.Images {
+		frames[image.Id] = i
+	}
+	for _, track := range file.Tracks {
+		node := importedNode{Source: fmt.Sprintf("cvat track %d", track.Id), Label: track.Label}
+		for _, polygon := range track.Polygons {
+			// CVAT пишет завершающий кадр трека с outside=1, он не является разметкой
+			if polygon.Outside == 1 {
+				continue
+			}
+			segment := importedSegment{
+				Source:  fmt.Sprintf("cvat track %d frame %d", track.Id, polygon.Frame),
+				Polygon: parseCvatPoints(polygon.Points),
+			}
+			if i, ok := frames[polygon.Frame]; ok {
+				image := file.Images[i]
+				segment.FileName, segment.Width, segment.Height = image.Name, image.Width, image.Height
+			}
+			node.Segments = append(node.Segments, segment)
+		}
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+func pairsToPoints(coords []float64) []domain.ContorPoint {
+	points := make([]domain.ContorPoint, 0, len(coords)/2)
+	for i := 0; i+1 < len(coords); i += 2 {
+		points = append(points, domain.ContorPoint{X: coords[i], Y: coords[i+1]})
+	}
+	return points
+}
+
+// точки CVAT: "x1,y1;x2,y2;...", нечитаемая точка делает полигон пустым
+func parseCvatPoints(raw string) []domain.ContorPoint {
+	var points []domain.ContorPoint
+	for _, pair := range strings.Split(raw, ";") {
+		x, y, ok := strings.Cut(strings.TrimSpace(pair), ",")
+		if !ok {
+			return nil
+		}
+		px, errX := strconv.ParseFloat(x, 64)
+		py, errY := strconv.ParseFloat(y, 64)
+		if errX != nil || errY != nil {
+			return nil
+		}
+		points = append(points, domain.ContorPoint{X: px, Y: py})
+	}
+	return points
+}
+
+// labelClass сопоставляет метку внешней разметки с классом датасета
+func labelClass(label string) *domain.DatasetClass {
+	var class domain.DatasetClass
+	switch strings.ToLower(strings.TrimSpace(label)) {
+	case "tirads_23", "tr1", "tr2", "tr3":
+		class = domain.DatasetClassTirads23
+	case "tirads_4", "tr4":
+		class = domain.DatasetClassTirads4
+	case "tirads_5", "tr5":
+		class = domain.DatasetClassTirads5
+	default:
+		return nil
+	}
+	return &class
+}
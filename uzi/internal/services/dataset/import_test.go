@@ -0,0 +1,104 @@
+package dataset
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+func TestParseCoco_GroupsTracks(t *testing.T) {
+	nodes, err := parseCoco([]byte(`{
+		"images": [{"id": 1, "file_name": "frame_001.png", "width": 100, "height": 50}],
+		"categories": [{"id": 7, "name": "TR4"}],
+		"annotations": [
+			{"id": 1, "image_id": 1, "category_id": 7, "segmentation": [[1, 1, 10, 1, 10, 10]], "attributes": {"track_id": 3}},
+			{"id": 2, "image_id": 1, "category_id": 7, "segmentation": [[2, 2, 20, 2, 20, 20]], "attributes": {"track_id": 3}},
+			{"id": 3, "image_id": 1, "category_id": 7, "segmentation": {"counts": "abc", "size": [50, 100]}}
+		]
+	}`))
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	require.Equal(t, "coco track 3", nodes[0].Source)
+	require.Equal(t, "TR4", nodes[0].Label)
+	require.Len(t, nodes[0].Segments, 2)
+	require.Equal(t, "frame_001.png", nodes[0].Segments[0].FileName)
+	require.Equal(t, []domain.ContorPoint{{X: 1, Y: 1}, {X: 10, Y: 1}, {X: 10, Y: 10}}, nodes[0].Segments[0].Polygon)
+
+	// RLE маска не превращается в полигон и отсеется валидацией
+	require.Empty(t, nodes[1].Segments[0].Polygon)
+}
+
+func TestParseCvat_ImagesAndTracks(t *testing.T) {
+	nodes, err := parseCvat([]byte(`<annotations>
+		<image id="0" name="1.png" width="100" height="50">
+			<polygon label="tirads_5" points="1,1;10,1;10,10" group_id="0"/>
+			<polygon label="tirads_5" points="1,1;10,1;10,10" group_id="4"/>
+			<polygon label="tirads_5" points="2,2;20,2;20,20" group_id="4"/>
+		</image>
+		<image id="1" name="2.png" width="100" height="50"/>
+		<track id="9" label="node">
+			<polygon frame="0" outside="0" points="1,1;10,1;10,10"/>
+			<polygon frame="1" outside="0" points="1,1;10,1;10,10"/>
+			<polygon frame="1" outside="1" points="1,1;10,1;10,10"/>
+		</track>
+	</annotations>`))
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+
+	require.Len(t, nodes[0].Segments, 1)
+	require.Equal(t, "cvat group 4", nodes[1].Source)
+	require.Len(t, nodes[1].Segments, 2)
+
+	require.Equal(t, "cvat track 9", nodes[2].Source)
+	require.Len(t, nodes[2].Segments, 2)
+	require.Equal(t, "2.png", nodes[2].Segments[1].FileName)
+	require.Equal(t, 100, nodes[2].Segments[1].Width)
+}
+
+func TestResolveFrame(t *testing.T) {
+	fallback := uuid.New()
+	other := uuid.New()
+
+	uziID, page, err := resolveFrame("study/frame_012.png", &fallback)
+	require.NoError(t, err)
+	require.Equal(t, fallback, uziID)
+	require.Equal(t, 12, page)
+
+	uziID, page, err = resolveFrame(other.String()+"/3.png", &fallback)
+	require.NoError(t, err)
+	require.Equal(t, other, uziID)
+	require.Equal(t, 3, page)
+
+	_, _, err = resolveFrame("3.png", nil)
+	require.Error(t, err)
+	_, _, err = resolveFrame("cover.png", &fallback)
+	require.Error(t, err)
+}
+
+func TestValidatePolygon(t *testing.T) {
+	require.NoError(t, validatePolygon(square(), 40, 30))
+	require.NoError(t, validatePolygon(square(), 0, 0))
+
+	require.Error(t, validatePolygon(square()[:2], 0, 0))
+	// выходящий за кадр контур обрезается, как при сохранении
+	require.NoError(t, validatePolygon(square(), 20, 20))
+	require.Error(t, validatePolygon(square(), 5, 5))
+	// повторы точек убираются, как при сохранении
+	repeated := append([]domain.ContorPoint{{X: 10, Y: 10}}, square()...)
+	require.NoError(t, validatePolygon(repeated, 0, 0))
+	require.Error(t, validatePolygon([]domain.ContorPoint{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}}, 0, 0))
+
+	bowtie := []domain.ContorPoint{{X: 0, Y: 0}, {X: 10, Y: 10}, {X: 10, Y: 0}, {X: 0, Y: 10}}
+	require.Error(t, validatePolygon(bowtie, 0, 0))
+}
+
+func TestLabelClass(t *testing.T) {
+	require.Equal(t, domain.DatasetClassTirads4, *labelClass(" TR4 "))
+	require.Equal(t, domain.DatasetClassTirads23, *labelClass("tr1"))
+	require.Equal(t, domain.DatasetClassTirads5, *labelClass("tirads_5"))
+	require.Nil(t, labelClass("node"))
+}
@@ -0,0 +1,42 @@
+package dataset
+
+import (
+	"slices"
+
+	"uzi/internal/domain"
+)
+
+// nodeClass оценка врача по дескрипторам важнее вероятностей нейросети
+func nodeClass(tirads domain.NodeTirads) domain.DatasetClass {
+	if tirads.Score != nil {
+		switch tirads.Score.Category {
+		case domain.TiradsCategoryTR5:
+			return domain.DatasetClassTirads5
+		case domain.TiradsCategoryTR4:
+			return domain.DatasetClassTirads4
+		default:
+			return domain.DatasetClassTirads23
+		}
+	}
+
+	node := tirads.Node
+	switch {
+	case node.Tirads5 >= node.Tirads4 && node.Tirads5 >= node.Tirads23:
+		return domain.DatasetClassTirads5
+	case node.Tirads4 >= node.Tirads23:
+		return domain.DatasetClassTirads4
+	default:
+		return domain.DatasetClassTirads23
+	}
+}
+
+func classIndex(class domain.DatasetClass) int {
+	return slices.Index(domain.DatasetClasses, class)
+}
+
+func nodeSelected(filter domain.DatasetNodeFilter, node domain.Node) bool {
+	if !node.Ai {
+		return filter.Manual
+	}
+	return filter.AiValid && node.Validation != nil && *node.Validation == domain.NodeValidationValid
+}
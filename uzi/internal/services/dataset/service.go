@@ -0,0 +1,39 @@
+// Выгрузка провалидированной разметки узи в датасеты для обучения моделей и загрузка внешней разметки
+package dataset
+
+import (
+	"context"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+	"uzi/internal/services/node_segment"
+	"uzi/internal/services/tirads"
+)
+
+type Service interface {
+	// Export выгружает датасет в переданное место назначения
+	Export(ctx context.Context, arg ExportArg, dst Writer) (domain.DatasetExport, error)
+	// ExportToStorage выгружает датасет в S3 под префикс datasets/<id>
+	ExportToStorage(ctx context.Context, arg ExportArg) (domain.DatasetExport, error)
+
+	// Import создает ручные узлы с сегментами из внешней разметки COCO/CVAT
+	Import(ctx context.Context, arg ImportArg) (domain.AnnotationImportReport, error)
+}
+
+type service struct {
+	dao         repository.DAO
+	tirads      tirads.Service
+	nodeSegment node_segment.Service
+}
+
+func New(
+	dao repository.DAO,
+	tirads tirads.Service,
+	nodeSegment node_segment.Service,
+) Service {
+	return &service{
+		dao:         dao,
+		tirads:      tirads,
+		nodeSegment: nodeSegment,
+	}
+}
@@ -0,0 +1,30 @@
+package dataset
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+
+	"uzi/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// длина анонимного имени файла в hex символах
+const fileNameLen = 20
+
+// splitOf относит пациента к выборке, все кадры одного пациента попадают в одну выборку
+func splitOf(seed string, externalID uuid.UUID, valRatio float64) domain.DatasetSplit {
+	sum := sha256.Sum256([]byte(seed + ":split:" + externalID.String()))
+	fraction := float64(binary.BigEndian.Uint64(sum[:8])) / (1 << 64)
+	if fraction < valRatio {
+		return domain.DatasetSplitVal
+	}
+	return domain.DatasetSplitTrain
+}
+
+// fileName имя кадра без идентификаторов узи и пациента
+func fileName(seed string, imageID uuid.UUID) string {
+	sum := sha256.Sum256([]byte(seed + ":image:" + imageID.String()))
+	return hex.EncodeToString(sum[:])[:fileNameLen]
+}
@@ -0,0 +1,78 @@
+package dataset
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+)
+
+// Writer место назначения файлов датасета, имена файлов относительные
+type Writer interface {
+	WriteFile(ctx context.Context, name string, content []byte) error
+	// Path корень датасета
+	Path() string
+}
+
+type dirWriter struct {
+	root string
+}
+
+// NewDirWriter пишет датасет в локальный каталог
+func NewDirWriter(root string) Writer {
+	return &dirWriter{root: root}
+}
+
+func (w *dirWriter) WriteFile(_ context.Context, name string, content []byte) error {
+	path := filepath.Join(w.root, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create dir: %w", err)
+	}
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	return nil
+}
+
+func (w *dirWriter) Path() string {
+	return w.root
+}
+
+type storageWriter struct {
+	files  repository.FileRepo
+	prefix string
+}
+
+func newStorageWriter(files repository.FileRepo, prefix string) Writer {
+	return &storageWriter{files: files, prefix: prefix}
+}
+
+func (w *storageWriter) WriteFile(ctx context.Context, name string, content []byte) error {
+	if err := w.files.LoadFile(ctx, filepath.Join(w.prefix, name), domain.File{
+		Format: contentType(name),
+		Size:   int64(len(content)),
+		Buf:    bytes.NewReader(content),
+	}); err != nil {
+		return fmt.Errorf("load dataset file to S3: %w", err)
+	}
+	return nil
+}
+
+func (w *storageWriter) Path() string {
+	return w.prefix
+}
+
+func contentType(name string) string {
+	switch filepath.Ext(name) {
+	case ".png":
+		return "image/png"
+	case ".json":
+		return "application/json"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
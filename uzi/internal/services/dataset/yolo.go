@@ -0,0 +1,51 @@
+package dataset
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"uzi/internal/domain"
+)
+
+// yoloBuilder пишет txt с полигонами рядом с каждым кадром и data.yaml в конце выгрузки
+type yoloBuilder struct {
+	dst Writer
+}
+
+func newYoloBuilder(dst Writer) *yoloBuilder {
+	return &yoloBuilder{dst: dst}
+}
+
+// формат строки: <класс> x1 y1 x2 y2 ..., координаты нормированы на размер кадра
+func (b *yoloBuilder) add(ctx context.Context, f frame, name string, width, height int) error {
+	var sb strings.Builder
+	for _, obj := range f.Objects {
+		sb.WriteString(strconv.Itoa(classIndex(obj.Class)))
+		for _, p := range obj.Polygon {
+			fmt.Fprintf(&sb, " %.6f %.6f", clamp(p.X/float64(width)), clamp(p.Y/float64(height)))
+		}
+		sb.WriteByte('\n')
+	}
+
+	return b.dst.WriteFile(ctx, path.Join("labels", f.Split.String(), name+".txt"), []byte(sb.String()))
+}
+
+func (b *yoloBuilder) finish(ctx context.Context, dst Writer) error {
+	var sb strings.Builder
+	sb.WriteString("path: .\n")
+	sb.WriteString("train: images/" + domain.DatasetSplitTrain.String() + "\n")
+	sb.WriteString("val: images/" + domain.DatasetSplitVal.String() + "\n")
+	sb.WriteString("names:\n")
+	for i, class := range domain.DatasetClasses {
+		fmt.Fprintf(&sb, "  %d: %s\n", i, class)
+	}
+
+	return dst.WriteFile(ctx, "data.yaml", []byte(sb.String()))
+}
+
+func clamp(v float64) float64 {
+	return min(max(v, 0), 1)
+}
@@ -2,9 +2,77 @@ package device
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	deviceEntity "uzi/internal/repository/device/entity"
+	"uzi/internal/repository/entity"
 )
 
-func (s *service) CreateDevice(ctx context.Context, deviceName string) (int, error) {
-	id, err := s.dao.NewDeviceQuery(ctx).CreateDevice(deviceName)
-	return id, err
+func (s *service) CreateDevice(ctx context.Context, arg CreateDeviceArg) (int, error) {
+	id, err := s.dao.NewDeviceQuery(ctx).CreateDevice(deviceEntity.Device{}.FromDomain(arg.domain()))
+	if err != nil {
+		return 0, wrapDeviceError(err)
+	}
+	return id, nil
+}
+
+func (s *service) SeedDevices(ctx context.Context, devices []CreateDeviceArg) error {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	deviceQuery := s.dao.NewDeviceQuery(ctx)
+	for _, arg := range devices {
+		device := arg.domain()
+
+		existing, err := deviceQuery.GetDeviceByName(arg.Name)
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			if _, err := deviceQuery.CreateDevice(deviceEntity.Device{}.FromDomain(device)); err != nil {
+				return fmt.Errorf("create device %q: %w", arg.Name, err)
+			}
+
+		case err != nil:
+			return fmt.Errorf("get device %q: %w", arg.Name, err)
+
+		default:
+			device.Id = existing.Id
+			if err := deviceQuery.UpdateDevice(deviceEntity.Device{}.FromDomain(device)); err != nil {
+				return fmt.Errorf("update device %q: %w", arg.Name, err)
+			}
+		}
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (a CreateDeviceArg) domain() domain.Device {
+	return domain.Device{
+		Name:         a.Name,
+		Manufacturer: a.Manufacturer,
+		Model:        a.Model,
+		SerialNumber: a.SerialNumber,
+		ProbeType:    a.ProbeType,
+		PixelSpacing: a.PixelSpacing,
+	}
+}
+
+func wrapDeviceError(err error) error {
+	var conflictErr *entity.DBConflictError
+	if errors.As(err, &conflictErr) {
+		return domain.ErrConflict
+	}
+	var valErr *entity.DBValidationError
+	if errors.As(err, &valErr) {
+		return domain.ErrUnprocessableEntity
+	}
+	return err
 }
@@ -0,0 +1,27 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+)
+
+func (s *service) DeleteDevice(ctx context.Context, id int) error {
+	if _, err := s.GetDeviceByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.dao.NewDeviceQuery(ctx).DeleteDevice(id); err != nil {
+		// на аппарат ссылаются узи
+		var valErr *entity.DBValidationError
+		if errors.As(err, &valErr) {
+			return domain.ErrConflict
+		}
+		return fmt.Errorf("delete device: %w", err)
+	}
+
+	return nil
+}
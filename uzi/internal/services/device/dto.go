@@ -0,0 +1,43 @@
+package device
+
+import "uzi/internal/domain"
+
+type CreateDeviceArg struct {
+	Name         string
+	Manufacturer *string
+	Model        *string
+	SerialNumber *string
+	ProbeType    *domain.ProbeType
+	PixelSpacing *domain.PixelSpacing
+}
+
+type UpdateDeviceArg struct {
+	Id           int
+	Name         *string
+	Manufacturer *string
+	Model        *string
+	SerialNumber *string
+	ProbeType    *domain.ProbeType
+	PixelSpacing *domain.PixelSpacing
+}
+
+func (u UpdateDeviceArg) UpdateDomain(d *domain.Device) {
+	if u.Name != nil {
+		d.Name = *u.Name
+	}
+	if u.Manufacturer != nil {
+		d.Manufacturer = u.Manufacturer
+	}
+	if u.Model != nil {
+		d.Model = u.Model
+	}
+	if u.SerialNumber != nil {
+		d.SerialNumber = u.SerialNumber
+	}
+	if u.ProbeType != nil {
+		d.ProbeType = u.ProbeType
+	}
+	if u.PixelSpacing != nil {
+		d.PixelSpacing = u.PixelSpacing
+	}
+}
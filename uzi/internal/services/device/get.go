@@ -2,16 +2,31 @@ package device
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"uzi/internal/domain"
-	"uzi/internal/repository/device/entity"
+	deviceEntity "uzi/internal/repository/device/entity"
+	"uzi/internal/repository/entity"
 )
 
+func (s *service) GetDeviceByID(ctx context.Context, id int) (domain.Device, error) {
+	device, err := s.dao.NewDeviceQuery(ctx).GetDeviceByID(id)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return domain.Device{}, domain.ErrNotFound
+		}
+		return domain.Device{}, fmt.Errorf("get device by id: %w", err)
+	}
+
+	return device.ToDomain(), nil
+}
+
 func (s *service) GetDeviceList(ctx context.Context) ([]domain.Device, error) {
 	devices, err := s.dao.NewDeviceQuery(ctx).GetDeviceList()
 	if err != nil {
 		return nil, err
 	}
 
-	return entity.Device{}.SliceToDomain(devices), nil
+	return deviceEntity.Device{}.SliceToDomain(devices), nil
 }
@@ -8,9 +8,16 @@ import (
 )
 
 type Service interface {
-	CreateDevice(ctx context.Context, deviceName string) (int, error)
+	CreateDevice(ctx context.Context, arg CreateDeviceArg) (int, error)
+	// SeedDevices создает или обновляет аппараты по названию, используется при старте сервиса
+	SeedDevices(ctx context.Context, devices []CreateDeviceArg) error
 
+	GetDeviceByID(ctx context.Context, id int) (domain.Device, error)
 	GetDeviceList(ctx context.Context) ([]domain.Device, error)
+
+	UpdateDevice(ctx context.Context, arg UpdateDeviceArg) (domain.Device, error)
+
+	DeleteDevice(ctx context.Context, id int) error
 }
 
 type service struct {
@@ -0,0 +1,23 @@
+package device
+
+import (
+	"context"
+	"fmt"
+
+	"uzi/internal/domain"
+	deviceEntity "uzi/internal/repository/device/entity"
+)
+
+func (s *service) UpdateDevice(ctx context.Context, arg UpdateDeviceArg) (domain.Device, error) {
+	device, err := s.GetDeviceByID(ctx, arg.Id)
+	if err != nil {
+		return domain.Device{}, err
+	}
+
+	arg.UpdateDomain(&device)
+	if err := s.dao.NewDeviceQuery(ctx).UpdateDevice(deviceEntity.Device{}.FromDomain(device)); err != nil {
+		return domain.Device{}, fmt.Errorf("update device: %w", wrapDeviceError(err))
+	}
+
+	return device, nil
+}
@@ -0,0 +1,38 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	nodeHistoryEntity "uzi/internal/repository/node_history/entity"
+	segmentHistoryEntity "uzi/internal/repository/segment_history/entity"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) GetNodeHistory(ctx context.Context, nodeID uuid.UUID) ([]domain.NodeVersion, error) {
+	history, err := s.dao.NewNodeHistoryQuery(ctx).GetNodeHistory(nodeID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return []domain.NodeVersion{}, nil
+		}
+		return nil, fmt.Errorf("get node history: %w", err)
+	}
+
+	return nodeHistoryEntity.NodeHistory{}.SliceToDomain(history), nil
+}
+
+func (s *service) GetSegmentHistory(ctx context.Context, segmentID uuid.UUID) ([]domain.SegmentVersion, error) {
+	history, err := s.dao.NewSegmentHistoryQuery(ctx).GetSegmentHistory(segmentID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return []domain.SegmentVersion{}, nil
+		}
+		return nil, fmt.Errorf("get segment history: %w", err)
+	}
+
+	return segmentHistoryEntity.SegmentHistory{}.SliceToDomain(history), nil
+}
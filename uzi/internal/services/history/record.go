@@ -0,0 +1,115 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	nodeHistoryEntity "uzi/internal/repository/node_history/entity"
+	segmentHistoryEntity "uzi/internal/repository/segment_history/entity"
+)
+
+func (s *service) RecordNode(ctx context.Context, action domain.HistoryAction, before, after *domain.Node) error {
+	node := after
+	if node == nil {
+		node = before
+	}
+	query := s.dao.NewNodeHistoryQuery(ctx)
+
+	version := 1
+	last, err := query.GetLastNodeHistory(node.Id)
+	switch {
+	case err == nil:
+		version = last.Version + 1
+
+	case errors.Is(err, entity.ErrNotFound):
+		// узел создан до ведения истории, сначала сохраняем его исходное состояние
+		if before != nil {
+			if err := query.InsertNodeHistory(nodeHistoryEntity.NodeHistory{}.FromDomain(domain.NodeVersion{
+				NodeID:  node.Id,
+				UziID:   node.UziID,
+				Version: version,
+				Action:  domain.HistoryActionSnapshot,
+				After:   before,
+			})); err != nil {
+				return fmt.Errorf("insert node snapshot: %w", err)
+			}
+			version++
+		}
+
+	default:
+		return fmt.Errorf("get last node history: %w", err)
+	}
+
+	if err := query.InsertNodeHistory(nodeHistoryEntity.NodeHistory{}.FromDomain(domain.NodeVersion{
+		NodeID:  node.Id,
+		UziID:   node.UziID,
+		Version: version,
+		Action:  action,
+		Actor:   domain.ActorFromContext(ctx),
+		Before:  before,
+		After:   after,
+	})); err != nil {
+		return recordErr("insert node history", err)
+	}
+
+	return nil
+}
+
+func (s *service) RecordSegment(ctx context.Context, action domain.HistoryAction, before, after *domain.Segment) error {
+	segment := after
+	if segment == nil {
+		segment = before
+	}
+	query := s.dao.NewSegmentHistoryQuery(ctx)
+
+	version := 1
+	last, err := query.GetLastSegmentHistory(segment.Id)
+	switch {
+	case err == nil:
+		version = last.Version + 1
+
+	case errors.Is(err, entity.ErrNotFound):
+		// сегмент создан до ведения истории, сначала сохраняем его исходное состояние
+		if before != nil {
+			if err := query.InsertSegmentHistory(segmentHistoryEntity.SegmentHistory{}.FromDomain(domain.SegmentVersion{
+				SegmentID: segment.Id,
+				NodeID:    before.NodeID,
+				Version:   version,
+				Action:    domain.HistoryActionSnapshot,
+				After:     before,
+			})); err != nil {
+				return fmt.Errorf("insert segment snapshot: %w", err)
+			}
+			version++
+		}
+
+	default:
+		return fmt.Errorf("get last segment history: %w", err)
+	}
+
+	if err := query.InsertSegmentHistory(segmentHistoryEntity.SegmentHistory{}.FromDomain(domain.SegmentVersion{
+		SegmentID: segment.Id,
+		NodeID:    segment.NodeID,
+		Version:   version,
+		Action:    action,
+		Actor:     domain.ActorFromContext(ctx),
+		Before:    before,
+		After:     after,
+	})); err != nil {
+		return recordErr("insert segment history", err)
+	}
+
+	return nil
+}
+
+// recordErr параллельное изменение того же объекта заняло номер версии
+func recordErr(msg string, err error) error {
+	var conflictErr *entity.DBConflictError
+	if errors.As(err, &conflictErr) {
+		return fmt.Errorf("%s: %w", msg, domain.ErrConflict)
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
@@ -0,0 +1,171 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	nodeEntity "uzi/internal/repository/node/entity"
+	segmentEntity "uzi/internal/repository/segment/entity"
+	segmentHistoryEntity "uzi/internal/repository/segment_history/entity"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) RestoreNode(ctx context.Context, nodeID uuid.UUID, version int) (domain.Node, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Node{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	historyQuery := s.dao.NewNodeHistoryQuery(ctx)
+	target, err := historyQuery.GetNodeHistoryByVersion(nodeID, version)
+	if err != nil {
+		return domain.Node{}, fmt.Errorf("get node history by version: %w", err)
+	}
+	restored := target.ToDomain().After
+	if restored == nil {
+		return domain.Node{}, fmt.Errorf("version %d is node deletion: %w", version, domain.ErrBadRequest)
+	}
+
+	nodeQuery := s.dao.NewNodeQuery(ctx)
+	var before *domain.Node
+	current, err := nodeQuery.GetNodeByID(nodeID)
+	switch {
+	case err == nil:
+		node := current.ToDomain()
+		before = &node
+		if err := nodeQuery.UpdateNode(nodeEntity.Node{}.FromDomain(*restored)); err != nil {
+			return domain.Node{}, fmt.Errorf("update node: %w", err)
+		}
+
+	case errors.Is(err, entity.ErrNotFound):
+		if err := nodeQuery.InsertNodes(nodeEntity.Node{}.FromDomain(*restored)); err != nil {
+			return domain.Node{}, fmt.Errorf("insert node: %w", err)
+		}
+		if err := s.restoreDeletedSegments(ctx, nodeID); err != nil {
+			return domain.Node{}, err
+		}
+
+	default:
+		return domain.Node{}, fmt.Errorf("get node by id: %w", err)
+	}
+
+	if err := s.RecordNode(ctx, domain.HistoryActionRestore, before, restored); err != nil {
+		return domain.Node{}, fmt.Errorf("record node history: %w", err)
+	}
+
+	if err := s.measurement.RecalculateNode(ctx, nodeID); err != nil {
+		return domain.Node{}, fmt.Errorf("recalculate node measurement: %w", err)
+	}
+
+	nodeDB, err := nodeQuery.GetNodeByID(nodeID)
+	if err != nil {
+		return domain.Node{}, fmt.Errorf("get restored node: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Node{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nodeDB.ToDomain(), nil
+}
+
+// restoreDeletedSegments возвращает сегменты, удаленные вместе с узлом.
+// Узел и его сегменты удаляются в одной транзакции, поэтому время записей в истории совпадает
+func (s *service) restoreDeletedSegments(ctx context.Context, nodeID uuid.UUID) error {
+	nodeDelete, err := s.dao.NewNodeHistoryQuery(ctx).GetLastNodeHistory(nodeID)
+	if err != nil {
+		return fmt.Errorf("get last node history: %w", err)
+	}
+	if domain.HistoryAction(nodeDelete.Action) != domain.HistoryActionDelete {
+		return nil
+	}
+
+	histories, err := s.dao.NewSegmentHistoryQuery(ctx).GetLastSegmentHistoriesByNodeID(nodeID)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		return fmt.Errorf("get last segment histories: %w", err)
+	}
+
+	segmentHistories := segmentHistoryEntity.SegmentHistory{}.SliceToDomain(histories)
+	for _, history := range segmentHistories {
+		if history.Action != domain.HistoryActionDelete || !history.CreateAt.Equal(nodeDelete.CreateAt) {
+			continue
+		}
+		segment := history.Before
+		if err := s.dao.NewSegmentQuery(ctx).InsertSegments(segmentEntity.Segment{}.FromDomain(*segment)); err != nil {
+			return fmt.Errorf("insert segment: %w", err)
+		}
+		if err := s.RecordSegment(ctx, domain.HistoryActionRestore, nil, segment); err != nil {
+			return fmt.Errorf("record segment history: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) RestoreSegment(ctx context.Context, segmentID uuid.UUID, version int) (domain.Segment, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Segment{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	target, err := s.dao.NewSegmentHistoryQuery(ctx).GetSegmentHistoryByVersion(segmentID, version)
+	if err != nil {
+		return domain.Segment{}, fmt.Errorf("get segment history by version: %w", err)
+	}
+	restored := target.ToDomain().After
+	if restored == nil {
+		return domain.Segment{}, fmt.Errorf("version %d is segment deletion: %w", version, domain.ErrBadRequest)
+	}
+
+	if _, err := s.dao.NewNodeQuery(ctx).GetNodeByID(restored.NodeID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return domain.Segment{}, fmt.Errorf("segment node deleted, restore node first: %w", domain.ErrUnprocessableEntity)
+		}
+		return domain.Segment{}, fmt.Errorf("get node by id: %w", err)
+	}
+
+	segmentQuery := s.dao.NewSegmentQuery(ctx)
+	var before *domain.Segment
+	current, err := segmentQuery.GetSegmentByID(segmentID)
+	switch {
+	case err == nil:
+		segment := current.ToDomain()
+		before = &segment
+		if err := segmentQuery.UpdateSegment(segmentEntity.Segment{}.FromDomain(*restored)); err != nil {
+			return domain.Segment{}, fmt.Errorf("update segment: %w", err)
+		}
+
+	case errors.Is(err, entity.ErrNotFound):
+		if err := segmentQuery.InsertSegments(segmentEntity.Segment{}.FromDomain(*restored)); err != nil {
+			return domain.Segment{}, fmt.Errorf("insert segment: %w", err)
+		}
+
+	default:
+		return domain.Segment{}, fmt.Errorf("get segment by id: %w", err)
+	}
+
+	if err := s.RecordSegment(ctx, domain.HistoryActionRestore, before, restored); err != nil {
+		return domain.Segment{}, fmt.Errorf("record segment history: %w", err)
+	}
+
+	if err := s.measurement.RecalculateNode(ctx, restored.NodeID); err != nil {
+		return domain.Segment{}, fmt.Errorf("recalculate node measurement: %w", err)
+	}
+
+	segmentDB, err := segmentQuery.GetSegmentByID(segmentID)
+	if err != nil {
+		return domain.Segment{}, fmt.Errorf("get restored segment: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Segment{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return segmentDB.ToDomain(), nil
+}
@@ -0,0 +1,44 @@
+// История изменений узлов и сегментов с восстановлением прошлых версий
+package history
+
+import (
+	"context"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+	"uzi/internal/services/measurement"
+
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	// RecordNode и RecordSegment вызываются сервисами, меняющими узлы и сегменты, внутри их транзакций.
+	// before nil - объект создан, after nil - удален. Пользователь берется из контекста
+	RecordNode(ctx context.Context, action domain.HistoryAction, before, after *domain.Node) error
+	RecordSegment(ctx context.Context, action domain.HistoryAction, before, after *domain.Segment) error
+
+	// история от новых версий к старым
+	GetNodeHistory(ctx context.Context, nodeID uuid.UUID) ([]domain.NodeVersion, error)
+	GetSegmentHistory(ctx context.Context, segmentID uuid.UUID) ([]domain.SegmentVersion, error)
+
+	// RestoreNode возвращает узел к состоянию после указанной версии.
+	// Удаленный узел создается заново вместе с сегментами, удаленными в той же операции
+	RestoreNode(ctx context.Context, nodeID uuid.UUID, version int) (domain.Node, error)
+	// RestoreSegment возвращает сегмент к состоянию после указанной версии, узел сегмента должен существовать
+	RestoreSegment(ctx context.Context, segmentID uuid.UUID, version int) (domain.Segment, error)
+}
+
+type service struct {
+	dao         repository.DAO
+	measurement measurement.Service
+}
+
+func New(
+	dao repository.DAO,
+	measurement measurement.Service,
+) Service {
+	return &service{
+		dao:         dao,
+		measurement: measurement,
+	}
+}
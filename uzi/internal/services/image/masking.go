@@ -0,0 +1,61 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"uzi/internal/domain"
+)
+
+type maskedRegion struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+type frameMasking struct {
+	Page    int            `json:"page"`
+	Regions []maskedRegion `json:"regions"`
+}
+
+// maskingReport json аудита закрашивания, в нем только геометрия без содержимого полос
+type maskingReport struct {
+	UziID         string         `json:"uzi_id"`
+	TopPercent    float64        `json:"top_percent"`
+	BottomPercent float64        `json:"bottom_percent"`
+	Frames        []frameMasking `json:"frames"`
+	CreateAt      time.Time      `json:"create_at"`
+}
+
+func (s *service) saveMaskingReport(ctx context.Context, report domain.MaskingReport) error {
+	frames := make([]frameMasking, 0, len(report.Frames))
+	for _, frame := range report.Frames {
+		regions := make([]maskedRegion, 0, len(frame.Regions))
+		for _, region := range frame.Regions {
+			regions = append(regions, maskedRegion(region))
+		}
+		frames = append(frames, frameMasking{Page: frame.Page, Regions: regions})
+	}
+
+	data, err := json.Marshal(maskingReport{
+		UziID:         report.UziID.String(),
+		TopPercent:    report.Mask.TopPercent,
+		BottomPercent: report.Mask.BottomPercent,
+		Frames:        frames,
+		CreateAt:      report.CreateAt,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal masking report: %w", err)
+	}
+
+	file := domain.File{Format: "application/json", Size: int64(len(data)), Buf: bytes.NewReader(data)}
+	if err := s.dao.NewFileRepo().LoadFile(ctx, report.Path(), file); err != nil {
+		return fmt.Errorf("load masking report to S3: %w", err)
+	}
+
+	return nil
+}
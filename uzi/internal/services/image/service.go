@@ -17,17 +17,25 @@ type Service interface {
 	GetImagesByUziID(ctx context.Context, id uuid.UUID) ([]domain.Image, error)
 }
 
+type Config struct {
+	// полосы кадров с впечатанным текстом, по умолчанию кадры не закрашиваются
+	Mask domain.EdgeMask
+}
+
 type service struct {
 	dao  repository.DAO
 	dbus dbus.Producer
+	cfg  Config
 }
 
 func New(
 	dao repository.DAO,
 	dbus dbus.Producer,
+	cfg Config,
 ) Service {
 	return &service{
 		dao:  dao,
 		dbus: dbus,
+		cfg:  cfg,
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	uzisplittedpb "uzi/internal/generated/dbus/produce/uzisplitted"
 
@@ -40,6 +41,7 @@ func (s *service) SplitUzi(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("split img to png: %w", err)
 	}
 
+	masking := domain.MaskingReport{UziID: id, Mask: s.cfg.Mask, CreateAt: time.Now()}
 	images := make([]domain.Image, len(splitted))
 	for i := range images {
 		images[i].Id = uuid.New()
@@ -47,13 +49,32 @@ func (s *service) SplitUzi(ctx context.Context, id uuid.UUID) error {
 		images[i].Page = i + 1
 	}
 
-	for i, image := range images {
-		if err := fileRepo.LoadFile(
-			ctx,
-			filepath.Join(id.String(), image.Id.String(), image.Id.String()),
-			splitted[i],
-		); err != nil {
-			return fmt.Errorf("load file to S3: %w", err)
+	for i := range images {
+		previews, err := splitterSrv.MakePreviews(splitted[i], s.cfg.Mask)
+		if err != nil {
+			return fmt.Errorf("make previews of page %d: %w", images[i].Page, err)
+		}
+		if len(previews.Masked) > 0 {
+			masking.Frames = append(masking.Frames, domain.FrameMasking{Page: images[i].Page, Regions: previews.Masked})
+		}
+		images[i].Width = previews.Width
+		images[i].Height = previews.Height
+		images[i].Previews = true
+
+		for size, file := range map[domain.ImageSize]domain.File{
+			domain.ImageSizeOriginal:  previews.Frame,
+			domain.ImageSizePreview:   previews.Preview,
+			domain.ImageSizeThumbnail: previews.Thumbnail,
+		} {
+			if err := fileRepo.LoadFile(ctx, images[i].Path(size), file); err != nil {
+				return fmt.Errorf("load %s file to S3: %w", size, err)
+			}
+		}
+	}
+
+	if len(masking.Frames) > 0 {
+		if err := s.saveMaskingReport(ctx, masking); err != nil {
+			return err
 		}
 	}
 
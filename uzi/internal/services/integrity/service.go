@@ -0,0 +1,88 @@
+// Проверка целостности исходных файлов узи: пересчет sha256 объектов S3 и сверка с сохраненной при загрузке
+package integrity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// сколько узи читается из БД за раз
+const pageSize = 100
+
+type Service interface {
+	// Verify пересчитывает sha256 исходных файлов всех узи, для которых она сохранена
+	Verify(ctx context.Context) (domain.IntegrityReport, error)
+}
+
+type service struct {
+	dao repository.DAO
+}
+
+func New(
+	dao repository.DAO,
+) Service {
+	return &service{
+		dao: dao,
+	}
+}
+
+func (s *service) Verify(ctx context.Context) (domain.IntegrityReport, error) {
+	report := domain.IntegrityReport{}
+
+	after := uuid.Nil
+	for {
+		uzis, err := s.dao.NewUziQuery(ctx).GetUzisWithSha256(after, pageSize)
+		if err != nil {
+			return domain.IntegrityReport{}, fmt.Errorf("get uzis with sha256: %w", err)
+		}
+
+		for _, uzi := range uzis {
+			expected := uzi.Sha256.String
+			actual, err := s.hashOriginal(ctx, uzi.Id)
+			switch {
+			case errors.Is(err, repository.ErrFileNotFound):
+				report.Missing = append(report.Missing, uzi.Id)
+			case err != nil:
+				return domain.IntegrityReport{}, fmt.Errorf("hash uzi %s: %w", uzi.Id, err)
+			case actual != expected:
+				report.Mismatches = append(report.Mismatches, domain.IntegrityMismatch{
+					UziID:    uzi.Id,
+					Expected: expected,
+					Actual:   actual,
+				})
+			}
+			report.Checked++
+		}
+
+		if len(uzis) < pageSize {
+			return report, nil
+		}
+		after = uzis[len(uzis)-1].Id
+	}
+}
+
+// hashOriginal читает исходный файл потоком, не сохраняя его на диск
+func (s *service) hashOriginal(ctx context.Context, id uuid.UUID) (string, error) {
+	file, err := s.dao.NewFileRepo().GetFile(ctx, filepath.Join(id.String(), id.String()))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("read file: %w", err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
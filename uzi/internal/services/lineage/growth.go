@@ -0,0 +1,140 @@
+package lineage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+
+	"github.com/google/uuid"
+)
+
+const hoursInDay = 24.0
+
+func (s *service) GetGrowthReport(ctx context.Context, externalID uuid.UUID) ([]domain.NodeGrowth, error) {
+	lineagesDB, err := s.dao.NewNodeLineageQuery(ctx).GetNodeLineagesByExternalID(externalID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return []domain.NodeGrowth{}, nil
+		}
+		return nil, fmt.Errorf("get node lineages by external id: %w", err)
+	}
+
+	nodeIDs := make([]uuid.UUID, 0, len(lineagesDB))
+	for _, v := range lineagesDB {
+		nodeIDs = append(nodeIDs, v.NodeID)
+	}
+	nodes, uzis, err := s.getNodesWithUzis(ctx, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	order := []uuid.UUID{}
+	points := map[uuid.UUID][]domain.NodeGrowthPoint{}
+	for _, v := range lineagesDB {
+		node, ok := nodes[v.NodeID]
+		if !ok {
+			return nil, domain.ErrNotFound
+		}
+		uzi, ok := uzis[node.UziID]
+		if !ok {
+			return nil, fmt.Errorf("get uzi %s: %w", node.UziID, entity.ErrNotFound)
+		}
+
+		if _, ok := points[v.LineageID]; !ok {
+			order = append(order, v.LineageID)
+		}
+		points[v.LineageID] = append(points[v.LineageID], growthPoint(node, uzi))
+	}
+
+	report := make([]domain.NodeGrowth, 0, len(order))
+	for _, lineageID := range order {
+		report = append(report, growth(lineageID, points[lineageID]))
+	}
+
+	return report, nil
+}
+
+// getNodesWithUzis узлы и их узи двумя запросами по id
+func (s *service) getNodesWithUzis(ctx context.Context, nodeIDs []uuid.UUID) (map[uuid.UUID]domain.Node, map[uuid.UUID]domain.Uzi, error) {
+	nodesDB, err := s.dao.NewNodeQuery(ctx).GetNodesByIDs(nodeIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get nodes by ids: %w", err)
+	}
+
+	nodes := make(map[uuid.UUID]domain.Node, len(nodesDB))
+	uziIDs := make([]uuid.UUID, 0, len(nodesDB))
+	for _, v := range nodesDB {
+		nodes[v.Id] = v.ToDomain()
+		uziIDs = append(uziIDs, v.UziID)
+	}
+
+	uzisDB, err := s.dao.NewUziQuery(ctx).GetUzisByIDs(domain.UniqueIDs(uziIDs))
+	if err != nil {
+		return nil, nil, fmt.Errorf("get uzis by ids: %w", err)
+	}
+
+	uzis := make(map[uuid.UUID]domain.Uzi, len(uzisDB))
+	for _, v := range uzisDB {
+		uzis[v.Id] = v.ToDomain()
+	}
+
+	return nodes, uzis, nil
+}
+
+func growthPoint(node domain.Node, uzi domain.Uzi) domain.NodeGrowthPoint {
+	point := domain.NodeGrowthPoint{
+		Node:     node,
+		UziID:    uzi.Id,
+		CreateAt: uzi.CreateAt,
+	}
+
+	// объемы в пикселях между разными узи не сравнимы
+	if m := node.Measurement; m != nil && m.Unit == domain.MeasureUnitMm {
+		volume := m.Volume
+		point.Volume = &volume
+	}
+
+	return point
+}
+
+// growth считает изменение объема и время удвоения между первым и последним измеренным узи
+func growth(lineageID uuid.UUID, points []domain.NodeGrowthPoint) domain.NodeGrowth {
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].CreateAt.Before(points[j].CreateAt)
+	})
+
+	res := domain.NodeGrowth{
+		LineageID: lineageID,
+		Points:    points,
+	}
+
+	var first, last *domain.NodeGrowthPoint
+	for i := range points {
+		if points[i].Volume == nil {
+			continue
+		}
+		if first == nil {
+			first = &points[i]
+		}
+		last = &points[i]
+	}
+	if first == nil || first == last || *first.Volume <= 0 {
+		return res
+	}
+
+	change := (*last.Volume - *first.Volume) / *first.Volume * 100
+	res.VolumeChange = &change
+
+	days := last.CreateAt.Sub(first.CreateAt).Hours() / hoursInDay
+	if *last.Volume > *first.Volume && days > 0 {
+		doubling := days * math.Ln2 / math.Log(*last.Volume / *first.Volume)
+		res.DoublingTime = &doubling
+	}
+
+	return res
+}
@@ -0,0 +1,108 @@
+package lineage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+func TestSuggestionScore(t *testing.T) {
+	measured := func(majorAxis float64) *domain.NodeMeasurement {
+		return &domain.NodeMeasurement{MajorAxis: majorAxis, Unit: domain.MeasureUnitMm}
+	}
+
+	tests := []struct {
+		name      string
+		node      domain.Node
+		candidate domain.Node
+		score     float64
+		ok        bool
+	}{
+		{
+			name:      "different lobes",
+			node:      domain.Node{Lobe: pointer.To(domain.NodeLobeLeft)},
+			candidate: domain.Node{Lobe: pointer.To(domain.NodeLobeRight)},
+			ok:        false,
+		},
+		{
+			name:      "same lobe and size",
+			node:      domain.Node{Lobe: pointer.To(domain.NodeLobeLeft), Measurement: measured(10)},
+			candidate: domain.Node{Lobe: pointer.To(domain.NodeLobeLeft), Measurement: measured(10)},
+			score:     1,
+			ok:        true,
+		},
+		{
+			name:      "same lobe, grown twice",
+			node:      domain.Node{Lobe: pointer.To(domain.NodeLobeLeft), Measurement: measured(20)},
+			candidate: domain.Node{Lobe: pointer.To(domain.NodeLobeLeft), Measurement: measured(10)},
+			score:     0.75,
+			ok:        true,
+		},
+		{
+			name:      "nothing known",
+			node:      domain.Node{},
+			candidate: domain.Node{},
+			score:     0.5,
+			ok:        true,
+		},
+		{
+			name:      "different units",
+			node:      domain.Node{Measurement: measured(10)},
+			candidate: domain.Node{Measurement: &domain.NodeMeasurement{MajorAxis: 100, Unit: domain.MeasureUnitPx}},
+			score:     0.5,
+			ok:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, ok := suggestionScore(tt.node, tt.candidate)
+			require.Equal(t, tt.ok, ok)
+			require.InDelta(t, tt.score, score, 1e-9)
+		})
+	}
+}
+
+func TestGrowth(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("doubled in a year", func(t *testing.T) {
+		res := growth(uuid.Nil, []domain.NodeGrowthPoint{
+			{CreateAt: start.AddDate(0, 0, 366), Volume: pointer.To(200.0)},
+			{CreateAt: start, Volume: pointer.To(100.0)},
+			{CreateAt: start.AddDate(0, 0, 100)},
+		})
+
+		require.Equal(t, start, res.Points[0].CreateAt)
+		require.NotNil(t, res.VolumeChange)
+		require.InDelta(t, 100, *res.VolumeChange, 1e-9)
+		require.NotNil(t, res.DoublingTime)
+		require.InDelta(t, 366, *res.DoublingTime, 1e-9)
+	})
+
+	t.Run("shrinking has no doubling time", func(t *testing.T) {
+		res := growth(uuid.Nil, []domain.NodeGrowthPoint{
+			{CreateAt: start, Volume: pointer.To(100.0)},
+			{CreateAt: start.AddDate(1, 0, 0), Volume: pointer.To(50.0)},
+		})
+
+		require.NotNil(t, res.VolumeChange)
+		require.InDelta(t, -50, *res.VolumeChange, 1e-9)
+		require.Nil(t, res.DoublingTime)
+	})
+
+	t.Run("single measurement", func(t *testing.T) {
+		res := growth(uuid.Nil, []domain.NodeGrowthPoint{
+			{CreateAt: start, Volume: pointer.To(100.0)},
+			{CreateAt: start.AddDate(1, 0, 0)},
+		})
+
+		require.Nil(t, res.VolumeChange)
+		require.Nil(t, res.DoublingTime)
+	})
+}
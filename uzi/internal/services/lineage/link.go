@@ -0,0 +1,167 @@
+package lineage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	lineageEntity "uzi/internal/repository/node_lineage/entity"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) LinkNodes(ctx context.Context, nodeID, targetNodeID uuid.UUID) (uuid.UUID, error) {
+	if nodeID == targetNodeID {
+		return uuid.Nil, domain.ErrBadRequest
+	}
+
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	node, nodeUzi, err := s.getNodeWithUzi(ctx, nodeID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	target, targetUzi, err := s.getNodeWithUzi(ctx, targetNodeID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// связываются только узлы разных узи одного пациента
+	if nodeUzi.ExternalID != targetUzi.ExternalID || nodeUzi.Id == targetUzi.Id {
+		return uuid.Nil, domain.ErrUnprocessableEntity
+	}
+
+	lineageQuery := s.dao.NewNodeLineageQuery(ctx)
+
+	nodeLineage, err := s.getLineageID(ctx, node.Id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	targetLineage, err := s.getLineageID(ctx, target.Id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// отслеживаемая сторона задает цепочку, в нее добавляется вторая
+	lineageID, attach := targetLineage, node
+	switch {
+	case nodeLineage != uuid.Nil && nodeLineage == targetLineage:
+		return nodeLineage, nil
+	case nodeLineage != uuid.Nil && targetLineage != uuid.Nil:
+		// узлы в разных цепочках, сначала один из них нужно отвязать
+		return uuid.Nil, domain.ErrConflict
+	case nodeLineage != uuid.Nil:
+		lineageID, attach = nodeLineage, target
+	case targetLineage == uuid.Nil:
+		lineageID = uuid.New()
+		if err := lineageQuery.InsertNodeLineage(lineageEntity.NodeLineage{
+			NodeID:    target.Id,
+			LineageID: lineageID,
+		}); err != nil {
+			return uuid.Nil, fmt.Errorf("insert target node lineage: %w", err)
+		}
+	}
+
+	// в цепочке не может быть двух узлов из одного узи
+	lineageDB, err := lineageQuery.GetNodeLineagesByLineageID(lineageID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("get lineage nodes: %w", err)
+	}
+	for _, v := range lineageDB {
+		linked, err := s.dao.NewNodeQuery(ctx).GetNodeByID(v.NodeID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("get lineage node: %w", err)
+		}
+		if linked.UziID == attach.UziID {
+			return uuid.Nil, domain.ErrConflict
+		}
+	}
+
+	if err := lineageQuery.InsertNodeLineage(lineageEntity.NodeLineage{
+		NodeID:    attach.Id,
+		LineageID: lineageID,
+	}); err != nil {
+		return uuid.Nil, fmt.Errorf("insert node lineage: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return lineageID, nil
+}
+
+func (s *service) UnlinkNode(ctx context.Context, nodeID uuid.UUID) error {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	lineageQuery := s.dao.NewNodeLineageQuery(ctx)
+
+	lineageDB, err := lineageQuery.GetNodeLineageByNodeID(nodeID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get node lineage: %w", err)
+	}
+
+	if err := lineageQuery.DeleteNodeLineageByNodeID(nodeID); err != nil {
+		return fmt.Errorf("delete node lineage: %w", err)
+	}
+
+	// цепочка из одного узла ничего не отслеживает
+	rest, err := lineageQuery.GetNodeLineagesByLineageID(lineageDB.LineageID)
+	switch {
+	case err == nil && len(rest) == 1:
+		if err := lineageQuery.DeleteNodeLineageByNodeID(rest[0].NodeID); err != nil {
+			return fmt.Errorf("delete last node lineage: %w", err)
+		}
+	case err != nil && !errors.Is(err, entity.ErrNotFound):
+		return fmt.Errorf("get lineage nodes: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) getNodeWithUzi(ctx context.Context, nodeID uuid.UUID) (domain.Node, domain.Uzi, error) {
+	nodeDB, err := s.dao.NewNodeQuery(ctx).GetNodeByID(nodeID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return domain.Node{}, domain.Uzi{}, domain.ErrNotFound
+		}
+		return domain.Node{}, domain.Uzi{}, fmt.Errorf("get node by id: %w", err)
+	}
+
+	uziDB, err := s.dao.NewUziQuery(ctx).GetUziByID(nodeDB.UziID)
+	if err != nil {
+		return domain.Node{}, domain.Uzi{}, fmt.Errorf("get uzi by id: %w", err)
+	}
+
+	return nodeDB.ToDomain(), uziDB.ToDomain(), nil
+}
+
+// getLineageID возвращает uuid.Nil если узел не отслеживается
+func (s *service) getLineageID(ctx context.Context, nodeID uuid.UUID) (uuid.UUID, error) {
+	lineageDB, err := s.dao.NewNodeLineageQuery(ctx).GetNodeLineageByNodeID(nodeID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return uuid.Nil, nil
+		}
+		return uuid.Nil, fmt.Errorf("get node lineage: %w", err)
+	}
+
+	return lineageDB.LineageID, nil
+}
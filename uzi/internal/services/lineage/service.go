@@ -0,0 +1,32 @@
+// Отслеживание узлов между узи одного пациента
+package lineage
+
+import (
+	"context"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	// LinkNodes добавляет неотслеживаемый узел в цепочку наблюдений второго, возвращает id цепочки
+	LinkNodes(ctx context.Context, nodeID, targetNodeID uuid.UUID) (uuid.UUID, error)
+	UnlinkNode(ctx context.Context, nodeID uuid.UUID) error
+
+	SuggestNodeLinks(ctx context.Context, nodeID uuid.UUID) ([]domain.NodeLinkSuggestion, error)
+	GetGrowthReport(ctx context.Context, externalID uuid.UUID) ([]domain.NodeGrowth, error)
+}
+
+type service struct {
+	dao repository.DAO
+}
+
+func New(
+	dao repository.DAO,
+) Service {
+	return &service{
+		dao: dao,
+	}
+}
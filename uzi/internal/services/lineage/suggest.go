@@ -0,0 +1,112 @@
+package lineage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	nodeEntity "uzi/internal/repository/node/entity"
+	uziEntity "uzi/internal/repository/uzi/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// вес совпадения доли в итоговой оценке кандидата, остальное приходится на размер
+	lobeWeight = 0.5
+	// оценка критерия, если данных для сравнения нет
+	unknownScore = 0.5
+)
+
+func (s *service) SuggestNodeLinks(ctx context.Context, nodeID uuid.UUID) ([]domain.NodeLinkSuggestion, error) {
+	node, nodeUzi, err := s.getNodeWithUzi(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// узи, узлы которых уже есть в цепочке узла, не рассматриваются
+	skipUzi := map[uuid.UUID]struct{}{nodeUzi.Id: {}}
+	lineageID, err := s.getLineageID(ctx, node.Id)
+	if err != nil {
+		return nil, err
+	}
+	if lineageID != uuid.Nil {
+		lineageDB, err := s.dao.NewNodeLineageQuery(ctx).GetNodeLineagesByLineageID(lineageID)
+		if err != nil {
+			return nil, fmt.Errorf("get lineage nodes: %w", err)
+		}
+		for _, v := range lineageDB {
+			linked, err := s.dao.NewNodeQuery(ctx).GetNodeByID(v.NodeID)
+			if err != nil {
+				return nil, fmt.Errorf("get lineage node: %w", err)
+			}
+			skipUzi[linked.UziID] = struct{}{}
+		}
+	}
+
+	uzisDB, err := s.dao.NewUziQuery(ctx).GetUzisByExternalID(nodeUzi.ExternalID)
+	if err != nil {
+		return nil, fmt.Errorf("get uzis by external id: %w", err)
+	}
+
+	uzis := uziEntity.Uzi{}.SliceToDomain(uzisDB)
+
+	suggestions := []domain.NodeLinkSuggestion{}
+	for _, uzi := range uzis {
+		if _, ok := skipUzi[uzi.Id]; ok {
+			continue
+		}
+
+		nodesDB, err := s.dao.NewNodeQuery(ctx).GetNodesByUziID(uzi.Id)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("get nodes by uzi id: %w", err)
+		}
+
+		candidates := nodeEntity.Node{}.SliceToDomain(nodesDB)
+		for _, candidate := range candidates {
+			score, ok := suggestionScore(node, candidate)
+			if !ok {
+				continue
+			}
+			suggestions = append(suggestions, domain.NodeLinkSuggestion{
+				Node:  candidate,
+				Score: score,
+			})
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Score > suggestions[j].Score
+	})
+
+	return suggestions, nil
+}
+
+// suggestionScore оценивает похожесть узлов по доле и размеру, значение в [0, 1].
+// Узлы из разных долей не совпадают.
+func suggestionScore(node, candidate domain.Node) (float64, bool) {
+	lobeScore := unknownScore
+	if node.Lobe != nil && candidate.Lobe != nil {
+		if *node.Lobe != *candidate.Lobe {
+			return 0, false
+		}
+		lobeScore = 1
+	}
+
+	sizeScore := unknownScore
+	if a, b := node.Measurement, candidate.Measurement; a != nil && b != nil && a.Unit == b.Unit {
+		maxAxis := math.Max(a.MajorAxis, b.MajorAxis)
+		if maxAxis > 0 {
+			sizeScore = math.Min(a.MajorAxis, b.MajorAxis) / maxAxis
+		}
+	}
+
+	return lobeWeight*lobeScore + (1-lobeWeight)*sizeScore, true
+}
@@ -0,0 +1,163 @@
+package measurement
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+
+	"uzi/internal/domain"
+)
+
+var ErrInvalidContor = domain.ErrInvalidContor
+
+func boundingBox(points []domain.ContorPoint) domain.BoundingBox {
+	minX, minY := points[0].X, points[0].Y
+	maxX, maxY := minX, minY
+	for _, p := range points[1:] {
+		minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+		minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+	}
+
+	x, y := int(math.Floor(minX)), int(math.Floor(minY))
+	return domain.BoundingBox{
+		X:      x,
+		Y:      y,
+		Width:  int(math.Ceil(maxX)) - x,
+		Height: int(math.Ceil(maxY)) - y,
+	}
+}
+
+func scale(points []domain.ContorPoint, spacing domain.PixelSpacing) []domain.ContorPoint {
+	res := make([]domain.ContorPoint, 0, len(points))
+	for _, p := range points {
+		res = append(res, domain.ContorPoint{X: p.X * spacing.X, Y: p.Y * spacing.Y})
+	}
+	return res
+}
+
+// формула шнурования
+func area(points []domain.ContorPoint) float64 {
+	var sum float64
+	for i := range points {
+		j := (i + 1) % len(points)
+		sum += points[i].X*points[j].Y - points[j].X*points[i].Y
+	}
+	return math.Abs(sum) / 2
+}
+
+func perimeter(points []domain.ContorPoint) float64 {
+	var sum float64
+	for i := range points {
+		j := (i + 1) % len(points)
+		sum += math.Hypot(points[j].X-points[i].X, points[j].Y-points[i].Y)
+	}
+	return sum
+}
+
+// выпуклая оболочка, алгоритм Эндрю
+func convexHull(points []domain.ContorPoint) []domain.ContorPoint {
+	sorted := make([]domain.ContorPoint, len(points))
+	copy(sorted, points)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].X == sorted[j].X {
+			return sorted[i].Y < sorted[j].Y
+		}
+		return sorted[i].X < sorted[j].X
+	})
+
+	cross := func(o, a, b domain.ContorPoint) float64 {
+		return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+	}
+
+	hull := make([]domain.ContorPoint, 0, 2*len(sorted))
+	for _, p := range sorted {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	for i, lower := len(sorted)-2, len(hull)+1; i >= 0; i-- {
+		p := sorted[i]
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	return hull[:len(hull)-1]
+}
+
+// большая ось - максимальный диаметр контура,
+// малая ось - максимальная ширина контура перпендикулярно большой оси
+func axes(points []domain.ContorPoint) (float64, float64) {
+	hull := convexHull(points)
+	if len(hull) < 2 {
+		return 0, 0
+	}
+
+	var major float64
+	var from, to domain.ContorPoint
+	for i := range hull {
+		for j := i + 1; j < len(hull); j++ {
+			if d := math.Hypot(hull[j].X-hull[i].X, hull[j].Y-hull[i].Y); d > major {
+				major, from, to = d, hull[i], hull[j]
+			}
+		}
+	}
+	if major == 0 {
+		return 0, 0
+	}
+
+	nx, ny := -(to.Y-from.Y)/major, (to.X-from.X)/major
+	minProj, maxProj := math.Inf(1), math.Inf(-1)
+	for _, p := range hull {
+		proj := p.X*nx + p.Y*ny
+		minProj, maxProj = math.Min(minProj, proj), math.Max(maxProj, proj)
+	}
+
+	return major, maxProj - minProj
+}
+
+// spacing == nil - измерения в пикселях
+func measureSegment(contor json.RawMessage, spacing *domain.PixelSpacing) (domain.SegmentMeasurement, error) {
+	points, err := domain.ParseContor(contor)
+	if err != nil {
+		return domain.SegmentMeasurement{}, err
+	}
+
+	measurement := domain.SegmentMeasurement{
+		BBox: boundingBox(points),
+		Unit: domain.MeasureUnitPx,
+	}
+
+	if spacing != nil {
+		points = scale(points, *spacing)
+		measurement.Unit = domain.MeasureUnitMm
+	}
+
+	measurement.Area = area(points)
+	measurement.Perimeter = perimeter(points)
+	measurement.MajorAxis, measurement.MinorAxis = axes(points)
+
+	return measurement, nil
+}
+
+// узел оценивается по максимальному срезу среди сегментов.
+// объем - эллипсоид π/6·L·W·D, глубина среза неизвестна (одна проекция),
+// поэтому принимаем D = W
+func measureNode(segments []domain.SegmentMeasurement) *domain.NodeMeasurement {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	node := domain.NodeMeasurement{Unit: segments[0].Unit}
+	for _, s := range segments {
+		node.Area = math.Max(node.Area, s.Area)
+		node.Perimeter = math.Max(node.Perimeter, s.Perimeter)
+		node.MajorAxis = math.Max(node.MajorAxis, s.MajorAxis)
+		node.MinorAxis = math.Max(node.MinorAxis, s.MinorAxis)
+	}
+	node.Volume = math.Pi / 6 * node.MajorAxis * node.MinorAxis * node.MinorAxis
+
+	return &node
+}
@@ -0,0 +1,91 @@
+package measurement
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+const eps = 1e-6
+
+func TestMeasureSegment_Rectangle(t *testing.T) {
+	contor := json.RawMessage(`[{"x":10,"y":20},{"x":30,"y":20},{"x":30,"y":30},{"x":10,"y":30}]`)
+
+	m, err := measureSegment(contor, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, domain.MeasureUnitPx, m.Unit)
+	require.Equal(t, domain.BoundingBox{X: 10, Y: 20, Width: 20, Height: 10}, m.BBox)
+	require.InDelta(t, 200, m.Area, eps)
+	require.InDelta(t, 60, m.Perimeter, eps)
+	require.InDelta(t, math.Sqrt(500), m.MajorAxis, eps)
+	require.InDelta(t, 2*20*10/math.Sqrt(500), m.MinorAxis, eps)
+}
+
+func TestMeasureSegment_PixelSpacing(t *testing.T) {
+	contor := json.RawMessage(`[{"x":0,"y":0},{"x":10,"y":0},{"x":10,"y":10},{"x":0,"y":10},{"x":0,"y":0}]`)
+
+	m, err := measureSegment(contor, &domain.PixelSpacing{X: 0.1, Y: 0.2})
+	require.NoError(t, err)
+
+	require.Equal(t, domain.MeasureUnitMm, m.Unit)
+	require.Equal(t, domain.BoundingBox{X: 0, Y: 0, Width: 10, Height: 10}, m.BBox)
+	require.InDelta(t, 2, m.Area, eps)
+	require.InDelta(t, 6, m.Perimeter, eps)
+	require.InDelta(t, math.Sqrt(5), m.MajorAxis, eps)
+}
+
+func TestMeasureSegment_ConcaveContor(t *testing.T) {
+	// буква L: оси считаются по выпуклой оболочке, площадь - по контуру
+	contor := json.RawMessage(`[{"x":0,"y":0},{"x":4,"y":0},{"x":4,"y":1},{"x":1,"y":1},{"x":1,"y":4},{"x":0,"y":4}]`)
+
+	m, err := measureSegment(contor, nil)
+	require.NoError(t, err)
+
+	require.InDelta(t, 7, m.Area, eps)
+	require.InDelta(t, 16, m.Perimeter, eps)
+	require.InDelta(t, math.Sqrt(32), m.MajorAxis, eps)
+}
+
+func TestMeasureSegment_InvalidContor(t *testing.T) {
+	for _, contor := range []string{
+		`{}`,
+		`[]`,
+		`[{"x": 1, "y": 1}]`,
+		`[{"x": 1, "y": 1}, {"x": 2, "y": 2}, {"x": 1, "y": 1}]`,
+	} {
+		_, err := measureSegment(json.RawMessage(contor), nil)
+		require.ErrorIs(t, err, ErrInvalidContor, contor)
+	}
+}
+
+func TestMeasure_NodeAggregate(t *testing.T) {
+	nodeID := uuid.New()
+	nodes := []domain.Node{{Id: nodeID}, {Id: uuid.New()}}
+	segments := []domain.Segment{
+		{NodeID: nodeID, Contor: json.RawMessage(`[{"x":0,"y":0},{"x":20,"y":0},{"x":20,"y":10},{"x":0,"y":10}]`)},
+		{NodeID: nodeID, Contor: json.RawMessage(`[{"x":0,"y":0},{"x":10,"y":0},{"x":10,"y":30},{"x":0,"y":30}]`)},
+		{NodeID: nodeID, Contor: json.RawMessage(`[{"x":1,"y":1}]`)},
+	}
+
+	New(nil).Measure(nil, nodes, segments)
+
+	require.NotNil(t, segments[0].Measurement)
+	require.NotNil(t, segments[1].Measurement)
+	require.Nil(t, segments[2].Measurement)
+	require.Nil(t, nodes[1].Measurement)
+
+	node := nodes[0].Measurement
+	require.NotNil(t, node)
+	require.Equal(t, domain.MeasureUnitPx, node.Unit)
+	require.InDelta(t, 300, node.Area, eps)
+	require.InDelta(t, 80, node.Perimeter, eps)
+	require.InDelta(t, segments[1].Measurement.MajorAxis, node.MajorAxis, eps)
+	require.InDelta(t, segments[1].Measurement.MinorAxis, node.MinorAxis, eps)
+	require.InDelta(t, math.Pi/6*node.MajorAxis*node.MinorAxis*node.MinorAxis, node.Volume, eps)
+}
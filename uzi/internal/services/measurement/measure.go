@@ -0,0 +1,137 @@
+package measurement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	nodeEntity "uzi/internal/repository/node/entity"
+	segmentEntity "uzi/internal/repository/segment/entity"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) Measure(spacing *domain.PixelSpacing, nodes []domain.Node, segments []domain.Segment) {
+	nodesSegments := make(map[uuid.UUID][]domain.SegmentMeasurement, len(nodes))
+	for i := range segments {
+		measurement, err := measureSegment(segments[i].Contor, spacing)
+		if err != nil {
+			// контур без площади не измеряем
+			segments[i].Measurement = nil
+			continue
+		}
+
+		segments[i].Measurement = &measurement
+		nodesSegments[segments[i].NodeID] = append(nodesSegments[segments[i].NodeID], measurement)
+	}
+
+	for i := range nodes {
+		nodes[i].Measurement = measureNode(nodesSegments[nodes[i].Id])
+	}
+}
+
+func (s *service) RecalculateNode(ctx context.Context, nodeID uuid.UUID) error {
+	nodeDB, err := s.dao.NewNodeQuery(ctx).GetNodeByID(nodeID)
+	if err != nil {
+		return fmt.Errorf("get node by id: %w", err)
+	}
+
+	uzi, err := s.dao.NewUziQuery(ctx).GetUziByID(nodeDB.UziID)
+	if err != nil {
+		return fmt.Errorf("get uzi by id: %w", err)
+	}
+
+	segmentsDB, err := s.dao.NewSegmentQuery(ctx).GetSegmentsByNodeID(nodeID)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		return fmt.Errorf("get segments by node_id: %w", err)
+	}
+
+	nodes := []domain.Node{nodeDB.ToDomain()}
+	segments := segmentEntity.Segment{}.SliceToDomain(segmentsDB)
+
+	spacing, err := s.Spacing(ctx, uzi.ToDomain())
+	if err != nil {
+		return err
+	}
+
+	return s.measureAndSave(ctx, spacing, nodes, segments)
+}
+
+func (s *service) RecalculateUzi(ctx context.Context, uziID uuid.UUID) ([]domain.Node, []domain.Segment, error) {
+	uzi, err := s.dao.NewUziQuery(ctx).GetUziByID(uziID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get uzi by id: %w", err)
+	}
+
+	nodesDB, err := s.dao.NewNodeQuery(ctx).GetNodesByUziID(uziID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return []domain.Node{}, []domain.Segment{}, nil
+		}
+		return nil, nil, fmt.Errorf("get nodes by uzi_id: %w", err)
+	}
+	nodes := nodeEntity.Node{}.SliceToDomain(nodesDB)
+
+	segments := make([]domain.Segment, 0, len(nodes))
+	for _, node := range nodes {
+		segmentsDB, err := s.dao.NewSegmentQuery(ctx).GetSegmentsByNodeID(node.Id)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				continue
+			}
+			return nil, nil, fmt.Errorf("get segments by node_id: %w", err)
+		}
+		segments = append(segments, segmentEntity.Segment{}.SliceToDomain(segmentsDB)...)
+	}
+
+	spacing, err := s.Spacing(ctx, uzi.ToDomain())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.measureAndSave(ctx, spacing, nodes, segments); err != nil {
+		return nil, nil, err
+	}
+
+	return nodes, segments, nil
+}
+
+func (s *service) Spacing(ctx context.Context, uzi domain.Uzi) (*domain.PixelSpacing, error) {
+	if uzi.PixelSpacing != nil {
+		return uzi.PixelSpacing, nil
+	}
+
+	device, err := s.dao.NewDeviceQuery(ctx).GetDeviceByID(uzi.DeviceID)
+	if err != nil {
+		return nil, fmt.Errorf("get device by id: %w", err)
+	}
+
+	return device.ToDomain().PixelSpacing, nil
+}
+
+func (s *service) measureAndSave(
+	ctx context.Context,
+	spacing *domain.PixelSpacing,
+	nodes []domain.Node,
+	segments []domain.Segment,
+) error {
+	s.Measure(spacing, nodes, segments)
+
+	segmentQuery := s.dao.NewSegmentQuery(ctx)
+	for _, segment := range segments {
+		if err := segmentQuery.UpdateSegment(segmentEntity.Segment{}.FromDomain(segment)); err != nil {
+			return fmt.Errorf("update segment measurement: %w", err)
+		}
+	}
+
+	nodeQuery := s.dao.NewNodeQuery(ctx)
+	for _, node := range nodes {
+		if err := nodeQuery.UpdateNode(nodeEntity.Node{}.FromDomain(node)); err != nil {
+			return fmt.Errorf("update node measurement: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// Геометрические измерения узлов и сегментов по контурам
+package measurement
+
+import (
+	"context"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// Сервис не открывает транзакций, вызывающая сторона должна передать ctx с транзакцией
+type Service interface {
+	// заполняет Measurement у сегментов и узлов, без обращения к БД
+	Measure(spacing *domain.PixelSpacing, nodes []domain.Node, segments []domain.Segment)
+	// Spacing шаг пикселя узи, если не задан - калибровка аппарата, nil если неизвестен
+	Spacing(ctx context.Context, uzi domain.Uzi) (*domain.PixelSpacing, error)
+
+	RecalculateNode(ctx context.Context, nodeID uuid.UUID) error
+	RecalculateUzi(ctx context.Context, uziID uuid.UUID) ([]domain.Node, []domain.Segment, error)
+}
+
+type service struct {
+	dao repository.DAO
+}
+
+func New(
+	dao repository.DAO,
+) Service {
+	return &service{
+		dao: dao,
+	}
+}
@@ -13,6 +13,7 @@ type UpdateNodeArg struct {
 	Tirads23   *float64
 	Tirads4    *float64
 	Tirads5    *float64
+	Lobe       *domain.NodeLobe
 }
 
 func (u UpdateNodeArg) UpdateDomain(d *domain.Node) {
@@ -28,4 +29,7 @@ func (u UpdateNodeArg) UpdateDomain(d *domain.Node) {
 	if u.Tirads5 != nil {
 		d.Tirads5 = *u.Tirads5
 	}
+	if u.Lobe != nil {
+		d.Lobe = u.Lobe
+	}
 }
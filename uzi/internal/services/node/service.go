@@ -5,6 +5,7 @@ import (
 
 	"uzi/internal/domain"
 	"uzi/internal/repository"
+	"uzi/internal/services/history"
 
 	"github.com/google/uuid"
 )
@@ -16,13 +17,16 @@ type Service interface {
 }
 
 type service struct {
-	dao repository.DAO
+	dao     repository.DAO
+	history history.Service
 }
 
 func New(
 	dao repository.DAO,
+	history history.Service,
 ) Service {
 	return &service{
-		dao: dao,
+		dao:     dao,
+		history: history,
 	}
 }
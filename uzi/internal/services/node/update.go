@@ -12,6 +12,12 @@ import (
 var ErrAiNodeEdit = errors.New("unable to edit ai node")
 
 func (s *service) UpdateNode(ctx context.Context, arg UpdateNodeArg) (domain.Node, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Node{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
 	nodeQuery := s.dao.NewNodeQuery(ctx)
 
 	nodeDB, err := nodeQuery.GetNodeByID(arg.Id)
@@ -29,7 +35,8 @@ func (s *service) UpdateNode(ctx context.Context, arg UpdateNodeArg) (domain.Nod
 			return domain.Node{}, ErrAiNodeEdit
 		}
 
-		if arg.Validation == nil {
+		// долю можно указать и без валидации
+		if arg.Validation == nil && arg.Lobe == nil {
 			return domain.Node{}, fmt.Errorf("can't set nil validation for ai node")
 		}
 
@@ -39,10 +46,19 @@ func (s *service) UpdateNode(ctx context.Context, arg UpdateNodeArg) (domain.Nod
 		}
 	}
 
+	before := node
 	arg.UpdateDomain(&node)
 	if err := nodeQuery.UpdateNode(nodeEntity.Node{}.FromDomain(node)); err != nil {
 		return domain.Node{}, fmt.Errorf("update node: %w", err)
 	}
 
+	if err := s.history.RecordNode(ctx, domain.HistoryActionUpdate, &before, &node); err != nil {
+		return domain.Node{}, fmt.Errorf("record node history: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Node{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
 	return node, nil
 }
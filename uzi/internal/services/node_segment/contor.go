@@ -0,0 +1,38 @@
+package node_segment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+)
+
+// normalizeContors приводит контуры сегментов к текущей схеме в границах их кадров
+func (s *service) normalizeContors(ctx context.Context, segments []domain.Segment) error {
+	imageIDs := make([]uuid.UUID, 0, len(segments))
+	for _, segment := range segments {
+		imageIDs = append(imageIDs, segment.ImageID)
+	}
+
+	imagesDB, err := s.dao.NewImageQuery(ctx).GetImagesByIDs(domain.UniqueIDs(imageIDs))
+	if err != nil {
+		return fmt.Errorf("get images by ids: %w", err)
+	}
+	images := make(map[uuid.UUID]domain.Image, len(imagesDB))
+	for _, v := range imagesDB {
+		images[v.Id] = v.ToDomain()
+	}
+
+	for i := range segments {
+		image := images[segments[i].ImageID]
+		contor, err := domain.NormalizeContor(segments[i].Contor, image.Width, image.Height)
+		if err != nil {
+			return fmt.Errorf("segment on image %s: %w", segments[i].ImageID, err)
+		}
+		segments[i].Contor = contor
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+package node_segment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	daoEntity "uzi/internal/repository/entity"
+	nodeEntity "uzi/internal/repository/node/entity"
+	nodeCorrectionEntity "uzi/internal/repository/node_correction/entity"
+	segmentEntity "uzi/internal/repository/segment/entity"
+)
+
+// aggregateTirads вероятности узла - среднее по его сегментам, узел без сегментов не меняется
+func aggregateTirads(node *domain.Node, segments []domain.Segment) {
+	if len(segments) == 0 {
+		return
+	}
+
+	var tirads23, tirads4, tirads5 float64
+	for _, segment := range segments {
+		tirads23 += segment.Tirads23
+		tirads4 += segment.Tirads4
+		tirads5 += segment.Tirads5
+	}
+	n := float64(len(segments))
+	node.Tirads23, node.Tirads4, node.Tirads5 = tirads23/n, tirads4/n, tirads5/n
+}
+
+func segmentIDsOf(segments []domain.Segment) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(segments))
+	for _, segment := range segments {
+		ids = append(ids, segment.Id)
+	}
+	return ids
+}
+
+func (s *service) getNodeSegments(ctx context.Context, nodeID uuid.UUID) ([]domain.Segment, error) {
+	segmentsDB, err := s.dao.NewSegmentQuery(ctx).GetSegmentsByNodeID(nodeID)
+	if err != nil && !errors.Is(err, daoEntity.ErrNotFound) {
+		return nil, fmt.Errorf("get node segments: %w", err)
+	}
+	return segmentEntity.Segment{}.SliceToDomain(segmentsDB), nil
+}
+
+// moveSegments переносит сегменты в узел и пишет их новые версии
+func (s *service) moveSegments(ctx context.Context, segments []domain.Segment, nodeID uuid.UUID) error {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	if err := s.dao.NewSegmentQuery(ctx).UpdateSegmentsNodeID(segmentIDsOf(segments), nodeID); err != nil {
+		return fmt.Errorf("update segments node: %w", err)
+	}
+
+	for i := range segments {
+		moved := segments[i]
+		moved.NodeID = nodeID
+		if err := s.history.RecordSegment(ctx, domain.HistoryActionUpdate, &segments[i], &moved); err != nil {
+			return fmt.Errorf("record segment history: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// saveTirads сохраняет вероятности узла и пересчитывает его измерения
+func (s *service) saveTirads(ctx context.Context, node domain.Node, segments []domain.Segment) (domain.Node, error) {
+	aggregateTirads(&node, segments)
+
+	nodeQuery := s.dao.NewNodeQuery(ctx)
+	if err := nodeQuery.UpdateNode(nodeEntity.Node{}.FromDomain(node)); err != nil {
+		return domain.Node{}, fmt.Errorf("update node: %w", err)
+	}
+	if err := s.measurement.RecalculateNode(ctx, node.Id); err != nil {
+		return domain.Node{}, fmt.Errorf("recalculate node measurement: %w", err)
+	}
+
+	nodeDB, err := nodeQuery.GetNodeByID(node.Id)
+	if err != nil {
+		return domain.Node{}, fmt.Errorf("get node by id: %w", err)
+	}
+	return nodeDB.ToDomain(), nil
+}
+
+func (s *service) MergeNodes(ctx context.Context, ids []uuid.UUID) (domain.Node, error) {
+	ids = domain.UniqueIDs(ids)
+	if len(ids) < 2 {
+		return domain.Node{}, fmt.Errorf("merge needs at least 2 nodes: %w", domain.ErrBadRequest)
+	}
+
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Node{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	nodeQuery := s.dao.NewNodeQuery(ctx)
+
+	sources := make([]domain.CorrectedNode, 0, len(ids))
+	segments := make([][]domain.Segment, 0, len(ids))
+	for _, id := range ids {
+		nodeDB, err := nodeQuery.GetNodeByID(id)
+		if err != nil {
+			return domain.Node{}, fmt.Errorf("get node %s: %w", id, err)
+		}
+		node := nodeDB.ToDomain()
+		if len(sources) > 0 && node.UziID != sources[0].Node.UziID {
+			return domain.Node{}, fmt.Errorf("nodes belong to different uzi: %w", domain.ErrBadRequest)
+		}
+
+		nodeSegments, err := s.getNodeSegments(ctx, id)
+		if err != nil {
+			return domain.Node{}, err
+		}
+
+		sources = append(sources, domain.CorrectedNode{Node: node, SegmentIDs: segmentIDsOf(nodeSegments)})
+		segments = append(segments, nodeSegments)
+	}
+
+	// узлы сливаются в первый, он сохраняет id, валидацию, дескрипторы и связи с другими узи
+	target := sources[0].Node
+	merged := segments[0]
+	for i := 1; i < len(sources); i++ {
+		if err := s.moveSegments(ctx, segments[i], target.Id); err != nil {
+			return domain.Node{}, err
+		}
+		for _, segment := range segments[i] {
+			segment.NodeID = target.Id
+			merged = append(merged, segment)
+		}
+
+		if err := nodeQuery.DeleteNodeByID(sources[i].Node.Id); err != nil {
+			return domain.Node{}, fmt.Errorf("delete merged node: %w", err)
+		}
+		if err := s.history.RecordNode(ctx, domain.HistoryActionDelete, &sources[i].Node, nil); err != nil {
+			return domain.Node{}, fmt.Errorf("record node history: %w", err)
+		}
+	}
+
+	result, err := s.saveTirads(ctx, target, merged)
+	if err != nil {
+		return domain.Node{}, err
+	}
+	if err := s.history.RecordNode(ctx, domain.HistoryActionUpdate, &target, &result); err != nil {
+		return domain.Node{}, fmt.Errorf("record node history: %w", err)
+	}
+
+	if err := s.recordCorrection(ctx, domain.NodeCorrectionKindMerge, sources, result.Id); err != nil {
+		return domain.Node{}, err
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Node{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *service) SplitNode(ctx context.Context, id uuid.UUID, segmentIDs []uuid.UUID) (domain.Node, domain.Node, error) {
+	segmentIDs = domain.UniqueIDs(segmentIDs)
+	if len(segmentIDs) == 0 {
+		return domain.Node{}, domain.Node{}, fmt.Errorf("no segments to split: %w", domain.ErrBadRequest)
+	}
+
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Node{}, domain.Node{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	nodeDB, err := s.dao.NewNodeQuery(ctx).GetNodeByID(id)
+	if err != nil {
+		return domain.Node{}, domain.Node{}, fmt.Errorf("get node by id: %w", err)
+	}
+	source := nodeDB.ToDomain()
+
+	segments, err := s.getNodeSegments(ctx, id)
+	if err != nil {
+		return domain.Node{}, domain.Node{}, err
+	}
+
+	split := make(map[uuid.UUID]struct{}, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		split[segmentID] = struct{}{}
+	}
+	var kept, moved []domain.Segment
+	for _, segment := range segments {
+		if _, ok := split[segment.Id]; ok {
+			moved = append(moved, segment)
+		} else {
+			kept = append(kept, segment)
+		}
+	}
+	if len(moved) != len(segmentIDs) {
+		return domain.Node{}, domain.Node{}, fmt.Errorf("segments do not belong to node: %w", domain.ErrBadRequest)
+	}
+	if len(kept) == 0 {
+		return domain.Node{}, domain.Node{}, fmt.Errorf("node must keep at least one segment: %w", domain.ErrBadRequest)
+	}
+
+	// новый узел того же происхождения, узел нейросети снова ждет валидации
+	created := domain.Node{
+		Id:    uuid.New(),
+		Ai:    source.Ai,
+		UziID: source.UziID,
+		Lobe:  source.Lobe,
+	}
+	if source.Ai {
+		validation := domain.NodeValidationNull
+		created.Validation = &validation
+	}
+	aggregateTirads(&created, moved)
+
+	if err := s.dao.NewNodeQuery(ctx).InsertNodes(nodeEntity.Node{}.FromDomain(created)); err != nil {
+		return domain.Node{}, domain.Node{}, fmt.Errorf("insert node: %w", err)
+	}
+	if err := s.moveSegments(ctx, moved, created.Id); err != nil {
+		return domain.Node{}, domain.Node{}, err
+	}
+	for i := range moved {
+		moved[i].NodeID = created.Id
+	}
+
+	created, err = s.saveTirads(ctx, created, moved)
+	if err != nil {
+		return domain.Node{}, domain.Node{}, err
+	}
+	if err := s.history.RecordNode(ctx, domain.HistoryActionCreate, nil, &created); err != nil {
+		return domain.Node{}, domain.Node{}, fmt.Errorf("record node history: %w", err)
+	}
+
+	result, err := s.saveTirads(ctx, source, kept)
+	if err != nil {
+		return domain.Node{}, domain.Node{}, err
+	}
+	if err := s.history.RecordNode(ctx, domain.HistoryActionUpdate, &source, &result); err != nil {
+		return domain.Node{}, domain.Node{}, fmt.Errorf("record node history: %w", err)
+	}
+
+	sources := []domain.CorrectedNode{{Node: source, SegmentIDs: segmentIDsOf(segments)}}
+	if err := s.recordCorrection(ctx, domain.NodeCorrectionKindSplit, sources, result.Id, created.Id); err != nil {
+		return domain.Node{}, domain.Node{}, err
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Node{}, domain.Node{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return result, created, nil
+}
+
+func (s *service) recordCorrection(
+	ctx context.Context,
+	kind domain.NodeCorrectionKind,
+	sources []domain.CorrectedNode,
+	resultIDs ...uuid.UUID,
+) error {
+	correction := domain.NodeCorrection{
+		Id:        uuid.New(),
+		UziID:     sources[0].Node.UziID,
+		Kind:      kind,
+		Actor:     domain.ActorFromContext(ctx),
+		Sources:   sources,
+		ResultIDs: resultIDs,
+	}
+
+	if err := s.dao.NewNodeCorrectionQuery(ctx).InsertNodeCorrection(nodeCorrectionEntity.NodeCorrection{}.FromDomain(correction)); err != nil {
+		return fmt.Errorf("insert node correction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package node_segment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+func TestAggregateTirads(t *testing.T) {
+	node := domain.Node{Tirads23: 1}
+	aggregateTirads(&node, []domain.Segment{
+		{Tirads23: 0.2, Tirads4: 0.6, Tirads5: 0.2},
+		{Tirads23: 0, Tirads4: 0.2, Tirads5: 0.8},
+	})
+	require.InDelta(t, 0.1, node.Tirads23, 1e-9)
+	require.InDelta(t, 0.4, node.Tirads4, 1e-9)
+	require.InDelta(t, 0.5, node.Tirads5, 1e-9)
+
+	// без сегментов вероятности узла не трогаются
+	node = domain.Node{Tirads4: 0.7}
+	aggregateTirads(&node, nil)
+	require.Equal(t, 0.7, node.Tirads4)
+}
@@ -27,6 +27,32 @@ func (s *service) createNodesWithSegments(
 		o(opt)
 	}
 
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	ids, err := s.insertNodesWithSegments(ctx, uziID, ai, arg, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return ids, nil
+}
+
+// insertNodesWithSegments создает узлы узи в транзакции из ctx
+func (s *service) insertNodesWithSegments(
+	ctx context.Context,
+	uziID uuid.UUID,
+	ai bool,
+	arg []CreateNodesWithSegmentsArg,
+	opt *createNodesWithSegmentsOption,
+) ([]CreateNodesWithSegmentsID, error) {
 	nodes, segments, ids := s.createDomainNodeSegmentsFromArgs(uziID, ai, arg)
 
 	if opt.setNodesValidation != nil {
@@ -35,11 +61,19 @@ func (s *service) createNodesWithSegments(
 		}
 	}
 
-	ctx, err := s.dao.BeginTx(ctx)
+	if err := s.normalizeContors(ctx, segments); err != nil {
+		return nil, err
+	}
+
+	uzi, err := s.dao.NewUziQuery(ctx).GetUziByID(uziID)
 	if err != nil {
-		return nil, fmt.Errorf("begin transaction: %w", err)
+		return nil, fmt.Errorf("get uzi by id: %w", err)
 	}
-	defer func() { _ = s.dao.RollbackTx(ctx) }()
+	spacing, err := s.measurement.Spacing(ctx, uzi.ToDomain())
+	if err != nil {
+		return nil, err
+	}
+	s.measurement.Measure(spacing, nodes, segments)
 
 	nodeQuery := s.dao.NewNodeQuery(ctx)
 	segmentQuery := s.dao.NewSegmentQuery(ctx)
@@ -52,16 +86,23 @@ func (s *service) createNodesWithSegments(
 		return nil, fmt.Errorf("insert segments: %w", err)
 	}
 
+	for i := range nodes {
+		if err := s.history.RecordNode(ctx, domain.HistoryActionCreate, nil, &nodes[i]); err != nil {
+			return nil, fmt.Errorf("record node history: %w", err)
+		}
+	}
+	for i := range segments {
+		if err := s.history.RecordSegment(ctx, domain.HistoryActionCreate, nil, &segments[i]); err != nil {
+			return nil, fmt.Errorf("record segment history: %w", err)
+		}
+	}
+
 	if opt.newUziStatus != nil {
 		if err := s.dao.NewUziQuery(ctx).UpdateUziStatus(uziID, opt.newUziStatus.String()); err != nil {
 			return nil, fmt.Errorf("update uzi status: %w", err)
 		}
 	}
 
-	if err := s.dao.CommitTx(ctx); err != nil {
-		return nil, fmt.Errorf("commit transaction: %w", err)
-	}
-
 	return ids, nil
 }
 
@@ -90,6 +131,32 @@ func (s *service) CreateManualNodesWithSegments(
 	return s.createNodesWithSegments(ctx, uziID, false, arg)
 }
 
+func (s *service) CreateManualNodesWithSegmentsForUzis(
+	ctx context.Context,
+	arg []CreateUziNodesWithSegmentsArg,
+) ([][]CreateNodesWithSegmentsID, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	ids := make([][]CreateNodesWithSegmentsID, 0, len(arg))
+	for _, v := range arg {
+		uziIDs, err := s.insertNodesWithSegments(ctx, v.UziID, false, v.Nodes, &createNodesWithSegmentsOption{})
+		if err != nil {
+			return nil, fmt.Errorf("create nodes of uzi %s: %w", v.UziID, err)
+		}
+		ids = append(ids, uziIDs)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return ids, nil
+}
+
 func (s *service) createDomainNodeSegmentsFromArgs(
 	uziID uuid.UUID,
 	ai bool,
@@ -7,7 +7,9 @@ import (
 
 	"github.com/google/uuid"
 
+	"uzi/internal/domain"
 	daoEntity "uzi/internal/repository/entity"
+	segmentEntity "uzi/internal/repository/segment/entity"
 )
 
 var (
@@ -30,7 +32,14 @@ func (s *service) DeleteNode(ctx context.Context, id uuid.UUID) error {
 	}
 	defer func() { _ = s.dao.RollbackTx(ctx) }()
 
-	if err := s.dao.NewSegmentQuery(ctx).DeleteSegmentsByUziID(id); err != nil {
+	segmentQuery := s.dao.NewSegmentQuery(ctx)
+
+	segments, err := segmentQuery.GetSegmentsByNodeID(id)
+	if err != nil && !errors.Is(err, daoEntity.ErrNotFound) {
+		return fmt.Errorf("get node segments: %w", err)
+	}
+
+	if err := segmentQuery.DeleteSegmentsByUziID(id); err != nil {
 		return fmt.Errorf("delete node segments: %w", err)
 	}
 
@@ -38,6 +47,18 @@ func (s *service) DeleteNode(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("delete node: %w", err)
 	}
 
+	deletedSegments := segmentEntity.Segment{}.SliceToDomain(segments)
+	for i := range deletedSegments {
+		if err := s.history.RecordSegment(ctx, domain.HistoryActionDelete, &deletedSegments[i], nil); err != nil {
+			return fmt.Errorf("record segment history: %w", err)
+		}
+	}
+
+	deletedNode := node.ToDomain()
+	if err := s.history.RecordNode(ctx, domain.HistoryActionDelete, &deletedNode, nil); err != nil {
+		return fmt.Errorf("record node history: %w", err)
+	}
+
 	if err := s.dao.CommitTx(ctx); err != nil {
 		return fmt.Errorf("commit transaction: %w", err)
 	}
@@ -67,18 +88,38 @@ func (s *service) DeleteSegment(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("delete segment: %w", err)
 	}
 
+	deletedSegment := segment.ToDomain()
+	if err := s.history.RecordSegment(ctx, domain.HistoryActionDelete, &deletedSegment, nil); err != nil {
+		return fmt.Errorf("record segment history: %w", err)
+	}
+
 	_, err = segmentQuery.GetSegmentsByNodeID(segment.NodeID)
-	if err != nil {
-		switch {
-		case errors.Is(err, daoEntity.ErrNotFound):
-			// у node не осталось сегментов, удаляем
-			if err := s.dao.NewNodeQuery(ctx).DeleteNodeByID(segment.NodeID); err != nil {
-				return fmt.Errorf("delete node by id: %w", err)
-			}
-
-		default:
-			return fmt.Errorf("get remaining segments by node_id: %w", err)
+	switch {
+	case err == nil:
+		// пересчитываем измерения узла по оставшимся сегментам
+		if err := s.measurement.RecalculateNode(ctx, segment.NodeID); err != nil {
+			return fmt.Errorf("recalculate node measurement: %w", err)
 		}
+
+	case errors.Is(err, daoEntity.ErrNotFound):
+		// у node не осталось сегментов, удаляем
+		nodeQuery := s.dao.NewNodeQuery(ctx)
+		node, err := nodeQuery.GetNodeByID(segment.NodeID)
+		if err != nil {
+			return fmt.Errorf("get node by id: %w", err)
+		}
+
+		if err := nodeQuery.DeleteNodeByID(segment.NodeID); err != nil {
+			return fmt.Errorf("delete node by id: %w", err)
+		}
+
+		deletedNode := node.ToDomain()
+		if err := s.history.RecordNode(ctx, domain.HistoryActionDelete, &deletedNode, nil); err != nil {
+			return fmt.Errorf("record node history: %w", err)
+		}
+
+	default:
+		return fmt.Errorf("get remaining segments by node_id: %w", err)
 	}
 
 	if err := s.dao.CommitTx(ctx); err != nil {
@@ -26,6 +26,11 @@ type CreateNodesWithSegmentsArg struct {
 	Segments []CreateNodesWithSegmentsArgSegment
 }
 
+type CreateUziNodesWithSegmentsArg struct {
+	UziID uuid.UUID
+	Nodes []CreateNodesWithSegmentsArg
+}
+
 type CreateNodesWithSegmentsID struct {
 	NodeID     uuid.UUID
 	SegmentsID []uuid.UUID
@@ -0,0 +1,29 @@
+package node_segment
+
+import (
+	"context"
+	"fmt"
+
+	"uzi/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) RecalculateMeasurements(ctx context.Context, uziID uuid.UUID) ([]domain.Node, []domain.Segment, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	nodes, segments, err := s.measurement.RecalculateUzi(ctx, uziID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("recalculate uzi measurements: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return nil, nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nodes, segments, nil
+}
@@ -7,6 +7,8 @@ import (
 
 	"uzi/internal/domain"
 	"uzi/internal/repository"
+	"uzi/internal/services/history"
+	"uzi/internal/services/measurement"
 
 	"github.com/google/uuid"
 )
@@ -14,17 +16,28 @@ import (
 type Service interface {
 	SaveProcessedNodesWithSegments(ctx context.Context, uziID uuid.UUID, arg []CreateNodesWithSegmentsArg) error
 	CreateManualNodesWithSegments(ctx context.Context, uziID uuid.UUID, arg []CreateNodesWithSegmentsArg) ([]CreateNodesWithSegmentsID, error)
+	// CreateManualNodesWithSegmentsForUzis создает узлы нескольких узи одной транзакцией, id в порядке arg
+	CreateManualNodesWithSegmentsForUzis(ctx context.Context, arg []CreateUziNodesWithSegmentsArg) ([][]CreateNodesWithSegmentsID, error)
 
 	GetNodesWithSegmentsByImageID(ctx context.Context, id uuid.UUID) ([]domain.Node, []domain.Segment, error)
 
+	RecalculateMeasurements(ctx context.Context, uziID uuid.UUID) ([]domain.Node, []domain.Segment, error)
+
 	DeleteNode(ctx context.Context, id uuid.UUID) error
 	DeleteSegment(ctx context.Context, id uuid.UUID) error
+
+	// MergeNodes сливает узлы в первый из переданных
+	MergeNodes(ctx context.Context, ids []uuid.UUID) (domain.Node, error)
+	// SplitNode переносит сегменты узла в новый узел, возвращает исходный и новый узлы
+	SplitNode(ctx context.Context, id uuid.UUID, segmentIDs []uuid.UUID) (domain.Node, domain.Node, error)
 }
 
 type service struct {
-	dao repository.DAO
+	dao         repository.DAO
+	measurement measurement.Service
+	history     history.Service
 }
 
-func New(dao repository.DAO) Service {
-	return &service{dao: dao}
+func New(dao repository.DAO, measurement measurement.Service, history history.Service) Service {
+	return &service{dao: dao, measurement: measurement, history: history}
 }
@@ -0,0 +1,106 @@
+package propagation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	segmentEntity "uzi/internal/repository/segment/entity"
+	segmentDraftEntity "uzi/internal/repository/segment_draft/entity"
+	"uzi/internal/services/segment"
+)
+
+func (s *service) GetSegmentDraftsByNodeID(ctx context.Context, nodeID uuid.UUID) ([]domain.SegmentDraft, error) {
+	drafts, err := s.dao.NewSegmentDraftQuery(ctx).GetSegmentDraftsByNodeID(nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("get segment drafts by node id: %w", err)
+	}
+
+	return segmentDraftEntity.SegmentDraft{}.SliceToDomain(drafts), nil
+}
+
+func (s *service) AcceptSegmentDraft(ctx context.Context, id uuid.UUID) (domain.Segment, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Segment{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	draftQuery := s.dao.NewSegmentDraftQuery(ctx)
+	draftDB, err := draftQuery.GetSegmentDraftByID(id)
+	if err != nil {
+		return domain.Segment{}, fmt.Errorf("get segment draft by id: %w", err)
+	}
+	draft := draftDB.ToDomain()
+
+	node, err := s.dao.NewNodeQuery(ctx).GetNodeByID(draft.NodeID)
+	if err != nil {
+		return domain.Segment{}, fmt.Errorf("get node by id: %w", err)
+	}
+	if node.Ai {
+		return domain.Segment{}, segment.ErrAddSegmentToAiNode
+	}
+
+	// пока черновик ждал решения, врач мог сам обвести узел на этом кадре
+	segmentQuery := s.dao.NewSegmentQuery(ctx)
+	segmentsDB, err := segmentQuery.GetSegmentsByNodeID(draft.NodeID)
+	if err != nil {
+		return domain.Segment{}, fmt.Errorf("get node segments: %w", err)
+	}
+	for _, v := range segmentsDB {
+		if v.ImageID == draft.ImageID {
+			return domain.Segment{}, fmt.Errorf("node already has segment on image %s: %w", draft.ImageID, domain.ErrConflict)
+		}
+	}
+
+	created := domain.Segment{
+		Id:       uuid.New(),
+		ImageID:  draft.ImageID,
+		NodeID:   draft.NodeID,
+		Contor:   draft.Contor,
+		Ai:       false,
+		Tirads23: draft.Tirads23,
+		Tirads4:  draft.Tirads4,
+		Tirads5:  draft.Tirads5,
+	}
+	if err := segmentQuery.InsertSegments(segmentEntity.Segment{}.FromDomain(created)); err != nil {
+		return domain.Segment{}, fmt.Errorf("insert segment: %w", err)
+	}
+	if err := draftQuery.DeleteSegmentDraftByID(id); err != nil {
+		return domain.Segment{}, fmt.Errorf("delete segment draft: %w", err)
+	}
+
+	if err := s.measurement.RecalculateNode(ctx, draft.NodeID); err != nil {
+		return domain.Segment{}, fmt.Errorf("recalculate node measurement: %w", err)
+	}
+
+	if err := s.history.RecordSegment(ctx, domain.HistoryActionCreate, nil, &created); err != nil {
+		return domain.Segment{}, fmt.Errorf("record segment history: %w", err)
+	}
+
+	segmentDB, err := segmentQuery.GetSegmentByID(created.Id)
+	if err != nil {
+		return domain.Segment{}, fmt.Errorf("get segment by id: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Segment{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return segmentDB.ToDomain(), nil
+}
+
+func (s *service) DiscardSegmentDraft(ctx context.Context, id uuid.UUID) error {
+	draftQuery := s.dao.NewSegmentDraftQuery(ctx)
+	if _, err := draftQuery.GetSegmentDraftByID(id); err != nil {
+		return fmt.Errorf("get segment draft by id: %w", err)
+	}
+
+	if err := draftQuery.DeleteSegmentDraftByID(id); err != nil {
+		return fmt.Errorf("delete segment draft: %w", err)
+	}
+
+	return nil
+}
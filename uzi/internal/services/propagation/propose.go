@@ -0,0 +1,147 @@
+package propagation
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	imageEntity "uzi/internal/repository/image/entity"
+	segmentDraftEntity "uzi/internal/repository/segment_draft/entity"
+	"uzi/internal/services/segment"
+)
+
+func (s *service) ProposeSegments(ctx context.Context, segmentID uuid.UUID, depth int) ([]domain.SegmentDraft, error) {
+	if depth < 1 || depth > MaxDepth {
+		return nil, fmt.Errorf("depth must be from 1 to %d: %w", MaxDepth, domain.ErrBadRequest)
+	}
+
+	segmentDB, err := s.dao.NewSegmentQuery(ctx).GetSegmentByID(segmentID)
+	if err != nil {
+		return nil, fmt.Errorf("get segment by id: %w", err)
+	}
+	source := segmentDB.ToDomain()
+
+	nodeDB, err := s.dao.NewNodeQuery(ctx).GetNodeByID(source.NodeID)
+	if err != nil {
+		return nil, fmt.Errorf("get node by id: %w", err)
+	}
+	if nodeDB.Ai {
+		return nil, segment.ErrAddSegmentToAiNode
+	}
+
+	points, err := domain.ParseContor(source.Contor)
+	if err != nil {
+		return nil, err
+	}
+
+	imagesDB, err := s.dao.NewImageQuery(ctx).GetImagesByUziID(nodeDB.UziID)
+	if err != nil {
+		return nil, fmt.Errorf("get images by uzi id: %w", err)
+	}
+	frames := imageEntity.Image{}.SliceToDomain(imagesDB)
+	slices.SortFunc(frames, func(a, b domain.Image) int { return a.Page - b.Page })
+
+	sourceIdx := slices.IndexFunc(frames, func(frame domain.Image) bool { return frame.Id == source.ImageID })
+	if sourceIdx < 0 {
+		return nil, fmt.Errorf("segment image %s not found in uzi", source.ImageID)
+	}
+
+	segmentsDB, err := s.dao.NewSegmentQuery(ctx).GetSegmentsByNodeID(source.NodeID)
+	if err != nil {
+		return nil, fmt.Errorf("get node segments: %w", err)
+	}
+	segmented := make(map[uuid.UUID]struct{}, len(segmentsDB))
+	for _, v := range segmentsDB {
+		segmented[v.ImageID] = struct{}{}
+	}
+
+	// исходный кадр грузится один раз для обоих направлений
+	grays := make(map[uuid.UUID]*image.Gray)
+	loadGray := func(frame domain.Image) (*image.Gray, error) {
+		if gray, ok := grays[frame.Id]; ok {
+			return gray, nil
+		}
+		gray, err := s.loadGray(ctx, frame)
+		if err != nil {
+			return nil, err
+		}
+		grays[frame.Id] = gray
+		return gray, nil
+	}
+
+	srcGray, err := loadGray(frames[sourceIdx])
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var drafts []domain.SegmentDraft
+	for _, direction := range []int{-1, 1} {
+		prevPoints, prevGray := points, srcGray
+		for step := 1; step <= depth; step++ {
+			idx := sourceIdx + direction*step
+			if idx < 0 || idx >= len(frames) {
+				break
+			}
+			frame := frames[idx]
+			if _, ok := segmented[frame.Id]; ok {
+				break
+			}
+
+			gray, err := loadGray(frame)
+			if err != nil {
+				return nil, err
+			}
+
+			tracked, score, err := track(prevPoints, prevGray, gray)
+			if err != nil || score < MinScore {
+				break
+			}
+
+			drafts = append(drafts, domain.SegmentDraft{
+				Id:              uuid.New(),
+				NodeID:          source.NodeID,
+				ImageID:         frame.Id,
+				SourceSegmentID: source.Id,
+				Contor:          domain.MarshalContor(tracked),
+				Tirads23:        source.Tirads23,
+				Tirads4:         source.Tirads4,
+				Tirads5:         source.Tirads5,
+				Score:           score,
+				CreateAt:        now,
+			})
+			prevPoints, prevGray = tracked, gray
+		}
+	}
+
+	entities := make([]segmentDraftEntity.SegmentDraft, 0, len(drafts))
+	for _, draft := range drafts {
+		entities = append(entities, segmentDraftEntity.SegmentDraft{}.FromDomain(draft))
+	}
+	if err := s.dao.NewSegmentDraftQuery(ctx).InsertSegmentDrafts(entities...); err != nil {
+		return nil, fmt.Errorf("insert segment drafts: %w", err)
+	}
+
+	return drafts, nil
+}
+
+func (s *service) loadGray(ctx context.Context, frame domain.Image) (*image.Gray, error) {
+	file, closer, err := s.dao.NewFileRepo().GetFileViaTemp(ctx, frame.Path(domain.ImageSizeOriginal))
+	if err != nil {
+		return nil, fmt.Errorf("get image from s3: %w", err)
+	}
+	defer closer()
+
+	img, err := png.Decode(file.Buf)
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+
+	return toGray(img), nil
+}
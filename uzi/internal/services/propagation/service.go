@@ -0,0 +1,51 @@
+package propagation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+	"uzi/internal/services/history"
+	"uzi/internal/services/measurement"
+)
+
+const (
+	// сколько кадров в каждую сторону от сегмента предлагается по умолчанию и максимум
+	DefaultDepth = 1
+	MaxDepth     = 10
+	// ниже этой корреляции окрестности контур считается потерянным, распространение в эту сторону останавливается
+	MinScore = 0.3
+)
+
+type Service interface {
+	// ProposeSegments предлагает контуры узла на соседних кадрах по сегменту, до depth кадров в каждую сторону.
+	// Распространение в сторону останавливается на кадре, где у узла уже есть сегмент, или при потере контура.
+	// Прежние черновики узла на тех же кадрах заменяются
+	ProposeSegments(ctx context.Context, segmentID uuid.UUID, depth int) ([]domain.SegmentDraft, error)
+
+	GetSegmentDraftsByNodeID(ctx context.Context, nodeID uuid.UUID) ([]domain.SegmentDraft, error)
+
+	// AcceptSegmentDraft создает из черновика сегмент узла и удаляет черновик
+	AcceptSegmentDraft(ctx context.Context, id uuid.UUID) (domain.Segment, error)
+	DiscardSegmentDraft(ctx context.Context, id uuid.UUID) error
+}
+
+type service struct {
+	dao         repository.DAO
+	measurement measurement.Service
+	history     history.Service
+}
+
+func New(
+	dao repository.DAO,
+	measurement measurement.Service,
+	history history.Service,
+) Service {
+	return &service{
+		dao:         dao,
+		measurement: measurement,
+		history:     history,
+	}
+}
@@ -0,0 +1,253 @@
+package propagation
+
+import (
+	"image"
+	"image/draw"
+	"math"
+
+	"uzi/internal/domain"
+)
+
+const (
+	// отступ вокруг контура, по которому сравниваются кадры: доля стороны bbox и минимум в пикселях
+	marginRatio = 0.15
+	marginMin   = 4
+	// радиус поиска сдвига: доля большей стороны bbox в пределах от min до max пикселей
+	searchRatio = 0.25
+	searchMin   = 8
+	searchMax   = 48
+	// не больше стольких пикселей окрестности на одно сравнение, остальные прореживаются
+	maxSamples = 4096
+
+	// на сколько пикселей по нормали точка контура может сдвинуться к границе
+	snapRadius = 4
+	// граница сильнее среднего градиента кадра во столько раз, иначе точка не двигается
+	snapEdgeFactor = 2.0
+)
+
+// toGray яркость кадра, координаты начинаются с 0
+func toGray(img image.Image) *image.Gray {
+	bounds := img.Bounds()
+	gray := image.NewGray(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(gray, gray.Bounds(), img, bounds.Min, draw.Src)
+	return gray
+}
+
+// contorBounds bbox контура с отступом в пределах кадра
+func contorBounds(points []domain.ContorPoint, frame image.Rectangle) image.Rectangle {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, p := range points {
+		minX, minY = math.Min(minX, p.X), math.Min(minY, p.Y)
+		maxX, maxY = math.Max(maxX, p.X), math.Max(maxY, p.Y)
+	}
+
+	marginX := max(int(marginRatio*(maxX-minX)), marginMin)
+	marginY := max(int(marginRatio*(maxY-minY)), marginMin)
+	rect := image.Rect(
+		int(math.Floor(minX))-marginX,
+		int(math.Floor(minY))-marginY,
+		int(math.Ceil(maxX))+marginX,
+		int(math.Ceil(maxY))+marginY,
+	)
+	return rect.Intersect(frame)
+}
+
+func searchRadius(rect image.Rectangle) int {
+	return min(max(int(searchRatio*float64(max(rect.Dx(), rect.Dy()))), searchMin), searchMax)
+}
+
+// estimateShift сдвиг окрестности rect с кадра src на кадр dst сопоставлением шаблонов:
+// перебираются сдвиги в радиусе, лучший - с наибольшей нормированной корреляцией (NCC).
+// Однотонная окрестность не сравнивается, возвращается нулевой сдвиг с нулевой оценкой
+func estimateShift(src, dst *image.Gray, rect image.Rectangle, radius int) (image.Point, float64) {
+	step := 1
+	if area := rect.Dx() * rect.Dy(); area > maxSamples {
+		step = int(math.Ceil(math.Sqrt(float64(area) / maxSamples)))
+	}
+
+	var template []float64
+	var mean float64
+	for y := rect.Min.Y; y < rect.Max.Y; y += step {
+		for x := rect.Min.X; x < rect.Max.X; x += step {
+			v := float64(src.GrayAt(x, y).Y)
+			template = append(template, v)
+			mean += v
+		}
+	}
+	if len(template) == 0 {
+		return image.Point{}, 0
+	}
+	mean /= float64(len(template))
+
+	var norm float64
+	for i := range template {
+		template[i] -= mean
+		norm += template[i] * template[i]
+	}
+	if norm == 0 {
+		return image.Point{}, 0
+	}
+
+	best, bestScore, bestDist := image.Point{}, math.Inf(-1), math.MaxInt
+	window := make([]float64, len(template))
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			shift := image.Pt(dx, dy)
+			if !rect.Add(shift).In(dst.Bounds()) {
+				continue
+			}
+
+			var windowMean float64
+			i := 0
+			for y := rect.Min.Y; y < rect.Max.Y; y += step {
+				for x := rect.Min.X; x < rect.Max.X; x += step {
+					window[i] = float64(dst.GrayAt(x+dx, y+dy).Y)
+					windowMean += window[i]
+					i++
+				}
+			}
+			windowMean /= float64(len(window))
+
+			var cross, windowNorm float64
+			for i := range window {
+				w := window[i] - windowMean
+				cross += template[i] * w
+				windowNorm += w * w
+			}
+			if windowNorm == 0 {
+				continue
+			}
+
+			score := cross / math.Sqrt(norm*windowNorm)
+			dist := dx*dx + dy*dy
+			// при равной оценке выбирается меньший сдвиг
+			if score > bestScore+1e-9 || (math.Abs(score-bestScore) <= 1e-9 && dist < bestDist) {
+				best, bestScore, bestDist = shift, score, dist
+			}
+		}
+	}
+
+	if math.IsInf(bestScore, -1) {
+		return image.Point{}, 0
+	}
+	return best, bestScore
+}
+
+// gradient модуль градиента Собеля
+type gradient struct {
+	width, height int
+	magnitude     []float64
+	mean          float64
+}
+
+func newGradient(img *image.Gray) gradient {
+	bounds := img.Bounds()
+	g := gradient{
+		width:     bounds.Dx(),
+		height:    bounds.Dy(),
+		magnitude: make([]float64, bounds.Dx()*bounds.Dy()),
+	}
+
+	at := func(x, y int) float64 {
+		x = min(max(x, 0), g.width-1)
+		y = min(max(y, 0), g.height-1)
+		return float64(img.GrayAt(x, y).Y)
+	}
+
+	var sum float64
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			gx := at(x+1, y-1) + 2*at(x+1, y) + at(x+1, y+1) - at(x-1, y-1) - 2*at(x-1, y) - at(x-1, y+1)
+			gy := at(x-1, y+1) + 2*at(x, y+1) + at(x+1, y+1) - at(x-1, y-1) - 2*at(x, y-1) - at(x+1, y-1)
+			m := math.Hypot(gx, gy)
+			g.magnitude[y*g.width+x] = m
+			sum += m
+		}
+	}
+	if len(g.magnitude) > 0 {
+		g.mean = sum / float64(len(g.magnitude))
+	}
+
+	return g
+}
+
+func (g gradient) at(p domain.ContorPoint) float64 {
+	x, y := int(math.Round(p.X)), int(math.Round(p.Y))
+	if x < 0 || y < 0 || x >= g.width || y >= g.height {
+		return 0
+	}
+	return g.magnitude[y*g.width+x]
+}
+
+// snapContor сдвигает точки контура по нормали к ближайшей сильной границе.
+// Сдвиги соседних точек усредняются, чтобы контур не становился рваным
+func snapContor(points []domain.ContorPoint, g gradient) []domain.ContorPoint {
+	n := len(points)
+	threshold := g.mean * snapEdgeFactor
+
+	normals := make([]domain.ContorPoint, n)
+	offsets := make([]float64, n)
+	for i, p := range points {
+		prev, next := points[(i+n-1)%n], points[(i+1)%n]
+		tx, ty := next.X-prev.X, next.Y-prev.Y
+		length := math.Hypot(tx, ty)
+		if length == 0 {
+			continue
+		}
+		normals[i] = domain.ContorPoint{X: ty / length, Y: -tx / length}
+
+		// от исходной точки наружу: при равной силе выигрывает ближняя граница
+		bestMagnitude := threshold
+		for d := 0; d <= 2*snapRadius; d++ {
+			t := float64((d + 1) / 2)
+			if d%2 == 1 {
+				t = -t
+			}
+			m := g.at(domain.ContorPoint{X: p.X + t*normals[i].X, Y: p.Y + t*normals[i].Y})
+			if m > bestMagnitude {
+				bestMagnitude, offsets[i] = m, t
+			}
+		}
+	}
+
+	snapped := make([]domain.ContorPoint, n)
+	for i, p := range points {
+		offset := (offsets[(i+n-1)%n] + 2*offsets[i] + offsets[(i+1)%n]) / 4
+		snapped[i] = domain.ContorPoint{X: p.X + offset*normals[i].X, Y: p.Y + offset*normals[i].Y}
+	}
+	return snapped
+}
+
+func shiftContor(points []domain.ContorPoint, shift image.Point) []domain.ContorPoint {
+	shifted := make([]domain.ContorPoint, 0, len(points))
+	for _, p := range points {
+		shifted = append(shifted, domain.ContorPoint{X: p.X + float64(shift.X), Y: p.Y + float64(shift.Y)})
+	}
+	return shifted
+}
+
+// track переносит контур с кадра src на кадр dst: сдвиг окрестности и прилипание к границам.
+// Возвращает нормализованный контур и оценку совпадения окрестности
+func track(points []domain.ContorPoint, src, dst *image.Gray) ([]domain.ContorPoint, float64, error) {
+	rect := contorBounds(points, src.Bounds())
+	shift, score := estimateShift(src, dst, rect, searchRadius(rect))
+
+	shifted := shiftContor(points, shift)
+	width, height := dst.Bounds().Dx(), dst.Bounds().Dy()
+
+	// если после прилипания контур стал некорректным, остается просто сдвинутый
+	for _, candidate := range [][]domain.ContorPoint{snapContor(shifted, newGradient(dst)), shifted} {
+		contor, err := domain.NormalizeContor(domain.MarshalContor(candidate), width, height)
+		if err != nil {
+			continue
+		}
+		result, err := domain.ParseContor(contor)
+		if err != nil {
+			continue
+		}
+		return result, score, nil
+	}
+
+	return nil, 0, domain.ErrInvalidContor
+}
@@ -0,0 +1,84 @@
+package propagation
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+// frameWithDisk темный кадр с ярким кругом и пятнами текстуры, чтобы окрестность не была однотонной
+func frameWithDisk(cx, cy, r float64) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, 200, 160))
+	for y := 0; y < 160; y++ {
+		for x := 0; x < 200; x++ {
+			v := uint8(40 + (x*7+y*13)%11)
+			if math.Hypot(float64(x)-cx, float64(y)-cy) <= r {
+				v = uint8(200 + (x*3+y*5)%9)
+			}
+			img.SetGray(x, y, color.Gray{Y: v})
+		}
+	}
+	return img
+}
+
+func circleContor(cx, cy, r float64, n int) []domain.ContorPoint {
+	points := make([]domain.ContorPoint, 0, n)
+	for i := range n {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		points = append(points, domain.ContorPoint{X: cx + r*math.Cos(a), Y: cy + r*math.Sin(a)})
+	}
+	return points
+}
+
+func centroid(points []domain.ContorPoint) (float64, float64) {
+	var x, y float64
+	for _, p := range points {
+		x += p.X
+		y += p.Y
+	}
+	return x / float64(len(points)), y / float64(len(points))
+}
+
+func TestEstimateShift(t *testing.T) {
+	src := frameWithDisk(80, 70, 20)
+	dst := frameWithDisk(86, 66, 20)
+
+	rect := contorBounds(circleContor(80, 70, 20, 24), src.Bounds())
+	shift, score := estimateShift(src, dst, rect, searchRadius(rect))
+	require.Equal(t, image.Pt(6, -4), shift)
+	require.Greater(t, score, 0.9)
+
+	// однотонная окрестность не сравнивается
+	flat := image.NewGray(image.Rect(0, 0, 50, 50))
+	shift, score = estimateShift(flat, flat, image.Rect(10, 10, 30, 30), 5)
+	require.Equal(t, image.Point{}, shift)
+	require.Zero(t, score)
+}
+
+func TestSnapContor(t *testing.T) {
+	dst := frameWithDisk(80, 70, 20)
+
+	// контур меньше круга прилипает к его границе
+	snapped := snapContor(circleContor(80, 70, 17, 32), newGradient(dst))
+	for _, p := range snapped {
+		require.InDelta(t, 20, math.Hypot(p.X-80, p.Y-70), 1.6)
+	}
+}
+
+func TestTrack(t *testing.T) {
+	src := frameWithDisk(80, 70, 20)
+	dst := frameWithDisk(90, 75, 22)
+
+	tracked, score, err := track(circleContor(80, 70, 20, 32), src, dst)
+	require.NoError(t, err)
+	require.Greater(t, score, MinScore)
+
+	cx, cy := centroid(tracked)
+	require.InDelta(t, 90, cx, 1.5)
+	require.InDelta(t, 75, cy, 1.5)
+}
@@ -0,0 +1,90 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	imageEntity "uzi/internal/repository/image/entity"
+	nodeEntity "uzi/internal/repository/node/entity"
+	segmentEntity "uzi/internal/repository/segment/entity"
+
+	"github.com/google/uuid"
+)
+
+// reportData все что попадает в заключение
+type reportData struct {
+	Uzi         domain.Uzi
+	Device      domain.Device
+	Echographic *domain.Echographic
+	Images      []domain.Image
+	Nodes       []domain.NodeTirads
+	Segments    []domain.Segment
+}
+
+func (s *service) collect(ctx context.Context, uziID uuid.UUID) (reportData, error) {
+	uziDB, err := s.dao.NewUziQuery(ctx).GetUziByID(uziID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return reportData{}, domain.ErrNotFound
+		}
+		return reportData{}, fmt.Errorf("get uzi by id: %w", err)
+	}
+	data := reportData{Uzi: uziDB.ToDomain()}
+
+	deviceDB, err := s.dao.NewDeviceQuery(ctx).GetDeviceByID(data.Uzi.DeviceID)
+	if err != nil {
+		return reportData{}, fmt.Errorf("get device by id: %w", err)
+	}
+	data.Device = deviceDB.ToDomain()
+
+	echographicDB, err := s.dao.NewEchographicQuery(ctx).GetEchographicByID(uziID)
+	switch {
+	case err == nil:
+		echographic := echographicDB.ToDomain()
+		data.Echographic = &echographic
+	case !errors.Is(err, entity.ErrNotFound):
+		return reportData{}, fmt.Errorf("get echographic by id: %w", err)
+	}
+
+	imagesDB, err := s.dao.NewImageQuery(ctx).GetImagesByUziID(uziID)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		return reportData{}, fmt.Errorf("get images by uzi_id: %w", err)
+	}
+	data.Images = imageEntity.Image{}.SliceToDomain(imagesDB)
+
+	nodesDB, err := s.dao.NewNodeQuery(ctx).GetNodesByUziID(uziID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return data, nil
+		}
+		return reportData{}, fmt.Errorf("get nodes by uzi_id: %w", err)
+	}
+	nodes := nodeEntity.Node{}.SliceToDomain(nodesDB)
+
+	for _, node := range nodes {
+		// отклоненные врачом узлы нейросети в заключение не попадают
+		if node.Validation != nil && *node.Validation == domain.NodeValidationInvalid {
+			continue
+		}
+
+		tirads, err := s.tirads.GetNodeTirads(ctx, node.Id)
+		if err != nil {
+			return reportData{}, fmt.Errorf("get node tirads: %w", err)
+		}
+		data.Nodes = append(data.Nodes, tirads)
+
+		segmentsDB, err := s.dao.NewSegmentQuery(ctx).GetSegmentsByNodeID(node.Id)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				continue
+			}
+			return reportData{}, fmt.Errorf("get segments by node_id: %w", err)
+		}
+		data.Segments = append(data.Segments, segmentEntity.Segment{}.SliceToDomain(segmentsDB)...)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,171 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+)
+
+// контрастные цвета контуров, узел получает цвет по своему номеру
+var palette = []color.RGBA{
+	{R: 230, G: 25, B: 75, A: 255},
+	{R: 60, G: 180, B: 75, A: 255},
+	{R: 255, G: 225, B: 25, A: 255},
+	{R: 0, G: 130, B: 200, A: 255},
+	{R: 245, G: 130, B: 48, A: 255},
+	{R: 145, G: 30, B: 180, A: 255},
+	{R: 70, G: 240, B: 240, A: 255},
+	{R: 240, G: 50, B: 230, A: 255},
+}
+
+// толщина линии контура в пикселях от центра
+const brushRadius = 1
+
+func nodeColor(number int) color.RGBA {
+	return palette[(number-1)%len(palette)]
+}
+
+// keyFrame кадр узи с нарисованными контурами узлов
+type keyFrame struct {
+	Page int
+	// номера узлов, контуры которых есть на кадре
+	Nodes []int
+	PNG   []byte
+}
+
+// keyImages для каждого узла выбирает кадр с наибольшей площадью сегмента, порядок - по номерам узлов
+func keyImages(nodes []domain.NodeTirads, segments []domain.Segment) []uuid.UUID {
+	best := make(map[uuid.UUID]domain.Segment, len(nodes))
+	for _, segment := range segments {
+		current, ok := best[segment.NodeID]
+		if !ok || segmentArea(segment) > segmentArea(current) {
+			best[segment.NodeID] = segment
+		}
+	}
+
+	images := make([]uuid.UUID, 0, len(nodes))
+	seen := make(map[uuid.UUID]struct{}, len(nodes))
+	for _, node := range nodes {
+		segment, ok := best[node.Node.Id]
+		if !ok {
+			continue
+		}
+		if _, ok := seen[segment.ImageID]; ok {
+			continue
+		}
+		seen[segment.ImageID] = struct{}{}
+		images = append(images, segment.ImageID)
+	}
+
+	return images
+}
+
+func segmentArea(segment domain.Segment) float64 {
+	if segment.Measurement == nil {
+		return 0
+	}
+	return segment.Measurement.Area
+}
+
+func (s *service) keyFrames(ctx context.Context, data reportData) ([]keyFrame, error) {
+	numbers := make(map[uuid.UUID]int, len(data.Nodes))
+	for i, node := range data.Nodes {
+		numbers[node.Node.Id] = i + 1
+	}
+
+	pages := make(map[uuid.UUID]int, len(data.Images))
+	for _, image := range data.Images {
+		pages[image.Id] = image.Page
+	}
+
+	frames := make([]keyFrame, 0, len(data.Nodes))
+	for _, imageID := range keyImages(data.Nodes, data.Segments) {
+		src, err := s.loadImage(ctx, data.Uzi.Id, imageID)
+		if err != nil {
+			return nil, err
+		}
+
+		frame := keyFrame{Page: pages[imageID]}
+		canvas := image.NewRGBA(src.Bounds())
+		draw.Draw(canvas, canvas.Bounds(), src, src.Bounds().Min, draw.Src)
+
+		for _, segment := range data.Segments {
+			if segment.ImageID != imageID {
+				continue
+			}
+			points, err := domain.ParseContor(segment.Contor)
+			// битый контур не должен ломать все заключение
+			if err != nil {
+				continue
+			}
+			number := numbers[segment.NodeID]
+			drawContor(canvas, points, nodeColor(number))
+			frame.Nodes = append(frame.Nodes, number)
+		}
+
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, canvas); err != nil {
+			return nil, fmt.Errorf("encode key frame: %w", err)
+		}
+		frame.PNG = buf.Bytes()
+
+		frames = append(frames, frame)
+	}
+
+	return frames, nil
+}
+
+func (s *service) loadImage(ctx context.Context, uziID, imageID uuid.UUID) (image.Image, error) {
+	file, closer, err := s.dao.NewFileRepo().GetFileViaTemp(
+		ctx,
+		filepath.Join(uziID.String(), imageID.String(), imageID.String()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get image from s3: %w", err)
+	}
+	defer closer()
+
+	img, err := png.Decode(file.Buf)
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+
+	return img, nil
+}
+
+func drawContor(img *image.RGBA, points []domain.ContorPoint, c color.RGBA) {
+	for i := range points {
+		drawLine(img, points[i], points[(i+1)%len(points)], c)
+	}
+}
+
+func drawLine(img *image.RGBA, from, to domain.ContorPoint, c color.RGBA) {
+	steps := int(math.Ceil(math.Max(math.Abs(to.X-from.X), math.Abs(to.Y-from.Y))))
+	if steps == 0 {
+		steps = 1
+	}
+
+	for i := 0; i <= steps; i++ {
+		t := float64(i) / float64(steps)
+		x := int(math.Round(from.X + (to.X-from.X)*t))
+		y := int(math.Round(from.Y + (to.Y-from.Y)*t))
+
+		for dx := -brushRadius; dx <= brushRadius; dx++ {
+			for dy := -brushRadius; dy <= brushRadius; dy++ {
+				if (image.Point{X: x + dx, Y: y + dy}).In(img.Bounds()) {
+					img.SetRGBA(x+dx, y+dy, c)
+				}
+			}
+		}
+	}
+}
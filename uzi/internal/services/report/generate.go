@@ -0,0 +1,114 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	reportEntity "uzi/internal/repository/report/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	htmlContentType = "text/html; charset=utf-8"
+	pdfContentType  = "application/pdf"
+)
+
+func (s *service) GenerateReport(ctx context.Context, uziID uuid.UUID) (domain.Report, error) {
+	data, err := s.collect(ctx, uziID)
+	if err != nil {
+		return domain.Report{}, err
+	}
+
+	frames, err := s.keyFrames(ctx, data)
+	if err != nil {
+		return domain.Report{}, err
+	}
+
+	ctx, err = s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Report{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	version := 1
+	last, err := s.dao.NewReportQuery(ctx).GetLastReportByUziID(uziID)
+	switch {
+	case err == nil:
+		version = last.Version + 1
+	case !errors.Is(err, entity.ErrNotFound):
+		return domain.Report{}, fmt.Errorf("get last report: %w", err)
+	}
+
+	report := domain.Report{
+		Id:       uuid.New(),
+		UziID:    uziID,
+		Version:  version,
+		CreateAt: time.Now(),
+	}
+	// версию может занять параллельная генерация, поэтому путь по id отчета
+	dir := filepath.Join(uziID.String(), "reports", report.Id.String())
+	report.HtmlPath = filepath.Join(dir, "report.html")
+	report.PdfPath = filepath.Join(dir, "report.pdf")
+
+	view := newReportView(data, frames, version, report.CreateAt)
+
+	html, err := renderHTML(view)
+	if err != nil {
+		return domain.Report{}, err
+	}
+	pdf, err := renderPDF(view)
+	if err != nil {
+		return domain.Report{}, err
+	}
+
+	// файлы загружаются до коммита, чтобы запись о версии не ссылалась на отсутствующие объекты
+	if err := s.upload(ctx, report.HtmlPath, htmlContentType, html); err != nil {
+		return domain.Report{}, err
+	}
+	if err := s.upload(ctx, report.PdfPath, pdfContentType, pdf); err != nil {
+		return domain.Report{}, s.deleteFiles(ctx, err, report.HtmlPath)
+	}
+
+	if err := s.dao.NewReportQuery(ctx).InsertReport(reportEntity.Report{}.FromDomain(report)); err != nil {
+		// параллельная генерация уже заняла эту версию
+		var conflictErr *entity.DBConflictError
+		if errors.As(err, &conflictErr) {
+			err = domain.ErrConflict
+		} else {
+			err = fmt.Errorf("insert report: %w", err)
+		}
+		return domain.Report{}, s.deleteFiles(ctx, err, report.HtmlPath, report.PdfPath)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Report{}, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return report, nil
+}
+
+// deleteFiles удаляет загруженные файлы отчета, запись о котором не сохранилась
+func (s *service) deleteFiles(ctx context.Context, cause error, paths ...string) error {
+	if err := s.dao.NewFileRepo().DeleteFiles(context.WithoutCancel(ctx), paths...); err != nil {
+		return errors.Join(cause, fmt.Errorf("delete report files: %w", err))
+	}
+	return cause
+}
+
+func (s *service) upload(ctx context.Context, path, contentType string, content []byte) error {
+	if err := s.dao.NewFileRepo().LoadFile(ctx, path, domain.File{
+		Format: contentType,
+		Size:   int64(len(content)),
+		Buf:    bytes.NewReader(content),
+	}); err != nil {
+		return fmt.Errorf("load report to S3: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	reportEntity "uzi/internal/repository/report/entity"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) GetReports(ctx context.Context, uziID uuid.UUID) ([]domain.Report, error) {
+	reports, err := s.dao.NewReportQuery(ctx).GetReportsByUziID(uziID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return []domain.Report{}, nil
+		}
+		return nil, fmt.Errorf("get reports by uzi_id: %w", err)
+	}
+
+	return reportEntity.Report{}.SliceToDomain(reports), nil
+}
+
+func (s *service) GetReport(ctx context.Context, uziID uuid.UUID, version *int) (domain.Report, error) {
+	var (
+		report reportEntity.Report
+		err    error
+	)
+	if version != nil {
+		report, err = s.dao.NewReportQuery(ctx).GetReportByVersion(uziID, *version)
+	} else {
+		report, err = s.dao.NewReportQuery(ctx).GetLastReportByUziID(uziID)
+	}
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return domain.Report{}, domain.ErrNotFound
+		}
+		return domain.Report{}, fmt.Errorf("get report: %w", err)
+	}
+
+	return report.ToDomain(), nil
+}
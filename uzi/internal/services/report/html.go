@@ -0,0 +1,42 @@
+package report
+
+import (
+	"bytes"
+	"embed"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+)
+
+//go:embed templates/report.html
+var templates embed.FS
+
+var reportTemplate = template.Must(template.ParseFS(templates, "templates/report.html"))
+
+type htmlFrame struct {
+	Caption string
+	// кадры встраиваются в страницу, чтобы заключение было одним файлом
+	Src template.URL
+}
+
+type htmlReport struct {
+	reportView
+	Frames []htmlFrame
+}
+
+func renderHTML(view reportView) ([]byte, error) {
+	report := htmlReport{reportView: view}
+	for _, frame := range view.Frames {
+		report.Frames = append(report.Frames, htmlFrame{
+			Caption: frame.Caption,
+			Src:     template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(frame.PNG)),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := reportTemplate.Execute(&buf, report); err != nil {
+		return nil, fmt.Errorf("execute report template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
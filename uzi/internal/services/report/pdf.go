@@ -0,0 +1,151 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const (
+	fontFamily = "go"
+
+	pageMargin  = 15.0
+	lineHeight  = 5.0
+	labelWidth  = 60.0
+	frameHeight = 200.0
+)
+
+// ширины колонок таблицы узлов в мм, в сумме ширина страницы без полей
+var nodeColumnWidths = []float64{8, 20, 26, 30, 24, 30, 20, 22}
+
+// шрифты Go покрывают кириллицу, поэтому встраиваются в документ как UTF-8
+func renderPDF(view reportView) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle(view.Title, true)
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.AddUTF8FontFromBytes(fontFamily, "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", gobold.TTF)
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-pageMargin + lineHeight)
+		pdf.SetFont(fontFamily, "", 8)
+		pdf.CellFormat(0, lineHeight, "Стр. "+strconv.Itoa(pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+	pdf.AddPage()
+
+	pdf.SetFont(fontFamily, "B", 14)
+	pdf.MultiCell(0, 7, view.Title, "", "L", false)
+	pdf.Ln(lineHeight)
+
+	pdfFields(pdf, view.Header)
+
+	if len(view.Echographic) > 0 {
+		pdfSection(pdf, "Эхографические признаки")
+		pdfFields(pdf, view.Echographic)
+	}
+
+	pdfSection(pdf, "Узлы")
+	if len(view.Nodes) > 0 {
+		pdfNodes(pdf, view.NodeColumns, view.Nodes)
+	} else {
+		pdf.SetFont(fontFamily, "", 10)
+		pdf.MultiCell(0, lineHeight, "Узлы не обнаружены.", "", "L", false)
+	}
+
+	if len(view.Frames) > 0 {
+		pdfSection(pdf, "Ключевые кадры")
+		pdfFrames(pdf, view.Frames)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("render pdf: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func pdfSection(pdf *fpdf.Fpdf, title string) {
+	pdf.Ln(lineHeight)
+	pdf.SetFont(fontFamily, "B", 12)
+	pdf.CellFormat(0, 7, title, "", 1, "L", false, 0, "")
+}
+
+func pdfFields(pdf *fpdf.Fpdf, fields []field) {
+	pageWidth, _ := pdf.GetPageSize()
+	valueWidth := pageWidth - 2*pageMargin - labelWidth
+
+	pdf.SetFillColor(238, 238, 238)
+	for _, f := range fields {
+		pdf.SetFont(fontFamily, "", 10)
+		lines := max(len(pdf.SplitText(f.Value, valueWidth-2)), 1)
+		height := float64(lines) * lineHeight
+		if pageBreakNeeded(pdf, height) {
+			pdf.AddPage()
+		}
+
+		pdf.SetFont(fontFamily, "B", 10)
+		pdf.CellFormat(labelWidth, height, f.Label, "1", 0, "L", true, 0, "")
+		pdf.SetFont(fontFamily, "", 10)
+		pdf.MultiCell(valueWidth, lineHeight, f.Value, "1", "L", false)
+	}
+}
+
+func pdfNodes(pdf *fpdf.Fpdf, columns []string, rows []nodeRow) {
+	pdf.SetFont(fontFamily, "B", 7)
+	pdf.SetFillColor(238, 238, 238)
+	for i, column := range columns {
+		pdf.CellFormat(nodeColumnWidths[i], lineHeight+1, column, "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont(fontFamily, "", 8)
+	for _, row := range rows {
+		c := nodeColor(row.Number)
+		for i, cell := range row.Cells {
+			// номер узла подсвечен цветом его контура на кадрах
+			fill := i == 0
+			if fill {
+				pdf.SetFillColor(int(c.R), int(c.G), int(c.B))
+			}
+			pdf.CellFormat(nodeColumnWidths[i], lineHeight+1, cell, "1", 0, "C", fill, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+}
+
+func pdfFrames(pdf *fpdf.Fpdf, frames []frameView) {
+	pageWidth, _ := pdf.GetPageSize()
+	maxWidth := pageWidth - 2*pageMargin
+
+	for i, frame := range frames {
+		name := fmt.Sprintf("frame-%d", i)
+		options := fpdf.ImageOptions{ImageType: "PNG"}
+		info := pdf.RegisterImageOptionsReader(name, options, bytes.NewReader(frame.PNG))
+		if info == nil {
+			return
+		}
+
+		width, height := maxWidth, maxWidth*info.Height()/info.Width()
+		if height > frameHeight {
+			width, height = width*frameHeight/height, frameHeight
+		}
+		if pageBreakNeeded(pdf, height+lineHeight) {
+			pdf.AddPage()
+		}
+
+		pdf.ImageOptions(name, pageMargin, pdf.GetY(), width, height, true, options, 0, "")
+		pdf.SetFont(fontFamily, "", 9)
+		pdf.CellFormat(0, lineHeight, frame.Caption, "", 1, "L", false, 0, "")
+		pdf.Ln(lineHeight)
+	}
+}
+
+func pageBreakNeeded(pdf *fpdf.Fpdf, height float64) bool {
+	_, pageHeight := pdf.GetPageSize()
+	return pdf.GetY()+height > pageHeight-pageMargin
+}
@@ -0,0 +1,87 @@
+package report
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+func testPNG(t *testing.T) []byte {
+	img := image.NewGray(image.Rect(0, 0, 40, 30))
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestKeyImages_LargestSegmentPerNode(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	small, large := uuid.New(), uuid.New()
+
+	nodes := []domain.NodeTirads{
+		{Node: domain.Node{Id: first}},
+		{Node: domain.Node{Id: second}},
+	}
+	segments := []domain.Segment{
+		{NodeID: first, ImageID: small, Measurement: &domain.SegmentMeasurement{Area: 10}},
+		{NodeID: first, ImageID: large, Measurement: &domain.SegmentMeasurement{Area: 50}},
+		// лучший кадр второго узла совпадает с первым, кадр не дублируется
+		{NodeID: second, ImageID: large, Measurement: &domain.SegmentMeasurement{Area: 20}},
+		{NodeID: second, ImageID: small},
+	}
+
+	require.Equal(t, []uuid.UUID{large}, keyImages(nodes, segments))
+}
+
+func TestDrawContor(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 20, 20))
+	red := color.RGBA{R: 255, A: 255}
+
+	drawContor(img, []domain.ContorPoint{{X: 5, Y: 5}, {X: 15, Y: 5}, {X: 15, Y: 15}, {X: 5, Y: 15}}, red)
+
+	require.Equal(t, red, img.RGBAAt(10, 5))
+	require.Equal(t, red, img.RGBAAt(15, 10))
+	require.Equal(t, color.RGBA{}, img.RGBAAt(10, 10))
+}
+
+func TestRender(t *testing.T) {
+	data := reportData{
+		Uzi: domain.Uzi{
+			Id:         uuid.New(),
+			Projection: domain.UziProjectionLong,
+			ExternalID: uuid.New(),
+			CreateAt:   time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+		},
+		Device:      domain.Device{Name: "Siemens", Model: pointer.To("Acuson S2000")},
+		Echographic: &domain.Echographic{Conclusion: pointer.To("Узловые образования щитовидной железы")},
+		Nodes: []domain.NodeTirads{{
+			Node: domain.Node{
+				Ai:          true,
+				Lobe:        pointer.To(domain.NodeLobeRight),
+				Tirads4:     0.7,
+				Measurement: &domain.NodeMeasurement{MajorAxis: 12, MinorAxis: 8, Volume: 400, Unit: domain.MeasureUnitMm},
+			},
+			Score: &domain.TiradsScore{Points: 4, Category: domain.TiradsCategoryTR4, Recommendation: domain.TiradsRecommendationFollowUp},
+		}},
+	}
+	frames := []keyFrame{{Page: 3, Nodes: []int{1}, PNG: testPNG(t)}}
+	view := newReportView(data, frames, 2, time.Now())
+
+	html, err := renderHTML(view)
+	require.NoError(t, err)
+	require.Contains(t, string(html), "Узловые образования щитовидной железы")
+	require.Contains(t, string(html), "TR4 (4 б.)")
+	require.Contains(t, string(html), "data:image/png;base64,")
+
+	pdf, err := renderPDF(view)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(pdf, []byte("%PDF")))
+}
@@ -0,0 +1,36 @@
+// Формирование заключений по узи в HTML и PDF
+package report
+
+import (
+	"context"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+	"uzi/internal/services/tirads"
+
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	// GenerateReport формирует новую версию заключения и сохраняет ее в S3
+	GenerateReport(ctx context.Context, uziID uuid.UUID) (domain.Report, error)
+
+	GetReports(ctx context.Context, uziID uuid.UUID) ([]domain.Report, error)
+	// GetReport возвращает указанную версию заключения, без версии - последнюю
+	GetReport(ctx context.Context, uziID uuid.UUID, version *int) (domain.Report, error)
+}
+
+type service struct {
+	dao    repository.DAO
+	tirads tirads.Service
+}
+
+func New(
+	dao repository.DAO,
+	tirads tirads.Service,
+) Service {
+	return &service{
+		dao:    dao,
+		tirads: tirads,
+	}
+}
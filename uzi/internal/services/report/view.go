@@ -0,0 +1,222 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"uzi/internal/domain"
+)
+
+const (
+	dateLayout = "02.01.2006"
+	timeLayout = "02.01.2006 15:04"
+	noValue    = "—"
+)
+
+var projectionLabels = map[domain.UziProjection]string{
+	domain.UziProjectionLong:  "продольная",
+	domain.UziProjectionCross: "поперечная",
+}
+
+var lobeLabels = map[domain.NodeLobe]string{
+	domain.NodeLobeRight:   "правая",
+	domain.NodeLobeLeft:    "левая",
+	domain.NodeLobeIsthmus: "перешеек",
+}
+
+var unitLabels = map[domain.MeasureUnit]string{
+	domain.MeasureUnitMm: "мм",
+	domain.MeasureUnitPx: "пикс",
+}
+
+var recommendationLabels = map[domain.TiradsRecommendation]string{
+	domain.TiradsRecommendationNone:     "не требуется",
+	domain.TiradsRecommendationFollowUp: "наблюдение",
+	domain.TiradsRecommendationFNA:      "ТАБ",
+	domain.TiradsRecommendationUnknown:  "нет размера узла",
+}
+
+var nodeColumns = []string{
+	"№",
+	"Доля",
+	"Источник",
+	"Размеры",
+	"Объем",
+	"ИИ TR2-3 / TR4 / TR5",
+	"ACR TI-RADS",
+	"Рекомендация",
+}
+
+type field struct {
+	Label string
+	Value string
+}
+
+type nodeRow struct {
+	Number int
+	// цвет контура узла на кадрах, #rrggbb
+	Color string
+	Cells []string
+}
+
+type frameView struct {
+	Caption string
+	PNG     []byte
+}
+
+// reportView заключение в виде готовых строк, общее для HTML и PDF
+type reportView struct {
+	Title       string
+	Header      []field
+	Echographic []field
+	NodeColumns []string
+	Nodes       []nodeRow
+	Frames      []frameView
+}
+
+func newReportView(data reportData, frames []keyFrame, version int, createAt time.Time) reportView {
+	view := reportView{
+		Title:       "Заключение ультразвукового исследования щитовидной железы",
+		Header:      header(data, version, createAt),
+		Echographic: echographicFields(data.Echographic),
+		NodeColumns: nodeColumns,
+	}
+
+	for i, node := range data.Nodes {
+		view.Nodes = append(view.Nodes, newNodeRow(i+1, node))
+	}
+
+	for _, frame := range frames {
+		numbers := make([]string, 0, len(frame.Nodes))
+		for _, number := range frame.Nodes {
+			numbers = append(numbers, fmt.Sprintf("№%d", number))
+		}
+		view.Frames = append(view.Frames, frameView{
+			Caption: fmt.Sprintf("Кадр %d, узлы: %s", frame.Page, strings.Join(numbers, ", ")),
+			PNG:     frame.PNG,
+		})
+	}
+
+	return view
+}
+
+func header(data reportData, version int, createAt time.Time) []field {
+	device := data.Device.Name
+	if data.Device.Model != nil {
+		device = fmt.Sprintf("%s (%s)", device, *data.Device.Model)
+	}
+
+	spacing := noValue
+	if data.Uzi.PixelSpacing != nil {
+		spacing = fmt.Sprintf("%.3f × %.3f мм", data.Uzi.PixelSpacing.X, data.Uzi.PixelSpacing.Y)
+	} else if data.Device.PixelSpacing != nil {
+		spacing = fmt.Sprintf("%.3f × %.3f мм (калибровка аппарата)", data.Device.PixelSpacing.X, data.Device.PixelSpacing.Y)
+	}
+
+	fields := []field{
+		{Label: "Версия заключения", Value: fmt.Sprint(version)},
+		{Label: "Сформировано", Value: createAt.Format(timeLayout)},
+		{Label: "Дата исследования", Value: data.Uzi.CreateAt.Format(dateLayout)},
+		{Label: "Пациент", Value: data.Uzi.ExternalID.String()},
+		{Label: "Аппарат", Value: device},
+		{Label: "Проекция", Value: projectionLabels[data.Uzi.Projection]},
+		{Label: "Размер пикселя", Value: spacing},
+	}
+	if data.Uzi.Description != nil {
+		fields = append(fields, field{Label: "Описание", Value: *data.Uzi.Description})
+	}
+
+	return fields
+}
+
+func echographicFields(e *domain.Echographic) []field {
+	if e == nil {
+		return nil
+	}
+
+	var fields []field
+	addString := func(label string, value *string) {
+		if value != nil && *value != "" {
+			fields = append(fields, field{Label: label, Value: *value})
+		}
+	}
+	addFloat := func(label string, value *float64) {
+		if value != nil {
+			fields = append(fields, field{Label: label, Value: fmt.Sprintf("%.2f", *value)})
+		}
+	}
+
+	addString("Контуры", e.Contors)
+	addFloat("Правая доля, длина", e.RightLobeLength)
+	addFloat("Правая доля, ширина", e.RightLobeWidth)
+	addFloat("Правая доля, толщина", e.RightLobeThick)
+	addFloat("Правая доля, объем", e.RightLobeVolum)
+	addFloat("Левая доля, длина", e.LeftLobeLength)
+	addFloat("Левая доля, ширина", e.LeftLobeWidth)
+	addFloat("Левая доля, толщина", e.LeftLobeThick)
+	addFloat("Левая доля, объем", e.LeftLobeVolum)
+	addFloat("Объем железы", e.GlandVolum)
+	addFloat("Перешеек", e.Isthmus)
+	if e.Struct != nil {
+		fields = append(fields, field{Label: "Структура", Value: e.Struct.Label()})
+	}
+	if e.Echogenicity != nil {
+		fields = append(fields, field{Label: "Эхогенность", Value: e.Echogenicity.Label()})
+	}
+	addString("Регионарные лимфоузлы", e.RegionalLymph)
+	if e.Vascularization != nil {
+		fields = append(fields, field{Label: "Васкуляризация", Value: e.Vascularization.Label()})
+	}
+	addString("Расположение", e.Location)
+	addString("Дополнительно", e.Additional)
+	addString("Заключение", e.Conclusion)
+
+	return fields
+}
+
+func newNodeRow(number int, tirads domain.NodeTirads) nodeRow {
+	node := tirads.Node
+	c := nodeColor(number)
+
+	lobe := noValue
+	if node.Lobe != nil {
+		lobe = lobeLabels[*node.Lobe]
+	}
+
+	source := "врач"
+	if node.Ai {
+		source = "ИИ"
+		if node.Validation != nil && *node.Validation == domain.NodeValidationValid {
+			source = "ИИ, подтвержден"
+		}
+	}
+
+	size, volume := noValue, noValue
+	if m := node.Measurement; m != nil {
+		unit := unitLabels[m.Unit]
+		size = fmt.Sprintf("%.1f × %.1f %s", m.MajorAxis, m.MinorAxis, unit)
+		volume = fmt.Sprintf("%.1f %s³", m.Volume, unit)
+	}
+
+	acr, recommendation := noValue, noValue
+	if score := tirads.Score; score != nil {
+		acr = fmt.Sprintf("%s (%d б.)", strings.ToUpper(score.Category.String()), score.Points)
+		recommendation = recommendationLabels[score.Recommendation]
+	}
+
+	return nodeRow{
+		Number: number,
+		Color:  fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B),
+		Cells: []string{
+			fmt.Sprint(number),
+			lobe,
+			source,
+			size,
+			volume,
+			fmt.Sprintf("%.0f%% / %.0f%% / %.0f%%", node.Tirads23*100, node.Tirads4*100, node.Tirads5*100),
+			acr,
+			recommendation,
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"uzi/internal/domain"
+	storageCleanupEntity "uzi/internal/repository/storage_cleanup/entity"
+)
+
+const (
+	cleanupBatch = 50
+
+	// задача, взятая в работу, повторяется после аренды, если реплика не записала результат
+	cleanupLease = 10 * time.Minute
+
+	cleanupBaseDelay = time.Minute
+	cleanupMaxDelay  = 6 * time.Hour
+)
+
+func (s *service) RunCleanup(ctx context.Context) (int, error) {
+	jobs, err := s.claimCleanupJobs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// работа с S3 идет вне транзакции, задачи до конца аренды не видны другим репликам
+	removeErrs := make([]error, len(jobs))
+	for i, job := range jobs {
+		removeErrs[i] = s.removePrefix(ctx, job.Prefix)
+	}
+
+	ctx, err = s.dao.BeginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	done := 0
+	for i, job := range jobs {
+		if err := s.finishJob(ctx, job.Prefix, job.Attempts, removeErrs[i]); err != nil {
+			return 0, err
+		}
+		if removeErrs[i] != nil {
+			slog.Warn("cleanup storage prefix", "prefix", job.Prefix, "attempts", job.Attempts+1, "err", removeErrs[i])
+			continue
+		}
+		done++
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return 0, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return done, nil
+}
+
+// claimCleanupJobs берет задачи в работу, откладывая их на cleanupLease
+func (s *service) claimCleanupJobs(ctx context.Context) ([]domain.CleanupJob, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	query := s.dao.NewStorageCleanupQuery(ctx)
+
+	jobsDB, err := query.GetDueCleanupJobs(time.Now(), cleanupBatch)
+	if err != nil {
+		return nil, fmt.Errorf("get due cleanup jobs: %w", err)
+	}
+	if len(jobsDB) == 0 {
+		return nil, nil
+	}
+	jobs := storageCleanupEntity.CleanupJob{}.SliceToDomain(jobsDB)
+
+	prefixes := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		prefixes = append(prefixes, job.Prefix)
+	}
+	if err := query.PostponeCleanupJobs(prefixes, time.Now().Add(cleanupLease)); err != nil {
+		return nil, fmt.Errorf("postpone cleanup jobs: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// cleanupPrefix попытка вне очереди, сразу после постановки задачи
+func (s *service) cleanupPrefix(ctx context.Context, prefix string) error {
+	removeErr := s.removePrefix(ctx, prefix)
+	if err := s.finishJob(ctx, prefix, 0, removeErr); err != nil {
+		return err
+	}
+	return removeErr
+}
+
+func (s *service) removePrefix(ctx context.Context, prefix string) error {
+	fileRepo := s.dao.NewFileRepo()
+
+	files, err := fileRepo.ListFiles(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("list files: %w", err)
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, file.Path)
+	}
+
+	if err := fileRepo.DeleteFiles(ctx, paths...); err != nil {
+		return fmt.Errorf("delete files: %w", err)
+	}
+
+	return nil
+}
+
+// finishJob удаляет выполненную задачу или откладывает неудачную с экспоненциальной задержкой
+func (s *service) finishJob(ctx context.Context, prefix string, attempts int, removeErr error) error {
+	query := s.dao.NewStorageCleanupQuery(ctx)
+
+	if removeErr == nil {
+		if err := query.DeleteCleanupJob(prefix); err != nil {
+			return fmt.Errorf("delete cleanup job: %w", err)
+		}
+		return nil
+	}
+
+	if err := query.UpdateCleanupJobFailure(prefix, removeErr.Error(), time.Now().Add(retryDelay(attempts+1))); err != nil {
+		return fmt.Errorf("update cleanup job: %w", err)
+	}
+	return nil
+}
+
+func retryDelay(attempts int) time.Duration {
+	delay := cleanupBaseDelay
+	for i := 1; i < attempts && delay < cleanupMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, cleanupMaxDelay)
+}
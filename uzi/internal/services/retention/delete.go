@@ -0,0 +1,125 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) DeleteUzi(ctx context.Context, id uuid.UUID) error {
+	if s.cfg.SoftDeleteWindow > 0 {
+		if err := s.dao.NewUziQuery(ctx).SoftDeleteUzi(id); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				return domain.ErrNotFound
+			}
+			return fmt.Errorf("soft delete uzi: %w", err)
+		}
+		return nil
+	}
+
+	return s.purgeUzi(ctx, id)
+}
+
+func (s *service) RestoreUzi(ctx context.Context, id uuid.UUID) (domain.Uzi, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Uzi{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	uziQuery := s.dao.NewUziQuery(ctx)
+	if err := uziQuery.RestoreUzi(id); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return domain.Uzi{}, domain.ErrNotFound
+		}
+		return domain.Uzi{}, fmt.Errorf("restore uzi: %w", err)
+	}
+
+	uzi, err := uziQuery.GetUziByID(id)
+	if err != nil {
+		return domain.Uzi{}, fmt.Errorf("get uzi by id: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Uzi{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return uzi.ToDomain(), nil
+}
+
+func (s *service) PurgeDeletedUzis(ctx context.Context) (int, error) {
+	if s.cfg.SoftDeleteWindow <= 0 {
+		return 0, nil
+	}
+
+	ids, err := s.dao.NewUziQuery(ctx).GetUziIDsDeletedBefore(time.Now().Add(-s.cfg.SoftDeleteWindow))
+	if err != nil {
+		return 0, fmt.Errorf("get expired uzis: %w", err)
+	}
+
+	purged := 0
+	for _, id := range ids {
+		if err := s.purgeUzi(ctx, id); err != nil {
+			// узи могли восстановить или удалить параллельно
+			if errors.Is(err, domain.ErrNotFound) {
+				continue
+			}
+			return purged, fmt.Errorf("purge uzi %s: %w", id, err)
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// purgeUzi удаляет строки узи и ставит очистку его объектов в одной транзакции,
+// поэтому объекты не теряются, даже если S3 недоступен
+func (s *service) purgeUzi(ctx context.Context, id uuid.UUID) error {
+	txCtx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(txCtx) }()
+
+	// история не связана внешними ключами и не удаляется каскадом
+	if err := s.dao.NewSegmentHistoryQuery(txCtx).DeleteSegmentHistoryByUziID(id); err != nil {
+		return fmt.Errorf("delete segment history: %w", err)
+	}
+	if err := s.dao.NewNodeHistoryQuery(txCtx).DeleteNodeHistoryByUziID(id); err != nil {
+		return fmt.Errorf("delete node history: %w", err)
+	}
+
+	if err := s.dao.NewUziQuery(txCtx).DeleteUzi(id); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("delete uzi: %w", err)
+	}
+
+	prefix := uziPrefix(id)
+	if err := s.dao.NewStorageCleanupQuery(txCtx).InsertCleanupJobs(prefix); err != nil {
+		return fmt.Errorf("insert cleanup job: %w", err)
+	}
+
+	if err := s.dao.CommitTx(txCtx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	// первая попытка сразу, при ошибке задача останется в очереди
+	if err := s.cleanupPrefix(ctx, prefix); err != nil {
+		slog.Warn("cleanup deleted uzi objects", "prefix", prefix, "err", err)
+	}
+
+	return nil
+}
+
+func uziPrefix(id uuid.UUID) string {
+	return id.String() + "/"
+}
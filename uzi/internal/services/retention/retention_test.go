@@ -0,0 +1,43 @@
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseObjectPath(t *testing.T) {
+	uziID, imageID := uuid.New(), uuid.New()
+
+	upload, ok := parseObjectPath(uziID.String() + "/" + uziID.String())
+	require.True(t, ok)
+	require.Equal(t, uziID, upload.uziID)
+	require.Nil(t, upload.imageID)
+
+	frame, ok := parseObjectPath(uziID.String() + "/" + imageID.String() + "/" + imageID.String())
+	require.True(t, ok)
+	require.Equal(t, uziID, frame.uziID)
+	require.Equal(t, imageID, *frame.imageID)
+
+	thumbnail, ok := parseObjectPath(uziID.String() + "/" + imageID.String() + "/thumbnail.jpg")
+	require.True(t, ok)
+	require.Equal(t, imageID, *thumbnail.imageID)
+
+	report, ok := parseObjectPath(uziID.String() + "/reports/1/report.pdf")
+	require.True(t, ok)
+	require.Nil(t, report.imageID)
+
+	for _, path := range []string{"datasets/" + uuid.NewString() + "/data.yaml", uziID.String(), "readme.txt"} {
+		_, ok := parseObjectPath(path)
+		require.False(t, ok, path)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, time.Minute, retryDelay(1))
+	require.Equal(t, 2*time.Minute, retryDelay(2))
+	require.Equal(t, 8*time.Minute, retryDelay(4))
+	require.Equal(t, cleanupMaxDelay, retryDelay(100))
+}
@@ -0,0 +1,48 @@
+// Удаление узи вместе с объектами S3: окно мягкого удаления, очередь очистки бакета и сверка бакета с БД
+package retention
+
+import (
+	"context"
+	"time"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type Config struct {
+	// окно, в течение которого удаленное узи можно восстановить, 0 - удаление сразу
+	SoftDeleteWindow time.Duration
+	// объекты моложе не считаются сиротами: загрузка в S3 может опережать запись в БД
+	OrphanGrace time.Duration
+}
+
+type Service interface {
+	// DeleteUzi в окне мягкого удаления только скрывает узи, иначе удаляет сразу
+	DeleteUzi(ctx context.Context, id uuid.UUID) error
+	// RestoreUzi возвращает мягко удаленное узи
+	RestoreUzi(ctx context.Context, id uuid.UUID) (domain.Uzi, error)
+
+	// PurgeDeletedUzis окончательно удаляет узи с истекшим окном, возвращает их число
+	PurgeDeletedUzis(ctx context.Context) (int, error)
+	// RunCleanup выполняет назревшие задачи очистки S3, возвращает число успешных
+	RunCleanup(ctx context.Context) (int, error)
+	// SweepOrphans находит объекты бакета без узи или кадра в БД и удаляет их, если не dryRun
+	SweepOrphans(ctx context.Context, dryRun bool) (domain.OrphanSweep, error)
+}
+
+type service struct {
+	dao repository.DAO
+	cfg Config
+}
+
+func New(
+	dao repository.DAO,
+	cfg Config,
+) Service {
+	return &service{
+		dao: dao,
+		cfg: cfg,
+	}
+}
@@ -0,0 +1,122 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"uzi/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// ограничение размера IN при проверке существования
+const sweepChunk = 1000
+
+// storedObject объект узи: "<uzi>/<uzi>" - исходный файл, "<uzi>/<image>/..." - кадр и его превью, остальное - производные узи
+type storedObject struct {
+	path    string
+	uziID   uuid.UUID
+	imageID *uuid.UUID
+}
+
+// parseObjectPath false для объектов вне каталогов узи, например datasets/
+func parseObjectPath(path string) (storedObject, bool) {
+	parts := strings.Split(path, "/")
+	uziID, err := uuid.Parse(parts[0])
+	if err != nil || len(parts) < 2 {
+		return storedObject{}, false
+	}
+
+	obj := storedObject{path: path, uziID: uziID}
+	if len(parts) == 3 {
+		if imageID, err := uuid.Parse(parts[1]); err == nil {
+			obj.imageID = &imageID
+		}
+	}
+
+	return obj, true
+}
+
+func (s *service) SweepOrphans(ctx context.Context, dryRun bool) (domain.OrphanSweep, error) {
+	fileRepo := s.dao.NewFileRepo()
+
+	files, err := fileRepo.ListFiles(ctx, "")
+	if err != nil {
+		return domain.OrphanSweep{}, fmt.Errorf("list files: %w", err)
+	}
+
+	threshold := time.Now().Add(-s.cfg.OrphanGrace)
+	objects := make([]storedObject, 0, len(files))
+	uziIDs := map[uuid.UUID]struct{}{}
+	imageIDs := map[uuid.UUID]struct{}{}
+	for _, file := range files {
+		if file.LastModified.After(threshold) {
+			continue
+		}
+		obj, ok := parseObjectPath(file.Path)
+		if !ok {
+			continue
+		}
+		objects = append(objects, obj)
+		uziIDs[obj.uziID] = struct{}{}
+		if obj.imageID != nil {
+			imageIDs[*obj.imageID] = struct{}{}
+		}
+	}
+
+	existingUzis, err := s.existing(uziIDs, s.dao.NewUziQuery(ctx).GetExistingUziIDs)
+	if err != nil {
+		return domain.OrphanSweep{}, fmt.Errorf("get existing uzis: %w", err)
+	}
+	existingImages, err := s.existing(imageIDs, s.dao.NewImageQuery(ctx).GetExistingImageIDs)
+	if err != nil {
+		return domain.OrphanSweep{}, fmt.Errorf("get existing images: %w", err)
+	}
+
+	sweep := domain.OrphanSweep{DryRun: dryRun, Checked: len(objects), Orphans: []string{}}
+	for _, obj := range objects {
+		_, uziExists := existingUzis[obj.uziID]
+		imageExists := true
+		if obj.imageID != nil {
+			_, imageExists = existingImages[*obj.imageID]
+		}
+		if !uziExists || !imageExists {
+			sweep.Orphans = append(sweep.Orphans, obj.path)
+		}
+	}
+
+	if dryRun || len(sweep.Orphans) == 0 {
+		return sweep, nil
+	}
+
+	if err := fileRepo.DeleteFiles(ctx, sweep.Orphans...); err != nil {
+		return domain.OrphanSweep{}, fmt.Errorf("delete orphans: %w", err)
+	}
+
+	return sweep, nil
+}
+
+func (s *service) existing(
+	ids map[uuid.UUID]struct{},
+	get func(ids []uuid.UUID) ([]uuid.UUID, error),
+) (map[uuid.UUID]struct{}, error) {
+	all := make([]uuid.UUID, 0, len(ids))
+	for id := range ids {
+		all = append(all, id)
+	}
+
+	existing := make(map[uuid.UUID]struct{}, len(ids))
+	for start := 0; start < len(all); start += sweepChunk {
+		found, err := get(all[start:min(start+sweepChunk, len(all))])
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range found {
+			existing[id] = struct{}{}
+		}
+	}
+
+	return existing, nil
+}
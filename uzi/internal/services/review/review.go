@@ -0,0 +1,254 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	daoEntity "uzi/internal/repository/entity"
+	nodeEntity "uzi/internal/repository/node/entity"
+	nodeReviewEntity "uzi/internal/repository/node_review/entity"
+	uziEntity "uzi/internal/repository/uzi/entity"
+)
+
+func (s *service) ReviewNodes(ctx context.Context, ids []uuid.UUID, validation domain.NodeValidation) ([]domain.NodeReviewItem, error) {
+	if err := decision(validation); err != nil {
+		return nil, err
+	}
+	ids = domain.UniqueIDs(ids)
+	if len(ids) == 0 || len(ids) > MaxBatch {
+		return nil, fmt.Errorf("node_ids must contain from 1 to %d nodes: %w", MaxBatch, domain.ErrBadRequest)
+	}
+
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	r := rule{validation: &validation}
+	items := make([]domain.NodeReviewItem, 0, len(ids))
+	for _, id := range ids {
+		nodeDB, err := s.dao.NewNodeQuery(ctx).GetNodeByID(id)
+		if err != nil {
+			if errors.Is(err, daoEntity.ErrNotFound) {
+				items = append(items, domain.NodeReviewItem{NodeID: id, Status: domain.NodeReviewStatusNotFound})
+				continue
+			}
+			return nil, fmt.Errorf("get node %s: %w", id, err)
+		}
+
+		item, err := s.apply(ctx, r, nodeDB.ToDomain())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	if err := s.record(ctx, domain.NodeReviewKindNodes, r, nil, items); err != nil {
+		return nil, err
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return items, nil
+}
+
+func (s *service) CheckUzi(ctx context.Context, uziID uuid.UUID, unvalidated *domain.NodeValidation) (domain.Uzi, []domain.NodeReviewItem, error) {
+	if unvalidated != nil {
+		if err := decision(*unvalidated); err != nil {
+			return domain.Uzi{}, nil, err
+		}
+	}
+
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Uzi{}, nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	uziDB, err := s.dao.NewUziQuery(ctx).GetUziByID(uziID)
+	if err != nil {
+		if errors.Is(err, daoEntity.ErrNotFound) {
+			return domain.Uzi{}, nil, domain.ErrNotFound
+		}
+		return domain.Uzi{}, nil, fmt.Errorf("get uzi by id: %w", err)
+	}
+	uzi := uziDB.ToDomain()
+
+	r := rule{validation: unvalidated, unvalidatedOnly: true}
+	items, err := s.applyUzi(ctx, r, uziID)
+	if err != nil {
+		return domain.Uzi{}, nil, err
+	}
+
+	if !uzi.Checked {
+		uzi.Checked = true
+		if err := s.dao.NewUziQuery(ctx).UpdateUzi(uziEntity.Uzi{}.FromDomain(uzi)); err != nil {
+			return domain.Uzi{}, nil, fmt.Errorf("update uzi: %w", err)
+		}
+	}
+
+	// проверка узи записывается и без изменения узлов
+	if err := s.record(ctx, domain.NodeReviewKindUzi, r, []uuid.UUID{uziID}, items); err != nil {
+		return domain.Uzi{}, nil, err
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Uzi{}, nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return uzi, items, nil
+}
+
+func (s *service) AcceptAiNodes(ctx context.Context, uziID uuid.UUID, threshold float64) ([]domain.NodeReviewItem, error) {
+	if threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("threshold must be from 0 to 1: %w", domain.ErrBadRequest)
+	}
+
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
+	if _, err := s.dao.NewUziQuery(ctx).GetUziByID(uziID); err != nil {
+		if errors.Is(err, daoEntity.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get uzi by id: %w", err)
+	}
+
+	valid := domain.NodeValidationValid
+	r := rule{validation: &valid, unvalidatedOnly: true, threshold: &threshold}
+	items, err := s.applyUzi(ctx, r, uziID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.record(ctx, domain.NodeReviewKindThreshold, r, nil, items); err != nil {
+		return nil, err
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return items, nil
+}
+
+// applyUzi применяет правило ко всем узлам узи
+func (s *service) applyUzi(ctx context.Context, r rule, uziID uuid.UUID) ([]domain.NodeReviewItem, error) {
+	nodesDB, err := s.dao.NewNodeQuery(ctx).GetNodesByUziID(uziID)
+	if err != nil && !errors.Is(err, daoEntity.ErrNotFound) {
+		return nil, fmt.Errorf("get nodes by uzi id: %w", err)
+	}
+
+	items := make([]domain.NodeReviewItem, 0, len(nodesDB))
+	for _, nodeDB := range nodesDB {
+		item, err := s.apply(ctx, r, nodeDB.ToDomain())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// apply меняет валидацию узла, если правило это требует, и пишет версию в историю
+func (s *service) apply(ctx context.Context, r rule, node domain.Node) (domain.NodeReviewItem, error) {
+	item := domain.NodeReviewItem{NodeID: node.Id, Status: r.status(node)}
+	if item.Status != domain.NodeReviewStatusApplied {
+		item.Node = &node
+		return item, nil
+	}
+
+	before := node
+	validation := *r.validation
+	node.Validation = &validation
+	if err := s.dao.NewNodeQuery(ctx).UpdateNode(nodeEntity.Node{}.FromDomain(node)); err != nil {
+		return domain.NodeReviewItem{}, fmt.Errorf("update node %s: %w", node.Id, err)
+	}
+
+	if err := s.history.RecordNode(ctx, domain.HistoryActionUpdate, &before, &node); err != nil {
+		return domain.NodeReviewItem{}, fmt.Errorf("record node history: %w", err)
+	}
+
+	item.Node = &node
+	return item, nil
+}
+
+// record пишет по одной записи на узи с измененными узлами и на каждое узи из always
+func (s *service) record(
+	ctx context.Context,
+	kind domain.NodeReviewKind,
+	r rule,
+	always []uuid.UUID,
+	items []domain.NodeReviewItem,
+) error {
+	reviews := groupReviews(kind, r, always, items)
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	actor := domain.ActorFromContext(ctx)
+	entities := make([]nodeReviewEntity.NodeReview, 0, len(reviews))
+	for _, review := range reviews {
+		review.Id = uuid.New()
+		review.Actor = actor
+		review.CreateAt = now
+		entities = append(entities, nodeReviewEntity.NodeReview{}.FromDomain(review))
+	}
+
+	if err := s.dao.NewNodeReviewQuery(ctx).InsertNodeReviews(entities...); err != nil {
+		return fmt.Errorf("insert node reviews: %w", err)
+	}
+
+	return nil
+}
+
+// groupReviews записи пакета по узи в порядке первого появления
+func groupReviews(
+	kind domain.NodeReviewKind,
+	r rule,
+	always []uuid.UUID,
+	items []domain.NodeReviewItem,
+) []domain.NodeReview {
+	var reviews []domain.NodeReview
+	byUzi := map[uuid.UUID]int{}
+	add := func(uziID uuid.UUID) int {
+		if i, ok := byUzi[uziID]; ok {
+			return i
+		}
+		byUzi[uziID] = len(reviews)
+		reviews = append(reviews, domain.NodeReview{
+			UziID:      uziID,
+			Kind:       kind,
+			Validation: r.validation,
+			Threshold:  r.threshold,
+			NodeIDs:    []uuid.UUID{},
+		})
+		return len(reviews) - 1
+	}
+
+	for _, uziID := range always {
+		add(uziID)
+	}
+	for _, item := range items {
+		if item.Status != domain.NodeReviewStatusApplied {
+			continue
+		}
+		i := add(item.Node.UziID)
+		reviews[i].NodeIDs = append(reviews[i].NodeIDs, item.NodeID)
+	}
+
+	return reviews
+}
@@ -0,0 +1,63 @@
+package review
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+func TestRuleStatus(t *testing.T) {
+	valid := domain.NodeValidationValid
+	invalid := domain.NodeValidationInvalid
+	null := domain.NodeValidationNull
+
+	aiNode := func(validation *domain.NodeValidation, tirads23, tirads4, tirads5 float64) domain.Node {
+		return domain.Node{Id: uuid.New(), Ai: true, Validation: validation, Tirads23: tirads23, Tirads4: tirads4, Tirads5: tirads5}
+	}
+
+	nodes := rule{validation: &invalid}
+	require.Equal(t, domain.NodeReviewStatusManualNode, nodes.status(domain.Node{Ai: false}))
+	require.Equal(t, domain.NodeReviewStatusApplied, nodes.status(aiNode(nil, 0.1, 0.2, 0.7)))
+	require.Equal(t, domain.NodeReviewStatusApplied, nodes.status(aiNode(&valid, 0.1, 0.2, 0.7)))
+	require.Equal(t, domain.NodeReviewStatusUnchanged, nodes.status(aiNode(&invalid, 0.1, 0.2, 0.7)))
+
+	// проверка узи без валидации узлы не меняет и решения врача не трогает
+	check := rule{unvalidatedOnly: true}
+	require.Equal(t, domain.NodeReviewStatusUnchanged, check.status(aiNode(&null, 0.1, 0.2, 0.7)))
+	require.Equal(t, domain.NodeReviewStatusAlreadyValidated, check.status(aiNode(&invalid, 0.1, 0.2, 0.7)))
+
+	threshold := rule{validation: &valid, unvalidatedOnly: true, threshold: pointer.To(0.8)}
+	require.Equal(t, domain.NodeReviewStatusApplied, threshold.status(aiNode(nil, 0.05, 0.15, 0.8)))
+	require.Equal(t, domain.NodeReviewStatusBelowThreshold, threshold.status(aiNode(nil, 0.3, 0.3, 0.4)))
+	require.Equal(t, domain.NodeReviewStatusAlreadyValidated, threshold.status(aiNode(&invalid, 0.05, 0.05, 0.9)))
+}
+
+func TestGroupReviews(t *testing.T) {
+	valid := domain.NodeValidationValid
+	uziA, uziB := uuid.New(), uuid.New()
+	nodeA, nodeB, nodeC := uuid.New(), uuid.New(), uuid.New()
+
+	items := []domain.NodeReviewItem{
+		{NodeID: nodeA, Status: domain.NodeReviewStatusApplied, Node: &domain.Node{Id: nodeA, UziID: uziB}},
+		{NodeID: uuid.New(), Status: domain.NodeReviewStatusNotFound},
+		{NodeID: nodeB, Status: domain.NodeReviewStatusUnchanged, Node: &domain.Node{Id: nodeB, UziID: uziA}},
+		{NodeID: nodeC, Status: domain.NodeReviewStatusApplied, Node: &domain.Node{Id: nodeC, UziID: uziB}},
+	}
+
+	reviews := groupReviews(domain.NodeReviewKindNodes, rule{validation: &valid}, nil, items)
+	require.Len(t, reviews, 1)
+	require.Equal(t, uziB, reviews[0].UziID)
+	require.Equal(t, []uuid.UUID{nodeA, nodeC}, reviews[0].NodeIDs)
+	require.Equal(t, &valid, reviews[0].Validation)
+
+	// проверенное узи записывается и без измененных узлов
+	reviews = groupReviews(domain.NodeReviewKindUzi, rule{unvalidatedOnly: true}, []uuid.UUID{uziA}, items[2:3])
+	require.Len(t, reviews, 1)
+	require.Equal(t, uziA, reviews[0].UziID)
+	require.Empty(t, reviews[0].NodeIDs)
+	require.Nil(t, reviews[0].Validation)
+}
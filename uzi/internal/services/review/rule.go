@@ -0,0 +1,43 @@
+package review
+
+import (
+	"fmt"
+
+	"uzi/internal/domain"
+)
+
+// rule решение пакета по одному узлу
+type rule struct {
+	// nil - валидация не меняется
+	validation *domain.NodeValidation
+	// решения врача не перезаписываются
+	unvalidatedOnly bool
+	threshold       *float64
+}
+
+func (r rule) status(node domain.Node) domain.NodeReviewStatus {
+	switch {
+	case !node.Ai:
+		return domain.NodeReviewStatusManualNode
+	case r.unvalidatedOnly && node.Validated():
+		return domain.NodeReviewStatusAlreadyValidated
+	case r.threshold != nil && node.Confidence() < *r.threshold:
+		return domain.NodeReviewStatusBelowThreshold
+	case r.validation == nil:
+		return domain.NodeReviewStatusUnchanged
+	case node.Validation != nil && *node.Validation == *r.validation:
+		return domain.NodeReviewStatusUnchanged
+	default:
+		return domain.NodeReviewStatusApplied
+	}
+}
+
+// decision пакет проставляет только решение врача, сброс в null делается через updateNode
+func decision(validation domain.NodeValidation) error {
+	switch validation {
+	case domain.NodeValidationValid, domain.NodeValidationInvalid:
+		return nil
+	default:
+		return fmt.Errorf("validation must be valid or invalid: %w", domain.ErrBadRequest)
+	}
+}
@@ -0,0 +1,41 @@
+// Пакетная валидация узлов нейросети врачом
+package review
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+	"uzi/internal/services/history"
+)
+
+// MaxBatch больше стольких узлов за один запрос не валидируется
+const MaxBatch = 1000
+
+// Каждый пакет выполняется в одной транзакции. Узлы, которые нельзя изменить, не прерывают пакет,
+// а возвращаются со своим статусом
+type Service interface {
+	// ReviewNodes проставляет валидацию выбранным узлам нейросети
+	ReviewNodes(ctx context.Context, ids []uuid.UUID, validation domain.NodeValidation) ([]domain.NodeReviewItem, error)
+	// CheckUzi отмечает узи проверенным. unvalidated проставляется узлам нейросети без решения врача, nil - не менять
+	CheckUzi(ctx context.Context, uziID uuid.UUID, unvalidated *domain.NodeValidation) (domain.Uzi, []domain.NodeReviewItem, error)
+	// AcceptAiNodes принимает узлы нейросети без решения врача с уверенностью не ниже threshold
+	AcceptAiNodes(ctx context.Context, uziID uuid.UUID, threshold float64) ([]domain.NodeReviewItem, error)
+}
+
+type service struct {
+	dao     repository.DAO
+	history history.Service
+}
+
+func New(
+	dao repository.DAO,
+	history history.Service,
+) Service {
+	return &service{
+		dao:     dao,
+		history: history,
+	}
+}
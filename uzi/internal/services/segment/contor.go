@@ -0,0 +1,26 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+)
+
+// normalizeContor приводит контур к текущей схеме в границах кадра
+func (s *service) normalizeContor(ctx context.Context, imageID uuid.UUID, contor json.RawMessage) (json.RawMessage, error) {
+	images, err := s.dao.NewImageQuery(ctx).GetImagesByIDs([]uuid.UUID{imageID})
+	if err != nil {
+		return nil, fmt.Errorf("get image by id: %w", err)
+	}
+
+	var width, height int
+	if len(images) > 0 {
+		width, height = images[0].Width, images[0].Height
+	}
+
+	return domain.NormalizeContor(contor, width, height)
+}
@@ -11,6 +11,12 @@ import (
 )
 
 func (s *service) CreateManualSegment(ctx context.Context, arg CreateSegmentArg) (uuid.UUID, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
 	node, err := s.dao.NewNodeQuery(ctx).GetNodeByID(arg.NodeID)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("get node by id: %w", err)
@@ -20,11 +26,16 @@ func (s *service) CreateManualSegment(ctx context.Context, arg CreateSegmentArg)
 		return uuid.Nil, ErrAddSegmentToAiNode
 	}
 
+	contor, err := s.normalizeContor(ctx, arg.ImageID, arg.Contor)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
 	segment := domain.Segment{
 		Id:       uuid.New(),
 		ImageID:  arg.ImageID,
 		NodeID:   arg.NodeID,
-		Contor:   arg.Contor,
+		Contor:   contor,
 		Ai:       false,
 		Tirads23: arg.Tirads23,
 		Tirads4:  arg.Tirads4,
@@ -34,5 +45,17 @@ func (s *service) CreateManualSegment(ctx context.Context, arg CreateSegmentArg)
 		return uuid.Nil, err
 	}
 
+	if err := s.measurement.RecalculateNode(ctx, arg.NodeID); err != nil {
+		return uuid.Nil, fmt.Errorf("recalculate node measurement: %w", err)
+	}
+
+	if err := s.history.RecordSegment(ctx, domain.HistoryActionCreate, nil, &segment); err != nil {
+		return uuid.Nil, fmt.Errorf("record segment history: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
 	return segment.Id, nil
 }
@@ -6,6 +6,8 @@ import (
 
 	"uzi/internal/domain"
 	"uzi/internal/repository"
+	"uzi/internal/services/history"
+	"uzi/internal/services/measurement"
 
 	"github.com/google/uuid"
 )
@@ -24,13 +26,19 @@ type Service interface {
 }
 
 type service struct {
-	dao repository.DAO
+	dao         repository.DAO
+	measurement measurement.Service
+	history     history.Service
 }
 
 func New(
 	dao repository.DAO,
+	measurement measurement.Service,
+	history history.Service,
 ) Service {
 	return &service{
-		dao: dao,
+		dao:         dao,
+		measurement: measurement,
+		history:     history,
 	}
 }
@@ -9,6 +9,12 @@ import (
 )
 
 func (s *service) UpdateSegment(ctx context.Context, arg UpdateSegmentArg) (domain.Segment, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Segment{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
 	segmentQuery := s.dao.NewSegmentQuery(ctx)
 	segmentDB, err := segmentQuery.GetSegmentByID(arg.Id)
 	if err != nil {
@@ -20,10 +26,37 @@ func (s *service) UpdateSegment(ctx context.Context, arg UpdateSegmentArg) (doma
 		return domain.Segment{}, ErrChangeAiSegment
 	}
 
+	before := segment
 	arg.UpdateDomain(&segment)
+	if arg.Contor != nil {
+		segment.Contor, err = s.normalizeContor(ctx, segment.ImageID, segment.Contor)
+		if err != nil {
+			return domain.Segment{}, err
+		}
+	}
 	if err := segmentQuery.UpdateSegment(segmentEntity.Segment{}.FromDomain(segment)); err != nil {
 		return domain.Segment{}, fmt.Errorf("update segment: %w", err)
 	}
 
+	if arg.Contor != nil {
+		if err := s.measurement.RecalculateNode(ctx, segment.NodeID); err != nil {
+			return domain.Segment{}, fmt.Errorf("recalculate node measurement: %w", err)
+		}
+
+		segmentDB, err = segmentQuery.GetSegmentByID(arg.Id)
+		if err != nil {
+			return domain.Segment{}, fmt.Errorf("get updated segment by id: %w", err)
+		}
+		segment = segmentDB.ToDomain()
+	}
+
+	if err := s.history.RecordSegment(ctx, domain.HistoryActionUpdate, &before, &segment); err != nil {
+		return domain.Segment{}, fmt.Errorf("record segment history: %w", err)
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Segment{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
 	return segment, nil
 }
@@ -2,12 +2,23 @@ package services
 
 import (
 	"uzi/internal/repository"
+	"uzi/internal/services/analytics"
+	"uzi/internal/services/dataset"
 	"uzi/internal/services/device"
+	"uzi/internal/services/history"
 	"uzi/internal/services/image"
+	"uzi/internal/services/integrity"
+	"uzi/internal/services/lineage"
+	"uzi/internal/services/measurement"
 	"uzi/internal/services/node"
 	"uzi/internal/services/node_segment"
+	"uzi/internal/services/propagation"
+	"uzi/internal/services/report"
+	"uzi/internal/services/retention"
+	"uzi/internal/services/review"
 	"uzi/internal/services/segment"
 	"uzi/internal/services/splitter"
+	"uzi/internal/services/tirads"
 	"uzi/internal/services/uzi"
 
 	dbus "uzi/internal/dbus/producers"
@@ -21,19 +32,44 @@ type Services struct {
 	Segment     segment.Service
 	NodeSegment node_segment.Service
 	Splitter    splitter.Service
+	Measurement measurement.Service
+	Tirads      tirads.Service
+	Lineage     lineage.Service
+	Report      report.Service
+	Dataset     dataset.Service
+	History     history.Service
+	Retention   retention.Service
+	Integrity   integrity.Service
+	Analytics   analytics.Service
+	Propagation propagation.Service
+	Review      review.Service
 }
 
 func New(
 	dao repository.DAO,
 	dbus dbus.Producer,
+	retentionCfg retention.Config,
+	uziCfg uzi.Config,
+	imageCfg image.Config,
 ) *Services {
+	measurement := measurement.New(dao)
 	device := device.New(dao)
-	uzi := uzi.New(dao)
-	image := image.New(dao, dbus)
-	node := node.New(dao)
-	segment := segment.New(dao)
-	nodeSegment := node_segment.New(dao)
+	uzi := uzi.New(dao, measurement, uziCfg)
+	image := image.New(dao, dbus, imageCfg)
+	history := history.New(dao, measurement)
+	node := node.New(dao, history)
+	segment := segment.New(dao, measurement, history)
+	nodeSegment := node_segment.New(dao, measurement, history)
 	splitter := splitter.New()
+	tirads := tirads.New(dao)
+	lineage := lineage.New(dao)
+	report := report.New(dao, tirads)
+	dataset := dataset.New(dao, tirads, nodeSegment)
+	retention := retention.New(dao, retentionCfg)
+	integrity := integrity.New(dao)
+	analytics := analytics.New(dao)
+	propagation := propagation.New(dao, measurement, history)
+	review := review.New(dao, history)
 
 	return &Services{
 		Device:      device,
@@ -43,5 +79,16 @@ func New(
 		Segment:     segment,
 		NodeSegment: nodeSegment,
 		Splitter:    splitter,
+		Measurement: measurement,
+		Tirads:      tirads,
+		Lineage:     lineage,
+		Report:      report,
+		Dataset:     dataset,
+		History:     history,
+		Retention:   retention,
+		Integrity:   integrity,
+		Analytics:   analytics,
+		Propagation: propagation,
+		Review:      review,
 	}
 }
@@ -0,0 +1,106 @@
+package splitter
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"golang.org/x/image/draw"
+
+	"uzi/internal/domain"
+)
+
+const (
+	previewQuality   = 85
+	thumbnailQuality = 75
+)
+
+// Previews кадр и его уменьшенные копии, готовые к загрузке в S3
+type Previews struct {
+	// исходный png, буфер кадра читается при создании превью
+	Frame     domain.File
+	Width     int
+	Height    int
+	Preview   domain.File
+	Thumbnail domain.File
+	// закрашенные области, пустой если кадр не менялся
+	Masked []domain.MaskedRegion
+}
+
+func (s *service) MakePreviews(frame domain.File, mask domain.EdgeMask) (Previews, error) {
+	raw, err := io.ReadAll(frame.Buf)
+	if err != nil {
+		return Previews{}, fmt.Errorf("read frame: %w", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return Previews{}, fmt.Errorf("decode png: %w", err)
+	}
+
+	bounds := img.Bounds()
+	masked := mask.Regions(bounds.Dx(), bounds.Dy())
+	if len(masked) > 0 {
+		img = maskRegions(img, masked)
+
+		// в S3 сохраняется закрашенный кадр
+		b := new(bytes.Buffer)
+		if err := png.Encode(b, img); err != nil {
+			return Previews{}, fmt.Errorf("encode masked png: %w", err)
+		}
+		raw = b.Bytes()
+	}
+
+	preview, err := resizeToJpeg(img, domain.PreviewMaxSide, previewQuality)
+	if err != nil {
+		return Previews{}, fmt.Errorf("make preview: %w", err)
+	}
+
+	thumbnail, err := resizeToJpeg(img, domain.ThumbnailMaxSide, thumbnailQuality)
+	if err != nil {
+		return Previews{}, fmt.Errorf("make thumbnail: %w", err)
+	}
+
+	return Previews{
+		Frame:     domain.File{Format: Png, Size: int64(len(raw)), Buf: bytes.NewReader(raw)},
+		Width:     bounds.Dx(),
+		Height:    bounds.Dy(),
+		Preview:   preview,
+		Thumbnail: thumbnail,
+		Masked:    masked,
+	}, nil
+}
+
+// maskRegions копия кадра с закрашенными черным областями
+func maskRegions(img image.Image, regions []domain.MaskedRegion) image.Image {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+
+	for _, region := range regions {
+		rect := image.Rect(region.X, region.Y, region.X+region.Width, region.Y+region.Height)
+		draw.Draw(dst, rect, image.Black, image.Point{}, draw.Src)
+	}
+
+	return dst
+}
+
+func resizeToJpeg(img image.Image, maxSide int, quality int) (domain.File, error) {
+	bounds := img.Bounds()
+	width, height := domain.FitSize(bounds.Dx(), bounds.Dy(), maxSide)
+
+	// jpeg без альфа канала: прозрачные области заливаются белым, как их показывает браузер
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	b := new(bytes.Buffer)
+	if err := jpeg.Encode(b, dst, &jpeg.Options{Quality: quality}); err != nil {
+		return domain.File{}, fmt.Errorf("encode jpeg: %w", err)
+	}
+
+	return domain.File{Format: Jpeg, Size: int64(b.Len()), Buf: b}, nil
+}
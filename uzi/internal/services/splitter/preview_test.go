@@ -0,0 +1,81 @@
+package splitter
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+func TestMakePreviews(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 2048, 1536))
+	b := new(bytes.Buffer)
+	require.NoError(t, png.Encode(b, img))
+	raw := b.Bytes()
+
+	previews, err := New().MakePreviews(domain.File{Format: Png, Size: int64(len(raw)), Buf: bytes.NewReader(raw)}, domain.EdgeMask{})
+	require.NoError(t, err)
+	require.Equal(t, 2048, previews.Width)
+	require.Equal(t, 1536, previews.Height)
+
+	frame, err := io.ReadAll(previews.Frame.Buf)
+	require.NoError(t, err)
+	require.Equal(t, raw, frame)
+
+	for _, tc := range []struct {
+		file          domain.File
+		width, height int
+	}{
+		{previews.Preview, 1024, 768},
+		{previews.Thumbnail, 256, 192},
+	} {
+		require.Equal(t, Jpeg, tc.file.Format)
+		cfg, err := jpeg.DecodeConfig(tc.file.Buf)
+		require.NoError(t, err)
+		require.Equal(t, tc.width, cfg.Width)
+		require.Equal(t, tc.height, cfg.Height)
+	}
+}
+
+func TestFitSize(t *testing.T) {
+	w, h := domain.FitSize(800, 600, 1024)
+	require.Equal(t, [2]int{800, 600}, [2]int{w, h})
+
+	w, h = domain.FitSize(600, 2400, 256)
+	require.Equal(t, [2]int{64, 256}, [2]int{w, h})
+}
+
+func TestMakePreviews_Mask(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 100, 100))
+	for i := range img.Pix {
+		img.Pix[i] = 0xFF
+	}
+	b := new(bytes.Buffer)
+	require.NoError(t, png.Encode(b, img))
+
+	previews, err := New().MakePreviews(
+		domain.File{Format: Png, Size: int64(b.Len()), Buf: bytes.NewReader(b.Bytes())},
+		domain.EdgeMask{TopPercent: 10, BottomPercent: 5},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []domain.MaskedRegion{
+		{X: 0, Y: 0, Width: 100, Height: 10},
+		{X: 0, Y: 95, Width: 100, Height: 5},
+	}, previews.Masked)
+
+	frame, err := png.Decode(previews.Frame.Buf)
+	require.NoError(t, err)
+	for _, tc := range []struct {
+		y     int
+		black bool
+	}{{0, true}, {9, true}, {10, false}, {94, false}, {95, true}, {99, true}} {
+		r, _, _, _ := frame.At(50, tc.y).RGBA()
+		require.Equal(t, tc.black, r == 0, "y=%d", tc.y)
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 type Service interface {
 	SplitToPng(file domain.File) ([]domain.File, error)
+	// MakePreviews закрашивает полосы маски и создает jpeg превью и миниатюру png кадра
+	MakePreviews(frame domain.File, mask domain.EdgeMask) (Previews, error)
 }
 
 type splitter interface {
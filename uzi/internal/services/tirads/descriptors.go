@@ -0,0 +1,76 @@
+package tirads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository/entity"
+	descriptorsEntity "uzi/internal/repository/node_descriptors/entity"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) SetNodeDescriptors(ctx context.Context, arg SetNodeDescriptorsArg) (domain.NodeTirads, error) {
+	nodeDB, err := s.dao.NewNodeQuery(ctx).GetNodeByID(arg.NodeID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return domain.NodeTirads{}, domain.ErrNotFound
+		}
+		return domain.NodeTirads{}, fmt.Errorf("get node by id: %w", err)
+	}
+
+	descriptors := domain.NodeDescriptors{
+		NodeID:        arg.NodeID,
+		Composition:   arg.Composition,
+		Echogenicity:  arg.Echogenicity,
+		Shape:         arg.Shape,
+		Margin:        arg.Margin,
+		EchogenicFoci: arg.EchogenicFoci,
+	}
+
+	if err := s.dao.NewNodeDescriptorsQuery(ctx).UpsertNodeDescriptors(descriptorsEntity.NodeDescriptors{}.FromDomain(descriptors)); err != nil {
+		var valErr *entity.DBValidationError
+		if errors.As(err, &valErr) {
+			return domain.NodeTirads{}, domain.ErrUnprocessableEntity
+		}
+		return domain.NodeTirads{}, fmt.Errorf("upsert node descriptors: %w", err)
+	}
+
+	node := nodeDB.ToDomain()
+	score := score(descriptors, node.Measurement)
+
+	return domain.NodeTirads{
+		Node:        node,
+		Descriptors: &descriptors,
+		Score:       &score,
+	}, nil
+}
+
+func (s *service) GetNodeTirads(ctx context.Context, nodeID uuid.UUID) (domain.NodeTirads, error) {
+	nodeDB, err := s.dao.NewNodeQuery(ctx).GetNodeByID(nodeID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return domain.NodeTirads{}, domain.ErrNotFound
+		}
+		return domain.NodeTirads{}, fmt.Errorf("get node by id: %w", err)
+	}
+	tirads := domain.NodeTirads{Node: nodeDB.ToDomain()}
+
+	descriptorsDB, err := s.dao.NewNodeDescriptorsQuery(ctx).GetNodeDescriptorsByNodeID(nodeID)
+	if err != nil {
+		// врач еще не заполнил дескрипторы, отдаем только вероятности нейросети
+		if errors.Is(err, entity.ErrNotFound) {
+			return tirads, nil
+		}
+		return domain.NodeTirads{}, fmt.Errorf("get node descriptors: %w", err)
+	}
+
+	descriptors := descriptorsDB.ToDomain()
+	score := score(descriptors, tirads.Node.Measurement)
+	tirads.Descriptors = &descriptors
+	tirads.Score = &score
+
+	return tirads, nil
+}
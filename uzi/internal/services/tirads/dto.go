@@ -0,0 +1,16 @@
+package tirads
+
+import (
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+)
+
+type SetNodeDescriptorsArg struct {
+	NodeID        uuid.UUID
+	Composition   domain.TiradsComposition
+	Echogenicity  domain.TiradsEchogenicity
+	Shape         domain.TiradsShape
+	Margin        domain.TiradsMargin
+	EchogenicFoci []domain.TiradsEchogenicFoci
+}
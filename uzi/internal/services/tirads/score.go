@@ -0,0 +1,134 @@
+package tirads
+
+import (
+	"slices"
+
+	"uzi/internal/domain"
+)
+
+var compositionPoints = map[domain.TiradsComposition]int{
+	domain.TiradsCompositionCystic:     0,
+	domain.TiradsCompositionSpongiform: 0,
+	domain.TiradsCompositionMixed:      1,
+	domain.TiradsCompositionSolid:      2,
+}
+
+var echogenicityPoints = map[domain.TiradsEchogenicity]int{
+	domain.TiradsEchogenicityAnechoic: 0,
+	domain.TiradsEchogenicityHyperIso: 1,
+	domain.TiradsEchogenicityHypo:     2,
+	domain.TiradsEchogenicityVeryHypo: 3,
+}
+
+var shapePoints = map[domain.TiradsShape]int{
+	domain.TiradsShapeWiderThanTall:  0,
+	domain.TiradsShapeTallerThanWide: 3,
+}
+
+var marginPoints = map[domain.TiradsMargin]int{
+	domain.TiradsMarginSmooth:         0,
+	domain.TiradsMarginIllDefined:     0,
+	domain.TiradsMarginLobulated:      2,
+	domain.TiradsMarginExtrathyroidal: 3,
+}
+
+var echogenicFociPoints = map[domain.TiradsEchogenicFoci]int{
+	domain.TiradsEchogenicFociNone:                0,
+	domain.TiradsEchogenicFociMacrocalcifications: 1,
+	domain.TiradsEchogenicFociPeripheral:          2,
+	domain.TiradsEchogenicFociPunctate:            3,
+}
+
+// пороги размера узла в мм: от fna - пункция, от followUp - наблюдение
+type sizeThresholds struct {
+	fna      float64
+	followUp float64
+}
+
+var categoryThresholds = map[domain.TiradsCategory]sizeThresholds{
+	domain.TiradsCategoryTR3: {fna: 25, followUp: 15},
+	domain.TiradsCategoryTR4: {fna: 15, followUp: 10},
+	domain.TiradsCategoryTR5: {fna: 10, followUp: 5},
+}
+
+func points(d domain.NodeDescriptors) int {
+	// губчатый узел доброкачественный, остальные признаки не учитываются
+	if d.Composition == domain.TiradsCompositionSpongiform {
+		return 0
+	}
+
+	sum := compositionPoints[d.Composition] +
+		echogenicityPoints[d.Echogenicity] +
+		shapePoints[d.Shape] +
+		marginPoints[d.Margin]
+
+	// баллы за каждый тип включений считаются один раз
+	var seen []domain.TiradsEchogenicFoci
+	for _, f := range d.EchogenicFoci {
+		if slices.Contains(seen, f) {
+			continue
+		}
+		seen = append(seen, f)
+		sum += echogenicFociPoints[f]
+	}
+
+	return sum
+}
+
+func category(points int) domain.TiradsCategory {
+	switch {
+	case points <= 1:
+		return domain.TiradsCategoryTR1
+	case points == 2:
+		return domain.TiradsCategoryTR2
+	case points == 3:
+		return domain.TiradsCategoryTR3
+	case points <= 6:
+		return domain.TiradsCategoryTR4
+	default:
+		return domain.TiradsCategoryTR5
+	}
+}
+
+// maxDiameter - максимальный размер узла в мм, nil если неизвестен
+func recommendation(category domain.TiradsCategory, maxDiameter *float64) domain.TiradsRecommendation {
+	thresholds, ok := categoryThresholds[category]
+	if !ok {
+		return domain.TiradsRecommendationNone
+	}
+
+	if maxDiameter == nil {
+		return domain.TiradsRecommendationUnknown
+	}
+
+	switch {
+	case *maxDiameter >= thresholds.fna:
+		return domain.TiradsRecommendationFNA
+	case *maxDiameter >= thresholds.followUp:
+		return domain.TiradsRecommendationFollowUp
+	default:
+		return domain.TiradsRecommendationNone
+	}
+}
+
+// размер узла берется из автоматических измерений, если они в мм
+func maxDiameter(m *domain.NodeMeasurement) *float64 {
+	if m == nil || m.Unit != domain.MeasureUnitMm {
+		return nil
+	}
+	v := m.MajorAxis
+	return &v
+}
+
+func score(d domain.NodeDescriptors, m *domain.NodeMeasurement) domain.TiradsScore {
+	p := points(d)
+	c := category(p)
+	diameter := maxDiameter(m)
+
+	return domain.TiradsScore{
+		Points:         p,
+		Category:       c,
+		Recommendation: recommendation(c, diameter),
+		MaxDiameter:    diameter,
+	}
+}
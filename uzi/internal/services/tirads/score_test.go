@@ -0,0 +1,122 @@
+package tirads
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+func TestPoints(t *testing.T) {
+	tests := []struct {
+		name        string
+		descriptors domain.NodeDescriptors
+		points      int
+	}{
+		{
+			name: "spongiform ignores other descriptors",
+			descriptors: domain.NodeDescriptors{
+				Composition:   domain.TiradsCompositionSpongiform,
+				Echogenicity:  domain.TiradsEchogenicityVeryHypo,
+				Shape:         domain.TiradsShapeTallerThanWide,
+				Margin:        domain.TiradsMarginExtrathyroidal,
+				EchogenicFoci: []domain.TiradsEchogenicFoci{domain.TiradsEchogenicFociPunctate},
+			},
+			points: 0,
+		},
+		{
+			name: "solid hypoechoic",
+			descriptors: domain.NodeDescriptors{
+				Composition:   domain.TiradsCompositionSolid,
+				Echogenicity:  domain.TiradsEchogenicityHypo,
+				Shape:         domain.TiradsShapeWiderThanTall,
+				Margin:        domain.TiradsMarginSmooth,
+				EchogenicFoci: []domain.TiradsEchogenicFoci{domain.TiradsEchogenicFociNone},
+			},
+			points: 4,
+		},
+		{
+			name: "foci are summed once per type",
+			descriptors: domain.NodeDescriptors{
+				Composition:  domain.TiradsCompositionSolid,
+				Echogenicity: domain.TiradsEchogenicityVeryHypo,
+				Shape:        domain.TiradsShapeTallerThanWide,
+				Margin:       domain.TiradsMarginLobulated,
+				EchogenicFoci: []domain.TiradsEchogenicFoci{
+					domain.TiradsEchogenicFociMacrocalcifications,
+					domain.TiradsEchogenicFociPunctate,
+					domain.TiradsEchogenicFociPunctate,
+				},
+			},
+			points: 14,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.points, points(tt.descriptors))
+		})
+	}
+}
+
+func TestCategory(t *testing.T) {
+	expected := map[int]domain.TiradsCategory{
+		0:  domain.TiradsCategoryTR1,
+		1:  domain.TiradsCategoryTR1,
+		2:  domain.TiradsCategoryTR2,
+		3:  domain.TiradsCategoryTR3,
+		4:  domain.TiradsCategoryTR4,
+		6:  domain.TiradsCategoryTR4,
+		7:  domain.TiradsCategoryTR5,
+		14: domain.TiradsCategoryTR5,
+	}
+
+	for points, expectedCategory := range expected {
+		require.Equal(t, expectedCategory, category(points), points)
+	}
+}
+
+func TestRecommendation(t *testing.T) {
+	tests := []struct {
+		category       domain.TiradsCategory
+		diameter       *float64
+		recommendation domain.TiradsRecommendation
+	}{
+		{domain.TiradsCategoryTR1, nil, domain.TiradsRecommendationNone},
+		{domain.TiradsCategoryTR2, pointer.To(50.0), domain.TiradsRecommendationNone},
+		{domain.TiradsCategoryTR3, nil, domain.TiradsRecommendationUnknown},
+		{domain.TiradsCategoryTR3, pointer.To(14.9), domain.TiradsRecommendationNone},
+		{domain.TiradsCategoryTR3, pointer.To(15.0), domain.TiradsRecommendationFollowUp},
+		{domain.TiradsCategoryTR3, pointer.To(25.0), domain.TiradsRecommendationFNA},
+		{domain.TiradsCategoryTR4, pointer.To(10.0), domain.TiradsRecommendationFollowUp},
+		{domain.TiradsCategoryTR4, pointer.To(15.0), domain.TiradsRecommendationFNA},
+		{domain.TiradsCategoryTR5, pointer.To(4.0), domain.TiradsRecommendationNone},
+		{domain.TiradsCategoryTR5, pointer.To(5.0), domain.TiradsRecommendationFollowUp},
+		{domain.TiradsCategoryTR5, pointer.To(10.0), domain.TiradsRecommendationFNA},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.recommendation, recommendation(tt.category, tt.diameter), tt)
+	}
+}
+
+func TestScore_UsesMillimetreMeasurementOnly(t *testing.T) {
+	descriptors := domain.NodeDescriptors{
+		Composition:  domain.TiradsCompositionSolid,
+		Echogenicity: domain.TiradsEchogenicityVeryHypo,
+		Shape:        domain.TiradsShapeTallerThanWide,
+		Margin:       domain.TiradsMarginSmooth,
+	}
+
+	px := score(descriptors, &domain.NodeMeasurement{MajorAxis: 120, Unit: domain.MeasureUnitPx})
+	require.Equal(t, domain.TiradsCategoryTR5, px.Category)
+	require.Equal(t, domain.TiradsRecommendationUnknown, px.Recommendation)
+	require.Nil(t, px.MaxDiameter)
+
+	mm := score(descriptors, &domain.NodeMeasurement{MajorAxis: 12, Unit: domain.MeasureUnitMm})
+	require.Equal(t, 8, mm.Points)
+	require.Equal(t, domain.TiradsRecommendationFNA, mm.Recommendation)
+	require.Equal(t, pointer.To(12.0), mm.MaxDiameter)
+}
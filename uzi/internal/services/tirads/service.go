@@ -0,0 +1,29 @@
+// Оценка узлов по шкале ACR TI-RADS
+package tirads
+
+import (
+	"context"
+
+	"uzi/internal/domain"
+	"uzi/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	SetNodeDescriptors(ctx context.Context, arg SetNodeDescriptorsArg) (domain.NodeTirads, error)
+
+	GetNodeTirads(ctx context.Context, nodeID uuid.UUID) (domain.NodeTirads, error)
+}
+
+type service struct {
+	dao repository.DAO
+}
+
+func New(
+	dao repository.DAO,
+) Service {
+	return &service{
+		dao: dao,
+	}
+}
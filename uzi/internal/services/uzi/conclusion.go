@@ -0,0 +1,71 @@
+package uzi
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"uzi/internal/domain"
+)
+
+//go:embed templates/conclusion.tmpl
+var templates embed.FS
+
+var flagLabels = map[string]string{
+	domain.EchographicFieldGlandVolum: "Объем железы",
+	domain.EchographicFieldIsthmus:    "Толщина перешейка",
+}
+
+var flagUnits = map[string]string{
+	domain.EchographicFieldGlandVolum: "мл",
+	domain.EchographicFieldIsthmus:    "см",
+}
+
+var conclusionFuncs = template.FuncMap{
+	"num": func(v any) string {
+		switch v := v.(type) {
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case *float64:
+			if v == nil {
+				return ""
+			}
+			return strconv.FormatFloat(*v, 'f', -1, 64)
+		default:
+			return fmt.Sprint(v)
+		}
+	},
+	"flagLabel": func(field string) string { return flagLabels[field] },
+	"flagUnit":  func(field string) string { return flagUnits[field] },
+}
+
+// ParseConclusionTemplate шаблон заключения text/template, данные - domain.Echographic
+// с вычисленными Flags, доступны функции num, flagLabel и flagUnit
+func ParseConclusionTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("conclusion").Funcs(conclusionFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse conclusion template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// DefaultConclusionTemplate шаблон заключения по умолчанию
+func DefaultConclusionTemplate() *template.Template {
+	return template.Must(template.New("conclusion.tmpl").Funcs(conclusionFuncs).ParseFS(templates, "templates/conclusion.tmpl"))
+}
+
+// describeEchographic вычисляет отклонения от норм и текст заключения по шаблону
+func (s *service) describeEchographic(echographic *domain.Echographic) error {
+	echographic.Flags = echographic.CheckNorms()
+
+	var buf bytes.Buffer
+	if err := s.cfg.ConclusionTemplate.Execute(&buf, echographic); err != nil {
+		return fmt.Errorf("execute conclusion template: %w", err)
+	}
+	echographic.GeneratedConclusion = strings.TrimSpace(buf.String())
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package uzi
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+func TestDescribeEchographic(t *testing.T) {
+	s := &service{cfg: Config{ConclusionTemplate: DefaultConclusionTemplate()}}
+
+	female := domain.PatientSexFemale
+	heterogeneous := domain.EchographicStructHeterogeneous
+	decreased := domain.EchographicEchogenicityDecreased
+	e := domain.Echographic{
+		LeftLobeVolum:  pointer.To(9.5),
+		RightLobeVolum: pointer.To(10.5),
+		GlandVolum:     pointer.To(20.0),
+		Struct:         &heterogeneous,
+		Echogenicity:   &decreased,
+		PatientSex:     &female,
+		PatientAge:     pointer.To(35),
+	}
+
+	require.NoError(t, s.describeEchographic(&e))
+	require.Len(t, e.Flags, 1)
+	require.Equal(t, "Объем щитовидной железы 20 мл (правая доля 10.5 мл, левая доля 9.5 мл).\n"+
+		"Структура неоднородная.\n"+
+		"Эхогенность пониженная.\n"+
+		"Объем железы 20 мл вне нормы от 4.4 до 18 мл.", e.GeneratedConclusion)
+
+	empty := domain.Echographic{}
+	require.NoError(t, s.describeEchographic(&empty))
+	require.Empty(t, empty.GeneratedConclusion)
+}
+
+func TestParseConclusionTemplate(t *testing.T) {
+	tmpl, err := ParseConclusionTemplate(`{{with .GlandVolum}}V={{num .}}{{end}}{{range .Flags}} {{flagLabel .Field}}{{end}}`)
+	require.NoError(t, err)
+
+	s := &service{cfg: Config{ConclusionTemplate: tmpl}}
+	e := domain.Echographic{GlandVolum: pointer.To(30.0)}
+	require.NoError(t, s.describeEchographic(&e))
+	require.Equal(t, "V=30 Объем железы", e.GeneratedConclusion)
+
+	_, err = ParseConclusionTemplate(`{{.Unknown`)
+	require.Error(t, err)
+}
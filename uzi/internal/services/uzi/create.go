@@ -14,13 +14,36 @@ import (
 	"github.com/google/uuid"
 )
 
-func (s *service) CreateUzi(ctx context.Context, arg CreateUziArg) (uuid.UUID, error) {
+var (
+	ErrDeviceNotFound = errors.New("device not found")
+	ErrDuplicateUzi   = errors.New("uzi with the same file already uploaded")
+)
+
+func (s *service) CreateUzi(ctx context.Context, arg CreateUziArg) (uuid.UUID, []uuid.UUID, error) {
 	ctx, err := s.dao.BeginTx(ctx)
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("begin transaction: %w", err)
+		return uuid.Nil, nil, fmt.Errorf("begin transaction: %w", err)
 	}
 	defer func() { _ = s.dao.RollbackTx(ctx) }()
 
+	var duplicates []uuid.UUID
+	if arg.Sha256 != nil {
+		duplicates, err = s.dao.NewUziQuery(ctx).GetUziIDsBySha256(arg.ExternalID, *arg.Sha256)
+		if err != nil {
+			return uuid.Nil, nil, fmt.Errorf("get uzis by sha256: %w", err)
+		}
+		if len(duplicates) > 0 && s.cfg.DuplicatePolicy == domain.DuplicatePolicyReject {
+			return uuid.Nil, duplicates, ErrDuplicateUzi
+		}
+	}
+
+	if _, err := s.dao.NewDeviceQuery(ctx).GetDeviceByID(arg.DeviceID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return uuid.Nil, nil, ErrDeviceNotFound
+		}
+		return uuid.Nil, nil, fmt.Errorf("get device by id: %w", err)
+	}
+
 	uzi := domain.Uzi{
 		Id:          uuid.New(),
 		Projection:  arg.Projection,
@@ -31,27 +54,30 @@ func (s *service) CreateUzi(ctx context.Context, arg CreateUziArg) (uuid.UUID, e
 		Status:      domain.UziStatusNew,
 		Description: arg.Description,
 		CreateAt:    time.Now(),
+
+		PixelSpacing: arg.PixelSpacing,
+		Sha256:       arg.Sha256,
 	}
 
 	if err := s.dao.NewUziQuery(ctx).InsertUzi(uziEntity.Uzi{}.FromDomain(uzi)); err != nil {
 		var valErr *entity.DBValidationError
 		if errors.As(err, &valErr) {
-			return uuid.Nil, domain.ErrUnprocessableEntity
+			return uuid.Nil, nil, domain.ErrUnprocessableEntity
 		}
-		return uuid.Nil, fmt.Errorf("insert uzi: %w", err)
+		return uuid.Nil, nil, fmt.Errorf("insert uzi: %w", err)
 	}
 
 	if err := s.dao.NewEchographicQuery(ctx).InsertEchographic(echographicEntity.Echographic{Id: uzi.Id}); err != nil {
 		var valErr *entity.DBValidationError
 		if errors.As(err, &valErr) {
-			return uuid.Nil, domain.ErrUnprocessableEntity
+			return uuid.Nil, nil, domain.ErrUnprocessableEntity
 		}
-		return uuid.Nil, fmt.Errorf("insert echographic: %w", err)
+		return uuid.Nil, nil, fmt.Errorf("insert echographic: %w", err)
 	}
 
 	if err := s.dao.CommitTx(ctx); err != nil {
-		return uuid.Nil, fmt.Errorf("commit transaction: %w", err)
+		return uuid.Nil, nil, fmt.Errorf("commit transaction: %w", err)
 	}
 
-	return uzi.Id, nil
+	return uzi.Id, duplicates, nil
 }
@@ -0,0 +1,44 @@
+package uzi
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"uzi/internal/domain"
+)
+
+const cursorSep = "|"
+
+// encodeCursor курсор непрозрачен для клиента, внутри дата создания и id узи
+func encodeCursor(cursor domain.UziCursor) string {
+	raw := cursor.CreateAt.Format(time.RFC3339) + cursorSep + cursor.Id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (domain.UziCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return domain.UziCursor{}, fmt.Errorf("decode cursor: %w", domain.ErrBadRequest)
+	}
+
+	createAt, id, ok := strings.Cut(string(raw), cursorSep)
+	if !ok {
+		return domain.UziCursor{}, fmt.Errorf("split cursor: %w", domain.ErrBadRequest)
+	}
+
+	parsedCreateAt, err := time.Parse(time.RFC3339, createAt)
+	if err != nil {
+		return domain.UziCursor{}, fmt.Errorf("parse cursor create_at: %w", domain.ErrBadRequest)
+	}
+
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return domain.UziCursor{}, fmt.Errorf("parse cursor id: %w", domain.ErrBadRequest)
+	}
+
+	return domain.UziCursor{CreateAt: parsedCreateAt, Id: parsedID}, nil
+}
@@ -0,0 +1,30 @@
+package uzi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"uzi/internal/domain"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	cursor := domain.UziCursor{
+		CreateAt: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+		Id:       uuid.New(),
+	}
+
+	decoded, err := decodeCursor(encodeCursor(cursor))
+	require.NoError(t, err)
+	require.True(t, cursor.CreateAt.Equal(decoded.CreateAt))
+	require.Equal(t, cursor.Id, decoded.Id)
+}
+
+func TestCursor_Invalid(t *testing.T) {
+	for _, cursor := range []string{"", "not base64!", "bm9zZXA", "MjAyNXxub3QtdXVpZA"} {
+		_, err := decodeCursor(cursor)
+		require.ErrorIs(t, err, domain.ErrBadRequest, cursor)
+	}
+}
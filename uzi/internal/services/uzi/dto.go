@@ -12,12 +12,17 @@ type CreateUziArg struct {
 	Author      uuid.UUID
 	DeviceID    int
 	Description *string
+	// размер пикселя из DICOM, если известен
+	PixelSpacing *domain.PixelSpacing
+	// sha256 исходного файла в hex, если посчитана при загрузке
+	Sha256 *string
 }
 
 type UpdateUziArg struct {
-	Id         uuid.UUID
-	Projection *domain.UziProjection
-	Checked    *bool
+	Id           uuid.UUID
+	Projection   *domain.UziProjection
+	Checked      *bool
+	PixelSpacing *domain.PixelSpacing
 }
 
 func (u UpdateUziArg) UpdateDomain(d *domain.Uzi) {
@@ -27,6 +32,9 @@ func (u UpdateUziArg) UpdateDomain(d *domain.Uzi) {
 	if u.Checked != nil {
 		d.Checked = *u.Checked
 	}
+	if u.PixelSpacing != nil {
+		d.PixelSpacing = u.PixelSpacing
+	}
 }
 
 type UpdateEchographicArg struct {
@@ -42,13 +50,15 @@ type UpdateEchographicArg struct {
 	RightLobeVolum  *float64
 	GlandVolum      *float64
 	Isthmus         *float64
-	Struct          *string
-	Echogenicity    *string
+	Struct          *domain.EchographicStruct
+	Echogenicity    *domain.EchographicEchogenicity
 	RegionalLymph   *string
-	Vascularization *string
+	Vascularization *domain.EchographicVascularization
 	Location        *string
 	Additional      *string
 	Conclusion      *string
+	PatientSex      *domain.PatientSex
+	PatientAge      *int
 }
 
 func (u UpdateEchographicArg) UpdateDomain(d *domain.Echographic) {
@@ -106,4 +116,19 @@ func (u UpdateEchographicArg) UpdateDomain(d *domain.Echographic) {
 	if u.Conclusion != nil {
 		d.Conclusion = u.Conclusion
 	}
+	if u.PatientSex != nil {
+		d.PatientSex = u.PatientSex
+	}
+	if u.PatientAge != nil {
+		d.PatientAge = u.PatientAge
+	}
+}
+
+type SearchUzisArg struct {
+	Filter domain.UziFilter
+	Order  domain.SortOrder
+	// курсор из предыдущей страницы
+	Cursor *string
+	// 0 - размер страницы по умолчанию
+	Limit int
 }
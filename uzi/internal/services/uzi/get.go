@@ -37,10 +37,15 @@ func (s *service) GetUzisByAuthor(ctx context.Context, author uuid.UUID) ([]doma
 }
 
 func (s *service) GetUziEchographicsByID(ctx context.Context, id uuid.UUID) (domain.Echographic, error) {
-	echographics, err := s.dao.NewEchographicQuery(ctx).GetEchographicByID(id)
+	echographicDB, err := s.dao.NewEchographicQuery(ctx).GetEchographicByID(id)
 	if err != nil {
 		return domain.Echographic{}, err
 	}
 
-	return echographics.ToDomain(), nil
+	echographic := echographicDB.ToDomain()
+	if err := s.describeEchographic(&echographic); err != nil {
+		return domain.Echographic{}, err
+	}
+
+	return echographic, nil
 }
@@ -0,0 +1,55 @@
+package uzi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlekSi/pointer"
+
+	"uzi/internal/domain"
+	uziEntity "uzi/internal/repository/uzi/entity"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+func (s *service) SearchUzis(ctx context.Context, arg SearchUzisArg) (domain.UziPage, error) {
+	limit := arg.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	if limit < 0 || limit > maxSearchLimit {
+		return domain.UziPage{}, fmt.Errorf("limit must be in [1, %d]: %w", maxSearchLimit, domain.ErrBadRequest)
+	}
+
+	order := arg.Order
+	if order == "" {
+		order = domain.SortOrderDesc
+	}
+
+	var after *domain.UziCursor
+	if arg.Cursor != nil {
+		cursor, err := decodeCursor(*arg.Cursor)
+		if err != nil {
+			return domain.UziPage{}, err
+		}
+		after = &cursor
+	}
+
+	// берем на одну запись больше, чтобы понять есть ли следующая страница
+	uzis, err := s.dao.NewUziQuery(ctx).SearchUzis(arg.Filter, order, after, limit+1)
+	if err != nil {
+		return domain.UziPage{}, fmt.Errorf("search uzis: %w", err)
+	}
+
+	page := domain.UziPage{Uzis: uziEntity.Uzi{}.SliceToDomain(uzis)}
+	if len(page.Uzis) > limit {
+		page.Uzis = page.Uzis[:limit]
+		last := page.Uzis[limit-1]
+		page.NextCursor = pointer.To(encodeCursor(domain.UziCursor{CreateAt: last.CreateAt, Id: last.Id}))
+	}
+
+	return page, nil
+}
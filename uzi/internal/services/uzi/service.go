@@ -2,35 +2,57 @@ package uzi
 
 import (
 	"context"
+	"text/template"
 
 	"uzi/internal/domain"
 	"uzi/internal/repository"
+	"uzi/internal/services/measurement"
 
 	"github.com/google/uuid"
 )
 
 type Service interface {
-	CreateUzi(ctx context.Context, arg CreateUziArg) (uuid.UUID, error)
+	// CreateUzi возвращает id узи и ранее загруженные узи пациента с тем же файлом.
+	// При DuplicatePolicyReject и найденных дубликатах узи не создается, возвращается ErrDuplicateUzi
+	CreateUzi(ctx context.Context, arg CreateUziArg) (uuid.UUID, []uuid.UUID, error)
 
 	GetUziByID(ctx context.Context, id uuid.UUID) (domain.Uzi, error)
 	GetUzisByExternalID(ctx context.Context, externalID uuid.UUID) ([]domain.Uzi, error)
 	GetUzisByAuthor(ctx context.Context, author uuid.UUID) ([]domain.Uzi, error)
+	SearchUzis(ctx context.Context, arg SearchUzisArg) (domain.UziPage, error)
+	// GetUziEchographicsByID возвращает эхографию с отклонениями от норм и сгенерированным заключением
 	GetUziEchographicsByID(ctx context.Context, id uuid.UUID) (domain.Echographic, error)
 
 	UpdateUzi(ctx context.Context, arg UpdateUziArg) (domain.Uzi, error)
+	// UpdateEchographic пересчитывает объемы долей и железы, если заданы размеры
 	UpdateEchographic(ctx context.Context, arg UpdateEchographicArg) (domain.Echographic, error)
+}
 
-	DeleteUzi(ctx context.Context, id uuid.UUID) error
+type Config struct {
+	// повторная загрузка того же файла для пациента
+	DuplicatePolicy domain.DuplicatePolicy
+	// шаблон заключения эхографии, nil - DefaultConclusionTemplate
+	ConclusionTemplate *template.Template
 }
 
 type service struct {
-	dao repository.DAO
+	dao         repository.DAO
+	measurement measurement.Service
+	cfg         Config
 }
 
 func New(
 	dao repository.DAO,
+	measurement measurement.Service,
+	cfg Config,
 ) Service {
+	if cfg.ConclusionTemplate == nil {
+		cfg.ConclusionTemplate = DefaultConclusionTemplate()
+	}
+
 	return &service{
-		dao: dao,
+		dao:         dao,
+		measurement: measurement,
+		cfg:         cfg,
 	}
 }
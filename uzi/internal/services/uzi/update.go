@@ -12,6 +12,12 @@ import (
 )
 
 func (s *service) UpdateUzi(ctx context.Context, arg UpdateUziArg) (domain.Uzi, error) {
+	ctx, err := s.dao.BeginTx(ctx)
+	if err != nil {
+		return domain.Uzi{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = s.dao.RollbackTx(ctx) }()
+
 	uzi, err := s.GetUziByID(ctx, arg.Id)
 	if err != nil {
 		if errors.Is(err, entity.ErrNotFound) {
@@ -33,6 +39,17 @@ func (s *service) UpdateUzi(ctx context.Context, arg UpdateUziArg) (domain.Uzi,
 		return domain.Uzi{}, fmt.Errorf("update uzi: %w", err)
 	}
 
+	// размер пикселя изменился, измерения узлов устарели
+	if arg.PixelSpacing != nil {
+		if _, _, err := s.measurement.RecalculateUzi(ctx, uzi.Id); err != nil {
+			return domain.Uzi{}, fmt.Errorf("recalculate uzi measurements: %w", err)
+		}
+	}
+
+	if err := s.dao.CommitTx(ctx); err != nil {
+		return domain.Uzi{}, fmt.Errorf("commit transaction: %w", err)
+	}
+
 	return uzi, nil
 }
 
@@ -45,6 +62,7 @@ func (s *service) UpdateEchographic(ctx context.Context, arg UpdateEchographicAr
 		return domain.Echographic{}, fmt.Errorf("get uzi by id: %w", err)
 	}
 	arg.UpdateDomain(&echographic)
+	echographic.ComputeVolumes()
 
 	if err := s.dao.NewEchographicQuery(ctx).UpdateEchographic(echographicEntity.Echographic{}.FromDomain(echographic)); err != nil {
 		var dbErr *entity.DBConflictError
@@ -58,5 +76,9 @@ func (s *service) UpdateEchographic(ctx context.Context, arg UpdateEchographicAr
 		return domain.Echographic{}, fmt.Errorf("update echographic: %w", err)
 	}
 
+	if err := s.describeEchographic(&echographic); err != nil {
+		return domain.Echographic{}, err
+	}
+
 	return echographic, nil
 }
@@ -38,7 +38,7 @@ var SaveNodesWithSegments flowfuncDepsInjector = func(deps *Deps) flowfunc {
 				imageId := data.Images[rand.Intn(len(data.Images))].Id
 				segment := &pbDbus.UziProcessed_Segment{
 					ImageId:   imageId.String(),
-					Contor:    []byte(`[{"x": 1, "y": 1}]`),
+					Contor:    []byte(`{"type": "Polygon", "version": 1, "coordinates": [[[1, 1], [5, 1], [5, 5], [1, 1]]]}`),
 					Tirads_23: rand.Float64(),
 					Tirads_4:  rand.Float64(),
 					Tirads_5:  rand.Float64(),
@@ -13,9 +13,19 @@ import (
 )
 
 var UziInit flowfuncDepsInjector = func(deps *Deps) flowfunc {
+	return UziInitWithExternalID(uuid.New())(deps)
+}
+
+// UziInitWithExternalID создает узи указанного пациента
+func UziInitWithExternalID(externalId uuid.UUID) flowfuncDepsInjector {
+	return func(deps *Deps) flowfunc {
+		return uziInit(deps, externalId)
+	}
+}
+
+func uziInit(deps *Deps, externalId uuid.UUID) flowfunc {
 	return func(ctx context.Context, data FlowData) (FlowData, error) {
 		projection := domain.UziProjectionLong
-		externalId := uuid.New()
 		author := uuid.New()
 		description := gofakeit.Word()
 
@@ -0,0 +1,66 @@
+//go:build e2e
+
+package dataset_test
+
+import (
+	"path/filepath"
+
+	"github.com/AlekSi/pointer"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/require"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/tests/e2e/flow"
+)
+
+func (suite *TestSuite) TestExportDataset_ManualNodes() {
+	data, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+		// узлы нейросети без валидации в датасет не попадают
+		flow.SaveNodesWithSegments,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	_, err = suite.deps.Adapter.CreateNodeWithSegments(
+		suite.T().Context(),
+		&pb.CreateNodeWithSegmentsIn{
+			UziId: data.Uzi.Id.String(),
+			Node:  &pb.CreateNodeWithSegmentsIn_Node{Tirads_5: 0.9},
+			Segments: []*pb.CreateNodeWithSegmentsIn_Segment{
+				{
+					ImageId: data.Images[0].Id.String(),
+					Contor:  []byte(`[{"x": 1, "y": 1}, {"x": 5, "y": 1}, {"x": 5, "y": 5}]`),
+				},
+			},
+		},
+	)
+	require.NoError(suite.T(), err)
+
+	export, err := suite.deps.Adapter.ExportDataset(
+		suite.T().Context(),
+		&pb.ExportDatasetIn{
+			ExternalId: pointer.To(data.Uzi.ExternalID.String()),
+			Manual:     true,
+			Format:     pb.DatasetFormat_DATASET_FORMAT_COCO,
+		},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), int64(1), export.TrainImages+export.ValImages)
+	require.Equal(suite.T(), int64(1), export.Annotations)
+
+	for _, name := range []string{"annotations/instances_train.json", "annotations/instances_val.json"} {
+		_, err = suite.deps.S3.StatObject(suite.T().Context(), suite.deps.Bucket, filepath.Join(export.Path, name), minio.StatObjectOptions{})
+		require.NoError(suite.T(), err)
+	}
+}
+
+func (suite *TestSuite) TestExportDataset_NoNodeSource() {
+	_, err := suite.deps.Adapter.ExportDataset(
+		suite.T().Context(),
+		&pb.ExportDatasetIn{},
+	)
+	require.Error(suite.T(), err)
+}
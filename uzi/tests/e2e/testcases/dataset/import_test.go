@@ -0,0 +1,76 @@
+//go:build e2e
+
+package dataset_test
+
+import (
+	"fmt"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/require"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/tests/e2e/flow"
+)
+
+func (suite *TestSuite) TestImportAnnotations_DryRunThenImport() {
+	data, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	content := []byte(fmt.Sprintf(`{
+		"images": [
+			{"id": 1, "file_name": "%s/1.png"},
+			{"id": 2, "file_name": "%s/999.png"}
+		],
+		"categories": [{"id": 1, "name": "tirads_4"}],
+		"annotations": [
+			{"id": 1, "image_id": 1, "category_id": 1, "segmentation": [[1, 1, 5, 1, 5, 5]]},
+			{"id": 2, "image_id": 1, "category_id": 1, "segmentation": [[1, 1, 2, 2]]},
+			{"id": 3, "image_id": 2, "category_id": 1, "segmentation": [[1, 1, 5, 1, 5, 5]]}
+		]
+	}`, data.Uzi.Id, data.Uzi.Id))
+
+	dryRun, err := suite.deps.Adapter.ImportAnnotations(
+		suite.T().Context(),
+		&pb.ImportAnnotationsIn{Format: pb.AnnotationFormat_ANNOTATION_FORMAT_COCO, Content: content, DryRun: true},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(dryRun.Nodes))
+	require.Nil(suite.T(), dryRun.Nodes[0].NodeId)
+	require.Equal(suite.T(), "tirads_4", dryRun.Nodes[0].GetClass())
+	// по невалидному сегменту и узлу без сегментов для аннотаций 2 и 3
+	require.Equal(suite.T(), 4, len(dryRun.Skipped))
+
+	nodes, err := suite.deps.Adapter.GetNodesByUziId(suite.T().Context(), &pb.GetNodesByUziIdIn{UziId: data.Uzi.Id.String()})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 0, len(nodes.Nodes))
+
+	imported, err := suite.deps.Adapter.ImportAnnotations(
+		suite.T().Context(),
+		&pb.ImportAnnotationsIn{
+			UziId:   pointer.To(data.Uzi.Id.String()),
+			Format:  pb.AnnotationFormat_ANNOTATION_FORMAT_COCO,
+			Content: content,
+		},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(imported.Nodes))
+	require.NotNil(suite.T(), imported.Nodes[0].NodeId)
+
+	nodes, err = suite.deps.Adapter.GetNodesByUziId(suite.T().Context(), &pb.GetNodesByUziIdIn{UziId: data.Uzi.Id.String()})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(nodes.Nodes))
+	require.False(suite.T(), nodes.Nodes[0].Ai)
+}
+
+func (suite *TestSuite) TestImportAnnotations_BrokenFile() {
+	_, err := suite.deps.Adapter.ImportAnnotations(
+		suite.T().Context(),
+		&pb.ImportAnnotationsIn{Format: pb.AnnotationFormat_ANNOTATION_FORMAT_CVAT, Content: []byte("<annotations")},
+	)
+	require.Error(suite.T(), err)
+}
@@ -0,0 +1,58 @@
+//go:build e2e
+
+package device_test
+
+import (
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/tests/e2e/flow"
+)
+
+func (suite *TestSuite) TestUpdateDevice_Success() {
+	createResp, err := suite.deps.Adapter.CreateDevice(suite.T().Context(), &pb.CreateDeviceIn{
+		Name:         gofakeit.School(),
+		Manufacturer: pointer.To(gofakeit.Company()),
+		ProbeType:    pb.ProbeType_PROBE_TYPE_LINEAR.Enum(),
+	})
+	require.NoError(suite.T(), err)
+
+	updateResp, err := suite.deps.Adapter.UpdateDevice(suite.T().Context(), &pb.UpdateDeviceIn{
+		Id:           createResp.Id,
+		Model:        pointer.To(gofakeit.Word()),
+		PixelSpacing: &pb.PixelSpacing{X: 0.1, Y: 0.1},
+	})
+	require.NoError(suite.T(), err)
+
+	getResp, err := suite.deps.Adapter.GetDeviceById(suite.T().Context(), &pb.GetDeviceByIdIn{Id: createResp.Id})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), updateResp.Device.Model, getResp.Device.Model)
+	require.Equal(suite.T(), pb.ProbeType_PROBE_TYPE_LINEAR, getResp.Device.GetProbeType())
+	require.NotNil(suite.T(), getResp.Device.Manufacturer)
+	require.InDelta(suite.T(), 0.1, getResp.Device.PixelSpacing.X, 1e-6)
+}
+
+func (suite *TestSuite) TestDeleteDevice_UsedByUzi() {
+	data, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	_, err = suite.deps.Adapter.DeleteDevice(suite.T().Context(), &pb.DeleteDeviceIn{Id: int64(data.Device.Id)})
+	require.Equal(suite.T(), codes.FailedPrecondition, status.Code(err))
+
+	createResp, err := suite.deps.Adapter.CreateDevice(suite.T().Context(), &pb.CreateDeviceIn{Name: gofakeit.School()})
+	require.NoError(suite.T(), err)
+
+	_, err = suite.deps.Adapter.DeleteDevice(suite.T().Context(), &pb.DeleteDeviceIn{Id: createResp.Id})
+	require.NoError(suite.T(), err)
+
+	_, err = suite.deps.Adapter.GetDeviceById(suite.T().Context(), &pb.GetDeviceByIdIn{Id: createResp.Id})
+	require.Equal(suite.T(), codes.NotFound, status.Code(err))
+}
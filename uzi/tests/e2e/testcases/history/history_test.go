@@ -0,0 +1,130 @@
+//go:build e2e
+
+package history_test
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/tests/e2e/flow"
+)
+
+func (suite *TestSuite) createManualNode(ctx context.Context) (*pb.CreateNodeWithSegmentsOut, flow.FlowData) {
+	data, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(ctx)
+	require.NoError(suite.T(), err)
+
+	created, err := suite.deps.Adapter.CreateNodeWithSegments(
+		ctx,
+		&pb.CreateNodeWithSegmentsIn{
+			UziId: data.Uzi.Id.String(),
+			Node: &pb.CreateNodeWithSegmentsIn_Node{
+				Tirads_23: 0.5,
+				Tirads_4:  0.3,
+				Tirads_5:  0.2,
+			},
+			Segments: []*pb.CreateNodeWithSegmentsIn_Segment{
+				{
+					ImageId:   data.Images[0].Id.String(),
+					Contor:    []byte(`{"type": "Polygon", "version": 1, "coordinates": [[[1, 1], [5, 1], [5, 5], [1, 1]]]}`),
+					Tirads_23: 0.5,
+					Tirads_4:  0.3,
+					Tirads_5:  0.2,
+				},
+			},
+		},
+	)
+	require.NoError(suite.T(), err)
+
+	return created, data
+}
+
+func (suite *TestSuite) TestNodeHistory_UpdateWithActorAndRestore() {
+	actor := uuid.New()
+	ctx := metadata.AppendToOutgoingContext(suite.T().Context(), "x-actor-id", actor.String())
+
+	created, _ := suite.createManualNode(ctx)
+
+	_, err := suite.deps.Adapter.UpdateNode(
+		ctx,
+		&pb.UpdateNodeIn{Id: created.NodeId, Tirads_5: pointer.To(0.9)},
+	)
+	require.NoError(suite.T(), err)
+
+	history, err := suite.deps.Adapter.GetNodeHistory(ctx, &pb.GetNodeHistoryIn{NodeId: created.NodeId})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), history.Versions, 2)
+
+	update := history.Versions[0]
+	require.Equal(suite.T(), int64(2), update.Version)
+	require.Equal(suite.T(), pb.HistoryAction_HISTORY_ACTION_UPDATE, update.Action)
+	require.Equal(suite.T(), actor.String(), update.GetActor())
+	require.Len(suite.T(), update.Diff, 1)
+	require.Equal(suite.T(), "tirads_5", update.Diff[0].Field)
+	require.Equal(suite.T(), pb.HistoryAction_HISTORY_ACTION_CREATE, history.Versions[1].Action)
+
+	restored, err := suite.deps.Adapter.RestoreNode(ctx, &pb.RestoreNodeIn{NodeId: created.NodeId, Version: 1})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 0.2, restored.Node.Tirads_5)
+
+	history, err = suite.deps.Adapter.GetNodeHistory(ctx, &pb.GetNodeHistoryIn{NodeId: created.NodeId})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), history.Versions, 3)
+	require.Equal(suite.T(), pb.HistoryAction_HISTORY_ACTION_RESTORE, history.Versions[0].Action)
+}
+
+func (suite *TestSuite) TestNodeHistory_RestoreDeletedNodeWithSegments() {
+	ctx := suite.T().Context()
+
+	created, _ := suite.createManualNode(ctx)
+
+	_, err := suite.deps.Adapter.DeleteNode(ctx, &pb.DeleteNodeIn{Id: created.NodeId})
+	require.NoError(suite.T(), err)
+
+	history, err := suite.deps.Adapter.GetNodeHistory(ctx, &pb.GetNodeHistoryIn{NodeId: created.NodeId})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), pb.HistoryAction_HISTORY_ACTION_DELETE, history.Versions[0].Action)
+	require.Nil(suite.T(), history.Versions[0].After)
+
+	_, err = suite.deps.Adapter.RestoreNode(ctx, &pb.RestoreNodeIn{NodeId: created.NodeId, Version: history.Versions[0].Version})
+	require.Error(suite.T(), err)
+
+	_, err = suite.deps.Adapter.RestoreNode(ctx, &pb.RestoreNodeIn{NodeId: created.NodeId, Version: 1})
+	require.NoError(suite.T(), err)
+
+	segments, err := suite.deps.Adapter.GetSegmentsByNodeId(ctx, &pb.GetSegmentsByNodeIdIn{NodeId: created.NodeId})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), segments.Segments, 1)
+	require.Equal(suite.T(), created.SegmentIds[0], segments.Segments[0].Id)
+}
+
+func (suite *TestSuite) TestSegmentHistory_RestoreContor() {
+	ctx := suite.T().Context()
+
+	created, _ := suite.createManualNode(ctx)
+	segmentID := created.SegmentIds[0]
+
+	_, err := suite.deps.Adapter.UpdateSegment(
+		ctx,
+		&pb.UpdateSegmentIn{Id: segmentID, Contor: []byte(`{"type": "Polygon", "version": 1, "coordinates": [[[2, 2], [6, 2], [6, 6], [2, 2]]]}`)},
+	)
+	require.NoError(suite.T(), err)
+
+	history, err := suite.deps.Adapter.GetSegmentHistory(ctx, &pb.GetSegmentHistoryIn{SegmentId: segmentID})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), history.Versions, 2)
+	require.Equal(suite.T(), "contor", history.Versions[0].Diff[0].Field)
+
+	restored, err := suite.deps.Adapter.RestoreSegment(ctx, &pb.RestoreSegmentIn{SegmentId: segmentID, Version: 1})
+	require.NoError(suite.T(), err)
+	require.JSONEq(suite.T(), `{"type": "Polygon", "version": 1, "coordinates": [[[1, 1], [5, 1], [5, 5], [1, 1]]]}`, string(restored.Segment.Contor))
+}
@@ -0,0 +1,182 @@
+//go:build e2e
+
+package lineage_test
+
+import (
+	"math/rand"
+
+	"github.com/stretchr/testify/require"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/tests/e2e/flow"
+)
+
+func (suite *TestSuite) createNode(data flow.FlowData, contor string) string {
+	resp, err := suite.deps.Adapter.CreateNodeWithSegments(
+		suite.T().Context(),
+		&pb.CreateNodeWithSegmentsIn{
+			UziId: data.Uzi.Id.String(),
+			Node: &pb.CreateNodeWithSegmentsIn_Node{
+				Tirads_23: rand.Float64(),
+				Tirads_4:  rand.Float64(),
+				Tirads_5:  rand.Float64(),
+			},
+			Segments: []*pb.CreateNodeWithSegmentsIn_Segment{
+				{
+					ImageId:   data.Images[0].Id.String(),
+					Contor:    []byte(contor),
+					Tirads_23: rand.Float64(),
+					Tirads_4:  rand.Float64(),
+					Tirads_5:  rand.Float64(),
+				},
+			},
+		},
+	)
+	require.NoError(suite.T(), err)
+
+	_, err = suite.deps.Adapter.UpdateUzi(
+		suite.T().Context(),
+		&pb.UpdateUziIn{
+			Id:           data.Uzi.Id.String(),
+			PixelSpacing: &pb.PixelSpacing{X: 1, Y: 1},
+		},
+	)
+	require.NoError(suite.T(), err)
+
+	return resp.NodeId
+}
+
+func (suite *TestSuite) TestLinkNodes_GrowthReport() {
+	first, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	second, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInitWithExternalID(first.Uzi.ExternalID),
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	firstNode := suite.createNode(first, `[{"x": 0, "y": 0}, {"x": 10, "y": 0}, {"x": 10, "y": 10}, {"x": 0, "y": 10}]`)
+	secondNode := suite.createNode(second, `[{"x": 0, "y": 0}, {"x": 20, "y": 0}, {"x": 20, "y": 20}, {"x": 0, "y": 20}]`)
+
+	suggestResp, err := suite.deps.Adapter.SuggestNodeLinks(
+		suite.T().Context(),
+		&pb.SuggestNodeLinksIn{NodeId: secondNode},
+	)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), suggestResp.Suggestions, 1)
+	require.Equal(suite.T(), firstNode, suggestResp.Suggestions[0].Node.Id)
+
+	linkResp, err := suite.deps.Adapter.LinkNodes(
+		suite.T().Context(),
+		&pb.LinkNodesIn{NodeId: secondNode, TargetNodeId: firstNode},
+	)
+	require.NoError(suite.T(), err)
+
+	reportResp, err := suite.deps.Adapter.GetGrowthReport(
+		suite.T().Context(),
+		&pb.GetGrowthReportIn{ExternalId: first.Uzi.ExternalID.String()},
+	)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), reportResp.Lineages, 1)
+	require.Equal(suite.T(), linkResp.LineageId, reportResp.Lineages[0].LineageId)
+	require.Len(suite.T(), reportResp.Lineages[0].Points, 2)
+	require.NotNil(suite.T(), reportResp.Lineages[0].VolumeChange)
+	require.InDelta(suite.T(), 700, *reportResp.Lineages[0].VolumeChange, 0.001)
+
+	_, err = suite.deps.Adapter.UnlinkNode(
+		suite.T().Context(),
+		&pb.UnlinkNodeIn{NodeId: secondNode},
+	)
+	require.NoError(suite.T(), err)
+
+	reportResp, err = suite.deps.Adapter.GetGrowthReport(
+		suite.T().Context(),
+		&pb.GetGrowthReportIn{ExternalId: first.Uzi.ExternalID.String()},
+	)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), reportResp.Lineages)
+}
+
+func (suite *TestSuite) TestLinkNodes_DifferentPatients() {
+	first, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	second, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	firstNode := suite.createNode(first, `[{"x": 0, "y": 0}, {"x": 10, "y": 0}, {"x": 10, "y": 10}]`)
+	secondNode := suite.createNode(second, `[{"x": 0, "y": 0}, {"x": 10, "y": 0}, {"x": 10, "y": 10}]`)
+
+	_, err = suite.deps.Adapter.LinkNodes(
+		suite.T().Context(),
+		&pb.LinkNodesIn{NodeId: secondNode, TargetNodeId: firstNode},
+	)
+	require.Error(suite.T(), err)
+}
+
+func (suite *TestSuite) TestLinkNodes_TrackedNodeToUntracked() {
+	first, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	datas := []flow.FlowData{first}
+	for range 2 {
+		data, err := flow.New(
+			suite.deps,
+			flow.DeviceInit,
+			flow.UziInitWithExternalID(first.Uzi.ExternalID),
+			flow.TiffSplit,
+		).Do(suite.T().Context())
+		require.NoError(suite.T(), err)
+		datas = append(datas, data)
+	}
+
+	contor := `[{"x": 0, "y": 0}, {"x": 10, "y": 0}, {"x": 10, "y": 10}]`
+	firstNode := suite.createNode(datas[0], contor)
+	secondNode := suite.createNode(datas[1], contor)
+	thirdNode := suite.createNode(datas[2], contor)
+
+	linkResp, err := suite.deps.Adapter.LinkNodes(
+		suite.T().Context(),
+		&pb.LinkNodesIn{NodeId: secondNode, TargetNodeId: firstNode},
+	)
+	require.NoError(suite.T(), err)
+
+	// отслеживаемый узел в node, неотслеживаемый в target: target добавляется в цепочку
+	thirdResp, err := suite.deps.Adapter.LinkNodes(
+		suite.T().Context(),
+		&pb.LinkNodesIn{NodeId: secondNode, TargetNodeId: thirdNode},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), linkResp.LineageId, thirdResp.LineageId)
+
+	reportResp, err := suite.deps.Adapter.GetGrowthReport(
+		suite.T().Context(),
+		&pb.GetGrowthReportIn{ExternalId: first.Uzi.ExternalID.String()},
+	)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), reportResp.Lineages, 1)
+	require.Len(suite.T(), reportResp.Lineages[0].Points, 3)
+}
@@ -0,0 +1,89 @@
+//go:build e2e
+
+package node_test
+
+import (
+	"math/rand"
+
+	"github.com/stretchr/testify/require"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/tests/e2e/flow"
+)
+
+func (suite *TestSuite) TestSetNodeDescriptors_Success() {
+	data, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	createResp, err := suite.deps.Adapter.CreateNodeWithSegments(
+		suite.T().Context(),
+		&pb.CreateNodeWithSegmentsIn{
+			UziId: data.Uzi.Id.String(),
+			Node: &pb.CreateNodeWithSegmentsIn_Node{
+				Tirads_23: rand.Float64(),
+				Tirads_4:  rand.Float64(),
+				Tirads_5:  rand.Float64(),
+			},
+			Segments: []*pb.CreateNodeWithSegmentsIn_Segment{
+				{
+					ImageId:   data.Images[0].Id.String(),
+					Contor:    []byte(`[{"x": 0, "y": 0}, {"x": 20, "y": 0}, {"x": 20, "y": 10}, {"x": 0, "y": 10}]`),
+					Tirads_23: rand.Float64(),
+					Tirads_4:  rand.Float64(),
+					Tirads_5:  rand.Float64(),
+				},
+			},
+		},
+	)
+	require.NoError(suite.T(), err)
+
+	getResp, err := suite.deps.Adapter.GetNodeTirads(
+		suite.T().Context(),
+		&pb.GetNodeTiradsIn{NodeId: createResp.NodeId},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), createResp.NodeId, getResp.Tirads.Node.Id)
+	require.Nil(suite.T(), getResp.Tirads.Descriptors)
+	require.Nil(suite.T(), getResp.Tirads.Score)
+
+	_, err = suite.deps.Adapter.UpdateUzi(
+		suite.T().Context(),
+		&pb.UpdateUziIn{
+			Id:           data.Uzi.Id.String(),
+			PixelSpacing: &pb.PixelSpacing{X: 1, Y: 1},
+		},
+	)
+	require.NoError(suite.T(), err)
+
+	setResp, err := suite.deps.Adapter.SetNodeDescriptors(
+		suite.T().Context(),
+		&pb.SetNodeDescriptorsIn{
+			Descriptors: &pb.NodeDescriptors{
+				NodeId:        createResp.NodeId,
+				Composition:   pb.TiradsComposition_TIRADS_COMPOSITION_SOLID,
+				Echogenicity:  pb.TiradsEchogenicity_TIRADS_ECHOGENICITY_HYPO,
+				Shape:         pb.TiradsShape_TIRADS_SHAPE_WIDER_THAN_TALL,
+				Margin:        pb.TiradsMargin_TIRADS_MARGIN_SMOOTH,
+				EchogenicFoci: []pb.TiradsEchogenicFoci{pb.TiradsEchogenicFoci_TIRADS_ECHOGENIC_FOCI_PUNCTATE},
+			},
+		},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), int64(7), setResp.Tirads.Score.Points)
+	require.Equal(suite.T(), pb.TiradsCategory_TIRADS_CATEGORY_TR5, setResp.Tirads.Score.Category)
+	require.Equal(suite.T(), pb.TiradsRecommendation_TIRADS_RECOMMENDATION_FNA, setResp.Tirads.Score.Recommendation)
+
+	getResp, err = suite.deps.Adapter.GetNodeTirads(
+		suite.T().Context(),
+		&pb.GetNodeTiradsIn{NodeId: createResp.NodeId},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), pb.TiradsComposition_TIRADS_COMPOSITION_SOLID, getResp.Tirads.Descriptors.Composition)
+	require.Equal(suite.T(), setResp.Tirads.Descriptors.EchogenicFoci, getResp.Tirads.Descriptors.EchogenicFoci)
+	require.Equal(suite.T(), setResp.Tirads.Score.Category, getResp.Tirads.Score.Category)
+}
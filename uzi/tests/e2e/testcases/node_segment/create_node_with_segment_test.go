@@ -34,14 +34,14 @@ func (suite *TestSuite) TestCreateNodeWithSegment_Success() {
 	segments := []*pb.CreateNodeWithSegmentsIn_Segment{
 		{
 			ImageId:   data.Images[0].Id.String(),
-			Contor:    []byte(`[{"x": 1, "y": 1}]`),
+			Contor:    []byte(`{"type": "Polygon", "version": 1, "coordinates": [[[1, 1], [5, 1], [5, 5], [1, 1]]]}`),
 			Tirads_23: rand.Float64(),
 			Tirads_4:  rand.Float64(),
 			Tirads_5:  rand.Float64(),
 		},
 		{
 			ImageId:   data.Images[1].Id.String(),
-			Contor:    []byte(`[{"x": 1, "y": 1}]`),
+			Contor:    []byte(`{"type": "Polygon", "version": 1, "coordinates": [[[1, 1], [5, 1], [5, 5], [1, 1]]]}`),
 			Tirads_23: rand.Float64(),
 			Tirads_4:  rand.Float64(),
 			Tirads_5:  rand.Float64(),
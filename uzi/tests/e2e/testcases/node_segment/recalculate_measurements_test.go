@@ -0,0 +1,77 @@
+//go:build e2e
+
+package node_segment_test
+
+import (
+	"math/rand"
+
+	"github.com/stretchr/testify/require"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/tests/e2e/flow"
+)
+
+func (suite *TestSuite) TestRecalculateMeasurements_PixelSpacing() {
+	data, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	createResp, err := suite.deps.Adapter.CreateNodeWithSegments(
+		suite.T().Context(),
+		&pb.CreateNodeWithSegmentsIn{
+			UziId: data.Uzi.Id.String(),
+			Node: &pb.CreateNodeWithSegmentsIn_Node{
+				Tirads_23: rand.Float64(),
+				Tirads_4:  rand.Float64(),
+				Tirads_5:  rand.Float64(),
+			},
+			Segments: []*pb.CreateNodeWithSegmentsIn_Segment{
+				{
+					ImageId:   data.Images[0].Id.String(),
+					Contor:    []byte(`[{"x": 0, "y": 0}, {"x": 20, "y": 0}, {"x": 20, "y": 10}, {"x": 0, "y": 10}]`),
+					Tirads_23: rand.Float64(),
+					Tirads_4:  rand.Float64(),
+					Tirads_5:  rand.Float64(),
+				},
+			},
+		},
+	)
+	require.NoError(suite.T(), err)
+
+	getResp, err := suite.deps.Adapter.GetNodesWithSegmentsByImageId(
+		suite.T().Context(),
+		&pb.GetNodesWithSegmentsByImageIdIn{Id: data.Images[0].Id.String()},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), pb.MeasureUnit_MEASURE_UNIT_PX, getResp.Segments[0].Measurement.Unit)
+	require.InDelta(suite.T(), 200, getResp.Segments[0].Measurement.Area, 0.001)
+	require.Equal(suite.T(), int64(20), getResp.Segments[0].Measurement.Bbox.Width)
+	require.Equal(suite.T(), pb.MeasureUnit_MEASURE_UNIT_PX, getResp.Nodes[0].Measurement.Unit)
+
+	_, err = suite.deps.Adapter.UpdateUzi(
+		suite.T().Context(),
+		&pb.UpdateUziIn{
+			Id:           data.Uzi.Id.String(),
+			PixelSpacing: &pb.PixelSpacing{X: 0.1, Y: 0.1},
+		},
+	)
+	require.NoError(suite.T(), err)
+
+	recalcResp, err := suite.deps.Adapter.RecalculateMeasurements(
+		suite.T().Context(),
+		&pb.RecalculateMeasurementsIn{UziId: data.Uzi.Id.String()},
+	)
+	require.NoError(suite.T(), err)
+
+	require.Len(suite.T(), recalcResp.Nodes, 1)
+	require.Equal(suite.T(), createResp.NodeId, recalcResp.Nodes[0].Id)
+	require.Equal(suite.T(), pb.MeasureUnit_MEASURE_UNIT_MM, recalcResp.Nodes[0].Measurement.Unit)
+	require.InDelta(suite.T(), 2, recalcResp.Nodes[0].Measurement.Area, 0.001)
+	require.Len(suite.T(), recalcResp.Segments, 1)
+	require.Equal(suite.T(), pb.MeasureUnit_MEASURE_UNIT_MM, recalcResp.Segments[0].Measurement.Unit)
+	require.Equal(suite.T(), int64(20), recalcResp.Segments[0].Measurement.Bbox.Width)
+}
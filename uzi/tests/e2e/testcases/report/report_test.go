@@ -0,0 +1,77 @@
+//go:build e2e
+
+package report_test
+
+import (
+	"github.com/AlekSi/pointer"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/require"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/tests/e2e/flow"
+)
+
+func (suite *TestSuite) TestGenerateReport_Versions() {
+	data, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+		flow.SaveNodesWithSegments,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	first, err := suite.deps.Adapter.GenerateReport(
+		suite.T().Context(),
+		&pb.GenerateReportIn{UziId: data.Uzi.Id.String()},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), int64(1), first.Report.Version)
+
+	pdf, err := suite.deps.S3.StatObject(suite.T().Context(), suite.deps.Bucket, first.Report.PdfPath, minio.StatObjectOptions{})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "application/pdf", pdf.ContentType)
+	_, err = suite.deps.S3.StatObject(suite.T().Context(), suite.deps.Bucket, first.Report.HtmlPath, minio.StatObjectOptions{})
+	require.NoError(suite.T(), err)
+
+	second, err := suite.deps.Adapter.GenerateReport(
+		suite.T().Context(),
+		&pb.GenerateReportIn{UziId: data.Uzi.Id.String()},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), int64(2), second.Report.Version)
+	require.NotEqual(suite.T(), first.Report.PdfPath, second.Report.PdfPath)
+
+	reports, err := suite.deps.Adapter.GetReports(
+		suite.T().Context(),
+		&pb.GetReportsIn{UziId: data.Uzi.Id.String()},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 2, len(reports.Reports))
+	require.Equal(suite.T(), int64(2), reports.Reports[0].Version)
+
+	last, err := suite.deps.Adapter.GetReport(
+		suite.T().Context(),
+		&pb.GetReportIn{UziId: data.Uzi.Id.String()},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), second.Report.Id, last.Report.Id)
+
+	old, err := suite.deps.Adapter.GetReport(
+		suite.T().Context(),
+		&pb.GetReportIn{UziId: data.Uzi.Id.String(), Version: pointer.To(int64(1))},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), first.Report.Id, old.Report.Id)
+}
+
+func (suite *TestSuite) TestGetReport_NotFound() {
+	data, err := flow.New(suite.deps, flow.DeviceInit, flow.UziInit).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	_, err = suite.deps.Adapter.GetReport(
+		suite.T().Context(),
+		&pb.GetReportIn{UziId: data.Uzi.Id.String()},
+	)
+	require.Error(suite.T(), err)
+}
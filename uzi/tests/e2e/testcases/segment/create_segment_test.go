@@ -7,6 +7,8 @@ import (
 	"math/rand"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "uzi/internal/generated/grpc/service"
 	"uzi/tests/e2e/flow"
@@ -22,7 +24,7 @@ func (suite *TestSuite) TestCreateSegment_AiNodeCreateNotAllowed() {
 	).Do(suite.T().Context())
 	require.NoError(suite.T(), err)
 
-	contor := []byte(`[{"x": 1, "y": 1}]`)
+	contor := []byte(`{"type": "Polygon", "version": 1, "coordinates": [[[1, 1], [5, 1], [5, 5], [1, 1]]]}`)
 	tirads23 := rand.Float64()
 	tirads4 := rand.Float64()
 	tirads5 := rand.Float64()
@@ -59,7 +61,7 @@ func (suite *TestSuite) TestCreateSegment_Success() {
 	segments := []*pb.CreateNodeWithSegmentsIn_Segment{
 		{
 			ImageId:   data.Images[0].Id.String(),
-			Contor:    []byte(`[{"x": 1, "y": 1}]`),
+			Contor:    []byte(`{"type": "Polygon", "version": 1, "coordinates": [[[1, 1], [5, 1], [5, 5], [1, 1]]]}`),
 			Tirads_23: rand.Float64(),
 			Tirads_4:  rand.Float64(),
 			Tirads_5:  rand.Float64(),
@@ -76,7 +78,7 @@ func (suite *TestSuite) TestCreateSegment_Success() {
 	)
 	require.NoError(suite.T(), err)
 
-	contor := []byte(`[{"x": 1, "y": 1}]`)
+	contor := []byte(`{"type": "Polygon", "version": 1, "coordinates": [[[1, 1], [5, 1], [5, 5], [1, 1]]]}`)
 	tirads23 := rand.Float64()
 	tirads4 := rand.Float64()
 	tirads5 := rand.Float64()
@@ -110,3 +112,67 @@ func (suite *TestSuite) TestCreateSegment_Success() {
 	require.True(suite.T(), math.Abs(tirads4-getResp.Segments[0].Tirads_4) < 0.0001)
 	require.True(suite.T(), math.Abs(tirads5-getResp.Segments[0].Tirads_5) < 0.0001)
 }
+
+func (suite *TestSuite) TestCreateSegment_LegacyContorNormalized() {
+	data, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	createNodeResp, err := suite.deps.Adapter.CreateNodeWithSegments(
+		suite.T().Context(),
+		&pb.CreateNodeWithSegmentsIn{
+			UziId: data.Uzi.Id.String(),
+			Node:  &pb.CreateNodeWithSegmentsIn_Node{},
+			Segments: []*pb.CreateNodeWithSegmentsIn_Segment{
+				{
+					ImageId: data.Images[0].Id.String(),
+					// по часовой стрелке, с повтором точки
+					Contor: []byte(`[{"x": 1, "y": 1}, {"x": 1, "y": 5}, {"x": 1, "y": 5}, {"x": 5, "y": 5}]`),
+				},
+			},
+		},
+	)
+	require.NoError(suite.T(), err)
+
+	getResp, err := suite.deps.Adapter.GetSegmentsByNodeId(
+		suite.T().Context(),
+		&pb.GetSegmentsByNodeIdIn{NodeId: createNodeResp.NodeId},
+	)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), getResp.Segments, 1)
+	require.JSONEq(suite.T(),
+		`{"type": "Polygon", "version": 1, "coordinates": [[[5, 5], [1, 5], [1, 1], [5, 5]]]}`,
+		string(getResp.Segments[0].Contor),
+	)
+}
+
+func (suite *TestSuite) TestCreateSegment_InvalidContor() {
+	data, err := flow.New(
+		suite.deps,
+		flow.DeviceInit,
+		flow.UziInit,
+		flow.TiffSplit,
+	).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	for _, contor := range []string{
+		`[{"x": 1, "y": 1}]`,
+		`[{"x": 0, "y": 0}, {"x": 10, "y": 10}, {"x": 10, "y": 0}, {"x": 0, "y": 10}]`,
+	} {
+		_, err = suite.deps.Adapter.CreateNodeWithSegments(
+			suite.T().Context(),
+			&pb.CreateNodeWithSegmentsIn{
+				UziId: data.Uzi.Id.String(),
+				Node:  &pb.CreateNodeWithSegmentsIn_Node{},
+				Segments: []*pb.CreateNodeWithSegmentsIn_Segment{
+					{ImageId: data.Images[0].Id.String(), Contor: []byte(contor)},
+				},
+			},
+		)
+		require.Equal(suite.T(), codes.InvalidArgument, status.Code(err), contor)
+	}
+}
@@ -31,7 +31,7 @@ func (suite *TestSuite) TestUpdateSegment_Success() {
 	segments := []*pb.CreateNodeWithSegmentsIn_Segment{
 		{
 			ImageId:   data.Images[0].Id.String(),
-			Contor:    []byte(`[{"x": 1, "y": 1}]`),
+			Contor:    []byte(`{"type": "Polygon", "version": 1, "coordinates": [[[1, 1], [5, 1], [5, 5], [1, 1]]]}`),
 			Tirads_23: rand.Float64(),
 			Tirads_4:  rand.Float64(),
 			Tirads_5:  rand.Float64(),
@@ -48,7 +48,7 @@ func (suite *TestSuite) TestUpdateSegment_Success() {
 	)
 	require.NoError(suite.T(), err)
 
-	newContor := json.RawMessage(`[{"x": 2, "y": 2}]`)
+	newContor := json.RawMessage(`{"type": "Polygon", "version": 1, "coordinates": [[[2, 2], [6, 2], [6, 6], [2, 2]]]}`)
 	tirads23 := rand.Float64()
 	tirads4 := rand.Float64()
 	tirads5 := rand.Float64()
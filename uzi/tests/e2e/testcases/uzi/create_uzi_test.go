@@ -48,3 +48,34 @@ func (suite *TestSuite) TestCreateUzi_Success() {
 	require.Equal(suite.T(), int64(data.Device.Id), getResp.Uzi.DeviceId)
 	require.Equal(suite.T(), description, *getResp.Uzi.Description)
 }
+
+func (suite *TestSuite) TestCreateUzi_DuplicateSha256() {
+	data, err := flow.New(suite.deps, flow.DeviceInit).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	externalID := uuid.New()
+	sha256 := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	in := &pb.CreateUziIn{
+		DeviceId:   int64(data.Device.Id),
+		Projection: mappers.UziProjectionMap[domain.UziProjectionLong],
+		ExternalId: externalID.String(),
+		Author:     uuid.NewString(),
+		Sha256:     &sha256,
+	}
+
+	first, err := suite.deps.Adapter.CreateUzi(suite.T().Context(), in)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), first.DuplicateOf)
+
+	// по умолчанию дубль создается, но в ответе есть исходное узи
+	second, err := suite.deps.Adapter.CreateUzi(suite.T().Context(), in)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), []string{first.Id}, second.DuplicateOf)
+
+	getResp, err := suite.deps.Adapter.GetUziById(
+		suite.T().Context(),
+		&pb.GetUziByIdIn{Id: second.Id},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), sha256, getResp.Uzi.GetSha256())
+}
@@ -0,0 +1,82 @@
+//go:build e2e
+
+package uzi_test
+
+import (
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	pb "uzi/internal/generated/grpc/service"
+	"uzi/tests/e2e/flow"
+)
+
+func (suite *TestSuite) TestSearchUzis_Pagination() {
+	externalID := uuid.New()
+	created := map[string]struct{}{}
+	for range 3 {
+		data, err := flow.New(suite.deps, flow.DeviceInit, flow.UziInitWithExternalID(externalID)).Do(suite.T().Context())
+		require.NoError(suite.T(), err)
+		created[data.Uzi.Id.String()] = struct{}{}
+	}
+
+	firstPage, err := suite.deps.Adapter.SearchUzis(
+		suite.T().Context(),
+		&pb.SearchUzisIn{ExternalId: pointer.To(externalID.String()), Limit: 2},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 2, len(firstPage.Uzis))
+	require.NotNil(suite.T(), firstPage.NextCursor)
+
+	secondPage, err := suite.deps.Adapter.SearchUzis(
+		suite.T().Context(),
+		&pb.SearchUzisIn{ExternalId: pointer.To(externalID.String()), Limit: 2, Cursor: firstPage.NextCursor},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(secondPage.Uzis))
+	require.Nil(suite.T(), secondPage.NextCursor)
+
+	for _, uzi := range append(firstPage.Uzis, secondPage.Uzis...) {
+		_, ok := created[uzi.Id]
+		require.True(suite.T(), ok)
+		delete(created, uzi.Id)
+	}
+	require.Empty(suite.T(), created)
+}
+
+func (suite *TestSuite) TestSearchUzis_Filters() {
+	data, err := flow.New(suite.deps, flow.DeviceInit, flow.UziInit).Do(suite.T().Context())
+	require.NoError(suite.T(), err)
+
+	found, err := suite.deps.Adapter.SearchUzis(
+		suite.T().Context(),
+		&pb.SearchUzisIn{
+			Author:   pointer.To(data.Uzi.Author.String()),
+			Status:   pointer.To(pb.UziStatus_UZI_STATUS_NEW),
+			DeviceId: pointer.To(int64(data.Uzi.DeviceID)),
+			Checked:  pointer.To(false),
+		},
+	)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(found.Uzis))
+	require.Equal(suite.T(), data.Uzi.Id.String(), found.Uzis[0].Id)
+
+	// у нового узи еще нет нейросетевых узлов
+	empty, err := suite.deps.Adapter.SearchUzis(
+		suite.T().Context(),
+		&pb.SearchUzisIn{
+			Author:        pointer.To(data.Uzi.Author.String()),
+			AiTirads_5Min: pointer.To(0.5),
+		},
+	)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), empty.Uzis)
+}
+
+func (suite *TestSuite) TestSearchUzis_InvalidCursor() {
+	_, err := suite.deps.Adapter.SearchUzis(
+		suite.T().Context(),
+		&pb.SearchUzisIn{Cursor: pointer.To("not a cursor")},
+	)
+	require.Error(suite.T(), err)
+}